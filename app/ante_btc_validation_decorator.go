@@ -4,22 +4,26 @@ import (
 	bbn "github.com/babylonchain/babylon/types"
 	btccheckpointkeeper "github.com/babylonchain/babylon/x/btccheckpoint/keeper"
 	btccheckpointtypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	btclightclientkeeper "github.com/babylonchain/babylon/x/btclightclient/keeper"
 	btclightclient "github.com/babylonchain/babylon/x/btclightclient/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type BtcValidationDecorator struct {
-	BtcCfg              bbn.BtcConfig
-	btccheckpointKeeper *btccheckpointkeeper.Keeper
+	BtcCfg               bbn.BtcConfig
+	btccheckpointKeeper  *btccheckpointkeeper.Keeper
+	btclightclientKeeper *btclightclientkeeper.Keeper
 }
 
 func NewBtcValidationDecorator(
 	cfg bbn.BtcConfig,
 	k *btccheckpointkeeper.Keeper,
+	btclcKeeper *btclightclientkeeper.Keeper,
 ) BtcValidationDecorator {
 	return BtcValidationDecorator{
-		BtcCfg:              cfg,
-		btccheckpointKeeper: k,
+		BtcCfg:               cfg,
+		btccheckpointKeeper:  k,
+		btclightclientKeeper: btclcKeeper,
 	}
 }
 
@@ -39,6 +43,12 @@ func (bvd BtcValidationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulat
 				}
 
 			case *btclightclient.MsgInsertHeaders:
+				// synthetic headers of the mock BTC reporter carry no proof
+				// of work
+				params := bvd.btclightclientKeeper.GetParams(ctx)
+				if signer, err := sdk.AccAddressFromBech32(msg.Signer); err == nil && params.IsMockBTCReporter(signer) {
+					continue
+				}
 				powLimit := bvd.BtcCfg.PowLimit()
 				err := msg.ValidateHeaders(&powLimit)
 				if err != nil {
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -127,6 +128,9 @@ import (
 	btclightclienttypes "github.com/babylonchain/babylon/x/btclightclient/types"
 	"github.com/babylonchain/babylon/x/btcstaking"
 	btcstakingkeeper "github.com/babylonchain/babylon/x/btcstaking/keeper"
+	"github.com/babylonchain/babylon/x/btcstaking/sigverifier"
+	"github.com/babylonchain/babylon/x/btcstaking/slasher"
+	"github.com/babylonchain/babylon/x/btcstaking/tracing"
 	btcstakingtypes "github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/babylonchain/babylon/x/checkpointing"
 	checkpointingkeeper "github.com/babylonchain/babylon/x/checkpointing/keeper"
@@ -184,7 +188,7 @@ var (
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		ibcfeetypes.ModuleName:         nil,
-		incentivetypes.ModuleName:      nil, // this line is needed to create an account for incentive module
+		incentivetypes.ModuleName:      {authtypes.Burner}, // burns rewards of slashed finality providers
 	}
 )
 
@@ -199,6 +203,19 @@ var (
 	_ servertypes.Application = (*BabylonApp)(nil)
 )
 
+// The modules depending on the BTC staking module only depend on the narrow
+// interfaces in their types packages, which are mocked for unit tests. Ensure
+// that the keepers wired into them satisfy these interfaces.
+var (
+	_ finalitytypes.BTCStakingKeeper      = btcstakingkeeper.Keeper{}
+	_ zctypes.BTCStakingKeeper            = (*btcstakingkeeper.Keeper)(nil)
+	_ finalitytypes.IncentiveKeeper       = incentivekeeper.Keeper{}
+	_ btcstakingtypes.IncentiveKeeper     = incentivekeeper.Keeper{}
+	_ checkpointingtypes.IncentiveKeeper  = incentivekeeper.Keeper{}
+	_ btcstakingtypes.ZoneConciergeKeeper = zckeeper.Keeper{}
+	_ finalitytypes.ZoneConciergeKeeper   = zckeeper.Keeper{}
+)
+
 // BabylonApp extends an ABCI application, but with most of its parameters exported.
 // They are exported for convenience in creating helper functions, as object
 // capabilities aren't needed for testing.
@@ -273,6 +290,10 @@ type BabylonApp struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	// shuts down the exporter of BTC delegation lifecycle traces
+	shutdownTracing tracing.ShutdownFunc
+	slasher         *slasher.Slasher
 }
 
 func init() {
@@ -295,9 +316,9 @@ func NewBabylonApp(
 ) *BabylonApp {
 	// we could also take it from global object which should be initilised in rootCmd
 	// but this way it makes babylon app more testable
+	// the BTC config is shared by all modules operating on BTC, so that they
+	// cannot be configured with different BTC networks
 	btcConfig := bbn.ParseBtcOptionsFromConfig(appOpts)
-	powLimit := btcConfig.PowLimit()
-	btcNetParams := btcConfig.NetParams()
 	homePath := cast.ToString(appOpts.Get(flags.FlagHome))
 	if homePath == "" {
 		homePath = DefaultNodeHome
@@ -379,6 +400,7 @@ func NewBabylonApp(
 		runtime.NewKVStoreService(keys[checkpointingtypes.StoreKey]),
 		privSigner.WrappedPV,
 		epochingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	bApp := baseapp.NewBaseApp(appName, logger, db, txConfig.TxDecoder(), baseAppOptions...)
@@ -395,7 +417,7 @@ func NewBabylonApp(
 	proposalHandler.SetHandlers(bApp)
 
 	tkeys := storetypes.NewTransientStoreKeys(
-		paramstypes.TStoreKey, btccheckpointtypes.TStoreKey)
+		paramstypes.TStoreKey, btclightclienttypes.TStoreKey, btccheckpointtypes.TStoreKey, incentivetypes.TStoreKey)
 	// NOTE: The testingkey is just mounted for testing purposes. Actual applications should
 	// not include this key.
 	memKeys := storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey, "testingkey")
@@ -487,8 +509,10 @@ func NewBabylonApp(
 	app.IncentiveKeeper = incentivekeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[incentivetypes.StoreKey]),
+		tkeys[incentivetypes.TStoreKey],
 		app.BankKeeper,
 		app.AccountKeeper,
+		app.DistrKeeper,
 		&epochingKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		authtypes.FeeCollectorName,
@@ -502,6 +526,25 @@ func NewBabylonApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// create evidence keeper with router
+	evidenceKeeper := evidencekeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[evidencetypes.StoreKey]),
+		app.StakingKeeper,
+		app.SlashingKeeper,
+		app.AccountKeeper.AddressCodec(),
+		runtime.ProvideCometInfoService(),
+	)
+	// validators who signed checkpoints conflicting with the local ones are
+	// slashed and tombstoned via the evidence module
+	evidenceRouter := evidencetypes.NewRouter().AddRoute(
+		checkpointingtypes.RouteConflictingCheckpoint,
+		checkpointingkeeper.NewConflictingCheckpointEvidenceHandler(&checkpointingKeeper, app.StakingKeeper, app.SlashingKeeper),
+	)
+	evidenceKeeper.SetRouter(evidenceRouter)
+	app.EvidenceKeeper = *evidenceKeeper
+	checkpointingKeeper.SetEvidenceKeeper(app.EvidenceKeeper)
+
 	app.CrisisKeeper = crisiskeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[crisistypes.StoreKey]),
@@ -589,6 +632,7 @@ func NewBabylonApp(
 	btclightclientKeeper := btclightclientkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[btclightclienttypes.StoreKey]),
+		tkeys[btclightclienttypes.TStoreKey],
 		btcConfig,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -600,7 +644,7 @@ func NewBabylonApp(
 		&btclightclientKeeper,
 		&checkpointingKeeper,
 		&app.IncentiveKeeper,
-		&powLimit,
+		btcConfig,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -630,6 +674,7 @@ func NewBabylonApp(
 		&checkpointingKeeper,
 		&btcCheckpointKeeper,
 		epochingKeeper,
+		&app.BTCStakingKeeper,
 		storeQuerier,
 		scopedZoneConciergeKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -664,6 +709,8 @@ func NewBabylonApp(
 	)
 
 	// set up Checkpointing, BTCCheckpoint, and BTCLightclient keepers
+	// make the validators who signed finalized checkpoints rewarded
+	checkpointingKeeper.SetIncentiveKeeper(app.IncentiveKeeper)
 	app.CheckpointingKeeper = *checkpointingKeeper.SetHooks(
 		checkpointingtypes.NewMultiCheckpointingHooks(app.EpochingKeeper.Hooks(), app.ZoneConciergeKeeper.Hooks(), app.MonitorKeeper.Hooks()),
 	)
@@ -673,15 +720,41 @@ func NewBabylonApp(
 	)
 
 	// set up BTC staking keeper
-	app.BTCStakingKeeper = btcstakingkeeper.NewKeeper(
+	btcStakingKeeper := btcstakingkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[btcstakingtypes.StoreKey]),
 		&btclightclientKeeper,
 		&btcCheckpointKeeper,
 		&checkpointingKeeper,
-		btcNetParams,
+		btcConfig,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	// make the incentive module subscribe to the BTC staking hooks, so that
+	// the commission of slashed finality providers is clawed back
+	btcStakingKeeper.SetHooks(
+		btcstakingtypes.NewMultiBtcStakingHooks(app.IncentiveKeeper.Hooks()),
+	)
+	// refund fees of txs carrying accepted covenant messages
+	btcStakingKeeper.SetIncentiveKeeper(app.IncentiveKeeper)
+	// let finality providers register for consumer chains in zoneconcierge
+	btcStakingKeeper.SetZoneConciergeKeeper(app.ZoneConciergeKeeper)
+	// let relayers register finality providers on their behalf via authz grants
+	btcStakingKeeper.SetAuthzKeeper(app.AuthzKeeper)
+	// optionally offload covenant signature verification to out-of-process workers
+	btcStakingKeeper.SetSigVerifier(
+		sigverifier.NewVerifierFromConfig(sigverifier.ParseConfigFromAppOpts(appOpts), logger),
+	)
+	// optionally export OpenTelemetry traces of the BTC delegation lifecycle
+	tracerProvider, shutdownTracing, err := tracing.NewTracerProvider(
+		context.Background(),
+		tracing.ParseConfigFromAppOpts(appOpts),
+	)
+	if err != nil {
+		panic(fmt.Errorf("failed to set up tracing: %w", err))
+	}
+	app.shutdownTracing = shutdownTracing
+	btcStakingKeeper.SetTracer(tracerProvider.Tracer(tracing.TracerName))
+	app.BTCStakingKeeper = btcStakingKeeper
 	// set up finality keeper
 	app.FinalityKeeper = finalitykeeper.NewKeeper(
 		appCodec,
@@ -690,25 +763,26 @@ func NewBabylonApp(
 		app.IncentiveKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
-
-	// create evidence keeper with router
-	evidenceKeeper := evidencekeeper.NewKeeper(
-		appCodec,
-		runtime.NewKVStoreService(keys[evidencetypes.StoreKey]),
-		app.StakingKeeper,
-		app.SlashingKeeper,
-		app.AccountKeeper.AddressCodec(),
-		runtime.ProvideCometInfoService(),
+	app.FinalityKeeper.SetEpochingKeeper(app.EpochingKeeper)
+	// consumer systems are registered in the consumer registry of zoneconcierge
+	app.FinalityKeeper.SetZoneConciergeKeeper(app.ZoneConciergeKeeper)
+	// optionally broadcast slashing txs of BTC delegations under equivocating
+	// finality providers, started once the latest state is loaded
+	app.slasher = slasher.New(
+		slasher.ParseConfigFromAppOpts(appOpts),
+		btcConfig.NetParams(),
+		app,
+		app.FinalityKeeper,
+		app.BTCStakingKeeper,
+		logger,
 	)
-	// If evidence needs to be handled for the app, set routes in router here and seal
-	app.EvidenceKeeper = *evidenceKeeper
 
 	wasmConfig, err := wasm.ReadWasmConfig(appOpts)
 	if err != nil {
 		panic(fmt.Sprintf("error while reading wasm config: %s", err))
 	}
 
-	wasmOpts = append(owasm.RegisterCustomPlugins(&app.EpochingKeeper, &app.ZoneConciergeKeeper, &app.BTCLightClientKeeper), wasmOpts...)
+	wasmOpts = append(owasm.RegisterCustomPlugins(&app.EpochingKeeper, &app.ZoneConciergeKeeper, &app.BTCLightClientKeeper, &app.BtcCheckpointKeeper, &app.BTCStakingKeeper), wasmOpts...)
 
 	app.WasmKeeper = wasmkeeper.NewKeeper(
 		appCodec,
@@ -803,7 +877,7 @@ func NewBabylonApp(
 		monitor.NewAppModule(appCodec, app.MonitorKeeper),
 		zoneconcierge.NewAppModule(appCodec, app.ZoneConciergeKeeper, app.AccountKeeper, app.BankKeeper),
 		// Babylon modules - btc staking
-		btcstaking.NewAppModule(appCodec, app.BTCStakingKeeper),
+		btcstaking.NewAppModule(appCodec, app.BTCStakingKeeper, app.AccountKeeper, app.BankKeeper, &app.BTCLightClientKeeper, &app.BtcCheckpointKeeper),
 		finality.NewAppModule(appCodec, app.FinalityKeeper),
 		// Babylon modules - tokenomics
 		incentive.NewAppModule(appCodec, app.IncentiveKeeper, app.AccountKeeper, app.BankKeeper),
@@ -995,7 +1069,8 @@ func NewBabylonApp(
 	anteHandler := sdk.ChainAnteDecorators(
 		NewWrappedAnteHandler(authAnteHandler),
 		epochingkeeper.NewDropValidatorMsgDecorator(app.EpochingKeeper),
-		NewBtcValidationDecorator(btcConfig, &app.BtcCheckpointKeeper),
+		NewBtcValidationDecorator(btcConfig, &app.BtcCheckpointKeeper, &app.BTCLightClientKeeper),
+		btcstakingkeeper.NewMsgSizeLimitDecorator(),
 	)
 
 	// initialize BaseApp
@@ -1007,6 +1082,7 @@ func NewBabylonApp(
 	// set postHandler
 	postHandler := sdk.ChainPostDecorators(
 		zckeeper.NewIBCHeaderDecorator(app.ZoneConciergeKeeper),
+		incentivekeeper.NewRefundTxDecorator(&app.IncentiveKeeper),
 	)
 	app.SetPostHandler(postHandler)
 
@@ -1051,6 +1127,8 @@ func NewBabylonApp(
 		if err := app.WasmKeeper.InitializePinnedCodes(ctx); err != nil {
 			cmtos.Exit(fmt.Sprintf("failed initialize pinned codes %s", err))
 		}
+
+		app.slasher.Start()
 	}
 
 	return app
@@ -1065,6 +1143,17 @@ func (app *BabylonApp) GetBaseApp() *baseapp.BaseApp {
 // Name returns the name of the App
 func (app *BabylonApp) Name() string { return app.BaseApp.Name() }
 
+// Close flushes the pending traces and closes the BaseApp
+func (app *BabylonApp) Close() error {
+	app.slasher.Stop()
+	if app.shutdownTracing != nil {
+		if err := app.shutdownTracing(context.Background()); err != nil {
+			app.Logger().Error("failed to shut down tracing", "err", err)
+		}
+	}
+	return app.BaseApp.Close()
+}
+
 // PreBlocker application updates every pre block
 func (app *BabylonApp) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
 	return app.ModuleManager.PreBlock(ctx)
@@ -0,0 +1,70 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TestInterfaceRegistryResolvesModuleTypes ensures that every Msg of the
+// given modules is registered in the app's interface registry, and that every
+// Msg and query response type can be resolved from its type URL / name.
+// Missing registrations lead to "unable to resolve type URL" errors when
+// clients submit or decode the corresponding messages.
+func TestInterfaceRegistryResolvesModuleTypes(t *testing.T) {
+	encCfg := GetEncodingConfig()
+	registry := encCfg.InterfaceRegistry
+
+	modules := []string{
+		"btcstaking",
+		"btccheckpoint",
+		"btclightclient",
+		"checkpointing",
+	}
+
+	for _, module := range modules {
+		module := module
+		t.Run(module, func(t *testing.T) {
+			// all Msgs and their responses
+			msgSvc := findServiceDescriptor(t, protoreflect.FullName("babylon."+module+".v1.Msg"))
+			for i := 0; i < msgSvc.Methods().Len(); i++ {
+				method := msgSvc.Methods().Get(i)
+
+				msgTypeURL := "/" + string(method.Input().FullName())
+				msg, err := registry.Resolve(msgTypeURL)
+				require.NoError(t, err, "unable to resolve Msg type URL %s", msgTypeURL)
+				_, ok := msg.(sdk.Msg)
+				require.True(t, ok, "%s does not implement sdk.Msg", msgTypeURL)
+				require.NoError(t, registry.EnsureRegistered(msg))
+
+				requireResolvableMessage(t, method.Output().FullName())
+			}
+
+			// all query responses
+			querySvc := findServiceDescriptor(t, protoreflect.FullName("babylon."+module+".v1.Query"))
+			for i := 0; i < querySvc.Methods().Len(); i++ {
+				method := querySvc.Methods().Get(i)
+				requireResolvableMessage(t, method.Input().FullName())
+				requireResolvableMessage(t, method.Output().FullName())
+			}
+		})
+	}
+}
+
+func findServiceDescriptor(t *testing.T, name protoreflect.FullName) protoreflect.ServiceDescriptor {
+	desc, err := proto.HybridResolver.FindDescriptorByName(name)
+	require.NoError(t, err, "unable to find service %s", name)
+	svc, ok := desc.(protoreflect.ServiceDescriptor)
+	require.True(t, ok, "%s is not a service", name)
+	return svc
+}
+
+func requireResolvableMessage(t *testing.T, name protoreflect.FullName) {
+	_, err := proto.HybridResolver.FindDescriptorByName(name)
+	require.NoError(t, err, "unable to find descriptor of %s", name)
+	require.NotNil(t, proto.MessageType(string(name)), "Go type of %s is not registered", name)
+}
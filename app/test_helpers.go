@@ -118,7 +118,7 @@ func NewBabylonAppWithCustomOptions(t *testing.T, isCheckTx bool, privSigner *Pr
 		EmptyWasmOpts,
 	)
 	genesisState := app.DefaultGenesis()
-	genesisState = genesisStateWithValSet(t, app, genesisState, genesisValSet, []authtypes.GenesisAccount{acc}, balance)
+	genesisState = GenesisStateWithValSet(t, app, genesisState, genesisValSet, []authtypes.GenesisAccount{acc}, balance)
 
 	if !isCheckTx {
 		// init chain must be called to stop deliverState from being nil
@@ -143,7 +143,10 @@ func NewBabylonAppWithCustomOptions(t *testing.T, isCheckTx bool, privSigner *Pr
 	return app
 }
 
-func genesisStateWithValSet(t *testing.T,
+// GenesisStateWithValSet sets the given validator set, genesis accounts and
+// balances in the given genesis state. Each validator is bonded with a
+// delegation from the first genesis account.
+func GenesisStateWithValSet(t *testing.T,
 	app *BabylonApp, genesisState GenesisState,
 	valSet []*checkpointingtypes.GenesisKey, genAccs []authtypes.GenesisAccount,
 	balances ...banktypes.Balance,
@@ -189,6 +192,7 @@ func genesisStateWithValSet(t *testing.T,
 
 	checkpointingGenesis := &checkpointingtypes.GenesisState{
 		GenesisKeys: valSet,
+		Params:      checkpointingtypes.DefaultParams(),
 	}
 	genesisState[checkpointingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(checkpointingGenesis)
 
@@ -270,7 +274,7 @@ func SetupTestPrivSigner() (*PrivSigner, error) {
 func SetupWithGenesisValSet(t *testing.T, valSet []*checkpointingtypes.GenesisKey, privSigner *PrivSigner, genAccs []authtypes.GenesisAccount, balances ...banktypes.Balance) *BabylonApp {
 	t.Helper()
 	app, genesisState := setup(t, privSigner, true, 5)
-	genesisState = genesisStateWithValSet(t, app, genesisState, valSet, genAccs, balances...)
+	genesisState = GenesisStateWithValSet(t, app, genesisState, valSet, genAccs, balances...)
 
 	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
 	require.NoError(t, err)
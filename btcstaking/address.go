@@ -0,0 +1,144 @@
+package btcstaking
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+)
+
+// AddressType is the type of a Bitcoin address that slashing txs and their
+// change outputs may pay to
+type AddressType string
+
+const (
+	AddressTypeP2PKH  AddressType = "p2pkh"
+	AddressTypeP2SH   AddressType = "p2sh"
+	AddressTypeP2WPKH AddressType = "p2wpkh"
+	AddressTypeP2WSH  AddressType = "p2wsh"
+	AddressTypeP2TR   AddressType = "p2tr"
+)
+
+// SupportedAddressTypes are all address types that slashing txs and their
+// change outputs may pay to
+var SupportedAddressTypes = []AddressType{
+	AddressTypeP2PKH,
+	AddressTypeP2SH,
+	AddressTypeP2WPKH,
+	AddressTypeP2WSH,
+	AddressTypeP2TR,
+}
+
+// scriptClass returns the class of the pk scripts of the address type
+func (t AddressType) scriptClass() (txscript.ScriptClass, error) {
+	switch t {
+	case AddressTypeP2PKH:
+		return txscript.PubKeyHashTy, nil
+	case AddressTypeP2SH:
+		return txscript.ScriptHashTy, nil
+	case AddressTypeP2WPKH:
+		return txscript.WitnessV0PubKeyHashTy, nil
+	case AddressTypeP2WSH:
+		return txscript.WitnessV0ScriptHashTy, nil
+	case AddressTypeP2TR:
+		return txscript.WitnessV1TaprootTy, nil
+	default:
+		return txscript.NonStandardTy, fmt.Errorf("%w: %s", ErrUnsupportedAddressType, t)
+	}
+}
+
+// ParseAddressType parses the given string into a supported address type
+func ParseAddressType(s string) (AddressType, error) {
+	t := AddressType(s)
+	if _, err := t.scriptClass(); err != nil {
+		return "", err
+	}
+	return t, nil
+}
+
+// GetAddressType returns the type of the given address, or an error if the
+// address is not of a supported type
+func GetAddressType(addr btcutil.Address) (AddressType, error) {
+	switch addr.(type) {
+	case *btcutil.AddressPubKeyHash:
+		return AddressTypeP2PKH, nil
+	case *btcutil.AddressScriptHash:
+		return AddressTypeP2SH, nil
+	case *btcutil.AddressWitnessPubKeyHash:
+		return AddressTypeP2WPKH, nil
+	case *btcutil.AddressWitnessScriptHash:
+		return AddressTypeP2WSH, nil
+	case *btcutil.AddressTaproot:
+		return AddressTypeP2TR, nil
+	default:
+		return "", fmt.Errorf("%w: %T", ErrUnsupportedAddressType, addr)
+	}
+}
+
+// AddressPkScript validates the given address against the allowed address
+// types and returns the pk script paying to it. All supported address types
+// are allowed if allowedTypes is empty. The pk script is checked to be of the
+// class of the address type, and the output key of a taproot address to be a
+// valid x-only public key, so that the outputs paying to the address are
+// standard and spendable.
+func AddressPkScript(addr btcutil.Address, allowedTypes []AddressType) ([]byte, error) {
+	if addr == nil {
+		return nil, fmt.Errorf("address must not be nil")
+	}
+	addrType, err := GetAddressType(addr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAddressTypeAllowed(addrType, allowedTypes) {
+		return nil, fmt.Errorf("%w: %s", ErrAddressTypeNotAllowed, addrType)
+	}
+
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, err
+	}
+	expectedClass, err := addrType.scriptClass()
+	if err != nil {
+		return nil, err
+	}
+	if class := txscript.GetScriptClass(pkScript); class != expectedClass {
+		return nil, fmt.Errorf("pk script of %s address %s is of class %s", addrType, addr.EncodeAddress(), class)
+	}
+	if addrType == AddressTypeP2TR {
+		if _, err := schnorr.ParsePubKey(addr.ScriptAddress()); err != nil {
+			return nil, fmt.Errorf("invalid output key of taproot address %s: %w", addr.EncodeAddress(), err)
+		}
+	}
+
+	return pkScript, nil
+}
+
+// DecodeAddress decodes the given address of the given network and validates
+// it against the allowed address types, as in AddressPkScript
+func DecodeAddress(address string, net *chaincfg.Params, allowedTypes []AddressType) (btcutil.Address, error) {
+	addr, err := btcutil.DecodeAddress(address, net)
+	if err != nil {
+		return nil, fmt.Errorf("invalid BTC address %s: %w", address, err)
+	}
+	if !addr.IsForNet(net) {
+		return nil, fmt.Errorf("BTC address %s is not for network %s", address, net.Name)
+	}
+	if _, err := AddressPkScript(addr, allowedTypes); err != nil {
+		return nil, err
+	}
+	return addr, nil
+}
+
+func isAddressTypeAllowed(addrType AddressType, allowedTypes []AddressType) bool {
+	if len(allowedTypes) == 0 {
+		return true
+	}
+	for _, t := range allowedTypes {
+		if t == addrType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package btcstaking_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	"github.com/babylonchain/babylon/testutil/datagen"
+)
+
+func FuzzAddressPkScript(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		net := &chaincfg.MainNetParams
+
+		for _, addrType := range btcstaking.SupportedAddressTypes {
+			addr, err := datagen.GenRandomBTCAddressOfType(r, net, addrType)
+			require.NoError(t, err)
+			parsedType, err := btcstaking.GetAddressType(addr)
+			require.NoError(t, err)
+			require.Equal(t, addrType, parsedType)
+
+			// the pk script pays to the address and is of the class of the
+			// address type
+			pkScript, err := btcstaking.AddressPkScript(addr, nil)
+			require.NoError(t, err)
+			expectedPkScript, err := txscript.PayToAddrScript(addr)
+			require.NoError(t, err)
+			require.Equal(t, expectedPkScript, pkScript)
+			_, addrs, _, err := txscript.ExtractPkScriptAddrs(pkScript, net)
+			require.NoError(t, err)
+			require.Len(t, addrs, 1)
+			require.Equal(t, addr.EncodeAddress(), addrs[0].EncodeAddress())
+
+			// the address is accepted only if its type is allowed
+			_, err = btcstaking.AddressPkScript(addr, []btcstaking.AddressType{addrType})
+			require.NoError(t, err)
+			for _, otherType := range btcstaking.SupportedAddressTypes {
+				if otherType == addrType {
+					continue
+				}
+				_, err = btcstaking.AddressPkScript(addr, []btcstaking.AddressType{otherType})
+				require.ErrorIs(t, err, btcstaking.ErrAddressTypeNotAllowed)
+			}
+
+			// the encoded address decodes only on its own network
+			decodedAddr, err := btcstaking.DecodeAddress(addr.EncodeAddress(), net, []btcstaking.AddressType{addrType})
+			require.NoError(t, err)
+			require.Equal(t, addr.EncodeAddress(), decodedAddr.EncodeAddress())
+			_, err = btcstaking.DecodeAddress(addr.EncodeAddress(), &chaincfg.SimNetParams, nil)
+			require.Error(t, err)
+		}
+
+		// a taproot address whose output key is not a valid x-only public
+		// key is rejected
+		var invalidKey []byte
+		for {
+			invalidKey = datagen.GenRandomByteArray(r, schnorr.PubKeyBytesLen)
+			if _, err := schnorr.ParsePubKey(invalidKey); err != nil {
+				break
+			}
+		}
+		invalidTaprootAddr, err := btcutil.NewAddressTaproot(invalidKey, net)
+		require.NoError(t, err)
+		_, err = btcstaking.AddressPkScript(invalidTaprootAddr, nil)
+		require.Error(t, err)
+
+		// addresses of unsupported types are rejected
+		_, pk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		pkAddr, err := btcutil.NewAddressPubKey(pk.SerializeCompressed(), net)
+		require.NoError(t, err)
+		_, err = btcstaking.AddressPkScript(pkAddr, nil)
+		require.ErrorIs(t, err, btcstaking.ErrUnsupportedAddressType)
+		_, err = btcstaking.ParseAddressType("p2pk")
+		require.ErrorIs(t, err, btcstaking.ErrUnsupportedAddressType)
+	})
+}
@@ -0,0 +1,172 @@
+package btcstaking
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// MuSig2PubNonceSize is the size of a MuSig2 public nonce, i.e., two
+// compressed public keys
+const MuSig2PubNonceSize = musig2.PubNonceSize
+
+// AggregateCovenantKey returns the MuSig2 aggregate key of the given covenant
+// keys. The keys are sorted before aggregation, so that the aggregate key
+// does not depend on their order. Covenant members produce signatures under
+// the aggregate key via the MuSig2 protocol over the same sorted keys.
+func AggregateCovenantKey(covenantKeys []*btcec.PublicKey) (*btcec.PublicKey, error) {
+	if len(covenantKeys) == 0 {
+		return nil, fmt.Errorf("no covenant keys provided")
+	}
+	sortedKeys := SortKeys(covenantKeys)
+	if len(sortedKeys) > 1 {
+		// reject duplicated keys
+		if _, err := prepareKeysForMultisigScript(sortedKeys); err != nil {
+			return nil, err
+		}
+	}
+	aggKey, _, _, err := musig2.AggregateKeys(sortedKeys, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate covenant keys: %w", err)
+	}
+	return aggKey.FinalKey, nil
+}
+
+// CovenantSignersMuSig2 returns the MuSig2 aggregate key of the covenant
+// committee as the only covenant signer. MuSig2 is an n-of-n scheme, thus the
+// covenant quorum must be the size of the covenant committee.
+func CovenantSignersMuSig2(covenantKeys []*btcec.PublicKey, covenantQuorum uint32) ([]*btcec.PublicKey, uint32, error) {
+	if covenantQuorum != uint32(len(covenantKeys)) {
+		return nil, 0, fmt.Errorf(
+			"MuSig2 covenant signing requires all %d covenant members to sign, got covenant quorum %d",
+			len(covenantKeys), covenantQuorum,
+		)
+	}
+	aggKey, err := AggregateCovenantKey(covenantKeys)
+	if err != nil {
+		return nil, 0, err
+	}
+	return []*btcec.PublicKey{aggKey}, 1, nil
+}
+
+// covenantSignersMultisig returns the covenant committee and the covenant
+// quorum as is, for the script templates where each covenant member signs
+// individually
+func covenantSignersMultisig(covenantKeys []*btcec.PublicKey, covenantQuorum uint32) ([]*btcec.PublicKey, uint32, error) {
+	return covenantKeys, covenantQuorum, nil
+}
+
+// BuildStakingInfoMuSig2 builds the staking output where the covenant
+// committee's part of the unbonding and slashing paths is a single key, i.e.,
+// <Covenant_AggPK> OP_CHECKSIG, rather than the M-out-of-N multisig of
+// BuildStakingInfo. This shrinks the witness of unbonding and slashing
+// transactions from a quorum number of signatures and N keys to a single
+// signature and key.
+func BuildStakingInfoMuSig2(
+	stakerKey *btcec.PublicKey,
+	fpKeys []*btcec.PublicKey,
+	covenantKeys []*btcec.PublicKey,
+	covenantQuorum uint32,
+	stakingTime uint16,
+	stakingAmount btcutil.Amount,
+	net *chaincfg.Params,
+) (*StakingInfo, error) {
+	signers, quorum, err := CovenantSignersMuSig2(covenantKeys, covenantQuorum)
+	if err != nil {
+		return nil, err
+	}
+	return BuildStakingInfo(stakerKey, fpKeys, signers, quorum, stakingTime, stakingAmount, net)
+}
+
+// BuildUnbondingInfoMuSig2 builds the unbonding output where the covenant
+// committee's part of the slashing path is a single key, i.e.,
+// <Covenant_AggPK> OP_CHECKSIG, rather than the M-out-of-N multisig of
+// BuildUnbondingInfo
+func BuildUnbondingInfoMuSig2(
+	stakerKey *btcec.PublicKey,
+	fpKeys []*btcec.PublicKey,
+	covenantKeys []*btcec.PublicKey,
+	covenantQuorum uint32,
+	unbondingTime uint16,
+	unbondingAmount btcutil.Amount,
+	net *chaincfg.Params,
+) (*UnbondingInfo, error) {
+	signers, quorum, err := CovenantSignersMuSig2(covenantKeys, covenantQuorum)
+	if err != nil {
+		return nil, err
+	}
+	return BuildUnbondingInfo(stakerKey, fpKeys, signers, quorum, unbondingTime, unbondingAmount, net)
+}
+
+// ValidateMuSig2PubNonce checks that the given bytes are a valid MuSig2
+// public nonce, i.e., two compressed public keys
+func ValidateMuSig2PubNonce(nonce []byte) error {
+	if len(nonce) != MuSig2PubNonceSize {
+		return fmt.Errorf("MuSig2 public nonce must be %d bytes, got %d", MuSig2PubNonceSize, len(nonce))
+	}
+	half := MuSig2PubNonceSize / 2
+	if _, err := btcec.ParsePubKey(nonce[:half]); err != nil {
+		return fmt.Errorf("invalid first point of MuSig2 public nonce: %w", err)
+	}
+	if _, err := btcec.ParsePubKey(nonce[half:]); err != nil {
+		return fmt.Errorf("invalid second point of MuSig2 public nonce: %w", err)
+	}
+	return nil
+}
+
+// CombineMuSig2Sigs combines the partial signatures of all MuSig2 signers of
+// a session on the given message hash into a Schnorr signature under the
+// aggregate key of the given keys. The keys are aggregated in the given order,
+// and the combined nonce is the aggregation of all signers' public nonces.
+func CombineMuSig2Sigs(
+	combinedNonce [musig2.PubNonceSize]byte,
+	keys []*btcec.PublicKey,
+	msgHash []byte,
+	partialSigs []*musig2.PartialSignature,
+) (*schnorr.Signature, error) {
+	if len(msgHash) != chainhash.HashSize {
+		return nil, fmt.Errorf("wrong size for message hash (got %v, want %v)", len(msgHash), chainhash.HashSize)
+	}
+	aggKey, _, _, err := musig2.AggregateKeys(keys, false)
+	if err != nil {
+		return nil, err
+	}
+	r1, err := btcec.ParseJacobian(combinedNonce[:btcec.PubKeyBytesLenCompressed])
+	if err != nil {
+		return nil, fmt.Errorf("invalid combined nonce: %w", err)
+	}
+	r2, err := btcec.ParseJacobian(combinedNonce[btcec.PubKeyBytesLenCompressed:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid combined nonce: %w", err)
+	}
+
+	// the final nonce R = R_1+b*R_2 with b = H(combined nonce || Q || m), or
+	// the generator if R is the point at infinity
+	var nonceMsgBuf bytes.Buffer
+	nonceMsgBuf.Write(combinedNonce[:])
+	nonceMsgBuf.Write(schnorr.SerializePubKey(aggKey.FinalKey))
+	nonceMsgBuf.Write(msgHash)
+	var b btcec.ModNScalar
+	b.SetByteSlice(chainhash.TaggedHash(musig2.NonceBlindTag, nonceMsgBuf.Bytes())[:])
+	var bR2, r btcec.JacobianPoint
+	btcec.ScalarMultNonConst(&b, &r2, &bR2)
+	btcec.AddNonConst(&r1, &bR2, &r)
+	if (r.X.IsZero() && r.Y.IsZero()) || r.Z.IsZero() {
+		var one btcec.ModNScalar
+		one.SetInt(1)
+		btcec.ScalarBaseMultNonConst(&one, &r)
+	}
+	r.ToAffine()
+
+	sig := musig2.CombineSigs(btcec.NewPublicKey(&r.X, &r.Y), partialSigs)
+	if !sig.Verify(msgHash, aggKey.FinalKey) {
+		return nil, fmt.Errorf("invalid combined MuSig2 signature")
+	}
+	return sig, nil
+}
@@ -0,0 +1,269 @@
+package btcstaking_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	asig "github.com/babylonchain/babylon/crypto/schnorr-adaptor-signature"
+	btctest "github.com/babylonchain/babylon/testutil/bitcoin"
+)
+
+func TestSpendingUnbondingPathCovenantMuSig2(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+
+	// MuSig2 requires all covenant members to sign
+	scenario := GenerateTestScenario(
+		r,
+		t,
+		1,
+		5,
+		5,
+		btcutil.Amount(2*10e8),
+		5,
+	)
+
+	// MuSig2 covenant signing rejects quorums smaller than the committee
+	_, err := btcstaking.BuildStakingInfoMuSig2(
+		scenario.StakerKey.PubKey(),
+		scenario.FinalityProviderPublicKeys(),
+		scenario.CovenantPublicKeys(),
+		scenario.RequiredCovenantSigs-1,
+		scenario.StakingTime,
+		scenario.StakingAmount,
+		&chaincfg.MainNetParams,
+	)
+	require.Error(t, err)
+
+	stakingInfo, err := btcstaking.BuildStakingInfoMuSig2(
+		scenario.StakerKey.PubKey(),
+		scenario.FinalityProviderPublicKeys(),
+		scenario.CovenantPublicKeys(),
+		scenario.RequiredCovenantSigs,
+		scenario.StakingTime,
+		scenario.StakingAmount,
+		&chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+
+	// the MuSig2 staking output is the multisig staking output over the
+	// aggregate covenant key
+	aggKey, err := btcstaking.AggregateCovenantKey(scenario.CovenantPublicKeys())
+	require.NoError(t, err)
+	expectedStakingInfo, err := btcstaking.BuildStakingInfo(
+		scenario.StakerKey.PubKey(),
+		scenario.FinalityProviderPublicKeys(),
+		[]*btcec.PublicKey{aggKey},
+		1,
+		scenario.StakingTime,
+		scenario.StakingAmount,
+		&chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+	require.Equal(t, expectedStakingInfo.StakingOutput, stakingInfo.StakingOutput)
+
+	spendStakeTx := wire.NewMsgTx(2)
+	spendStakeTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil, nil))
+	spendStakeTx.AddTxOut(
+		&wire.TxOut{
+			PkScript: []byte("doesn't matter"),
+			// spend half of the staking amount
+			Value: int64(scenario.StakingAmount.MulF64(0.5)),
+		},
+	)
+
+	si, err := stakingInfo.UnbondingPathSpendInfo()
+	require.NoError(t, err)
+
+	stakerSig, err := btcstaking.SignTxWithOneScriptSpendInputFromTapLeaf(
+		spendStakeTx,
+		stakingInfo.StakingOutput,
+		scenario.StakerKey,
+		si.RevealedLeaf,
+	)
+	require.NoError(t, err)
+
+	// covenant members exchange MuSig2 nonces and produce partial signatures
+	// over the unbonding path sighash
+	prevOutputFetcher := stakingInfo.GetOutputFetcher()
+	sigHash, err := txscript.CalcTapscriptSignaturehash(
+		txscript.NewTxSigHashes(spendStakeTx, prevOutputFetcher),
+		txscript.SigHashDefault,
+		spendStakeTx,
+		0,
+		prevOutputFetcher,
+		si.RevealedLeaf,
+	)
+	require.NoError(t, err)
+	var msg [32]byte
+	copy(msg[:], sigHash)
+
+	sortedCovKeys := btcstaking.SortKeys(scenario.CovenantPublicKeys())
+	nonces := make([]*musig2.Nonces, len(scenario.CovenantKeys))
+	pubNonces := make([][musig2.PubNonceSize]byte, len(scenario.CovenantKeys))
+	for i, covSK := range scenario.CovenantKeys {
+		nonces[i], err = musig2.GenNonces(musig2.WithPublicKey(covSK.PubKey()))
+		require.NoError(t, err)
+		require.NoError(t, btcstaking.ValidateMuSig2PubNonce(nonces[i].PubNonce[:]))
+		pubNonces[i] = nonces[i].PubNonce
+	}
+	combinedNonce, err := musig2.AggregateNonces(pubNonces)
+	require.NoError(t, err)
+	partialSigs := make([]*musig2.PartialSignature, len(scenario.CovenantKeys))
+	for i, covSK := range scenario.CovenantKeys {
+		partialSigs[i], err = musig2.Sign(nonces[i].SecNonce, covSK, combinedNonce, sortedCovKeys, msg)
+		require.NoError(t, err)
+	}
+	aggSig := musig2.CombineSigs(partialSigs[0].R, partialSigs)
+	require.True(t, aggSig.Verify(sigHash, aggKey))
+	// the aggregator recomputes the final nonce from the combined nonce
+	combinedSig, err := btcstaking.CombineMuSig2Sigs(combinedNonce, sortedCovKeys, sigHash, partialSigs)
+	require.NoError(t, err)
+	require.Equal(t, aggSig.Serialize(), combinedSig.Serialize())
+
+	// the witness has a single covenant signature
+	witness, err := si.CreateUnbondingPathWitness([]*schnorr.Signature{aggSig}, stakerSig)
+	require.NoError(t, err)
+	spendStakeTx.TxIn[0].Witness = witness
+
+	newEngine := func() (*txscript.Engine, error) {
+		return txscript.NewEngine(
+			stakingInfo.GetPkScript(),
+			spendStakeTx, 0, txscript.StandardVerifyFlags, nil,
+			txscript.NewTxSigHashes(spendStakeTx, prevOutputFetcher), stakingInfo.StakingOutput.Value,
+			prevOutputFetcher,
+		)
+	}
+	btctest.AssertEngineExecution(t, 0, true, newEngine)
+}
+
+func TestSpendingSlashingPathCovenantMuSig2(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+
+	// MuSig2 requires all covenant members to sign
+	scenario := GenerateTestScenario(
+		r,
+		t,
+		1,
+		5,
+		5,
+		btcutil.Amount(2*10e8),
+		5,
+	)
+
+	stakingInfo, err := btcstaking.BuildStakingInfoMuSig2(
+		scenario.StakerKey.PubKey(),
+		scenario.FinalityProviderPublicKeys(),
+		scenario.CovenantPublicKeys(),
+		scenario.RequiredCovenantSigs,
+		scenario.StakingTime,
+		scenario.StakingAmount,
+		&chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+	aggKey, err := btcstaking.AggregateCovenantKey(scenario.CovenantPublicKeys())
+	require.NoError(t, err)
+
+	spendStakeTx := wire.NewMsgTx(2)
+	spendStakeTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil, nil))
+	spendStakeTx.AddTxOut(
+		&wire.TxOut{
+			PkScript: []byte("doesn't matter"),
+			// spend half of the staking amount
+			Value: int64(scenario.StakingAmount.MulF64(0.5)),
+		},
+	)
+
+	si, err := stakingInfo.SlashingPathSpendInfo()
+	require.NoError(t, err)
+
+	stakerSig, err := btcstaking.SignTxWithOneScriptSpendInputFromTapLeaf(
+		spendStakeTx,
+		stakingInfo.StakingOutput,
+		scenario.StakerKey,
+		si.RevealedLeaf,
+	)
+	require.NoError(t, err)
+
+	// covenant members exchange MuSig2 nonces and produce partial signatures
+	// towards an adaptor signature over the slashing path sighash, encrypted
+	// by the finality provider's PK
+	prevOutputFetcher := stakingInfo.GetOutputFetcher()
+	sigHash, err := txscript.CalcTapscriptSignaturehash(
+		txscript.NewTxSigHashes(spendStakeTx, prevOutputFetcher),
+		txscript.SigHashDefault,
+		spendStakeTx,
+		0,
+		prevOutputFetcher,
+		si.RevealedLeaf,
+	)
+	require.NoError(t, err)
+	fpSK := scenario.FinalityProviderKeys[0]
+	encKey, err := asig.NewEncryptionKeyFromBTCPK(fpSK.PubKey())
+	require.NoError(t, err)
+
+	sortedCovKeys := btcstaking.SortKeys(scenario.CovenantPublicKeys())
+	nonces := make([]*musig2.Nonces, len(scenario.CovenantKeys))
+	pubNonces := make([][musig2.PubNonceSize]byte, len(scenario.CovenantKeys))
+	for i, covSK := range scenario.CovenantKeys {
+		nonces[i], err = musig2.GenNonces(musig2.WithPublicKey(covSK.PubKey()))
+		require.NoError(t, err)
+		pubNonces[i] = nonces[i].PubNonce
+	}
+	combinedNonce, err := musig2.AggregateNonces(pubNonces)
+	require.NoError(t, err)
+	partialSigs := make([]*musig2.PartialSignature, len(scenario.CovenantKeys))
+	for i, covSK := range scenario.CovenantKeys {
+		partialSigs[i], err = asig.MuSig2EncSign(nonces[i].SecNonce, covSK, combinedNonce, sortedCovKeys, encKey, sigHash)
+		require.NoError(t, err)
+	}
+	adaptorSig, err := asig.MuSig2CombineEncSigs(combinedNonce, sortedCovKeys, encKey, sigHash, partialSigs)
+	require.NoError(t, err)
+	require.NoError(t, adaptorSig.EncVerify(aggKey, encKey, sigHash))
+
+	// the finality provider's SK decrypts the adaptor signature into the
+	// covenant committee's signature on the slashing path
+	decKey, err := asig.NewDecyptionKeyFromBTCSK(fpSK)
+	require.NoError(t, err)
+	covSig := adaptorSig.Decrypt(decKey)
+	fpSig, err := btcstaking.SignTxWithOneScriptSpendInputFromTapLeaf(
+		spendStakeTx,
+		stakingInfo.StakingOutput,
+		fpSK,
+		si.RevealedLeaf,
+	)
+	require.NoError(t, err)
+
+	// the witness has a single covenant signature
+	witness, err := si.CreateSlashingPathWitness(
+		[]*schnorr.Signature{covSig},
+		[]*schnorr.Signature{fpSig},
+		stakerSig,
+	)
+	require.NoError(t, err)
+	spendStakeTx.TxIn[0].Witness = witness
+
+	newEngine := func() (*txscript.Engine, error) {
+		return txscript.NewEngine(
+			stakingInfo.GetPkScript(),
+			spendStakeTx, 0, txscript.StandardVerifyFlags, nil,
+			txscript.NewTxSigHashes(spendStakeTx, prevOutputFetcher), stakingInfo.StakingOutput.Value,
+			prevOutputFetcher,
+		)
+	}
+	btctest.AssertEngineExecution(t, 0, true, newEngine)
+
+	// the covenant signature on Bitcoin reveals the finality provider's SK
+	recoveredKey := adaptorSig.Recover(covSig)
+	require.True(t, recoveredKey.Equals(&decKey.ModNScalar))
+}
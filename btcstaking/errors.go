@@ -7,4 +7,8 @@ var (
 	ErrDustOutputFound            = errors.New("transaction contains a dust output")
 	ErrInsufficientSlashingAmount = errors.New("insufficient slashing amount")
 	ErrInsufficientChangeAmount   = errors.New("insufficient change amount")
+	ErrUnknownScriptTemplate      = errors.New("unknown script template")
+	ErrNotStakingTx               = errors.New("not a staking transaction")
+	ErrUnsupportedAddressType     = errors.New("unsupported address type")
+	ErrAddressTypeNotAllowed      = errors.New("address type is not allowed")
 )
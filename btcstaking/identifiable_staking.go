@@ -348,6 +348,30 @@ func ParseV0StakingTx(
 	covenantKeys []*btcec.PublicKey,
 	covenantQuorum uint32,
 	net *chaincfg.Params,
+) (*ParsedV0StakingTx, error) {
+	return parseV0StakingTx(tx, expectedMagicBytes, covenantKeys, covenantQuorum, BuildStakingInfo, net)
+}
+
+// stakingInfoBuilder builds the staking output of a script template
+type stakingInfoBuilder func(
+	stakerKey *btcec.PublicKey,
+	fpKeys []*btcec.PublicKey,
+	covenantKeys []*btcec.PublicKey,
+	covenantQuorum uint32,
+	stakingTime uint16,
+	stakingAmount btcutil.Amount,
+	net *chaincfg.Params,
+) (*StakingInfo, error)
+
+// parseV0StakingTx is ParseV0StakingTx where the staking output is expected to
+// be built by the given constructor
+func parseV0StakingTx(
+	tx *wire.MsgTx,
+	expectedMagicBytes []byte,
+	covenantKeys []*btcec.PublicKey,
+	covenantQuorum uint32,
+	buildStakingInfo stakingInfoBuilder,
+	net *chaincfg.Params,
 ) (*ParsedV0StakingTx, error) {
 	// 1. Basic arguments checks
 	if tx == nil {
@@ -396,7 +420,7 @@ func ParseV0StakingTx(
 
 	// 3. Op return seems to be valid V0 op return output. Now, we need to check whether
 	// the staking output exists and is valid.
-	stakingInfo, err := BuildStakingInfo(
+	stakingInfo, err := buildStakingInfo(
 		opReturnData.StakerPublicKey.PubKey,
 		[]*btcec.PublicKey{opReturnData.FinalityProviderPublicKey.PubKey},
 		covenantKeys,
@@ -22,6 +22,10 @@ func generateTxFromOutputs(r *rand.Rand, info *btcstaking.IdentifiableStakingInf
 	opReturnOutputIdx := int(datagen.RandomIntOtherThan(r, int(stakingOutputIdx), int(numOutputs)))
 
 	tx := wire.NewMsgTx(2)
+	// a tx with inputs, as a tx without inputs is ambiguous with the segwit
+	// serialization
+	prevOutHash := datagen.GenRandomBtcdHash(r)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prevOutHash, r.Uint32()), nil, nil))
 	for i := 0; i < int(numOutputs); i++ {
 		if i == stakingOutputIdx {
 			tx.AddTxOut(info.StakingOutput)
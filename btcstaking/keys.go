@@ -0,0 +1,112 @@
+package btcstaking
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/cosmos/go-bip39"
+)
+
+const (
+	// BIP86Purpose is the purpose field of BIP86 derivation paths, i.e., of
+	// single key taproot outputs. See https://github.com/bitcoin/bips/blob/master/bip-0086.mediawiki
+	BIP86Purpose uint32 = 86
+	// StakingKeyChange is the change field of the derivation path of staking
+	// keys, which uses the external chain
+	StakingKeyChange uint32 = 0
+
+	mainnetCoinType uint32 = 0
+	testnetCoinType uint32 = 1
+)
+
+// StakingKeyDerivationPath returns the derivation path of the staking key of
+// the given account and address index, following BIP86:
+//
+//	m/86'/coin_type'/account'/0/index
+//
+// where coin_type is 0 for mainnet and 1 for all other networks, as in BIP44.
+// The staking key is the internal key of the BIP86 address at the same path,
+// so wallets following BIP86 can restore their staking keys from the mnemonic.
+func StakingKeyDerivationPath(net *chaincfg.Params, account uint32, index uint32) []uint32 {
+	coinType := testnetCoinType
+	if net.Net == chaincfg.MainNetParams.Net {
+		coinType = mainnetCoinType
+	}
+	return []uint32{
+		hdkeychain.HardenedKeyStart + BIP86Purpose,
+		hdkeychain.HardenedKeyStart + coinType,
+		hdkeychain.HardenedKeyStart + account,
+		StakingKeyChange,
+		index,
+	}
+}
+
+// FormatDerivationPath formats the given derivation path, e.g., m/86'/0'/0'/0/0
+func FormatDerivationPath(path []uint32) string {
+	var sb strings.Builder
+	sb.WriteString("m")
+	for _, i := range path {
+		if i >= hdkeychain.HardenedKeyStart {
+			sb.WriteString(fmt.Sprintf("/%d'", i-hdkeychain.HardenedKeyStart))
+		} else {
+			sb.WriteString(fmt.Sprintf("/%d", i))
+		}
+	}
+	return sb.String()
+}
+
+// DeriveStakingKeyFromSeed derives the staking key of the given account and
+// address index from the given BIP32 seed
+func DeriveStakingKeyFromSeed(
+	seed []byte,
+	net *chaincfg.Params,
+	account uint32,
+	index uint32,
+) (*btcec.PrivateKey, error) {
+	if account >= hdkeychain.HardenedKeyStart {
+		return nil, fmt.Errorf("account %d must be less than %d", account, hdkeychain.HardenedKeyStart)
+	}
+	if index >= hdkeychain.HardenedKeyStart {
+		return nil, fmt.Errorf("index %d must be less than %d", index, hdkeychain.HardenedKeyStart)
+	}
+
+	key, err := hdkeychain.NewMaster(seed, net)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create master key: %w", err)
+	}
+	for _, i := range StakingKeyDerivationPath(net, account, index) {
+		key, err = key.Derive(i)
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive child key %d: %w", i, err)
+		}
+	}
+	return key.ECPrivKey()
+}
+
+// DeriveStakingKeyFromMnemonic derives the staking key of the given account
+// and address index from the given BIP39 mnemonic and passphrase
+func DeriveStakingKeyFromMnemonic(
+	mnemonic string,
+	passphrase string,
+	net *chaincfg.Params,
+	account uint32,
+	index uint32,
+) (*btcec.PrivateKey, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mnemonic: %w", err)
+	}
+	return DeriveStakingKeyFromSeed(seed, net, account, index)
+}
+
+// BIP86Address returns the BIP86 address of the given internal key, i.e.,
+// the taproot address committing to no script
+func BIP86Address(internalKey *btcec.PublicKey, net *chaincfg.Params) (*btcutil.AddressTaproot, error) {
+	outputKey := txscript.ComputeTaprootKeyNoScript(internalKey)
+	return btcutil.NewAddressTaproot(outputKey.SerializeCompressed()[1:], net)
+}
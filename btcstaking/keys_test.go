@@ -0,0 +1,82 @@
+package btcstaking_test
+
+import (
+	"encoding/hex"
+	"math/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	"github.com/babylonchain/babylon/testutil/datagen"
+)
+
+// TestDeriveStakingKeyBIP86Vectors checks the derivation against the test
+// vectors of BIP86
+func TestDeriveStakingKeyBIP86Vectors(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	testCases := []struct {
+		index       uint32
+		path        string
+		internalKey string
+		address     string
+	}{
+		{
+			index:       0,
+			path:        "m/86'/0'/0'/0/0",
+			internalKey: "cc8a4bc64d897bddc5fbc2f670f7a8ba0b386779106cf1223c6fc5d7cd6fc115",
+			address:     "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr",
+		},
+		{
+			index:       1,
+			path:        "m/86'/0'/0'/0/1",
+			internalKey: "83dfe85a3151d2517290da461fe2815591ef69f2b18a2ce63f01697a8b313145",
+			address:     "bc1p4qhjn9zdvkux4e44uhx8tc55attvtyu358kutcqkudyccelu0was9fqzwh",
+		},
+	}
+
+	for _, tc := range testCases {
+		path := btcstaking.StakingKeyDerivationPath(&chaincfg.MainNetParams, 0, tc.index)
+		require.Equal(t, tc.path, btcstaking.FormatDerivationPath(path))
+
+		sk, err := btcstaking.DeriveStakingKeyFromMnemonic(mnemonic, "", &chaincfg.MainNetParams, 0, tc.index)
+		require.NoError(t, err)
+		require.Equal(t, tc.internalKey, hex.EncodeToString(schnorr.SerializePubKey(sk.PubKey())))
+
+		addr, err := btcstaking.BIP86Address(sk.PubKey(), &chaincfg.MainNetParams)
+		require.NoError(t, err)
+		require.Equal(t, tc.address, addr.EncodeAddress())
+	}
+}
+
+func FuzzDeriveStakingKeyFromSeed(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		hdSeed := datagen.GenRandomByteArray(r, 32)
+		account := uint32(r.Int31())
+		index := uint32(r.Int31())
+
+		// derivation is deterministic
+		sk1, err := btcstaking.DeriveStakingKeyFromSeed(hdSeed, &chaincfg.SigNetParams, account, index)
+		require.NoError(t, err)
+		sk2, err := btcstaking.DeriveStakingKeyFromSeed(hdSeed, &chaincfg.SigNetParams, account, index)
+		require.NoError(t, err)
+		require.Equal(t, sk1.Serialize(), sk2.Serialize())
+
+		// mainnet and other networks use different coin types
+		mainnetSK, err := btcstaking.DeriveStakingKeyFromSeed(hdSeed, &chaincfg.MainNetParams, account, index)
+		require.NoError(t, err)
+		require.NotEqual(t, sk1.Serialize(), mainnetSK.Serialize())
+
+		// hardened account or index is rejected
+		_, err = btcstaking.DeriveStakingKeyFromSeed(hdSeed, &chaincfg.SigNetParams, account|1<<31, index)
+		require.Error(t, err)
+		_, err = btcstaking.DeriveStakingKeyFromSeed(hdSeed, &chaincfg.SigNetParams, account, index|1<<31)
+		require.Error(t, err)
+	})
+}
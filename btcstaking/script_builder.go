@@ -0,0 +1,302 @@
+package btcstaking
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// SpendPath identifies a spend path of staking and unbonding outputs
+type SpendPath int
+
+const (
+	// TimeLockPath is the path for the staker to withdraw after the timelock
+	TimeLockPath SpendPath = iota
+	// UnbondingPath is the path for on-demand early unbonding, co-signed by
+	// the covenant committee
+	UnbondingPath
+	// SlashingPath is the path for slashing, co-signed by the covenant
+	// committee and a finality provider
+	SlashingPath
+)
+
+func (p SpendPath) String() string {
+	switch p {
+	case TimeLockPath:
+		return "timelock"
+	case UnbondingPath:
+		return "unbonding"
+	case SlashingPath:
+		return "slashing"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(p))
+	}
+}
+
+// ScriptBuilder is a set of constructors of the building blocks of the
+// tapscripts of staking and unbonding outputs. The spend paths are assembled
+// as follows:
+//
+//	timelock path:  TimeLockScript
+//	unbonding path: StakerScript || CovenantScript(UnbondingPath)
+//	slashing path:  StakerScript || FinalityProviderScript || CovenantScript(SlashingPath)
+//
+// The covenant committee's part of the scripts is the one that depends on the
+// covenant primitive. Alternative covenant primitives, e.g., constructions
+// based on OP_CHECKTEMPLATEVERIFY or SIGHASH_ANYPREVOUT, are plugged in by
+// replacing CovenantScript of DefaultScriptBuilder, and registering the
+// BuildStakingInfo and BuildUnbondingInfo methods of the resulting script
+// builder as a new script template.
+type ScriptBuilder struct {
+	// TimeLockScript builds the script of the timelock path, which lets the
+	// staker spend after the relative timelock
+	TimeLockScript func(stakerKey *btcec.PublicKey, lockTime uint16) ([]byte, error)
+	// StakerScript builds the staker's part of the unbonding and slashing
+	// paths. It precedes other parts, thus must leave the stack clean.
+	StakerScript func(stakerKey *btcec.PublicKey) ([]byte, error)
+	// FinalityProviderScript builds the finality providers' part of the
+	// slashing path. It precedes the covenant committee's part, thus must
+	// leave the stack clean.
+	FinalityProviderScript func(fpKeys []*btcec.PublicKey) ([]byte, error)
+	// CovenantScript builds the covenant committee's part of the given spend
+	// path. It ends the script, thus must leave a single true value on the
+	// stack upon success.
+	CovenantScript func(path SpendPath, covenantKeys []*btcec.PublicKey, covenantQuorum uint32) ([]byte, error)
+}
+
+// DefaultScriptBuilder returns the script builder of the scripts introduced at
+// launch, where each part is a signature check over the corresponding keys:
+//
+//	<Staker_PK> OP_CHECKSIGVERIFY <Lock_Time> OP_CHECKSEQUENCEVERIFY
+//	<Staker_PK> OP_CHECKSIGVERIFY
+//	<FP_PK1> OP_CHECKSIG ... <FP_PKN> OP_CHECKSIGADD 1 OP_GREATERTHANOREQUAL OP_VERIFY
+//	<Covenant_PK1> OP_CHECKSIG ... <Covenant_PKN> OP_CHECKSIGADD M OP_GREATERTHANOREQUAL
+//
+// A new instance is returned upon each call, so that replacing its
+// constructors does not affect other script builders.
+func DefaultScriptBuilder() *ScriptBuilder {
+	return &ScriptBuilder{
+		TimeLockScript: buildTimeLockScript,
+		StakerScript: func(stakerKey *btcec.PublicKey) ([]byte, error) {
+			return buildSingleKeySigScript(stakerKey, true)
+		},
+		FinalityProviderScript: func(fpKeys []*btcec.PublicKey) ([]byte, error) {
+			return buildMultiSigScript(
+				fpKeys,
+				// we always require only one finality provider to sign
+				1,
+				// we need to run verify to clear the stack, as finality provider multisig is in the middle of the script
+				true,
+			)
+		},
+		CovenantScript: func(_ SpendPath, covenantKeys []*btcec.PublicKey, covenantQuorum uint32) ([]byte, error) {
+			return buildMultiSigScript(
+				covenantKeys,
+				covenantQuorum,
+				// covenant multisig is always last in script so we do not run verify and leave
+				// last value on the stack. If we do not leave at least one element on the stack
+				// script will always error
+				false,
+			)
+		},
+	}
+}
+
+// Validate checks that all constructors of the script builder are set
+func (b *ScriptBuilder) Validate() error {
+	if b == nil {
+		return fmt.Errorf("script builder is nil")
+	}
+	if b.TimeLockScript == nil {
+		return fmt.Errorf("script builder has no timelock script constructor")
+	}
+	if b.StakerScript == nil {
+		return fmt.Errorf("script builder has no staker script constructor")
+	}
+	if b.FinalityProviderScript == nil {
+		return fmt.Errorf("script builder has no finality provider script constructor")
+	}
+	if b.CovenantScript == nil {
+		return fmt.Errorf("script builder has no covenant script constructor")
+	}
+	return nil
+}
+
+// buildScriptPaths builds the scripts of all spend paths
+func (b *ScriptBuilder) buildScriptPaths(
+	stakerKey *btcec.PublicKey,
+	fpKeys []*btcec.PublicKey,
+	covenantKeys []*btcec.PublicKey,
+	covenantQuorum uint32,
+	lockTime uint16,
+) (*babylonScriptPaths, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	if stakerKey == nil {
+		return nil, fmt.Errorf("staker key is nil")
+	}
+
+	timeLockPathScript, err := b.TimeLockScript(stakerKey, lockTime)
+	if err != nil {
+		return nil, err
+	}
+
+	stakerSigScript, err := b.StakerScript(stakerKey)
+	if err != nil {
+		return nil, err
+	}
+
+	fpMultisigScript, err := b.FinalityProviderScript(fpKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	unbondingCovenantScript, err := b.CovenantScript(UnbondingPath, covenantKeys, covenantQuorum)
+	if err != nil {
+		return nil, err
+	}
+
+	slashingCovenantScript, err := b.CovenantScript(SlashingPath, covenantKeys, covenantQuorum)
+	if err != nil {
+		return nil, err
+	}
+
+	unbondingPathScript := aggregateScripts(
+		stakerSigScript,
+		unbondingCovenantScript,
+	)
+
+	slashingPathScript := aggregateScripts(
+		stakerSigScript,
+		fpMultisigScript,
+		slashingCovenantScript,
+	)
+
+	return &babylonScriptPaths{
+		timeLockPathScript:  timeLockPathScript,
+		unbondingPathScript: unbondingPathScript,
+		slashingPathScript:  slashingPathScript,
+	}, nil
+}
+
+// BuildStakingInfo builds the staking output with timelock, unbonding and
+// slashing paths out of the scripts of the script builder
+func (b *ScriptBuilder) BuildStakingInfo(
+	stakerKey *btcec.PublicKey,
+	fpKeys []*btcec.PublicKey,
+	covenantKeys []*btcec.PublicKey,
+	covenantQuorum uint32,
+	stakingTime uint16,
+	stakingAmount btcutil.Amount,
+	net *chaincfg.Params,
+) (*StakingInfo, error) {
+	unspendableKeyPathKey := unspendableKeyPathInternalPubKey()
+
+	babylonScripts, err := b.buildScriptPaths(
+		stakerKey,
+		fpKeys,
+		covenantKeys,
+		covenantQuorum,
+		stakingTime,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var unbondingPaths [][]byte
+	unbondingPaths = append(unbondingPaths, babylonScripts.timeLockPathScript)
+	unbondingPaths = append(unbondingPaths, babylonScripts.unbondingPathScript)
+	unbondingPaths = append(unbondingPaths, babylonScripts.slashingPathScript)
+
+	timeLockLeafHash := txscript.NewBaseTapLeaf(babylonScripts.timeLockPathScript).TapHash()
+	unbondingPathLeafHash := txscript.NewBaseTapLeaf(babylonScripts.unbondingPathScript).TapHash()
+	slashingLeafHash := txscript.NewBaseTapLeaf(babylonScripts.slashingPathScript).TapHash()
+
+	sh, err := newTaprootScriptHolder(
+		&unspendableKeyPathKey,
+		unbondingPaths,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	taprootPkScript, err := sh.taprootPkScript(net)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stakingOutput := wire.NewTxOut(int64(stakingAmount), taprootPkScript)
+
+	return &StakingInfo{
+		StakingOutput:         stakingOutput,
+		scriptHolder:          sh,
+		timeLockPathLeafHash:  timeLockLeafHash,
+		unbondingPathLeafHash: unbondingPathLeafHash,
+		slashingPathLeafHash:  slashingLeafHash,
+	}, nil
+}
+
+// BuildUnbondingInfo builds the unbonding output with timelock and slashing
+// paths out of the scripts of the script builder
+func (b *ScriptBuilder) BuildUnbondingInfo(
+	stakerKey *btcec.PublicKey,
+	fpKeys []*btcec.PublicKey,
+	covenantKeys []*btcec.PublicKey,
+	covenantQuorum uint32,
+	unbondingTime uint16,
+	unbondingAmount btcutil.Amount,
+	net *chaincfg.Params,
+) (*UnbondingInfo, error) {
+	unspendableKeyPathKey := unspendableKeyPathInternalPubKey()
+
+	babylonScripts, err := b.buildScriptPaths(
+		stakerKey,
+		fpKeys,
+		covenantKeys,
+		covenantQuorum,
+		unbondingTime,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var unbondingPaths [][]byte
+	unbondingPaths = append(unbondingPaths, babylonScripts.timeLockPathScript)
+	unbondingPaths = append(unbondingPaths, babylonScripts.slashingPathScript)
+
+	timeLockLeafHash := txscript.NewBaseTapLeaf(babylonScripts.timeLockPathScript).TapHash()
+	slashingLeafHash := txscript.NewBaseTapLeaf(babylonScripts.slashingPathScript).TapHash()
+
+	sh, err := newTaprootScriptHolder(
+		&unspendableKeyPathKey,
+		unbondingPaths,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	taprootPkScript, err := sh.taprootPkScript(net)
+
+	if err != nil {
+		return nil, err
+	}
+
+	unbondingOutput := wire.NewTxOut(int64(unbondingAmount), taprootPkScript)
+
+	return &UnbondingInfo{
+		UnbondingOutput:      unbondingOutput,
+		scriptHolder:         sh,
+		timeLockPathLeafHash: timeLockLeafHash,
+		slashingPathLeafHash: slashingLeafHash,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package btcstaking_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	"github.com/babylonchain/babylon/testutil/datagen"
+)
+
+func FuzzScriptBuilder(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		sd := genValidStakingScriptData(t, r)
+		fpKeys := []*btcec.PublicKey{sd.FinalityProviderKey}
+		covenantKeys := []*btcec.PublicKey{sd.CovenantKey}
+		amount := btcutil.Amount(datagen.RandomInt(r, 1000000) + 1000)
+		net := &chaincfg.SimNetParams
+
+		// the default script builder builds the same outputs as the library does
+		defaultBuilder := btcstaking.DefaultScriptBuilder()
+		stakingInfo, err := defaultBuilder.BuildStakingInfo(sd.StakerKey, fpKeys, covenantKeys, 1, sd.StakingTime, amount, net)
+		require.NoError(t, err)
+		expectedStakingInfo, err := btcstaking.BuildStakingInfo(sd.StakerKey, fpKeys, covenantKeys, 1, sd.StakingTime, amount, net)
+		require.NoError(t, err)
+		require.Equal(t, expectedStakingInfo.StakingOutput, stakingInfo.StakingOutput)
+
+		// plug in a covenant script committing to a template hash per spend
+		// path, in the way of OP_CHECKTEMPLATEVERIFY
+		templateHashes := map[btcstaking.SpendPath][]byte{
+			btcstaking.UnbondingPath: datagen.GenRandomByteArray(r, 32),
+			btcstaking.SlashingPath:  datagen.GenRandomByteArray(r, 32),
+		}
+		ctvBuilder := btcstaking.DefaultScriptBuilder()
+		ctvBuilder.CovenantScript = func(path btcstaking.SpendPath, _ []*btcec.PublicKey, _ uint32) ([]byte, error) {
+			return txscript.NewScriptBuilder().
+				AddData(templateHashes[path]).
+				AddOp(txscript.OP_NOP4).
+				Script()
+		}
+		ctvStakingInfo, err := ctvBuilder.BuildStakingInfo(sd.StakerKey, fpKeys, covenantKeys, 1, sd.StakingTime, amount, net)
+		require.NoError(t, err)
+		require.NotEqual(t, stakingInfo.StakingOutput.PkScript, ctvStakingInfo.StakingOutput.PkScript)
+
+		// only the covenant committee's part of the scripts is replaced
+		timeLockSpendInfo, err := stakingInfo.TimeLockPathSpendInfo()
+		require.NoError(t, err)
+		ctvTimeLockSpendInfo, err := ctvStakingInfo.TimeLockPathSpendInfo()
+		require.NoError(t, err)
+		require.Equal(t, timeLockSpendInfo.RevealedLeaf.Script, ctvTimeLockSpendInfo.RevealedLeaf.Script)
+		ctvUnbondingSpendInfo, err := ctvStakingInfo.UnbondingPathSpendInfo()
+		require.NoError(t, err)
+		require.Contains(t, string(ctvUnbondingSpendInfo.RevealedLeaf.Script), string(templateHashes[btcstaking.UnbondingPath]))
+		ctvSlashingSpendInfo, err := ctvStakingInfo.SlashingPathSpendInfo()
+		require.NoError(t, err)
+		require.Contains(t, string(ctvSlashingSpendInfo.RevealedLeaf.Script), string(templateHashes[btcstaking.SlashingPath]))
+
+		// the script builder of the unbonding output is pluggable as well
+		unbondingInfo, err := defaultBuilder.BuildUnbondingInfo(sd.StakerKey, fpKeys, covenantKeys, 1, sd.StakingTime, amount, net)
+		require.NoError(t, err)
+		ctvUnbondingInfo, err := ctvBuilder.BuildUnbondingInfo(sd.StakerKey, fpKeys, covenantKeys, 1, sd.StakingTime, amount, net)
+		require.NoError(t, err)
+		require.NotEqual(t, unbondingInfo.UnbondingOutput.PkScript, ctvUnbondingInfo.UnbondingOutput.PkScript)
+
+		// replacing a constructor does not affect other script builders
+		stakingInfo, err = btcstaking.DefaultScriptBuilder().BuildStakingInfo(sd.StakerKey, fpKeys, covenantKeys, 1, sd.StakingTime, amount, net)
+		require.NoError(t, err)
+		require.Equal(t, expectedStakingInfo.StakingOutput, stakingInfo.StakingOutput)
+
+		// script builders with missing constructors are rejected
+		ctvBuilder.CovenantScript = nil
+		require.Error(t, ctvBuilder.Validate())
+		_, err = ctvBuilder.BuildStakingInfo(sd.StakerKey, fpKeys, covenantKeys, 1, sd.StakingTime, amount, net)
+		require.Error(t, err)
+	})
+}
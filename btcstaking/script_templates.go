@@ -0,0 +1,123 @@
+package btcstaking
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+const (
+	// ScriptTemplateV1 is the version of the script template introduced at
+	// launch. Its staking output commits to the timelock, unbonding and
+	// slashing paths, and its unbonding output commits to the timelock and
+	// slashing paths.
+	ScriptTemplateV1 uint32 = 1
+
+	// ScriptTemplateV2 is the version of the script template where the
+	// covenant committee signs via MuSig2. Its outputs have the same spend
+	// paths as ScriptTemplateV1, except that the covenant committee's
+	// multisig is replaced by the MuSig2 aggregate key of all covenant
+	// members.
+	ScriptTemplateV2 uint32 = 2
+
+	// DefaultScriptTemplateVersion is the version of the script template used
+	// by new BTC delegations unless specified otherwise
+	DefaultScriptTemplateVersion = ScriptTemplateV1
+)
+
+// ScriptTemplate is a versioned set of constructors of the outputs of staking
+// and unbonding transactions. Each BTC delegation records the version of the
+// script template its outputs are built with, so that new spend paths or
+// timelock schemes can be introduced as new versions while the BTC delegations
+// under older versions keep being verified against their own scripts.
+//
+// Script templates built on alternative covenant primitives are defined via a
+// ScriptBuilder with the corresponding covenant script, whose BuildStakingInfo
+// and BuildUnbondingInfo methods serve as the constructors of the template.
+type ScriptTemplate struct {
+	// Version is the version of the script template
+	Version uint32
+	// Description is a human-readable description of the spend paths
+	Description string
+	// CovenantMuSig2 is whether the covenant committee signs via MuSig2, i.e.,
+	// produces a single aggregate signature rather than individual signatures
+	CovenantMuSig2 bool
+	// BuildStakingInfo builds the staking output and its spend paths
+	BuildStakingInfo func(
+		stakerKey *btcec.PublicKey,
+		fpKeys []*btcec.PublicKey,
+		covenantKeys []*btcec.PublicKey,
+		covenantQuorum uint32,
+		stakingTime uint16,
+		stakingAmount btcutil.Amount,
+		net *chaincfg.Params,
+	) (*StakingInfo, error)
+	// BuildUnbondingInfo builds the unbonding output and its spend paths
+	BuildUnbondingInfo func(
+		stakerKey *btcec.PublicKey,
+		fpKeys []*btcec.PublicKey,
+		covenantKeys []*btcec.PublicKey,
+		covenantQuorum uint32,
+		unbondingTime uint16,
+		unbondingAmount btcutil.Amount,
+		net *chaincfg.Params,
+	) (*UnbondingInfo, error)
+	// CovenantSigners returns the keys whose signatures on the transactions
+	// spending the outputs are accepted on behalf of the covenant committee,
+	// and the number of such signatures required
+	CovenantSigners func(
+		covenantKeys []*btcec.PublicKey,
+		covenantQuorum uint32,
+	) ([]*btcec.PublicKey, uint32, error)
+}
+
+// scriptTemplates is the registry of all script templates. A script template
+// must never be changed or removed once registered, since BTC delegations
+// recording its version rely on it for verifying their transactions.
+var scriptTemplates = map[uint32]*ScriptTemplate{
+	ScriptTemplateV1: {
+		Version:            ScriptTemplateV1,
+		Description:        "staking output with timelock, unbonding and slashing paths; unbonding output with timelock and slashing paths",
+		BuildStakingInfo:   BuildStakingInfo,
+		BuildUnbondingInfo: BuildUnbondingInfo,
+		CovenantSigners:    covenantSignersMultisig,
+	},
+	ScriptTemplateV2: {
+		Version:            ScriptTemplateV2,
+		Description:        "spend paths of version 1, where the covenant committee's multisig is replaced by the MuSig2 aggregate key of all covenant members",
+		CovenantMuSig2:     true,
+		BuildStakingInfo:   BuildStakingInfoMuSig2,
+		BuildUnbondingInfo: BuildUnbondingInfoMuSig2,
+		CovenantSigners:    CovenantSignersMuSig2,
+	},
+}
+
+// GetScriptTemplate returns the script template of the given version. Version
+// 0 refers to the script template of BTC delegations created before script
+// templates were versioned, i.e., ScriptTemplateV1.
+func GetScriptTemplate(version uint32) (*ScriptTemplate, error) {
+	if version == 0 {
+		version = ScriptTemplateV1
+	}
+	template, ok := scriptTemplates[version]
+	if !ok {
+		return nil, fmt.Errorf("%w: version %d", ErrUnknownScriptTemplate, version)
+	}
+	return template, nil
+}
+
+// ScriptTemplateVersions returns the versions of all registered script
+// templates in ascending order
+func ScriptTemplateVersions() []uint32 {
+	versions := make([]uint32, 0, len(scriptTemplates))
+	for version := range scriptTemplates {
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i] < versions[j]
+	})
+	return versions
+}
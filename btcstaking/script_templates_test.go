@@ -0,0 +1,60 @@
+package btcstaking_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	"github.com/babylonchain/babylon/testutil/datagen"
+)
+
+func FuzzScriptTemplates(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		sd := genValidStakingScriptData(t, r)
+		fpKeys := []*btcec.PublicKey{sd.FinalityProviderKey}
+		covenantKeys := []*btcec.PublicKey{sd.CovenantKey}
+		amount := btcutil.Amount(datagen.RandomInt(r, 1000000) + 1000)
+		net := &chaincfg.SimNetParams
+
+		// version 0 of legacy BTC delegations refers to the first script template
+		legacyTemplate, err := btcstaking.GetScriptTemplate(0)
+		require.NoError(t, err)
+		require.Equal(t, btcstaking.ScriptTemplateV1, legacyTemplate.Version)
+
+		// all registered script templates are retrievable, and the first script
+		// template builds the same outputs as the library does
+		versions := btcstaking.ScriptTemplateVersions()
+		require.Contains(t, versions, btcstaking.DefaultScriptTemplateVersion)
+		for _, version := range versions {
+			template, err := btcstaking.GetScriptTemplate(version)
+			require.NoError(t, err)
+			require.Equal(t, version, template.Version)
+		}
+		template, err := btcstaking.GetScriptTemplate(btcstaking.ScriptTemplateV1)
+		require.NoError(t, err)
+
+		stakingInfo, err := template.BuildStakingInfo(sd.StakerKey, fpKeys, covenantKeys, 1, sd.StakingTime, amount, net)
+		require.NoError(t, err)
+		expectedStakingInfo, err := btcstaking.BuildStakingInfo(sd.StakerKey, fpKeys, covenantKeys, 1, sd.StakingTime, amount, net)
+		require.NoError(t, err)
+		require.Equal(t, expectedStakingInfo.StakingOutput, stakingInfo.StakingOutput)
+
+		unbondingInfo, err := template.BuildUnbondingInfo(sd.StakerKey, fpKeys, covenantKeys, 1, sd.StakingTime, amount, net)
+		require.NoError(t, err)
+		expectedUnbondingInfo, err := btcstaking.BuildUnbondingInfo(sd.StakerKey, fpKeys, covenantKeys, 1, sd.StakingTime, amount, net)
+		require.NoError(t, err)
+		require.Equal(t, expectedUnbondingInfo.UnbondingOutput, unbondingInfo.UnbondingOutput)
+
+		// unknown script templates are rejected
+		_, err = btcstaking.GetScriptTemplate(versions[len(versions)-1] + 1)
+		require.ErrorIs(t, err, btcstaking.ErrUnknownScriptTemplate)
+	})
+}
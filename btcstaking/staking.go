@@ -58,9 +58,9 @@ func buildSlashingTxFromOutpoint(
 		return nil, ErrInsufficientSlashingAmount
 	}
 	// Generate script for slashing address
-	slashingAddrScript, err := txscript.PayToAddrScript(slashingAddress)
+	slashingAddrScript, err := AddressPkScript(slashingAddress, nil)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid slashing address: %w", err)
 	}
 
 	// Calculate the change amount
@@ -69,9 +69,9 @@ func buildSlashingTxFromOutpoint(
 		return nil, ErrInsufficientChangeAmount
 	}
 	// Generate script for change address
-	changeAddrScript, err := txscript.PayToAddrScript(changeAddress)
+	changeAddrScript, err := AddressPkScript(changeAddress, nil)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid change address: %w", err)
 	}
 
 	// Create a new btc transaction
@@ -270,7 +270,7 @@ func ValidateSlashingTx(
 	}
 
 	// Verify that the first output pays to the provided slashing address.
-	slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+	slashingPkScript, err := AddressPkScript(slashingAddress, nil)
 	if err != nil {
 		return fmt.Errorf("error creating slashing pk script: %w", err)
 	}
@@ -641,6 +641,37 @@ func VerifyTransactionSigWithOutputData(
 	return nil
 }
 
+// TransactionSigHashWithOutputData returns the BIP341 sighash (SigHashDefault)
+// of the given transaction with exactly one input, which spends the given
+// funding output via the given script path
+func TransactionSigHashWithOutputData(
+	transaction *wire.MsgTx,
+	fundingOutputPkScript []byte,
+	fundingOutputValue int64,
+	script []byte,
+) ([]byte, error) {
+	if transaction == nil {
+		return nil, fmt.Errorf("tx to verify not be nil")
+	}
+
+	if len(transaction.TxIn) != 1 {
+		return nil, fmt.Errorf("tx to sign must have exactly one input")
+	}
+
+	tapLeaf := txscript.NewBaseTapLeaf(script)
+
+	inputFetcher := txscript.NewCannedPrevOutputFetcher(
+		fundingOutputPkScript,
+		fundingOutputValue,
+	)
+
+	sigHashes := txscript.NewTxSigHashes(transaction, inputFetcher)
+
+	return txscript.CalcTapscriptSignaturehash(
+		sigHashes, txscript.SigHashDefault, transaction, 0, inputFetcher, tapLeaf,
+	)
+}
+
 // EncVerifyTransactionSigWithOutputData verifies that:
 // - provided transaction has exactly one input
 // - provided signature is valid adaptor signature
@@ -112,8 +112,8 @@ func FuzzGeneratingValidStakingSlashingTx(f *testing.F) {
 	})
 }
 
-func genRandomBTCAddress(r *rand.Rand) (*btcutil.AddressPubKeyHash, error) {
-	return btcutil.NewAddressPubKeyHash(datagen.GenRandomByteArray(r, 20), &chaincfg.MainNetParams)
+func genRandomBTCAddress(r *rand.Rand) (btcutil.Address, error) {
+	return datagen.GenRandomBTCAddressOfRandomType(r, &chaincfg.MainNetParams)
 }
 
 func taprootOutputWithValue(t *testing.T, r *rand.Rand, value btcutil.Amount) *wire.TxOut {
@@ -0,0 +1,153 @@
+package btcstaking
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+
+	bbn "github.com/babylonchain/babylon/types"
+)
+
+// StakingTxIdentificationParams are the parameters that staking transactions
+// are identified against. They correspond to the BTC staking parameters of
+// Babylon that the staking transactions are created under.
+type StakingTxIdentificationParams struct {
+	// MagicBytes are the magic bytes prefixing the OP_RETURN data of staking
+	// transactions
+	MagicBytes []byte
+	// CovenantKeys are the public keys of the covenant committee
+	CovenantKeys []*btcec.PublicKey
+	// CovenantQuorum is the minimum number of covenant signatures required
+	CovenantQuorum uint32
+	// ScriptTemplateVersion is the version of the script template that the
+	// staking outputs are built with. 0 refers to ScriptTemplateV1.
+	ScriptTemplateVersion uint32
+}
+
+// Validate checks that the parameters are well-formed
+func (p *StakingTxIdentificationParams) Validate() error {
+	if p == nil {
+		return fmt.Errorf("nil staking tx identification params")
+	}
+	if len(p.MagicBytes) != MagicBytesLen {
+		return fmt.Errorf("invalid magic bytes length: %d, expected: %d", len(p.MagicBytes), MagicBytesLen)
+	}
+	if len(p.CovenantKeys) == 0 {
+		return fmt.Errorf("no covenant keys specified")
+	}
+	if p.CovenantQuorum == 0 || p.CovenantQuorum > uint32(len(p.CovenantKeys)) {
+		return fmt.Errorf("covenant quorum %d is not in [1, %d]", p.CovenantQuorum, len(p.CovenantKeys))
+	}
+	if _, err := GetScriptTemplate(p.ScriptTemplateVersion); err != nil {
+		return err
+	}
+	return nil
+}
+
+// IdentifiedStakingTx is a staking transaction identified by
+// IdentifyStakingTx, along with the staking data it carries
+type IdentifiedStakingTx struct {
+	// TxHash is the hash of the staking transaction
+	TxHash chainhash.Hash
+	// StakerKey is the public key of the staker. As the staking data carries
+	// x-only keys, this and FinalityProviderKeys are the keys with even Y
+	// coordinate.
+	StakerKey *btcec.PublicKey
+	// FinalityProviderKeys are the public keys of the finality providers
+	// being staked to
+	FinalityProviderKeys []*btcec.PublicKey
+	// StakingTime is the timelock of the staking output in BTC blocks
+	StakingTime uint16
+	// StakingValue is the value of the staking output
+	StakingValue btcutil.Amount
+	// StakingOutputIdx is the index of the staking output
+	StakingOutputIdx uint32
+	// OpReturnOutputIdx is the index of the OP_RETURN output carrying the
+	// staking data
+	OpReturnOutputIdx uint32
+	// ScriptTemplateVersion is the version of the script template that the
+	// staking output is built with
+	ScriptTemplateVersion uint32
+}
+
+// IdentifyStakingTxFromBytes is IdentifyStakingTx over a serialized BTC
+// transaction. A transaction without inputs cannot be decoded in the segwit
+// serialization, since its zero input count is read as the segwit marker, so
+// it is decoded in the non-witness serialization instead.
+func IdentifyStakingTxFromBytes(
+	txBytes []byte,
+	params *StakingTxIdentificationParams,
+	net *chaincfg.Params,
+) (*IdentifiedStakingTx, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	tx, err := bbn.NewBTCTxFromBytes(txBytes)
+	if err != nil {
+		var noWitnessTx wire.MsgTx
+		rbuf := bytes.NewReader(txBytes)
+		if noWitnessErr := noWitnessTx.DeserializeNoWitness(rbuf); noWitnessErr != nil || rbuf.Len() != 0 {
+			return nil, fmt.Errorf("%w: cannot deserialize tx: %v", ErrNotStakingTx, err)
+		}
+		tx = &noWitnessTx
+	}
+	return IdentifyStakingTx(tx, params, net)
+}
+
+// IdentifyStakingTx determines whether the given BTC transaction is a Babylon
+// staking transaction under the given parameters and, if so, extracts its
+// staking data. It is meant for indexers and explorers, so that they do not
+// need to replicate the identification logic.
+//
+// A transaction that is not a staking transaction under the given parameters
+// results in an error wrapping ErrNotStakingTx, while malformed parameters
+// result in other errors.
+func IdentifyStakingTx(
+	tx *wire.MsgTx,
+	params *StakingTxIdentificationParams,
+	net *chaincfg.Params,
+) (*IdentifiedStakingTx, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, fmt.Errorf("nil tx")
+	}
+	template, err := GetScriptTemplate(params.ScriptTemplateVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	// cheap checks to filter out most of the transactions
+	if !IsPossibleV0StakingTx(tx, params.MagicBytes) {
+		return nil, fmt.Errorf("%w: no staking data with the expected magic bytes", ErrNotStakingTx)
+	}
+
+	parsedTx, err := parseV0StakingTx(
+		tx,
+		params.MagicBytes,
+		params.CovenantKeys,
+		params.CovenantQuorum,
+		template.BuildStakingInfo,
+		net,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrNotStakingTx, err)
+	}
+
+	return &IdentifiedStakingTx{
+		TxHash:                tx.TxHash(),
+		StakerKey:             parsedTx.OpReturnData.StakerPublicKey.PubKey,
+		FinalityProviderKeys:  []*btcec.PublicKey{parsedTx.OpReturnData.FinalityProviderPublicKey.PubKey},
+		StakingTime:           parsedTx.OpReturnData.StakingTime,
+		StakingValue:          btcutil.Amount(parsedTx.StakingOutput.Value),
+		StakingOutputIdx:      uint32(parsedTx.StakingOutputIdx),
+		OpReturnOutputIdx:     uint32(parsedTx.OpReturnOutputIdx),
+		ScriptTemplateVersion: template.Version,
+	}, nil
+}
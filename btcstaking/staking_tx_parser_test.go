@@ -0,0 +1,102 @@
+package btcstaking_test
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	"github.com/babylonchain/babylon/testutil/datagen"
+)
+
+func FuzzIdentifyStakingTx(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 100)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		numCovenantKeys := uint32(r.Int31n(7) + 3)
+		quorum := uint32(r.Intn(int(numCovenantKeys)) + 1)
+		stakingAmount := btcutil.Amount(r.Int63n(1000000000) + 10000)
+		stakingTime := uint16(r.Int31n(math.MaxUint16-1) + 1)
+		magicBytes := datagen.GenRandomByteArray(r, btcstaking.MagicBytesLen)
+		net := &chaincfg.MainNetParams
+
+		sc := GenerateTestScenario(r, t, 1, numCovenantKeys, quorum, stakingAmount, stakingTime)
+		outputs, err := btcstaking.BuildV0IdentifiableStakingOutputs(
+			magicBytes,
+			sc.StakerKey.PubKey(),
+			sc.FinalityProviderKeys[0].PubKey(),
+			sc.CovenantPublicKeys(),
+			quorum,
+			stakingTime,
+			stakingAmount,
+			net,
+		)
+		require.NoError(t, err)
+		tx, stakingOutputIdx, opReturnOutputIdx := generateTxFromOutputs(r, outputs)
+		var txBuf bytes.Buffer
+		require.NoError(t, tx.Serialize(&txBuf))
+
+		params := &btcstaking.StakingTxIdentificationParams{
+			MagicBytes:     magicBytes,
+			CovenantKeys:   sc.CovenantPublicKeys(),
+			CovenantQuorum: quorum,
+		}
+
+		// the staking tx is identified with its staking data
+		identifiedTx, err := btcstaking.IdentifyStakingTxFromBytes(txBuf.Bytes(), params, net)
+		require.NoError(t, err)
+		require.Equal(t, tx.TxHash(), identifiedTx.TxHash)
+		require.Equal(t, schnorr.SerializePubKey(sc.StakerKey.PubKey()), schnorr.SerializePubKey(identifiedTx.StakerKey))
+		require.Len(t, identifiedTx.FinalityProviderKeys, 1)
+		require.Equal(t, schnorr.SerializePubKey(sc.FinalityProviderKeys[0].PubKey()), schnorr.SerializePubKey(identifiedTx.FinalityProviderKeys[0]))
+		require.Equal(t, stakingTime, identifiedTx.StakingTime)
+		require.Equal(t, stakingAmount, identifiedTx.StakingValue)
+		require.Equal(t, uint32(stakingOutputIdx), identifiedTx.StakingOutputIdx)
+		require.Equal(t, uint32(opReturnOutputIdx), identifiedTx.OpReturnOutputIdx)
+		require.Equal(t, btcstaking.ScriptTemplateV1, identifiedTx.ScriptTemplateVersion)
+
+		// the staking tx is identified without inputs, where it is decoded in
+		// the non-witness serialization
+		noInputTx := tx.Copy()
+		noInputTx.TxIn = nil
+		var noInputTxBuf bytes.Buffer
+		require.NoError(t, noInputTx.SerializeNoWitness(&noInputTxBuf))
+		identifiedTx, err = btcstaking.IdentifyStakingTxFromBytes(noInputTxBuf.Bytes(), params, net)
+		require.NoError(t, err)
+		require.Equal(t, noInputTx.TxHash(), identifiedTx.TxHash)
+		require.Equal(t, uint32(stakingOutputIdx), identifiedTx.StakingOutputIdx)
+
+		// the staking tx is not identified under other parameters
+		otherParams := *params
+		otherParams.MagicBytes = datagen.GenRandomByteArray(r, btcstaking.MagicBytesLen)
+		_, err = btcstaking.IdentifyStakingTx(tx, &otherParams, net)
+		require.ErrorIs(t, err, btcstaking.ErrNotStakingTx)
+
+		otherParams = *params
+		otherParams.CovenantQuorum = uint32(datagen.RandomIntOtherThan(r, int(quorum-1), int(numCovenantKeys))) + 1
+		_, err = btcstaking.IdentifyStakingTx(tx, &otherParams, net)
+		require.ErrorIs(t, err, btcstaking.ErrNotStakingTx)
+
+		// neither are garbage bytes
+		_, err = btcstaking.IdentifyStakingTxFromBytes(datagen.GenRandomByteArray(r, 100), params, net)
+		require.ErrorIs(t, err, btcstaking.ErrNotStakingTx)
+
+		// malformed parameters are reported as such
+		otherParams = *params
+		otherParams.CovenantQuorum = numCovenantKeys + 1
+		_, err = btcstaking.IdentifyStakingTx(tx, &otherParams, net)
+		require.Error(t, err)
+		require.NotErrorIs(t, err, btcstaking.ErrNotStakingTx)
+
+		otherParams = *params
+		otherParams.ScriptTemplateVersion = btcstaking.ScriptTemplateVersions()[len(btcstaking.ScriptTemplateVersions())-1] + 1
+		_, err = btcstaking.IdentifyStakingTx(tx, &otherParams, net)
+		require.ErrorIs(t, err, btcstaking.ErrUnknownScriptTemplate)
+	})
+}
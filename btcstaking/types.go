@@ -267,72 +267,9 @@ type babylonScriptPaths struct {
 	slashingPathScript []byte
 }
 
-func newBabylonScriptPaths(
-	stakerKey *btcec.PublicKey,
-	fpKeys []*btcec.PublicKey,
-	covenantKeys []*btcec.PublicKey,
-	covenantQuorum uint32,
-	lockTime uint16,
-) (*babylonScriptPaths, error) {
-	if stakerKey == nil {
-		return nil, fmt.Errorf("staker key is nil")
-	}
-
-	timeLockPathScript, err := buildTimeLockScript(stakerKey, lockTime)
-
-	if err != nil {
-		return nil, err
-	}
-
-	covenantMultisigScript, err := buildMultiSigScript(
-		covenantKeys,
-		covenantQuorum,
-		// covenant multisig is always last in script so we do not run verify and leave
-		// last value on the stack. If we do not leave at least one element on the stack
-		// script will always error
-		false,
-	)
-
-	if err != nil {
-		return nil, err
-	}
-
-	stakerSigScript, err := buildSingleKeySigScript(stakerKey, true)
-
-	if err != nil {
-		return nil, err
-	}
-
-	fpMultisigScript, err := buildMultiSigScript(
-		fpKeys,
-		// we always require only one finality provider to sign
-		1,
-		// we need to run verify to clear the stack, as finality provider multisig is in the middle of the script
-		true,
-	)
-
-	if err != nil {
-		return nil, err
-	}
-
-	unbondingPathScript := aggregateScripts(
-		stakerSigScript,
-		covenantMultisigScript,
-	)
-
-	slashingPathScript := aggregateScripts(
-		stakerSigScript,
-		fpMultisigScript,
-		covenantMultisigScript,
-	)
-
-	return &babylonScriptPaths{
-		timeLockPathScript:  timeLockPathScript,
-		unbondingPathScript: unbondingPathScript,
-		slashingPathScript:  slashingPathScript,
-	}, nil
-}
-
+// BuildStakingInfo builds the staking output with timelock, unbonding and
+// slashing paths, where the covenant committee's part of the unbonding and
+// slashing paths is a covenantQuorum-out-of-N multisig over the covenant keys
 func BuildStakingInfo(
 	stakerKey *btcec.PublicKey,
 	fpKeys []*btcec.PublicKey,
@@ -342,53 +279,15 @@ func BuildStakingInfo(
 	stakingAmount btcutil.Amount,
 	net *chaincfg.Params,
 ) (*StakingInfo, error) {
-	unspendableKeyPathKey := unspendableKeyPathInternalPubKey()
-
-	babylonScripts, err := newBabylonScriptPaths(
+	return DefaultScriptBuilder().BuildStakingInfo(
 		stakerKey,
 		fpKeys,
 		covenantKeys,
 		covenantQuorum,
 		stakingTime,
+		stakingAmount,
+		net,
 	)
-
-	if err != nil {
-		return nil, err
-	}
-
-	var unbondingPaths [][]byte
-	unbondingPaths = append(unbondingPaths, babylonScripts.timeLockPathScript)
-	unbondingPaths = append(unbondingPaths, babylonScripts.unbondingPathScript)
-	unbondingPaths = append(unbondingPaths, babylonScripts.slashingPathScript)
-
-	timeLockLeafHash := txscript.NewBaseTapLeaf(babylonScripts.timeLockPathScript).TapHash()
-	unbondingPathLeafHash := txscript.NewBaseTapLeaf(babylonScripts.unbondingPathScript).TapHash()
-	slashingLeafHash := txscript.NewBaseTapLeaf(babylonScripts.slashingPathScript).TapHash()
-
-	sh, err := newTaprootScriptHolder(
-		&unspendableKeyPathKey,
-		unbondingPaths,
-	)
-
-	if err != nil {
-		return nil, err
-	}
-
-	taprootPkScript, err := sh.taprootPkScript(net)
-
-	if err != nil {
-		return nil, err
-	}
-
-	stakingOutput := wire.NewTxOut(int64(stakingAmount), taprootPkScript)
-
-	return &StakingInfo{
-		StakingOutput:         stakingOutput,
-		scriptHolder:          sh,
-		timeLockPathLeafHash:  timeLockLeafHash,
-		unbondingPathLeafHash: unbondingPathLeafHash,
-		slashingPathLeafHash:  slashingLeafHash,
-	}, nil
 }
 
 func (i *StakingInfo) TimeLockPathSpendInfo() (*SpendInfo, error) {
@@ -413,6 +312,9 @@ type UnbondingInfo struct {
 	slashingPathLeafHash chainhash.Hash
 }
 
+// BuildUnbondingInfo builds the unbonding output with timelock and slashing
+// paths, where the covenant committee's part of the slashing path is a
+// covenantQuorum-out-of-N multisig over the covenant keys
 func BuildUnbondingInfo(
 	stakerKey *btcec.PublicKey,
 	fpKeys []*btcec.PublicKey,
@@ -422,50 +324,15 @@ func BuildUnbondingInfo(
 	unbondingAmount btcutil.Amount,
 	net *chaincfg.Params,
 ) (*UnbondingInfo, error) {
-	unspendableKeyPathKey := unspendableKeyPathInternalPubKey()
-
-	babylonScripts, err := newBabylonScriptPaths(
+	return DefaultScriptBuilder().BuildUnbondingInfo(
 		stakerKey,
 		fpKeys,
 		covenantKeys,
 		covenantQuorum,
 		unbondingTime,
+		unbondingAmount,
+		net,
 	)
-
-	if err != nil {
-		return nil, err
-	}
-
-	var unbondingPaths [][]byte
-	unbondingPaths = append(unbondingPaths, babylonScripts.timeLockPathScript)
-	unbondingPaths = append(unbondingPaths, babylonScripts.slashingPathScript)
-
-	timeLockLeafHash := txscript.NewBaseTapLeaf(babylonScripts.timeLockPathScript).TapHash()
-	slashingLeafHash := txscript.NewBaseTapLeaf(babylonScripts.slashingPathScript).TapHash()
-
-	sh, err := newTaprootScriptHolder(
-		&unspendableKeyPathKey,
-		unbondingPaths,
-	)
-
-	if err != nil {
-		return nil, err
-	}
-
-	taprootPkScript, err := sh.taprootPkScript(net)
-
-	if err != nil {
-		return nil, err
-	}
-
-	unbondingOutput := wire.NewTxOut(int64(unbondingAmount), taprootPkScript)
-
-	return &UnbondingInfo{
-		UnbondingOutput:      unbondingOutput,
-		scriptHolder:         sh,
-		timeLockPathLeafHash: timeLockLeafHash,
-		slashingPathLeafHash: slashingLeafHash,
-	}, nil
 }
 
 func (i *UnbondingInfo) TimeLockPathSpendInfo() (*SpendInfo, error) {
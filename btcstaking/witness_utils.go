@@ -4,6 +4,9 @@ import (
 	"fmt"
 
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/mempool"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 )
 
@@ -118,3 +121,41 @@ func CreateWitness(si *SpendInfo, signatures [][]byte) (wire.TxWitness, error) {
 
 	return witnessStack, nil
 }
+
+// EstimateScriptPathSpendVirtualSize estimates the virtual size of the given tx
+// with a single input once the input is signed via the script path of the
+// given spend info. Every key in the revealed script is assumed to provide a
+// Schnorr signature, so the estimation is an upper bound of the virtual size
+// of the signed tx.
+func EstimateScriptPathSpendVirtualSize(tx *wire.MsgTx, si *SpendInfo) (int64, error) {
+	if si == nil {
+		panic("cannot estimate virtual size without spend info")
+	}
+	if len(tx.TxIn) != 1 {
+		return 0, fmt.Errorf("tx must have exactly one input, got %d", len(tx.TxIn))
+	}
+
+	// each key in the script consumes a signature from the witness stack
+	numKeys := 0
+	tokenizer := txscript.MakeScriptTokenizer(0, si.GetPkScriptPath())
+	for tokenizer.Next() {
+		if len(tokenizer.Data()) == schnorr.PubKeyBytesLen {
+			numKeys++
+		}
+	}
+	if err := tokenizer.Err(); err != nil {
+		return 0, fmt.Errorf("invalid script: %w", err)
+	}
+	signatures := make([][]byte, numKeys)
+	for i := range signatures {
+		signatures[i] = make([]byte, schnorr.SignatureSize)
+	}
+	witness, err := CreateWitness(si, signatures)
+	if err != nil {
+		return 0, err
+	}
+
+	signedTx := tx.Copy()
+	signedTx.TxIn[0].Witness = witness
+	return mempool.GetTxVirtualSize(btcutil.NewTx(signedTx)), nil
+}
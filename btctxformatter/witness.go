@@ -0,0 +1,109 @@
+package btctxformatter
+
+import (
+	"errors"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// Besides OP_RETURN outputs, a checkpoint part can be committed inside a
+// Taproot script path spend, in an ordinals-style envelope of the tapscript:
+//
+//	OP_FALSE OP_IF <tag> <checkpoint part> OP_ENDIF
+//
+// where <checkpoint part> is the same byte array as carried in OP_RETURN
+// outputs, i.e., including the header. The envelope is never executed, so it
+// can be prepended or appended to any tapscript, e.g., `<pk> OP_CHECKSIG`.
+// As witness data is discounted, this reduces the cost of checkpointing.
+
+// EnvelopeProtocolID is the first push of the envelope, used to tell Babylon
+// envelopes apart from other envelopes in the same tapscript
+var EnvelopeProtocolID = []byte("bbn")
+
+// BuildWitnessEnvelope returns the tapscript envelope carrying the given
+// checkpoint part
+func BuildWitnessEnvelope(checkpointPart []byte) ([]byte, error) {
+	if len(checkpointPart) == 0 {
+		return nil, errors.New("checkpoint part should not be empty")
+	}
+
+	return txscript.NewScriptBuilder().
+		AddOp(txscript.OP_FALSE).
+		AddOp(txscript.OP_IF).
+		AddData(EnvelopeProtocolID).
+		AddData(checkpointPart).
+		AddOp(txscript.OP_ENDIF).
+		Script()
+}
+
+// TapscriptFromWitness returns the tapscript of a Taproot script path spend,
+// or nil if the witness is not a script path spend. The annex, if present, is
+// skipped.
+func TapscriptFromWitness(witness wire.TxWitness) []byte {
+	if len(witness) >= 2 && len(witness[len(witness)-1]) > 0 &&
+		witness[len(witness)-1][0] == txscript.TaprootAnnexTag {
+		witness = witness[:len(witness)-1]
+	}
+
+	// script path spend has at least the tapscript and the control block
+	if len(witness) < 2 {
+		return nil
+	}
+
+	controlBlock := witness[len(witness)-1]
+	if len(controlBlock) < txscript.ControlBlockBaseSize ||
+		(len(controlBlock)-txscript.ControlBlockBaseSize)%txscript.ControlBlockNodeSize != 0 {
+		return nil
+	}
+
+	return witness[len(witness)-2]
+}
+
+// ExtractWitnessEnvelopeData returns the data of the first Babylon envelope
+// in the given tapscript, or nil if there is none
+func ExtractWitnessEnvelopeData(tapscript []byte) []byte {
+	tokenizer := txscript.MakeScriptTokenizer(0, tapscript)
+
+	// the last two opcodes, to recognise the OP_FALSE OP_IF opening
+	var prev, prevPrev byte = txscript.OP_INVALIDOPCODE, txscript.OP_INVALIDOPCODE
+	for tokenizer.Next() {
+		op := tokenizer.Opcode()
+		if prevPrev == txscript.OP_FALSE && prev == txscript.OP_IF {
+			if data, ok := parseEnvelopeBody(&tokenizer); ok {
+				return data
+			}
+			prevPrev, prev = txscript.OP_INVALIDOPCODE, txscript.OP_INVALIDOPCODE
+			continue
+		}
+		prevPrev, prev = prev, op
+	}
+
+	return nil
+}
+
+// parseEnvelopeBody parses the body of an envelope, whose first opcode is the
+// current opcode of the tokenizer, up to OP_ENDIF
+func parseEnvelopeBody(tokenizer *txscript.ScriptTokenizer) ([]byte, bool) {
+	if !isDataPush(tokenizer.Opcode()) || string(tokenizer.Data()) != string(EnvelopeProtocolID) {
+		return nil, false
+	}
+
+	var data []byte
+	for tokenizer.Next() {
+		op := tokenizer.Opcode()
+		if op == txscript.OP_ENDIF {
+			return data, len(data) > 0
+		}
+		if !isDataPush(op) {
+			return nil, false
+		}
+		data = append(data, tokenizer.Data()...)
+	}
+
+	return nil, false
+}
+
+func isDataPush(op byte) bool {
+	return op >= txscript.OP_DATA_1 && op <= txscript.OP_PUSHDATA4
+}
@@ -0,0 +1,104 @@
+package btctxformatter
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+func FuzzWitnessEnvelope(f *testing.F) {
+	f.Add(uint64(5), randNBytes(TagLength), randNBytes(BlockHashLength), randNBytes(BitMapLength), randNBytes(BlsSigLength), randNBytes(AddressLength), false)
+	f.Add(uint64(2000), randNBytes(TagLength), randNBytes(BlockHashLength), randNBytes(BitMapLength), randNBytes(BlsSigLength), randNBytes(AddressLength), true)
+
+	f.Fuzz(func(t *testing.T, epoch uint64, tag []byte, appHash []byte, bitMap []byte, blsSig []byte, address []byte, withAnnex bool) {
+		if len(tag) < TagLength {
+			t.Skip("Tag should have 4 bytes")
+		}
+		babylonTag := BabylonTag(tag[:TagLength])
+
+		firstHalf, secondHalf, err := EncodeCheckpointData(
+			babylonTag,
+			CurrentVersion,
+			&RawBtcCheckpoint{
+				Epoch:            epoch,
+				BlockHash:        appHash,
+				BitMap:           bitMap,
+				SubmitterAddress: address,
+				BlsSig:           blsSig,
+			},
+		)
+		if err != nil {
+			t.Skip("Encoding should be correct")
+		}
+
+		for idx, part := range [][]byte{firstHalf, secondHalf} {
+			envelope, err := BuildWitnessEnvelope(part)
+			if err != nil {
+				t.Fatalf("Building envelope should succeed: %v", err)
+			}
+
+			// <pk> OP_CHECKSIG followed by the envelope
+			tapscript, err := txscript.NewScriptBuilder().
+				AddData(randNBytes(32)).
+				AddOp(txscript.OP_CHECKSIG).
+				Script()
+			if err != nil {
+				t.Fatalf("Building tapscript should succeed: %v", err)
+			}
+			tapscript = append(tapscript, envelope...)
+
+			witness := wire.TxWitness{randNBytes(64), tapscript, randNBytes(txscript.ControlBlockBaseSize)}
+			if withAnnex {
+				witness = append(witness, append([]byte{txscript.TaprootAnnexTag}, randNBytes(10)...))
+			}
+
+			extracted := ExtractWitnessEnvelopeData(TapscriptFromWitness(witness))
+			if !bytes.Equal(extracted, part) {
+				t.Errorf("Extracted envelope data should be equal to the checkpoint part")
+			}
+
+			decoded, err := IsBabylonCheckpointData(babylonTag, CurrentVersion, extracted)
+			if err != nil {
+				t.Errorf("Valid envelope data should be properly decoded")
+			} else if decoded.Index != uint8(idx) {
+				t.Errorf("Decoded part should have index %d, have %d", idx, decoded.Index)
+			}
+		}
+	})
+}
+
+func TestWitnessWithoutEnvelope(t *testing.T) {
+	// key path spend
+	if TapscriptFromWitness(wire.TxWitness{randNBytes(64)}) != nil {
+		t.Errorf("Key path spend should not have a tapscript")
+	}
+
+	// tapscript without envelope
+	tapscript, err := txscript.NewScriptBuilder().
+		AddData(randNBytes(32)).
+		AddOp(txscript.OP_CHECKSIG).
+		Script()
+	if err != nil {
+		t.Fatalf("Building tapscript should succeed: %v", err)
+	}
+	if ExtractWitnessEnvelopeData(tapscript) != nil {
+		t.Errorf("Tapscript without envelope should not carry data")
+	}
+
+	// envelope of another protocol
+	otherEnvelope, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_FALSE).
+		AddOp(txscript.OP_IF).
+		AddData([]byte("ord")).
+		AddData(randNBytes(62)).
+		AddOp(txscript.OP_ENDIF).
+		Script()
+	if err != nil {
+		t.Fatalf("Building envelope should succeed: %v", err)
+	}
+	if ExtractWitnessEnvelopeData(otherEnvelope) != nil {
+		t.Errorf("Envelope of another protocol should not carry Babylon data")
+	}
+}
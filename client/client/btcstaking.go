@@ -0,0 +1,294 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"github.com/avast/retry-go/v4"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdkquerytypes "github.com/cosmos/cosmos-sdk/types/query"
+	pv "github.com/cosmos/relayer/v2/relayer/provider"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// CreateBTCDelegationRequest contains everything a staker submits to create a
+// BTC delegation, in the types of the btcd library rather than the protobuf
+// encodings of MsgCreateBTCDelegation
+type CreateBTCDelegationRequest struct {
+	// BabylonPk is the Babylon PK of the staker
+	BabylonPk *secp256k1.PubKey
+	// Pop is the proof of possession of the Babylon PK and the BTC PK
+	Pop *bstypes.ProofOfPossession
+	// StakerBtcPk is the BTC PK of the staker
+	StakerBtcPk *btcec.PublicKey
+	// FpBtcPks are the BTC PKs of the finality providers to delegate to
+	FpBtcPks []*btcec.PublicKey
+	// StakingTime is the timelock of the staking output in BTC blocks
+	StakingTime uint16
+	// StakingValue is the value of the staking output
+	StakingValue btcutil.Amount
+	// StakingTxInfo is the staking tx along with the proof of its inclusion
+	// in a BTC block, e.g., as returned by NewBTCTxInfo
+	StakingTxInfo *btcctypes.TransactionInfo
+	// SlashingTx is the slashing tx spending the staking output
+	SlashingTx *wire.MsgTx
+	// DelegatorSlashingSig is the staker's signature on SlashingTx
+	DelegatorSlashingSig *schnorr.Signature
+	// UnbondingTime is the timelock of the unbonding output in BTC blocks
+	UnbondingTime uint16
+	// UnbondingTx is the unbonding tx spending the staking output
+	UnbondingTx *wire.MsgTx
+	// UnbondingValue is the value of the unbonding output
+	UnbondingValue btcutil.Amount
+	// UnbondingSlashingTx is the slashing tx spending the unbonding output
+	UnbondingSlashingTx *wire.MsgTx
+	// DelegatorUnbondingSlashingSig is the staker's signature on
+	// UnbondingSlashingTx
+	DelegatorUnbondingSlashingSig *schnorr.Signature
+	// Memo is the optional label of the BTC delegation
+	Memo string
+	// RewardAddress is the optional Babylon address receiving the rewards of
+	// the BTC delegation
+	RewardAddress string
+	// WithdrawalBtcAddress is the optional BTC address the staker withdraws
+	// the stake to
+	WithdrawalBtcAddress string
+}
+
+// NewBTCTxInfo returns the tx at the given index of the given BTC block along
+// with the Merkle proof of its inclusion in the block, as submitted in
+// MsgCreateBTCDelegation and MsgReportStakingSpend
+func NewBTCTxInfo(block *wire.MsgBlock, txIdx uint) (*btcctypes.TransactionInfo, error) {
+	if txIdx >= uint(len(block.Transactions)) {
+		return nil, fmt.Errorf("tx index %d is out of range of %d txs in the block", txIdx, len(block.Transactions))
+	}
+	txs := make([][]byte, 0, len(block.Transactions))
+	for _, tx := range block.Transactions {
+		txBytes, err := bbn.SerializeBTCTx(tx)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, txBytes)
+	}
+	header := bbn.NewBTCHeaderBytesFromBlockHeader(&block.Header)
+	proof, err := btcctypes.SpvProofFromHeaderAndTransactions(&header, txs, txIdx)
+	if err != nil {
+		return nil, err
+	}
+	return btcctypes.NewTransactionInfoFromSpvProof(proof), nil
+}
+
+// NewCreateBTCDelegationMsg builds the MsgCreateBTCDelegation of the given
+// request signed by the given signer, and validates it
+func NewCreateBTCDelegationMsg(signer string, req *CreateBTCDelegationRequest) (*bstypes.MsgCreateBTCDelegation, error) {
+	if req.SlashingTx == nil || req.UnbondingTx == nil || req.UnbondingSlashingTx == nil {
+		return nil, fmt.Errorf("the request misses the slashing tx, the unbonding tx or the unbonding slashing tx")
+	}
+	if req.DelegatorSlashingSig == nil || req.DelegatorUnbondingSlashingSig == nil {
+		return nil, fmt.Errorf("the request misses the staker's signatures on the slashing txs")
+	}
+	slashingTx, err := bstypes.NewBTCSlashingTxFromMsgTx(req.SlashingTx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid slashing tx: %w", err)
+	}
+	unbondingTx, err := bbn.SerializeBTCTx(req.UnbondingTx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid unbonding tx: %w", err)
+	}
+	unbondingSlashingTx, err := bstypes.NewBTCSlashingTxFromMsgTx(req.UnbondingSlashingTx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid unbonding slashing tx: %w", err)
+	}
+
+	msg := &bstypes.MsgCreateBTCDelegation{
+		Signer:                        signer,
+		BabylonPk:                     req.BabylonPk,
+		Pop:                           req.Pop,
+		BtcPk:                         bbn.NewBIP340PubKeyFromBTCPK(req.StakerBtcPk),
+		FpBtcPkList:                   bbn.NewBIP340PKsFromBTCPKs(req.FpBtcPks),
+		StakingTime:                   uint32(req.StakingTime),
+		StakingValue:                  int64(req.StakingValue),
+		StakingTx:                     req.StakingTxInfo,
+		SlashingTx:                    slashingTx,
+		DelegatorSlashingSig:          bbn.NewBIP340SignatureFromBTCSig(req.DelegatorSlashingSig),
+		UnbondingTime:                 uint32(req.UnbondingTime),
+		UnbondingTx:                   unbondingTx,
+		UnbondingValue:                int64(req.UnbondingValue),
+		UnbondingSlashingTx:           unbondingSlashingTx,
+		DelegatorUnbondingSlashingSig: bbn.NewBIP340SignatureFromBTCSig(req.DelegatorUnbondingSlashingSig),
+		Memo:                          req.Memo,
+		RewardAddress:                 req.RewardAddress,
+		WithdrawalBtcAddress:          req.WithdrawalBtcAddress,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// NewAddCovenantSigsMsg verifies the given work item of the PendingBTCDelegations
+// query against the given params of its BTC delegation's params version, e.g.,
+// queried via BTCStakingParamsByVersion, and produces the signatures of the
+// given covenant member on its BTC delegation
+func NewAddCovenantSigsMsg(
+	signer string,
+	workItem *bstypes.CovenantSigningWorkItem,
+	params *bstypes.Params,
+	covenantSK *btcec.PrivateKey,
+	btcNet *chaincfg.Params,
+) (*bstypes.MsgAddCovenantSigs, error) {
+	btcDel, err := workItem.Verify(params, btcNet)
+	if err != nil {
+		return nil, err
+	}
+	return bstypes.NewMsgAddCovenantSigs(signer, btcDel, params, covenantSK, btcNet)
+}
+
+// NewBTCUndelegateMsg builds the MsgBTCUndelegate of the BTC delegation with
+// the given staking tx hash from the staker's signature on its unbonding tx
+func NewBTCUndelegateMsg(signer string, stakingTxHash string, unbondingTxSig *schnorr.Signature) *bstypes.MsgBTCUndelegate {
+	return &bstypes.MsgBTCUndelegate{
+		Signer:         signer,
+		StakingTxHash:  stakingTxHash,
+		UnbondingTxSig: bbn.NewBIP340SignatureFromBTCSig(unbondingTxSig),
+	}
+}
+
+// CreateFinalityProvider reliably submits the given MsgCreateFinalityProvider.
+// Registering an already registered finality provider is not an error
+func (c *Client) CreateFinalityProvider(ctx context.Context, msg *bstypes.MsgCreateFinalityProvider) (*pv.RelayerTxResponse, error) {
+	return c.ReliablySendMsg(
+		ctx,
+		msg,
+		[]*errors.Error{bstypes.ErrFpRegistered},
+		[]*errors.Error{bstypes.ErrInvalidProofOfPossession, bstypes.ErrCommissionLTMinRate, bstypes.ErrCommissionGTMaxRate, bstypes.ErrUnauthorizedFpSigner},
+	)
+}
+
+// CreateBTCDelegation reliably submits the given MsgCreateBTCDelegation.
+// Submitting an already used staking tx is not an error
+func (c *Client) CreateBTCDelegation(ctx context.Context, msg *bstypes.MsgCreateBTCDelegation) (*pv.RelayerTxResponse, error) {
+	return c.ReliablySendMsg(
+		ctx,
+		msg,
+		[]*errors.Error{bstypes.ErrReusedStakingTx},
+		[]*errors.Error{bstypes.ErrInvalidStakingTx, bstypes.ErrInvalidSlashingTx, bstypes.ErrInvalidUnbondingTx, bstypes.ErrInvalidProofOfPossession},
+	)
+}
+
+// AddCovenantSigs reliably submits the given MsgAddCovenantSigs
+func (c *Client) AddCovenantSigs(ctx context.Context, msg *bstypes.MsgAddCovenantSigs) (*pv.RelayerTxResponse, error) {
+	return c.ReliablySendMsg(
+		ctx,
+		msg,
+		[]*errors.Error{},
+		[]*errors.Error{bstypes.ErrBTCDelegationNotFound, bstypes.ErrInvalidCovenantPK, bstypes.ErrInvalidCovenantSig},
+	)
+}
+
+// BTCUndelegate reliably submits the given MsgBTCUndelegate
+func (c *Client) BTCUndelegate(ctx context.Context, msg *bstypes.MsgBTCUndelegate) (*pv.RelayerTxResponse, error) {
+	return c.ReliablySendMsg(
+		ctx,
+		msg,
+		[]*errors.Error{},
+		[]*errors.Error{bstypes.ErrBTCDelegationNotFound, bstypes.ErrInvalidBTCUndelegateReq, bstypes.ErrUnauthorizedSigner},
+	)
+}
+
+// ReliablyQueryBTCStakingParams queries the current BTC staking parameters,
+// retrying upon transient errors
+func (c *Client) ReliablyQueryBTCStakingParams(ctx context.Context) (*bstypes.Params, error) {
+	var resp *bstypes.QueryParamsResponse
+	err := c.reliablyQuery(ctx, func() error {
+		var err error
+		resp, err = c.BTCStakingParams()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Params, nil
+}
+
+// ReliablyQueryFinalityProvider queries the finality provider with the given
+// BTC PK, retrying upon transient errors
+func (c *Client) ReliablyQueryFinalityProvider(ctx context.Context, fpBtcPkHex string) (*bstypes.FinalityProviderResponse, error) {
+	var resp *bstypes.QueryFinalityProviderResponse
+	err := c.reliablyQuery(ctx, func() error {
+		var err error
+		resp, err = c.FinalityProvider(fpBtcPkHex)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.FinalityProvider, nil
+}
+
+// ReliablyQueryBTCDelegation queries the BTC delegation with the given
+// staking tx hash, retrying upon transient errors
+func (c *Client) ReliablyQueryBTCDelegation(ctx context.Context, stakingTxHashHex string) (*bstypes.BTCDelegationResponse, error) {
+	var resp *bstypes.QueryBTCDelegationResponse
+	err := c.reliablyQuery(ctx, func() error {
+		var err error
+		resp, err = c.BTCDelegation(stakingTxHashHex)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.BtcDelegation, nil
+}
+
+// ReliablyQueryPendingBTCDelegations queries the work items of the pending
+// BTC delegations not yet signed by the given covenant member, retrying upon
+// transient errors
+func (c *Client) ReliablyQueryPendingBTCDelegations(ctx context.Context, covenantPkHex string, pagination *sdkquerytypes.PageRequest) (*bstypes.QueryPendingBTCDelegationsResponse, error) {
+	var resp *bstypes.QueryPendingBTCDelegationsResponse
+	err := c.reliablyQuery(ctx, func() error {
+		var err error
+		resp, err = c.PendingBTCDelegations(covenantPkHex, pagination)
+		return err
+	})
+	return resp, err
+}
+
+// reliablyQuery runs the given query until it succeeds, fails with an error
+// that retrying cannot resolve, e.g., an object that is not found, or runs out
+// of attempts
+func (c *Client) reliablyQuery(ctx context.Context, query func() error) error {
+	return retry.Do(func() error {
+		err := query()
+		if err != nil && isUnrecoverableQueryErr(err) {
+			return retry.Unrecoverable(err)
+		}
+		return err
+	}, retry.Context(ctx), rtyAtt, rtyDel, rtyErr, retry.OnRetry(func(n uint, err error) {
+		c.logger.Debug("retrying query", zap.Uint("attempt", n+1), zap.Uint("max_attempts", rtyAttNum), zap.Error(err))
+	}))
+}
+
+func isUnrecoverableQueryErr(err error) bool {
+	if errors.IsOf(err, bstypes.ErrFpNotFound, bstypes.ErrBTCDelegationNotFound) {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.NotFound, codes.InvalidArgument:
+		return true
+	default:
+		return false
+	}
+}
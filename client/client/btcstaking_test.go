@@ -0,0 +1,123 @@
+package client_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/client/client"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+func FuzzNewCreateBTCDelegationMsg(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		net := &chaincfg.SimNetParams
+
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		_, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		_, covPKs, covQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+		stakingTime := uint16(datagen.RandomInt(r, 1000) + 100)
+		stakingValue := int64(datagen.RandomInt(r, 100000) + 100000)
+		unbondingTime := uint16(datagen.RandomInt(r, 50) + 10)
+		unbondingValue := stakingValue - 1000
+
+		// staking tx and slashing tx
+		stakingInfo := datagen.GenBTCStakingSlashingInfo(
+			r, t, net, delSK, []*btcec.PublicKey{fpPK}, covPKs, covQuorum,
+			stakingTime, stakingValue, slashingAddress.EncodeAddress(), slashingRate, unbondingTime,
+		)
+		slashingPathInfo, err := stakingInfo.StakingInfo.SlashingPathSpendInfo()
+		require.NoError(t, err)
+		delSlashingSig, err := stakingInfo.SlashingTx.Sign(stakingInfo.StakingTx, 0, slashingPathInfo.GetPkScriptPath(), delSK)
+		require.NoError(t, err)
+
+		// unbonding tx and unbonding slashing tx
+		stakingTxHash := stakingInfo.StakingTx.TxHash()
+		unbondingInfo := datagen.GenBTCUnbondingSlashingInfo(
+			r, t, net, delSK, []*btcec.PublicKey{fpPK}, covPKs, covQuorum,
+			wire.NewOutPoint(&stakingTxHash, 0), unbondingTime, unbondingValue,
+			slashingAddress.EncodeAddress(), slashingRate, unbondingTime,
+		)
+		delUnbondingSlashingSig, err := unbondingInfo.GenDelSlashingTxSig(delSK)
+		require.NoError(t, err)
+
+		// BTC block including the staking tx at a random position
+		block, _ := datagen.GenRandomBtcdBlock(r, 0, nil)
+		txIdx := uint(datagen.RandomInt(r, len(block.Transactions)+1))
+		txs := append([]*wire.MsgTx{}, block.Transactions[:txIdx]...)
+		txs = append(txs, stakingInfo.StakingTx)
+		block.Transactions = append(txs, block.Transactions[txIdx:]...)
+
+		txInfo, err := client.NewBTCTxInfo(block, txIdx)
+		require.NoError(t, err)
+		require.Equal(t, uint32(txIdx), txInfo.Key.Index)
+		header := bbn.NewBTCHeaderBytesFromBlockHeader(&block.Header)
+		require.True(t, txInfo.Key.Hash.Eq(header.Hash()))
+		stakingTxBytes, err := bbn.SerializeBTCTx(stakingInfo.StakingTx)
+		require.NoError(t, err)
+		require.Equal(t, stakingTxBytes, txInfo.Transaction)
+
+		// an out-of-range tx index is rejected
+		_, err = client.NewBTCTxInfo(block, uint(len(block.Transactions)))
+		require.Error(t, err)
+
+		delBabylonSK, delBabylonPK, err := datagen.GenRandomSecp256k1KeyPair(r)
+		require.NoError(t, err)
+		pop, err := bstypes.NewPoP(delBabylonSK, delSK)
+		require.NoError(t, err)
+		slashingTx, err := stakingInfo.SlashingTx.ToMsgTx()
+		require.NoError(t, err)
+		unbondingSlashingTx, err := unbondingInfo.SlashingTx.ToMsgTx()
+		require.NoError(t, err)
+		delSlashingBTCSig, err := delSlashingSig.ToBTCSig()
+		require.NoError(t, err)
+		delUnbondingSlashingBTCSig, err := delUnbondingSlashingSig.ToBTCSig()
+		require.NoError(t, err)
+
+		req := &client.CreateBTCDelegationRequest{
+			BabylonPk:                     delBabylonPK.(*secp256k1.PubKey),
+			Pop:                           pop,
+			StakerBtcPk:                   delSK.PubKey(),
+			FpBtcPks:                      []*btcec.PublicKey{fpPK},
+			StakingTime:                   stakingTime,
+			StakingValue:                  btcutil.Amount(stakingValue),
+			StakingTxInfo:                 txInfo,
+			SlashingTx:                    slashingTx,
+			DelegatorSlashingSig:          delSlashingBTCSig,
+			UnbondingTime:                 unbondingTime,
+			UnbondingTx:                   unbondingInfo.UnbondingTx,
+			UnbondingValue:                btcutil.Amount(unbondingValue),
+			UnbondingSlashingTx:           unbondingSlashingTx,
+			DelegatorUnbondingSlashingSig: delUnbondingSlashingBTCSig,
+		}
+		signer := datagen.GenRandomAccount().Address
+		msg, err := client.NewCreateBTCDelegationMsg(signer, req)
+		require.NoError(t, err)
+		require.Equal(t, signer, msg.Signer)
+		require.True(t, msg.BtcPk.Equals(bbn.NewBIP340PubKeyFromBTCPK(delSK.PubKey())))
+		require.Equal(t, *stakingInfo.SlashingTx, *msg.SlashingTx)
+		require.Equal(t, delSlashingSig.MustMarshal(), msg.DelegatorSlashingSig.MustMarshal())
+
+		// a request without the unbonding tx is rejected
+		req.UnbondingTx = nil
+		_, err = client.NewCreateBTCDelegationMsg(signer, req)
+		require.Error(t, err)
+	})
+}
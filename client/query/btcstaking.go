@@ -139,6 +139,23 @@ func (c *QueryClient) ActiveFinalityProvidersAtHeight(height uint64, pagination
 	return resp, err
 }
 
+// ActiveFinalityProvidersByPowerAtHeight queries the BTCStaking module for the active finality
+// providers at a given height, sorted by voting power in descending order
+func (c *QueryClient) ActiveFinalityProvidersByPowerAtHeight(height uint64, pagination *sdkquerytypes.PageRequest) (*btcstakingtypes.QueryActiveFinalityProvidersByPowerAtHeightResponse, error) {
+	var resp *btcstakingtypes.QueryActiveFinalityProvidersByPowerAtHeightResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryActiveFinalityProvidersByPowerAtHeightRequest{
+			Height:     height,
+			Pagination: pagination,
+		}
+		resp, err = queryClient.ActiveFinalityProvidersByPowerAtHeight(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
 // FinalityProviderPowerAtHeight queries the BTCStaking module for the power of a finality provider at a given height
 func (c *QueryClient) FinalityProviderPowerAtHeight(fpBtcPkHex string, height uint64) (*btcstakingtypes.QueryFinalityProviderPowerAtHeightResponse, error) {
 	var resp *btcstakingtypes.QueryFinalityProviderPowerAtHeightResponse
@@ -166,3 +183,21 @@ func (c *QueryClient) ActivatedHeight() (*btcstakingtypes.QueryActivatedHeightRe
 
 	return resp, err
 }
+
+// PendingBTCDelegations queries the BTCStaking module for the pending BTC delegations, along with everything
+// a covenant member needs to sign each of them. If covenantPkHex is not empty, only the BTC delegations not yet
+// signed by this covenant member are returned
+func (c *QueryClient) PendingBTCDelegations(covenantPkHex string, pagination *sdkquerytypes.PageRequest) (*btcstakingtypes.QueryPendingBTCDelegationsResponse, error) {
+	var resp *btcstakingtypes.QueryPendingBTCDelegationsResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryPendingBTCDelegationsRequest{
+			CovenantPkHex: covenantPkHex,
+			Pagination:    pagination,
+		}
+		resp, err = queryClient.PendingBTCDelegations(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
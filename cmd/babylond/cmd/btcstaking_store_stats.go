@@ -0,0 +1,220 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+const (
+	flagHeight = "height"
+	flagTop    = "top"
+)
+
+// btcStakingStorePrefixNames maps the key prefixes of the btcstaking store to
+// the names of the objects stored under them
+var btcStakingStorePrefixNames = map[byte]string{
+	bstypes.ParamsKey[0]:               "params",
+	bstypes.FinalityProviderKey[0]:     "finality_provider",
+	bstypes.BTCDelegatorKey[0]:         "btc_delegator",
+	bstypes.BTCDelegationKey[0]:        "btc_delegation",
+	bstypes.VotingPowerKey[0]:          "voting_power",
+	bstypes.BTCHeightKey[0]:            "btc_height",
+	bstypes.VotingPowerDistCacheKey[0]: "voting_power_dist_cache",
+	bstypes.PowerDistUpdateKey[0]:      "power_dist_update_event",
+	bstypes.SlashingRateReportKey[0]:   "slashing_rate_report",
+	bstypes.ScheduledParamsKey[0]:      "scheduled_params",
+	bstypes.DelegationOperatorKey[0]:   "delegation_operator",
+	bstypes.FpStatusReportKey[0]:       "fp_status_report",
+	bstypes.CovenantMuSig2NoncesKey[0]: "covenant_musig2_nonces",
+	bstypes.BTCDelegationTxsKey[0]:     "btc_delegation_txs",
+}
+
+// StoreStats is the output of the btcstaking-store-stats command
+type StoreStats struct {
+	Height         int64               `json:"height"`
+	NumEntries     uint64              `json:"num_entries"`
+	TotalSize      uint64              `json:"total_size"`
+	Objects        []*StoreObjectStats `json:"objects"`
+	LargestEntries []*StoreEntryStats  `json:"largest_entries"`
+}
+
+// StoreObjectStats is the statistics of the objects under a key prefix
+type StoreObjectStats struct {
+	Prefix         string `json:"prefix"`
+	Name           string `json:"name"`
+	Count          uint64 `json:"count"`
+	TotalKeySize   uint64 `json:"total_key_size"`
+	TotalValueSize uint64 `json:"total_value_size"`
+	AvgValueSize   uint64 `json:"avg_value_size"`
+	MaxValueSize   uint64 `json:"max_value_size"`
+}
+
+// StoreEntryStats is the size of a single store entry
+type StoreEntryStats struct {
+	Name      string `json:"name"`
+	Key       string `json:"key"`
+	KeySize   uint64 `json:"key_size"`
+	ValueSize uint64 `json:"value_size"`
+}
+
+func (e *StoreEntryStats) size() uint64 {
+	return e.KeySize + e.ValueSize
+}
+
+func BTCStakingStoreStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btcstaking-store-stats",
+		Args:  cobra.NoArgs,
+		Short: "Report the size of the objects in the btcstaking store of a node",
+		Long: strings.TrimSpace(`btcstaking-store-stats scans the btcstaking store in the application
+database of a stopped node and reports, for each object type, the number of
+objects, their total and average encoded size, and the largest entries of the
+store. The report is meant to guide pruning and compaction work.
+
+The node must not be running, since the application database is locked by the
+node while it is running.
+
+Example:
+$ babylond btcstaking-store-stats --home ./node0 --top 20
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			height, _ := cmd.Flags().GetInt64(flagHeight)
+			topN, _ := cmd.Flags().GetInt(flagTop)
+			if topN < 0 {
+				return fmt.Errorf("--%s must not be negative", flagTop)
+			}
+
+			dataDir := filepath.Join(serverCtx.Config.RootDir, "data")
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), dataDir)
+			if err != nil {
+				return fmt.Errorf("failed to open application database in %s: %w", dataDir, err)
+			}
+			defer db.Close()
+
+			storeKey := storetypes.NewKVStoreKey(bstypes.StoreKey)
+			cms := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+			cms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+			if height > 0 {
+				err = cms.LoadVersion(height)
+			} else {
+				err = cms.LoadLatestVersion()
+			}
+			if err != nil {
+				return fmt.Errorf("failed to load application state: %w", err)
+			}
+
+			stats := ComputeStoreStats(cms.GetKVStore(storeKey), btcStakingStorePrefixNames, topN)
+			stats.Height = cms.LastCommitID().Version
+
+			bz, err := json.MarshalIndent(stats, "", "  ")
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return err
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "The height of the state to scan. The latest height is used if not set")
+	cmd.Flags().Int(flagTop, 10, "The number of largest entries to report")
+
+	return cmd
+}
+
+// ComputeStoreStats scans the given KV store and computes the statistics of the
+// objects in it, grouped by the first byte of the keys. prefixNames gives the
+// object name of each known key prefix, and topN is the number of largest
+// entries to report.
+func ComputeStoreStats(kvStore storetypes.KVStore, prefixNames map[byte]string, topN int) *StoreStats {
+	stats := &StoreStats{
+		Objects:        []*StoreObjectStats{},
+		LargestEntries: []*StoreEntryStats{},
+	}
+	objects := map[byte]*StoreObjectStats{}
+
+	iter := kvStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key, value := iter.Key(), iter.Value()
+		if len(key) == 0 {
+			continue
+		}
+
+		prefix := key[0]
+		obj, ok := objects[prefix]
+		if !ok {
+			name, known := prefixNames[prefix]
+			if !known {
+				name = "unknown"
+			}
+			obj = &StoreObjectStats{
+				Prefix: hex.EncodeToString([]byte{prefix}),
+				Name:   name,
+			}
+			objects[prefix] = obj
+		}
+
+		keySize, valueSize := uint64(len(key)), uint64(len(value))
+		obj.Count++
+		obj.TotalKeySize += keySize
+		obj.TotalValueSize += valueSize
+		if valueSize > obj.MaxValueSize {
+			obj.MaxValueSize = valueSize
+		}
+		stats.NumEntries++
+		stats.TotalSize += keySize + valueSize
+
+		stats.LargestEntries = insertLargestEntry(stats.LargestEntries, &StoreEntryStats{
+			Name:      obj.Name,
+			Key:       hex.EncodeToString(key),
+			KeySize:   keySize,
+			ValueSize: valueSize,
+		}, topN)
+	}
+
+	for _, obj := range objects {
+		obj.AvgValueSize = obj.TotalValueSize / obj.Count
+		stats.Objects = append(stats.Objects, obj)
+	}
+	sort.Slice(stats.Objects, func(i, j int) bool {
+		return stats.Objects[i].Prefix < stats.Objects[j].Prefix
+	})
+
+	return stats
+}
+
+// insertLargestEntry inserts the given entry to the given list of entries
+// sorted by size in descending order, keeping at most topN entries
+func insertLargestEntry(entries []*StoreEntryStats, entry *StoreEntryStats, topN int) []*StoreEntryStats {
+	if topN == 0 {
+		return entries
+	}
+	if len(entries) == topN && entries[topN-1].size() >= entry.size() {
+		return entries
+	}
+	idx := sort.Search(len(entries), func(i int) bool {
+		return entries[i].size() < entry.size()
+	})
+	entries = append(entries, nil)
+	copy(entries[idx+1:], entries[idx:])
+	entries[idx] = entry
+	if len(entries) > topN {
+		entries = entries[:topN]
+	}
+	return entries
+}
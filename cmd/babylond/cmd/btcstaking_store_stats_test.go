@@ -0,0 +1,83 @@
+package cmd_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/store/dbadapter"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	bbncmd "github.com/babylonchain/babylon/cmd/babylond/cmd"
+	"github.com/babylonchain/babylon/testutil/datagen"
+)
+
+func FuzzComputeStoreStats(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		kvStore := dbadapter.Store{DB: dbm.NewMemDB()}
+		prefixNames := map[byte]string{0x01: "foo", 0x02: "bar"}
+
+		// write random entries under known and unknown prefixes
+		counts := map[byte]uint64{}
+		valueSizes := map[byte]uint64{}
+		maxSize := uint64(0)
+		numEntries := datagen.RandomInt(r, 100) + 1
+		for i := uint64(0); i < numEntries; i++ {
+			prefix := byte(datagen.RandomInt(r, 3) + 1)
+			key := append([]byte{prefix}, datagen.GenRandomByteArray(r, 8)...)
+			if kvStore.Has(key) {
+				continue
+			}
+			value := datagen.GenRandomByteArray(r, datagen.RandomInt(r, 200)+1)
+			kvStore.Set(key, value)
+			counts[prefix]++
+			valueSizes[prefix] += uint64(len(value))
+			if size := uint64(len(key) + len(value)); size > maxSize {
+				maxSize = size
+			}
+		}
+
+		topN := int(datagen.RandomInt(r, 10))
+		stats := bbncmd.ComputeStoreStats(kvStore, prefixNames, topN)
+
+		require.Len(t, stats.Objects, len(counts))
+		totalCount := uint64(0)
+		for _, obj := range stats.Objects {
+			require.Len(t, obj.Prefix, 2)
+			var prefix byte
+			switch obj.Prefix {
+			case "01":
+				prefix = 0x01
+				require.Equal(t, "foo", obj.Name)
+			case "02":
+				prefix = 0x02
+				require.Equal(t, "bar", obj.Name)
+			case "03":
+				prefix = 0x03
+				require.Equal(t, "unknown", obj.Name)
+			default:
+				t.Fatalf("unexpected prefix %s", obj.Prefix)
+			}
+			require.Equal(t, counts[prefix], obj.Count)
+			require.Equal(t, valueSizes[prefix], obj.TotalValueSize)
+			require.Equal(t, valueSizes[prefix]/counts[prefix], obj.AvgValueSize)
+			totalCount += obj.Count
+		}
+		require.Equal(t, totalCount, stats.NumEntries)
+
+		// the largest entries are sorted in descending order of size
+		require.LessOrEqual(t, len(stats.LargestEntries), topN)
+		require.Equal(t, min(topN, int(stats.NumEntries)), len(stats.LargestEntries))
+		for i, e := range stats.LargestEntries {
+			if i == 0 {
+				require.Equal(t, maxSize, e.KeySize+e.ValueSize)
+				continue
+			}
+			prev := stats.LargestEntries[i-1]
+			require.GreaterOrEqual(t, prev.KeySize+prev.ValueSize, e.KeySize+e.ValueSize)
+		}
+	})
+}
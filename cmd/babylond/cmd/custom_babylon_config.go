@@ -5,6 +5,9 @@ import (
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 
 	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/sigverifier"
+	"github.com/babylonchain/babylon/x/btcstaking/slasher"
+	"github.com/babylonchain/babylon/x/btcstaking/tracing"
 )
 
 type BtcConfig struct {
@@ -23,6 +26,12 @@ type BabylonAppConfig struct {
 	Wasm wasmtypes.WasmConfig `mapstructure:"wasm"`
 
 	BtcConfig BtcConfig `mapstructure:"btc-config"`
+
+	SigVerifierConfig sigverifier.Config `mapstructure:"sig-verifier"`
+
+	TracingConfig tracing.Config `mapstructure:"tracing"`
+
+	SlasherConfig slasher.Config `mapstructure:"slasher"`
 }
 
 func DefaultBabylonConfig() *BabylonAppConfig {
@@ -30,6 +39,10 @@ func DefaultBabylonConfig() *BabylonAppConfig {
 		Config:    *serverconfig.DefaultConfig(),
 		Wasm:      wasmtypes.DefaultWasmConfig(),
 		BtcConfig: defaultBabylonBtcConfig(),
+
+		SigVerifierConfig: sigverifier.DefaultConfig(),
+		TracingConfig:     tracing.DefaultConfig(),
+		SlasherConfig:     slasher.DefaultConfig(),
 	}
 }
 
@@ -44,5 +57,5 @@ func DefaultBabylonTemplate() string {
 # Configures which bitcoin network should be used for checkpointing
 # valid values are: [mainnet, testnet, simnet, signet, regtest]
 network = "{{ .BtcConfig.Network }}"
-`
+` + sigverifier.DefaultConfigTemplate + tracing.DefaultConfigTemplate + slasher.DefaultConfigTemplate
 }
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/spf13/cobra"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	bbn "github.com/babylonchain/babylon/types"
+)
+
+const (
+	flagAccount        = "account"
+	flagIndex          = "index"
+	flagShowPrivateKey = "show-private-key"
+)
+
+// DerivedBTCStakingKey is the output of the derive-btc-staking-key command
+type DerivedBTCStakingKey struct {
+	DerivationPath string `json:"derivation_path"`
+	BtcPkHex       string `json:"btc_pk_hex"`
+	Address        string `json:"address"`
+	WIF            string `json:"wif,omitempty"`
+}
+
+func DeriveBTCStakingKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "derive-btc-staking-key",
+		Args:  cobra.NoArgs,
+		Short: "Derive a BTC staking key from a BIP39 mnemonic",
+		Long: strings.TrimSpace(`derive-btc-staking-key derives a BTC staking key from a BIP39 mnemonic
+read from stdin, following the BIP86 derivation path
+
+  m/86'/coin_type'/account'/0/index
+
+where coin_type is 0 for mainnet and 1 for all other networks. The staking
+key is the internal key of the BIP86 address at the same path, so a staker can
+restore the keys of their staking positions from the mnemonic of a BIP86
+wallet instead of keeping loose WIFs.
+
+The command outputs the derivation path, the BIP340 public key to be used as
+the staker or finality provider BTC PK, and the BIP86 address. The private key
+is only printed in WIF with --show-private-key.
+
+Example:
+$ babylond derive-btc-staking-key --btc-network signet --account 0 --index 0
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			network, _ := cmd.Flags().GetString(flagBtcNetwork)
+			account, _ := cmd.Flags().GetUint32(flagAccount)
+			index, _ := cmd.Flags().GetUint32(flagIndex)
+			showPrivateKey, _ := cmd.Flags().GetBool(flagShowPrivateKey)
+
+			net, err := bbn.GetBtcNetworkParams(network)
+			if err != nil {
+				return err
+			}
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			mnemonic, err := input.GetString("Enter your bip39 mnemonic", inBuf)
+			if err != nil {
+				return err
+			}
+			passphrase, err := input.GetString("Enter your bip39 passphrase (or press enter for none)", inBuf)
+			if err != nil {
+				return err
+			}
+
+			sk, err := btcstaking.DeriveStakingKeyFromMnemonic(mnemonic, passphrase, net, account, index)
+			if err != nil {
+				return err
+			}
+			addr, err := btcstaking.BIP86Address(sk.PubKey(), net)
+			if err != nil {
+				return err
+			}
+
+			out := DerivedBTCStakingKey{
+				DerivationPath: btcstaking.FormatDerivationPath(btcstaking.StakingKeyDerivationPath(net, account, index)),
+				BtcPkHex:       hex.EncodeToString(schnorr.SerializePubKey(sk.PubKey())),
+				Address:        addr.EncodeAddress(),
+			}
+			if showPrivateKey {
+				wif, err := btcutil.NewWIF(sk, net, true)
+				if err != nil {
+					return err
+				}
+				out.WIF = wif.String()
+			}
+
+			bz, err := json.MarshalIndent(out, "", "  ")
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return err
+		},
+	}
+
+	cmd.Flags().String(flagBtcNetwork, string(bbn.BtcSimnet), "Bitcoin network to use. Available networks: simnet, testnet, regtest, mainnet, signet")
+	cmd.Flags().Uint32(flagAccount, 0, "The account number of the derivation path")
+	cmd.Flags().Uint32(flagIndex, 0, "The address index of the derivation path")
+	cmd.Flags().Bool(flagShowPrivateKey, false, "Output the private key in WIF")
+
+	return cmd
+}
@@ -25,6 +25,7 @@ const (
 	flagBaseBtcHeaderHex           = "btc-base-header"
 	flagBaseBtcHeaderHeight        = "btc-base-header-height"
 	flagAllowedReporterAddresses   = "allowed-reporter-addresses"
+	flagMockBtcReporter            = "mock-btc-reporter"
 	flagInflationRateChange        = "inflation-rate-change"
 	flagInflationMax               = "inflation-max"
 	flagInflationMin               = "inflation-min"
@@ -54,6 +55,7 @@ type GenesisCLIArgs struct {
 	BaseBtcHeaderHex             string
 	BaseBtcHeaderHeight          uint64
 	AllowedReporterAddresses     []string
+	MockBtcReporter              string
 	InflationRateChange          float64
 	InflationMax                 float64
 	InflationMin                 float64
@@ -88,6 +90,7 @@ func addGenesisFlags(cmd *cobra.Command) {
 	cmd.Flags().String(flagBaseBtcHeaderHex, "0100000000000000000000000000000000000000000000000000000000000000000000003ba3edfd7a7b12b27ac72c3e67768f617fc81bc3888a51323a9fb8aa4b1e5e4a45068653ffff7f2002000000", "Hex of the base Bitcoin header.")
 	cmd.Flags().String(flagAllowedReporterAddresses, strings.Join(btcltypes.DefaultParams().InsertHeadersAllowList, ","), "addresses of reporters allowed to submit Bitcoin headers to babylon")
 	cmd.Flags().Uint64(flagBaseBtcHeaderHeight, 0, "Height of the base Bitcoin header.")
+	cmd.Flags().String(flagMockBtcReporter, "", "address of the reporter allowed to submit synthetic Bitcoin headers without proof of work, for devnets without a real Bitcoin network")
 	// btcstaking args
 	cmd.Flags().String(flagCovenantPks, strings.Join(btcstypes.DefaultParams().CovenantPksHex(), ","), "Bitcoin staking covenant public keys, comma separated")
 	cmd.Flags().Uint32(flagCovenantQuorum, btcstypes.DefaultParams().CovenantQuorum, "Bitcoin staking covenant quorum")
@@ -121,6 +124,7 @@ func parseGenesisFlags(cmd *cobra.Command) *GenesisCLIArgs {
 	baseBtcHeaderHex, _ := cmd.Flags().GetString(flagBaseBtcHeaderHex)
 	baseBtcHeaderHeight, _ := cmd.Flags().GetUint64(flagBaseBtcHeaderHeight)
 	reporterAddresses, _ := cmd.Flags().GetString(flagAllowedReporterAddresses)
+	mockBtcReporter, _ := cmd.Flags().GetString(flagMockBtcReporter)
 	covenantPks, _ := cmd.Flags().GetString(flagCovenantPks)
 	covenantQuorum, _ := cmd.Flags().GetUint32(flagCovenantQuorum)
 	slashingAddress, _ := cmd.Flags().GetString(flagSlashingAddress)
@@ -160,6 +164,7 @@ func parseGenesisFlags(cmd *cobra.Command) *GenesisCLIArgs {
 		BaseBtcHeaderHeight:          baseBtcHeaderHeight,
 		BaseBtcHeaderHex:             baseBtcHeaderHex,
 		AllowedReporterAddresses:     allowedReporterAddresses,
+		MockBtcReporter:              mockBtcReporter,
 		CovenantPKs:                  strings.Split(covenantPks, ","),
 		CovenantQuorum:               covenantQuorum,
 		SlashingAddress:              slashingAddress,
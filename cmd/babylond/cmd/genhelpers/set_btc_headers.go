@@ -1,6 +1,8 @@
 package genhelpers
 
 import (
+	"bufio"
+	"encoding/json"
 	"fmt"
 	"os"
 
@@ -16,6 +18,13 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	flagFormat = "format"
+
+	formatBinary = "binary"
+	formatJSON   = "json"
+)
+
 // CmdSetBtcHeaders CLI sets bitcoin headers into the genesis state.
 func CmdSetBtcHeaders() *cobra.Command {
 	cmd := &cobra.Command{
@@ -24,6 +33,10 @@ func CmdSetBtcHeaders() *cobra.Command {
 		Long: `Reads BTC Headers structures from the given json file and update the genesis.json file
 in place to include the btc headers in the btcstaking module's genesis state.
 Duplicated BTC headers are not allowed and it will prompt an error.
+
+With --format binary, the given file is a binary export of BTC headers produced
+by 'babylond query btclightclient export-headers --format binary', whose
+manifest is read from <path>.manifest.json.
 `,
 		Example: `babylond gen-helpers set-btc-headers path/to/btc_headers.json
 Possible content of 'btc_headers.json' is
@@ -50,7 +63,17 @@ Possible content of 'btc_headers.json' is
 			config := server.GetServerContextFromCmd(cmd).Config
 			config.SetRoot(clientCtx.HomeDir)
 
-			inputBtcHeaders, err := getBtcLightGenStateFromFile(clientCtx.Codec, args[0])
+			format, _ := cmd.Flags().GetString(flagFormat)
+			var inputBtcHeaders *btclighttypes.GenesisState
+			var err error
+			switch format {
+			case formatJSON:
+				inputBtcHeaders, err = getBtcLightGenStateFromFile(clientCtx.Codec, args[0])
+			case formatBinary:
+				inputBtcHeaders, err = getBtcLightGenStateFromExport(args[0])
+			default:
+				err = fmt.Errorf("unsupported format %s, expected %s or %s", format, formatJSON, formatBinary)
+			}
 			if err != nil {
 				return err
 			}
@@ -99,6 +122,8 @@ Possible content of 'btc_headers.json' is
 		},
 	}
 
+	cmd.Flags().String(flagFormat, formatJSON, "The format of the given file (json|binary)")
+
 	return cmd
 }
 
@@ -120,3 +145,33 @@ func getBtcLightGenStateFromFile(cdc codec.Codec, inputFilePath string) (*btclig
 
 	return &genState, nil
 }
+
+func getBtcLightGenStateFromExport(exportPath string) (*btclighttypes.GenesisState, error) {
+	manifestPath := btclighttypes.HeadersExportManifestPath(exportPath)
+	if !cmtos.FileExists(manifestPath) {
+		return nil, fmt.Errorf("manifest file %s does not exists", manifestPath)
+	}
+
+	bz, err := os.ReadFile(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest btclighttypes.HeadersExportManifest
+	if err := json.Unmarshal(bz, &manifest); err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(exportPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	headers, err := btclighttypes.ReadHeadersExport(bufio.NewReader(f), &manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	return &btclighttypes.GenesisState{BtcHeaders: headers}, nil
+}
@@ -189,6 +189,9 @@ func initRootCmd(rootCmd *cobra.Command, txConfig client.TxEncodingConfig, basic
 		TestnetCmd(basicManager, banktypes.GenesisBalancesIterator{}),
 		genhelpers.CmdGenHelpers(gentxModule.GenTxValidator),
 		CreateBlsKeyCmd(),
+		SigVerifierWorkerCmd(),
+		DeriveBTCStakingKeyCmd(),
+		BTCStakingStoreStatsCmd(),
 		debug.Cmd(),
 		confixcmd.ConfigCommand(),
 	)
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"cosmossdk.io/log"
+	"github.com/spf13/cobra"
+
+	"github.com/babylonchain/babylon/x/btcstaking/sigverifier"
+)
+
+const (
+	flagSocket     = "socket"
+	flagNumWorkers = "num-workers"
+)
+
+func SigVerifierWorkerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sig-verifier-worker",
+		Args:  cobra.NoArgs,
+		Short: "Start a worker verifying covenant signatures on behalf of a node",
+		Long: strings.TrimSpace(`sig-verifier-worker starts a process that listens on a unix socket
+and verifies batches of covenant signatures sent by a babylond node in CheckTx,
+so that the crypto load of admitting txs to the mempool is isolated from the
+node. Blocks are always executed with in-process verification.
+
+The node uses the worker once offloading is enabled and its socket is listed
+in the [sig-verifier] section of app.toml. The worker has to run the same babylond binary as the
+node, otherwise the node ignores it and verifies signatures in-process.
+
+Example:
+$ babylond sig-verifier-worker --socket /tmp/babylon-sig-verifier-0.sock --num-workers 8
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			socketPath, _ := cmd.Flags().GetString(flagSocket)
+			numWorkers, _ := cmd.Flags().GetInt(flagNumWorkers)
+			if socketPath == "" {
+				return fmt.Errorf("--%s is required", flagSocket)
+			}
+
+			logger := log.NewLogger(cmd.OutOrStdout())
+			server, err := sigverifier.NewServer(socketPath, numWorkers, logger)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			logger.Info("signature verification worker started", "socket", socketPath)
+			return server.Serve(ctx)
+		},
+	}
+
+	cmd.Flags().String(flagSocket, "", "The unix socket path to listen on")
+	cmd.Flags().Int(flagNumWorkers, 0, "The number of signatures verified in parallel (default: number of CPUs)")
+
+	return cmd
+}
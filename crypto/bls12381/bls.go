@@ -54,9 +54,23 @@ func Sign(sk PrivateKey, msg []byte) Signature {
 
 // Verify verifies a BLS sig over msg with a BLS public key
 // the sig and public key are all compressed
+// the public key is checked to be in the G2 subgroup upon its first use
 func Verify(sig Signature, pk PublicKey, msg []byte) (bool, error) {
-	dummySig := new(BlsSig)
-	return dummySig.VerifyCompressed(sig, false, pk, false, msg, DST), nil
+	pkAffine, err := getVerifiedPubKey(pk)
+	if err != nil {
+		return false, nil
+	}
+	return verifyWithPubKey(sig, pkAffine, msg), nil
+}
+
+// verifyWithPubKey verifies a compressed BLS sig over msg with a
+// decompressed BLS public key that has passed the subgroup check
+func verifyWithPubKey(sig Signature, pk *BlsPubKey, msg []byte) bool {
+	blsSig := new(BlsSig).Uncompress(sig)
+	if blsSig == nil {
+		return false
+	}
+	return blsSig.Verify(false, pk, false, msg, DST)
 }
 
 // AggrSig aggregates BLS signatures in an accumulative manner
@@ -105,10 +119,13 @@ func AggrPKList(pks []PublicKey) (PublicKey, error) {
 
 // VerifyMultiSig verifies a BLS sig (compressed) over a message with
 // a group of BLS public keys (compressed)
+// the public keys are checked to be in the G2 subgroup upon their first use,
+// and their aggregate is cached, so that verifying a multi-sig from a known
+// group of signers does not decompress or check each of their public keys
 func VerifyMultiSig(sig Signature, pks []PublicKey, msg []byte) (bool, error) {
-	aggPk, err := AggrPKList(pks)
+	aggPk, err := aggrVerifiedPKList(pks)
 	if err != nil {
 		return false, err
 	}
-	return Verify(sig, aggPk, msg)
+	return verifyWithPubKey(sig, aggPk, msg), nil
 }
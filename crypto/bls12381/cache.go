@@ -0,0 +1,145 @@
+package bls12381
+
+import (
+	"crypto/sha256"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxVerifiedPubKeys is the maximum number of cached verified public
+	// keys. It is well above the size of any validator set, so that the keys
+	// of the current validator set are not evicted in practice.
+	maxVerifiedPubKeys = 10000
+	// maxAggrPubKeys is the maximum number of cached aggregate public keys.
+	// The aggregate public key of a checkpoint's signers is verified multiple
+	// times, e.g., upon each submission of the checkpoint to BTC.
+	maxAggrPubKeys = 100
+)
+
+var (
+	// verifiedPubKeys caches the decompressed form of public keys that have
+	// passed the subgroup check, keyed by their compressed form
+	verifiedPubKeys = newPubKeyCache(maxVerifiedPubKeys)
+	// aggrPubKeys caches aggregate public keys of verified public keys, keyed
+	// by the hash of the list of the aggregated public keys
+	aggrPubKeys = newPubKeyCache(maxAggrPubKeys)
+)
+
+// pubKeyCache is a concurrency-safe map from byte strings to decompressed
+// public keys with a bounded size
+type pubKeyCache struct {
+	mu      sync.RWMutex
+	maxSize int
+	keys    map[string]*BlsPubKey
+}
+
+func newPubKeyCache(maxSize int) *pubKeyCache {
+	return &pubKeyCache{
+		maxSize: maxSize,
+		keys:    make(map[string]*BlsPubKey),
+	}
+}
+
+func (c *pubKeyCache) get(key []byte) (*BlsPubKey, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	pk, ok := c.keys[string(key)]
+	return pk, ok
+}
+
+func (c *pubKeyCache) add(key []byte, pk *BlsPubKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.keys[string(key)]; !ok && len(c.keys) >= c.maxSize {
+		// evict an arbitrary entry. The cache only saves computation, thus
+		// which entry is evicted does not affect correctness.
+		for k := range c.keys {
+			delete(c.keys, k)
+			break
+		}
+	}
+	c.keys[string(key)] = pk
+}
+
+func (c *pubKeyCache) len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.keys)
+}
+
+func (c *pubKeyCache) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.keys = make(map[string]*BlsPubKey)
+}
+
+// ValidatePubKey checks that the given compressed public key is a valid
+// point in the G2 subgroup other than the identity. Keys that pass the check
+// are cached, so that the subgroup check is not repeated for them.
+func ValidatePubKey(pk PublicKey) error {
+	_, err := getVerifiedPubKey(pk)
+	return err
+}
+
+// getVerifiedPubKey returns the decompressed form of the given public key,
+// which is checked to be in the G2 subgroup upon its first use
+func getVerifiedPubKey(pk PublicKey) (*BlsPubKey, error) {
+	if p, ok := verifiedPubKeys.get(pk); ok {
+		return p, nil
+	}
+	if len(pk) != PubKeySize {
+		return nil, errors.New("invalid BLS public key length")
+	}
+	p := new(BlsPubKey).Uncompress(pk)
+	if p == nil {
+		return nil, errors.New("failed to decompress BLS public key")
+	}
+	if !p.KeyValidate() {
+		return nil, errors.New("BLS public key is not in the G2 subgroup or is the identity")
+	}
+	verifiedPubKeys.add(pk, p)
+	return p, nil
+}
+
+// aggrVerifiedPKList aggregates the given public keys, each of which is
+// checked to be in the G2 subgroup upon its first use. The aggregate public
+// key is cached, so that verifying multiple signatures from the same signers
+// aggregates their public keys only once.
+func aggrVerifiedPKList(pks []PublicKey) (*BlsPubKey, error) {
+	if len(pks) == 0 {
+		return nil, errors.New("empty list of bls public keys")
+	}
+
+	// the public keys are of fixed size, thus their concatenation uniquely
+	// identifies the list
+	hasher := sha256.New()
+	for _, pk := range pks {
+		if len(pk) != PubKeySize {
+			return nil, errors.New("invalid BLS public key length")
+		}
+		hasher.Write(pk)
+	}
+	aggrKey := hasher.Sum(nil)
+	if aggPk, ok := aggrPubKeys.get(aggrKey); ok {
+		return aggPk, nil
+	}
+
+	points := make([]*BlsPubKey, len(pks))
+	for i, pk := range pks {
+		p, err := getVerifiedPubKey(pk)
+		if err != nil {
+			return nil, err
+		}
+		points[i] = p
+	}
+	// the public keys are already checked to be in the subgroup
+	aggPk := new(BlsMultiPubKey)
+	if !aggPk.Aggregate(points, false) {
+		return nil, errors.New("failed to aggregate bls public keys")
+	}
+	aggPkAffine := aggPk.ToAffine()
+	aggrPubKeys.add(aggrKey, aggPkAffine)
+	return aggPkAffine, nil
+}
@@ -0,0 +1,96 @@
+package bls12381
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifiedPubKeyCache(t *testing.T) {
+	verifiedPubKeys.reset()
+	aggrPubKeys.reset()
+
+	// valid public keys are cached upon verification
+	_, pk := GenKeyPair()
+	require.NoError(t, ValidatePubKey(pk))
+	_, ok := verifiedPubKeys.get(pk)
+	require.True(t, ok)
+
+	// invalid public keys are rejected and not cached
+	invalidPK := make(PublicKey, PubKeySize)
+	_, err := rand.Read(invalidPK)
+	require.NoError(t, err)
+	require.Error(t, ValidatePubKey(invalidPK))
+	_, ok = verifiedPubKeys.get(invalidPK)
+	require.False(t, ok)
+	require.Error(t, ValidatePubKey(pk[1:]))
+
+	// multi-sig verification with an invalid public key fails
+	msg := []byte("aaaaaaaa")
+	n := 10
+	sks, pks := generateBatchTestKeyPairs(n)
+	sigs := make([]Signature, n)
+	for i := 0; i < n; i++ {
+		sigs[i] = Sign(sks[i], msg)
+	}
+	multiSig, err := AggrSigList(sigs)
+	require.NoError(t, err)
+	_, err = VerifyMultiSig(multiSig, append(pks, invalidPK), msg)
+	require.Error(t, err)
+	_, err = VerifyMultiSig(multiSig, nil, msg)
+	require.Error(t, err)
+
+	// the aggregate public key is cached upon multi-sig verification, and
+	// yields the same result as the uncached aggregation
+	res, err := VerifyMultiSig(multiSig, pks, msg)
+	require.NoError(t, err)
+	require.True(t, res)
+	require.Equal(t, 1, aggrPubKeys.len())
+	res, err = VerifyMultiSig(multiSig, pks, msg)
+	require.NoError(t, err)
+	require.True(t, res)
+	aggPK, err := AggrPKList(pks)
+	require.NoError(t, err)
+	cachedAggPK, err := aggrVerifiedPKList(pks)
+	require.NoError(t, err)
+	require.Equal(t, []byte(aggPK), cachedAggPK.Compress())
+
+	// the cache size is bounded
+	cache := newPubKeyCache(2)
+	for i := 0; i < 3; i++ {
+		cache.add([]byte{byte(i)}, new(BlsPubKey))
+	}
+	require.Equal(t, 2, cache.len())
+	_, ok = cache.get([]byte{2})
+	require.True(t, ok)
+}
+
+func benchmarkVerifyMultiSig(b *testing.B, n int, cached bool) {
+	msg := []byte("aaaaaaaa")
+	sks, pks := generateBatchTestKeyPairs(n)
+	sigs := make([]Signature, n)
+	for i := 0; i < n; i++ {
+		sigs[i] = Sign(sks[i], msg)
+	}
+	multiSig, err := AggrSigList(sigs)
+	require.NoError(b, err)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !cached {
+			b.StopTimer()
+			verifiedPubKeys.reset()
+			aggrPubKeys.reset()
+			b.StartTimer()
+		}
+		res, err := VerifyMultiSig(multiSig, pks, msg)
+		require.NoError(b, err)
+		require.True(b, res)
+	}
+}
+
+func BenchmarkVerifyMultiSig_100_Uncached(b *testing.B) { benchmarkVerifyMultiSig(b, 100, false) }
+func BenchmarkVerifyMultiSig_100_Cached(b *testing.B)   { benchmarkVerifyMultiSig(b, 100, true) }
+func BenchmarkVerifyMultiSig_500_Uncached(b *testing.B) { benchmarkVerifyMultiSig(b, 500, false) }
+func BenchmarkVerifyMultiSig_500_Cached(b *testing.B)   { benchmarkVerifyMultiSig(b, 500, true) }
@@ -3,4 +3,7 @@ package bls12381
 /*
 This package contains a wrapper around blst's go binding: https://github.com/supranational/blst/blob/master/bindings/go/blst.go.
 This package employs minimal signature size by default and can be changed to minimal public key in types.go.
+Public keys are checked to be in the G2 subgroup upon their first use in verification, and the decompressed form of
+the verified public keys as well as the aggregate public keys of multi-sigs are cached (see cache.go), so that verifying
+checkpoints of a mostly unchanged validator set does not repeat the decompression and subgroup check of each key.
 */
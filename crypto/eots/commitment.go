@@ -0,0 +1,39 @@
+package eots
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+)
+
+// CommitPubRandList commits to the given list of public randomness via a
+// Merkle tree, where each leaf is a public randomness serialized by
+// PublicRandToBytes. It returns the Merkle root, i.e., the commitment, and
+// the inclusion proof of each public randomness. The commitment is the one
+// that finality providers commit on the Babylon chain in advance.
+func CommitPubRandList(pubRandList []*PublicRand) ([]byte, []*merkle.Proof) {
+	prBytesList := make([][]byte, 0, len(pubRandList))
+	for _, pr := range pubRandList {
+		prBytesList = append(prBytesList, PublicRandToBytes(pr))
+	}
+	return merkle.ProofsFromByteSlices(prBytesList)
+}
+
+// VerifyPubRandInclusion verifies that the given public randomness is the
+// index-th out of total public randomness committed by the given commitment,
+// with the given inclusion proof
+func VerifyPubRandInclusion(commitment []byte, total uint64, index uint64, pubRand *PublicRand, proof *merkle.Proof) error {
+	if proof == nil {
+		return fmt.Errorf("empty inclusion proof")
+	}
+	if proof.Total != int64(total) {
+		return fmt.Errorf("the inclusion proof has %d leaves, expected %d", proof.Total, total)
+	}
+	if proof.Index != int64(index) {
+		return fmt.Errorf("the inclusion proof is for index %d, expected %d", proof.Index, index)
+	}
+	if err := proof.Verify(commitment, PublicRandToBytes(pubRand)); err != nil {
+		return fmt.Errorf("invalid inclusion proof: %w", err)
+	}
+	return nil
+}
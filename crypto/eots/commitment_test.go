@@ -0,0 +1,56 @@
+package eots_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/babylonchain/babylon/crypto/eots"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzPubRandCommitment(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		// derive a list of public randomness from the master randomness
+		msr, mpr, err := eots.NewMasterRandPair(r)
+		require.NoError(t, err)
+		startHeight := uint32(datagen.RandomInt(r, 10000))
+		numPubRand := uint32(datagen.RandomInt(r, 100) + 1)
+		srList, prList, err := msr.DeriveRandPairList(startHeight, numPubRand)
+		require.NoError(t, err)
+		require.Len(t, srList, int(numPubRand))
+		prList2, err := mpr.DerivePubRandList(startHeight, numPubRand)
+		require.NoError(t, err)
+		require.Equal(t, prList, prList2)
+
+		// commit to the list of public randomness
+		commitment, proofs := eots.CommitPubRandList(prList)
+		require.Len(t, proofs, int(numPubRand))
+
+		// each public randomness is included at its index
+		for i := range prList {
+			err := eots.VerifyPubRandInclusion(commitment, uint64(numPubRand), uint64(i), prList[i], proofs[i])
+			require.NoError(t, err)
+		}
+
+		// a public randomness is not included at another index
+		idx := datagen.RandomInt(r, int(numPubRand))
+		if numPubRand > 1 {
+			otherIdx := (idx + 1) % uint64(numPubRand)
+			err = eots.VerifyPubRandInclusion(commitment, uint64(numPubRand), otherIdx, prList[idx], proofs[idx])
+			require.Error(t, err)
+			err = eots.VerifyPubRandInclusion(commitment, uint64(numPubRand), idx, prList[otherIdx], proofs[idx])
+			require.Error(t, err)
+		}
+
+		// randomness that is not committed is not included
+		_, otherPR, err := eots.RandGen(r)
+		require.NoError(t, err)
+		err = eots.VerifyPubRandInclusion(commitment, uint64(numPubRand), idx, otherPR, proofs[idx])
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,145 @@
+package eots
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+// The serialization formats below are stable across versions, so that EOTS
+// keys, randomness and signatures produced by one version of this library
+// can be consumed by any other version, as well as by the Babylon chain.
+const (
+	// PrivateKeySize is the size of a serialized private key, i.e., the
+	// 32-byte big-endian encoding of the secret scalar
+	PrivateKeySize = 32
+	// PublicKeySize is the size of a serialized public key, i.e., the 32-byte
+	// x-only encoding specified in BIP-340
+	PublicKeySize = schnorr.PubKeyBytesLen
+	// PrivateRandSize is the size of a serialized private randomness, i.e.,
+	// the 32-byte big-endian encoding of the secret nonce
+	PrivateRandSize = 32
+	// PublicRandSize is the size of a serialized public randomness, i.e., the
+	// 32-byte big-endian encoding of the x coordinate of the nonce point
+	PublicRandSize = 32
+	// SignatureSize is the size of a serialized EOTS signature, i.e., the
+	// 32-byte big-endian encoding of the s part of a BIP-340 signature
+	SignatureSize = 32
+)
+
+// PrivateKeyToBytes serializes the given private key
+func PrivateKeyToBytes(sk *PrivateKey) []byte {
+	return sk.Serialize()
+}
+
+// NewPrivateKeyFromBytes parses a private key serialized by PrivateKeyToBytes
+func NewPrivateKeyFromBytes(b []byte) (*PrivateKey, error) {
+	if len(b) != PrivateKeySize {
+		return nil, fmt.Errorf("private key must be %d bytes, got %d", PrivateKeySize, len(b))
+	}
+	var k ModNScalar
+	if overflow := k.SetByteSlice(b); overflow {
+		return nil, fmt.Errorf("private key is not less than the group order")
+	}
+	if k.IsZero() {
+		return nil, fmt.Errorf("private key is zero")
+	}
+	return secp256k1.NewPrivateKey(&k), nil
+}
+
+// PublicKeyToBytes serializes the given public key
+func PublicKeyToBytes(pk *PublicKey) []byte {
+	return schnorr.SerializePubKey(pk)
+}
+
+// NewPublicKeyFromBytes parses a public key serialized by PublicKeyToBytes
+func NewPublicKeyFromBytes(b []byte) (*PublicKey, error) {
+	return schnorr.ParsePubKey(b)
+}
+
+// PrivateRandToBytes serializes the given private randomness
+func PrivateRandToBytes(sr *PrivateRand) []byte {
+	b := sr.Bytes()
+	return b[:]
+}
+
+// NewPrivateRandFromBytes parses a private randomness serialized by
+// PrivateRandToBytes
+func NewPrivateRandFromBytes(b []byte) (*PrivateRand, error) {
+	if len(b) != PrivateRandSize {
+		return nil, fmt.Errorf("private randomness must be %d bytes, got %d", PrivateRandSize, len(b))
+	}
+	var sr PrivateRand
+	if overflow := sr.SetByteSlice(b); overflow {
+		return nil, fmt.Errorf("private randomness is not less than the group order")
+	}
+	if sr.IsZero() {
+		return nil, fmt.Errorf("private randomness is zero")
+	}
+	return &sr, nil
+}
+
+// PublicRandToBytes serializes the given public randomness
+func PublicRandToBytes(pr *PublicRand) []byte {
+	// normalize a copy so that the encoding is canonical
+	var normalized PublicRand
+	normalized.Set(pr).Normalize()
+	b := normalized.Bytes()
+	return b[:]
+}
+
+// NewPublicRandFromBytes parses a public randomness serialized by
+// PublicRandToBytes
+func NewPublicRandFromBytes(b []byte) (*PublicRand, error) {
+	if len(b) != PublicRandSize {
+		return nil, fmt.Errorf("public randomness must be %d bytes, got %d", PublicRandSize, len(b))
+	}
+	var pr PublicRand
+	if overflow := pr.SetByteSlice(b); overflow {
+		return nil, fmt.Errorf("public randomness is not less than the field prime")
+	}
+	return &pr, nil
+}
+
+// SignatureToBytes serializes the given EOTS signature
+func SignatureToBytes(sig *Signature) []byte {
+	b := sig.Bytes()
+	return b[:]
+}
+
+// NewSignatureFromBytes parses an EOTS signature serialized by
+// SignatureToBytes
+func NewSignatureFromBytes(b []byte) (*Signature, error) {
+	if len(b) != SignatureSize {
+		return nil, fmt.Errorf("signature must be %d bytes, got %d", SignatureSize, len(b))
+	}
+	var sig Signature
+	if overflow := sig.SetByteSlice(b); overflow {
+		return nil, fmt.Errorf("signature is not less than the group order")
+	}
+	return &sig, nil
+}
+
+// ExtractFromBytes extracts the private key from a serialized public key,
+// public randomness, and two serialized signatures under this public
+// randomness on two distinct messages, e.g., the evidence of an equivocation
+func ExtractFromBytes(pkBytes []byte, prBytes []byte, message1 []byte, sig1Bytes []byte, message2 []byte, sig2Bytes []byte) (*PrivateKey, error) {
+	pk, err := NewPublicKeyFromBytes(pkBytes)
+	if err != nil {
+		return nil, err
+	}
+	pr, err := NewPublicRandFromBytes(prBytes)
+	if err != nil {
+		return nil, err
+	}
+	sig1, err := NewSignatureFromBytes(sig1Bytes)
+	if err != nil {
+		return nil, err
+	}
+	sig2, err := NewSignatureFromBytes(sig2Bytes)
+	if err != nil {
+		return nil, err
+	}
+	return Extract(pk, pr, message1, sig1, message2, sig2)
+}
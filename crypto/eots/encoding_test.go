@@ -0,0 +1,106 @@
+package eots_test
+
+import (
+	"encoding/hex"
+	"math/rand"
+	"testing"
+
+	"github.com/babylonchain/babylon/crypto/eots"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzEncoding(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		// EOTS key pair and randomness pair
+		sk, err := eots.KeyGen(r)
+		require.NoError(t, err)
+		pk := eots.PubGen(sk)
+		sr, pr, err := eots.RandGen(r)
+		require.NoError(t, err)
+
+		// two signatures under the same randomness
+		msg1 := datagen.GenRandomByteArray(r, 100)
+		msg2 := datagen.GenRandomByteArray(r, 100)
+		sig1, err := eots.Sign(sk, sr, msg1)
+		require.NoError(t, err)
+		sig2, err := eots.Sign(sk, sr, msg2)
+		require.NoError(t, err)
+
+		// roundtrip of serializing/parsing each value
+		skBytes := eots.PrivateKeyToBytes(sk)
+		require.Len(t, skBytes, eots.PrivateKeySize)
+		sk2, err := eots.NewPrivateKeyFromBytes(skBytes)
+		require.NoError(t, err)
+		require.Equal(t, sk.Serialize(), sk2.Serialize())
+
+		pkBytes := eots.PublicKeyToBytes(pk)
+		require.Len(t, pkBytes, eots.PublicKeySize)
+		pk2, err := eots.NewPublicKeyFromBytes(pkBytes)
+		require.NoError(t, err)
+		require.Equal(t, pkBytes, eots.PublicKeyToBytes(pk2))
+
+		srBytes := eots.PrivateRandToBytes(sr)
+		require.Len(t, srBytes, eots.PrivateRandSize)
+		sr2, err := eots.NewPrivateRandFromBytes(srBytes)
+		require.NoError(t, err)
+		require.True(t, sr.Equals(sr2))
+
+		prBytes := eots.PublicRandToBytes(pr)
+		require.Len(t, prBytes, eots.PublicRandSize)
+		pr2, err := eots.NewPublicRandFromBytes(prBytes)
+		require.NoError(t, err)
+		require.True(t, pr.Equals(pr2))
+
+		sig1Bytes := eots.SignatureToBytes(sig1)
+		require.Len(t, sig1Bytes, eots.SignatureSize)
+		sig1Parsed, err := eots.NewSignatureFromBytes(sig1Bytes)
+		require.NoError(t, err)
+		require.True(t, sig1.Equals(sig1Parsed))
+		require.NoError(t, eots.Verify(pk2, pr2, msg1, sig1Parsed))
+
+		// values of wrong sizes are rejected
+		_, err = eots.NewSignatureFromBytes(sig1Bytes[1:])
+		require.Error(t, err)
+		_, err = eots.NewPublicRandFromBytes(append(prBytes, 0))
+		require.Error(t, err)
+
+		// the private key is extracted from the serialized signatures
+		extractedSK, err := eots.ExtractFromBytes(pkBytes, prBytes, msg1, sig1Bytes, msg2, eots.SignatureToBytes(sig2))
+		require.NoError(t, err)
+		require.Equal(t, pkBytes, eots.PublicKeyToBytes(extractedSK.PubKey()))
+	})
+}
+
+func TestEncodingFormat(t *testing.T) {
+	// the serialization formats are big-endian and fixed-size, and must not
+	// change across versions
+	one := make([]byte, 32)
+	one[31] = 1
+
+	var sig eots.Signature
+	sig.SetInt(1)
+	require.Equal(t, one, eots.SignatureToBytes(&sig))
+
+	var pr eots.PublicRand
+	pr.SetInt(1)
+	require.Equal(t, one, eots.PublicRandToBytes(&pr))
+
+	var sr eots.PrivateRand
+	sr.SetInt(1)
+	require.Equal(t, one, eots.PrivateRandToBytes(&sr))
+
+	// the public key of private key 1 is the generator point, whose BIP-340
+	// encoding is its x coordinate
+	sk, err := eots.NewPrivateKeyFromBytes(one)
+	require.NoError(t, err)
+	require.Equal(t, one, eots.PrivateKeyToBytes(sk))
+	require.Equal(t,
+		"79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+		hex.EncodeToString(eots.PublicKeyToBytes(eots.PubGen(sk))),
+	)
+}
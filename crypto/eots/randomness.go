@@ -89,7 +89,9 @@ func NewMasterSecretRand(b []byte) (*MasterSecretRand, error) {
 	return NewMasterSecretRandFromBase58(string(b))
 }
 
-func (msr *MasterSecretRand) MasterPubicRand() (*MasterPublicRand, error) {
+// MasterPublicRand returns the master public randomness corresponding to the
+// master secret randomness
+func (msr *MasterSecretRand) MasterPublicRand() (*MasterPublicRand, error) {
 	masterPK, err := msr.k.Neuter()
 	if err != nil {
 		return nil, err
@@ -97,6 +99,15 @@ func (msr *MasterSecretRand) MasterPubicRand() (*MasterPublicRand, error) {
 	return &MasterPublicRand{masterPK}, nil
 }
 
+// MasterPubicRand returns the master public randomness corresponding to the
+// master secret randomness
+//
+// Deprecated: use MasterPublicRand instead. MasterPubicRand is kept for
+// compatibility with existing callers for at least one release.
+func (msr *MasterSecretRand) MasterPubicRand() (*MasterPublicRand, error) {
+	return msr.MasterPublicRand()
+}
+
 // TODO: extend to support uint64
 func (msr *MasterSecretRand) DeriveRandPair(height uint32) (*PrivateRand, *PublicRand, error) {
 	// get child SK, then child SK in BTC format, and finally private randomness
@@ -119,6 +130,25 @@ func (msr *MasterSecretRand) DeriveRandPair(height uint32) (*PrivateRand, *Publi
 	return privRand, pubRand, nil
 }
 
+// DeriveRandPairList derives the pairs of private and public randomness for
+// the num consecutive heights starting from startHeight
+func (msr *MasterSecretRand) DeriveRandPairList(startHeight uint32, num uint32) ([]*PrivateRand, []*PublicRand, error) {
+	if uint64(startHeight)+uint64(num) > uint64(hdkeychain.HardenedKeyStart) {
+		return nil, nil, fmt.Errorf("heights [%d, %d+%d) exceed the maximum derivable height %d", startHeight, startHeight, num, hdkeychain.HardenedKeyStart-1)
+	}
+	srList := make([]*PrivateRand, 0, num)
+	prList := make([]*PublicRand, 0, num)
+	for i := uint32(0); i < num; i++ {
+		sr, pr, err := msr.DeriveRandPair(startHeight + i)
+		if err != nil {
+			return nil, nil, err
+		}
+		srList = append(srList, sr)
+		prList = append(prList, pr)
+	}
+	return srList, prList, nil
+}
+
 func (msr *MasterSecretRand) MarshalBase58() string {
 	return msr.k.String()
 }
@@ -164,6 +194,23 @@ func (mpr *MasterPublicRand) DerivePubRand(height uint32) (*PublicRand, error) {
 	return pubRand, nil
 }
 
+// DerivePubRandList derives the public randomness for the num consecutive
+// heights starting from startHeight
+func (mpr *MasterPublicRand) DerivePubRandList(startHeight uint32, num uint32) ([]*PublicRand, error) {
+	if uint64(startHeight)+uint64(num) > uint64(hdkeychain.HardenedKeyStart) {
+		return nil, fmt.Errorf("heights [%d, %d+%d) exceed the maximum derivable height %d", startHeight, startHeight, num, hdkeychain.HardenedKeyStart-1)
+	}
+	prList := make([]*PublicRand, 0, num)
+	for i := uint32(0); i < num; i++ {
+		pr, err := mpr.DerivePubRand(startHeight + i)
+		if err != nil {
+			return nil, err
+		}
+		prList = append(prList, pr)
+	}
+	return prList, nil
+}
+
 func (mpr *MasterPublicRand) MarshalBase58() string {
 	return mpr.k.String()
 }
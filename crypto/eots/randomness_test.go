@@ -65,10 +65,15 @@ func FuzzBIP32RandomnessDerivation(f *testing.F) {
 		require.NoError(t, mpr.Validate())
 
 		// ensure msr can derive mpr
-		mpr2, err := msr.MasterPubicRand()
+		mpr2, err := msr.MasterPublicRand()
 		require.NoError(t, err)
 		require.NoError(t, mpr2.Validate())
 		require.Equal(t, mpr, mpr2)
+		// the deprecated accessor keeps deriving the same master public
+		// randomness for existing callers
+		mpr3, err := msr.MasterPubicRand() //nolint:staticcheck
+		require.NoError(t, err)
+		require.Equal(t, mpr, mpr3)
 
 		height := uint32(datagen.RandomInt(r, 10000))
 
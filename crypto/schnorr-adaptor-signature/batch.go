@@ -0,0 +1,226 @@
+package schnorr_adaptor_signature
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// batchCoefficientSize is the size of the random coefficients in batch
+// verification. 128-bit coefficients make the probability that an invalid
+// batch passes verification negligible, while halving the length of the
+// scalar multiplications they are involved in.
+const batchCoefficientSize = 16
+
+// tagBatchCoefficient is the tag of the hash that derives the coefficients in
+// batch verification
+var tagBatchCoefficient = []byte("Babylon/AdaptorSigBatch")
+
+// VerifyBatch verifies that each adaptor signature is valid w.r.t. the public
+// key, the encryption key and the message hash at the same index. It returns
+// nil iff all adaptor signatures are valid, i.e., iff EncVerify returns nil
+// for each of them.
+//
+// Rather than checking s'_i*G - e_i*P_i = R'_i for each adaptor signature,
+// it checks the random linear combination
+//
+//	(sum a_i*s'_i)*G - sum (sum_{j: P_j=P} a_j*e_j)*P - sum a_i*R'_i = 0
+//
+// where a_0 = 1 and a_i are 128-bit coefficients derived from the hash of the
+// whole batch, so that the outcome is deterministic. This takes a single
+// scalar base multiplication, one scalar multiplication per distinct public
+// key, and one half-length scalar multiplication per adaptor signature, in
+// contrast to two scalar multiplications per adaptor signature. For adaptor
+// signatures from the same signer, e.g., a covenant member signing a
+// slashing tx for each finality provider, this is roughly half of the
+// scalar multiplications.
+//
+// If the batch fails verification, each adaptor signature is verified
+// individually, and the error of the first invalid one is returned.
+func VerifyBatch(sigs []*AdaptorSignature, msgHashes [][]byte, pks []*btcec.PublicKey, encKeys []*EncryptionKey) error {
+	n := len(sigs)
+	if len(msgHashes) != n || len(pks) != n || len(encKeys) != n {
+		return fmt.Errorf("mismatched batch sizes: %d signatures, %d message hashes, %d public keys, %d encryption keys",
+			n, len(msgHashes), len(pks), len(encKeys))
+	}
+	if n <= 1 {
+		return verifyEach(sigs, msgHashes, pks, encKeys)
+	}
+
+	// the batch hash commits to all inputs of the batch
+	batchHasher := sha256.New()
+
+	var (
+		// sSum = sum a_i*s'_i
+		sSum btcec.ModNScalar
+		// coefficients of each distinct public key, i.e., sum a_j*e_j
+		pkPoints []btcec.JacobianPoint
+		pkCoeffs []btcec.ModNScalar
+		pkIdxs   = map[string]int{}
+		// R'_i with even y, e_i, and the index of P_i in the distinct
+		// public keys
+		rHats      = make([]btcec.JacobianPoint, n)
+		es         = make([]btcec.ModNScalar, n)
+		itemPkIdxs = make([]int, n)
+	)
+	for i := 0; i < n; i++ {
+		rHat, e, pubKey, ok := prepareBatchItem(sigs[i], msgHashes[i], pks[i], encKeys[i])
+		if !ok {
+			// the adaptor signature is malformed, which is reported by
+			// the individual verification
+			return verifyEach(sigs, msgHashes, pks, encKeys)
+		}
+		rHats[i] = *rHat
+		es[i] = *e
+		pkBytes := schnorr.SerializePubKey(pubKey)
+
+		batchHasher.Write(sigs[i].MustMarshal())
+		batchHasher.Write(msgHashes[i])
+		batchHasher.Write(pkBytes)
+		batchHasher.Write(encKeys[i].ToBytes())
+
+		pkIdx, ok := pkIdxs[string(pkBytes)]
+		if !ok {
+			var P btcec.JacobianPoint
+			pubKey.AsJacobian(&P)
+			pkIdx = len(pkPoints)
+			pkIdxs[string(pkBytes)] = pkIdx
+			pkPoints = append(pkPoints, P)
+			pkCoeffs = append(pkCoeffs, btcec.ModNScalar{})
+		}
+		itemPkIdxs[i] = pkIdx
+	}
+	batchHash := batchHasher.Sum(nil)
+
+	// accumulate sum a_i*s'_i, sum a_j*e_j for each public key, and -sum a_i*R'_i
+	var rSum btcec.JacobianPoint
+	for i := 0; i < n; i++ {
+		a := batchCoefficient(batchHash, i)
+
+		var aS btcec.ModNScalar
+		aS.Mul2(&a, &sigs[i].sHat)
+		sSum.Add(&aS)
+
+		var aE btcec.ModNScalar
+		aE.Mul2(&a, &es[i])
+		pkCoeffs[itemPkIdxs[i]].Add(&aE)
+
+		var aR btcec.JacobianPoint
+		btcec.ScalarMultNonConst(&a, negatePoint(&rHats[i]), &aR)
+		addPoint(&rSum, &aR)
+	}
+
+	// sum a_i*s'_i*G - sum (sum a_j*e_j)*P - sum a_i*R'_i
+	var result btcec.JacobianPoint
+	btcec.ScalarBaseMultNonConst(&sSum, &result)
+	for k := range pkPoints {
+		var ePk btcec.JacobianPoint
+		coeff := pkCoeffs[k]
+		coeff.Negate()
+		btcec.ScalarMultNonConst(&coeff, &pkPoints[k], &ePk)
+		addPoint(&result, &ePk)
+	}
+	addPoint(&result, &rSum)
+
+	if isInfinity(&result) {
+		return nil
+	}
+
+	// find the invalid adaptor signature
+	if err := verifyEach(sigs, msgHashes, pks, encKeys); err != nil {
+		return err
+	}
+	return fmt.Errorf("batch verification of adaptor signatures failed")
+}
+
+// prepareBatchItem returns, for the given adaptor signature, the point R' with
+// even y that s'*G - e*P is expected to equal to, the challenge e, and the
+// public key P with even y. It returns false if the adaptor signature is
+// malformed, which is left to the individual verification.
+func prepareBatchItem(sig *AdaptorSignature, m []byte, pk *btcec.PublicKey, encKey *EncryptionKey) (*btcec.JacobianPoint, *btcec.ModNScalar, *btcec.PublicKey, bool) {
+	if sig == nil || pk == nil || encKey == nil || len(m) != chainhash.HashSize {
+		return nil, nil, nil, false
+	}
+
+	// R' = R-T (or R+T if it needs negation)
+	R := &sig.r
+	T := &encKey.JacobianPoint
+	var RHat btcec.JacobianPoint
+	if sig.needNegation {
+		btcec.AddNonConst(R, T, &RHat)
+	} else {
+		btcec.AddNonConst(R, negatePoint(T), &RHat)
+	}
+	if isInfinity(&RHat) {
+		return nil, nil, nil, false
+	}
+	RHat.ToAffine()
+	// the individual verification only compares the x coordinates of R' and
+	// the expected R' with even y, thus R' is lifted to the point with even y
+	if RHat.Y.IsOdd() {
+		RHat = *negatePoint(&RHat)
+	}
+
+	// P = lift_x(int(pk))
+	pkBytes := schnorr.SerializePubKey(pk)
+	pubKey, err := schnorr.ParsePubKey(pkBytes)
+	if err != nil || !pubKey.IsOnCurve() {
+		return nil, nil, nil, false
+	}
+
+	// e = int(tagged_hash("BIP0340/challenge", bytes(R) || bytes(P) || M)) mod n.
+	var rBytes [chainhash.HashSize]byte
+	R.X.PutBytesUnchecked(rBytes[:])
+	commitment := chainhash.TaggedHash(
+		chainhash.TagBIP0340Challenge, rBytes[:], pkBytes, m,
+	)
+	var e btcec.ModNScalar
+	e.SetBytes((*[ModNScalarSize]byte)(commitment))
+
+	return &RHat, &e, pubKey, true
+}
+
+// batchCoefficient returns the coefficient of the i-th adaptor signature in
+// the batch with the given hash. The first coefficient is 1.
+func batchCoefficient(batchHash []byte, i int) btcec.ModNScalar {
+	var a btcec.ModNScalar
+	if i == 0 {
+		a.SetInt(1)
+		return a
+	}
+	var idx [8]byte
+	binary.BigEndian.PutUint64(idx[:], uint64(i))
+	h := chainhash.TaggedHash(tagBatchCoefficient, batchHash, idx[:])
+	a.SetByteSlice(h[:batchCoefficientSize])
+	return a
+}
+
+// verifyEach verifies the given adaptor signatures one by one, and returns
+// the error of the first invalid one
+func verifyEach(sigs []*AdaptorSignature, msgHashes [][]byte, pks []*btcec.PublicKey, encKeys []*EncryptionKey) error {
+	for i := range sigs {
+		if sigs[i] == nil || pks[i] == nil || encKeys[i] == nil {
+			return fmt.Errorf("invalid adaptor signature at index %d: nil input", i)
+		}
+		if err := sigs[i].EncVerify(pks[i], encKeys[i], msgHashes[i]); err != nil {
+			return fmt.Errorf("invalid adaptor signature at index %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// addPoint adds the given point to the accumulator
+func addPoint(acc *btcec.JacobianPoint, p *btcec.JacobianPoint) {
+	var sum btcec.JacobianPoint
+	btcec.AddNonConst(acc, p, &sum)
+	*acc = sum
+}
+
+// isInfinity returns whether the given point is the point at infinity
+func isInfinity(p *btcec.JacobianPoint) bool {
+	return (p.X.IsZero() && p.Y.IsZero()) || p.Z.IsZero()
+}
@@ -0,0 +1,70 @@
+package schnorr_adaptor_signature_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/stretchr/testify/require"
+
+	asig "github.com/babylonchain/babylon/crypto/schnorr-adaptor-signature"
+)
+
+func FuzzVerifyBatch(f *testing.F) {
+	// random seeds
+	f.Add([]byte("hello"), uint8(1))
+	f.Add([]byte("1234567890!@#$%^&*()"), uint8(2))
+	f.Add([]byte("1234567891!@#$%^&*()"), uint8(5))
+	f.Add([]byte("1234567892!@#$%^&*()"), uint8(10))
+	f.Add([]byte("1234567893!@#$%^&*()"), uint8(20))
+
+	f.Fuzz(func(t *testing.T, msg []byte, batchSize uint8) {
+		n := int(batchSize)%20 + 1
+
+		// a signer signing for each encryption key, as well as other signers
+		sk, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		sigs := make([]*asig.AdaptorSignature, n)
+		msgHashes := make([][]byte, n)
+		pks := make([]*btcec.PublicKey, n)
+		encKeys := make([]*asig.EncryptionKey, n)
+		for i := 0; i < n; i++ {
+			signerSK := sk
+			if i%3 == 2 {
+				signerSK, err = btcec.NewPrivateKey()
+				require.NoError(t, err)
+			}
+			encKey, _, err := asig.GenKeyPair()
+			require.NoError(t, err)
+			msgHash := chainhash.HashB(append(msg, byte(i)))
+
+			sigs[i], err = asig.EncSign(signerSK, encKey, msgHash)
+			require.NoError(t, err)
+			msgHashes[i] = msgHash
+			pks[i] = signerSK.PubKey()
+			encKeys[i] = encKey
+		}
+
+		// the batch of valid adaptor signatures passes verification
+		require.NoError(t, asig.VerifyBatch(sigs, msgHashes, pks, encKeys))
+
+		// the batch fails verification if any adaptor signature is invalid
+		invalidIdx := int(batchSize) % n
+		otherEncKey, _, err := asig.GenKeyPair()
+		require.NoError(t, err)
+		validEncKey := encKeys[invalidIdx]
+		encKeys[invalidIdx] = otherEncKey
+		err = asig.VerifyBatch(sigs, msgHashes, pks, encKeys)
+		require.Error(t, err)
+		require.ErrorContains(t, err, "index")
+		require.Error(t, sigs[invalidIdx].EncVerify(pks[invalidIdx], otherEncKey, msgHashes[invalidIdx]))
+
+		encKeys[invalidIdx] = validEncKey
+		msgHashes[invalidIdx] = chainhash.HashB(msgHashes[invalidIdx])
+		require.Error(t, asig.VerifyBatch(sigs, msgHashes, pks, encKeys))
+
+		// the batch sizes have to match
+		require.Error(t, asig.VerifyBatch(sigs, msgHashes[1:], pks, encKeys))
+	})
+}
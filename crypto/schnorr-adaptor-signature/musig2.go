@@ -0,0 +1,225 @@
+package schnorr_adaptor_signature
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// maxMuSig2NonceOffset is the maximum public offset added to the combined
+// nonce of a MuSig2 adaptor signing session. Each offset works with
+// probability 1/2, so the limit is never reached in practice.
+const maxMuSig2NonceOffset = 256
+
+// muSig2AdaptorSession is a MuSig2 signing session producing an adaptor
+// signature encrypted by an encryption key.
+//
+// The signers run MuSig2 over the adjusted combined nonce (R_1+T+j*G, R_2),
+// where (R_1, R_2) is the combined nonce of the signers, T is the encryption
+// key and j is a public offset. The final nonce R = R_1+T+j*G+b*R_2 commits to
+// T, while the partial signatures only contain the signers' secret nonces, so
+// that the sum of the partial signatures plus j is the s' of an adaptor
+// signature with R' = R-T. The offset j is the smallest one for which R' has
+// an even y coordinate after the negation of R, which the adaptor signature
+// format requires, and which a single signer achieves by retrying nonces in
+// EncSign.
+type muSig2AdaptorSession struct {
+	adjustedNonce [musig2.PubNonceSize]byte
+	offset        btcec.ModNScalar
+	// r is the final nonce with an even y coordinate
+	r btcec.JacobianPoint
+	// needNegation is whether the final nonce is negated to have an even y
+	// coordinate
+	needNegation bool
+}
+
+func newMuSig2AdaptorSession(
+	combinedNonce [musig2.PubNonceSize]byte,
+	aggKey *btcec.PublicKey,
+	encKey *EncryptionKey,
+	msgHash [chainhash.HashSize]byte,
+) (*muSig2AdaptorSession, error) {
+	r1, err := btcec.ParseJacobian(combinedNonce[:btcec.PubKeyBytesLenCompressed])
+	if err != nil {
+		return nil, fmt.Errorf("invalid combined nonce: %w", err)
+	}
+	r2, err := btcec.ParseJacobian(combinedNonce[btcec.PubKeyBytesLenCompressed:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid combined nonce: %w", err)
+	}
+
+	// R_1+T
+	var r1WithEncKey btcec.JacobianPoint
+	btcec.AddNonConst(&r1, &encKey.JacobianPoint, &r1WithEncKey)
+
+	var offsetG btcec.JacobianPoint
+	for j := uint32(0); j < maxMuSig2NonceOffset; j++ {
+		var s muSig2AdaptorSession
+		s.offset.SetInt(j)
+
+		// R_1+T+j*G
+		var adjustedR1 btcec.JacobianPoint
+		btcec.ScalarBaseMultNonConst(&s.offset, &offsetG)
+		btcec.AddNonConst(&r1WithEncKey, &offsetG, &adjustedR1)
+		if isInfinity(&adjustedR1) {
+			continue
+		}
+		adjustedR1.ToAffine()
+		copy(s.adjustedNonce[:], btcec.NewPublicKey(&adjustedR1.X, &adjustedR1.Y).SerializeCompressed())
+		copy(s.adjustedNonce[btcec.PubKeyBytesLenCompressed:], combinedNonce[btcec.PubKeyBytesLenCompressed:])
+
+		// b = H(tag=NonceBlindTag, adjusted nonce || Q || m)
+		var nonceMsgBuf bytes.Buffer
+		nonceMsgBuf.Write(s.adjustedNonce[:])
+		nonceMsgBuf.Write(schnorr.SerializePubKey(aggKey))
+		nonceMsgBuf.Write(msgHash[:])
+		var b btcec.ModNScalar
+		b.SetByteSlice(chainhash.TaggedHash(musig2.NonceBlindTag, nonceMsgBuf.Bytes())[:])
+
+		// R = R_1+T+j*G+b*R_2
+		var bR2, r btcec.JacobianPoint
+		btcec.ScalarMultNonConst(&b, &r2, &bR2)
+		btcec.AddNonConst(&adjustedR1, &bR2, &r)
+		if isInfinity(&r) {
+			// MuSig2 signs with the generator then, which does not
+			// commit to the encryption key
+			continue
+		}
+		evenR, needNegation := intoPointWithEvenY(&r)
+
+		// R' = R-T if R is not negated, or -(R-T) = -R+T otherwise, which
+		// has to have an even y coordinate
+		var rHat btcec.JacobianPoint
+		if needNegation {
+			btcec.AddNonConst(evenR, &encKey.JacobianPoint, &rHat)
+		} else {
+			btcec.AddNonConst(evenR, negatePoint(&encKey.JacobianPoint), &rHat)
+		}
+		if isInfinity(&rHat) {
+			continue
+		}
+		rHat.ToAffine()
+		if rHat.Y.IsOdd() {
+			continue
+		}
+
+		s.r.Set(evenR)
+		s.needNegation = needNegation
+		return &s, nil
+	}
+
+	return nil, fmt.Errorf("no nonce offset for the MuSig2 adaptor signature within %d tries", maxMuSig2NonceOffset)
+}
+
+// MuSig2EncSign generates the partial signature of a MuSig2 signer towards an
+// adaptor signature under the aggregate key of the given keys, encrypted by
+// the given encryption key. The keys are aggregated in the given order, and
+// the combined nonce is the aggregation of all signers' public nonces. The
+// secret nonce must not be used in any other signing session.
+func MuSig2EncSign(
+	secNonce [musig2.SecNonceSize]byte,
+	sk *btcec.PrivateKey,
+	combinedNonce [musig2.PubNonceSize]byte,
+	keys []*btcec.PublicKey,
+	encKey *EncryptionKey,
+	msgHash []byte,
+) (*musig2.PartialSignature, error) {
+	msg, err := toMsgHash(msgHash)
+	if err != nil {
+		return nil, err
+	}
+	aggKey, _, _, err := musig2.AggregateKeys(keys, false)
+	if err != nil {
+		return nil, err
+	}
+	session, err := newMuSig2AdaptorSession(combinedNonce, aggKey.FinalKey, encKey, msg)
+	if err != nil {
+		return nil, err
+	}
+	return musig2.Sign(secNonce, sk, session.adjustedNonce, keys, msg)
+}
+
+// MuSig2EncVerifyPartial verifies the partial signature of a MuSig2 signer
+// with the given public key and public nonce towards an adaptor signature
+// encrypted by the given encryption key
+func MuSig2EncVerifyPartial(
+	partialSig *musig2.PartialSignature,
+	pubNonce [musig2.PubNonceSize]byte,
+	combinedNonce [musig2.PubNonceSize]byte,
+	keys []*btcec.PublicKey,
+	signerKey *btcec.PublicKey,
+	encKey *EncryptionKey,
+	msgHash []byte,
+) error {
+	msg, err := toMsgHash(msgHash)
+	if err != nil {
+		return err
+	}
+	aggKey, _, _, err := musig2.AggregateKeys(keys, false)
+	if err != nil {
+		return err
+	}
+	session, err := newMuSig2AdaptorSession(combinedNonce, aggKey.FinalKey, encKey, msg)
+	if err != nil {
+		return err
+	}
+	if !partialSig.Verify(pubNonce, session.adjustedNonce, keys, signerKey, msg) {
+		return fmt.Errorf("invalid partial signature of %x", schnorr.SerializePubKey(signerKey))
+	}
+	return nil
+}
+
+// MuSig2CombineEncSigs combines the partial signatures of all MuSig2 signers
+// of a session into an adaptor signature under the aggregate key of the given
+// keys, encrypted by the given encryption key
+func MuSig2CombineEncSigs(
+	combinedNonce [musig2.PubNonceSize]byte,
+	keys []*btcec.PublicKey,
+	encKey *EncryptionKey,
+	msgHash []byte,
+	partialSigs []*musig2.PartialSignature,
+) (*AdaptorSignature, error) {
+	msg, err := toMsgHash(msgHash)
+	if err != nil {
+		return nil, err
+	}
+	aggKey, _, _, err := musig2.AggregateKeys(keys, false)
+	if err != nil {
+		return nil, err
+	}
+	session, err := newMuSig2AdaptorSession(combinedNonce, aggKey.FinalKey, encKey, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// s' = sum of partial signatures + j, or - j if R is negated, since the
+	// signers negate their secret nonces but not the offset
+	var sHat btcec.ModNScalar
+	for _, partialSig := range partialSigs {
+		sHat.Add(partialSig.S)
+	}
+	offset := session.offset
+	if session.needNegation {
+		offset.Negate()
+	}
+	sHat.Add(&offset)
+
+	sig := newAdaptorSignature(&session.r, &sHat, session.needNegation)
+	if err := encVerify(sig, msg[:], schnorr.SerializePubKey(aggKey.FinalKey), &encKey.JacobianPoint); err != nil {
+		return nil, fmt.Errorf("invalid combined adaptor signature: %w", err)
+	}
+	return sig, nil
+}
+
+func toMsgHash(msgHash []byte) ([chainhash.HashSize]byte, error) {
+	var msg [chainhash.HashSize]byte
+	if len(msgHash) != chainhash.HashSize {
+		return msg, fmt.Errorf("wrong size for message hash (got %v, want %v)", len(msgHash), chainhash.HashSize)
+	}
+	copy(msg[:], msgHash)
+	return msg, nil
+}
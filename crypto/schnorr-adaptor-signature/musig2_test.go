@@ -0,0 +1,194 @@
+package schnorr_adaptor_signature_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/stretchr/testify/require"
+
+	asig "github.com/babylonchain/babylon/crypto/schnorr-adaptor-signature"
+)
+
+func FuzzMuSig2EncSign(f *testing.F) {
+	// random seeds
+	f.Add([]byte("hello"), uint8(1))
+	f.Add([]byte("1234567890!@#$%^&*()"), uint8(2))
+	f.Add([]byte("1234567891!@#$%^&*()"), uint8(3))
+	f.Add([]byte("1234567892!@#$%^&*()"), uint8(5))
+	f.Add([]byte("1234567893!@#$%^&*()"), uint8(9))
+
+	f.Fuzz(func(t *testing.T, msg []byte, numSigners uint8) {
+		n := int(numSigners%9) + 1
+
+		// signers and their aggregate key
+		sks := make([]*btcec.PrivateKey, n)
+		keys := make([]*btcec.PublicKey, n)
+		for i := range sks {
+			sk, err := btcec.NewPrivateKey()
+			require.NoError(t, err)
+			sks[i] = sk
+			keys[i] = sk.PubKey()
+		}
+		aggKey, _, _, err := musig2.AggregateKeys(keys, false)
+		require.NoError(t, err)
+
+		// encryption/decryption key pair
+		encKey, decKey, err := asig.GenKeyPair()
+		require.NoError(t, err)
+
+		// message hash
+		msgHash := chainhash.HashB(msg)
+
+		// signers exchange their public nonces
+		nonces := make([]*musig2.Nonces, n)
+		pubNonces := make([][musig2.PubNonceSize]byte, n)
+		for i, sk := range sks {
+			nonces[i], err = musig2.GenNonces(musig2.WithPublicKey(sk.PubKey()))
+			require.NoError(t, err)
+			pubNonces[i] = nonces[i].PubNonce
+		}
+		combinedNonce, err := musig2.AggregateNonces(pubNonces)
+		require.NoError(t, err)
+
+		// each signer produces a verifiable partial signature
+		partialSigs := make([]*musig2.PartialSignature, n)
+		for i, sk := range sks {
+			partialSigs[i], err = asig.MuSig2EncSign(nonces[i].SecNonce, sk, combinedNonce, keys, encKey, msgHash)
+			require.NoError(t, err)
+			err = asig.MuSig2EncVerifyPartial(partialSigs[i], pubNonces[i], combinedNonce, keys, keys[i], encKey, msgHash)
+			require.NoError(t, err)
+			// the partial signature is not valid for another signer
+			err = asig.MuSig2EncVerifyPartial(partialSigs[i], pubNonces[i], combinedNonce, keys, keys[(i+1)%n], encKey, msgHash)
+			if n > 1 {
+				require.Error(t, err)
+			}
+		}
+
+		// the partial signatures combine into an adaptor signature under the
+		// aggregate key
+		adaptorSig, err := asig.MuSig2CombineEncSigs(combinedNonce, keys, encKey, msgHash, partialSigs)
+		require.NoError(t, err)
+		err = adaptorSig.EncVerify(aggKey.FinalKey, encKey, msgHash)
+		require.NoError(t, err)
+		// the adaptor signature survives serialization
+		adaptorSig2, err := asig.NewAdaptorSignatureFromBytes(adaptorSig.MustMarshal())
+		require.NoError(t, err)
+		err = adaptorSig2.EncVerify(aggKey.FinalKey, encKey, msgHash)
+		require.NoError(t, err)
+
+		// decrypting it gives a Schnorr signature under the aggregate key,
+		// from which the decryption key is recovered
+		schnorrSig := adaptorSig.Decrypt(decKey)
+		require.True(t, schnorrSig.Verify(msgHash, aggKey.FinalKey))
+		require.True(t, adaptorSig.Recover(schnorrSig).Equals(&decKey.ModNScalar))
+
+		// missing partial signatures do not combine
+		if n > 1 {
+			_, err = asig.MuSig2CombineEncSigs(combinedNonce, keys, encKey, msgHash, partialSigs[1:])
+			require.Error(t, err)
+		}
+	})
+}
+
+// muSig2Vector is a test vector of testdata/musig2_vectors.json, which is
+// generated by testdata/gen_musig2_vectors.py independently of btcd
+type muSig2Vector struct {
+	Comment          string   `json:"comment"`
+	SKs              []string `json:"sks"`
+	PKs              []string `json:"pks"`
+	SecNonces        []string `json:"sec_nonces"`
+	PubNonces        []string `json:"pub_nonces"`
+	AggNonce         string   `json:"agg_nonce"`
+	EncKey           string   `json:"enc_key"`
+	DecKey           string   `json:"dec_key"`
+	Msg              string   `json:"msg"`
+	AggPK            string   `json:"agg_pk"`
+	NonceOffset      int      `json:"nonce_offset"`
+	AdjustedAggNonce string   `json:"adjusted_agg_nonce"`
+	PartialSigs      []string `json:"partial_sigs"`
+	AdaptorSig       string   `json:"adaptor_sig"`
+	Sig              string   `json:"sig"`
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	bz, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestMuSig2EncSignVectors(t *testing.T) {
+	bz, err := os.ReadFile("testdata/musig2_vectors.json")
+	require.NoError(t, err)
+	var vectors struct {
+		Valid []muSig2Vector `json:"valid"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &vectors))
+	require.NotEmpty(t, vectors.Valid)
+
+	for _, v := range vectors.Valid {
+		t.Run(v.Comment, func(t *testing.T) {
+			sks := make([]*btcec.PrivateKey, len(v.SKs))
+			keys := make([]*btcec.PublicKey, len(v.SKs))
+			for i := range v.SKs {
+				sks[i], keys[i] = btcec.PrivKeyFromBytes(mustDecodeHex(t, v.SKs[i]))
+				require.Equal(t, v.PKs[i], hex.EncodeToString(keys[i].SerializeCompressed()))
+			}
+			aggKey, _, _, err := musig2.AggregateKeys(keys, false)
+			require.NoError(t, err)
+			require.Equal(t, v.AggPK, hex.EncodeToString(schnorr.SerializePubKey(aggKey.FinalKey)))
+
+			encKey, err := asig.NewEncryptionKeyFromBytes(mustDecodeHex(t, v.EncKey))
+			require.NoError(t, err)
+			decKey, err := asig.NewDecyptionKeyFromBytes(mustDecodeHex(t, v.DecKey))
+			require.NoError(t, err)
+			require.Equal(t, encKey.ToBytes(), decKey.GetEncKey().ToBytes())
+			msgHash := mustDecodeHex(t, v.Msg)
+
+			pubNonces := make([][musig2.PubNonceSize]byte, len(v.PubNonces))
+			for i := range v.PubNonces {
+				copy(pubNonces[i][:], mustDecodeHex(t, v.PubNonces[i]))
+			}
+			combinedNonce, err := musig2.AggregateNonces(pubNonces)
+			require.NoError(t, err)
+			require.Equal(t, v.AggNonce, hex.EncodeToString(combinedNonce[:]))
+
+			// each signer produces the partial signature of the vector
+			partialSigs := make([]*musig2.PartialSignature, len(sks))
+			for i, sk := range sks {
+				var secNonce [musig2.SecNonceSize]byte
+				copy(secNonce[:], mustDecodeHex(t, v.SecNonces[i]))
+				copy(secNonce[2*btcec.PrivKeyBytesLen:], keys[i].SerializeCompressed())
+				partialSigs[i], err = asig.MuSig2EncSign(secNonce, sk, combinedNonce, keys, encKey, msgHash)
+				require.NoError(t, err)
+				var buf bytes.Buffer
+				require.NoError(t, partialSigs[i].Encode(&buf))
+				require.Equal(t, v.PartialSigs[i], hex.EncodeToString(buf.Bytes()))
+				err = asig.MuSig2EncVerifyPartial(partialSigs[i], pubNonces[i], combinedNonce, keys, keys[i], encKey, msgHash)
+				require.NoError(t, err)
+			}
+
+			// the partial signatures combine into the adaptor signature of
+			// the vector, which decrypts into its Schnorr signature
+			adaptorSig, err := asig.MuSig2CombineEncSigs(combinedNonce, keys, encKey, msgHash, partialSigs)
+			require.NoError(t, err)
+			require.Equal(t, v.AdaptorSig, adaptorSig.MarshalHex())
+			schnorrSig := adaptorSig.Decrypt(decKey)
+			require.Equal(t, v.Sig, hex.EncodeToString(schnorrSig.Serialize()))
+			require.True(t, schnorrSig.Verify(msgHash, aggKey.FinalKey))
+			require.True(t, adaptorSig.Recover(schnorrSig).Equals(&decKey.ModNScalar))
+
+			// the partial signatures are bound to the encryption key
+			otherEncKey, _, err := asig.GenKeyPair()
+			require.NoError(t, err)
+			err = asig.MuSig2EncVerifyPartial(partialSigs[0], pubNonces[0], combinedNonce, keys, keys[0], otherEncKey, msgHash)
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,303 @@
+#!/usr/bin/env python3
+"""Generates the MuSig2 adaptor signature test vectors in musig2_vectors.json.
+
+This is an implementation of the MuSig2 adaptor signing in ../musig2.go that
+is independent of btcd, following the reference code of BIP-327
+(https://github.com/bitcoin/bips/blob/master/bip-0327/reference.py) for key
+aggregation, nonce aggregation and partial signing, and ../sign_utils.go for
+the adaptor signature format. All inputs are derived deterministically from
+the label of each vector, so running it again produces the same file.
+
+Usage: python3 gen_musig2_vectors.py > musig2_vectors.json
+"""
+
+import hashlib
+import json
+
+p = 0xFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC2F
+n = 0xFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEBAAEDCE6AF48A03BBFD25E8CD0364141
+G = (
+    0x79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798,
+    0x483ADA7726A3C4655DA4FBFC0E1108A8FD17B448A68554199C47D08FFB10D4B8,
+)
+
+# the maximum public offset added to the combined nonce, as in ../musig2.go
+MAX_NONCE_OFFSET = 256
+
+
+def point_add(P1, P2):
+    if P1 is None:
+        return P2
+    if P2 is None:
+        return P1
+    if P1[0] == P2[0] and P1[1] != P2[1]:
+        return None
+    if P1 == P2:
+        lam = (3 * P1[0] * P1[0] * pow(2 * P1[1], p - 2, p)) % p
+    else:
+        lam = ((P2[1] - P1[1]) * pow(P2[0] - P1[0], p - 2, p)) % p
+    x3 = (lam * lam - P1[0] - P2[0]) % p
+    return (x3, (lam * (P1[0] - x3) - P1[1]) % p)
+
+
+def point_mul(P, k):
+    R = None
+    for i in range(256):
+        if (k >> i) & 1:
+            R = point_add(R, P)
+        P = point_add(P, P)
+    return R
+
+
+def point_negate(P):
+    if P is None:
+        return None
+    return (P[0], p - P[1])
+
+
+def has_even_y(P):
+    return P[1] % 2 == 0
+
+
+def bytes_from_int(x):
+    return x.to_bytes(32, "big")
+
+
+def int_from_bytes(b):
+    return int.from_bytes(b, "big")
+
+
+def xbytes(P):
+    return bytes_from_int(P[0])
+
+
+def cbytes(P):
+    return (b"\x02" if has_even_y(P) else b"\x03") + xbytes(P)
+
+
+def cbytes_ext(P):
+    if P is None:
+        return b"\x00" * 33
+    return cbytes(P)
+
+
+def cpoint(b):
+    x = int_from_bytes(b[1:33])
+    y_sq = (pow(x, 3, p) + 7) % p
+    y = pow(y_sq, (p + 1) // 4, p)
+    if pow(y, 2, p) != y_sq:
+        raise ValueError("invalid point")
+    if (y % 2) != (b[0] - 2):
+        y = p - y
+    return (x, y)
+
+
+def cpoint_ext(b):
+    if b == b"\x00" * 33:
+        return None
+    return cpoint(b)
+
+
+def tagged_hash(tag, msg):
+    tag_hash = hashlib.sha256(tag.encode()).digest()
+    return hashlib.sha256(tag_hash + tag_hash + msg).digest()
+
+
+# BIP-327 key aggregation, with the keys in the given order
+
+
+def get_second_key(pks):
+    for pk in pks[1:]:
+        if pk != pks[0]:
+            return pk
+    return b"\x00" * 33
+
+
+def key_agg_coeff(pks, pk):
+    if pk == get_second_key(pks):
+        return 1
+    L = tagged_hash("KeyAgg list", b"".join(pks))
+    return int_from_bytes(tagged_hash("KeyAgg coefficient", L + pk)) % n
+
+
+def key_agg(pks):
+    Q = None
+    for pk in pks:
+        Q = point_add(Q, point_mul(cpoint(pk), key_agg_coeff(pks, pk)))
+    return Q
+
+
+# BIP-327 nonce aggregation and signing
+
+
+def nonce_agg(pubnonces):
+    aggnonce = b""
+    for j in (0, 1):
+        R = None
+        for pubnonce in pubnonces:
+            R = point_add(R, cpoint(pubnonce[33 * j : 33 * (j + 1)]))
+        aggnonce += cbytes_ext(R)
+    return aggnonce
+
+
+def nonce_coef(aggnonce, Q, msg):
+    return int_from_bytes(tagged_hash("MuSig/noncecoef", aggnonce + xbytes(Q) + msg)) % n
+
+
+def final_nonce(aggnonce, Q, msg):
+    b = nonce_coef(aggnonce, Q, msg)
+    R1 = cpoint_ext(aggnonce[0:33])
+    R2 = cpoint_ext(aggnonce[33:66])
+    R = point_add(R1, point_mul(R2, b))
+    return R if R is not None else G, b
+
+
+def challenge(R, Q, msg):
+    return int_from_bytes(tagged_hash("BIP0340/challenge", xbytes(R) + xbytes(Q) + msg)) % n
+
+
+def sign(k1, k2, sk, aggnonce, pks, msg):
+    Q = key_agg(pks)
+    R, b = final_nonce(aggnonce, Q, msg)
+    if not has_even_y(R):
+        k1, k2 = n - k1, n - k2
+    pk = cbytes(point_mul(G, sk))
+    g = 1 if has_even_y(Q) else n - 1
+    e = challenge(R, Q, msg)
+    a = key_agg_coeff(pks, pk)
+    return (k1 + b * k2 + e * a * g * sk) % n
+
+
+def partial_sig_verify(s, pubnonce, aggnonce, pks, pk, msg):
+    Q = key_agg(pks)
+    R, b = final_nonce(aggnonce, Q, msg)
+    Re = point_add(cpoint(pubnonce[0:33]), point_mul(cpoint(pubnonce[33:66]), b))
+    if not has_even_y(R):
+        Re = point_negate(Re)
+    g = 1 if has_even_y(Q) else n - 1
+    e = challenge(R, Q, msg)
+    a = key_agg_coeff(pks, pk)
+    return point_mul(G, s) == point_add(Re, point_mul(cpoint(pk), e * a * g % n))
+
+
+def schnorr_verify(msg, pk_x, sig):
+    P = (int_from_bytes(pk_x), None)
+    y_sq = (pow(P[0], 3, p) + 7) % p
+    y = pow(y_sq, (p + 1) // 4, p)
+    P = (P[0], y if y % 2 == 0 else p - y)
+    r = int_from_bytes(sig[0:32])
+    s = int_from_bytes(sig[32:64])
+    e = int_from_bytes(tagged_hash("BIP0340/challenge", sig[0:32] + pk_x + msg)) % n
+    R = point_add(point_mul(G, s), point_mul(P, n - e))
+    return R is not None and has_even_y(R) and R[0] == r
+
+
+# adaptor signing session over the adjusted aggregate nonce (R1+T+j*G, R2)
+
+
+def adaptor_session(aggnonce, Q, T, msg):
+    R1 = cpoint(aggnonce[0:33])
+    R2 = cpoint(aggnonce[33:66])
+    R1T = point_add(R1, T)
+    for j in range(MAX_NONCE_OFFSET):
+        adjR1 = point_add(R1T, point_mul(G, j) if j > 0 else None)
+        if adjR1 is None:
+            continue
+        adjusted = cbytes(adjR1) + aggnonce[33:66]
+        b = nonce_coef(adjusted, Q, msg)
+        R = point_add(adjR1, point_mul(R2, b))
+        if R is None:
+            continue
+        need_negation = not has_even_y(R)
+        evenR = point_negate(R) if need_negation else R
+        # R' = R-T, or -(R-T) = -R+T if R is negated
+        Rhat = point_add(evenR, T if need_negation else point_negate(T))
+        if Rhat is None or not has_even_y(Rhat):
+            continue
+        return j, adjusted, evenR, need_negation
+    raise ValueError("no nonce offset")
+
+
+def derive(label, *parts):
+    h = hashlib.sha256(("babylon/musig2-adaptor-vectors/" + label).encode())
+    for part in parts:
+        h.update(str(part).encode())
+    return int_from_bytes(h.digest()) % n
+
+
+def vector(label, num_signers):
+    sks = [derive(label, "sk", i) for i in range(num_signers)]
+    pks = [cbytes(point_mul(G, sk)) for sk in sks]
+    nonces = [(derive(label, "k1", i), derive(label, "k2", i)) for i in range(num_signers)]
+    pubnonces = [cbytes(point_mul(G, k1)) + cbytes(point_mul(G, k2)) for k1, k2 in nonces]
+    # the decryption key corresponding to an encryption key with an even y
+    # coordinate, as ../keys.go enforces
+    t = derive(label, "t")
+    T = point_mul(G, t)
+    if not has_even_y(T):
+        t, T = n - t, point_negate(T)
+    msg = bytes_from_int(derive(label, "msg"))
+
+    Q = key_agg(pks)
+    aggnonce = nonce_agg(pubnonces)
+    j, adjusted, R, need_negation = adaptor_session(aggnonce, Q, T, msg)
+
+    partial_sigs = []
+    for (k1, k2), sk, pk, pubnonce in zip(nonces, sks, pks, pubnonces):
+        s = sign(k1, k2, sk, adjusted, pks, msg)
+        assert partial_sig_verify(s, pubnonce, adjusted, pks, pk, msg)
+        partial_sigs.append(s)
+
+    # s' = sum of partial signatures + j, or - j if R is negated
+    s_hat = (sum(partial_sigs) + (n - j if need_negation else j)) % n
+    adaptor_sig = cbytes(R) + bytes_from_int(s_hat) + (b"\x01" if need_negation else b"\x00")
+
+    # the decrypted signature is a BIP-340 signature under the aggregate key,
+    # and the decryption key is extracted from it
+    s = (s_hat + (n - t if need_negation else t)) % n
+    sig = xbytes(R) + bytes_from_int(s)
+    assert schnorr_verify(msg, xbytes(Q), sig)
+    extracted = (s - s_hat) % n
+    if need_negation:
+        extracted = n - extracted
+    assert extracted == t
+
+    return {
+        "comment": label,
+        "sks": [bytes_from_int(sk).hex() for sk in sks],
+        "pks": [pk.hex() for pk in pks],
+        "sec_nonces": [(bytes_from_int(k1) + bytes_from_int(k2)).hex() for k1, k2 in nonces],
+        "pub_nonces": [pubnonce.hex() for pubnonce in pubnonces],
+        "agg_nonce": aggnonce.hex(),
+        "enc_key": cbytes(T).hex(),
+        "dec_key": bytes_from_int(t).hex(),
+        "msg": msg.hex(),
+        "agg_pk": xbytes(Q).hex(),
+        "nonce_offset": j,
+        "adjusted_agg_nonce": adjusted.hex(),
+        "partial_sigs": [bytes_from_int(s).hex() for s in partial_sigs],
+        "adaptor_sig": adaptor_sig.hex(),
+        "sig": sig.hex(),
+    }
+
+
+def main():
+    vectors = []
+    # cover both parities of the final nonce, and both zero and non-zero
+    # offsets, for a single signer and for several signers
+    wanted = {(single, neg, j > 0) for single in (True, False) for neg in (False, True) for j in (0, 1)}
+    covered = set()
+    i = 0
+    while covered != wanted:
+        num_signers = [1, 2, 3, 5][i % 4]
+        v = vector("vector-%d" % i, num_signers)
+        case = (num_signers == 1, v["adaptor_sig"].endswith("01"), v["nonce_offset"] > 0)
+        if case not in covered:
+            covered.add(case)
+            vectors.append(v)
+        i += 1
+    print(json.dumps({"valid": vectors}, indent=2))
+
+
+if __name__ == "__main__":
+    main()
@@ -1,9 +1,12 @@
 package datagen
 
 import (
+	"fmt"
 	"io"
 	"math/rand"
 
+	"github.com/babylonchain/babylon/btcstaking"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/txscript"
@@ -37,6 +40,35 @@ func GenRandomBTCAddress(r *rand.Rand, net *chaincfg.Params) (btcutil.Address, e
 	return addr, nil
 }
 
+// GenRandomBTCAddressOfType generates a random address of the given type
+func GenRandomBTCAddressOfType(r *rand.Rand, net *chaincfg.Params, addrType btcstaking.AddressType) (btcutil.Address, error) {
+	switch addrType {
+	case btcstaking.AddressTypeP2PKH:
+		return btcutil.NewAddressPubKeyHash(GenRandomByteArray(r, 20), net)
+	case btcstaking.AddressTypeP2SH:
+		return btcutil.NewAddressScriptHashFromHash(GenRandomByteArray(r, 20), net)
+	case btcstaking.AddressTypeP2WPKH:
+		return btcutil.NewAddressWitnessPubKeyHash(GenRandomByteArray(r, 20), net)
+	case btcstaking.AddressTypeP2WSH:
+		return btcutil.NewAddressWitnessScriptHash(GenRandomByteArray(r, 32), net)
+	case btcstaking.AddressTypeP2TR:
+		_, pk, err := GenRandomBTCKeyPair(r)
+		if err != nil {
+			return nil, err
+		}
+		return btcutil.NewAddressTaproot(schnorr.SerializePubKey(pk), net)
+	default:
+		return nil, fmt.Errorf("unsupported address type %s", addrType)
+	}
+}
+
+// GenRandomBTCAddressOfRandomType generates a random address of a random
+// supported type
+func GenRandomBTCAddressOfRandomType(r *rand.Rand, net *chaincfg.Params) (btcutil.Address, error) {
+	addrTypes := btcstaking.SupportedAddressTypes
+	return GenRandomBTCAddressOfType(r, net, addrTypes[r.Intn(len(addrTypes))])
+}
+
 func GenRandomPubKeyHashScript(r *rand.Rand, net *chaincfg.Params) ([]byte, error) {
 	addr, err := btcutil.NewAddressPubKeyHash(GenRandomPkHash(r), net)
 	if err != nil {
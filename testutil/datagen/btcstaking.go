@@ -84,7 +84,7 @@ func GenRandomCustomFinalityProvider(r *rand.Rand, btcSK *btcec.PrivateKey, bbnS
 		return nil, err
 	}
 	// master pub rand
-	mpr, err := msr.MasterPubicRand()
+	mpr, err := msr.MasterPublicRand()
 	if err != nil {
 		return nil, err
 	}
@@ -195,6 +195,8 @@ func GenRandomBTCDelegation(
 		UnbondingTime:    uint32(w + 1),
 		StakingTx:        serializedStakingTx,
 		SlashingTx:       stakingSlashingInfo.SlashingTx,
+		SlashingRate:     slashingRate,
+		SlashingAddress:  slashingAddress,
 	}
 
 	/*
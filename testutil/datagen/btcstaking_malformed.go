@@ -0,0 +1,297 @@
+package datagen
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	bbn "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// BTCTxMalformation is a way of malforming a valid BTC transaction, or a
+// valid signature on a BTC transaction, in the BTC staking protocol. The
+// malformations are used for negative-path tests, where Babylon is expected
+// to reject the malformed transactions.
+type BTCTxMalformation int
+
+const (
+	// MalformationWrongSighash signs the transaction with SIGHASH_ALL rather
+	// than SIGHASH_DEFAULT, which Babylon verifies signatures against
+	MalformationWrongSighash BTCTxMalformation = iota
+	// MalformationExtraOutput adds an output to the transaction
+	MalformationExtraOutput
+	// MalformationDustOutput moves the value of the change output of the
+	// slashing transaction to the slashing output, leaving a dust output
+	MalformationDustOutput
+	// MalformationWrongTimelock locks the change output of the slashing
+	// transaction for a different number of BTC blocks than the unbonding time
+	MalformationWrongTimelock
+	// MalformationSwappedScriptBranch signs the transaction against a spend
+	// path other than the one it is supposed to spend
+	MalformationSwappedScriptBranch
+)
+
+func (m BTCTxMalformation) String() string {
+	switch m {
+	case MalformationWrongSighash:
+		return "wrong sighash"
+	case MalformationExtraOutput:
+		return "extra output"
+	case MalformationDustOutput:
+		return "dust output"
+	case MalformationWrongTimelock:
+		return "wrong timelock"
+	case MalformationSwappedScriptBranch:
+		return "swapped script branch"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(m))
+	}
+}
+
+// SlashingTxMalformations returns all malformations applicable to slashing
+// transactions and the staker's signatures on them
+func SlashingTxMalformations() []BTCTxMalformation {
+	return []BTCTxMalformation{
+		MalformationWrongSighash,
+		MalformationExtraOutput,
+		MalformationDustOutput,
+		MalformationWrongTimelock,
+		MalformationSwappedScriptBranch,
+	}
+}
+
+// UnbondingTxSigMalformations returns all malformations applicable to the
+// staker's signatures on unbonding transactions. The unbonding transaction
+// itself is fixed upon the creation of the BTC delegation.
+func UnbondingTxSigMalformations() []BTCTxMalformation {
+	return []BTCTxMalformation{
+		MalformationWrongSighash,
+		MalformationSwappedScriptBranch,
+	}
+}
+
+// RandomSlashingTxMalformation returns a random malformation applicable to
+// slashing transactions
+func RandomSlashingTxMalformation(r *rand.Rand) BTCTxMalformation {
+	malformations := SlashingTxMalformations()
+	return malformations[r.Intn(len(malformations))]
+}
+
+// MalformSlashingTx returns a malformed copy of the given valid slashing tx
+// spending the given funding output, along with the staker's signature on the
+// malformed slashing tx. slashingPkScriptPath is the script of the slashing
+// path of the funding output, and otherPkScriptPath is the script of another
+// spend path of the funding output, which is signed against upon
+// MalformationSwappedScriptBranch.
+func MalformSlashingTx(
+	r *rand.Rand,
+	t testing.TB,
+	m BTCTxMalformation,
+	slashingTx *bstypes.BTCSlashingTx,
+	fundingTx *wire.MsgTx,
+	fundingOutputIdx uint32,
+	slashingPkScriptPath []byte,
+	otherPkScriptPath []byte,
+	stakerSK *btcec.PrivateKey,
+	slashingChangeLockTime uint16,
+	btcNet *chaincfg.Params,
+) (*bstypes.BTCSlashingTx, *bbn.BIP340Signature) {
+	slashingMsgTx, err := slashingTx.ToMsgTx()
+	require.NoError(t, err)
+	// the slashing tx has the slashing output and the change output
+	require.Len(t, slashingMsgTx.TxOut, 2)
+	changeOut := slashingMsgTx.TxOut[1]
+
+	signedPkScriptPath := slashingPkScriptPath
+	sigHashType := txscript.SigHashDefault
+	switch m {
+	case MalformationWrongSighash:
+		sigHashType = txscript.SigHashAll
+	case MalformationExtraOutput:
+		extraOutScript, err := GenRandomPubKeyHashScript(r, btcNet)
+		require.NoError(t, err)
+		extraOutValue := changeOut.Value / 2
+		changeOut.Value -= extraOutValue
+		slashingMsgTx.AddTxOut(wire.NewTxOut(extraOutValue, extraOutScript))
+	case MalformationDustOutput:
+		slashingMsgTx.TxOut[0].Value += changeOut.Value - 1
+		changeOut.Value = 1
+	case MalformationWrongTimelock:
+		wrongLockTime := slashingChangeLockTime + uint16(RandomInt(r, 100)) + 1
+		changeInfo, err := btcstaking.BuildRelativeTimelockTaprootScript(stakerSK.PubKey(), wrongLockTime, btcNet)
+		require.NoError(t, err)
+		changeOut.PkScript = changeInfo.PkScript
+	case MalformationSwappedScriptBranch:
+		signedPkScriptPath = otherPkScriptPath
+	default:
+		require.FailNow(t, fmt.Sprintf("malformation %s is not applicable to slashing txs", m))
+	}
+
+	malformedSlashingTx, err := bstypes.NewBTCSlashingTxFromMsgTx(slashingMsgTx)
+	require.NoError(t, err)
+	sig := signTxWithSigHashType(t, slashingMsgTx, fundingTx, fundingOutputIdx, signedPkScriptPath, stakerSK, sigHashType)
+
+	return malformedSlashingTx, sig
+}
+
+// MalformMsgCreateBTCDelegation returns a copy of the given valid
+// MsgCreateBTCDelegation, where the slashing tx of the staking tx, or the
+// slashing tx of the unbonding tx if onUnbondingTx is true, is malformed by
+// the given malformation and re-signed by the staker. The params are the BTC
+// staking params that the message is valid against.
+func MalformMsgCreateBTCDelegation(
+	r *rand.Rand,
+	t testing.TB,
+	m BTCTxMalformation,
+	msg *bstypes.MsgCreateBTCDelegation,
+	delSK *btcec.PrivateKey,
+	params *bstypes.Params,
+	onUnbondingTx bool,
+	btcNet *chaincfg.Params,
+) *bstypes.MsgCreateBTCDelegation {
+	fpPKs, err := bbn.NewBTCPKsFromBIP340PKs(msg.FpBtcPkList)
+	require.NoError(t, err)
+	covenantPKs, err := bbn.NewBTCPKsFromBIP340PKs(params.CovenantPks)
+	require.NoError(t, err)
+	template, err := btcstaking.GetScriptTemplate(params.ScriptTemplateVersion)
+	require.NoError(t, err)
+	unbondingTime := uint16(msg.UnbondingTime)
+
+	malformedMsg := *msg
+	if !onUnbondingTx {
+		stakingTx, err := bbn.NewBTCTxFromBytes(msg.StakingTx.Transaction)
+		require.NoError(t, err)
+		stakingInfo, err := template.BuildStakingInfo(
+			delSK.PubKey(),
+			fpPKs,
+			covenantPKs,
+			params.CovenantQuorum,
+			uint16(msg.StakingTime),
+			btcutil.Amount(msg.StakingValue),
+			btcNet,
+		)
+		require.NoError(t, err)
+		stakingOutputIdx, err := bbn.GetOutputIdxInBTCTx(stakingTx, stakingInfo.StakingOutput)
+		require.NoError(t, err)
+		slashingPathInfo, err := stakingInfo.SlashingPathSpendInfo()
+		require.NoError(t, err)
+		unbondingPathInfo, err := stakingInfo.UnbondingPathSpendInfo()
+		require.NoError(t, err)
+
+		malformedMsg.SlashingTx, malformedMsg.DelegatorSlashingSig = MalformSlashingTx(
+			r, t, m,
+			msg.SlashingTx,
+			stakingTx,
+			stakingOutputIdx,
+			slashingPathInfo.GetPkScriptPath(),
+			unbondingPathInfo.GetPkScriptPath(),
+			delSK,
+			unbondingTime,
+			btcNet,
+		)
+		return &malformedMsg
+	}
+
+	unbondingTx, err := bbn.NewBTCTxFromBytes(msg.UnbondingTx)
+	require.NoError(t, err)
+	unbondingInfo, err := template.BuildUnbondingInfo(
+		delSK.PubKey(),
+		fpPKs,
+		covenantPKs,
+		params.CovenantQuorum,
+		unbondingTime,
+		btcutil.Amount(msg.UnbondingValue),
+		btcNet,
+	)
+	require.NoError(t, err)
+	unbondingOutputIdx, err := bbn.GetOutputIdxInBTCTx(unbondingTx, unbondingInfo.UnbondingOutput)
+	require.NoError(t, err)
+	slashingPathInfo, err := unbondingInfo.SlashingPathSpendInfo()
+	require.NoError(t, err)
+	timeLockPathInfo, err := unbondingInfo.TimeLockPathSpendInfo()
+	require.NoError(t, err)
+
+	malformedMsg.UnbondingSlashingTx, malformedMsg.DelegatorUnbondingSlashingSig = MalformSlashingTx(
+		r, t, m,
+		msg.UnbondingSlashingTx,
+		unbondingTx,
+		unbondingOutputIdx,
+		slashingPathInfo.GetPkScriptPath(),
+		timeLockPathInfo.GetPkScriptPath(),
+		delSK,
+		unbondingTime,
+		btcNet,
+	)
+	return &malformedMsg
+}
+
+// GenMalformedUnbondingTxSig generates the staker's signature on the unbonding
+// tx of the given BTC delegation, malformed by the given malformation, for
+// MsgBTCUndelegate
+func GenMalformedUnbondingTxSig(
+	t testing.TB,
+	m BTCTxMalformation,
+	btcDel *bstypes.BTCDelegation,
+	delSK *btcec.PrivateKey,
+	params *bstypes.Params,
+	btcNet *chaincfg.Params,
+) *bbn.BIP340Signature {
+	stakingTx, err := bbn.NewBTCTxFromBytes(btcDel.StakingTx)
+	require.NoError(t, err)
+	unbondingTx, err := bbn.NewBTCTxFromBytes(btcDel.BtcUndelegation.UnbondingTx)
+	require.NoError(t, err)
+	stakingInfo, err := btcDel.GetStakingInfo(params, btcNet)
+	require.NoError(t, err)
+	unbondingPathInfo, err := stakingInfo.UnbondingPathSpendInfo()
+	require.NoError(t, err)
+
+	switch m {
+	case MalformationWrongSighash:
+		return signTxWithSigHashType(t, unbondingTx, stakingTx, btcDel.StakingOutputIdx, unbondingPathInfo.GetPkScriptPath(), delSK, txscript.SigHashAll)
+	case MalformationSwappedScriptBranch:
+		slashingPathInfo, err := stakingInfo.SlashingPathSpendInfo()
+		require.NoError(t, err)
+		return signTxWithSigHashType(t, unbondingTx, stakingTx, btcDel.StakingOutputIdx, slashingPathInfo.GetPkScriptPath(), delSK, txscript.SigHashDefault)
+	default:
+		require.FailNow(t, fmt.Sprintf("malformation %s is not applicable to unbonding tx signatures", m))
+		return nil
+	}
+}
+
+// signTxWithSigHashType signs the given tx spending the given funding output
+// via the given script path with the given sighash type. The sighash type
+// byte, if any, is dropped so that the signature is a BIP-340 signature.
+func signTxWithSigHashType(
+	t testing.TB,
+	txToSign *wire.MsgTx,
+	fundingTx *wire.MsgTx,
+	fundingOutputIdx uint32,
+	pkScriptPath []byte,
+	sk *btcec.PrivateKey,
+	sigHashType txscript.SigHashType,
+) *bbn.BIP340Signature {
+	require.Less(t, int(fundingOutputIdx), len(fundingTx.TxOut))
+	fundingOutput := fundingTx.TxOut[fundingOutputIdx]
+
+	inputFetcher := txscript.NewCannedPrevOutputFetcher(fundingOutput.PkScript, fundingOutput.Value)
+	sigHashes := txscript.NewTxSigHashes(txToSign, inputFetcher)
+	sig, err := txscript.RawTxInTapscriptSignature(
+		txToSign, sigHashes, 0, fundingOutput.Value,
+		fundingOutput.PkScript, txscript.NewBaseTapLeaf(pkScriptPath), sigHashType,
+		sk,
+	)
+	require.NoError(t, err)
+
+	bip340Sig, err := bbn.NewBIP340Signature(sig[:bbn.BIP340SignatureLen])
+	require.NoError(t, err)
+	return bip340Sig
+}
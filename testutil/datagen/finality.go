@@ -43,3 +43,18 @@ func GenRandomEvidence(r *rand.Rand, sk *btcec.PrivateKey, height uint64) (*ftyp
 	}
 	return evidence, nil
 }
+
+// GenRandomPubRandList generates a list of random EOTS randomness pairs
+func GenRandomPubRandList(r *rand.Rand, numPubRand uint64) ([]*eots.PrivateRand, []*eots.PublicRand, error) {
+	srList := make([]*eots.PrivateRand, 0, numPubRand)
+	prList := make([]*eots.PublicRand, 0, numPubRand)
+	for i := uint64(0); i < numPubRand; i++ {
+		sr, pr, err := eots.RandGen(r)
+		if err != nil {
+			return nil, nil, err
+		}
+		srList = append(srList, sr)
+		prList = append(prList, pr)
+	}
+	return srList, prList, nil
+}
@@ -0,0 +1,425 @@
+package datagen
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	"github.com/babylonchain/babylon/crypto/eots"
+	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+// ScenarioBuilder deterministically builds a consistent state of a Babylon
+// chain, i.e., a BTC chain, finality providers and their BTC delegations at
+// various statuses w.r.t. the BTC chain, and checkpoints of a number of
+// epochs. The state is derived from the given randomness source only, so that
+// fuzz tests are reproducible from their seeds.
+//
+//	scenario := datagen.NewScenarioBuilder(r, t).
+//		WithBTCChainDepth(100).
+//		WithFinalityProviders(3).
+//		WithDelegationsPerFinalityProvider(4, bstypes.BTCDelegationStatus_ACTIVE, bstypes.BTCDelegationStatus_PENDING).
+//		WithEpochs(5).
+//		Build()
+//	scenario.Install(ctx, datagen.ScenarioKeepers{...})
+type ScenarioBuilder struct {
+	r *rand.Rand
+	t *testing.T
+
+	btcChainDepth          uint32
+	numFps                 int
+	numDelsPerFp           int
+	delStatuses            []bstypes.BTCDelegationStatus
+	numEpochs              uint64
+	finalizationTimeout    uint64
+	numCovenantMembers     int
+	stakingValueSat        uint64
+	slashingRate           sdkmath.LegacyDec
+	slashingChangeLockTime uint16
+}
+
+// NewScenarioBuilder returns a scenario builder with a BTC chain of depth 10,
+// a single finality provider with a single active BTC delegation, and no
+// checkpoints
+func NewScenarioBuilder(r *rand.Rand, t *testing.T) *ScenarioBuilder {
+	finalizationTimeout := btcctypes.DefaultParams().CheckpointFinalizationTimeout
+	return &ScenarioBuilder{
+		r:                      r,
+		t:                      t,
+		btcChainDepth:          10,
+		numFps:                 1,
+		numDelsPerFp:           1,
+		delStatuses:            []bstypes.BTCDelegationStatus{bstypes.BTCDelegationStatus_ACTIVE},
+		finalizationTimeout:    finalizationTimeout,
+		numCovenantMembers:     3,
+		stakingValueSat:        10000,
+		slashingRate:           sdkmath.LegacyNewDecWithPrec(1, 1),
+		slashingChangeLockTime: uint16(finalizationTimeout + 1),
+	}
+}
+
+// WithBTCChainDepth sets the number of BTC headers on top of the base BTC
+// header, i.e., the simnet genesis block
+func (b *ScenarioBuilder) WithBTCChainDepth(depth uint32) *ScenarioBuilder {
+	b.btcChainDepth = depth
+	return b
+}
+
+// WithFinalityProviders sets the number of finality providers
+func (b *ScenarioBuilder) WithFinalityProviders(n int) *ScenarioBuilder {
+	b.numFps = n
+	return b
+}
+
+// WithDelegationsPerFinalityProvider sets the number of BTC delegations
+// staking to each finality provider. The statuses of the BTC delegations
+// cycle through the given statuses, or are all active if none is given.
+func (b *ScenarioBuilder) WithDelegationsPerFinalityProvider(m int, statuses ...bstypes.BTCDelegationStatus) *ScenarioBuilder {
+	b.numDelsPerFp = m
+	if len(statuses) > 0 {
+		b.delStatuses = statuses
+	}
+	return b
+}
+
+// WithEpochs sets the number of epochs with checkpoints. Checkpoints of all
+// epochs but the last one are finalized, and the checkpoint of the last epoch
+// is sealed.
+func (b *ScenarioBuilder) WithEpochs(k uint64) *ScenarioBuilder {
+	b.numEpochs = k
+	return b
+}
+
+// WithCheckpointFinalizationTimeout sets the checkpoint finalization timeout
+// w, which the statuses of the BTC delegations are derived with. It has to be
+// consistent with the BTC checkpoint parameters of the keepers the scenario is
+// installed into.
+func (b *ScenarioBuilder) WithCheckpointFinalizationTimeout(w uint64) *ScenarioBuilder {
+	b.finalizationTimeout = w
+	return b
+}
+
+// WithCovenantCommittee sets the size of the covenant committee
+func (b *ScenarioBuilder) WithCovenantCommittee(n int) *ScenarioBuilder {
+	b.numCovenantMembers = n
+	return b
+}
+
+// Build generates the scenario
+func (b *ScenarioBuilder) Build() *Scenario {
+	t := b.t
+	r := b.r
+	net := &chaincfg.SimNetParams
+
+	require.Positive(t, b.numCovenantMembers, "covenant committee must not be empty")
+	require.NotEmpty(t, b.delStatuses, "statuses of BTC delegations must not be empty")
+	// the staking time has to fit the timelock of the staking output
+	require.Less(t, uint64(b.btcChainDepth)+b.finalizationTimeout+1000, uint64(math.MaxUint16),
+		"BTC chain depth %d is too large for the timelock of staking outputs", b.btcChainDepth)
+
+	s := &Scenario{
+		FinalizationTimeout: b.finalizationTimeout,
+	}
+
+	// BTC chain on top of the base BTC header
+	baseHeader := btclctypes.SimnetGenesisBlock()
+	if b.btcChainDepth > 0 {
+		s.BTCChain = NewBTCHeaderChainFromParentInfo(r, &baseHeader, b.btcChainDepth)
+	}
+	tipHeight := s.BTCTipHeight()
+
+	// covenant committee and params
+	covenantSKs, covenantPKs := make([]*btcec.PrivateKey, 0, b.numCovenantMembers), make([]*btcec.PublicKey, 0, b.numCovenantMembers)
+	for i := 0; i < b.numCovenantMembers; i++ {
+		sk, pk, err := GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		covenantSKs = append(covenantSKs, sk)
+		covenantPKs = append(covenantPKs, pk)
+	}
+	slashingAddress, err := GenRandomBTCAddress(r, net)
+	require.NoError(t, err)
+	s.CovenantSKs = covenantSKs
+	s.Params = bstypes.DefaultParams()
+	s.Params.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(covenantPKs)
+	s.Params.CovenantQuorum = uint32(b.numCovenantMembers/2 + 1)
+	s.Params.SlashingAddress = slashingAddress.EncodeAddress()
+	s.Params.SlashingRate = b.slashingRate
+	require.NoError(t, s.Params.Validate())
+
+	// checkpoints of epochs 1..k, where all but the last one are finalized
+	for epoch := uint64(1); epoch <= b.numEpochs; epoch++ {
+		ckpt := GenRandomRawCheckpointWithMeta(r)
+		ckpt.Ckpt.EpochNum = epoch
+		if epoch < b.numEpochs {
+			ckpt.Status = ckpttypes.Finalized
+		} else {
+			ckpt.Status = ckpttypes.Sealed
+		}
+		s.Checkpoints = append(s.Checkpoints, ckpt)
+	}
+	lastFinalizedEpoch := s.LastFinalizedEpoch()
+
+	// finality providers registered in finalized epochs, and their BTC
+	// delegations
+	for i := 0; i < b.numFps; i++ {
+		fpSK, _, err := GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		bbnSK, _, err := GenRandomSecp256k1KeyPair(r)
+		require.NoError(t, err)
+		msr, _, err := eots.NewMasterRandPair(r)
+		require.NoError(t, err)
+		fp, err := GenRandomCustomFinalityProvider(r, fpSK, bbnSK, msr)
+		require.NoError(t, err)
+		fp.RegisteredEpoch = RandomInt(r, int(lastFinalizedEpoch)+1)
+
+		scenarioFp := &ScenarioFinalityProvider{
+			FinalityProvider: fp,
+			BTCSK:            fpSK,
+			MasterSecretRand: msr,
+		}
+		for j := 0; j < b.numDelsPerFp; j++ {
+			status := b.delStatuses[j%len(b.delStatuses)]
+			scenarioFp.Delegations = append(scenarioFp.Delegations, b.genDelegation(s, fp, status, tipHeight))
+		}
+		s.FinalityProviders = append(s.FinalityProviders, scenarioFp)
+	}
+
+	return s
+}
+
+// genDelegation generates a BTC delegation staking to the given finality
+// provider, which has the given status at the given BTC tip height
+func (b *ScenarioBuilder) genDelegation(
+	s *Scenario,
+	fp *bstypes.FinalityProvider,
+	status bstypes.BTCDelegationStatus,
+	tipHeight uint64,
+) *ScenarioBTCDelegation {
+	t := b.t
+	r := b.r
+
+	// the timelock of the BTC delegation has begun and has more than w BTC
+	// blocks left at the BTC tip
+	startHeight := RandomInt(r, int(tipHeight)+1)
+	endHeight := tipHeight + b.finalizationTimeout + RandomInt(r, 1000) + 1
+
+	delSK, _, err := GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	btcDel, err := GenRandomBTCDelegation(
+		r,
+		t,
+		[]bbn.BIP340PubKey{*fp.BtcPk},
+		delSK,
+		s.CovenantSKs,
+		s.Params.CovenantQuorum,
+		s.Params.SlashingAddress,
+		startHeight, endHeight, b.stakingValueSat,
+		s.Params.SlashingRate,
+		b.slashingChangeLockTime,
+	)
+	require.NoError(t, err)
+
+	switch status {
+	case bstypes.BTCDelegationStatus_ACTIVE:
+		// the generated BTC delegation has covenant quorum
+	case bstypes.BTCDelegationStatus_PENDING:
+		btcDel.CovenantSigs = nil
+		btcDel.BtcUndelegation.CovenantSlashingSigs = nil
+		btcDel.BtcUndelegation.CovenantUnbondingSigList = nil
+	case bstypes.BTCDelegationStatus_UNBONDED:
+		// the staker signs the unbonding tx, i.e., unbonds early
+		btcDel.BtcUndelegation.DelegatorUnbondingSig = b.genDelegatorUnbondingSig(s, btcDel, delSK)
+	default:
+		require.FailNow(t, fmt.Sprintf("unsupported status of BTC delegations in scenarios: %s", status))
+	}
+	require.Equal(t, status, btcDel.GetStatus(tipHeight, b.finalizationTimeout, s.Params.CovenantQuorum))
+
+	return &ScenarioBTCDelegation{
+		BTCDelegation: btcDel,
+		DelegatorSK:   delSK,
+		Status:        status,
+	}
+}
+
+// genDelegatorUnbondingSig generates the staker's signature on the unbonding
+// tx of the given BTC delegation
+func (b *ScenarioBuilder) genDelegatorUnbondingSig(
+	s *Scenario,
+	btcDel *bstypes.BTCDelegation,
+	delSK *btcec.PrivateKey,
+) *bbn.BIP340Signature {
+	t := b.t
+	stakingTx, err := bbn.NewBTCTxFromBytes(btcDel.StakingTx)
+	require.NoError(t, err)
+	unbondingTx, err := bbn.NewBTCTxFromBytes(btcDel.BtcUndelegation.UnbondingTx)
+	require.NoError(t, err)
+	stakingInfo, err := btcDel.GetStakingInfo(&s.Params, &chaincfg.SimNetParams)
+	require.NoError(t, err)
+	unbondingPathSpendInfo, err := stakingInfo.UnbondingPathSpendInfo()
+	require.NoError(t, err)
+	sig, err := btcstaking.SignTxWithOneScriptSpendInputStrict(
+		unbondingTx,
+		stakingTx,
+		btcDel.StakingOutputIdx,
+		unbondingPathSpendInfo.GetPkScriptPath(),
+		delSK,
+	)
+	require.NoError(t, err)
+	return bbn.NewBIP340SignatureFromBTCSig(sig)
+}
+
+// Scenario is a consistent state of a Babylon chain built by ScenarioBuilder
+type Scenario struct {
+	// BTCChain is the BTC chain on top of the base BTC header. It is nil if
+	// the BTC chain has depth 0.
+	BTCChain *BTCHeaderPartialChain
+	// CovenantSKs are the secret keys of the covenant committee
+	CovenantSKs []*btcec.PrivateKey
+	// Params are the BTC staking parameters, including the covenant
+	// committee
+	Params bstypes.Params
+	// FinalizationTimeout is the checkpoint finalization timeout w that the
+	// statuses of the BTC delegations are derived with
+	FinalizationTimeout uint64
+	// FinalityProviders are the finality providers along with their BTC
+	// delegations
+	FinalityProviders []*ScenarioFinalityProvider
+	// Checkpoints are the checkpoints in ascending order of epochs
+	Checkpoints []*ckpttypes.RawCheckpointWithMeta
+}
+
+// ScenarioFinalityProvider is a finality provider in a scenario, along with
+// its secrets and BTC delegations
+type ScenarioFinalityProvider struct {
+	FinalityProvider *bstypes.FinalityProvider
+	BTCSK            *btcec.PrivateKey
+	MasterSecretRand *eots.MasterSecretRand
+	Delegations      []*ScenarioBTCDelegation
+}
+
+// ScenarioBTCDelegation is a BTC delegation in a scenario, along with the
+// staker's secret key and the status of the BTC delegation at the BTC tip
+type ScenarioBTCDelegation struct {
+	BTCDelegation *bstypes.BTCDelegation
+	DelegatorSK   *btcec.PrivateKey
+	Status        bstypes.BTCDelegationStatus
+}
+
+// BTCTipHeight returns the height of the tip of the BTC chain
+func (s *Scenario) BTCTipHeight() uint64 {
+	if s.BTCChain == nil {
+		return btclctypes.SimnetGenesisBlock().Height
+	}
+	return s.BTCChain.GetTipInfo().Height
+}
+
+// LastFinalizedEpoch returns the last epoch with a finalized checkpoint, or 0
+// if there is none
+func (s *Scenario) LastFinalizedEpoch() uint64 {
+	lastFinalizedEpoch := uint64(0)
+	for _, ckpt := range s.Checkpoints {
+		if ckpt.Status == ckpttypes.Finalized {
+			lastFinalizedEpoch = ckpt.Ckpt.EpochNum
+		}
+	}
+	return lastFinalizedEpoch
+}
+
+// Delegations returns the BTC delegations of all finality providers
+func (s *Scenario) Delegations() []*ScenarioBTCDelegation {
+	dels := []*ScenarioBTCDelegation{}
+	for _, fp := range s.FinalityProviders {
+		dels = append(dels, fp.Delegations...)
+	}
+	return dels
+}
+
+// DelegationsWithStatus returns the BTC delegations of all finality providers
+// with the given status
+func (s *Scenario) DelegationsWithStatus(status bstypes.BTCDelegationStatus) []*ScenarioBTCDelegation {
+	dels := []*ScenarioBTCDelegation{}
+	for _, del := range s.Delegations() {
+		if del.Status == status {
+			dels = append(dels, del)
+		}
+	}
+	return dels
+}
+
+// ScenarioBTCLightClientKeeper is the part of the BTC light client keeper
+// that a scenario is installed into
+type ScenarioBTCLightClientKeeper interface {
+	InsertHeaders(ctx context.Context, headers []bbn.BTCHeaderBytes) error
+}
+
+// ScenarioBTCStakingKeeper is the part of the BTC staking keeper that a
+// scenario is installed into
+type ScenarioBTCStakingKeeper interface {
+	SetParams(ctx context.Context, p bstypes.Params) error
+	SetFinalityProvider(ctx context.Context, fp *bstypes.FinalityProvider)
+	AddBTCDelegation(ctx sdk.Context, btcDel *bstypes.BTCDelegation) error
+}
+
+// ScenarioCheckpointingKeeper is the part of the checkpointing keeper that a
+// scenario is installed into
+type ScenarioCheckpointingKeeper interface {
+	AddRawCheckpoint(ctx context.Context, ckptWithMeta *ckpttypes.RawCheckpointWithMeta) error
+	SetLastFinalizedEpoch(ctx context.Context, epochNumber uint64)
+}
+
+// ScenarioKeepers are the keepers that a scenario is installed into. Nil
+// keepers are skipped, e.g., when they are mocked in the test.
+type ScenarioKeepers struct {
+	BTCLightClientKeeper ScenarioBTCLightClientKeeper
+	BTCStakingKeeper     ScenarioBTCStakingKeeper
+	CheckpointingKeeper  ScenarioCheckpointingKeeper
+}
+
+// Install writes the scenario to the given keepers. The BTC light client is
+// expected to have the simnet genesis block as its base BTC header, which is
+// the default.
+func (s *Scenario) Install(ctx sdk.Context, keepers ScenarioKeepers) error {
+	if keepers.BTCLightClientKeeper != nil && s.BTCChain != nil {
+		if err := keepers.BTCLightClientKeeper.InsertHeaders(ctx, s.BTCChain.ChainToBytes()); err != nil {
+			return fmt.Errorf("failed to insert BTC headers: %w", err)
+		}
+	}
+
+	if keepers.CheckpointingKeeper != nil {
+		for _, ckpt := range s.Checkpoints {
+			if err := keepers.CheckpointingKeeper.AddRawCheckpoint(ctx, ckpt); err != nil {
+				return fmt.Errorf("failed to add checkpoint of epoch %d: %w", ckpt.Ckpt.EpochNum, err)
+			}
+		}
+		keepers.CheckpointingKeeper.SetLastFinalizedEpoch(ctx, s.LastFinalizedEpoch())
+	}
+
+	if keepers.BTCStakingKeeper != nil {
+		if err := keepers.BTCStakingKeeper.SetParams(ctx, s.Params); err != nil {
+			return fmt.Errorf("failed to set BTC staking params: %w", err)
+		}
+		for _, fp := range s.FinalityProviders {
+			keepers.BTCStakingKeeper.SetFinalityProvider(ctx, fp.FinalityProvider)
+			for _, del := range fp.Delegations {
+				if err := keepers.BTCStakingKeeper.AddBTCDelegation(ctx, del.BTCDelegation); err != nil {
+					return fmt.Errorf("failed to add BTC delegation: %w", err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
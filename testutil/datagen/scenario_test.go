@@ -0,0 +1,65 @@
+package datagen_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+func FuzzScenarioBuilder(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 5)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		depth := uint32(datagen.RandomInt(r, 50) + 1)
+		numFps := int(datagen.RandomInt(r, 3) + 1)
+		numEpochs := datagen.RandomInt(r, 5) + 1
+		statuses := []bstypes.BTCDelegationStatus{
+			bstypes.BTCDelegationStatus_ACTIVE,
+			bstypes.BTCDelegationStatus_PENDING,
+			bstypes.BTCDelegationStatus_UNBONDED,
+		}
+
+		build := func() *datagen.Scenario {
+			return datagen.NewScenarioBuilder(rand.New(rand.NewSource(seed)), t).
+				WithBTCChainDepth(depth).
+				WithFinalityProviders(numFps).
+				WithDelegationsPerFinalityProvider(len(statuses), statuses...).
+				WithEpochs(numEpochs).
+				Build()
+		}
+		scenario := build()
+
+		// the BTC chain has the given depth
+		require.Equal(t, uint64(depth), scenario.BTCTipHeight())
+
+		// each finality provider has a BTC delegation at each status
+		require.Len(t, scenario.FinalityProviders, numFps)
+		tipHeight := scenario.BTCTipHeight()
+		for _, status := range statuses {
+			dels := scenario.DelegationsWithStatus(status)
+			require.Len(t, dels, numFps)
+			for _, del := range dels {
+				actualStatus := del.BTCDelegation.GetStatus(tipHeight, scenario.FinalizationTimeout, scenario.Params.CovenantQuorum)
+				require.Equal(t, status, actualStatus)
+			}
+		}
+
+		// all checkpoints but the last one are finalized, and finality
+		// providers are registered in finalized epochs
+		require.Len(t, scenario.Checkpoints, int(numEpochs))
+		require.Equal(t, numEpochs-1, scenario.LastFinalizedEpoch())
+		require.Equal(t, ckpttypes.Sealed, scenario.Checkpoints[numEpochs-1].Status)
+		for _, fp := range scenario.FinalityProviders {
+			require.LessOrEqual(t, fp.FinalityProvider.RegisteredEpoch, scenario.LastFinalizedEpoch())
+		}
+
+		// the scenario is deterministic w.r.t. the randomness source
+		require.Equal(t, scenario.BTCChain.ChainToBytes(), build().BTCChain.ChainToBytes())
+	})
+}
@@ -34,7 +34,7 @@ func GenRandomPubkeysAndSigs(n int, msg []byte) ([]bls12381.PublicKey, []bls1238
 	var blsSigs []bls12381.Signature
 	for i := 0; i < n; i++ {
 		privKey := bls12381.GenPrivKey()
-		pubkey := bls12381.GenPrivKey().PubKey()
+		pubkey := privKey.PubKey()
 		sig := bls12381.Sign(privKey, msg)
 		blsPubkeys = append(blsPubkeys, pubkey)
 		blsSigs = append(blsSigs, sig)
@@ -0,0 +1,215 @@
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os/exec"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/x/btcstaking/slasher"
+)
+
+const (
+	bitcoindBinary  = "bitcoind"
+	bitcoindRPCUser = "user"
+	bitcoindRPCPass = "pass"
+	bitcoindWallet  = "e2e"
+	// the coinbase outputs of the first blocks become spendable after 100
+	// confirmations
+	coinbaseMaturity = 100
+)
+
+// BitcoindNode is a bitcoind node running in regtest mode, which serves as the
+// Bitcoin network of the in-process Babylon network
+type BitcoindNode struct {
+	t      *testing.T
+	cmd    *exec.Cmd
+	client *slasher.BTCClient
+
+	// RPCURL is the URL of the JSON-RPC endpoint of the node
+	RPCURL string
+	// MiningAddress is the wallet address receiving the coinbase outputs
+	MiningAddress string
+}
+
+// StartBitcoind starts a bitcoind node in regtest mode with a funded wallet.
+// The test is skipped if bitcoind is not installed. The node is stopped once
+// the test finishes.
+func StartBitcoind(t *testing.T) *BitcoindNode {
+	binary, err := exec.LookPath(bitcoindBinary)
+	if err != nil {
+		t.Skipf("%s is not found in PATH, skipping the test", bitcoindBinary)
+	}
+
+	rpcPort := freePort(t)
+	cmd := exec.Command(binary,
+		"-regtest",
+		"-server",
+		"-listen=0",
+		"-printtoconsole=0",
+		"-fallbackfee=0.0002",
+		"-datadir="+t.TempDir(),
+		"-rpcbind=127.0.0.1",
+		"-rpcallowip=127.0.0.1",
+		"-rpcport="+strconv.Itoa(rpcPort),
+		"-rpcuser="+bitcoindRPCUser,
+		"-rpcpassword="+bitcoindRPCPass,
+	)
+	require.NoError(t, cmd.Start())
+
+	rpcURL := fmt.Sprintf("http://127.0.0.1:%d", rpcPort)
+	node := &BitcoindNode{
+		t:      t,
+		cmd:    cmd,
+		client: slasher.NewBTCClient(rpcURL, bitcoindRPCUser, bitcoindRPCPass),
+		RPCURL: rpcURL,
+	}
+	t.Cleanup(node.stop)
+
+	// wait until the RPC endpoint is up
+	require.Eventually(t, func() bool {
+		_, err := node.GetBlockCount()
+		return err == nil
+	}, 30*time.Second, 100*time.Millisecond, "bitcoind does not start in time")
+
+	// create a wallet and mine enough blocks to spend the first coinbase
+	// outputs
+	node.call("createwallet", []interface{}{bitcoindWallet}, nil)
+	node.call("getnewaddress", nil, &node.MiningAddress)
+	node.GenerateBlocks(coinbaseMaturity + 1)
+
+	return node
+}
+
+// NetParams returns the parameters of the Bitcoin network of the node
+func (n *BitcoindNode) NetParams() *chaincfg.Params {
+	return &chaincfg.RegressionNetParams
+}
+
+// GenerateBlocks mines the given number of blocks and returns their hashes
+func (n *BitcoindNode) GenerateBlocks(num int) []string {
+	var blockHashes []string
+	n.call("generatetoaddress", []interface{}{num, n.MiningAddress}, &blockHashes)
+	return blockHashes
+}
+
+// GetBlockCount returns the height of the tip of the node
+func (n *BitcoindNode) GetBlockCount() (uint64, error) {
+	var count uint64
+	err := n.client.Call(context.Background(), "getblockcount", []interface{}{}, &count)
+	return count, err
+}
+
+// GetBlockHash returns the hash of the block at the given height in the
+// canonical chain of the node
+func (n *BitcoindNode) GetBlockHash(height uint64) string {
+	var blockHash string
+	n.call("getblockhash", []interface{}{height}, &blockHash)
+	return blockHash
+}
+
+// GetBlockHeader returns the header of the block with the given hash
+func (n *BitcoindNode) GetBlockHeader(blockHash string) *wire.BlockHeader {
+	var headerHex string
+	n.call("getblockheader", []interface{}{blockHash, false}, &headerHex)
+	header := &wire.BlockHeader{}
+	require.NoError(n.t, header.Deserialize(bytes.NewReader(n.decodeHex(headerHex))))
+	return header
+}
+
+// GetBlock returns the block with the given hash
+func (n *BitcoindNode) GetBlock(blockHash string) *wire.MsgBlock {
+	var blockHex string
+	n.call("getblock", []interface{}{blockHash, 0}, &blockHex)
+	block := &wire.MsgBlock{}
+	require.NoError(n.t, block.Deserialize(bytes.NewReader(n.decodeHex(blockHex))))
+	return block
+}
+
+// FundAndSendTx adds inputs and a change output to the given tx using the
+// wallet, signs and broadcasts it, and returns the signed tx. The existing
+// outputs of the given tx keep their indices.
+func (n *BitcoindNode) FundAndSendTx(tx *wire.MsgTx) *wire.MsgTx {
+	var fundResult struct {
+		Hex string `json:"hex"`
+	}
+	n.call("fundrawtransaction", []interface{}{
+		n.encodeTx(tx),
+		map[string]interface{}{"changePosition": len(tx.TxOut)},
+	}, &fundResult)
+
+	var signResult struct {
+		Hex      string `json:"hex"`
+		Complete bool   `json:"complete"`
+	}
+	n.call("signrawtransactionwithwallet", []interface{}{fundResult.Hex}, &signResult)
+	require.True(n.t, signResult.Complete, "failed to sign the tx with the wallet")
+
+	signedTx := &wire.MsgTx{}
+	require.NoError(n.t, signedTx.Deserialize(bytes.NewReader(n.decodeHex(signResult.Hex))))
+	_, err := n.client.SendRawTransaction(context.Background(), signedTx)
+	require.NoError(n.t, err)
+	return signedTx
+}
+
+// FindTx returns the index of the tx with the given hash in the given block,
+// or -1 if the block does not include the tx
+func FindTx(block *wire.MsgBlock, tx *wire.MsgTx) int {
+	txHash := tx.TxHash()
+	for i, blockTx := range block.Transactions {
+		if blockTx.TxHash() == txHash {
+			return i
+		}
+	}
+	return -1
+}
+
+func (n *BitcoindNode) call(method string, params []interface{}, result interface{}) {
+	if params == nil {
+		params = []interface{}{}
+	}
+	if result == nil {
+		result = &json.RawMessage{}
+	}
+	err := n.client.Call(context.Background(), method, params, result)
+	require.NoError(n.t, err, "bitcoind RPC %s failed", method)
+}
+
+func (n *BitcoindNode) encodeTx(tx *wire.MsgTx) string {
+	var buf bytes.Buffer
+	require.NoError(n.t, tx.Serialize(&buf))
+	return hex.EncodeToString(buf.Bytes())
+}
+
+func (n *BitcoindNode) decodeHex(s string) []byte {
+	bz, err := hex.DecodeString(s)
+	require.NoError(n.t, err)
+	return bz
+}
+
+func (n *BitcoindNode) stop() {
+	// the stop RPC shuts down the node gracefully, after which the process
+	// exits on its own
+	if err := n.client.Call(context.Background(), "stop", []interface{}{}, &json.RawMessage{}); err != nil {
+		_ = n.cmd.Process.Kill()
+	}
+	_ = n.cmd.Wait()
+}
+
+// freePort returns a TCP port that is free at the time of calling
+func freePort(t *testing.T) int {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
@@ -0,0 +1,453 @@
+package e2e
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	txformat "github.com/babylonchain/babylon/btctxformatter"
+	"github.com/babylonchain/babylon/crypto/eots"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	ftypes "github.com/babylonchain/babylon/x/finality/types"
+)
+
+// maxBlocksToWait is the maximum number of Babylon blocks the harness
+// produces while waiting for a state transition
+const maxBlocksToWait = 100
+
+// FinalityProvider is a finality provider registered via the harness, along
+// with its secrets
+type FinalityProvider struct {
+	*bstypes.FinalityProvider
+	Account *Account
+	BTCSK   *btcec.PrivateKey
+	MSR     *eots.MasterSecretRand
+}
+
+// BTCDelegation is a BTC delegation created via the harness, along with its
+// secrets
+type BTCDelegation struct {
+	Staker        *Account
+	BTCSK         *btcec.PrivateKey
+	StakingTx     *wire.MsgTx
+	StakingTxHash string
+}
+
+// Harness drives the lifecycle of BTC staking on an in-process Babylon
+// network backed by a bitcoind regtest node, i.e., registering finality
+// providers, creating BTC delegations on Bitcoin, submitting covenant
+// signatures and finality signatures, and checkpointing epochs to Bitcoin
+type Harness struct {
+	t *testing.T
+	r *rand.Rand
+
+	BTC     *BitcoindNode
+	Network *Network
+	Relayer *HeaderRelayer
+	// CovenantSKs is the secret keys of the covenant committee in the
+	// genesis, i.e., the default covenant committee
+	CovenantSKs []*btcec.PrivateKey
+}
+
+// NewHarness starts a bitcoind regtest node and a Babylon network whose BTC
+// light client starts from the tip of the Bitcoin node. The first account of
+// the network relays BTC headers and submits checkpoints. The test is skipped
+// if bitcoind is not installed.
+func NewHarness(t *testing.T, cfg Config) *Harness {
+	btc := StartBitcoind(t)
+	network := NewNetwork(t, cfg, BaseHeader(btc))
+	covenantSKs, _, _ := bstypes.DefaultCovenantCommittee()
+	return &Harness{
+		t:           t,
+		r:           rand.New(rand.NewSource(time.Now().UnixNano())),
+		BTC:         btc,
+		Network:     network,
+		Relayer:     NewHeaderRelayer(btc, network, network.Accounts[0]),
+		CovenantSKs: covenantSKs,
+	}
+}
+
+// MineAndRelay mines the given number of BTC blocks and relays them to
+// Babylon
+func (h *Harness) MineAndRelay(numBlocks int) {
+	h.BTC.GenerateBlocks(numBlocks)
+	require.NoError(h.t, h.Relayer.Relay())
+}
+
+// DeliverMsgs delivers the given msgs signed by the given account and
+// requires them to succeed
+func (h *Harness) DeliverMsgs(acc *Account, msgs ...sdk.Msg) {
+	_, err := h.Network.DeliverMsgs(acc, msgs...)
+	require.NoError(h.t, err)
+}
+
+// ProduceBlocksUntil produces blocks until the given condition holds
+func (h *Harness) ProduceBlocksUntil(cond func(ctx sdk.Context) bool) {
+	for i := 0; i < maxBlocksToWait; i++ {
+		if cond(h.Network.QueryContext()) {
+			return
+		}
+		h.Network.ProduceBlock()
+	}
+	h.t.Fatalf("the condition does not hold after %d blocks", maxBlocksToWait)
+}
+
+// CreateFinalityProvider registers a finality provider with a random BTC key
+// and master randomness, operated by the given account
+func (h *Harness) CreateFinalityProvider(acc *Account) *FinalityProvider {
+	btcSK, _, err := datagen.GenRandomBTCKeyPair(h.r)
+	require.NoError(h.t, err)
+	msr, _, err := eots.NewMasterRandPair(h.r)
+	require.NoError(h.t, err)
+	fp, err := datagen.GenRandomCustomFinalityProvider(h.r, btcSK, acc.PrivKey, msr)
+	require.NoError(h.t, err)
+
+	h.DeliverMsgs(acc, &bstypes.MsgCreateFinalityProvider{
+		Signer:        acc.Address.String(),
+		Description:   fp.Description,
+		Commission:    fp.Commission,
+		BabylonPk:     fp.BabylonPk,
+		BtcPk:         fp.BtcPk,
+		Pop:           fp.Pop,
+		MasterPubRand: fp.MasterPubRand,
+	})
+
+	// read back the registered epoch
+	storedFP, err := h.Network.Nodes[0].App.BTCStakingKeeper.GetFinalityProvider(h.Network.QueryContext(), fp.BtcPk.MustMarshal())
+	require.NoError(h.t, err)
+	return &FinalityProvider{
+		FinalityProvider: storedFP,
+		Account:          acc,
+		BTCSK:            btcSK,
+		MSR:              msr,
+	}
+}
+
+// FinalizeEpochs checkpoints all epochs up to the given one to Bitcoin, and
+// waits until they are finalised, i.e., the checkpoints are w-deep
+func (h *Harness) FinalizeEpochs(endEpoch uint64) {
+	babylonApp := h.Network.Nodes[0].App
+	ckptKeeper := babylonApp.CheckpointingKeeper
+
+	// the checkpoint of the end epoch is sealed in the first block of the
+	// next epoch
+	h.ProduceBlocksUntil(func(ctx sdk.Context) bool {
+		ckpt, err := ckptKeeper.GetRawCheckpoint(ctx, endEpoch)
+		return err == nil && ckpt.Status != ckpttypes.Accumulating
+	})
+
+	startEpoch := ckptKeeper.GetLastFinalizedEpoch(h.Network.QueryContext()) + 1
+	for epoch := startEpoch; epoch <= endEpoch; epoch++ {
+		h.SubmitCheckpoint(epoch)
+	}
+
+	params := babylonApp.BtcCheckpointKeeper.GetParams(h.Network.QueryContext())
+	h.MineAndRelay(int(params.CheckpointFinalizationTimeout))
+	h.ProduceBlocksUntil(func(ctx sdk.Context) bool {
+		return ckptKeeper.GetLastFinalizedEpoch(ctx) >= endEpoch
+	})
+}
+
+// SubmitCheckpoint submits the sealed checkpoint of the given epoch to Bitcoin
+// in two OP_RETURN txs, mines a BTC block including them, relays the block,
+// and reports the inclusion proofs to Babylon
+func (h *Harness) SubmitCheckpoint(epoch uint64) {
+	babylonApp := h.Network.Nodes[0].App
+	ctx := h.Network.QueryContext()
+	submitter := h.Relayer.submitter
+
+	ckpt, err := babylonApp.CheckpointingKeeper.GetRawCheckpoint(ctx, epoch)
+	require.NoError(h.t, err)
+	btcCkpt, err := ckpttypes.FromRawCkptToBTCCkpt(ckpt.Ckpt, submitter.Address)
+	require.NoError(h.t, err)
+	tag := babylonApp.BtcCheckpointKeeper.GetExpectedTag(ctx)
+	data1, data2, err := txformat.EncodeCheckpointData(tag, txformat.CurrentVersion, btcCkpt)
+	require.NoError(h.t, err)
+
+	var txs []*wire.MsgTx
+	for _, data := range [][]byte{data1, data2} {
+		script, err := txscript.NullDataScript(data)
+		require.NoError(h.t, err)
+		tx := wire.NewMsgTx(wire.TxVersion)
+		tx.AddTxOut(wire.NewTxOut(0, script))
+		txs = append(txs, h.BTC.FundAndSendTx(tx))
+	}
+
+	block := h.BTC.GetBlock(h.BTC.GenerateBlocks(1)[0])
+	require.NoError(h.t, h.Relayer.Relay())
+
+	var proofs []*btcctypes.BTCSpvProof
+	for _, tx := range txs {
+		proofs = append(proofs, h.spvProof(block, tx))
+	}
+	h.DeliverMsgs(submitter, &btcctypes.MsgInsertBTCSpvProof{
+		Submitter: submitter.Address.String(),
+		Proofs:    proofs,
+	})
+}
+
+// CreateBTCDelegation stakes the given amount of BTC with the given staking
+// time under the given finality providers. The staking tx is funded by the
+// wallet of the Bitcoin node, mined and relayed to Babylon before submitting
+// the BTC delegation. The registered epochs of the finality providers need to
+// be finalised.
+func (h *Harness) CreateBTCDelegation(
+	staker *Account,
+	fps []*FinalityProvider,
+	stakingValue int64,
+	stakingTime uint16,
+) *BTCDelegation {
+	babylonApp := h.Network.Nodes[0].App
+	btcNet := h.Network.BTCNet()
+	ctx := h.Network.QueryContext()
+	params := babylonApp.BTCStakingKeeper.GetParams(ctx)
+	btccParams := babylonApp.BtcCheckpointKeeper.GetParams(ctx)
+
+	stakerSK, stakerPK, err := datagen.GenRandomBTCKeyPair(h.r)
+	require.NoError(h.t, err)
+	var fpPKs []*btcec.PublicKey
+	var fpBTCPKs []bbn.BIP340PubKey
+	for _, fp := range fps {
+		fpPKs = append(fpPKs, fp.BtcPk.MustToBTCPK())
+		fpBTCPKs = append(fpBTCPKs, *fp.BtcPk)
+	}
+	covenantPKs, err := bbn.NewBTCPKsFromBIP340PKs(params.CovenantPks)
+	require.NoError(h.t, err)
+
+	// stake on Bitcoin, with the staking output being the first output
+	stakingInfo, err := btcstaking.BuildStakingInfo(
+		stakerPK,
+		fpPKs,
+		covenantPKs,
+		params.CovenantQuorum,
+		stakingTime,
+		btcutil.Amount(stakingValue),
+		btcNet,
+	)
+	require.NoError(h.t, err)
+	stakingTx := wire.NewMsgTx(wire.TxVersion)
+	stakingTx.AddTxOut(stakingInfo.StakingOutput)
+	stakingTx = h.BTC.FundAndSendTx(stakingTx)
+	block := h.BTC.GetBlock(h.BTC.GenerateBlocks(1)[0])
+	require.NoError(h.t, h.Relayer.Relay())
+	stakingTxInfo := btcctypes.NewTransactionInfoFromSpvProof(h.spvProof(block, stakingTx))
+
+	// the slashing tx and the delegator's signature on it
+	slashingAddr, err := btcutil.DecodeAddress(params.SlashingAddress, btcNet)
+	require.NoError(h.t, err)
+	unbondingTime := uint16(bstypes.MinimumUnbondingTime(params, btccParams)) + 1
+	slashingMsgTx, err := btcstaking.BuildSlashingTxFromStakingTxStrict(
+		stakingTx,
+		datagen.StakingOutIdx,
+		slashingAddr,
+		stakerPK,
+		unbondingTime,
+		params.MinSlashingTxFeeSat,
+		params.SlashingRate,
+		btcNet,
+	)
+	require.NoError(h.t, err)
+	slashingTx, err := bstypes.NewBTCSlashingTxFromMsgTx(slashingMsgTx)
+	require.NoError(h.t, err)
+	slashingPathInfo, err := stakingInfo.SlashingPathSpendInfo()
+	require.NoError(h.t, err)
+	delSlashingSig, err := slashingTx.Sign(stakingTx, datagen.StakingOutIdx, slashingPathInfo.GetPkScriptPath(), stakerSK)
+	require.NoError(h.t, err)
+
+	// the unbonding tx, its slashing tx and the delegator's signature on the
+	// latter
+	stakingTxHash := stakingTx.TxHash()
+	unbondingValue := stakingValue - datagen.UnbondingTxFee
+	unbondingInfo := datagen.GenBTCUnbondingSlashingInfo(
+		h.r,
+		h.t,
+		btcNet,
+		stakerSK,
+		fpPKs,
+		covenantPKs,
+		params.CovenantQuorum,
+		wire.NewOutPoint(&stakingTxHash, datagen.StakingOutIdx),
+		stakingTime,
+		unbondingValue,
+		params.SlashingAddress,
+		params.SlashingRate,
+		unbondingTime,
+	)
+	delUnbondingSlashingSig, err := unbondingInfo.GenDelSlashingTxSig(stakerSK)
+	require.NoError(h.t, err)
+	unbondingTxBytes, err := bbn.SerializeBTCTx(unbondingInfo.UnbondingTx)
+	require.NoError(h.t, err)
+
+	pop, err := bstypes.NewPoP(staker.PrivKey, stakerSK)
+	require.NoError(h.t, err)
+	h.DeliverMsgs(staker, &bstypes.MsgCreateBTCDelegation{
+		Signer:                        staker.Address.String(),
+		BabylonPk:                     staker.PrivKey.PubKey().(*secp256k1.PubKey),
+		Pop:                           pop,
+		BtcPk:                         bbn.NewBIP340PubKeyFromBTCPK(stakerPK),
+		FpBtcPkList:                   fpBTCPKs,
+		StakingTime:                   uint32(stakingTime),
+		StakingValue:                  stakingValue,
+		StakingTx:                     stakingTxInfo,
+		SlashingTx:                    slashingTx,
+		DelegatorSlashingSig:          delSlashingSig,
+		UnbondingTime:                 uint32(unbondingTime),
+		UnbondingTx:                   unbondingTxBytes,
+		UnbondingValue:                unbondingValue,
+		UnbondingSlashingTx:           unbondingInfo.SlashingTx,
+		DelegatorUnbondingSlashingSig: delUnbondingSlashingSig,
+	})
+
+	return &BTCDelegation{
+		Staker:        staker,
+		BTCSK:         stakerSK,
+		StakingTx:     stakingTx,
+		StakingTxHash: stakingTxHash.String(),
+	}
+}
+
+// AddCovenantSigs submits the signatures of a quorum of the covenant
+// committee on the given BTC delegation
+func (h *Harness) AddCovenantSigs(submitter *Account, del *BTCDelegation) {
+	babylonApp := h.Network.Nodes[0].App
+	btcNet := h.Network.BTCNet()
+	ctx := h.Network.QueryContext()
+
+	btcDel, err := babylonApp.BTCStakingKeeper.GetBTCDelegation(ctx, del.StakingTxHash)
+	require.NoError(h.t, err)
+	params := babylonApp.BTCStakingKeeper.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	require.NotNil(h.t, params)
+	fpPKs, err := bbn.NewBTCPKsFromBIP340PKs(btcDel.FpBtcPkList)
+	require.NoError(h.t, err)
+	stakingTx, err := bbn.NewBTCTxFromBytes(btcDel.StakingTx)
+	require.NoError(h.t, err)
+	unbondingTx, err := bbn.NewBTCTxFromBytes(btcDel.BtcUndelegation.UnbondingTx)
+	require.NoError(h.t, err)
+
+	// adaptor signatures on the slashing tx
+	stakingInfo, err := btcDel.GetStakingInfo(params, btcNet)
+	require.NoError(h.t, err)
+	slashingPathInfo, err := stakingInfo.SlashingPathSpendInfo()
+	require.NoError(h.t, err)
+	covSlashingSigs, err := datagen.GenCovenantAdaptorSigs(
+		h.CovenantSKs,
+		fpPKs,
+		stakingTx,
+		slashingPathInfo.GetPkScriptPath(),
+		btcDel.SlashingTx,
+	)
+	require.NoError(h.t, err)
+
+	// Schnorr signatures on the unbonding tx
+	unbondingPathInfo, err := stakingInfo.UnbondingPathSpendInfo()
+	require.NoError(h.t, err)
+	covUnbondingSigs, err := datagen.GenCovenantUnbondingSigs(
+		h.CovenantSKs,
+		stakingTx,
+		btcDel.StakingOutputIdx,
+		unbondingPathInfo.GetPkScriptPath(),
+		unbondingTx,
+	)
+	require.NoError(h.t, err)
+
+	// adaptor signatures on the unbonding slashing tx
+	unbondingInfo, err := btcDel.GetUnbondingInfo(params, btcNet)
+	require.NoError(h.t, err)
+	unbondingSlashingPathInfo, err := unbondingInfo.SlashingPathSpendInfo()
+	require.NoError(h.t, err)
+	covUnbondingSlashingSigs, err := datagen.GenCovenantAdaptorSigs(
+		h.CovenantSKs,
+		fpPKs,
+		unbondingTx,
+		unbondingSlashingPathInfo.GetPkScriptPath(),
+		btcDel.BtcUndelegation.SlashingTx,
+	)
+	require.NoError(h.t, err)
+
+	var msgs []sdk.Msg
+	for i := 0; i < int(params.CovenantQuorum); i++ {
+		msgs = append(msgs, &bstypes.MsgAddCovenantSigs{
+			Signer:                  submitter.Address.String(),
+			Pk:                      covSlashingSigs[i].CovPk,
+			StakingTxHash:           del.StakingTxHash,
+			SlashingTxSigs:          covSlashingSigs[i].AdaptorSigs,
+			UnbondingTxSig:          bbn.NewBIP340SignatureFromBTCSig(covUnbondingSigs[i]),
+			SlashingUnbondingTxSigs: covUnbondingSlashingSigs[i].AdaptorSigs,
+		})
+	}
+	h.DeliverMsgs(submitter, msgs...)
+}
+
+// ActivateBTCDelegation mines and relays BTC blocks until the staking tx of
+// the given BTC delegation reaches the activation depth, and produces a block
+// so that the BTC delegation is counted in the voting power table. The BTC
+// delegation needs a covenant quorum.
+func (h *Harness) ActivateBTCDelegation(del *BTCDelegation) {
+	babylonApp := h.Network.Nodes[0].App
+	for i := 0; i < maxBlocksToWait; i++ {
+		ctx := h.Network.QueryContext()
+		btcDel, err := babylonApp.BTCStakingKeeper.GetBTCDelegation(ctx, del.StakingTxHash)
+		require.NoError(h.t, err)
+		params := babylonApp.BTCStakingKeeper.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+		btcTip := babylonApp.BTCLightClientKeeper.GetTipInfo(ctx).Height
+		wValue := babylonApp.BtcCheckpointKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+		if btcDel.GetStatus(btcTip, wValue, params.CovenantQuorum) == bstypes.BTCDelegationStatus_ACTIVE {
+			// the voting power table is updated in the next block
+			h.Network.ProduceBlock()
+			return
+		}
+		h.MineAndRelay(1)
+	}
+	h.t.Fatalf("the BTC delegation %s is not activated", del.StakingTxHash)
+}
+
+// AddFinalitySig submits the finality signature of the given finality
+// provider on the block at the given height
+func (h *Harness) AddFinalitySig(fp *FinalityProvider, height uint64) {
+	ctx := h.Network.QueryContext()
+	block, err := h.Network.Nodes[0].App.FinalityKeeper.GetBlock(ctx, height)
+	require.NoError(h.t, err)
+
+	msg := &ftypes.MsgAddFinalitySig{
+		Signer:       fp.Account.Address.String(),
+		FpBtcPk:      fp.BtcPk,
+		BlockHeight:  height,
+		BlockAppHash: block.AppHash,
+	}
+	sr, _, err := fp.MSR.DeriveRandPair(uint32(height))
+	require.NoError(h.t, err)
+	sig, err := eots.Sign(fp.BTCSK, sr, msg.MsgToSign())
+	require.NoError(h.t, err)
+	msg.FinalitySig = bbn.NewSchnorrEOTSSigFromModNScalar(sig)
+	h.DeliverMsgs(fp.Account, msg)
+}
+
+// spvProof returns the inclusion proof of the given tx in the given block
+func (h *Harness) spvProof(block *wire.MsgBlock, tx *wire.MsgTx) *btcctypes.BTCSpvProof {
+	idx := FindTx(block, tx)
+	require.GreaterOrEqual(h.t, idx, 0, "the block does not include tx %s", tx.TxHash())
+
+	var txsBytes [][]byte
+	for _, blockTx := range block.Transactions {
+		txBytes, err := bbn.SerializeBTCTx(blockTx)
+		require.NoError(h.t, err)
+		txsBytes = append(txsBytes, txBytes)
+	}
+	headerBytes := bbn.NewBTCHeaderBytesFromBlockHeader(&block.Header)
+	proof, err := btcctypes.SpvProofFromHeaderAndTransactions(&headerBytes, txsBytes, uint(idx))
+	require.NoError(h.t, err)
+	return proof
+}
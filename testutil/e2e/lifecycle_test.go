@@ -0,0 +1,53 @@
+//go:build e2e
+// +build e2e
+
+package e2e_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/e2e"
+)
+
+// TestBTCStakingLifecycle drives a BTC delegation from staking on Bitcoin to
+// finalising Babylon blocks, and checkpoints the resulting epoch to Bitcoin
+func TestBTCStakingLifecycle(t *testing.T) {
+	h := e2e.NewHarness(t, e2e.DefaultConfig())
+	babylonApp := h.Network.Nodes[0].App
+
+	// register a finality provider and finalise its registered epoch
+	fp := h.CreateFinalityProvider(h.Network.Accounts[1])
+	h.FinalizeEpochs(fp.RegisteredEpoch)
+
+	// stake on Bitcoin and activate the BTC delegation
+	del := h.CreateBTCDelegation(h.Network.Accounts[2], []*e2e.FinalityProvider{fp}, 10_000_000, 10_000)
+	h.AddCovenantSigs(h.Network.Accounts[3], del)
+	h.ActivateBTCDelegation(del)
+
+	ctx := h.Network.QueryContext()
+	activatedHeight, err := babylonApp.BTCStakingKeeper.GetBTCStakingActivatedHeight(ctx)
+	require.NoError(t, err)
+	votingPower := babylonApp.BTCStakingKeeper.GetVotingPower(ctx, fp.BtcPk.MustMarshal(), activatedHeight)
+	require.Equal(t, uint64(10_000_000), votingPower)
+
+	// the finality provider votes on all blocks since BTC staking is
+	// activated, which become finalised
+	tipHeight := uint64(h.Network.Height())
+	for height := activatedHeight; height <= tipHeight; height++ {
+		h.AddFinalitySig(fp, height)
+	}
+	h.Network.ProduceBlock()
+	ctx = h.Network.QueryContext()
+	for height := activatedHeight; height <= tipHeight; height++ {
+		block, err := babylonApp.FinalityKeeper.GetBlock(ctx, height)
+		require.NoError(t, err)
+		require.True(t, block.Finalized, "block %d is not finalised", height)
+	}
+
+	// checkpoint the current epoch to Bitcoin
+	epoch := babylonApp.EpochingKeeper.GetEpoch(ctx).EpochNumber
+	h.FinalizeEpochs(epoch)
+	require.GreaterOrEqual(t, babylonApp.CheckpointingKeeper.GetLastFinalizedEpoch(h.Network.QueryContext()), epoch)
+}
@@ -0,0 +1,439 @@
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/server"
+	simsutils "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/app"
+	appparams "github.com/babylonchain/babylon/app/params"
+	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
+)
+
+const (
+	// votingPower is the voting power of each genesis validator, i.e., the
+	// bonded tokens of each validator divided by the power reduction
+	votingPower = 1000
+	// blockInterval is the time between two consecutive Babylon blocks
+	blockInterval = 5 * time.Second
+	// txGasLimit is the gas limit of each tx delivered via the network
+	txGasLimit = 100_000_000
+)
+
+// Config is the configuration of the in-process Babylon network
+type Config struct {
+	// NumValidators is the number of Babylon nodes, each of which runs a
+	// genesis validator
+	NumValidators int
+	// NumAccounts is the number of funded accounts for submitting txs
+	NumAccounts int
+	// EpochInterval is the number of blocks in each epoch
+	EpochInterval uint64
+	// BTCConfirmationDepth is the k parameter of BTC timestamping
+	BTCConfirmationDepth uint64
+	// BTCFinalizationTimeout is the w parameter of BTC timestamping
+	BTCFinalizationTimeout uint64
+}
+
+func DefaultConfig() Config {
+	return Config{
+		NumValidators:          4,
+		NumAccounts:            4,
+		EpochInterval:          5,
+		BTCConfirmationDepth:   2,
+		BTCFinalizationTimeout: 4,
+	}
+}
+
+// Account is a funded Babylon account
+type Account struct {
+	PrivKey *secp256k1.PrivKey
+	Address sdk.AccAddress
+}
+
+// Node is a Babylon node of the network, whose app is driven via ABCI calls
+// in the same process
+type Node struct {
+	App    *app.BabylonApp
+	Signer *app.PrivSigner
+}
+
+func (n *Node) consAddress() []byte {
+	return n.Signer.WrappedPV.Key.PubKey.Address()
+}
+
+// Network is an in-process Babylon network. It plays the role of CometBFT,
+// i.e., it proposes, votes on and commits blocks with all nodes via ABCI,
+// including the vote extensions carrying the BLS signatures of checkpoints.
+type Network struct {
+	t      *testing.T
+	r      *rand.Rand
+	cfg    Config
+	btcNet *chaincfg.Params
+
+	Nodes    []*Node
+	Accounts []*Account
+
+	height        int64
+	blockTime     time.Time
+	lastBlockHash []byte
+	// lastVotes is the extended votes of all nodes on the last block
+	lastVotes       []abci.ExtendedVoteInfo
+	consensusParams cmtproto.ConsensusParams
+}
+
+// NewNetwork creates a Babylon network on the regtest Bitcoin network, whose
+// BTC light client starts from the given base header, and commits the first
+// block
+func NewNetwork(t *testing.T, cfg Config, baseBTCHeader *btclctypes.BTCHeaderInfo) *Network {
+	btcNet := &chaincfg.RegressionNetParams
+
+	// funded accounts
+	var (
+		accounts []*Account
+		genAccs  []authtypes.GenesisAccount
+		balances []banktypes.Balance
+	)
+	for i := 0; i < cfg.NumAccounts; i++ {
+		sk := secp256k1.GenPrivKey()
+		addr := sdk.AccAddress(sk.PubKey().Address())
+		accounts = append(accounts, &Account{PrivKey: sk, Address: addr})
+		genAccs = append(genAccs, authtypes.NewBaseAccount(addr, sk.PubKey(), uint64(i), 0))
+		balances = append(balances, banktypes.Balance{
+			Address: addr.String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(appparams.DefaultBondDenom, math.NewInt(100000000000000))),
+		})
+	}
+	require.NotEmpty(t, accounts, "the network needs at least one funded account")
+
+	// nodes, each with its own validator keys
+	var (
+		nodes  []*Node
+		valSet []*checkpointingtypes.GenesisKey
+	)
+	chainID := fmt.Sprintf("e2e-%d", time.Now().UnixNano())
+	for i := 0; i < cfg.NumValidators; i++ {
+		ps, err := app.SetupTestPrivSigner()
+		require.NoError(t, err)
+		genesisKey, err := app.GenesisKeyFromPrivSigner(ps)
+		require.NoError(t, err)
+		valSet = append(valSet, genesisKey)
+		nodes = append(nodes, &Node{
+			App:    newBabylonApp(t, ps, chainID),
+			Signer: ps,
+		})
+	}
+
+	// the genesis shared by all nodes
+	genesisState := nodes[0].App.DefaultGenesis()
+	genesisState = app.GenesisStateWithValSet(t, nodes[0].App, genesisState, valSet, genAccs, balances...)
+	setGenesisParams(t, nodes[0].App, genesisState, cfg, btcNet, baseBTCHeader)
+	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+
+	consensusParams := cmttypes.DefaultConsensusParams().ToProto()
+	consensusParams.Block.MaxGas = -1
+	consensusParams.Abci = &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 1}
+
+	n := &Network{
+		t:               t,
+		r:               rand.New(rand.NewSource(time.Now().UnixNano())),
+		cfg:             cfg,
+		btcNet:          btcNet,
+		Nodes:           nodes,
+		Accounts:        accounts,
+		blockTime:       time.Now().UTC(),
+		consensusParams: consensusParams,
+	}
+	for _, node := range n.Nodes {
+		_, err := node.App.InitChain(&abci.RequestInitChain{
+			ChainId:         chainID,
+			Time:            n.blockTime,
+			Validators:      []abci.ValidatorUpdate{},
+			ConsensusParams: &n.consensusParams,
+			InitialHeight:   1,
+			AppStateBytes:   stateBytes,
+		})
+		require.NoError(t, err)
+	}
+	n.ProduceBlock()
+
+	return n
+}
+
+func newBabylonApp(t *testing.T, ps *app.PrivSigner, chainID string) *app.BabylonApp {
+	appOptions := make(simsutils.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = t.TempDir()
+	appOptions[flags.FlagChainID] = chainID
+	appOptions["btc-config.network"] = string(bbn.BtcRegtest)
+	appOptions[server.FlagPruning] = pruningtypes.PruningOptionDefault
+	// the proposer includes the txs given by the network as they are rather
+	// than the ones in its mempool
+	appOptions[server.FlagMempoolMaxTxs] = -1
+	baseAppOpts := server.DefaultBaseappOptions(appOptions)
+	return app.NewBabylonApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		map[int64]bool{},
+		0,
+		ps,
+		appOptions,
+		app.EmptyWasmOpts,
+		baseAppOpts...,
+	)
+}
+
+// setGenesisParams adjusts the genesis of modules for the regtest Bitcoin
+// network and shortens epochs and BTC timestamping for tests
+func setGenesisParams(
+	t *testing.T,
+	babylonApp *app.BabylonApp,
+	genesisState app.GenesisState,
+	cfg Config,
+	btcNet *chaincfg.Params,
+	baseBTCHeader *btclctypes.BTCHeaderInfo,
+) {
+	cdc := babylonApp.AppCodec()
+
+	btclcGenesis := btclctypes.DefaultGenesis()
+	btclcGenesis.BtcHeaders = []*btclctypes.BTCHeaderInfo{baseBTCHeader}
+	genesisState[btclctypes.ModuleName] = cdc.MustMarshalJSON(btclcGenesis)
+
+	btccGenesis := btcctypes.DefaultGenesis()
+	btccGenesis.Params.BtcConfirmationDepth = cfg.BTCConfirmationDepth
+	btccGenesis.Params.CheckpointFinalizationTimeout = cfg.BTCFinalizationTimeout
+	genesisState[btcctypes.ModuleName] = cdc.MustMarshalJSON(btccGenesis)
+
+	epochingGenesis := epochingtypes.DefaultGenesis()
+	epochingGenesis.Params.EpochInterval = cfg.EpochInterval
+	genesisState[epochingtypes.ModuleName] = cdc.MustMarshalJSON(epochingGenesis)
+
+	// the default slashing address is on simnet
+	slashingAddr, err := btcutil.NewAddressPubKeyHash(make([]byte, 20), btcNet)
+	require.NoError(t, err)
+	bsGenesis := bstypes.DefaultGenesis()
+	bsGenesis.Params[0].SlashingAddress = slashingAddr.EncodeAddress()
+	genesisState[bstypes.ModuleName] = cdc.MustMarshalJSON(bsGenesis)
+}
+
+// Height returns the height of the last committed block
+func (n *Network) Height() int64 {
+	return n.height
+}
+
+// BTCNet returns the parameters of the Bitcoin network of Babylon
+func (n *Network) BTCNet() *chaincfg.Params {
+	return n.btcNet
+}
+
+// QueryContext returns a context on the last committed state of the first
+// node
+func (n *Network) QueryContext() sdk.Context {
+	ctx, err := n.Nodes[0].App.CreateQueryContext(0, false)
+	require.NoError(n.t, err)
+	return ctx
+}
+
+// ProduceBlock proposes a block with the given txs, executes and commits it
+// on all nodes, and ensures all nodes reach the same app hash
+func (n *Network) ProduceBlock(txs ...[]byte) *abci.ResponseFinalizeBlock {
+	res, _ := n.produceBlock(txs)
+	return res
+}
+
+// produceBlock produces a block with the given txs and returns the execution
+// results along with the txs in the block
+func (n *Network) produceBlock(txs [][]byte) (*abci.ResponseFinalizeBlock, [][]byte) {
+	height := n.height + 1
+	blockTime := n.blockTime.Add(blockInterval)
+	proposer := n.Nodes[int(height)%len(n.Nodes)]
+	lastCommit := abci.ExtendedCommitInfo{Votes: n.lastVotes}
+
+	// 1. the proposer prepares the block, e.g., injecting the checkpoint
+	// built from the vote extensions in the last block
+	prepareRes, err := proposer.App.PrepareProposal(&abci.RequestPrepareProposal{
+		MaxTxBytes:      n.consensusParams.Block.MaxBytes,
+		Txs:             txs,
+		LocalLastCommit: lastCommit,
+		Height:          height,
+		Time:            blockTime,
+		ProposerAddress: proposer.consAddress(),
+	})
+	require.NoError(n.t, err)
+	blockTxs := prepareRes.Txs
+	blockHash := n.blockHash(height, blockTxs)
+
+	// 2. all nodes verify the proposal
+	for i, node := range n.Nodes {
+		processRes, err := node.App.ProcessProposal(&abci.RequestProcessProposal{
+			Txs:             blockTxs,
+			Hash:            blockHash,
+			Height:          height,
+			Time:            blockTime,
+			ProposerAddress: proposer.consAddress(),
+		})
+		require.NoError(n.t, err)
+		require.True(n.t, processRes.IsAccepted(), "node %d rejects the block at height %d", i, height)
+	}
+
+	// 3. all nodes extend their precommits, e.g., with BLS signatures at the
+	// end of epochs
+	votes := make([]abci.ExtendedVoteInfo, 0, len(n.Nodes))
+	for _, node := range n.Nodes {
+		extendRes, err := node.App.ExtendVote(context.Background(), &abci.RequestExtendVote{
+			Hash:   blockHash,
+			Height: height,
+		})
+		require.NoError(n.t, err)
+		votes = append(votes, n.signVoteExtension(node, height, extendRes.VoteExtension))
+	}
+
+	// 4. all nodes execute and commit the block
+	var finalizeRes *abci.ResponseFinalizeBlock
+	for i, node := range n.Nodes {
+		res, err := node.App.FinalizeBlock(&abci.RequestFinalizeBlock{
+			Txs:             blockTxs,
+			Hash:            blockHash,
+			Height:          height,
+			Time:            blockTime,
+			ProposerAddress: proposer.consAddress(),
+		})
+		require.NoError(n.t, err)
+		if finalizeRes != nil {
+			require.Equal(n.t, finalizeRes.AppHash, res.AppHash, "node %d diverges at height %d", i, height)
+		}
+		finalizeRes = res
+
+		_, err = node.App.Commit()
+		require.NoError(n.t, err)
+	}
+
+	n.height = height
+	n.blockTime = blockTime
+	n.lastBlockHash = blockHash
+	n.lastVotes = votes
+	return finalizeRes, blockTxs
+}
+
+// ProduceBlocks produces the given number of empty blocks
+func (n *Network) ProduceBlocks(num int) {
+	for i := 0; i < num; i++ {
+		n.ProduceBlock()
+	}
+}
+
+// DeliverMsgs signs a tx with the given msgs by the given account, includes
+// it in a new block and returns its execution result. An error is returned if
+// the tx fails.
+func (n *Network) DeliverMsgs(acc *Account, msgs ...sdk.Msg) (*abci.ExecTxResult, error) {
+	txBytes := n.SignTx(acc, 0, msgs...)
+	res, blockTxs := n.produceBlock([][]byte{txBytes})
+	for i, blockTx := range blockTxs {
+		if !bytes.Equal(blockTx, txBytes) {
+			continue
+		}
+		// the proposer may inject a checkpoint tx, thus the tx results are
+		// indexed by the txs in the block rather than the given txs
+		txRes := res.TxResults[i]
+		if !txRes.IsOK() {
+			return txRes, fmt.Errorf("tx failed with code %d: %s", txRes.Code, txRes.Log)
+		}
+		return txRes, nil
+	}
+	return nil, fmt.Errorf("the tx is not included in the block")
+}
+
+// SignTx signs a tx with the given msgs by the given account. The offset is
+// added to the sequence of the account, so that several txs of the account
+// can be included in the same block.
+func (n *Network) SignTx(acc *Account, seqOffset uint64, msgs ...sdk.Msg) []byte {
+	babylonApp := n.Nodes[0].App
+	account := babylonApp.AccountKeeper.GetAccount(n.QueryContext(), acc.Address)
+	require.NotNil(n.t, account, "account %s is not found", acc.Address)
+
+	tx, err := simsutils.GenSignedMockTx(
+		n.r,
+		babylonApp.TxConfig(),
+		msgs,
+		sdk.NewCoins(),
+		txGasLimit,
+		babylonApp.ChainID(),
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence() + seqOffset},
+		acc.PrivKey,
+	)
+	require.NoError(n.t, err)
+	txBytes, err := babylonApp.TxConfig().TxEncoder()(tx)
+	require.NoError(n.t, err)
+	return txBytes
+}
+
+// blockHash returns a deterministic hash of the block at the given height,
+// which stands for the block ID signed by CometBFT validators
+func (n *Network) blockHash(height int64, txs [][]byte) []byte {
+	h := sha256.New()
+	h.Write(sdk.Uint64ToBigEndian(uint64(height)))
+	h.Write(n.lastBlockHash)
+	for _, tx := range txs {
+		txHash := sha256.Sum256(tx)
+		h.Write(txHash[:])
+	}
+	return h.Sum(nil)
+}
+
+// signVoteExtension signs the given vote extension with the validator key of
+// the given node, as CometBFT does upon precommitting
+func (n *Network) signVoteExtension(node *Node, height int64, voteExt []byte) abci.ExtendedVoteInfo {
+	cve := cmtproto.CanonicalVoteExtension{
+		Extension: voteExt,
+		Height:    height,
+		Round:     0,
+		ChainId:   node.App.ChainID(),
+	}
+	var cveBuffer bytes.Buffer
+	err := protoio.NewDelimitedWriter(&cveBuffer).WriteMsg(&cve)
+	require.NoError(n.t, err)
+	extensionSig, err := node.Signer.WrappedPV.GetValPrivKey().Sign(cveBuffer.Bytes())
+	require.NoError(n.t, err)
+
+	return abci.ExtendedVoteInfo{
+		Validator: abci.Validator{
+			Address: node.consAddress(),
+			Power:   votingPower,
+		},
+		VoteExtension:      voteExt,
+		ExtensionSignature: extensionSig,
+		BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+	}
+}
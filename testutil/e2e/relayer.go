@@ -0,0 +1,85 @@
+package e2e
+
+import (
+	bbn "github.com/babylonchain/babylon/types"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
+)
+
+// maxHeadersPerMsg is the maximum number of headers the relayer submits in a
+// single MsgInsertHeaders
+const maxHeadersPerMsg = 100
+
+// HeaderRelayer is a minimal BTC header relayer, which submits the headers of
+// the Bitcoin node to the BTC light client of the Babylon network
+type HeaderRelayer struct {
+	btc       *BitcoindNode
+	network   *Network
+	submitter *Account
+}
+
+func NewHeaderRelayer(btc *BitcoindNode, network *Network, submitter *Account) *HeaderRelayer {
+	return &HeaderRelayer{
+		btc:       btc,
+		network:   network,
+		submitter: submitter,
+	}
+}
+
+// BaseHeader returns the tip of the Bitcoin node as the base header of the
+// BTC light client
+func BaseHeader(btc *BitcoindNode) *btclctypes.BTCHeaderInfo {
+	height, err := btc.GetBlockCount()
+	if err != nil {
+		btc.t.Fatalf("failed to get the tip of the Bitcoin node: %v", err)
+	}
+	header := btc.GetBlockHeader(btc.GetBlockHash(height))
+	headerBytes := bbn.NewBTCHeaderBytesFromBlockHeader(header)
+	blockHash := header.BlockHash()
+	headerHash := bbn.NewBTCHeaderHashBytesFromChainhash(&blockHash)
+	work := btclctypes.CalcWork(&headerBytes)
+	return btclctypes.NewBTCHeaderInfo(&headerBytes, &headerHash, height, &work)
+}
+
+// Relay submits the headers in the canonical chain of the Bitcoin node that
+// the BTC light client does not have yet. Upon a reorg, the headers since the
+// fork point are submitted.
+func (r *HeaderRelayer) Relay() error {
+	btcTipHeight, err := r.btc.GetBlockCount()
+	if err != nil {
+		return err
+	}
+
+	// find the highest header in the canonical chain of the Bitcoin node that
+	// the BTC light client has
+	lcKeeper := r.network.Nodes[0].App.BTCLightClientKeeper
+	ctx := r.network.QueryContext()
+	height := min(lcKeeper.GetTipInfo(ctx).Height, btcTipHeight)
+	for {
+		headerHash, err := bbn.NewBTCHeaderHashBytesFromHex(r.btc.GetBlockHash(height))
+		if err != nil {
+			return err
+		}
+		if lcKeeper.GetHeaderByHash(ctx, &headerHash) != nil {
+			break
+		}
+		height--
+	}
+
+	var headers []bbn.BTCHeaderBytes
+	for h := height + 1; h <= btcTipHeight; h++ {
+		header := r.btc.GetBlockHeader(r.btc.GetBlockHash(h))
+		headers = append(headers, bbn.NewBTCHeaderBytesFromBlockHeader(header))
+	}
+	for len(headers) > 0 {
+		n := min(len(headers), maxHeadersPerMsg)
+		msg := &btclctypes.MsgInsertHeaders{
+			Signer:  r.submitter.Address.String(),
+			Headers: headers[:n],
+		}
+		if _, err := r.network.DeliverMsgs(r.submitter, msg); err != nil {
+			return err
+		}
+		headers = headers[n:]
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/btcsuite/btcd/chaincfg"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -19,6 +20,7 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 
+	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btccheckpoint/keeper"
 	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
 )
@@ -40,6 +42,14 @@ func NewBTCCheckpointKeeper(
 	registry := codectypes.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(registry)
 
+	// the PoW limit overrides the one of simnet so that tests can use
+	// headers of any difficulty. Tests that do not care about the PoW limit
+	// pass nil and use the one of simnet
+	btcNetParams := chaincfg.SimNetParams
+	if powLimit != nil {
+		btcNetParams.PowLimit = powLimit
+	}
+
 	k := keeper.NewKeeper(
 		cdc,
 		runtime.NewKVStoreService(storeKey),
@@ -47,7 +57,7 @@ func NewBTCCheckpointKeeper(
 		lk,
 		ek,
 		ik,
-		powLimit,
+		bbn.NewBtcConfig(&btcNetParams),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -42,10 +42,12 @@ func NewBTCHeaderBytesList(chain []*wire.BlockHeader) []bbn.BTCHeaderBytes {
 
 func BTCLightClientKeeperWithCustomParams(t testing.TB, p btclightclientt.Params) (*btclightclientk.Keeper, sdk.Context, corestore.KVStoreService) {
 	storeKey := storetypes.NewKVStoreKey(btclightclientt.StoreKey)
+	tstoreKey := storetypes.NewTransientStoreKey(btclightclientt.TStoreKey)
 
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(tstoreKey, storetypes.StoreTypeTransient, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
@@ -57,6 +59,7 @@ func BTCLightClientKeeperWithCustomParams(t testing.TB, p btclightclientt.Params
 	k := btclightclientk.NewKeeper(
 		cdc,
 		stServ,
+		tstoreKey,
 		testCfg,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
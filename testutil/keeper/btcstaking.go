@@ -19,6 +19,7 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 
+	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btcstaking/keeper"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 )
@@ -45,7 +46,7 @@ func BTCStakingKeeper(
 		btclcKeeper,
 		btccKeeper,
 		ckptKeeper,
-		&chaincfg.SimNetParams,
+		bbn.NewBtcConfig(&chaincfg.SimNetParams),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
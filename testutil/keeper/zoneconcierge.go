@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"testing"
 
 	"cosmossdk.io/core/header"
@@ -49,6 +50,9 @@ func (zoneconciergeChannelKeeper) GetAllChannels(ctx sdk.Context) []channeltypes
 func (zoneconciergeChannelKeeper) GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, ibcexported.ClientState, error) {
 	return "", nil, nil
 }
+func (zoneconciergeChannelKeeper) GetConnection(ctx sdk.Context, connectionID string) (ibcexported.ConnectionI, error) {
+	return nil, fmt.Errorf("connection %s not found", connectionID)
+}
 
 // zoneconciergeportKeeper is a stub of PortKeeper
 type zoneconciergePortKeeper struct{}
@@ -69,7 +73,23 @@ func (zoneconciergeStoreQuerier) Query(req *storetypes.RequestQuery) (*storetype
 	}, nil
 }
 
-func ZoneConciergeKeeper(t testing.TB, btclcKeeper types.BTCLightClientKeeper, checkpointingKeeper types.CheckpointingKeeper, btccKeeper types.BtcCheckpointKeeper, epochingKeeper types.EpochingKeeper) (*keeper.Keeper, sdk.Context) {
+func ZoneConciergeKeeper(t testing.TB, btclcKeeper types.BTCLightClientKeeper, checkpointingKeeper types.CheckpointingKeeper, btccKeeper types.BtcCheckpointKeeper, epochingKeeper types.EpochingKeeper, btcStakingKeeper types.BTCStakingKeeper) (*keeper.Keeper, sdk.Context) {
+	return zoneConciergeKeeper(t, nil, nil, zoneconciergeChannelKeeper{}, nil, btclcKeeper, checkpointingKeeper, btccKeeper, epochingKeeper, btcStakingKeeper)
+}
+
+// ZoneConciergeKeeperWithClientKeeper is ZoneConciergeKeeper with the given
+// IBC client keeper
+func ZoneConciergeKeeperWithClientKeeper(t testing.TB, clientKeeper types.ClientKeeper, btclcKeeper types.BTCLightClientKeeper, checkpointingKeeper types.CheckpointingKeeper, btccKeeper types.BtcCheckpointKeeper, epochingKeeper types.EpochingKeeper, btcStakingKeeper types.BTCStakingKeeper) (*keeper.Keeper, sdk.Context) {
+	return zoneConciergeKeeper(t, nil, clientKeeper, zoneconciergeChannelKeeper{}, nil, btclcKeeper, checkpointingKeeper, btccKeeper, epochingKeeper, btcStakingKeeper)
+}
+
+// ZoneConciergeKeeperWithIBCKeepers is ZoneConciergeKeeper with the given
+// ICS4 wrapper, IBC channel keeper and scoped keeper, for sending IBC packets
+func ZoneConciergeKeeperWithIBCKeepers(t testing.TB, ics4Wrapper types.ICS4Wrapper, channelKeeper types.ChannelKeeper, scopedKeeper types.ScopedKeeper, btclcKeeper types.BTCLightClientKeeper, checkpointingKeeper types.CheckpointingKeeper, btccKeeper types.BtcCheckpointKeeper, epochingKeeper types.EpochingKeeper, btcStakingKeeper types.BTCStakingKeeper) (*keeper.Keeper, sdk.Context) {
+	return zoneConciergeKeeper(t, ics4Wrapper, nil, channelKeeper, scopedKeeper, btclcKeeper, checkpointingKeeper, btccKeeper, epochingKeeper, btcStakingKeeper)
+}
+
+func zoneConciergeKeeper(t testing.TB, ics4Wrapper types.ICS4Wrapper, clientKeeper types.ClientKeeper, channelKeeper types.ChannelKeeper, scopedKeeper types.ScopedKeeper, btclcKeeper types.BTCLightClientKeeper, checkpointingKeeper types.CheckpointingKeeper, btccKeeper types.BtcCheckpointKeeper, epochingKeeper types.EpochingKeeper, btcStakingKeeper types.BTCStakingKeeper) (*keeper.Keeper, sdk.Context) {
 	logger := log.NewTestLogger(t)
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
@@ -82,13 +102,16 @@ func ZoneConciergeKeeper(t testing.TB, btclcKeeper types.BTCLightClientKeeper, c
 
 	registry := codectypes.NewInterfaceRegistry()
 	appCodec := codec.NewProtoCodec(registry)
-	capabilityKeeper := capabilitykeeper.NewKeeper(appCodec, storeKey, memStoreKey)
+	if scopedKeeper == nil {
+		capabilityKeeper := capabilitykeeper.NewKeeper(appCodec, storeKey, memStoreKey)
+		scopedKeeper = capabilityKeeper.ScopeToModule("ZoneconciergeScopedKeeper")
+	}
 	k := keeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(storeKey),
-		nil, // TODO: mock this keeper
-		nil, // TODO: mock this keeper
-		zoneconciergeChannelKeeper{},
+		ics4Wrapper,
+		clientKeeper,
+		channelKeeper,
 		zoneconciergePortKeeper{},
 		nil, // TODO: mock this keeper
 		nil, // TODO: mock this keeper
@@ -96,8 +119,9 @@ func ZoneConciergeKeeper(t testing.TB, btclcKeeper types.BTCLightClientKeeper, c
 		checkpointingKeeper,
 		btccKeeper,
 		epochingKeeper,
+		btcStakingKeeper,
 		zoneconciergeStoreQuerier{},
-		capabilityKeeper.ScopeToModule("ZoneconciergeScopedKeeper"),
+		scopedKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
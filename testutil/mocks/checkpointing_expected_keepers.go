@@ -7,7 +7,10 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	math "cosmossdk.io/math"
+	exported "cosmossdk.io/x/evidence/exported"
 	types "github.com/babylonchain/babylon/x/checkpointing/types"
 	types0 "github.com/babylonchain/babylon/x/epoching/types"
 	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
@@ -94,6 +97,21 @@ func (mr *MockEpochingKeeperMockRecorder) GetEpoch(ctx interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEpoch", reflect.TypeOf((*MockEpochingKeeper)(nil).GetEpoch), ctx)
 }
 
+// GetHistoricalEpoch mocks base method.
+func (m *MockEpochingKeeper) GetHistoricalEpoch(ctx context.Context, epochNumber uint64) (*types0.Epoch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoricalEpoch", ctx, epochNumber)
+	ret0, _ := ret[0].(*types0.Epoch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistoricalEpoch indicates an expected call of GetHistoricalEpoch.
+func (mr *MockEpochingKeeperMockRecorder) GetHistoricalEpoch(ctx, epochNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoricalEpoch", reflect.TypeOf((*MockEpochingKeeper)(nil).GetHistoricalEpoch), ctx, epochNumber)
+}
+
 // GetPubKeyByConsAddr mocks base method.
 func (m *MockEpochingKeeper) GetPubKeyByConsAddr(ctx context.Context, consAddr types1.ConsAddress) (crypto.PublicKey, error) {
 	m.ctrl.T.Helper()
@@ -137,6 +155,224 @@ func (mr *MockEpochingKeeperMockRecorder) GetValidatorSet(ctx, epochNumer interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorSet", reflect.TypeOf((*MockEpochingKeeper)(nil).GetValidatorSet), ctx, epochNumer)
 }
 
+// MockEvidenceKeeper is a mock of EvidenceKeeper interface.
+type MockEvidenceKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockEvidenceKeeperMockRecorder
+}
+
+// MockEvidenceKeeperMockRecorder is the mock recorder for MockEvidenceKeeper.
+type MockEvidenceKeeperMockRecorder struct {
+	mock *MockEvidenceKeeper
+}
+
+// NewMockEvidenceKeeper creates a new mock instance.
+func NewMockEvidenceKeeper(ctrl *gomock.Controller) *MockEvidenceKeeper {
+	mock := &MockEvidenceKeeper{ctrl: ctrl}
+	mock.recorder = &MockEvidenceKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEvidenceKeeper) EXPECT() *MockEvidenceKeeperMockRecorder {
+	return m.recorder
+}
+
+// SubmitEvidence mocks base method.
+func (m *MockEvidenceKeeper) SubmitEvidence(ctx context.Context, evidence exported.Evidence) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitEvidence", ctx, evidence)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubmitEvidence indicates an expected call of SubmitEvidence.
+func (mr *MockEvidenceKeeperMockRecorder) SubmitEvidence(ctx, evidence interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitEvidence", reflect.TypeOf((*MockEvidenceKeeper)(nil).SubmitEvidence), ctx, evidence)
+}
+
+// MockStakingKeeper is a mock of StakingKeeper interface.
+type MockStakingKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockStakingKeeperMockRecorder
+}
+
+// MockStakingKeeperMockRecorder is the mock recorder for MockStakingKeeper.
+type MockStakingKeeperMockRecorder struct {
+	mock *MockStakingKeeper
+}
+
+// NewMockStakingKeeper creates a new mock instance.
+func NewMockStakingKeeper(ctrl *gomock.Controller) *MockStakingKeeper {
+	mock := &MockStakingKeeper{ctrl: ctrl}
+	mock.recorder = &MockStakingKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStakingKeeper) EXPECT() *MockStakingKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetValidator mocks base method.
+func (m *MockStakingKeeper) GetValidator(ctx context.Context, addr types1.ValAddress) (types2.Validator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidator", ctx, addr)
+	ret0, _ := ret[0].(types2.Validator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidator indicates an expected call of GetValidator.
+func (mr *MockStakingKeeperMockRecorder) GetValidator(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidator", reflect.TypeOf((*MockStakingKeeper)(nil).GetValidator), ctx, addr)
+}
+
+// MockSlashingKeeper is a mock of SlashingKeeper interface.
+type MockSlashingKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlashingKeeperMockRecorder
+}
+
+// MockSlashingKeeperMockRecorder is the mock recorder for MockSlashingKeeper.
+type MockSlashingKeeperMockRecorder struct {
+	mock *MockSlashingKeeper
+}
+
+// NewMockSlashingKeeper creates a new mock instance.
+func NewMockSlashingKeeper(ctrl *gomock.Controller) *MockSlashingKeeper {
+	mock := &MockSlashingKeeper{ctrl: ctrl}
+	mock.recorder = &MockSlashingKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlashingKeeper) EXPECT() *MockSlashingKeeperMockRecorder {
+	return m.recorder
+}
+
+// IsTombstoned mocks base method.
+func (m *MockSlashingKeeper) IsTombstoned(ctx context.Context, consAddr types1.ConsAddress) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTombstoned", ctx, consAddr)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsTombstoned indicates an expected call of IsTombstoned.
+func (mr *MockSlashingKeeperMockRecorder) IsTombstoned(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTombstoned", reflect.TypeOf((*MockSlashingKeeper)(nil).IsTombstoned), ctx, consAddr)
+}
+
+// Jail mocks base method.
+func (m *MockSlashingKeeper) Jail(ctx context.Context, consAddr types1.ConsAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Jail", ctx, consAddr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Jail indicates an expected call of Jail.
+func (mr *MockSlashingKeeperMockRecorder) Jail(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Jail", reflect.TypeOf((*MockSlashingKeeper)(nil).Jail), ctx, consAddr)
+}
+
+// JailUntil mocks base method.
+func (m *MockSlashingKeeper) JailUntil(ctx context.Context, consAddr types1.ConsAddress, jailTime time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JailUntil", ctx, consAddr, jailTime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JailUntil indicates an expected call of JailUntil.
+func (mr *MockSlashingKeeperMockRecorder) JailUntil(ctx, consAddr, jailTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailUntil", reflect.TypeOf((*MockSlashingKeeper)(nil).JailUntil), ctx, consAddr, jailTime)
+}
+
+// SlashFractionDoubleSign mocks base method.
+func (m *MockSlashingKeeper) SlashFractionDoubleSign(ctx context.Context) (math.LegacyDec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlashFractionDoubleSign", ctx)
+	ret0, _ := ret[0].(math.LegacyDec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SlashFractionDoubleSign indicates an expected call of SlashFractionDoubleSign.
+func (mr *MockSlashingKeeperMockRecorder) SlashFractionDoubleSign(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashFractionDoubleSign", reflect.TypeOf((*MockSlashingKeeper)(nil).SlashFractionDoubleSign), ctx)
+}
+
+// SlashWithInfractionReason mocks base method.
+func (m *MockSlashingKeeper) SlashWithInfractionReason(ctx context.Context, consAddr types1.ConsAddress, fraction math.LegacyDec, power, distributionHeight int64, infraction types2.Infraction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlashWithInfractionReason", ctx, consAddr, fraction, power, distributionHeight, infraction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SlashWithInfractionReason indicates an expected call of SlashWithInfractionReason.
+func (mr *MockSlashingKeeperMockRecorder) SlashWithInfractionReason(ctx, consAddr, fraction, power, distributionHeight, infraction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashWithInfractionReason", reflect.TypeOf((*MockSlashingKeeper)(nil).SlashWithInfractionReason), ctx, consAddr, fraction, power, distributionHeight, infraction)
+}
+
+// Tombstone mocks base method.
+func (m *MockSlashingKeeper) Tombstone(ctx context.Context, consAddr types1.ConsAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tombstone", ctx, consAddr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Tombstone indicates an expected call of Tombstone.
+func (mr *MockSlashingKeeperMockRecorder) Tombstone(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tombstone", reflect.TypeOf((*MockSlashingKeeper)(nil).Tombstone), ctx, consAddr)
+}
+
+// MockIncentiveKeeper is a mock of IncentiveKeeper interface.
+type MockIncentiveKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockIncentiveKeeperMockRecorder
+}
+
+// MockIncentiveKeeperMockRecorder is the mock recorder for MockIncentiveKeeper.
+type MockIncentiveKeeperMockRecorder struct {
+	mock *MockIncentiveKeeper
+}
+
+// NewMockIncentiveKeeper creates a new mock instance.
+func NewMockIncentiveKeeper(ctrl *gomock.Controller) *MockIncentiveKeeper {
+	mock := &MockIncentiveKeeper{ctrl: ctrl}
+	mock.recorder = &MockIncentiveKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIncentiveKeeper) EXPECT() *MockIncentiveKeeperMockRecorder {
+	return m.recorder
+}
+
+// RewardBLSSigners mocks base method.
+func (m *MockIncentiveKeeper) RewardBLSSigners(ctx context.Context, epoch uint64, credits []*types.BlsSigRewardCredit) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RewardBLSSigners", ctx, epoch, credits)
+}
+
+// RewardBLSSigners indicates an expected call of RewardBLSSigners.
+func (mr *MockIncentiveKeeperMockRecorder) RewardBLSSigners(ctx, epoch, credits interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RewardBLSSigners", reflect.TypeOf((*MockIncentiveKeeper)(nil).RewardBLSSigners), ctx, epoch, credits)
+}
+
 // MockCheckpointingHooks is a mock of CheckpointingHooks interface.
 type MockCheckpointingHooks struct {
 	ctrl     *gomock.Controller
@@ -229,3 +465,17 @@ func (mr *MockCheckpointingHooksMockRecorder) AfterRawCheckpointForgotten(ctx, c
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterRawCheckpointForgotten", reflect.TypeOf((*MockCheckpointingHooks)(nil).AfterRawCheckpointForgotten), ctx, ckpt)
 }
+
+// AfterRawCheckpointUnfinalized mocks base method.
+func (m *MockCheckpointingHooks) AfterRawCheckpointUnfinalized(ctx context.Context, epoch uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterRawCheckpointUnfinalized", ctx, epoch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterRawCheckpointUnfinalized indicates an expected call of AfterRawCheckpointUnfinalized.
+func (mr *MockCheckpointingHooksMockRecorder) AfterRawCheckpointUnfinalized(ctx, epoch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterRawCheckpointUnfinalized", reflect.TypeOf((*MockCheckpointingHooks)(nil).AfterRawCheckpointUnfinalized), ctx, epoch)
+}
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"fmt"
 	"math/big"
 
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/spf13/cast"
@@ -35,24 +37,41 @@ func getParams(opts servertypes.AppOptions) *chaincfg.Params {
 		panic("Bitcoin netowrk config should be valid string")
 	}
 
-	if network == string(BtcMainnet) {
-		return &chaincfg.MainNetParams
-	} else if network == string(BtcTestnet) {
-		return &chaincfg.TestNet3Params
-	} else if network == string(BtcSimnet) {
-		return &chaincfg.SimNetParams
-	} else if network == string(BtcRegtest) {
-		return &chaincfg.RegressionNetParams
-	} else if network == string(BtcSignet) {
-		return &chaincfg.SigNetParams
-	} else {
-		panic("Bitcoin network should be one of [mainet, testnet, simnet, regtest, signet]")
+	params, err := GetBtcNetworkParams(network)
+	if err != nil {
+		panic(err)
+	}
+	return params
+}
+
+// GetBtcNetworkParams returns the chain params of the given Bitcoin network
+func GetBtcNetworkParams(network string) (*chaincfg.Params, error) {
+	switch SupportedBtcNetwork(network) {
+	case BtcMainnet:
+		return &chaincfg.MainNetParams, nil
+	case BtcTestnet:
+		return &chaincfg.TestNet3Params, nil
+	case BtcSimnet:
+		return &chaincfg.SimNetParams, nil
+	case BtcRegtest:
+		return &chaincfg.RegressionNetParams, nil
+	case BtcSignet:
+		return &chaincfg.SigNetParams, nil
+	default:
+		return nil, fmt.Errorf("Bitcoin network should be one of [mainet, testnet, simnet, regtest, signet]")
 	}
 }
 
 func ParseBtcOptionsFromConfig(opts servertypes.AppOptions) BtcConfig {
+	return NewBtcConfig(getParams(opts))
+}
+
+// NewBtcConfig creates the BTC config of the given network. The config is
+// created once in the app and provided to all modules that need to know the
+// BTC network, so that all of them operate on the same network.
+func NewBtcConfig(btcNetParams *chaincfg.Params) BtcConfig {
 	return BtcConfig{
-		btcNetParams: getParams(opts),
+		btcNetParams: btcNetParams,
 	}
 }
 
@@ -71,3 +90,21 @@ func (c *BtcConfig) RetargetAdjustmentFactor() int64 {
 func (c *BtcConfig) ReduceMinDifficulty() bool {
 	return c.btcNetParams.ReduceMinDifficulty
 }
+
+// PowLimitPtr returns a copy of the PoW limit of the network
+func (c *BtcConfig) PowLimitPtr() *big.Int {
+	return new(big.Int).Set(c.btcNetParams.PowLimit)
+}
+
+// ValidateAddress checks whether the given address is a valid address of the
+// network
+func (c *BtcConfig) ValidateAddress(address string) error {
+	addr, err := btcutil.DecodeAddress(address, c.btcNetParams)
+	if err != nil {
+		return fmt.Errorf("invalid BTC address %s: %w", address, err)
+	}
+	if !addr.IsForNet(c.btcNetParams) {
+		return fmt.Errorf("BTC address %s is not for network %s", address, c.btcNetParams.Name)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package bindings
+
+// BabylonMsg is the custom message that contracts can send to Babylon
+type BabylonMsg struct {
+	CreateFinalityProvider *CreateFinalityProvider `json:"create_finality_provider,omitempty"`
+}
+
+// CreateFinalityProvider registers a finality provider, where the contract
+// sending the message is the signer, on behalf of the holder of the BTC key
+// and the Babylon key that produce the proof of possession. The holder of the
+// Babylon key has to grant the contract an authz authorization for
+// MsgCreateFinalityProvider beforehand
+type CreateFinalityProvider struct {
+	Description   *FinalityProviderDescription `json:"description,omitempty"`
+	Commission    string                       `json:"commission"`
+	BabylonPkHex  string                       `json:"babylon_pk_hex"`
+	BtcPkHex      string                       `json:"btc_pk_hex"`
+	PopHex        string                       `json:"pop_hex"`
+	MasterPubRand string                       `json:"master_pub_rand"`
+	ConsumerID    string                       `json:"consumer_id,omitempty"`
+}
+
+type FinalityProviderDescription struct {
+	Moniker         string `json:"moniker"`
+	Identity        string `json:"identity,omitempty"`
+	Website         string `json:"website,omitempty"`
+	SecurityContact string `json:"security_contact,omitempty"`
+	Details         string `json:"details,omitempty"`
+}
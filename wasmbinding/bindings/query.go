@@ -7,6 +7,10 @@ type BabylonQuery struct {
 	BtcBaseHeader            *struct{}          `json:"btc_base_header,omitempty"`
 	BtcHeaderByHash          *BtcHeaderByHash   `json:"btc_header_by_hash,omitempty"`
 	BtcHeaderByHeight        *BtcHeaderByHeight `json:"btc_header_by_height,omitempty"`
+	BtcFinalizedTip          *struct{}          `json:"btc_finalized_tip,omitempty"`
+	FinalityProvider         *FinalityProvider  `json:"finality_provider,omitempty"`
+	BtcDelegation            *BtcDelegation     `json:"btc_delegation,omitempty"`
+	BtcStakingParams         *struct{}          `json:"btc_staking_params,omitempty"`
 }
 
 type BtcHeaderByHash struct {
@@ -17,6 +21,14 @@ type BtcHeaderByHeight struct {
 	Height uint64 `json:"height"`
 }
 
+type FinalityProvider struct {
+	BtcPkHex string `json:"btc_pk_hex,omitempty"`
+}
+
+type BtcDelegation struct {
+	StakingTxHashHex string `json:"staking_tx_hash_hex,omitempty"`
+}
+
 type CurrentEpochResponse struct {
 	Epoch uint64 `json:"epoch"`
 }
@@ -55,3 +67,55 @@ type BtcBaseHeaderResponse struct {
 type BtcHeaderQueryResponse struct {
 	HeaderInfo *BtcBlockHeaderInfo `json:"header_info,omitempty"`
 }
+
+type BtcFinalizedTipResponse struct {
+	HeaderInfo *BtcBlockHeaderInfo `json:"header_info,omitempty"`
+}
+
+type FinalityProviderInfo struct {
+	BtcPkHex             string `json:"btc_pk_hex"`
+	BabylonAddress       string `json:"babylon_address"`
+	Moniker              string `json:"moniker"`
+	Commission           string `json:"commission"`
+	RegisteredEpoch      uint64 `json:"registered_epoch"`
+	SlashedBabylonHeight uint64 `json:"slashed_babylon_height"`
+	SlashedBtcHeight     uint64 `json:"slashed_btc_height"`
+	VotingPower          uint64 `json:"voting_power"`
+}
+
+type FinalityProviderResponse struct {
+	FinalityProvider *FinalityProviderInfo `json:"finality_provider,omitempty"`
+}
+
+type BtcDelegationInfo struct {
+	StakerBtcPkHex   string   `json:"staker_btc_pk_hex"`
+	FpBtcPkList      []string `json:"fp_btc_pk_list"`
+	StartHeight      uint64   `json:"start_height"`
+	EndHeight        uint64   `json:"end_height"`
+	TotalSat         uint64   `json:"total_sat"`
+	StakingTxHashHex string   `json:"staking_tx_hash_hex"`
+	Status           string   `json:"status"`
+	UnbondingTime    uint32   `json:"unbonding_time"`
+	ParamsVersion    uint32   `json:"params_version"`
+}
+
+type BtcDelegationResponse struct {
+	BtcDelegation *BtcDelegationInfo `json:"btc_delegation,omitempty"`
+}
+
+type BtcStakingParams struct {
+	Version                    uint32   `json:"version"`
+	CovenantPks                []string `json:"covenant_pks"`
+	CovenantQuorum             uint32   `json:"covenant_quorum"`
+	SlashingAddress            string   `json:"slashing_address"`
+	MinSlashingTxFeeSat        int64    `json:"min_slashing_tx_fee_sat"`
+	MinCommissionRate          string   `json:"min_commission_rate"`
+	SlashingRate               string   `json:"slashing_rate"`
+	MaxActiveFinalityProviders uint32   `json:"max_active_finality_providers"`
+	MinUnbondingTime           uint32   `json:"min_unbonding_time"`
+	MinUnbondingRate           string   `json:"min_unbonding_rate"`
+}
+
+type BtcStakingParamsResponse struct {
+	Params *BtcStakingParams `json:"params,omitempty"`
+}
@@ -1,7 +1,10 @@
 package bindings
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	lcTypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
 )
 
 // AsBtcBlockHeaderInfo translates BTCHeaderInfo to BtcBlockHeaderInfo
@@ -25,3 +28,77 @@ func AsBtcBlockHeaderInfo(info *lcTypes.BTCHeaderInfo) *BtcBlockHeaderInfo {
 		Height: info.Height,
 	}
 }
+
+// AsFinalityProviderInfo translates FinalityProvider along with its voting
+// power to FinalityProviderInfo
+func AsFinalityProviderInfo(fp *bstypes.FinalityProvider, votingPower uint64) *FinalityProviderInfo {
+	if fp == nil {
+		return nil
+	}
+
+	info := &FinalityProviderInfo{
+		BtcPkHex:             fp.BtcPk.MarshalHex(),
+		RegisteredEpoch:      fp.RegisteredEpoch,
+		SlashedBabylonHeight: fp.SlashedBabylonHeight,
+		SlashedBtcHeight:     fp.SlashedBtcHeight,
+		VotingPower:          votingPower,
+	}
+	if fp.BabylonPk != nil {
+		info.BabylonAddress = sdk.AccAddress(fp.BabylonPk.Address()).String()
+	}
+	if fp.Description != nil {
+		info.Moniker = fp.Description.Moniker
+	}
+	if fp.Commission != nil {
+		info.Commission = fp.Commission.String()
+	}
+	return info
+}
+
+// AsBtcDelegationInfo translates BTCDelegationResponse to BtcDelegationInfo
+func AsBtcDelegationInfo(stakingTxHashHex string, resp *bstypes.BTCDelegationResponse) *BtcDelegationInfo {
+	if resp == nil {
+		return nil
+	}
+
+	fpBtcPkList := make([]string, 0, len(resp.FpBtcPkList))
+	for _, fpBtcPk := range resp.FpBtcPkList {
+		fpBtcPkList = append(fpBtcPkList, fpBtcPk.MarshalHex())
+	}
+	return &BtcDelegationInfo{
+		StakerBtcPkHex:   resp.BtcPk.MarshalHex(),
+		FpBtcPkList:      fpBtcPkList,
+		StartHeight:      resp.StartHeight,
+		EndHeight:        resp.EndHeight,
+		TotalSat:         resp.TotalSat,
+		StakingTxHashHex: stakingTxHashHex,
+		Status:           resp.StatusDesc,
+		UnbondingTime:    resp.UnbondingTime,
+		ParamsVersion:    resp.ParamsVersion,
+	}
+}
+
+// AsBtcStakingParams translates StoredParams to BtcStakingParams
+func AsBtcStakingParams(sp *bstypes.StoredParams) *BtcStakingParams {
+	if sp == nil {
+		return nil
+	}
+
+	p := sp.Params
+	covenantPks := make([]string, 0, len(p.CovenantPks))
+	for _, covenantPk := range p.CovenantPks {
+		covenantPks = append(covenantPks, covenantPk.MarshalHex())
+	}
+	return &BtcStakingParams{
+		Version:                    sp.Version,
+		CovenantPks:                covenantPks,
+		CovenantQuorum:             p.CovenantQuorum,
+		SlashingAddress:            p.SlashingAddress,
+		MinSlashingTxFeeSat:        p.MinSlashingTxFeeSat,
+		MinCommissionRate:          p.MinCommissionRate.String(),
+		SlashingRate:               p.SlashingRate.String(),
+		MaxActiveFinalityProviders: p.MaxActiveFinalityProviders,
+		MinUnbondingTime:           p.MinUnbondingTime,
+		MinUnbondingRate:           p.MinUnbondingRate.String(),
+	}
+}
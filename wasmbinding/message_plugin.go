@@ -0,0 +1,120 @@
+package wasmbinding
+
+import (
+	"encoding/hex"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/wasmbinding/bindings"
+	bskeeper "github.com/babylonchain/babylon/x/btcstaking/keeper"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// CustomMessageDecorator returns decorator for custom CosmWasm bindings messages
+func CustomMessageDecorator(bsKeeper *bskeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped:  old,
+			bsKeeper: bsKeeper,
+		}
+	}
+}
+
+// CustomMessenger dispatches custom CosmWasm bindings messages, and forwards
+// all other messages to the wrapped messenger
+type CustomMessenger struct {
+	wrapped  wasmkeeper.Messenger
+	bsKeeper *bskeeper.Keeper
+}
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// DispatchMsg executes on the contractMsg.
+func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+	if msg.Custom == nil {
+		return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	}
+
+	var contractMsg bindings.BabylonMsg
+	if err := json.Unmarshal(msg.Custom, &contractMsg); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed to unmarshal babylon msg")
+	}
+
+	switch {
+	case contractMsg.CreateFinalityProvider != nil:
+		return m.createFinalityProvider(ctx, contractAddr, contractMsg.CreateFinalityProvider)
+	default:
+		return nil, nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown babylon msg variant"}
+	}
+}
+
+// createFinalityProvider registers a finality provider with the contract as
+// the signer
+func (m *CustomMessenger) createFinalityProvider(ctx sdk.Context, contractAddr sdk.AccAddress, createFp *bindings.CreateFinalityProvider) ([]sdk.Event, [][]byte, error) {
+	msg, err := AsMsgCreateFinalityProvider(contractAddr, createFp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// collect the events emitted by the message, which are returned to wasmd
+	em := sdk.NewEventManager()
+	msgServer := bskeeper.NewMsgServerImpl(*m.bsKeeper)
+	if _, err := msgServer.CreateFinalityProvider(ctx.WithEventManager(em), msg); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed to create finality provider")
+	}
+
+	return em.Events(), nil, nil
+}
+
+// AsMsgCreateFinalityProvider translates the CreateFinalityProvider binding
+// sent by the given contract to MsgCreateFinalityProvider
+func AsMsgCreateFinalityProvider(contractAddr sdk.AccAddress, createFp *bindings.CreateFinalityProvider) (*bstypes.MsgCreateFinalityProvider, error) {
+	if createFp.Description == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "empty finality provider description"}
+	}
+	commission, err := sdkmath.LegacyNewDecFromStr(createFp.Commission)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to parse commission")
+	}
+	babylonPkBytes, err := hex.DecodeString(createFp.BabylonPkHex)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to parse Babylon PK")
+	}
+	if len(babylonPkBytes) != secp256k1.PubKeySize {
+		return nil, wasmvmtypes.InvalidRequest{Err: "invalid Babylon PK length"}
+	}
+	btcPk, err := bbn.NewBIP340PubKeyFromHex(createFp.BtcPkHex)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to parse BTC PK")
+	}
+	pop, err := bstypes.NewPoPFromHex(createFp.PopHex)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to parse proof of possession")
+	}
+
+	description := stakingtypes.NewDescription(
+		createFp.Description.Moniker,
+		createFp.Description.Identity,
+		createFp.Description.Website,
+		createFp.Description.SecurityContact,
+		createFp.Description.Details,
+	)
+	return &bstypes.MsgCreateFinalityProvider{
+		Signer:        contractAddr.String(),
+		Description:   &description,
+		Commission:    &commission,
+		BabylonPk:     &secp256k1.PubKey{Key: babylonPkBytes},
+		BtcPk:         btcPk,
+		Pop:           pop,
+		MasterPubRand: createFp.MasterPubRand,
+		ConsumerId:    createFp.ConsumerID,
+	}, nil
+}
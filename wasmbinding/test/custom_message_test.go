@@ -0,0 +1,138 @@
+package wasmbinding
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/app"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	owasm "github.com/babylonchain/babylon/wasmbinding"
+	"github.com/babylonchain/babylon/wasmbinding/bindings"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+func TestCreateFinalityProviderMsg(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	contractAddress := randomAccountAddress()
+	babylonApp, ctx := setupAppWithContext(t)
+
+	fp, err := datagen.GenRandomFinalityProvider(r)
+	require.NoError(t, err)
+	popHex, err := fp.Pop.ToHexStr()
+	require.NoError(t, err)
+	// the finality provider authorizes the contract to register it
+	err = babylonApp.AuthzKeeper.SaveGrant(
+		ctx,
+		contractAddress,
+		sdk.AccAddress(fp.BabylonPk.Address()),
+		authz.NewGenericAuthorization(sdk.MsgTypeURL(&bstypes.MsgCreateFinalityProvider{})),
+		nil,
+	)
+	require.NoError(t, err)
+	msg := bindings.BabylonMsg{
+		CreateFinalityProvider: &bindings.CreateFinalityProvider{
+			Description:   &bindings.FinalityProviderDescription{Moniker: fp.Description.Moniker},
+			Commission:    fp.Commission.String(),
+			BabylonPkHex:  hex.EncodeToString(fp.BabylonPk.Key),
+			BtcPkHex:      fp.BtcPk.MarshalHex(),
+			PopHex:        popHex,
+			MasterPubRand: fp.MasterPubRand,
+		},
+	}
+	dispatchCustomMsg(t, ctx, babylonApp, contractAddress, msg)
+
+	// the finality provider is registered
+	query := bindings.BabylonQuery{
+		FinalityProvider: &bindings.FinalityProvider{BtcPkHex: fp.BtcPk.MarshalHex()},
+	}
+	resp := bindings.FinalityProviderResponse{}
+	queryCustomDirect(t, ctx, babylonApp, query, &resp)
+	require.Equal(t, fp.BtcPk.MarshalHex(), resp.FinalityProvider.BtcPkHex)
+	require.Equal(t, sdk.AccAddress(fp.BabylonPk.Address()).String(), resp.FinalityProvider.BabylonAddress)
+	require.Equal(t, fp.Description.Moniker, resp.FinalityProvider.Moniker)
+	require.Equal(t, fp.Commission.String(), resp.FinalityProvider.Commission)
+	require.Zero(t, resp.FinalityProvider.VotingPower)
+
+	// registering the same finality provider again fails
+	msgBz, err := json.Marshal(msg)
+	require.NoError(t, err)
+	messenger := owasm.CustomMessageDecorator(&babylonApp.BTCStakingKeeper)(nil)
+	_, _, err = messenger.DispatchMsg(ctx, contractAddress, "", wasmvmtypes.CosmosMsg{Custom: msgBz})
+	require.Error(t, err)
+}
+
+func TestQueryBtcStakingParams(t *testing.T) {
+	babylonApp, ctx := setupAppWithContext(t)
+
+	query := bindings.BabylonQuery{
+		BtcStakingParams: &struct{}{},
+	}
+	resp := bindings.BtcStakingParamsResponse{}
+	queryCustomDirect(t, ctx, babylonApp, query, &resp)
+
+	storedParams := babylonApp.BTCStakingKeeper.GetParamsWithVersion(ctx)
+	require.Equal(t, bindings.AsBtcStakingParams(&storedParams), resp.Params)
+}
+
+func TestQueryBtcFinalizedTip(t *testing.T) {
+	babylonApp, ctx := setupAppWithContext(t)
+
+	query := bindings.BabylonQuery{
+		BtcFinalizedTip: &struct{}{},
+	}
+	resp := bindings.BtcFinalizedTipResponse{}
+	queryCustomDirect(t, ctx, babylonApp, query, &resp)
+
+	// the BTC light client is not w-deep at genesis, so the base header is
+	// the finalized tip
+	tip := babylonApp.BTCLightClientKeeper.GetTipInfo(ctx)
+	baseHeader := babylonApp.BTCLightClientKeeper.GetBaseBTCHeader(ctx)
+	wValue := babylonApp.BtcCheckpointKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+	require.Less(t, tip.Height, baseHeader.Height+wValue)
+	require.Equal(t, bindings.AsBtcBlockHeaderInfo(baseHeader), resp.HeaderInfo)
+}
+
+func dispatchCustomMsg(
+	t *testing.T,
+	ctx sdk.Context,
+	bbn *app.BabylonApp,
+	contract sdk.AccAddress,
+	msg bindings.BabylonMsg,
+) {
+	msgBz, err := json.Marshal(msg)
+	require.NoError(t, err)
+
+	messenger := owasm.CustomMessageDecorator(&bbn.BTCStakingKeeper)(nil)
+	_, _, err = messenger.DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{Custom: msgBz})
+	require.NoError(t, err)
+}
+
+func queryCustomDirect(
+	t *testing.T,
+	ctx sdk.Context,
+	bbn *app.BabylonApp,
+	request bindings.BabylonQuery,
+	response interface{},
+) {
+	requestBz, err := json.Marshal(request)
+	require.NoError(t, err)
+
+	querier := owasm.CustomQuerier(owasm.NewQueryPlugin(
+		&bbn.EpochingKeeper,
+		&bbn.ZoneConciergeKeeper,
+		&bbn.BTCLightClientKeeper,
+		&bbn.BtcCheckpointKeeper,
+		&bbn.BTCStakingKeeper,
+	))
+	resBz, err := querier(ctx, requestBz)
+	require.NoError(t, err)
+	err = json.Unmarshal(resBz, response)
+	require.NoError(t, err)
+}
@@ -9,7 +9,10 @@ import (
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/wasmbinding/bindings"
+	btcckeeper "github.com/babylonchain/babylon/x/btccheckpoint/keeper"
 	lcKeeper "github.com/babylonchain/babylon/x/btclightclient/keeper"
+	bskeeper "github.com/babylonchain/babylon/x/btcstaking/keeper"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
 	epochingkeeper "github.com/babylonchain/babylon/x/epoching/keeper"
 	zckeeper "github.com/babylonchain/babylon/x/zoneconcierge/keeper"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,6 +22,8 @@ type QueryPlugin struct {
 	epochingKeeper *epochingkeeper.Keeper
 	zcKeeper       *zckeeper.Keeper
 	lcKeeper       *lcKeeper.Keeper
+	btccKeeper     *btcckeeper.Keeper
+	bsKeeper       *bskeeper.Keeper
 }
 
 // NewQueryPlugin returns a reference to a new QueryPlugin.
@@ -26,11 +31,15 @@ func NewQueryPlugin(
 	ek *epochingkeeper.Keeper,
 	zcKeeper *zckeeper.Keeper,
 	lcKeeper *lcKeeper.Keeper,
+	btccKeeper *btcckeeper.Keeper,
+	bsKeeper *bskeeper.Keeper,
 ) *QueryPlugin {
 	return &QueryPlugin{
 		epochingKeeper: ek,
 		zcKeeper:       zcKeeper,
 		lcKeeper:       lcKeeper,
+		btccKeeper:     btccKeeper,
+		bsKeeper:       bsKeeper,
 	}
 }
 
@@ -144,6 +153,88 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 				return nil, errorsmod.Wrap(err, "failed marshaling")
 			}
 
+			return bz, nil
+		case contractQuery.BtcFinalizedTip != nil:
+			// the finalized tip is the BTC header that is w-deep in the BTC light
+			// client, or the base header if the BTC light client is not w-deep yet
+			tip := qp.lcKeeper.GetTipInfo(ctx)
+			baseHeader := qp.lcKeeper.GetBaseBTCHeader(ctx)
+			if tip == nil || baseHeader == nil {
+				return nil, fmt.Errorf("no tip info found")
+			}
+			wValue := qp.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+			finalizedHeight := baseHeader.Height
+			if tip.Height >= baseHeader.Height+wValue {
+				finalizedHeight = tip.Height - wValue
+			}
+			headerInfo := qp.lcKeeper.GetHeaderByHeight(ctx, finalizedHeight)
+
+			res := bindings.BtcFinalizedTipResponse{
+				HeaderInfo: bindings.AsBtcBlockHeaderInfo(headerInfo),
+			}
+			bz, err := json.Marshal(res)
+
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "failed marshaling")
+			}
+
+			return bz, nil
+		case contractQuery.FinalityProvider != nil:
+			fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(contractQuery.FinalityProvider.BtcPkHex)
+
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "failed to parse finality provider BTC PK")
+			}
+
+			fp, err := qp.bsKeeper.GetFinalityProvider(ctx, *fpBTCPK)
+
+			if err != nil {
+				return nil, err
+			}
+
+			votingPower := qp.bsKeeper.GetVotingPower(ctx, *fpBTCPK, uint64(ctx.HeaderInfo().Height))
+			res := bindings.FinalityProviderResponse{
+				FinalityProvider: bindings.AsFinalityProviderInfo(fp, votingPower),
+			}
+			bz, err := json.Marshal(res)
+
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "failed marshaling")
+			}
+
+			return bz, nil
+		case contractQuery.BtcDelegation != nil:
+			stakingTxHashHex := contractQuery.BtcDelegation.StakingTxHashHex
+			resp, err := qp.bsKeeper.BTCDelegation(ctx, &bstypes.QueryBTCDelegationRequest{
+				StakingTxHashHex: stakingTxHashHex,
+			})
+
+			if err != nil {
+				return nil, err
+			}
+
+			res := bindings.BtcDelegationResponse{
+				BtcDelegation: bindings.AsBtcDelegationInfo(stakingTxHashHex, resp.BtcDelegation),
+			}
+			bz, err := json.Marshal(res)
+
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "failed marshaling")
+			}
+
+			return bz, nil
+		case contractQuery.BtcStakingParams != nil:
+			storedParams := qp.bsKeeper.GetParamsWithVersion(ctx)
+
+			res := bindings.BtcStakingParamsResponse{
+				Params: bindings.AsBtcStakingParams(&storedParams),
+			}
+			bz, err := json.Marshal(res)
+
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "failed marshaling")
+			}
+
 			return bz, nil
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown babylon query variant"}
@@ -155,14 +246,20 @@ func RegisterCustomPlugins(
 	ek *epochingkeeper.Keeper,
 	zcKeeper *zckeeper.Keeper,
 	lcKeeper *lcKeeper.Keeper,
+	btccKeeper *btcckeeper.Keeper,
+	bsKeeper *bskeeper.Keeper,
 ) []wasmkeeper.Option {
-	wasmQueryPlugin := NewQueryPlugin(ek, zcKeeper, lcKeeper)
+	wasmQueryPlugin := NewQueryPlugin(ek, zcKeeper, lcKeeper, btccKeeper, bsKeeper)
 
 	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
 		Custom: CustomQuerier(wasmQueryPlugin),
 	})
+	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
+		CustomMessageDecorator(bsKeeper),
+	)
 
 	return []wasmkeeper.Option{
 		queryPluginOpt,
+		messengerDecoratorOpt,
 	}
 }
@@ -3,7 +3,9 @@ package cli
 import (
 	"context"
 	"fmt"
+	"io"
 	"strconv"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 
@@ -28,6 +30,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdBtcCheckpointHeightAndHash())
 	cmd.AddCommand(CmdEpochSubmissions())
+	cmd.AddCommand(CmdBestSubmission())
 	return cmd
 }
 
@@ -63,10 +66,12 @@ func CmdBtcCheckpointHeightAndHash() *cobra.Command {
 	return cmd
 }
 
+const flagTable = "table"
+
 func CmdEpochSubmissions() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "epoch-submissions <epochNumber>",
-		Short: "all checkpoint submissions for given epoch",
+		Short: "all checkpoint submissions for given epoch, with their btc positions and depths",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
@@ -77,12 +82,86 @@ func CmdEpochSubmissions() *cobra.Command {
 				return err
 			}
 
-			req := types.QueryEpochSubmissionsRequest{EpochNum: epochNum}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := types.QueryEpochSubmissionsRequest{EpochNum: epochNum, Pagination: pageReq}
 			res, err := queryClient.EpochSubmissions(context.Background(), &req)
 			if err != nil {
 				return err
 			}
 
+			asTable, err := cmd.Flags().GetBool(flagTable)
+			if err != nil {
+				return err
+			}
+			if asTable {
+				return printSubmissionsTable(cmd.OutOrStdout(), res.Submissions)
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagTable, false, "print the submissions as a human-readable table")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "epoch-submissions")
+
+	return cmd
+}
+
+// printSubmissionsTable prints the given submissions as a table, one row per
+// submission
+func printSubmissionsTable(out io.Writer, submissions []*types.SubmissionInfoResponse) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "FIRST BTC BLOCK\tTX IDX\tSECOND BTC BLOCK\tTX IDX\tON MAIN CHAIN\tBTC HEIGHT\tBTC DEPTH\tSUBMITTER\tREPORTER")
+	for _, sub := range submissions {
+		height, depth := "-", "-"
+		if sub.OnMainChain {
+			height = strconv.FormatUint(sub.BtcBlockHeight, 10)
+			depth = strconv.FormatUint(sub.BtcDepth, 10)
+		}
+		submitter, reporter := "-", "-"
+		if sub.VigilanteAddresses != nil {
+			submitter, reporter = sub.VigilanteAddresses.Submitter, sub.VigilanteAddresses.Reporter
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%d\t%t\t%s\t%s\t%s\t%s\n",
+			sub.Key.FirstTxBlockHash,
+			sub.Key.FirstTxIndex,
+			sub.Key.SecondTxBlockHash,
+			sub.Key.SecondTxIndex,
+			sub.OnMainChain,
+			height,
+			depth,
+			submitter,
+			reporter,
+		)
+	}
+	return w.Flush()
+}
+
+func CmdBestSubmission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "best-submission <epochNumber>",
+		Short: "best checkpoint submission for given epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epochNum, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := types.QueryBestSubmissionRequest{EpochNum: epochNum}
+			res, err := queryClient.BestSubmission(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
 			return clientCtx.PrintProto(res)
 		},
 	}
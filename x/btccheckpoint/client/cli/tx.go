@@ -23,6 +23,7 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdTxInsertSpvProofs())
+	cmd.AddCommand(CmdTxReportConflictingCheckpoint())
 
 	return cmd
 }
@@ -66,3 +67,40 @@ func CmdTxInsertSpvProofs() *cobra.Command {
 
 	return cmd
 }
+
+func CmdTxReportConflictingCheckpoint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report-conflicting-checkpoint [proof-hex-string] [proof-hex-string]",
+		Short: "report a conflicting checkpoint included in BTC via its proof bytes",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proof1, err := types.NewSpvProofFromHexBytes(clientCtx.Codec, args[0])
+
+			if err != nil {
+				return err
+			}
+
+			proof2, err := types.NewSpvProofFromHexBytes(clientCtx.Codec, args[1])
+
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgReportConflictingCheckpoint{
+				Submitter: clientCtx.GetFromAddress().String(),
+				Proofs:    []*types.BTCSpvProof{proof1, proof2},
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -97,27 +97,126 @@ func (k Keeper) EpochSubmissions(c context.Context, req *types.QueryEpochSubmiss
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Reverse {
+		return nil, status.Error(codes.InvalidArgument, "reverse pagination is not supported")
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
 	epoch := req.GetEpochNum()
 	epochData := k.GetEpochData(ctx, epoch)
 	if epochData == nil || len(epochData.Keys) == 0 {
 		return &types.QueryEpochSubmissionsResponse{
-			Keys: []*types.SubmissionKeyResponse{},
+			Keys:        []*types.SubmissionKeyResponse{},
+			Submissions: []*types.SubmissionInfoResponse{},
+			Pagination:  &query.PageResponse{},
 		}, nil
 	}
 
-	submKeysResp := make([]*types.SubmissionKeyResponse, len(epochData.Keys))
-	for i, submKey := range epochData.Keys {
+	// submissions of an epoch are kept in the epoch data in the order of
+	// submission, so the page key is the index of the first submission
+	total := uint64(len(epochData.Keys))
+	start := pageReq.Offset
+	if len(pageReq.Key) > 0 {
+		if len(pageReq.Key) != 8 {
+			return nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+		start = sdk.BigEndianToUint64(pageReq.Key)
+	}
+	start = min(start, total)
+	end := min(start+limit, total)
+
+	submKeysResp := make([]*types.SubmissionKeyResponse, 0, end-start)
+	submInfosResp := make([]*types.SubmissionInfoResponse, 0, end-start)
+	for _, submKey := range epochData.Keys[start:end] {
 		skr, err := types.NewSubmissionKeyResponse(*submKey)
 		if err != nil {
 			errMsgf := "epoch submission: this error should not happen, check DB corruption and proto files: %v"
 			k.Logger(ctx).Error(errMsgf, err)
 			return nil, status.Errorf(codes.Internal, errMsgf, err)
 		}
+		submKeysResp = append(submKeysResp, skr)
+		submInfosResp = append(submInfosResp, k.getSubmissionInfo(ctx, *submKey, skr))
+	}
 
-		submKeysResp[i] = skr
+	pageRes := &query.PageResponse{}
+	if end < total {
+		pageRes.NextKey = sdk.Uint64ToBigEndian(end)
+	}
+	if pageReq.CountTotal {
+		pageRes.Total = total
 	}
 
 	return &types.QueryEpochSubmissionsResponse{
-		Keys: submKeysResp,
+		Keys:        submKeysResp,
+		Submissions: submInfosResp,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// getSubmissionInfo returns the current BTC position and depth of the given
+// submission, along with its vigilante addresses
+func (k Keeper) getSubmissionInfo(ctx context.Context, sk types.SubmissionKey, skr *types.SubmissionKeyResponse) *types.SubmissionInfoResponse {
+	info := &types.SubmissionInfoResponse{Key: skr}
+
+	if submissionData := k.GetSubmissionData(ctx, sk); submissionData != nil && submissionData.VigilanteAddresses != nil {
+		info.VigilanteAddresses = submissionData.VigilanteAddresses.ToResponse()
+	}
+
+	submissionInfo, err := k.GetSubmissionBtcInfo(ctx, sk)
+	if err != nil {
+		// the submission is no longer on the BTC main chain
+		return info
+	}
+	height, err := k.GetBlockHeight(ctx, &submissionInfo.YoungestBlockHash)
+	if err != nil {
+		return info
+	}
+	info.OnMainChain = true
+	info.BtcBlockHeight = height
+	info.BtcBlockHash = submissionInfo.YoungestBlockHash.MarshalHex()
+	info.BtcDepth = submissionInfo.SubmissionDepth()
+	return info
+}
+
+func (k Keeper) BestSubmission(c context.Context, req *types.QueryBestSubmissionRequest) (*types.QueryBestSubmissionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	epoch := req.GetEpochNum()
+	epochData := k.GetEpochData(ctx, epoch)
+	bestSubmission := k.GetEpochBestSubmissionBtcInfo(ctx, epochData)
+	if bestSubmission == nil {
+		return nil, status.Errorf(codes.NotFound, "no submission on BTC main chain for epoch %d", epoch)
+	}
+
+	bestSubmissionHeight, err := k.GetBlockHeight(ctx, &bestSubmission.YoungestBlockHash)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting best submission height: %v", err)
+	}
+
+	skr, err := types.NewSubmissionKeyResponse(bestSubmission.SubmissionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBestSubmissionResponse{
+		EpochNum:       epoch,
+		Status:         epochData.Status,
+		Key:            skr,
+		BtcBlockHeight: bestSubmissionHeight,
+		BtcBlockHash:   bestSubmission.YoungestBlockHash.MarshalHex(),
+		NumSubmissions: uint64(len(epochData.Keys)),
 	}, nil
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 
 	dg "github.com/babylonchain/babylon/testutil/datagen"
@@ -69,3 +70,69 @@ func TestBtcCheckpointInfo(t *testing.T) {
 	require.Equal(t, btcInfo.BestSubmissionVigilanteAddressList[0].Reporter, rawSubmission.Reporter.String())
 	require.Equal(t, btcInfo.BestSubmissionVigilanteAddressList[0].Submitter, sdk.AccAddress(btcRaw.SubmitterAddress).String())
 }
+
+func TestEpochSubmissions(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	tk := InitTestKeepers(t)
+	epoch := uint64(1)
+
+	msg1 := dg.GenerateMessageWithRandomSubmitterForEpoch(r, epoch)
+	tk.BTCLightClient.SetDepth(b1Hash(msg1), uint64(5))
+	tk.BTCLightClient.SetDepth(b2Hash(msg1), uint64(4))
+	_, err := tk.insertProofMsg(msg1)
+	require.NoError(t, err)
+
+	msg2 := dg.GenerateMessageWithRandomSubmitterForEpoch(r, epoch)
+	tk.BTCLightClient.SetDepth(b1Hash(msg2), uint64(2))
+	tk.BTCLightClient.SetDepth(b2Hash(msg2), uint64(1))
+	_, err = tk.insertProofMsg(msg2)
+	require.NoError(t, err)
+
+	// the second submission is reorged out of the BTC main chain
+	tk.BTCLightClient.DeleteHeader(b2Hash(msg2))
+
+	// all submissions are returned in the order of submission
+	resp, err := tk.BTCCheckpoint.EpochSubmissions(tk.Ctx, &types.QueryEpochSubmissionsRequest{EpochNum: epoch})
+	require.NoError(t, err)
+	require.Len(t, resp.Keys, 2)
+	require.Len(t, resp.Submissions, 2)
+	require.Nil(t, resp.Pagination.NextKey)
+
+	sub1 := resp.Submissions[0]
+	require.Equal(t, resp.Keys[0], sub1.Key)
+	require.Equal(t, b1Hash(msg1).MarshalHex(), sub1.Key.FirstTxBlockHash)
+	require.True(t, sub1.OnMainChain)
+	require.Equal(t, b2Hash(msg1).MarshalHex(), sub1.BtcBlockHash)
+	require.Equal(t, uint64(4), sub1.BtcDepth)
+	require.Equal(t, msg1.Submitter, sub1.VigilanteAddresses.Reporter)
+
+	sub2 := resp.Submissions[1]
+	require.Equal(t, b1Hash(msg2).MarshalHex(), sub2.Key.FirstTxBlockHash)
+	require.False(t, sub2.OnMainChain)
+	require.Zero(t, sub2.BtcDepth)
+	require.Equal(t, msg2.Submitter, sub2.VigilanteAddresses.Reporter)
+
+	// paginated query
+	resp, err = tk.BTCCheckpoint.EpochSubmissions(tk.Ctx, &types.QueryEpochSubmissionsRequest{
+		EpochNum:   epoch,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Submissions, 1)
+	require.Equal(t, b1Hash(msg1).MarshalHex(), resp.Submissions[0].Key.FirstTxBlockHash)
+	require.Equal(t, uint64(2), resp.Pagination.Total)
+	require.NotNil(t, resp.Pagination.NextKey)
+	resp, err = tk.BTCCheckpoint.EpochSubmissions(tk.Ctx, &types.QueryEpochSubmissionsRequest{
+		EpochNum:   epoch,
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Submissions, 1)
+	require.Equal(t, b1Hash(msg2).MarshalHex(), resp.Submissions[0].Key.FirstTxBlockHash)
+	require.Nil(t, resp.Pagination.NextKey)
+
+	// epoch without submissions
+	resp, err = tk.BTCCheckpoint.EpochSubmissions(tk.Ctx, &types.QueryEpochSubmissionsRequest{EpochNum: epoch + 1})
+	require.NoError(t, err)
+	require.Empty(t, resp.Submissions)
+}
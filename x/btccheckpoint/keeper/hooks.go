@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+
+	"github.com/babylonchain/babylon/x/btccheckpoint/types"
 	ltypes "github.com/babylonchain/babylon/x/btclightclient/types"
 	etypes "github.com/babylonchain/babylon/x/epoching/types"
 )
@@ -36,3 +38,34 @@ func (h Hooks) AfterEpochBegins(_ context.Context, _ uint64) {}
 func (h Hooks) AfterEpochEnds(_ context.Context, _ uint64) {}
 
 func (h Hooks) BeforeSlashThreshold(_ context.Context, _ etypes.ValidatorSet) {}
+
+// Implements BtcCheckpointHooks interface
+var _ types.BtcCheckpointHooks = Keeper{}
+
+// AfterBtcCheckpointSubmitted - call hook if registered
+func (k Keeper) AfterBtcCheckpointSubmitted(ctx context.Context, epoch uint64, bestSubmission *types.SubmissionBtcInfo) {
+	if k.hooks != nil {
+		k.hooks.AfterBtcCheckpointSubmitted(ctx, epoch, bestSubmission)
+	}
+}
+
+// AfterBtcCheckpointConfirmed - call hook if registered
+func (k Keeper) AfterBtcCheckpointConfirmed(ctx context.Context, epoch uint64, bestSubmission *types.SubmissionBtcInfo) {
+	if k.hooks != nil {
+		k.hooks.AfterBtcCheckpointConfirmed(ctx, epoch, bestSubmission)
+	}
+}
+
+// AfterBtcCheckpointFinalized - call hook if registered
+func (k Keeper) AfterBtcCheckpointFinalized(ctx context.Context, epoch uint64, bestSubmission *types.SubmissionBtcInfo) {
+	if k.hooks != nil {
+		k.hooks.AfterBtcCheckpointFinalized(ctx, epoch, bestSubmission)
+	}
+}
+
+// AfterBtcCheckpointForgotten - call hook if registered
+func (k Keeper) AfterBtcCheckpointForgotten(ctx context.Context, epoch uint64) {
+	if k.hooks != nil {
+		k.hooks.AfterBtcCheckpointForgotten(ctx, epoch)
+	}
+}
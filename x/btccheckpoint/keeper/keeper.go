@@ -26,6 +26,7 @@ type (
 		btcLightClientKeeper types.BTCLightClientKeeper
 		checkpointingKeeper  types.CheckpointingKeeper
 		incentiveKeeper      types.IncentiveKeeper
+		hooks                types.BtcCheckpointHooks
 		powLimit             *big.Int
 		authority            string
 	}
@@ -62,7 +63,7 @@ func NewKeeper(
 	bk types.BTCLightClientKeeper,
 	ck types.CheckpointingKeeper,
 	ik types.IncentiveKeeper,
-	powLimit *big.Int,
+	btcConfig bbn.BtcConfig,
 	authority string,
 ) Keeper {
 
@@ -73,11 +74,22 @@ func NewKeeper(
 		btcLightClientKeeper: bk,
 		checkpointingKeeper:  ck,
 		incentiveKeeper:      ik,
-		powLimit:             powLimit,
+		hooks:                nil,
+		powLimit:             btcConfig.PowLimitPtr(),
 		authority:            authority,
 	}
 }
 
+// SetHooks sets the btccheckpoint hooks
+func (k *Keeper) SetHooks(bh types.BtcCheckpointHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set btccheckpoint hooks twice")
+	}
+	k.hooks = bh
+
+	return k
+}
+
 func (k Keeper) GetPowLimit() *big.Int {
 	return k.powLimit
 }
@@ -210,6 +222,26 @@ func (k Keeper) setLastFinalizedEpochNumber(ctx context.Context, epoch uint64) {
 	}
 }
 
+func (k Keeper) getHighestFinalizedEpochNumber(ctx context.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	epoch, err := store.Get(types.HighestFinalizedEpochKey)
+	if err != nil {
+		panic(err)
+	}
+	if len(epoch) == 0 {
+		return uint64(0)
+	}
+
+	return sdk.BigEndianToUint64(epoch)
+}
+
+func (k Keeper) setHighestFinalizedEpochNumber(ctx context.Context, epoch uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.HighestFinalizedEpochKey, sdk.Uint64ToBigEndian(epoch)); err != nil {
+		panic(err)
+	}
+}
+
 func (k Keeper) getEpochChanges(
 	ctx context.Context,
 	parentEpochBestSubmission *types.SubmissionBtcInfo,
@@ -251,6 +283,7 @@ func (k Keeper) getEpochChanges(
 			// we do not have info of best submission in this epoch. Set current submission
 			// as best
 			currentEpochBestSubmission = submissionInfo
+			bestSubmissionIdx = i
 			continue
 		}
 
@@ -270,9 +303,53 @@ func (k Keeper) getEpochChanges(
 
 // OnTipChange is the callback function to be called when btc light client tip changes
 func (k Keeper) OnTipChange(ctx context.Context) {
+	k.checkFinalizedCheckpoints(ctx)
 	k.checkCheckpoints(ctx)
 }
 
+// checkFinalizedCheckpoints rolls back the finalized epochs whose best
+// submissions are no longer w-deep on the btc main chain, which happens only
+// upon a btc reorg deeper than w. Such epochs are downgraded to confirmed and
+// the last finalized epoch rolls back to their parent, so that checkCheckpoints
+// re-evaluates them, i.e., forgets them if their submissions are no longer on
+// the main chain, or downgrades them further if they are no longer k-deep.
+// Epochs are checked from the last finalized one backwards, as the best
+// submission of an epoch is always deeper than the ones of its child epochs.
+func (k Keeper) checkFinalizedCheckpoints(ctx context.Context) {
+	store := k.epochDataStore(ctx)
+
+	lastFinalizedEpoch := k.getLastFinalizedEpochNumber(ctx)
+	for epoch := lastFinalizedEpoch; epoch > 0; epoch-- {
+		epochData := k.GetEpochData(ctx, epoch)
+		if epochData == nil {
+			break
+		}
+		if epochData.Status != types.Finalized {
+			panic("Epochs up to the last finalized epoch must be finalized")
+		}
+		if len(epochData.Keys) != 1 {
+			panic("Finalized epoch must have only one valid submission")
+		}
+
+		subInfo, err := k.GetSubmissionBtcInfo(ctx, *epochData.Keys[0])
+		if err == nil && k.checkSubmissionStatus(ctx, subInfo) == types.Finalized {
+			// the epoch and all its ancestors are still finalized
+			break
+		}
+
+		if epoch == lastFinalizedEpoch && epoch > k.getHighestFinalizedEpochNumber(ctx) {
+			// remember the epochs that have been rewarded, so that they are not
+			// rewarded again once finalized again
+			k.setHighestFinalizedEpochNumber(ctx, epoch)
+		}
+		epochData.Status = types.Confirmed
+		store.Set(sdk.Uint64ToBigEndian(epoch), k.cdc.MustMarshal(epochData))
+		k.setLastFinalizedEpochNumber(ctx, epoch-1)
+		k.checkpointingKeeper.SetCheckpointUnfinalized(ctx, epoch)
+		k.onBtcCheckpointRolledBack(ctx, epoch, types.Finalized, types.Confirmed)
+	}
+}
+
 // checkCheckpoints is the main function checking status of all submissions
 // on btc chain as viewed through btc light client.
 // Check works roughly as follows:
@@ -295,7 +372,8 @@ func (k Keeper) OnTipChange(ctx context.Context) {
 //
 // 5. After choosing best submission, the status of epoch is checked. If best
 //    submission depth >= k deep, epoch is treated as confirmed. If depth >= w deep
-//    epoch is treated as finalized.
+//    epoch is treated as finalized. If a confirmed epoch's best submission is no
+//    longer k deep due to btc reorg, epoch is rolled back to submitted.
 //
 // 6. If the epoch became finalized, delete all submissions except best one. If the
 //		epoch is to finalized, delete all submissions which were marked as to delete
@@ -362,6 +440,7 @@ func (k Keeper) checkCheckpoints(ctx context.Context) {
 
 			k.clearEpochData(ctx, it.Key(), store, &currentEpoch)
 			k.checkpointingKeeper.SetCheckpointForgotten(ctx, epoch)
+			k.onBtcCheckpointForgotten(ctx, epoch, currentEpoch.Status)
 			// set parent epoch with empty best submission, so child epoch will also
 			// get clearead
 			parentEpochInfo = &epochInfo{}
@@ -382,6 +461,7 @@ func (k Keeper) checkCheckpoints(ctx context.Context) {
 			// epoch lost all submissions clear it and inform checkpointing about it
 			k.clearEpochData(ctx, it.Key(), store, &currentEpoch)
 			k.checkpointingKeeper.SetCheckpointForgotten(ctx, epoch)
+			k.onBtcCheckpointForgotten(ctx, epoch, currentEpoch.Status)
 			// set parent epoch with empty best submission, so child epoch will also
 			// get clearead
 			parentEpochInfo = &epochInfo{}
@@ -391,10 +471,18 @@ func (k Keeper) checkCheckpoints(ctx context.Context) {
 		// there is at least one submission in the epoch, check its current btc status
 		bestSubmissionStatus := k.checkSubmissionStatus(ctx, epochChanges.EpochBestSubmission)
 
+		if bestSubmissionStatus < currentEpoch.Status && currentEpoch.Status == types.Confirmed {
+			// epoch is no longer confirmed by best submission due to btc reorg
+			currentEpoch.Status = types.Submitted
+			k.checkpointingKeeper.SetCheckpointUnconfirmed(ctx, epoch)
+			k.onBtcCheckpointRolledBack(ctx, epoch, types.Confirmed, types.Submitted)
+		}
+
 		if bestSubmissionStatus > currentEpoch.Status && currentEpoch.Status == types.Submitted {
 			// epoch just got confirmed by best submission
 			currentEpoch.Status = types.Confirmed
 			k.checkpointingKeeper.SetCheckpointConfirmed(ctx, epoch)
+			k.onBtcCheckpointStatusUpdate(ctx, epoch, types.Confirmed, epochChanges.EpochBestSubmission)
 		}
 
 		if bestSubmissionStatus > currentEpoch.Status && currentEpoch.Status == types.Confirmed {
@@ -402,11 +490,15 @@ func (k Keeper) checkCheckpoints(ctx context.Context) {
 			currentEpoch.Status = types.Finalized
 			k.checkpointingKeeper.SetCheckpointFinalized(ctx, epoch)
 			k.setLastFinalizedEpochNumber(ctx, epoch)
+			k.onBtcCheckpointStatusUpdate(ctx, epoch, types.Finalized, epochChanges.EpochBestSubmission)
 		}
 
 		if currentEpoch.Status == types.Finalized {
-			// trigger incentive module to distribute rewards to submitters/reporters
-			k.rewardBTCTimestamping(ctx, epoch, &currentEpoch, epochChanges.BestSubmissionIdx)
+			// trigger incentive module to distribute rewards to submitters/reporters,
+			// unless the epoch is finalized again after a btc reorg
+			if epoch > k.getHighestFinalizedEpochNumber(ctx) {
+				k.rewardBTCTimestamping(ctx, epoch, &currentEpoch, epochChanges.BestSubmissionIdx)
+			}
 			// delete all submissions except best one
 			for i, sk := range currentEpoch.Keys {
 				if i != epochChanges.BestSubmissionIdx {
@@ -86,6 +86,7 @@ func (ms msgServer) InsertBTCSpvProof(ctx context.Context, req *types.MsgInsertB
 		epochNum,
 		submissionKey,
 		submissionData,
+		newSubmissionOldestHeaderDepth,
 	)
 
 	if err != nil {
@@ -95,6 +96,30 @@ func (ms msgServer) InsertBTCSpvProof(ctx context.Context, req *types.MsgInsertB
 	return &types.MsgInsertBTCSpvProofResponse{}, nil
 }
 
+// ReportConflictingCheckpoint verifies that the reported checkpoint is included
+// in the BTC chain, and lets the checkpointing module punish the validators who
+// signed it if it conflicts with the local checkpoint of the same epoch. The
+// submission is not stored, as it does not checkpoint the canonical chain
+func (ms msgServer) ReportConflictingCheckpoint(ctx context.Context, req *types.MsgReportConflictingCheckpoint) (*types.MsgReportConflictingCheckpointResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	rawSubmission, err := types.ParseConflictingSubmission(req, ms.k.GetPowLimit(), ms.k.GetExpectedTag(sdkCtx))
+	if err != nil {
+		return nil, types.ErrInvalidCheckpointProof.Wrap(err.Error())
+	}
+
+	// ensure the headers including the checkpoint are known to BTCLightClient
+	if _, err := ms.k.GetSubmissionBtcInfo(sdkCtx, rawSubmission.GetSubmissionKey()); err != nil {
+		return nil, types.ErrInvalidHeader.Wrap(err.Error())
+	}
+
+	if err := ms.k.checkpointingKeeper.HandleConflictingCheckpoint(sdkCtx, rawSubmission.CheckpointData); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReportConflictingCheckpointResponse{}, nil
+}
+
 // UpdateParams updates the params.
 func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if ms.k.authority != req.Authority {
@@ -14,6 +14,7 @@ import (
 	bkeeper "github.com/babylonchain/babylon/x/btccheckpoint/keeper"
 	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	"github.com/btcsuite/btcd/chaincfg"
+	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
@@ -78,6 +79,36 @@ func (k *TestKeepers) onTipChange() {
 	k.BTCCheckpoint.OnTipChange(k.SdkCtx)
 }
 
+// lastStatusUpdateEvent returns the last emitted BTC checkpoint status update
+// event, or nil if there is none
+func (k *TestKeepers) lastStatusUpdateEvent(t *testing.T) *btcctypes.EventBtcCheckpointStatusUpdate {
+	events := k.SdkCtx.EventManager().Events()
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Type != "babylon.btccheckpoint.v1.EventBtcCheckpointStatusUpdate" {
+			continue
+		}
+		ev, err := sdk.ParseTypedEvent(abci.Event(events[i]))
+		require.NoError(t, err)
+		return ev.(*btcctypes.EventBtcCheckpointStatusUpdate)
+	}
+	return nil
+}
+
+// lastRolledBackEvent returns the last emitted BTC checkpoint rolled back
+// event, or nil if there is none
+func (k *TestKeepers) lastRolledBackEvent(t *testing.T) *btcctypes.EventBtcCheckpointRolledBack {
+	events := k.SdkCtx.EventManager().Events()
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Type != "babylon.btccheckpoint.v1.EventBtcCheckpointRolledBack" {
+			continue
+		}
+		ev, err := sdk.ParseTypedEvent(abci.Event(events[i]))
+		require.NoError(t, err)
+		return ev.(*btcctypes.EventBtcCheckpointRolledBack)
+	}
+	return nil
+}
+
 func TestRejectDuplicatedSubmission(t *testing.T) {
 	r := rand.New(rand.NewSource(time.Now().Unix()))
 	epoch := uint64(1)
@@ -137,6 +168,43 @@ func TestRejectUnknownToBtcLightClient(t *testing.T) {
 	require.ErrorContainsf(t, err, btcctypes.ErrInvalidHeader.Error(), "Processing should return invalid header error")
 }
 
+func TestReportConflictingCheckpoint(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	epoch := uint64(1)
+	raw, _ := dg.RandomRawCheckpointDataForEpoch(r, epoch)
+
+	blck1 := dg.CreateBlock(r, 1, 7, 7, raw.FirstPart)
+	blck2 := dg.CreateBlock(r, 2, 14, 3, raw.SecondPart)
+
+	tk := InitTestKeepers(t)
+
+	insertMsg := dg.GenerateMessageWithRandomSubmitter([]*dg.BlockCreationResult{blck1, blck2})
+	msg := &btcctypes.MsgReportConflictingCheckpoint{
+		Submitter: insertMsg.Submitter,
+		Proofs:    insertMsg.Proofs,
+	}
+
+	// headers unknown to btc light client
+	_, err := tk.MsgSrv.ReportConflictingCheckpoint(tk.Ctx, msg)
+	require.ErrorContainsf(t, err, btcctypes.ErrInvalidHeader.Error(), "Processing should return invalid header error")
+
+	tk.BTCLightClient.SetDepth(blck1.HeaderBytes.Hash(), uint64(1))
+	tk.BTCLightClient.SetDepth(blck2.HeaderBytes.Hash(), uint64(1))
+
+	// checkpoint not accepted as conflicting by checkpointing module
+	tk.Checkpointing.ReturnError()
+	_, err = tk.MsgSrv.ReportConflictingCheckpoint(tk.Ctx, msg)
+	require.Error(t, err)
+
+	tk.Checkpointing.ReturnSuccess()
+	_, err = tk.MsgSrv.ReportConflictingCheckpoint(tk.Ctx, msg)
+	require.NoError(t, err)
+
+	// the conflicting submission is not stored
+	ed := tk.GetEpochData(epoch)
+	require.Nil(t, ed)
+}
+
 func TestSubmitValidNewCheckpoint(t *testing.T) {
 	r := rand.New(rand.NewSource(time.Now().Unix()))
 	epoch := uint64(1)
@@ -434,6 +502,20 @@ func TestLeaveOnlyBestSubmissionWhenEpochFinalized(t *testing.T) {
 
 	require.Equal(t, finalSubKey.Key[0].Hash, b1Hash(msg3))
 	require.Equal(t, finalSubKey.Key[1].Hash, b2Hash(msg3))
+
+	// only the BTC txs of the remaining submission are indexed
+	for _, msg := range []*btcctypes.MsgInsertBTCSpvProof{msg1, msg2, msg3} {
+		for _, proof := range msg.Proofs {
+			tx, err := btcctypes.ParseTransaction(proof.BtcTransaction)
+			require.NoError(t, err)
+			sk := tk.BTCCheckpoint.GetSubmissionKeyByTxHash(tk.Ctx, tx.Hash())
+			if msg == msg3 {
+				require.Equal(t, finalSubKey, sk)
+			} else {
+				require.Nil(t, sk)
+			}
+		}
+	}
 }
 
 func TestTxIdxShouldBreakTies(t *testing.T) {
@@ -485,6 +567,63 @@ func TestTxIdxShouldBreakTies(t *testing.T) {
 	}
 }
 
+func TestBestSubmissionIsNotFirstSubmission(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	tk := InitTestKeepers(t)
+	defaultParams := btcctypes.DefaultParams()
+	wDeep := defaultParams.CheckpointFinalizationTimeout
+
+	msg1 := dg.GenerateMessageWithRandomSubmitterForEpoch(r, 1)
+	tk.BTCLightClient.SetDepth(b1Hash(msg1), uint64(1))
+	tk.BTCLightClient.SetDepth(b2Hash(msg1), uint64(0))
+	_, err := tk.insertProofMsg(msg1)
+	require.NoError(t, err, "failed to insert submission")
+
+	msg2 := dg.GenerateMessageWithRandomSubmitterForEpoch(r, 1)
+	tk.BTCLightClient.SetDepth(b1Hash(msg2), uint64(1))
+	tk.BTCLightClient.SetDepth(b2Hash(msg2), uint64(0))
+	_, err = tk.insertProofMsg(msg2)
+	require.NoError(t, err, "failed to insert submission")
+
+	msg3 := dg.GenerateMessageWithRandomSubmitterForEpoch(r, 1)
+	tk.BTCLightClient.SetDepth(b1Hash(msg3), uint64(1))
+	tk.BTCLightClient.SetDepth(b2Hash(msg3), uint64(0))
+	_, err = tk.insertProofMsg(msg3)
+	require.NoError(t, err, "failed to insert submission")
+
+	// first submission lands on a fork, second submission is the deepest one
+	tk.BTCLightClient.DeleteHeader(b1Hash(msg1))
+	tk.BTCLightClient.SetDepth(b1Hash(msg2), wDeep+2)
+	tk.BTCLightClient.SetDepth(b2Hash(msg2), wDeep+3)
+	tk.BTCLightClient.SetDepth(b1Hash(msg3), wDeep)
+	tk.BTCLightClient.SetDepth(b2Hash(msg3), wDeep+1)
+
+	resp, err := tk.BTCCheckpoint.BestSubmission(tk.Ctx, &btcctypes.QueryBestSubmissionRequest{EpochNum: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), resp.NumSubmissions)
+	require.Equal(t, b1Hash(msg2).MarshalHex(), resp.Key.FirstTxBlockHash)
+	require.Equal(t, b2Hash(msg2).MarshalHex(), resp.Key.SecondTxBlockHash)
+
+	tk.onTipChange()
+
+	ed := tk.GetEpochData(uint64(1))
+	require.NotNil(t, ed)
+	require.Len(t, ed.Keys, 1)
+	require.Equal(t, ed.Status, btcctypes.Finalized)
+
+	// best submission and its data are kept after finalization
+	finalSubKey := ed.Keys[0]
+	require.Equal(t, finalSubKey.Key[0].Hash, b1Hash(msg2))
+	require.Equal(t, finalSubKey.Key[1].Hash, b2Hash(msg2))
+	require.NotNil(t, tk.getSubmissionData(*finalSubKey))
+
+	resp, err = tk.BTCCheckpoint.BestSubmission(tk.Ctx, &btcctypes.QueryBestSubmissionRequest{EpochNum: 1})
+	require.NoError(t, err)
+	require.Equal(t, btcctypes.Finalized, resp.Status)
+	require.Equal(t, uint64(1), resp.NumSubmissions)
+	require.Equal(t, b1Hash(msg2).MarshalHex(), resp.Key.FirstTxBlockHash)
+}
+
 func TestStateTransitionOfValidSubmission(t *testing.T) {
 	r := rand.New(rand.NewSource(time.Now().Unix()))
 	epoch := uint64(1)
@@ -520,6 +659,10 @@ func TestStateTransitionOfValidSubmission(t *testing.T) {
 	if ed.Status != btcctypes.Submitted {
 		t.Errorf("Epoch should be in submitted stated")
 	}
+	ev := tk.lastStatusUpdateEvent(t)
+	require.NotNil(t, ev)
+	require.Equal(t, epoch, ev.EpochNum)
+	require.Equal(t, btcctypes.Submitted, ev.NewStatus)
 
 	// Now we will return depth enough for moving submission to confirmed
 	tk.BTCLightClient.SetDepth(blck1.HeaderBytes.Hash(), kDeep)
@@ -538,6 +681,9 @@ func TestStateTransitionOfValidSubmission(t *testing.T) {
 	if ed.Status != btcctypes.Confirmed {
 		t.Errorf("Epoch should be in submitted stated")
 	}
+	ev = tk.lastStatusUpdateEvent(t)
+	require.Equal(t, btcctypes.Confirmed, ev.NewStatus)
+	require.Equal(t, kDeep, ev.BtcDepth)
 
 	tk.BTCLightClient.SetDepth(blck1.HeaderBytes.Hash(), wDeep)
 	tk.BTCLightClient.SetDepth(blck2.HeaderBytes.Hash(), wDeep)
@@ -549,6 +695,9 @@ func TestStateTransitionOfValidSubmission(t *testing.T) {
 	if ed == nil || ed.Status != btcctypes.Finalized {
 		t.Errorf("Epoch Data missing of in unexpected state")
 	}
+	ev = tk.lastStatusUpdateEvent(t)
+	require.Equal(t, btcctypes.Finalized, ev.NewStatus)
+	require.Equal(t, wDeep, ev.BtcDepth)
 }
 
 func FuzzConfirmAndDinalizeManyEpochs(f *testing.F) {
@@ -654,3 +803,77 @@ func FuzzConfirmAndDinalizeManyEpochs(f *testing.F) {
 		}
 	})
 }
+
+func TestRollBackCheckpointUponBtcReorg(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	epoch := uint64(1)
+	defaultParams := btcctypes.DefaultParams()
+	kDeep := defaultParams.BtcConfirmationDepth
+	wDeep := defaultParams.CheckpointFinalizationTimeout
+	raw, _ := dg.RandomRawCheckpointDataForEpoch(r, epoch)
+
+	blck1 := dg.CreateBlock(r, 1, 7, 7, raw.FirstPart)
+	blck2 := dg.CreateBlock(r, 2, 14, 3, raw.SecondPart)
+
+	tk := InitTestKeepers(t)
+
+	msg := dg.GenerateMessageWithRandomSubmitter([]*dg.BlockCreationResult{blck1, blck2})
+	setDepth := func(depth uint64) {
+		tk.BTCLightClient.SetDepth(blck1.HeaderBytes.Hash(), depth)
+		tk.BTCLightClient.SetDepth(blck2.HeaderBytes.Hash(), depth)
+	}
+
+	// the epoch becomes finalized
+	setDepth(1)
+	_, err := tk.insertProofMsg(msg)
+	require.NoError(t, err)
+	setDepth(kDeep)
+	tk.onTipChange()
+	setDepth(wDeep)
+	tk.onTipChange()
+	require.Equal(t, btcctypes.Finalized, tk.GetEpochData(epoch).Status)
+	require.Nil(t, tk.lastRolledBackEvent(t))
+
+	// the best submission is no longer w-deep upon a BTC reorg, so the epoch
+	// rolls back to confirmed
+	setDepth(kDeep)
+	tk.onTipChange()
+	ed := tk.GetEpochData(epoch)
+	require.Equal(t, btcctypes.Confirmed, ed.Status)
+	require.Len(t, ed.Keys, 1)
+	ev := tk.lastRolledBackEvent(t)
+	require.NotNil(t, ev)
+	require.Equal(t, epoch, ev.EpochNum)
+	require.Equal(t, btcctypes.Finalized, ev.OldStatus)
+	require.Equal(t, btcctypes.Confirmed, ev.NewStatus)
+
+	// the best submission is no longer k-deep upon a BTC reorg, so the epoch
+	// rolls back to submitted
+	setDepth(kDeep - 1)
+	tk.onTipChange()
+	ed = tk.GetEpochData(epoch)
+	require.Equal(t, btcctypes.Submitted, ed.Status)
+	require.Len(t, ed.Keys, 1)
+	ev = tk.lastRolledBackEvent(t)
+	require.Equal(t, btcctypes.Confirmed, ev.OldStatus)
+	require.Equal(t, btcctypes.Submitted, ev.NewStatus)
+
+	// the epoch becomes finalized again
+	setDepth(kDeep)
+	tk.onTipChange()
+	setDepth(wDeep)
+	tk.onTipChange()
+	require.Equal(t, btcctypes.Finalized, tk.GetEpochData(epoch).Status)
+	require.Equal(t, btcctypes.Finalized, tk.lastStatusUpdateEvent(t).NewStatus)
+
+	// the best submission is reorged out of the BTC main chain, so the epoch
+	// loses its finality and all its submissions
+	tk.BTCLightClient.DeleteHeader(blck1.HeaderBytes.Hash())
+	tk.BTCLightClient.DeleteHeader(blck2.HeaderBytes.Hash())
+	tk.onTipChange()
+	ed = tk.GetEpochData(epoch)
+	require.Empty(t, ed.Keys)
+	ev = tk.lastRolledBackEvent(t)
+	require.Equal(t, btcctypes.Finalized, ev.OldStatus)
+	require.Equal(t, btcctypes.Confirmed, ev.NewStatus)
+}
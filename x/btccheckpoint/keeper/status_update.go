@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/babylonchain/babylon/x/btccheckpoint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// onBtcCheckpointStatusUpdate emits an event and invokes the corresponding
+// hook upon the BTC status change of the checkpoint of the given epoch
+func (k Keeper) onBtcCheckpointStatusUpdate(
+	ctx context.Context,
+	epoch uint64,
+	newStatus types.BtcStatus,
+	bestSubmission *types.SubmissionBtcInfo,
+) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	btcHeight, err := k.GetBlockHeight(ctx, &bestSubmission.YoungestBlockHash)
+	if err != nil {
+		// best submission is on the BTC main chain, so this should not happen
+		k.Logger(sdkCtx).Error("failed to get BTC height of best submission", "epoch", epoch, "err", err)
+	}
+	event := &types.EventBtcCheckpointStatusUpdate{
+		EpochNum:       epoch,
+		NewStatus:      newStatus,
+		BtcBlockHeight: btcHeight,
+		BtcBlockHash:   bestSubmission.YoungestBlockHash.MarshalHex(),
+		BtcDepth:       bestSubmission.SubmissionDepth(),
+	}
+	if err := sdkCtx.EventManager().EmitTypedEvent(event); err != nil {
+		k.Logger(sdkCtx).Error("failed to emit BTC checkpoint status update event", "epoch", epoch, "err", err)
+	}
+
+	switch newStatus {
+	case types.Submitted:
+		k.AfterBtcCheckpointSubmitted(ctx, epoch, bestSubmission)
+	case types.Confirmed:
+		k.AfterBtcCheckpointConfirmed(ctx, epoch, bestSubmission)
+	case types.Finalized:
+		k.AfterBtcCheckpointFinalized(ctx, epoch, bestSubmission)
+	}
+}
+
+// onBtcCheckpointForgotten emits an event and invokes the corresponding hook
+// after the checkpoint of the given epoch loses all its submissions
+func (k Keeper) onBtcCheckpointForgotten(ctx context.Context, epoch uint64, oldStatus types.BtcStatus) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	event := &types.EventBtcCheckpointForgotten{
+		EpochNum:  epoch,
+		OldStatus: oldStatus,
+	}
+	if err := sdkCtx.EventManager().EmitTypedEvent(event); err != nil {
+		k.Logger(sdkCtx).Error("failed to emit BTC checkpoint forgotten event", "epoch", epoch, "err", err)
+	}
+
+	k.AfterBtcCheckpointForgotten(ctx, epoch)
+}
+
+// onBtcCheckpointRolledBack emits an event after the BTC status of the
+// checkpoint of the given epoch is downgraded upon a BTC reorg
+func (k Keeper) onBtcCheckpointRolledBack(ctx context.Context, epoch uint64, oldStatus types.BtcStatus, newStatus types.BtcStatus) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	event := &types.EventBtcCheckpointRolledBack{
+		EpochNum:  epoch,
+		OldStatus: oldStatus,
+		NewStatus: newStatus,
+	}
+	if err := sdkCtx.EventManager().EmitTypedEvent(event); err != nil {
+		k.Logger(sdkCtx).Error("failed to emit BTC checkpoint rolled back event", "epoch", epoch, "err", err)
+	}
+}
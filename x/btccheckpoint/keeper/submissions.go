@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,6 +10,7 @@ import (
 	"cosmossdk.io/store/prefix"
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btccheckpoint/types"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 )
 
 func (k Keeper) HasSubmission(ctx context.Context, sk types.SubmissionKey) bool {
@@ -40,7 +42,8 @@ func (k Keeper) GetBestSubmission(ctx context.Context, epochNumber uint64) (type
 }
 
 // addEpochSubmission save given submission key and data to database and takes
-// car of updating any necessary indexes.
+// car of updating any necessary indexes. The given submission info is the
+// position of the submission on btc chain.
 // Provided submmission should be known to btclightclient and all of its blocks
 // should be on btc main chaing as viewed by btclightclient
 func (k Keeper) addEpochSubmission(
@@ -48,6 +51,7 @@ func (k Keeper) addEpochSubmission(
 	epochNum uint64,
 	sk types.SubmissionKey,
 	sd types.SubmissionData,
+	submissionInfo *types.SubmissionBtcInfo,
 ) error {
 
 	ed := k.GetEpochData(ctx, epochNum)
@@ -72,7 +76,8 @@ func (k Keeper) addEpochSubmission(
 		return types.ErrEpochAlreadyFinalized
 	}
 
-	if len(ed.Keys) == 0 {
+	isFirstSubmission := len(ed.Keys) == 0
+	if isFirstSubmission {
 		// it is first epoch submission inform checkpointing module about this fact
 		k.checkpointingKeeper.SetCheckpointSubmitted(ctx, epochNum)
 	}
@@ -80,6 +85,10 @@ func (k Keeper) addEpochSubmission(
 	ed.AppendKey(sk)
 	k.saveEpochData(ctx, epochNum, ed)
 	k.saveSubmission(ctx, sk, sd)
+
+	if isFirstSubmission {
+		k.onBtcCheckpointStatusUpdate(ctx, epochNum, types.Submitted, submissionInfo)
+	}
 	return nil
 }
 
@@ -90,16 +99,75 @@ func (k Keeper) saveSubmission(ctx context.Context, sk types.SubmissionKey, sd t
 	if err := store.Set(kBytes, sBytes); err != nil {
 		panic(err)
 	}
+
+	// index the submission by the hashes of its BTC txs
+	skBytes := k.cdc.MustMarshal(&sk)
+	for _, txHash := range submissionTxHashes(&sd) {
+		if err := store.Set(types.GetSubmissionTxIndexKey(txHash[:]), skBytes); err != nil {
+			panic(err)
+		}
+	}
 }
 
 func (k Keeper) deleteSubmission(ctx context.Context, sk types.SubmissionKey) {
 	store := k.storeService.OpenKVStore(ctx)
 	kBytes := types.PrefixedSubmisionKey(k.cdc, &sk)
+
+	// remove the index entries of the BTC txs of the submission, unless the
+	// same BTC tx has been indexed for another submission since
+	if sd := k.GetSubmissionData(ctx, sk); sd != nil {
+		skBytes := k.cdc.MustMarshal(&sk)
+		for _, txHash := range submissionTxHashes(sd) {
+			indexKey := types.GetSubmissionTxIndexKey(txHash[:])
+			indexedSKBytes, err := store.Get(indexKey)
+			if err != nil {
+				panic(err)
+			}
+			if !bytes.Equal(indexedSKBytes, skBytes) {
+				continue
+			}
+			if err := store.Delete(indexKey); err != nil {
+				panic(err)
+			}
+		}
+	}
+
 	if err := store.Delete(kBytes); err != nil {
 		panic(err)
 	}
 }
 
+// submissionTxHashes returns the hashes of the BTC txs of the given
+// submission. The BTC txs have been validated upon submission
+func submissionTxHashes(sd *types.SubmissionData) []*chainhash.Hash {
+	txHashes := make([]*chainhash.Hash, 0, len(sd.TxsInfo))
+	for _, txInfo := range sd.TxsInfo {
+		tx, err := types.ParseTransaction(txInfo.Transaction)
+		if err != nil {
+			panic(fmt.Errorf("failed to parse BTC tx of a stored submission: %w", err))
+		}
+		txHashes = append(txHashes, tx.Hash())
+	}
+	return txHashes
+}
+
+// GetSubmissionKeyByTxHash returns the key of the submission including the
+// BTC tx with the given hash, or nil if no stored submission includes it
+func (k Keeper) GetSubmissionKeyByTxHash(ctx context.Context, txHash *chainhash.Hash) *types.SubmissionKey {
+	store := k.storeService.OpenKVStore(ctx)
+	skBytes, err := store.Get(types.GetSubmissionTxIndexKey(txHash[:]))
+	if err != nil {
+		panic(err)
+	}
+	if len(skBytes) == 0 {
+		return nil
+	}
+
+	var sk types.SubmissionKey
+	k.cdc.MustUnmarshal(skBytes, &sk)
+	return &sk
+}
+
 // GetSubmissionData returns submission data for a given key or nil if there is no data
 // under the given key
 func (k Keeper) GetSubmissionData(ctx context.Context, sk types.SubmissionKey) *types.SubmissionData {
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 
+	txformat "github.com/babylonchain/babylon/btctxformatter"
 	"github.com/babylonchain/babylon/types"
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcutil"
@@ -30,7 +31,7 @@ const (
 // - Bitcoin Header hash
 // - Bitcoin Transaction
 // - Bitcoin Transaction index in block
-// - Non-empty OpReturnData
+// - Non-empty OpReturnData or WitnessEnvelopeData
 type ParsedProof struct {
 	// keeping header hash to avoid recomputing it everytime
 	BlockHash        types.BTCHeaderHashBytes
@@ -38,6 +39,28 @@ type ParsedProof struct {
 	TransactionBytes []byte
 	TransactionIdx   uint32
 	OpReturnData     []byte
+	// data of the Babylon envelope in the Taproot witness of the transaction
+	WitnessEnvelopeData []byte
+}
+
+// GetCheckpointData returns the checkpoint part with the given index carried
+// by the transaction. The part can be either in the OP_RETURN outputs or in a
+// Taproot witness envelope, where OP_RETURN outputs take precedence.
+func (p *ParsedProof) GetCheckpointData(
+	tag txformat.BabylonTag,
+	version txformat.FormatVersion,
+	partIndex uint8,
+) ([]byte, error) {
+	data, err := txformat.GetCheckpointData(tag, version, partIndex, p.OpReturnData)
+	if err == nil {
+		return data, nil
+	}
+
+	if len(p.WitnessEnvelopeData) == 0 {
+		return nil, err
+	}
+
+	return txformat.GetCheckpointData(tag, version, partIndex, p.WitnessEnvelopeData)
 }
 
 // Concatenates and double hashes two provided inputs
@@ -232,6 +255,22 @@ func ExtractOpReturnData(tx *btcutil.Tx) []byte {
 	return opReturnData
 }
 
+// ExtractWitnessEnvelopeData returns the data of the first Babylon envelope
+// in the Taproot script path spends of the transaction's inputs
+func ExtractWitnessEnvelopeData(tx *btcutil.Tx) []byte {
+	for _, txIn := range tx.MsgTx().TxIn {
+		tapscript := txformat.TapscriptFromWitness(txIn.Witness)
+		if tapscript == nil {
+			continue
+		}
+		if data := txformat.ExtractWitnessEnvelopeData(tapscript); len(data) > 0 {
+			return data
+		}
+	}
+
+	return nil
+}
+
 func ParseTransaction(bytes []byte) (*btcutil.Tx, error) {
 	tx, e := btcutil.NewTxFromBytes(bytes)
 
@@ -277,9 +316,10 @@ func ParseProof(
 	}
 
 	opReturnData := ExtractOpReturnData(tx)
+	witnessEnvelopeData := ExtractWitnessEnvelopeData(tx)
 
-	if len(opReturnData) == 0 {
-		return nil, fmt.Errorf("provided transaction should provide op return data")
+	if len(opReturnData) == 0 && len(witnessEnvelopeData) == 0 {
+		return nil, fmt.Errorf("provided transaction should provide op return or witness envelope data")
 	}
 
 	bh := header.BlockHash()
@@ -289,6 +329,8 @@ func ParseProof(
 		TransactionBytes: btcTransaction,
 		TransactionIdx:   transactionIndex,
 		OpReturnData:     opReturnData,
+
+		WitnessEnvelopeData: witnessEnvelopeData,
 	}
 
 	return parsedProof, nil
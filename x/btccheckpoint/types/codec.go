@@ -10,12 +10,14 @@ import (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgInsertBTCSpvProof{}, "btccheckpoint/MsgInsertBTCSpvProof", nil)
+	cdc.RegisterConcrete(&MsgReportConflictingCheckpoint{}, "btccheckpoint/MsgReportConflictingCheckpoint", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "btccheckpoint/MsgUpdateParams", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgInsertBTCSpvProof{},
+		&MsgReportConflictingCheckpoint{},
 	)
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
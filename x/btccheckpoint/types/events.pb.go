@@ -0,0 +1,909 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: babylon/btccheckpoint/v1/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventBtcCheckpointStatusUpdate is emitted when the BTC status of the
+// checkpoint of an epoch changes. There are the following possible status
+// transitions:
+// - non-existing -> SUBMITTED, upon the first valid submission of the epoch
+// - SUBMITTED -> CONFIRMED, when the best submission becomes k-deep
+// - CONFIRMED -> FINALIZED, when the best submission becomes w-deep
+type EventBtcCheckpointStatusUpdate struct {
+	// epoch_num is the number of the epoch
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// new_status is the new BTC status of the checkpoint
+	NewStatus BtcStatus `protobuf:"varint,2,opt,name=new_status,json=newStatus,proto3,enum=babylon.btccheckpoint.v1.BtcStatus" json:"new_status,omitempty"`
+	// btc_block_height is the height of the youngest BTC block of the best
+	// submission of the epoch
+	BtcBlockHeight uint64 `protobuf:"varint,3,opt,name=btc_block_height,json=btcBlockHeight,proto3" json:"btc_block_height,omitempty"`
+	// btc_block_hash is the hash of the youngest BTC block of the best
+	// submission of the epoch as hex
+	BtcBlockHash string `protobuf:"bytes,4,opt,name=btc_block_hash,json=btcBlockHash,proto3" json:"btc_block_hash,omitempty"`
+	// btc_depth is the depth of the best submission of the epoch
+	BtcDepth uint64 `protobuf:"varint,5,opt,name=btc_depth,json=btcDepth,proto3" json:"btc_depth,omitempty"`
+}
+
+func (m *EventBtcCheckpointStatusUpdate) Reset()         { *m = EventBtcCheckpointStatusUpdate{} }
+func (m *EventBtcCheckpointStatusUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventBtcCheckpointStatusUpdate) ProtoMessage()    {}
+func (*EventBtcCheckpointStatusUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a3dc2a5e93427d, []int{0}
+}
+func (m *EventBtcCheckpointStatusUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBtcCheckpointStatusUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBtcCheckpointStatusUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBtcCheckpointStatusUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBtcCheckpointStatusUpdate.Merge(m, src)
+}
+func (m *EventBtcCheckpointStatusUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBtcCheckpointStatusUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBtcCheckpointStatusUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBtcCheckpointStatusUpdate proto.InternalMessageInfo
+
+func (m *EventBtcCheckpointStatusUpdate) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *EventBtcCheckpointStatusUpdate) GetNewStatus() BtcStatus {
+	if m != nil {
+		return m.NewStatus
+	}
+	return Submitted
+}
+
+func (m *EventBtcCheckpointStatusUpdate) GetBtcBlockHeight() uint64 {
+	if m != nil {
+		return m.BtcBlockHeight
+	}
+	return 0
+}
+
+func (m *EventBtcCheckpointStatusUpdate) GetBtcBlockHash() string {
+	if m != nil {
+		return m.BtcBlockHash
+	}
+	return ""
+}
+
+func (m *EventBtcCheckpointStatusUpdate) GetBtcDepth() uint64 {
+	if m != nil {
+		return m.BtcDepth
+	}
+	return 0
+}
+
+// EventBtcCheckpointForgotten is emitted when the checkpoint of an epoch
+// loses all its submissions on the BTC main chain, e.g., due to a BTC reorg,
+// and is thus rolled back
+type EventBtcCheckpointForgotten struct {
+	// epoch_num is the number of the epoch
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// old_status is the BTC status of the checkpoint before it is forgotten
+	OldStatus BtcStatus `protobuf:"varint,2,opt,name=old_status,json=oldStatus,proto3,enum=babylon.btccheckpoint.v1.BtcStatus" json:"old_status,omitempty"`
+}
+
+func (m *EventBtcCheckpointForgotten) Reset()         { *m = EventBtcCheckpointForgotten{} }
+func (m *EventBtcCheckpointForgotten) String() string { return proto.CompactTextString(m) }
+func (*EventBtcCheckpointForgotten) ProtoMessage()    {}
+func (*EventBtcCheckpointForgotten) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a3dc2a5e93427d, []int{1}
+}
+func (m *EventBtcCheckpointForgotten) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBtcCheckpointForgotten) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBtcCheckpointForgotten.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBtcCheckpointForgotten) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBtcCheckpointForgotten.Merge(m, src)
+}
+func (m *EventBtcCheckpointForgotten) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBtcCheckpointForgotten) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBtcCheckpointForgotten.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBtcCheckpointForgotten proto.InternalMessageInfo
+
+func (m *EventBtcCheckpointForgotten) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *EventBtcCheckpointForgotten) GetOldStatus() BtcStatus {
+	if m != nil {
+		return m.OldStatus
+	}
+	return Submitted
+}
+
+// EventBtcCheckpointRolledBack is emitted when the best submission of the
+// checkpoint of an epoch becomes shallower on the BTC main chain upon a BTC
+// reorg, and the BTC status of the checkpoint is thus downgraded. There are
+// the following possible status transitions:
+// - FINALIZED -> CONFIRMED, when the best submission is no longer w-deep
+// - CONFIRMED -> SUBMITTED, when the best submission is no longer k-deep
+type EventBtcCheckpointRolledBack struct {
+	// epoch_num is the number of the epoch
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// old_status is the BTC status of the checkpoint before the rollback
+	OldStatus BtcStatus `protobuf:"varint,2,opt,name=old_status,json=oldStatus,proto3,enum=babylon.btccheckpoint.v1.BtcStatus" json:"old_status,omitempty"`
+	// new_status is the BTC status of the checkpoint after the rollback
+	NewStatus BtcStatus `protobuf:"varint,3,opt,name=new_status,json=newStatus,proto3,enum=babylon.btccheckpoint.v1.BtcStatus" json:"new_status,omitempty"`
+}
+
+func (m *EventBtcCheckpointRolledBack) Reset()         { *m = EventBtcCheckpointRolledBack{} }
+func (m *EventBtcCheckpointRolledBack) String() string { return proto.CompactTextString(m) }
+func (*EventBtcCheckpointRolledBack) ProtoMessage()    {}
+func (*EventBtcCheckpointRolledBack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a3dc2a5e93427d, []int{2}
+}
+func (m *EventBtcCheckpointRolledBack) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBtcCheckpointRolledBack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBtcCheckpointRolledBack.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBtcCheckpointRolledBack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBtcCheckpointRolledBack.Merge(m, src)
+}
+func (m *EventBtcCheckpointRolledBack) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBtcCheckpointRolledBack) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBtcCheckpointRolledBack.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBtcCheckpointRolledBack proto.InternalMessageInfo
+
+func (m *EventBtcCheckpointRolledBack) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *EventBtcCheckpointRolledBack) GetOldStatus() BtcStatus {
+	if m != nil {
+		return m.OldStatus
+	}
+	return Submitted
+}
+
+func (m *EventBtcCheckpointRolledBack) GetNewStatus() BtcStatus {
+	if m != nil {
+		return m.NewStatus
+	}
+	return Submitted
+}
+
+func init() {
+	proto.RegisterType((*EventBtcCheckpointStatusUpdate)(nil), "babylon.btccheckpoint.v1.EventBtcCheckpointStatusUpdate")
+	proto.RegisterType((*EventBtcCheckpointForgotten)(nil), "babylon.btccheckpoint.v1.EventBtcCheckpointForgotten")
+	proto.RegisterType((*EventBtcCheckpointRolledBack)(nil), "babylon.btccheckpoint.v1.EventBtcCheckpointRolledBack")
+}
+
+func init() {
+	proto.RegisterFile("babylon/btccheckpoint/v1/events.proto", fileDescriptor_51a3dc2a5e93427d)
+}
+
+var fileDescriptor_51a3dc2a5e93427d = []byte{
+	// 356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xcf, 0x4a, 0xc3, 0x40,
+	0x10, 0xc6, 0xbb, 0xb6, 0x8a, 0x5d, 0xa4, 0x48, 0x4e, 0xc1, 0xca, 0x52, 0xaa, 0x42, 0x0e, 0x92,
+	0x50, 0xc5, 0x17, 0x88, 0x7f, 0xf0, 0xa4, 0x10, 0xf1, 0xe2, 0x25, 0xec, 0x6e, 0x96, 0x6e, 0x68,
+	0xba, 0x1b, 0x9a, 0x49, 0x6b, 0x2f, 0x3e, 0x83, 0xef, 0xe4, 0xc5, 0x63, 0x8f, 0x1e, 0xa5, 0xb9,
+	0xfb, 0x0c, 0x92, 0xa4, 0x16, 0xdb, 0x52, 0x84, 0x82, 0xb7, 0x99, 0xc9, 0x2f, 0xdf, 0x7e, 0x1f,
+	0x33, 0xf8, 0x84, 0x51, 0x36, 0x8e, 0xb4, 0x72, 0x18, 0x70, 0x2e, 0x05, 0xef, 0xc5, 0x3a, 0x54,
+	0xe0, 0x0c, 0x3b, 0x8e, 0x18, 0x0a, 0x05, 0x89, 0x1d, 0x0f, 0x34, 0x68, 0xc3, 0x9c, 0x61, 0xf6,
+	0x02, 0x66, 0x0f, 0x3b, 0x07, 0xa7, 0x6b, 0x05, 0x16, 0xd1, 0x42, 0xa7, 0xfd, 0x85, 0x30, 0xb9,
+	0xce, 0x85, 0x5d, 0xe0, 0x97, 0xf3, 0x8f, 0x0f, 0x40, 0x21, 0x4d, 0x1e, 0xe3, 0x80, 0x82, 0x30,
+	0x9a, 0xb8, 0x2e, 0x62, 0xcd, 0xa5, 0xaf, 0xd2, 0xbe, 0x89, 0x5a, 0xc8, 0xaa, 0x79, 0xbb, 0xc5,
+	0xe0, 0x2e, 0xed, 0x1b, 0x2e, 0xc6, 0x4a, 0x8c, 0xfc, 0xa4, 0xf8, 0xc1, 0xdc, 0x6a, 0x21, 0xab,
+	0x71, 0x76, 0x64, 0xaf, 0x33, 0x67, 0xbb, 0xc0, 0x4b, 0x6d, 0xaf, 0xae, 0xc4, 0xa8, 0x2c, 0x0d,
+	0x0b, 0xef, 0x33, 0xe0, 0x3e, 0x8b, 0x34, 0xef, 0xf9, 0x52, 0x84, 0x5d, 0x09, 0x66, 0xb5, 0x78,
+	0xa7, 0xc1, 0x80, 0xbb, 0xf9, 0xf8, 0xb6, 0x98, 0x1a, 0xc7, 0xb8, 0xf1, 0x8b, 0xa4, 0x89, 0x34,
+	0x6b, 0x2d, 0x64, 0xd5, 0xbd, 0xbd, 0x39, 0x47, 0x13, 0x99, 0x1b, 0xce, 0xa9, 0x40, 0xc4, 0x20,
+	0xcd, 0xed, 0xd2, 0x30, 0x03, 0x7e, 0x95, 0xf7, 0xed, 0x17, 0xdc, 0x5c, 0xcd, 0x7b, 0xa3, 0x07,
+	0x5d, 0x0d, 0x20, 0xd4, 0x9f, 0x61, 0x75, 0x14, 0x6c, 0x12, 0x56, 0x47, 0x41, 0x59, 0xb6, 0xdf,
+	0x10, 0x3e, 0x5c, 0x35, 0xe0, 0xe9, 0x28, 0x12, 0x81, 0x4b, 0x79, 0xef, 0xdf, 0x1d, 0x2c, 0xad,
+	0xac, 0xba, 0xc9, 0xca, 0xdc, 0xfb, 0xf7, 0x29, 0x41, 0x93, 0x29, 0x41, 0x9f, 0x53, 0x82, 0x5e,
+	0x33, 0x52, 0x99, 0x64, 0xa4, 0xf2, 0x91, 0x91, 0xca, 0xd3, 0x45, 0x37, 0x04, 0x99, 0x32, 0x9b,
+	0xeb, 0xbe, 0x33, 0xd3, 0xe4, 0x92, 0x86, 0xea, 0xa7, 0x71, 0x9e, 0x97, 0x0e, 0x13, 0xc6, 0xb1,
+	0x48, 0xd8, 0x4e, 0x71, 0x8e, 0xe7, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x76, 0x18, 0xfa, 0xfe,
+	0xff, 0x02, 0x00, 0x00,
+}
+
+func (m *EventBtcCheckpointStatusUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBtcCheckpointStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBtcCheckpointStatusUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcDepth != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BtcDepth))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BtcBlockHash) > 0 {
+		i -= len(m.BtcBlockHash)
+		copy(dAtA[i:], m.BtcBlockHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BtcBlockHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BtcBlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BtcBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NewStatus != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewStatus))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBtcCheckpointForgotten) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBtcCheckpointForgotten) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBtcCheckpointForgotten) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OldStatus != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OldStatus))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBtcCheckpointRolledBack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBtcCheckpointRolledBack) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBtcCheckpointRolledBack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewStatus != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewStatus))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OldStatus != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OldStatus))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventBtcCheckpointStatusUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovEvents(uint64(m.EpochNum))
+	}
+	if m.NewStatus != 0 {
+		n += 1 + sovEvents(uint64(m.NewStatus))
+	}
+	if m.BtcBlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BtcBlockHeight))
+	}
+	l = len(m.BtcBlockHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BtcDepth != 0 {
+		n += 1 + sovEvents(uint64(m.BtcDepth))
+	}
+	return n
+}
+
+func (m *EventBtcCheckpointForgotten) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovEvents(uint64(m.EpochNum))
+	}
+	if m.OldStatus != 0 {
+		n += 1 + sovEvents(uint64(m.OldStatus))
+	}
+	return n
+}
+
+func (m *EventBtcCheckpointRolledBack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovEvents(uint64(m.EpochNum))
+	}
+	if m.OldStatus != 0 {
+		n += 1 + sovEvents(uint64(m.OldStatus))
+	}
+	if m.NewStatus != 0 {
+		n += 1 + sovEvents(uint64(m.NewStatus))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventBtcCheckpointStatusUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBtcCheckpointStatusUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBtcCheckpointStatusUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+			}
+			m.NewStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewStatus |= BtcStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcBlockHeight", wireType)
+			}
+			m.BtcBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcBlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcBlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDepth", wireType)
+			}
+			m.BtcDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBtcCheckpointForgotten) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBtcCheckpointForgotten: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBtcCheckpointForgotten: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldStatus", wireType)
+			}
+			m.OldStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldStatus |= BtcStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBtcCheckpointRolledBack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBtcCheckpointRolledBack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBtcCheckpointRolledBack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldStatus", wireType)
+			}
+			m.OldStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldStatus |= BtcStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+			}
+			m.NewStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewStatus |= BtcStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -18,6 +18,10 @@ type BTCLightClientKeeper interface {
 
 type CheckpointingKeeper interface {
 	VerifyCheckpoint(ctx context.Context, checkpoint txformat.RawBtcCheckpoint) error
+	// HandleConflictingCheckpoint informs checkpointing module that a checkpoint
+	// conflicting with the local one was submitted on btc chain, such that its
+	// signers are punished
+	HandleConflictingCheckpoint(ctx context.Context, checkpoint txformat.RawBtcCheckpoint) error
 	// It quite mouthfull to have 4 different methods to operate on checkpoint state
 	// but this approach decouples both modules a bit more than having some kind
 	// of shared enum passed into the methods. Both modules are free to evolve their
@@ -36,8 +40,22 @@ type CheckpointingKeeper interface {
 	// SetCheckpointForgotten informs checkpointing module that this checkpoint lost
 	// all submissions on btc chain
 	SetCheckpointForgotten(ctx context.Context, epoch uint64)
+	// SetCheckpointUnfinalized informs checkpointing module that the finalized
+	// checkpoint is no longer W-deep on the main chain after a btc reorg
+	SetCheckpointUnfinalized(ctx context.Context, epoch uint64)
+	// SetCheckpointUnconfirmed informs checkpointing module that the confirmed
+	// checkpoint is no longer K-deep on the main chain after a btc reorg
+	SetCheckpointUnconfirmed(ctx context.Context, epoch uint64)
 }
 
 type IncentiveKeeper interface {
 	RewardBTCTimestamping(ctx context.Context, epoch uint64, rewardDistInfo *RewardDistInfo)
 }
+
+// BtcCheckpointHooks event hooks for BTC status changes of checkpoints
+type BtcCheckpointHooks interface {
+	AfterBtcCheckpointSubmitted(ctx context.Context, epoch uint64, bestSubmission *SubmissionBtcInfo) // Must be called after the first valid submission of an epoch
+	AfterBtcCheckpointConfirmed(ctx context.Context, epoch uint64, bestSubmission *SubmissionBtcInfo) // Must be called after the best submission of an epoch becomes k-deep
+	AfterBtcCheckpointFinalized(ctx context.Context, epoch uint64, bestSubmission *SubmissionBtcInfo) // Must be called after the best submission of an epoch becomes w-deep
+	AfterBtcCheckpointForgotten(ctx context.Context, epoch uint64)                                    // Must be called after an epoch loses all its submissions
+}
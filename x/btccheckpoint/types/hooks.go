@@ -0,0 +1,37 @@
+package types
+
+import (
+	"context"
+)
+
+var _ BtcCheckpointHooks = &MultiBtcCheckpointHooks{}
+
+type MultiBtcCheckpointHooks []BtcCheckpointHooks
+
+func NewMultiBtcCheckpointHooks(hooks ...BtcCheckpointHooks) MultiBtcCheckpointHooks {
+	return hooks
+}
+
+func (h MultiBtcCheckpointHooks) AfterBtcCheckpointSubmitted(ctx context.Context, epoch uint64, bestSubmission *SubmissionBtcInfo) {
+	for i := range h {
+		h[i].AfterBtcCheckpointSubmitted(ctx, epoch, bestSubmission)
+	}
+}
+
+func (h MultiBtcCheckpointHooks) AfterBtcCheckpointConfirmed(ctx context.Context, epoch uint64, bestSubmission *SubmissionBtcInfo) {
+	for i := range h {
+		h[i].AfterBtcCheckpointConfirmed(ctx, epoch, bestSubmission)
+	}
+}
+
+func (h MultiBtcCheckpointHooks) AfterBtcCheckpointFinalized(ctx context.Context, epoch uint64, bestSubmission *SubmissionBtcInfo) {
+	for i := range h {
+		h[i].AfterBtcCheckpointFinalized(ctx, epoch, bestSubmission)
+	}
+}
+
+func (h MultiBtcCheckpointHooks) AfterBtcCheckpointForgotten(ctx context.Context, epoch uint64) {
+	for i := range h {
+		h[i].AfterBtcCheckpointForgotten(ctx, epoch)
+	}
+}
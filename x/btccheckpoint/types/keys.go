@@ -34,6 +34,13 @@ var (
 	LastFinalizedEpochKey    = append([]byte{5}, []byte(LatestFinalizedEpochKey)...)
 	BtcLightClientUpdatedKey = append([]byte{6}, []byte(btcLightClientUpdated)...)
 	ParamsKey                = []byte{7}
+	// HighestFinalizedEpochKey is the key of the highest epoch that has ever
+	// been finalized, which can be higher than the last finalized epoch after
+	// a btc reorg deeper than w
+	HighestFinalizedEpochKey = []byte{8}
+	// SubmissionTxIndexPrefix is the key prefix of the index from the hash of
+	// each BTC tx of a submission to the submission key
+	SubmissionTxIndexPrefix = []byte{9}
 )
 
 func KeyPrefix(p string) []byte {
@@ -44,6 +51,10 @@ func PrefixedSubmisionKey(cdc codec.BinaryCodec, k *SubmissionKey) []byte {
 	return append(SubmisionKeyPrefix, cdc.MustMarshal(k)...)
 }
 
+func GetSubmissionTxIndexKey(txHash []byte) []byte {
+	return append(SubmissionTxIndexPrefix, txHash...)
+}
+
 func GetEpochIndexKey(e uint64) []byte {
 	return append(EpochDataPrefix, sdk.Uint64ToBigEndian(e)...)
 }
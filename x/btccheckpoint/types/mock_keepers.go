@@ -75,6 +75,14 @@ func (ck MockCheckpointingKeeper) VerifyCheckpoint(ctx context.Context, checkpoi
 	return nil
 }
 
+func (ck MockCheckpointingKeeper) HandleConflictingCheckpoint(ctx context.Context, checkpoint txformat.RawBtcCheckpoint) error {
+	if ck.returnError {
+		return errors.New("checkpoint is not conflicting")
+	}
+
+	return nil
+}
+
 // SetCheckpointSubmitted Informs checkpointing module that checkpoint was
 // successfully submitted on btc chain.
 func (ck MockCheckpointingKeeper) SetCheckpointSubmitted(ctx context.Context, epoch uint64) {
@@ -95,5 +103,15 @@ func (ck MockCheckpointingKeeper) SetCheckpointFinalized(ctx context.Context, ep
 func (ck MockCheckpointingKeeper) SetCheckpointForgotten(ctx context.Context, epoch uint64) {
 }
 
+// SetCheckpointUnfinalized Informs checkpointing module that checkpoint is
+// no longer W-deep on the main chain after a BTC reorg
+func (ck MockCheckpointingKeeper) SetCheckpointUnfinalized(ctx context.Context, epoch uint64) {
+}
+
+// SetCheckpointUnconfirmed Informs checkpointing module that checkpoint is
+// no longer K-deep on the main chain after a BTC reorg
+func (ck MockCheckpointingKeeper) SetCheckpointUnconfirmed(ctx context.Context, epoch uint64) {
+}
+
 func (ik *MockIncentiveKeeper) RewardBTCTimestamping(ctx context.Context, epoch uint64, rewardDistInfo *RewardDistInfo) {
 }
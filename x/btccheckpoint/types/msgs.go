@@ -13,11 +13,12 @@ import (
 var (
 	// Ensure that MsgInsertBTCSpvProof implements all functions of the Msg interface
 	_ sdk.Msg = (*MsgInsertBTCSpvProof)(nil)
+	_ sdk.Msg = (*MsgReportConflictingCheckpoint)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 )
 
-// ParseTwoProofs Parse and Validate transactions which should contain OP_RETURN data.
-// OP_RETURN bytes are not validated in any way. It is up to the caller attach
+// ParseTwoProofs Parse and Validate transactions which should contain OP_RETURN data,
+// or Taproot witness envelope data. These bytes are not validated in any way. It is up to the caller attach
 // semantic meaning and validity to those bytes.
 // Returned ParsedProofs are in same order as raw proofs
 func ParseTwoProofs(
@@ -52,11 +53,10 @@ func ParseTwoProofs(
 	var checkpointData [][]byte
 
 	for i, proof := range parsedProofs {
-		data, err := txformat.GetCheckpointData(
+		data, err := proof.GetCheckpointData(
 			expectedTag,
 			txformat.CurrentVersion,
 			uint8(i),
-			proof.OpReturnData,
 		)
 
 		if err != nil {
@@ -107,6 +107,19 @@ func ParseSubmission(
 	return sub, nil
 }
 
+// ParseConflictingSubmission parses the checkpoint submission carried by
+// MsgReportConflictingCheckpoint in the same way as a regular submission
+func ParseConflictingSubmission(
+	m *MsgReportConflictingCheckpoint,
+	powLimit *big.Int,
+	expectedTag txformat.BabylonTag) (*RawCheckpointSubmission, error) {
+	if m == nil {
+		return nil, errors.New("msgReportConflictingCheckpoint can't nil")
+	}
+
+	return ParseSubmission(&MsgInsertBTCSpvProof{Submitter: m.Submitter, Proofs: m.Proofs}, powLimit, expectedTag)
+}
+
 // ValidateBasic does a sanity check on the provided data.
 func (m *MsgUpdateParams) ValidateBasic() error {
 	if err := m.Params.Validate(); err != nil {
@@ -315,6 +315,8 @@ func (m *QueryBtcCheckpointsInfoResponse) GetPagination() *query.PageResponse {
 type QueryEpochSubmissionsRequest struct {
 	// Number of epoch for which submissions are requested
 	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// pagination defines whether to have the pagination in the request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryEpochSubmissionsRequest) Reset()         { *m = QueryEpochSubmissionsRequest{} }
@@ -357,11 +359,23 @@ func (m *QueryEpochSubmissionsRequest) GetEpochNum() uint64 {
 	return 0
 }
 
+func (m *QueryEpochSubmissionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryEpochSubmissionsResponse defines a response to get all submissions in
 // given epoch (QueryEpochSubmissionsRequest)
 type QueryEpochSubmissionsResponse struct {
 	// Keys All submissions transactions key saved during an epoch.
 	Keys []*SubmissionKeyResponse `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	// submissions contains the BTC positions and depths of the submissions, in
+	// the same order as keys
+	Submissions []*SubmissionInfoResponse `protobuf:"bytes,2,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryEpochSubmissionsResponse) Reset()         { *m = QueryEpochSubmissionsResponse{} }
@@ -404,6 +418,261 @@ func (m *QueryEpochSubmissionsResponse) GetKeys() []*SubmissionKeyResponse {
 	return nil
 }
 
+func (m *QueryEpochSubmissionsResponse) GetSubmissions() []*SubmissionInfoResponse {
+	if m != nil {
+		return m.Submissions
+	}
+	return nil
+}
+
+func (m *QueryEpochSubmissionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// SubmissionInfoResponse contains the current BTC position and depth of a
+// submission of a checkpoint, along with the addresses of its submitter and
+// reporter
+type SubmissionInfoResponse struct {
+	// key is the key of the submission
+	Key *SubmissionKeyResponse `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// on_main_chain is whether both BTC blocks of the submission are on the
+	// BTC main chain. If not, the BTC position and depth are not set.
+	OnMainChain bool `protobuf:"varint,2,opt,name=on_main_chain,json=onMainChain,proto3" json:"on_main_chain,omitempty"`
+	// btc_block_height is the height of the youngest BTC block of the
+	// submission
+	BtcBlockHeight uint64 `protobuf:"varint,3,opt,name=btc_block_height,json=btcBlockHeight,proto3" json:"btc_block_height,omitempty"`
+	// btc_block_hash is the hash of the youngest BTC block of the submission as
+	// hex
+	BtcBlockHash string `protobuf:"bytes,4,opt,name=btc_block_hash,json=btcBlockHash,proto3" json:"btc_block_hash,omitempty"`
+	// btc_depth is the depth of the youngest BTC block of the submission
+	BtcDepth uint64 `protobuf:"varint,5,opt,name=btc_depth,json=btcDepth,proto3" json:"btc_depth,omitempty"`
+	// vigilante_addresses are the addresses of the submitter and reporter of
+	// the submission
+	VigilanteAddresses *CheckpointAddressesResponse `protobuf:"bytes,6,opt,name=vigilante_addresses,json=vigilanteAddresses,proto3" json:"vigilante_addresses,omitempty"`
+}
+
+func (m *SubmissionInfoResponse) Reset()         { *m = SubmissionInfoResponse{} }
+func (m *SubmissionInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*SubmissionInfoResponse) ProtoMessage()    {}
+func (*SubmissionInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9a2f46ada7d854, []int{8}
+}
+func (m *SubmissionInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmissionInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmissionInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubmissionInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmissionInfoResponse.Merge(m, src)
+}
+func (m *SubmissionInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmissionInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmissionInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmissionInfoResponse proto.InternalMessageInfo
+
+func (m *SubmissionInfoResponse) GetKey() *SubmissionKeyResponse {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *SubmissionInfoResponse) GetOnMainChain() bool {
+	if m != nil {
+		return m.OnMainChain
+	}
+	return false
+}
+
+func (m *SubmissionInfoResponse) GetBtcBlockHeight() uint64 {
+	if m != nil {
+		return m.BtcBlockHeight
+	}
+	return 0
+}
+
+func (m *SubmissionInfoResponse) GetBtcBlockHash() string {
+	if m != nil {
+		return m.BtcBlockHash
+	}
+	return ""
+}
+
+func (m *SubmissionInfoResponse) GetBtcDepth() uint64 {
+	if m != nil {
+		return m.BtcDepth
+	}
+	return 0
+}
+
+func (m *SubmissionInfoResponse) GetVigilanteAddresses() *CheckpointAddressesResponse {
+	if m != nil {
+		return m.VigilanteAddresses
+	}
+	return nil
+}
+
+// QueryBestSubmissionRequest defines a request to get the best submission of
+// a given epoch
+type QueryBestSubmissionRequest struct {
+	// Number of epoch for which the best submission is requested
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+}
+
+func (m *QueryBestSubmissionRequest) Reset()         { *m = QueryBestSubmissionRequest{} }
+func (m *QueryBestSubmissionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBestSubmissionRequest) ProtoMessage()    {}
+func (*QueryBestSubmissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9a2f46ada7d854, []int{9}
+}
+func (m *QueryBestSubmissionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBestSubmissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBestSubmissionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBestSubmissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBestSubmissionRequest.Merge(m, src)
+}
+func (m *QueryBestSubmissionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBestSubmissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBestSubmissionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBestSubmissionRequest proto.InternalMessageInfo
+
+func (m *QueryBestSubmissionRequest) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+// QueryBestSubmissionResponse defines a response to get the best submission of
+// a given epoch (QueryBestSubmissionRequest). Submissions are ordered by the
+// depth of their youngest BTC block, with ties broken by the lowest tx index
+// in that block.
+type QueryBestSubmissionResponse struct {
+	// epoch_num is the number of the epoch
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// status is the BTC status of the epoch
+	Status BtcStatus `protobuf:"varint,2,opt,name=status,proto3,enum=babylon.btccheckpoint.v1.BtcStatus" json:"status,omitempty"`
+	// key is the key of the best submission
+	Key *SubmissionKeyResponse `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// btc_block_height is the height of the youngest BTC block of the best
+	// submission
+	BtcBlockHeight uint64 `protobuf:"varint,4,opt,name=btc_block_height,json=btcBlockHeight,proto3" json:"btc_block_height,omitempty"`
+	// btc_block_hash is the hash of the youngest BTC block of the best
+	// submission as hex
+	BtcBlockHash string `protobuf:"bytes,5,opt,name=btc_block_hash,json=btcBlockHash,proto3" json:"btc_block_hash,omitempty"`
+	// num_submissions is the number of submissions of the epoch currently
+	// tracked
+	NumSubmissions uint64 `protobuf:"varint,6,opt,name=num_submissions,json=numSubmissions,proto3" json:"num_submissions,omitempty"`
+}
+
+func (m *QueryBestSubmissionResponse) Reset()         { *m = QueryBestSubmissionResponse{} }
+func (m *QueryBestSubmissionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBestSubmissionResponse) ProtoMessage()    {}
+func (*QueryBestSubmissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9a2f46ada7d854, []int{10}
+}
+func (m *QueryBestSubmissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBestSubmissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBestSubmissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBestSubmissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBestSubmissionResponse.Merge(m, src)
+}
+func (m *QueryBestSubmissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBestSubmissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBestSubmissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBestSubmissionResponse proto.InternalMessageInfo
+
+func (m *QueryBestSubmissionResponse) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *QueryBestSubmissionResponse) GetStatus() BtcStatus {
+	if m != nil {
+		return m.Status
+	}
+	return Submitted
+}
+
+func (m *QueryBestSubmissionResponse) GetKey() *SubmissionKeyResponse {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *QueryBestSubmissionResponse) GetBtcBlockHeight() uint64 {
+	if m != nil {
+		return m.BtcBlockHeight
+	}
+	return 0
+}
+
+func (m *QueryBestSubmissionResponse) GetBtcBlockHash() string {
+	if m != nil {
+		return m.BtcBlockHash
+	}
+	return ""
+}
+
+func (m *QueryBestSubmissionResponse) GetNumSubmissions() uint64 {
+	if m != nil {
+		return m.NumSubmissions
+	}
+	return 0
+}
+
 // BTCCheckpointInfoResponse contains all data about best submission of checkpoint for
 // given epoch. Best submission is the submission which is deeper in btc ledger.
 type BTCCheckpointInfoResponse struct {
@@ -424,7 +693,7 @@ func (m *BTCCheckpointInfoResponse) Reset()         { *m = BTCCheckpointInfoResp
 func (m *BTCCheckpointInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*BTCCheckpointInfoResponse) ProtoMessage()    {}
 func (*BTCCheckpointInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b9a2f46ada7d854, []int{8}
+	return fileDescriptor_6b9a2f46ada7d854, []int{11}
 }
 func (m *BTCCheckpointInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -508,7 +777,7 @@ func (m *TransactionInfoResponse) Reset()         { *m = TransactionInfoResponse
 func (m *TransactionInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*TransactionInfoResponse) ProtoMessage()    {}
 func (*TransactionInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b9a2f46ada7d854, []int{9}
+	return fileDescriptor_6b9a2f46ada7d854, []int{12}
 }
 func (m *TransactionInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,7 +849,7 @@ func (m *CheckpointAddressesResponse) Reset()         { *m = CheckpointAddresses
 func (m *CheckpointAddressesResponse) String() string { return proto.CompactTextString(m) }
 func (*CheckpointAddressesResponse) ProtoMessage()    {}
 func (*CheckpointAddressesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b9a2f46ada7d854, []int{10}
+	return fileDescriptor_6b9a2f46ada7d854, []int{13}
 }
 func (m *CheckpointAddressesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -643,7 +912,7 @@ func (m *SubmissionKeyResponse) Reset()         { *m = SubmissionKeyResponse{} }
 func (m *SubmissionKeyResponse) String() string { return proto.CompactTextString(m) }
 func (*SubmissionKeyResponse) ProtoMessage()    {}
 func (*SubmissionKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b9a2f46ada7d854, []int{11}
+	return fileDescriptor_6b9a2f46ada7d854, []int{14}
 }
 func (m *SubmissionKeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -709,6 +978,9 @@ func init() {
 	proto.RegisterType((*QueryBtcCheckpointsInfoResponse)(nil), "babylon.btccheckpoint.v1.QueryBtcCheckpointsInfoResponse")
 	proto.RegisterType((*QueryEpochSubmissionsRequest)(nil), "babylon.btccheckpoint.v1.QueryEpochSubmissionsRequest")
 	proto.RegisterType((*QueryEpochSubmissionsResponse)(nil), "babylon.btccheckpoint.v1.QueryEpochSubmissionsResponse")
+	proto.RegisterType((*SubmissionInfoResponse)(nil), "babylon.btccheckpoint.v1.SubmissionInfoResponse")
+	proto.RegisterType((*QueryBestSubmissionRequest)(nil), "babylon.btccheckpoint.v1.QueryBestSubmissionRequest")
+	proto.RegisterType((*QueryBestSubmissionResponse)(nil), "babylon.btccheckpoint.v1.QueryBestSubmissionResponse")
 	proto.RegisterType((*BTCCheckpointInfoResponse)(nil), "babylon.btccheckpoint.v1.BTCCheckpointInfoResponse")
 	proto.RegisterType((*TransactionInfoResponse)(nil), "babylon.btccheckpoint.v1.TransactionInfoResponse")
 	proto.RegisterType((*CheckpointAddressesResponse)(nil), "babylon.btccheckpoint.v1.CheckpointAddressesResponse")
@@ -720,67 +992,82 @@ func init() {
 }
 
 var fileDescriptor_6b9a2f46ada7d854 = []byte{
-	// 949 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x41, 0x6f, 0xdc, 0x44,
-	0x14, 0x8e, 0x37, 0x4e, 0x94, 0x7d, 0x69, 0xa1, 0x9d, 0x2e, 0x62, 0xb3, 0x09, 0xdb, 0xad, 0xd5,
-	0xa6, 0x11, 0x22, 0xb6, 0xb6, 0xa1, 0x2d, 0x15, 0x08, 0x89, 0x8d, 0x68, 0xa9, 0x40, 0x10, 0xdc,
-	0xc0, 0x81, 0x8b, 0x35, 0xf6, 0x4e, 0xec, 0x51, 0x76, 0x3d, 0xae, 0x67, 0x36, 0xca, 0xaa, 0xe2,
-	0xc2, 0x0d, 0x71, 0x00, 0x89, 0xbf, 0xc1, 0x11, 0x6e, 0x08, 0x89, 0x03, 0x52, 0x25, 0x2e, 0x15,
-	0x5c, 0x38, 0x21, 0x94, 0xf0, 0x43, 0x2a, 0xcf, 0xcc, 0xae, 0xbd, 0x9b, 0xb8, 0x9b, 0xf4, 0xb6,
-	0xf6, 0x7c, 0xdf, 0xf7, 0xbe, 0xf9, 0xde, 0x8b, 0x5f, 0xe0, 0xba, 0x8f, 0xfd, 0x61, 0x8f, 0xc5,
-	0x8e, 0x2f, 0x82, 0x20, 0x22, 0xc1, 0x7e, 0xc2, 0x68, 0x2c, 0x9c, 0x83, 0xb6, 0xf3, 0x78, 0x40,
-	0xd2, 0xa1, 0x9d, 0xa4, 0x4c, 0x30, 0x54, 0xd7, 0x28, 0x7b, 0x02, 0x65, 0x1f, 0xb4, 0x1b, 0xb5,
-	0x90, 0x85, 0x4c, 0x82, 0x9c, 0xec, 0x97, 0xc2, 0x37, 0x56, 0x02, 0xc6, 0xfb, 0x8c, 0x7b, 0xea,
-	0x40, 0x3d, 0xe8, 0xa3, 0xb5, 0x90, 0xb1, 0xb0, 0x47, 0x1c, 0x9c, 0x50, 0x07, 0xc7, 0x31, 0x13,
-	0x58, 0x50, 0x16, 0x8f, 0x4e, 0xdf, 0x54, 0x58, 0xc7, 0xc7, 0x9c, 0x28, 0x07, 0xce, 0x41, 0xdb,
-	0x27, 0x02, 0xb7, 0x9d, 0x04, 0x87, 0x34, 0x96, 0x60, 0x8d, 0xbd, 0x51, 0x6a, 0x3d, 0xc1, 0x29,
-	0xee, 0x6b, 0x49, 0xab, 0x06, 0xe8, 0xf3, 0x4c, 0x68, 0x47, 0xbe, 0x74, 0xc9, 0xe3, 0x01, 0xe1,
-	0xc2, 0xfa, 0x02, 0xae, 0x4c, 0xbc, 0xe5, 0x09, 0x8b, 0x39, 0x41, 0xef, 0xc3, 0xa2, 0x22, 0xd7,
-	0x8d, 0x96, 0xb1, 0xb1, 0x7c, 0xab, 0x65, 0x97, 0xdd, 0xdc, 0x56, 0xcc, 0x8e, 0xf9, 0xf4, 0xdf,
-	0xab, 0x73, 0xae, 0x66, 0x59, 0xef, 0xc1, 0x1b, 0x52, 0xb6, 0x23, 0x82, 0xed, 0x31, 0xfa, 0x61,
-	0xbc, 0xc7, 0x74, 0x5d, 0xb4, 0x0a, 0x55, 0x92, 0xb0, 0x20, 0xf2, 0xe2, 0x41, 0x5f, 0xd6, 0x30,
-	0xdd, 0x25, 0xf9, 0xe2, 0xd3, 0x41, 0xdf, 0xa2, 0xd0, 0x2c, 0x63, 0x6b, 0x7f, 0x0f, 0xc0, 0xa4,
-	0xf1, 0x1e, 0xd3, 0xee, 0xb6, 0xca, 0xdd, 0x75, 0x76, 0xb7, 0x4f, 0x97, 0x70, 0xa5, 0x80, 0x15,
-	0x9d, 0x56, 0x8a, 0x17, 0x9d, 0xde, 0x07, 0xc8, 0x23, 0xd7, 0x05, 0xd7, 0x6d, 0xdd, 0xcb, 0xac,
-	0x3f, 0xb6, 0x9a, 0x10, 0xdd, 0x1f, 0x7b, 0x07, 0x87, 0x44, 0x73, 0xdd, 0x02, 0xd3, 0xfa, 0xd5,
-	0x80, 0xab, 0xa5, 0xa5, 0xf4, 0xb5, 0x76, 0xa0, 0x9a, 0xb9, 0xf2, 0x7a, 0x94, 0x8b, 0xba, 0xd1,
-	0x9a, 0x7f, 0xd9, 0xbb, 0x2d, 0x65, 0x2a, 0x9f, 0x50, 0x2e, 0xd0, 0x83, 0x09, 0xf7, 0x15, 0xe9,
-	0xfe, 0xe6, 0x4c, 0xf7, 0x5a, 0xa6, 0x68, 0xff, 0x5d, 0x58, 0x93, 0xee, 0x3f, 0xcc, 0x9a, 0xf4,
-	0x68, 0xe0, 0xf7, 0x29, 0xe7, 0xd9, 0xc0, 0x9e, 0xa9, 0xa1, 0x5d, 0x3d, 0x0e, 0x27, 0xc9, 0xfa,
-	0xe2, 0xdb, 0x60, 0xee, 0x93, 0x21, 0xd7, 0x77, 0x76, 0xca, 0xef, 0x9c, 0x93, 0x3f, 0x26, 0xc3,
-	0xbc, 0x97, 0x19, 0xd9, 0xfa, 0x73, 0x1e, 0x56, 0x4a, 0x33, 0x41, 0xd7, 0xe0, 0xc2, 0xd8, 0xa0,
-	0x4f, 0x52, 0xed, 0x71, 0x79, 0xe4, 0xd1, 0x27, 0x29, 0xba, 0x0f, 0x2d, 0x9f, 0x70, 0xe1, 0xf1,
-	0x71, 0x11, 0xcf, 0x17, 0x81, 0xe7, 0xf7, 0x58, 0xb0, 0xef, 0x45, 0x84, 0x86, 0x91, 0x90, 0x11,
-	0x9a, 0xee, 0x5a, 0x86, 0xcb, 0xbd, 0x74, 0x44, 0xd0, 0xc9, 0x40, 0x1f, 0x49, 0x0c, 0xea, 0x40,
-	0xf3, 0x05, 0x3a, 0x98, 0x47, 0xf5, 0xf9, 0x96, 0xb1, 0x51, 0x75, 0x1b, 0x25, 0x2a, 0x98, 0x47,
-	0x88, 0xc3, 0xda, 0xb4, 0x86, 0x48, 0x71, 0xcc, 0x71, 0x20, 0xbf, 0x13, 0x75, 0x53, 0x26, 0xd5,
-	0x2e, 0x4f, 0x6a, 0x37, 0x47, 0x4f, 0xcc, 0xc6, 0x54, 0xd1, 0x02, 0x8c, 0xa3, 0x6f, 0x0d, 0x58,
-	0x9f, 0xae, 0x7a, 0x40, 0x43, 0xda, 0xc3, 0xb1, 0x20, 0x1e, 0xee, 0x76, 0x53, 0xc2, 0xb9, 0x9a,
-	0xce, 0x05, 0x59, 0xff, 0x76, 0x79, 0xfd, 0xbc, 0x0d, 0x1f, 0x28, 0x1e, 0x19, 0xb7, 0xdb, 0xb5,
-	0x26, 0x3d, 0x7c, 0x39, 0x2a, 0xa1, 0x91, 0xd9, 0xe4, 0x5a, 0x4f, 0xe0, 0xf5, 0x92, 0x2b, 0xa0,
-	0x1a, 0x2c, 0xd0, 0xb8, 0x4b, 0x0e, 0x65, 0x0f, 0x2f, 0xba, 0xea, 0x01, 0x21, 0x30, 0x65, 0xb6,
-	0x15, 0x99, 0xad, 0xfc, 0x8d, 0x5a, 0xb0, 0x5c, 0x48, 0x4d, 0xc7, 0x5e, 0x7c, 0x95, 0x69, 0x25,
-	0x29, 0x63, 0x7b, 0x75, 0x53, 0x9e, 0xa9, 0x07, 0xeb, 0x3b, 0x03, 0x56, 0x5f, 0x70, 0x01, 0x74,
-	0x07, 0xaa, 0x32, 0x22, 0x21, 0xf4, 0x24, 0x55, 0x3b, 0xf5, 0xbf, 0x7e, 0xde, 0xac, 0xe9, 0x3f,
-	0x2c, 0x4d, 0x78, 0x24, 0x52, 0x1a, 0x87, 0x6e, 0x0e, 0x45, 0x6f, 0xc3, 0x52, 0x4a, 0x12, 0x96,
-	0x66, 0xb4, 0xca, 0x0c, 0xda, 0x18, 0x69, 0xfd, 0x61, 0xc0, 0x6b, 0xa7, 0x0e, 0x3e, 0xda, 0x84,
-	0x2b, 0x7b, 0x34, 0xe5, 0xc2, 0x13, 0x87, 0xc5, 0xf1, 0x92, 0x8e, 0xdc, 0x4b, 0xf2, 0x68, 0xf7,
-	0x30, 0x1f, 0xaa, 0xeb, 0xf0, 0xca, 0x18, 0xae, 0x12, 0xac, 0xc8, 0x04, 0x2f, 0x68, 0xe4, 0x43,
-	0x19, 0xa4, 0x03, 0x35, 0x4e, 0x02, 0x16, 0x77, 0xa7, 0x54, 0x55, 0x7a, 0x97, 0xd5, 0x59, 0x51,
-	0x76, 0x1d, 0x5e, 0xcd, 0x09, 0x4a, 0xd7, 0x94, 0xba, 0x17, 0x47, 0x58, 0x29, 0x7c, 0xeb, 0xf7,
-	0x05, 0x58, 0x90, 0xdf, 0x01, 0xf4, 0xbd, 0x01, 0x8b, 0x6a, 0x71, 0xa0, 0xb7, 0xca, 0x47, 0xe8,
-	0xe4, 0xbe, 0x6a, 0x6c, 0x9e, 0x11, 0xad, 0xf2, 0xb1, 0x36, 0xbe, 0xf9, 0xfb, 0xff, 0x1f, 0x2b,
-	0x16, 0x6a, 0x39, 0x33, 0x96, 0x24, 0xfa, 0xc5, 0x80, 0xcb, 0x27, 0xf6, 0x0d, 0xba, 0x3b, 0xa3,
-	0x5c, 0xd9, 0x7e, 0x6b, 0xbc, 0x73, 0x7e, 0xa2, 0xb6, 0xbc, 0x29, 0x2d, 0xdf, 0x44, 0x37, 0xca,
-	0x2d, 0x3f, 0x19, 0x7f, 0xc8, 0xbe, 0x46, 0x3f, 0x19, 0x80, 0x4e, 0x6e, 0x14, 0x74, 0xae, 0xfa,
-	0xc5, 0x7d, 0xd7, 0xb8, 0xf7, 0x12, 0x4c, 0x6d, 0xfd, 0x9a, 0xb4, 0xbe, 0x8a, 0x56, 0x4a, 0xad,
-	0xa3, 0xdf, 0x0c, 0xb8, 0x34, 0xbd, 0x05, 0xd0, 0x9d, 0x19, 0x25, 0x4b, 0x76, 0x4e, 0xe3, 0xee,
-	0xb9, 0x79, 0xda, 0xe8, 0x3d, 0x69, 0x74, 0x0b, 0xb5, 0xcf, 0x94, 0xb1, 0x93, 0x7f, 0x0d, 0x79,
-	0xe7, 0xb3, 0xa7, 0x47, 0x4d, 0xe3, 0xd9, 0x51, 0xd3, 0xf8, 0xef, 0xa8, 0x69, 0xfc, 0x70, 0xdc,
-	0x9c, 0x7b, 0x76, 0xdc, 0x9c, 0xfb, 0xe7, 0xb8, 0x39, 0xf7, 0xd5, 0xed, 0x90, 0x8a, 0x68, 0xe0,
-	0xdb, 0x01, 0xeb, 0x8f, 0x64, 0x83, 0x08, 0xd3, 0x78, 0x5c, 0xe3, 0x70, 0xaa, 0x8a, 0x18, 0x26,
-	0x84, 0xfb, 0x8b, 0xf2, 0xdf, 0xb3, 0xad, 0xe7, 0x01, 0x00, 0x00, 0xff, 0xff, 0x07, 0x9f, 0x31,
-	0x97, 0x82, 0x0a, 0x00, 0x00,
+	// 1198 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xda, 0x8e, 0x49, 0x5e, 0x3e, 0x9a, 0x4e, 0x02, 0x38, 0x4e, 0xea, 0xba, 0x4b, 0x9a,
+	0x44, 0xa8, 0xf1, 0xe2, 0xa4, 0x69, 0x88, 0xf8, 0x90, 0xea, 0x40, 0x4a, 0xc5, 0x57, 0xd8, 0x04,
+	0x0e, 0x5c, 0x56, 0xbb, 0x9b, 0x89, 0xbd, 0x4a, 0x3c, 0xb3, 0xdd, 0x19, 0x47, 0xb1, 0x2a, 0x24,
+	0x3e, 0x4e, 0x88, 0x03, 0x48, 0xfc, 0x1b, 0xdc, 0x80, 0x5b, 0xc5, 0x0d, 0xa9, 0x12, 0x97, 0x0a,
+	0x38, 0x70, 0x42, 0x28, 0xe1, 0x0f, 0x41, 0x3b, 0x33, 0xb6, 0x77, 0x1d, 0x6f, 0xec, 0x84, 0x5b,
+	0x76, 0xe6, 0xf7, 0x7e, 0xef, 0xf7, 0x7e, 0x6f, 0x66, 0xfc, 0x02, 0x0b, 0x8e, 0xed, 0x34, 0x8f,
+	0x28, 0x31, 0x1c, 0xee, 0xba, 0x35, 0xec, 0x1e, 0xfa, 0xd4, 0x23, 0xdc, 0x38, 0x2e, 0x1b, 0x8f,
+	0x1a, 0x38, 0x68, 0x96, 0xfc, 0x80, 0x72, 0x8a, 0x72, 0x0a, 0x55, 0x8a, 0xa1, 0x4a, 0xc7, 0xe5,
+	0xfc, 0x4c, 0x95, 0x56, 0xa9, 0x00, 0x19, 0xe1, 0x5f, 0x12, 0x9f, 0x9f, 0x75, 0x29, 0xab, 0x53,
+	0x66, 0xc9, 0x0d, 0xf9, 0xa1, 0xb6, 0xe6, 0xab, 0x94, 0x56, 0x8f, 0xb0, 0x61, 0xfb, 0x9e, 0x61,
+	0x13, 0x42, 0xb9, 0xcd, 0x3d, 0x4a, 0x5a, 0xbb, 0x2f, 0x4b, 0xac, 0xe1, 0xd8, 0x0c, 0x4b, 0x05,
+	0xc6, 0x71, 0xd9, 0xc1, 0xdc, 0x2e, 0x1b, 0xbe, 0x5d, 0xf5, 0x88, 0x00, 0x2b, 0xec, 0xed, 0x44,
+	0xe9, 0xbe, 0x1d, 0xd8, 0xf5, 0x16, 0xe5, 0x9d, 0x44, 0x58, 0xbc, 0x18, 0x81, 0xd6, 0x67, 0x00,
+	0x7d, 0x14, 0xa6, 0xdd, 0x11, 0x14, 0x26, 0x7e, 0xd4, 0xc0, 0x8c, 0xeb, 0x1f, 0xc3, 0x74, 0x6c,
+	0x95, 0xf9, 0x94, 0x30, 0x8c, 0xde, 0x84, 0xac, 0x4c, 0x95, 0xd3, 0x8a, 0xda, 0xf2, 0xd8, 0x6a,
+	0xb1, 0x94, 0xe4, 0x53, 0x49, 0x46, 0x56, 0x32, 0x4f, 0xff, 0xbe, 0x39, 0x64, 0xaa, 0x28, 0xfd,
+	0x75, 0xb8, 0x21, 0x68, 0x2b, 0xdc, 0xdd, 0x6a, 0xa3, 0x1f, 0x92, 0x03, 0xaa, 0xf2, 0xa2, 0x39,
+	0x18, 0xc5, 0x3e, 0x75, 0x6b, 0x16, 0x69, 0xd4, 0x45, 0x8e, 0x8c, 0x39, 0x22, 0x16, 0x3e, 0x68,
+	0xd4, 0x75, 0x0f, 0x0a, 0x49, 0xd1, 0x4a, 0xdf, 0x03, 0xc8, 0x78, 0xe4, 0x80, 0x2a, 0x75, 0x6b,
+	0xc9, 0xea, 0x2a, 0x7b, 0x5b, 0xbd, 0x29, 0x4c, 0x41, 0xa0, 0xd7, 0x7a, 0xa5, 0x62, 0x51, 0xa5,
+	0xdb, 0x00, 0x9d, 0x06, 0xa9, 0x84, 0x8b, 0x25, 0xd5, 0xf9, 0xb0, 0x9b, 0x25, 0x79, 0x9e, 0x54,
+	0x37, 0x4b, 0x3b, 0x76, 0x15, 0xab, 0x58, 0x33, 0x12, 0xa9, 0x3f, 0xd1, 0xe0, 0x66, 0x62, 0x2a,
+	0x55, 0xd6, 0x0e, 0x8c, 0x86, 0xaa, 0xac, 0x23, 0x8f, 0xf1, 0x9c, 0x56, 0x4c, 0x5f, 0xb5, 0xb6,
+	0x91, 0x90, 0xe5, 0x3d, 0x8f, 0x71, 0xf4, 0x20, 0xa6, 0x3e, 0x25, 0xd4, 0x2f, 0xf5, 0x55, 0xaf,
+	0x68, 0xa2, 0xf2, 0xbf, 0xd2, 0x60, 0x5e, 0xc8, 0x7f, 0x3b, 0xec, 0xd2, 0x6e, 0xc3, 0xa9, 0x7b,
+	0x8c, 0x85, 0xe7, 0x7b, 0x90, 0x8e, 0xa2, 0xed, 0x1e, 0x32, 0xae, 0x62, 0xe2, 0x17, 0x29, 0xb8,
+	0x91, 0xa0, 0x42, 0x59, 0xb8, 0x05, 0x99, 0x43, 0xdc, 0x64, 0xca, 0x3d, 0x23, 0xd9, 0xbd, 0x4e,
+	0xf0, 0xbb, 0xb8, 0xd9, 0x39, 0x15, 0x61, 0x30, 0x32, 0x61, 0x8c, 0x75, 0xb8, 0x73, 0x29, 0xc1,
+	0xf5, 0xca, 0x20, 0x5c, 0xb1, 0x36, 0x44, 0x49, 0xba, 0x3a, 0x91, 0xbe, 0x7a, 0x27, 0xfe, 0x4c,
+	0xc1, 0x0b, 0xbd, 0x13, 0xa2, 0xfb, 0x90, 0x3e, 0xc4, 0x4d, 0x75, 0x48, 0x2f, 0x5d, 0x7b, 0x18,
+	0x8b, 0x74, 0x98, 0xa0, 0xc4, 0xaa, 0xdb, 0x1e, 0xb1, 0xdc, 0x9a, 0xed, 0xc9, 0x66, 0x8d, 0x98,
+	0x63, 0x94, 0xbc, 0x6f, 0x7b, 0x64, 0x2b, 0x5c, 0x42, 0xcb, 0x30, 0xe5, 0x70, 0xd7, 0x72, 0x8e,
+	0xa8, 0x7b, 0x68, 0xd5, 0xb0, 0x57, 0xad, 0x71, 0x51, 0x50, 0xc6, 0x9c, 0x74, 0xb8, 0x5b, 0x09,
+	0x97, 0xdf, 0x11, 0xab, 0x68, 0x01, 0x26, 0x23, 0x48, 0x9b, 0xd5, 0x72, 0x99, 0xa2, 0xb6, 0x3c,
+	0x6a, 0x8e, 0xb7, 0x71, 0x36, 0xab, 0x85, 0x47, 0x27, 0x44, 0xed, 0x63, 0x9f, 0xd7, 0x72, 0xc3,
+	0xf2, 0xe8, 0x38, 0xdc, 0x7d, 0x2b, 0xfc, 0x46, 0x07, 0x30, 0x7d, 0xec, 0x55, 0xbd, 0x23, 0x9b,
+	0x70, 0x6c, 0xd9, 0xfb, 0xfb, 0x01, 0x66, 0x0c, 0xb3, 0x5c, 0x56, 0xd4, 0xb8, 0x9e, 0x5c, 0x63,
+	0xe7, 0x6a, 0xdc, 0x6f, 0x05, 0xb5, 0x2b, 0x45, 0x6d, 0xc6, 0xf6, 0x9e, 0xbe, 0x09, 0x79, 0x79,
+	0x3d, 0x31, 0xe3, 0x1d, 0x7f, 0x06, 0x7a, 0xaf, 0x7e, 0x4c, 0xc1, 0x5c, 0xcf, 0x58, 0xd5, 0x96,
+	0x0b, 0xaf, 0xc6, 0x6b, 0x90, 0x65, 0xdc, 0xe6, 0x0d, 0x26, 0x9c, 0x9e, 0x5c, 0x7d, 0xe9, 0x82,
+	0x0b, 0xcf, 0xdd, 0x5d, 0x01, 0x35, 0x55, 0x48, 0xab, 0xe1, 0xe9, 0xff, 0xd1, 0xf0, 0x5e, 0xcd,
+	0xcc, 0x0c, 0xd8, 0xcc, 0xe1, 0x1e, 0xcd, 0x5c, 0x82, 0x6b, 0xa4, 0x51, 0xb7, 0xa2, 0xf7, 0x27,
+	0x2b, 0xe9, 0x48, 0xa3, 0x1e, 0xb9, 0xb1, 0xfa, 0x6f, 0x69, 0x98, 0x4d, 0x7c, 0xc2, 0xd0, 0x2d,
+	0x18, 0x6f, 0x7b, 0xe6, 0xe0, 0x40, 0xd9, 0x36, 0xd6, 0xb2, 0xcd, 0xc1, 0x01, 0xda, 0x86, 0xa2,
+	0x83, 0x19, 0x8f, 0xa4, 0xb2, 0xce, 0x55, 0x92, 0x12, 0x61, 0xf3, 0x4e, 0xac, 0x31, 0x95, 0x78,
+	0x5d, 0x15, 0x28, 0x5c, 0xc0, 0x13, 0xd6, 0x99, 0x16, 0x75, 0xe6, 0x13, 0x58, 0xc2, 0xaa, 0x19,
+	0xcc, 0x77, 0x73, 0xf0, 0xc0, 0x26, 0xcc, 0x76, 0xc5, 0x10, 0x90, 0xcb, 0x88, 0x27, 0xa4, 0x9c,
+	0xdc, 0xa1, 0xbd, 0x0e, 0x3a, 0xf6, 0x86, 0x74, 0x25, 0x8d, 0xc0, 0x18, 0xfa, 0x5a, 0x83, 0xc5,
+	0xee, 0xac, 0xe7, 0xee, 0x8a, 0xfc, 0x31, 0x19, 0x2e, 0xa6, 0xaf, 0x7e, 0x5d, 0xf4, 0xb8, 0x86,
+	0x4f, 0xba, 0x2e, 0x4f, 0xf8, 0x43, 0xa3, 0x3f, 0x86, 0x17, 0x13, 0x4a, 0x40, 0x33, 0x30, 0xec,
+	0x91, 0x7d, 0x7c, 0x22, 0x7a, 0x38, 0x61, 0xca, 0x0f, 0x84, 0x20, 0x23, 0xbc, 0x4d, 0x09, 0x6f,
+	0xc5, 0xdf, 0xa8, 0x08, 0x63, 0x11, 0xd7, 0x94, 0xed, 0xd1, 0xa5, 0x90, 0xcb, 0x0f, 0x28, 0x3d,
+	0x50, 0xef, 0x88, 0xfc, 0xd0, 0xbf, 0xd1, 0x60, 0xee, 0x82, 0x02, 0xd0, 0x3d, 0x18, 0x15, 0x16,
+	0x71, 0xae, 0x4e, 0xd2, 0x68, 0x25, 0xf7, 0xfb, 0x4f, 0x2b, 0x33, 0xea, 0xf5, 0x55, 0x01, 0xbb,
+	0x3c, 0xf0, 0x48, 0xd5, 0xec, 0x40, 0xd1, 0x5d, 0x18, 0x09, 0xb0, 0x4f, 0x83, 0x30, 0x2c, 0xd5,
+	0x27, 0xac, 0x8d, 0xd4, 0x7f, 0xd5, 0xe0, 0xf9, 0x9e, 0x17, 0x0e, 0xad, 0xc0, 0xf4, 0x81, 0x17,
+	0x30, 0x6e, 0xf1, 0x93, 0xe8, 0xf1, 0x12, 0x8a, 0xcc, 0x29, 0xb1, 0xb5, 0x77, 0xd2, 0x39, 0x54,
+	0x0b, 0x30, 0xd9, 0x86, 0x4b, 0x07, 0x53, 0xc2, 0xc1, 0x71, 0x85, 0x7c, 0x28, 0x8c, 0x34, 0x60,
+	0x86, 0x61, 0x97, 0x92, 0xfd, 0x2e, 0x56, 0xe9, 0xde, 0x75, 0xb9, 0x17, 0xa5, 0x5d, 0x84, 0x6b,
+	0x9d, 0x00, 0xc9, 0x9b, 0x11, 0xbc, 0x13, 0x2d, 0xac, 0x20, 0x5e, 0xfd, 0xfc, 0x39, 0x18, 0x16,
+	0xcf, 0x1a, 0xfa, 0x56, 0x83, 0xac, 0x9c, 0xf3, 0xd0, 0x9d, 0xe4, 0x23, 0x74, 0x7e, 0xbc, 0xcc,
+	0xaf, 0x0c, 0x88, 0x96, 0xfe, 0xe8, 0xcb, 0x5f, 0xfe, 0xf1, 0xef, 0xf7, 0x29, 0x1d, 0x15, 0x8d,
+	0x3e, 0x13, 0x30, 0xfa, 0x59, 0x83, 0xeb, 0xe7, 0xc6, 0x43, 0xb4, 0xd1, 0x27, 0x5d, 0xd2, 0x38,
+	0x9a, 0x7f, 0xf5, 0xf2, 0x81, 0x4a, 0xf2, 0x8a, 0x90, 0xbc, 0x84, 0x6e, 0x27, 0x4b, 0x7e, 0xdc,
+	0x7e, 0xc8, 0x3e, 0x43, 0x3f, 0x68, 0x80, 0xce, 0x0f, 0x80, 0xe8, 0x52, 0xf9, 0xa3, 0xe3, 0x69,
+	0x7e, 0xf3, 0x0a, 0x91, 0x4a, 0xfa, 0x2d, 0x21, 0x7d, 0x0e, 0xcd, 0x26, 0x4a, 0x47, 0xbf, 0x68,
+	0x30, 0xd5, 0x3d, 0x6a, 0xa1, 0x7b, 0x7d, 0x52, 0x26, 0x4c, 0x88, 0xf9, 0x8d, 0x4b, 0xc7, 0x29,
+	0xa1, 0x9b, 0x42, 0xe8, 0x1a, 0x2a, 0x0f, 0xe4, 0xb1, 0x11, 0x9d, 0xba, 0x9e, 0x68, 0x30, 0x19,
+	0xff, 0x55, 0x46, 0x77, 0xfb, 0x39, 0xd6, 0x6b, 0x00, 0xc8, 0xaf, 0x5f, 0x32, 0x4a, 0x49, 0x7f,
+	0x43, 0x48, 0xdf, 0x40, 0xeb, 0x83, 0x49, 0xef, 0x7a, 0xcd, 0x2b, 0x1f, 0x3e, 0x3d, 0x2d, 0x68,
+	0xcf, 0x4e, 0x0b, 0xda, 0x3f, 0xa7, 0x05, 0xed, 0xbb, 0xb3, 0xc2, 0xd0, 0xb3, 0xb3, 0xc2, 0xd0,
+	0x5f, 0x67, 0x85, 0xa1, 0x4f, 0xd7, 0xab, 0x1e, 0xaf, 0x35, 0x9c, 0x92, 0x4b, 0xeb, 0x2d, 0x6a,
+	0x31, 0xaf, 0xb5, 0xf3, 0x9c, 0x74, 0x65, 0xe2, 0x4d, 0x1f, 0x33, 0x27, 0x2b, 0xfe, 0x19, 0x5c,
+	0xfb, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x24, 0xdb, 0xdc, 0xa5, 0x1e, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -803,6 +1090,9 @@ type QueryClient interface {
 	BtcCheckpointsInfo(ctx context.Context, in *QueryBtcCheckpointsInfoRequest, opts ...grpc.CallOption) (*QueryBtcCheckpointsInfoResponse, error)
 	// EpochSubmissions returns all submissions for a given epoch
 	EpochSubmissions(ctx context.Context, in *QueryEpochSubmissionsRequest, opts ...grpc.CallOption) (*QueryEpochSubmissionsResponse, error)
+	// BestSubmission returns the best submission for a given epoch among all
+	// submissions currently on the BTC main chain
+	BestSubmission(ctx context.Context, in *QueryBestSubmissionRequest, opts ...grpc.CallOption) (*QueryBestSubmissionResponse, error)
 }
 
 type queryClient struct {
@@ -849,6 +1139,15 @@ func (c *queryClient) EpochSubmissions(ctx context.Context, in *QueryEpochSubmis
 	return out, nil
 }
 
+func (c *queryClient) BestSubmission(ctx context.Context, in *QueryBestSubmissionRequest, opts ...grpc.CallOption) (*QueryBestSubmissionResponse, error) {
+	out := new(QueryBestSubmissionResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btccheckpoint.v1.Query/BestSubmission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -859,6 +1158,9 @@ type QueryServer interface {
 	BtcCheckpointsInfo(context.Context, *QueryBtcCheckpointsInfoRequest) (*QueryBtcCheckpointsInfoResponse, error)
 	// EpochSubmissions returns all submissions for a given epoch
 	EpochSubmissions(context.Context, *QueryEpochSubmissionsRequest) (*QueryEpochSubmissionsResponse, error)
+	// BestSubmission returns the best submission for a given epoch among all
+	// submissions currently on the BTC main chain
+	BestSubmission(context.Context, *QueryBestSubmissionRequest) (*QueryBestSubmissionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -877,6 +1179,9 @@ func (*UnimplementedQueryServer) BtcCheckpointsInfo(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) EpochSubmissions(ctx context.Context, req *QueryEpochSubmissionsRequest) (*QueryEpochSubmissionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EpochSubmissions not implemented")
 }
+func (*UnimplementedQueryServer) BestSubmission(ctx context.Context, req *QueryBestSubmissionRequest) (*QueryBestSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BestSubmission not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -954,6 +1259,24 @@ func _Query_EpochSubmissions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BestSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBestSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BestSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btccheckpoint.v1.Query/BestSubmission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BestSubmission(ctx, req.(*QueryBestSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.btccheckpoint.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -974,6 +1297,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EpochSubmissions",
 			Handler:    _Query_EpochSubmissions_Handler,
 		},
+		{
+			MethodName: "BestSubmission",
+			Handler:    _Query_BestSubmission_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/btccheckpoint/v1/query.proto",
@@ -1202,6 +1529,18 @@ func (m *QueryEpochSubmissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.EpochNum != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
 		i--
@@ -1230,6 +1569,32 @@ func (m *QueryEpochSubmissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Submissions) > 0 {
+		for iNdEx := len(m.Submissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Submissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Keys) > 0 {
 		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1247,7 +1612,7 @@ func (m *QueryEpochSubmissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *BTCCheckpointInfoResponse) Marshal() (dAtA []byte, err error) {
+func (m *SubmissionInfoResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1257,62 +1622,226 @@ func (m *BTCCheckpointInfoResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BTCCheckpointInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubmissionInfoResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BTCCheckpointInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubmissionInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BestSubmissionVigilanteAddressList) > 0 {
-		for iNdEx := len(m.BestSubmissionVigilanteAddressList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BestSubmissionVigilanteAddressList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.BestSubmissionTransactions) > 0 {
-		for iNdEx := len(m.BestSubmissionTransactions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BestSubmissionTransactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.VigilanteAddresses != nil {
+		{
+			size, err := m.VigilanteAddresses.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x22
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.BestSubmissionBtcBlockHash) > 0 {
-		i -= len(m.BestSubmissionBtcBlockHash)
-		copy(dAtA[i:], m.BestSubmissionBtcBlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BestSubmissionBtcBlockHash)))
+	if m.BtcDepth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcDepth))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x28
 	}
-	if m.BestSubmissionBtcBlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BestSubmissionBtcBlockHeight))
+	if len(m.BtcBlockHash) > 0 {
+		i -= len(m.BtcBlockHash)
+		copy(dAtA[i:], m.BtcBlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BtcBlockHash)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x22
 	}
-	if m.EpochNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+	if m.BtcBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcBlockHeight))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x18
 	}
-	return len(dAtA) - i, nil
+	if m.OnMainChain {
+		i--
+		if m.OnMainChain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Key != nil {
+		{
+			size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBestSubmissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBestSubmissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBestSubmissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBestSubmissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBestSubmissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBestSubmissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumSubmissions != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumSubmissions))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.BtcBlockHash) > 0 {
+		i -= len(m.BtcBlockHash)
+		copy(dAtA[i:], m.BtcBlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BtcBlockHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BtcBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcBlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Key != nil {
+		{
+			size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCCheckpointInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCCheckpointInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCCheckpointInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BestSubmissionVigilanteAddressList) > 0 {
+		for iNdEx := len(m.BestSubmissionVigilanteAddressList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BestSubmissionVigilanteAddressList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BestSubmissionTransactions) > 0 {
+		for iNdEx := len(m.BestSubmissionTransactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BestSubmissionTransactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BestSubmissionBtcBlockHash) > 0 {
+		i -= len(m.BestSubmissionBtcBlockHash)
+		copy(dAtA[i:], m.BestSubmissionBtcBlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BestSubmissionBtcBlockHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BestSubmissionBtcBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BestSubmissionBtcBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *TransactionInfoResponse) Marshal() (dAtA []byte, err error) {
@@ -1545,6 +2074,10 @@ func (m *QueryEpochSubmissionsRequest) Size() (n int) {
 	if m.EpochNum != 0 {
 		n += 1 + sovQuery(uint64(m.EpochNum))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1560,6 +2093,87 @@ func (m *QueryEpochSubmissionsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.Submissions) > 0 {
+		for _, e := range m.Submissions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SubmissionInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != nil {
+		l = m.Key.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OnMainChain {
+		n += 2
+	}
+	if m.BtcBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BtcBlockHeight))
+	}
+	l = len(m.BtcBlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BtcDepth != 0 {
+		n += 1 + sovQuery(uint64(m.BtcDepth))
+	}
+	if m.VigilanteAddresses != nil {
+		l = m.VigilanteAddresses.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBestSubmissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	return n
+}
+
+func (m *QueryBestSubmissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Key != nil {
+		l = m.Key.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BtcBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BtcBlockHeight))
+	}
+	l = len(m.BtcBlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumSubmissions != 0 {
+		n += 1 + sovQuery(uint64(m.NumSubmissions))
+	}
 	return n
 }
 
@@ -2206,6 +2820,42 @@ func (m *QueryEpochSubmissionsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2290,6 +2940,551 @@ func (m *QueryEpochSubmissionsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submissions = append(m.Submissions, &SubmissionInfoResponse{})
+			if err := m.Submissions[len(m.Submissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmissionInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmissionInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmissionInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Key == nil {
+				m.Key = &SubmissionKeyResponse{}
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnMainChain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnMainChain = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcBlockHeight", wireType)
+			}
+			m.BtcBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcBlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcBlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDepth", wireType)
+			}
+			m.BtcDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VigilanteAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VigilanteAddresses == nil {
+				m.VigilanteAddresses = &CheckpointAddressesResponse{}
+			}
+			if err := m.VigilanteAddresses.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBestSubmissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBestSubmissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBestSubmissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBestSubmissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBestSubmissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBestSubmissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BtcStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Key == nil {
+				m.Key = &SubmissionKeyResponse{}
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcBlockHeight", wireType)
+			}
+			m.BtcBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcBlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcBlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSubmissions", wireType)
+			}
+			m.NumSubmissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumSubmissions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
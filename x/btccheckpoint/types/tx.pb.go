@@ -122,6 +122,103 @@ func (m *MsgInsertBTCSpvProofResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgInsertBTCSpvProofResponse proto.InternalMessageInfo
 
+// MsgReportConflictingCheckpoint defines a request to report a checkpoint
+// included in BTC whose BLS multi-sig is valid but which conflicts with the
+// local checkpoint of the same epoch
+type MsgReportConflictingCheckpoint struct {
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// proofs are the SPV proofs of the BTC txs carrying the conflicting
+	// checkpoint
+	Proofs []*BTCSpvProof `protobuf:"bytes,2,rep,name=proofs,proto3" json:"proofs,omitempty"`
+}
+
+func (m *MsgReportConflictingCheckpoint) Reset()         { *m = MsgReportConflictingCheckpoint{} }
+func (m *MsgReportConflictingCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*MsgReportConflictingCheckpoint) ProtoMessage()    {}
+func (*MsgReportConflictingCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69a562325f8b35c5, []int{2}
+}
+func (m *MsgReportConflictingCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportConflictingCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportConflictingCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReportConflictingCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportConflictingCheckpoint.Merge(m, src)
+}
+func (m *MsgReportConflictingCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportConflictingCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportConflictingCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReportConflictingCheckpoint proto.InternalMessageInfo
+
+func (m *MsgReportConflictingCheckpoint) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *MsgReportConflictingCheckpoint) GetProofs() []*BTCSpvProof {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
+// MsgReportConflictingCheckpointResponse defines the response for the
+// MsgReportConflictingCheckpoint message
+type MsgReportConflictingCheckpointResponse struct {
+}
+
+func (m *MsgReportConflictingCheckpointResponse) Reset() {
+	*m = MsgReportConflictingCheckpointResponse{}
+}
+func (m *MsgReportConflictingCheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReportConflictingCheckpointResponse) ProtoMessage()    {}
+func (*MsgReportConflictingCheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69a562325f8b35c5, []int{3}
+}
+func (m *MsgReportConflictingCheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportConflictingCheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportConflictingCheckpointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReportConflictingCheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportConflictingCheckpointResponse.Merge(m, src)
+}
+func (m *MsgReportConflictingCheckpointResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportConflictingCheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportConflictingCheckpointResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReportConflictingCheckpointResponse proto.InternalMessageInfo
+
 // MsgUpdateParams defines a message to update the btccheckpoint module params.
 type MsgUpdateParams struct {
 	// authority is the address of the governance account.
@@ -139,7 +236,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_69a562325f8b35c5, []int{2}
+	return fileDescriptor_69a562325f8b35c5, []int{4}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -190,7 +287,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_69a562325f8b35c5, []int{3}
+	return fileDescriptor_69a562325f8b35c5, []int{5}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -222,6 +319,8 @@ var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*MsgInsertBTCSpvProof)(nil), "babylon.btccheckpoint.v1.MsgInsertBTCSpvProof")
 	proto.RegisterType((*MsgInsertBTCSpvProofResponse)(nil), "babylon.btccheckpoint.v1.MsgInsertBTCSpvProofResponse")
+	proto.RegisterType((*MsgReportConflictingCheckpoint)(nil), "babylon.btccheckpoint.v1.MsgReportConflictingCheckpoint")
+	proto.RegisterType((*MsgReportConflictingCheckpointResponse)(nil), "babylon.btccheckpoint.v1.MsgReportConflictingCheckpointResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.btccheckpoint.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.btccheckpoint.v1.MsgUpdateParamsResponse")
 }
@@ -229,35 +328,38 @@ func init() {
 func init() { proto.RegisterFile("babylon/btccheckpoint/v1/tx.proto", fileDescriptor_69a562325f8b35c5) }
 
 var fileDescriptor_69a562325f8b35c5 = []byte{
-	// 439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0x4e, 0xce, 0x48, 0x4d, 0xce, 0x2e, 0xc8, 0xcf, 0xcc,
-	0x2b, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x80,
-	0x2a, 0xd1, 0x43, 0x51, 0xa2, 0x57, 0x66, 0x28, 0xa5, 0x83, 0x53, 0x33, 0xaa, 0x52, 0xb0, 0x39,
-	0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xf1, 0x60, 0x9e, 0x3e, 0x84, 0x03, 0x95, 0x12,
-	0x87, 0xf0, 0xf4, 0x73, 0x8b, 0xd3, 0x41, 0xba, 0x73, 0x8b, 0xd3, 0xa1, 0x12, 0xaa, 0x38, 0x6d,
-	0x28, 0x48, 0x2c, 0x4a, 0xcc, 0x85, 0xe9, 0x17, 0x49, 0xcf, 0x4f, 0xcf, 0x87, 0x98, 0x0b, 0x62,
-	0x41, 0x44, 0x95, 0x9a, 0x19, 0xb9, 0x44, 0x7c, 0x8b, 0xd3, 0x3d, 0xf3, 0x8a, 0x53, 0x8b, 0x4a,
-	0x9c, 0x42, 0x9c, 0x83, 0x0b, 0xca, 0x02, 0x8a, 0xf2, 0xf3, 0xd3, 0x84, 0x64, 0xb8, 0x38, 0x8b,
-	0x4b, 0x93, 0x72, 0x33, 0x4b, 0x4a, 0x52, 0x8b, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0x10,
-	0x02, 0x42, 0xb6, 0x5c, 0x6c, 0x05, 0x20, 0x65, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46,
-	0xaa, 0x7a, 0xb8, 0x02, 0x40, 0x0f, 0xc9, 0xd0, 0x20, 0xa8, 0x26, 0x2b, 0xbe, 0xa6, 0xe7, 0x1b,
-	0xb4, 0x10, 0xc6, 0x29, 0xc9, 0x71, 0xc9, 0x60, 0x73, 0x44, 0x50, 0x6a, 0x71, 0x41, 0x7e, 0x5e,
-	0x71, 0xaa, 0xd2, 0x4c, 0x46, 0x2e, 0x7e, 0xdf, 0xe2, 0xf4, 0xd0, 0x82, 0x94, 0xc4, 0x92, 0xd4,
-	0x00, 0xb0, 0xaf, 0x84, 0xcc, 0xb8, 0x38, 0x13, 0x4b, 0x4b, 0x32, 0xf2, 0x8b, 0x32, 0x4b, 0x2a,
-	0x21, 0x0e, 0x74, 0x92, 0xb8, 0xb4, 0x45, 0x57, 0x04, 0x1a, 0x68, 0x8e, 0x29, 0x29, 0x45, 0xa9,
-	0xc5, 0xc5, 0xc1, 0x25, 0x45, 0x99, 0x79, 0xe9, 0x41, 0x08, 0xa5, 0x42, 0x76, 0x5c, 0x6c, 0x90,
-	0x70, 0x91, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x36, 0x52, 0xc0, 0xed, 0x74, 0x88, 0x4d, 0x4e, 0x2c,
-	0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x75, 0x41, 0xdd, 0x0e, 0x37, 0x4f, 0x49, 0x92, 0x4b, 0x1c,
-	0xcd, 0x69, 0x30, 0x67, 0x1b, 0x7d, 0x67, 0xe4, 0x62, 0xf6, 0x2d, 0x4e, 0x17, 0xaa, 0xe6, 0x12,
-	0xc4, 0x0c, 0x60, 0x3d, 0xdc, 0xf6, 0x62, 0x0b, 0x0b, 0x29, 0x33, 0xd2, 0xd4, 0xc3, 0x1c, 0x21,
-	0x94, 0xc3, 0xc5, 0x83, 0x12, 0x6e, 0x9a, 0x78, 0xcd, 0x41, 0x56, 0x2a, 0x65, 0x48, 0xb4, 0x52,
-	0x98, 0x6d, 0x52, 0xac, 0x0d, 0xcf, 0x37, 0x68, 0x31, 0x3a, 0xf9, 0x9f, 0x78, 0x24, 0xc7, 0x78,
-	0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7,
-	0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x69, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e,
-	0xae, 0x3e, 0xd4, 0xf4, 0xe4, 0x8c, 0xc4, 0xcc, 0x3c, 0x18, 0x47, 0xbf, 0x02, 0x2d, 0x1d, 0x97,
-	0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x93, 0xab, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x00,
-	0x2e, 0x80, 0x63, 0x8c, 0x03, 0x00, 0x00,
+	// 493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xcf, 0x6a, 0x13, 0x41,
+	0x18, 0xcf, 0x34, 0x1a, 0xc8, 0x54, 0x14, 0x97, 0x40, 0xb7, 0x6b, 0x59, 0x63, 0xa0, 0x12, 0x8b,
+	0xee, 0x92, 0x88, 0x45, 0x04, 0x45, 0x93, 0x93, 0x87, 0x60, 0xd9, 0xea, 0xc5, 0x8b, 0xec, 0x6e,
+	0xa6, 0x93, 0xc5, 0xec, 0xcc, 0x30, 0xdf, 0x24, 0x34, 0x78, 0x11, 0x3d, 0x0b, 0x82, 0xa7, 0xbe,
+	0x45, 0x0f, 0x3e, 0x44, 0x8f, 0xc5, 0x93, 0x27, 0x91, 0xe4, 0xd0, 0xd7, 0x90, 0x64, 0x67, 0x93,
+	0xa6, 0x66, 0x17, 0xf5, 0xe0, 0x6d, 0xbf, 0x99, 0xdf, 0xbf, 0xd9, 0xdf, 0x30, 0xf8, 0x56, 0xe0,
+	0x07, 0xa3, 0x3e, 0x67, 0x6e, 0xa0, 0xc2, 0xb0, 0x47, 0xc2, 0xb7, 0x82, 0x47, 0x4c, 0xb9, 0xc3,
+	0x86, 0xab, 0x0e, 0x1d, 0x21, 0xb9, 0xe2, 0x86, 0xa9, 0x21, 0xce, 0x12, 0xc4, 0x19, 0x36, 0xac,
+	0xbb, 0x99, 0xe4, 0x65, 0xe8, 0x4c, 0xc7, 0xda, 0x0c, 0x39, 0xc4, 0x1c, 0xde, 0xcc, 0x26, 0x37,
+	0x19, 0xf4, 0xd6, 0x46, 0x32, 0xb9, 0x31, 0xd0, 0x29, 0x3b, 0x06, 0xaa, 0x37, 0xb6, 0x33, 0x1d,
+	0x84, 0x2f, 0xfd, 0x38, 0xe5, 0x57, 0x28, 0xa7, 0x3c, 0xd1, 0x9d, 0x7e, 0x25, 0xab, 0xb5, 0x8f,
+	0x08, 0x57, 0x3a, 0x40, 0x9f, 0x33, 0x20, 0x52, 0xb5, 0x5e, 0xb6, 0xf7, 0xc5, 0x70, 0x4f, 0x72,
+	0x7e, 0x60, 0x6c, 0xe1, 0x32, 0x0c, 0x82, 0x38, 0x52, 0x8a, 0x48, 0x13, 0x55, 0x51, 0xbd, 0xec,
+	0x2d, 0x16, 0x8c, 0xc7, 0xb8, 0x24, 0xa6, 0x30, 0x30, 0xd7, 0xaa, 0xc5, 0xfa, 0x7a, 0x73, 0xdb,
+	0xc9, 0xfa, 0x01, 0xce, 0x39, 0x51, 0x4f, 0x93, 0x1e, 0x5d, 0xfd, 0x70, 0x76, 0xbc, 0xb3, 0x90,
+	0xab, 0xd9, 0x78, 0x6b, 0x55, 0x08, 0x8f, 0x80, 0xe0, 0x0c, 0x48, 0xed, 0x13, 0xc2, 0x76, 0x07,
+	0xa8, 0x47, 0x04, 0x97, 0xaa, 0xcd, 0xd9, 0x41, 0x3f, 0x0a, 0x55, 0xc4, 0x68, 0x7b, 0xee, 0xf4,
+	0x7f, 0xf3, 0xd6, 0xf1, 0xed, 0xfc, 0x38, 0xf3, 0xe4, 0x47, 0x08, 0x5f, 0xeb, 0x00, 0x7d, 0x25,
+	0xba, 0xbe, 0x22, 0x7b, 0xb3, 0x3e, 0x8c, 0x5d, 0x5c, 0xf6, 0x07, 0xaa, 0xc7, 0x65, 0xa4, 0x46,
+	0x49, 0xd4, 0x96, 0xf9, 0xed, 0xeb, 0xbd, 0x8a, 0xae, 0xfb, 0x59, 0xb7, 0x2b, 0x09, 0xc0, 0xbe,
+	0x92, 0x11, 0xa3, 0xde, 0x02, 0x6a, 0x3c, 0xc1, 0xa5, 0xa4, 0x51, 0x73, 0xad, 0x8a, 0xea, 0xeb,
+	0xcd, 0x6a, 0xf6, 0x21, 0x12, 0xa7, 0xd6, 0xa5, 0x93, 0x1f, 0x37, 0x0b, 0x9e, 0x66, 0xe9, 0x53,
+	0xcc, 0xf5, 0x6a, 0x9b, 0x78, 0xe3, 0x42, 0xb4, 0x34, 0x76, 0xf3, 0x4b, 0x11, 0x17, 0x3b, 0x40,
+	0x8d, 0x77, 0xf8, 0xfa, 0xef, 0x57, 0xc3, 0xc9, 0xf6, 0x5d, 0xd5, 0xa2, 0xb5, 0xfb, 0x77, 0xf8,
+	0x34, 0x84, 0x71, 0x84, 0xf0, 0x8d, 0xbc, 0xca, 0x1f, 0xe6, 0xea, 0xe6, 0x30, 0xad, 0xa7, 0xff,
+	0xca, 0x9c, 0x67, 0xeb, 0xe3, 0x2b, 0x4b, 0x9d, 0xde, 0xc9, 0x55, 0x3c, 0x0f, 0xb5, 0x1a, 0x7f,
+	0x0c, 0x4d, 0xdd, 0xac, 0xcb, 0xef, 0xcf, 0x8e, 0x77, 0x50, 0xeb, 0xc5, 0xc9, 0xd8, 0x46, 0xa7,
+	0x63, 0x1b, 0xfd, 0x1c, 0xdb, 0xe8, 0xf3, 0xc4, 0x2e, 0x9c, 0x4e, 0xec, 0xc2, 0xf7, 0x89, 0x5d,
+	0x78, 0xfd, 0x80, 0x46, 0xaa, 0x37, 0x08, 0x9c, 0x90, 0xc7, 0xae, 0x56, 0x0f, 0x7b, 0x7e, 0xc4,
+	0xd2, 0xc1, 0x3d, 0xbc, 0xf0, 0x3a, 0xa8, 0x91, 0x20, 0x10, 0x94, 0x66, 0x8f, 0xc0, 0xfd, 0x5f,
+	0x01, 0x00, 0x00, 0xff, 0xff, 0x97, 0x84, 0x4c, 0x16, 0xe2, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -274,6 +376,10 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// InsertBTCSpvProof tries to insert a new checkpoint into the store.
 	InsertBTCSpvProof(ctx context.Context, in *MsgInsertBTCSpvProof, opts ...grpc.CallOption) (*MsgInsertBTCSpvProofResponse, error)
+	// ReportConflictingCheckpoint reports a checkpoint included in BTC that
+	// conflicts with the local checkpoint of the same epoch, such that the
+	// validators who signed it are slashed.
+	ReportConflictingCheckpoint(ctx context.Context, in *MsgReportConflictingCheckpoint, opts ...grpc.CallOption) (*MsgReportConflictingCheckpointResponse, error)
 	// UpdateParams updates the btccheckpoint module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
@@ -295,6 +401,15 @@ func (c *msgClient) InsertBTCSpvProof(ctx context.Context, in *MsgInsertBTCSpvPr
 	return out, nil
 }
 
+func (c *msgClient) ReportConflictingCheckpoint(ctx context.Context, in *MsgReportConflictingCheckpoint, opts ...grpc.CallOption) (*MsgReportConflictingCheckpointResponse, error) {
+	out := new(MsgReportConflictingCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btccheckpoint.v1.Msg/ReportConflictingCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/babylon.btccheckpoint.v1.Msg/UpdateParams", in, out, opts...)
@@ -308,6 +423,10 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 type MsgServer interface {
 	// InsertBTCSpvProof tries to insert a new checkpoint into the store.
 	InsertBTCSpvProof(context.Context, *MsgInsertBTCSpvProof) (*MsgInsertBTCSpvProofResponse, error)
+	// ReportConflictingCheckpoint reports a checkpoint included in BTC that
+	// conflicts with the local checkpoint of the same epoch, such that the
+	// validators who signed it are slashed.
+	ReportConflictingCheckpoint(context.Context, *MsgReportConflictingCheckpoint) (*MsgReportConflictingCheckpointResponse, error)
 	// UpdateParams updates the btccheckpoint module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
@@ -319,6 +438,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) InsertBTCSpvProof(ctx context.Context, req *MsgInsertBTCSpvProof) (*MsgInsertBTCSpvProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertBTCSpvProof not implemented")
 }
+func (*UnimplementedMsgServer) ReportConflictingCheckpoint(ctx context.Context, req *MsgReportConflictingCheckpoint) (*MsgReportConflictingCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportConflictingCheckpoint not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -345,6 +467,24 @@ func _Msg_InsertBTCSpvProof_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ReportConflictingCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportConflictingCheckpoint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportConflictingCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btccheckpoint.v1.Msg/ReportConflictingCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportConflictingCheckpoint(ctx, req.(*MsgReportConflictingCheckpoint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -371,6 +511,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InsertBTCSpvProof",
 			Handler:    _Msg_InsertBTCSpvProof_Handler,
 		},
+		{
+			MethodName: "ReportConflictingCheckpoint",
+			Handler:    _Msg_ReportConflictingCheckpoint_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -447,6 +591,73 @@ func (m *MsgInsertBTCSpvProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgReportConflictingCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportConflictingCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportConflictingCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportConflictingCheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportConflictingCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportConflictingCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -549,6 +760,34 @@ func (m *MsgInsertBTCSpvProofResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgReportConflictingCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgReportConflictingCheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -745,6 +984,172 @@ func (m *MsgInsertBTCSpvProofResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgReportConflictingCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReportConflictingCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReportConflictingCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proofs = append(m.Proofs, &BTCSpvProof{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReportConflictingCheckpointResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReportConflictingCheckpointResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReportConflictingCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
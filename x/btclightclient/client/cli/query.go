@@ -1,8 +1,11 @@
 package cli
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
 
 	"github.com/babylonchain/babylon/x/btclightclient/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -27,6 +30,7 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(CmdTip())
 	cmd.AddCommand(CmdBaseHeader())
 	cmd.AddCommand(CmdHeaderDepth())
+	cmd.AddCommand(CmdExportHeaders())
 
 	return cmd
 }
@@ -198,3 +202,124 @@ func CmdHeaderDepth() *cobra.Command {
 
 	return cmd
 }
+
+const (
+	flagFrom      = "from"
+	flagTo        = "to"
+	flagFormat    = "format"
+	flagOutFile   = "out-file"
+	flagChunkSize = "chunk-size"
+
+	formatBinary = "binary"
+	formatJSON   = "json"
+)
+
+func CmdExportHeaders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-headers",
+		Short: "export the headers on the canonical chain within a range of heights to a file",
+		Long: `Export the headers on the canonical chain within a range of heights to a file.
+
+With --format binary, the file is the concatenation of the raw 80-byte headers
+in ascending order of height, and a manifest with the heights and the cumulative
+work of the headers is written to <out-file>.manifest.json. This compact format is
+meant for external BTC light clients and for the gen-helpers set-btc-headers
+command.
+
+With --format json, the file is a btclightclient genesis state carrying the
+headers.`,
+		Example: `babylond query btclightclient export-headers --from 0 --to 800000 --format binary --out-file btc_headers.bin`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			fromHeight, _ := cmd.Flags().GetUint64(flagFrom)
+			toHeight, _ := cmd.Flags().GetUint64(flagTo)
+			format, _ := cmd.Flags().GetString(flagFormat)
+			outFile, _ := cmd.Flags().GetString(flagOutFile)
+			chunkSize, _ := cmd.Flags().GetUint64(flagChunkSize)
+			if format != formatBinary && format != formatJSON {
+				return fmt.Errorf("unsupported format %s, expected %s or %s", format, formatBinary, formatJSON)
+			}
+			if chunkSize == 0 || chunkSize > types.MaxHeadersInRange {
+				return fmt.Errorf("chunk size has to be within [1, %d]", types.MaxHeadersInRange)
+			}
+
+			// export up to the tip if no end height is given
+			if toHeight == 0 {
+				tipRes, err := queryClient.Tip(cmd.Context(), types.NewQueryTipRequest())
+				if err != nil {
+					return err
+				}
+				toHeight = tipRes.Header.Height
+			}
+			if fromHeight > toHeight {
+				return fmt.Errorf("--%s %d is higher than --%s %d", flagFrom, fromHeight, flagTo, toHeight)
+			}
+
+			f, err := os.Create(outFile)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			w := bufio.NewWriter(f)
+			exportWriter := types.NewHeadersExportWriter(w)
+			jsonHeaders := make([]*types.BTCHeaderInfo, 0)
+
+			for start := fromHeight; start <= toHeight; start += chunkSize {
+				end := start + chunkSize - 1
+				if end > toHeight {
+					end = toHeight
+				}
+				res, err := queryClient.HeadersInRange(cmd.Context(), types.NewQueryHeadersInRangeRequest(start, end))
+				if err != nil {
+					return err
+				}
+				if uint64(len(res.Headers)) != end-start+1 {
+					return fmt.Errorf("headers within heights [%d, %d] are not all on the canonical chain", start, end)
+				}
+
+				if format == formatJSON {
+					jsonHeaders = append(jsonHeaders, res.Headers...)
+					continue
+				}
+				if err := exportWriter.WriteChunk(res.Headers); err != nil {
+					return err
+				}
+			}
+
+			if format == formatJSON {
+				bz, err := clientCtx.Codec.MarshalJSON(&types.GenesisState{BtcHeaders: jsonHeaders})
+				if err != nil {
+					return err
+				}
+				if _, err := w.Write(bz); err != nil {
+					return err
+				}
+				return w.Flush()
+			}
+
+			if err := w.Flush(); err != nil {
+				return err
+			}
+			manifestBytes, err := json.MarshalIndent(exportWriter.Manifest(), "", "  ")
+			if err != nil {
+				return err
+			}
+			return os.WriteFile(types.HeadersExportManifestPath(outFile), manifestBytes, 0o644)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint64(flagFrom, 0, "The height of the first header to export")
+	cmd.Flags().Uint64(flagTo, 0, "The height of the last header to export. The tip is used if not set")
+	cmd.Flags().String(flagFormat, formatBinary, "The format of the exported file (binary|json)")
+	cmd.Flags().String(flagOutFile, "btc_headers.bin", "The path of the exported file")
+	cmd.Flags().Uint64(flagChunkSize, types.MaxHeadersInRange, "The number of headers to query at a time")
+
+	return cmd
+}
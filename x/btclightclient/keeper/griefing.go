@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// checkForkDepth ensures that the chain starting with the given header does
+// not fork from the canonical chain deeper than allowed by the parameters.
+// The depth of the fork is measured by the cumulative work of the canonical
+// headers above the fork point rather than by their number, so that a fork
+// cannot go deeper by forking below headers of low difficulty.
+// Headers whose parent is unknown are left to the light client to reject.
+func (k Keeper) checkForkDepth(ctx context.Context, params *types.Params, firstHeader *wire.BlockHeader) error {
+	parentHash := bbn.NewBTCHeaderHashBytesFromChainhash(&firstHeader.PrevBlock)
+	parent := k.GetHeaderByHash(ctx, &parentHash)
+	if parent == nil {
+		return nil
+	}
+
+	tip := k.GetTipInfo(ctx)
+	forkWork := tip.Work.Sub(*parent.Work)
+	tipHeaderWork := types.CalcWork(tip.Header)
+	if !params.IsForkWorkAllowed(forkWork, tipHeaderWork) {
+		return types.ErrForkTooDeep.Wrapf("fork work: %s, max fork work: %d headers of work %s",
+			forkWork, params.MaxForkDepth, tipHeaderWork)
+	}
+
+	return nil
+}
+
+// checkReporterRateLimit ensures that the given reporter has not reached the
+// maximum number of messages it can submit within the current Babylon block
+func (k Keeper) checkReporterRateLimit(ctx context.Context, params *types.Params, reporter sdk.AccAddress) error {
+	numMsgs := k.getReporterNumMsgs(ctx, reporter)
+	if params.IsReporterRateLimited(numMsgs) {
+		return types.ErrReporterRateLimited.Wrapf("reporter %s has submitted %d messages in this block", reporter, numMsgs)
+	}
+
+	return nil
+}
+
+// incReporterNumMsgs increments the number of messages that the given
+// reporter has submitted within the current Babylon block
+func (k Keeper) incReporterNumMsgs(ctx context.Context, reporter sdk.AccAddress) {
+	numMsgs := k.getReporterNumMsgs(ctx, reporter)
+	k.reporterMsgsStore(ctx).Set(reporter.Bytes(), sdk.Uint64ToBigEndian(uint64(numMsgs)+1))
+}
+
+// getReporterNumMsgs returns the number of messages that the given reporter
+// has submitted within the current Babylon block
+func (k Keeper) getReporterNumMsgs(ctx context.Context, reporter sdk.AccAddress) uint32 {
+	value := k.reporterMsgsStore(ctx).Get(reporter.Bytes())
+	if value == nil {
+		return 0
+	}
+	return uint32(sdk.BigEndianToUint64(value))
+}
+
+// reporterMsgsStore returns the transient KVStore of the number of messages
+// submitted by each reporter. The transient store is cleared after each block
+// execution, so it only contains the reporters of the current block
+// prefix: ReporterMsgsPrefix
+// key: reporter address
+// value: number of messages submitted in the current block
+func (k Keeper) reporterMsgsStore(ctx context.Context) prefix.Store {
+	store := sdk.UnwrapSDKContext(ctx).TransientStore(k.tsKey)
+	return prefix.NewStore(store, types.ReporterMsgsPrefix)
+}
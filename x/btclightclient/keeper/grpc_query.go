@@ -207,3 +207,20 @@ func (k Keeper) HeaderDepth(ctx context.Context, req *types.QueryHeaderDepthRequ
 
 	return &types.QueryHeaderDepthResponse{Depth: uint64(depth)}, nil
 }
+
+func (k Keeper) HeadersInRange(ctx context.Context, req *types.QueryHeadersInRangeRequest) (*types.QueryHeadersInRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.StartHeight > req.EndHeight {
+		return nil, status.Error(codes.InvalidArgument, "start height is higher than end height")
+	}
+	if req.EndHeight-req.StartHeight >= types.MaxHeadersInRange {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d headers can be queried at a time", types.MaxHeadersInRange)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	headers := k.GetMainChainInRange(sdkCtx, req.StartHeight, req.EndHeight)
+
+	return &types.QueryHeadersInRangeResponse{Headers: headers}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/babylonchain/babylon/testutil/datagen"
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/babylonchain/babylon/testutil/keeper"
 	"github.com/babylonchain/babylon/x/btclightclient/types"
@@ -407,6 +408,62 @@ func FuzzBaseHeaderQuery(f *testing.F) {
 	})
 }
 
+func FuzzHeadersInRangeQuery(f *testing.F) {
+	/*
+		Checks:
+		1. If the request is nil, (nil, error) is returned
+		2. If the range is invalid or too large, (nil, error) is returned
+		3. The query returns the headers on the canonical chain within the range
+
+		Data generation:
+		- Generate a random chain of headers and insert into storage.
+		- Generate a random range of heights within the chain.
+	*/
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		blcKeeper, ctx := keepertest.BTCLightClientKeeper(t)
+
+		// Test nil input
+		resp, err := blcKeeper.HeadersInRange(ctx, nil)
+		require.Nil(t, resp)
+		require.Error(t, err)
+
+		// Test invalid ranges
+		_, err = blcKeeper.HeadersInRange(ctx, types.NewQueryHeadersInRangeRequest(2, 1))
+		require.Error(t, err)
+		_, err = blcKeeper.HeadersInRange(ctx, types.NewQueryHeadersInRangeRequest(0, types.MaxHeadersInRange))
+		require.Error(t, err)
+
+		// Generate a random chain of headers and insert it into storage
+		base, chain := datagen.GenRandBtcChainInsertingInKeeper(
+			t,
+			r,
+			blcKeeper,
+			ctx,
+			0,
+			datagen.RandomInt(r, 50)+100,
+		)
+		mainchain := append([]*types.BTCHeaderInfo{base}, chain.GetChainInfo()...)
+
+		start := datagen.RandomInt(r, len(mainchain))
+		end := start + datagen.RandomInt(r, len(mainchain)-int(start))
+		resp, err = blcKeeper.HeadersInRange(ctx, types.NewQueryHeadersInRangeRequest(start, end))
+		require.NoError(t, err)
+		require.Len(t, resp.Headers, int(end-start+1))
+		for i, header := range resp.Headers {
+			require.True(t, header.Eq(mainchain[int(start)+i]))
+			require.Equal(t, start+uint64(i), header.Height)
+		}
+
+		// Test range beyond the tip
+		tipHeight := mainchain[len(mainchain)-1].Height
+		resp, err = blcKeeper.HeadersInRange(ctx, types.NewQueryHeadersInRangeRequest(tipHeight+1, tipHeight+10))
+		require.NoError(t, err)
+		require.Empty(t, resp.Headers)
+	})
+}
+
 // Constructors for PageRequest objects
 func constructRequestWithKeyAndLimit(r *rand.Rand, key []byte, limit uint64) *query.PageRequest {
 	// If limit is 0, set one randomly
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	keepertest "github.com/babylonchain/babylon/testutil/keeper"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btclightclient/keeper"
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+)
+
+// requireChainInvariants checks the fork-choice invariants of the light client
+//   - the main chain is a contiguous chain of headers from the base header to
+//     the tip, with the cumulative work of each header
+//   - every known header indexed by hash is on the main chain
+func requireChainInvariants(
+	t *testing.T,
+	ctx context.Context,
+	blcKeeper *keeper.Keeper,
+	knownHashes []*bbn.BTCHeaderHashBytes,
+) {
+	base := blcKeeper.GetBaseBTCHeader(ctx)
+	tip := blcKeeper.GetTipInfo(ctx)
+	mainChain := blcKeeper.GetMainChainFrom(ctx, base.Height)
+	require.Len(t, mainChain, int(tip.Height-base.Height+1))
+	require.True(t, allFieldsEqual(base, mainChain[0]))
+	require.True(t, allFieldsEqual(tip, mainChain[len(mainChain)-1]))
+	for i := 1; i < len(mainChain); i++ {
+		prev, cur := mainChain[i-1], mainChain[i]
+		require.Equal(t, prev.Height+1, cur.Height)
+		require.True(t, cur.Header.ParentHash().Eq(prev.Hash))
+		require.True(t, cur.Work.Equal(types.CumulativeWork(*prev.Work, types.CalcHeaderWork(cur.Header.ToBlockHeader()))))
+	}
+
+	for _, hash := range knownHashes {
+		headerInfo := blcKeeper.GetHeaderByHash(ctx, hash)
+		if headerInfo == nil {
+			continue
+		}
+		headerInfoByHeight := blcKeeper.GetHeaderByHeight(ctx, headerInfo.Height)
+		require.NotNil(t, headerInfoByHeight)
+		require.True(t, allFieldsEqual(headerInfo, headerInfoByHeight))
+	}
+}
+
+func hashesOf(headers []*wire.BlockHeader) []*bbn.BTCHeaderHashBytes {
+	hashes := make([]*bbn.BTCHeaderHashBytes, 0, len(headers))
+	for _, header := range headers {
+		headerHash := header.BlockHash()
+		hash := bbn.NewBTCHeaderHashBytesFromChainhash(&headerHash)
+		hashes = append(hashes, &hash)
+	}
+	return hashes
+}
+
+// FuzzKeeperHeaderChainOperations feeds a random sequence of chain extensions,
+// forks of varying work, duplicate headers and invalid headers to the keeper,
+// and checks the fork-choice invariants after each operation
+func FuzzKeeperHeaderChainOperations(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		blcKeeper, ctx := keepertest.BTCLightClientKeeper(t)
+		_, chain := datagen.GenRandBtcChainInsertingInKeeper(
+			t,
+			r,
+			blcKeeper,
+			ctx,
+			0,
+			datagen.RandomInt(r, 20)+10,
+		)
+		knownHashes := hashesOf(chain.Headers)
+		requireChainInvariants(t, ctx, blcKeeper, knownHashes)
+
+		numOps := int(datagen.RandomInt(r, 30)) + 10
+		for i := 0; i < numOps; i++ {
+			tip := blcKeeper.GetTipInfo(ctx)
+			base := blcKeeper.GetBaseBTCHeader(ctx)
+
+			var (
+				headers    []*wire.BlockHeader
+				expSuccess bool
+			)
+			switch r.Intn(4) {
+			case 0:
+				// valid extension of the tip
+				headers = datagen.GenRandomValidChainStartingFrom(
+					r,
+					tip.Height,
+					tip.Header.ToBlockHeader(),
+					nil,
+					uint32(datagen.RandomInt(r, 10)+1),
+				)
+				expSuccess = true
+			case 1:
+				// fork of random length from a random main chain header, which
+				// is accepted only if it has more work than the main chain
+				forkParentHeight := base.Height + datagen.RandomInt(r, int(tip.Height-base.Height+1))
+				forkParent := blcKeeper.GetHeaderByHeight(ctx, forkParentHeight)
+				require.NotNil(t, forkParent)
+				headers = datagen.GenRandomValidChainStartingFrom(
+					r,
+					forkParent.Height,
+					forkParent.Header.ToBlockHeader(),
+					nil,
+					uint32(datagen.RandomInt(r, int(tip.Height-forkParent.Height)+3)+1),
+				)
+				forkWork := forkParent.Work.Add(*chainWork(headers))
+				expSuccess = forkParent.Height == tip.Height || forkWork.GT(*tip.Work)
+			case 2:
+				// duplicate headers of the main chain, which have no more work
+				// than the main chain
+				if tip.Height == base.Height {
+					continue
+				}
+				startHeight := base.Height + 1 + datagen.RandomInt(r, int(tip.Height-base.Height))
+				for _, headerInfo := range blcKeeper.GetMainChainFrom(ctx, startHeight) {
+					headers = append(headers, headerInfo.Header.ToBlockHeader())
+				}
+				expSuccess = false
+			case 3:
+				// extension of the tip with a header that does not link to its
+				// predecessor. Tampering with other fields, e.g., the nonce,
+				// does not necessarily invalidate the last header, since the
+				// proof of work of test headers is trivial
+				headers = datagen.GenRandomValidChainStartingFrom(
+					r,
+					tip.Height,
+					tip.Header.ToBlockHeader(),
+					nil,
+					uint32(datagen.RandomInt(r, 10)+1),
+				)
+				invalidIdx := r.Intn(len(headers))
+				headers[invalidIdx].PrevBlock = datagen.GenRandomBtcdHash(r)
+				expSuccess = false
+			}
+
+			err := blcKeeper.InsertHeaders(ctx, keepertest.NewBTCHeaderBytesList(headers))
+			newTip := blcKeeper.GetTipInfo(ctx)
+			if expSuccess {
+				require.NoError(t, err)
+				// the tip is the last inserted header
+				lastHash := hashesOf(headers[len(headers)-1:])[0]
+				require.True(t, newTip.Hash.Eq(lastHash))
+				knownHashes = append(knownHashes, hashesOf(headers)...)
+			} else {
+				require.Error(t, err)
+				// the tip does not change upon failed insertion
+				require.True(t, allFieldsEqual(tip, newTip))
+			}
+			// the work of the tip never decreases
+			require.True(t, newTip.Work.GTE(*tip.Work))
+
+			requireChainInvariants(t, ctx, blcKeeper, knownHashes)
+		}
+	})
+}
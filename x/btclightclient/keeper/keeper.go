@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	corestoretypes "cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
 
 	"cosmossdk.io/log"
 	bbn "github.com/babylonchain/babylon/types"
@@ -20,6 +21,7 @@ type (
 	Keeper struct {
 		cdc          codec.BinaryCodec
 		storeService corestoretypes.KVStoreService
+		tsKey        storetypes.StoreKey
 		hooks        types.BTCLightClientHooks
 		btcConfig    bbn.BtcConfig
 		bl           *types.BtcLightClient
@@ -32,6 +34,7 @@ var _ types.BtcChainReadStore = (*headersState)(nil)
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService corestoretypes.KVStoreService,
+	tsKey storetypes.StoreKey,
 	btcConfig bbn.BtcConfig,
 	authority string,
 ) Keeper {
@@ -40,6 +43,7 @@ func NewKeeper(
 	return Keeper{
 		cdc:          cdc,
 		storeService: storeService,
+		tsKey:        tsKey,
 		hooks:        nil,
 		btcConfig:    btcConfig,
 		bl:           bl,
@@ -77,6 +81,35 @@ func (k Keeper) insertHeaders(
 		return err
 	}
 
+	k.applyInsertResult(ctx, headerState, result)
+	return nil
+}
+
+// insertMockHeaders inserts the given synthetic headers without checking
+// their proof of work, difficulty and timestamps. It is only used in mock BTC
+// mode
+func (k Keeper) insertMockHeaders(
+	ctx context.Context,
+	headers []*wire.BlockHeader,
+) error {
+	headerState := k.headersState(ctx)
+
+	result, err := k.bl.InsertMockHeaders(
+		headerState,
+		headers,
+	)
+	if err != nil {
+		return err
+	}
+
+	k.applyInsertResult(ctx, headerState, result)
+	return nil
+}
+
+// applyInsertResult rolls back the header chain if needed and inserts the new
+// headers of the given result of the light client, triggering the
+// corresponding hooks
+func (k Keeper) applyInsertResult(ctx context.Context, headerState headersState, result *types.InsertResult) {
 	// if we have rollback, first delete all headers up to the rollback point
 	if result.RollbackInfo != nil {
 		// roll back to the height
@@ -91,7 +124,6 @@ func (k Keeper) insertHeaders(
 		k.triggerHeaderInserted(ctx, h)
 		k.triggerRollForward(ctx, h)
 	}
-	return nil
 }
 
 // InsertHeaderInfos inserts multiple headers info at the store.
@@ -115,6 +147,25 @@ func (k Keeper) InsertHeaders(ctx context.Context, headers []bbn.BTCHeaderBytes)
 	return k.insertHeaders(ctx, blockHeaders)
 }
 
+// InsertMockHeaders inserts the given synthetic headers without checking their
+// proof of work, difficulty and timestamps. It is only allowed in mock BTC
+// mode, which cannot be enabled on mainnet
+func (k Keeper) InsertMockHeaders(ctx context.Context, headers []bbn.BTCHeaderBytes) error {
+	if len(headers) == 0 {
+		return types.ErrEmptyMessage
+	}
+	if k.GetParams(ctx).MockBtcReporter == "" || k.isMainnet(ctx) {
+		return types.ErrMockBTCModeNotAllowed
+	}
+
+	blockHeaders := make([]*wire.BlockHeader, len(headers))
+	for i, header := range headers {
+		blockHeaders[i] = header.ToBlockHeader()
+	}
+
+	return k.insertMockHeaders(ctx, blockHeaders)
+}
+
 // BlockHeight returns the height of the provided header
 func (k Keeper) BlockHeight(ctx context.Context, headerHash *bbn.BTCHeaderHashBytes) (uint64, error) {
 	if headerHash == nil {
@@ -192,6 +243,22 @@ func (k Keeper) GetMainChainFrom(ctx context.Context, startHeight uint64) []*typ
 	return headers
 }
 
+// GetMainChainInRange returns the current canonical chain from the given start
+// height up to the given end height (inclusive)
+// If the start height is higher than the tip, it returns an empty slice
+func (k Keeper) GetMainChainInRange(ctx context.Context, startHeight, endHeight uint64) []*types.BTCHeaderInfo {
+	headers := make([]*types.BTCHeaderInfo, 0)
+	accHeaderFn := func(header *types.BTCHeaderInfo) bool {
+		if header.Height > endHeight {
+			return true
+		}
+		headers = append(headers, header)
+		return false
+	}
+	k.headersState(ctx).IterateForwardHeaders(startHeight, accHeaderFn)
+	return headers
+}
+
 // GetMainChainUpTo returns the current canonical chain as a collection of block headers
 // starting from the tip and ending on the header that has `depth` distance from it.
 func (k Keeper) GetMainChainUpTo(ctx context.Context, depth uint64) []*types.BTCHeaderInfo {
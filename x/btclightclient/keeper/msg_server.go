@@ -43,15 +43,36 @@ func (m msgServer) InsertHeaders(ctx context.Context, msg *types.MsgInsertHeader
 
 	reporterAddress := msg.ReporterAddress()
 
+	// in mock BTC mode, the mock BTC reporter inserts synthetic headers
+	// without proof of work
+	params := m.k.GetParams(sdkCtx)
+	if params.IsMockBTCReporter(reporterAddress) {
+		if err := m.k.InsertMockHeaders(sdkCtx, msg.Headers); err != nil {
+			return nil, err
+		}
+		return &types.MsgInsertHeadersResponse{}, nil
+	}
+
 	if !m.canInsertHeaders(sdkCtx, reporterAddress) {
 		return nil, types.ErrUnauthorizedReporter.Wrapf("reporter %s is not authorized to insert headers", reporterAddress)
 	}
 
+	// griefing protection against permissionless reporters
+	if err := m.k.checkReporterRateLimit(sdkCtx, &params, reporterAddress); err != nil {
+		return nil, err
+	}
+	if err := m.k.checkForkDepth(sdkCtx, &params, msg.Headers[0].ToBlockHeader()); err != nil {
+		return nil, err
+	}
+
 	err := m.k.InsertHeaders(sdkCtx, msg.Headers)
 
 	if err != nil {
 		return nil, err
 	}
+
+	m.k.incReporterNumMsgs(sdkCtx, reporterAddress)
+
 	return &types.MsgInsertHeadersResponse{}, nil
 }
 
@@ -6,7 +6,10 @@ import (
 	"testing"
 	"time"
 
+	storetypes "cosmossdk.io/store/types"
 	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/babylonchain/babylon/testutil/keeper"
@@ -227,3 +230,171 @@ func TestAllowUpdatesOnlyFromReportesInTheList(t *testing.T) {
 	_, err = srv.InsertHeaders(sdkCtx, msg1)
 	require.NoError(t, err)
 }
+
+func TestRejectTooDeepFork(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	sender := secp256k1.GenPrivKey()
+	address, err := sdk.AccAddressFromHexUnsafe(sender.PubKey().Address().String())
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.MaxForkDepth = 5
+
+	srv, blcKeeper, sdkCtx := setupMsgServerWithCustomParams(t, params)
+	ctx := sdk.UnwrapSDKContext(sdkCtx)
+
+	_, chain := datagen.GenRandBtcChainInsertingInKeeper(
+		t,
+		r,
+		blcKeeper,
+		ctx,
+		0,
+		20,
+	)
+	initTip := chain.GetTipInfo()
+
+	// fork that is deeper than the max fork depth is rejected, even if it
+	// has more work than the current chain
+	deepForkHeader := blcKeeper.GetHeaderByHeight(ctx, initTip.Height-uint64(params.MaxForkDepth)-1)
+	require.NotNil(t, deepForkHeader)
+	deepFork := datagen.GenRandomValidChainStartingFrom(
+		r,
+		deepForkHeader.Height,
+		deepForkHeader.Header.ToBlockHeader(),
+		nil,
+		params.MaxForkDepth+10,
+	)
+	msg := &types.MsgInsertHeaders{Signer: address.String(), Headers: keepertest.NewBTCHeaderBytesList(deepFork)}
+	_, err = srv.InsertHeaders(sdkCtx, msg)
+	require.ErrorIs(t, err, types.ErrForkTooDeep)
+
+	// fork within the max fork depth is accepted
+	forkHeader := blcKeeper.GetHeaderByHeight(ctx, initTip.Height-uint64(params.MaxForkDepth))
+	require.NotNil(t, forkHeader)
+	fork := datagen.GenRandomValidChainStartingFrom(
+		r,
+		forkHeader.Height,
+		forkHeader.Header.ToBlockHeader(),
+		nil,
+		params.MaxForkDepth+10,
+	)
+	msg = &types.MsgInsertHeaders{Signer: address.String(), Headers: keepertest.NewBTCHeaderBytesList(fork)}
+	_, err = srv.InsertHeaders(sdkCtx, msg)
+	require.NoError(t, err)
+}
+
+func TestReporterRateLimit(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	sender1 := secp256k1.GenPrivKey()
+	address1, err := sdk.AccAddressFromHexUnsafe(sender1.PubKey().Address().String())
+	require.NoError(t, err)
+	sender2 := secp256k1.GenPrivKey()
+	address2, err := sdk.AccAddressFromHexUnsafe(sender2.PubKey().Address().String())
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.MaxMsgsPerReporterPerBlock = 2
+
+	srv, blcKeeper, sdkCtx := setupMsgServerWithCustomParams(t, params)
+	ctx := sdk.UnwrapSDKContext(sdkCtx)
+
+	_, _ = datagen.GenRandBtcChainInsertingInKeeper(
+		t,
+		r,
+		blcKeeper,
+		ctx,
+		0,
+		10,
+	)
+
+	insertExtension := func(ctx sdk.Context, addr sdk.AccAddress) error {
+		tip := blcKeeper.GetTipInfo(ctx)
+		chainExtension := datagen.GenRandomValidChainStartingFrom(
+			r,
+			tip.Height,
+			tip.Header.ToBlockHeader(),
+			nil,
+			1,
+		)
+		msg := &types.MsgInsertHeaders{Signer: addr.String(), Headers: keepertest.NewBTCHeaderBytesList(chainExtension)}
+		_, err := srv.InsertHeaders(ctx, msg)
+		return err
+	}
+
+	// sender 1 can submit up to the max number of messages in a block
+	for i := uint32(0); i < params.MaxMsgsPerReporterPerBlock; i++ {
+		require.NoError(t, insertExtension(ctx, address1))
+	}
+	require.ErrorIs(t, insertExtension(ctx, address1), types.ErrReporterRateLimited)
+
+	// sender 2 is not affected by the rate limit of sender 1
+	require.NoError(t, insertExtension(ctx, address2))
+
+	// sender 1 can submit messages again in the next block, as committing
+	// the block clears the transient store of the message counters
+	ctx.MultiStore().(storetypes.CommitMultiStore).Commit()
+	ctx = datagen.WithCtxHeight(ctx, uint64(ctx.HeaderInfo().Height)+1)
+	require.NoError(t, insertExtension(ctx, address1))
+}
+
+func TestMockBTCReporter(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	mockSender := secp256k1.GenPrivKey()
+	mockAddress, err := sdk.AccAddressFromHexUnsafe(mockSender.PubKey().Address().String())
+	require.NoError(t, err)
+	sender := secp256k1.GenPrivKey()
+	address, err := sdk.AccAddressFromHexUnsafe(sender.PubKey().Address().String())
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.MockBtcReporter = mockAddress.String()
+
+	srv, blcKeeper, sdkCtx := setupMsgServerWithCustomParams(t, params)
+	ctx := sdk.UnwrapSDKContext(sdkCtx)
+
+	_, chain := datagen.GenRandBtcChainInsertingInKeeper(
+		t,
+		r,
+		blcKeeper,
+		ctx,
+		0,
+		10,
+	)
+	initTip := chain.GetTipInfo()
+
+	// synthetic headers whose difficulty does not follow the chain and
+	// whose hashes do not meet their targets
+	mockHeaders := make([]*wire.BlockHeader, 5)
+	prevHeader := initTip.Header.ToBlockHeader()
+	for i := range mockHeaders {
+		mockHeaders[i] = &wire.BlockHeader{
+			Version:    prevHeader.Version,
+			PrevBlock:  prevHeader.BlockHash(),
+			MerkleRoot: chainhash.HashH(datagen.GenRandomByteArray(r, 32)),
+			Timestamp:  prevHeader.Timestamp.Add(10 * time.Minute),
+			Bits:       0x1d00ffff,
+			Nonce:      r.Uint32(),
+		}
+		prevHeader = mockHeaders[i]
+	}
+
+	// other reporters cannot insert synthetic headers
+	msg := &types.MsgInsertHeaders{Signer: address.String(), Headers: keepertest.NewBTCHeaderBytesList(mockHeaders)}
+	_, err = srv.InsertHeaders(sdkCtx, msg)
+	require.Error(t, err)
+
+	// the mock BTC reporter can insert synthetic headers
+	msg = &types.MsgInsertHeaders{Signer: mockAddress.String(), Headers: keepertest.NewBTCHeaderBytesList(mockHeaders)}
+	_, err = srv.InsertHeaders(sdkCtx, msg)
+	require.NoError(t, err)
+	newTip := blcKeeper.GetTipInfo(ctx)
+	require.Equal(t, initTip.Height+uint64(len(mockHeaders)), newTip.Height)
+	require.Equal(t, prevHeader.BlockHash(), *newTip.Hash.ToChainhash())
+
+	// mock BTC mode cannot be enabled on mainnet chain IDs
+	mainnetCtx := ctx.WithChainID(types.MainnetChainIDs[0])
+	err = blcKeeper.SetParams(mainnetCtx, params)
+	require.ErrorIs(t, err, types.ErrMockBTCModeNotAllowed)
+	err = blcKeeper.InsertMockHeaders(mainnetCtx, keepertest.NewBTCHeaderBytesList(mockHeaders))
+	require.ErrorIs(t, err, types.ErrMockBTCModeNotAllowed)
+}
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/babylonchain/babylon/x/btclightclient/types"
 )
 
@@ -11,6 +14,9 @@ func (k Keeper) SetParams(ctx context.Context, p types.Params) error {
 	if err := p.Validate(); err != nil {
 		return err
 	}
+	if p.MockBtcReporter != "" && k.isMainnet(ctx) {
+		return types.ErrMockBTCModeNotAllowed.Wrapf("chain ID %s, BTC network %s", sdk.UnwrapSDKContext(ctx).ChainID(), k.btcConfig.NetParams().Name)
+	}
 	store := k.storeService.OpenKVStore(ctx)
 	bz := k.cdc.MustMarshal(&p)
 	return store.Set(types.ParamsKey, bz)
@@ -29,3 +35,12 @@ func (k Keeper) GetParams(ctx context.Context) (p types.Params) {
 	k.cdc.MustUnmarshal(bz, &p)
 	return p
 }
+
+// isMainnet returns whether Babylon operates on BTC mainnet or on a Babylon
+// mainnet chain ID, where mock BTC mode is not allowed
+func (k Keeper) isMainnet(ctx context.Context) bool {
+	if k.btcConfig.NetParams().Net == wire.MainNet {
+		return true
+	}
+	return types.IsMainnetChainID(sdk.UnwrapSDKContext(ctx).ChainID())
+}
@@ -0,0 +1,41 @@
+package v2
+
+import (
+	corestoretypes "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The
+// parameters stored by v1 predate the griefing limits, which are thus zero
+// after decoding and would leave MsgInsertHeaders unprotected, as zero
+// disables them. The migration sets the griefing limits that are unset to
+// their default values, and it keeps all other parameters as they are.
+func MigrateStore(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	store := storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.ParamsKey)
+	if err != nil {
+		return err
+	}
+
+	var params types.Params
+	if bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	if params.MaxForkDepth == 0 {
+		params.MaxForkDepth = types.DefaultMaxForkDepth
+	}
+	if params.MaxMsgsPerReporterPerBlock == 0 {
+		params.MaxMsgsPerReporterPerBlock = types.DefaultMaxMsgsPerReporterPerBlock
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	return store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+}
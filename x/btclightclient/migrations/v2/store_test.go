@@ -0,0 +1,77 @@
+package v2_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	v2 "github.com/babylonchain/babylon/x/btclightclient/migrations/v2"
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+)
+
+func FuzzMigrateStore(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+		db := dbm.NewMemDB()
+		stateStore := store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
+		stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+		require.NoError(t, stateStore.LoadLatestVersion())
+		ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
+		storeService := runtime.NewKVStoreService(storeKey)
+		cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+		kvStore := storeService.OpenKVStore(ctx)
+
+		// v1 params only carry the parameters preceding the griefing limits
+		v1Params := types.Params{}
+		numAllowed := int(datagen.RandomInt(r, 3))
+		for i := 0; i < numAllowed; i++ {
+			addr := sdk.AccAddress(datagen.GenRandomByteArray(r, 20))
+			v1Params.InsertHeadersAllowList = append(v1Params.InsertHeadersAllowList, addr.String())
+		}
+		if datagen.OneInN(r, 2) {
+			v1Params.MockBtcReporter = sdk.AccAddress(datagen.GenRandomByteArray(r, 20)).String()
+		}
+		require.NoError(t, kvStore.Set(types.ParamsKey, cdc.MustMarshal(&v1Params)))
+
+		require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+
+		bz, err := kvStore.Get(types.ParamsKey)
+		require.NoError(t, err)
+		var params types.Params
+		cdc.MustUnmarshal(bz, &params)
+		require.NoError(t, params.Validate())
+
+		// griefing limits are set to their defaults while the others are kept
+		expectedParams := v1Params
+		expectedParams.MaxForkDepth = types.DefaultMaxForkDepth
+		expectedParams.MaxMsgsPerReporterPerBlock = types.DefaultMaxMsgsPerReporterPerBlock
+		require.Equal(t, expectedParams, params)
+
+		// griefing limits that are already set are kept as well
+		params.MaxForkDepth = uint32(datagen.RandomInt(r, 1000)) + 1
+		params.MaxMsgsPerReporterPerBlock = uint32(datagen.RandomInt(r, 1000)) + 1
+		require.NoError(t, kvStore.Set(types.ParamsKey, cdc.MustMarshal(&params)))
+		require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+		bz, err = kvStore.Get(types.ParamsKey)
+		require.NoError(t, err)
+		var migratedParams types.Params
+		cdc.MustUnmarshal(bz, &migratedParams)
+		require.Equal(t, params, migratedParams)
+	})
+}
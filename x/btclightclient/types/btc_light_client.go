@@ -302,19 +302,22 @@ func (s *storeWithExtensionChain) getHeaderAtHeight(height uint64) *localHeaderI
 func (l *BtcLightClient) processNewHeadersChain(
 	store *storeWithExtensionChain,
 	chainParent *localHeaderInfo,
-	chain []*wire.BlockHeader) error {
+	chain []*wire.BlockHeader,
+	checkHeaders bool) error {
 	// init info about parent as current tip
 	parentHeaderInfo := chainParent
 
 	for _, blockHeader := range chain {
 		h := blockHeader
 
-		err := l.checkHeader(
-			store, parentHeaderInfo, h,
-		)
+		if checkHeaders {
+			err := l.checkHeader(
+				store, parentHeaderInfo, h,
+			)
 
-		if err != nil {
-			return fmt.Errorf("provided header contains invalid header. Error msg: %s: %w", err.Error(), ErrInvalidHeader)
+			if err != nil {
+				return fmt.Errorf("provided header contains invalid header. Error msg: %s: %w", err.Error(), ErrInvalidHeader)
+			}
 		}
 
 		childWork := CalcHeaderWork(h)
@@ -341,6 +344,18 @@ type InsertResult struct {
 }
 
 func (l *BtcLightClient) InsertHeaders(readStore BtcChainReadStore, headers []*wire.BlockHeader) (*InsertResult, error) {
+	return l.insertHeaders(readStore, headers, true)
+}
+
+// InsertMockHeaders is the same as InsertHeaders, except that it does not
+// check the proof of work, difficulty and timestamps of the headers. It is
+// only used in mock BTC mode, where synthetic headers are inserted on devnets
+// without a real BTC network
+func (l *BtcLightClient) InsertMockHeaders(readStore BtcChainReadStore, headers []*wire.BlockHeader) (*InsertResult, error) {
+	return l.insertHeaders(readStore, headers, false)
+}
+
+func (l *BtcLightClient) insertHeaders(readStore BtcChainReadStore, headers []*wire.BlockHeader, checkHeaders bool) (*InsertResult, error) {
 	headersLen := len(headers)
 	if headersLen == 0 {
 		return nil, fmt.Errorf("cannot insert empty headers")
@@ -364,7 +379,7 @@ func (l *BtcLightClient) InsertHeaders(readStore BtcChainReadStore, headers []*w
 
 	if firstHeaderOfExtensionChain.PrevBlock.IsEqual(&currentTipHash) {
 		// most common case - extending of current tip
-		if err := l.processNewHeadersChain(store, currentTip, headers); err != nil {
+		if err := l.processNewHeadersChain(store, currentTip, headers, checkHeaders); err != nil {
 			return nil, err
 		}
 
@@ -383,7 +398,7 @@ func (l *BtcLightClient) InsertHeaders(readStore BtcChainReadStore, headers []*w
 
 		forkParentInfo := toLocalInfo(forkParent)
 
-		if err := l.processNewHeadersChain(store, forkParentInfo, headers); err != nil {
+		if err := l.processNewHeadersChain(store, forkParentInfo, headers, checkHeaders); err != nil {
 			return nil, err
 		}
 
@@ -7,7 +7,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
-func RegisterCodec(_ *codec.LegacyAmino) {
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgInsertHeaders{}, "btclightclient/MsgInsertHeaders", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "btclightclient/MsgUpdateParams", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -15,6 +17,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	// Register messages
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgInsertHeaders{},
+		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
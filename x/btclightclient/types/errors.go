@@ -16,4 +16,7 @@ var (
 	ErrChainWithNotEnoughWork   = errorsmod.Register(ModuleName, 1105, "provided chain has not enough work")
 	ErrUnauthorizedReporter     = errorsmod.Register(ModuleName, 1106, "unauthorized reporter")
 	ErrInvalidMessageFormat     = errorsmod.Register(ModuleName, 1107, "invalid message format")
+	ErrForkTooDeep              = errorsmod.Register(ModuleName, 1108, "provided chain forks too deep from the current tip")
+	ErrReporterRateLimited      = errorsmod.Register(ModuleName, 1109, "reporter exceeded the maximum number of messages per block")
+	ErrMockBTCModeNotAllowed    = errorsmod.Register(ModuleName, 1110, "mock BTC mode cannot be enabled on mainnet")
 )
@@ -0,0 +1,209 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	sdkmath "cosmossdk.io/math"
+	bbn "github.com/babylonchain/babylon/types"
+)
+
+// HeadersExportVersion is the version of the binary format of BTC headers
+// exports
+const HeadersExportVersion = 1
+
+// HeadersExportManifest describes a binary export of a range of BTC headers on
+// the canonical chain. The binary export is the concatenation of the raw
+// 80-byte headers in ascending order of height, and the manifest carries the
+// information that cannot be derived from the raw headers, i.e., the heights
+// and the cumulative work of the headers.
+type HeadersExportManifest struct {
+	// Version is the version of the binary format
+	Version uint32 `json:"version"`
+	// StartHeight is the height of the first header in the export
+	StartHeight uint64 `json:"start_height"`
+	// EndHeight is the height of the last header in the export
+	EndHeight uint64 `json:"end_height"`
+	// NumHeaders is the number of headers in the export
+	NumHeaders uint64 `json:"num_headers"`
+	// StartWork is the cumulative work of the first header in the export. The
+	// cumulative work of each following header is the cumulative work of its
+	// parent plus its own work
+	StartWork *sdkmath.Uint `json:"start_work"`
+	// Chunks are the chunks of the export, each carrying the cumulative work
+	// of its last header as a checkpoint
+	Chunks []*HeadersExportChunk `json:"chunks"`
+}
+
+// HeadersExportChunk describes a contiguous range of headers in a binary
+// export of BTC headers
+type HeadersExportChunk struct {
+	// StartHeight is the height of the first header in the chunk
+	StartHeight uint64 `json:"start_height"`
+	// EndHeight is the height of the last header in the chunk
+	EndHeight uint64 `json:"end_height"`
+	// Offset is the offset in bytes of the first header of the chunk in the
+	// binary export
+	Offset uint64 `json:"offset"`
+	// LastHeaderHash is the hex-encoded hash of the last header in the chunk
+	LastHeaderHash string `json:"last_header_hash"`
+	// CumulativeWork is the cumulative work of the last header in the chunk
+	CumulativeWork *sdkmath.Uint `json:"cumulative_work"`
+}
+
+// HeadersExportWriter writes a binary export of BTC headers chunk by chunk,
+// and builds the manifest of the export along the way
+type HeadersExportWriter struct {
+	w        io.Writer
+	manifest *HeadersExportManifest
+	offset   uint64
+	last     *BTCHeaderInfo
+}
+
+// NewHeadersExportWriter creates a HeadersExportWriter writing to the given
+// writer
+func NewHeadersExportWriter(w io.Writer) *HeadersExportWriter {
+	return &HeadersExportWriter{
+		w: w,
+		manifest: &HeadersExportManifest{
+			Version: HeadersExportVersion,
+			Chunks:  []*HeadersExportChunk{},
+		},
+	}
+}
+
+// WriteChunk writes the given headers as a new chunk of the export. The headers
+// have to be in ascending order of height, and extend the headers written so
+// far.
+func (ew *HeadersExportWriter) WriteChunk(headers []*BTCHeaderInfo) error {
+	if len(headers) == 0 {
+		return errors.New("empty chunk of headers")
+	}
+
+	chunkOffset := ew.offset
+	for _, header := range headers {
+		if err := header.Validate(); err != nil {
+			return fmt.Errorf("invalid header at height %d: %w", header.Height, err)
+		}
+		if ew.last == nil {
+			ew.manifest.StartHeight = header.Height
+			ew.manifest.StartWork = header.Work
+		} else if header.Height != ew.last.Height+1 || !header.HasParent(ew.last) {
+			return fmt.Errorf("header at height %d does not extend the header at height %d", header.Height, ew.last.Height)
+		}
+
+		if _, err := ew.w.Write(header.Header.MustMarshal()); err != nil {
+			return err
+		}
+		ew.offset += bbn.BTCHeaderLen
+		ew.last = header
+	}
+
+	ew.manifest.EndHeight = ew.last.Height
+	ew.manifest.NumHeaders += uint64(len(headers))
+	ew.manifest.Chunks = append(ew.manifest.Chunks, &HeadersExportChunk{
+		StartHeight:    headers[0].Height,
+		EndHeight:      ew.last.Height,
+		Offset:         chunkOffset,
+		LastHeaderHash: ew.last.Hash.MarshalHex(),
+		CumulativeWork: ew.last.Work,
+	})
+	return nil
+}
+
+// Manifest returns the manifest of the headers written so far
+func (ew *HeadersExportWriter) Manifest() *HeadersExportManifest {
+	return ew.manifest
+}
+
+// Validate performs basic validation of the manifest
+func (m *HeadersExportManifest) Validate() error {
+	if m.Version != HeadersExportVersion {
+		return fmt.Errorf("unsupported headers export version %d", m.Version)
+	}
+	if m.NumHeaders == 0 {
+		return errors.New("empty headers export")
+	}
+	if m.EndHeight-m.StartHeight+1 != m.NumHeaders {
+		return fmt.Errorf("the number of headers %d does not match the range of heights [%d, %d]", m.NumHeaders, m.StartHeight, m.EndHeight)
+	}
+	if m.StartWork == nil || m.StartWork.IsZero() {
+		return errors.New("start work is zero")
+	}
+
+	nextHeight := m.StartHeight
+	for _, chunk := range m.Chunks {
+		if chunk.StartHeight != nextHeight || chunk.EndHeight < chunk.StartHeight {
+			return fmt.Errorf("chunk [%d, %d] is not contiguous with the previous chunks", chunk.StartHeight, chunk.EndHeight)
+		}
+		if chunk.CumulativeWork == nil {
+			return fmt.Errorf("empty cumulative work of chunk [%d, %d]", chunk.StartHeight, chunk.EndHeight)
+		}
+		if chunk.Offset != (chunk.StartHeight-m.StartHeight)*bbn.BTCHeaderLen {
+			return fmt.Errorf("invalid offset %d of chunk [%d, %d]", chunk.Offset, chunk.StartHeight, chunk.EndHeight)
+		}
+		nextHeight = chunk.EndHeight + 1
+	}
+	if nextHeight != m.EndHeight+1 {
+		return fmt.Errorf("chunks do not cover the range of heights [%d, %d]", m.StartHeight, m.EndHeight)
+	}
+
+	return nil
+}
+
+// ReadHeadersExport reads the binary export of BTC headers described by the
+// given manifest, and reconstructs the headers along with their heights and
+// cumulative work. It verifies that the headers form a chain and match the
+// checkpoints of all chunks in the manifest.
+func ReadHeadersExport(r io.Reader, manifest *HeadersExportManifest) ([]*BTCHeaderInfo, error) {
+	if err := manifest.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid headers export manifest: %w", err)
+	}
+
+	headers := make([]*BTCHeaderInfo, 0, manifest.NumHeaders)
+	var last *BTCHeaderInfo
+	for _, chunk := range manifest.Chunks {
+		for height := chunk.StartHeight; height <= chunk.EndHeight; height++ {
+			bz := make([]byte, bbn.BTCHeaderLen)
+			if _, err := io.ReadFull(r, bz); err != nil {
+				return nil, fmt.Errorf("failed to read header at height %d: %w", height, err)
+			}
+			header, err := bbn.NewBTCHeaderBytesFromBytes(bz)
+			if err != nil {
+				return nil, fmt.Errorf("invalid header at height %d: %w", height, err)
+			}
+
+			work := *manifest.StartWork
+			if last != nil {
+				if !header.HasParent(last.Header) {
+					return nil, fmt.Errorf("header at height %d does not extend the header at height %d", height, last.Height)
+				}
+				work = CumulativeWork(CalcWork(&header), *last.Work)
+			}
+			info := NewBTCHeaderInfo(&header, header.Hash(), height, &work)
+			headers = append(headers, info)
+			last = info
+		}
+
+		if last.Hash.MarshalHex() != chunk.LastHeaderHash {
+			return nil, fmt.Errorf("the last header of chunk [%d, %d] has hash %s, expected %s", chunk.StartHeight, chunk.EndHeight, last.Hash.MarshalHex(), chunk.LastHeaderHash)
+		}
+		if !last.Work.Equal(*chunk.CumulativeWork) {
+			return nil, fmt.Errorf("the last header of chunk [%d, %d] has cumulative work %s, expected %s", chunk.StartHeight, chunk.EndHeight, last.Work, chunk.CumulativeWork)
+		}
+	}
+
+	// ensure there is no trailing data after the last header
+	if n, _ := r.Read(make([]byte, 1)); n != 0 {
+		return nil, errors.New("unexpected data after the last header")
+	}
+
+	return headers, nil
+}
+
+// HeadersExportManifestPath returns the path of the manifest of the binary
+// export of BTC headers at the given path
+func HeadersExportManifestPath(exportPath string) string {
+	return exportPath + ".manifest.json"
+}
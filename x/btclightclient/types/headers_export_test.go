@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+)
+
+func FuzzHeadersExport(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		startHeight := datagen.RandomInt(r, 1000)
+		length := uint32(datagen.RandomInt(r, 100)) + 1
+		chain := datagen.NewBTCHeaderChainWithLength(r, startHeight, datagen.RandomInt(r, 1000), length)
+		headers := chain.GetChainInfo()
+
+		// write the headers chunk by chunk
+		var buf bytes.Buffer
+		ew := types.NewHeadersExportWriter(&buf)
+		chunkSize := int(datagen.RandomInt(r, 10)) + 1
+		for i := 0; i < len(headers); i += chunkSize {
+			end := i + chunkSize
+			if end > len(headers) {
+				end = len(headers)
+			}
+			err := ew.WriteChunk(headers[i:end])
+			require.NoError(t, err)
+		}
+		exported := buf.Bytes()
+		require.Len(t, exported, len(headers)*80)
+
+		// the manifest survives a JSON roundtrip
+		manifestBytes, err := json.Marshal(ew.Manifest())
+		require.NoError(t, err)
+		var manifest types.HeadersExportManifest
+		err = json.Unmarshal(manifestBytes, &manifest)
+		require.NoError(t, err)
+		require.Equal(t, startHeight, manifest.StartHeight)
+		require.Equal(t, headers[len(headers)-1].Height, manifest.EndHeight)
+		require.Equal(t, uint64(len(headers)), manifest.NumHeaders)
+
+		// the headers read back from the export are the written ones
+		readHeaders, err := types.ReadHeadersExport(bytes.NewReader(exported), &manifest)
+		require.NoError(t, err)
+		require.Len(t, readHeaders, len(headers))
+		for i := range headers {
+			require.True(t, headers[i].Eq(readHeaders[i]))
+			require.Equal(t, headers[i].Height, readHeaders[i].Height)
+			require.True(t, headers[i].Work.Equal(*readHeaders[i].Work))
+		}
+
+		// a truncated export is rejected
+		_, err = types.ReadHeadersExport(bytes.NewReader(exported[:len(exported)-1]), &manifest)
+		require.Error(t, err)
+		// an export with trailing data is rejected
+		_, err = types.ReadHeadersExport(bytes.NewReader(append(exported, 0)), &manifest)
+		require.Error(t, err)
+		// a non-contiguous chunk is rejected
+		err = ew.WriteChunk(headers[:1])
+		require.Error(t, err)
+	})
+}
@@ -20,12 +20,16 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_btclightclient"
+
+	// TStoreKey defines the transient store key
+	TStoreKey = "transient_btclightclient"
 )
 
 var (
 	HeadersObjectPrefix = []byte{0x01} // reserve this namespace mapping: Height -> BTCHeaderInfo
 	HashToHeightPrefix  = []byte{0x02} // reserve this namespace mapping: Hash -> Height
 	ParamsKey           = []byte{0x03} // key for params
+	ReporterMsgsPrefix  = []byte{0x04} // reserve this namespace mapping in the transient store: Reporter address -> number of messages
 )
 
 func HeadersObjectKey(height uint64) []byte {
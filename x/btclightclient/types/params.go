@@ -3,9 +3,34 @@ package types
 import (
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	// DefaultMaxForkDepth is the default maximum depth of a fork below the
+	// current tip, which matches the default BTC checkpoint finalization
+	// timeout, beyond which BTC reorgs break BTC checkpoints anyway
+	DefaultMaxForkDepth uint32 = 100
+	// DefaultMaxMsgsPerReporterPerBlock is the default maximum number of
+	// MsgInsertHeaders a single reporter can submit within a Babylon block
+	DefaultMaxMsgsPerReporterPerBlock uint32 = 20
+)
+
+// MainnetChainIDs are the chain IDs of Babylon mainnets, on which mock BTC
+// mode cannot be enabled
+var MainnetChainIDs = []string{"bbn-1"}
+
+// IsMainnetChainID returns whether the given chain ID is of a Babylon mainnet
+func IsMainnetChainID(chainID string) bool {
+	for _, id := range MainnetChainIDs {
+		if chainID == id {
+			return true
+		}
+	}
+	return false
+}
+
 // NewParams creates a new Params instance
 func NewParams(allowedAddresses []string) Params {
 	return Params{
@@ -23,9 +48,12 @@ func NewParamsValidate(allowedAddresses []string) (Params, error) {
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(
+	p := NewParams(
 		[]string{},
 	)
+	p.MaxForkDepth = DefaultMaxForkDepth
+	p.MaxMsgsPerReporterPerBlock = DefaultMaxMsgsPerReporterPerBlock
+	return p
 }
 
 func ValidateAddressList(i interface{}) error {
@@ -49,6 +77,11 @@ func (p Params) Validate() error {
 	if err := ValidateAddressList(p.InsertHeadersAllowList); err != nil {
 		return err
 	}
+	if p.MockBtcReporter != "" {
+		if _, err := sdk.AccAddressFromBech32(p.MockBtcReporter); err != nil {
+			return fmt.Errorf("invalid mock BTC reporter: %w", err)
+		}
+	}
 
 	return nil
 }
@@ -56,3 +89,24 @@ func (p Params) Validate() error {
 func (p *Params) AllowAllReporters() bool {
 	return len(p.InsertHeadersAllowList) == 0
 }
+
+// IsMockBTCReporter returns whether mock BTC mode is enabled and the given
+// reporter is allowed to insert synthetic headers in it
+func (p *Params) IsMockBTCReporter(reporter sdk.AccAddress) bool {
+	return p.MockBtcReporter != "" && sdk.MustAccAddressFromBech32(p.MockBtcReporter).Equals(reporter)
+}
+
+// IsForkWorkAllowed returns whether a chain forking from the canonical chain
+// at a header whose cumulative work is the given fork work below the one of
+// the current tip can be inserted, i.e., whether the fork work is at most the
+// work of MaxForkDepth headers at the difficulty of the current tip, whose
+// work is the given tip header work
+func (p *Params) IsForkWorkAllowed(forkWork, tipHeaderWork sdkmath.Uint) bool {
+	return p.MaxForkDepth == 0 || forkWork.LTE(tipHeaderWork.MulUint64(uint64(p.MaxForkDepth)))
+}
+
+// IsReporterRateLimited returns whether a reporter that has already submitted
+// the given number of messages in the current block is rate limited
+func (p *Params) IsReporterRateLimited(numMsgs uint32) bool {
+	return p.MaxMsgsPerReporterPerBlock != 0 && numMsgs >= p.MaxMsgsPerReporterPerBlock
+}
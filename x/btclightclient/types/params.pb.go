@@ -28,6 +28,23 @@ type Params struct {
 	// List of addresses which are allowed to insert headers to btc light client
 	// if the list is empty, any address can insert headers
 	InsertHeadersAllowList []string `protobuf:"bytes,1,rep,name=insert_headers_allow_list,json=insertHeadersAllowList,proto3" json:"insert_headers_allow_list,omitempty"`
+	// max_fork_depth is the maximum depth below the current tip at which a chain
+	// submitted via MsgInsertHeaders can fork from the canonical chain. The
+	// depth is measured in work, i.e., the cumulative work of the canonical
+	// headers above the fork point can be at most the work of max_fork_depth
+	// headers at the difficulty of the current tip. This bounds the work of
+	// processing alternative chains submitted by permissionless reporters.
+	// If it is 0, forks of any depth are accepted
+	MaxForkDepth uint32 `protobuf:"varint,2,opt,name=max_fork_depth,json=maxForkDepth,proto3" json:"max_fork_depth,omitempty"`
+	// max_msgs_per_reporter_per_block is the maximum number of MsgInsertHeaders
+	// a single reporter can successfully submit within a Babylon block.
+	// If it is 0, the number of messages is not limited
+	MaxMsgsPerReporterPerBlock uint32 `protobuf:"varint,3,opt,name=max_msgs_per_reporter_per_block,json=maxMsgsPerReporterPerBlock,proto3" json:"max_msgs_per_reporter_per_block,omitempty"`
+	// mock_btc_reporter is the address allowed to insert synthetic headers
+	// without proof of work and difficulty checks, for devnets without a real
+	// BTC network. Mock BTC mode is disabled if it is empty. It cannot be
+	// enabled on BTC mainnet or on Babylon mainnet chain IDs
+	MockBtcReporter string `protobuf:"bytes,4,opt,name=mock_btc_reporter,json=mockBtcReporter,proto3" json:"mock_btc_reporter,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -70,6 +87,27 @@ func (m *Params) GetInsertHeadersAllowList() []string {
 	return nil
 }
 
+func (m *Params) GetMaxForkDepth() uint32 {
+	if m != nil {
+		return m.MaxForkDepth
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMsgsPerReporterPerBlock() uint32 {
+	if m != nil {
+		return m.MaxMsgsPerReporterPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMockBtcReporter() string {
+	if m != nil {
+		return m.MockBtcReporter
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.btclightclient.v1.Params")
 }
@@ -79,21 +117,28 @@ func init() {
 }
 
 var fileDescriptor_1e4c5f7a17079e1f = []byte{
-	// 211 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0xce, 0xc9, 0x4c, 0xcf, 0x00, 0x91, 0xa9, 0x79, 0x25,
-	0xfa, 0x65, 0x86, 0xfa, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0x92, 0x50, 0x75, 0x7a, 0xa8, 0xea, 0xf4, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3,
-	0xc1, 0xaa, 0xf4, 0x41, 0x2c, 0x88, 0x06, 0x25, 0x4f, 0x2e, 0xb6, 0x00, 0xb0, 0x01, 0x42, 0x96,
-	0x5c, 0x92, 0x99, 0x79, 0xc5, 0xa9, 0x45, 0x25, 0xf1, 0x19, 0xa9, 0x89, 0x29, 0xa9, 0x45, 0xc5,
-	0xf1, 0x89, 0x39, 0x39, 0xf9, 0xe5, 0xf1, 0x39, 0x99, 0xc5, 0x25, 0x12, 0x8c, 0x0a, 0xcc, 0x1a,
-	0x9c, 0x41, 0x62, 0x10, 0x05, 0x1e, 0x10, 0x79, 0x47, 0x90, 0xb4, 0x4f, 0x66, 0x71, 0x89, 0x15,
-	0xcb, 0x8b, 0x05, 0xf2, 0x8c, 0x4e, 0x01, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8,
-	0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7,
-	0x10, 0x65, 0x96, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x75, 0x60,
-	0x72, 0x46, 0x62, 0x66, 0x1e, 0x8c, 0xa3, 0x5f, 0x81, 0xee, 0xaf, 0x92, 0xca, 0x82, 0xd4, 0xe2,
-	0x24, 0x36, 0xb0, 0x1b, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x30, 0xe4, 0x07, 0x29, 0xfe,
-	0x00, 0x00, 0x00,
+	// 323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x4f, 0x4b, 0xc3, 0x30,
+	0x18, 0xc6, 0x17, 0x37, 0x06, 0x2b, 0xfe, 0xc1, 0x22, 0xd2, 0xed, 0xd0, 0x0d, 0x11, 0x19, 0x1e,
+	0x5a, 0x86, 0x20, 0xe8, 0xcd, 0x29, 0xe2, 0x41, 0xa1, 0xf4, 0xe8, 0x25, 0x24, 0x59, 0x6c, 0x4b,
+	0x9b, 0x26, 0x24, 0xaf, 0xb3, 0xfb, 0x16, 0x7e, 0x04, 0x3f, 0x8e, 0xc7, 0x1d, 0x3d, 0x8e, 0xed,
+	0xe2, 0xc7, 0x90, 0x74, 0x9b, 0xa0, 0x97, 0x90, 0xe7, 0x7d, 0x7e, 0xcf, 0x73, 0x78, 0x9c, 0x33,
+	0x4a, 0xe8, 0xac, 0x90, 0x65, 0x48, 0x81, 0x15, 0x59, 0x92, 0xda, 0x97, 0x97, 0x10, 0x4e, 0x47,
+	0xa1, 0x22, 0x9a, 0x08, 0x13, 0x28, 0x2d, 0x41, 0xba, 0xdd, 0x0d, 0x17, 0xfc, 0xe5, 0x82, 0xe9,
+	0xa8, 0x77, 0x94, 0xc8, 0x44, 0xd6, 0x54, 0x68, 0x7f, 0xeb, 0xc0, 0xc9, 0x02, 0x39, 0xed, 0xa8,
+	0x6e, 0x70, 0xaf, 0x9c, 0x6e, 0x56, 0x1a, 0xae, 0x01, 0xa7, 0x9c, 0x4c, 0xb8, 0x36, 0x98, 0x14,
+	0x85, 0x7c, 0xc3, 0x45, 0x66, 0xc0, 0x43, 0x83, 0xe6, 0xb0, 0x13, 0x1f, 0xaf, 0x81, 0x87, 0xb5,
+	0x7f, 0x63, 0xed, 0xc7, 0xcc, 0x80, 0x7b, 0xea, 0xec, 0x0b, 0x52, 0xe1, 0x17, 0xa9, 0x73, 0x3c,
+	0xe1, 0x0a, 0x52, 0x6f, 0x67, 0x80, 0x86, 0x7b, 0xf1, 0xae, 0x20, 0xd5, 0xbd, 0xd4, 0xf9, 0x9d,
+	0xbd, 0xb9, 0xb7, 0x4e, 0xdf, 0x52, 0xc2, 0x24, 0x06, 0x2b, 0xae, 0xb1, 0xe6, 0x4a, 0x6a, 0xe0,
+	0xba, 0x16, 0xb4, 0x90, 0x2c, 0xf7, 0x9a, 0x75, 0xac, 0x27, 0x48, 0xf5, 0x64, 0x12, 0x13, 0x71,
+	0x1d, 0x6f, 0x98, 0x88, 0xeb, 0xb1, 0x25, 0xdc, 0x73, 0xe7, 0x50, 0x48, 0x96, 0x63, 0x0a, 0xec,
+	0xb7, 0xc0, 0x6b, 0x0d, 0xd0, 0xb0, 0x13, 0x1f, 0x58, 0x63, 0x0c, 0x6c, 0x9b, 0xb9, 0x6e, 0x7d,
+	0x7f, 0xf4, 0xd1, 0x38, 0xfa, 0x5c, 0xfa, 0x68, 0xbe, 0xf4, 0xd1, 0x62, 0xe9, 0xa3, 0xf7, 0x95,
+	0xdf, 0x98, 0xaf, 0xfc, 0xc6, 0xd7, 0xca, 0x6f, 0x3c, 0x5f, 0x26, 0x19, 0xa4, 0xaf, 0x34, 0x60,
+	0x52, 0x84, 0x9b, 0xe1, 0x58, 0x4a, 0xb2, 0x72, 0x2b, 0xc2, 0xea, 0xff, 0xde, 0x30, 0x53, 0xdc,
+	0xd0, 0x76, 0xbd, 0xdd, 0xc5, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x63, 0x9d, 0x40, 0x90, 0x96,
+	0x01, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -123,6 +168,15 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.MaxForkDepth != that1.MaxForkDepth {
+		return false
+	}
+	if this.MaxMsgsPerReporterPerBlock != that1.MaxMsgsPerReporterPerBlock {
+		return false
+	}
+	if this.MockBtcReporter != that1.MockBtcReporter {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -145,6 +199,23 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MockBtcReporter) > 0 {
+		i -= len(m.MockBtcReporter)
+		copy(dAtA[i:], m.MockBtcReporter)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MockBtcReporter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxMsgsPerReporterPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMsgsPerReporterPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxForkDepth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxForkDepth))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.InsertHeadersAllowList) > 0 {
 		for iNdEx := len(m.InsertHeadersAllowList) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.InsertHeadersAllowList[iNdEx])
@@ -180,6 +251,16 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.MaxForkDepth != 0 {
+		n += 1 + sovParams(uint64(m.MaxForkDepth))
+	}
+	if m.MaxMsgsPerReporterPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxMsgsPerReporterPerBlock))
+	}
+	l = len(m.MockBtcReporter)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -250,6 +331,76 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.InsertHeadersAllowList = append(m.InsertHeadersAllowList, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxForkDepth", wireType)
+			}
+			m.MaxForkDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxForkDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgsPerReporterPerBlock", wireType)
+			}
+			m.MaxMsgsPerReporterPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMsgsPerReporterPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MockBtcReporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MockBtcReporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
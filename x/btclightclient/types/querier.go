@@ -5,6 +5,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
+// MaxHeadersInRange is the maximum number of headers returned by a single
+// HeadersInRange query
+const MaxHeadersInRange = 1000
+
 // NewQueryHashesRequest creates a new instance of QueryHashesRequest.
 func NewQueryHashesRequest(req *query.PageRequest) *QueryHashesRequest {
 	return &QueryHashesRequest{Pagination: req}
@@ -40,3 +44,7 @@ func NewQueryTipRequest() *QueryTipRequest {
 func NewQueryBaseHeaderRequest() *QueryBaseHeaderRequest {
 	return &QueryBaseHeaderRequest{}
 }
+
+func NewQueryHeadersInRangeRequest(startHeight, endHeight uint64) *QueryHeadersInRangeRequest {
+	return &QueryHeadersInRangeRequest{StartHeight: startHeight, EndHeight: endHeight}
+}
@@ -741,6 +741,111 @@ func (m *QueryHeaderDepthResponse) GetDepth() uint64 {
 	return 0
 }
 
+// QueryHeadersInRangeRequest is the request type for the Query/HeadersInRange
+// RPC method
+type QueryHeadersInRangeRequest struct {
+	// start_height is the height of the first header to return
+	StartHeight uint64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the height of the last header to return. At most
+	// MaxHeadersInRange headers are returned in a single query
+	EndHeight uint64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *QueryHeadersInRangeRequest) Reset()         { *m = QueryHeadersInRangeRequest{} }
+func (m *QueryHeadersInRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHeadersInRangeRequest) ProtoMessage()    {}
+func (*QueryHeadersInRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{16}
+}
+func (m *QueryHeadersInRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeadersInRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeadersInRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHeadersInRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeadersInRangeRequest.Merge(m, src)
+}
+func (m *QueryHeadersInRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeadersInRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeadersInRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeadersInRangeRequest proto.InternalMessageInfo
+
+func (m *QueryHeadersInRangeRequest) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *QueryHeadersInRangeRequest) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// QueryHeadersInRangeResponse is the response type for the
+// Query/HeadersInRange RPC method
+type QueryHeadersInRangeResponse struct {
+	// headers are the headers on the canonical chain within the given range of
+	// heights, in ascending order of height
+	Headers []*BTCHeaderInfo `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty"`
+}
+
+func (m *QueryHeadersInRangeResponse) Reset()         { *m = QueryHeadersInRangeResponse{} }
+func (m *QueryHeadersInRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHeadersInRangeResponse) ProtoMessage()    {}
+func (*QueryHeadersInRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{17}
+}
+func (m *QueryHeadersInRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeadersInRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeadersInRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHeadersInRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeadersInRangeResponse.Merge(m, src)
+}
+func (m *QueryHeadersInRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeadersInRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeadersInRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeadersInRangeResponse proto.InternalMessageInfo
+
+func (m *QueryHeadersInRangeResponse) GetHeaders() []*BTCHeaderInfo {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
 // BTCHeaderInfoResponse is a structure that contains all relevant information about a
 // BTC header response
 //   - Full header as string hex.
@@ -761,7 +866,7 @@ func (m *BTCHeaderInfoResponse) Reset()         { *m = BTCHeaderInfoResponse{} }
 func (m *BTCHeaderInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*BTCHeaderInfoResponse) ProtoMessage()    {}
 func (*BTCHeaderInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3961270631e52721, []int{16}
+	return fileDescriptor_3961270631e52721, []int{18}
 }
 func (m *BTCHeaderInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,6 +933,8 @@ func init() {
 	proto.RegisterType((*QueryBaseHeaderResponse)(nil), "babylon.btclightclient.v1.QueryBaseHeaderResponse")
 	proto.RegisterType((*QueryHeaderDepthRequest)(nil), "babylon.btclightclient.v1.QueryHeaderDepthRequest")
 	proto.RegisterType((*QueryHeaderDepthResponse)(nil), "babylon.btclightclient.v1.QueryHeaderDepthResponse")
+	proto.RegisterType((*QueryHeadersInRangeRequest)(nil), "babylon.btclightclient.v1.QueryHeadersInRangeRequest")
+	proto.RegisterType((*QueryHeadersInRangeResponse)(nil), "babylon.btclightclient.v1.QueryHeadersInRangeResponse")
 	proto.RegisterType((*BTCHeaderInfoResponse)(nil), "babylon.btclightclient.v1.BTCHeaderInfoResponse")
 }
 
@@ -836,65 +943,71 @@ func init() {
 }
 
 var fileDescriptor_3961270631e52721 = []byte{
-	// 920 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0x89, 0xeb, 0x26, 0x2f, 0x20, 0x60, 0x48, 0x83, 0xb3, 0x02, 0x27, 0xdd, 0x92,
-	0x1f, 0x4d, 0xf1, 0x4e, 0x9c, 0x00, 0xea, 0x01, 0x09, 0xe1, 0x20, 0x30, 0x48, 0x48, 0xc6, 0x32,
-	0x1c, 0x50, 0xa5, 0x68, 0xec, 0x0c, 0xbb, 0xab, 0xc6, 0x3b, 0x5b, 0xef, 0x26, 0xc4, 0x42, 0x5c,
-	0x38, 0x70, 0x46, 0x70, 0xe3, 0xc0, 0x81, 0x0b, 0x17, 0xe0, 0xd4, 0x3f, 0xa2, 0xc7, 0x0a, 0x2e,
-	0xa8, 0x87, 0x08, 0x25, 0xfc, 0x11, 0x1c, 0xd1, 0xcc, 0xbc, 0xb5, 0xbd, 0x76, 0xea, 0xb5, 0xd5,
-	0x5c, 0xa2, 0xcc, 0xcc, 0x7b, 0xef, 0xfb, 0x79, 0xcf, 0xb3, 0xdf, 0x5d, 0x58, 0x6f, 0xf2, 0x66,
-	0xf7, 0x48, 0x06, 0xac, 0x19, 0xb7, 0x8e, 0x7c, 0xd7, 0x53, 0x7f, 0x45, 0x10, 0xb3, 0x93, 0x32,
-	0x7b, 0x70, 0x2c, 0x3a, 0x5d, 0x27, 0xec, 0xc8, 0x58, 0xd2, 0x15, 0x0c, 0x73, 0xd2, 0x61, 0xce,
-	0x49, 0xd9, 0x5a, 0x72, 0xa5, 0x2b, 0x75, 0x14, 0x53, 0xff, 0x99, 0x04, 0x6b, 0xa5, 0x25, 0xa3,
-	0xb6, 0x8c, 0x0e, 0xcc, 0x81, 0x59, 0xe0, 0xd1, 0xab, 0xae, 0x94, 0xee, 0x91, 0x60, 0x3c, 0xf4,
-	0x19, 0x0f, 0x02, 0x19, 0xf3, 0xd8, 0x97, 0x41, 0x72, 0xba, 0x6d, 0x62, 0x59, 0x93, 0x47, 0xc2,
-	0x20, 0xb0, 0x93, 0x72, 0x53, 0xc4, 0xbc, 0xcc, 0x42, 0xee, 0xfa, 0x81, 0x0e, 0xc6, 0xd8, 0x8d,
-	0xa7, 0xc3, 0x87, 0xbc, 0xc3, 0xdb, 0x58, 0xd3, 0x5e, 0x02, 0xfa, 0xa9, 0xaa, 0x54, 0xd3, 0x9b,
-	0x75, 0xf1, 0xe0, 0x58, 0x44, 0xb1, 0xfd, 0x39, 0xbc, 0x9c, 0xda, 0x8d, 0x42, 0x19, 0x44, 0x82,
-	0xbe, 0x0b, 0x79, 0x93, 0x5c, 0x20, 0x6b, 0x64, 0x6b, 0x71, 0xf7, 0xa6, 0xf3, 0xd4, 0xde, 0x1d,
-	0x93, 0x5a, 0xc9, 0x3d, 0x3a, 0x5b, 0x9d, 0xa9, 0x63, 0x9a, 0x7d, 0x0f, 0xd5, 0xaa, 0x3c, 0xf2,
-	0x44, 0xa2, 0x46, 0x3f, 0x00, 0xe8, 0xf3, 0x63, 0xe9, 0x0d, 0x07, 0x07, 0xa3, 0x9a, 0x75, 0xcc,
-	0xbc, 0xb1, 0x59, 0xa7, 0xc6, 0x5d, 0x81, 0xb9, 0xf5, 0x81, 0x4c, 0xfb, 0x21, 0x41, 0xec, 0xa4,
-	0x3c, 0x62, 0x37, 0x20, 0xef, 0xe9, 0x9d, 0x02, 0x59, 0x9b, 0xdb, 0x7a, 0xae, 0xf2, 0xce, 0x93,
-	0xb3, 0xd5, 0xbb, 0xae, 0x1f, 0x7b, 0xc7, 0x4d, 0xa7, 0x25, 0xdb, 0x0c, 0x9b, 0x68, 0x79, 0xdc,
-	0x0f, 0x92, 0x05, 0x8b, 0xbb, 0xa1, 0x88, 0x9c, 0x4a, 0x63, 0xbf, 0x2a, 0xf8, 0xa1, 0xe8, 0xa8,
-	0x92, 0x95, 0x6e, 0x2c, 0xa2, 0x3a, 0xd6, 0xa2, 0x1f, 0xa6, 0xa8, 0x67, 0x35, 0xf5, 0x66, 0x26,
-	0xb5, 0x41, 0x4a, 0x61, 0x7b, 0xb0, 0xa4, 0xa9, 0xf7, 0x65, 0x10, 0x73, 0x3f, 0xe8, 0x8d, 0xa5,
-	0x06, 0x39, 0x25, 0xa5, 0x07, 0xf2, 0xac, 0xd0, 0xba, 0x92, 0xbd, 0x07, 0x37, 0x86, 0x94, 0x70,
-	0x42, 0x16, 0xcc, 0xb7, 0x70, 0x4f, 0xcb, 0xcd, 0xd7, 0x7b, 0x6b, 0x9b, 0xc1, 0x4a, 0x2a, 0xc9,
-	0x14, 0x44, 0x46, 0x3a, 0xc8, 0x88, 0x2a, 0x77, 0xc1, 0xba, 0x2c, 0x61, 0x02, 0xa9, 0x03, 0xe4,
-	0xfb, 0x84, 0xfb, 0xc1, 0xbe, 0x6a, 0xec, 0xaa, 0x6f, 0xc8, 0xef, 0x04, 0x96, 0x87, 0x15, 0x90,
-	0xeb, 0x63, 0xb8, 0xee, 0xe9, 0xa1, 0x99, 0x5b, 0xb2, 0xb8, 0xbb, 0x33, 0xe6, 0x72, 0xf7, 0x26,
-	0xfc, 0x51, 0xf0, 0xa5, 0xec, 0xfd, 0xa8, 0x49, 0x81, 0xab, 0xbb, 0x1a, 0x2f, 0xc1, 0x0b, 0x1a,
-	0xb7, 0xe1, 0x87, 0xc9, 0xa3, 0x79, 0x0f, 0x5e, 0xec, 0x6f, 0x21, 0x7b, 0x15, 0xf2, 0x46, 0x1a,
-	0x47, 0x33, 0x3d, 0x3a, 0xe6, 0xdb, 0x05, 0x9c, 0x4f, 0x85, 0x47, 0xc2, 0x84, 0x25, 0xba, 0x2d,
-	0x78, 0x65, 0xe4, 0xe4, 0xca, 0xe5, 0x4b, 0x28, 0x62, 0x42, 0xde, 0x17, 0x61, 0xec, 0x5d, 0x76,
-	0xd3, 0x16, 0xf0, 0xa6, 0xed, 0x40, 0x61, 0x34, 0x1c, 0xa1, 0x96, 0xe0, 0xda, 0xa1, 0xda, 0xd0,
-	0x09, 0xb9, 0xba, 0x59, 0xd8, 0xbf, 0x11, 0xb8, 0x71, 0x29, 0x02, 0x7d, 0x0d, 0xc0, 0x40, 0x1c,
-	0x78, 0xe2, 0x14, 0x55, 0x16, 0xcc, 0x4e, 0x55, 0x9c, 0xd2, 0x15, 0x98, 0x57, 0x92, 0xfa, 0x70,
-	0x56, 0x1f, 0x5e, 0x57, 0x6b, 0x75, 0xb4, 0xac, 0xda, 0x57, 0x5d, 0x16, 0xe6, 0xb4, 0x14, 0xae,
-	0xe8, 0x7b, 0x90, 0xfb, 0x4a, 0x76, 0xee, 0x17, 0x72, 0x2a, 0xbc, 0x52, 0x52, 0x46, 0xf8, 0xe4,
-	0x6c, 0x75, 0xd9, 0x5c, 0x83, 0xe8, 0xf0, 0xbe, 0xe3, 0x4b, 0xd6, 0xe6, 0xb1, 0xe7, 0x7c, 0xe6,
-	0x07, 0xf1, 0x9f, 0x0f, 0x4b, 0x8b, 0x78, 0x41, 0xd4, 0xb2, 0xae, 0x53, 0x77, 0xff, 0x5b, 0x80,
-	0x6b, 0xba, 0x43, 0xfa, 0x03, 0x81, 0xbc, 0xb1, 0x54, 0x5a, 0x1a, 0x33, 0xde, 0x51, 0x2f, 0xb7,
-	0x9c, 0x49, 0xc3, 0xcd, 0x20, 0xec, 0xdb, 0xdf, 0xfe, 0xf5, 0xef, 0x8f, 0xb3, 0xb7, 0xe8, 0x4d,
-	0x96, 0xf5, 0x0a, 0xd1, 0x50, 0xc6, 0x6b, 0xb3, 0xa1, 0x52, 0x96, 0x9f, 0x0d, 0x95, 0xb6, 0xf0,
-	0x89, 0xa0, 0xd0, 0x97, 0x7f, 0x22, 0x30, 0x9f, 0x58, 0x0f, 0x65, 0x59, 0x3a, 0x43, 0xa6, 0x6b,
-	0xed, 0x4c, 0x9e, 0x80, 0x68, 0x77, 0x34, 0xda, 0x3a, 0xbd, 0x35, 0x06, 0x2d, 0x71, 0x38, 0xfa,
-	0x07, 0x81, 0xe7, 0x53, 0xbe, 0x48, 0xdf, 0x9c, 0x54, 0x70, 0xd0, 0x77, 0xad, 0xb7, 0xa6, 0xcc,
-	0x42, 0xd6, 0x1d, 0xcd, 0xba, 0x4d, 0xb7, 0x26, 0x60, 0x35, 0x78, 0x3f, 0x13, 0x58, 0xe8, 0x99,
-	0x25, 0xcd, 0x9c, 0xce, 0xb0, 0x73, 0x5b, 0xe5, 0x29, 0x32, 0x10, 0xf2, 0x0d, 0x0d, 0xb9, 0x41,
-	0x5f, 0x1f, 0x03, 0xd9, 0xe6, 0xbe, 0x79, 0xf5, 0xd1, 0xef, 0x08, 0xcc, 0x35, 0xfc, 0x90, 0x6e,
-	0x67, 0x09, 0xf5, 0x3d, 0xd4, 0xba, 0x33, 0x51, 0x2c, 0xe2, 0x6c, 0x68, 0x9c, 0x35, 0x5a, 0x1c,
-	0x83, 0x13, 0xfb, 0x21, 0xfd, 0x85, 0x00, 0xf4, 0xcd, 0x91, 0x66, 0x36, 0x3e, 0x62, 0xb1, 0xd6,
-	0xee, 0x34, 0x29, 0x48, 0x57, 0xd2, 0x74, 0x9b, 0x74, 0x7d, 0x0c, 0x9d, 0x7a, 0xe3, 0x18, 0x27,
-	0xa3, 0xbf, 0x12, 0x58, 0x1c, 0x70, 0x4b, 0x9a, 0x29, 0x39, 0xea, 0xc4, 0xd6, 0xde, 0x54, 0x39,
-	0xc8, 0xc9, 0x34, 0xe7, 0x6d, 0xba, 0x39, 0x86, 0x53, 0x5b, 0x34, 0xfb, 0x5a, 0x3d, 0xc7, 0xdf,
-	0x54, 0x6a, 0x8f, 0xce, 0x8b, 0xe4, 0xf1, 0x79, 0x91, 0xfc, 0x73, 0x5e, 0x24, 0xdf, 0x5f, 0x14,
-	0x67, 0x1e, 0x5f, 0x14, 0x67, 0xfe, 0xbe, 0x28, 0xce, 0x7c, 0xf1, 0x76, 0xd6, 0x57, 0xd0, 0xe9,
-	0x70, 0x6d, 0xfd, 0x59, 0xd4, 0xcc, 0xeb, 0x2f, 0xde, 0xbd, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x93, 0xd5, 0xc3, 0x8e, 0xd8, 0x0b, 0x00, 0x00,
+	// 1023 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0x89, 0xeb, 0x26, 0xcf, 0xe5, 0xd7, 0x34, 0x0d, 0xce, 0x02, 0x4e, 0xb2, 0x25,
+	0x3f, 0x9a, 0xe0, 0xdd, 0x38, 0xa1, 0x55, 0x0f, 0x48, 0x08, 0x07, 0x81, 0x8b, 0x84, 0x14, 0xac,
+	0xc0, 0x01, 0x55, 0x58, 0x63, 0x7b, 0xf0, 0xae, 0x1a, 0xcf, 0x6e, 0xbd, 0x93, 0x90, 0x08, 0x71,
+	0xe1, 0xc0, 0x19, 0xc1, 0x8d, 0x03, 0x07, 0x2e, 0x5c, 0xf8, 0x71, 0xc9, 0x1f, 0xd1, 0x63, 0x05,
+	0x17, 0xd4, 0x43, 0x84, 0x12, 0xfe, 0x10, 0x34, 0x33, 0x6f, 0x1d, 0xaf, 0xed, 0x78, 0xd7, 0x6a,
+	0x2e, 0x51, 0x66, 0xe6, 0x7d, 0xdf, 0xf7, 0x33, 0x6f, 0x67, 0xe7, 0xad, 0x61, 0xb9, 0x4e, 0xeb,
+	0xc7, 0xfb, 0x3e, 0x77, 0xea, 0xa2, 0xb1, 0xef, 0xb5, 0x5c, 0xf9, 0x97, 0x71, 0xe1, 0x1c, 0x96,
+	0x9c, 0xc7, 0x07, 0xac, 0x73, 0x6c, 0x07, 0x1d, 0x5f, 0xf8, 0x64, 0x1e, 0xc3, 0xec, 0x78, 0x98,
+	0x7d, 0x58, 0x32, 0x67, 0x5b, 0x7e, 0xcb, 0x57, 0x51, 0x8e, 0xfc, 0x4f, 0x0b, 0xcc, 0xf9, 0x86,
+	0x1f, 0xb6, 0xfd, 0xb0, 0xa6, 0x17, 0xf4, 0x00, 0x97, 0x5e, 0x6f, 0xf9, 0x7e, 0x6b, 0x9f, 0x39,
+	0x34, 0xf0, 0x1c, 0xca, 0xb9, 0x2f, 0xa8, 0xf0, 0x7c, 0x1e, 0xad, 0xae, 0xeb, 0x58, 0xa7, 0x4e,
+	0x43, 0xa6, 0x11, 0x9c, 0xc3, 0x52, 0x9d, 0x09, 0x5a, 0x72, 0x02, 0xda, 0xf2, 0xb8, 0x0a, 0xc6,
+	0xd8, 0x95, 0xcb, 0xe1, 0x03, 0xda, 0xa1, 0xed, 0x28, 0xa7, 0x7d, 0x79, 0x5c, 0xdf, 0x7e, 0x54,
+	0xbc, 0x35, 0x0b, 0xe4, 0x13, 0xe9, 0xbc, 0xab, 0x92, 0x54, 0xd9, 0xe3, 0x03, 0x16, 0x0a, 0xeb,
+	0x33, 0xb8, 0x19, 0x9b, 0x0d, 0x03, 0x9f, 0x87, 0x8c, 0xbc, 0x0b, 0x59, 0x6d, 0x96, 0x37, 0x16,
+	0x8d, 0xb5, 0xdc, 0xd6, 0x92, 0x7d, 0x69, 0xad, 0x6c, 0x2d, 0x2d, 0x67, 0x9e, 0x9c, 0x2e, 0x4c,
+	0x54, 0x51, 0x66, 0x3d, 0x44, 0xb7, 0x0a, 0x0d, 0x5d, 0x16, 0xb9, 0x91, 0x0f, 0x00, 0x2e, 0xf6,
+	0x8b, 0xa9, 0x57, 0x6c, 0x2c, 0xa4, 0x2c, 0x8e, 0xad, 0x9f, 0x0f, 0x16, 0xc7, 0xde, 0xa5, 0x2d,
+	0x86, 0xda, 0x6a, 0x8f, 0xd2, 0x3a, 0x31, 0xe0, 0x66, 0x2c, 0x3d, 0x62, 0xef, 0x41, 0xd6, 0x55,
+	0x33, 0x79, 0x63, 0x71, 0x6a, 0xed, 0x46, 0xf9, 0x9d, 0x67, 0xa7, 0x0b, 0xf7, 0x5b, 0x9e, 0x70,
+	0x0f, 0xea, 0x76, 0xc3, 0x6f, 0x3b, 0xb8, 0x89, 0x86, 0x4b, 0x3d, 0x1e, 0x0d, 0x1c, 0x71, 0x1c,
+	0xb0, 0xd0, 0x2e, 0xef, 0xed, 0x54, 0x18, 0x6d, 0xb2, 0x8e, 0x4c, 0x59, 0x3e, 0x16, 0x2c, 0xac,
+	0x62, 0x2e, 0xf2, 0x61, 0x8c, 0x7a, 0x52, 0x51, 0xaf, 0x26, 0x52, 0x6b, 0xa4, 0x18, 0xb6, 0x0b,
+	0xb3, 0x8a, 0x7a, 0xc7, 0xe7, 0x82, 0x7a, 0xbc, 0x5b, 0x96, 0x5d, 0xc8, 0x48, 0x2b, 0x55, 0x90,
+	0xe7, 0x85, 0x56, 0x99, 0xac, 0x6d, 0xb8, 0xd5, 0xe7, 0x84, 0x15, 0x32, 0x61, 0xba, 0x81, 0x73,
+	0xca, 0x6e, 0xba, 0xda, 0x1d, 0x5b, 0x0e, 0xcc, 0xc7, 0x44, 0x3a, 0x21, 0x32, 0x92, 0x5e, 0x46,
+	0x74, 0xb9, 0x0f, 0xe6, 0x30, 0x41, 0x0a, 0xab, 0x1a, 0xf2, 0x7d, 0x4c, 0x3d, 0xbe, 0x23, 0x37,
+	0x76, 0xd5, 0x27, 0xe4, 0x77, 0x03, 0xe6, 0xfa, 0x1d, 0x90, 0xeb, 0x23, 0xb8, 0xee, 0xaa, 0xa2,
+	0xe9, 0x53, 0x92, 0xdb, 0xda, 0x1c, 0x71, 0xb8, 0xbb, 0x15, 0x7e, 0xc0, 0xbf, 0xf4, 0xbb, 0x0f,
+	0x35, 0x4a, 0x70, 0x75, 0x47, 0xe3, 0x15, 0x78, 0x49, 0xe1, 0xee, 0x79, 0x41, 0xf4, 0x6a, 0x3e,
+	0x84, 0x97, 0x2f, 0xa6, 0x90, 0xbd, 0x02, 0x59, 0x6d, 0x8d, 0xa5, 0x19, 0x1f, 0x1d, 0xf5, 0x56,
+	0x1e, 0xeb, 0x53, 0xa6, 0x21, 0xd3, 0x61, 0x91, 0x6f, 0x03, 0x5e, 0x1d, 0x58, 0xb9, 0x72, 0xfb,
+	0x22, 0x9a, 0xe8, 0x90, 0xf7, 0x59, 0x20, 0xdc, 0x61, 0x27, 0x6d, 0x06, 0x4f, 0xda, 0x26, 0xe4,
+	0x07, 0xc3, 0x11, 0x6a, 0x16, 0xae, 0x35, 0xe5, 0x84, 0x12, 0x64, 0xaa, 0x7a, 0x60, 0x7d, 0x01,
+	0x66, 0x8f, 0x22, 0x7c, 0xc0, 0xab, 0x94, 0x77, 0x8f, 0x0a, 0x59, 0x82, 0x1b, 0xa1, 0xa0, 0x1d,
+	0x51, 0x73, 0x99, 0xa4, 0x46, 0x69, 0x4e, 0xcd, 0x55, 0xd4, 0x14, 0x79, 0x03, 0x80, 0xf1, 0x66,
+	0x14, 0x30, 0xa9, 0x02, 0x66, 0x18, 0x6f, 0xea, 0x65, 0x8b, 0xc2, 0x6b, 0x43, 0xf3, 0x23, 0x54,
+	0xb9, 0xff, 0x90, 0xad, 0xa5, 0x2e, 0x55, 0x24, 0xb4, 0x7e, 0x33, 0xe0, 0xd6, 0xd0, 0x2a, 0x4a,
+	0x36, 0x1d, 0x54, 0x73, 0xd9, 0x11, 0x16, 0x6a, 0x46, 0xcf, 0x54, 0xd8, 0x11, 0x99, 0x87, 0x69,
+	0x59, 0x35, 0xb5, 0x38, 0xa9, 0x16, 0xaf, 0xcb, 0xb1, 0x5c, 0x9a, 0x93, 0x4f, 0x50, 0xed, 0x68,
+	0x4a, 0xed, 0x08, 0x47, 0xe4, 0x3d, 0xc8, 0x7c, 0xe5, 0x77, 0x1e, 0xe5, 0x33, 0x32, 0xbc, 0x5c,
+	0x94, 0x77, 0xf9, 0xb3, 0xd3, 0x85, 0x39, 0x7d, 0x92, 0xc3, 0xe6, 0x23, 0xdb, 0xf3, 0x9d, 0x36,
+	0x15, 0xae, 0xfd, 0xa9, 0xc7, 0xc5, 0x5f, 0x27, 0xc5, 0x9c, 0x5e, 0x51, 0xc3, 0xaa, 0x92, 0x6e,
+	0xfd, 0x99, 0x83, 0x6b, 0xaa, 0x24, 0xe4, 0x07, 0x03, 0xb2, 0xba, 0x2b, 0x90, 0xe2, 0x88, 0x6d,
+	0x0f, 0xb6, 0x23, 0xd3, 0x4e, 0x1b, 0xae, 0x0b, 0x61, 0xdd, 0xf9, 0xf6, 0xef, 0xff, 0x7e, 0x9c,
+	0xbc, 0x4d, 0x96, 0x9c, 0xa4, 0xae, 0xa9, 0xa0, 0x74, 0xbb, 0x48, 0x86, 0x8a, 0x75, 0x2d, 0xd3,
+	0x4e, 0x1b, 0x3e, 0x06, 0x14, 0xb6, 0x96, 0x9f, 0x0c, 0x98, 0x8e, 0x6e, 0x4f, 0xe2, 0x24, 0xf9,
+	0xf4, 0xf5, 0x0d, 0x73, 0x33, 0xbd, 0x00, 0xd1, 0x36, 0x14, 0xda, 0x32, 0xb9, 0x3d, 0x02, 0x2d,
+	0xba, 0xa4, 0xc9, 0x1f, 0x06, 0xbc, 0x10, 0xbb, 0xda, 0xc9, 0xdb, 0x69, 0x0d, 0x7b, 0x5b, 0x87,
+	0x79, 0x77, 0x4c, 0x15, 0xb2, 0x6e, 0x2a, 0xd6, 0x75, 0xb2, 0x96, 0x82, 0x55, 0xe3, 0xfd, 0x6c,
+	0xc0, 0x4c, 0xf7, 0xbe, 0x27, 0x89, 0xd5, 0xe9, 0x6f, 0x3e, 0x66, 0x69, 0x0c, 0x05, 0x42, 0xbe,
+	0xa5, 0x20, 0x57, 0xc8, 0x9b, 0x23, 0x20, 0xdb, 0xd4, 0xd3, 0xdd, 0x9b, 0x7c, 0x67, 0xc0, 0xd4,
+	0x9e, 0x17, 0x90, 0xf5, 0x24, 0xa3, 0x8b, 0x36, 0x60, 0x6e, 0xa4, 0x8a, 0x45, 0x9c, 0x15, 0x85,
+	0xb3, 0x48, 0x0a, 0x23, 0x70, 0x84, 0x17, 0x90, 0x5f, 0x0c, 0x80, 0x8b, 0xfb, 0x9d, 0x24, 0x6e,
+	0x7c, 0xa0, 0x4b, 0x98, 0x5b, 0xe3, 0x48, 0x90, 0xae, 0xa8, 0xe8, 0x56, 0xc9, 0xf2, 0x08, 0xba,
+	0x3a, 0x0d, 0x99, 0xbe, 0xc9, 0xc8, 0xaf, 0x06, 0xe4, 0x7a, 0x2e, 0x7c, 0x92, 0x68, 0x39, 0xd8,
+	0x4c, 0xcc, 0xed, 0xb1, 0x34, 0xc8, 0xe9, 0x28, 0xce, 0x3b, 0x64, 0x75, 0x04, 0xa7, 0xea, 0x32,
+	0xce, 0xd7, 0xf2, 0x3d, 0xfe, 0x86, 0x9c, 0x18, 0xf0, 0x62, 0xbc, 0x11, 0x90, 0xbb, 0xe9, 0x8c,
+	0xfb, 0x1a, 0x93, 0x79, 0x6f, 0x5c, 0x19, 0x22, 0x6f, 0x2b, 0xe4, 0x22, 0xd9, 0x18, 0x75, 0xe7,
+	0x68, 0x69, 0xcd, 0xe3, 0xb5, 0x8e, 0x14, 0x97, 0x77, 0x9f, 0x9c, 0x15, 0x8c, 0xa7, 0x67, 0x05,
+	0xe3, 0xdf, 0xb3, 0x82, 0xf1, 0xfd, 0x79, 0x61, 0xe2, 0xe9, 0x79, 0x61, 0xe2, 0x9f, 0xf3, 0xc2,
+	0xc4, 0xe7, 0xf7, 0x92, 0xbe, 0x3f, 0x8f, 0xfa, 0xf3, 0xab, 0x0f, 0xd2, 0x7a, 0x56, 0xfd, 0xd6,
+	0xd8, 0xfe, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x36, 0x9d, 0xae, 0xdd, 0x82, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -930,6 +1043,9 @@ type QueryClient interface {
 	// HeaderDepth returns the depth of the header in main chain or error if the
 	// block is not found or it exists on fork
 	HeaderDepth(ctx context.Context, in *QueryHeaderDepthRequest, opts ...grpc.CallOption) (*QueryHeaderDepthResponse, error)
+	// HeadersInRange returns the headers on the canonical chain within the
+	// given range of heights, in ascending order of height
+	HeadersInRange(ctx context.Context, in *QueryHeadersInRangeRequest, opts ...grpc.CallOption) (*QueryHeadersInRangeResponse, error)
 }
 
 type queryClient struct {
@@ -1012,6 +1128,15 @@ func (c *queryClient) HeaderDepth(ctx context.Context, in *QueryHeaderDepthReque
 	return out, nil
 }
 
+func (c *queryClient) HeadersInRange(ctx context.Context, in *QueryHeadersInRangeRequest, opts ...grpc.CallOption) (*QueryHeadersInRangeResponse, error) {
+	out := new(QueryHeadersInRangeResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/HeadersInRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of the module.
@@ -1035,6 +1160,9 @@ type QueryServer interface {
 	// HeaderDepth returns the depth of the header in main chain or error if the
 	// block is not found or it exists on fork
 	HeaderDepth(context.Context, *QueryHeaderDepthRequest) (*QueryHeaderDepthResponse, error)
+	// HeadersInRange returns the headers on the canonical chain within the
+	// given range of heights, in ascending order of height
+	HeadersInRange(context.Context, *QueryHeadersInRangeRequest) (*QueryHeadersInRangeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1065,6 +1193,9 @@ func (*UnimplementedQueryServer) BaseHeader(ctx context.Context, req *QueryBaseH
 func (*UnimplementedQueryServer) HeaderDepth(ctx context.Context, req *QueryHeaderDepthRequest) (*QueryHeaderDepthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HeaderDepth not implemented")
 }
+func (*UnimplementedQueryServer) HeadersInRange(ctx context.Context, req *QueryHeadersInRangeRequest) (*QueryHeadersInRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadersInRange not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1214,6 +1345,24 @@ func _Query_HeaderDepth_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_HeadersInRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadersInRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeadersInRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Query/HeadersInRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadersInRange(ctx, req.(*QueryHeadersInRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.btclightclient.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1250,6 +1399,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HeaderDepth",
 			Handler:    _Query_HeaderDepth_Handler,
 		},
+		{
+			MethodName: "HeadersInRange",
+			Handler:    _Query_HeadersInRange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/btclightclient/v1/query.proto",
@@ -1784,6 +1937,76 @@ func (m *QueryHeaderDepthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryHeadersInRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeadersInRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeadersInRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeadersInRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeadersInRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeadersInRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BTCHeaderInfoResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2050,6 +2273,36 @@ func (m *QueryHeaderDepthResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryHeadersInRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *QueryHeadersInRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *BTCHeaderInfoResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3356,6 +3609,178 @@ func (m *QueryHeaderDepthResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryHeadersInRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadersInRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadersInRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadersInRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadersInRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadersInRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, &BTCHeaderInfo{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BTCHeaderInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -28,3 +28,29 @@ func FuzzCumulativeWork(f *testing.F) {
 		}
 	})
 }
+
+func FuzzIsForkWorkAllowed(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		tipHeaderWork := sdkmath.NewUint(r.Uint64() + 1)
+		params := types.DefaultParams()
+		params.MaxForkDepth = uint32(datagen.RandomInt(r, 1000) + 1)
+		maxForkWork := tipHeaderWork.MulUint64(uint64(params.MaxForkDepth))
+
+		// forks up to the work of max fork depth headers at the difficulty
+		// of the tip are allowed, regardless of how many headers they skip
+		if !params.IsForkWorkAllowed(maxForkWork, tipHeaderWork) {
+			t.Errorf("fork with the max fork work is not allowed")
+		}
+		if params.IsForkWorkAllowed(maxForkWork.AddUint64(1), tipHeaderWork) {
+			t.Errorf("fork with more than the max fork work is allowed")
+		}
+
+		// forks of any depth are allowed if the max fork depth is 0
+		params.MaxForkDepth = 0
+		if !params.IsForkWorkAllowed(maxForkWork.AddUint64(1), tipHeaderWork) {
+			t.Errorf("fork is not allowed without max fork depth")
+		}
+	})
+}
@@ -19,5 +19,5 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 func EndBlocker(ctx context.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
-	return []abci.ValidatorUpdate{}, nil
+	return []abci.ValidatorUpdate{}, k.EndBlocker(ctx)
 }
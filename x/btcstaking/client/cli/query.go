@@ -2,15 +2,20 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 
+	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/spf13/cobra"
 )
 
+const flagOutFile = "out-file"
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string) *cobra.Command {
 	// Group btcstaking queries under a subcommand
@@ -25,12 +30,28 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdFinalityProvider())
 	cmd.AddCommand(CmdFinalityProviders())
+	cmd.AddCommand(CmdFinalityProvidersByConsumer())
+	cmd.AddCommand(CmdFinalityProviderStatus())
 	cmd.AddCommand(CmdBTCDelegations())
 	cmd.AddCommand(CmdFinalityProvidersAtHeight())
+	cmd.AddCommand(CmdFinalityProvidersByPowerAtHeight())
 	cmd.AddCommand(CmdFinalityProviderPowerAtHeight())
 	cmd.AddCommand(CmdActivatedHeight())
 	cmd.AddCommand(CmdFinalityProviderDelegations())
 	cmd.AddCommand(CmdDelegation())
+	cmd.AddCommand(CmdDelegationBundle())
+	cmd.AddCommand(CmdSlashingRateChangeReports())
+	cmd.AddCommand(CmdSlashingRateChangeReport())
+	cmd.AddCommand(CmdScheduledParams())
+	cmd.AddCommand(CmdPauseState())
+	cmd.AddCommand(CmdLastFinalityProviderSetDiff())
+	cmd.AddCommand(CmdTxIndex())
+	cmd.AddCommand(CmdStakingTxTemplate())
+	cmd.AddCommand(CmdPendingBTCDelegations())
+	cmd.AddCommand(CmdCovenantMuSig2Nonces())
+	cmd.AddCommand(CmdWatchtowerBackup())
+	cmd.AddCommand(CmdCovenantPerformance())
+	cmd.AddCommand(CmdBTCDelegationTxFees())
 
 	return cmd
 }
@@ -62,6 +83,33 @@ func CmdFinalityProvider() *cobra.Command {
 	return cmd
 }
 
+func CmdFinalityProviderStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-provider-status [fp_btc_pk_hex]",
+		Short: "retrieve the planned downtime or key migration window announced by a finality provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FinalityProviderStatus(
+				cmd.Context(),
+				&types.QueryFinalityProviderStatusRequest{
+					FpBtcPkHex: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdDelegation() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delegation [staking_tx_hash_hex]",
@@ -91,6 +139,92 @@ func CmdDelegation() *cobra.Command {
 	return cmd
 }
 
+func CmdDelegationBundle() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegation-bundle [staking_tx_hash_hex]",
+		Short: "export a BTC delegation into a self-contained bundle for spending it offline",
+		Long: strings.TrimSpace(`Export a BTC delegation into a self-contained JSON bundle, which carries the BTC txs, the
+covenant signatures, the covenant committee of the params version of the BTC delegation, and the scripts and control
+blocks of the timelock paths and the unbonding path. With the bundle, the staker can construct the withdrawal of the
+staking output or the unbonding output, or the unbonding tx, completely offline.`),
+		Example: `babylond query btcstaking delegation-bundle <staking_tx_hash_hex> --btc-network mainnet --out-file bundle.json`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			network, _ := cmd.Flags().GetString(FlagBtcNetwork)
+			outFile, _ := cmd.Flags().GetString(flagOutFile)
+
+			delRes, err := queryClient.BTCDelegation(cmd.Context(), &types.QueryBTCDelegationRequest{StakingTxHashHex: args[0]})
+			if err != nil {
+				return err
+			}
+			// the scripts are built under the params the BTC delegation is created with
+			paramsRes, err := queryClient.ParamsByVersion(cmd.Context(), &types.QueryParamsByVersionRequest{Version: delRes.BtcDelegation.ParamsVersion})
+			if err != nil {
+				return err
+			}
+
+			bundle, err := types.NewBTCDelegationBundle(args[0], delRes.BtcDelegation, &paramsRes.Params, network)
+			if err != nil {
+				return err
+			}
+			if err := bundle.Verify(); err != nil {
+				return err
+			}
+
+			bz, err := clientCtx.Codec.MarshalJSON(bundle)
+			if err != nil {
+				return err
+			}
+			return os.WriteFile(outFile, bz, 0o644)
+		},
+	}
+
+	cmd.AddCommand(CmdVerifyDelegationBundle())
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagBtcNetwork, string(bbn.BtcSimnet), "Bitcoin network to use. Available networks: simnet, testnet, regtest, mainnet, signet")
+	cmd.Flags().String(flagOutFile, "btc_delegation_bundle.json", "The path of the exported bundle")
+
+	return cmd
+}
+
+func CmdVerifyDelegationBundle() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify [bundle_file]",
+		Short: "check the internal consistency of a BTC delegation bundle offline",
+		Long: strings.TrimSpace(`Check that the outputs and the spend paths in a BTC delegation bundle are the ones rebuilt
+from the BTC delegation, that its BTC txs carry these outputs, and that the covenant signatures on the unbonding tx
+are valid. The check does not need a connection to a node.`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var bundle types.BTCDelegationBundle
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &bundle); err != nil {
+				return err
+			}
+			if err := bundle.Verify(); err != nil {
+				return fmt.Errorf("invalid BTC delegation bundle: %w", err)
+			}
+
+			cmd.Printf("BTC delegation bundle of staking tx %s is consistent\n", bundle.StakingTxHashHex)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
 func CmdFinalityProviders() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "finality-providers",
@@ -123,6 +257,39 @@ func CmdFinalityProviders() *cobra.Command {
 	return cmd
 }
 
+func CmdFinalityProvidersByConsumer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-providers-by-consumer [consumer-id]",
+		Short: "retrieve all finality providers securing a given consumer chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FinalityProvidersByConsumer(cmd.Context(), &types.QueryFinalityProvidersByConsumerRequest{
+				ConsumerId: args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "finality-providers-by-consumer")
+
+	return cmd
+}
+
 func CmdBTCDelegations() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "btc-delegations [status]",
@@ -253,6 +420,44 @@ func CmdFinalityProvidersAtHeight() *cobra.Command {
 	return cmd
 }
 
+func CmdFinalityProvidersByPowerAtHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-providers-by-power-at-height [height]",
+		Short: "retrieve the active finality providers at a given babylon height, sorted by voting power in descending order",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ActiveFinalityProvidersByPowerAtHeight(cmd.Context(), &types.QueryActiveFinalityProvidersByPowerAtHeightRequest{
+				Height:     height,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "finality-providers-by-power-at-height")
+
+	return cmd
+}
+
 func CmdFinalityProviderDelegations() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "finality-provider-delegations [fp_pk_hex]",
@@ -285,3 +490,371 @@ func CmdFinalityProviderDelegations() *cobra.Command {
 
 	return cmd
 }
+
+func CmdSlashingRateChangeReports() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slashing-rate-reports",
+		Short: "retrieve the reports of all slashing rate changes",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SlashingRateChangeReports(cmd.Context(), &types.QuerySlashingRateChangeReportsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "slashing-rate-reports")
+
+	return cmd
+}
+
+func CmdSlashingRateChangeReport() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slashing-rate-report [params_version]",
+		Short: "retrieve the report of the slashing rate change made by the given params version",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			version, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SlashingRateChangeReport(cmd.Context(), &types.QuerySlashingRateChangeReportRequest{
+				ParamsVersion: uint32(version),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdScheduledParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-params",
+		Short: "retrieve the params scheduled to take effect in the future",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ScheduledParams(cmd.Context(), &types.QueryScheduledParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPauseState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-state",
+		Short: "retrieve whether the processing of new BTC delegations and early unbondings is paused",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PauseState(cmd.Context(), &types.QueryPauseStateRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdLastFinalityProviderSetDiff() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "last-finality-provider-set-diff",
+		Short: "retrieve the finality providers that last entered or exited the active finality provider set",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LastFinalityProviderSetDiff(cmd.Context(), &types.QueryLastFinalityProviderSetDiffRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdTxIndex() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx-index [btc_tx_hash_hex]",
+		Short: "retrieve the BTC delegation or checkpoint submission that a BTC tx belongs to",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TxIndex(cmd.Context(), &types.QueryTxIndexRequest{BtcTxHashHex: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdStakingTxTemplate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "staking-tx-template [staker_btc_pk] [fp_btc_pk1,fp_btc_pk2,...] [staking_value] [staking_time] [unbonding_fee]",
+		Short: "build the unsigned transactions and the message for staking with the given finality providers",
+		Long: strings.TrimSpace(`Build the unsigned staking tx without inputs, the slashing tx, the unbonding tx,
+the unbonding slashing tx, and the MsgCreateBTCDelegation to submit for staking with the given
+finality providers under the current parameters. BTC PKs are in hex, the staking value and the
+unbonding fee are in satoshis, and the staking time is in BTC blocks.`),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			stakingValue, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			stakingTime, err := strconv.ParseUint(args[3], 10, 32)
+			if err != nil {
+				return err
+			}
+			unbondingFee, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+			unbondingTime, err := cmd.Flags().GetUint32(FlagUnbondingTime)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.StakingTxTemplate(cmd.Context(), &types.QueryStakingTxTemplateRequest{
+				StakerBtcPkHex:  args[0],
+				FpBtcPkHexList:  strings.Split(args[1], ","),
+				StakingValue:    stakingValue,
+				StakingTime:     uint32(stakingTime),
+				UnbondingTime:   unbondingTime,
+				UnbondingFeeSat: unbondingFee,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint32(FlagUnbondingTime, 0, "unbonding time in BTC blocks, where 0 means the minimum unbonding time")
+
+	return cmd
+}
+
+func CmdPendingBTCDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-btc-delegations [covenant_pk_hex]",
+		Short: "retrieve all BTC delegations waiting for covenant signatures along with the data for signing them, optionally only those not yet signed by the given covenant member",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPendingBTCDelegationsRequest{Pagination: pageReq}
+			if len(args) > 0 {
+				req.CovenantPkHex = args[0]
+			}
+			res, err := queryClient.PendingBTCDelegations(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-btc-delegations")
+
+	return cmd
+}
+
+func CmdCovenantMuSig2Nonces() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "covenant-musig2-nonces [staking_tx_hash_hex]",
+		Short: "retrieve the MuSig2 nonces submitted by covenant members for a BTC delegation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CovenantMuSig2Nonces(
+				cmd.Context(),
+				&types.QueryCovenantMuSig2NoncesRequest{
+					StakingTxHashHex: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdWatchtowerBackup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watchtower-backup [staking_tx_hash_hex]",
+		Short: "retrieve the backup data deposited by the staker of a BTC delegation for its watchtower",
+		Long: strings.TrimSpace(
+			`Retrieve the backup data deposited by the staker of a BTC delegation for its watchtower. ` +
+				`The backup data is public ciphertext encrypted by the staker for the watchtower.`,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.WatchtowerBackup(
+				cmd.Context(),
+				&types.QueryWatchtowerBackupRequest{
+					StakingTxHashHex: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCovenantPerformance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "covenant-performance",
+		Short: "retrieve the signing records of all covenant signers, i.e., the numbers of signed and missed BTC delegations and the average number of blocks to sign",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CovenantPerformance(cmd.Context(), &types.QueryCovenantPerformanceRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "covenant-performance")
+
+	return cmd
+}
+
+func CmdBTCDelegationTxFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-delegation-tx-fees [staking_tx_hash_hex] [fee_rate]",
+		Short: "estimate the virtual sizes and the minimum fees at the given fee rate (in sat/vB) of the slashing, unbonding, and unbonding slashing txs of a BTC delegation",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			feeRate, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BTCDelegationTxFees(
+				cmd.Context(),
+				&types.QueryBTCDelegationTxFeesRequest{
+					StakingTxHashHex: args[0],
+					FeeRate:          feeRate,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
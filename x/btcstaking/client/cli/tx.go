@@ -2,17 +2,26 @@ package cli
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"os"
+	"strconv"
 	"strings"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdkcrypto "github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/spf13/cobra"
 
+	"github.com/babylonchain/babylon/btcstaking"
 	asig "github.com/babylonchain/babylon/crypto/schnorr-adaptor-signature"
 	bbn "github.com/babylonchain/babylon/types"
 	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
@@ -26,6 +35,21 @@ const (
 	FlagSecurityContact = "security-contact"
 	FlagDetails         = "details"
 	FlagCommissionRate  = "commission-rate"
+	FlagConsumerID      = "consumer-id"
+	FlagMemo            = "memo"
+	FlagRewardAddress   = "reward-address"
+	FlagWithdrawalAddr  = "withdrawal-btc-address"
+	FlagUnbondingTime   = "unbonding-time"
+	FlagStartHeight     = "start-height"
+	FlagEndHeight       = "end-height"
+	FlagReason          = "reason"
+	FlagUnbondingFee    = "unbonding-fee"
+	FlagBtcNetwork      = "btc-network"
+	FlagDelegations     = "delegations"
+	FlagKeyName         = "key-name"
+	FlagSecNonces       = "sec-nonces"
+	FlagPartialSigs     = "partial-sigs"
+	FlagOutputFile      = "output-file"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -44,7 +68,17 @@ func GetTxCmd() *cobra.Command {
 		NewCreateBTCDelegationCmd(),
 		NewAddCovenantSigsCmd(),
 		NewBTCUndelegateCmd(),
+		NewSetDelegationOperatorCmd(),
+		NewSetWatchtowerBackupCmd(),
+		NewReportStakingSpendCmd(),
+		NewUpdateFinalityProviderStatusCmd(),
+		NewSetPauseCmd(),
 		NewSelectiveSlashingEvidenceCmd(),
+		NewCreateStakingTxCmd(),
+		NewSignCovenantCmd(),
+		NewAddCovenantMuSig2NoncesCmd(),
+		NewSignCovenantMuSig2Cmd(),
+		NewAggregateCovenantMuSig2Cmd(),
 	)
 
 	return cmd
@@ -111,6 +145,8 @@ func NewCreateFinalityProviderCmd() *cobra.Command {
 			// get master public randomness in base58 string
 			mpr := args[3]
 
+			consumerID, _ := fs.GetString(FlagConsumerID)
+
 			msg := types.MsgCreateFinalityProvider{
 				Signer:        clientCtx.FromAddress.String(),
 				Description:   &description,
@@ -119,6 +155,7 @@ func NewCreateFinalityProviderCmd() *cobra.Command {
 				BtcPk:         btcPK,
 				Pop:           pop,
 				MasterPubRand: mpr,
+				ConsumerId:    consumerID,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
@@ -132,6 +169,7 @@ func NewCreateFinalityProviderCmd() *cobra.Command {
 	fs.String(FlagDetails, "", "The finality provider's (optional) details")
 	fs.String(FlagIdentity, "", "The (optional) identity signature (ex. UPort or Keybase)")
 	fs.String(FlagCommissionRate, "0", "The initial commission rate percentage")
+	fs.String(FlagConsumerID, "", "The (optional) chain ID of the consumer chain secured by the finality provider")
 
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -306,6 +344,12 @@ func NewCreateBTCDelegationCmd() *cobra.Command {
 				return err
 			}
 
+			// the (optional) label of the BTC delegation, which is not to be
+			// confused with the memo of the Babylon tx
+			delMemo, _ := cmd.Flags().GetString(FlagMemo)
+			rewardAddr, _ := cmd.Flags().GetString(FlagRewardAddress)
+			withdrawalAddr, _ := cmd.Flags().GetString(FlagWithdrawalAddr)
+
 			msg := types.MsgCreateBTCDelegation{
 				Signer:                        clientCtx.FromAddress.String(),
 				BabylonPk:                     &babylonPK,
@@ -322,12 +366,18 @@ func NewCreateBTCDelegationCmd() *cobra.Command {
 				UnbondingValue:                int64(unbondingValue),
 				UnbondingSlashingTx:           unbondingSlashingTx,
 				DelegatorUnbondingSlashingSig: delegatorUnbondingSlashingSig,
+				Memo:                          delMemo,
+				RewardAddress:                 rewardAddr,
+				WithdrawalBtcAddress:          withdrawalAddr,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
+	cmd.Flags().String(FlagMemo, "", "The (optional) label of the BTC delegation, e.g., a client or batch ID")
+	cmd.Flags().String(FlagRewardAddress, "", "The (optional) Babylon address receiving the rewards of the BTC delegation")
+	cmd.Flags().String(FlagWithdrawalAddr, "", "The (optional) BTC address the staked BTC is to be withdrawn to")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -437,6 +487,222 @@ func NewBTCUndelegateCmd() *cobra.Command {
 	return cmd
 }
 
+func NewSetDelegationOperatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-delegation-operator [staking_tx_hash] [operator]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Authorize an operator to undelegate a BTC delegation on behalf of the staker",
+		Long: strings.TrimSpace(
+			`Authorize an operator to undelegate a BTC delegation identified by a given staking tx hash on behalf of the staker. ` +
+				`The tx has to be signed by the staker's Babylon account. If the operator is omitted, the existing operator is revoked.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get operator, if any
+			operator := ""
+			if len(args) == 2 {
+				operator = args[1]
+			}
+
+			msg := types.MsgSetDelegationOperator{
+				Signer:        clientCtx.FromAddress.String(),
+				StakingTxHash: args[0],
+				Operator:      operator,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetWatchtowerBackupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "set-watchtower-backup [staking_tx_hash] [watchtower] [encrypted_backup_hex]",
+		Args: cobra.MatchAll(cobra.RangeArgs(1, 3), func(_ *cobra.Command, args []string) error {
+			if len(args) == 2 {
+				return fmt.Errorf("the watchtower and the backup data have to be given together")
+			}
+			return nil
+		}),
+		Short: "Deposit backup data of a BTC delegation for a watchtower designated by the staker",
+		Long: strings.TrimSpace(
+			`Deposit backup data of a BTC delegation identified by a given staking tx hash for a watchtower designated by the staker, ` +
+				`e.g., a pre-signed tx sweeping the timelock path of the staking output encrypted for the watchtower. ` +
+				`The tx has to be signed by the staker's Babylon account. If the watchtower and the backup data are omitted, the existing backup data is removed.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get watchtower and backup data, if any
+			watchtower := ""
+			var backup []byte
+			if len(args) == 3 {
+				watchtower = args[1]
+				backup, err = hex.DecodeString(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.MsgSetWatchtowerBackup{
+				Signer:            clientCtx.FromAddress.String(),
+				StakingTxHash:     args[0],
+				WatchtowerAddress: watchtower,
+				EncryptedBackup:   backup,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewReportStakingSpendCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report-staking-spend [staking_tx_hash] [spend_tx_info]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Report that the staking output of a BTC delegation is spent outside the protocol",
+		Long: strings.TrimSpace(
+			`Report that the staking output of a BTC delegation identified by a given staking tx hash is spent by a tx that is neither its unbonding tx nor its slashing tx. ` +
+				`The spend tx info is the hex of the spend tx along with its inclusion proof. The BTC delegation is then flagged as compromised and loses its voting power.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get spend tx info
+			spendTxInfo, err := btcctypes.NewTransactionInfoFromHex(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgReportStakingSpend{
+				Signer:        clientCtx.FromAddress.String(),
+				StakingTxHash: args[0],
+				SpendTx:       spendTxInfo,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewUpdateFinalityProviderStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-finality-provider-status [fp_btc_pk] [status]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Announce a planned downtime or key migration window of a finality provider",
+		Long: strings.TrimSpace(
+			`Announce a planned downtime or key migration window of a finality provider, so that delegators ` +
+				`and covenant tooling can distinguish planned maintenance from faults. The status is one of ` +
+				`PLANNED_DOWNTIME, KEY_MIGRATION, or OPERATIONAL, where OPERATIONAL withdraws the existing ` +
+				`announcement. The tx has to be signed by the finality provider's Babylon account.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get finality provider BTC PK
+			fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			// get status
+			fpStatus, ok := types.FinalityProviderOperationalStatus_value[strings.ToUpper(args[1])]
+			if !ok {
+				return fmt.Errorf("unknown finality provider status %s", args[1])
+			}
+
+			fs := cmd.Flags()
+			startHeight, _ := fs.GetUint64(FlagStartHeight)
+			endHeight, _ := fs.GetUint64(FlagEndHeight)
+			reason, _ := fs.GetString(FlagReason)
+
+			msg := types.MsgUpdateFinalityProviderStatus{
+				Signer:      clientCtx.FromAddress.String(),
+				FpBtcPk:     fpBTCPK,
+				Status:      types.FinalityProviderOperationalStatus(fpStatus),
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+				Reason:      reason,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	fs := cmd.Flags()
+	fs.Uint64(FlagStartHeight, 0, "The first Babylon height of the announced window")
+	fs.Uint64(FlagEndHeight, 0, "The last Babylon height of the announced window")
+	fs.String(FlagReason, "", "The reason of the announcement")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetPauseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pause [true|false]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Pause or resume the processing of new BTC delegations and early unbondings",
+		Long: strings.TrimSpace(
+			`Pause or resume the processing of MsgCreateBTCDelegation and MsgBTCUndelegate, e.g., upon ` +
+				`an incident with the covenant committee or the BTC light client. The tx has to be signed ` +
+				`by the pause authority in the params, or be submitted through a governance proposal.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			paused, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid pause flag %s: %w", args[0], err)
+			}
+			reason, _ := cmd.Flags().GetString(FlagReason)
+
+			msg := types.MsgSetPause{
+				Signer: clientCtx.FromAddress.String(),
+				Paused: paused,
+				Reason: reason,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReason, "", "The reason of pausing or resuming")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewSelectiveSlashingEvidenceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "selective-slashing-evidence [staking_tx_hash] [recovered_fp_btc_sk]",
@@ -474,3 +740,615 @@ func NewSelectiveSlashingEvidenceCmd() *cobra.Command {
 
 	return cmd
 }
+
+// StakingTx is the output of the create-staking-tx command
+type StakingTx struct {
+	// Template contains the unsigned transactions and the message for staking
+	Template json.RawMessage `json:"template"`
+	// StakingTxPsbt is the base64 encoded PSBT of the unsigned staking tx,
+	// which can be funded and signed by a BTC wallet
+	StakingTxPsbt string `json:"staking_tx_psbt"`
+}
+
+func NewCreateStakingTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-staking-tx [staker_btc_pk] [fp_btc_pk1,fp_btc_pk2,...] [staking_value] [staking_time]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Build the unsigned BTC staking tx, slashing txs, unbonding tx and the MsgCreateBTCDelegation payload for staking",
+		Long: strings.TrimSpace(`Build the unsigned staking tx without inputs, the slashing tx, the unbonding tx,
+the unbonding slashing tx, and the MsgCreateBTCDelegation payload for staking with the given
+finality providers. The current parameters are fetched from the node, while the transactions
+are built locally, so that they can be verified and signed on an air-gapped machine without
+third-party tooling. Nothing is broadcast.
+
+BTC PKs are in hex, the staking value is in satoshis, and the staking time is in BTC blocks.
+If --from is given, the signer and the Babylon PK of the message are filled with the key.
+The staking tx is also output as a PSBT, to be funded and signed by a BTC wallet.
+
+Example:
+$ babylond tx btcstaking create-staking-tx [staker_btc_pk] [fp_btc_pk] 100000 1000 --btc-network signet
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			stakingValue, err := parseBtcAmount(args[2])
+			if err != nil {
+				return err
+			}
+			stakingTime, err := parseLockTime(args[3])
+			if err != nil {
+				return err
+			}
+			unbondingTime, err := cmd.Flags().GetUint32(FlagUnbondingTime)
+			if err != nil {
+				return err
+			}
+			unbondingFee, err := cmd.Flags().GetInt64(FlagUnbondingFee)
+			if err != nil {
+				return err
+			}
+			network, err := cmd.Flags().GetString(FlagBtcNetwork)
+			if err != nil {
+				return err
+			}
+			btcNet, err := bbn.GetBtcNetworkParams(network)
+			if err != nil {
+				return err
+			}
+
+			// fetch the current parameters
+			paramsRes, err := types.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			btccParamsRes, err := btcctypes.NewQueryClient(clientCtx).Params(cmd.Context(), &btcctypes.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			template, err := types.BuildStakingTxTemplate(
+				&types.QueryStakingTxTemplateRequest{
+					StakerBtcPkHex:  args[0],
+					FpBtcPkHexList:  strings.Split(args[1], ","),
+					StakingValue:    int64(stakingValue),
+					StakingTime:     uint32(stakingTime),
+					UnbondingTime:   unbondingTime,
+					UnbondingFeeSat: unbondingFee,
+				},
+				&paramsRes.Params,
+				paramsRes.Version,
+				&btccParamsRes.Params,
+				btcNet,
+			)
+			if err != nil {
+				return err
+			}
+
+			// fill the signer and the Babylon PK with the key, if any
+			if clientCtx.FromName != "" {
+				record, err := clientCtx.Keyring.Key(clientCtx.FromName)
+				if err != nil {
+					return err
+				}
+				pk, err := record.GetPubKey()
+				if err != nil {
+					return err
+				}
+				babylonPK, ok := pk.(*secp256k1.PubKey)
+				if !ok {
+					return fmt.Errorf("key %s is not a secp256k1 key", clientCtx.FromName)
+				}
+				template.MsgCreateBtcDelegation.Signer = clientCtx.FromAddress.String()
+				template.MsgCreateBtcDelegation.BabylonPk = babylonPK
+			}
+
+			stakingTx, _, err := bbn.NewBTCTxFromHex(template.StakingTxHex)
+			if err != nil {
+				return err
+			}
+			stakingTxPsbt, err := encodeUnsignedPsbt(stakingTx)
+			if err != nil {
+				return err
+			}
+			templateBytes, err := clientCtx.Codec.MarshalJSON(template)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(StakingTx{
+				Template:      templateBytes,
+				StakingTxPsbt: stakingTxPsbt,
+			}, "", "  ")
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return err
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Uint32(FlagUnbondingTime, 0, "unbonding time in BTC blocks, where 0 means the minimum unbonding time")
+	cmd.Flags().Int64(FlagUnbondingFee, 1000, "fee of the unbonding tx in satoshis")
+	cmd.Flags().String(FlagBtcNetwork, string(bbn.BtcSimnet), "Bitcoin network to use. Available networks: simnet, testnet, regtest, mainnet, signet")
+
+	return cmd
+}
+
+// exportPassphrase is the throwaway passphrase used for exporting a key from
+// the keyring, which only exports keys in armored form
+const exportPassphrase = "babylon-btc-key-export"
+
+// btcSKFromKeyring returns the secp256k1 key stored under the given name in
+// the keyring as a BTC key
+func btcSKFromKeyring(kr keyring.Keyring, keyName string) (*btcec.PrivateKey, error) {
+	armor, err := kr.ExportPrivKeyArmor(keyName, exportPassphrase)
+	if err != nil {
+		return nil, err
+	}
+	privKey, _, err := sdkcrypto.UnarmorDecryptPrivKey(armor, exportPassphrase)
+	if err != nil {
+		return nil, err
+	}
+	secpKey, ok := privKey.(*secp256k1.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("key %s is not a secp256k1 key", keyName)
+	}
+	sk, _ := btcec.PrivKeyFromBytes(secpKey.Key)
+	return sk, nil
+}
+
+func NewSignCovenantCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-covenant --delegations [file] --key-name [name]",
+		Args:  cobra.NoArgs,
+		Short: "Sign pending BTC delegations as a covenant member",
+		Long: strings.TrimSpace(`Sign the pending BTC delegations in the given file as a covenant member, acting as a
+minimal covenant emulator. The file is the JSON output of the pending-btc-delegations query.
+For each BTC delegation, the params of its params version are queried from the node, against
+which the staking and unbonding scripts are rebuilt and verified locally, and the slashing txs
+and the unbonding tx are validated, before producing the adaptor signatures on the slashing txs and the Schnorr signature on the
+unbonding tx with the covenant BTC key stored in the keyring under the given key name.
+BTC delegations already signed by the covenant member are skipped, and so are BTC delegations
+whose covenant committee signs via MuSig2, see add-covenant-musig2-nonces.
+
+The resulting MsgAddCovenantSigs messages are signed by the --from account and broadcast, or
+only output with --generate-only.
+
+Example:
+$ babylond query btcstaking pending-btc-delegations [covenant_pk] --output json > delegations.json
+$ babylond tx btcstaking sign-covenant --delegations delegations.json --key-name cov1 --from submitter
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delegationsFile, err := cmd.Flags().GetString(FlagDelegations)
+			if err != nil {
+				return err
+			}
+			keyName, err := cmd.Flags().GetString(FlagKeyName)
+			if err != nil {
+				return err
+			}
+			network, err := cmd.Flags().GetString(FlagBtcNetwork)
+			if err != nil {
+				return err
+			}
+			btcNet, err := bbn.GetBtcNetworkParams(network)
+			if err != nil {
+				return err
+			}
+
+			// get the covenant BTC key from the keyring
+			covenantSK, err := btcSKFromKeyring(clientCtx.Keyring, keyName)
+			if err != nil {
+				return err
+			}
+			covenantPK := bbn.NewBIP340PubKeyFromBTCPK(covenantSK.PubKey())
+
+			delegations, err := loadCovenantDelegations(cmd, clientCtx, delegationsFile, btcNet)
+			if err != nil {
+				return err
+			}
+
+			msgs := []sdk.Msg{}
+			for _, del := range delegations {
+				if del.muSig2 {
+					cmd.PrintErrf("skipping BTC delegation with staking tx %s whose covenant committee signs via MuSig2\n", del.stakingTxHash)
+					continue
+				}
+				if del.btcDel.IsSignedByCovMember(covenantPK) {
+					cmd.PrintErrf("skipping BTC delegation with staking tx %s already signed by %s\n", del.stakingTxHash, covenantPK.MarshalHex())
+					continue
+				}
+				msg, err := types.NewMsgAddCovenantSigs(clientCtx.FromAddress.String(), del.btcDel, del.params, covenantSK, btcNet)
+				if err != nil {
+					return fmt.Errorf("failed to sign BTC delegation with staking tx %s: %w", del.stakingTxHash, err)
+				}
+				msgs = append(msgs, msg)
+			}
+			if len(msgs) == 0 {
+				return fmt.Errorf("no BTC delegation to sign")
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagDelegations, "", "path to the JSON file of the pending BTC delegations")
+	cmd.Flags().String(FlagKeyName, "", "name of the covenant BTC key in the keyring")
+	cmd.Flags().String(FlagBtcNetwork, string(bbn.BtcSimnet), "Bitcoin network to use. Available networks: simnet, testnet, regtest, mainnet, signet")
+	_ = cmd.MarkFlagRequired(FlagDelegations)
+	_ = cmd.MarkFlagRequired(FlagKeyName)
+
+	return cmd
+}
+
+// covenantDelegation is a pending BTC delegation verified locally against the
+// params of its params version
+type covenantDelegation struct {
+	stakingTxHash string
+	btcDel        *types.BTCDelegation
+	params        *types.Params
+	// muSig2 is whether the covenant committee of the BTC delegation signs
+	// via MuSig2
+	muSig2 bool
+}
+
+// loadCovenantDelegations reads the pending BTC delegations in the given file,
+// i.e., the JSON output of the pending-btc-delegations query, and verifies
+// each of them against the params of its params version, queried from the
+// node rather than taken from the work items
+func loadCovenantDelegations(
+	cmd *cobra.Command,
+	clientCtx client.Context,
+	delegationsFile string,
+	btcNet *chaincfg.Params,
+) ([]*covenantDelegation, error) {
+	bz, err := os.ReadFile(delegationsFile)
+	if err != nil {
+		return nil, err
+	}
+	var delegations types.QueryPendingBTCDelegationsResponse
+	if err := clientCtx.Codec.UnmarshalJSON(bz, &delegations); err != nil {
+		return nil, err
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	paramsByVersion := map[uint32]*types.Params{}
+	verified := make([]*covenantDelegation, 0, len(delegations.WorkItems))
+	for _, workItem := range delegations.WorkItems {
+		if workItem.BtcDelegation == nil {
+			return nil, fmt.Errorf("invalid BTC delegation with staking tx %s: no BTC delegation", workItem.StakingTxHashHex)
+		}
+		version := workItem.BtcDelegation.ParamsVersion
+		params, ok := paramsByVersion[version]
+		if !ok {
+			res, err := queryClient.ParamsByVersion(cmd.Context(), &types.QueryParamsByVersionRequest{Version: version})
+			if err != nil {
+				return nil, fmt.Errorf("failed to query params of version %d: %w", version, err)
+			}
+			params = &res.Params
+			paramsByVersion[version] = params
+		}
+		btcDel, err := workItem.Verify(params, btcNet)
+		if err != nil {
+			return nil, fmt.Errorf("invalid BTC delegation with staking tx %s: %w", workItem.StakingTxHashHex, err)
+		}
+		template, err := btcstaking.GetScriptTemplate(btcDel.ScriptTemplateVersion)
+		if err != nil {
+			return nil, err
+		}
+		verified = append(verified, &covenantDelegation{
+			stakingTxHash: workItem.StakingTxHashHex,
+			btcDel:        btcDel,
+			params:        params,
+			muSig2:        template.CovenantMuSig2,
+		})
+	}
+	return verified, nil
+}
+
+// queryCovenantMuSig2Nonces queries the MuSig2 nonces of the covenant members
+// that have submitted them for the BTC delegation with the given staking tx
+// hash
+func queryCovenantMuSig2Nonces(cmd *cobra.Command, clientCtx client.Context, stakingTxHash string) ([]*types.CovenantMuSig2Nonces, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.CovenantMuSig2Nonces(cmd.Context(), &types.QueryCovenantMuSig2NoncesRequest{StakingTxHashHex: stakingTxHash})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query MuSig2 nonces of BTC delegation with staking tx %s: %w", stakingTxHash, err)
+	}
+	return res.Nonces, nil
+}
+
+func NewAddCovenantMuSig2NoncesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-covenant-musig2-nonces --delegations [file] --key-name [name] --sec-nonces [file]",
+		Args:  cobra.NoArgs,
+		Short: "Submit MuSig2 nonces for pending BTC delegations as a covenant member",
+		Long: strings.TrimSpace(`Submit the MuSig2 public nonces of a covenant member for the pending BTC delegations in the
+given file whose covenant committee signs via MuSig2. The file is the JSON output of the
+pending-btc-delegations query, and each BTC delegation is verified as in sign-covenant.
+The secret nonces are stored in the --sec-nonces file before the public nonces are broadcast,
+and are used by sign-covenant-musig2 once all covenant members have submitted their nonces.
+BTC delegations for which the covenant member's nonces are already on-chain are skipped.
+
+Example:
+$ babylond query btcstaking pending-btc-delegations [covenant_pk] --output json > delegations.json
+$ babylond tx btcstaking add-covenant-musig2-nonces --delegations delegations.json --key-name cov1 --sec-nonces cov1_nonces.json --from submitter
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delegationsFile, _ := cmd.Flags().GetString(FlagDelegations)
+			keyName, _ := cmd.Flags().GetString(FlagKeyName)
+			secNoncesFile, _ := cmd.Flags().GetString(FlagSecNonces)
+			network, _ := cmd.Flags().GetString(FlagBtcNetwork)
+			btcNet, err := bbn.GetBtcNetworkParams(network)
+			if err != nil {
+				return err
+			}
+
+			covenantSK, err := btcSKFromKeyring(clientCtx.Keyring, keyName)
+			if err != nil {
+				return err
+			}
+			covenantPK := bbn.NewBIP340PubKeyFromBTCPK(covenantSK.PubKey())
+			delegations, err := loadCovenantDelegations(cmd, clientCtx, delegationsFile, btcNet)
+			if err != nil {
+				return err
+			}
+			secNonces, err := readSecNonces(secNoncesFile)
+			if err != nil {
+				return err
+			}
+
+			msgs := []sdk.Msg{}
+			for _, del := range delegations {
+				if !del.muSig2 {
+					continue
+				}
+				noncesList, err := queryCovenantMuSig2Nonces(cmd, clientCtx, del.stakingTxHash)
+				if err != nil {
+					return err
+				}
+				if hasCovenantMuSig2Nonces(noncesList, covenantPK) {
+					cmd.PrintErrf("skipping BTC delegation with staking tx %s with MuSig2 nonces of %s\n", del.stakingTxHash, covenantPK.MarshalHex())
+					continue
+				}
+				// secret nonces whose public nonces never made it on-chain
+				// were not used, so they are replaced
+				msg, sn, err := types.NewMsgAddCovenantMuSig2Nonces(clientCtx.FromAddress.String(), del.btcDel, del.params, covenantSK, btcNet)
+				if err != nil {
+					return fmt.Errorf("failed to generate MuSig2 nonces for BTC delegation with staking tx %s: %w", del.stakingTxHash, err)
+				}
+				secNonces[del.stakingTxHash] = sn
+				msgs = append(msgs, msg)
+			}
+			if len(msgs) == 0 {
+				return fmt.Errorf("no BTC delegation to submit MuSig2 nonces for")
+			}
+
+			// the secret nonces are stored before the public nonces are
+			// published
+			if err := writeSecNonces(secNoncesFile, secNonces); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagDelegations, "", "path to the JSON file of the pending BTC delegations")
+	cmd.Flags().String(FlagKeyName, "", "name of the covenant BTC key in the keyring")
+	cmd.Flags().String(FlagSecNonces, "", "path to the JSON file storing the secret MuSig2 nonces")
+	cmd.Flags().String(FlagBtcNetwork, string(bbn.BtcSimnet), "Bitcoin network to use. Available networks: simnet, testnet, regtest, mainnet, signet")
+	_ = cmd.MarkFlagRequired(FlagDelegations)
+	_ = cmd.MarkFlagRequired(FlagKeyName)
+	_ = cmd.MarkFlagRequired(FlagSecNonces)
+
+	return cmd
+}
+
+func NewSignCovenantMuSig2Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-covenant-musig2 --delegations [file] --key-name [name] --sec-nonces [file] --output-file [file]",
+		Args:  cobra.NoArgs,
+		Short: "Produce MuSig2 partial signatures on pending BTC delegations as a covenant member",
+		Long: strings.TrimSpace(`Produce the MuSig2 partial signatures of a covenant member on the pending BTC delegations in
+the given file whose covenant committee signs via MuSig2, once all covenant members have
+submitted their nonces. The file is the JSON output of the pending-btc-delegations query, and
+each BTC delegation is verified as in sign-covenant. The partial signatures are produced with
+the secret nonces in the --sec-nonces file, which are removed from it afterwards as they must
+not be used again, and are written to the --output-file file to be passed to the aggregator.
+Nothing is broadcast.
+
+Example:
+$ babylond tx btcstaking sign-covenant-musig2 --delegations delegations.json --key-name cov1 --sec-nonces cov1_nonces.json --output-file cov1_partial_sigs.json
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delegationsFile, _ := cmd.Flags().GetString(FlagDelegations)
+			keyName, _ := cmd.Flags().GetString(FlagKeyName)
+			secNoncesFile, _ := cmd.Flags().GetString(FlagSecNonces)
+			outputFile, _ := cmd.Flags().GetString(FlagOutputFile)
+			network, _ := cmd.Flags().GetString(FlagBtcNetwork)
+			btcNet, err := bbn.GetBtcNetworkParams(network)
+			if err != nil {
+				return err
+			}
+
+			covenantSK, err := btcSKFromKeyring(clientCtx.Keyring, keyName)
+			if err != nil {
+				return err
+			}
+			delegations, err := loadCovenantDelegations(cmd, clientCtx, delegationsFile, btcNet)
+			if err != nil {
+				return err
+			}
+			secNonces, err := readSecNonces(secNoncesFile)
+			if err != nil {
+				return err
+			}
+
+			var partialSigsList []json.RawMessage
+			for _, del := range delegations {
+				sn, ok := secNonces[del.stakingTxHash]
+				if !del.muSig2 || !ok {
+					continue
+				}
+				noncesList, err := queryCovenantMuSig2Nonces(cmd, clientCtx, del.stakingTxHash)
+				if err != nil {
+					return err
+				}
+				if len(noncesList) < len(del.params.CovenantPks) {
+					cmd.PrintErrf("skipping BTC delegation with staking tx %s with MuSig2 nonces of %d out of %d covenant members\n",
+						del.stakingTxHash, len(noncesList), len(del.params.CovenantPks))
+					continue
+				}
+				partialSigs, err := types.NewCovenantMuSig2PartialSigs(del.btcDel, del.params, covenantSK, sn, noncesList, btcNet)
+				if err != nil {
+					return fmt.Errorf("failed to sign BTC delegation with staking tx %s: %w", del.stakingTxHash, err)
+				}
+				bz, err := clientCtx.Codec.MarshalJSON(partialSigs)
+				if err != nil {
+					return err
+				}
+				partialSigsList = append(partialSigsList, bz)
+				delete(secNonces, del.stakingTxHash)
+			}
+			if len(partialSigsList) == 0 {
+				return fmt.Errorf("no BTC delegation to sign")
+			}
+
+			bz, err := json.MarshalIndent(partialSigsList, "", "  ")
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(outputFile, bz, 0o600); err != nil {
+				return err
+			}
+			return writeSecNonces(secNoncesFile, secNonces)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagDelegations, "", "path to the JSON file of the pending BTC delegations")
+	cmd.Flags().String(FlagKeyName, "", "name of the covenant BTC key in the keyring")
+	cmd.Flags().String(FlagSecNonces, "", "path to the JSON file storing the secret MuSig2 nonces")
+	cmd.Flags().String(FlagOutputFile, "", "path to the JSON file to write the partial signatures to")
+	cmd.Flags().String(FlagBtcNetwork, string(bbn.BtcSimnet), "Bitcoin network to use. Available networks: simnet, testnet, regtest, mainnet, signet")
+	_ = cmd.MarkFlagRequired(FlagDelegations)
+	_ = cmd.MarkFlagRequired(FlagKeyName)
+	_ = cmd.MarkFlagRequired(FlagSecNonces)
+	_ = cmd.MarkFlagRequired(FlagOutputFile)
+
+	return cmd
+}
+
+func NewAggregateCovenantMuSig2Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-covenant-musig2 --delegations [file] --partial-sigs [file1,file2,...]",
+		Args:  cobra.NoArgs,
+		Short: "Aggregate the MuSig2 partial signatures of all covenant members on pending BTC delegations",
+		Long: strings.TrimSpace(`Aggregate the MuSig2 partial signatures of all covenant members on the pending BTC delegations
+in the given file whose covenant committee signs via MuSig2. The file is the JSON output of the
+pending-btc-delegations query, and each BTC delegation is verified as in sign-covenant. The
+partial signatures are the output files of sign-covenant-musig2 of each covenant member, and
+are verified against the nonces on-chain before being combined into the signatures under the
+aggregate covenant PK. Anyone can aggregate, as the partial signatures only combine into
+valid signatures of the covenant committee.
+
+The resulting MsgAddCovenantSigs messages are signed by the --from account and broadcast, or
+only output with --generate-only.
+
+Example:
+$ babylond tx btcstaking aggregate-covenant-musig2 --delegations delegations.json --partial-sigs cov1_partial_sigs.json,cov2_partial_sigs.json --from submitter
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delegationsFile, _ := cmd.Flags().GetString(FlagDelegations)
+			partialSigsFiles, _ := cmd.Flags().GetStringSlice(FlagPartialSigs)
+			network, _ := cmd.Flags().GetString(FlagBtcNetwork)
+			btcNet, err := bbn.GetBtcNetworkParams(network)
+			if err != nil {
+				return err
+			}
+
+			delegations, err := loadCovenantDelegations(cmd, clientCtx, delegationsFile, btcNet)
+			if err != nil {
+				return err
+			}
+
+			// the partial signatures of each BTC delegation
+			partialSigsByDel := map[string][]*types.CovenantMuSig2PartialSigs{}
+			for _, file := range partialSigsFiles {
+				bz, err := os.ReadFile(file)
+				if err != nil {
+					return err
+				}
+				var partialSigsList []json.RawMessage
+				if err := json.Unmarshal(bz, &partialSigsList); err != nil {
+					return fmt.Errorf("invalid partial signatures file %s: %w", file, err)
+				}
+				for _, raw := range partialSigsList {
+					var partialSigs types.CovenantMuSig2PartialSigs
+					if err := clientCtx.Codec.UnmarshalJSON(raw, &partialSigs); err != nil {
+						return fmt.Errorf("invalid partial signatures file %s: %w", file, err)
+					}
+					partialSigsByDel[partialSigs.StakingTxHash] = append(partialSigsByDel[partialSigs.StakingTxHash], &partialSigs)
+				}
+			}
+
+			msgs := []sdk.Msg{}
+			for _, del := range delegations {
+				partialSigsList, ok := partialSigsByDel[del.stakingTxHash]
+				if !del.muSig2 || !ok {
+					continue
+				}
+				if len(del.btcDel.CovenantSigs) > 0 {
+					cmd.PrintErrf("skipping BTC delegation with staking tx %s already signed by the covenant committee\n", del.stakingTxHash)
+					continue
+				}
+				noncesList, err := queryCovenantMuSig2Nonces(cmd, clientCtx, del.stakingTxHash)
+				if err != nil {
+					return err
+				}
+				msg, err := types.NewMsgAddCovenantSigsFromMuSig2(clientCtx.FromAddress.String(), del.btcDel, del.params, noncesList, partialSigsList, btcNet)
+				if err != nil {
+					return fmt.Errorf("failed to aggregate signatures on BTC delegation with staking tx %s: %w", del.stakingTxHash, err)
+				}
+				msgs = append(msgs, msg)
+			}
+			if len(msgs) == 0 {
+				return fmt.Errorf("no BTC delegation to aggregate signatures on")
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagDelegations, "", "path to the JSON file of the pending BTC delegations")
+	cmd.Flags().StringSlice(FlagPartialSigs, nil, "paths to the JSON files of the partial signatures of the covenant members")
+	cmd.Flags().String(FlagBtcNetwork, string(bbn.BtcSimnet), "Bitcoin network to use. Available networks: simnet, testnet, regtest, mainnet, signet")
+	_ = cmd.MarkFlagRequired(FlagDelegations)
+	_ = cmd.MarkFlagRequired(FlagPartialSigs)
+
+	return cmd
+}
@@ -0,0 +1,122 @@
+package cli_test
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/app"
+	testutilcli "github.com/babylonchain/babylon/testutil/cli"
+	"github.com/babylonchain/babylon/x/btcstaking/client/cli"
+)
+
+func TestSignCovenantCmd(t *testing.T) {
+	encCfg := app.GetEncodingConfig()
+	kr := keyring.NewInMemory(encCfg.Codec)
+	_, _, err := kr.NewMnemonic("cov", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	clientCtx := client.Context{}.
+		WithKeyring(kr).
+		WithTxConfig(encCfg.TxConfig).
+		WithCodec(encCfg.Codec).
+		WithOutput(io.Discard).
+		WithChainID("test-chain")
+
+	// the command is registered under the tx commands
+	found := false
+	for _, cmd := range cli.GetTxCmd().Commands() {
+		if cmd.Name() == "sign-covenant" {
+			found = true
+		}
+	}
+	require.True(t, found)
+
+	delegationsFile := testutil.WriteToNewTempFile(t, `{"work_items": []}`)
+	defer delegationsFile.Close()
+	exec := func(keyName string) error {
+		cmd := cli.NewSignCovenantCmd()
+		_, err := testutilcli.ExecTestCLICmd(clientCtx, cmd, []string{
+			fmt.Sprintf("--%s=%s", cli.FlagDelegations, delegationsFile.Name()),
+			fmt.Sprintf("--%s=%s", cli.FlagKeyName, keyName),
+		})
+		return err
+	}
+
+	// a key missing in the keyring is rejected
+	require.Error(t, exec("unknown"))
+	// the covenant key is read from the keyring, and there is no BTC
+	// delegation to sign
+	err = exec("cov")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "no BTC delegation to sign")
+}
+
+func TestCovenantMuSig2Cmds(t *testing.T) {
+	encCfg := app.GetEncodingConfig()
+	kr := keyring.NewInMemory(encCfg.Codec)
+	_, _, err := kr.NewMnemonic("cov", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	clientCtx := client.Context{}.
+		WithKeyring(kr).
+		WithTxConfig(encCfg.TxConfig).
+		WithCodec(encCfg.Codec).
+		WithOutput(io.Discard).
+		WithChainID("test-chain")
+
+	// the commands are registered under the tx commands
+	registered := map[string]bool{}
+	for _, cmd := range cli.GetTxCmd().Commands() {
+		registered[cmd.Name()] = true
+	}
+	require.True(t, registered["add-covenant-musig2-nonces"])
+	require.True(t, registered["sign-covenant-musig2"])
+	require.True(t, registered["aggregate-covenant-musig2"])
+
+	delegationsFile := testutil.WriteToNewTempFile(t, `{"work_items": []}`)
+	defer delegationsFile.Close()
+	secNoncesFile := filepath.Join(t.TempDir(), "sec_nonces.json")
+	outputFile := filepath.Join(t.TempDir(), "partial_sigs.json")
+	partialSigsFile := testutil.WriteToNewTempFile(t, `[]`)
+	defer partialSigsFile.Close()
+
+	// a key missing in the keyring is rejected
+	_, err = testutilcli.ExecTestCLICmd(clientCtx, cli.NewAddCovenantMuSig2NoncesCmd(), []string{
+		fmt.Sprintf("--%s=%s", cli.FlagDelegations, delegationsFile.Name()),
+		fmt.Sprintf("--%s=%s", cli.FlagKeyName, "unknown"),
+		fmt.Sprintf("--%s=%s", cli.FlagSecNonces, secNoncesFile),
+	})
+	require.Error(t, err)
+
+	// there is no BTC delegation to submit nonces for, sign or aggregate
+	// signatures on, and no secret nonce file is written
+	_, err = testutilcli.ExecTestCLICmd(clientCtx, cli.NewAddCovenantMuSig2NoncesCmd(), []string{
+		fmt.Sprintf("--%s=%s", cli.FlagDelegations, delegationsFile.Name()),
+		fmt.Sprintf("--%s=%s", cli.FlagKeyName, "cov"),
+		fmt.Sprintf("--%s=%s", cli.FlagSecNonces, secNoncesFile),
+	})
+	require.ErrorContains(t, err, "no BTC delegation to submit MuSig2 nonces for")
+	require.NoFileExists(t, secNoncesFile)
+
+	_, err = testutilcli.ExecTestCLICmd(clientCtx, cli.NewSignCovenantMuSig2Cmd(), []string{
+		fmt.Sprintf("--%s=%s", cli.FlagDelegations, delegationsFile.Name()),
+		fmt.Sprintf("--%s=%s", cli.FlagKeyName, "cov"),
+		fmt.Sprintf("--%s=%s", cli.FlagSecNonces, secNoncesFile),
+		fmt.Sprintf("--%s=%s", cli.FlagOutputFile, outputFile),
+	})
+	require.ErrorContains(t, err, "no BTC delegation to sign")
+	require.NoFileExists(t, outputFile)
+
+	_, err = testutilcli.ExecTestCLICmd(clientCtx, cli.NewAggregateCovenantMuSig2Cmd(), []string{
+		fmt.Sprintf("--%s=%s", cli.FlagDelegations, delegationsFile.Name()),
+		fmt.Sprintf("--%s=%s", cli.FlagPartialSigs, partialSigsFile.Name()),
+	})
+	require.ErrorContains(t, err, "no BTC delegation to aggregate signatures on")
+}
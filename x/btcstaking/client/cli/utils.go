@@ -1,13 +1,26 @@
 package cli
 
 import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"os"
+	"sort"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
 )
 
+// psbtMagic is the magic bytes prefixing a serialized PSBT, as per BIP174
+var psbtMagic = []byte{0x70, 0x73, 0x62, 0x74, 0xff}
+
 func parseLockTime(str string) (uint16, error) {
 	num, ok := sdkmath.NewIntFromString(str)
 
@@ -47,3 +60,88 @@ func parseBtcAmount(str string) (btcutil.Amount, error) {
 
 	return btcutil.Amount(asInt64), nil
 }
+
+// encodeUnsignedPsbt encodes the given unsigned tx as a base64 PSBT, as per
+// BIP174. The PSBT only contains the unsigned tx in its global map, and empty
+// maps for all inputs and outputs.
+func encodeUnsignedPsbt(tx *wire.MsgTx) (string, error) {
+	var txBuf bytes.Buffer
+	if err := tx.SerializeNoWitness(&txBuf); err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	buf.Write(psbtMagic)
+	// global map with the unsigned tx, whose key type is 0x00
+	if err := wire.WriteVarBytes(&buf, 0, []byte{0x00}); err != nil {
+		return "", err
+	}
+	if err := wire.WriteVarBytes(&buf, 0, txBuf.Bytes()); err != nil {
+		return "", err
+	}
+	buf.WriteByte(0x00)
+	// empty input and output maps
+	for range tx.TxIn {
+		buf.WriteByte(0x00)
+	}
+	for range tx.TxOut {
+		buf.WriteByte(0x00)
+	}
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// hasCovenantMuSig2Nonces returns whether the given covenant member is among
+// the ones with the given MuSig2 nonces
+func hasCovenantMuSig2Nonces(noncesList []*types.CovenantMuSig2Nonces, covPK *bbn.BIP340PubKey) bool {
+	for _, nonces := range noncesList {
+		if nonces.CovPk.Equals(covPK) {
+			return true
+		}
+	}
+	return false
+}
+
+// readSecNonces reads the secret MuSig2 nonces of a covenant member from the
+// given file, keyed by the staking tx hash of their BTC delegations. A missing
+// file has no secret nonces.
+func readSecNonces(path string) (map[string]*types.CovenantMuSig2SecNonces, error) {
+	secNonces := map[string]*types.CovenantMuSig2SecNonces{}
+	bz, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return secNonces, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var secNoncesList []*types.CovenantMuSig2SecNonces
+	if err := json.Unmarshal(bz, &secNoncesList); err != nil {
+		return nil, fmt.Errorf("invalid secret nonces file %s: %w", path, err)
+	}
+	for _, sn := range secNoncesList {
+		secNonces[sn.StakingTxHash] = sn
+	}
+	return secNonces, nil
+}
+
+// writeSecNonces writes the given secret MuSig2 nonces to the given file,
+// readable by the owner only. The file is replaced atomically, so that secret
+// nonces are never lost halfway.
+func writeSecNonces(path string, secNonces map[string]*types.CovenantMuSig2SecNonces) error {
+	secNoncesList := make([]*types.CovenantMuSig2SecNonces, 0, len(secNonces))
+	for _, sn := range secNonces {
+		secNoncesList = append(secNoncesList, sn)
+	}
+	sort.Slice(secNoncesList, func(i, j int) bool {
+		return secNoncesList[i].StakingTxHash < secNoncesList[j].StakingTxHash
+	})
+	bz, err := json.MarshalIndent(secNoncesList, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, bz, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
@@ -12,6 +12,7 @@ import (
 	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
 	bsmodule "github.com/babylonchain/babylon/x/btcstaking"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/golang/mock/gomock"
 )
 
@@ -36,7 +37,7 @@ func benchBeginBlock(b *testing.B, numFPs int, numDelsUnderFP int) {
 		fp, err := datagen.GenRandomFinalityProvider(r)
 		h.NoError(err)
 		msg := &types.MsgCreateFinalityProvider{
-			Signer:      datagen.GenRandomAccount().Address,
+			Signer:      sdk.AccAddress(fp.BabylonPk.Address()).String(),
 			Description: fp.Description,
 			Commission:  fp.Commission,
 			BabylonPk:   fp.BabylonPk,
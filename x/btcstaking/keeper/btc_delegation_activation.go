@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// ActivateMaturedBTCDelegations activates the BTC delegations with covenant
+// quorum whose activation heights are reached by the BTC tip, and removes them
+// from the activation index. A BTC delegation is not activated if it has
+// become unbonded in the meantime, or if the BTC header including its staking
+// tx is no longer on the canonical BTC chain.
+// This is triggered upon each `EndBlock`
+func (k Keeper) ActivateMaturedBTCDelegations(ctx context.Context) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	// get all BTC delegations whose activation heights are no higher than the
+	// BTC tip
+	store := k.btcDelActivationStore(ctx)
+	keys := [][]byte{}
+	stakingTxHashes := []chainhash.Hash{}
+	func() {
+		iter := store.Iterator(nil, sdk.Uint64ToBigEndian(btcTipHeight+1))
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			stakingTxHash, err := chainhash.NewHash(iter.Value())
+			if err != nil {
+				panic(err) // only programming error
+			}
+			keys = append(keys, iter.Key())
+			stakingTxHashes = append(stakingTxHashes, *stakingTxHash)
+		}
+	}()
+
+	for i, stakingTxHash := range stakingTxHashes {
+		store.Delete(keys[i])
+
+		btcDel := k.getBTCDelegation(ctx, stakingTxHash)
+		if btcDel == nil {
+			panic(types.ErrBTCDelegationNotFound) // only programming error
+		}
+
+		// the staking tx is reorged out of the BTC chain before becoming deep
+		// enough. Postpone the activation height to the end of the timelock,
+		// such that the BTC delegation never becomes active
+		if btcDel.StakingTxHeaderHash != nil && k.btclcKeeper.GetHeaderByHash(ctx, btcDel.StakingTxHeaderHash) == nil {
+			k.Logger(sdkCtx).Info("staking tx of maturing BTC delegation is no longer on the BTC chain", "staking tx hash", stakingTxHash.String())
+			btcDel.ActivationHeight = btcDel.EndHeight
+			k.setBTCDelegation(ctx, btcDel)
+			continue
+		}
+
+		// the BTC delegation is unbonded, compromised, or expired before
+		// becoming active
+		params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+		if params == nil {
+			panic(types.ErrParamsNotFound) // only programming error
+		}
+		if btcDel.GetStatus(btcTipHeight, wValue, params.CovenantQuorum) != types.BTCDelegationStatus_ACTIVE {
+			continue
+		}
+
+		k.activateBTCDelegation(sdkCtx, btcDel, btcTipHeight)
+	}
+}
+
+// setBTCDelActivation indexes the BTC delegation with the given staking tx
+// hash by the given activation height
+func (k Keeper) setBTCDelActivation(ctx context.Context, activationHeight uint64, stakingTxHash chainhash.Hash) {
+	store := k.btcDelActivationStore(ctx)
+	key := append(sdk.Uint64ToBigEndian(activationHeight), stakingTxHash[:]...)
+	store.Set(key, stakingTxHash[:])
+}
+
+// maturingBTCDelegations returns the staking tx hashes of all BTC delegations
+// in the activation index
+func (k Keeper) maturingBTCDelegations(ctx context.Context) []string {
+	stakingTxHashes := make([]string, 0)
+	iter := k.btcDelActivationStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		stakingTxHash, err := chainhash.NewHash(iter.Value())
+		if err != nil {
+			panic(err) // only programming error
+		}
+		stakingTxHashes = append(stakingTxHashes, stakingTxHash.String())
+	}
+
+	return stakingTxHashes
+}
+
+// btcDelActivationStore returns the KVStore of the BTC delegations with
+// covenant quorum indexed by their activation heights
+// prefix: BTCDelActivationKey
+// key: (activation height || staking tx hash)
+// value: staking tx hash
+func (k Keeper) btcDelActivationStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.BTCDelActivationKey)
+}
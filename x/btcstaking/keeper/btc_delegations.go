@@ -15,7 +15,9 @@ import (
 
 // AddBTCDelegation adds a BTC delegation post verification to the system, including
 // - indexing the given BTC delegation in the BTC delegator store,
-// - saving it under BTC delegation store, and
+// - saving it under BTC delegation store,
+// - indexing it by the hashes of its unbonding and slashing txs,
+// - indexing it as a pending BTC delegation until it receives covenant quorum, and
 // - emit events about this BTC delegation.
 func (k Keeper) AddBTCDelegation(ctx sdk.Context, btcDel *types.BTCDelegation) error {
 	if err := btcDel.ValidateBasic(); err != nil {
@@ -45,11 +47,14 @@ func (k Keeper) AddBTCDelegation(ctx sdk.Context, btcDel *types.BTCDelegation) e
 
 	// save this BTC delegation
 	k.setBTCDelegation(ctx, btcDel)
+	k.setBTCTxIndex(ctx, btcDel)
+	k.setPendingBTCDelegation(ctx, btcDel)
 
-	// notify subscriber
+	// notify subscriber, who may index the BTC delegation by its memo
 	event := &types.EventBTCDelegationStateUpdate{
 		StakingTxHash: stakingTxHash.String(),
 		NewState:      types.BTCDelegationStatus_PENDING,
+		Memo:          btcDel.Memo,
 	}
 	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
 		panic(fmt.Errorf("failed to emit EventBTCDelegationStateUpdate for the new pending BTC delegation: %w", err))
@@ -57,6 +62,8 @@ func (k Keeper) AddBTCDelegation(ctx sdk.Context, btcDel *types.BTCDelegation) e
 
 	// NOTE: we don't need to record events for pending BTC delegations since these
 	// do not affect voting power distribution
+	// record metrics
+	types.RecordNewBTCDelegation(types.BTCDelegationStatus_PENDING)
 
 	// record event that the BTC delegation will become unbonded at endHeight-w
 	unbondedEvent := types.NewEventPowerDistUpdateWithBTCDel(&types.EventBTCDelegationStateUpdate{
@@ -91,22 +98,55 @@ func (k Keeper) addCovenantSigsToBTCDelegation(
 	k.setBTCDelegation(ctx, btcDel)
 
 	// If reaching the covenant quorum after this msg, the BTC delegation becomes
-	// active. Then, record and emit this event
-	if len(btcDel.CovenantSigs) == int(params.CovenantQuorum) {
-		// notify subscriber
-		event := &types.EventBTCDelegationStateUpdate{
-			StakingTxHash: btcDel.MustGetStakingTxHash().String(),
-			NewState:      types.BTCDelegationStatus_ACTIVE,
+	// active once its staking tx is deep enough
+	if len(btcDel.CovenantSigs) == int(btcDel.GetCovenantQuorum(params.CovenantQuorum)) {
+		k.removePendingBTCDelegation(ctx, btcDel)
+
+		btcTip := k.btclcKeeper.GetTipInfo(ctx)
+		if btcTip.Height >= btcDel.StartHeight {
+			types.RecordCovenantQuorumLatency(btcTip.Height - btcDel.StartHeight)
 		}
-		if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
-			panic(fmt.Errorf("failed to emit EventBTCDelegationStateUpdate for the new active BTC delegation: %w", err))
+
+		if btcTip.Height < btcDel.ActivationHeight {
+			// the staking tx is not deep enough yet, activate the BTC
+			// delegation in `EndBlocker` when the BTC chain reaches its
+			// activation height
+			k.setBTCDelActivation(ctx, btcDel.ActivationHeight, btcDel.MustGetStakingTxHash())
+			return
 		}
+		k.activateBTCDelegation(ctx, btcDel, btcTip.Height)
+	}
+}
 
-		// record event that the BTC delegation becomes active at this height
-		activeEvent := types.NewEventPowerDistUpdateWithBTCDel(event)
-		btcTip := k.btclcKeeper.GetTipInfo(ctx)
-		k.addPowerDistUpdateEvent(ctx, btcTip.Height, activeEvent)
+// activateBTCDelegation records and emits the event that the given BTC
+// delegation becomes active at the given BTC height, and notifies the hooks
+func (k Keeper) activateBTCDelegation(ctx sdk.Context, btcDel *types.BTCDelegation, btcHeight uint64) {
+	// notify subscriber
+	event := &types.EventBTCDelegationStateUpdate{
+		StakingTxHash: btcDel.MustGetStakingTxHash().String(),
+		NewState:      types.BTCDelegationStatus_ACTIVE,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("failed to emit EventBTCDelegationStateUpdate for the new active BTC delegation: %w", err))
 	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBTCDelegationActivated{
+		StakingTxHash: event.StakingTxHash,
+		FpBtcPkList:   btcDel.FpBtcPkList,
+		StakerBtcPk:   btcDel.BtcPk,
+		TotalSat:      btcDel.TotalSat,
+		BabylonHeight: uint64(ctx.HeaderInfo().Height),
+		BtcHeight:     btcHeight,
+	}); err != nil {
+		panic(fmt.Errorf("failed to emit EventBTCDelegationActivated: %w", err))
+	}
+	k.AfterBTCDelegationActivated(ctx, btcDel)
+
+	// record event that the BTC delegation becomes active at this height
+	activeEvent := types.NewEventPowerDistUpdateWithBTCDel(event)
+	k.addPowerDistUpdateEvent(ctx, btcHeight, activeEvent)
+
+	// record metrics
+	types.RecordNewBTCDelegation(types.BTCDelegationStatus_ACTIVE)
 }
 
 // btcUndelegate adds the signature of the unbonding tx signed by the staker
@@ -118,6 +158,7 @@ func (k Keeper) btcUndelegate(
 ) {
 	btcDel.BtcUndelegation.DelegatorUnbondingSig = unbondingTxSig
 	k.setBTCDelegation(ctx, btcDel)
+	k.removePendingBTCDelegation(ctx, btcDel)
 
 	// notify subscriber about this unbonded BTC delegation
 	event := &types.EventBTCDelegationStateUpdate{
@@ -133,13 +174,48 @@ func (k Keeper) btcUndelegate(
 	unbondedEvent := types.NewEventPowerDistUpdateWithBTCDel(event)
 	btcTip := k.btclcKeeper.GetTipInfo(ctx)
 	k.addPowerDistUpdateEvent(ctx, btcTip.Height, unbondedEvent)
+	// record metrics
+	types.RecordNewBTCDelegation(types.BTCDelegationStatus_UNBONDED)
 }
 
+// compromiseBTCDelegation flags the given BTC delegation as compromised due
+// to the given tx spending its staking output outside the protocol, and
+// removes its voting power
+func (k Keeper) compromiseBTCDelegation(
+	ctx sdk.Context,
+	btcDel *types.BTCDelegation,
+	spendTxHash chainhash.Hash,
+) {
+	btcDel.CompromisingSpendTxHash = spendTxHash.String()
+	k.setBTCDelegation(ctx, btcDel)
+	k.removePendingBTCDelegation(ctx, btcDel)
+
+	// notify subscriber about this compromised BTC delegation
+	event := &types.EventBTCDelegationStateUpdate{
+		StakingTxHash: btcDel.MustGetStakingTxHash().String(),
+		NewState:      types.BTCDelegationStatus_COMPROMISED,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("failed to emit EventBTCDelegationStateUpdate for the compromised BTC delegation: %w", err))
+	}
+
+	// record event that the BTC delegation loses its voting power at this
+	// height
+	compromisedEvent := types.NewEventPowerDistUpdateWithBTCDel(event)
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	k.addPowerDistUpdateEvent(ctx, btcTip.Height, compromisedEvent)
+	// record metrics
+	types.RecordNewBTCDelegation(types.BTCDelegationStatus_COMPROMISED)
+}
+
+// setBTCDelegation saves the given BTC delegation, which has to carry its raw
+// BTC txs. The raw BTC txs are saved separately from the rest of the BTC
+// delegation
 func (k Keeper) setBTCDelegation(ctx context.Context, btcDel *types.BTCDelegation) {
-	store := k.btcDelegationStore(ctx)
 	stakingTxHash := btcDel.MustGetStakingTxHash()
-	btcDelBytes := k.cdc.MustMarshal(btcDel)
-	store.Set(stakingTxHash[:], btcDelBytes)
+	btcDelWithoutTxs, btcDelTxs := btcDel.SplitTxs()
+	k.btcDelegationStore(ctx).Set(stakingTxHash[:], k.cdc.MustMarshal(btcDelWithoutTxs))
+	k.btcDelegationTxsStore(ctx).Set(stakingTxHash[:], k.cdc.MustMarshal(btcDelTxs))
 }
 
 // GetBTCDelegation gets the BTC delegation with a given staking tx hash
@@ -157,9 +233,80 @@ func (k Keeper) GetBTCDelegation(ctx context.Context, stakingTxHashStr string) (
 	return btcDel, nil
 }
 
-func (k Keeper) getBTCDelegation(ctx context.Context, stakingTxHash chainhash.Hash) *types.BTCDelegation {
+// GetBTCDelegationStatus gets the status of the given BTC delegation at the
+// current BTC tip
+func (k Keeper) GetBTCDelegationStatus(ctx context.Context, btcDel *types.BTCDelegation) types.BTCDelegationStatus {
+	return btcDel.GetStatus(
+		k.btclcKeeper.GetTipInfo(ctx).Height,
+		k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout,
+		k.btcDelCovenantQuorum(ctx, btcDel),
+	)
+}
+
+// btcDelCovenantQuorum returns the covenant quorum in the params version of
+// the given BTC delegation. A BTC delegation is bound to the covenant
+// committee and quorum of the params under which it is created, so that
+// params updates do not change the status of existing BTC delegations
+func (k Keeper) btcDelCovenantQuorum(ctx context.Context, btcDel *types.BTCDelegation) uint32 {
+	params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if params == nil {
+		panic(fmt.Errorf("params version %d of a BTC delegation is not found", btcDel.ParamsVersion))
+	}
+	return params.CovenantQuorum
+}
+
+// covenantQuorumCache returns a function returning the covenant quorum in the
+// params version of a given BTC delegation, which caches the params versions
+// it loads. It is used for iterating over BTC delegations
+func (k Keeper) covenantQuorumCache(ctx context.Context) func(btcDel *types.BTCDelegation) uint32 {
+	quorums := map[uint32]uint32{}
+	return func(btcDel *types.BTCDelegation) uint32 {
+		quorum, ok := quorums[btcDel.ParamsVersion]
+		if !ok {
+			quorum = k.btcDelCovenantQuorum(ctx, btcDel)
+			quorums[btcDel.ParamsVersion] = quorum
+		}
+		return quorum
+	}
+}
+
+// GetStakingTxDepth gets the depth of the staking tx of the given BTC
+// delegation in the canonical BTC chain. It returns false if the BTC header
+// including the staking tx is no longer on the canonical BTC chain
+func (k Keeper) GetStakingTxDepth(ctx context.Context, btcDel *types.BTCDelegation) (uint64, bool) {
+	if btcDel.StakingTxHeaderHash != nil && k.btclcKeeper.GetHeaderByHash(ctx, btcDel.StakingTxHeaderHash) == nil {
+		return 0, false
+	}
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	if btcTipHeight < btcDel.StartHeight {
+		return 0, true
+	}
+	return btcTipHeight - btcDel.StartHeight, true
+}
+
+// HasBTCDelegation checks if the BTC delegation with the given staking tx hash
+// exists. It only checks the existence of the key without unmarshalling the
+// BTC delegation
+func (k Keeper) HasBTCDelegation(ctx context.Context, stakingTxHash chainhash.Hash) bool {
 	store := k.btcDelegationStore(ctx)
-	btcDelBytes := store.Get(stakingTxHash[:])
+	return store.Has(stakingTxHash[:])
+}
+
+func (k Keeper) getBTCDelegation(ctx context.Context, stakingTxHash chainhash.Hash) *types.BTCDelegation {
+	btcDel := k.getBTCDelegationWithoutTxs(ctx, stakingTxHash)
+	if btcDel == nil {
+		return nil
+	}
+	k.loadBTCDelegationTxs(ctx, stakingTxHash[:], btcDel)
+	return btcDel
+}
+
+// getBTCDelegationWithoutTxs gets the BTC delegation with the given staking tx
+// hash without loading its raw BTC txs. It is used in hot paths that do not
+// need the raw BTC txs, and the returned BTC delegation must not be saved
+// back
+func (k Keeper) getBTCDelegationWithoutTxs(ctx context.Context, stakingTxHash chainhash.Hash) *types.BTCDelegation {
+	btcDelBytes := k.btcDelegationStore(ctx).Get(stakingTxHash[:])
 	if len(btcDelBytes) == 0 {
 		return nil
 	}
@@ -168,11 +315,35 @@ func (k Keeper) getBTCDelegation(ctx context.Context, stakingTxHash chainhash.Ha
 	return &btcDel
 }
 
+// loadBTCDelegationTxs loads the raw BTC txs of the BTC delegation with the
+// given staking tx hash into the given BTC delegation, which is read from the
+// BTC delegation store
+func (k Keeper) loadBTCDelegationTxs(ctx context.Context, stakingTxHash []byte, btcDel *types.BTCDelegation) {
+	btcDelTxsBytes := k.btcDelegationTxsStore(ctx).Get(stakingTxHash)
+	if len(btcDelTxsBytes) == 0 {
+		// the raw BTC txs are saved along with each BTC delegation
+		panic(fmt.Errorf("raw BTC txs of BTC delegation %x are not found", stakingTxHash))
+	}
+	var btcDelTxs types.BTCDelegationTxs
+	k.cdc.MustUnmarshal(btcDelTxsBytes, &btcDelTxs)
+	btcDel.AttachTxs(&btcDelTxs)
+}
+
 // btcDelegationStore returns the KVStore of the BTC delegations
 // prefix: BTCDelegationKey
 // key: BTC delegation's staking tx hash
-// value: BTCDelegation
+// value: BTCDelegation without the raw BTC txs
 func (k Keeper) btcDelegationStore(ctx context.Context) prefix.Store {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	return prefix.NewStore(storeAdapter, types.BTCDelegationKey)
 }
+
+// btcDelegationTxsStore returns the KVStore of the raw BTC txs of the BTC
+// delegations
+// prefix: BTCDelegationTxsKey
+// key: BTC delegation's staking tx hash
+// value: BTCDelegationTxs
+func (k Keeper) btcDelegationTxsStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.BTCDelegationTxsKey)
+}
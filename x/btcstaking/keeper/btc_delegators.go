@@ -24,12 +24,12 @@ func (k Keeper) getBTCDelegatorDelegationIndex(ctx context.Context, fpBTCPK *bbn
 	}
 
 	// ensure BTC delegator exists
-	if !store.Has(delBTCPKBytes) {
+	btcDelIndexBytes := store.Get(delBTCPKBytes)
+	if btcDelIndexBytes == nil {
 		return nil
 	}
-	// get and unmarshal
+	// unmarshal
 	var btcDelIndex types.BTCDelegatorDelegationIndex
-	btcDelIndexBytes := store.Get(delBTCPKBytes)
 	k.cdc.MustUnmarshal(btcDelIndexBytes, &btcDelIndex)
 	return &btcDelIndex
 }
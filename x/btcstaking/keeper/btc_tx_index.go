@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// setBTCTxIndex indexes the unbonding tx, slashing tx and unbonding slashing
+// tx of the given BTC delegation by their hashes. The BTC delegation has to
+// carry its raw BTC txs. The staking tx does not need to be indexed as it is
+// the key of the BTC delegation
+func (k Keeper) setBTCTxIndex(ctx context.Context, btcDel *types.BTCDelegation) {
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+	store := k.btcTxIndexStore(ctx)
+	for _, tx := range btcDel.MustGetIndexedTxHashes() {
+		entry := &types.BTCTxIndexEntry{
+			TxType:        tx.TxType,
+			StakingTxHash: stakingTxHash[:],
+		}
+		store.Set(tx.TxHash[:], k.cdc.MustMarshal(entry))
+	}
+}
+
+// removeBTCTxIndex removes the index entries of the BTC txs of the given BTC
+// delegation. The BTC delegation has to carry its raw BTC txs
+func (k Keeper) removeBTCTxIndex(ctx context.Context, btcDel *types.BTCDelegation) {
+	store := k.btcTxIndexStore(ctx)
+	for _, tx := range btcDel.MustGetIndexedTxHashes() {
+		store.Delete(tx.TxHash[:])
+	}
+}
+
+// getBTCTxIndexEntry returns the index entry of the BTC tx with the given
+// hash, or nil if it is not an unbonding tx, slashing tx or unbonding
+// slashing tx of any BTC delegation
+func (k Keeper) getBTCTxIndexEntry(ctx context.Context, txHash chainhash.Hash) *types.BTCTxIndexEntry {
+	store := k.btcTxIndexStore(ctx)
+	entryBytes := store.Get(txHash[:])
+	if len(entryBytes) == 0 {
+		return nil
+	}
+	var entry types.BTCTxIndexEntry
+	k.cdc.MustUnmarshal(entryBytes, &entry)
+	return &entry
+}
+
+// btcTxIndexStore returns the KVStore of the index of the BTC txs of BTC
+// delegations other than their staking txs
+// prefix: BTCTxIndexKey
+// key: BTC tx hash
+// value: BTCTxIndexEntry
+func (k Keeper) btcTxIndexStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.BTCTxIndexKey)
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// HasConsumerFinalityProvider checks if the finality provider with the given
+// BTC PK secures the given consumer chain
+func (k Keeper) HasConsumerFinalityProvider(ctx context.Context, consumerID string, fpBTCPK []byte) bool {
+	return k.consumerFPStore(ctx, consumerID).Has(fpBTCPK)
+}
+
+// IterateConsumerFPs iterates over all finality providers securing the given
+// consumer chain
+func (k Keeper) IterateConsumerFPs(ctx context.Context, consumerID string, handler func(fp *types.FinalityProvider) (shouldContinue bool)) {
+	iter := k.consumerFPStore(ctx, consumerID).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		fp, err := k.GetFinalityProvider(ctx, iter.Key())
+		if err != nil {
+			// a finality provider in the consumer index has to exist
+			panic(fmt.Errorf("failed to get indexed finality provider: %w", err))
+		}
+		if !handler(fp) {
+			return
+		}
+	}
+}
+
+// SetConsumerVotingPower sets the voting power of the given finality provider
+// securing the given consumer chain at the given Babylon height
+func (k Keeper) SetConsumerVotingPower(ctx context.Context, consumerID string, fpBTCPK []byte, height uint64, power uint64) {
+	store := k.consumerVotingPowerBbnBlockHeightStore(ctx, consumerID, height)
+	store.Set(fpBTCPK, sdk.Uint64ToBigEndian(power))
+}
+
+// GetConsumerVotingPower gets the voting power of the given finality provider
+// securing the given consumer chain at the given Babylon height
+func (k Keeper) GetConsumerVotingPower(ctx context.Context, consumerID string, fpBTCPK []byte, height uint64) uint64 {
+	store := k.consumerVotingPowerBbnBlockHeightStore(ctx, consumerID, height)
+	powerBytes := store.Get(fpBTCPK)
+	if len(powerBytes) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(powerBytes)
+}
+
+// GetConsumerVotingPowerTable gets the voting power table, i.e., the finality
+// provider set of the given consumer chain at the given Babylon height
+func (k Keeper) GetConsumerVotingPowerTable(ctx context.Context, consumerID string, height uint64) map[string]uint64 {
+	store := k.consumerVotingPowerBbnBlockHeightStore(ctx, consumerID, height)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	// if no finality provider at this height, return nil
+	if !iter.Valid() {
+		return nil
+	}
+
+	fpSet := map[string]uint64{}
+	for ; iter.Valid(); iter.Next() {
+		fpBTCPK, err := bbn.NewBIP340PubKey(iter.Key())
+		if err != nil {
+			// failing to unmarshal finality provider BTC PK in KVStore is a programming error
+			panic(fmt.Errorf("%w: %w", bbn.ErrUnmarshal, err))
+		}
+		fpSet[fpBTCPK.MarshalHex()] = sdk.BigEndianToUint64(iter.Value())
+	}
+
+	return fpSet
+}
+
+// consumerFPStore returns the KVStore of the finality providers securing the
+// given consumer chain
+// prefix: (ConsumerFPKey || length-prefixed consumer ID)
+// key: Bitcoin secp256k1 PK
+// value: empty
+func (k Keeper) consumerFPStore(ctx context.Context, consumerID string) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	consumerFPStore := prefix.NewStore(storeAdapter, types.ConsumerFPKey)
+	return prefix.NewStore(consumerFPStore, address.MustLengthPrefix([]byte(consumerID)))
+}
+
+// consumerVotingPowerBbnBlockHeightStore returns the KVStore of the voting
+// power of the finality providers securing the given consumer chain
+// prefix: (ConsumerVotingPowerKey || length-prefixed consumer ID || Babylon block height)
+// key: Bitcoin secp256k1 PK
+// value: voting power quantified in Satoshi
+func (k Keeper) consumerVotingPowerBbnBlockHeightStore(ctx context.Context, consumerID string, height uint64) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	consumerVPStore := prefix.NewStore(storeAdapter, types.ConsumerVotingPowerKey)
+	consumerStore := prefix.NewStore(consumerVPStore, address.MustLengthPrefix([]byte(consumerID)))
+	return prefix.NewStore(consumerStore, sdk.Uint64ToBigEndian(height))
+}
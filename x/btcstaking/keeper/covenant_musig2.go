@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// setCovenantMuSig2Nonces records the MuSig2 nonces of a covenant member for
+// co-signing the BTC delegation with the given staking tx hash
+func (k Keeper) setCovenantMuSig2Nonces(ctx context.Context, stakingTxHash chainhash.Hash, nonces *types.CovenantMuSig2Nonces) {
+	store := k.covenantMuSig2NoncesStore(ctx)
+	key := append(stakingTxHash[:], nonces.CovPk.MustMarshal()...)
+	store.Set(key, k.cdc.MustMarshal(nonces))
+}
+
+// hasCovenantMuSig2Nonces returns whether the given covenant member has
+// submitted MuSig2 nonces for the BTC delegation with the given staking tx
+// hash
+func (k Keeper) hasCovenantMuSig2Nonces(ctx context.Context, stakingTxHash chainhash.Hash, covPK *bbn.BIP340PubKey) bool {
+	store := k.covenantMuSig2NoncesStore(ctx)
+	key := append(stakingTxHash[:], covPK.MustMarshal()...)
+	return store.Has(key)
+}
+
+// GetCovenantMuSig2Nonces returns the MuSig2 nonces of all covenant members
+// that have submitted them for the BTC delegation with the given staking tx
+// hash, in the order of their PKs
+func (k Keeper) GetCovenantMuSig2Nonces(ctx context.Context, stakingTxHash chainhash.Hash) []*types.CovenantMuSig2Nonces {
+	store := prefix.NewStore(k.covenantMuSig2NoncesStore(ctx), stakingTxHash[:])
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var noncesList []*types.CovenantMuSig2Nonces
+	for ; iter.Valid(); iter.Next() {
+		var nonces types.CovenantMuSig2Nonces
+		k.cdc.MustUnmarshal(iter.Value(), &nonces)
+		noncesList = append(noncesList, &nonces)
+	}
+	return noncesList
+}
+
+// removeCovenantMuSig2Nonces removes the MuSig2 nonces of all covenant members
+// for the BTC delegation with the given staking tx hash. Nonces are no longer
+// needed once the aggregate covenant signatures are submitted.
+func (k Keeper) removeCovenantMuSig2Nonces(ctx context.Context, stakingTxHash chainhash.Hash) {
+	store := prefix.NewStore(k.covenantMuSig2NoncesStore(ctx), stakingTxHash[:])
+	iter := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// covenantMuSig2NoncesStore returns the KVStore of the MuSig2 nonces of
+// covenant members
+// prefix: CovenantMuSig2NoncesKey
+// key: staking tx hash || covenant member's BTC PK
+// value: CovenantMuSig2Nonces
+func (k Keeper) covenantMuSig2NoncesStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.CovenantMuSig2NoncesKey)
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// recordCovenantSigs records that the given covenant signer has signed the
+// given BTC delegation. If the BTC delegation reaches the covenant quorum with
+// these signatures, the covenant signers that have not signed it are recorded
+// to have missed it.
+func (k Keeper) recordCovenantSigs(
+	ctx context.Context,
+	btcDel *types.BTCDelegation,
+	covPK *bbn.BIP340PubKey,
+	params *types.Params,
+) {
+	// BTC delegations created before their creation height was recorded are
+	// not accounted
+	if btcDel.CreatedBabylonHeight == 0 {
+		return
+	}
+	curHeight := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	if curHeight < btcDel.CreatedBabylonHeight {
+		return
+	}
+	signingBlocks := curHeight - btcDel.CreatedBabylonHeight
+
+	perf := k.GetCovenantPerformance(ctx, covPK)
+	perf.NumSigned++
+	perf.TotalSigningBlocks += signingBlocks
+	k.setCovenantPerformance(ctx, perf)
+
+	// signatures received after the covenant quorum are not accepted, so
+	// reaching the quorum concludes the signing of the BTC delegation
+	if !btcDel.HasCovenantQuorums(params.CovenantQuorum) {
+		return
+	}
+	k.recordCovenantMisses(ctx, btcDel, params)
+}
+
+// recordCovenantMisses records that the covenant signers under the given
+// params that have not signed the given BTC delegation have missed it. It is
+// invoked once the signing of the BTC delegation concludes, i.e., when it
+// reaches the covenant quorum or expires without it.
+func (k Keeper) recordCovenantMisses(
+	ctx context.Context,
+	btcDel *types.BTCDelegation,
+	params *types.Params,
+) {
+	// BTC delegations created before their creation height was recorded are
+	// not accounted
+	if btcDel.CreatedBabylonHeight == 0 {
+		return
+	}
+	covenantSigners, _, err := params.CovenantSigners(btcDel.ScriptTemplateVersion)
+	if err != nil {
+		// the script template of the delegation has passed verification
+		panic(fmt.Errorf("failed to get covenant signers of a verified delegation: %w", err))
+	}
+	for i := range covenantSigners {
+		signer := covenantSigners[i]
+		if btcDel.IsSignedByCovMember(&signer) {
+			continue
+		}
+		missedPerf := k.GetCovenantPerformance(ctx, &signer)
+		missedPerf.NumMissed++
+		k.setCovenantPerformance(ctx, missedPerf)
+	}
+}
+
+// GetCovenantPerformance returns the signing record of the given covenant
+// signer, which is empty if the covenant signer has not signed or missed any
+// BTC delegation
+func (k Keeper) GetCovenantPerformance(ctx context.Context, covPK *bbn.BIP340PubKey) *types.CovenantPerformance {
+	store := k.covenantPerformanceStore(ctx)
+	perfBytes := store.Get(covPK.MustMarshal())
+	if len(perfBytes) == 0 {
+		return &types.CovenantPerformance{CovPk: covPK}
+	}
+	var perf types.CovenantPerformance
+	k.cdc.MustUnmarshal(perfBytes, &perf)
+	return &perf
+}
+
+func (k Keeper) setCovenantPerformance(ctx context.Context, perf *types.CovenantPerformance) {
+	store := k.covenantPerformanceStore(ctx)
+	store.Set(perf.CovPk.MustMarshal(), k.cdc.MustMarshal(perf))
+}
+
+// covenantPerformanceStore returns the KVStore of the signing records of
+// covenant signers
+// prefix: CovenantPerformanceKey
+// key: covenant signer's BTC PK
+// value: CovenantPerformance
+func (k Keeper) covenantPerformanceStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.CovenantPerformanceKey)
+}
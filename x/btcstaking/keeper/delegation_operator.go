@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// setDelegationOperator authorizes the given operator to undelegate the BTC
+// delegation with the given staking tx hash on behalf of the staker
+func (k Keeper) setDelegationOperator(ctx context.Context, stakingTxHash chainhash.Hash, operator sdk.AccAddress) {
+	store := k.delegationOperatorStore(ctx)
+	store.Set(stakingTxHash[:], operator)
+}
+
+// removeDelegationOperator revokes the operator of the BTC delegation with the
+// given staking tx hash, if any
+func (k Keeper) removeDelegationOperator(ctx context.Context, stakingTxHash chainhash.Hash) {
+	store := k.delegationOperatorStore(ctx)
+	store.Delete(stakingTxHash[:])
+}
+
+// GetDelegationOperator returns the operator authorized to undelegate the BTC
+// delegation with the given staking tx hash, or nil if there is none
+func (k Keeper) GetDelegationOperator(ctx context.Context, stakingTxHash chainhash.Hash) sdk.AccAddress {
+	store := k.delegationOperatorStore(ctx)
+	operatorBytes := store.Get(stakingTxHash[:])
+	if len(operatorBytes) == 0 {
+		return nil
+	}
+	return sdk.AccAddress(operatorBytes)
+}
+
+// isAuthorizedUndelegator returns whether the given signer is allowed to
+// undelegate the given BTC delegation, i.e., whether the signer is either the
+// staker or the operator authorized by the staker
+func (k Keeper) isAuthorizedUndelegator(ctx context.Context, btcDel *types.BTCDelegation, signer sdk.AccAddress) bool {
+	if signer.Equals(btcDel.StakerAddress()) {
+		return true
+	}
+	operator := k.GetDelegationOperator(ctx, btcDel.MustGetStakingTxHash())
+	return operator != nil && signer.Equals(operator)
+}
+
+// delegationOperatorStore returns the KVStore of the BTC delegation operators
+// prefix: DelegationOperatorKey
+// key: staking tx hash
+// value: operator address
+func (k Keeper) delegationOperatorStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.DelegationOperatorKey)
+}
@@ -15,6 +15,9 @@ func (k Keeper) SetFinalityProvider(ctx context.Context, fp *types.FinalityProvi
 	store := k.finalityProviderStore(ctx)
 	fpBytes := k.cdc.MustMarshal(fp)
 	store.Set(fp.BtcPk.MustMarshal(), fpBytes)
+	if fp.SecuresConsumer() {
+		k.consumerFPStore(ctx, fp.ConsumerId).Set(fp.BtcPk.MustMarshal(), []byte{})
+	}
 }
 
 // HasFinalityProvider checks if the finality provider exists
@@ -26,18 +29,19 @@ func (k Keeper) HasFinalityProvider(ctx context.Context, fpBTCPK []byte) bool {
 // GetFinalityProvider gets the finality provider with the given finality provider Bitcoin PK
 func (k Keeper) GetFinalityProvider(ctx context.Context, fpBTCPK []byte) (*types.FinalityProvider, error) {
 	store := k.finalityProviderStore(ctx)
-	if !k.HasFinalityProvider(ctx, fpBTCPK) {
+	fpBytes := store.Get(fpBTCPK)
+	if fpBytes == nil {
 		return nil, types.ErrFpNotFound
 	}
-	fpBytes := store.Get(fpBTCPK)
 	var fp types.FinalityProvider
 	k.cdc.MustUnmarshal(fpBytes, &fp)
 	return &fp, nil
 }
 
-// SlashFinalityProvider slashes a finality provider with the given PK
+// SlashFinalityProvider slashes a finality provider with the given PK for an
+// infraction at the given Babylon height
 // A slashed finality provider will not have voting power
-func (k Keeper) SlashFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
+func (k Keeper) SlashFinalityProvider(ctx context.Context, fpBTCPK []byte, infractionHeight uint64) error {
 	// ensure finality provider exists
 	fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
 	if err != nil {
@@ -62,6 +66,82 @@ func (k Keeper) SlashFinalityProvider(ctx context.Context, fpBTCPK []byte) error
 	// event for updating the finality provider set
 	powerUpdateEvent := types.NewEventPowerDistUpdateWithSlashedFP(fp.BtcPk)
 	k.addPowerDistUpdateEvent(ctx, btcTip.Height, powerUpdateEvent)
+	// record metrics
+	types.RecordNewSlashedFinalityProvider()
+
+	// notify subscribers, e.g., the incentive module that claws back
+	// rewards of the slashed finality provider
+	k.AfterFinalityProviderSlashed(ctx, fp, infractionHeight)
+
+	return nil
+}
+
+// JailFinalityProvider jails a finality provider with the given PK due to
+// insufficient liveness. A jailed finality provider loses its voting power
+// from the next height on, while its BTC delegations remain intact.
+func (k Keeper) JailFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
+	// ensure finality provider exists
+	fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
+	if err != nil {
+		return err
+	}
+
+	// ensure finality provider is not slashed or jailed yet
+	if fp.IsSlashed() {
+		return types.ErrFpAlreadySlashed
+	}
+	if fp.Jailed {
+		return types.ErrFpAlreadyJailed
+	}
+
+	// set finality provider to be jailed
+	fp.Jailed = true
+	k.SetFinalityProvider(ctx, fp)
+
+	// record jailed event. The next `BeginBlock` will consume this
+	// event for updating the finality provider set
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	if btcTip == nil {
+		return fmt.Errorf("failed to get current BTC tip")
+	}
+	powerUpdateEvent := types.NewEventPowerDistUpdateWithJailedFP(fp.BtcPk)
+	k.addPowerDistUpdateEvent(ctx, btcTip.Height, powerUpdateEvent)
+
+	// notify subscribers
+	k.AfterFinalityProviderJailed(ctx, fp)
+
+	return nil
+}
+
+// UnjailFinalityProvider unjails a jailed finality provider with the given
+// PK. The finality provider regains its voting power from the next height on.
+func (k Keeper) UnjailFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
+	// ensure finality provider exists
+	fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
+	if err != nil {
+		return err
+	}
+
+	// ensure finality provider is jailed and not slashed
+	if fp.IsSlashed() {
+		return types.ErrFpAlreadySlashed
+	}
+	if !fp.Jailed {
+		return types.ErrFpNotJailed
+	}
+
+	// set finality provider to be unjailed
+	fp.Jailed = false
+	k.SetFinalityProvider(ctx, fp)
+
+	// record unjailed event. The next `BeginBlock` will consume this
+	// event for updating the finality provider set
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	if btcTip == nil {
+		return fmt.Errorf("failed to get current BTC tip")
+	}
+	powerUpdateEvent := types.NewEventPowerDistUpdateWithUnjailedFP(fp.BtcPk)
+	k.addPowerDistUpdateEvent(ctx, btcTip.Height, powerUpdateEvent)
 
 	return nil
 }
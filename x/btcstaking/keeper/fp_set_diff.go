@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// RecordFinalityProviderSetDiff compares the active finality provider set of
+// Babylon at the current height with the one at the previous height. If they
+// differ, e.g., a finality provider drops out of or re-enters the top
+// `MaxActiveFinalityProviders`, it records the diff and emits an
+// EventFinalityProviderSetChange. This is triggered upon each `EndBlock`.
+func (k Keeper) RecordFinalityProviderSetDiff(ctx context.Context) {
+	height := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	if height == 0 {
+		return
+	}
+
+	diff := diffVotingPowerTables(
+		k.GetVotingPowerTable(ctx, height-1),
+		k.GetVotingPowerTable(ctx, height),
+	)
+	if len(diff.Entered) == 0 && len(diff.Exited) == 0 {
+		return
+	}
+	diff.BabylonHeight = height
+
+	k.setLastFinalityProviderSetDiff(ctx, diff)
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventFinalityProviderSetChange{Diff: diff}); err != nil {
+		panic(fmt.Errorf("failed to emit EventFinalityProviderSetChange: %w", err))
+	}
+}
+
+// diffVotingPowerTables returns the finality providers that are in the new
+// voting power table but not in the old one, and vice versa, ordered by their
+// BTC PKs
+func diffVotingPowerTables(oldTable, newTable map[string]uint64) *types.FinalityProviderSetDiff {
+	diff := &types.FinalityProviderSetDiff{}
+	for fpBTCPKHex, power := range newTable {
+		if _, ok := oldTable[fpBTCPKHex]; !ok {
+			diff.Entered = append(diff.Entered, newFinalityProviderPower(fpBTCPKHex, power))
+		}
+	}
+	for fpBTCPKHex, power := range oldTable {
+		if _, ok := newTable[fpBTCPKHex]; !ok {
+			diff.Exited = append(diff.Exited, newFinalityProviderPower(fpBTCPKHex, power))
+		}
+	}
+
+	// order the finality providers deterministically
+	sortFinalityProviderPowers(diff.Entered)
+	sortFinalityProviderPowers(diff.Exited)
+
+	return diff
+}
+
+func newFinalityProviderPower(fpBTCPKHex string, power uint64) *types.FinalityProviderPower {
+	fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(fpBTCPKHex)
+	if err != nil {
+		// the voting power table only contains valid BTC PKs
+		panic(fmt.Errorf("%w: %w", bbn.ErrUnmarshal, err))
+	}
+	return &types.FinalityProviderPower{
+		FpBtcPk:     fpBTCPK,
+		VotingPower: power,
+	}
+}
+
+func sortFinalityProviderPowers(fps []*types.FinalityProviderPower) {
+	sort.SliceStable(fps, func(i, j int) bool {
+		return fps[i].FpBtcPk.MarshalHex() < fps[j].FpBtcPk.MarshalHex()
+	})
+}
+
+// GetLastFinalityProviderSetDiff returns the last change of the active
+// finality provider set, or nil if the set has never changed
+func (k Keeper) GetLastFinalityProviderSetDiff(ctx context.Context) *types.FinalityProviderSetDiff {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.LastFPSetDiffKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var diff types.FinalityProviderSetDiff
+	k.cdc.MustUnmarshal(bz, &diff)
+	return &diff
+}
+
+func (k Keeper) setLastFinalityProviderSetDiff(ctx context.Context, diff *types.FinalityProviderSetDiff) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.LastFPSetDiffKey, k.cdc.MustMarshal(diff)); err != nil {
+		panic(err)
+	}
+}
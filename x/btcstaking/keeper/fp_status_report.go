@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// setFpStatusReport sets the status report announced by a finality provider
+func (k Keeper) setFpStatusReport(ctx context.Context, report *types.FinalityProviderStatusReport) {
+	store := k.fpStatusReportStore(ctx)
+	store.Set(report.FpBtcPk.MustMarshal(), k.cdc.MustMarshal(report))
+}
+
+// removeFpStatusReport removes the status report announced by the given
+// finality provider, if any
+func (k Keeper) removeFpStatusReport(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) {
+	store := k.fpStatusReportStore(ctx)
+	store.Delete(fpBTCPK.MustMarshal())
+}
+
+// GetFpStatusReport returns the status report announced by the given finality
+// provider
+func (k Keeper) GetFpStatusReport(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) (*types.FinalityProviderStatusReport, error) {
+	store := k.fpStatusReportStore(ctx)
+	reportBytes := store.Get(fpBTCPK.MustMarshal())
+	if len(reportBytes) == 0 {
+		return nil, types.ErrFpStatusReportNotFound.Wrapf("finality provider: %s", fpBTCPK.MarshalHex())
+	}
+	var report types.FinalityProviderStatusReport
+	k.cdc.MustUnmarshal(reportBytes, &report)
+	return &report, nil
+}
+
+// fpStatusReportStore returns the KVStore of the finality provider status
+// reports
+// prefix: FpStatusReportKey
+// key: finality provider's BTC PK
+// value: FinalityProviderStatusReport
+func (k Keeper) fpStatusReportStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.FpStatusReportKey)
+}
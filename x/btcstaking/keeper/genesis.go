@@ -7,11 +7,19 @@ import (
 	btcstk "github.com/babylonchain/babylon/btcstaking"
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
+	// the params have to be for the BTC network the node is configured with,
+	// otherwise the node would operate on a different network than the one
+	// the genesis is created for
+	if err := k.validateParamsNetwork(gs); err != nil {
+		return err
+	}
+
 	// save all past params versions
 	for _, p := range gs.Params {
 		params := p
@@ -26,6 +34,7 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 
 	for _, btcDel := range gs.BtcDelegations {
 		k.setBTCDelegation(ctx, btcDel)
+		k.setBTCTxIndex(ctx, btcDel)
 	}
 
 	for _, fpVP := range gs.VotingPowers {
@@ -55,6 +64,91 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 		k.setVotingPowerDistCache(ctx, vpCache.BlockHeight, vpCache.VpDistribution)
 	}
 
+	for _, report := range gs.SlashingRateReports {
+		k.setSlashingRateChangeReport(ctx, report)
+	}
+
+	if gs.ScheduledParams != nil {
+		k.setScheduledParams(ctx, gs.ScheduledParams)
+	}
+
+	for _, op := range gs.DelegationOperators {
+		stakingTxHash, err := chainhash.NewHashFromStr(op.StakingTxHash)
+		if err != nil {
+			return err
+		}
+		operator, err := sdk.AccAddressFromBech32(op.Operator)
+		if err != nil {
+			return err
+		}
+		k.setDelegationOperator(ctx, *stakingTxHash, operator)
+	}
+
+	for _, backup := range gs.WatchtowerBackups {
+		stakingTxHash, err := chainhash.NewHashFromStr(backup.StakingTxHash)
+		if err != nil {
+			return err
+		}
+		k.setWatchtowerBackup(ctx, *stakingTxHash, backup)
+	}
+
+	for _, report := range gs.FpStatusReports {
+		k.setFpStatusReport(ctx, report)
+	}
+
+	for _, entry := range gs.CovenantMusig2Nonces {
+		stakingTxHash, err := chainhash.NewHashFromStr(entry.StakingTxHash)
+		if err != nil {
+			return err
+		}
+		k.setCovenantMuSig2Nonces(ctx, *stakingTxHash, entry.Nonces)
+	}
+
+	for _, perf := range gs.CovenantPerformances {
+		k.setCovenantPerformance(ctx, perf)
+	}
+
+	for _, stakingTxHashStr := range gs.MaturingBtcDelegations {
+		btcDel, err := k.GetBTCDelegation(ctx, stakingTxHashStr)
+		if err != nil {
+			return err
+		}
+		k.setBTCDelActivation(ctx, btcDel.ActivationHeight, btcDel.MustGetStakingTxHash())
+	}
+
+	for _, stakingTxHashStr := range gs.PendingBtcDelegations {
+		btcDel, err := k.GetBTCDelegation(ctx, stakingTxHashStr)
+		if err != nil {
+			return err
+		}
+		k.setPendingBTCDelegation(ctx, btcDel)
+	}
+
+	if gs.PauseState != nil {
+		k.setPauseState(ctx, gs.PauseState)
+	}
+
+	if gs.LastFpSetDiff != nil {
+		k.setLastFinalityProviderSetDiff(ctx, gs.LastFpSetDiff)
+	}
+
+	return nil
+}
+
+// validateParamsNetwork checks that the slashing addresses of all params in
+// the genesis are for the BTC network of the keeper and of the address types
+// allowed by the params
+func (k Keeper) validateParamsNetwork(gs types.GenesisState) error {
+	for i, p := range gs.Params {
+		if err := p.ValidateWithBTCNet(k.btcNet); err != nil {
+			return fmt.Errorf("params version %d do not match the configured BTC network: %w", i, err)
+		}
+	}
+	if gs.ScheduledParams != nil {
+		if err := gs.ScheduledParams.Params.ValidateWithBTCNet(k.btcNet); err != nil {
+			return fmt.Errorf("scheduled params do not match the configured BTC network: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -90,6 +184,31 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	reports, err := k.slashingRateChangeReports(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	operators, err := k.delegationOperators(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nonces, err := k.covenantMuSig2NoncesEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	covPerfs, err := k.covenantPerformances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	backups, err := k.watchtowerBackups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
 		Params:            k.GetAllParams(ctx),
 		FinalityProviders: fps,
@@ -99,6 +218,18 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		BtcDelegators:     btcDels,
 		Events:            evts,
 		VpDstCache:        vpsCache,
+
+		SlashingRateReports:    reports,
+		ScheduledParams:        k.GetScheduledParams(ctx),
+		DelegationOperators:    operators,
+		FpStatusReports:        k.fpStatusReports(ctx),
+		CovenantMusig2Nonces:   nonces,
+		CovenantPerformances:   covPerfs,
+		MaturingBtcDelegations: k.maturingBTCDelegations(ctx),
+		PendingBtcDelegations:  k.pendingBTCDelegations(ctx),
+		PauseState:             k.exportPauseState(ctx),
+		LastFpSetDiff:          k.GetLastFinalityProviderSetDiff(ctx),
+		WatchtowerBackups:      backups,
 	}, nil
 }
 
@@ -128,6 +259,7 @@ func (k Keeper) btcDelegations(ctx context.Context) ([]*types.BTCDelegation, err
 		if err := del.Unmarshal(iter.Value()); err != nil {
 			return nil, err
 		}
+		k.loadBTCDelegationTxs(ctx, iter.Key(), &del)
 		dels = append(dels, &del)
 	}
 
@@ -297,3 +429,110 @@ func parseBIP340PubKeysFromStoreKey(key []byte) (fpBTCPK, delBTCPK *bbn.BIP340Pu
 
 	return fpBTCPK, delBTCPK, nil
 }
+
+func (k Keeper) slashingRateChangeReports(ctx context.Context) ([]*types.SlashingRateChangeReport, error) {
+	reports := make([]*types.SlashingRateChangeReport, 0)
+	iter := k.slashingRateReportStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var report types.SlashingRateChangeReport
+		if err := report.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+		reports = append(reports, &report)
+	}
+
+	return reports, nil
+}
+
+func (k Keeper) delegationOperators(ctx context.Context) ([]*types.DelegationOperator, error) {
+	operators := make([]*types.DelegationOperator, 0)
+	iter := k.delegationOperatorStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		stakingTxHash, err := chainhash.NewHash(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		operators = append(operators, &types.DelegationOperator{
+			StakingTxHash: stakingTxHash.String(),
+			Operator:      sdk.AccAddress(iter.Value()).String(),
+		})
+	}
+
+	return operators, nil
+}
+
+func (k Keeper) watchtowerBackups(ctx context.Context) ([]*types.WatchtowerBackup, error) {
+	backups := make([]*types.WatchtowerBackup, 0)
+	iter := k.watchtowerBackupStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var backup types.WatchtowerBackup
+		if err := k.cdc.Unmarshal(iter.Value(), &backup); err != nil {
+			return nil, err
+		}
+		backups = append(backups, &backup)
+	}
+
+	return backups, nil
+}
+
+func (k Keeper) fpStatusReports(ctx context.Context) []*types.FinalityProviderStatusReport {
+	reports := make([]*types.FinalityProviderStatusReport, 0)
+	iter := k.fpStatusReportStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var report types.FinalityProviderStatusReport
+		k.cdc.MustUnmarshal(iter.Value(), &report)
+		reports = append(reports, &report)
+	}
+
+	return reports
+}
+
+func (k Keeper) covenantMuSig2NoncesEntries(ctx context.Context) ([]*types.CovenantMuSig2NoncesEntry, error) {
+	entries := make([]*types.CovenantMuSig2NoncesEntry, 0)
+	iter := k.covenantMuSig2NoncesStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if len(iter.Key()) < chainhash.HashSize {
+			return nil, fmt.Errorf("key not long enough to parse staking tx hash: %x", iter.Key())
+		}
+		stakingTxHash, err := chainhash.NewHash(iter.Key()[:chainhash.HashSize])
+		if err != nil {
+			return nil, err
+		}
+		var nonces types.CovenantMuSig2Nonces
+		if err := nonces.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+		entries = append(entries, &types.CovenantMuSig2NoncesEntry{
+			StakingTxHash: stakingTxHash.String(),
+			Nonces:        &nonces,
+		})
+	}
+
+	return entries, nil
+}
+
+func (k Keeper) covenantPerformances(ctx context.Context) ([]*types.CovenantPerformance, error) {
+	perfs := make([]*types.CovenantPerformance, 0)
+	iter := k.covenantPerformanceStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var perf types.CovenantPerformance
+		if err := perf.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+		perfs = append(perfs, &perf)
+	}
+
+	return perfs, nil
+}
@@ -8,8 +8,10 @@ import (
 
 	"github.com/babylonchain/babylon/testutil/datagen"
 	"github.com/babylonchain/babylon/testutil/helper"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
 	btclightclientt "github.com/babylonchain/babylon/x/btclightclient/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/stretchr/testify/require"
 )
 
@@ -174,3 +176,98 @@ func TestExportGenesis(t *testing.T) {
 
 	// TODO: vp dst cache
 }
+
+func TestInitGenesisWrongBTCNetwork(t *testing.T) {
+	r := rand.New(rand.NewSource(11))
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+	// the test keeper operates on simnet, so params with a mainnet slashing
+	// address have to be rejected
+	params := types.DefaultParams()
+	slashingAddr, err := datagen.GenRandomBTCAddress(r, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+	params.SlashingAddress = slashingAddr.EncodeAddress()
+	gs := types.GenesisState{Params: []*types.Params{&params}}
+	require.Error(t, k.InitGenesis(ctx, gs))
+
+	// params for simnet are accepted
+	defaultParams := types.DefaultParams()
+	gs = types.GenesisState{Params: []*types.Params{&defaultParams}}
+	require.NoError(t, k.InitGenesis(ctx, gs))
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	r, h := rand.New(rand.NewSource(11)), helper.NewHelper(t)
+	k, ctx := h.App.BTCStakingKeeper, h.Ctx
+	params := k.GetParams(ctx)
+
+	// finality providers with BTC delegations carrying covenant signatures
+	// and undelegations
+	fps := datagen.CreateNFinalityProviders(r, t, 3)
+	blkHeight := uint64(r.Int63n(1000)) + math.MaxUint16
+	for _, fp := range fps {
+		h.AddFinalityProvider(fp)
+		stakingValue := r.Int63n(200000) + 10000
+		dels := createNDelegationsForFinalityProvider(r, t, fp.BtcPk.MustToBTCPK(), stakingValue, int(r.Int31n(5))+1, params.CovenantQuorum)
+		for _, del := range dels {
+			h.AddDelegation(del)
+		}
+		k.SetVotingPower(ctx, *fp.BtcPk, blkHeight, uint64(stakingValue))
+	}
+
+	gs, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, gs.BtcDelegations)
+	for _, btcDel := range gs.BtcDelegations {
+		require.NotNil(t, btcDel.BtcUndelegation)
+		require.NotEmpty(t, btcDel.BtcUndelegation.CovenantUnbondingSigList)
+		require.NotEmpty(t, btcDel.BtcUndelegation.CovenantSlashingSigs)
+	}
+
+	// MuSig2 nonces of a covenant member, which are kept in the store until
+	// the BTC delegation receives covenant signatures
+	covPK, err := datagen.GenRandomBIP340PubKey(r)
+	require.NoError(t, err)
+	gs.CovenantMusig2Nonces = append(gs.CovenantMusig2Nonces, &types.CovenantMuSig2NoncesEntry{
+		StakingTxHash: gs.BtcDelegations[0].MustGetStakingTxHash().String(),
+		Nonces: &types.CovenantMuSig2Nonces{
+			CovPk:                     covPK,
+			SlashingTxNonces:          [][]byte{datagen.GenRandomByteArray(r, 66)},
+			UnbondingTxNonce:          datagen.GenRandomByteArray(r, 66),
+			SlashingUnbondingTxNonces: [][]byte{datagen.GenRandomByteArray(r, 66)},
+		},
+	})
+
+	// signing record of the covenant member
+	numSigned := datagen.RandomInt(r, 100) + 1
+	gs.CovenantPerformances = append(gs.CovenantPerformances, &types.CovenantPerformance{
+		CovPk:              covPK,
+		NumSigned:          numSigned,
+		TotalSigningBlocks: numSigned * (datagen.RandomInt(r, 100) + 1),
+		NumMissed:          datagen.RandomInt(r, 100),
+	})
+
+	// the genesis survives the JSON encoding used by genesis files and
+	// state exports
+	cdc := h.App.AppCodec()
+	var decodedGs types.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(cdc.MustMarshalJSON(gs), &decodedGs))
+	require.NoError(t, decodedGs.Validate())
+
+	// importing the genesis into a fresh keeper and exporting it again
+	// yields the same genesis
+	newK, newCtx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+	require.NoError(t, newK.InitGenesis(newCtx, decodedGs))
+	newGs, err := newK.ExportGenesis(newCtx)
+	require.NoError(t, err)
+	// the fresh keeper is initialised with the default params as the first
+	// params version
+	newGs.Params = newGs.Params[1:]
+	require.Equal(t, gs, newGs)
+
+	// a BTC delegation without its undelegation is rejected
+	btcDel := *decodedGs.BtcDelegations[0]
+	btcDel.BtcUndelegation = nil
+	decodedGs.BtcDelegations[0] = &btcDel
+	require.Error(t, decodedGs.Validate())
+}
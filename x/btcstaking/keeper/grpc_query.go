@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"sort"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -11,6 +14,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/babylonchain/babylon/btcstaking"
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 )
@@ -46,6 +50,47 @@ func (k Keeper) FinalityProviders(c context.Context, req *types.QueryFinalityPro
 	return &types.QueryFinalityProvidersResponse{FinalityProviders: fpResp, Pagination: pageRes}, nil
 }
 
+// FinalityProvidersByConsumer returns a paginated list of all finality
+// providers securing the given consumer chain, along with their voting power
+// in the consumer chain's power table
+func (k Keeper) FinalityProvidersByConsumer(c context.Context, req *types.QueryFinalityProvidersByConsumerRequest) (*types.QueryFinalityProvidersByConsumerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.ConsumerId) == 0 {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "consumer ID cannot be empty")
+	}
+	if len(req.ConsumerId) > types.MaxConsumerIDLength {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "consumer ID is longer than %d bytes", types.MaxConsumerIDLength)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := k.consumerFPStore(ctx, req.ConsumerId)
+	// consumer power tables are keyed by the height in the header info
+	currBlockHeight := uint64(ctx.HeaderInfo().Height)
+
+	var fpResp []*types.FinalityProviderResponse
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		fp, err := k.GetFinalityProvider(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		votingPower := k.GetConsumerVotingPower(ctx, req.ConsumerId, key, currBlockHeight)
+		resp := types.NewFinalityProviderResponse(fp, currBlockHeight, votingPower)
+		fpResp = append(fpResp, resp)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFinalityProvidersByConsumerResponse{FinalityProviders: fpResp, Pagination: pageRes}, nil
+}
+
 // FinalityProvider returns the finality provider with the specified finality provider BTC PK
 func (k Keeper) FinalityProvider(c context.Context, req *types.QueryFinalityProviderRequest) (*types.QueryFinalityProviderResponse, error) {
 	if req == nil {
@@ -79,13 +124,37 @@ func (k Keeper) FinalityProvider(c context.Context, req *types.QueryFinalityProv
 	return &types.QueryFinalityProviderResponse{FinalityProvider: fpResp}, nil
 }
 
+// FinalityProviderStatus returns the status report announced by the finality
+// provider with the specified BTC PK
+func (k Keeper) FinalityProviderStatus(c context.Context, req *types.QueryFinalityProviderStatusRequest) (*types.QueryFinalityProviderStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	fpPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	report, err := k.GetFpStatusReport(ctx, fpPK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFinalityProviderStatusResponse{
+		Report:   report,
+		InWindow: report.InWindow(uint64(ctx.HeaderInfo().Height)),
+	}, nil
+}
+
 // BTCDelegations returns all BTC delegations under a given status
 func (k Keeper) BTCDelegations(ctx context.Context, req *types.QueryBTCDelegationsRequest) (*types.QueryBTCDelegationsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+	covenantQuorum := k.covenantQuorumCache(ctx)
 
 	// get current BTC height
 	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
@@ -94,14 +163,15 @@ func (k Keeper) BTCDelegations(ctx context.Context, req *types.QueryBTCDelegatio
 
 	store := k.btcDelegationStore(ctx)
 	var btcDels []*types.BTCDelegationResponse
-	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var btcDel types.BTCDelegation
 		k.cdc.MustUnmarshal(value, &btcDel)
 
 		// hit if the queried status is ANY or matches the BTC delegation status
-		status := btcDel.GetStatus(btcTipHeight, wValue, covenantQuorum)
+		status := btcDel.GetStatus(btcTipHeight, wValue, covenantQuorum(&btcDel))
 		if req.Status == types.BTCDelegationStatus_ANY || status == req.Status {
 			if accumulate {
+				k.loadBTCDelegationTxs(ctx, key, &btcDel)
 				resp := types.NewBTCDelegationResponse(&btcDel, status)
 				btcDels = append(btcDels, resp)
 			}
@@ -206,6 +276,125 @@ func (k Keeper) ActiveFinalityProvidersAtHeight(ctx context.Context, req *types.
 	return &types.QueryActiveFinalityProvidersAtHeightResponse{FinalityProviders: finalityProvidersWithMeta, Pagination: pageRes}, nil
 }
 
+// fpPower is the voting power of a finality provider at a height
+type fpPower struct {
+	fpBTCPK []byte
+	power   uint64
+}
+
+// before returns whether the finality provider comes before the other one
+// when sorting by voting power in descending order, and by BTC PK in
+// ascending order for the same voting power
+func (p fpPower) before(other fpPower) bool {
+	if p.power != other.power {
+		return p.power > other.power
+	}
+	return bytes.Compare(p.fpBTCPK, other.fpBTCPK) < 0
+}
+
+// cursor encodes the position of the finality provider in the sorted list
+// as a pagination key
+func (p fpPower) cursor() []byte {
+	return append(sdk.Uint64ToBigEndian(p.power), p.fpBTCPK...)
+}
+
+// fpPowerFromCursor decodes the pagination key into a position in the
+// sorted list
+func fpPowerFromCursor(cursor []byte) (fpPower, error) {
+	if len(cursor) != 8+bbn.BIP340PubKeyLen {
+		return fpPower{}, fmt.Errorf("invalid pagination key length %d", len(cursor))
+	}
+	return fpPower{
+		power:   sdk.BigEndianToUint64(cursor[:8]),
+		fpBTCPK: cursor[8:],
+	}, nil
+}
+
+// ActiveFinalityProvidersByPowerAtHeight returns the active finality providers
+// at the provided height, sorted by voting power in descending order
+func (k Keeper) ActiveFinalityProvidersByPowerAtHeight(ctx context.Context, req *types.QueryActiveFinalityProvidersByPowerAtHeightRequest) (*types.QueryActiveFinalityProvidersByPowerAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Reverse {
+		return nil, status.Error(codes.InvalidArgument, "reverse pagination is not supported")
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	// the active finality providers are bounded by the maximum number of
+	// active finality providers, so they are sorted in memory
+	store := k.votingPowerBbnBlockHeightStore(ctx, req.Height)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	fpPowers := []fpPower{}
+	totalPower := uint64(0)
+	for ; iter.Valid(); iter.Next() {
+		power := sdk.BigEndianToUint64(iter.Value())
+		fpPowers = append(fpPowers, fpPower{fpBTCPK: bytes.Clone(iter.Key()), power: power})
+		totalPower += power
+	}
+	sort.SliceStable(fpPowers, func(i, j int) bool {
+		return fpPowers[i].before(fpPowers[j])
+	})
+
+	// the page starts from the given cursor or offset
+	start := uint64(0)
+	if len(pageReq.Key) > 0 {
+		cursor, err := fpPowerFromCursor(pageReq.Key)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		start = uint64(sort.Search(len(fpPowers), func(i int) bool {
+			return !fpPowers[i].before(cursor)
+		}))
+	} else {
+		start = min(pageReq.Offset, uint64(len(fpPowers)))
+	}
+	end := min(start+limit, uint64(len(fpPowers)))
+
+	fpsWithMeta := make([]*types.FinalityProviderWithMeta, 0, end-start)
+	for _, fpp := range fpPowers[start:end] {
+		fp, err := k.GetFinalityProvider(ctx, fpp.fpBTCPK)
+		if err != nil {
+			return nil, err
+		}
+		fpsWithMeta = append(fpsWithMeta, &types.FinalityProviderWithMeta{
+			BtcPk:                fp.BtcPk,
+			Height:               req.Height,
+			VotingPower:          fpp.power,
+			MasterPubRand:        fp.MasterPubRand,
+			SlashedBabylonHeight: fp.SlashedBabylonHeight,
+			SlashedBtcHeight:     fp.SlashedBtcHeight,
+			RegisteredEpoch:      fp.RegisteredEpoch,
+		})
+	}
+
+	pageRes := &query.PageResponse{}
+	if end < uint64(len(fpPowers)) {
+		pageRes.NextKey = fpPowers[end].cursor()
+	}
+	if pageReq.CountTotal {
+		pageRes.Total = uint64(len(fpPowers))
+	}
+
+	return &types.QueryActiveFinalityProvidersByPowerAtHeightResponse{
+		FinalityProviders:          fpsWithMeta,
+		TotalVotingPower:           totalPower,
+		NumActiveFinalityProviders: uint32(len(fpPowers)),
+		Pagination:                 pageRes,
+	}, nil
+}
+
 // ActivatedHeight returns the Babylon height in which the BTC Staking protocol was enabled
 // TODO: Requires investigation on whether we can enable the BTC staking protocol at genesis
 func (k Keeper) ActivatedHeight(ctx context.Context, req *types.QueryActivatedHeightRequest) (*types.QueryActivatedHeightResponse, error) {
@@ -242,7 +431,7 @@ func (k Keeper) FinalityProviderDelegations(ctx context.Context, req *types.Quer
 
 	currentWValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
 	btcHeight := k.btclcKeeper.GetTipInfo(ctx).Height
-	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+	covenantQuorum := k.covenantQuorumCache(ctx)
 
 	btcDels := []*types.BTCDelegatorDelegationsResponse{}
 	pageRes, err := query.Paginate(btcDelStore, req.Pagination, func(key, value []byte) error {
@@ -258,7 +447,7 @@ func (k Keeper) FinalityProviderDelegations(ctx context.Context, req *types.Quer
 			status := btcDel.GetStatus(
 				btcHeight,
 				currentWValue,
-				covenantQuorum,
+				covenantQuorum(btcDel),
 			)
 			btcDelsResp[i] = types.NewBTCDelegationResponse(btcDel, status)
 		}
@@ -293,14 +482,359 @@ func (k Keeper) BTCDelegation(ctx context.Context, req *types.QueryBTCDelegation
 		return nil, types.ErrBTCDelegationNotFound
 	}
 
-	currentWValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
-	status := btcDel.GetStatus(
-		k.btclcKeeper.GetTipInfo(ctx).Height,
-		currentWValue,
-		k.GetParams(ctx).CovenantQuorum,
-	)
+	status := k.GetBTCDelegationStatus(ctx, btcDel)
 
 	return &types.QueryBTCDelegationResponse{
 		BtcDelegation: types.NewBTCDelegationResponse(btcDel, status),
 	}, nil
 }
+
+// CovenantMuSig2Nonces returns the MuSig2 nonces that covenant members have
+// submitted for co-signing the given BTC delegation
+func (k Keeper) CovenantMuSig2Nonces(ctx context.Context, req *types.QueryCovenantMuSig2NoncesRequest) (*types.QueryCovenantMuSig2NoncesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// decode staking tx hash
+	stakingTxHash, err := chainhash.NewHashFromStr(req.StakingTxHashHex)
+	if err != nil {
+		return nil, err
+	}
+
+	// find BTC delegation
+	btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+	if btcDel == nil {
+		return nil, types.ErrBTCDelegationNotFound
+	}
+	scriptTemplate, err := btcstaking.GetScriptTemplate(btcDel.ScriptTemplateVersion)
+	if err != nil {
+		return nil, err
+	}
+	if !scriptTemplate.CovenantMuSig2 {
+		return nil, status.Errorf(codes.InvalidArgument, "the covenant committee of BTC delegation %s does not sign via MuSig2", req.StakingTxHashHex)
+	}
+
+	params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if params == nil {
+		return nil, fmt.Errorf("params version %d of the BTC delegation is not found", btcDel.ParamsVersion)
+	}
+	aggPK, err := params.AggregateCovenantPK()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCovenantMuSig2NoncesResponse{
+		Nonces:                 k.GetCovenantMuSig2Nonces(ctx, *stakingTxHash),
+		AggregateCovenantPkHex: aggPK.MarshalHex(),
+	}, nil
+}
+
+// WatchtowerBackup returns the backup data that the staker of the given BTC
+// delegation has deposited for its watchtower. The backup data is public
+// ciphertext encrypted by the staker for the watchtower, so it is returned to
+// anyone
+func (k Keeper) WatchtowerBackup(c context.Context, req *types.QueryWatchtowerBackupRequest) (*types.QueryWatchtowerBackupResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// decode staking tx hash
+	stakingTxHash, err := chainhash.NewHashFromStr(req.StakingTxHashHex)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	backup := k.GetWatchtowerBackup(ctx, *stakingTxHash)
+	if backup == nil {
+		return nil, types.ErrWatchtowerBackupNotFound
+	}
+
+	return &types.QueryWatchtowerBackupResponse{
+		WatchtowerAddress: backup.WatchtowerAddress,
+		EncryptedBackup:   backup.EncryptedBackup,
+	}, nil
+}
+
+// CovenantPerformance returns the signing records of all covenant signers
+func (k Keeper) CovenantPerformance(c context.Context, req *types.QueryCovenantPerformanceRequest) (*types.QueryCovenantPerformanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	covenantSigners, _, err := params.CovenantSigners(params.ScriptTemplateVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	store := k.covenantPerformanceStore(ctx)
+	var perfs []*types.CovenantPerformanceResponse
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var perf types.CovenantPerformance
+		if err := k.cdc.Unmarshal(value, &perf); err != nil {
+			return err
+		}
+		inCommittee := types.ContainsPK(covenantSigners, perf.CovPk)
+		perfs = append(perfs, types.NewCovenantPerformanceResponse(&perf, inCommittee))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCovenantPerformanceResponse{
+		CovenantPerformances: perfs,
+		Pagination:           pageRes,
+	}, nil
+}
+
+// SlashingRateChangeReports returns a paginated list of the reports of all
+// slashing rate changes
+func (k Keeper) SlashingRateChangeReports(c context.Context, req *types.QuerySlashingRateChangeReportsRequest) (*types.QuerySlashingRateChangeReportsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := k.slashingRateReportStore(ctx)
+
+	var reports []*types.SlashingRateChangeReport
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var report types.SlashingRateChangeReport
+		if err := report.Unmarshal(value); err != nil {
+			return err
+		}
+		reports = append(reports, &report)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySlashingRateChangeReportsResponse{Reports: reports, Pagination: pageRes}, nil
+}
+
+// SlashingRateChangeReport returns the report of the slashing rate change made
+// by the given params version
+func (k Keeper) SlashingRateChangeReport(c context.Context, req *types.QuerySlashingRateChangeReportRequest) (*types.QuerySlashingRateChangeReportResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	report, err := k.GetSlashingRateChangeReport(ctx, req.ParamsVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySlashingRateChangeReportResponse{Report: report}, nil
+}
+
+// ScheduledParams returns the params scheduled to take effect in the future
+func (k Keeper) ScheduledParams(ctx context.Context, req *types.QueryScheduledParamsRequest) (*types.QueryScheduledParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryScheduledParamsResponse{ScheduledParams: k.GetScheduledParams(ctx)}, nil
+}
+
+// PauseState returns whether the processing of new BTC delegations and early
+// unbondings is paused
+func (k Keeper) PauseState(ctx context.Context, req *types.QueryPauseStateRequest) (*types.QueryPauseStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryPauseStateResponse{PauseState: k.GetPauseState(ctx)}, nil
+}
+
+// LastFinalityProviderSetDiff returns the last change of the active finality
+// provider set
+func (k Keeper) LastFinalityProviderSetDiff(ctx context.Context, req *types.QueryLastFinalityProviderSetDiffRequest) (*types.QueryLastFinalityProviderSetDiffResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryLastFinalityProviderSetDiffResponse{Diff: k.GetLastFinalityProviderSetDiff(ctx)}, nil
+}
+
+// TxIndex returns the Babylon object that the given BTC tx belongs to, i.e.,
+// the BTC delegation of a staking, unbonding or slashing tx, or the checkpoint
+// submission of a checkpoint tx
+func (k Keeper) TxIndex(ctx context.Context, req *types.QueryTxIndexRequest) (*types.QueryTxIndexResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	txHash, err := chainhash.NewHashFromStr(req.BtcTxHashHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid BTC tx hash: %v", err)
+	}
+
+	// the staking tx hash is the key of the BTC delegation
+	if k.HasBTCDelegation(ctx, *txHash) {
+		return &types.QueryTxIndexResponse{
+			TxType:           types.BTCTxType_STAKING_TX,
+			StakingTxHashHex: txHash.String(),
+		}, nil
+	}
+
+	// unbonding tx, slashing tx or unbonding slashing tx of a BTC delegation
+	if entry := k.getBTCTxIndexEntry(ctx, *txHash); entry != nil {
+		stakingTxHash, err := chainhash.NewHash(entry.StakingTxHash)
+		if err != nil {
+			return nil, err
+		}
+		return &types.QueryTxIndexResponse{
+			TxType:           entry.TxType,
+			StakingTxHashHex: stakingTxHash.String(),
+		}, nil
+	}
+
+	// BTC tx of a checkpoint submission
+	if sk := k.btccKeeper.GetSubmissionKeyByTxHash(ctx, txHash); sk != nil {
+		sd := k.btccKeeper.GetSubmissionData(ctx, *sk)
+		if sd == nil {
+			return nil, fmt.Errorf("the submission of BTC tx %s is indexed but not found", txHash)
+		}
+		return &types.QueryTxIndexResponse{
+			TxType:        types.BTCTxType_CHECKPOINT_SUBMISSION_TX,
+			EpochNum:      sd.Epoch,
+			SubmissionKey: sk,
+		}, nil
+	}
+
+	return nil, types.ErrBTCTxNotFound.Wrapf("BTC tx hash: %s", txHash)
+}
+
+// StakingTxTemplate returns the unsigned transactions and the message that a
+// wallet needs for staking with the given finality providers
+func (k Keeper) StakingTxTemplate(ctx context.Context, req *types.QueryStakingTxTemplateRequest) (*types.QueryStakingTxTemplateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	template, err := k.BuildStakingTxTemplate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStakingTxTemplateResponse{Template: template}, nil
+}
+
+// PendingBTCDelegations returns the BTC delegations that are waiting for
+// covenant signatures, along with the data covenant members need for signing
+// them. If a covenant PK is given, the BTC delegations that are already fully
+// signed by this covenant member are skipped.
+func (k Keeper) PendingBTCDelegations(ctx context.Context, req *types.QueryPendingBTCDelegationsRequest) (*types.QueryPendingBTCDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var covenantPK *bbn.BIP340PubKey
+	if len(req.CovenantPkHex) > 0 {
+		var err error
+		covenantPK, err = bbn.NewBIP340PubKeyFromHex(req.CovenantPkHex)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal covenant BTC PK hex: %v", err)
+		}
+	}
+
+	// get current BTC height
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	// get value of w
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	// only iterate over the index of the BTC delegations without covenant
+	// quorum, rather than all BTC delegations. The BTC delegations that were
+	// pending before the index was introduced are indexed by the v3 to v4
+	// store migration, so the index covers all pending BTC delegations
+	store := k.pendingBTCDelHeightStore(ctx)
+	workItems := []*types.CovenantSigningWorkItem{}
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		stakingTxHash, err := chainhash.NewHash(value)
+		if err != nil {
+			return false, err
+		}
+		btcDel := k.getBTCDelegationWithoutTxs(ctx, *stakingTxHash)
+		if btcDel == nil {
+			return false, fmt.Errorf("pending BTC delegation %s is not found", stakingTxHash)
+		}
+
+		params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+		if params == nil {
+			return false, fmt.Errorf("params version %d of BTC delegation %s is unknown",
+				btcDel.ParamsVersion, stakingTxHash)
+		}
+
+		// hit if the BTC delegation is pending and, if a covenant PK is
+		// given, is not fully signed by this covenant member
+		status := btcDel.GetStatus(btcTipHeight, wValue, params.CovenantQuorum)
+		if status != types.BTCDelegationStatus_PENDING {
+			return false, nil
+		}
+		if covenantPK != nil &&
+			(!params.HasCovenantPK(covenantPK) || (btcDel.IsSignedByCovMember(covenantPK) && btcDel.BtcUndelegation.IsSignedByCovMember(covenantPK))) {
+			return false, nil
+		}
+
+		if accumulate {
+			k.loadBTCDelegationTxs(ctx, stakingTxHash[:], btcDel)
+			workItem, err := types.NewCovenantSigningWorkItem(btcDel, status, params, k.btcNet)
+			if err != nil {
+				return false, err
+			}
+			workItems = append(workItems, workItem)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingBTCDelegationsResponse{
+		WorkItems:  workItems,
+		Pagination: pageRes,
+	}, nil
+}
+
+// BTCDelegationTxFees estimates the virtual sizes and the minimum fees at the
+// given fee rate of the slashing tx, the unbonding tx and the unbonding
+// slashing tx of the given BTC delegation
+func (k Keeper) BTCDelegationTxFees(ctx context.Context, req *types.QueryBTCDelegationTxFeesRequest) (*types.QueryBTCDelegationTxFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.FeeRate == 0 {
+		return nil, status.Error(codes.InvalidArgument, "fee rate must be positive")
+	}
+
+	// decode staking tx hash
+	stakingTxHash, err := chainhash.NewHashFromStr(req.StakingTxHashHex)
+	if err != nil {
+		return nil, err
+	}
+
+	// find BTC delegation
+	btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+	if btcDel == nil {
+		return nil, types.ErrBTCDelegationNotFound
+	}
+
+	// the scripts of the BTC delegation commit to the covenant committee of
+	// the params it was validated against
+	params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if params == nil {
+		return nil, types.ErrParamsNotFound.Wrapf("params version %d of BTC delegation %s", btcDel.ParamsVersion, req.StakingTxHashHex)
+	}
+
+	resp, err := btcDel.EstimateTxFees(params, k.btcNet, req.FeeRate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return resp, nil
+}
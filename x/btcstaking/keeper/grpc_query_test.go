@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"bytes"
+	"encoding/hex"
 	"errors"
 	"math/rand"
 	"testing"
@@ -8,11 +10,14 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/babylonchain/babylon/btcstaking"
 	"github.com/babylonchain/babylon/testutil/datagen"
 	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
 	bbn "github.com/babylonchain/babylon/types"
@@ -482,6 +487,76 @@ func FuzzActiveFinalityProvidersAtHeight(f *testing.F) {
 	})
 }
 
+func FuzzActiveFinalityProvidersByPowerAtHeight(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+		// a random number of finality providers with random voting power,
+		// where some of them have the same voting power
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		numFps := datagen.RandomInt(r, 20) + 1
+		fpPowers := make(map[string]uint64)
+		totalPower := uint64(0)
+		for i := uint64(0); i < numFps; i++ {
+			fp, err := datagen.GenRandomFinalityProvider(r)
+			require.NoError(t, err)
+			keeper.SetFinalityProvider(ctx, fp)
+			power := datagen.RandomInt(r, 5) + 1
+			keeper.SetVotingPower(ctx, fp.BtcPk.MustMarshal(), babylonHeight, power)
+			fpPowers[fp.BtcPk.MarshalHex()] = power
+			totalPower += power
+		}
+
+		_, err := keeper.ActiveFinalityProvidersByPowerAtHeight(ctx, nil)
+		require.Error(t, err)
+
+		// go through all pages via the cursor
+		limit := datagen.RandomInt(r, int(numFps)) + 1
+		req := &types.QueryActiveFinalityProvidersByPowerAtHeightRequest{
+			Height:     babylonHeight,
+			Pagination: constructRequestWithLimit(r, limit),
+		}
+		fpsFound := []*types.FinalityProviderWithMeta{}
+		for {
+			resp, err := keeper.ActiveFinalityProvidersByPowerAtHeight(ctx, req)
+			require.NoError(t, err)
+			require.LessOrEqual(t, uint64(len(resp.FinalityProviders)), limit)
+			require.Equal(t, totalPower, resp.TotalVotingPower)
+			require.Equal(t, uint32(numFps), resp.NumActiveFinalityProviders)
+			fpsFound = append(fpsFound, resp.FinalityProviders...)
+			if resp.Pagination.NextKey == nil {
+				break
+			}
+			req.Pagination = constructRequestWithKeyAndLimit(r, resp.Pagination.NextKey, limit)
+		}
+
+		// all finality providers are returned once, sorted by voting power in
+		// descending order and by BTC PK in ascending order
+		require.Len(t, fpsFound, int(numFps))
+		for i, fp := range fpsFound {
+			require.Equal(t, fpPowers[fp.BtcPk.MarshalHex()], fp.VotingPower)
+			if i > 0 {
+				prev := fpsFound[i-1]
+				require.True(t, prev.VotingPower > fp.VotingPower ||
+					(prev.VotingPower == fp.VotingPower && bytes.Compare(*prev.BtcPk, *fp.BtcPk) < 0))
+			}
+		}
+
+		// offset-based pagination returns the same page as the cursor
+		offset := datagen.RandomInt(r, int(numFps))
+		resp, err := keeper.ActiveFinalityProvidersByPowerAtHeight(ctx, &types.QueryActiveFinalityProvidersByPowerAtHeightRequest{
+			Height:     babylonHeight,
+			Pagination: &query.PageRequest{Offset: offset, Limit: limit, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, numFps, resp.Pagination.Total)
+		end := min(offset+limit, numFps)
+		require.Equal(t, fpsFound[offset:end], resp.FinalityProviders)
+	})
+}
+
 func FuzzFinalityProviderDelegations(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 	f.Fuzz(func(t *testing.T, seed int64) {
@@ -612,3 +687,383 @@ func constructRequestWithKeyAndLimit(r *rand.Rand, key []byte, limit uint64) *qu
 func constructRequestWithLimit(r *rand.Rand, limit uint64) *query.PageRequest {
 	return constructRequestWithKeyAndLimit(r, nil, limit)
 }
+
+func FuzzStakingTxTemplate(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		h.GenAndApplyParams(r)
+		params := h.BTCStakingKeeper.GetParams(h.Ctx)
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+		_, stakerPK, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+
+		req := &types.QueryStakingTxTemplateRequest{
+			StakerBtcPkHex:  bbn.NewBIP340PubKeyFromBTCPK(stakerPK).MarshalHex(),
+			FpBtcPkHexList:  []string{bbn.NewBIP340PubKeyFromBTCPK(fpPK).MarshalHex()},
+			StakingValue:    int64(2 * 10e8),
+			StakingTime:     1000,
+			UnbondingFeeSat: 1000,
+		}
+		// duplicated finality providers are rejected
+		dupReq := *req
+		dupReq.FpBtcPkHexList = []string{req.FpBtcPkHexList[0], req.FpBtcPkHexList[0]}
+		_, err = h.BTCStakingKeeper.StakingTxTemplate(h.Ctx, &dupReq)
+		require.ErrorIs(t, err, types.ErrDuplicatedFp)
+
+		resp, err := h.BTCStakingKeeper.StakingTxTemplate(h.Ctx, req)
+		h.NoError(err)
+		template := resp.Template
+		require.Equal(t, types.StakingTxTemplateVersion, template.TemplateVersion)
+		require.Equal(t, h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx).Version, template.ParamsVersion)
+		unbondingTime := uint16(template.MsgCreateBtcDelegation.UnbondingTime)
+		require.Equal(t, types.MinimumUnbondingTime(params, btcctypes.DefaultParams())+1, uint64(unbondingTime))
+
+		// fund the staking tx, which is serialized without witness as it
+		// has no inputs
+		stakingTxBytes, err := hex.DecodeString(template.StakingTxHex)
+		h.NoError(err)
+		stakingTx := wire.NewMsgTx(2)
+		err = stakingTx.DeserializeNoWitness(bytes.NewReader(stakingTxBytes))
+		h.NoError(err)
+		require.Empty(t, stakingTx.TxIn)
+		prevHash, err := chainhash.NewHash(datagen.GenRandomByteArray(r, chainhash.HashSize))
+		h.NoError(err)
+		stakingTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(prevHash, 0), nil, nil))
+		stakingTxHash := stakingTx.TxHash()
+
+		// the slashing tx is valid after pointing it to the staking output
+		slashingTx, _, err := bbn.NewBTCTxFromHex(template.SlashingTxHex)
+		h.NoError(err)
+		slashingTx.TxIn[0].PreviousOutPoint = *wire.NewOutPoint(&stakingTxHash, template.StakingOutputIdx)
+		err = btcstaking.CheckTransactions(
+			slashingTx,
+			stakingTx,
+			template.StakingOutputIdx,
+			params.MinSlashingTxFeeSat,
+			params.SlashingRate,
+			params.MustGetSlashingAddress(h.Net),
+			stakerPK,
+			unbondingTime,
+			h.Net,
+		)
+		h.NoError(err)
+
+		// the unbonding slashing tx is valid after pointing it to the unbonding output
+		unbondingTx, _, err := bbn.NewBTCTxFromHex(template.UnbondingTxHex)
+		h.NoError(err)
+		unbondingTx.TxIn[0].PreviousOutPoint = *wire.NewOutPoint(&stakingTxHash, template.StakingOutputIdx)
+		require.Equal(t, template.MsgCreateBtcDelegation.UnbondingValue, unbondingTx.TxOut[0].Value)
+		unbondingTxHash := unbondingTx.TxHash()
+		unbondingSlashingTx, _, err := bbn.NewBTCTxFromHex(template.UnbondingSlashingTxHex)
+		h.NoError(err)
+		unbondingSlashingTx.TxIn[0].PreviousOutPoint = *wire.NewOutPoint(&unbondingTxHash, 0)
+		err = btcstaking.CheckTransactions(
+			unbondingSlashingTx,
+			unbondingTx,
+			0,
+			params.MinSlashingTxFeeSat,
+			params.SlashingRate,
+			params.MustGetSlashingAddress(h.Net),
+			stakerPK,
+			unbondingTime,
+			h.Net,
+		)
+		h.NoError(err)
+
+		// staking with an unknown finality provider is rejected
+		_, unknownFPPK, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		req.FpBtcPkHexList = []string{bbn.NewBIP340PubKeyFromBTCPK(unknownFPPK).MarshalHex()}
+		_, err = h.BTCStakingKeeper.StakingTxTemplate(h.Ctx, req)
+		require.ErrorIs(t, err, types.ErrFpNotFound)
+	})
+}
+
+func FuzzPendingBTCDelegationsWorkItems(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider and pending BTC delegation
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+		h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).AnyTimes()
+		stakingTxHash, _, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(2*10e8),
+			1000,
+		)
+
+		// the pending BTC delegation carries the scripts the covenant
+		// signatures commit to
+		resp, err := h.BTCStakingKeeper.PendingBTCDelegations(h.Ctx, &types.QueryPendingBTCDelegationsRequest{})
+		h.NoError(err)
+		require.Len(t, resp.WorkItems, 1)
+		workItem := resp.WorkItems[0]
+		require.Equal(t, stakingTxHash, workItem.StakingTxHashHex)
+		require.Equal(t, bsParams.CovenantQuorum, workItem.CovenantQuorum)
+		require.Equal(t, bsParams.CovenantPks, workItem.CovenantPks)
+		require.Equal(t, types.BTCDelegationStatus_PENDING.String(), workItem.BtcDelegation.StatusDesc)
+
+		stakingInfo, err := actualDel.GetStakingInfo(&bsParams, h.Net)
+		h.NoError(err)
+		slashingPathInfo, err := stakingInfo.SlashingPathSpendInfo()
+		h.NoError(err)
+		unbondingPathInfo, err := stakingInfo.UnbondingPathSpendInfo()
+		h.NoError(err)
+		require.Equal(t, hex.EncodeToString(stakingInfo.StakingOutput.PkScript), workItem.StakingOutputPkScriptHex)
+		require.Equal(t, stakingInfo.StakingOutput.Value, workItem.StakingOutputValue)
+		require.Equal(t, hex.EncodeToString(slashingPathInfo.GetPkScriptPath()), workItem.StakingSlashingPathScriptHex)
+		require.Equal(t, hex.EncodeToString(unbondingPathInfo.GetPkScriptPath()), workItem.StakingUnbondingPathScriptHex)
+
+		unbondingInfo, err := actualDel.GetUnbondingInfo(&bsParams, h.Net)
+		h.NoError(err)
+		unbondingSlashingPathInfo, err := unbondingInfo.SlashingPathSpendInfo()
+		h.NoError(err)
+		require.Equal(t, hex.EncodeToString(unbondingInfo.UnbondingOutput.PkScript), workItem.UnbondingOutputPkScriptHex)
+		require.Equal(t, unbondingInfo.UnbondingOutput.Value, workItem.UnbondingOutputValue)
+		require.Equal(t, hex.EncodeToString(unbondingSlashingPathInfo.GetPkScriptPath()), workItem.UnbondingSlashingPathScriptHex)
+
+		// a covenant member that has signed the BTC delegation no longer
+		// receives it
+		msgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+		_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msgs[0])
+		h.NoError(err)
+		resp, err = h.BTCStakingKeeper.PendingBTCDelegations(h.Ctx, &types.QueryPendingBTCDelegationsRequest{
+			CovenantPkHex: msgs[0].Pk.MarshalHex(),
+		})
+		h.NoError(err)
+		require.Empty(t, resp.WorkItems)
+
+		// the BTC delegation is no longer pending once reaching covenant quorum
+		for i := 1; i < int(bsParams.CovenantQuorum); i++ {
+			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msgs[i])
+			h.NoError(err)
+		}
+		resp, err = h.BTCStakingKeeper.PendingBTCDelegations(h.Ctx, &types.QueryPendingBTCDelegationsRequest{})
+		h.NoError(err)
+		require.Empty(t, resp.WorkItems)
+
+		// the pending BTC delegations are paginated
+		numPendingDels := int(datagen.RandomInt(r, 5)) + 2
+		pendingStakingTxHashes := map[string]struct{}{}
+		for i := 0; i < numPendingDels; i++ {
+			stakingTxHash, _, _, _, _ := h.CreateDelegation(
+				r,
+				fpPK,
+				changeAddress.EncodeAddress(),
+				int64(2*10e8),
+				1000,
+			)
+			pendingStakingTxHashes[stakingTxHash] = struct{}{}
+		}
+		pagedStakingTxHashes := map[string]struct{}{}
+		var nextKey []byte
+		for {
+			resp, err = h.BTCStakingKeeper.PendingBTCDelegations(h.Ctx, &types.QueryPendingBTCDelegationsRequest{
+				Pagination: &query.PageRequest{Key: nextKey, Limit: 1},
+			})
+			h.NoError(err)
+			require.LessOrEqual(t, len(resp.WorkItems), 1)
+			for _, workItem := range resp.WorkItems {
+				pagedStakingTxHashes[workItem.StakingTxHashHex] = struct{}{}
+			}
+			nextKey = resp.Pagination.NextKey
+			if len(nextKey) == 0 {
+				break
+			}
+		}
+		require.Equal(t, pendingStakingTxHashes, pagedStakingTxHashes)
+	})
+}
+
+func FuzzBTCDelegationTxFees(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider and BTC delegation
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+		h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).AnyTimes()
+		stakingValue := int64(2 * 10e8)
+		stakingTxHash, _, _, _, actualDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+
+		feeRate := datagen.RandomInt(r, 100) + 1
+		resp, err := h.BTCStakingKeeper.BTCDelegationTxFees(h.Ctx, &types.QueryBTCDelegationTxFeesRequest{
+			StakingTxHashHex: stakingTxHash,
+			FeeRate:          feeRate,
+		})
+		h.NoError(err)
+
+		// the fees are paid by the inputs minus the outputs of the txs
+		slashingTx, err := actualDel.SlashingTx.ToMsgTx()
+		h.NoError(err)
+		unbondingTx, err := bbn.NewBTCTxFromBytes(actualDel.BtcUndelegation.UnbondingTx)
+		h.NoError(err)
+		unbondingSlashingTx, err := actualDel.BtcUndelegation.SlashingTx.ToMsgTx()
+		h.NoError(err)
+		unbondingValue := unbondingTx.TxOut[0].Value
+		expectedFees := []struct {
+			estimate   *types.TxFeeEstimate
+			inputValue int64
+			tx         *wire.MsgTx
+		}{
+			{resp.SlashingTx, stakingValue, slashingTx},
+			{resp.UnbondingTx, stakingValue, unbondingTx},
+			{resp.UnbondingSlashingTx, unbondingValue, unbondingSlashingTx},
+		}
+		for _, expected := range expectedFees {
+			fee := expected.inputValue
+			for _, out := range expected.tx.TxOut {
+				fee -= out.Value
+			}
+			require.Equal(t, fee, expected.estimate.Fee)
+			// the witness makes the tx larger than its serialization without
+			// witness
+			require.Greater(t, expected.estimate.VirtualSize, int64(expected.tx.SerializeSizeStripped()))
+			require.Equal(t, expected.estimate.VirtualSize*int64(feeRate), expected.estimate.MinFee)
+			require.Equal(t, expected.estimate.Fee >= expected.estimate.MinFee, expected.estimate.Sufficient)
+		}
+
+		// the fee of the slashing tx is insufficient under a high fee rate
+		resp, err = h.BTCStakingKeeper.BTCDelegationTxFees(h.Ctx, &types.QueryBTCDelegationTxFeesRequest{
+			StakingTxHashHex: stakingTxHash,
+			FeeRate:          uint64(resp.SlashingTx.Fee) + 1,
+		})
+		h.NoError(err)
+		require.False(t, resp.SlashingTx.Sufficient)
+
+		// zero fee rate is rejected
+		_, err = h.BTCStakingKeeper.BTCDelegationTxFees(h.Ctx, &types.QueryBTCDelegationTxFeesRequest{
+			StakingTxHashHex: stakingTxHash,
+		})
+		require.Error(t, err)
+
+		// unknown BTC delegation is rejected
+		_, err = h.BTCStakingKeeper.BTCDelegationTxFees(h.Ctx, &types.QueryBTCDelegationTxFeesRequest{
+			StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+			FeeRate:          feeRate,
+		})
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+	})
+}
+
+func FuzzTxIndex(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider and BTC delegation
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+		h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).AnyTimes()
+		stakingTxHash, _, _, _, actualDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(2*10e8),
+			1000,
+		)
+
+		// all BTC txs of the BTC delegation resolve to it
+		unbondingTx, err := bbn.NewBTCTxFromBytes(actualDel.BtcUndelegation.UnbondingTx)
+		h.NoError(err)
+		expectedTxs := []struct {
+			txType types.BTCTxType
+			txHash string
+		}{
+			{types.BTCTxType_STAKING_TX, stakingTxHash},
+			{types.BTCTxType_UNBONDING_TX, unbondingTx.TxHash().String()},
+			{types.BTCTxType_SLASHING_TX, actualDel.SlashingTx.MustGetTxHash().String()},
+			{types.BTCTxType_UNBONDING_SLASHING_TX, actualDel.BtcUndelegation.SlashingTx.MustGetTxHash().String()},
+		}
+		for _, expected := range expectedTxs {
+			resp, err := h.BTCStakingKeeper.TxIndex(h.Ctx, &types.QueryTxIndexRequest{BtcTxHashHex: expected.txHash})
+			h.NoError(err)
+			require.Equal(t, expected.txType, resp.TxType)
+			require.Equal(t, stakingTxHash, resp.StakingTxHashHex)
+			require.Nil(t, resp.SubmissionKey)
+		}
+
+		// a BTC tx of a checkpoint submission resolves to the submission
+		ckptTxHash := datagen.GenRandomBtcdHash(r)
+		sk := &btcctypes.SubmissionKey{Key: []*btcctypes.TransactionKey{{Index: 1}, {Index: 2}}}
+		epoch := datagen.RandomInt(r, 100) + 1
+		btccKeeper.EXPECT().GetSubmissionKeyByTxHash(gomock.Any(), &ckptTxHash).Return(sk).Times(1)
+		btccKeeper.EXPECT().GetSubmissionData(gomock.Any(), *sk).Return(&btcctypes.SubmissionData{Epoch: epoch}).Times(1)
+		resp, err := h.BTCStakingKeeper.TxIndex(h.Ctx, &types.QueryTxIndexRequest{BtcTxHashHex: ckptTxHash.String()})
+		h.NoError(err)
+		require.Equal(t, types.BTCTxType_CHECKPOINT_SUBMISSION_TX, resp.TxType)
+		require.Equal(t, epoch, resp.EpochNum)
+		require.Equal(t, sk, resp.SubmissionKey)
+		require.Empty(t, resp.StakingTxHashHex)
+
+		// unknown BTC txs are rejected
+		unknownTxHash := datagen.GenRandomBtcdHash(r)
+		btccKeeper.EXPECT().GetSubmissionKeyByTxHash(gomock.Any(), &unknownTxHash).Return(nil).Times(1)
+		_, err = h.BTCStakingKeeper.TxIndex(h.Ctx, &types.QueryTxIndexRequest{BtcTxHashHex: unknownTxHash.String()})
+		require.ErrorIs(t, err, types.ErrBTCTxNotFound)
+	})
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// Implements BtcStakingHooks interface
+var _ types.BtcStakingHooks = Keeper{}
+
+// AfterFinalityProviderSlashed - call hook if registered
+func (k Keeper) AfterFinalityProviderSlashed(ctx context.Context, fp *types.FinalityProvider, infractionHeight uint64) {
+	if k.hooks != nil {
+		k.hooks.AfterFinalityProviderSlashed(ctx, fp, infractionHeight)
+	}
+}
+
+// AfterFinalityProviderJailed - call hook if registered
+func (k Keeper) AfterFinalityProviderJailed(ctx context.Context, fp *types.FinalityProvider) {
+	if k.hooks != nil {
+		k.hooks.AfterFinalityProviderJailed(ctx, fp)
+	}
+}
+
+// AfterBTCDelegationActivated - call hook if registered
+func (k Keeper) AfterBTCDelegationActivated(ctx context.Context, btcDel *types.BTCDelegation) {
+	if k.hooks != nil {
+		k.hooks.AfterBTCDelegationActivated(ctx, btcDel)
+	}
+}
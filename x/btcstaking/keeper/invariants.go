@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// RegisterInvariants registers all btcstaking invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "voting-power-table", VotingPowerTableInvariant(k))
+}
+
+// VotingPowerTableInvariant checks that the voting power table used by the
+// finality tally at each height is backed by BTC delegations that are active at
+// that height. The check covers all heights whose voting power distribution
+// cache is still retained, i.e., all heights that are not finalised yet.
+// At each of these heights,
+//   - the voting power table matches the non-jailed finality providers in the
+//     voting power distribution cache,
+//   - each finality provider in the voting power table is not slashed before
+//     the height, and its voting power is the sum of the voting power of its
+//     BTC delegations, and
+//   - each of these BTC delegations has the same voting power as its staked
+//     amount, and is active at the BTC height indexed at the height.
+//
+// NOTE: a BTC delegation unbonded early does not record the height at which it
+// is unbonded, thus its status is not checked.
+func VotingPowerTableInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msgs []string
+		wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+		iter := k.votingPowerDistCacheStore(ctx).Iterator(nil, nil)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			height := sdk.BigEndianToUint64(iter.Key())
+			var dc types.VotingPowerDistCache
+			k.cdc.MustUnmarshal(iter.Value(), &dc)
+			msgs = append(msgs, k.checkVotingPowerAtHeight(ctx, height, &dc, wValue)...)
+		}
+
+		broken := len(msgs) > 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "voting-power-table",
+			fmt.Sprintf("found %d inconsistencies in voting power tables\n%s", len(msgs), strings.Join(msgs, "\n")),
+		), broken
+	}
+}
+
+// checkVotingPowerAtHeight checks the voting power table at the given height
+// against the given voting power distribution cache at the same height, and
+// returns the inconsistencies found
+func (k Keeper) checkVotingPowerAtHeight(ctx context.Context, height uint64, dc *types.VotingPowerDistCache, wValue uint64) []string {
+	var msgs []string
+	vpTable := k.GetVotingPowerTable(ctx, height)
+	btcHeight := k.GetBTCHeightAtBabylonHeight(ctx, height)
+
+	totalPower := uint64(0)
+	for _, power := range vpTable {
+		totalPower += power
+	}
+	if totalPower != dc.TotalVotingPower {
+		msgs = append(msgs, fmt.Sprintf("height %d: total voting power %d in the voting power table does not match %d in the distribution cache",
+			height, totalPower, dc.TotalVotingPower))
+	}
+
+	fpsInCache := map[string]struct{}{}
+	for _, fpDistInfo := range dc.FinalityProviders {
+		fpBTCPKHex := fpDistInfo.BtcPk.MarshalHex()
+		fpsInCache[fpBTCPKHex] = struct{}{}
+		power, inTable := vpTable[fpBTCPKHex]
+		if !inTable {
+			continue
+		}
+		if fpDistInfo.IsJailed {
+			msgs = append(msgs, fmt.Sprintf("height %d: jailed finality provider %s has voting power", height, fpBTCPKHex))
+		}
+		if fpDistInfo.InsufficientSelfDelegation {
+			msgs = append(msgs, fmt.Sprintf("height %d: finality provider %s with insufficient self-delegation has voting power", height, fpBTCPKHex))
+		}
+		if power != fpDistInfo.TotalVotingPower {
+			msgs = append(msgs, fmt.Sprintf("height %d: finality provider %s has voting power %d in the voting power table, but %d in the distribution cache",
+				height, fpBTCPKHex, power, fpDistInfo.TotalVotingPower))
+		}
+
+		fp, err := k.GetFinalityProvider(ctx, fpDistInfo.BtcPk.MustMarshal())
+		if err != nil {
+			msgs = append(msgs, fmt.Sprintf("height %d: finality provider %s with voting power is unknown", height, fpBTCPKHex))
+			continue
+		}
+		if fp.IsSlashed() && fp.SlashedBabylonHeight < height {
+			msgs = append(msgs, fmt.Sprintf("height %d: finality provider %s slashed at height %d has voting power",
+				height, fpBTCPKHex, fp.SlashedBabylonHeight))
+		}
+
+		delsPower, selfDelsPower := uint64(0), uint64(0)
+		for _, btcDelDistInfo := range fpDistInfo.BtcDels {
+			delsPower += btcDelDistInfo.VotingPower
+			if fpDistInfo.IsSelfDelegation(btcDelDistInfo) {
+				selfDelsPower += btcDelDistInfo.VotingPower
+			}
+			msgs = append(msgs, k.checkBTCDelDistInfo(ctx, height, btcHeight, wValue, btcDelDistInfo)...)
+		}
+		if delsPower != fpDistInfo.TotalVotingPower {
+			msgs = append(msgs, fmt.Sprintf("height %d: finality provider %s has voting power %d, but its BTC delegations have voting power %d",
+				height, fpBTCPKHex, fpDistInfo.TotalVotingPower, delsPower))
+		}
+		if selfDelsPower != fpDistInfo.SelfDelegatedSat {
+			msgs = append(msgs, fmt.Sprintf("height %d: finality provider %s has self-delegated Satoshis %d, but its self-delegations have voting power %d",
+				height, fpBTCPKHex, fpDistInfo.SelfDelegatedSat, selfDelsPower))
+		}
+	}
+
+	// ensure every finality provider in the voting power table is known by
+	// the distribution cache
+	for fpBTCPKHex := range vpTable {
+		if _, ok := fpsInCache[fpBTCPKHex]; !ok {
+			msgs = append(msgs, fmt.Sprintf("height %d: finality provider %s in the voting power table is not in the distribution cache", height, fpBTCPKHex))
+		}
+	}
+
+	return msgs
+}
+
+// checkBTCDelDistInfo checks that the BTC delegation in the voting power
+// distribution cache at the given height is active at the given BTC height,
+// and returns the inconsistencies found
+func (k Keeper) checkBTCDelDistInfo(ctx context.Context, height uint64, btcHeight uint64, wValue uint64, d *types.BTCDelDistInfo) []string {
+	btcDel, err := k.GetBTCDelegation(ctx, d.StakingTxHash)
+	if err != nil {
+		return []string{fmt.Sprintf("height %d: BTC delegation %s with voting power is unknown", height, d.StakingTxHash)}
+	}
+
+	var msgs []string
+	if d.VotingPower != btcDel.TotalSat {
+		msgs = append(msgs, fmt.Sprintf("height %d: BTC delegation %s has voting power %d, but stakes %d satoshis",
+			height, d.StakingTxHash, d.VotingPower, btcDel.TotalSat))
+	}
+	// early unbonded or compromised BTC delegations keep their voting power
+	// until the next power distribution update
+	if btcDel.IsUnbondedEarly() || btcDel.IsCompromised() {
+		return msgs
+	}
+
+	params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if params == nil {
+		return append(msgs, fmt.Sprintf("height %d: params version %d of BTC delegation %s is unknown",
+			height, btcDel.ParamsVersion, d.StakingTxHash))
+	}
+	if status := btcDel.GetStatus(btcHeight, wValue, params.CovenantQuorum); status != types.BTCDelegationStatus_ACTIVE {
+		msgs = append(msgs, fmt.Sprintf("height %d: BTC delegation %s with voting power is %s at BTC height %d",
+			height, d.StakingTxHash, status.String(), btcHeight))
+	}
+	return msgs
+}
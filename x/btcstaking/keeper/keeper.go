@@ -7,10 +7,15 @@ import (
 	corestoretypes "cosmossdk.io/core/store"
 
 	"cosmossdk.io/log"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/sigverifier"
+	"github.com/babylonchain/babylon/x/btcstaking/tracing"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 )
 
 type (
@@ -22,6 +27,27 @@ type (
 		btccKeeper  types.BtcCheckpointKeeper
 		ckptKeeper  types.CheckpointingKeeper
 
+		hooks types.BtcStakingHooks
+
+		// iKeeper refunds fees of txs carrying accepted covenant messages,
+		// if set
+		iKeeper types.IncentiveKeeper
+
+		// zcKeeper provides the registry of consumer chains that finality
+		// providers can secure, if set
+		zcKeeper types.ZoneConciergeKeeper
+
+		// authzKeeper provides the authz grants that allow relayers to
+		// register finality providers on their behalf, if set
+		authzKeeper types.AuthzKeeper
+
+		// sigVerifier verifies covenant signatures in CheckTx and ReCheckTx,
+		// either in-process or by offloading them to out-of-process workers
+		sigVerifier sigverifier.Verifier
+
+		// tracer records spans of the BTC delegation lifecycle handlers
+		tracer trace.Tracer
+
 		btcNet *chaincfg.Params
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
@@ -37,7 +63,7 @@ func NewKeeper(
 	btccKeeper types.BtcCheckpointKeeper,
 	ckptKeeper types.CheckpointingKeeper,
 
-	btcNet *chaincfg.Params,
+	btcConfig bbn.BtcConfig,
 	authority string,
 ) Keeper {
 	return Keeper{
@@ -48,7 +74,13 @@ func NewKeeper(
 		btccKeeper:  btccKeeper,
 		ckptKeeper:  ckptKeeper,
 
-		btcNet:    btcNet,
+		hooks: nil,
+
+		sigVerifier: sigverifier.NewInProcessVerifier(),
+
+		tracer: noop.NewTracerProvider().Tracer(tracing.TracerName),
+
+		btcNet:    btcConfig.NetParams(),
 		authority: authority,
 	}
 }
@@ -57,12 +89,133 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// SetHooks sets the btcstaking hooks
+func (k *Keeper) SetHooks(bh types.BtcStakingHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set btcstaking hooks twice")
+	}
+	k.hooks = bh
+
+	return k
+}
+
+// SetIncentiveKeeper sets the incentive keeper, which refunds fees of txs
+// carrying accepted covenant messages
+func (k *Keeper) SetIncentiveKeeper(ik types.IncentiveKeeper) *Keeper {
+	k.iKeeper = ik
+
+	return k
+}
+
+// indexRefundableMsg indexes the given accepted message as refundable, if the
+// incentive keeper is set
+func (k Keeper) indexRefundableMsg(ctx context.Context, msg sdk.Msg) {
+	if k.iKeeper == nil {
+		return
+	}
+	k.iKeeper.IndexRefundableMsg(ctx, msg)
+}
+
+// SetZoneConciergeKeeper sets the zoneconcierge keeper, which provides the
+// registry of consumer chains that finality providers can secure
+func (k *Keeper) SetZoneConciergeKeeper(zk types.ZoneConciergeKeeper) *Keeper {
+	k.zcKeeper = zk
+
+	return k
+}
+
+// hasConsumer checks whether the consumer chain with the given chain ID is
+// registered in zoneconcierge. No consumer chain is registered if the
+// zoneconcierge keeper is not set
+func (k Keeper) hasConsumer(ctx context.Context, consumerID string) bool {
+	if k.zcKeeper == nil {
+		return false
+	}
+	return k.zcKeeper.HasConsumer(ctx, consumerID)
+}
+
+// SetSigVerifier sets the verifier of covenant signatures in CheckTx and
+// ReCheckTx
+func (k *Keeper) SetSigVerifier(v sigverifier.Verifier) *Keeper {
+	k.sigVerifier = v
+
+	return k
+}
+
+// covenantSigVerifier returns the verifier of covenant signatures under the
+// given context. The configured verifier, which might offload signatures to
+// out-of-process workers, is only used in CheckTx and ReCheckTx, where a wrong
+// result only affects the local mempool. When executing blocks, signatures are
+// always verified in-process, so that the state transition never depends on
+// the results reported by other processes.
+func (k Keeper) covenantSigVerifier(ctx sdk.Context) sigverifier.Verifier {
+	if ctx.IsCheckTx() || ctx.IsReCheckTx() {
+		return k.sigVerifier
+	}
+	return sigverifier.NewInProcessVerifier()
+}
+
+// SetAuthzKeeper sets the authz keeper, which provides the authz grants that
+// allow relayers to register finality providers on their behalf
+func (k *Keeper) SetAuthzKeeper(ak types.AuthzKeeper) *Keeper {
+	k.authzKeeper = ak
+
+	return k
+}
+
+// authorizeFpSigner ensures that the given signer may submit the given
+// message registering the finality provider with the given Babylon address,
+// i.e., the signer is the finality provider itself, or a relayer holding an
+// authz grant of the finality provider for the message. Relayers cannot
+// register finality providers if the authz keeper is not set
+func (k Keeper) authorizeFpSigner(ctx context.Context, signer, fpAddr sdk.AccAddress, msg sdk.Msg) error {
+	if signer.Equals(fpAddr) {
+		return nil
+	}
+	if k.authzKeeper == nil {
+		return types.ErrUnauthorizedFpSigner.Wrapf("%s is not the finality provider %s", signer, fpAddr)
+	}
+
+	msgType := sdk.MsgTypeURL(msg)
+	authorization, expiration := k.authzKeeper.GetAuthorization(ctx, signer, fpAddr, msgType)
+	if authorization == nil {
+		return types.ErrUnauthorizedFpSigner.Wrapf("%s has no authz grant of the finality provider %s for %s", signer, fpAddr, msgType)
+	}
+	resp, err := authorization.Accept(ctx, msg)
+	if err != nil {
+		return types.ErrUnauthorizedFpSigner.Wrapf("the authz grant of the finality provider %s does not accept the message: %v", fpAddr, err)
+	}
+	if !resp.Accept {
+		return types.ErrUnauthorizedFpSigner.Wrapf("the authz grant of the finality provider %s does not accept the message", fpAddr)
+	}
+
+	// consume the grant as the authz module does upon MsgExec
+	if resp.Delete {
+		return k.authzKeeper.DeleteGrant(ctx, signer, fpAddr, msgType)
+	}
+	if resp.Updated != nil {
+		return k.authzKeeper.SaveGrant(ctx, signer, fpAddr, resp.Updated, expiration)
+	}
+	return nil
+}
+
+// SetTracer sets the tracer of the BTC delegation lifecycle handlers
+func (k *Keeper) SetTracer(tracer trace.Tracer) *Keeper {
+	k.tracer = tracer
+
+	return k
+}
+
 // BeginBlocker is invoked upon `BeginBlock` of the system. The function
 // iterates over all BTC delegations under non-slashed finality providers
 // to 1) record the voting power table for the current height, and 2) record
 // the voting power distribution cache used for computing voting power table
 // and distributing rewards once the block is finalised by finality providers.
 func (k Keeper) BeginBlocker(ctx context.Context) error {
+	// activate the scheduled params if it's time
+	if err := k.ActivateScheduledParams(ctx); err != nil {
+		return err
+	}
 	// index BTC height at the current height
 	k.IndexBTCHeight(ctx)
 	// update voting power distribution
@@ -71,6 +224,18 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 	return nil
 }
 
+// EndBlocker is invoked upon `EndBlock` of the system. The function activates
+// the BTC delegations whose staking txs become deep enough, which take effect
+// in the voting power distribution of the next height, and records the change
+// of the active finality provider set at the current height.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	k.ActivateMaturedBTCDelegations(ctx)
+	k.PruneExpiredPendingBTCDelegations(ctx)
+	k.RecordFinalityProviderSetDiff(ctx)
+
+	return nil
+}
+
 func (k Keeper) GetLastFinalizedEpoch(ctx context.Context) uint64 {
 	return k.ckptKeeper.GetLastFinalizedEpoch(ctx)
 }
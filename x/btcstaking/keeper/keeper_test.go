@@ -127,6 +127,12 @@ func CreateFinalityProvider(r *rand.Rand, t *testing.T) *types.FinalityProvider
 }
 
 func (h *Helper) CreateFinalityProvider(r *rand.Rand) (*btcec.PrivateKey, *btcec.PublicKey, *types.FinalityProvider) {
+	return h.CreateConsumerFinalityProvider(r, "")
+}
+
+// CreateConsumerFinalityProvider creates a finality provider securing the
+// consumer chain with the given chain ID, or Babylon if it is empty
+func (h *Helper) CreateConsumerFinalityProvider(r *rand.Rand, consumerID string) (*btcec.PrivateKey, *btcec.PublicKey, *types.FinalityProvider) {
 	fpBTCSK, fpBTCPK, err := datagen.GenRandomBTCKeyPair(r)
 	h.NoError(err)
 	fpBBNSK, _, err := datagen.GenRandomSecp256k1KeyPair(r)
@@ -139,17 +145,19 @@ func (h *Helper) CreateFinalityProvider(r *rand.Rand) (*btcec.PrivateKey, *btcec
 
 	registeredEpoch := uint64(10)
 	fp.RegisteredEpoch = registeredEpoch
+	fp.ConsumerId = consumerID
 
 	h.CheckpointingKeeper.EXPECT().GetEpoch(gomock.Eq(h.Ctx)).Return(&etypes.Epoch{EpochNumber: registeredEpoch}).Times(1)
 
 	msgNewFp := types.MsgCreateFinalityProvider{
-		Signer:        datagen.GenRandomAccount().Address,
+		Signer:        sdk.AccAddress(fp.BabylonPk.Address()).String(),
 		Description:   fp.Description,
 		Commission:    fp.Commission,
 		BabylonPk:     fp.BabylonPk,
 		BtcPk:         fp.BtcPk,
 		Pop:           fp.Pop,
 		MasterPubRand: fp.MasterPubRand,
+		ConsumerId:    fp.ConsumerId,
 	}
 
 	_, err = h.MsgServer.CreateFinalityProvider(h.Ctx, &msgNewFp)
@@ -166,11 +174,61 @@ func (h *Helper) CreateDelegationCustom(
 	unbondingValue int64,
 	unbondingTime uint16,
 ) (string, *btcec.PrivateKey, *btcec.PublicKey, *types.MsgCreateBTCDelegation, error) {
+	delSK, delPK, msgCreateBTCDel := h.GenMsgCreateDelegation(
+		r,
+		fpPK,
+		stakingValue,
+		stakingTime,
+		unbondingValue,
+		unbondingTime,
+	)
+
+	_, err := h.MsgServer.CreateBTCDelegation(h.Ctx, msgCreateBTCDel)
+	if err != nil {
+		return "", nil, nil, nil, err
+	}
+
+	stakingMsgTx, err := bbn.NewBTCTxFromBytes(msgCreateBTCDel.StakingTx.Transaction)
+	h.NoError(err)
+	stakingTxHash := stakingMsgTx.TxHash().String()
+
+	return stakingTxHash, delSK, delPK, msgCreateBTCDel, nil
+}
+
+// GenMsgCreateDelegation generates a valid MsgCreateBTCDelegation without
+// submitting it, along with the staker's key pair
+func (h *Helper) GenMsgCreateDelegation(
+	r *rand.Rand,
+	fpPK *btcec.PublicKey,
+	stakingValue int64,
+	stakingTime uint16,
+	unbondingValue int64,
+	unbondingTime uint16,
+) (*btcec.PrivateKey, *btcec.PublicKey, *types.MsgCreateBTCDelegation) {
 	delSK, delPK, err := datagen.GenRandomBTCKeyPair(r)
 	h.NoError(err)
+	msgCreateBTCDel := h.GenMsgCreateDelegationWithStaker(r, delSK, fpPK, stakingValue, stakingTime, unbondingValue, unbondingTime)
+	return delSK, delPK, msgCreateBTCDel
+}
+
+// GenMsgCreateDelegationWithStaker generates a valid MsgCreateBTCDelegation of
+// the staker with the given BTC SK without submitting it
+func (h *Helper) GenMsgCreateDelegationWithStaker(
+	r *rand.Rand,
+	delSK *btcec.PrivateKey,
+	fpPK *btcec.PublicKey,
+	stakingValue int64,
+	stakingTime uint16,
+	unbondingValue int64,
+	unbondingTime uint16,
+) *types.MsgCreateBTCDelegation {
 	stakingTimeBlocks := stakingTime
 	bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
-	covPKs, err := bbn.NewBTCPKsFromBIP340PKs(bsParams.CovenantPks)
+	// the covenant committee's part of the scripts, i.e., the aggregate
+	// covenant PK if the covenant committee signs via MuSig2
+	covSigners, covQuorum, err := bsParams.CovenantSigners(bsParams.ScriptTemplateVersion)
+	h.NoError(err)
+	covPKs, err := bbn.NewBTCPKsFromBIP340PKs(covSigners)
 	h.NoError(err)
 
 	testStakingInfo := datagen.GenBTCStakingSlashingInfo(
@@ -180,7 +238,7 @@ func (h *Helper) CreateDelegationCustom(
 		delSK,
 		[]*btcec.PublicKey{fpPK},
 		covPKs,
-		bsParams.CovenantQuorum,
+		covQuorum,
 		stakingTimeBlocks,
 		stakingValue,
 		bsParams.SlashingAddress,
@@ -188,7 +246,6 @@ func (h *Helper) CreateDelegationCustom(
 		unbondingTime,
 	)
 	h.NoError(err)
-	stakingTxHash := testStakingInfo.StakingTx.TxHash().String()
 
 	// random signer
 	signer := datagen.GenRandomAccount().Address
@@ -239,7 +296,7 @@ func (h *Helper) CreateDelegationCustom(
 		delSK,
 		[]*btcec.PublicKey{fpPK},
 		covPKs,
-		bsParams.CovenantQuorum,
+		covQuorum,
 		wire.NewOutPoint(&stkTxHash, stkOutputIdx),
 		unbondingTime,
 		unbondingValue,
@@ -275,12 +332,7 @@ func (h *Helper) CreateDelegationCustom(
 		DelegatorUnbondingSlashingSig: delSlashingTxSig,
 	}
 
-	_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, msgCreateBTCDel)
-	if err != nil {
-		return "", nil, nil, nil, err
-	}
-
-	return stakingTxHash, delSK, delPK, msgCreateBTCDel, nil
+	return msgCreateBTCDel
 }
 
 func (h *Helper) CreateDelegation(
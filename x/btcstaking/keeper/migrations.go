@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/babylonchain/babylon/x/btcstaking/migrations/v2"
+	v3 "github.com/babylonchain/babylon/x/btcstaking/migrations/v3"
+	v4 "github.com/babylonchain/babylon/x/btcstaking/migrations/v4"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2, which decodes all BTC
+// delegations with the frozen v1 schema and rewrites them with the v2 schema.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate2to3 migrates from version 2 to 3, which moves the raw BTC txs of
+// all BTC delegations to a separate store.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate3to4 migrates from version 3 to 4, which indexes all BTC delegations
+// by their slashing, unbonding and unbonding slashing txs, and all pending BTC
+// delegations by their stakers and inclusion heights.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
@@ -10,10 +10,12 @@ import (
 	"github.com/babylonchain/babylon/btcstaking"
 	"github.com/babylonchain/babylon/crypto/eots"
 	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	"github.com/babylonchain/babylon/x/btcstaking/sigverifier"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
-	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -33,26 +35,56 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 
 var _ types.MsgServer = msgServer{}
 
-// UpdateParams updates the params
+// UpdateParams updates the params. An update is not rejected for invalidating
+// live BTC delegations, e.g., by removing covenant members required for their
+// quorum, as each BTC delegation is evaluated against the params version it
+// was created under
 func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if ms.authority != req.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
 	}
-	if err := req.Params.Validate(); err != nil {
+	if err := req.Params.ValidateWithBTCNet(ms.btcNet); err != nil {
 		return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid parameter: %v", err)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	if err := ms.SetParams(ctx, req.Params); err != nil {
-		return nil, err
+	if req.ActivationHeight == 0 && req.ActivationEpoch == 0 {
+		if err := ms.ApplyParams(ctx, req.Params); err != nil {
+			return nil, err
+		}
+		return &types.MsgUpdateParamsResponse{}, nil
+	}
+
+	if err := ms.ScheduleParams(ctx, req.Params, req.ActivationHeight, req.ActivationEpoch); err != nil {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid parameter activation: %v", err)
 	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+// SetPause pauses or resumes the processing of new BTC delegations and early
+// unbondings. It can only be executed by the governance account or the pause
+// authority.
+func (ms msgServer) SetPause(goCtx context.Context, req *types.MsgSetPause) (*types.MsgSetPauseResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !ms.isPauseSigner(ctx, req.Signer) {
+		return nil, types.ErrUnauthorizedPauseSigner.Wrapf("got %s", req.Signer)
+	}
+	if err := ms.updatePauseState(ctx, req.Signer, req.Paused, req.Reason); err != nil {
+		panic(fmt.Errorf("failed to emit EventPauseStateUpdated: %w", err))
+	}
+
+	return &types.MsgSetPauseResponse{}, nil
+}
+
 // CreateFinalityProvider creates a finality provider
 func (ms msgServer) CreateFinalityProvider(goCtx context.Context, req *types.MsgCreateFinalityProvider) (*types.MsgCreateFinalityProviderResponse, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyCreateFinalityProvider)
+	defer recordMsgGasUsed(goCtx, types.MetricsKeyCreateFinalityProvider)()
 
 	// ensure the finality provider address does not already exist
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -61,7 +93,15 @@ func (ms msgServer) CreateFinalityProvider(goCtx context.Context, req *types.Msg
 		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
+	// ensure the signer is the finality provider, or a relayer authorized by
+	// the finality provider via authz
+	signer := sdk.MustAccAddressFromBech32(req.Signer)
+	if err := ms.authorizeFpSigner(ctx, signer, sdk.AccAddress(req.BabylonPk.Address()), req); err != nil {
+		return nil, err
+	}
+
 	// verify proof of possession
+	ctx.GasMeter().ConsumeGas(types.GasPoPVerification, "proof of possession verification")
 	if err := req.Pop.Verify(req.BabylonPk, req.BtcPk, ms.btcNet); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid proof of possession: %v", err)
 	}
@@ -86,6 +126,11 @@ func (ms msgServer) CreateFinalityProvider(goCtx context.Context, req *types.Msg
 		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
+	// ensure the consumer chain, if any, is registered
+	if req.ConsumerId != "" && !ms.hasConsumer(ctx, req.ConsumerId) {
+		return nil, types.ErrConsumerNotRegistered.Wrapf("consumer ID: %s", req.ConsumerId)
+	}
+
 	// all good, add this finality provider
 	fp := types.FinalityProvider{
 		Description:     req.Description,
@@ -95,6 +140,7 @@ func (ms msgServer) CreateFinalityProvider(goCtx context.Context, req *types.Msg
 		Pop:             req.Pop,
 		MasterPubRand:   req.MasterPubRand,
 		RegisteredEpoch: ms.ckptKeeper.GetEpoch(ctx).EpochNumber,
+		ConsumerId:      req.ConsumerId,
 	}
 	ms.SetFinalityProvider(ctx, &fp)
 
@@ -144,23 +190,14 @@ func (ms msgServer) EditFinalityProvider(ctx context.Context, req *types.MsgEdit
 	return &types.MsgEditFinalityProviderResponse{}, nil
 }
 
-// caluculateMinimumUnbondingValue calculates minimum unbonding value basend on current staking output value
-// and params.MinUnbondingRate
-func caluculateMinimumUnbondingValue(
-	stakingOutput *wire.TxOut,
-	params *types.Params,
-) btcutil.Amount {
-	// this conversions must always succeed, as it is part of our params
-	minUnbondingRate := params.MinUnbondingRate.MustFloat64()
-	// Caluclate min unbonding output value based on staking output, use btc native multiplication
-	minUnbondingOutputValue := btcutil.Amount(stakingOutput.Value).MulF64(minUnbondingRate)
-	return minUnbondingOutputValue
-}
-
 // CreateBTCDelegation creates a BTC delegation
 // TODO: refactor this handler. It's now too convoluted
-func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCreateBTCDelegation) (*types.MsgCreateBTCDelegationResponse, error) {
+func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCreateBTCDelegation) (_ *types.MsgCreateBTCDelegationResponse, err error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyCreateBTCDelegation)
+	defer recordMsgGasUsed(goCtx, types.MetricsKeyCreateBTCDelegation)()
+
+	goCtx, span := ms.startDelegationSpan(goCtx, "CreateBTCDelegation")
+	defer func() { endDelegationSpan(span, err) }()
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	// basic stateless checks
@@ -168,9 +205,14 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
+	// new BTC delegations are not accepted during an emergency pause
+	if ms.IsPaused(ctx) {
+		return nil, types.ErrMsgProcessingPaused.Wrap("new BTC delegations are paused")
+	}
+
 	vp := ms.GetParamsWithVersion(ctx)
 	btccParams := ms.btccKeeper.GetParams(ctx)
-	kValue, wValue := btccParams.BtcConfirmationDepth, btccParams.CheckpointFinalizationTimeout
+	activationDepth, wValue := types.StakingTxActivationDepth(vp.Params, btccParams), btccParams.CheckpointFinalizationTimeout
 
 	minUnbondingTime := types.MinimumUnbondingTime(vp.Params, btccParams)
 
@@ -187,7 +229,16 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 	// - is smaller than math.MaxUint16 (due to check in req.ValidateBasic())
 	validatedUnbondingTime := uint16(req.UnbondingTime)
 
+	// the BTC withdrawal address, if specified, has to be a valid address on
+	// the BTC network of Babylon
+	if req.WithdrawalBtcAddress != "" {
+		if _, err := btcstaking.DecodeAddress(req.WithdrawalBtcAddress, ms.btcNet, nil); err != nil {
+			return nil, types.ErrInvalidWithdrawalAddress.Wrap(err.Error())
+		}
+	}
+
 	// verify proof of possession
+	ctx.GasMeter().ConsumeGas(types.GasPoPVerification, "proof of possession verification")
 	if err := req.Pop.Verify(req.BabylonPk, req.BtcPk, ms.btcNet); err != nil {
 		return nil, types.ErrInvalidProofOfPossession.Wrapf("error while validating proof of posession: %v", err)
 	}
@@ -219,11 +270,18 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 
 	// Check staking tx is not duplicated
 	stakingTxHash := stakingMsgTx.TxHash()
-	delgation := ms.getBTCDelegation(ctx, stakingTxHash)
-	if delgation != nil {
+	setDelegationSpanAttributes(ctx, stakingTxHash.String(), req.FpBtcPkList, req.BtcPk)
+	if ms.HasBTCDelegation(ctx, stakingTxHash) {
 		return nil, types.ErrReusedStakingTx.Wrapf("duplicated tx hash: %s", stakingTxHash.String())
 	}
 
+	// ensure the staker does not exceed the maximum number of pending BTC
+	// delegations, which may never receive covenant quorum
+	if maxPending := vp.Params.MaxPendingBtcDelegationsPerStaker; maxPending > 0 &&
+		ms.countPendingBTCDelegations(ctx, req.BtcPk, maxPending) >= maxPending {
+		return nil, types.ErrTooManyPendingBTCDelegations.Wrapf("staker %s has %d pending BTC delegations", req.BtcPk.MarshalHex(), maxPending)
+	}
+
 	// Check if data provided in request, matches data to which staking tx is committed
 	fpPKs, err := bbn.NewBTCPKsFromBIP340PKs(req.FpBtcPkList)
 	if err != nil {
@@ -236,7 +294,14 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 	}
 	stakerPk := req.BtcPk.MustToBTCPK()
 
-	stakingInfo, err := btcstaking.BuildStakingInfo(
+	// new BTC delegations are built with the script template in the current
+	// params
+	scriptTemplate, err := btcstaking.GetScriptTemplate(vp.Params.ScriptTemplateVersion)
+	if err != nil {
+		// programming error
+		panic(fmt.Errorf("invalid script template in params: %w", err))
+	}
+	stakingInfo, err := scriptTemplate.BuildStakingInfo(
 		stakerPk,
 		fpPKs,
 		covenantPKs,
@@ -254,6 +319,19 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 		return nil, types.ErrInvalidStakingTx.Wrap("staking tx does not contain expected staking output")
 	}
 
+	// ensure the staking tx carries the tagged OP_RETURN output if the params
+	// require so
+	if err := vp.Params.VerifyStakingTxTag(
+		stakingMsgTx,
+		stakingOutputIdx,
+		req.BtcPk,
+		req.FpBtcPkList,
+		uint16(req.StakingTime),
+		ms.btcNet,
+	); err != nil {
+		return nil, err
+	}
+
 	// Check staking tx timelock has correct values
 	// get startheight and endheight of the timelock
 	stakingTxHeader := ms.btclcKeeper.GetHeaderByHash(ctx, req.StakingTx.Key.Hash)
@@ -262,19 +340,18 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 	}
 	startHeight := stakingTxHeader.Height
 	endHeight := stakingTxHeader.Height + uint64(req.StakingTime)
+	// the BTC delegation becomes active once the staking tx is deep enough
+	activationHeight := startHeight + activationDepth
 
-	// ensure staking tx is k-deep
+	// ensure staking tx's timelock has more than w BTC blocks left when the
+	// BTC delegation becomes active
 	btcTip := ms.btclcKeeper.GetTipInfo(ctx)
-	stakingTxDepth := btcTip.Height - stakingTxHeader.Height
-	if stakingTxDepth < kValue {
-		return nil, types.ErrInvalidStakingTx.Wrapf("not k-deep: k=%d; depth=%d", kValue, stakingTxDepth)
-	}
-	// ensure staking tx's timelock has more than w BTC blocks left
-	if btcTip.Height+wValue >= endHeight {
-		return nil, types.ErrInvalidStakingTx.Wrapf("staking tx's timelock has no more than w(=%d) blocks left", wValue)
+	if max(btcTip.Height, activationHeight)+wValue >= endHeight {
+		return nil, types.ErrInvalidStakingTx.Wrapf("staking tx's timelock has no more than w(=%d) blocks left upon activation", wValue)
 	}
 
 	// verify staking tx info, i.e., inclusion proof
+	consumeSPVProofGas(ctx, req.StakingTx)
 	if err := req.StakingTx.VerifyInclusion(stakingTxHeader.Header, ms.btccKeeper.GetPowLimit()); err != nil {
 		return nil, types.ErrInvalidStakingTx.Wrapf("not included in the Bitcoin chain: %v", err)
 	}
@@ -287,10 +364,7 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 
 	// decode slashing address
 	// TODO: Decode slashing address only once, as it is the same for all BTC delegations
-	slashingAddr, err := btcutil.DecodeAddress(vp.Params.SlashingAddress, ms.btcNet)
-	if err != nil {
-		panic(fmt.Errorf("failed to decode slashing address in genesis: %w", err))
-	}
+	slashingAddr := vp.Params.MustGetSlashingAddress(ms.btcNet)
 
 	// Check slashing tx and staking tx are valid and consistent
 	if err := btcstaking.CheckTransactions(
@@ -313,6 +387,7 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 		panic(fmt.Errorf("failed to construct slashing path from the staking tx: %w", err))
 	}
 
+	consumeSigVerificationGas(ctx, 1, 0)
 	err = req.SlashingTx.VerifySignature(
 		stakingInfo.StakingOutput.PkScript,
 		stakingInfo.StakingOutput.Value,
@@ -324,10 +399,16 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 		return nil, types.ErrInvalidSlashingTx.Wrapf("invalid delegator signature: %v", err)
 	}
 
+	// ensure the slashing tx pays the fee at the minimum fee rate, such that
+	// it can be included in Bitcoin in time once the BTC delegation is slashed
+	if err := vp.Params.CheckTxFeeRate(slashingMsgTx, stakingInfo.StakingOutput.Value, slashingSpendInfo); err != nil {
+		return nil, errorsmod.Wrap(err, "slashing tx")
+	}
+
 	// all good, construct BTCDelegation and insert BTC delegation
 	// NOTE: the BTC delegation does not have voting power yet. It will
-	// have voting power only when 1) its corresponding staking tx is k-deep,
-	// and 2) it receives a covenant signature
+	// have voting power only when 1) it receives a covenant quorum, and 2) the
+	// BTC chain reaches its activation height
 	newBTCDel := &types.BTCDelegation{
 		BabylonPk:        req.BabylonPk,
 		BtcPk:            req.BtcPk,
@@ -344,6 +425,23 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 		CovenantSigs:     nil,        // NOTE: covenant signature will be submitted in a separate msg by covenant
 		BtcUndelegation:  nil,        // this will be constructed in below code
 		ParamsVersion:    vp.Version, // version of the params against delegations was validated
+		// version of the script template the outputs of the delegation are built with
+		ScriptTemplateVersion: scriptTemplate.Version,
+		// the slashing rate and the slashing address the slashing txs commit to,
+		// which remain effective for the delegation upon params changes
+		SlashingRate:    vp.Params.SlashingRate,
+		SlashingAddress: vp.Params.SlashingAddress,
+		Memo:            req.Memo,
+		// the Babylon height at which the delegation is created, for
+		// measuring the latency of covenant signatures
+		CreatedBabylonHeight: uint64(ctx.HeaderInfo().Height),
+		ActivationHeight:     activationHeight,
+		StakingTxHeaderHash:  req.StakingTx.Key.Hash,
+		// the account receiving the rewards and the BTC address the stake is
+		// to be withdrawn to, which may differ from the staker's keys in
+		// custodial setups
+		RewardAddress:        req.RewardAddress,
+		WithdrawalBtcAddress: req.WithdrawalBtcAddress,
 	}
 
 	/*
@@ -370,7 +468,7 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 	}
 
 	// building unbonding info
-	unbondingInfo, err := btcstaking.BuildUnbondingInfo(
+	unbondingInfo, err := scriptTemplate.BuildUnbondingInfo(
 		newBTCDel.BtcPk.MustToBTCPK(),
 		fpPKs,
 		covenantPKs,
@@ -413,6 +511,7 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 		panic(err)
 	}
 
+	consumeSigVerificationGas(ctx, 1, 0)
 	err = req.UnbondingSlashingTx.VerifySignature(
 		unbondingInfo.UnbondingOutput.PkScript,
 		unbondingInfo.UnbondingOutput.Value,
@@ -436,11 +535,24 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 		return nil, types.ErrInvalidUnbondingTx.Wrapf("unbonding tx fee must be larger that 0")
 	}
 
-	minUnbondingValue := caluculateMinimumUnbondingValue(stakingMsgTx.TxOut[stakingOutputIdx], &vp.Params)
+	minUnbondingValue := types.MinimumUnbondingValue(stakingMsgTx.TxOut[stakingOutputIdx], &vp.Params)
 	if btcutil.Amount(unbondingMsgTx.TxOut[0].Value) < minUnbondingValue {
 		return nil, types.ErrInvalidUnbondingTx.Wrapf("unbonding output value must be at least %s, based on staking output", minUnbondingValue)
 	}
 
+	// ensure the unbonding tx and the unbonding slashing tx pay the fees at
+	// the minimum fee rate
+	unbondingSpendInfo, err := stakingInfo.UnbondingPathSpendInfo()
+	if err != nil {
+		panic(fmt.Errorf("failed to construct unbonding path from the staking tx: %w", err))
+	}
+	if err := vp.Params.CheckTxFeeRate(unbondingMsgTx, stakingInfo.StakingOutput.Value, unbondingSpendInfo); err != nil {
+		return nil, errorsmod.Wrap(err, "unbonding tx")
+	}
+	if err := vp.Params.CheckTxFeeRate(unbondingSlashingMsgTx, unbondingInfo.UnbondingOutput.Value, unbondingSlashingSpendInfo); err != nil {
+		return nil, errorsmod.Wrap(err, "unbonding slashing tx")
+	}
+
 	// all good, add BTC undelegation
 	newBTCDel.BtcUndelegation = &types.BTCUndelegation{
 		UnbondingTx:              req.UnbondingTx,
@@ -472,13 +584,19 @@ func (ms msgServer) getBTCDelWithParams(
 		panic("params version in BTC delegation is not found")
 	}
 
-	return btcDel, bsParams, nil
+	// the BTC delegation is validated against the slashing rate and the
+	// slashing address recorded at its creation rather than current params
+	return btcDel, btcDel.ParamsWithRecordedSlashing(bsParams), nil
 }
 
 // AddCovenantSig adds signatures from covenants to a BTC delegation
 // TODO: refactor this handler. Now it's too convoluted
-func (ms msgServer) AddCovenantSigs(goCtx context.Context, req *types.MsgAddCovenantSigs) (*types.MsgAddCovenantSigsResponse, error) {
+func (ms msgServer) AddCovenantSigs(goCtx context.Context, req *types.MsgAddCovenantSigs) (_ *types.MsgAddCovenantSigsResponse, err error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyAddCovenantSigs)
+	defer recordMsgGasUsed(goCtx, types.MetricsKeyAddCovenantSigs)()
+
+	goCtx, span := ms.startDelegationSpan(goCtx, "AddCovenantSigs")
+	defer func() { endDelegationSpan(span, err) }()
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	// basic stateless checks
@@ -491,9 +609,17 @@ func (ms msgServer) AddCovenantSigs(goCtx context.Context, req *types.MsgAddCove
 	if err != nil {
 		return nil, err
 	}
+	setDelegationSpanAttributes(ctx, req.StakingTxHash, btcDel.FpBtcPkList, btcDel.BtcPk)
 
-	// ensure that the given covenant PK is in the parameter
-	if !params.HasCovenantPK(req.Pk) {
+	// ensure that the given covenant PK is a covenant signer of the BTC
+	// delegation, i.e., a covenant member in the parameter, or the aggregate
+	// covenant PK if the covenant committee signs via MuSig2
+	covenantSigners, _, err := params.CovenantSigners(btcDel.ScriptTemplateVersion)
+	if err != nil {
+		// the script template of the delegation has passed verification
+		panic(fmt.Errorf("failed to get covenant signers of a verified delegation: %w", err))
+	}
+	if !types.ContainsPK(covenantSigners, req.Pk) {
 		return nil, types.ErrInvalidCovenantPK.Wrapf("covenant pk: %s", req.Pk.MarshalHex())
 	}
 
@@ -524,37 +650,42 @@ func (ms msgServer) AddCovenantSigs(goCtx context.Context, req *types.MsgAddCove
 			len(req.SlashingTxSigs), len(btcDel.FpBtcPkList))
 	}
 
-	/*
-		Verify each covenant adaptor signature over slashing tx
-	*/
+	// Check that the number of covenant sigs and number of the
+	// finality providers are matched
+	if len(req.SlashingUnbondingTxSigs) != len(btcDel.FpBtcPkList) {
+		return nil, types.ErrInvalidCovenantSig.Wrapf(
+			"number of covenant signatures: %d, number of finality providers being staked to: %d",
+			len(req.SlashingUnbondingTxSigs), len(btcDel.FpBtcPkList))
+	}
+
+	// ensure the slashing txs that covenant members sign over slash with the
+	// slashing rate and to the slashing address recorded on the delegation
+	if err := btcDel.CheckSlashingTxs(params, ms.btcNet); err != nil {
+		return nil, types.ErrInvalidSlashingTx.Wrap(err.Error())
+	}
+
 	stakingInfo, err := btcDel.GetStakingInfo(params, ms.btcNet)
 	if err != nil {
 		panic(fmt.Errorf("failed to get staking info from a verified delegation: %w", err))
 	}
+	var sigReqs []*sigverifier.Request
+
+	/*
+		Verify each covenant adaptor signature over slashing tx
+	*/
 	slashingSpendInfo, err := stakingInfo.SlashingPathSpendInfo()
 	if err != nil {
 		// our staking info was constructed by using BuildStakingInfo constructor, so if
 		// this fails, it is a programming error
 		panic(err)
 	}
-	parsedSlashingAdaptorSignatures, err := btcDel.SlashingTx.ParseEncVerifyAdaptorSignatures(
+	sigReqs = append(sigReqs, btcDel.SlashingTx.NewAdaptorSigVerificationRequests(
 		stakingInfo.StakingOutput,
 		slashingSpendInfo,
 		req.Pk,
 		btcDel.FpBtcPkList,
 		req.SlashingTxSigs,
-	)
-	if err != nil {
-		return nil, types.ErrInvalidCovenantSig.Wrapf("err: %v", err)
-	}
-
-	// Check that the number of covenant sigs and number of the
-	// finality providers are matched
-	if len(req.SlashingUnbondingTxSigs) != len(btcDel.FpBtcPkList) {
-		return nil, types.ErrInvalidCovenantSig.Wrapf(
-			"number of covenant signatures: %d, number of finality providers being staked to: %d",
-			len(req.SlashingUnbondingTxSigs), len(btcDel.FpBtcPkList))
-	}
+	)...)
 
 	/*
 		Verify Schnorr signature over unbonding tx
@@ -569,16 +700,17 @@ func (ms msgServer) AddCovenantSigs(goCtx context.Context, req *types.MsgAddCove
 		// this fails, it is a programming error
 		panic(err)
 	}
-	if err := btcstaking.VerifyTransactionSigWithOutputData(
+	unbondingSigReq, err := sigverifier.NewSchnorrSigRequest(
 		unbondingMsgTx,
-		stakingInfo.StakingOutput.PkScript,
-		stakingInfo.StakingOutput.Value,
+		stakingInfo.StakingOutput,
 		unbondingSpendInfo.GetPkScriptPath(),
-		req.Pk.MustToBTCPK(),
+		req.Pk,
 		*req.UnbondingTxSig,
-	); err != nil {
-		return nil, types.ErrInvalidCovenantSig.Wrap(err.Error())
+	)
+	if err != nil {
+		panic(fmt.Errorf("failed to serialize unbonding tx from existing delegation with hash %s : %v", req.StakingTxHash, err))
 	}
+	sigReqs = append(sigReqs, unbondingSigReq)
 
 	/*
 		verify each adaptor signature on slashing unbonding tx
@@ -594,13 +726,26 @@ func (ms msgServer) AddCovenantSigs(goCtx context.Context, req *types.MsgAddCove
 		// this fails, it is a programming error
 		panic(err)
 	}
-	parsedUnbondingSlashingAdaptorSignatures, err := btcDel.BtcUndelegation.SlashingTx.ParseEncVerifyAdaptorSignatures(
+	sigReqs = append(sigReqs, btcDel.BtcUndelegation.SlashingTx.NewAdaptorSigVerificationRequests(
 		unbondingOutput,
 		unbondingSlashingSpendInfo,
 		req.Pk,
 		btcDel.FpBtcPkList,
 		req.SlashingUnbondingTxSigs,
-	)
+	)...)
+
+	// verify all signatures in a single batch, which might be offloaded
+	// to out-of-process workers in CheckTx
+	consumeSigVerificationGas(ctx, 1, len(req.SlashingTxSigs)+len(req.SlashingUnbondingTxSigs))
+	if _, err := sigverifier.FirstError(ms.covenantSigVerifier(ctx).VerifyBatch(sigReqs)); err != nil {
+		return nil, types.ErrInvalidCovenantSig.Wrapf("err: %v", err)
+	}
+
+	parsedSlashingAdaptorSignatures, err := types.ParseAdaptorSignatures(req.SlashingTxSigs)
+	if err != nil {
+		return nil, types.ErrInvalidCovenantSig.Wrapf("err: %v", err)
+	}
+	parsedUnbondingSlashingAdaptorSignatures, err := types.ParseAdaptorSignatures(req.SlashingUnbondingTxSigs)
 	if err != nil {
 		return nil, types.ErrInvalidCovenantSig.Wrapf("err: %v", err)
 	}
@@ -616,15 +761,96 @@ func (ms msgServer) AddCovenantSigs(goCtx context.Context, req *types.MsgAddCove
 		parsedUnbondingSlashingAdaptorSignatures,
 		params,
 	)
+	ms.recordCovenantSigs(ctx, btcDel, req.Pk, params)
+
+	// the MuSig2 nonces of covenant members, if any, are no longer needed
+	// once the BTC delegation has a covenant quorum
+	if btcDel.HasCovenantQuorums(params.CovenantQuorum) {
+		ms.removeCovenantMuSig2Nonces(ctx, btcDel.MustGetStakingTxHash())
+	}
+
+	// the covenant signatures are accepted, so the fee of the tx carrying
+	// them is refundable
+	ms.indexRefundableMsg(ctx, req)
 
 	return &types.MsgAddCovenantSigsResponse{}, nil
 }
 
+// AddCovenantMuSig2Nonces records the MuSig2 nonces of a covenant member for
+// co-signing a BTC delegation whose covenant committee signs via MuSig2
+func (ms msgServer) AddCovenantMuSig2Nonces(goCtx context.Context, req *types.MsgAddCovenantMuSig2Nonces) (*types.MsgAddCovenantMuSig2NoncesResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyAddCovenantMuSig2Nonces)
+	defer recordMsgGasUsed(goCtx, types.MetricsKeyAddCovenantMuSig2Nonces)()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// basic stateless checks
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	btcDel, params, err := ms.getBTCDelWithParams(ctx, req.StakingTxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	// nonces are only exchanged for BTC delegations whose covenant committee
+	// signs via MuSig2
+	scriptTemplate, err := btcstaking.GetScriptTemplate(btcDel.ScriptTemplateVersion)
+	if err != nil {
+		// the script template of the delegation has passed verification
+		panic(fmt.Errorf("failed to get script template of a verified delegation: %w", err))
+	}
+	if !scriptTemplate.CovenantMuSig2 {
+		return nil, types.ErrInvalidMuSig2Nonces.Wrapf("the covenant committee of BTC delegation %s does not sign via MuSig2", req.StakingTxHash)
+	}
+
+	// ensure that the given covenant PK is in the parameter
+	covPK := req.Nonces.CovPk
+	if !params.HasCovenantPK(covPK) {
+		return nil, types.ErrInvalidCovenantPK.Wrapf("covenant pk: %s", covPK.MarshalHex())
+	}
+
+	// ensure BTC delegation is still pending
+	btcTipHeight := ms.btclcKeeper.GetTipInfo(ctx).Height
+	wValue := ms.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+	if btcDel.GetStatus(btcTipHeight, wValue, params.CovenantQuorum) != types.BTCDelegationStatus_PENDING {
+		return nil, types.ErrInvalidDelegationState.Wrap("MuSig2 nonces can only be submitted for pending BTC delegations")
+	}
+
+	// a covenant member's nonces cannot be replaced, since other covenant
+	// members might have already signed with them
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+	if ms.hasCovenantMuSig2Nonces(ctx, stakingTxHash, covPK) {
+		return nil, types.ErrDuplicatedMuSig2Nonces.Wrapf("covenant pk: %s", covPK.MarshalHex())
+	}
+
+	// ensure the nonces are well-formed and are signed by the covenant member
+	if err := req.Nonces.Validate(len(btcDel.FpBtcPkList)); err != nil {
+		return nil, err
+	}
+	consumeSigVerificationGas(ctx, 1, 0)
+	if err := req.Nonces.VerifySig(req.StakingTxHash, req.Sig); err != nil {
+		return nil, err
+	}
+
+	ms.setCovenantMuSig2Nonces(ctx, stakingTxHash, &req.Nonces)
+
+	// the MuSig2 nonces are accepted, so the fee of the tx carrying them is
+	// refundable
+	ms.indexRefundableMsg(ctx, req)
+
+	return &types.MsgAddCovenantMuSig2NoncesResponse{}, nil
+}
+
 // BTCUndelegate adds a signature on the unbonding tx from the BTC delegator
 // this effectively proves that the BTC delegator wants to unbond and Babylon
 // will consider its BTC delegation unbonded
-func (ms msgServer) BTCUndelegate(goCtx context.Context, req *types.MsgBTCUndelegate) (*types.MsgBTCUndelegateResponse, error) {
+func (ms msgServer) BTCUndelegate(goCtx context.Context, req *types.MsgBTCUndelegate) (_ *types.MsgBTCUndelegateResponse, err error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyBTCUndelegate)
+	defer recordMsgGasUsed(goCtx, types.MetricsKeyBTCUndelegate)()
+
+	goCtx, span := ms.startDelegationSpan(goCtx, "BTCUndelegate")
+	defer func() { endDelegationSpan(span, err) }()
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	// basic stateless checks
@@ -632,11 +858,28 @@ func (ms msgServer) BTCUndelegate(goCtx context.Context, req *types.MsgBTCUndele
 		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
+	// early unbondings are not accepted during an emergency pause
+	if ms.IsPaused(ctx) {
+		return nil, types.ErrMsgProcessingPaused.Wrap("early unbondings are paused")
+	}
+
 	btcDel, bsParams, err := ms.getBTCDelWithParams(ctx, req.StakingTxHash)
 
 	if err != nil {
 		return nil, err
 	}
+	setDelegationSpanAttributes(ctx, req.StakingTxHash, btcDel.FpBtcPkList, btcDel.BtcPk)
+
+	// ensure the signer is either the staker or the operator authorized by
+	// the staker, so that third parties cannot trigger unbonding of others'
+	// BTC delegations
+	signer, err := sdk.AccAddressFromBech32(req.Signer)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid signer address: %v", err)
+	}
+	if !ms.isAuthorizedUndelegator(ctx, btcDel, signer) {
+		return nil, types.ErrUnauthorizedSigner.Wrapf("%s is neither the staker nor the operator of BTC delegation %s", req.Signer, req.StakingTxHash)
+	}
 
 	// ensure the BTC delegation with the given staking tx hash is active
 	btcTip := ms.btclcKeeper.GetTipInfo(ctx)
@@ -660,6 +903,7 @@ func (ms msgServer) BTCUndelegate(goCtx context.Context, req *types.MsgBTCUndele
 		// this fails, it is a programming error
 		panic(err)
 	}
+	consumeSigVerificationGas(ctx, 1, 0)
 	if err := btcstaking.VerifyTransactionSigWithOutputData(
 		unbondingMsgTx,
 		stakingInfo.StakingOutput.PkScript,
@@ -674,14 +918,214 @@ func (ms msgServer) BTCUndelegate(goCtx context.Context, req *types.MsgBTCUndele
 	// all good, add the signature to BTC delegation's undelegation
 	// and set back
 	ms.btcUndelegate(ctx, btcDel, req.UnbondingTxSig)
+	// the operator is no longer needed after unbonding
+	ms.removeDelegationOperator(ctx, btcDel.MustGetStakingTxHash())
 
 	return &types.MsgBTCUndelegateResponse{}, nil
 }
 
+// SetDelegationOperator authorizes an operator to undelegate a BTC delegation
+// on behalf of the staker, or revokes the existing operator if the given
+// operator is empty
+func (ms msgServer) SetDelegationOperator(goCtx context.Context, req *types.MsgSetDelegationOperator) (*types.MsgSetDelegationOperatorResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeySetDelegationOperator)
+	defer recordMsgGasUsed(goCtx, types.MetricsKeySetDelegationOperator)()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// basic stateless checks
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	btcDel, bsParams, err := ms.getBTCDelWithParams(ctx, req.StakingTxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the staker can set the operator of its BTC delegation
+	signer := sdk.MustAccAddressFromBech32(req.Signer)
+	if !signer.Equals(btcDel.StakerAddress()) {
+		return nil, types.ErrUnauthorizedSigner.Wrapf("%s is not the staker of BTC delegation %s", req.Signer, req.StakingTxHash)
+	}
+
+	// there is nothing to operate on an unbonded BTC delegation
+	btcTip := ms.btclcKeeper.GetTipInfo(ctx)
+	wValue := ms.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+	if btcDel.GetStatus(btcTip.Height, wValue, bsParams.CovenantQuorum) == types.BTCDelegationStatus_UNBONDED {
+		return nil, types.ErrInvalidDelegationState.Wrap("cannot set the operator of an unbonded BTC delegation")
+	}
+
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+	if len(req.Operator) == 0 {
+		ms.removeDelegationOperator(ctx, stakingTxHash)
+	} else {
+		ms.setDelegationOperator(ctx, stakingTxHash, sdk.MustAccAddressFromBech32(req.Operator))
+	}
+
+	return &types.MsgSetDelegationOperatorResponse{}, nil
+}
+
+// SetWatchtowerBackup deposits backup data of a BTC delegation for the
+// watchtower designated by the staker, or removes the existing backup data if
+// the given watchtower is empty
+func (ms msgServer) SetWatchtowerBackup(goCtx context.Context, req *types.MsgSetWatchtowerBackup) (*types.MsgSetWatchtowerBackupResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeySetWatchtowerBackup)
+	defer recordMsgGasUsed(goCtx, types.MetricsKeySetWatchtowerBackup)()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// basic stateless checks
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	btcDel, bsParams, err := ms.getBTCDelWithParams(ctx, req.StakingTxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the staker can deposit backup data of its BTC delegation
+	signer := sdk.MustAccAddressFromBech32(req.Signer)
+	if !signer.Equals(btcDel.StakerAddress()) {
+		return nil, types.ErrUnauthorizedSigner.Wrapf("%s is not the staker of BTC delegation %s", req.Signer, req.StakingTxHash)
+	}
+
+	// there is nothing to recover from an unbonded BTC delegation
+	btcTip := ms.btclcKeeper.GetTipInfo(ctx)
+	wValue := ms.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+	if btcDel.GetStatus(btcTip.Height, wValue, bsParams.CovenantQuorum) == types.BTCDelegationStatus_UNBONDED {
+		return nil, types.ErrInvalidDelegationState.Wrap("cannot set the watchtower backup of an unbonded BTC delegation")
+	}
+
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+	if len(req.WatchtowerAddress) == 0 {
+		ms.removeWatchtowerBackup(ctx, stakingTxHash)
+	} else {
+		ms.setWatchtowerBackup(ctx, stakingTxHash, &types.WatchtowerBackup{
+			StakingTxHash:     stakingTxHash.String(),
+			WatchtowerAddress: req.WatchtowerAddress,
+			EncryptedBackup:   req.EncryptedBackup,
+		})
+	}
+
+	return &types.MsgSetWatchtowerBackupResponse{}, nil
+}
+
+// ReportStakingSpend handles the report that the staking output of a BTC
+// delegation is spent by a tx that is neither its unbonding tx nor its slashing
+// tx, in which case the BTC delegation is flagged as compromised and loses its
+// voting power
+func (ms msgServer) ReportStakingSpend(goCtx context.Context, req *types.MsgReportStakingSpend) (*types.MsgReportStakingSpendResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyReportStakingSpend)
+	defer recordMsgGasUsed(goCtx, types.MetricsKeyReportStakingSpend)()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// basic stateless checks
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	btcDel, _, err := ms.getBTCDelWithParams(ctx, req.StakingTxHash)
+	if err != nil {
+		return nil, err
+	}
+	if btcDel.IsCompromised() {
+		return nil, types.ErrInvalidDelegationState.Wrap("the BTC delegation is already compromised")
+	}
+
+	// ensure the spend tx is neither the unbonding tx nor the slashing tx
+	spendMsgTx, err := bbn.NewBTCTxFromBytes(req.SpendTx.Transaction)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid spend tx: %v", err)
+	}
+	if err := btcDel.VerifyStakingSpend(spendMsgTx); err != nil {
+		return nil, types.ErrInvalidStakingSpend.Wrap(err.Error())
+	}
+
+	// ensure the spend tx is k-deep in the Bitcoin chain
+	spendTxHeader := ms.btclcKeeper.GetHeaderByHash(ctx, req.SpendTx.Key.Hash)
+	if spendTxHeader == nil {
+		return nil, types.ErrInvalidStakingSpend.Wrap("header that includes the spend tx is not found")
+	}
+	kValue := ms.btccKeeper.GetParams(ctx).BtcConfirmationDepth
+	btcTip := ms.btclcKeeper.GetTipInfo(ctx)
+	if spendTxHeader.Height > btcTip.Height || btcTip.Height-spendTxHeader.Height < kValue {
+		return nil, types.ErrInvalidStakingSpend.Wrapf("spend tx is not k-deep: k=%d", kValue)
+	}
+	consumeSPVProofGas(ctx, req.SpendTx)
+	if err := req.SpendTx.VerifyInclusion(spendTxHeader.Header, ms.btccKeeper.GetPowLimit()); err != nil {
+		return nil, types.ErrInvalidStakingSpend.Wrapf("spend tx is not included in the Bitcoin chain: %v", err)
+	}
+
+	// the staker can legitimately withdraw via the timelock path once the
+	// staking tx's timelock expires
+	if spendTxHeader.Height >= btcDel.EndHeight {
+		return nil, types.ErrInvalidStakingSpend.Wrapf("spend tx is included after the staking tx's timelock expires at BTC height %d", btcDel.EndHeight)
+	}
+
+	// all good, flag the BTC delegation as compromised
+	ms.compromiseBTCDelegation(ctx, btcDel, spendMsgTx.TxHash())
+	// the operator is no longer needed after the staking output is spent
+	ms.removeDelegationOperator(ctx, btcDel.MustGetStakingTxHash())
+
+	return &types.MsgReportStakingSpendResponse{}, nil
+}
+
+// UpdateFinalityProviderStatus records the planned downtime or key migration
+// window announced by a finality provider, or removes the announcement if the
+// finality provider reports the OPERATIONAL status
+func (ms msgServer) UpdateFinalityProviderStatus(goCtx context.Context, req *types.MsgUpdateFinalityProviderStatus) (*types.MsgUpdateFinalityProviderStatusResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyUpdateFpStatus)
+	defer recordMsgGasUsed(goCtx, types.MetricsKeyUpdateFpStatus)()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// basic stateless checks
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	// find the finality provider with the given BTC PK
+	fp, err := ms.GetFinalityProvider(ctx, req.FpBtcPk.MustMarshal())
+	if err != nil {
+		return nil, err
+	}
+	if fp.IsSlashed() {
+		return nil, types.ErrFpAlreadySlashed
+	}
+
+	// ensure the signer corresponds to the finality provider's Babylon address
+	fpBabylonAddr := sdk.AccAddress(fp.BabylonPk.Address())
+	if req.Signer != fpBabylonAddr.String() {
+		return nil, status.Errorf(codes.PermissionDenied, "the signer does not correspond to the finality provider's Babylon address")
+	}
+
+	curHeight := uint64(ctx.HeaderInfo().Height)
+	report := req.StatusReport(curHeight)
+	if report.Status == types.FinalityProviderOperationalStatus_OPERATIONAL {
+		ms.removeFpStatusReport(ctx, req.FpBtcPk)
+	} else {
+		// the announced window cannot be entirely in the past
+		if report.EndHeight < curHeight {
+			return nil, types.ErrInvalidFpStatusReport.Wrapf("the window [%d, %d] has ended before the current height %d", report.StartHeight, report.EndHeight, curHeight)
+		}
+		ms.setFpStatusReport(ctx, report)
+	}
+
+	// notify subscriber
+	if err := ctx.EventManager().EmitTypedEvent(types.NewEventFinalityProviderStatusUpdated(report)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateFinalityProviderStatusResponse{}, nil
+}
+
 // SelectiveSlashingEvidence handles the evidence that a finality provider has
 // selectively slashed a BTC delegation
-func (ms msgServer) SelectiveSlashingEvidence(goCtx context.Context, req *types.MsgSelectiveSlashingEvidence) (*types.MsgSelectiveSlashingEvidenceResponse, error) {
+func (ms msgServer) SelectiveSlashingEvidence(goCtx context.Context, req *types.MsgSelectiveSlashingEvidence) (_ *types.MsgSelectiveSlashingEvidenceResponse, err error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeySelectiveSlashingEvidence)
+	defer recordMsgGasUsed(goCtx, types.MetricsKeySelectiveSlashingEvidence)()
+
+	goCtx, span := ms.startDelegationSpan(goCtx, "SelectiveSlashingEvidence")
+	defer func() { endDelegationSpan(span, err) }()
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -690,6 +1134,7 @@ func (ms msgServer) SelectiveSlashingEvidence(goCtx context.Context, req *types.
 	if err != nil {
 		return nil, err
 	}
+	setDelegationSpanAttributes(ctx, req.StakingTxHash, btcDel.FpBtcPkList, btcDel.BtcPk)
 
 	// ensure the BTC delegation is active, or its BTC undelegation receives an
 	// unbonding signature from the staker
@@ -723,8 +1168,9 @@ func (ms msgServer) SelectiveSlashingEvidence(goCtx context.Context, req *types.
 	// at this point, the finality provider must have done selective slashing and must be
 	// adversarial
 
-	// slash the finality provider now
-	if err := ms.SlashFinalityProvider(ctx, fpBTCPK.MustMarshal()); err != nil {
+	// slash the finality provider now. The selective slashing happens on
+	// Bitcoin, so the infraction is accounted from the current height
+	if err := ms.SlashFinalityProvider(ctx, fpBTCPK.MustMarshal(), uint64(ctx.HeaderInfo().Height)); err != nil {
 		panic(err) // failed to slash the finality provider, must be programming error
 	}
 
@@ -741,3 +1187,28 @@ func (ms msgServer) SelectiveSlashingEvidence(goCtx context.Context, req *types.
 
 	return &types.MsgSelectiveSlashingEvidenceResponse{}, nil
 }
+
+// recordMsgGasUsed returns a function that records the gas consumed by the
+// message with the given metrics key since recordMsgGasUsed is invoked. It
+// is expected to be deferred at the beginning of each message handler
+func recordMsgGasUsed(goCtx context.Context, msgKey string) func() {
+	gasMeter := sdk.UnwrapSDKContext(goCtx).GasMeter()
+	gasBefore := gasMeter.GasConsumed()
+	return func() {
+		types.RecordMsgGasUsed(msgKey, gasMeter.GasConsumed()-gasBefore)
+	}
+}
+
+// consumeSigVerificationGas charges the gas of verifying the given numbers of
+// Schnorr signatures and adaptor signatures over BTC txs
+func consumeSigVerificationGas(ctx sdk.Context, numSchnorrSigs int, numAdaptorSigs int) {
+	ctx.GasMeter().ConsumeGas(uint64(numSchnorrSigs)*types.GasSchnorrSigVerification, "BTC Schnorr signature verification")
+	ctx.GasMeter().ConsumeGas(uint64(numAdaptorSigs)*types.GasAdaptorSigVerification, "BTC adaptor signature verification")
+}
+
+// consumeSPVProofGas charges the gas of verifying the Merkle proof of the
+// given BTC tx, which consists of 32-byte nodes
+func consumeSPVProofGas(ctx sdk.Context, txInfo *btcctypes.TransactionInfo) {
+	numNodes := uint64(len(txInfo.Proof) / chainhash.HashSize)
+	ctx.GasMeter().ConsumeGas(numNodes*types.GasSPVProofNode, "BTC SPV proof verification")
+}
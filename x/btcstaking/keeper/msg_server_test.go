@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
 	"math"
@@ -9,19 +10,31 @@ import (
 	"time"
 
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+	"github.com/babylonchain/babylon/btcstaking"
 	asig "github.com/babylonchain/babylon/crypto/schnorr-adaptor-signature"
+	btctest "github.com/babylonchain/babylon/testutil/bitcoin"
 	"github.com/babylonchain/babylon/testutil/datagen"
 	testhelper "github.com/babylonchain/babylon/testutil/helper"
 	bbn "github.com/babylonchain/babylon/types"
 	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
 	"github.com/babylonchain/babylon/x/btcstaking/keeper"
+	"github.com/babylonchain/babylon/x/btcstaking/sigverifier"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 	etypes "github.com/babylonchain/babylon/x/epoching/types"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -54,7 +67,7 @@ func FuzzMsgCreateFinalityProvider(f *testing.F) {
 			h.CheckpointingKeeper.EXPECT().GetEpoch(gomock.Eq(h.Ctx)).Return(&etypes.Epoch{EpochNumber: 10}).Times(1)
 
 			msg := &types.MsgCreateFinalityProvider{
-				Signer:        datagen.GenRandomAccount().Address,
+				Signer:        sdk.AccAddress(fp.BabylonPk.Address()).String(),
 				Description:   fp.Description,
 				Commission:    fp.Commission,
 				BabylonPk:     fp.BabylonPk,
@@ -76,7 +89,7 @@ func FuzzMsgCreateFinalityProvider(f *testing.F) {
 		// duplicated finality providers should not pass
 		for _, fp2 := range fps {
 			msg := &types.MsgCreateFinalityProvider{
-				Signer:        datagen.GenRandomAccount().Address,
+				Signer:        sdk.AccAddress(fp2.BabylonPk.Address()).String(),
 				Description:   fp2.Description,
 				Commission:    fp2.Commission,
 				BabylonPk:     fp2.BabylonPk,
@@ -90,6 +103,62 @@ func FuzzMsgCreateFinalityProvider(f *testing.F) {
 	})
 }
 
+func FuzzMsgCreateFinalityProviderViaRelayer(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		h := testhelper.NewHelper(t)
+		bsKeeper := h.App.BTCStakingKeeper
+		msgSrvr := keeper.NewMsgServerImpl(bsKeeper)
+
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		fpAddr := sdk.AccAddress(fp.BabylonPk.Address())
+		relayerAddr := datagen.GenRandomAccount().GetAddress()
+		msg := &types.MsgCreateFinalityProvider{
+			Signer:        relayerAddr.String(),
+			Description:   fp.Description,
+			Commission:    fp.Commission,
+			BabylonPk:     fp.BabylonPk,
+			BtcPk:         fp.BtcPk,
+			Pop:           fp.Pop,
+			MasterPubRand: fp.MasterPubRand,
+		}
+
+		// a relayer without an authz grant of the finality provider cannot
+		// register it
+		_, err = msgSrvr.CreateFinalityProvider(h.Ctx, msg)
+		require.ErrorIs(t, err, types.ErrUnauthorizedFpSigner)
+		require.False(t, bsKeeper.HasFinalityProvider(h.Ctx, *fp.BtcPk))
+
+		// a grant for another message does not authorize the relayer either
+		err = h.App.AuthzKeeper.SaveGrant(h.Ctx, relayerAddr, fpAddr, authz.NewGenericAuthorization(sdk.MsgTypeURL(&types.MsgEditFinalityProvider{})), nil)
+		h.NoError(err)
+		_, err = msgSrvr.CreateFinalityProvider(h.Ctx, msg)
+		require.ErrorIs(t, err, types.ErrUnauthorizedFpSigner)
+
+		// the relayer registers the finality provider once granted
+		err = h.App.AuthzKeeper.SaveGrant(h.Ctx, relayerAddr, fpAddr, authz.NewGenericAuthorization(sdk.MsgTypeURL(msg)), nil)
+		h.NoError(err)
+		_, err = msgSrvr.CreateFinalityProvider(h.Ctx, msg)
+		h.NoError(err)
+		actualFp, err := bsKeeper.GetFinalityProvider(h.Ctx, *fp.BtcPk)
+		h.NoError(err)
+		require.Equal(t, fpAddr, sdk.AccAddress(actualFp.BabylonPk.Address()))
+
+		// a fee allowance of the relayer covers the messages of the module,
+		// but not other messages
+		allowance, err := types.NewFeeAllowance(&feegrant.BasicAllowance{})
+		h.NoError(err)
+		fee := sdk.NewCoins(sdk.NewInt64Coin("ubbn", int64(datagen.RandomInt(r, 1000)+1)))
+		_, err = allowance.Accept(h.Ctx, fee, []sdk.Msg{msg})
+		h.NoError(err)
+		_, err = allowance.Accept(h.Ctx, fee, []sdk.Msg{msg, banktypes.NewMsgSend(fpAddr, relayerAddr, fee)})
+		h.Error(err)
+	})
+}
+
 func FuzzMsgEditFinalityProvider(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 
@@ -99,50 +168,1368 @@ func FuzzMsgEditFinalityProvider(f *testing.F) {
 		bsKeeper := h.App.BTCStakingKeeper
 		msgSrvr := keeper.NewMsgServerImpl(bsKeeper)
 
-		// generate new finality provider
-		fp, err := datagen.GenRandomFinalityProvider(r)
-		fpAddr := sdk.AccAddress(fp.BabylonPk.Address())
-		require.NoError(t, err)
-		// insert the finality provider
-		h.AddFinalityProvider(fp)
-		// assert the finality providers exist in KVStore
-		require.True(t, bsKeeper.HasFinalityProvider(h.Ctx, *fp.BtcPk))
+		// generate new finality provider
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		fpAddr := sdk.AccAddress(fp.BabylonPk.Address())
+		require.NoError(t, err)
+		// insert the finality provider
+		h.AddFinalityProvider(fp)
+		// assert the finality providers exist in KVStore
+		require.True(t, bsKeeper.HasFinalityProvider(h.Ctx, *fp.BtcPk))
+
+		// updated commission and description
+		newCommission := datagen.GenRandomCommission(r)
+		newDescription := datagen.GenRandomDescription(r)
+
+		// scenario 1: editing finality provider should succeed
+		msg := &types.MsgEditFinalityProvider{
+			Signer:      fpAddr.String(),
+			BtcPk:       *fp.BtcPk,
+			Description: newDescription,
+			Commission:  &newCommission,
+		}
+		_, err = msgSrvr.EditFinalityProvider(h.Ctx, msg)
+		h.NoError(err)
+		editedFp, err := bsKeeper.GetFinalityProvider(h.Ctx, *fp.BtcPk)
+		h.NoError(err)
+		require.Equal(t, newCommission, *editedFp.Commission)
+		require.Equal(t, newDescription, editedFp.Description)
+
+		// scenario 2: message from an unauthorised signer should fail
+		newCommission = datagen.GenRandomCommission(r)
+		newDescription = datagen.GenRandomDescription(r)
+		msg = &types.MsgEditFinalityProvider{
+			Signer:      datagen.GenRandomAccount().Address,
+			BtcPk:       *fp.BtcPk,
+			Description: newDescription,
+			Commission:  &newCommission,
+		}
+		_, err = msgSrvr.EditFinalityProvider(h.Ctx, msg)
+		h.Error(err)
+		errStatus := status.Convert(err)
+		require.Equal(t, codes.PermissionDenied, errStatus.Code())
+	})
+}
+
+func FuzzMsgUpdateFinalityProviderStatus(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		h := testhelper.NewHelper(t)
+		bsKeeper := h.App.BTCStakingKeeper
+		msgSrvr := keeper.NewMsgServerImpl(bsKeeper)
+
+		// generate and insert new finality provider
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		fpAddr := sdk.AccAddress(fp.BabylonPk.Address())
+		h.AddFinalityProvider(fp)
+
+		curHeight := datagen.RandomInt(r, 1000) + 1
+		h.Ctx = datagen.WithCtxHeight(h.Ctx, curHeight)
+		startHeight := curHeight + datagen.RandomInt(r, 100)
+		endHeight := startHeight + datagen.RandomInt(r, 100)
+
+		// scenario 1: announcing a planned downtime window should succeed
+		msg := &types.MsgUpdateFinalityProviderStatus{
+			Signer:      fpAddr.String(),
+			FpBtcPk:     fp.BtcPk,
+			Status:      types.FinalityProviderOperationalStatus_PLANNED_DOWNTIME,
+			StartHeight: startHeight,
+			EndHeight:   endHeight,
+			Reason:      "hardware upgrade",
+		}
+		_, err = msgSrvr.UpdateFinalityProviderStatus(h.Ctx, msg)
+		h.NoError(err)
+		resp, err := bsKeeper.FinalityProviderStatus(h.Ctx, &types.QueryFinalityProviderStatusRequest{FpBtcPkHex: fp.BtcPk.MarshalHex()})
+		h.NoError(err)
+		require.Equal(t, msg.StatusReport(curHeight), resp.Report)
+		require.Equal(t, startHeight == curHeight, resp.InWindow)
+
+		// scenario 2: message from an unauthorised signer should fail
+		msg.Signer = datagen.GenRandomAccount().Address
+		_, err = msgSrvr.UpdateFinalityProviderStatus(h.Ctx, msg)
+		h.Error(err)
+		require.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+
+		// scenario 3: a window that has ended should fail
+		if curHeight > 1 {
+			msg = &types.MsgUpdateFinalityProviderStatus{
+				Signer:      fpAddr.String(),
+				FpBtcPk:     fp.BtcPk,
+				Status:      types.FinalityProviderOperationalStatus_KEY_MIGRATION,
+				StartHeight: 1,
+				EndHeight:   curHeight - 1,
+			}
+			_, err = msgSrvr.UpdateFinalityProviderStatus(h.Ctx, msg)
+			require.ErrorIs(t, err, types.ErrInvalidFpStatusReport)
+		}
+
+		// scenario 4: reporting the OPERATIONAL status withdraws the announcement
+		msg = &types.MsgUpdateFinalityProviderStatus{
+			Signer:  fpAddr.String(),
+			FpBtcPk: fp.BtcPk,
+			Status:  types.FinalityProviderOperationalStatus_OPERATIONAL,
+		}
+		_, err = msgSrvr.UpdateFinalityProviderStatus(h.Ctx, msg)
+		h.NoError(err)
+		_, err = bsKeeper.GetFpStatusReport(h.Ctx, fp.BtcPk)
+		require.ErrorIs(t, err, types.ErrFpStatusReportNotFound)
+	})
+}
+
+func FuzzCreateBTCDelegation(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		h.GenAndApplyParams(r)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+
+		// mock that the registered epoch is finalised
+		h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).Times(1)
+
+		// generate and insert new BTC delegation
+		stakingValue := int64(2 * 10e8)
+		stakingTxHash, _, _, msgCreateBTCDel, _ := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+
+		// ensure the BTC delegation exists and a random one does not
+		parsedStakingTxHash, err := chainhash.NewHashFromStr(stakingTxHash)
+		h.NoError(err)
+		require.True(h.t, h.BTCStakingKeeper.HasBTCDelegation(h.Ctx, *parsedStakingTxHash))
+		randTxHash, err := chainhash.NewHash(datagen.GenRandomByteArray(r, chainhash.HashSize))
+		h.NoError(err)
+		require.False(h.t, h.BTCStakingKeeper.HasBTCDelegation(h.Ctx, *randTxHash))
+
+		// ensure consistency between the msg and the BTC delegation in DB
+		actualDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.Equal(h.t, msgCreateBTCDel.BabylonPk, actualDel.BabylonPk)
+		require.Equal(h.t, msgCreateBTCDel.Pop, actualDel.Pop)
+		require.Equal(h.t, msgCreateBTCDel.StakingTx.Transaction, actualDel.StakingTx)
+		require.Equal(h.t, msgCreateBTCDel.SlashingTx, actualDel.SlashingTx)
+		// ensure the BTC delegation in DB is correctly formatted
+		err = actualDel.ValidateBasic()
+		h.NoError(err)
+		// delegation is not activated by covenant yet
+		require.False(h.t, actualDel.HasCovenantQuorums(h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum))
+	})
+}
+
+func FuzzCreateBTCDelegation_MalformedTxs(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		h.GenAndApplyParams(r)
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		minUnbondingTime := types.MinimumUnbondingTime(bsParams, h.BTCCheckpointKeeper.GetParams(h.Ctx))
+
+		// generate and insert new finality provider
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+
+		// mock that the registered epoch is finalised
+		h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).AnyTimes()
+
+		// generate a valid msg without submitting it
+		stakingValue := int64(2 * 10e8)
+		delSK, _, msgCreateBTCDel := h.GenMsgCreateDelegation(
+			r,
+			fpPK,
+			stakingValue,
+			1000,
+			stakingValue-1000,
+			uint16(minUnbondingTime)+1,
+		)
+
+		// each malformation of the slashing tx of either the staking tx or
+		// the unbonding tx is rejected
+		for _, malformation := range datagen.SlashingTxMalformations() {
+			for _, onUnbondingTx := range []bool{false, true} {
+				malformedMsg := datagen.MalformMsgCreateBTCDelegation(r, t, malformation, msgCreateBTCDel, delSK, &bsParams, onUnbondingTx, h.Net)
+				_, err := h.MsgServer.CreateBTCDelegation(h.Ctx, malformedMsg)
+				require.Error(t, err, "malformation %s (on unbonding tx: %t) is not rejected", malformation, onUnbondingTx)
+			}
+		}
+
+		// the valid msg is accepted, i.e., the rejections above are due to
+		// the malformations
+		_, err := h.MsgServer.CreateBTCDelegation(h.Ctx, msgCreateBTCDel)
+		h.NoError(err)
+	})
+}
+
+func TestProperVersionInDelegation(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+	h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+	// set all parameters
+	h.GenAndApplyParams(r)
+
+	changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+	require.NoError(t, err)
+
+	// generate and insert new finality provider
+	_, fpPK, fp := h.CreateFinalityProvider(r)
+
+	// mock that the registered epoch is finalised
+	h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).AnyTimes()
+
+	// generate and insert new BTC delegation
+	stakingValue := int64(2 * 10e8)
+	stakingTxHash, _, _, _, _ := h.CreateDelegation(
+		r,
+		fpPK,
+		changeAddress.EncodeAddress(),
+		stakingValue,
+		1000,
+	)
+
+	// ensure consistency between the msg and the BTC delegation in DB
+	actualDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+	h.NoError(err)
+	err = actualDel.ValidateBasic()
+	h.NoError(err)
+	// Current version will be `1` as:
+	// - version `0` is initialized by `NewHelper`
+	// - version `1` is set by `GenAndApplyParams`
+	require.Equal(t, uint32(1), actualDel.ParamsVersion)
+
+	customMinUnbondingTime := uint32(2000)
+	currentParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+	currentParams.MinUnbondingTime = 2000
+	// Update new params
+	err = h.BTCStakingKeeper.SetParams(h.Ctx, currentParams)
+	require.NoError(t, err)
+	// create new delegation
+	stakingTxHash1, _, _, _, err := h.CreateDelegationCustom(
+		r,
+		fpPK,
+		changeAddress.EncodeAddress(),
+		stakingValue,
+		1000,
+		stakingValue-1000,
+		uint16(customMinUnbondingTime)+1,
+	)
+	require.NoError(t, err)
+	actualDel1, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash1)
+	h.NoError(err)
+	err = actualDel1.ValidateBasic()
+	h.NoError(err)
+	// Assert that the new delegation has the updated params version
+	require.Equal(t, uint32(2), actualDel1.ParamsVersion)
+}
+
+func TestSlashingRateChangeReport(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+	h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+	// set all parameters
+	covenantSKs, _ := h.GenAndApplyParams(r)
+	oldParams := h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx)
+
+	changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+	require.NoError(t, err)
+
+	// generate and insert new finality provider
+	_, fpPK, fp := h.CreateFinalityProvider(r)
+
+	// mock that the registered epoch is finalised
+	h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).AnyTimes()
+
+	// generate and insert an active BTC delegation and a pending BTC delegation
+	stakingValue := int64(2 * 10e8)
+	_, _, _, msgCreateBTCDel, activeDel := h.CreateDelegation(
+		r,
+		fpPK,
+		changeAddress.EncodeAddress(),
+		stakingValue,
+		1000,
+	)
+	for _, msg := range h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, activeDel) {
+		_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msg)
+		h.NoError(err)
+	}
+	h.CreateDelegation(
+		r,
+		fpPK,
+		changeAddress.EncodeAddress(),
+		stakingValue,
+		1000,
+	)
+
+	// updating params without changing the slashing rate does not produce
+	// any report
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	newParams := oldParams.Params
+	newParams.MinUnbondingTime++
+	_, err = h.MsgServer.UpdateParams(h.Ctx, &types.MsgUpdateParams{Authority: authority, Params: newParams})
+	h.NoError(err)
+	_, err = h.BTCStakingKeeper.GetSlashingRateChangeReport(h.Ctx, oldParams.Version+1)
+	require.ErrorIs(t, err, types.ErrSlashingRateReportNotFound)
+
+	// changing the slashing rate produces a report, where the active BTC
+	// delegation keeps its slashing rate
+	newParams.SlashingRate = newParams.SlashingRate.Add(sdkmath.LegacyNewDecWithPrec(5, 2))
+	_, err = h.MsgServer.UpdateParams(h.Ctx, &types.MsgUpdateParams{Authority: authority, Params: newParams})
+	h.NoError(err)
+
+	resp, err := h.BTCStakingKeeper.SlashingRateChangeReport(h.Ctx, &types.QuerySlashingRateChangeReportRequest{
+		ParamsVersion: oldParams.Version + 2,
+	})
+	h.NoError(err)
+	report := resp.Report
+	require.True(t, oldParams.Params.SlashingRate.Equal(report.OldSlashingRate))
+	require.True(t, newParams.SlashingRate.Equal(report.NewSlashingRate))
+	require.Len(t, report.Cohorts, 1)
+	require.Equal(t, oldParams.Version, report.Cohorts[0].ParamsVersion)
+	require.True(t, oldParams.Params.SlashingRate.Equal(report.Cohorts[0].SlashingRate))
+	require.Equal(t, uint64(1), report.Cohorts[0].NumActiveDelegations)
+	require.Equal(t, uint64(stakingValue), report.Cohorts[0].TotalSat)
+
+	reportsResp, err := h.BTCStakingKeeper.SlashingRateChangeReports(h.Ctx, &types.QuerySlashingRateChangeReportsRequest{})
+	h.NoError(err)
+	require.Len(t, reportsResp.Reports, 1)
+}
+
+func TestSlashingParamsRecordedInDelegation(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+	h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+	// set all parameters
+	covenantSKs, _ := h.GenAndApplyParams(r)
+	oldParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+
+	changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+	require.NoError(t, err)
+
+	// generate and insert new finality provider
+	_, fpPK, fp := h.CreateFinalityProvider(r)
+
+	// mock that the registered epoch is finalised
+	h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).AnyTimes()
+
+	// generate and insert a pending BTC delegation, which records the slashing
+	// rate and the slashing address of the current params
+	stakingValue := int64(2 * 10e8)
+	stakingTxHash, _, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
+		r,
+		fpPK,
+		changeAddress.EncodeAddress(),
+		stakingValue,
+		1000,
+	)
+	require.True(t, oldParams.SlashingRate.Equal(actualDel.SlashingRate))
+	require.Equal(t, oldParams.SlashingAddress, actualDel.SlashingAddress)
+
+	// change the slashing rate and the slashing address
+	newSlashingAddress, err := datagen.GenRandomBTCAddressOfRandomType(r, h.Net)
+	require.NoError(t, err)
+	newParams := oldParams
+	newParams.SlashingRate = newParams.SlashingRate.Add(sdkmath.LegacyNewDecWithPrec(5, 2))
+	newParams.SlashingAddress = newSlashingAddress.EncodeAddress()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// the slashing address has to be of an allowed address type
+	newSlashingAddrType, err := btcstaking.GetAddressType(newSlashingAddress)
+	require.NoError(t, err)
+	disallowedParams := newParams
+	disallowedParams.AllowedSlashingAddressTypes = []string{string(btcstaking.AddressTypeP2TR)}
+	if newSlashingAddrType == btcstaking.AddressTypeP2TR {
+		disallowedParams.AllowedSlashingAddressTypes = []string{string(btcstaking.AddressTypeP2WPKH)}
+	}
+	_, err = h.MsgServer.UpdateParams(h.Ctx, &types.MsgUpdateParams{Authority: authority, Params: disallowedParams})
+	require.Error(t, err)
+
+	newParams.AllowedSlashingAddressTypes = []string{string(newSlashingAddrType)}
+	_, err = h.MsgServer.UpdateParams(h.Ctx, &types.MsgUpdateParams{Authority: authority, Params: newParams})
+	h.NoError(err)
+
+	// covenant signatures are still validated against the slashing rate and
+	// the slashing address recorded on the BTC delegation
+	for _, msg := range h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel) {
+		_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msg)
+		h.NoError(err)
+	}
+	actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+	h.NoError(err)
+	require.True(t, actualDel.HasCovenantQuorums(oldParams.CovenantQuorum))
+	require.True(t, oldParams.SlashingRate.Equal(actualDel.SlashingRate))
+	require.Equal(t, oldParams.SlashingAddress, actualDel.SlashingAddress)
+
+	// the recorded values override the given params, while BTC delegations
+	// created before these were recorded use the given params as is
+	effectiveParams := actualDel.ParamsWithRecordedSlashing(&newParams)
+	require.True(t, oldParams.SlashingRate.Equal(effectiveParams.SlashingRate))
+	require.Equal(t, oldParams.SlashingAddress, effectiveParams.SlashingAddress)
+	h.NoError(actualDel.CheckSlashingTxs(effectiveParams, h.Net))
+	h.Error(actualDel.CheckSlashingTxs(&newParams, h.Net))
+
+	legacyDel := *actualDel
+	legacyDel.SlashingRate = sdkmath.LegacyDec{}
+	legacyDel.SlashingAddress = ""
+	effectiveParams = legacyDel.ParamsWithRecordedSlashing(&newParams)
+	require.True(t, newParams.SlashingRate.Equal(effectiveParams.SlashingRate))
+	require.Equal(t, newParams.SlashingAddress, effectiveParams.SlashingAddress)
+}
+
+func TestScheduledParams(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+	h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+	curEpoch := uint64(5)
+	ckptKeeper.EXPECT().GetEpoch(gomock.Any()).DoAndReturn(func(_ context.Context) *etypes.Epoch {
+		return &etypes.Epoch{EpochNumber: curEpoch}
+	}).AnyTimes()
+
+	// set all parameters
+	h.GenAndApplyParams(r)
+	oldParams := h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// params cannot be scheduled in the past, or at both a height and an epoch
+	newParams := oldParams.Params
+	newParams.MinUnbondingTime++
+	_, err := h.MsgServer.UpdateParams(h.Ctx, &types.MsgUpdateParams{Authority: authority, Params: newParams, ActivationHeight: 1})
+	require.Error(t, err)
+	_, err = h.MsgServer.UpdateParams(h.Ctx, &types.MsgUpdateParams{Authority: authority, Params: newParams, ActivationEpoch: curEpoch})
+	require.Error(t, err)
+	_, err = h.MsgServer.UpdateParams(h.Ctx, &types.MsgUpdateParams{Authority: authority, Params: newParams, ActivationHeight: 10, ActivationEpoch: curEpoch + 1})
+	require.Error(t, err)
+
+	// params scheduled at a height take effect at the height
+	_, err = h.MsgServer.UpdateParams(h.Ctx, &types.MsgUpdateParams{Authority: authority, Params: newParams, ActivationHeight: 10})
+	h.NoError(err)
+	resp, err := h.BTCStakingKeeper.ScheduledParams(h.Ctx, &types.QueryScheduledParamsRequest{})
+	h.NoError(err)
+	require.Equal(t, uint64(10), resp.ScheduledParams.ActivationHeight)
+	require.Equal(t, newParams, resp.ScheduledParams.Params)
+
+	h.SetCtxHeight(9)
+	err = h.BTCStakingKeeper.ActivateScheduledParams(h.Ctx)
+	h.NoError(err)
+	require.Equal(t, oldParams, h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx))
+
+	h.SetCtxHeight(10)
+	err = h.BTCStakingKeeper.ActivateScheduledParams(h.Ctx)
+	h.NoError(err)
+	require.Equal(t, oldParams.Version+1, h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx).Version)
+	require.Equal(t, newParams, h.BTCStakingKeeper.GetParams(h.Ctx))
+	require.Nil(t, h.BTCStakingKeeper.GetScheduledParams(h.Ctx))
+
+	// params scheduled at an epoch take effect at the epoch, and replace the
+	// params scheduled before
+	newParams.MinUnbondingTime++
+	_, err = h.MsgServer.UpdateParams(h.Ctx, &types.MsgUpdateParams{Authority: authority, Params: newParams, ActivationHeight: 100})
+	h.NoError(err)
+	newParams.MinUnbondingTime++
+	_, err = h.MsgServer.UpdateParams(h.Ctx, &types.MsgUpdateParams{Authority: authority, Params: newParams, ActivationEpoch: curEpoch + 1})
+	h.NoError(err)
+	require.Equal(t, curEpoch+1, h.BTCStakingKeeper.GetScheduledParams(h.Ctx).ActivationEpoch)
+
+	err = h.BTCStakingKeeper.ActivateScheduledParams(h.Ctx)
+	h.NoError(err)
+	require.Equal(t, oldParams.Version+1, h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx).Version)
+
+	curEpoch++
+	err = h.BTCStakingKeeper.ActivateScheduledParams(h.Ctx)
+	h.NoError(err)
+	require.Equal(t, oldParams.Version+2, h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx).Version)
+	require.Equal(t, newParams, h.BTCStakingKeeper.GetParams(h.Ctx))
+	require.Nil(t, h.BTCStakingKeeper.GetScheduledParams(h.Ctx))
+}
+
+func TestUpdateParams(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+	h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+	// set all parameters
+	covenantSKs, _ := h.GenAndApplyParams(r)
+	oldParams := h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx)
+
+	changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+	require.NoError(t, err)
+
+	// generate and insert new finality provider
+	_, fpPK, fp := h.CreateFinalityProvider(r)
+	// mock that the registered epoch is finalised
+	h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).AnyTimes()
+
+	// generate and insert an active BTC delegation
+	stakingTxHash, _, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
+		r,
+		fpPK,
+		changeAddress.EncodeAddress(),
+		int64(2*10e8),
+		1000,
+	)
+	for _, msg := range h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel) {
+		_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msg)
+		h.NoError(err)
+	}
+	actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+	h.NoError(err)
+	require.Equal(t, types.BTCDelegationStatus_ACTIVE, h.BTCStakingKeeper.GetBTCDelegationStatus(h.Ctx, actualDel))
+
+	// replace the covenant committee with a bigger one with a higher quorum
+	_, newCovenantPKs, err := datagen.GenRandomBTCKeyPairs(r, 7)
+	h.NoError(err)
+	newParams := oldParams.Params
+	newParams.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(newCovenantPKs)
+	newParams.CovenantQuorum = 5
+
+	// only the governance account can update the params
+	_, err = h.MsgServer.UpdateParams(h.Ctx, &types.MsgUpdateParams{Authority: datagen.GenRandomAccount().Address, Params: newParams})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	require.Equal(t, oldParams, h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx))
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	_, err = h.MsgServer.UpdateParams(h.Ctx, &types.MsgUpdateParams{Authority: authority, Params: newParams})
+	h.NoError(err)
+	require.Equal(t, oldParams.Version+1, h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx).Version)
+
+	// the update is announced by an event
+	var updateEvent *types.EventParamsUpdated
+	for _, event := range h.Ctx.EventManager().Events() {
+		if event.Type != "babylon.btcstaking.v1.EventParamsUpdated" {
+			continue
+		}
+		ev, err := sdk.ParseTypedEvent(abci.Event(event))
+		h.NoError(err)
+		updateEvent = ev.(*types.EventParamsUpdated)
+	}
+	require.NotNil(t, updateEvent)
+	// the JSON encoding of the event does not tell an empty list from a nil
+	// one, while the params decoded from the store carry nil lists
+	if len(updateEvent.Params.AllowedSlashingAddressTypes) == 0 {
+		updateEvent.Params.AllowedSlashingAddressTypes = nil
+	}
+	require.Equal(t, oldParams.Version+1, updateEvent.Version)
+	require.Equal(t, newParams, updateEvent.Params)
+
+	// the existing BTC delegation is still active under the covenant
+	// committee of its params version
+	require.Equal(t, types.BTCDelegationStatus_ACTIVE, h.BTCStakingKeeper.GetBTCDelegationStatus(h.Ctx, actualDel))
+	resp, err := h.BTCStakingKeeper.BTCDelegations(h.Ctx, &types.QueryBTCDelegationsRequest{Status: types.BTCDelegationStatus_ACTIVE})
+	h.NoError(err)
+	require.Len(t, resp.BtcDelegations, 1)
+	require.Equal(t, actualDel.BtcPk, resp.BtcDelegations[0].BtcPk)
+}
+
+func FuzzAddCovenantSigs(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// mock hooks expecting the BTC delegation to be activated once
+		hooks := types.NewMockBtcStakingHooks(ctrl)
+		h.BTCStakingKeeper.SetHooks(hooks)
+		h.MsgServer = keeper.NewMsgServerImpl(*h.BTCStakingKeeper)
+		var activatedDel *types.BTCDelegation
+		hooks.EXPECT().AfterBTCDelegationActivated(gomock.Any(), gomock.Any()).Do(
+			func(_ context.Context, btcDel *types.BTCDelegation) {
+				activatedDel = btcDel
+			},
+		).Times(1)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+
+		// mock that the registered epoch is finalised
+		h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).Times(1)
+
+		// generate and insert new BTC delegation
+		stakingValue := int64(2 * 10e8)
+		stakingTxHash, _, _, msgCreateBTCDel, _ := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+
+		// ensure consistency between the msg and the BTC delegation in DB
+		actualDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		// delegation is not activated by covenant yet
+		require.False(h.t, actualDel.HasCovenantQuorums(h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum))
+
+		msgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+
+		// ensure the system does not panick due to a bogus covenant sig request
+		bogusMsg := *msgs[0]
+		bogusMsg.StakingTxHash = datagen.GenRandomBtcdHash(r).String()
+		_, err = h.MsgServer.AddCovenantSigs(h.Ctx, &bogusMsg)
+		h.Error(err)
+
+		for i, msg := range msgs {
+			gasBefore := h.Ctx.GasMeter().GasConsumed()
+			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msg)
+			h.NoError(err)
+			if i == 0 {
+				// the verification of the first covenant member's Schnorr sig
+				// and adaptor sigs is charged
+				sigGas := types.GasSchnorrSigVerification + uint64(len(msg.SlashingTxSigs)+len(msg.SlashingUnbondingTxSigs))*types.GasAdaptorSigVerification
+				require.GreaterOrEqual(t, h.Ctx.GasMeter().GasConsumed()-gasBefore, sigGas)
+			}
+			// check that submitting the same covenant signature does not produce an error
+			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msg)
+			h.NoError(err)
+		}
+
+		// ensure the BTC delegation now has voting power
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.True(h.t, actualDel.HasCovenantQuorums(h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum))
+		require.True(h.t, actualDel.BtcUndelegation.HasCovenantQuorums(h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum))
+		votingPower := actualDel.VotingPower(h.BTCLightClientKeeper.GetTipInfo(h.Ctx).Height, h.BTCCheckpointKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout, h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum)
+		require.Equal(t, uint64(stakingValue), votingPower)
+
+		// ensure the hooks are notified about the activation in the same
+		// block, along with an EventBTCDelegationActivated
+		require.NotNil(t, activatedDel)
+		require.Equal(t, stakingTxHash, activatedDel.MustGetStakingTxHash().String())
+		activatedEvents := 0
+		for _, event := range h.Ctx.EventManager().Events() {
+			if event.Type == proto.MessageName(&types.EventBTCDelegationActivated{}) {
+				activatedEvents++
+			}
+		}
+		require.Equal(t, 1, activatedEvents)
+	})
+}
+
+// acceptAllSigVerifier is a verifier accepting any signature, e.g., a buggy
+// or impersonating out-of-process worker
+type acceptAllSigVerifier struct{}
+
+func (acceptAllSigVerifier) VerifyBatch(reqs []*sigverifier.Request) []error {
+	return make([]error, len(reqs))
+}
+
+func TestAddCovenantSigsVerifiesInProcessWhenExecutingBlocks(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+	h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+	h.BTCStakingKeeper.SetSigVerifier(acceptAllSigVerifier{})
+	h.MsgServer = keeper.NewMsgServerImpl(*h.BTCStakingKeeper)
+
+	// set all parameters
+	covenantSKs, _ := h.GenAndApplyParams(r)
+
+	changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+	require.NoError(t, err)
+
+	// generate and insert new finality provider
+	_, fpPK, fp := h.CreateFinalityProvider(r)
+
+	// mock that the registered epoch is finalised
+	h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).AnyTimes()
+
+	// generate and insert new BTC delegation
+	stakingTxHash, _, _, msgCreateBTCDel, _ := h.CreateDelegation(
+		r,
+		fpPK,
+		changeAddress.EncodeAddress(),
+		int64(2*10e8),
+		1000,
+	)
+	actualDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+	h.NoError(err)
+
+	// the first covenant member submits the unbonding sig of another member
+	msgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+	invalidMsg := *msgs[0]
+	invalidMsg.UnbondingTxSig = msgs[1].UnbondingTxSig
+
+	// the invalid sig is rejected when executing blocks, regardless of the
+	// configured verifier
+	_, err = h.MsgServer.AddCovenantSigs(h.Ctx, &invalidMsg)
+	require.ErrorIs(t, err, types.ErrInvalidCovenantSig)
+
+	// the configured verifier is only used in CheckTx
+	checkTxCtx, _ := h.Ctx.WithIsCheckTx(true).CacheContext()
+	h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(checkTxCtx)).Return(&btclctypes.BTCHeaderInfo{Height: 30}).AnyTimes()
+	_, err = h.MsgServer.AddCovenantSigs(checkTxCtx, &invalidMsg)
+	require.NoError(t, err)
+}
+
+func FuzzAddCovenantSigsMuSig2(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters, with the covenant committee signing via MuSig2
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		bsParams.ScriptTemplateVersion = btcstaking.ScriptTemplateV2
+		bsParams.CovenantQuorum = uint32(len(covenantSKs))
+		err := h.BTCStakingKeeper.SetParams(h.Ctx, bsParams)
+		h.NoError(err)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		fpSK, fpPK, fp := h.CreateFinalityProvider(r)
+		h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).AnyTimes()
+
+		// generate and insert new BTC delegation under the MuSig2 script
+		// template
+		stakingValue := int64(2 * 10e8)
+		stakingTxHash, _, _, msgCreateBTCDel, btcDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		require.Equal(t, btcstaking.ScriptTemplateV2, btcDel.ScriptTemplateVersion)
+
+		// covenant members cannot sign individually
+		_, err = types.NewMsgAddCovenantSigs(msgCreateBTCDel.Signer, btcDel, &bsParams, covenantSKs[0], h.Net)
+		require.Error(t, err)
+
+		// each covenant member submits its MuSig2 nonces, and keeps the
+		// secret nonces
+		secNonces := make([]*types.CovenantMuSig2SecNonces, len(covenantSKs))
+		for i, covenantSK := range covenantSKs {
+			msg, sn, err := types.NewMsgAddCovenantMuSig2Nonces(msgCreateBTCDel.Signer, btcDel, &bsParams, covenantSK, h.Net)
+			h.NoError(err)
+			secNonces[i] = sn
+			_, err = h.MsgServer.AddCovenantMuSig2Nonces(h.Ctx, msg)
+			h.NoError(err)
+			// nonces cannot be replaced
+			_, err = h.MsgServer.AddCovenantMuSig2Nonces(h.Ctx, msg)
+			require.ErrorIs(t, err, types.ErrDuplicatedMuSig2Nonces)
+		}
+
+		// each covenant member produces its partial signatures off-chain with
+		// the nonces of all covenant members on-chain
+		stkTxHash, err := chainhash.NewHashFromStr(stakingTxHash)
+		h.NoError(err)
+		noncesList := h.BTCStakingKeeper.GetCovenantMuSig2Nonces(h.Ctx, *stkTxHash)
+		require.Len(t, noncesList, len(covenantSKs))
+		partialSigsList := make([]*types.CovenantMuSig2PartialSigs, len(covenantSKs))
+		for i, covenantSK := range covenantSKs {
+			partialSigsList[i], err = types.NewCovenantMuSig2PartialSigs(btcDel, &bsParams, covenantSK, secNonces[i], noncesList, h.Net)
+			h.NoError(err)
+		}
+		// the secret nonces of a covenant member do not sign for another one
+		_, err = types.NewCovenantMuSig2PartialSigs(btcDel, &bsParams, covenantSKs[0], secNonces[1], noncesList, h.Net)
+		require.Error(t, err)
+
+		// the partial signatures of all covenant members are required
+		_, err = types.NewMsgAddCovenantSigsFromMuSig2(msgCreateBTCDel.Signer, btcDel, &bsParams, noncesList, partialSigsList[1:], h.Net)
+		require.Error(t, err)
+		// a tampered partial signature is rejected
+		tampered := *partialSigsList[0]
+		tampered.UnbondingTxPartialSig = partialSigsList[1].UnbondingTxPartialSig
+		tamperedList := append([]*types.CovenantMuSig2PartialSigs{&tampered}, partialSigsList[1:]...)
+		_, err = types.NewMsgAddCovenantSigsFromMuSig2(msgCreateBTCDel.Signer, btcDel, &bsParams, noncesList, tamperedList, h.Net)
+		require.Error(t, err)
+
+		// the aggregator combines the partial signatures into the signatures
+		// under the aggregate covenant PK, which activate the BTC delegation
+		msg, err := types.NewMsgAddCovenantSigsFromMuSig2(msgCreateBTCDel.Signer, btcDel, &bsParams, noncesList, partialSigsList, h.Net)
+		h.NoError(err)
+		aggPK, err := bsParams.AggregateCovenantPK()
+		h.NoError(err)
+		require.True(t, msg.Pk.Equals(aggPK))
+		_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msg)
+		h.NoError(err)
+
+		actualDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.Len(t, actualDel.CovenantSigs, 1)
+		votingPower := actualDel.VotingPower(h.BTCLightClientKeeper.GetTipInfo(h.Ctx).Height, h.BTCCheckpointKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout, bsParams.CovenantQuorum)
+		require.Equal(t, uint64(stakingValue), votingPower)
+		// the nonces are no longer needed
+		require.Empty(t, h.BTCStakingKeeper.GetCovenantMuSig2Nonces(h.Ctx, *stkTxHash))
+
+		// the finality provider's SK decrypts the aggregate adaptor signatures
+		// into slashing txs spendable on Bitcoin
+		stakingInfo, err := actualDel.GetStakingInfo(&bsParams, h.Net)
+		h.NoError(err)
+		slashingTx, err := actualDel.BuildSlashingTxWithWitness(&bsParams, h.Net, fpSK)
+		h.NoError(err)
+		btctest.AssertSlashingTxExecution(t, stakingInfo.StakingOutput, slashingTx)
+		unbondingInfo, err := actualDel.GetUnbondingInfo(&bsParams, h.Net)
+		h.NoError(err)
+		unbondingSlashingTx, err := actualDel.BuildUnbondingSlashingTxWithWitness(&bsParams, h.Net, fpSK)
+		h.NoError(err)
+		btctest.AssertSlashingTxExecution(t, unbondingInfo.UnbondingOutput, unbondingSlashingTx)
+	})
+}
+
+func FuzzBTCUndelegate(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		wValue := h.BTCCheckpointKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+
+		// mock that the registered epoch is finalised
+		h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).Times(1)
+
+		// generate and insert new BTC delegation
+		stakingValue := int64(2 * 10e8)
+		stakingTxHash, delSK, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+
+		// add covenant signatures to this BTC delegation
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+
+		// ensure the BTC delegation is bonded right now
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		btcTip := h.BTCLightClientKeeper.GetTipInfo(h.Ctx).Height
+		status := actualDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum)
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, status)
+
+		// construct unbonding msg
+		delUnbondingSig, err := actualDel.SignUnbondingTx(&bsParams, h.Net, delSK)
+		h.NoError(err)
+		stakerAddr := actualDel.StakerAddress().String()
+		msg := &types.MsgBTCUndelegate{
+			Signer:         stakerAddr,
+			StakingTxHash:  stakingTxHash,
+			UnbondingTxSig: bbn.NewBIP340SignatureFromBTCSig(delUnbondingSig),
+		}
+
+		// ensure the system does not panick due to a bogus unbonding msg
+		bogusMsg := *msg
+		bogusMsg.StakingTxHash = datagen.GenRandomBtcdHash(r).String()
+		_, err = h.MsgServer.BTCUndelegate(h.Ctx, &bogusMsg)
+		h.Error(err)
+
+		// a third party cannot unbond the BTC delegation
+		operatorAddr := datagen.GenRandomAccount().Address
+		thirdPartyMsg := *msg
+		thirdPartyMsg.Signer = operatorAddr
+		_, err = h.MsgServer.BTCUndelegate(h.Ctx, &thirdPartyMsg)
+		require.ErrorIs(t, err, types.ErrUnauthorizedSigner)
+
+		// only the staker can authorize an operator
+		setOperatorMsg := &types.MsgSetDelegationOperator{
+			Signer:        operatorAddr,
+			StakingTxHash: stakingTxHash,
+			Operator:      datagen.GenRandomAccount().Address,
+		}
+		_, err = h.MsgServer.SetDelegationOperator(h.Ctx, setOperatorMsg)
+		require.ErrorIs(t, err, types.ErrUnauthorizedSigner)
+
+		// randomly unbond via the staker or an operator authorized by the staker
+		if datagen.OneInN(r, 2) {
+			setOperatorMsg.Signer = stakerAddr
+			setOperatorMsg.Operator = operatorAddr
+			_, err = h.MsgServer.SetDelegationOperator(h.Ctx, setOperatorMsg)
+			h.NoError(err)
+			stakingTxHashObj, err := chainhash.NewHashFromStr(stakingTxHash)
+			h.NoError(err)
+			require.Equal(t, operatorAddr, h.BTCStakingKeeper.GetDelegationOperator(h.Ctx, *stakingTxHashObj).String())
+			msg = &thirdPartyMsg
+		}
+
+		// malformed signatures on the unbonding tx are rejected
+		for _, malformation := range datagen.UnbondingTxSigMalformations() {
+			malformedMsg := *msg
+			malformedMsg.UnbondingTxSig = datagen.GenMalformedUnbondingTxSig(t, malformation, actualDel, delSK, &bsParams, h.Net)
+			_, err = h.MsgServer.BTCUndelegate(h.Ctx, &malformedMsg)
+			require.ErrorIs(t, err, types.ErrInvalidCovenantSig, "malformation %s is not rejected", malformation)
+		}
+
+		// unbond
+		_, err = h.MsgServer.BTCUndelegate(h.Ctx, msg)
+		h.NoError(err)
+
+		// ensure the BTC delegation is unbonded
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		status = actualDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum)
+		require.Equal(t, types.BTCDelegationStatus_UNBONDED, status)
+	})
+}
+
+func FuzzSetPause(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+
+		// mock that the registered epoch is finalised
+		h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).AnyTimes()
+
+		// generate and insert new BTC delegation, and add covenant signatures
+		// to it
+		stakingTxHash, delSK, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(2*10e8),
+			1000,
+		)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		delUnbondingSig, err := actualDel.SignUnbondingTx(&bsParams, h.Net, delSK)
+		h.NoError(err)
+		undelegateMsg := &types.MsgBTCUndelegate{
+			Signer:         actualDel.StakerAddress().String(),
+			StakingTxHash:  stakingTxHash,
+			UnbondingTxSig: bbn.NewBIP340SignatureFromBTCSig(delUnbondingSig),
+		}
+
+		// the pause state is not paused if never set
+		resp, err := h.BTCStakingKeeper.PauseState(h.Ctx, &types.QueryPauseStateRequest{})
+		h.NoError(err)
+		require.False(t, resp.PauseState.Paused)
+
+		// only the governance account can pause if there is no pause authority
+		pauseAuthority := datagen.GenRandomAccount().Address
+		pauseMsg := &types.MsgSetPause{Signer: pauseAuthority, Paused: true, Reason: "incident"}
+		_, err = h.MsgServer.SetPause(h.Ctx, pauseMsg)
+		require.ErrorIs(t, err, types.ErrUnauthorizedPauseSigner)
+
+		// randomly pause via the governance account or the pause authority
+		if datagen.OneInN(r, 2) {
+			pauseMsg.Signer = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+		} else {
+			bsParams.PauseAuthority = pauseAuthority
+			err = h.BTCStakingKeeper.SetParams(h.Ctx, bsParams)
+			h.NoError(err)
+			// a third party still cannot pause
+			thirdPartyMsg := *pauseMsg
+			thirdPartyMsg.Signer = datagen.GenRandomAccount().Address
+			_, err = h.MsgServer.SetPause(h.Ctx, &thirdPartyMsg)
+			require.ErrorIs(t, err, types.ErrUnauthorizedPauseSigner)
+		}
+		_, err = h.MsgServer.SetPause(h.Ctx, pauseMsg)
+		h.NoError(err)
+		resp, err = h.BTCStakingKeeper.PauseState(h.Ctx, &types.QueryPauseStateRequest{})
+		h.NoError(err)
+		require.True(t, resp.PauseState.Paused)
+		require.Equal(t, pauseMsg.Signer, resp.PauseState.UpdatedBy)
+		require.Equal(t, pauseMsg.Reason, resp.PauseState.Reason)
+
+		// new BTC delegations and early unbondings are rejected while paused
+		_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, msgCreateBTCDel)
+		require.ErrorIs(t, err, types.ErrMsgProcessingPaused)
+		_, err = h.MsgServer.BTCUndelegate(h.Ctx, undelegateMsg)
+		require.ErrorIs(t, err, types.ErrMsgProcessingPaused)
+
+		// early unbondings are processed once resumed
+		resumeMsg := &types.MsgSetPause{Signer: pauseMsg.Signer, Paused: false}
+		_, err = h.MsgServer.SetPause(h.Ctx, resumeMsg)
+		h.NoError(err)
+		require.False(t, h.BTCStakingKeeper.IsPaused(h.Ctx))
+		_, err = h.MsgServer.BTCUndelegate(h.Ctx, undelegateMsg)
+		h.NoError(err)
+	})
+}
+
+func FuzzBTCDelegationMemo(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		h.GenAndApplyParams(r)
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		bcParams := h.BTCCheckpointKeeper.GetParams(h.Ctx)
+		minUnbondingTime := types.MinimumUnbondingTime(bsParams, bcParams)
+
+		// generate and insert new finality provider
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+
+		// mock that the registered epoch is finalised
+		h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).AnyTimes()
+
+		// the event manager is replaced before generating the BTC delegation,
+		// as mocked keepers expect the context as is
+		h.Ctx = h.Ctx.WithEventManager(sdk.NewEventManager())
+		stakingValue := int64(2 * 10e8)
+		_, _, msgCreateBTCDel := h.GenMsgCreateDelegation(
+			r,
+			fpPK,
+			stakingValue,
+			1000,
+			stakingValue-1000,
+			uint16(minUnbondingTime)+1,
+		)
+
+		// a memo longer than the limit is rejected
+		msgCreateBTCDel.Memo = datagen.GenRandomHexStr(r, types.MaxBTCDelegationMemoLength/2+1)
+		require.Error(t, msgCreateBTCDel.ValidateBasic())
+
+		memo := datagen.GenRandomHexStr(r, datagen.RandomInt(r, types.MaxBTCDelegationMemoLength/2)+1)
+		msgCreateBTCDel.Memo = memo
+		require.NoError(t, msgCreateBTCDel.ValidateBasic())
+		_, err := h.MsgServer.CreateBTCDelegation(h.Ctx, msgCreateBTCDel)
+		h.NoError(err)
+
+		// the memo is stored on the BTC delegation and returned by queries
+		stakingMsgTx, err := bbn.NewBTCTxFromBytes(msgCreateBTCDel.StakingTx.Transaction)
+		h.NoError(err)
+		stakingTxHash := stakingMsgTx.TxHash().String()
+		actualDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.Equal(t, memo, actualDel.Memo)
+		resp, err := h.BTCStakingKeeper.BTCDelegation(h.Ctx, &types.QueryBTCDelegationRequest{StakingTxHashHex: stakingTxHash})
+		h.NoError(err)
+		require.Equal(t, memo, resp.BtcDelegation.Memo)
+
+		// the event of the creation of the BTC delegation carries the memo
+		var creationEvent *types.EventBTCDelegationStateUpdate
+		for _, event := range h.Ctx.EventManager().Events() {
+			if event.Type != "babylon.btcstaking.v1.EventBTCDelegationStateUpdate" {
+				continue
+			}
+			ev, err := sdk.ParseTypedEvent(abci.Event(event))
+			h.NoError(err)
+			creationEvent = ev.(*types.EventBTCDelegationStateUpdate)
+		}
+		require.NotNil(t, creationEvent)
+		require.Equal(t, stakingTxHash, creationEvent.StakingTxHash)
+		require.Equal(t, types.BTCDelegationStatus_PENDING, creationEvent.NewState)
+		require.Equal(t, memo, creationEvent.Memo)
+	})
+}
+
+func FuzzBTCDelegationRewardAddress(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		h.GenAndApplyParams(r)
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		bcParams := h.BTCCheckpointKeeper.GetParams(h.Ctx)
+		minUnbondingTime := types.MinimumUnbondingTime(bsParams, bcParams)
+
+		// generate and insert new finality provider
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+
+		// mock that the registered epoch is finalised
+		h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).AnyTimes()
+
+		stakingValue := int64(2 * 10e8)
+		_, _, msgCreateBTCDel := h.GenMsgCreateDelegation(
+			r,
+			fpPK,
+			stakingValue,
+			1000,
+			stakingValue-1000,
+			uint16(minUnbondingTime)+1,
+		)
+
+		// an invalid reward address is rejected
+		msgCreateBTCDel.RewardAddress = datagen.GenRandomHexStr(r, 20)
+		require.Error(t, msgCreateBTCDel.ValidateBasic())
+
+		// a BTC withdrawal address of another BTC network is rejected
+		rewardAddr := datagen.GenRandomAccount().GetAddress()
+		msgCreateBTCDel.RewardAddress = rewardAddr.String()
+		mainnetAddr, err := datagen.GenRandomBTCAddress(r, &chaincfg.MainNetParams)
+		h.NoError(err)
+		msgCreateBTCDel.WithdrawalBtcAddress = mainnetAddr.EncodeAddress()
+		_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, msgCreateBTCDel)
+		require.ErrorIs(t, err, types.ErrInvalidWithdrawalAddress)
+
+		withdrawalAddr, err := datagen.GenRandomBTCAddress(r, h.Net)
+		h.NoError(err)
+		msgCreateBTCDel.WithdrawalBtcAddress = withdrawalAddr.EncodeAddress()
+		_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, msgCreateBTCDel)
+		h.NoError(err)
+
+		// both addresses are stored on the BTC delegation and returned by
+		// queries
+		stakingMsgTx, err := bbn.NewBTCTxFromBytes(msgCreateBTCDel.StakingTx.Transaction)
+		h.NoError(err)
+		stakingTxHash := stakingMsgTx.TxHash().String()
+		actualDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.Equal(t, rewardAddr.String(), actualDel.RewardAddress)
+		require.Equal(t, withdrawalAddr.EncodeAddress(), actualDel.WithdrawalBtcAddress)
+		resp, err := h.BTCStakingKeeper.BTCDelegation(h.Ctx, &types.QueryBTCDelegationRequest{StakingTxHashHex: stakingTxHash})
+		h.NoError(err)
+		require.Equal(t, rewardAddr.String(), resp.BtcDelegation.RewardAddress)
+		require.Equal(t, withdrawalAddr.EncodeAddress(), resp.BtcDelegation.WithdrawalBtcAddress)
+
+		// the rewards of the BTC delegation go to the reward address rather
+		// than to the address of its Babylon PK
+		distInfo := types.NewBTCDelDistInfo(stakingTxHash, actualDel)
+		require.Equal(t, rewardAddr, distInfo.GetAddress())
+		distInfo.RewardAddress = ""
+		require.Equal(t, sdk.AccAddress(actualDel.BabylonPk.Address()), distInfo.GetAddress())
+	})
+}
+
+func FuzzPendingBTCDelegationLimitAndExpiry(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters, with a random cap on pending BTC delegations
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		bcParams := h.BTCCheckpointKeeper.GetParams(h.Ctx)
+		minUnbondingTime := types.MinimumUnbondingTime(bsParams, bcParams)
+		maxPending := uint32(datagen.RandomInt(r, 3) + 1)
+		bsParams.MaxPendingBtcDelegationsPerStaker = maxPending
+		h.NoError(h.BTCStakingKeeper.SetParams(h.Ctx, bsParams))
+
+		// generate and insert new finality provider
+		_, fpPK, fp := h.CreateFinalityProvider(r)
 
-		// updated commission and description
-		newCommission := datagen.GenRandomCommission(r)
-		newDescription := datagen.GenRandomDescription(r)
+		// mock that the registered epoch is finalised
+		h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).AnyTimes()
 
-		// scenario 1: editing finality provider should succeed
-		msg := &types.MsgEditFinalityProvider{
-			Signer:      fpAddr.String(),
-			BtcPk:       *fp.BtcPk,
-			Description: newDescription,
-			Commission:  &newCommission,
+		stakingValue := int64(2 * 10e8)
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		genMsg := func() *types.MsgCreateBTCDelegation {
+			return h.GenMsgCreateDelegationWithStaker(r, delSK, fpPK, stakingValue, 1000, stakingValue-1000, uint16(minUnbondingTime)+1)
 		}
-		_, err = msgSrvr.EditFinalityProvider(h.Ctx, msg)
+
+		// the staker can create up to maxPending pending BTC delegations
+		msgs := []*types.MsgCreateBTCDelegation{}
+		stakingTxHashes := []string{}
+		for i := uint32(0); i < maxPending; i++ {
+			msg := genMsg()
+			_, err := h.MsgServer.CreateBTCDelegation(h.Ctx, msg)
+			h.NoError(err)
+			stakingMsgTx, err := bbn.NewBTCTxFromBytes(msg.StakingTx.Transaction)
+			h.NoError(err)
+			msgs = append(msgs, msg)
+			stakingTxHashes = append(stakingTxHashes, stakingMsgTx.TxHash().String())
+		}
+		_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, genMsg())
+		require.ErrorIs(t, err, types.ErrTooManyPendingBTCDelegations)
+
+		// another staker is not affected
+		_, _, otherMsg := h.GenMsgCreateDelegation(r, fpPK, stakingValue, 1000, stakingValue-1000, uint16(minUnbondingTime)+1)
+		_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, otherMsg)
 		h.NoError(err)
-		editedFp, err := bsKeeper.GetFinalityProvider(h.Ctx, *fp.BtcPk)
+
+		// a BTC delegation receiving covenant quorum no longer counts
+		quorumDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHashes[0])
 		h.NoError(err)
-		require.Equal(t, newCommission, *editedFp.Commission)
-		require.Equal(t, newDescription, editedFp.Description)
+		h.CreateCovenantSigs(r, covenantSKs, msgs[0], quorumDel)
+		msg := genMsg()
+		_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, msg)
+		h.NoError(err)
+		stakingMsgTx, err := bbn.NewBTCTxFromBytes(msg.StakingTx.Transaction)
+		h.NoError(err)
+		stakingTxHashes = append(stakingTxHashes[1:], stakingMsgTx.TxHash().String())
+
+		// pending BTC delegations do not expire before their staking txs are
+		// deep enough
+		btcTipHeight := h.BTCLightClientKeeper.GetTipInfo(h.Ctx).Height
+		inclusionHeight := quorumDel.StartHeight
+		bsParams = h.BTCStakingKeeper.GetParams(h.Ctx)
+		bsParams.PendingBtcDelegationExpiryBlocks = uint32(btcTipHeight-inclusionHeight) + 1
+		h.NoError(h.BTCStakingKeeper.SetParams(h.Ctx, bsParams))
+		h.NoError(h.BTCStakingKeeper.EndBlocker(h.Ctx))
+		for _, stakingTxHash := range stakingTxHashes {
+			_, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+			h.NoError(err)
+		}
 
-		// scenario 2: message from an unauthorised signer should fail
-		newCommission = datagen.GenRandomCommission(r)
-		newDescription = datagen.GenRandomDescription(r)
-		msg = &types.MsgEditFinalityProvider{
-			Signer:      datagen.GenRandomAccount().Address,
-			BtcPk:       *fp.BtcPk,
-			Description: newDescription,
-			Commission:  &newCommission,
+		// pending BTC delegations are removed once they expire, while the BTC
+		// delegation with covenant quorum remains
+		bsParams.PendingBtcDelegationExpiryBlocks = uint32(btcTipHeight - inclusionHeight)
+		h.NoError(h.BTCStakingKeeper.SetParams(h.Ctx, bsParams))
+		h.NoError(h.BTCStakingKeeper.EndBlocker(h.Ctx))
+		for _, stakingTxHash := range stakingTxHashes {
+			_, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+			require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
 		}
-		_, err = msgSrvr.EditFinalityProvider(h.Ctx, msg)
-		h.Error(err)
-		errStatus := status.Convert(err)
-		require.Equal(t, codes.PermissionDenied, errStatus.Code())
+		_, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, quorumDel.MustGetStakingTxHash().String())
+		h.NoError(err)
+		// no BTC delegation expired in earlier blocks, so all expiry events
+		// are emitted by the last EndBlocker
+		numExpiredEvents := 0
+		for _, event := range h.Ctx.EventManager().Events() {
+			if event.Type == "babylon.btcstaking.v1.EventPendingBTCDelegationExpired" {
+				numExpiredEvents++
+			}
+		}
+		// including the pending BTC delegation of the other staker
+		require.Equal(t, len(stakingTxHashes)+1, numExpiredEvents)
+
+		// the staker can create pending BTC delegations again
+		_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, genMsg())
+		h.NoError(err)
 	})
 }
 
-func FuzzCreateBTCDelegation(f *testing.F) {
+func FuzzCovenantPerformance(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 
 	f.Fuzz(func(t *testing.T, seed int64) {
@@ -157,7 +1544,8 @@ func FuzzCreateBTCDelegation(f *testing.F) {
 		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
 
 		// set all parameters
-		h.GenAndApplyParams(r)
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		params := h.BTCStakingKeeper.GetParams(h.Ctx)
 
 		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
 		require.NoError(t, err)
@@ -166,102 +1554,108 @@ func FuzzCreateBTCDelegation(f *testing.F) {
 		_, fpPK, fp := h.CreateFinalityProvider(r)
 
 		// mock that the registered epoch is finalised
-		h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).Times(1)
+		h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).AnyTimes()
 
 		// generate and insert new BTC delegation
 		stakingValue := int64(2 * 10e8)
-		stakingTxHash, _, _, msgCreateBTCDel, _ := h.CreateDelegation(
+		createdHeight := uint64(h.Ctx.HeaderInfo().Height)
+		_, _, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
 			r,
 			fpPK,
 			changeAddress.EncodeAddress(),
 			stakingValue,
 			1000,
 		)
+		require.Equal(t, createdHeight, actualDel.CreatedBabylonHeight)
+
+		// a quorum of covenant members sign the BTC delegation some blocks
+		// after its creation
+		signingBlocks := datagen.RandomInt(r, 20)
+		h.SetCtxHeight(createdHeight + signingBlocks)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: 30}).AnyTimes()
+		covenantMsgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+		quorum := int(params.CovenantQuorum)
+		for _, msg := range covenantMsgs[:quorum] {
+			_, err := h.MsgServer.AddCovenantSigs(h.Ctx, msg)
+			h.NoError(err)
+		}
 
-		// ensure consistency between the msg and the BTC delegation in DB
-		actualDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
-		h.NoError(err)
-		require.Equal(h.t, msgCreateBTCDel.BabylonPk, actualDel.BabylonPk)
-		require.Equal(h.t, msgCreateBTCDel.Pop, actualDel.Pop)
-		require.Equal(h.t, msgCreateBTCDel.StakingTx.Transaction, actualDel.StakingTx)
-		require.Equal(h.t, msgCreateBTCDel.SlashingTx, actualDel.SlashingTx)
-		// ensure the BTC delegation in DB is correctly formatted
-		err = actualDel.ValidateBasic()
-		h.NoError(err)
-		// delegation is not activated by covenant yet
-		require.False(h.t, actualDel.HasCovenantQuorums(h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum))
-	})
-}
-
-func TestProperVersionInDelegation(t *testing.T) {
-	r := rand.New(rand.NewSource(time.Now().Unix()))
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	// mock BTC light client and BTC checkpoint modules
-	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
-	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
-	ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
-	h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
-
-	// set all parameters
-	h.GenAndApplyParams(r)
-
-	changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
-	require.NoError(t, err)
-
-	// generate and insert new finality provider
-	_, fpPK, fp := h.CreateFinalityProvider(r)
-
-	// mock that the registered epoch is finalised
-	h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).AnyTimes()
+		// the signing covenant members have the signature and its latency
+		// recorded
+		for _, msg := range covenantMsgs[:quorum] {
+			perf := h.BTCStakingKeeper.GetCovenantPerformance(h.Ctx, msg.Pk)
+			require.Equal(t, uint64(1), perf.NumSigned)
+			require.Equal(t, signingBlocks, perf.TotalSigningBlocks)
+			require.Zero(t, perf.NumMissed)
+		}
+		// all other covenant members miss the BTC delegation
+		for _, msg := range covenantMsgs[quorum:] {
+			perf := h.BTCStakingKeeper.GetCovenantPerformance(h.Ctx, msg.Pk)
+			require.Zero(t, perf.NumSigned)
+			require.Equal(t, uint64(1), perf.NumMissed)
+		}
 
-	// generate and insert new BTC delegation
-	stakingValue := int64(2 * 10e8)
-	stakingTxHash, _, _, _, _ := h.CreateDelegation(
-		r,
-		fpPK,
-		changeAddress.EncodeAddress(),
-		stakingValue,
-		1000,
-	)
+		// signatures after the quorum are neither accepted nor recorded
+		_, err = h.MsgServer.AddCovenantSigs(h.Ctx, covenantMsgs[quorum])
+		h.NoError(err)
+		perf := h.BTCStakingKeeper.GetCovenantPerformance(h.Ctx, covenantMsgs[quorum].Pk)
+		require.Zero(t, perf.NumSigned)
+		require.Equal(t, uint64(1), perf.NumMissed)
 
-	// ensure consistency between the msg and the BTC delegation in DB
-	actualDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
-	h.NoError(err)
-	err = actualDel.ValidateBasic()
-	h.NoError(err)
-	// Current version will be `1` as:
-	// - version `0` is initialized by `NewHelper`
-	// - version `1` is set by `GenAndApplyParams`
-	require.Equal(t, uint32(1), actualDel.ParamsVersion)
+		// the query returns the signing records of all covenant members
+		resp, err := h.BTCStakingKeeper.CovenantPerformance(h.Ctx, &types.QueryCovenantPerformanceRequest{})
+		h.NoError(err)
+		require.Len(t, resp.CovenantPerformances, len(covenantMsgs))
+		for _, perfResp := range resp.CovenantPerformances {
+			require.True(t, perfResp.InCommittee)
+			if perfResp.NumSigned > 0 {
+				require.True(t, perfResp.AverageSigningBlocks.Equal(sdkmath.LegacyNewDec(int64(signingBlocks))))
+			}
+		}
 
-	customMinUnbondingTime := uint32(2000)
-	currentParams := h.BTCStakingKeeper.GetParams(h.Ctx)
-	currentParams.MinUnbondingTime = 2000
-	// Update new params
-	err = h.BTCStakingKeeper.SetParams(h.Ctx, currentParams)
-	require.NoError(t, err)
-	// create new delegation
-	stakingTxHash1, _, _, _, err := h.CreateDelegationCustom(
-		r,
-		fpPK,
-		changeAddress.EncodeAddress(),
-		stakingValue,
-		1000,
-		stakingValue-1000,
-		uint16(customMinUnbondingTime)+1,
-	)
-	require.NoError(t, err)
-	actualDel1, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash1)
-	h.NoError(err)
-	err = actualDel1.ValidateBasic()
-	h.NoError(err)
-	// Assert that the new delegation has the updated params version
-	require.Equal(t, uint32(2), actualDel1.ParamsVersion)
+		// another BTC delegation is signed by fewer covenant members than the
+		// quorum before it expires
+		_, _, _, msgCreateBTCDel, actualDel = h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		covenantMsgs = h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+		numSigners := int(datagen.RandomInt(r, quorum))
+		for _, msg := range covenantMsgs[:numSigners] {
+			_, err := h.MsgServer.AddCovenantSigs(h.Ctx, msg)
+			h.NoError(err)
+		}
+		params.PendingBtcDelegationExpiryBlocks = 1
+		h.NoError(h.BTCStakingKeeper.SetParams(h.Ctx, params))
+		h.BTCStakingKeeper.PruneExpiredPendingBTCDelegations(h.Ctx)
+		_, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, actualDel.MustGetStakingTxHash().String())
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+
+		// all covenant members that have not signed the expired BTC
+		// delegation miss it
+		for i, msg := range covenantMsgs {
+			perf := h.BTCStakingKeeper.GetCovenantPerformance(h.Ctx, msg.Pk)
+			expectedSigned, expectedMissed := uint64(0), uint64(0)
+			if i < quorum {
+				expectedSigned++
+			} else {
+				expectedMissed++
+			}
+			if i < numSigners {
+				expectedSigned++
+			} else {
+				expectedMissed++
+			}
+			require.Equal(t, expectedSigned, perf.NumSigned)
+			require.Equal(t, expectedMissed, perf.NumMissed)
+		}
+	})
 }
 
-func FuzzAddCovenantSigs(f *testing.F) {
+func FuzzWatchtowerBackup(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 
 	f.Fuzz(func(t *testing.T, seed int64) {
@@ -287,49 +1681,69 @@ func FuzzAddCovenantSigs(f *testing.F) {
 		// mock that the registered epoch is finalised
 		h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).Times(1)
 
-		// generate and insert new BTC delegation
+		// generate and insert new BTC delegation with covenant signatures
 		stakingValue := int64(2 * 10e8)
-		stakingTxHash, _, _, msgCreateBTCDel, _ := h.CreateDelegation(
+		stakingTxHash, _, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
 			r,
 			fpPK,
 			changeAddress.EncodeAddress(),
 			stakingValue,
 			1000,
 		)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+		stakerAddr := actualDel.StakerAddress().String()
+
+		watchtowerSK := secp256k1.GenPrivKey()
+		backup := datagen.GenRandomByteArray(r, datagen.RandomInt(r, types.MaxWatchtowerBackupSize)+1)
+		msg := &types.MsgSetWatchtowerBackup{
+			Signer:            stakerAddr,
+			StakingTxHash:     stakingTxHash,
+			WatchtowerAddress: sdk.AccAddress(watchtowerSK.PubKey().Address()).String(),
+			EncryptedBackup:   backup,
+		}
 
-		// ensure consistency between the msg and the BTC delegation in DB
-		actualDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
-		h.NoError(err)
-		// delegation is not activated by covenant yet
-		require.False(h.t, actualDel.HasCovenantQuorums(h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum))
+		// only the staker can deposit backup data
+		thirdPartyMsg := *msg
+		thirdPartyMsg.Signer = datagen.GenRandomAccount().Address
+		_, err = h.MsgServer.SetWatchtowerBackup(h.Ctx, &thirdPartyMsg)
+		require.ErrorIs(t, err, types.ErrUnauthorizedSigner)
 
-		msgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+		// backup data exceeding the size limit is rejected
+		oversizedMsg := *msg
+		oversizedMsg.EncryptedBackup = datagen.GenRandomByteArray(r, types.MaxWatchtowerBackupSize+1)
+		_, err = h.MsgServer.SetWatchtowerBackup(h.Ctx, &oversizedMsg)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
 
-		// ensure the system does not panick due to a bogus covenant sig request
-		bogusMsg := *msgs[0]
-		bogusMsg.StakingTxHash = datagen.GenRandomBtcdHash(r).String()
-		_, err = h.MsgServer.AddCovenantSigs(h.Ctx, &bogusMsg)
-		h.Error(err)
+		// the staker deposits backup data
+		_, err = h.MsgServer.SetWatchtowerBackup(h.Ctx, msg)
+		h.NoError(err)
 
-		for _, msg := range msgs {
-			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msg)
-			h.NoError(err)
-			// check that submitting the same covenant signature does not produce an error
-			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msg)
-			h.NoError(err)
-		}
+		// anyone can retrieve the backup data, which is public ciphertext
+		req := &types.QueryWatchtowerBackupRequest{StakingTxHashHex: stakingTxHash}
+		resp, err := h.BTCStakingKeeper.WatchtowerBackup(h.Ctx, req)
+		h.NoError(err)
+		require.Equal(t, msg.WatchtowerAddress, resp.WatchtowerAddress)
+		require.Equal(t, backup, resp.EncryptedBackup)
 
-		// ensure the BTC delegation now has voting power
-		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		// the backup data is stored apart from the BTC delegation
+		stakingTxHashObj, err := chainhash.NewHashFromStr(stakingTxHash)
 		h.NoError(err)
-		require.True(h.t, actualDel.HasCovenantQuorums(h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum))
-		require.True(h.t, actualDel.BtcUndelegation.HasCovenantQuorums(h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum))
-		votingPower := actualDel.VotingPower(h.BTCLightClientKeeper.GetTipInfo(h.Ctx).Height, h.BTCCheckpointKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout, h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum)
-		require.Equal(t, uint64(stakingValue), votingPower)
+		storedBackup := h.BTCStakingKeeper.GetWatchtowerBackup(h.Ctx, *stakingTxHashObj)
+		require.NotNil(t, storedBackup)
+		require.Equal(t, stakingTxHash, storedBackup.StakingTxHash)
+
+		// the staker removes the backup data
+		_, err = h.MsgServer.SetWatchtowerBackup(h.Ctx, &types.MsgSetWatchtowerBackup{
+			Signer:        stakerAddr,
+			StakingTxHash: stakingTxHash,
+		})
+		h.NoError(err)
+		_, err = h.BTCStakingKeeper.WatchtowerBackup(h.Ctx, req)
+		require.ErrorIs(t, err, types.ErrWatchtowerBackupNotFound)
 	})
 }
 
-func FuzzBTCUndelegate(f *testing.F) {
+func FuzzReportStakingSpend(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 
 	f.Fuzz(func(t *testing.T, seed int64) {
@@ -358,50 +1772,89 @@ func FuzzBTCUndelegate(f *testing.F) {
 		// mock that the registered epoch is finalised
 		h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).Times(1)
 
-		// generate and insert new BTC delegation
+		// generate and insert new BTC delegation with covenant signatures
 		stakingValue := int64(2 * 10e8)
-		stakingTxHash, delSK, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
+		stakingTxHash, _, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
 			r,
 			fpPK,
 			changeAddress.EncodeAddress(),
 			stakingValue,
 			1000,
 		)
-
-		// add covenant signatures to this BTC delegation
 		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
-
-		// ensure the BTC delegation is bonded right now
 		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
 		h.NoError(err)
 		btcTip := h.BTCLightClientKeeper.GetTipInfo(h.Ctx).Height
-		status := actualDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum)
-		require.Equal(t, types.BTCDelegationStatus_ACTIVE, status)
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, actualDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum))
+
+		// includeTx includes the given tx in a k-deep BTC block, and returns
+		// the tx info with the inclusion proof
+		includeTx := func(tx *wire.MsgTx) *btcctypes.TransactionInfo {
+			prevBlock, _ := datagen.GenRandomBtcdBlock(r, 0, nil)
+			btcHeaderWithProof := datagen.CreateBlockWithTransaction(r, &prevBlock.Header, tx)
+			btcHeader := btcHeaderWithProof.HeaderBytes
+			serializedTx, err := bbn.SerializeBTCTx(tx)
+			h.NoError(err)
+			h.BTCLightClientKeeper.EXPECT().GetHeaderByHash(gomock.Eq(h.Ctx), gomock.Eq(btcHeader.Hash())).Return(&btclctypes.BTCHeaderInfo{Header: &btcHeader, Height: 15}).AnyTimes()
+			return btcctypes.NewTransactionInfo(&btcctypes.TransactionKey{Index: 1, Hash: btcHeader.Hash()}, serializedTx, btcHeaderWithProof.SpvProof.MerkleNodes)
+		}
+		reporter := datagen.GenRandomAccount().Address
 
-		// construct unbonding msg
-		delUnbondingSig, err := actualDel.SignUnbondingTx(&bsParams, h.Net, delSK)
+		// spending the staking output via the unbonding tx is not a
+		// compromise
+		unbondingTx, err := bbn.NewBTCTxFromBytes(actualDel.BtcUndelegation.UnbondingTx)
 		h.NoError(err)
-		msg := &types.MsgBTCUndelegate{
-			Signer:         datagen.GenRandomAccount().Address,
-			StakingTxHash:  stakingTxHash,
-			UnbondingTxSig: bbn.NewBIP340SignatureFromBTCSig(delUnbondingSig),
-		}
+		_, err = h.MsgServer.ReportStakingSpend(h.Ctx, &types.MsgReportStakingSpend{
+			Signer:        reporter,
+			StakingTxHash: stakingTxHash,
+			SpendTx:       includeTx(unbondingTx),
+		})
+		require.ErrorIs(t, err, types.ErrInvalidStakingSpend)
 
-		// ensure the system does not panick due to a bogus unbonding msg
-		bogusMsg := *msg
-		bogusMsg.StakingTxHash = datagen.GenRandomBtcdHash(r).String()
-		_, err = h.MsgServer.BTCUndelegate(h.Ctx, &bogusMsg)
-		h.Error(err)
+		// a tx not spending the staking output is not a compromise
+		_, err = h.MsgServer.ReportStakingSpend(h.Ctx, &types.MsgReportStakingSpend{
+			Signer:        reporter,
+			StakingTxHash: stakingTxHash,
+			SpendTx:       includeTx(datagen.GenRandomTx(r)),
+		})
+		require.ErrorIs(t, err, types.ErrInvalidStakingSpend)
 
-		// unbond
-		_, err = h.MsgServer.BTCUndelegate(h.Ctx, msg)
+		// a tx spending the staking output outside the protocol compromises
+		// the BTC delegation
+		stakingTxHashObj, err := chainhash.NewHashFromStr(stakingTxHash)
+		h.NoError(err)
+		spendTx := wire.NewMsgTx(2)
+		spendTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(stakingTxHashObj, actualDel.StakingOutputIdx), nil, nil))
+		spendTx.AddTxOut(wire.NewTxOut(stakingValue-1000, changeAddress.ScriptAddress()))
+		msg := &types.MsgReportStakingSpend{
+			Signer:        reporter,
+			StakingTxHash: stakingTxHash,
+			SpendTx:       includeTx(spendTx),
+		}
+		_, err = h.MsgServer.ReportStakingSpend(h.Ctx, msg)
 		h.NoError(err)
 
-		// ensure the BTC delegation is unbonded
 		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
 		h.NoError(err)
-		status = actualDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum)
-		require.Equal(t, types.BTCDelegationStatus_UNBONDED, status)
+		require.Equal(t, types.BTCDelegationStatus_COMPROMISED, actualDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum))
+		require.Equal(t, spendTx.TxHash().String(), actualDel.CompromisingSpendTxHash)
+		require.Zero(t, actualDel.VotingPower(btcTip, wValue, bsParams.CovenantQuorum))
+
+		// the BTC delegation loses its voting power upon the next power
+		// distribution update
+		compromisedEventFound := false
+		h.BTCStakingKeeper.IteratePowerDistUpdateEvents(h.Ctx, btcTip, func(ev *types.EventPowerDistUpdate) bool {
+			delEvent := ev.GetBtcDelStateUpdate()
+			if delEvent != nil && delEvent.StakingTxHash == stakingTxHash && delEvent.NewState == types.BTCDelegationStatus_COMPROMISED {
+				compromisedEventFound = true
+			}
+			return true
+		})
+		require.True(t, compromisedEventFound)
+
+		// a compromised BTC delegation cannot be reported again
+		_, err = h.MsgServer.ReportStakingSpend(h.Ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidDelegationState)
 	})
 }
 
@@ -973,3 +2426,49 @@ func FuzzDeterminismBtcstakingBeginBlocker(f *testing.F) {
 		require.Equal(t, appHash1, appHash2)
 	})
 }
+
+func FuzzCreateBTCDelegationMinTxFeeRate(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		h.GenAndApplyParams(r)
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		bcParams := h.BTCCheckpointKeeper.GetParams(h.Ctx)
+		minUnbondingTime := types.MinimumUnbondingTime(bsParams, bcParams)
+
+		// generate and insert new finality provider
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+
+		// mock that the registered epoch is finalised
+		h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).AnyTimes()
+
+		stakingValue := int64(2 * 10e8)
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		msg := h.GenMsgCreateDelegationWithStaker(r, delSK, fpPK, stakingValue, 1000, stakingValue-1000, uint16(minUnbondingTime)+1)
+
+		// the pre-signed txs pay fees of a few thousand satoshis, which are
+		// insufficient at a fee rate of at least 1000 sat/vbyte
+		bsParams.MinTxFeeRateSatPerVbyte = datagen.RandomInt(r, 1000) + 1000
+		h.NoError(h.BTCStakingKeeper.SetParams(h.Ctx, bsParams))
+		_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, msg)
+		require.ErrorIs(t, err, types.ErrInsufficientTxFee)
+
+		// the fees are sufficient at a fee rate of 1 sat/vbyte
+		bsParams.MinTxFeeRateSatPerVbyte = 1
+		h.NoError(h.BTCStakingKeeper.SetParams(h.Ctx, bsParams))
+		_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, msg)
+		h.NoError(err)
+	})
+}
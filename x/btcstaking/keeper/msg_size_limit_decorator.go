@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// MsgSizeLimitDecorator defines an AnteHandler decorator that rejects BTC
+// staking messages exceeding the size limits of the module, so that
+// malformed giant messages do not consume block gas in the keeper
+type MsgSizeLimitDecorator struct{}
+
+// NewMsgSizeLimitDecorator creates a new MsgSizeLimitDecorator
+func NewMsgSizeLimitDecorator() *MsgSizeLimitDecorator {
+	return &MsgSizeLimitDecorator{}
+}
+
+// AnteHandle checks the size limits of all BTC staking messages in the tx,
+// including the ones wrapped in authz MsgExec, whose ValidateBasic is not
+// invoked before execution
+func (d MsgSizeLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	for _, msg := range tx.GetMsgs() {
+		if err := d.ValidateMsg(msg); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// ValidateMsg checks the size limits of the given message and of all
+// messages nested in it
+func (d MsgSizeLimitDecorator) ValidateMsg(msg sdk.Msg) error {
+	if execMsg, ok := msg.(*authz.MsgExec); ok {
+		innerMsgs, err := execMsg.GetMessages()
+		if err != nil {
+			return err
+		}
+		for _, innerMsg := range innerMsgs {
+			if err := d.ValidateMsg(innerMsg); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return types.ValidateMsgSizeLimits(msg)
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	"github.com/babylonchain/babylon/x/btcstaking/keeper"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+func TestMsgSizeLimitDecorator(t *testing.T) {
+	oversizedSigs := make([][]byte, types.MaxRestakedFinalityProviders+1)
+	oversizedSlashingTx := types.BTCSlashingTx(make([]byte, types.MaxPreSignedTxSize+1))
+
+	testCases := []struct {
+		msg        sdk.Msg
+		expectPass bool
+	}{
+		// messages within the size limits
+		{&types.MsgCreateBTCDelegation{}, true},
+		{&types.MsgAddCovenantSigs{SlashingTxSigs: make([][]byte, types.MaxRestakedFinalityProviders)}, true},
+		{&types.MsgBTCUndelegate{}, true},
+		// oversized messages
+		{&types.MsgCreateBTCDelegation{StakingTx: &btcctypes.TransactionInfo{Transaction: make([]byte, types.MaxStakingTxSize+1)}}, false},
+		{&types.MsgCreateBTCDelegation{StakingTx: &btcctypes.TransactionInfo{Proof: make([]byte, types.MaxTxInclusionProofSize+1)}}, false},
+		{&types.MsgCreateBTCDelegation{SlashingTx: &oversizedSlashingTx}, false},
+		{&types.MsgCreateBTCDelegation{UnbondingTx: make([]byte, types.MaxPreSignedTxSize+1)}, false},
+		{&types.MsgAddCovenantSigs{SlashingTxSigs: oversizedSigs}, false},
+		{&types.MsgAddCovenantSigs{SlashingUnbondingTxSigs: oversizedSigs}, false},
+		{&types.MsgAddCovenantMuSig2Nonces{Nonces: types.CovenantMuSig2Nonces{SlashingTxNonces: oversizedSigs}}, false},
+		{&types.MsgReportStakingSpend{SpendTx: &btcctypes.TransactionInfo{Transaction: make([]byte, types.MaxStakingTxSize+1)}}, false},
+		// oversized message wrapped in authz MsgExec
+		{newMsgExec(&types.MsgAddCovenantSigs{SlashingTxSigs: oversizedSigs}), false},
+		{newMsgExec(&types.MsgBTCUndelegate{}), true},
+	}
+
+	decorator := keeper.NewMsgSizeLimitDecorator()
+
+	for _, tc := range testCases {
+		err := decorator.ValidateMsg(tc.msg)
+		if tc.expectPass {
+			require.NoError(t, err)
+		} else {
+			require.ErrorIs(t, err, types.ErrMsgTooLarge)
+		}
+	}
+}
+
+func newMsgExec(msg sdk.Msg) sdk.Msg {
+	msgExec := authz.NewMsgExec(sdk.AccAddress("grantee"), []sdk.Msg{msg})
+	return &msgExec
+}
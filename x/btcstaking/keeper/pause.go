@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// GetPauseState returns the pause state of the processing of new BTC
+// delegations and early unbondings, which is not paused if never set
+func (k Keeper) GetPauseState(ctx context.Context) types.PauseState {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.PauseStateKey)
+	if err != nil {
+		panic(err)
+	}
+	var ps types.PauseState
+	if bz == nil {
+		return ps
+	}
+	k.cdc.MustUnmarshal(bz, &ps)
+	return ps
+}
+
+// IsPaused returns whether the processing of MsgCreateBTCDelegation and
+// MsgBTCUndelegate is paused
+func (k Keeper) IsPaused(ctx context.Context) bool {
+	return k.GetPauseState(ctx).Paused
+}
+
+// isPauseSigner returns whether the given address is allowed to pause and
+// resume, i.e., it is either the governance account or the pause authority in
+// the current params
+func (k Keeper) isPauseSigner(ctx context.Context, signer string) bool {
+	if signer == k.authority {
+		return true
+	}
+	pauseAuthority := k.GetParams(ctx).PauseAuthority
+	return pauseAuthority != "" && signer == pauseAuthority
+}
+
+// updatePauseState sets the pause state updated by the given signer and emits
+// an event
+func (k Keeper) updatePauseState(ctx context.Context, signer string, paused bool, reason string) error {
+	ps := &types.PauseState{
+		Paused:        paused,
+		UpdatedBy:     signer,
+		UpdatedHeight: uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height),
+		Reason:        reason,
+	}
+	k.setPauseState(ctx, ps)
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventPauseStateUpdated{PauseState: ps})
+}
+
+// exportPauseState returns the pause state for the genesis, which is nil if
+// never set
+func (k Keeper) exportPauseState(ctx context.Context) *types.PauseState {
+	store := k.storeService.OpenKVStore(ctx)
+	has, err := store.Has(types.PauseStateKey)
+	if err != nil {
+		panic(err)
+	}
+	if !has {
+		return nil
+	}
+	ps := k.GetPauseState(ctx)
+	return &ps
+}
+
+func (k Keeper) setPauseState(ctx context.Context, ps *types.PauseState) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.PauseStateKey, k.cdc.MustMarshal(ps)); err != nil {
+		panic(err)
+	}
+}
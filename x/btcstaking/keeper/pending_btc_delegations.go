@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// PruneExpiredPendingBTCDelegations removes the BTC delegations that have not
+// received covenant quorum within `PendingBtcDelegationExpiryBlocks` BTC
+// blocks after the inclusion of their staking txs, such that BTC delegations
+// that never become active do not bloat the state.
+// This is triggered upon each `EndBlock`
+func (k Keeper) PruneExpiredPendingBTCDelegations(ctx context.Context) {
+	expiryBlocks := uint64(k.GetParams(ctx).PendingBtcDelegationExpiryBlocks)
+	if expiryBlocks == 0 {
+		return
+	}
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	if btcTipHeight < expiryBlocks {
+		return
+	}
+
+	// get all pending BTC delegations whose staking txs are included at
+	// heights no higher than the BTC tip minus the expiry
+	stakingTxHashes := []chainhash.Hash{}
+	func() {
+		iter := k.pendingBTCDelHeightStore(ctx).Iterator(nil, sdk.Uint64ToBigEndian(btcTipHeight-expiryBlocks+1))
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			stakingTxHash, err := chainhash.NewHash(iter.Value())
+			if err != nil {
+				panic(err) // only programming error
+			}
+			stakingTxHashes = append(stakingTxHashes, *stakingTxHash)
+		}
+	}()
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, stakingTxHash := range stakingTxHashes {
+		btcDel := k.getBTCDelegation(ctx, stakingTxHash)
+		if btcDel == nil {
+			panic(types.ErrBTCDelegationNotFound) // only programming error
+		}
+		k.removeBTCDelegation(ctx, btcDel)
+		// the covenant members that have not signed the expired BTC
+		// delegation have missed it
+		params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+		if params == nil {
+			panic(types.ErrParamsNotFound) // only programming error
+		}
+		k.recordCovenantMisses(ctx, btcDel, params)
+
+		k.Logger(sdkCtx).Info("removed expired pending BTC delegation", "staking tx hash", stakingTxHash.String())
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventPendingBTCDelegationExpired{
+			StakingTxHash: stakingTxHash.String(),
+			StakerBtcPk:   btcDel.BtcPk,
+			BtcHeight:     btcTipHeight,
+		}); err != nil {
+			panic(fmt.Errorf("failed to emit EventPendingBTCDelegationExpired: %w", err))
+		}
+	}
+}
+
+// removeBTCDelegation removes the given BTC delegation along with all its
+// indexes. It is only used for BTC delegations without covenant quorum,
+// which never affect the voting power distribution
+func (k Keeper) removeBTCDelegation(ctx context.Context, btcDel *types.BTCDelegation) {
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+
+	for _, fpBTCPK := range btcDel.FpBtcPkList {
+		btcDelIndex := k.getBTCDelegatorDelegationIndex(ctx, &fpBTCPK, btcDel.BtcPk)
+		if btcDelIndex == nil || !btcDelIndex.Remove(stakingTxHash) {
+			continue
+		}
+		if len(btcDelIndex.StakingTxHashList) == 0 {
+			k.btcDelegatorFpStore(ctx, &fpBTCPK).Delete(*btcDel.BtcPk)
+			continue
+		}
+		k.setBTCDelegatorDelegationIndex(ctx, &fpBTCPK, btcDel.BtcPk, btcDelIndex)
+	}
+
+	k.removePendingBTCDelegation(ctx, btcDel)
+	k.removeBTCTxIndex(ctx, btcDel)
+	k.removeDelegationOperator(ctx, stakingTxHash)
+	k.removeCovenantMuSig2Nonces(ctx, stakingTxHash)
+	k.btcDelegationStore(ctx).Delete(stakingTxHash[:])
+	k.btcDelegationTxsStore(ctx).Delete(stakingTxHash[:])
+}
+
+// setPendingBTCDelegation indexes the given BTC delegation without covenant
+// quorum by its staker and by the height of the BTC block including its
+// staking tx
+func (k Keeper) setPendingBTCDelegation(ctx context.Context, btcDel *types.BTCDelegation) {
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+	heightBytes := sdk.Uint64ToBigEndian(btcDel.StartHeight)
+	k.pendingBTCDelStakerStore(ctx, btcDel.BtcPk).Set(stakingTxHash[:], heightBytes)
+	k.pendingBTCDelHeightStore(ctx).Set(append(heightBytes, stakingTxHash[:]...), stakingTxHash[:])
+}
+
+// removePendingBTCDelegation removes the given BTC delegation from the
+// indexes of the pending BTC delegations, if it is there
+func (k Keeper) removePendingBTCDelegation(ctx context.Context, btcDel *types.BTCDelegation) {
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+	heightBytes := sdk.Uint64ToBigEndian(btcDel.StartHeight)
+	k.pendingBTCDelStakerStore(ctx, btcDel.BtcPk).Delete(stakingTxHash[:])
+	k.pendingBTCDelHeightStore(ctx).Delete(append(heightBytes, stakingTxHash[:]...))
+}
+
+// countPendingBTCDelegations returns the number of pending BTC delegations of
+// the staker with the given BTC PK, counting up to the given limit
+func (k Keeper) countPendingBTCDelegations(ctx context.Context, stakerBTCPK *bbn.BIP340PubKey, limit uint32) uint32 {
+	iter := k.pendingBTCDelStakerStore(ctx, stakerBTCPK).Iterator(nil, nil)
+	defer iter.Close()
+
+	count := uint32(0)
+	for ; iter.Valid() && count < limit; iter.Next() {
+		count++
+	}
+	return count
+}
+
+// pendingBTCDelegations returns the staking tx hashes of all BTC delegations
+// in the indexes of the pending BTC delegations
+func (k Keeper) pendingBTCDelegations(ctx context.Context) []string {
+	stakingTxHashes := make([]string, 0)
+	iter := k.pendingBTCDelHeightStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		stakingTxHash, err := chainhash.NewHash(iter.Value())
+		if err != nil {
+			panic(err) // only programming error
+		}
+		stakingTxHashes = append(stakingTxHashes, stakingTxHash.String())
+	}
+
+	return stakingTxHashes
+}
+
+// pendingBTCDelStakerStore returns the KVStore of the pending BTC delegations
+// of the staker with the given BTC PK
+// prefix: PendingBTCDelStakerKey || staker's BTC PK
+// key: staking tx hash
+// value: height of the BTC block including the staking tx
+func (k Keeper) pendingBTCDelStakerStore(ctx context.Context, stakerBTCPK *bbn.BIP340PubKey) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	pendingStore := prefix.NewStore(storeAdapter, types.PendingBTCDelStakerKey)
+	return prefix.NewStore(pendingStore, stakerBTCPK.MustMarshal())
+}
+
+// pendingBTCDelHeightStore returns the KVStore of the pending BTC delegations
+// indexed by the heights of the BTC blocks including their staking txs
+// prefix: PendingBTCDelHeightKey
+// key: (height of the BTC block including the staking tx || staking tx hash)
+// value: staking tx hash
+func (k Keeper) pendingBTCDelHeightStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.PendingBTCDelHeightKey)
+}
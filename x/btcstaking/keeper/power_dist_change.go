@@ -8,6 +8,7 @@ import (
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -70,6 +71,13 @@ func (k Keeper) recordVotingPowerAndCache(ctx context.Context, dc *types.VotingP
 
 	}
 
+	// set voting power table of each consumer chain for this height
+	for _, consumerID := range dc.GetConsumerIDs() {
+		for _, fp := range dc.GetActiveConsumerFinalityProviders(consumerID, maxActiveFps) {
+			k.SetConsumerVotingPower(ctx, consumerID, fp.BtcPk.MustMarshal(), babylonTipHeight, fp.TotalVotingPower)
+		}
+	}
+
 	// set the voting power distribution cache of the current height
 	k.setVotingPowerDistCache(ctx, babylonTipHeight, dc)
 }
@@ -88,7 +96,15 @@ func (k Keeper) recordMetrics(dc *types.VotingPowerDistCache, maxActiveFps uint3
 	}
 	numStakedBTCs := stakedSats.ToBTC()
 	types.RecordMetricsKeyStakedBitcoins(float32(numStakedBTCs))
-	// TODO: record number of BTC delegations under different status
+	// number of active BTC delegations, where a BTC delegation restaked to
+	// multiple finality providers is counted once
+	activeBTCDels := map[string]struct{}{}
+	for _, fp := range dc.FinalityProviders {
+		for _, btcDel := range fp.BtcDels {
+			activeBTCDels[btcDel.StakingTxHash] = struct{}{}
+		}
+	}
+	types.RecordBTCDelegations(len(activeBTCDels), types.BTCDelegationStatus_ACTIVE)
 }
 
 // ProcessAllPowerDistUpdateEvents processes all events that affect
@@ -97,6 +113,8 @@ func (k Keeper) recordMetrics(dc *types.VotingPowerDistCache, maxActiveFps uint3
 // - newly active BTC delegations
 // - newly unbonded BTC delegations
 // - slashed finality providers
+// - jailed finality providers
+// - unjailed finality providers
 func (k Keeper) ProcessAllPowerDistUpdateEvents(
 	ctx context.Context,
 	dc *types.VotingPowerDistCache,
@@ -105,11 +123,15 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 ) *types.VotingPowerDistCache {
 	// a map where key is finality provider's BTC PK hex and value is a list
 	// of BTC delegations that newly become active under this provider
-	activeBTCDels := map[string][]*types.BTCDelegation{}
+	activeBTCDels := map[string][]*types.BTCDelDistInfo{}
 	// a map where key is unbonded BTC delegation's staking tx hash
 	unbondedBTCDels := map[string]struct{}{}
 	// a map where key is slashed finality providers' BTC PK
 	slashedFPs := map[string]struct{}{}
+	// a map where key is jailed finality providers' BTC PK
+	jailedFPs := map[string]struct{}{}
+	// a map where key is unjailed finality providers' BTC PK
+	unjailedFPs := map[string]struct{}{}
 
 	/*
 		filter and classify all events into new/expired BTC delegations and slashed FPs
@@ -119,23 +141,35 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 		case *types.EventPowerDistUpdate_BtcDelStateUpdate:
 			delEvent := typedEvent.BtcDelStateUpdate
 			if delEvent.NewState == types.BTCDelegationStatus_ACTIVE {
-				// newly active BTC delegation
-				btcDel, err := k.GetBTCDelegation(ctx, delEvent.StakingTxHash)
+				// newly active BTC delegation, whose raw BTC txs are not
+				// needed for the voting power
+				stakingTxHash, err := chainhash.NewHashFromStr(delEvent.StakingTxHash)
 				if err != nil {
 					panic(err) // only programming error
 				}
+				btcDel := k.getBTCDelegationWithoutTxs(ctx, *stakingTxHash)
+				if btcDel == nil {
+					panic(types.ErrBTCDelegationNotFound) // only programming error
+				}
 				// add the BTC delegation to each restaked finality provider
 				for _, fpBTCPK := range btcDel.FpBtcPkList {
 					fpBTCPKHex := fpBTCPK.MarshalHex()
-					activeBTCDels[fpBTCPKHex] = append(activeBTCDels[fpBTCPKHex], btcDel)
+					activeBTCDels[fpBTCPKHex] = append(activeBTCDels[fpBTCPKHex], types.NewBTCDelDistInfo(delEvent.StakingTxHash, btcDel))
 				}
-			} else if delEvent.NewState == types.BTCDelegationStatus_UNBONDED {
-				// add the expired BTC delegation to the map
+			} else if delEvent.NewState == types.BTCDelegationStatus_UNBONDED ||
+				delEvent.NewState == types.BTCDelegationStatus_COMPROMISED {
+				// add the expired or compromised BTC delegation to the map
 				unbondedBTCDels[delEvent.StakingTxHash] = struct{}{}
 			}
 		case *types.EventPowerDistUpdate_SlashedFp:
 			// slashed finality providers
 			slashedFPs[typedEvent.SlashedFp.Pk.MarshalHex()] = struct{}{}
+		case *types.EventPowerDistUpdate_JailedFp:
+			// jailed finality providers
+			jailedFPs[typedEvent.JailedFp.Pk.MarshalHex()] = struct{}{}
+		case *types.EventPowerDistUpdate_UnjailedFp:
+			// unjailed finality providers
+			unjailedFPs[typedEvent.UnjailedFp.Pk.MarshalHex()] = struct{}{}
 		}
 	}
 
@@ -155,6 +189,7 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 		// create a copy of the finality provider
 		fp := *dc.FinalityProviders[i]
 		fp.TotalVotingPower = 0
+		fp.SelfDelegatedSat = 0
 		fp.BtcDels = []*types.BTCDelDistInfo{}
 
 		fpBTCPKHex := fp.BtcPk.MarshalHex()
@@ -164,6 +199,17 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 			continue
 		}
 
+		// if this finality provider is jailed, keep its BTC delegations but
+		// mark it as jailed so that it will not be active
+		if _, ok := jailedFPs[fpBTCPKHex]; ok {
+			fp.IsJailed = true
+		}
+		// if this finality provider is unjailed, it becomes active again
+		// with its BTC delegations
+		if _, ok := unjailedFPs[fpBTCPKHex]; ok {
+			fp.IsJailed = false
+		}
+
 		// add all BTC delegations that are not unbonded to the new finality provider
 		for j := range dc.FinalityProviders[i].BtcDels {
 			btcDel := *dc.FinalityProviders[i].BtcDels[j]
@@ -176,7 +222,7 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 		if fpActiveBTCDels, ok := activeBTCDels[fpBTCPKHex]; ok {
 			// handle new BTC delegations for this finality provider
 			for _, d := range fpActiveBTCDels {
-				fp.AddBTCDel(d)
+				fp.AddBTCDelDistInfo(d)
 			}
 			// remove the finality provider entry in activeBTCDels map, so that
 			// after the for loop the rest entries in activeBTCDels belongs to new
@@ -217,7 +263,7 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 		// add each BTC delegation
 		fpActiveBTCDels := activeBTCDels[fpBTCPKHex]
 		for _, d := range fpActiveBTCDels {
-			fpDistInfo.AddBTCDel(d)
+			fpDistInfo.AddBTCDelDistInfo(d)
 		}
 
 		// add this finality provider to the new cache if it has voting power
@@ -226,8 +272,10 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 		}
 	}
 
-	// filter out the top N finality providers and their total voting power, and
-	// record them in the new cache
+	// exclude finality providers with insufficient self-delegation from the
+	// active set, then filter out the top N finality providers and their total
+	// voting power, and record them in the new cache
+	newDc.ApplyMinSelfDelegation(k.GetParams(ctx).MinSelfDelegationSat)
 	newDc.ApplyActiveFinalityProviders(maxActiveFps)
 
 	return newDc
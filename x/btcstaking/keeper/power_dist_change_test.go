@@ -4,10 +4,14 @@ import (
 	"math/rand"
 	"testing"
 
+	"github.com/babylonchain/babylon/crypto/eots"
 	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
 	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/babylonchain/babylon/x/btcstaking/keeper"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/btcsuite/btcd/btcec/v2"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -125,7 +129,7 @@ func FuzzFinalityProviderEvents(f *testing.F) {
 			Slash the finality provider and execute BeginBlock
 			Then, ensure the finality provider does not have voting power anymore
 		*/
-		err = h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal())
+		err = h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal(), uint64(h.Ctx.HeaderInfo().Height))
 		h.NoError(err)
 
 		// at this point, there should be only 1 event that the finality provider is slashed
@@ -254,3 +258,253 @@ func FuzzBTCDelegationEvents(f *testing.F) {
 		require.Len(t, events, 0)
 	})
 }
+
+func FuzzBTCDelegationActivation(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters, where the staking tx has to be deeper than the
+		// BTC tip upon the creation of the BTC delegation
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		params := h.BTCStakingKeeper.GetParams(h.Ctx)
+		params.StakingTxActivationDepth = uint32(datagen.RandomInt(r, 100)) + 30
+		err := h.BTCStakingKeeper.SetParams(h.Ctx, params)
+		h.NoError(err)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+
+		// mock that the registered epoch is finalised
+		h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).AnyTimes()
+
+		// generate and insert new BTC delegation, which records its
+		// activation height
+		stakingValue := int64(2 * 10e8)
+		expectedStakingTxHash, _, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		activationHeight := actualDel.StartHeight + uint64(params.StakingTxActivationDepth)
+		require.Equal(t, activationHeight, actualDel.ActivationHeight)
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		require.Less(t, btcTip.Height, activationHeight)
+
+		// the BTC delegation with covenant quorum is still pending as its
+		// staking tx is not deep enough
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+		events := h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, btcTip.Height, btcTip.Height)
+		require.Len(t, events, 0)
+		wValue := btccKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, expectedStakingTxHash)
+		h.NoError(err)
+		require.Equal(t, types.BTCDelegationStatus_PENDING, actualDel.GetStatus(btcTip.Height, wValue, params.CovenantQuorum))
+
+		// the BTC delegation is not activated before its activation height
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: activationHeight - 1}).AnyTimes()
+		err = h.BTCStakingKeeper.EndBlocker(h.Ctx)
+		h.NoError(err)
+		events = h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, btcTip.Height, activationHeight-1)
+		require.Len(t, events, 0)
+
+		// the BTC tip reaches the activation height
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: activationHeight}).AnyTimes()
+		reorged := r.Intn(2) == 0
+		if reorged {
+			// the block including the staking tx is no longer on the BTC chain
+			h.BTCLightClientKeeper.EXPECT().GetHeaderByHash(gomock.Eq(h.Ctx), gomock.Eq(actualDel.StakingTxHeaderHash)).Return(nil).Times(1)
+		} else {
+			h.BTCLightClientKeeper.EXPECT().GetHeaderByHash(gomock.Eq(h.Ctx), gomock.Eq(actualDel.StakingTxHeaderHash)).Return(&btclctypes.BTCHeaderInfo{Height: actualDel.StartHeight}).Times(1)
+		}
+		err = h.BTCStakingKeeper.EndBlocker(h.Ctx)
+		h.NoError(err)
+		events = h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, activationHeight, activationHeight)
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, expectedStakingTxHash)
+		h.NoError(err)
+
+		if reorged {
+			// the BTC delegation never becomes active
+			require.Len(t, events, 0)
+			require.Equal(t, actualDel.EndHeight, actualDel.ActivationHeight)
+			require.Equal(t, types.BTCDelegationStatus_PENDING, actualDel.GetStatus(activationHeight, wValue, params.CovenantQuorum))
+		} else {
+			// the BTC delegation becomes active at the activation height
+			require.Len(t, events, 1)
+			btcDelStateUpdate := events[0].GetBtcDelStateUpdate()
+			require.NotNil(t, btcDelStateUpdate)
+			require.Equal(t, expectedStakingTxHash, btcDelStateUpdate.StakingTxHash)
+			require.Equal(t, types.BTCDelegationStatus_ACTIVE, btcDelStateUpdate.NewState)
+			require.Equal(t, types.BTCDelegationStatus_ACTIVE, actualDel.GetStatus(activationHeight, wValue, params.CovenantQuorum))
+		}
+
+		// the BTC delegation is removed from the activation index in both
+		// cases, thus is not processed again
+		err = h.BTCStakingKeeper.EndBlocker(h.Ctx)
+		h.NoError(err)
+		require.Len(t, h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, activationHeight, activationHeight), len(events))
+
+		// the finality provider has voting power iff the BTC delegation is
+		// activated
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: activationHeight}).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		if reorged {
+			require.Zero(t, h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
+		} else {
+			require.Equal(t, uint64(stakingValue), h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
+		}
+	})
+}
+
+func FuzzConsumerFinalityProviders(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// mock a zoneconcierge registry with a single consumer chain
+		consumerID := datagen.GenRandomHexStr(r, 10)
+		zcKeeper := types.NewMockZoneConciergeKeeper(ctrl)
+		zcKeeper.EXPECT().HasConsumer(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ interface{}, id string) bool { return id == consumerID },
+		).AnyTimes()
+		h.BTCStakingKeeper.SetZoneConciergeKeeper(zcKeeper)
+		h.MsgServer = keeper.NewMsgServerImpl(*h.BTCStakingKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// a finality provider cannot register for an unknown consumer chain
+		fpBTCSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fpBBNSK, _, err := datagen.GenRandomSecp256k1KeyPair(r)
+		require.NoError(t, err)
+		msr, _, err := eots.NewMasterRandPair(r)
+		require.NoError(t, err)
+		unknownFP, err := datagen.GenRandomCustomFinalityProvider(r, fpBTCSK, fpBBNSK, msr)
+		require.NoError(t, err)
+		_, err = h.MsgServer.CreateFinalityProvider(h.Ctx, &types.MsgCreateFinalityProvider{
+			Signer:        sdk.AccAddress(unknownFP.BabylonPk.Address()).String(),
+			Description:   unknownFP.Description,
+			Commission:    unknownFP.Commission,
+			BabylonPk:     unknownFP.BabylonPk,
+			BtcPk:         unknownFP.BtcPk,
+			Pop:           unknownFP.Pop,
+			MasterPubRand: unknownFP.MasterPubRand,
+			ConsumerId:    consumerID + "-unknown",
+		})
+		require.ErrorIs(t, err, types.ErrConsumerNotRegistered)
+
+		// generate finality providers securing Babylon and the consumer chain
+		numBabylonFPs := int(datagen.RandomInt(r, 3) + 1)
+		numConsumerFPs := int(datagen.RandomInt(r, 3) + 1)
+		babylonFPPKs := []*btcec.PublicKey{}
+		for i := 0; i < numBabylonFPs; i++ {
+			_, fpPK, _ := h.CreateFinalityProvider(r)
+			babylonFPPKs = append(babylonFPPKs, fpPK)
+		}
+		consumerFPPKs := []*btcec.PublicKey{}
+		for i := 0; i < numConsumerFPs; i++ {
+			_, fpPK, fp := h.CreateConsumerFinalityProvider(r, consumerID)
+			require.True(t, h.BTCStakingKeeper.HasConsumerFinalityProvider(h.Ctx, consumerID, *fp.BtcPk))
+			consumerFPPKs = append(consumerFPPKs, fpPK)
+		}
+
+		// mock that the registered epoch is finalised
+		h.CheckpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(uint64(10)).AnyTimes()
+
+		// give each finality provider an active BTC delegation
+		stakingValue := int64(2 * 10e8)
+		for _, fpPK := range append(babylonFPPKs, consumerFPPKs...) {
+			_, _, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
+				r,
+				fpPK,
+				changeAddress.EncodeAddress(),
+				stakingValue,
+				1000,
+			)
+			msgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+			for i := 0; i < int(h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum); i++ {
+				_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msgs[i])
+				h.NoError(err)
+			}
+		}
+
+		// execute BeginBlock
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+
+		// finality providers securing Babylon are the active ones in the
+		// voting power distribution cache, and the consumer chain has its own
+		// active finality providers
+		dc, err := h.BTCStakingKeeper.GetVotingPowerDistCache(h.Ctx, babylonHeight)
+		h.NoError(err)
+		require.Equal(t, uint32(numBabylonFPs), dc.GetNumActiveFPs(100))
+		for _, fp := range dc.GetActiveFinalityProviders(100) {
+			require.Empty(t, fp.ConsumerId)
+		}
+		require.Equal(t, uint64(numBabylonFPs)*uint64(stakingValue), dc.TotalVotingPower)
+		require.Len(t, dc.GetActiveConsumerFinalityProviders(consumerID, 100), numConsumerFPs)
+		require.Equal(t, []string{consumerID}, dc.GetConsumerIDs())
+
+		// only finality providers securing Babylon are in Babylon's voting
+		// power table, and vice versa for the consumer chain
+		for _, fpPK := range babylonFPPKs {
+			fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(fpPK)
+			require.Equal(t, uint64(stakingValue), h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fpBTCPK, babylonHeight))
+			require.Zero(t, h.BTCStakingKeeper.GetConsumerVotingPower(h.Ctx, consumerID, *fpBTCPK, babylonHeight))
+		}
+		for _, fpPK := range consumerFPPKs {
+			fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(fpPK)
+			require.Zero(t, h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fpBTCPK, babylonHeight))
+			require.Equal(t, uint64(stakingValue), h.BTCStakingKeeper.GetConsumerVotingPower(h.Ctx, consumerID, *fpBTCPK, babylonHeight))
+		}
+		require.Len(t, h.BTCStakingKeeper.GetVotingPowerTable(h.Ctx, babylonHeight), numBabylonFPs)
+		require.Len(t, h.BTCStakingKeeper.GetConsumerVotingPowerTable(h.Ctx, consumerID, babylonHeight), numConsumerFPs)
+
+		// the finality providers of the consumer chain can be queried
+		resp, err := h.BTCStakingKeeper.FinalityProvidersByConsumer(h.Ctx, &types.QueryFinalityProvidersByConsumerRequest{
+			ConsumerId: consumerID,
+		})
+		h.NoError(err)
+		require.Len(t, resp.FinalityProviders, numConsumerFPs)
+		for _, fpResp := range resp.FinalityProviders {
+			require.Equal(t, consumerID, fpResp.ConsumerId)
+			require.Equal(t, uint64(stakingValue), fpResp.VotingPower)
+		}
+	})
+}
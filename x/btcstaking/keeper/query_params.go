@@ -15,7 +15,8 @@ func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*t
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+	vp := k.GetParamsWithVersion(ctx)
+	return &types.QueryParamsResponse{Params: vp.Params, Version: vp.Version}, nil
 }
 
 func (k Keeper) ParamsByVersion(goCtx context.Context, req *types.QueryParamsByVersionRequest) (*types.QueryParamsByVersionResponse, error) {
@@ -17,7 +17,7 @@ func TestParamsQuery(t *testing.T) {
 
 	response, err := keeper.Params(ctx, &types.QueryParamsRequest{})
 	require.NoError(t, err)
-	require.Equal(t, &types.QueryParamsResponse{Params: params}, response)
+	require.Equal(t, &types.QueryParamsResponse{Params: params, Version: 1}, response)
 }
 
 func TestParamsByVersionQuery(t *testing.T) {
@@ -36,19 +36,19 @@ func TestParamsByVersionQuery(t *testing.T) {
 	require.NoError(t, err)
 	response, err := keeper.Params(ctx, &types.QueryParamsRequest{})
 	require.NoError(t, err)
-	require.Equal(t, &types.QueryParamsResponse{Params: params1}, response)
+	require.Equal(t, &types.QueryParamsResponse{Params: params1, Version: 1}, response)
 
 	err = keeper.SetParams(ctx, params2)
 	require.NoError(t, err)
 	response, err = keeper.Params(ctx, &types.QueryParamsRequest{})
 	require.NoError(t, err)
-	require.Equal(t, &types.QueryParamsResponse{Params: params2}, response)
+	require.Equal(t, &types.QueryParamsResponse{Params: params2, Version: 2}, response)
 
 	err = keeper.SetParams(ctx, params3)
 	require.NoError(t, err)
 	response, err = keeper.Params(ctx, &types.QueryParamsRequest{})
 	require.NoError(t, err)
-	require.Equal(t, &types.QueryParamsResponse{Params: params3}, response)
+	require.Equal(t, &types.QueryParamsResponse{Params: params3, Version: 3}, response)
 
 	// Check that each past version is available through ParamsByVersion query
 	resp0, err := keeper.ParamsByVersion(ctx, &types.QueryParamsByVersionRequest{Version: 1})
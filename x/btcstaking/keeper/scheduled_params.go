@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// ApplyParams makes the given params take effect immediately, reports the
+// BTC delegations grandfathered by a slashing rate change, and emits an
+// EventParamsUpdated event
+func (k Keeper) ApplyParams(ctx context.Context, params types.Params) error {
+	oldParams := k.GetParams(ctx)
+	if err := k.SetParams(ctx, params); err != nil {
+		return err
+	}
+	k.reportSlashingRateChange(ctx, oldParams)
+
+	newParams := k.GetParamsWithVersion(ctx)
+	event := &types.EventParamsUpdated{Version: newParams.Version, Params: newParams.Params}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("failed to emit EventParamsUpdated event: %w", err))
+	}
+	return nil
+}
+
+// ScheduleParams schedules the given params to take effect at the given
+// Babylon height or at the first block of the given epoch. Exactly one of
+// activationHeight and activationEpoch has to be non-zero. The scheduled
+// params replace any params scheduled before.
+func (k Keeper) ScheduleParams(ctx context.Context, params types.Params, activationHeight uint64, activationEpoch uint64) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	curHeight := uint64(sdkCtx.HeaderInfo().Height)
+	switch {
+	case activationHeight != 0 && activationEpoch != 0:
+		return types.ErrInvalidParamsActivation.Wrap("activation height and activation epoch are mutually exclusive")
+	case activationHeight != 0:
+		if activationHeight <= curHeight {
+			return types.ErrInvalidParamsActivation.Wrapf("activation height %d is not after the current height %d", activationHeight, curHeight)
+		}
+	case activationEpoch != 0:
+		curEpoch := k.ckptKeeper.GetEpoch(ctx).EpochNumber
+		if activationEpoch <= curEpoch {
+			return types.ErrInvalidParamsActivation.Wrapf("activation epoch %d is not after the current epoch %d", activationEpoch, curEpoch)
+		}
+	default:
+		return types.ErrInvalidParamsActivation.Wrap("either activation height or activation epoch has to be specified")
+	}
+
+	k.setScheduledParams(ctx, &types.ScheduledParams{
+		Params:           params,
+		ActivationHeight: activationHeight,
+		ActivationEpoch:  activationEpoch,
+		ScheduledHeight:  curHeight,
+	})
+	return nil
+}
+
+// GetScheduledParams returns the params scheduled to take effect in the
+// future, or nil if no params are scheduled
+func (k Keeper) GetScheduledParams(ctx context.Context) *types.ScheduledParams {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.ScheduledParamsKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var sp types.ScheduledParams
+	k.cdc.MustUnmarshal(bz, &sp)
+	return &sp
+}
+
+// ActivateScheduledParams makes the scheduled params take effect if their
+// activation height or epoch is reached. It is invoked upon BeginBlock.
+func (k Keeper) ActivateScheduledParams(ctx context.Context) error {
+	sp := k.GetScheduledParams(ctx)
+	if sp == nil {
+		return nil
+	}
+
+	if sp.ActivationHeight != 0 {
+		if uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height) < sp.ActivationHeight {
+			return nil
+		}
+	} else if k.ckptKeeper.GetEpoch(ctx).EpochNumber < sp.ActivationEpoch {
+		return nil
+	}
+
+	if err := k.ApplyParams(ctx, sp.Params); err != nil {
+		return err
+	}
+	k.deleteScheduledParams(ctx)
+	return nil
+}
+
+func (k Keeper) setScheduledParams(ctx context.Context, sp *types.ScheduledParams) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.ScheduledParamsKey, k.cdc.MustMarshal(sp)); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) deleteScheduledParams(ctx context.Context) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.ScheduledParamsKey); err != nil {
+		panic(err)
+	}
+}
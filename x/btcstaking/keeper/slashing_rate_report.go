@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// reportSlashingRateChange produces the report of the active BTC delegations
+// if the latest params change the slashing rate of the given old params. The
+// report is saved under the version of the latest params and emitted as an
+// event.
+func (k Keeper) reportSlashingRateChange(ctx context.Context, oldParams types.Params) {
+	newParams := k.GetParamsWithVersion(ctx)
+	if newParams.Params.SlashingRate.Equal(oldParams.SlashingRate) {
+		return
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	// group active BTC delegations by the params version they are created under
+	cohorts := map[uint32]*types.SlashingRateCohort{}
+	paramsByVersion := map[uint32]*types.Params{}
+	iter := k.btcDelegationStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var btcDel types.BTCDelegation
+		k.cdc.MustUnmarshal(iter.Value(), &btcDel)
+
+		params, ok := paramsByVersion[btcDel.ParamsVersion]
+		if !ok {
+			params = k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+			if params == nil {
+				panic(fmt.Errorf("params version %d of a BTC delegation is not found", btcDel.ParamsVersion))
+			}
+			paramsByVersion[btcDel.ParamsVersion] = params
+		}
+		if btcDel.GetStatus(btcTipHeight, wValue, params.CovenantQuorum) != types.BTCDelegationStatus_ACTIVE {
+			continue
+		}
+
+		cohort, ok := cohorts[btcDel.ParamsVersion]
+		if !ok {
+			cohort = &types.SlashingRateCohort{
+				ParamsVersion: btcDel.ParamsVersion,
+				SlashingRate:  btcDel.ParamsWithRecordedSlashing(params).SlashingRate,
+			}
+			cohorts[btcDel.ParamsVersion] = cohort
+		}
+		cohort.NumActiveDelegations++
+		cohort.TotalSat += btcDel.TotalSat
+	}
+
+	report := &types.SlashingRateChangeReport{
+		ParamsVersion:   newParams.Version,
+		OldSlashingRate: oldParams.SlashingRate,
+		NewSlashingRate: newParams.Params.SlashingRate,
+		BabylonHeight:   uint64(sdkCtx.BlockHeight()),
+		BtcHeight:       btcTipHeight,
+		Cohorts:         make([]*types.SlashingRateCohort, 0, len(cohorts)),
+	}
+	for _, cohort := range cohorts {
+		report.Cohorts = append(report.Cohorts, cohort)
+	}
+	sort.Slice(report.Cohorts, func(i, j int) bool {
+		return report.Cohorts[i].ParamsVersion < report.Cohorts[j].ParamsVersion
+	})
+
+	k.setSlashingRateChangeReport(ctx, report)
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventSlashingRateChanged{Report: report}); err != nil {
+		panic(fmt.Errorf("failed to emit EventSlashingRateChanged event: %w", err))
+	}
+}
+
+func (k Keeper) setSlashingRateChangeReport(ctx context.Context, report *types.SlashingRateChangeReport) {
+	store := k.slashingRateReportStore(ctx)
+	store.Set(uint32ToBytes(report.ParamsVersion), k.cdc.MustMarshal(report))
+}
+
+// GetSlashingRateChangeReport returns the report of the slashing rate change
+// made by the given params version
+func (k Keeper) GetSlashingRateChangeReport(ctx context.Context, paramsVersion uint32) (*types.SlashingRateChangeReport, error) {
+	store := k.slashingRateReportStore(ctx)
+	reportBytes := store.Get(uint32ToBytes(paramsVersion))
+	if len(reportBytes) == 0 {
+		return nil, types.ErrSlashingRateReportNotFound.Wrapf("params version %d", paramsVersion)
+	}
+	var report types.SlashingRateChangeReport
+	k.cdc.MustUnmarshal(reportBytes, &report)
+	return &report, nil
+}
+
+// slashingRateReportStore returns the KVStore of the slashing rate change
+// reports
+// prefix: SlashingRateReportKey
+// key: params version
+// value: SlashingRateChangeReport
+func (k Keeper) slashingRateReportStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.SlashingRateReportKey)
+}
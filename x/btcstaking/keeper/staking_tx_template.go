@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// BuildStakingTxTemplate builds the unsigned transactions and the message
+// that a wallet needs for staking with the given finality providers under
+// the current parameters
+func (k Keeper) BuildStakingTxTemplate(ctx context.Context, req *types.QueryStakingTxTemplateRequest) (*types.StakingTxTemplate, error) {
+	// the finality providers have to be distinct, known to Babylon, and not
+	// slashed
+	fpBTCPKs := make([]bbn.BIP340PubKey, 0, len(req.FpBtcPkHexList))
+	for _, fpPKHex := range req.FpBtcPkHexList {
+		fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(fpPKHex)
+		if err != nil {
+			return nil, types.ErrInvalidStakingTx.Wrapf("invalid finality provider BTC PK: %v", err)
+		}
+		fpBTCPKs = append(fpBTCPKs, *fpBTCPK)
+	}
+	if types.ExistsDup(fpBTCPKs) {
+		return nil, types.ErrDuplicatedFp
+	}
+	for _, fpBTCPK := range fpBTCPKs {
+		fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
+		if err != nil {
+			return nil, err
+		}
+		if fp.IsSlashed() {
+			return nil, types.ErrFpAlreadySlashed
+		}
+	}
+
+	vp := k.GetParamsWithVersion(ctx)
+	btccParams := k.btccKeeper.GetParams(ctx)
+
+	return types.BuildStakingTxTemplate(req, &vp.Params, vp.Version, &btccParams, k.btcNet)
+}
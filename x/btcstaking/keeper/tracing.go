@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+
+	bbn "github.com/babylonchain/babylon/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// attribute keys of the spans of the BTC delegation lifecycle handlers
+const (
+	attrKeyBlockHeight   = "babylon.block_height"
+	attrKeyStakingTxHash = "babylon.btcstaking.staking_tx_hash"
+	attrKeyFpBtcPks      = "babylon.btcstaking.fp_btc_pks"
+	attrKeyDelBtcPk      = "babylon.btcstaking.del_btc_pk"
+	attrKeyOutcome       = "babylon.btcstaking.outcome"
+
+	outcomeAccepted = "accepted"
+	outcomeRejected = "rejected"
+)
+
+// startDelegationSpan starts a span of a BTC delegation lifecycle handler.
+// If the span is recorded, the returned context carries the span, so that
+// attributes can be attached to it via setDelegationSpanAttributes.
+// Otherwise, the given context is returned as is.
+func (k Keeper) startDelegationSpan(goCtx context.Context, spanName string) (context.Context, trace.Span) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	spanCtx, span := k.tracer.Start(
+		ctx.Context(),
+		spanName,
+		trace.WithAttributes(attribute.Int64(attrKeyBlockHeight, ctx.BlockHeight())),
+	)
+	if !span.IsRecording() {
+		return goCtx, span
+	}
+	return ctx.WithContext(spanCtx), span
+}
+
+// endDelegationSpan records the outcome of a BTC delegation lifecycle handler
+// and ends the span
+func endDelegationSpan(span trace.Span, err error) {
+	if err != nil {
+		span.SetAttributes(attribute.String(attrKeyOutcome, outcomeRejected))
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+	} else {
+		span.SetAttributes(attribute.String(attrKeyOutcome, outcomeAccepted))
+		span.SetStatus(otelcodes.Ok, "")
+	}
+	span.End()
+}
+
+// setDelegationSpanAttributes attaches the staking tx hash and the BTC PKs
+// of a BTC delegation to the span in the context
+func setDelegationSpanAttributes(
+	ctx context.Context,
+	stakingTxHash string,
+	fpBtcPks []bbn.BIP340PubKey,
+	delBtcPk *bbn.BIP340PubKey,
+) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+	span.SetAttributes(
+		attribute.String(attrKeyStakingTxHash, stakingTxHash),
+		attribute.StringSlice(attrKeyFpBtcPks, bip340PKsToHex(fpBtcPks)),
+	)
+	if delBtcPk != nil {
+		span.SetAttributes(attribute.String(attrKeyDelBtcPk, delBtcPk.MarshalHex()))
+	}
+}
+
+func bip340PKsToHex(pks []bbn.BIP340PubKey) []string {
+	hexPKs := make([]string, 0, len(pks))
+	for _, pk := range pks {
+		hexPKs = append(hexPKs, pk.MarshalHex())
+	}
+	return hexPKs
+}
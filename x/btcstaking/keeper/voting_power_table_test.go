@@ -6,8 +6,10 @@ import (
 	"testing"
 
 	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
 	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -101,7 +103,7 @@ func FuzzVotingPowerTable(f *testing.F) {
 		// slash a random finality provider
 		slashedIdx := datagen.RandomInt(r, int(numFpsWithVotingPower))
 		slashedFp := fps[slashedIdx]
-		err = h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, slashedFp.BtcPk.MustMarshal())
+		err = h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, slashedFp.BtcPk.MustMarshal(), uint64(h.Ctx.HeaderInfo().Height))
 		require.NoError(t, err)
 		// index height and record power table
 		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
@@ -399,3 +401,86 @@ func FuzzVotingPowerTable_ActiveFinalityProviderRotation(f *testing.F) {
 		}
 	})
 }
+
+func FuzzRecordFinalityProviderSetDiff(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		babylonHeight := datagen.RandomInt(r, 100) + 2
+
+		// generate finality providers that stay active, enter and exit the
+		// active finality provider set at the height
+		numStaying := int(datagen.RandomInt(r, 5))
+		numEntered := int(datagen.RandomInt(r, 5)) + 1
+		numExited := int(datagen.RandomInt(r, 5)) + 1
+		entered := map[string]uint64{}
+		exited := map[string]uint64{}
+		for i := 0; i < numStaying+numEntered+numExited; i++ {
+			fpBTCPK, err := datagen.GenRandomBIP340PubKey(r)
+			h.NoError(err)
+			power := datagen.RandomInt(r, 1000) + 1
+			switch {
+			case i < numStaying:
+				h.BTCStakingKeeper.SetVotingPower(h.Ctx, *fpBTCPK, babylonHeight-1, power)
+				h.BTCStakingKeeper.SetVotingPower(h.Ctx, *fpBTCPK, babylonHeight, power+1)
+			case i < numStaying+numEntered:
+				h.BTCStakingKeeper.SetVotingPower(h.Ctx, *fpBTCPK, babylonHeight, power)
+				entered[fpBTCPK.MarshalHex()] = power
+			default:
+				h.BTCStakingKeeper.SetVotingPower(h.Ctx, *fpBTCPK, babylonHeight-1, power)
+				exited[fpBTCPK.MarshalHex()] = power
+			}
+		}
+
+		// the set has never changed before
+		resp, err := h.BTCStakingKeeper.LastFinalityProviderSetDiff(h.Ctx, &types.QueryLastFinalityProviderSetDiffRequest{})
+		h.NoError(err)
+		require.Nil(t, resp.Diff)
+
+		// the diff is recorded and emitted at the end of the height
+		h.Ctx = datagen.WithCtxHeight(h.Ctx, babylonHeight)
+		h.BTCStakingKeeper.RecordFinalityProviderSetDiff(h.Ctx)
+		resp, err = h.BTCStakingKeeper.LastFinalityProviderSetDiff(h.Ctx, &types.QueryLastFinalityProviderSetDiffRequest{})
+		h.NoError(err)
+		require.NotNil(t, resp.Diff)
+		require.Equal(t, babylonHeight, resp.Diff.BabylonHeight)
+		require.Len(t, resp.Diff.Entered, numEntered)
+		require.Len(t, resp.Diff.Exited, numExited)
+		for i, fp := range resp.Diff.Entered {
+			require.Equal(t, entered[fp.FpBtcPk.MarshalHex()], fp.VotingPower)
+			if i > 0 {
+				require.Less(t, resp.Diff.Entered[i-1].FpBtcPk.MarshalHex(), fp.FpBtcPk.MarshalHex())
+			}
+		}
+		for _, fp := range resp.Diff.Exited {
+			require.Equal(t, exited[fp.FpBtcPk.MarshalHex()], fp.VotingPower)
+		}
+		numEvents := 0
+		for _, evt := range h.Ctx.EventManager().Events() {
+			if evt.Type == proto.MessageName(&types.EventFinalityProviderSetChange{}) {
+				numEvents++
+			}
+		}
+		require.Equal(t, 1, numEvents)
+
+		// the last diff is kept if the set does not change at the next height
+		h.Ctx = datagen.WithCtxHeight(h.Ctx, babylonHeight+1)
+		for fpBTCPKHex, power := range h.BTCStakingKeeper.GetVotingPowerTable(h.Ctx, babylonHeight) {
+			fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(fpBTCPKHex)
+			h.NoError(err)
+			h.BTCStakingKeeper.SetVotingPower(h.Ctx, *fpBTCPK, babylonHeight+1, power)
+		}
+		h.BTCStakingKeeper.RecordFinalityProviderSetDiff(h.Ctx)
+		require.Equal(t, babylonHeight, h.BTCStakingKeeper.GetLastFinalityProviderSetDiff(h.Ctx).BabylonHeight)
+	})
+}
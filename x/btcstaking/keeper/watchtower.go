@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// setWatchtowerBackup sets the backup data that the staker of a BTC
+// delegation has deposited for its watchtower
+func (k Keeper) setWatchtowerBackup(ctx context.Context, stakingTxHash chainhash.Hash, backup *types.WatchtowerBackup) {
+	store := k.watchtowerBackupStore(ctx)
+	store.Set(stakingTxHash[:], k.cdc.MustMarshal(backup))
+}
+
+// removeWatchtowerBackup removes the backup data of the BTC delegation with
+// the given staking tx hash, if any
+func (k Keeper) removeWatchtowerBackup(ctx context.Context, stakingTxHash chainhash.Hash) {
+	store := k.watchtowerBackupStore(ctx)
+	store.Delete(stakingTxHash[:])
+}
+
+// GetWatchtowerBackup returns the backup data of the BTC delegation with the
+// given staking tx hash, or nil if the staker has not deposited any
+func (k Keeper) GetWatchtowerBackup(ctx context.Context, stakingTxHash chainhash.Hash) *types.WatchtowerBackup {
+	store := k.watchtowerBackupStore(ctx)
+	backupBytes := store.Get(stakingTxHash[:])
+	if len(backupBytes) == 0 {
+		return nil
+	}
+	var backup types.WatchtowerBackup
+	k.cdc.MustUnmarshal(backupBytes, &backup)
+	return &backup
+}
+
+// watchtowerBackupStore returns the KVStore of the watchtower backups. The
+// backups are public ciphertext encrypted by the stakers for their
+// watchtowers
+// prefix: WatchtowerBackupKey
+// key: staking tx hash
+// value: WatchtowerBackup
+func (k Keeper) watchtowerBackupStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.WatchtowerBackupKey)
+}
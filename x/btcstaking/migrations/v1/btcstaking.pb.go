@@ -0,0 +1,2235 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: babylon/btcstaking/migrations/v1/btcstaking.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	secp256k1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BTCDelegation is a BTC delegation as stored at consensus version 1
+type BTCDelegation struct {
+	// babylon_pk is the Babylon secp256k1 PK of this BTC delegation
+	BabylonPk *secp256k1.PubKey `protobuf:"bytes,1,opt,name=babylon_pk,json=babylonPk,proto3" json:"babylon_pk,omitempty"`
+	// btc_pk is the BIP-340 PK of the staker
+	BtcPk []byte `protobuf:"bytes,2,opt,name=btc_pk,json=btcPk,proto3" json:"btc_pk,omitempty"`
+	// pop is the proof of possession of babylon_pk and btc_pk
+	Pop *ProofOfPossession `protobuf:"bytes,3,opt,name=pop,proto3" json:"pop,omitempty"`
+	// fp_btc_pk_list is the list of BIP-340 PKs of the finality providers that
+	// this BTC delegation delegates to
+	FpBtcPkList [][]byte `protobuf:"bytes,4,rep,name=fp_btc_pk_list,json=fpBtcPkList,proto3" json:"fp_btc_pk_list,omitempty"`
+	// start_height is the start BTC height of the BTC delegation
+	StartHeight uint64 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the end height of the BTC delegation
+	EndHeight uint64 `protobuf:"varint,6,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// total_sat is the total amount of BTC stakes in this delegation
+	TotalSat uint64 `protobuf:"varint,7,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
+	// staking_tx is the staking tx
+	StakingTx []byte `protobuf:"bytes,8,opt,name=staking_tx,json=stakingTx,proto3" json:"staking_tx,omitempty"`
+	// staking_output_idx is the index of the staking output in the staking tx
+	StakingOutputIdx uint32 `protobuf:"varint,9,opt,name=staking_output_idx,json=stakingOutputIdx,proto3" json:"staking_output_idx,omitempty"`
+	// slashing_tx is the slashing tx
+	SlashingTx []byte `protobuf:"bytes,10,opt,name=slashing_tx,json=slashingTx,proto3" json:"slashing_tx,omitempty"`
+	// delegator_sig is the staker's signature on the slashing tx
+	DelegatorSig []byte `protobuf:"bytes,11,opt,name=delegator_sig,json=delegatorSig,proto3" json:"delegator_sig,omitempty"`
+	// covenant_sigs is a list of adaptor signatures on the slashing tx by
+	// each covenant member
+	CovenantSigs []*CovenantAdaptorSignatures `protobuf:"bytes,12,rep,name=covenant_sigs,json=covenantSigs,proto3" json:"covenant_sigs,omitempty"`
+	// unbonding_time is the timelock of the unbonding output and the
+	// slashing change output
+	UnbondingTime uint32 `protobuf:"varint,13,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
+	// btc_undelegation is the early unbonding path of the BTC delegation
+	BtcUndelegation *BTCUndelegation `protobuf:"bytes,14,opt,name=btc_undelegation,json=btcUndelegation,proto3" json:"btc_undelegation,omitempty"`
+	// params_version is the version of the params the delegation is
+	// validated against
+	ParamsVersion uint32 `protobuf:"varint,15,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+}
+
+func (m *BTCDelegation) Reset()         { *m = BTCDelegation{} }
+func (m *BTCDelegation) String() string { return proto.CompactTextString(m) }
+func (*BTCDelegation) ProtoMessage()    {}
+func (*BTCDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_301d6d4b1d81cc8c, []int{0}
+}
+func (m *BTCDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCDelegation.Merge(m, src)
+}
+func (m *BTCDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCDelegation proto.InternalMessageInfo
+
+func (m *BTCDelegation) GetBabylonPk() *secp256k1.PubKey {
+	if m != nil {
+		return m.BabylonPk
+	}
+	return nil
+}
+
+func (m *BTCDelegation) GetBtcPk() []byte {
+	if m != nil {
+		return m.BtcPk
+	}
+	return nil
+}
+
+func (m *BTCDelegation) GetPop() *ProofOfPossession {
+	if m != nil {
+		return m.Pop
+	}
+	return nil
+}
+
+func (m *BTCDelegation) GetFpBtcPkList() [][]byte {
+	if m != nil {
+		return m.FpBtcPkList
+	}
+	return nil
+}
+
+func (m *BTCDelegation) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *BTCDelegation) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *BTCDelegation) GetTotalSat() uint64 {
+	if m != nil {
+		return m.TotalSat
+	}
+	return 0
+}
+
+func (m *BTCDelegation) GetStakingTx() []byte {
+	if m != nil {
+		return m.StakingTx
+	}
+	return nil
+}
+
+func (m *BTCDelegation) GetStakingOutputIdx() uint32 {
+	if m != nil {
+		return m.StakingOutputIdx
+	}
+	return 0
+}
+
+func (m *BTCDelegation) GetSlashingTx() []byte {
+	if m != nil {
+		return m.SlashingTx
+	}
+	return nil
+}
+
+func (m *BTCDelegation) GetDelegatorSig() []byte {
+	if m != nil {
+		return m.DelegatorSig
+	}
+	return nil
+}
+
+func (m *BTCDelegation) GetCovenantSigs() []*CovenantAdaptorSignatures {
+	if m != nil {
+		return m.CovenantSigs
+	}
+	return nil
+}
+
+func (m *BTCDelegation) GetUnbondingTime() uint32 {
+	if m != nil {
+		return m.UnbondingTime
+	}
+	return 0
+}
+
+func (m *BTCDelegation) GetBtcUndelegation() *BTCUndelegation {
+	if m != nil {
+		return m.BtcUndelegation
+	}
+	return nil
+}
+
+func (m *BTCDelegation) GetParamsVersion() uint32 {
+	if m != nil {
+		return m.ParamsVersion
+	}
+	return 0
+}
+
+// ProofOfPossession is a proof of possession as stored at consensus version 1
+type ProofOfPossession struct {
+	// btc_sig_type indicates the type of btc_sig in the pop
+	BtcSigType int32 `protobuf:"varint,1,opt,name=btc_sig_type,json=btcSigType,proto3" json:"btc_sig_type,omitempty"`
+	// babylon_sig is the signature generated via sign(sk_babylon, pk_btc)
+	BabylonSig []byte `protobuf:"bytes,2,opt,name=babylon_sig,json=babylonSig,proto3" json:"babylon_sig,omitempty"`
+	// btc_sig is the signature generated via sign(sk_btc, babylon_sig)
+	BtcSig []byte `protobuf:"bytes,3,opt,name=btc_sig,json=btcSig,proto3" json:"btc_sig,omitempty"`
+}
+
+func (m *ProofOfPossession) Reset()         { *m = ProofOfPossession{} }
+func (m *ProofOfPossession) String() string { return proto.CompactTextString(m) }
+func (*ProofOfPossession) ProtoMessage()    {}
+func (*ProofOfPossession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_301d6d4b1d81cc8c, []int{1}
+}
+func (m *ProofOfPossession) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProofOfPossession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProofOfPossession.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProofOfPossession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofOfPossession.Merge(m, src)
+}
+func (m *ProofOfPossession) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProofOfPossession) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofOfPossession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProofOfPossession proto.InternalMessageInfo
+
+func (m *ProofOfPossession) GetBtcSigType() int32 {
+	if m != nil {
+		return m.BtcSigType
+	}
+	return 0
+}
+
+func (m *ProofOfPossession) GetBabylonSig() []byte {
+	if m != nil {
+		return m.BabylonSig
+	}
+	return nil
+}
+
+func (m *ProofOfPossession) GetBtcSig() []byte {
+	if m != nil {
+		return m.BtcSig
+	}
+	return nil
+}
+
+// BTCUndelegation is the early unbonding path of a BTC delegation as stored at
+// consensus version 1
+type BTCUndelegation struct {
+	// unbonding_tx is the unbonding tx
+	UnbondingTx []byte `protobuf:"bytes,1,opt,name=unbonding_tx,json=unbondingTx,proto3" json:"unbonding_tx,omitempty"`
+	// slashing_tx is the slashing tx of the unbonding tx
+	SlashingTx []byte `protobuf:"bytes,2,opt,name=slashing_tx,json=slashingTx,proto3" json:"slashing_tx,omitempty"`
+	// delegator_unbonding_sig is the staker's signature on the unbonding tx
+	DelegatorUnbondingSig []byte `protobuf:"bytes,3,opt,name=delegator_unbonding_sig,json=delegatorUnbondingSig,proto3" json:"delegator_unbonding_sig,omitempty"`
+	// delegator_slashing_sig is the staker's signature on the slashing tx of
+	// the unbonding tx
+	DelegatorSlashingSig []byte `protobuf:"bytes,4,opt,name=delegator_slashing_sig,json=delegatorSlashingSig,proto3" json:"delegator_slashing_sig,omitempty"`
+	// covenant_slashing_sigs is a list of adaptor signatures on the slashing
+	// tx of the unbonding tx by each covenant member
+	CovenantSlashingSigs []*CovenantAdaptorSignatures `protobuf:"bytes,5,rep,name=covenant_slashing_sigs,json=covenantSlashingSigs,proto3" json:"covenant_slashing_sigs,omitempty"`
+	// covenant_unbonding_sig_list is the list of signatures on the unbonding
+	// tx by covenant members
+	CovenantUnbondingSigList []*SignatureInfo `protobuf:"bytes,6,rep,name=covenant_unbonding_sig_list,json=covenantUnbondingSigList,proto3" json:"covenant_unbonding_sig_list,omitempty"`
+}
+
+func (m *BTCUndelegation) Reset()         { *m = BTCUndelegation{} }
+func (m *BTCUndelegation) String() string { return proto.CompactTextString(m) }
+func (*BTCUndelegation) ProtoMessage()    {}
+func (*BTCUndelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_301d6d4b1d81cc8c, []int{2}
+}
+func (m *BTCUndelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCUndelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCUndelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCUndelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCUndelegation.Merge(m, src)
+}
+func (m *BTCUndelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCUndelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCUndelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCUndelegation proto.InternalMessageInfo
+
+func (m *BTCUndelegation) GetUnbondingTx() []byte {
+	if m != nil {
+		return m.UnbondingTx
+	}
+	return nil
+}
+
+func (m *BTCUndelegation) GetSlashingTx() []byte {
+	if m != nil {
+		return m.SlashingTx
+	}
+	return nil
+}
+
+func (m *BTCUndelegation) GetDelegatorUnbondingSig() []byte {
+	if m != nil {
+		return m.DelegatorUnbondingSig
+	}
+	return nil
+}
+
+func (m *BTCUndelegation) GetDelegatorSlashingSig() []byte {
+	if m != nil {
+		return m.DelegatorSlashingSig
+	}
+	return nil
+}
+
+func (m *BTCUndelegation) GetCovenantSlashingSigs() []*CovenantAdaptorSignatures {
+	if m != nil {
+		return m.CovenantSlashingSigs
+	}
+	return nil
+}
+
+func (m *BTCUndelegation) GetCovenantUnbondingSigList() []*SignatureInfo {
+	if m != nil {
+		return m.CovenantUnbondingSigList
+	}
+	return nil
+}
+
+// CovenantAdaptorSignatures is a covenant member's adaptor signatures as
+// stored at consensus version 1
+type CovenantAdaptorSignatures struct {
+	// cov_pk is the BIP-340 PK of the covenant member
+	CovPk []byte `protobuf:"bytes,1,opt,name=cov_pk,json=covPk,proto3" json:"cov_pk,omitempty"`
+	// adaptor_sigs is a list of adaptor signatures, each encrypted by a
+	// restaked finality provider's PK
+	AdaptorSigs [][]byte `protobuf:"bytes,2,rep,name=adaptor_sigs,json=adaptorSigs,proto3" json:"adaptor_sigs,omitempty"`
+}
+
+func (m *CovenantAdaptorSignatures) Reset()         { *m = CovenantAdaptorSignatures{} }
+func (m *CovenantAdaptorSignatures) String() string { return proto.CompactTextString(m) }
+func (*CovenantAdaptorSignatures) ProtoMessage()    {}
+func (*CovenantAdaptorSignatures) Descriptor() ([]byte, []int) {
+	return fileDescriptor_301d6d4b1d81cc8c, []int{3}
+}
+func (m *CovenantAdaptorSignatures) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CovenantAdaptorSignatures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CovenantAdaptorSignatures.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CovenantAdaptorSignatures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CovenantAdaptorSignatures.Merge(m, src)
+}
+func (m *CovenantAdaptorSignatures) XXX_Size() int {
+	return m.Size()
+}
+func (m *CovenantAdaptorSignatures) XXX_DiscardUnknown() {
+	xxx_messageInfo_CovenantAdaptorSignatures.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CovenantAdaptorSignatures proto.InternalMessageInfo
+
+func (m *CovenantAdaptorSignatures) GetCovPk() []byte {
+	if m != nil {
+		return m.CovPk
+	}
+	return nil
+}
+
+func (m *CovenantAdaptorSignatures) GetAdaptorSigs() [][]byte {
+	if m != nil {
+		return m.AdaptorSigs
+	}
+	return nil
+}
+
+// SignatureInfo is a BIP-340 signature together with its signer as stored at
+// consensus version 1
+type SignatureInfo struct {
+	// pk is the BIP-340 PK of the signer
+	Pk []byte `protobuf:"bytes,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	// sig is the BIP-340 signature
+	Sig []byte `protobuf:"bytes,2,opt,name=sig,proto3" json:"sig,omitempty"`
+}
+
+func (m *SignatureInfo) Reset()         { *m = SignatureInfo{} }
+func (m *SignatureInfo) String() string { return proto.CompactTextString(m) }
+func (*SignatureInfo) ProtoMessage()    {}
+func (*SignatureInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_301d6d4b1d81cc8c, []int{4}
+}
+func (m *SignatureInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignatureInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignatureInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignatureInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignatureInfo.Merge(m, src)
+}
+func (m *SignatureInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignatureInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignatureInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignatureInfo proto.InternalMessageInfo
+
+func (m *SignatureInfo) GetPk() []byte {
+	if m != nil {
+		return m.Pk
+	}
+	return nil
+}
+
+func (m *SignatureInfo) GetSig() []byte {
+	if m != nil {
+		return m.Sig
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*BTCDelegation)(nil), "babylon.btcstaking.migrations.v1.BTCDelegation")
+	proto.RegisterType((*ProofOfPossession)(nil), "babylon.btcstaking.migrations.v1.ProofOfPossession")
+	proto.RegisterType((*BTCUndelegation)(nil), "babylon.btcstaking.migrations.v1.BTCUndelegation")
+	proto.RegisterType((*CovenantAdaptorSignatures)(nil), "babylon.btcstaking.migrations.v1.CovenantAdaptorSignatures")
+	proto.RegisterType((*SignatureInfo)(nil), "babylon.btcstaking.migrations.v1.SignatureInfo")
+}
+
+func init() {
+	proto.RegisterFile("babylon/btcstaking/migrations/v1/btcstaking.proto", fileDescriptor_301d6d4b1d81cc8c)
+}
+
+var fileDescriptor_301d6d4b1d81cc8c = []byte{
+	// 772 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6e, 0xf3, 0x44,
+	0x14, 0xad, 0x93, 0x26, 0xdf, 0x97, 0x1b, 0x27, 0x2d, 0xa3, 0xef, 0x6b, 0x0d, 0x15, 0xa9, 0x49,
+	0x85, 0x94, 0x05, 0xb2, 0x95, 0x16, 0xba, 0x00, 0x09, 0x89, 0x14, 0x24, 0x2a, 0x90, 0x1a, 0x39,
+	0x29, 0x0b, 0x84, 0x64, 0xc6, 0xf6, 0xc4, 0x19, 0x39, 0xf1, 0x18, 0xcf, 0x24, 0x4a, 0xde, 0x82,
+	0xa7, 0xe0, 0x59, 0x58, 0x56, 0xac, 0x58, 0xa2, 0xf6, 0x45, 0xd0, 0x8c, 0xff, 0x52, 0xaa, 0x2a,
+	0x0b, 0x76, 0xed, 0x3d, 0x73, 0xce, 0x3d, 0x73, 0xef, 0xf1, 0x04, 0x86, 0x1e, 0xf6, 0xb6, 0x0b,
+	0x16, 0xdb, 0x9e, 0xf0, 0xb9, 0xc0, 0x11, 0x8d, 0x43, 0x7b, 0x49, 0xc3, 0x14, 0x0b, 0xca, 0x62,
+	0x6e, 0xaf, 0x87, 0x3b, 0x80, 0x95, 0xa4, 0x4c, 0x30, 0x64, 0xe6, 0x14, 0x6b, 0x07, 0xa9, 0x28,
+	0xd6, 0x7a, 0xf8, 0x51, 0xdf, 0x67, 0x7c, 0xc9, 0xb8, 0xed, 0xa7, 0xdb, 0x44, 0x30, 0x9b, 0x13,
+	0x3f, 0xb9, 0xfc, 0xe2, 0x3a, 0x1a, 0xda, 0x11, 0xd9, 0xf2, 0x4c, 0xa5, 0xff, 0x57, 0x03, 0x3a,
+	0xa3, 0xe9, 0xcd, 0xb7, 0x64, 0x41, 0x42, 0xc5, 0x44, 0x5f, 0x03, 0xe4, 0xca, 0x6e, 0x12, 0x19,
+	0x9a, 0xa9, 0x0d, 0xda, 0x97, 0xe7, 0x56, 0x26, 0x65, 0x65, 0x52, 0x56, 0x29, 0x65, 0x8d, 0x57,
+	0xde, 0x0f, 0x64, 0xeb, 0xb4, 0x72, 0xca, 0x38, 0x42, 0xef, 0xa1, 0xe9, 0x09, 0x5f, 0x72, 0x6b,
+	0xa6, 0x36, 0xd0, 0x9d, 0x86, 0x27, 0xfc, 0x71, 0x84, 0xbe, 0x83, 0x7a, 0xc2, 0x12, 0xa3, 0xae,
+	0xf4, 0xae, 0xac, 0x7d, 0xe6, 0xad, 0x71, 0xca, 0xd8, 0xec, 0x6e, 0x36, 0x66, 0x9c, 0x13, 0xce,
+	0x29, 0x8b, 0x1d, 0xc9, 0x47, 0x17, 0xd0, 0x9d, 0x25, 0x6e, 0xd6, 0xc0, 0x5d, 0x50, 0x2e, 0x8c,
+	0x43, 0xb3, 0x3e, 0xd0, 0x9d, 0xf6, 0x2c, 0x19, 0xc9, 0x3e, 0x3f, 0x52, 0x2e, 0xd0, 0x27, 0xa0,
+	0x73, 0x81, 0x53, 0xe1, 0xce, 0x09, 0x0d, 0xe7, 0xc2, 0x68, 0x98, 0xda, 0xe0, 0xd0, 0x69, 0xab,
+	0xda, 0xf7, 0xaa, 0x84, 0x3e, 0x06, 0x20, 0x71, 0x50, 0x1c, 0x68, 0xaa, 0x03, 0x2d, 0x12, 0x07,
+	0x39, 0x7c, 0x06, 0x2d, 0xc1, 0x04, 0x5e, 0xb8, 0x1c, 0x0b, 0xe3, 0x8d, 0x42, 0xdf, 0xaa, 0xc2,
+	0x04, 0x2b, 0x6e, 0xee, 0xd9, 0x15, 0x1b, 0xe3, 0xad, 0xba, 0x65, 0x2b, 0xaf, 0x4c, 0x37, 0xe8,
+	0x33, 0x40, 0x05, 0xcc, 0x56, 0x22, 0x59, 0x09, 0x97, 0x06, 0x1b, 0xa3, 0x65, 0x6a, 0x83, 0x8e,
+	0x73, 0x9c, 0x23, 0x77, 0x0a, 0xb8, 0x0d, 0x36, 0xe8, 0x1c, 0xda, 0x7c, 0x81, 0xf9, 0x3c, 0x57,
+	0x03, 0xa5, 0x06, 0x45, 0x69, 0xba, 0x41, 0x17, 0xd0, 0x09, 0xb2, 0xed, 0xb0, 0xd4, 0xe5, 0x34,
+	0x34, 0xda, 0xea, 0x88, 0x5e, 0x16, 0x27, 0x34, 0x44, 0xbf, 0x42, 0xc7, 0x67, 0x6b, 0x12, 0xe3,
+	0x58, 0xc8, 0x33, 0xdc, 0xd0, 0xcd, 0xfa, 0xa0, 0x7d, 0xf9, 0xd5, 0xfe, 0x39, 0xdf, 0xe4, 0xb4,
+	0x6f, 0x02, 0x9c, 0x64, 0x62, 0x31, 0x16, 0xab, 0x94, 0x70, 0x47, 0x2f, 0x14, 0x27, 0x34, 0xe4,
+	0xe8, 0x53, 0xe8, 0xae, 0x62, 0x8f, 0xc5, 0x81, 0x32, 0x4a, 0x97, 0xc4, 0xe8, 0xa8, 0x1b, 0x75,
+	0xca, 0xea, 0x94, 0x2e, 0x09, 0xfa, 0x05, 0x8e, 0xe5, 0x72, 0x56, 0x71, 0x50, 0x26, 0xca, 0xe8,
+	0xaa, 0x9d, 0x0f, 0xf7, 0x7b, 0x19, 0x4d, 0x6f, 0xee, 0x77, 0x88, 0xce, 0x91, 0x27, 0xfc, 0xdd,
+	0x82, 0x34, 0x91, 0xe0, 0x14, 0x2f, 0xb9, 0xbb, 0x26, 0xa9, 0x0c, 0x85, 0x71, 0x94, 0x99, 0xc8,
+	0xaa, 0x3f, 0x65, 0xc5, 0x3e, 0x83, 0x0f, 0x5e, 0xc4, 0x07, 0x99, 0xa0, 0x4b, 0x67, 0x9c, 0x86,
+	0xae, 0xd8, 0x26, 0x44, 0x25, 0xbb, 0xe1, 0x80, 0x27, 0xfc, 0x09, 0x0d, 0xa7, 0xdb, 0x84, 0xc8,
+	0x55, 0x14, 0xc9, 0x97, 0x73, 0xce, 0xe2, 0x5b, 0x7c, 0x0c, 0x72, 0xca, 0xa7, 0xf0, 0x26, 0x97,
+	0x50, 0x39, 0xd6, 0x9d, 0x66, 0xc6, 0xee, 0xff, 0x51, 0x87, 0xa3, 0xff, 0x98, 0x97, 0x21, 0xdc,
+	0x19, 0xd8, 0x46, 0xf5, 0xd3, 0x9d, 0x76, 0x35, 0xae, 0x17, 0xbb, 0xaf, 0xbd, 0xd8, 0xfd, 0x35,
+	0x9c, 0x56, 0xbb, 0xaf, 0xd4, 0x2a, 0x03, 0xef, 0x4b, 0xf8, 0xbe, 0x40, 0xa5, 0xd1, 0xcf, 0xe1,
+	0xa4, 0xe2, 0x95, 0x2d, 0x24, 0xed, 0x50, 0xd1, 0xde, 0x55, 0xe1, 0xc9, 0x41, 0xc9, 0xfa, 0x0d,
+	0x4e, 0xaa, 0x10, 0xed, 0x90, 0xb8, 0xd1, 0xf8, 0xff, 0x69, 0x7a, 0x57, 0xa6, 0xa9, 0xea, 0xc8,
+	0x51, 0x0c, 0x67, 0x65, 0xcb, 0x67, 0xf7, 0xcb, 0xbe, 0xed, 0xa6, 0xea, 0x6b, 0xef, 0xef, 0x5b,
+	0x36, 0xba, 0x8d, 0x67, 0xcc, 0x31, 0x0a, 0xcd, 0xdd, 0xa1, 0xc8, 0x97, 0xa1, 0x7f, 0x0f, 0x1f,
+	0xbe, 0x6a, 0x51, 0xbe, 0x5c, 0x3e, 0x5b, 0x17, 0xaf, 0x9e, 0xee, 0x34, 0x7c, 0xb6, 0x1e, 0x47,
+	0x72, 0x91, 0x38, 0x3b, 0x9b, 0x0d, 0xa3, 0x96, 0x3d, 0x38, 0xb8, 0xe4, 0xf3, 0xfe, 0x10, 0x3a,
+	0xcf, 0x1c, 0xa0, 0x2e, 0xd4, 0x4a, 0x99, 0x5a, 0x12, 0xa1, 0x63, 0xa8, 0x57, 0x91, 0x92, 0x7f,
+	0x8e, 0xa6, 0x7f, 0x3e, 0xf6, 0xb4, 0x87, 0xc7, 0x9e, 0xf6, 0xcf, 0x63, 0x4f, 0xfb, 0xfd, 0xa9,
+	0x77, 0xf0, 0xf0, 0xd4, 0x3b, 0xf8, 0xfb, 0xa9, 0x77, 0xf0, 0xf3, 0x97, 0x21, 0x15, 0xf3, 0x95,
+	0x67, 0xf9, 0x6c, 0x69, 0xe7, 0x17, 0xf7, 0xe7, 0x98, 0xc6, 0xc5, 0x3f, 0xf6, 0xe6, 0xd5, 0x5f,
+	0x09, 0xaf, 0xa9, 0x5e, 0xf5, 0xab, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x39, 0xf3, 0x1c, 0x06,
+	0x50, 0x06, 0x00, 0x00,
+}
+
+func (m *BTCDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ParamsVersion != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.BtcUndelegation != nil {
+		{
+			size, err := m.BtcUndelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.UnbondingTime != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.UnbondingTime))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.CovenantSigs) > 0 {
+		for iNdEx := len(m.CovenantSigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.DelegatorSig) > 0 {
+		i -= len(m.DelegatorSig)
+		copy(dAtA[i:], m.DelegatorSig)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.DelegatorSig)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.SlashingTx) > 0 {
+		i -= len(m.SlashingTx)
+		copy(dAtA[i:], m.SlashingTx)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.SlashingTx)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.StakingOutputIdx != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.StakingOutputIdx))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.StakingTx) > 0 {
+		i -= len(m.StakingTx)
+		copy(dAtA[i:], m.StakingTx)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.StakingTx)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TotalSat != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.TotalSat))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FpBtcPkList[iNdEx])
+			copy(dAtA[i:], m.FpBtcPkList[iNdEx])
+			i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.FpBtcPkList[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Pop != nil {
+		{
+			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BtcPk) > 0 {
+		i -= len(m.BtcPk)
+		copy(dAtA[i:], m.BtcPk)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.BtcPk)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BabylonPk != nil {
+		{
+			size, err := m.BabylonPk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProofOfPossession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofOfPossession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofOfPossession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BtcSig) > 0 {
+		i -= len(m.BtcSig)
+		copy(dAtA[i:], m.BtcSig)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.BtcSig)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BabylonSig) > 0 {
+		i -= len(m.BabylonSig)
+		copy(dAtA[i:], m.BabylonSig)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.BabylonSig)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BtcSigType != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.BtcSigType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCUndelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCUndelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCUndelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CovenantUnbondingSigList) > 0 {
+		for iNdEx := len(m.CovenantUnbondingSigList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantUnbondingSigList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CovenantSlashingSigs) > 0 {
+		for iNdEx := len(m.CovenantSlashingSigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantSlashingSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DelegatorSlashingSig) > 0 {
+		i -= len(m.DelegatorSlashingSig)
+		copy(dAtA[i:], m.DelegatorSlashingSig)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.DelegatorSlashingSig)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DelegatorUnbondingSig) > 0 {
+		i -= len(m.DelegatorUnbondingSig)
+		copy(dAtA[i:], m.DelegatorUnbondingSig)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.DelegatorUnbondingSig)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SlashingTx) > 0 {
+		i -= len(m.SlashingTx)
+		copy(dAtA[i:], m.SlashingTx)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.SlashingTx)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UnbondingTx) > 0 {
+		i -= len(m.UnbondingTx)
+		copy(dAtA[i:], m.UnbondingTx)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.UnbondingTx)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CovenantAdaptorSignatures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CovenantAdaptorSignatures) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CovenantAdaptorSignatures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AdaptorSigs) > 0 {
+		for iNdEx := len(m.AdaptorSigs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AdaptorSigs[iNdEx])
+			copy(dAtA[i:], m.AdaptorSigs[iNdEx])
+			i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.AdaptorSigs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CovPk) > 0 {
+		i -= len(m.CovPk)
+		copy(dAtA[i:], m.CovPk)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.CovPk)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignatureInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignatureInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignatureInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sig) > 0 {
+		i -= len(m.Sig)
+		copy(dAtA[i:], m.Sig)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.Sig)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pk) > 0 {
+		i -= len(m.Pk)
+		copy(dAtA[i:], m.Pk)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.Pk)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBtcstaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBtcstaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BTCDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BabylonPk != nil {
+		l = m.BabylonPk.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.BtcPk)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.Pop != nil {
+		l = m.Pop.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for _, b := range m.FpBtcPkList {
+			l = len(b)
+			n += 1 + l + sovBtcstaking(uint64(l))
+		}
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovBtcstaking(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovBtcstaking(uint64(m.EndHeight))
+	}
+	if m.TotalSat != 0 {
+		n += 1 + sovBtcstaking(uint64(m.TotalSat))
+	}
+	l = len(m.StakingTx)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.StakingOutputIdx != 0 {
+		n += 1 + sovBtcstaking(uint64(m.StakingOutputIdx))
+	}
+	l = len(m.SlashingTx)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.DelegatorSig)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if len(m.CovenantSigs) > 0 {
+		for _, e := range m.CovenantSigs {
+			l = e.Size()
+			n += 1 + l + sovBtcstaking(uint64(l))
+		}
+	}
+	if m.UnbondingTime != 0 {
+		n += 1 + sovBtcstaking(uint64(m.UnbondingTime))
+	}
+	if m.BtcUndelegation != nil {
+		l = m.BtcUndelegation.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.ParamsVersion != 0 {
+		n += 1 + sovBtcstaking(uint64(m.ParamsVersion))
+	}
+	return n
+}
+
+func (m *ProofOfPossession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcSigType != 0 {
+		n += 1 + sovBtcstaking(uint64(m.BtcSigType))
+	}
+	l = len(m.BabylonSig)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.BtcSig)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCUndelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UnbondingTx)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.SlashingTx)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.DelegatorUnbondingSig)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.DelegatorSlashingSig)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if len(m.CovenantSlashingSigs) > 0 {
+		for _, e := range m.CovenantSlashingSigs {
+			l = e.Size()
+			n += 1 + l + sovBtcstaking(uint64(l))
+		}
+	}
+	if len(m.CovenantUnbondingSigList) > 0 {
+		for _, e := range m.CovenantUnbondingSigList {
+			l = e.Size()
+			n += 1 + l + sovBtcstaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CovenantAdaptorSignatures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CovPk)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if len(m.AdaptorSigs) > 0 {
+		for _, b := range m.AdaptorSigs {
+			l = len(b)
+			n += 1 + l + sovBtcstaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SignatureInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pk)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.Sig)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	return n
+}
+
+func sovBtcstaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBtcstaking(x uint64) (n int) {
+	return sovBtcstaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonPk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BabylonPk == nil {
+				m.BabylonPk = &secp256k1.PubKey{}
+			}
+			if err := m.BabylonPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcPk = append(m.BtcPk[:0], dAtA[iNdEx:postIndex]...)
+			if m.BtcPk == nil {
+				m.BtcPk = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pop == nil {
+				m.Pop = &ProofOfPossession{}
+			}
+			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkList = append(m.FpBtcPkList, make([]byte, postIndex-iNdEx))
+			copy(m.FpBtcPkList[len(m.FpBtcPkList)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
+			}
+			m.TotalSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTx = append(m.StakingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.StakingTx == nil {
+				m.StakingTx = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputIdx", wireType)
+			}
+			m.StakingOutputIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingOutputIdx |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingTx = append(m.SlashingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.SlashingTx == nil {
+				m.SlashingTx = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorSig = append(m.DelegatorSig[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorSig == nil {
+				m.DelegatorSig = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantSigs = append(m.CovenantSigs, &CovenantAdaptorSignatures{})
+			if err := m.CovenantSigs[len(m.CovenantSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			m.UnbondingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcUndelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BtcUndelegation == nil {
+				m.BtcUndelegation = &BTCUndelegation{}
+			}
+			if err := m.BtcUndelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+			}
+			m.ParamsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParamsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProofOfPossession) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProofOfPossession: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProofOfPossession: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcSigType", wireType)
+			}
+			m.BtcSigType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcSigType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BabylonSig = append(m.BabylonSig[:0], dAtA[iNdEx:postIndex]...)
+			if m.BabylonSig == nil {
+				m.BabylonSig = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcSig = append(m.BtcSig[:0], dAtA[iNdEx:postIndex]...)
+			if m.BtcSig == nil {
+				m.BtcSig = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCUndelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCUndelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCUndelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingTx = append(m.UnbondingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.UnbondingTx == nil {
+				m.UnbondingTx = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingTx = append(m.SlashingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.SlashingTx == nil {
+				m.SlashingTx = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorUnbondingSig = append(m.DelegatorUnbondingSig[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorUnbondingSig == nil {
+				m.DelegatorUnbondingSig = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorSlashingSig = append(m.DelegatorSlashingSig[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorSlashingSig == nil {
+				m.DelegatorSlashingSig = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSlashingSigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantSlashingSigs = append(m.CovenantSlashingSigs, &CovenantAdaptorSignatures{})
+			if err := m.CovenantSlashingSigs[len(m.CovenantSlashingSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantUnbondingSigList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantUnbondingSigList = append(m.CovenantUnbondingSigList, &SignatureInfo{})
+			if err := m.CovenantUnbondingSigList[len(m.CovenantUnbondingSigList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CovenantAdaptorSignatures) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CovenantAdaptorSignatures: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CovenantAdaptorSignatures: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovPk = append(m.CovPk[:0], dAtA[iNdEx:postIndex]...)
+			if m.CovPk == nil {
+				m.CovPk = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptorSigs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdaptorSigs = append(m.AdaptorSigs, make([]byte, postIndex-iNdEx))
+			copy(m.AdaptorSigs[len(m.AdaptorSigs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignatureInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignatureInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignatureInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pk = append(m.Pk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Pk == nil {
+				m.Pk = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sig = append(m.Sig[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sig == nil {
+				m.Sig = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBtcstaking(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBtcstaking
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBtcstaking
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBtcstaking
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBtcstaking        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBtcstaking          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBtcstaking = fmt.Errorf("proto: unexpected end of group")
+)
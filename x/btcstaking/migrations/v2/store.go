@@ -0,0 +1,150 @@
+package v2
+
+import (
+	"bytes"
+	"fmt"
+
+	corestoretypes "cosmossdk.io/core/store"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	bbn "github.com/babylonchain/babylon/types"
+	v1 "github.com/babylonchain/babylon/x/btcstaking/migrations/v1"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The
+// migration decodes each stored BTC delegation with the frozen v1 schema in
+// the migrations/v1 package, and rewrites it with the v2 schema of
+// BTCDelegation. BTC delegations in v1 are all built with the first script
+// template, which is recorded explicitly. The other fields added in v2 take
+// their zero values, i.e., no slashing rate, slashing address or activation
+// height is recorded, so that they are derived from the params version of the
+// BTC delegation as before.
+// The migration fails upon any BTC delegation that cannot be decoded with the
+// v1 schema, or whose key does not match its staking tx hash.
+func MigrateStore(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	storeAdapter := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.BTCDelegationKey)
+
+	// collect all rewritten BTC delegations before writing them back, as the
+	// store cannot be written while being iterated
+	var keys, values [][]byte
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		var legacyDel v1.BTCDelegation
+		if err := cdc.Unmarshal(iter.Value(), &legacyDel); err != nil {
+			iter.Close()
+			return fmt.Errorf("failed to decode v1 BTC delegation %x: %w", iter.Key(), err)
+		}
+		btcDel := convertBTCDelegation(&legacyDel)
+		stakingTxHash, err := btcDel.GetStakingTxHash()
+		if err != nil {
+			iter.Close()
+			return fmt.Errorf("failed to get staking tx hash of BTC delegation %x: %w", iter.Key(), err)
+		}
+		if !bytes.Equal(stakingTxHash[:], iter.Key()) {
+			iter.Close()
+			return fmt.Errorf("BTC delegation %x is stored under a different staking tx hash %s", iter.Key(), stakingTxHash)
+		}
+		keys = append(keys, iter.Key())
+		values = append(values, cdc.MustMarshal(btcDel))
+	}
+	iter.Close()
+
+	for i := range keys {
+		store.Set(keys[i], values[i])
+	}
+
+	return nil
+}
+
+// convertBTCDelegation converts the given v1 BTC delegation to a v2 one
+func convertBTCDelegation(d *v1.BTCDelegation) *types.BTCDelegation {
+	btcDel := &types.BTCDelegation{
+		BabylonPk:             d.BabylonPk,
+		BtcPk:                 toBIP340PubKey(d.BtcPk),
+		FpBtcPkList:           make([]bbn.BIP340PubKey, 0, len(d.FpBtcPkList)),
+		StartHeight:           d.StartHeight,
+		EndHeight:             d.EndHeight,
+		TotalSat:              d.TotalSat,
+		StakingTx:             d.StakingTx,
+		StakingOutputIdx:      d.StakingOutputIdx,
+		SlashingTx:            toBTCSlashingTx(d.SlashingTx),
+		DelegatorSig:          toBIP340Signature(d.DelegatorSig),
+		CovenantSigs:          convertCovenantAdaptorSigs(d.CovenantSigs),
+		UnbondingTime:         d.UnbondingTime,
+		ParamsVersion:         d.ParamsVersion,
+		ScriptTemplateVersion: btcstaking.ScriptTemplateV1,
+	}
+	for _, fpPK := range d.FpBtcPkList {
+		btcDel.FpBtcPkList = append(btcDel.FpBtcPkList, bbn.BIP340PubKey(fpPK))
+	}
+	if d.Pop != nil {
+		btcDel.Pop = &types.ProofOfPossession{
+			BtcSigType: types.BTCSigType(d.Pop.BtcSigType),
+			BabylonSig: d.Pop.BabylonSig,
+			BtcSig:     d.Pop.BtcSig,
+			// v1 proofs of possession all sign the BTC PK itself
+			BabylonSigType: types.BabylonSigType_RAW,
+		}
+	}
+	if ud := d.BtcUndelegation; ud != nil {
+		btcDel.BtcUndelegation = &types.BTCUndelegation{
+			UnbondingTx:           ud.UnbondingTx,
+			SlashingTx:            toBTCSlashingTx(ud.SlashingTx),
+			DelegatorUnbondingSig: toBIP340Signature(ud.DelegatorUnbondingSig),
+			DelegatorSlashingSig:  toBIP340Signature(ud.DelegatorSlashingSig),
+			CovenantSlashingSigs:  convertCovenantAdaptorSigs(ud.CovenantSlashingSigs),
+		}
+		for _, sigInfo := range ud.CovenantUnbondingSigList {
+			btcDel.BtcUndelegation.CovenantUnbondingSigList = append(btcDel.BtcUndelegation.CovenantUnbondingSigList, &types.SignatureInfo{
+				Pk:  toBIP340PubKey(sigInfo.Pk),
+				Sig: toBIP340Signature(sigInfo.Sig),
+			})
+		}
+	}
+
+	return btcDel
+}
+
+func convertCovenantAdaptorSigs(sigs []*v1.CovenantAdaptorSignatures) []*types.CovenantAdaptorSignatures {
+	if len(sigs) == 0 {
+		return nil
+	}
+	converted := make([]*types.CovenantAdaptorSignatures, 0, len(sigs))
+	for _, sig := range sigs {
+		converted = append(converted, &types.CovenantAdaptorSignatures{
+			CovPk:       toBIP340PubKey(sig.CovPk),
+			AdaptorSigs: sig.AdaptorSigs,
+		})
+	}
+	return converted
+}
+
+func toBIP340PubKey(bz []byte) *bbn.BIP340PubKey {
+	if len(bz) == 0 {
+		return nil
+	}
+	pk := bbn.BIP340PubKey(bz)
+	return &pk
+}
+
+func toBIP340Signature(bz []byte) *bbn.BIP340Signature {
+	if len(bz) == 0 {
+		return nil
+	}
+	sig := bbn.BIP340Signature(bz)
+	return &sig
+}
+
+func toBTCSlashingTx(bz []byte) *types.BTCSlashingTx {
+	if len(bz) == 0 {
+		return nil
+	}
+	tx := types.BTCSlashingTx(bz)
+	return &tx
+}
@@ -0,0 +1,148 @@
+package v2_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/btcsuite/btcd/chaincfg"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	v1 "github.com/babylonchain/babylon/x/btcstaking/migrations/v1"
+	v2 "github.com/babylonchain/babylon/x/btcstaking/migrations/v2"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+func FuzzMigrateStore(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+		db := dbm.NewMemDB()
+		stateStore := store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
+		stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+		require.NoError(t, stateStore.LoadLatestVersion())
+		ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
+		storeService := runtime.NewKVStoreService(storeKey)
+		cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+		btcDelStore := prefix.NewStore(runtime.KVStoreAdapter(storeService.OpenKVStore(ctx)), types.BTCDelegationKey)
+
+		// BTC delegations stored with the v1 schema
+		_, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		covenantSKs, _, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, &chaincfg.SimNetParams)
+		require.NoError(t, err)
+		numBTCDels := int(datagen.RandomInt(r, 5)) + 1
+		btcDels := make([]*types.BTCDelegation, 0, numBTCDels)
+		for i := 0; i < numBTCDels; i++ {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				[]bbn.BIP340PubKey{*bbn.NewBIP340PubKeyFromBTCPK(fpPK)},
+				delSK,
+				covenantSKs,
+				covenantQuorum,
+				slashingAddress.EncodeAddress(),
+				1000,
+				1000+datagen.RandomInt(r, 1000)+10,
+				10000,
+				sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2),
+				uint16(101),
+			)
+			require.NoError(t, err)
+			// fields that do not exist in the v1 schema are not recorded
+			btcDel.SlashingRate = sdkmath.LegacyDec{}
+			btcDel.SlashingAddress = ""
+			stakingTxHash := btcDel.MustGetStakingTxHash()
+			btcDelStore.Set(stakingTxHash[:], cdc.MustMarshal(toV1BTCDelegation(btcDel)))
+
+			// the v2 BTC delegation records the script template of v1
+			btcDel.ScriptTemplateVersion = btcstaking.ScriptTemplateV1
+			btcDels = append(btcDels, btcDel)
+		}
+
+		err = v2.MigrateStore(ctx, storeService, cdc)
+		require.NoError(t, err)
+
+		// all BTC delegations are rewritten with the v2 schema
+		for _, btcDel := range btcDels {
+			stakingTxHash := btcDel.MustGetStakingTxHash()
+			require.Equal(t, cdc.MustMarshal(btcDel), btcDelStore.Get(stakingTxHash[:]))
+		}
+
+		// a BTC delegation stored under another staking tx hash fails the
+		// migration
+		btcDelStore.Set(datagen.GenRandomByteArray(r, 32), cdc.MustMarshal(toV1BTCDelegation(btcDels[0])))
+		err = v2.MigrateStore(ctx, storeService, cdc)
+		require.Error(t, err)
+	})
+}
+
+// toV1BTCDelegation encodes the given BTC delegation with the v1 schema
+func toV1BTCDelegation(d *types.BTCDelegation) *v1.BTCDelegation {
+	legacyDel := &v1.BTCDelegation{
+		BabylonPk: d.BabylonPk,
+		BtcPk:     *d.BtcPk,
+		Pop: &v1.ProofOfPossession{
+			BtcSigType: int32(d.Pop.BtcSigType),
+			BabylonSig: d.Pop.BabylonSig,
+			BtcSig:     d.Pop.BtcSig,
+		},
+		StartHeight:      d.StartHeight,
+		EndHeight:        d.EndHeight,
+		TotalSat:         d.TotalSat,
+		StakingTx:        d.StakingTx,
+		StakingOutputIdx: d.StakingOutputIdx,
+		SlashingTx:       *d.SlashingTx,
+		DelegatorSig:     *d.DelegatorSig,
+		CovenantSigs:     toV1CovenantAdaptorSigs(d.CovenantSigs),
+		UnbondingTime:    d.UnbondingTime,
+		ParamsVersion:    d.ParamsVersion,
+		BtcUndelegation: &v1.BTCUndelegation{
+			UnbondingTx:          d.BtcUndelegation.UnbondingTx,
+			SlashingTx:           *d.BtcUndelegation.SlashingTx,
+			DelegatorSlashingSig: *d.BtcUndelegation.DelegatorSlashingSig,
+			CovenantSlashingSigs: toV1CovenantAdaptorSigs(d.BtcUndelegation.CovenantSlashingSigs),
+		},
+	}
+	for _, fpPK := range d.FpBtcPkList {
+		legacyDel.FpBtcPkList = append(legacyDel.FpBtcPkList, fpPK)
+	}
+	for _, sigInfo := range d.BtcUndelegation.CovenantUnbondingSigList {
+		legacyDel.BtcUndelegation.CovenantUnbondingSigList = append(legacyDel.BtcUndelegation.CovenantUnbondingSigList, &v1.SignatureInfo{
+			Pk:  *sigInfo.Pk,
+			Sig: *sigInfo.Sig,
+		})
+	}
+	return legacyDel
+}
+
+func toV1CovenantAdaptorSigs(sigs []*types.CovenantAdaptorSignatures) []*v1.CovenantAdaptorSignatures {
+	legacySigs := make([]*v1.CovenantAdaptorSignatures, 0, len(sigs))
+	for _, sig := range sigs {
+		legacySigs = append(legacySigs, &v1.CovenantAdaptorSignatures{
+			CovPk:       *sig.CovPk,
+			AdaptorSigs: sig.AdaptorSigs,
+		})
+	}
+	return legacySigs
+}
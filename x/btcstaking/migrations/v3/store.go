@@ -0,0 +1,49 @@
+package v3
+
+import (
+	"fmt"
+
+	corestoretypes "cosmossdk.io/core/store"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3. The
+// migration moves the raw BTC txs of each stored BTC delegation, i.e., its
+// staking tx, slashing tx, unbonding tx and unbonding slashing tx, out of
+// the BTC delegation and into the store of BTC delegation txs under the same
+// staking tx hash, so that iterating BTC delegations no longer loads them.
+// The migration fails upon any BTC delegation that cannot be decoded.
+func MigrateStore(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	storeAdapter := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+	btcDelStore := prefix.NewStore(storeAdapter, types.BTCDelegationKey)
+	btcDelTxsStore := prefix.NewStore(storeAdapter, types.BTCDelegationTxsKey)
+
+	// collect all BTC delegations before writing them back, as the store
+	// cannot be written while being iterated
+	var keys [][]byte
+	var btcDels []*types.BTCDelegation
+	iter := btcDelStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		var btcDel types.BTCDelegation
+		if err := cdc.Unmarshal(iter.Value(), &btcDel); err != nil {
+			iter.Close()
+			return fmt.Errorf("failed to decode BTC delegation %x: %w", iter.Key(), err)
+		}
+		keys = append(keys, iter.Key())
+		btcDels = append(btcDels, &btcDel)
+	}
+	iter.Close()
+
+	for i := range keys {
+		btcDelWithoutTxs, btcDelTxs := btcDels[i].SplitTxs()
+		btcDelStore.Set(keys[i], cdc.MustMarshal(btcDelWithoutTxs))
+		btcDelTxsStore.Set(keys[i], cdc.MustMarshal(btcDelTxs))
+	}
+
+	return nil
+}
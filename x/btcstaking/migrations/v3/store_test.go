@@ -0,0 +1,104 @@
+package v3_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/btcsuite/btcd/chaincfg"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	v3 "github.com/babylonchain/babylon/x/btcstaking/migrations/v3"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+func FuzzMigrateStore(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+		db := dbm.NewMemDB()
+		stateStore := store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
+		stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+		require.NoError(t, stateStore.LoadLatestVersion())
+		ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
+		storeService := runtime.NewKVStoreService(storeKey)
+		cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+		storeAdapter := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+		btcDelStore := prefix.NewStore(storeAdapter, types.BTCDelegationKey)
+		btcDelTxsStore := prefix.NewStore(storeAdapter, types.BTCDelegationTxsKey)
+
+		// BTC delegations stored with v2 layout, which embeds the raw BTC txs
+		_, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		covenantSKs, _, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, &chaincfg.SimNetParams)
+		require.NoError(t, err)
+		numBTCDels := int(datagen.RandomInt(r, 5)) + 1
+		btcDels := make([]*types.BTCDelegation, 0, numBTCDels)
+		for i := 0; i < numBTCDels; i++ {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				[]bbn.BIP340PubKey{*bbn.NewBIP340PubKeyFromBTCPK(fpPK)},
+				delSK,
+				covenantSKs,
+				covenantQuorum,
+				slashingAddress.EncodeAddress(),
+				1000,
+				1000+datagen.RandomInt(r, 1000)+10,
+				10000,
+				sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2),
+				uint16(101),
+			)
+			require.NoError(t, err)
+			btcDels = append(btcDels, btcDel)
+
+			stakingTxHash := btcDel.MustGetStakingTxHash()
+			btcDelStore.Set(stakingTxHash[:], cdc.MustMarshal(btcDel))
+		}
+
+		err = v3.MigrateStore(ctx, storeService, cdc)
+		require.NoError(t, err)
+
+		// the raw BTC txs are moved out of each BTC delegation, and attaching
+		// them back recovers the BTC delegation
+		for _, btcDel := range btcDels {
+			stakingTxHash := btcDel.MustGetStakingTxHash()
+			var btcDelWithoutTxs types.BTCDelegation
+			cdc.MustUnmarshal(btcDelStore.Get(stakingTxHash[:]), &btcDelWithoutTxs)
+			require.Nil(t, btcDelWithoutTxs.StakingTx)
+			require.Nil(t, btcDelWithoutTxs.SlashingTx)
+			require.Nil(t, btcDelWithoutTxs.BtcUndelegation.UnbondingTx)
+			require.Nil(t, btcDelWithoutTxs.BtcUndelegation.SlashingTx)
+
+			var btcDelTxs types.BTCDelegationTxs
+			cdc.MustUnmarshal(btcDelTxsStore.Get(stakingTxHash[:]), &btcDelTxs)
+			btcDelWithoutTxs.AttachTxs(&btcDelTxs)
+			require.Equal(t, cdc.MustMarshal(btcDel), cdc.MustMarshal(&btcDelWithoutTxs))
+		}
+
+		// a BTC delegation that cannot be decoded fails the migration, e.g.,
+		// one with a length-delimited field longer than the remaining bytes
+		btcDelStore.Set(datagen.GenRandomByteArray(r, 32), []byte{0x0a, 0xff})
+		err = v3.MigrateStore(ctx, storeService, cdc)
+		require.Error(t, err)
+	})
+}
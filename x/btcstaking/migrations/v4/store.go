@@ -0,0 +1,117 @@
+package v4
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	corestoretypes "cosmossdk.io/core/store"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// MigrateStore performs in-place store migrations from v3 to v4. As v3 only
+// indexes BTC delegations upon their creation, the migration indexes the BTC
+// delegations created before the upgrade:
+//   - the slashing tx, unbonding tx and unbonding slashing tx of each BTC
+//     delegation are indexed by their hashes, so that the BTC delegation can
+//     be looked up by these txs;
+//   - each pending BTC delegation, i.e., one that has neither reached the
+//     covenant quorum of its params version nor been unbonded early or
+//     compromised, is indexed by its staker and by the height of the BTC
+//     block including its staking tx, so that it counts towards the cap on
+//     pending BTC delegations per staker, is returned to covenant members as
+//     a pending BTC delegation, and expires if it never reaches the quorum.
+//
+// The migration fails upon any BTC delegation whose raw BTC txs or params
+// version are missing, or that cannot be decoded.
+func MigrateStore(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	storeAdapter := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+	btcDelStore := prefix.NewStore(storeAdapter, types.BTCDelegationKey)
+	btcDelTxsStore := prefix.NewStore(storeAdapter, types.BTCDelegationTxsKey)
+	btcTxIndexStore := prefix.NewStore(storeAdapter, types.BTCTxIndexKey)
+	pendingBTCDelStakerStore := prefix.NewStore(storeAdapter, types.PendingBTCDelStakerKey)
+	pendingBTCDelHeightStore := prefix.NewStore(storeAdapter, types.PendingBTCDelHeightKey)
+	covenantQuorum := covenantQuorumByVersion(storeAdapter, cdc)
+
+	// collect all BTC delegations before writing the indexes, as the store
+	// cannot be written while being iterated
+	var keys [][]byte
+	var btcDels []*types.BTCDelegation
+	iter := btcDelStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		var btcDel types.BTCDelegation
+		if err := cdc.Unmarshal(iter.Value(), &btcDel); err != nil {
+			iter.Close()
+			return fmt.Errorf("failed to decode BTC delegation %x: %w", iter.Key(), err)
+		}
+		keys = append(keys, iter.Key())
+		btcDels = append(btcDels, &btcDel)
+	}
+	iter.Close()
+
+	for i, stakingTxHash := range keys {
+		btcDelTxsBytes := btcDelTxsStore.Get(stakingTxHash)
+		if len(btcDelTxsBytes) == 0 {
+			return fmt.Errorf("the BTC txs of BTC delegation %x are not found", stakingTxHash)
+		}
+		var btcDelTxs types.BTCDelegationTxs
+		if err := cdc.Unmarshal(btcDelTxsBytes, &btcDelTxs); err != nil {
+			return fmt.Errorf("failed to decode the BTC txs of BTC delegation %x: %w", stakingTxHash, err)
+		}
+		btcDel := btcDels[i]
+		btcDel.AttachTxs(&btcDelTxs)
+
+		for _, tx := range btcDel.MustGetIndexedTxHashes() {
+			entry := &types.BTCTxIndexEntry{
+				TxType:        tx.TxType,
+				StakingTxHash: stakingTxHash,
+			}
+			btcTxIndexStore.Set(tx.TxHash[:], cdc.MustMarshal(entry))
+		}
+
+		if btcDel.IsCompromised() || btcDel.IsUnbondedEarly() {
+			continue
+		}
+		quorum, err := covenantQuorum(btcDel.ParamsVersion)
+		if err != nil {
+			return err
+		}
+		if uint32(len(btcDel.CovenantSigs)) >= btcDel.GetCovenantQuorum(quorum) {
+			continue
+		}
+		heightBytes := sdk.Uint64ToBigEndian(btcDel.StartHeight)
+		prefix.NewStore(pendingBTCDelStakerStore, btcDel.BtcPk.MustMarshal()).Set(stakingTxHash, heightBytes)
+		pendingBTCDelHeightStore.Set(append(heightBytes, stakingTxHash...), stakingTxHash)
+	}
+
+	return nil
+}
+
+// covenantQuorumByVersion returns a function returning the covenant quorum of
+// the given params version, which caches the params versions it loads
+func covenantQuorumByVersion(storeAdapter storetypes.KVStore, cdc codec.BinaryCodec) func(version uint32) (uint32, error) {
+	paramsStore := prefix.NewStore(storeAdapter, types.ParamsKey)
+	quorums := map[uint32]uint32{}
+	return func(version uint32) (uint32, error) {
+		if quorum, ok := quorums[version]; ok {
+			return quorum, nil
+		}
+		var versionBytes [4]byte
+		binary.BigEndian.PutUint32(versionBytes[:], version)
+		spBytes := paramsStore.Get(versionBytes[:])
+		if len(spBytes) == 0 {
+			return 0, fmt.Errorf("params version %d is not found", version)
+		}
+		var sp types.StoredParams
+		if err := cdc.Unmarshal(spBytes, &sp); err != nil {
+			return 0, fmt.Errorf("failed to decode params version %d: %w", version, err)
+		}
+		quorums[version] = sp.Params.CovenantQuorum
+		return sp.Params.CovenantQuorum, nil
+	}
+}
@@ -0,0 +1,147 @@
+package v4_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	v4 "github.com/babylonchain/babylon/x/btcstaking/migrations/v4"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+func FuzzMigrateStore(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+		db := dbm.NewMemDB()
+		stateStore := store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
+		stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+		require.NoError(t, stateStore.LoadLatestVersion())
+		ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
+		storeService := runtime.NewKVStoreService(storeKey)
+		cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+		storeAdapter := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+		btcDelStore := prefix.NewStore(storeAdapter, types.BTCDelegationKey)
+		btcDelTxsStore := prefix.NewStore(storeAdapter, types.BTCDelegationTxsKey)
+		btcTxIndexStore := prefix.NewStore(storeAdapter, types.BTCTxIndexKey)
+		pendingBTCDelStakerStore := prefix.NewStore(storeAdapter, types.PendingBTCDelStakerKey)
+		pendingBTCDelHeightStore := prefix.NewStore(storeAdapter, types.PendingBTCDelHeightKey)
+
+		// BTC delegations stored with v3 layout, which are not indexed by
+		// their BTC txs
+		_, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		covenantSKs, _, covenantQuorum := datagen.GenCovenantCommittee(r)
+		params := types.DefaultParams()
+		params.CovenantQuorum = covenantQuorum
+		paramsStore := prefix.NewStore(storeAdapter, types.ParamsKey)
+		paramsStore.Set([]byte{0, 0, 0, 0}, cdc.MustMarshal(&types.StoredParams{Params: params, Version: 0}))
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, &chaincfg.SimNetParams)
+		require.NoError(t, err)
+		numBTCDels := int(datagen.RandomInt(r, 5)) + 1
+		btcDels := make([]*types.BTCDelegation, 0, numBTCDels)
+		pendingBTCDels := map[chainhash.Hash]bool{}
+		for i := 0; i < numBTCDels; i++ {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				[]bbn.BIP340PubKey{*bbn.NewBIP340PubKeyFromBTCPK(fpPK)},
+				delSK,
+				covenantSKs,
+				covenantQuorum,
+				slashingAddress.EncodeAddress(),
+				1000,
+				1000+datagen.RandomInt(r, 1000)+10,
+				10000,
+				sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2),
+				uint16(101),
+			)
+			require.NoError(t, err)
+			btcDels = append(btcDels, btcDel)
+
+			// some BTC delegations are still pending without covenant sigs,
+			// unless they are unbonded early
+			stakingTxHash := btcDel.MustGetStakingTxHash()
+			if datagen.OneInN(r, 2) {
+				btcDel.CovenantSigs = nil
+				if datagen.OneInN(r, 3) {
+					btcDel.BtcUndelegation.DelegatorUnbondingSig = btcDel.DelegatorSig
+				} else {
+					pendingBTCDels[stakingTxHash] = true
+				}
+			}
+			btcDelWithoutTxs, btcDelTxs := btcDel.SplitTxs()
+			btcDelStore.Set(stakingTxHash[:], cdc.MustMarshal(btcDelWithoutTxs))
+			btcDelTxsStore.Set(stakingTxHash[:], cdc.MustMarshal(btcDelTxs))
+		}
+
+		err = v4.MigrateStore(ctx, storeService, cdc)
+		require.NoError(t, err)
+
+		// each BTC delegation can be looked up by its other BTC txs
+		for _, btcDel := range btcDels {
+			stakingTxHash := btcDel.MustGetStakingTxHash()
+			txHashes := btcDel.MustGetIndexedTxHashes()
+			require.Len(t, txHashes, 3)
+			for _, tx := range txHashes {
+				var entry types.BTCTxIndexEntry
+				cdc.MustUnmarshal(btcTxIndexStore.Get(tx.TxHash[:]), &entry)
+				require.Equal(t, tx.TxType, entry.TxType)
+				require.Equal(t, stakingTxHash[:], entry.StakingTxHash)
+			}
+		}
+
+		// exactly the pending BTC delegations are indexed by their stakers and
+		// inclusion heights
+		for _, btcDel := range btcDels {
+			stakingTxHash := btcDel.MustGetStakingTxHash()
+			heightBytes := sdk.Uint64ToBigEndian(btcDel.StartHeight)
+			stakerStore := prefix.NewStore(pendingBTCDelStakerStore, btcDel.BtcPk.MustMarshal())
+			if !pendingBTCDels[stakingTxHash] {
+				require.False(t, stakerStore.Has(stakingTxHash[:]))
+				require.False(t, pendingBTCDelHeightStore.Has(append(heightBytes, stakingTxHash[:]...)))
+				continue
+			}
+			require.Equal(t, heightBytes, stakerStore.Get(stakingTxHash[:]))
+			require.Equal(t, stakingTxHash[:], pendingBTCDelHeightStore.Get(append(heightBytes, stakingTxHash[:]...)))
+		}
+
+		// a BTC delegation without its params version fails the migration
+		btcDels[0].ParamsVersion = 1
+		btcDels[0].CovenantSigs = nil
+		btcDels[0].BtcUndelegation.DelegatorUnbondingSig = nil
+		stakingTxHash := btcDels[0].MustGetStakingTxHash()
+		btcDelWithoutTxs, _ := btcDels[0].SplitTxs()
+		btcDelStore.Set(stakingTxHash[:], cdc.MustMarshal(btcDelWithoutTxs))
+		err = v4.MigrateStore(ctx, storeService, cdc)
+		require.Error(t, err)
+		btcDelStore.Delete(stakingTxHash[:])
+
+		// a BTC delegation without its raw BTC txs fails the migration
+		btcDelStore.Set(datagen.GenRandomByteArray(r, 32), cdc.MustMarshal(btcDelWithoutTxs))
+		err = v4.MigrateStore(ctx, storeService, cdc)
+		require.Error(t, err)
+	})
+}
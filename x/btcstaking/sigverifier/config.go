@@ -0,0 +1,88 @@
+package sigverifier
+
+import (
+	"time"
+
+	"cosmossdk.io/log"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	flagEnable        = "sig-verifier.enable"
+	flagWorkerSockets = "sig-verifier.worker-sockets"
+	flagTimeout       = "sig-verifier.timeout"
+)
+
+// Config is the configuration of covenant signature verification offloading
+type Config struct {
+	// Enable enables offloading signature verification in CheckTx and
+	// ReCheckTx to the workers. If disabled, signatures are verified
+	// in-process. Signatures are always verified in-process when executing
+	// blocks.
+	Enable bool `mapstructure:"enable"`
+	// WorkerSockets is the list of unix socket paths of the verification
+	// workers. If empty, signatures are verified in-process.
+	WorkerSockets []string `mapstructure:"worker-sockets"`
+	// Timeout is the timeout of verifying a batch of signatures with a worker,
+	// after which the batch is verified in-process
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Enable:        false,
+		WorkerSockets: []string{},
+		Timeout:       2 * time.Second,
+	}
+}
+
+// ParseConfigFromAppOpts parses the config from the app options. Missing
+// entries take the default values.
+func ParseConfigFromAppOpts(opts servertypes.AppOptions) Config {
+	cfg := DefaultConfig()
+	if v := opts.Get(flagEnable); v != nil {
+		cfg.Enable = cast.ToBool(v)
+	}
+	if v := opts.Get(flagWorkerSockets); v != nil {
+		cfg.WorkerSockets = cast.ToStringSlice(v)
+	}
+	if v := opts.Get(flagTimeout); v != nil {
+		if timeout := cast.ToDuration(v); timeout > 0 {
+			cfg.Timeout = timeout
+		}
+	}
+	return cfg
+}
+
+// NewVerifierFromConfig returns a worker pool if offloading is enabled and any
+// worker is configured, and the in-process verifier otherwise
+func NewVerifierFromConfig(cfg Config, logger log.Logger) Verifier {
+	if !cfg.Enable || len(cfg.WorkerSockets) == 0 {
+		return NewInProcessVerifier()
+	}
+	return NewWorkerPool(cfg, logger)
+}
+
+// DefaultConfigTemplate is the app.toml template of the config
+const DefaultConfigTemplate = `
+###############################################################################
+###                 Covenant signature verification offloading              ###
+###############################################################################
+
+[sig-verifier]
+
+# Enable offloading covenant signature verification in CheckTx and ReCheckTx to
+# out-of-process workers. If disabled, signatures are verified in-process.
+# Signatures are always verified in-process when executing blocks.
+enable = {{ .SigVerifierConfig.Enable }}
+
+# Unix socket paths of out-of-process covenant signature verification workers,
+# started with "babylond sig-verifier-worker". If empty, signatures are
+# verified in-process. Results are only accepted from workers running the same
+# binary version, and any worker failure falls back to in-process verification.
+worker-sockets = [{{ range .SigVerifierConfig.WorkerSockets }}"{{ . }}", {{ end }}]
+
+# Timeout of verifying a batch of signatures with a worker
+timeout = "{{ .SigVerifierConfig.Timeout }}"
+`
@@ -0,0 +1,114 @@
+package sigverifier
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"cosmossdk.io/log"
+)
+
+// WorkerPool offloads signature verification to out-of-process workers
+// listening on unix sockets, in a round-robin manner.
+//
+// Signatures reported as valid by the worker are accepted without being
+// re-verified, so a buggy or impersonating worker can get invalid signatures
+// accepted. The pool must thus only be used where a wrong result does not
+// affect consensus, i.e., in CheckTx and ReCheckTx, while blocks are always
+// executed with the in-process verifier.
+//   - Results are only accepted from workers with the same protocol and
+//     binary version.
+//   - If the worker is unavailable, times out, or replies with a malformed
+//     response, the whole batch is verified in-process.
+//   - Signatures reported as invalid by the worker are re-verified in-process,
+//     so that the returned errors are produced by the node itself.
+type WorkerPool struct {
+	socketPaths []string
+	timeout     time.Duration
+	next        atomic.Uint64
+	fallback    InProcessVerifier
+	logger      log.Logger
+}
+
+var _ Verifier = &WorkerPool{}
+
+func NewWorkerPool(cfg Config, logger log.Logger) *WorkerPool {
+	return &WorkerPool{
+		socketPaths: cfg.WorkerSockets,
+		timeout:     cfg.Timeout,
+		fallback:    NewInProcessVerifier(),
+		logger:      logger,
+	}
+}
+
+func (p *WorkerPool) VerifyBatch(reqs []*Request) []error {
+	if len(reqs) == 0 || len(p.socketPaths) == 0 {
+		return p.fallback.VerifyBatch(reqs)
+	}
+
+	socketPath := p.socketPaths[p.next.Add(1)%uint64(len(p.socketPaths))]
+	workerErrs, err := p.verifyRemotely(socketPath, reqs)
+	if err != nil {
+		p.logger.Error(
+			"failed to verify signatures with worker, falling back to in-process verification",
+			"socket", socketPath,
+			"err", err,
+		)
+		return p.fallback.VerifyBatch(reqs)
+	}
+
+	errs := make([]error, len(reqs))
+	for i, workerErr := range workerErrs {
+		if workerErr != "" {
+			errs[i] = reqs[i].Verify()
+		}
+	}
+	return errs
+}
+
+func (p *WorkerPool) verifyRemotely(socketPath string, reqs []*Request) ([]string, error) {
+	conn, err := net.DialTimeout("unix", socketPath, p.timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(p.timeout)); err != nil {
+		return nil, err
+	}
+
+	if err := json.NewEncoder(conn).Encode(newBatchRequest(reqs)); err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxMessageSize)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read response: %w", err)
+		}
+		return nil, fmt.Errorf("worker closed the connection")
+	}
+	var resp batchResponse
+	if err := json.Unmarshal(scanner.Bytes(), &resp); err != nil {
+		return nil, fmt.Errorf("malformed response: %w", err)
+	}
+
+	if !isCompatible(resp.ProtocolVersion, resp.AppVersion) {
+		return nil, fmt.Errorf(
+			"incompatible worker: protocol version %d, app version %s",
+			resp.ProtocolVersion,
+			resp.AppVersion,
+		)
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("worker error: %s", resp.Error)
+	}
+	if len(resp.Errors) != len(reqs) {
+		return nil, fmt.Errorf("worker returned %d results for %d requests", len(resp.Errors), len(reqs))
+	}
+
+	return resp.Errors, nil
+}
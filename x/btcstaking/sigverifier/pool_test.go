@@ -0,0 +1,151 @@
+package sigverifier_test
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"math/rand"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/x/btcstaking/sigverifier"
+)
+
+// startFakeWorker listens on a unix socket and replies to each batch with the
+// line returned by the given reply function, or does not reply if it is empty
+func startFakeWorker(t *testing.T, reply func() string) string {
+	socketPath := filepath.Join(t.TempDir(), "fake.sock")
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+				scanner := bufio.NewScanner(conn)
+				scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+				for scanner.Scan() {
+					line := reply()
+					if line == "" {
+						// hang until the node gives up
+						time.Sleep(time.Second)
+						return
+					}
+					if _, err := fmt.Fprintln(conn, line); err != nil {
+						return
+					}
+				}
+			}(conn)
+		}
+	}()
+	return socketPath
+}
+
+func newTestPool(socketPath string) *sigverifier.WorkerPool {
+	cfg := sigverifier.DefaultConfig()
+	cfg.Enable = true
+	cfg.WorkerSockets = []string{socketPath}
+	cfg.Timeout = 200 * time.Millisecond
+	return sigverifier.NewWorkerPool(cfg, log.NewNopLogger())
+}
+
+func TestNewVerifierFromConfig(t *testing.T) {
+	// signatures are verified in-process by default
+	cfg := sigverifier.DefaultConfig()
+	require.False(t, cfg.Enable)
+	require.IsType(t, sigverifier.InProcessVerifier{}, sigverifier.NewVerifierFromConfig(cfg, log.NewNopLogger()))
+
+	// configured workers are not used unless offloading is enabled
+	cfg.WorkerSockets = []string{"/tmp/worker.sock"}
+	require.IsType(t, sigverifier.InProcessVerifier{}, sigverifier.NewVerifierFromConfig(cfg, log.NewNopLogger()))
+
+	// offloading without any worker verifies in-process
+	cfg.Enable = true
+	cfg.WorkerSockets = nil
+	require.IsType(t, sigverifier.InProcessVerifier{}, sigverifier.NewVerifierFromConfig(cfg, log.NewNopLogger()))
+
+	cfg.WorkerSockets = []string{"/tmp/worker.sock"}
+	require.IsType(t, &sigverifier.WorkerPool{}, sigverifier.NewVerifierFromConfig(cfg, log.NewNopLogger()))
+}
+
+func TestWorkerPool(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	reqs := genSchnorrSigRequests(t, r)
+
+	// start a worker
+	socketPath := filepath.Join(t.TempDir(), "worker.sock")
+	server, err := sigverifier.NewServer(socketPath, 2, log.NewNopLogger())
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go server.Serve(ctx) //nolint:errcheck // closed upon cancel
+
+	requireValidThenInvalid(t, newTestPool(socketPath).VerifyBatch(reqs))
+}
+
+func TestWorkerPoolFallback(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	reqs := genSchnorrSigRequests(t, r)
+
+	response := func(protocolVersion uint32, appVersion string, errs string) string {
+		return fmt.Sprintf(`{"protocol_version":%d,"app_version":%q,"errors":%s}`, protocolVersion, appVersion, errs)
+	}
+	testCases := []struct {
+		name  string
+		reply func() string
+	}{
+		{
+			name:  "no reply before the timeout",
+			reply: func() string { return "" },
+		},
+		{
+			name:  "malformed response",
+			reply: func() string { return "not json" },
+		},
+		{
+			name:  "different protocol version",
+			reply: func() string { return response(sigverifier.ProtocolVersion+1, version.Version, `["", ""]`) },
+		},
+		{
+			name:  "different binary version",
+			reply: func() string { return response(sigverifier.ProtocolVersion, version.Version+"-other", `["", ""]`) },
+		},
+		{
+			name:  "wrong number of results",
+			reply: func() string { return response(sigverifier.ProtocolVersion, version.Version, `[""]`) },
+		},
+		{
+			name: "worker error",
+			reply: func() string {
+				return fmt.Sprintf(`{"protocol_version":%d,"app_version":%q,"error":"out of memory"}`, sigverifier.ProtocolVersion, version.Version)
+			},
+		},
+		{
+			// the signature reported as invalid is re-verified in-process
+			name:  "valid signature reported as invalid",
+			reply: func() string { return response(sigverifier.ProtocolVersion, version.Version, `["invalid", "invalid"]`) },
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pool := newTestPool(startFakeWorker(t, tc.reply))
+			requireValidThenInvalid(t, pool.VerifyBatch(reqs))
+		})
+	}
+
+	// no worker is listening on the socket, so the pool falls back to
+	// in-process verification
+	pool := newTestPool(filepath.Join(t.TempDir(), "missing.sock"))
+	requireValidThenInvalid(t, pool.VerifyBatch(reqs))
+}
@@ -0,0 +1,44 @@
+package sigverifier
+
+import (
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// ProtocolVersion is the version of the protocol between the node and the
+// verification workers. It has to be bumped upon any change in the messages
+// below or in the verification logic.
+const ProtocolVersion uint32 = 1
+
+// batchRequest is the message sent by the node to a worker over the unix
+// socket. Messages are JSON encoded, one message per line.
+type batchRequest struct {
+	ProtocolVersion uint32     `json:"protocol_version"`
+	AppVersion      string     `json:"app_version"`
+	Requests        []*Request `json:"requests"`
+}
+
+// batchResponse is the message sent by a worker back to the node. Errors
+// has the same length as the requests in the batch, where an empty string
+// means the corresponding signature is valid.
+type batchResponse struct {
+	ProtocolVersion uint32   `json:"protocol_version"`
+	AppVersion      string   `json:"app_version"`
+	Errors          []string `json:"errors"`
+	// Error is set if the worker failed to process the whole batch
+	Error string `json:"error,omitempty"`
+}
+
+func newBatchRequest(reqs []*Request) *batchRequest {
+	return &batchRequest{
+		ProtocolVersion: ProtocolVersion,
+		AppVersion:      version.Version,
+		Requests:        reqs,
+	}
+}
+
+// isCompatible returns whether the peer runs the same protocol and binary
+// version. Verification results are only accepted from compatible workers,
+// which guards against stale workers but does not authenticate them.
+func isCompatible(protocolVersion uint32, appVersion string) bool {
+	return protocolVersion == ProtocolVersion && appVersion == version.Version
+}
@@ -0,0 +1,194 @@
+package sigverifier
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	asig "github.com/babylonchain/babylon/crypto/schnorr-adaptor-signature"
+	bbn "github.com/babylonchain/babylon/types"
+)
+
+// SigType is the type of the signature to verify
+type SigType uint8
+
+const (
+	// SchnorrSig is a BIP340 Schnorr signature over a tx
+	SchnorrSig SigType = iota
+	// AdaptorSig is a Schnorr adaptor signature over a tx, encrypted by
+	// a finality provider's BTC PK
+	AdaptorSig
+)
+
+// Request is a self-contained request to verify a covenant signature over a
+// BTC tx spending a given funding output via a given script path. It contains
+// only raw bytes so that it can be sent to an out-of-process worker.
+type Request struct {
+	Type SigType `json:"type"`
+	// Tx is the serialised tx being signed
+	Tx []byte `json:"tx"`
+	// FundingPkScript is the pk script of the output spent by the tx
+	FundingPkScript []byte `json:"funding_pk_script"`
+	// FundingValue is the value of the output spent by the tx
+	FundingValue int64 `json:"funding_value"`
+	// Script is the script path that the tx spends
+	Script []byte `json:"script"`
+	// SignerPK is the BIP340 PK of the signer
+	SignerPK []byte `json:"signer_pk"`
+	// EncPK is the BIP340 PK that encrypts the adaptor signature. Only
+	// used for adaptor signatures
+	EncPK []byte `json:"enc_pk,omitempty"`
+	// Sig is the signature to verify
+	Sig []byte `json:"sig"`
+}
+
+// NewSchnorrSigRequest creates a request to verify a Schnorr signature
+func NewSchnorrSigRequest(
+	tx *wire.MsgTx,
+	fundingOut *wire.TxOut,
+	script []byte,
+	signerPK *bbn.BIP340PubKey,
+	sig []byte,
+) (*Request, error) {
+	txBytes, err := bbn.SerializeBTCTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	return &Request{
+		Type:            SchnorrSig,
+		Tx:              txBytes,
+		FundingPkScript: fundingOut.PkScript,
+		FundingValue:    fundingOut.Value,
+		Script:          script,
+		SignerPK:        *signerPK,
+		Sig:             sig,
+	}, nil
+}
+
+// NewAdaptorSigRequest creates a request to verify an adaptor signature
+// encrypted by the given PK
+func NewAdaptorSigRequest(
+	txBytes []byte,
+	fundingOut *wire.TxOut,
+	script []byte,
+	signerPK *bbn.BIP340PubKey,
+	encPK *bbn.BIP340PubKey,
+	sig []byte,
+) *Request {
+	return &Request{
+		Type:            AdaptorSig,
+		Tx:              txBytes,
+		FundingPkScript: fundingOut.PkScript,
+		FundingValue:    fundingOut.Value,
+		Script:          script,
+		SignerPK:        *signerPK,
+		EncPK:           *encPK,
+		Sig:             sig,
+	}
+}
+
+// Verify verifies the signature in the request in-process
+func (r *Request) Verify() error {
+	var tx wire.MsgTx
+	if err := tx.Deserialize(bytes.NewReader(r.Tx)); err != nil {
+		return fmt.Errorf("failed to parse tx: %w", err)
+	}
+	signerPK, err := bbn.BIP340PubKey(r.SignerPK).ToBTCPK()
+	if err != nil {
+		return fmt.Errorf("invalid signer PK: %w", err)
+	}
+
+	switch r.Type {
+	case SchnorrSig:
+		return btcstaking.VerifyTransactionSigWithOutputData(
+			&tx,
+			r.FundingPkScript,
+			r.FundingValue,
+			r.Script,
+			signerPK,
+			r.Sig,
+		)
+	case AdaptorSig:
+		encPK, err := bbn.BIP340PubKey(r.EncPK).ToBTCPK()
+		if err != nil {
+			return fmt.Errorf("invalid encryption PK: %w", err)
+		}
+		encKey, err := asig.NewEncryptionKeyFromBTCPK(encPK)
+		if err != nil {
+			return err
+		}
+		adaptorSig, err := asig.NewAdaptorSignatureFromBytes(r.Sig)
+		if err != nil {
+			return err
+		}
+		return btcstaking.EncVerifyTransactionSigWithOutputData(
+			&tx,
+			r.FundingPkScript,
+			r.FundingValue,
+			r.Script,
+			signerPK,
+			encKey,
+			adaptorSig,
+		)
+	default:
+		return fmt.Errorf("unknown signature type %d", r.Type)
+	}
+}
+
+// sigHashKey identifies the sighash of a request. Requests with the same key,
+// e.g., the adaptor signatures of all covenant members over the same slashing
+// tx, share the same sighash
+type sigHashKey struct {
+	tx              string
+	fundingPkScript string
+	fundingValue    int64
+	script          string
+}
+
+func (r *Request) sigHashKey() sigHashKey {
+	return sigHashKey{
+		tx:              string(r.Tx),
+		fundingPkScript: string(r.FundingPkScript),
+		fundingValue:    r.FundingValue,
+		script:          string(r.Script),
+	}
+}
+
+// sigHash decodes the tx in the request and returns the sighash that the
+// signature in the request is verified against
+func (r *Request) sigHash() ([]byte, error) {
+	var tx wire.MsgTx
+	if err := tx.Deserialize(bytes.NewReader(r.Tx)); err != nil {
+		return nil, fmt.Errorf("failed to parse tx: %w", err)
+	}
+	return btcstaking.TransactionSigHashWithOutputData(&tx, r.FundingPkScript, r.FundingValue, r.Script)
+}
+
+// adaptorSigInputs returns the adaptor signature in the request along with the
+// signer PK and the encryption key it is verified against, so that adaptor
+// signatures can be verified in a batch
+func (r *Request) adaptorSigInputs() (*asig.AdaptorSignature, *btcec.PublicKey, *asig.EncryptionKey, error) {
+	if r.Type != AdaptorSig {
+		return nil, nil, nil, fmt.Errorf("not an adaptor signature")
+	}
+	signerPK, err := bbn.BIP340PubKey(r.SignerPK).ToBTCPK()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid signer PK: %w", err)
+	}
+	encPK, err := bbn.BIP340PubKey(r.EncPK).ToBTCPK()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid encryption PK: %w", err)
+	}
+	encKey, err := asig.NewEncryptionKeyFromBTCPK(encPK)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	adaptorSig, err := asig.NewAdaptorSignatureFromBytes(r.Sig)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return adaptorSig, signerPK, encKey, nil
+}
@@ -0,0 +1,126 @@
+package sigverifier
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"runtime"
+	"sync"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// maxMessageSize is the maximum size of a message over the unix socket
+const maxMessageSize = 64 * 1024 * 1024
+
+// Server is a verification worker listening on a unix socket. It verifies
+// batches of signatures sent by a node using up to NumWorkers goroutines.
+type Server struct {
+	listener   net.Listener
+	numWorkers int
+	logger     log.Logger
+}
+
+// NewServer creates a verification worker listening on the given unix socket
+// path. A stale socket file at the path is removed. If numWorkers is not
+// positive, the number of CPUs is used.
+func NewServer(socketPath string, numWorkers int, logger log.Logger) (*Server, error) {
+	if err := os.Remove(socketPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to remove stale socket %s: %w", socketPath, err)
+	}
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", socketPath, err)
+	}
+	if numWorkers <= 0 {
+		numWorkers = runtime.NumCPU()
+	}
+	return &Server{
+		listener:   listener,
+		numWorkers: numWorkers,
+		logger:     logger,
+	}, nil
+}
+
+// Serve accepts connections until the given context is done
+func (s *Server) Serve(ctx context.Context) error {
+	go func() {
+		<-ctx.Done()
+		s.listener.Close()
+	}()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		go s.handleConn(conn)
+	}
+}
+
+func (s *Server) handleConn(conn net.Conn) {
+	defer conn.Close()
+
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxMessageSize)
+	encoder := json.NewEncoder(conn)
+
+	for scanner.Scan() {
+		var req batchRequest
+		resp := &batchResponse{
+			ProtocolVersion: ProtocolVersion,
+			AppVersion:      version.Version,
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+			resp.Error = fmt.Sprintf("malformed request: %v", err)
+		} else if !isCompatible(req.ProtocolVersion, req.AppVersion) {
+			resp.Error = fmt.Sprintf("incompatible request: protocol version %d, app version %s", req.ProtocolVersion, req.AppVersion)
+		} else {
+			resp.Errors = s.verifyBatch(req.Requests)
+		}
+
+		if err := encoder.Encode(resp); err != nil {
+			s.logger.Error("failed to write response", "err", err)
+			return
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		s.logger.Error("failed to read request", "err", err)
+	}
+}
+
+// verifyBatch verifies the given requests in parallel
+func (s *Server) verifyBatch(reqs []*Request) []string {
+	errs := make([]string, len(reqs))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, s.numWorkers)
+	for i := range reqs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if reqs[i] == nil {
+				errs[i] = "nil request"
+				return
+			}
+			if err := reqs[i].Verify(); err != nil {
+				errs[i] = err.Error()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	return errs
+}
@@ -0,0 +1,89 @@
+package sigverifier
+
+import (
+	"github.com/btcsuite/btcd/btcec/v2"
+
+	asig "github.com/babylonchain/babylon/crypto/schnorr-adaptor-signature"
+)
+
+// Verifier verifies a batch of signature verification requests, returning
+// one error per request. The i-th error is nil iff the i-th signature is valid.
+type Verifier interface {
+	VerifyBatch(reqs []*Request) []error
+}
+
+// InProcessVerifier verifies signatures in the calling process, so that the
+// verification is deterministic. Schnorr signatures are verified one by one,
+// while adaptor signatures are verified in a batch, and only verified one by
+// one if the batch fails verification, so that the returned errors are
+// identical to verifying them one by one. Each distinct tx in the batch, e.g.,
+// the slashing tx signed by all covenant members, is decoded and hashed once.
+type InProcessVerifier struct{}
+
+var _ Verifier = InProcessVerifier{}
+
+func NewInProcessVerifier() InProcessVerifier {
+	return InProcessVerifier{}
+}
+
+func (InProcessVerifier) VerifyBatch(reqs []*Request) []error {
+	errs := make([]error, len(reqs))
+
+	var (
+		adaptorIdxs []int
+		adaptorSigs []*asig.AdaptorSignature
+		sigHashes   [][]byte
+		signerPKs   []*btcec.PublicKey
+		encKeys     []*asig.EncryptionKey
+	)
+	sigHashCache := map[sigHashKey][]byte{}
+	for i, req := range reqs {
+		if req.Type != AdaptorSig {
+			errs[i] = req.Verify()
+			continue
+		}
+		key := req.sigHashKey()
+		sigHash, ok := sigHashCache[key]
+		if !ok {
+			var err error
+			sigHash, err = req.sigHash()
+			if err != nil {
+				// malformed request, reported by the individual verification
+				errs[i] = req.Verify()
+				continue
+			}
+			sigHashCache[key] = sigHash
+		}
+		adaptorSig, signerPK, encKey, err := req.adaptorSigInputs()
+		if err != nil {
+			// malformed request, reported by the individual verification
+			errs[i] = req.Verify()
+			continue
+		}
+		adaptorIdxs = append(adaptorIdxs, i)
+		adaptorSigs = append(adaptorSigs, adaptorSig)
+		sigHashes = append(sigHashes, sigHash)
+		signerPKs = append(signerPKs, signerPK)
+		encKeys = append(encKeys, encKey)
+	}
+
+	if len(adaptorIdxs) > 0 && asig.VerifyBatch(adaptorSigs, sigHashes, signerPKs, encKeys) != nil {
+		// find the invalid adaptor signatures
+		for _, i := range adaptorIdxs {
+			errs[i] = reqs[i].Verify()
+		}
+	}
+
+	return errs
+}
+
+// FirstError returns the first non-nil error and its index in the given
+// list, or (-1, nil) if there is no error
+func FirstError(errs []error) (int, error) {
+	for i, err := range errs {
+		if err != nil {
+			return i, err
+		}
+	}
+	return -1, nil
+}
@@ -0,0 +1,120 @@
+package sigverifier_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	asig "github.com/babylonchain/babylon/crypto/schnorr-adaptor-signature"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/sigverifier"
+)
+
+// genSchnorrSigRequests generates a request with a valid Schnorr signature
+// and a request with a signature by a different key
+func genSchnorrSigRequests(t *testing.T, r *rand.Rand) []*sigverifier.Request {
+	sk, pk, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	otherSK, _, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+
+	fundingOut := wire.NewTxOut(int64(datagen.RandomInt(r, 100000))+1000, datagen.GenRandomByteArray(r, 34))
+	script := datagen.GenRandomByteArray(r, 64)
+	prevHash, err := chainhash.NewHash(datagen.GenRandomByteArray(r, chainhash.HashSize))
+	require.NoError(t, err)
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(prevHash, 0), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(fundingOut.Value-500, datagen.GenRandomByteArray(r, 34)))
+
+	signerPK := bbn.NewBIP340PubKeyFromBTCPK(pk)
+	reqs := []*sigverifier.Request{}
+	for _, signerSK := range []*btcec.PrivateKey{sk, otherSK} {
+		sig, err := btcstaking.SignTxWithOneScriptSpendInputFromScript(tx, fundingOut, signerSK, script)
+		require.NoError(t, err)
+		req, err := sigverifier.NewSchnorrSigRequest(tx, fundingOut, script, signerPK, sig.Serialize())
+		require.NoError(t, err)
+		reqs = append(reqs, req)
+	}
+	return reqs
+}
+
+func requireValidThenInvalid(t *testing.T, errs []error) {
+	require.Len(t, errs, 2)
+	require.NoError(t, errs[0])
+	require.Error(t, errs[1])
+}
+
+func TestInProcessVerifier(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	reqs := genSchnorrSigRequests(t, r)
+
+	requireValidThenInvalid(t, sigverifier.NewInProcessVerifier().VerifyBatch(reqs))
+}
+
+func TestInProcessVerifierAdaptorSigs(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+
+	sk, pk, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	signerPK := bbn.NewBIP340PubKeyFromBTCPK(pk)
+
+	fundingOut := wire.NewTxOut(int64(datagen.RandomInt(r, 100000))+1000, datagen.GenRandomByteArray(r, 34))
+	script := datagen.GenRandomByteArray(r, 64)
+	prevHash, err := chainhash.NewHash(datagen.GenRandomByteArray(r, chainhash.HashSize))
+	require.NoError(t, err)
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(prevHash, 0), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(fundingOut.Value-500, datagen.GenRandomByteArray(r, 34)))
+	txBytes, err := bbn.SerializeBTCTx(tx)
+	require.NoError(t, err)
+	sigHash, err := btcstaking.TransactionSigHashWithOutputData(tx, fundingOut.PkScript, fundingOut.Value, script)
+	require.NoError(t, err)
+
+	// adaptor signatures encrypted by a number of finality providers, one of
+	// which is encrypted by a key other than the one in the request
+	numFPs := int(datagen.RandomInt(r, 10)) + 2
+	invalidIdx := int(datagen.RandomInt(r, numFPs))
+	reqs := []*sigverifier.Request{}
+	for i := 0; i < numFPs; i++ {
+		_, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		encKey, err := asig.NewEncryptionKeyFromBTCPK(fpPK)
+		require.NoError(t, err)
+		if i == invalidIdx {
+			encKey, _, err = asig.GenKeyPair()
+			require.NoError(t, err)
+		}
+		adaptorSig, err := asig.EncSign(sk, encKey, sigHash)
+		require.NoError(t, err)
+		fpBIP340PK := bbn.NewBIP340PubKeyFromBTCPK(fpPK)
+		reqs = append(reqs, sigverifier.NewAdaptorSigRequest(txBytes, fundingOut, script, signerPK, fpBIP340PK, adaptorSig.MustMarshal()))
+	}
+	// along with Schnorr signatures
+	reqs = append(reqs, genSchnorrSigRequests(t, r)...)
+
+	// the batch verification gives the same outcome as the individual one
+	errs := sigverifier.NewInProcessVerifier().VerifyBatch(reqs)
+	require.Len(t, errs, len(reqs))
+	for i, req := range reqs {
+		if expectedErr := req.Verify(); expectedErr != nil {
+			require.EqualError(t, errs[i], expectedErr.Error())
+		} else {
+			require.NoError(t, errs[i])
+		}
+	}
+	require.Error(t, errs[invalidIdx])
+	requireValidThenInvalid(t, errs[numFPs:])
+
+	// all adaptor signatures are valid
+	reqs = append(reqs[:invalidIdx], reqs[invalidIdx+1:numFPs]...)
+	for _, err := range sigverifier.NewInProcessVerifier().VerifyBatch(reqs) {
+		require.NoError(t, err)
+	}
+}
@@ -0,0 +1,109 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+)
+
+// btcSKFromAccount derives the BTC secret key of a simulation account from
+// its Babylon secret key, so that the BTC keys of finality providers and BTC
+// delegators are recoverable from the simulation accounts
+func btcSKFromAccount(acc simtypes.Account) *btcec.PrivateKey {
+	sk, _ := btcec.PrivKeyFromBytes(acc.PrivKey.Bytes())
+	return sk
+}
+
+// genBTCBlock generates a BTC block including the given txs on top of the
+// given parent header. The block has the parent's difficulty, a timestamp 10
+// minutes after the parent's, and version 4 since blocks of earlier versions
+// are rejected, so that it extends the BTC light client on the simulation
+// Bitcoin network.
+func genBTCBlock(r *rand.Rand, parent *wire.BlockHeader, txs []*wire.MsgTx) *wire.MsgBlock {
+	// coinbase tx with random data to make it unique
+	coinbaseTx := wire.NewMsgTx(wire.TxVersion)
+	coinbaseTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: *wire.NewOutPoint(&chainhash.Hash{}, wire.MaxPrevOutIndex),
+		SignatureScript:  []byte(simtypes.RandStringOfLength(r, 8)),
+		Sequence:         wire.MaxTxInSequenceNum,
+	})
+	coinbaseTx.AddTxOut(wire.NewTxOut(btcutil.SatoshiPerBitcoin, []byte{txscript.OP_TRUE}))
+	blockTxs := append([]*wire.MsgTx{coinbaseTx}, txs...)
+
+	utilTxs := make([]*btcutil.Tx, 0, len(blockTxs))
+	for _, tx := range blockTxs {
+		utilTxs = append(utilTxs, btcutil.NewTx(tx))
+	}
+	merkles := blockchain.BuildMerkleTreeStore(utilTxs, false)
+
+	header := wire.BlockHeader{
+		Version:    4,
+		PrevBlock:  parent.BlockHash(),
+		MerkleRoot: *merkles[len(merkles)-1],
+		Timestamp:  parent.Timestamp.Add(10 * time.Minute),
+		Bits:       parent.Bits,
+	}
+	// find a nonce satisfying the difficulty, which takes a few attempts on
+	// the simulation Bitcoin network
+	target := blockchain.CompactToBig(header.Bits)
+	for {
+		hash := header.BlockHash()
+		if blockchain.HashToBig(&hash).Cmp(target) <= 0 {
+			break
+		}
+		header.Nonce++
+	}
+
+	return &wire.MsgBlock{
+		Header:       header,
+		Transactions: blockTxs,
+	}
+}
+
+// genBTCChainWithTx generates a BTC chain on top of the given parent header,
+// where the first block includes the given tx and is followed by the given
+// number of confirmation blocks. It returns the headers of the BTC chain and
+// the info of the tx with its inclusion proof.
+func genBTCChainWithTx(
+	r *rand.Rand,
+	parent *wire.BlockHeader,
+	tx *wire.MsgTx,
+	numConfirmations uint64,
+) ([]bbn.BTCHeaderBytes, *btcctypes.TransactionInfo, error) {
+	block := genBTCBlock(r, parent, []*wire.MsgTx{tx})
+	headerBytes := bbn.NewBTCHeaderBytesFromBlockHeader(&block.Header)
+
+	txsBytes := make([][]byte, 0, len(block.Transactions))
+	for _, blockTx := range block.Transactions {
+		txBytes, err := bbn.SerializeBTCTx(blockTx)
+		if err != nil {
+			return nil, nil, err
+		}
+		txsBytes = append(txsBytes, txBytes)
+	}
+	// the tx follows the coinbase tx
+	proof, err := btcctypes.SpvProofFromHeaderAndTransactions(&headerBytes, txsBytes, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	headers := []bbn.BTCHeaderBytes{headerBytes}
+	prevHeader := &block.Header
+	for i := uint64(0); i < numConfirmations; i++ {
+		confirmationBlock := genBTCBlock(r, prevHeader, nil)
+		headers = append(headers, bbn.NewBTCHeaderBytesFromBlockHeader(&confirmationBlock.Header))
+		prevHeader = &confirmationBlock.Header
+	}
+
+	return headers, btcctypes.NewTransactionInfoFromSpvProof(proof), nil
+}
@@ -0,0 +1,178 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/babylonchain/babylon/crypto/eots"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// Simulation parameter constants
+const (
+	slashingRateKey        = "slashing_rate"
+	minSlashingTxFeeSatKey = "min_slashing_tx_fee_sat"
+	minCommissionRateKey   = "min_commission_rate"
+	minUnbondingRateKey    = "min_unbonding_rate"
+	maxActiveFpsKey        = "max_active_finality_providers"
+	numGenesisFpsKey       = "num_genesis_finality_providers"
+)
+
+// simNet is the Bitcoin network that simulations run on
+var simNet = &chaincfg.SimNetParams
+
+// genSlashingRate returns a random slashing rate in [0.01, 0.5]
+func genSlashingRate(r *rand.Rand) sdkmath.LegacyDec {
+	return sdkmath.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 51)), 2)
+}
+
+// genMinSlashingTxFeeSat returns a random minimum fee of slashing txs
+func genMinSlashingTxFeeSat(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1000, 5000))
+}
+
+// genMinCommissionRate returns a random minimum commission rate in [0, 0.1]
+func genMinCommissionRate(r *rand.Rand) sdkmath.LegacyDec {
+	return sdkmath.LegacyNewDecWithPrec(int64(r.Intn(11)), 2)
+}
+
+// genMinUnbondingRate returns a random minimum unbonding rate in [0.5, 0.9]
+func genMinUnbondingRate(r *rand.Rand) sdkmath.LegacyDec {
+	return sdkmath.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 50, 91)), 2)
+}
+
+// genMaxActiveFps returns a random maximum number of active finality providers
+func genMaxActiveFps(r *rand.Rand) uint32 {
+	return uint32(simtypes.RandIntBetween(r, 1, 101))
+}
+
+// genSlashingAddress returns a random slashing address on the simulation
+// Bitcoin network
+func genSlashingAddress(r *rand.Rand) string {
+	addr, err := btcutil.NewAddressPubKeyHash([]byte(simtypes.RandStringOfLength(r, 20)), simNet)
+	if err != nil {
+		panic(err)
+	}
+	return addr.EncodeAddress()
+}
+
+// RandomizedParams returns random BTC staking params. The covenant committee
+// is the default one, so that simulated covenant members can sign.
+func RandomizedParams(r *rand.Rand) types.Params {
+	params := types.DefaultParams()
+	params.SlashingAddress = genSlashingAddress(r)
+	params.SlashingRate = genSlashingRate(r)
+	params.MinSlashingTxFeeSat = genMinSlashingTxFeeSat(r)
+	params.MinCommissionRate = genMinCommissionRate(r)
+	params.MinUnbondingRate = genMinUnbondingRate(r)
+	params.MaxActiveFinalityProviders = genMaxActiveFps(r)
+	return params
+}
+
+// babylonPKFromAccount returns the secp256k1 public key of a simulation account
+func babylonPKFromAccount(acc simtypes.Account) (*secp256k1.PubKey, error) {
+	babylonPK, ok := acc.PubKey.(*secp256k1.PubKey)
+	if !ok {
+		return nil, fmt.Errorf("simulation account %s does not have a secp256k1 key", acc.Address)
+	}
+	return babylonPK, nil
+}
+
+// genFinalityProvider generates a finality provider operated by the given
+// simulation account, whose BTC key is derived from the account's key
+func genFinalityProvider(r *rand.Rand, acc simtypes.Account, minCommissionRate sdkmath.LegacyDec) (*types.FinalityProvider, error) {
+	btcSK := btcSKFromAccount(acc)
+	pop, err := types.NewPoP(acc.PrivKey, btcSK)
+	if err != nil {
+		return nil, err
+	}
+	_, mpr, err := eots.NewMasterRandPair(r)
+	if err != nil {
+		return nil, err
+	}
+	babylonPK, err := babylonPKFromAccount(acc)
+	if err != nil {
+		return nil, err
+	}
+	commission := minCommissionRate.Add(sdkmath.LegacyNewDecWithPrec(int64(r.Intn(50)), 2))
+
+	return &types.FinalityProvider{
+		Description:   &stakingtypes.Description{Moniker: simtypes.RandStringOfLength(r, 10)},
+		Commission:    &commission,
+		BabylonPk:     babylonPK,
+		BtcPk:         bbn.NewBIP340PubKeyFromBTCPK(btcSK.PubKey()),
+		Pop:           pop,
+		MasterPubRand: mpr.MarshalBase58(),
+	}, nil
+}
+
+// RandomizedGenState generates a random GenesisState for btcstaking. As
+// epochs are not finalized during simulations, finality providers registered
+// after genesis cannot receive BTC delegations, so the genesis state includes
+// finality providers registered at epoch 0 for BTC delegations to stake to.
+func RandomizedGenState(simState *module.SimulationState) {
+	var params types.Params
+	simState.AppParams.GetOrGenerate(
+		slashingRateKey, &params.SlashingRate, simState.Rand,
+		func(r *rand.Rand) { params.SlashingRate = genSlashingRate(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		minSlashingTxFeeSatKey, &params.MinSlashingTxFeeSat, simState.Rand,
+		func(r *rand.Rand) { params.MinSlashingTxFeeSat = genMinSlashingTxFeeSat(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		minCommissionRateKey, &params.MinCommissionRate, simState.Rand,
+		func(r *rand.Rand) { params.MinCommissionRate = genMinCommissionRate(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		minUnbondingRateKey, &params.MinUnbondingRate, simState.Rand,
+		func(r *rand.Rand) { params.MinUnbondingRate = genMinUnbondingRate(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		maxActiveFpsKey, &params.MaxActiveFinalityProviders, simState.Rand,
+		func(r *rand.Rand) { params.MaxActiveFinalityProviders = genMaxActiveFps(r) },
+	)
+	var numGenesisFps int
+	simState.AppParams.GetOrGenerate(
+		numGenesisFpsKey, &numGenesisFps, simState.Rand,
+		func(r *rand.Rand) { numGenesisFps = simtypes.RandIntBetween(r, 1, len(simState.Accounts)+1) },
+	)
+
+	defaultParams := types.DefaultParams()
+	params.CovenantPks = defaultParams.CovenantPks
+	params.CovenantQuorum = defaultParams.CovenantQuorum
+	params.MinUnbondingTime = defaultParams.MinUnbondingTime
+	params.ScriptTemplateVersion = defaultParams.ScriptTemplateVersion
+	params.SlashingAddress = genSlashingAddress(simState.Rand)
+
+	fps := make([]*types.FinalityProvider, 0, numGenesisFps)
+	for _, acc := range simState.Accounts[:numGenesisFps] {
+		fp, err := genFinalityProvider(simState.Rand, acc, params.MinCommissionRate)
+		if err != nil {
+			panic(err)
+		}
+		fps = append(fps, fp)
+	}
+
+	genesis := &types.GenesisState{
+		Params:            []*types.Params{&params},
+		FinalityProviders: fps,
+	}
+
+	bz, err := json.MarshalIndent(&genesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
@@ -0,0 +1,512 @@
+package simulation
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	bbn "github.com/babylonchain/babylon/types"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/babylonchain/babylon/x/btcstaking/keeper"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateFinalityProvider = "op_weight_msg_create_finality_provider"
+	OpWeightMsgCreateBTCDelegation    = "op_weight_msg_create_btc_delegation"
+	OpWeightMsgAddCovenantSigs        = "op_weight_msg_add_covenant_sigs"
+	OpWeightMsgBTCUndelegate          = "op_weight_msg_btc_undelegate"
+
+	DefaultWeightMsgCreateFinalityProvider = 20
+	DefaultWeightMsgCreateBTCDelegation    = 50
+	DefaultWeightMsgAddCovenantSigs        = 80
+	DefaultWeightMsgBTCUndelegate          = 20
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	btclcKeeper types.BTCLightClientKeeper,
+	btccKeeper types.BtcCheckpointKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgCreateFinalityProvider int
+		weightMsgCreateBTCDelegation    int
+		weightMsgAddCovenantSigs        int
+		weightMsgBTCUndelegate          int
+	)
+	appParams.GetOrGenerate(OpWeightMsgCreateFinalityProvider, &weightMsgCreateFinalityProvider, nil,
+		func(_ *rand.Rand) { weightMsgCreateFinalityProvider = DefaultWeightMsgCreateFinalityProvider },
+	)
+	appParams.GetOrGenerate(OpWeightMsgCreateBTCDelegation, &weightMsgCreateBTCDelegation, nil,
+		func(_ *rand.Rand) { weightMsgCreateBTCDelegation = DefaultWeightMsgCreateBTCDelegation },
+	)
+	appParams.GetOrGenerate(OpWeightMsgAddCovenantSigs, &weightMsgAddCovenantSigs, nil,
+		func(_ *rand.Rand) { weightMsgAddCovenantSigs = DefaultWeightMsgAddCovenantSigs },
+	)
+	appParams.GetOrGenerate(OpWeightMsgBTCUndelegate, &weightMsgBTCUndelegate, nil,
+		func(_ *rand.Rand) { weightMsgBTCUndelegate = DefaultWeightMsgBTCUndelegate },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateFinalityProvider,
+			SimulateMsgCreateFinalityProvider(txGen, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateBTCDelegation,
+			SimulateMsgCreateBTCDelegation(txGen, ak, bk, btclcKeeper, btccKeeper, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAddCovenantSigs,
+			SimulateMsgAddCovenantSigs(txGen, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBTCUndelegate,
+			SimulateMsgBTCUndelegate(txGen, ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgCreateFinalityProvider generates a MsgCreateFinalityProvider
+// registering a random account as a finality provider
+func SimulateMsgCreateFinalityProvider(
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgCreateFinalityProvider{})
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		btcPK := bbn.NewBIP340PubKeyFromBTCPK(btcSKFromAccount(simAccount).PubKey())
+		if k.HasFinalityProvider(ctx, *btcPK) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "finality provider is already registered"), nil, nil
+		}
+
+		fp, err := genFinalityProvider(r, simAccount, k.MinCommissionRate(ctx))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate finality provider"), nil, err
+		}
+		msg := &types.MsgCreateFinalityProvider{
+			Signer:        simAccount.Address.String(),
+			Description:   fp.Description,
+			Commission:    fp.Commission,
+			BabylonPk:     fp.BabylonPk,
+			BtcPk:         fp.BtcPk,
+			Pop:           fp.Pop,
+			MasterPubRand: fp.MasterPubRand,
+		}
+
+		return deliverTx(r, app, ctx, txGen, ak, bk, simAccount, msg, types.ModuleName)
+	}
+}
+
+// SimulateMsgCreateBTCDelegation generates a MsgCreateBTCDelegation staking
+// from a random account to a random finality provider. The staking tx is
+// included in a BTC chain that extends the BTC light client by k blocks,
+// which is submitted beforehand.
+func SimulateMsgCreateBTCDelegation(
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	btclcKeeper types.BTCLightClientKeeper,
+	btccKeeper types.BtcCheckpointKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgCreateBTCDelegation{})
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		params := k.GetParams(ctx)
+		bcParams := btccKeeper.GetParams(ctx)
+
+		// as epochs are not finalized during simulations, only finality
+		// providers registered at genesis can receive BTC delegations
+		fpPKs := []bbn.BIP340PubKey{}
+		k.IterateFPs(ctx, func(fp *types.FinalityProvider) bool {
+			if fp.RegisteredEpoch == 0 && !fp.IsSlashed() {
+				fpPKs = append(fpPKs, *fp.BtcPk)
+			}
+			return true
+		})
+		if len(fpPKs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no finality provider to stake to"), nil, nil
+		}
+		fpPK := fpPKs[r.Intn(len(fpPKs))]
+
+		// the timelock has to last for more than k+w BTC blocks, and the
+		// unbonding time has to be larger than the minimum one
+		stakingTime := bcParams.BtcConfirmationDepth + bcParams.CheckpointFinalizationTimeout + 1 + uint64(r.Intn(1000))
+		unbondingTime := types.MinimumUnbondingTime(params, bcParams) + 1 + uint64(r.Intn(10))
+		if stakingTime > math.MaxUint16 || unbondingTime > math.MaxUint16 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "timelocks do not fit BTC scripts"), nil, nil
+		}
+		stakingValue := int64(simtypes.RandIntBetween(r, btcutil.SatoshiPerBitcent, btcutil.SatoshiPerBitcoin))
+		unbondingValue := stakingValue - params.MinSlashingTxFeeSat
+
+		msg, stakingTx, err := genMsgCreateBTCDelegation(
+			r,
+			simAccount,
+			&params,
+			fpPK,
+			uint16(stakingTime),
+			stakingValue,
+			uint16(unbondingTime),
+			unbondingValue,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate BTC delegation"), nil, err
+		}
+
+		// include the staking tx in a BTC chain with k confirmations, and
+		// submit the BTC chain to the BTC light client
+		tip := btclcKeeper.GetTipInfo(ctx)
+		headers, stakingTxInfo, err := genBTCChainWithTx(r, tip.Header.ToBlockHeader(), stakingTx, bcParams.BtcConfirmationDepth)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate BTC chain"), nil, err
+		}
+		insertHeadersMsg := &btclctypes.MsgInsertHeaders{
+			Signer:  simAccount.Address.String(),
+			Headers: headers,
+		}
+		opMsg, _, err := deliverTx(r, app, ctx, txGen, ak, bk, simAccount, insertHeadersMsg, btclctypes.ModuleName)
+		if err != nil || !opMsg.OK {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to submit BTC chain including the staking tx"), nil, nil
+		}
+		msg.StakingTx = stakingTxInfo
+
+		return deliverTx(r, app, ctx, txGen, ak, bk, simAccount, msg, types.ModuleName)
+	}
+}
+
+// genMsgCreateBTCDelegation generates a MsgCreateBTCDelegation of the given
+// simulation account, whose BTC key is derived from the account's key, along
+// with the staking tx. The info of the staking tx is left to the caller,
+// which includes the staking tx in the BTC chain.
+func genMsgCreateBTCDelegation(
+	r *rand.Rand,
+	simAccount simtypes.Account,
+	params *types.Params,
+	fpPK bbn.BIP340PubKey,
+	stakingTime uint16,
+	stakingValue int64,
+	unbondingTime uint16,
+	unbondingValue int64,
+) (*types.MsgCreateBTCDelegation, *wire.MsgTx, error) {
+	delSK := btcSKFromAccount(simAccount)
+	delPK := delSK.PubKey()
+	fpBTCPK, err := fpPK.ToBTCPK()
+	if err != nil {
+		return nil, nil, err
+	}
+	fpBTCPKs := []*btcec.PublicKey{fpBTCPK}
+	covenantPKs, err := bbn.NewBTCPKsFromBIP340PKs(params.CovenantPks)
+	if err != nil {
+		return nil, nil, err
+	}
+	template, err := btcstaking.GetScriptTemplate(params.ScriptTemplateVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+	slashingAddr, err := btcutil.DecodeAddress(params.SlashingAddress, simNet)
+	if err != nil {
+		return nil, nil, err
+	}
+	slashingTxFee := params.MinSlashingTxFeeSat + 1
+
+	// staking tx spending an arbitrary output
+	stakingInfo, err := template.BuildStakingInfo(
+		delPK,
+		fpBTCPKs,
+		covenantPKs,
+		params.CovenantQuorum,
+		stakingTime,
+		btcutil.Amount(stakingValue),
+		simNet,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	fundingTxHash, err := chainhash.NewHash([]byte(simtypes.RandStringOfLength(r, chainhash.HashSize)))
+	if err != nil {
+		return nil, nil, err
+	}
+	stakingTx := wire.NewMsgTx(2)
+	stakingTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(fundingTxHash, 0), nil, nil))
+	stakingTx.AddTxOut(stakingInfo.StakingOutput)
+	if params.HasStakingTxTag() {
+		tagOutput, err := params.BuildStakingTxTagOutput(delPK, fpBTCPKs, stakingTime)
+		if err != nil {
+			return nil, nil, err
+		}
+		stakingTx.AddTxOut(tagOutput)
+	}
+
+	// slashing tx of the staking tx
+	slashingMsgTx, err := btcstaking.BuildSlashingTxFromStakingTxStrict(
+		stakingTx,
+		0,
+		slashingAddr,
+		delPK,
+		unbondingTime,
+		slashingTxFee,
+		params.SlashingRate,
+		simNet,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	slashingTx, err := types.NewBTCSlashingTxFromMsgTx(slashingMsgTx)
+	if err != nil {
+		return nil, nil, err
+	}
+	slashingPathInfo, err := stakingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		return nil, nil, err
+	}
+	delSlashingSig, err := slashingTx.Sign(stakingTx, 0, slashingPathInfo.GetPkScriptPath(), delSK)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// unbonding tx spending the staking output, and its slashing tx
+	unbondingInfo, err := template.BuildUnbondingInfo(
+		delPK,
+		fpBTCPKs,
+		covenantPKs,
+		params.CovenantQuorum,
+		unbondingTime,
+		btcutil.Amount(unbondingValue),
+		simNet,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	stakingTxHash := stakingTx.TxHash()
+	unbondingTx := wire.NewMsgTx(2)
+	unbondingTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&stakingTxHash, 0), nil, nil))
+	unbondingTx.AddTxOut(unbondingInfo.UnbondingOutput)
+	unbondingTxBytes, err := bbn.SerializeBTCTx(unbondingTx)
+	if err != nil {
+		return nil, nil, err
+	}
+	unbondingSlashingMsgTx, err := btcstaking.BuildSlashingTxFromStakingTxStrict(
+		unbondingTx,
+		0,
+		slashingAddr,
+		delPK,
+		unbondingTime,
+		slashingTxFee,
+		params.SlashingRate,
+		simNet,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	unbondingSlashingTx, err := types.NewBTCSlashingTxFromMsgTx(unbondingSlashingMsgTx)
+	if err != nil {
+		return nil, nil, err
+	}
+	unbondingSlashingPathInfo, err := unbondingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		return nil, nil, err
+	}
+	delUnbondingSlashingSig, err := unbondingSlashingTx.Sign(unbondingTx, 0, unbondingSlashingPathInfo.GetPkScriptPath(), delSK)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pop, err := types.NewPoP(simAccount.PrivKey, delSK)
+	if err != nil {
+		return nil, nil, err
+	}
+	babylonPK, err := babylonPKFromAccount(simAccount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &types.MsgCreateBTCDelegation{
+		Signer:                        simAccount.Address.String(),
+		BabylonPk:                     babylonPK,
+		BtcPk:                         bbn.NewBIP340PubKeyFromBTCPK(delPK),
+		FpBtcPkList:                   []bbn.BIP340PubKey{fpPK},
+		Pop:                           pop,
+		StakingTime:                   uint32(stakingTime),
+		StakingValue:                  stakingValue,
+		SlashingTx:                    slashingTx,
+		DelegatorSlashingSig:          delSlashingSig,
+		UnbondingTx:                   unbondingTxBytes,
+		UnbondingTime:                 uint32(unbondingTime),
+		UnbondingValue:                unbondingValue,
+		UnbondingSlashingTx:           unbondingSlashingTx,
+		DelegatorUnbondingSlashingSig: delUnbondingSlashingSig,
+	}, stakingTx, nil
+}
+
+// SimulateMsgAddCovenantSigs generates a MsgAddCovenantSigs of a random
+// covenant member of the default covenant committee, which has not signed a
+// random pending BTC delegation yet
+func SimulateMsgAddCovenantSigs(
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgAddCovenantSigs{})
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		btcDels, err := getBTCDelegations(ctx, k, types.BTCDelegationStatus_PENDING)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get pending BTC delegations"), nil, err
+		}
+		if len(btcDels) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no pending BTC delegation"), nil, nil
+		}
+		btcDel := btcDels[r.Intn(len(btcDels))]
+		template, err := btcstaking.GetScriptTemplate(btcDel.ScriptTemplateVersion)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid script template of BTC delegation"), nil, err
+		}
+		if template.CovenantMuSig2 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "MuSig2 covenant signing is not simulated"), nil, nil
+		}
+
+		// a covenant member that has not signed the BTC delegation yet
+		covenantSKs, _, _ := types.DefaultCovenantCommittee()
+		unsignedCovenantSKs := []*btcec.PrivateKey{}
+		for _, covenantSK := range covenantSKs {
+			if !btcDel.IsSignedByCovMember(bbn.NewBIP340PubKeyFromBTCPK(covenantSK.PubKey())) {
+				unsignedCovenantSKs = append(unsignedCovenantSKs, covenantSK)
+			}
+		}
+		if len(unsignedCovenantSKs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "all covenant members have signed"), nil, nil
+		}
+		covenantSK := unsignedCovenantSKs[r.Intn(len(unsignedCovenantSKs))]
+
+		params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+		msg, err := types.NewMsgAddCovenantSigs(simAccount.Address.String(), btcDel, params, covenantSK, simNet)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate covenant signatures"), nil, err
+		}
+
+		return deliverTx(r, app, ctx, txGen, ak, bk, simAccount, msg, types.ModuleName)
+	}
+}
+
+// SimulateMsgBTCUndelegate generates a MsgBTCUndelegate unbonding a random
+// active BTC delegation of a simulation account
+func SimulateMsgBTCUndelegate(
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgBTCUndelegate{})
+
+		btcDels, err := getBTCDelegations(ctx, k, types.BTCDelegationStatus_ACTIVE)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get active BTC delegations"), nil, err
+		}
+		// active BTC delegations of simulation accounts
+		candidates := []*types.BTCDelegation{}
+		for _, btcDel := range btcDels {
+			if _, found := simtypes.FindAccount(accs, btcDel.StakerAddress()); found {
+				candidates = append(candidates, btcDel)
+			}
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no active BTC delegation"), nil, nil
+		}
+		btcDel := candidates[r.Intn(len(candidates))]
+		simAccount, _ := simtypes.FindAccount(accs, btcDel.StakerAddress())
+
+		params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+		unbondingTxSig, err := btcDel.SignUnbondingTx(params, simNet, btcSKFromAccount(simAccount))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to sign unbonding tx"), nil, err
+		}
+		msg := &types.MsgBTCUndelegate{
+			Signer:         simAccount.Address.String(),
+			StakingTxHash:  btcDel.MustGetStakingTxHash().String(),
+			UnbondingTxSig: bbn.NewBIP340SignatureFromBTCSig(unbondingTxSig),
+		}
+
+		return deliverTx(r, app, ctx, txGen, ak, bk, simAccount, msg, types.ModuleName)
+	}
+}
+
+// getBTCDelegations returns the BTC delegations with the given status
+func getBTCDelegations(ctx sdk.Context, k keeper.Keeper, status types.BTCDelegationStatus) ([]*types.BTCDelegation, error) {
+	resp, err := k.BTCDelegations(ctx, &types.QueryBTCDelegationsRequest{Status: status})
+	if err != nil {
+		return nil, err
+	}
+	btcDels := make([]*types.BTCDelegation, 0, len(resp.BtcDelegations))
+	for _, btcDelResp := range resp.BtcDelegations {
+		stakingTx, _, err := bbn.NewBTCTxFromHex(btcDelResp.StakingTxHex)
+		if err != nil {
+			return nil, err
+		}
+		btcDel, err := k.GetBTCDelegation(ctx, stakingTx.TxHash().String())
+		if err != nil {
+			return nil, err
+		}
+		btcDels = append(btcDels, btcDel)
+	}
+	return btcDels, nil
+}
+
+// deliverTx delivers a tx with the given msg signed by the given simulation
+// account with random fees
+func deliverTx(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	simAccount simtypes.Account,
+	msg sdk.Msg,
+	moduleName string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           txGen,
+		Msg:             msg,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      moduleName,
+		CoinsSpentInMsg: sdk.NewCoins(),
+	}
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
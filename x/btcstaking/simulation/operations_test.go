@@ -0,0 +1,116 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/app"
+	appparams "github.com/babylonchain/babylon/app/params"
+	"github.com/babylonchain/babylon/x/btcstaking/simulation"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// TestWeightedOperations checks that all operations of the module are
+// weighted by default
+func TestWeightedOperations(t *testing.T) {
+	babylonApp := app.Setup(t, false)
+
+	weightedOps := simulation.WeightedOperations(
+		make(simtypes.AppParams),
+		babylonApp.TxConfig(),
+		babylonApp.AccountKeeper,
+		babylonApp.BankKeeper,
+		babylonApp.BTCLightClientKeeper,
+		babylonApp.BtcCheckpointKeeper,
+		babylonApp.BTCStakingKeeper,
+	)
+	expectedWeights := []int{
+		simulation.DefaultWeightMsgCreateFinalityProvider,
+		simulation.DefaultWeightMsgCreateBTCDelegation,
+		simulation.DefaultWeightMsgAddCovenantSigs,
+		simulation.DefaultWeightMsgBTCUndelegate,
+	}
+	require.Len(t, weightedOps, len(expectedWeights))
+	for i, weightedOp := range weightedOps {
+		require.Equal(t, expectedWeights[i], weightedOp.Weight())
+	}
+}
+
+// TestSimulateOperations runs the operations of the module against a Babylon
+// app through the lifecycle of a BTC delegation, i.e., registering the
+// finality provider, staking to it, collecting a covenant quorum, and
+// unbonding
+func TestSimulateOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	babylonApp := app.Setup(t, false)
+	ctx := babylonApp.BaseApp.NewContext(false).WithChainID(babylonApp.ChainID())
+	txConfig := babylonApp.TxConfig()
+	ak := babylonApp.AccountKeeper
+	bk := babylonApp.BankKeeper
+	k := babylonApp.BTCStakingKeeper
+	accs := getTestingAccounts(t, r, babylonApp, ctx, 3)
+
+	runOp := func(op simtypes.Operation) {
+		opMsg, futureOps, err := op(r, babylonApp.BaseApp, ctx, accs, ctx.ChainID())
+		require.NoError(t, err)
+		require.True(t, opMsg.OK, opMsg.Comment)
+		require.Empty(t, futureOps)
+	}
+
+	// no BTC delegation to sign or unbond yet
+	opMsg, _, err := simulation.SimulateMsgAddCovenantSigs(txConfig, ak, bk, k)(r, babylonApp.BaseApp, ctx, accs, ctx.ChainID())
+	require.NoError(t, err)
+	require.False(t, opMsg.OK)
+
+	// register a finality provider, which is treated as registered at
+	// genesis, since epochs are not finalized in simulations
+	runOp(simulation.SimulateMsgCreateFinalityProvider(txConfig, ak, bk, k))
+	var fps []*types.FinalityProvider
+	k.IterateFPs(ctx, func(fp *types.FinalityProvider) bool {
+		fps = append(fps, fp)
+		return true
+	})
+	require.Len(t, fps, 1)
+	fps[0].RegisteredEpoch = 0
+	k.SetFinalityProvider(ctx, fps[0])
+
+	// stake to the finality provider
+	runOp(simulation.SimulateMsgCreateBTCDelegation(txConfig, ak, bk, babylonApp.BTCLightClientKeeper, babylonApp.BtcCheckpointKeeper, k))
+	pendingResp, err := k.BTCDelegations(ctx, &types.QueryBTCDelegationsRequest{Status: types.BTCDelegationStatus_PENDING})
+	require.NoError(t, err)
+	require.Len(t, pendingResp.BtcDelegations, 1)
+
+	// covenant members sign the BTC delegation until the covenant quorum
+	params := k.GetParams(ctx)
+	for i := uint32(0); i < params.CovenantQuorum; i++ {
+		runOp(simulation.SimulateMsgAddCovenantSigs(txConfig, ak, bk, k))
+	}
+	activeResp, err := k.BTCDelegations(ctx, &types.QueryBTCDelegationsRequest{Status: types.BTCDelegationStatus_ACTIVE})
+	require.NoError(t, err)
+	require.Len(t, activeResp.BtcDelegations, 1)
+
+	// the staker unbonds the BTC delegation
+	runOp(simulation.SimulateMsgBTCUndelegate(txConfig, ak, bk, k))
+	unbondedResp, err := k.BTCDelegations(ctx, &types.QueryBTCDelegationsRequest{Status: types.BTCDelegationStatus_UNBONDED})
+	require.NoError(t, err)
+	require.Len(t, unbondedResp.BtcDelegations, 1)
+}
+
+// getTestingAccounts generates the given number of simulation accounts, and
+// funds them for paying fees
+func getTestingAccounts(t *testing.T, r *rand.Rand, babylonApp *app.BabylonApp, ctx sdk.Context, n int) []simtypes.Account {
+	accs := simtypes.RandomAccounts(r, n)
+	coins := sdk.NewCoins(sdk.NewCoin(appparams.DefaultBondDenom, sdkmath.NewInt(100_000_000_000)))
+	for _, acc := range accs {
+		babylonApp.AccountKeeper.SetAccount(ctx, babylonApp.AccountKeeper.NewAccountWithAddress(ctx, acc.Address))
+		require.NoError(t, babylonApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+		require.NoError(t, babylonApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, acc.Address, coins))
+	}
+	return accs
+}
@@ -0,0 +1,177 @@
+package slasher
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+const (
+	btcRPCTimeout = 30 * time.Second
+	// rpcVerifyAlreadyInChain is the code of the error returned by the
+	// Bitcoin node upon broadcasting a tx that is already confirmed
+	rpcVerifyAlreadyInChain = -27
+	// rpcDeserializationError is the code of the error returned by the
+	// Bitcoin node upon broadcasting a tx that cannot be decoded
+	rpcDeserializationError = -22
+)
+
+// permanentRejectReasons are the reject reasons of Bitcoin Core for txs that
+// are invalid regardless of the state of the mempool and the chain, e.g.,
+// with an invalid witness or with dust outputs
+var permanentRejectReasons = []string{
+	"script-verify-flag-failed",
+	"bad-txns-",
+	"bad-witness-",
+	"dust",
+	"scriptpubkey",
+	"tx-size",
+}
+
+// RPCError is an error returned by the Bitcoin node, e.g., when rejecting a
+// tx. Unlike transport errors, retrying the same request does not help.
+type RPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("bitcoin RPC error %d: %s", e.Code, e.Message)
+}
+
+// IsAlreadySpentOrConfirmed returns whether the Bitcoin node rejects a tx
+// since it is already confirmed, or since its inputs are already spent. In
+// either case, broadcasting the tx again never succeeds.
+func (e *RPCError) IsAlreadySpentOrConfirmed() bool {
+	if e.Code == rpcVerifyAlreadyInChain {
+		return true
+	}
+	// the reject reason of Bitcoin Core, and the one of its versions before
+	// 0.21
+	return strings.Contains(e.Message, "bad-txns-inputs-missingorspent") ||
+		strings.Contains(e.Message, "Missing inputs")
+}
+
+// IsPermanent returns whether the Bitcoin node rejects a tx as invalid in
+// itself, in which case broadcasting the tx again never succeeds. Other
+// rejections, e.g., due to a fee below the minimum relay fee, a conflicting
+// tx in the mempool or an unexpired timelock, may not recur later. A tx
+// rejected since it is already confirmed or its inputs are already spent is
+// not considered as permanently rejected, see IsAlreadySpentOrConfirmed.
+func (e *RPCError) IsPermanent() bool {
+	if e.IsAlreadySpentOrConfirmed() {
+		return false
+	}
+	if e.Code == rpcDeserializationError {
+		return true
+	}
+	for _, reason := range permanentRejectReasons {
+		if strings.Contains(e.Message, reason) {
+			return true
+		}
+	}
+	return false
+}
+
+type rpcRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      uint64        `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type rpcResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *RPCError       `json:"error"`
+}
+
+// BTCClient is a minimal client of the JSON-RPC endpoint of a Bitcoin node
+type BTCClient struct {
+	url  string
+	user string
+	pass string
+
+	httpClient *http.Client
+}
+
+func NewBTCClient(url string, user string, pass string) *BTCClient {
+	return &BTCClient{
+		url:        url,
+		user:       user,
+		pass:       pass,
+		httpClient: &http.Client{Timeout: btcRPCTimeout},
+	}
+}
+
+// SendRawTransaction broadcasts the given tx and returns its tx hash
+func (c *BTCClient) SendRawTransaction(ctx context.Context, tx *wire.MsgTx) (string, error) {
+	var buf bytes.Buffer
+	if err := tx.Serialize(&buf); err != nil {
+		return "", err
+	}
+
+	var txHash string
+	if err := c.Call(ctx, "sendrawtransaction", []interface{}{hex.EncodeToString(buf.Bytes())}, &txHash); err != nil {
+		return "", err
+	}
+	return txHash, nil
+}
+
+// HasUnspentOutput returns whether the given output of the given tx exists
+// and is unspent, either in the UTXO set or in the mempool of the Bitcoin node
+func (c *BTCClient) HasUnspentOutput(ctx context.Context, txHash chainhash.Hash, index uint32) (bool, error) {
+	// the Bitcoin node replies with null if the output is not found or spent
+	var txOut *json.RawMessage
+	if err := c.Call(ctx, "gettxout", []interface{}{txHash.String(), index, true}, &txOut); err != nil {
+		return false, err
+	}
+	return txOut != nil, nil
+}
+
+// Call invokes the given JSON-RPC method of the Bitcoin node and decodes the
+// result into the given value
+func (c *BTCClient) Call(ctx context.Context, method string, params []interface{}, result interface{}) error {
+	reqBytes, err := json.Marshal(&rpcRequest{
+		JSONRPC: "1.0",
+		ID:      1,
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(reqBytes))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if c.user != "" || c.pass != "" {
+		httpReq.SetBasicAuth(c.user, c.pass)
+	}
+
+	httpResp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	// the Bitcoin node replies with a non-2xx status code along with a JSON
+	// body upon RPC errors, thus the body is decoded regardless
+	var resp rpcResponse
+	if err := json.NewDecoder(httpResp.Body).Decode(&resp); err != nil {
+		return fmt.Errorf("failed to decode response of %s (HTTP status %d): %w", method, httpResp.StatusCode, err)
+	}
+	if resp.Error != nil {
+		return resp.Error
+	}
+	return json.Unmarshal(resp.Result, result)
+}
@@ -0,0 +1,102 @@
+package slasher_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/babylonchain/babylon/x/btcstaking/slasher"
+)
+
+func FuzzBTCClientSendRawTransaction(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		tx := datagen.GenRandomTx(r)
+		reject := datagen.OneInN(r, 2)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			user, pass, ok := req.BasicAuth()
+			require.True(t, ok)
+			require.Equal(t, "user", user)
+			require.Equal(t, "pass", pass)
+
+			var rpcReq struct {
+				Method string   `json:"method"`
+				Params []string `json:"params"`
+			}
+			err := json.NewDecoder(req.Body).Decode(&rpcReq)
+			require.NoError(t, err)
+			require.Equal(t, "sendrawtransaction", rpcReq.Method)
+			require.Len(t, rpcReq.Params, 1)
+
+			if reject {
+				// the Bitcoin node rejects txs with a non-2xx status code
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"result":null,"error":{"code":-27,"message":"Transaction already in block chain"},"id":1}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"result":"` + tx.TxHash().String() + `","error":null,"id":1}`))
+		}))
+		defer server.Close()
+
+		client := slasher.NewBTCClient(server.URL, "user", "pass")
+		txHash, err := client.SendRawTransaction(context.Background(), tx)
+		if reject {
+			var rpcErr *slasher.RPCError
+			require.True(t, errors.As(err, &rpcErr))
+			require.Equal(t, -27, rpcErr.Code)
+			return
+		}
+		require.NoError(t, err)
+		require.Equal(t, tx.TxHash().String(), txHash)
+	})
+}
+
+func FuzzBTCClientHasUnspentOutput(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		txHash := datagen.GenRandomBtcdHash(r)
+		index := uint32(datagen.RandomInt(r, 10))
+		found := datagen.OneInN(r, 2)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			var rpcReq struct {
+				Method string            `json:"method"`
+				Params []json.RawMessage `json:"params"`
+			}
+			err := json.NewDecoder(req.Body).Decode(&rpcReq)
+			require.NoError(t, err)
+			require.Equal(t, "gettxout", rpcReq.Method)
+			require.Len(t, rpcReq.Params, 3)
+			require.JSONEq(t, `"`+txHash.String()+`"`, string(rpcReq.Params[0]))
+			require.JSONEq(t, fmt.Sprint(index), string(rpcReq.Params[1]))
+			// outputs in the mempool are included
+			require.JSONEq(t, "true", string(rpcReq.Params[2]))
+
+			if found {
+				_, _ = w.Write([]byte(`{"result":{"bestblock":"` + txHash.String() + `","confirmations":1,"value":0.1},"error":null,"id":1}`))
+				return
+			}
+			// the Bitcoin node replies with null for spent or unknown outputs
+			_, _ = w.Write([]byte(`{"result":null,"error":null,"id":1}`))
+		}))
+		defer server.Close()
+
+		client := slasher.NewBTCClient(server.URL, "", "")
+		unspent, err := client.HasUnspentOutput(context.Background(), txHash, index)
+		require.NoError(t, err)
+		require.Equal(t, found, unspent)
+	})
+}
@@ -0,0 +1,92 @@
+package slasher
+
+import (
+	"time"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	flagEnable       = "slasher.enable"
+	flagBTCRPCURL    = "slasher.btc-rpc-url"
+	flagBTCRPCUser   = "slasher.btc-rpc-user"
+	flagBTCRPCPass   = "slasher.btc-rpc-pass"
+	flagPollInterval = "slasher.poll-interval"
+)
+
+// Config is the configuration of the built-in slasher, which broadcasts the
+// slashing txs of BTC delegations under equivocating finality providers
+type Config struct {
+	// Enable enables the slasher. If disabled, the node does not broadcast
+	// any slashing tx.
+	Enable bool `mapstructure:"enable"`
+	// BTCRPCURL is the URL of the JSON-RPC endpoint of a Bitcoin node
+	BTCRPCURL string `mapstructure:"btc-rpc-url"`
+	// BTCRPCUser is the username of the JSON-RPC endpoint
+	BTCRPCUser string `mapstructure:"btc-rpc-user"`
+	// BTCRPCPass is the password of the JSON-RPC endpoint
+	BTCRPCPass string `mapstructure:"btc-rpc-pass"`
+	// PollInterval is the interval of checking for new equivocation evidences
+	PollInterval time.Duration `mapstructure:"poll-interval"`
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Enable:       false,
+		BTCRPCURL:    "http://localhost:8332",
+		BTCRPCUser:   "",
+		BTCRPCPass:   "",
+		PollInterval: time.Minute,
+	}
+}
+
+// ParseConfigFromAppOpts parses the config from the app options. Missing
+// entries take the default values.
+func ParseConfigFromAppOpts(opts servertypes.AppOptions) Config {
+	cfg := DefaultConfig()
+	if v := opts.Get(flagEnable); v != nil {
+		cfg.Enable = cast.ToBool(v)
+	}
+	if v := opts.Get(flagBTCRPCURL); v != nil {
+		cfg.BTCRPCURL = cast.ToString(v)
+	}
+	if v := opts.Get(flagBTCRPCUser); v != nil {
+		cfg.BTCRPCUser = cast.ToString(v)
+	}
+	if v := opts.Get(flagBTCRPCPass); v != nil {
+		cfg.BTCRPCPass = cast.ToString(v)
+	}
+	if v := opts.Get(flagPollInterval); v != nil {
+		if interval := cast.ToDuration(v); interval > 0 {
+			cfg.PollInterval = interval
+		}
+	}
+	return cfg
+}
+
+// DefaultConfigTemplate is the app.toml template of the config
+const DefaultConfigTemplate = `
+###############################################################################
+###                             Built-in slasher                            ###
+###############################################################################
+
+[slasher]
+
+# Enable the built-in slasher. Once a finality provider equivocates, the node
+# extracts its BTC SK from the evidence, assembles the slashing txs of all BTC
+# delegations under it, and broadcasts them to the Bitcoin node below
+enable = {{ .SlasherConfig.Enable }}
+
+# URL of the JSON-RPC endpoint of the Bitcoin node
+btc-rpc-url = "{{ .SlasherConfig.BTCRPCURL }}"
+
+# Username of the JSON-RPC endpoint of the Bitcoin node
+btc-rpc-user = "{{ .SlasherConfig.BTCRPCUser }}"
+
+# Password of the JSON-RPC endpoint of the Bitcoin node
+btc-rpc-pass = "{{ .SlasherConfig.BTCRPCPass }}"
+
+# Interval of checking for new equivocation evidences
+poll-interval = "{{ .SlasherConfig.PollInterval }}"
+`
@@ -0,0 +1,336 @@
+package slasher
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	bbn "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	ftypes "github.com/babylonchain/babylon/x/finality/types"
+)
+
+// maxProcessed is the maximum number of BTC delegations remembered as
+// processed. Once exceeded, the earliest processed BTC delegations are
+// forgotten, and are processed again if they are still under slashed finality
+// providers, which is harmless as the Bitcoin node rejects their slashing txs
+// again.
+const maxProcessed = 10_000
+
+// QueryContextCreator creates contexts on committed states, e.g., BaseApp
+type QueryContextCreator interface {
+	CreateQueryContext(height int64, prove bool) (sdk.Context, error)
+}
+
+// FinalityKeeper provides the equivocation evidences of finality providers
+type FinalityKeeper interface {
+	ListEvidences(ctx context.Context, req *ftypes.QueryListEvidencesRequest) (*ftypes.QueryListEvidencesResponse, error)
+}
+
+// BTCStakingKeeper provides the BTC delegations under finality providers
+type BTCStakingKeeper interface {
+	FinalityProviderDelegations(ctx context.Context, req *bstypes.QueryFinalityProviderDelegationsRequest) (*bstypes.QueryFinalityProviderDelegationsResponse, error)
+	GetBTCDelegation(ctx context.Context, stakingTxHashStr string) (*bstypes.BTCDelegation, error)
+	GetParamsByVersion(ctx context.Context, v uint32) *bstypes.Params
+}
+
+// Slasher is a background service that watches for equivocation evidences of
+// finality providers, assembles the slashing txs of the BTC delegations under
+// them with the extracted BTC SKs, and broadcasts the slashing txs to Bitcoin
+type Slasher struct {
+	cfg       Config
+	btcNet    *chaincfg.Params
+	app       QueryContextCreator
+	fKeeper   FinalityKeeper
+	bsKeeper  BTCStakingKeeper
+	btcClient *BTCClient
+	logger    log.Logger
+
+	// processed is the set of staking tx hashes of the BTC delegations whose
+	// slashed outputs are known to be spent, either by their slashing txs or
+	// otherwise, or whose slashing txs can never be broadcast, and
+	// processedOrder keeps them in the order they are added
+	processed      map[string]struct{}
+	processedOrder []string
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func New(
+	cfg Config,
+	btcNet *chaincfg.Params,
+	app QueryContextCreator,
+	fKeeper FinalityKeeper,
+	bsKeeper BTCStakingKeeper,
+	logger log.Logger,
+) *Slasher {
+	return &Slasher{
+		cfg:       cfg,
+		btcNet:    btcNet,
+		app:       app,
+		fKeeper:   fKeeper,
+		bsKeeper:  bsKeeper,
+		btcClient: NewBTCClient(cfg.BTCRPCURL, cfg.BTCRPCUser, cfg.BTCRPCPass),
+		logger:    logger.With("module", "slasher"),
+		processed: map[string]struct{}{},
+	}
+}
+
+// Start starts the slasher in the background if it is enabled
+func (s *Slasher) Start() {
+	if !s.cfg.Enable {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.cfg.PollInterval)
+		defer ticker.Stop()
+		s.logger.Info("slasher started", "btc_rpc_url", s.cfg.BTCRPCURL)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := s.SlashOnce(ctx); err != nil {
+					s.logger.Error("failed to slash BTC delegations", "err", err)
+				}
+			}
+		}
+	}()
+}
+
+// Stop stops the slasher and waits for the ongoing round to finish
+func (s *Slasher) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	s.wg.Wait()
+}
+
+// SlashOnce broadcasts the slashing txs of all BTC delegations under the
+// finality providers with slashable evidences at the latest committed height,
+// except the ones that have been processed before
+func (s *Slasher) SlashOnce(ctx context.Context) error {
+	queryCtx, err := s.app.CreateQueryContext(0, false)
+	if err != nil {
+		// the chain has not committed any block yet
+		return nil
+	}
+	queryCtx = queryCtx.WithContext(ctx)
+
+	fpSKs, err := s.getSlashedFPSKs(queryCtx)
+	if err != nil {
+		return err
+	}
+	for fpBTCPKHex, fpSK := range fpSKs {
+		if err := s.slashFinalityProvider(queryCtx, fpBTCPKHex, fpSK); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getSlashedFPSKs returns the BTC SKs of all finality providers with slashable
+// evidences, extracted from the evidences
+func (s *Slasher) getSlashedFPSKs(ctx sdk.Context) (map[string]*btcec.PrivateKey, error) {
+	fpSKs := map[string]*btcec.PrivateKey{}
+	pagination := &query.PageRequest{}
+	for {
+		resp, err := s.fKeeper.ListEvidences(ctx, &ftypes.QueryListEvidencesRequest{Pagination: pagination})
+		if err != nil {
+			return nil, err
+		}
+		for _, evidence := range resp.Evidences {
+			fpBTCPKHex := evidence.FpBtcPk.MarshalHex()
+			if _, ok := fpSKs[fpBTCPKHex]; ok || !evidence.IsSlashable() {
+				continue
+			}
+			fpSK, err := evidence.ExtractBTCSK()
+			if err != nil {
+				s.logger.Error("failed to extract BTC SK from evidence", "fp_btc_pk", fpBTCPKHex, "err", err)
+				continue
+			}
+			fpSKs[fpBTCPKHex] = fpSK
+		}
+		if resp.Pagination == nil || len(resp.Pagination.NextKey) == 0 {
+			return fpSKs, nil
+		}
+		pagination = &query.PageRequest{Key: resp.Pagination.NextKey}
+	}
+}
+
+// slashFinalityProvider broadcasts the slashing txs of all BTC delegations
+// under the given finality provider. The self-delegations of the finality
+// provider, i.e., BTC delegations whose staker BTC PK is the BTC PK of the
+// finality provider, are slashed before the other BTC delegations.
+func (s *Slasher) slashFinalityProvider(ctx sdk.Context, fpBTCPKHex string, fpSK *btcec.PrivateKey) error {
+	fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(fpBTCPKHex)
+	if err != nil {
+		return err
+	}
+
+	var selfDelStakingTxHashes, otherStakingTxHashes []string
+	pagination := &query.PageRequest{}
+	for {
+		resp, err := s.bsKeeper.FinalityProviderDelegations(ctx, &bstypes.QueryFinalityProviderDelegationsRequest{
+			FpBtcPkHex: fpBTCPKHex,
+			Pagination: pagination,
+		})
+		if err != nil {
+			return err
+		}
+		for _, delsResp := range resp.BtcDelegatorDelegations {
+			for _, delResp := range delsResp.Dels {
+				stakingTx, _, err := bbn.NewBTCTxFromHex(delResp.StakingTxHex)
+				if err != nil {
+					return err
+				}
+				stakingTxHash := stakingTx.TxHash().String()
+				if delResp.BtcPk.Equals(fpBTCPK) {
+					selfDelStakingTxHashes = append(selfDelStakingTxHashes, stakingTxHash)
+				} else {
+					otherStakingTxHashes = append(otherStakingTxHashes, stakingTxHash)
+				}
+			}
+		}
+		if resp.Pagination == nil || len(resp.Pagination.NextKey) == 0 {
+			break
+		}
+		pagination = &query.PageRequest{Key: resp.Pagination.NextKey}
+	}
+
+	for _, stakingTxHash := range append(selfDelStakingTxHashes, otherStakingTxHashes...) {
+		if err := s.slashBTCDelegation(ctx, stakingTxHash, fpSK); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// slashBTCDelegation broadcasts the slashing tx of the given BTC delegation.
+// If the delegator has unbonded early, the unbonding slashing tx is broadcast
+// instead, unless the unbonding tx has never reached Bitcoin while the staking
+// output is still unspent, in which case the slashing tx spending the staking
+// output is broadcast. The slashing tx is broadcast again in each round until
+// the Bitcoin node reports that it is confirmed or that the spent output is
+// spent otherwise, or rejects it permanently, upon which the BTC delegation is
+// marked as processed. Only transport errors towards the Bitcoin node are
+// returned, so that the round is aborted and retried.
+func (s *Slasher) slashBTCDelegation(ctx sdk.Context, stakingTxHash string, fpSK *btcec.PrivateKey) error {
+	if _, ok := s.processed[stakingTxHash]; ok {
+		return nil
+	}
+	btcDel, err := s.bsKeeper.GetBTCDelegation(ctx, stakingTxHash)
+	if err != nil {
+		return err
+	}
+	bsParams := s.bsKeeper.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if bsParams == nil || !btcDel.HasCovenantQuorums(bsParams.CovenantQuorum) {
+		// the BTC delegation is not active, thus cannot be slashed for now
+		return nil
+	}
+
+	slashUnbonding := false
+	if btcDel.IsUnbondedEarly() {
+		slashUnbonding, err = s.shouldSlashUnbondingOutput(ctx, btcDel)
+		var rpcErr *RPCError
+		if errors.As(err, &rpcErr) {
+			s.logger.Error("failed to look up unbonding output", "staking_tx_hash", stakingTxHash, "err", rpcErr)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	var slashingTx *wire.MsgTx
+	if slashUnbonding {
+		slashingTx, err = btcDel.BuildUnbondingSlashingTxWithWitness(bsParams, s.btcNet, fpSK)
+	} else {
+		slashingTx, err = btcDel.BuildSlashingTxWithWitness(bsParams, s.btcNet, fpSK)
+	}
+	if err != nil {
+		// the BTC delegation and the SK do not change, thus building the
+		// slashing tx never succeeds
+		s.logger.Error("failed to build slashing tx", "staking_tx_hash", stakingTxHash, "err", err)
+		s.markProcessed(stakingTxHash)
+		return nil
+	}
+
+	txHash, err := s.btcClient.SendRawTransaction(ctx, slashingTx)
+	var rpcErr *RPCError
+	if errors.As(err, &rpcErr) {
+		if rpcErr.IsAlreadySpentOrConfirmed() {
+			// e.g., the slashing tx is confirmed, or the staking output has
+			// been withdrawn
+			s.logger.Info("slashed output is already spent", "staking_tx_hash", stakingTxHash, "err", rpcErr)
+			s.markProcessed(stakingTxHash)
+			return nil
+		}
+		if rpcErr.IsPermanent() {
+			s.logger.Error("slashing tx is permanently rejected by the Bitcoin node", "staking_tx_hash", stakingTxHash, "err", rpcErr)
+			s.markProcessed(stakingTxHash)
+			return nil
+		}
+		// e.g., the fee of the slashing tx is below the minimum relay fee of
+		// the Bitcoin node at the moment
+		s.logger.Error("slashing tx is rejected by the Bitcoin node", "staking_tx_hash", stakingTxHash, "err", rpcErr)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("broadcast slashing tx", "staking_tx_hash", stakingTxHash, "slashing_tx_hash", txHash, "unbonding", slashUnbonding)
+	return nil
+}
+
+// shouldSlashUnbondingOutput returns whether the unbonding output of the given
+// BTC delegation, which has unbonded early, is to be slashed rather than its
+// staking output. This is the case unless the unbonding output is not found
+// in the UTXO set nor in the mempool of the Bitcoin node while the staking
+// output is still unspent, i.e., the unbonding tx has never reached Bitcoin.
+// If neither output is found, the unbonding output is to be slashed, whose
+// slashing tx is then rejected as spending a spent output.
+func (s *Slasher) shouldSlashUnbondingOutput(ctx context.Context, btcDel *bstypes.BTCDelegation) (bool, error) {
+	unbondingTx, err := bbn.NewBTCTxFromBytes(btcDel.BtcUndelegation.UnbondingTx)
+	if err != nil {
+		// the slashing tx of the staking output is the only option
+		return false, nil
+	}
+	unbondingOutputFound, err := s.btcClient.HasUnspentOutput(ctx, unbondingTx.TxHash(), 0)
+	if err != nil || unbondingOutputFound {
+		return true, err
+	}
+	stakingOutputFound, err := s.btcClient.HasUnspentOutput(ctx, btcDel.MustGetStakingTxHash(), btcDel.StakingOutputIdx)
+	if err != nil {
+		return false, err
+	}
+	return !stakingOutputFound, nil
+}
+
+// markProcessed marks the BTC delegation with the given staking tx hash as
+// processed, forgetting the earliest processed BTC delegation if there are
+// more than maxProcessed of them
+func (s *Slasher) markProcessed(stakingTxHash string) {
+	s.processed[stakingTxHash] = struct{}{}
+	s.processedOrder = append(s.processedOrder, stakingTxHash)
+	if len(s.processedOrder) > maxProcessed {
+		delete(s.processed, s.processedOrder[0])
+		s.processedOrder = s.processedOrder[1:]
+	}
+}
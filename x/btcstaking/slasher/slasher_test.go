@@ -0,0 +1,243 @@
+package slasher_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/slasher"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	ftypes "github.com/babylonchain/babylon/x/finality/types"
+)
+
+type mockApp struct{}
+
+func (mockApp) CreateQueryContext(int64, bool) (sdk.Context, error) {
+	return sdk.Context{}, nil
+}
+
+type mockFinalityKeeper struct {
+	evidences []*ftypes.Evidence
+}
+
+func (k *mockFinalityKeeper) ListEvidences(context.Context, *ftypes.QueryListEvidencesRequest) (*ftypes.QueryListEvidencesResponse, error) {
+	return &ftypes.QueryListEvidencesResponse{Evidences: k.evidences}, nil
+}
+
+type mockBTCStakingKeeper struct {
+	fpBTCPKHex string
+	btcDels    []*bstypes.BTCDelegation
+	params     *bstypes.Params
+}
+
+func (k *mockBTCStakingKeeper) FinalityProviderDelegations(_ context.Context, req *bstypes.QueryFinalityProviderDelegationsRequest) (*bstypes.QueryFinalityProviderDelegationsResponse, error) {
+	if req.FpBtcPkHex != k.fpBTCPKHex {
+		return &bstypes.QueryFinalityProviderDelegationsResponse{}, nil
+	}
+	delsResp := &bstypes.BTCDelegatorDelegationsResponse{}
+	for _, btcDel := range k.btcDels {
+		delsResp.Dels = append(delsResp.Dels, &bstypes.BTCDelegationResponse{
+			BtcPk:        btcDel.BtcPk,
+			StakingTxHex: hex.EncodeToString(btcDel.StakingTx),
+		})
+	}
+	return &bstypes.QueryFinalityProviderDelegationsResponse{
+		BtcDelegatorDelegations: []*bstypes.BTCDelegatorDelegationsResponse{delsResp},
+	}, nil
+}
+
+func (k *mockBTCStakingKeeper) GetBTCDelegation(_ context.Context, stakingTxHashStr string) (*bstypes.BTCDelegation, error) {
+	for _, btcDel := range k.btcDels {
+		if btcDel.MustGetStakingTxHash().String() == stakingTxHashStr {
+			return btcDel, nil
+		}
+	}
+	return nil, bstypes.ErrBTCDelegationNotFound
+}
+
+func (k *mockBTCStakingKeeper) GetParamsByVersion(context.Context, uint32) *bstypes.Params {
+	return k.params
+}
+
+// FuzzSlashOnce checks that the slasher broadcasts the slashing txs of the
+// active BTC delegations under an equivocating finality provider in each round
+// until the Bitcoin node reports them as spent or confirmed or rejects them
+// permanently, after which they are no longer broadcast. The slashing txs of
+// BTC delegations unbonded early spend their unbonding outputs, unless their
+// unbonding txs never reached Bitcoin.
+func FuzzSlashOnce(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		net := &chaincfg.SimNetParams
+
+		// a finality provider with an equivocation evidence
+		fpSK, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(fpPK)
+		evidence, err := datagen.GenRandomEvidence(r, fpSK, datagen.RandomInt(r, 1000)+1)
+		require.NoError(t, err)
+
+		// BTC delegations under the finality provider, only some of which
+		// have covenant quorum
+		covenantSKs, covenantPKs, err := datagen.GenRandomBTCKeyPairs(r, 5)
+		require.NoError(t, err)
+		covenantQuorum := uint32(3)
+		bsParams := &bstypes.Params{
+			CovenantPks:    bbn.NewBIP340PKsFromBTCPKs(covenantPKs),
+			CovenantQuorum: covenantQuorum,
+		}
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		numDels := int(datagen.RandomInt(r, 5) + 1)
+		numActiveDels := 0
+		btcDels := []*bstypes.BTCDelegation{}
+		// the outpoint expected to be slashed for each active BTC delegation,
+		// and the outpoints found unspent by the Bitcoin node
+		expectedSlashed := map[wire.OutPoint]bool{}
+		unspent := map[wire.OutPoint]bool{}
+		for i := 0; i < numDels; i++ {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				[]bbn.BIP340PubKey{*fpBTCPK},
+				delSK,
+				covenantSKs,
+				covenantQuorum,
+				slashingAddress.EncodeAddress(),
+				1000,
+				1005,
+				uint64(2*10e8),
+				sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2),
+				101,
+			)
+			require.NoError(t, err)
+			btcDels = append(btcDels, btcDel)
+			if i > 0 && datagen.OneInN(r, 3) {
+				btcDel.CovenantSigs = nil
+				continue
+			}
+			numActiveDels++
+
+			stakingOutPoint := wire.OutPoint{Hash: btcDel.MustGetStakingTxHash(), Index: btcDel.StakingOutputIdx}
+			if !datagen.OneInN(r, 2) {
+				unspent[stakingOutPoint] = true
+				expectedSlashed[stakingOutPoint] = true
+				continue
+			}
+			// the BTC delegation is unbonded early, while its unbonding tx
+			// may not have reached Bitcoin
+			btcDel.BtcUndelegation.DelegatorUnbondingSig = btcDel.DelegatorSig
+			unbondingTx, err := bbn.NewBTCTxFromBytes(btcDel.BtcUndelegation.UnbondingTx)
+			require.NoError(t, err)
+			unbondingOutPoint := wire.OutPoint{Hash: unbondingTx.TxHash(), Index: 0}
+			if datagen.OneInN(r, 2) {
+				unspent[unbondingOutPoint] = true
+				expectedSlashed[unbondingOutPoint] = true
+			} else {
+				unspent[stakingOutPoint] = true
+				expectedSlashed[stakingOutPoint] = true
+			}
+		}
+
+		// a Bitcoin node replying to sendrawtransaction with the given
+		// response, and recording the outpoints spent by the broadcast txs
+		var numRequests int
+		var reply string
+		slashed := map[wire.OutPoint]bool{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			var rpcReq struct {
+				Method string            `json:"method"`
+				Params []json.RawMessage `json:"params"`
+			}
+			err := json.NewDecoder(req.Body).Decode(&rpcReq)
+			require.NoError(t, err)
+
+			if rpcReq.Method == "gettxout" {
+				var txHashStr string
+				var index uint32
+				require.NoError(t, json.Unmarshal(rpcReq.Params[0], &txHashStr))
+				require.NoError(t, json.Unmarshal(rpcReq.Params[1], &index))
+				txHash, err := chainhash.NewHashFromStr(txHashStr)
+				require.NoError(t, err)
+				if unspent[wire.OutPoint{Hash: *txHash, Index: index}] {
+					_, _ = w.Write([]byte(`{"result":{"confirmations":1},"error":null,"id":1}`))
+				} else {
+					_, _ = w.Write([]byte(`{"result":null,"error":null,"id":1}`))
+				}
+				return
+			}
+
+			require.Equal(t, "sendrawtransaction", rpcReq.Method)
+			numRequests++
+			var txHex string
+			require.NoError(t, json.Unmarshal(rpcReq.Params[0], &txHex))
+			tx, _, err := bbn.NewBTCTxFromHex(txHex)
+			require.NoError(t, err)
+			slashed[tx.TxIn[0].PreviousOutPoint] = true
+			_, _ = w.Write([]byte(reply))
+		}))
+		defer server.Close()
+		rejectWith := func(code int, msg string) string {
+			return fmt.Sprintf(`{"result":null,"error":{"code":%d,"message":"%s"},"id":1}`, code, msg)
+		}
+
+		cfg := slasher.DefaultConfig()
+		cfg.BTCRPCURL = server.URL
+		s := slasher.New(
+			cfg,
+			net,
+			mockApp{},
+			&mockFinalityKeeper{evidences: []*ftypes.Evidence{evidence}},
+			&mockBTCStakingKeeper{fpBTCPKHex: fpBTCPK.MarshalHex(), btcDels: btcDels, params: bsParams},
+			log.NewNopLogger(),
+		)
+
+		// the slashing txs are broadcast again after being rejected for
+		// other reasons than being spent or confirmed, and after being
+		// accepted
+		reply = rejectWith(-26, "min relay fee not met")
+		require.NoError(t, s.SlashOnce(context.Background()))
+		require.Equal(t, numActiveDels, numRequests)
+		require.Equal(t, expectedSlashed, slashed)
+		numRequests = 0
+		reply = `{"result":"` + datagen.GenRandomBtcdHash(r).String() + `","error":null,"id":1}`
+		require.NoError(t, s.SlashOnce(context.Background()))
+		require.Equal(t, numActiveDels, numRequests)
+
+		// the slashing txs are no longer broadcast once the Bitcoin node
+		// reports them as spent or confirmed, or rejects them permanently
+		numRequests = 0
+		switch r.Intn(3) {
+		case 0:
+			reply = rejectWith(-27, "Transaction already in block chain")
+		case 1:
+			reply = rejectWith(-25, "bad-txns-inputs-missingorspent")
+		default:
+			reply = rejectWith(-26, "mandatory-script-verify-flag-failed (Invalid Schnorr signature)")
+		}
+		require.NoError(t, s.SlashOnce(context.Background()))
+		require.Equal(t, numActiveDels, numRequests)
+		numRequests = 0
+		require.NoError(t, s.SlashOnce(context.Background()))
+		require.Zero(t, numRequests)
+	})
+}
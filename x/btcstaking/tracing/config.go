@@ -0,0 +1,86 @@
+package tracing
+
+import (
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	flagEnable      = "tracing.enable"
+	flagEndpoint    = "tracing.endpoint"
+	flagInsecure    = "tracing.insecure"
+	flagServiceName = "tracing.service-name"
+	flagSampleRatio = "tracing.sample-ratio"
+)
+
+// Config is the configuration of exporting OpenTelemetry traces of the BTC
+// delegation lifecycle
+type Config struct {
+	// Enable enables tracing. If disabled, no span is recorded.
+	Enable bool `mapstructure:"enable"`
+	// Endpoint is the host:port of the OTLP/gRPC collector
+	Endpoint string `mapstructure:"endpoint"`
+	// Insecure disables TLS towards the collector
+	Insecure bool `mapstructure:"insecure"`
+	// ServiceName is the service name attached to the exported traces
+	ServiceName string `mapstructure:"service-name"`
+	// SampleRatio is the ratio of traces to be sampled, within [0, 1]
+	SampleRatio float64 `mapstructure:"sample-ratio"`
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Enable:      false,
+		Endpoint:    "localhost:4317",
+		Insecure:    true,
+		ServiceName: "babylond",
+		SampleRatio: 1,
+	}
+}
+
+// ParseConfigFromAppOpts parses the config from the app options. Missing
+// entries take the default values.
+func ParseConfigFromAppOpts(opts servertypes.AppOptions) Config {
+	cfg := DefaultConfig()
+	if v := opts.Get(flagEnable); v != nil {
+		cfg.Enable = cast.ToBool(v)
+	}
+	if v := opts.Get(flagEndpoint); v != nil {
+		cfg.Endpoint = cast.ToString(v)
+	}
+	if v := opts.Get(flagInsecure); v != nil {
+		cfg.Insecure = cast.ToBool(v)
+	}
+	if v := opts.Get(flagServiceName); v != nil {
+		cfg.ServiceName = cast.ToString(v)
+	}
+	if v := opts.Get(flagSampleRatio); v != nil {
+		cfg.SampleRatio = cast.ToFloat64(v)
+	}
+	return cfg
+}
+
+// DefaultConfigTemplate is the app.toml template of the config
+const DefaultConfigTemplate = `
+###############################################################################
+###                     BTC delegation lifecycle tracing                    ###
+###############################################################################
+
+[tracing]
+
+# Enable exporting OpenTelemetry spans of the BTC delegation lifecycle handlers,
+# carrying the staking tx hash, finality provider PKs and the outcome
+enable = {{ .TracingConfig.Enable }}
+
+# Host:port of the OTLP/gRPC collector
+endpoint = "{{ .TracingConfig.Endpoint }}"
+
+# Disable TLS towards the collector
+insecure = {{ .TracingConfig.Insecure }}
+
+# Service name attached to the exported traces
+service-name = "{{ .TracingConfig.ServiceName }}"
+
+# Ratio of traces to be sampled, within [0, 1]
+sample-ratio = {{ .TracingConfig.SampleRatio }}
+`
@@ -0,0 +1,57 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// TracerName is the name of the tracer of the BTC delegation lifecycle
+const TracerName = "github.com/babylonchain/babylon/x/btcstaking"
+
+// ShutdownFunc flushes the pending spans and stops the exporter
+type ShutdownFunc func(ctx context.Context) error
+
+// NewTracerProvider returns a tracer provider exporting spans to the OTLP
+// collector in the config. If tracing is disabled, a no-op tracer provider is
+// returned.
+func NewTracerProvider(ctx context.Context, cfg Config) (trace.TracerProvider, ShutdownFunc, error) {
+	if !cfg.Enable {
+		return noop.NewTracerProvider(), func(context.Context) error { return nil }, nil
+	}
+	if cfg.SampleRatio < 0 || cfg.SampleRatio > 1 {
+		return nil, nil, fmt.Errorf("tracing sample ratio %v is not within [0, 1]", cfg.SampleRatio)
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	// the exporter connects lazily, so an unavailable collector does not
+	// prevent the node from starting
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewSchemaless(semconv.ServiceName(cfg.ServiceName)),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create tracing resource: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	return tp, tp.Shutdown, nil
+}
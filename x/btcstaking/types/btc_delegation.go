@@ -14,8 +14,13 @@ import (
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxBTCDelegationMemoLength is the maximum length of the memo of a BTC
+// delegation
+const MaxBTCDelegationMemoLength = 256
+
 func NewBTCDelegationStatusFromString(statusStr string) (BTCDelegationStatus, error) {
 	switch statusStr {
 	case "pending":
@@ -24,10 +29,12 @@ func NewBTCDelegationStatusFromString(statusStr string) (BTCDelegationStatus, er
 		return BTCDelegationStatus_ACTIVE, nil
 	case "unbonded":
 		return BTCDelegationStatus_UNBONDED, nil
+	case "compromised":
+		return BTCDelegationStatus_COMPROMISED, nil
 	case "any":
 		return BTCDelegationStatus_ANY, nil
 	default:
-		return -1, fmt.Errorf("invalid status string; should be one of {pending, active, unbonding, unbonded, any}")
+		return -1, fmt.Errorf("invalid status string; should be one of {pending, active, unbonding, unbonded, compromised, any}")
 	}
 }
 
@@ -42,6 +49,12 @@ func (d *BTCDelegation) GetStakingTime() uint16 {
 	return uint16(diff)
 }
 
+// StakerAddress returns the Babylon account of the staker, i.e., the address
+// of the BTC delegation's Babylon PK
+func (d *BTCDelegation) StakerAddress() sdk.AccAddress {
+	return sdk.AccAddress(d.BabylonPk.Address())
+}
+
 // GetFpIdx returns the index of the finality provider in the list of finality providers
 // that the BTC delegation is restaked to
 func (d *BTCDelegation) GetFpIdx(fpBTCPK *bbn.BIP340PubKey) int {
@@ -81,11 +94,58 @@ func (d *BTCDelegation) IsUnbondedEarly() bool {
 	return d.BtcUndelegation.DelegatorUnbondingSig != nil
 }
 
+// IsCompromised returns whether the staking output of the BTC delegation is
+// reported to be spent outside the protocol
+func (d *BTCDelegation) IsCompromised() bool {
+	return len(d.CompromisingSpendTxHash) > 0
+}
+
+// VerifyStakingSpend verifies that the given tx spends the staking output of
+// the BTC delegation, and that it is neither the unbonding tx nor the slashing
+// tx of the BTC delegation
+func (d *BTCDelegation) VerifyStakingSpend(spendTx *wire.MsgTx) error {
+	stakingTxHash, err := d.GetStakingTxHash()
+	if err != nil {
+		return err
+	}
+	stakingOutPoint := wire.NewOutPoint(&stakingTxHash, d.StakingOutputIdx)
+	spendsStakingOutput := false
+	for _, txIn := range spendTx.TxIn {
+		if txIn.PreviousOutPoint == *stakingOutPoint {
+			spendsStakingOutput = true
+			break
+		}
+	}
+	if !spendsStakingOutput {
+		return fmt.Errorf("the tx does not spend the staking output %s", stakingOutPoint)
+	}
+
+	spendTxHash := spendTx.TxHash()
+	if d.BtcUndelegation != nil {
+		unbondingTx, err := bbn.NewBTCTxFromBytes(d.BtcUndelegation.UnbondingTx)
+		if err != nil {
+			return err
+		}
+		if unbondingTx.TxHash() == spendTxHash {
+			return fmt.Errorf("the tx is the unbonding tx of the BTC delegation")
+		}
+	}
+	if d.SlashingTx != nil && d.SlashingTx.MustGetTxHash().IsEqual(&spendTxHash) {
+		return fmt.Errorf("the tx is the slashing tx of the BTC delegation")
+	}
+	return nil
+}
+
 // GetStatus returns the status of the BTC Delegation based on BTC height, w value, and covenant quorum
-// Pending: the BTC height is in the range of d's [startHeight, endHeight-w] and the delegation does not have covenant signatures
-// Active: the BTC height is in the range of d's [startHeight, endHeight-w] and the delegation has quorum number of signatures over slashing tx, unbonding tx, and slashing unbonding tx from covenant committee
+// Pending: the BTC height is in the range of d's [startHeight, endHeight-w] and the delegation does not have covenant signatures, or the BTC height is lower than d's activationHeight
+// Active: the BTC height is in the range of d's [activationHeight, endHeight-w] and the delegation has quorum number of signatures over slashing tx, unbonding tx, and slashing unbonding tx from covenant committee
 // Unbonded: the BTC height is larger than `endHeight-w` or the BTC delegation has received a signature on unbonding tx from the delegator
+// Compromised: the staking output is reported to be spent by a tx that is neither the unbonding tx nor the slashing tx
 func (d *BTCDelegation) GetStatus(btcHeight uint64, w uint64, covenantQuorum uint32) BTCDelegationStatus {
+	if d.IsCompromised() {
+		return BTCDelegationStatus_COMPROMISED
+	}
+
 	if d.IsUnbondedEarly() {
 		return BTCDelegationStatus_UNBONDED
 	}
@@ -98,13 +158,14 @@ func (d *BTCDelegation) GetStatus(btcHeight uint64, w uint64, covenantQuorum uin
 
 	// at this point, BTC delegation has an active timelock, and Babylon is not
 	// aware of unbonding tx with delegator's signature
-	if d.HasCovenantQuorums(covenantQuorum) {
+	if d.HasCovenantQuorums(covenantQuorum) && btcHeight >= d.ActivationHeight {
 		// this BTC delegation receives covenant quorums on
-		// {slashing/unbonding/unbondingslashing} txs, thus is active
+		// {slashing/unbonding/unbondingslashing} txs and its staking tx is
+		// deep enough, thus is active
 		return BTCDelegationStatus_ACTIVE
 	}
 
-	// no covenant quorum yet, pending
+	// no covenant quorum yet, or the staking tx is not deep enough, pending
 	return BTCDelegationStatus_PENDING
 }
 
@@ -118,6 +179,66 @@ func (d *BTCDelegation) VotingPower(btcHeight uint64, w uint64, covenantQuorum u
 	return d.GetTotalSat()
 }
 
+// SplitTxs splits the BTC delegation into a copy without the raw BTC txs and
+// the raw BTC txs, which are stored separately
+func (d *BTCDelegation) SplitTxs() (*BTCDelegation, *BTCDelegationTxs) {
+	txs := &BTCDelegationTxs{
+		StakingTx:  d.StakingTx,
+		SlashingTx: d.SlashingTx,
+	}
+	btcDelWithoutTxs := *d
+	btcDelWithoutTxs.StakingTx = nil
+	btcDelWithoutTxs.SlashingTx = nil
+	if d.BtcUndelegation != nil {
+		txs.UnbondingTx = d.BtcUndelegation.UnbondingTx
+		txs.UnbondingSlashingTx = d.BtcUndelegation.SlashingTx
+		btcUndelWithoutTxs := *d.BtcUndelegation
+		btcUndelWithoutTxs.UnbondingTx = nil
+		btcUndelWithoutTxs.SlashingTx = nil
+		btcDelWithoutTxs.BtcUndelegation = &btcUndelWithoutTxs
+	}
+	return &btcDelWithoutTxs, txs
+}
+
+// AttachTxs sets the given raw BTC txs to the BTC delegation, reverting
+// SplitTxs
+func (d *BTCDelegation) AttachTxs(txs *BTCDelegationTxs) {
+	d.StakingTx = txs.StakingTx
+	d.SlashingTx = txs.SlashingTx
+	if d.BtcUndelegation != nil {
+		d.BtcUndelegation.UnbondingTx = txs.UnbondingTx
+		d.BtcUndelegation.SlashingTx = txs.UnbondingSlashingTx
+	}
+}
+
+// TypedTxHash is the hash of a BTC tx of a BTC delegation along with its type
+type TypedTxHash struct {
+	TxType BTCTxType
+	TxHash chainhash.Hash
+}
+
+// MustGetIndexedTxHashes returns the hashes of the slashing tx, unbonding tx
+// and unbonding slashing tx of the BTC delegation along with their types,
+// i.e., the BTC txs by which the BTC delegation is indexed other than its
+// staking tx. The BTC delegation has to carry its raw BTC txs, which have
+// been verified upon its creation
+func (d *BTCDelegation) MustGetIndexedTxHashes() []TypedTxHash {
+	txHashes := []TypedTxHash{
+		{TxType: BTCTxType_SLASHING_TX, TxHash: *d.SlashingTx.MustGetTxHash()},
+	}
+	if d.BtcUndelegation != nil {
+		unbondingTx, err := bbn.NewBTCTxFromBytes(d.BtcUndelegation.UnbondingTx)
+		if err != nil {
+			panic(err) // only programming error
+		}
+		txHashes = append(txHashes,
+			TypedTxHash{TxType: BTCTxType_UNBONDING_TX, TxHash: unbondingTx.TxHash()},
+			TypedTxHash{TxType: BTCTxType_UNBONDING_SLASHING_TX, TxHash: *d.BtcUndelegation.SlashingTx.MustGetTxHash()},
+		)
+	}
+	return txHashes
+}
+
 func (d *BTCDelegation) GetStakingTxHash() (chainhash.Hash, error) {
 	parsed, err := bbn.NewBTCTxFromBytes(d.StakingTx)
 
@@ -154,6 +275,14 @@ func (d *BTCDelegation) ValidateBasic() error {
 	if ExistsDup(d.FpBtcPkList) {
 		return fmt.Errorf("list of finality provider PKs has duplication")
 	}
+	if len(d.Memo) > MaxBTCDelegationMemoLength {
+		return fmt.Errorf("memo is longer than %d bytes", MaxBTCDelegationMemoLength)
+	}
+	if d.RewardAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(d.RewardAddress); err != nil {
+			return fmt.Errorf("invalid reward address: %w", err)
+		}
+	}
 	if d.StakingTx == nil {
 		return fmt.Errorf("empty staking tx")
 	}
@@ -163,6 +292,11 @@ func (d *BTCDelegation) ValidateBasic() error {
 	if d.DelegatorSig == nil {
 		return fmt.Errorf("empty delegator signature")
 	}
+	// the activation height is 0 for BTC delegations created before it was
+	// recorded
+	if d.ActivationHeight != 0 && d.ActivationHeight < d.StartHeight {
+		return fmt.Errorf("activation height %d is lower than start height %d", d.ActivationHeight, d.StartHeight)
+	}
 
 	// ensure staking tx is correctly formatted
 	if _, err := bbn.NewBTCTxFromBytes(d.StakingTx); err != nil {
@@ -171,6 +305,92 @@ func (d *BTCDelegation) ValidateBasic() error {
 	if err := d.Pop.ValidateBasic(); err != nil {
 		return err
 	}
+	// the slashing rate is nil for BTC delegations created before it was
+	// recorded
+	if !d.SlashingRate.IsNil() && !btcstaking.IsRateValid(d.SlashingRate) {
+		return btcstaking.ErrInvalidSlashingRate
+	}
+
+	return nil
+}
+
+// HasRecordedSlashing returns whether the slashing rate and the slashing
+// address are recorded on the BTC delegation at creation
+func (d *BTCDelegation) HasRecordedSlashing() bool {
+	return !d.SlashingRate.IsNil() && len(d.SlashingAddress) > 0
+}
+
+// ParamsWithRecordedSlashing returns a copy of the given params that the BTC
+// delegation is created under, where the slashing rate and the slashing
+// address are replaced by the ones recorded on the BTC delegation, i.e., the
+// ones its slashing txs commit to. BTC delegations created before these were
+// recorded use the given params as is.
+func (d *BTCDelegation) ParamsWithRecordedSlashing(params *Params) *Params {
+	p := *params
+	if d.HasRecordedSlashing() {
+		p.SlashingRate = d.SlashingRate
+		p.SlashingAddress = d.SlashingAddress
+	}
+	return &p
+}
+
+// CheckSlashingTxs checks that the slashing tx and the unbonding slashing tx
+// of the BTC delegation slash the staking output and the unbonding output with
+// the slashing rate and to the slashing address in the given params, which are
+// supposed to come from ParamsWithRecordedSlashing
+func (d *BTCDelegation) CheckSlashingTxs(params *Params, btcNet *chaincfg.Params) error {
+	// the slashing address is not checked against the allowed address types,
+	// as it may be recorded under params that allowed different ones
+	slashingAddr, err := btcstaking.DecodeAddress(params.SlashingAddress, btcNet, nil)
+	if err != nil {
+		return fmt.Errorf("invalid slashing address: %w", err)
+	}
+	stakingTx, err := bbn.NewBTCTxFromBytes(d.StakingTx)
+	if err != nil {
+		return fmt.Errorf("invalid staking tx: %w", err)
+	}
+	slashingTx, err := d.SlashingTx.ToMsgTx()
+	if err != nil {
+		return fmt.Errorf("invalid slashing tx: %w", err)
+	}
+	if err := btcstaking.CheckTransactions(
+		slashingTx,
+		stakingTx,
+		d.StakingOutputIdx,
+		params.MinSlashingTxFeeSat,
+		params.SlashingRate,
+		slashingAddr,
+		d.BtcPk.MustToBTCPK(),
+		uint16(d.GetUnbondingTime()),
+		btcNet,
+	); err != nil {
+		return fmt.Errorf("invalid slashing tx: %w", err)
+	}
+
+	if d.BtcUndelegation == nil {
+		return fmt.Errorf("empty undelegation")
+	}
+	unbondingTx, err := bbn.NewBTCTxFromBytes(d.BtcUndelegation.UnbondingTx)
+	if err != nil {
+		return fmt.Errorf("invalid unbonding tx: %w", err)
+	}
+	unbondingSlashingTx, err := d.BtcUndelegation.SlashingTx.ToMsgTx()
+	if err != nil {
+		return fmt.Errorf("invalid unbonding slashing tx: %w", err)
+	}
+	if err := btcstaking.CheckTransactions(
+		unbondingSlashingTx,
+		unbondingTx,
+		0,
+		params.MinSlashingTxFeeSat,
+		params.SlashingRate,
+		slashingAddr,
+		d.BtcPk.MustToBTCPK(),
+		uint16(d.GetUnbondingTime()),
+		btcNet,
+	); err != nil {
+		return fmt.Errorf("invalid unbonding slashing tx: %w", err)
+	}
 
 	return nil
 }
@@ -181,9 +401,22 @@ func (d *BTCDelegation) ValidateBasic() error {
 // - Schnorr signatures on unbonding tx
 // - adaptor signatrues on unbonding slashing tx
 func (d *BTCDelegation) HasCovenantQuorums(quorum uint32) bool {
+	quorum = d.GetCovenantQuorum(quorum)
 	return uint32(len(d.CovenantSigs)) >= quorum && d.BtcUndelegation.HasCovenantQuorums(quorum)
 }
 
+// GetCovenantQuorum returns the number of covenant signatures the BTC
+// delegation needs given the covenant quorum in the parameters. A BTC
+// delegation whose covenant committee signs via MuSig2 needs a single
+// aggregate signature.
+func (d *BTCDelegation) GetCovenantQuorum(quorum uint32) uint32 {
+	template, err := btcstaking.GetScriptTemplate(d.ScriptTemplateVersion)
+	if err == nil && template.CovenantMuSig2 {
+		return 1
+	}
+	return quorum
+}
+
 // IsSignedByCovMember checks whether the given covenant PK has signed the delegation
 func (d *BTCDelegation) IsSignedByCovMember(covPk *bbn.BIP340PubKey) bool {
 	for _, sigInfo := range d.CovenantSigs {
@@ -229,7 +462,11 @@ func (d *BTCDelegation) GetStakingInfo(bsParams *Params, btcNet *chaincfg.Params
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert covenant pks to BTC pks %v", err)
 	}
-	stakingInfo, err := btcstaking.BuildStakingInfo(
+	template, err := btcstaking.GetScriptTemplate(d.ScriptTemplateVersion)
+	if err != nil {
+		return nil, err
+	}
+	stakingInfo, err := template.BuildStakingInfo(
 		d.BtcPk.MustToBTCPK(),
 		fpBtcPkList,
 		covenantBtcPkList,
@@ -293,7 +530,11 @@ func (d *BTCDelegation) GetUnbondingInfo(bsParams *Params, btcNet *chaincfg.Para
 		return nil, fmt.Errorf("failed to parse unbonding transaction: %v", err)
 	}
 
-	unbondingInfo, err := btcstaking.BuildUnbondingInfo(
+	template, err := btcstaking.GetScriptTemplate(d.ScriptTemplateVersion)
+	if err != nil {
+		return nil, err
+	}
+	unbondingInfo, err := template.BuildUnbondingInfo(
 		d.BtcPk.MustToBTCPK(),
 		fpBtcPkList,
 		covenantBtcPkList,
@@ -325,7 +566,6 @@ func (d *BTCDelegation) findFPIdx(fpBTCPK *bbn.BIP340PubKey) (int, error) {
 // the signatures on the slashing tx, such that the slashing tx obtains full
 // witness and can be submitted to Bitcoin.
 // This happens after the finality provider is slashed and its SK is extracted.
-// TODO: verify not used
 func (d *BTCDelegation) BuildSlashingTxWithWitness(bsParams *Params, btcNet *chaincfg.Params, fpSK *btcec.PrivateKey) (*wire.MsgTx, error) {
 	stakingMsgTx, err := bbn.NewBTCTxFromBytes(d.StakingTx)
 	if err != nil {
@@ -348,7 +588,11 @@ func (d *BTCDelegation) BuildSlashingTxWithWitness(bsParams *Params, btcNet *cha
 	if err != nil {
 		return nil, err
 	}
-	covAdaptorSigs, err := GetOrderedCovenantSignatures(fpIdx, d.CovenantSigs, bsParams)
+	covenantSigners, _, err := bsParams.CovenantSigners(d.ScriptTemplateVersion)
+	if err != nil {
+		return nil, err
+	}
+	covAdaptorSigs, err := GetOrderedCovenantSignaturesBySigners(fpIdx, d.CovenantSigs, covenantSigners)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get ordered covenant adaptor signatures: %w", err)
 	}
@@ -375,7 +619,9 @@ func (d *BTCDelegation) BuildSlashingTxWithWitness(bsParams *Params, btcNet *cha
 	return slashingMsgTxWithWitness, nil
 }
 
-// TODO: verify to remove, func not used by babylon, used in side car processes.
+// BuildUnbondingSlashingTxWithWitness uses the given finality provider's SK to
+// complete the signatures on the unbonding slashing tx, such that the unbonding
+// slashing tx obtains full witness and can be submitted to Bitcoin.
 func (d *BTCDelegation) BuildUnbondingSlashingTxWithWitness(bsParams *Params, btcNet *chaincfg.Params, fpSK *btcec.PrivateKey) (*wire.MsgTx, error) {
 	unbondingMsgTx, err := bbn.NewBTCTxFromBytes(d.BtcUndelegation.UnbondingTx)
 	if err != nil {
@@ -399,7 +645,11 @@ func (d *BTCDelegation) BuildUnbondingSlashingTxWithWitness(bsParams *Params, bt
 	if err != nil {
 		return nil, err
 	}
-	covAdaptorSigs, err := GetOrderedCovenantSignatures(fpIdx, d.BtcUndelegation.CovenantSlashingSigs, bsParams)
+	covenantSigners, _, err := bsParams.CovenantSigners(d.ScriptTemplateVersion)
+	if err != nil {
+		return nil, err
+	}
+	covAdaptorSigs, err := GetOrderedCovenantSignaturesBySigners(fpIdx, d.BtcUndelegation.CovenantSlashingSigs, covenantSigners)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get ordered covenant adaptor signatures: %w", err)
 	}
@@ -454,6 +704,18 @@ func (i *BTCDelegatorDelegationIndex) Add(stakingTxHash chainhash.Hash) error {
 	return nil
 }
 
+// Remove removes the given staking tx hash from the index, and returns
+// whether it was in the index
+func (i *BTCDelegatorDelegationIndex) Remove(stakingTxHash chainhash.Hash) bool {
+	for j, hash := range i.StakingTxHashList {
+		if bytes.Equal(stakingTxHash[:], hash) {
+			i.StakingTxHashList = append(i.StakingTxHashList[:j], i.StakingTxHashList[j+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
 // VotingPower calculates the total voting power of all BTC delegations
 func (dels *BTCDelegatorDelegations) VotingPower(btcHeight uint64, w uint64, covenantQuorum uint32) uint64 {
 	power := uint64(0)
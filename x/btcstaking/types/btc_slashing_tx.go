@@ -13,6 +13,7 @@ import (
 	"github.com/babylonchain/babylon/btcstaking"
 	asig "github.com/babylonchain/babylon/crypto/schnorr-adaptor-signature"
 	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/sigverifier"
 )
 
 type BTCSlashingTx []byte
@@ -247,6 +248,45 @@ func (tx *BTCSlashingTx) EncVerifyAdaptorSignatures(
 
 }
 
+// NewAdaptorSigVerificationRequests creates the requests to verify a list of
+// adaptor signatures, each encrypted by a restaked finality provider PK and
+// signed by the given PK, w.r.t. the given funding output (in staking or
+// unbonding tx), slashing spend info and slashing tx
+func (tx BTCSlashingTx) NewAdaptorSigVerificationRequests(
+	fundingOut *wire.TxOut,
+	slashingSpendInfo *btcstaking.SpendInfo,
+	pk *bbn.BIP340PubKey,
+	fpPKs []bbn.BIP340PubKey,
+	sigs [][]byte,
+) []*sigverifier.Request {
+	reqs := make([]*sigverifier.Request, len(sigs))
+	for i := range sigs {
+		reqs[i] = sigverifier.NewAdaptorSigRequest(
+			tx,
+			fundingOut,
+			slashingSpendInfo.GetPkScriptPath(),
+			pk,
+			&fpPKs[i],
+			sigs[i],
+		)
+	}
+	return reqs
+}
+
+// ParseAdaptorSignatures parses a list of adaptor signatures without verifying
+// them
+func ParseAdaptorSignatures(sigs [][]byte) ([]asig.AdaptorSignature, error) {
+	adaptorSigs := make([]asig.AdaptorSignature, len(sigs))
+	for i := range sigs {
+		adaptorSig, err := asig.NewAdaptorSignatureFromBytes(sigs[i])
+		if err != nil {
+			return nil, err
+		}
+		adaptorSigs[i] = *adaptorSig
+	}
+	return adaptorSigs, nil
+}
+
 // findFPIdxInWitness returns the index of the finality provider's signature
 // in the witness stack of 1-out-of-n multisig from finality providers
 // Note: the signatures are sorted in reverse lexical order since the PKs
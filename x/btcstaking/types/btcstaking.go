@@ -9,12 +9,19 @@ import (
 	asig "github.com/babylonchain/babylon/crypto/schnorr-adaptor-signature"
 	bbn "github.com/babylonchain/babylon/types"
 	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (fp *FinalityProvider) IsSlashed() bool {
 	return fp.SlashedBabylonHeight > 0
 }
 
+// SecuresConsumer returns whether the finality provider secures a consumer
+// chain rather than Babylon
+func (fp *FinalityProvider) SecuresConsumer() bool {
+	return fp.ConsumerId != ""
+}
+
 func (fp *FinalityProvider) ValidateBasic() error {
 	// ensure fields are non-empty and well-formatted
 	if fp.BabylonPk == nil {
@@ -53,10 +60,21 @@ func (fp *FinalityProvider) MustGetPubRand(height uint64) *eots.PublicRand {
 	return pr
 }
 
-// SortFinalityProviders sorts the finality providers slice,
-// from higher to lower voting power
+// SortFinalityProviders sorts the given finality providers such that
+// finality providers securing Babylon are placed before those securing
+// consumer chains, which are grouped by consumer chain ID. Within each group,
+// ineligible finality providers, i.e., jailed ones or those with insufficient
+// self-delegation, are placed after all eligible ones, and finality providers
+// with the same eligibility are in descending order of voting power
 func SortFinalityProviders(fps []*FinalityProviderDistInfo) {
 	sort.SliceStable(fps, func(i, j int) bool {
+		if fps[i].ConsumerId != fps[j].ConsumerId {
+			// the empty consumer ID of Babylon sorts first
+			return fps[i].ConsumerId < fps[j].ConsumerId
+		}
+		if fps[i].IsEligible() != fps[j].IsEligible() {
+			return fps[i].IsEligible()
+		}
 		return fps[i].TotalVotingPower > fps[j].TotalVotingPower
 	})
 }
@@ -76,6 +94,16 @@ func ExistsDup(btcPKs []bbn.BIP340PubKey) bool {
 	return false
 }
 
+// ContainsPK returns whether the given list of PKs contains the given PK
+func ContainsPK(btcPKs []bbn.BIP340PubKey, pk *bbn.BIP340PubKey) bool {
+	for _, btcPK := range btcPKs {
+		if btcPK.Equals(pk) {
+			return true
+		}
+	}
+	return false
+}
+
 func NewSignatureInfo(pk *bbn.BIP340PubKey, sig *bbn.BIP340Signature) *SignatureInfo {
 	return &SignatureInfo{
 		Pk:  pk,
@@ -89,6 +117,13 @@ func NewSignatureInfo(pk *bbn.BIP340PubKey, sig *bbn.BIP340Signature) *Signature
 // the order of covenant adaptor signatures will follow the reverse lexicographical order
 // of signing public keys, in order to be used as tx witness
 func GetOrderedCovenantSignatures(fpIdx int, covSigsList []*CovenantAdaptorSignatures, params *Params) ([]*asig.AdaptorSignature, error) {
+	return GetOrderedCovenantSignaturesBySigners(fpIdx, covSigsList, params.CovenantPks)
+}
+
+// GetOrderedCovenantSignaturesBySigners is GetOrderedCovenantSignatures
+// w.r.t. the given covenant signers, e.g., the aggregate covenant PK for BTC
+// delegations whose covenant committee signs via MuSig2
+func GetOrderedCovenantSignaturesBySigners(fpIdx int, covSigsList []*CovenantAdaptorSignatures, covenantPks []bbn.BIP340PubKey) ([]*asig.AdaptorSignature, error) {
 	// construct the map where
 	// - key is the covenant PK, and
 	// - value is this covenant member's adaptor signature encrypted
@@ -110,7 +145,7 @@ func GetOrderedCovenantSignatures(fpIdx int, covSigsList []*CovenantAdaptorSigna
 	}
 
 	// sort covenant PKs in reverse reverse lexicographical order
-	orderedCovenantPKs := bbn.SortBIP340PKs(params.CovenantPks)
+	orderedCovenantPKs := bbn.SortBIP340PKs(covenantPks)
 
 	// get ordered list of covenant signatures w.r.t. the order of sorted covenant PKs
 	// Note that only a quorum number of covenant signatures needs to be provided
@@ -137,3 +172,74 @@ func MinimumUnbondingTime(
 		checkpointingParams.CheckpointFinalizationTimeout,
 	)
 }
+
+// StakingTxActivationDepth returns the number of BTC blocks on top of the block
+// including the staking tx, after which a BTC delegation becomes active. It is
+// the StakingTxActivationDepth in the params if set, or the
+// BtcConfirmationDepth of the BTC checkpoint module otherwise
+func StakingTxActivationDepth(
+	stakingParams Params,
+	checkpointingParams btcctypes.Params) uint64 {
+	if stakingParams.StakingTxActivationDepth != 0 {
+		return uint64(stakingParams.StakingTxActivationDepth)
+	}
+	return checkpointingParams.BtcConfirmationDepth
+}
+
+// MaxFpStatusReasonLength is the maximum length of the reason of a finality
+// provider status report
+const MaxFpStatusReasonLength = 280
+
+// MaxPauseReasonLength is the maximum length of the reason of an update of the
+// pause state
+const MaxPauseReasonLength = 280
+
+// Validate performs basic validation of the pause state
+func (ps *PauseState) Validate() error {
+	if ps.UpdatedBy != "" {
+		if _, err := sdk.AccAddressFromBech32(ps.UpdatedBy); err != nil {
+			return fmt.Errorf("invalid address of the last updater: %w", err)
+		}
+	}
+	if len(ps.Reason) > MaxPauseReasonLength {
+		return fmt.Errorf("reason is longer than %d bytes", MaxPauseReasonLength)
+	}
+	return nil
+}
+
+// Validate performs basic validation of the status report. A report with the
+// OPERATIONAL status withdraws the previous announcement, and thus carries no
+// window.
+func (r *FinalityProviderStatusReport) Validate() error {
+	if r.FpBtcPk == nil {
+		return ErrInvalidFpStatusReport.Wrap("empty finality provider BTC PK")
+	}
+	if _, err := r.FpBtcPk.ToBTCPK(); err != nil {
+		return ErrInvalidFpStatusReport.Wrapf("invalid finality provider BTC PK: %v", err)
+	}
+	if _, ok := FinalityProviderOperationalStatus_name[int32(r.Status)]; !ok {
+		return ErrInvalidFpStatusReport.Wrapf("unknown status %d", r.Status)
+	}
+	if len(r.Reason) > MaxFpStatusReasonLength {
+		return ErrInvalidFpStatusReport.Wrapf("reason is longer than %d bytes", MaxFpStatusReasonLength)
+	}
+	if r.Status == FinalityProviderOperationalStatus_OPERATIONAL {
+		if r.StartHeight != 0 || r.EndHeight != 0 {
+			return ErrInvalidFpStatusReport.Wrap("the OPERATIONAL status cannot have a window")
+		}
+		return nil
+	}
+	if r.StartHeight == 0 || r.EndHeight < r.StartHeight {
+		return ErrInvalidFpStatusReport.Wrapf("invalid window [%d, %d]", r.StartHeight, r.EndHeight)
+	}
+	return nil
+}
+
+// InWindow returns whether the given Babylon height is within the window
+// announced by the status report
+func (r *FinalityProviderStatusReport) InWindow(height uint64) bool {
+	if r.Status == FinalityProviderOperationalStatus_OPERATIONAL {
+		return false
+	}
+	return r.StartHeight <= height && height <= r.EndHeight
+}
@@ -45,6 +45,10 @@ const (
 	BTCDelegationStatus_UNBONDED BTCDelegationStatus = 2
 	// ANY is any of the above status
 	BTCDelegationStatus_ANY BTCDelegationStatus = 3
+	// COMPROMISED defines a delegation whose staking output is spent by a tx
+	// that is neither its unbonding tx nor its slashing tx. It no longer has
+	// voting power
+	BTCDelegationStatus_COMPROMISED BTCDelegationStatus = 4
 )
 
 var BTCDelegationStatus_name = map[int32]string{
@@ -52,13 +56,15 @@ var BTCDelegationStatus_name = map[int32]string{
 	1: "ACTIVE",
 	2: "UNBONDED",
 	3: "ANY",
+	4: "COMPROMISED",
 }
 
 var BTCDelegationStatus_value = map[string]int32{
-	"PENDING":  0,
-	"ACTIVE":   1,
-	"UNBONDED": 2,
-	"ANY":      3,
+	"PENDING":     0,
+	"ACTIVE":      1,
+	"UNBONDED":    2,
+	"ANY":         3,
+	"COMPROMISED": 4,
 }
 
 func (x BTCDelegationStatus) String() string {
@@ -69,6 +75,83 @@ func (BTCDelegationStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_3851ae95ccfaf7db, []int{0}
 }
 
+// FinalityProviderOperationalStatus is the operational status self-reported by
+// a finality provider
+type FinalityProviderOperationalStatus int32
+
+const (
+	// OPERATIONAL means the finality provider operates normally
+	FinalityProviderOperationalStatus_OPERATIONAL FinalityProviderOperationalStatus = 0
+	// PLANNED_DOWNTIME means the finality provider plans to be offline for
+	// maintenance
+	FinalityProviderOperationalStatus_PLANNED_DOWNTIME FinalityProviderOperationalStatus = 1
+	// KEY_MIGRATION means the finality provider plans to migrate its keys or
+	// infrastructure
+	FinalityProviderOperationalStatus_KEY_MIGRATION FinalityProviderOperationalStatus = 2
+)
+
+var FinalityProviderOperationalStatus_name = map[int32]string{
+	0: "OPERATIONAL",
+	1: "PLANNED_DOWNTIME",
+	2: "KEY_MIGRATION",
+}
+
+var FinalityProviderOperationalStatus_value = map[string]int32{
+	"OPERATIONAL":      0,
+	"PLANNED_DOWNTIME": 1,
+	"KEY_MIGRATION":    2,
+}
+
+func (x FinalityProviderOperationalStatus) String() string {
+	return proto.EnumName(FinalityProviderOperationalStatus_name, int32(x))
+}
+
+func (FinalityProviderOperationalStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{1}
+}
+
+// BTCTxType is the type of a BTC tx known to Babylon
+type BTCTxType int32
+
+const (
+	// STAKING_TX is the staking tx of a BTC delegation
+	BTCTxType_STAKING_TX BTCTxType = 0
+	// UNBONDING_TX is the unbonding tx of a BTC delegation
+	BTCTxType_UNBONDING_TX BTCTxType = 1
+	// SLASHING_TX is the slashing tx spending the staking tx of a BTC
+	// delegation
+	BTCTxType_SLASHING_TX BTCTxType = 2
+	// UNBONDING_SLASHING_TX is the slashing tx spending the unbonding tx of a
+	// BTC delegation
+	BTCTxType_UNBONDING_SLASHING_TX BTCTxType = 3
+	// CHECKPOINT_SUBMISSION_TX is a BTC tx of a checkpoint submission
+	BTCTxType_CHECKPOINT_SUBMISSION_TX BTCTxType = 4
+)
+
+var BTCTxType_name = map[int32]string{
+	0: "STAKING_TX",
+	1: "UNBONDING_TX",
+	2: "SLASHING_TX",
+	3: "UNBONDING_SLASHING_TX",
+	4: "CHECKPOINT_SUBMISSION_TX",
+}
+
+var BTCTxType_value = map[string]int32{
+	"STAKING_TX":               0,
+	"UNBONDING_TX":             1,
+	"SLASHING_TX":              2,
+	"UNBONDING_SLASHING_TX":    3,
+	"CHECKPOINT_SUBMISSION_TX": 4,
+}
+
+func (x BTCTxType) String() string {
+	return proto.EnumName(BTCTxType_name, int32(x))
+}
+
+func (BTCTxType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{2}
+}
+
 // FinalityProvider defines a finality provider
 type FinalityProvider struct {
 	// description defines the description terms for the finality provider.
@@ -95,6 +178,13 @@ type FinalityProvider struct {
 	// the finality provider is slashed.
 	// if it's 0 then the finality provider is not slashed
 	SlashedBtcHeight uint64 `protobuf:"varint,9,opt,name=slashed_btc_height,json=slashedBtcHeight,proto3" json:"slashed_btc_height,omitempty"`
+	// jailed defines whether the finality provider is jailed due to
+	// insufficient liveness. A jailed finality provider has no voting power
+	Jailed bool `protobuf:"varint,10,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// consumer_id is the chain ID of the consumer chain secured by this
+	// finality provider, as registered in zoneconcierge. It is empty if the
+	// finality provider secures Babylon itself
+	ConsumerId string `protobuf:"bytes,11,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
 func (m *FinalityProvider) Reset()         { *m = FinalityProvider{} }
@@ -179,6 +269,20 @@ func (m *FinalityProvider) GetSlashedBtcHeight() uint64 {
 	return 0
 }
 
+func (m *FinalityProvider) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *FinalityProvider) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
 // FinalityProviderWithMeta wraps the FinalityProvider with metadata.
 type FinalityProviderWithMeta struct {
 	// btc_pk is the Bitcoin secp256k1 PK of thisfinality provider
@@ -324,6 +428,44 @@ type BTCDelegation struct {
 	BtcUndelegation *BTCUndelegation `protobuf:"bytes,14,opt,name=btc_undelegation,json=btcUndelegation,proto3" json:"btc_undelegation,omitempty"`
 	// version of the params used to validate the delegation
 	ParamsVersion uint32 `protobuf:"varint,15,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// script_template_version is the version of the script template that the
+	// staking and unbonding outputs of the delegation are built with.
+	// 0 refers to the script template of BTC delegations created before script
+	// templates were versioned
+	ScriptTemplateVersion uint32 `protobuf:"varint,16,opt,name=script_template_version,json=scriptTemplateVersion,proto3" json:"script_template_version,omitempty"`
+	// slashing_rate is the slashing rate of the params the delegation is
+	// created under, which the slashing txs of the delegation commit to.
+	// It is nil for BTC delegations created before it was recorded
+	SlashingRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,17,opt,name=slashing_rate,json=slashingRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slashing_rate"`
+	// slashing_address is the slashing address of the params the delegation
+	// is created under, which the slashing txs of the delegation pay to.
+	// It is empty for BTC delegations created before it was recorded
+	SlashingAddress string `protobuf:"bytes,18,opt,name=slashing_address,json=slashingAddress,proto3" json:"slashing_address,omitempty"`
+	// compromising_spend_tx_hash is the hash of the tx that spends the
+	// staking output outside the protocol, i.e., neither the unbonding tx nor
+	// the slashing tx. It is empty unless the delegation is compromised
+	CompromisingSpendTxHash string `protobuf:"bytes,19,opt,name=compromising_spend_tx_hash,json=compromisingSpendTxHash,proto3" json:"compromising_spend_tx_hash,omitempty"`
+	// memo is an optional label of the delegation set by the staker upon
+	// creation, e.g., a client or batch ID of a custodian
+	Memo string `protobuf:"bytes,20,opt,name=memo,proto3" json:"memo,omitempty"`
+	// created_babylon_height is the Babylon height at which the delegation is
+	// created. It is 0 for BTC delegations created before it was recorded
+	CreatedBabylonHeight uint64 `protobuf:"varint,21,opt,name=created_babylon_height,json=createdBabylonHeight,proto3" json:"created_babylon_height,omitempty"`
+	// activation_height is the BTC height at which the delegation becomes
+	// active once it has covenant quorum, i.e., the height of the block
+	// including the staking tx plus the staking tx activation depth. It is 0
+	// for BTC delegations created before it was recorded
+	ActivationHeight uint64 `protobuf:"varint,22,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// staking_tx_header_hash is the hash of the BTC header including the
+	// staking tx, which has to remain on the canonical BTC chain until the
+	// delegation becomes active
+	StakingTxHeaderHash *github_com_babylonchain_babylon_types.BTCHeaderHashBytes `protobuf:"bytes,23,opt,name=staking_tx_header_hash,json=stakingTxHeaderHash,proto3,customtype=github.com/babylonchain/babylon/types.BTCHeaderHashBytes" json:"staking_tx_header_hash,omitempty"`
+	// reward_address is the Babylon address receiving the rewards of the
+	// delegation. If empty, the rewards go to the address of babylon_pk
+	RewardAddress string `protobuf:"bytes,24,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"`
+	// withdrawal_btc_address is the BTC address the staker intends to
+	// withdraw the staked BTC to, if specified upon creation
+	WithdrawalBtcAddress string `protobuf:"bytes,25,opt,name=withdrawal_btc_address,json=withdrawalBtcAddress,proto3" json:"withdrawal_btc_address,omitempty"`
 }
 
 func (m *BTCDelegation) Reset()         { *m = BTCDelegation{} }
@@ -436,6 +578,62 @@ func (m *BTCDelegation) GetParamsVersion() uint32 {
 	return 0
 }
 
+func (m *BTCDelegation) GetScriptTemplateVersion() uint32 {
+	if m != nil {
+		return m.ScriptTemplateVersion
+	}
+	return 0
+}
+
+func (m *BTCDelegation) GetSlashingAddress() string {
+	if m != nil {
+		return m.SlashingAddress
+	}
+	return ""
+}
+
+func (m *BTCDelegation) GetCompromisingSpendTxHash() string {
+	if m != nil {
+		return m.CompromisingSpendTxHash
+	}
+	return ""
+}
+
+func (m *BTCDelegation) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *BTCDelegation) GetCreatedBabylonHeight() uint64 {
+	if m != nil {
+		return m.CreatedBabylonHeight
+	}
+	return 0
+}
+
+func (m *BTCDelegation) GetActivationHeight() uint64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *BTCDelegation) GetRewardAddress() string {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return ""
+}
+
+func (m *BTCDelegation) GetWithdrawalBtcAddress() string {
+	if m != nil {
+		return m.WithdrawalBtcAddress
+	}
+	return ""
+}
+
 // BTCUndelegation contains the information about the early unbonding path of the BTC delegation
 type BTCUndelegation struct {
 	// unbonding_tx is the transaction which will transfer the funds from staking
@@ -520,6 +718,138 @@ func (m *BTCUndelegation) GetCovenantUnbondingSigList() []*SignatureInfo {
 	return nil
 }
 
+// BTCDelegationTxs contains the raw BTC txs of a BTC delegation. They are
+// stored separately from the BTC delegation, so that iterating BTC
+// delegations in hot paths, e.g., voting power calculation, does not load
+// them
+type BTCDelegationTxs struct {
+	// staking_tx is the staking tx
+	StakingTx []byte `protobuf:"bytes,1,opt,name=staking_tx,json=stakingTx,proto3" json:"staking_tx,omitempty"`
+	// slashing_tx is the slashing tx spending the staking tx
+	SlashingTx *BTCSlashingTx `protobuf:"bytes,2,opt,name=slashing_tx,json=slashingTx,proto3,customtype=BTCSlashingTx" json:"slashing_tx,omitempty"`
+	// unbonding_tx is the unbonding tx
+	UnbondingTx []byte `protobuf:"bytes,3,opt,name=unbonding_tx,json=unbondingTx,proto3" json:"unbonding_tx,omitempty"`
+	// unbonding_slashing_tx is the slashing tx spending the unbonding tx
+	UnbondingSlashingTx *BTCSlashingTx `protobuf:"bytes,4,opt,name=unbonding_slashing_tx,json=unbondingSlashingTx,proto3,customtype=BTCSlashingTx" json:"unbonding_slashing_tx,omitempty"`
+}
+
+func (m *BTCDelegationTxs) Reset()         { *m = BTCDelegationTxs{} }
+func (m *BTCDelegationTxs) String() string { return proto.CompactTextString(m) }
+func (*BTCDelegationTxs) ProtoMessage()    {}
+func (*BTCDelegationTxs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{4}
+}
+func (m *BTCDelegationTxs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCDelegationTxs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCDelegationTxs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCDelegationTxs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCDelegationTxs.Merge(m, src)
+}
+func (m *BTCDelegationTxs) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCDelegationTxs) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCDelegationTxs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCDelegationTxs proto.InternalMessageInfo
+
+func (m *BTCDelegationTxs) GetStakingTx() []byte {
+	if m != nil {
+		return m.StakingTx
+	}
+	return nil
+}
+
+func (m *BTCDelegationTxs) GetUnbondingTx() []byte {
+	if m != nil {
+		return m.UnbondingTx
+	}
+	return nil
+}
+
+// WatchtowerBackup is the backup data that the staker of a BTC delegation has
+// deposited for a watchtower designated by the staker. It is stored apart from
+// the BTC delegation. The backup data is public, and its confidentiality
+// relies solely on the staker's encryption for the watchtower
+type WatchtowerBackup struct {
+	// staking_tx_hash is the hash of the staking tx of the BTC delegation
+	StakingTxHash string `protobuf:"bytes,1,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// watchtower_address is the Babylon address of the watchtower designated
+	// by the staker
+	WatchtowerAddress string `protobuf:"bytes,2,opt,name=watchtower_address,json=watchtowerAddress,proto3" json:"watchtower_address,omitempty"`
+	// encrypted_backup is the backup data, e.g., a pre-signed tx sweeping the
+	// timelock path of the staking output, encrypted by the staker for the
+	// watchtower. It is public ciphertext
+	EncryptedBackup []byte `protobuf:"bytes,3,opt,name=encrypted_backup,json=encryptedBackup,proto3" json:"encrypted_backup,omitempty"`
+}
+
+func (m *WatchtowerBackup) Reset()         { *m = WatchtowerBackup{} }
+func (m *WatchtowerBackup) String() string { return proto.CompactTextString(m) }
+func (*WatchtowerBackup) ProtoMessage()    {}
+func (*WatchtowerBackup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{5}
+}
+func (m *WatchtowerBackup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchtowerBackup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchtowerBackup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchtowerBackup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchtowerBackup.Merge(m, src)
+}
+func (m *WatchtowerBackup) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchtowerBackup) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchtowerBackup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchtowerBackup proto.InternalMessageInfo
+
+func (m *WatchtowerBackup) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
+}
+
+func (m *WatchtowerBackup) GetWatchtowerAddress() string {
+	if m != nil {
+		return m.WatchtowerAddress
+	}
+	return ""
+}
+
+func (m *WatchtowerBackup) GetEncryptedBackup() []byte {
+	if m != nil {
+		return m.EncryptedBackup
+	}
+	return nil
+}
+
 // BTCDelegatorDelegations is a collection of BTC delegations from the same delegator.
 type BTCDelegatorDelegations struct {
 	Dels []*BTCDelegation `protobuf:"bytes,1,rep,name=dels,proto3" json:"dels,omitempty"`
@@ -529,7 +859,7 @@ func (m *BTCDelegatorDelegations) Reset()         { *m = BTCDelegatorDelegations
 func (m *BTCDelegatorDelegations) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegatorDelegations) ProtoMessage()    {}
 func (*BTCDelegatorDelegations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{4}
+	return fileDescriptor_3851ae95ccfaf7db, []int{6}
 }
 func (m *BTCDelegatorDelegations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -574,7 +904,7 @@ func (m *BTCDelegatorDelegationIndex) Reset()         { *m = BTCDelegatorDelegat
 func (m *BTCDelegatorDelegationIndex) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegatorDelegationIndex) ProtoMessage()    {}
 func (*BTCDelegatorDelegationIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{5}
+	return fileDescriptor_3851ae95ccfaf7db, []int{7}
 }
 func (m *BTCDelegatorDelegationIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -620,7 +950,7 @@ func (m *SignatureInfo) Reset()         { *m = SignatureInfo{} }
 func (m *SignatureInfo) String() string { return proto.CompactTextString(m) }
 func (*SignatureInfo) ProtoMessage()    {}
 func (*SignatureInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{6}
+	return fileDescriptor_3851ae95ccfaf7db, []int{8}
 }
 func (m *SignatureInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -662,7 +992,7 @@ func (m *CovenantAdaptorSignatures) Reset()         { *m = CovenantAdaptorSignat
 func (m *CovenantAdaptorSignatures) String() string { return proto.CompactTextString(m) }
 func (*CovenantAdaptorSignatures) ProtoMessage()    {}
 func (*CovenantAdaptorSignatures) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{7}
+	return fileDescriptor_3851ae95ccfaf7db, []int{9}
 }
 func (m *CovenantAdaptorSignatures) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -698,37 +1028,39 @@ func (m *CovenantAdaptorSignatures) GetAdaptorSigs() [][]byte {
 	return nil
 }
 
-// SelectiveSlashingEvidence is the evidence that the finality provider
-// selectively slashed a BTC delegation
-// NOTE: it's possible that a slashed finality provider exploits the
-// SelectiveSlashingEvidence endpoint while it is actually slashed due to
-// equivocation. But such behaviour does not affect the system's security
-// or gives any benefit for the adversary
-type SelectiveSlashingEvidence struct {
-	// staking_tx_hash is the hash of the staking tx.
-	// It uniquely identifies a BTC delegation
-	StakingTxHash string `protobuf:"bytes,1,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
-	// fp_btc_pk is the BTC PK of the finality provider who
-	// launches the selective slashing offence
-	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
-	// recovered_fp_btc_sk is the finality provider's BTC SK recovered from
-	// the covenant adaptor/Schnorr signature pair. It is the consequence
-	// of selective slashing.
-	RecoveredFpBtcSk []byte `protobuf:"bytes,3,opt,name=recovered_fp_btc_sk,json=recoveredFpBtcSk,proto3" json:"recovered_fp_btc_sk,omitempty"`
+// CovenantMuSig2Nonces is a list of MuSig2 public nonces of a covenant member
+// for co-signing the transactions of a BTC delegation whose script template
+// has the covenant committee sign via MuSig2. Each public nonce is 66 bytes,
+// i.e., two compressed points, and is used in exactly one signing session.
+type CovenantMuSig2Nonces struct {
+	// cov_pk is the public key of the covenant member
+	CovPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=cov_pk,json=covPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"cov_pk,omitempty"`
+	// slashing_tx_nonces is a list of public nonces for the adaptor signatures
+	// on the slashing tx, one for each restaked finality provider in the order
+	// of finality providers of the BTC delegation
+	SlashingTxNonces [][]byte `protobuf:"bytes,2,rep,name=slashing_tx_nonces,json=slashingTxNonces,proto3" json:"slashing_tx_nonces,omitempty"`
+	// unbonding_tx_nonce is the public nonce for the signature on the
+	// unbonding tx
+	UnbondingTxNonce []byte `protobuf:"bytes,3,opt,name=unbonding_tx_nonce,json=unbondingTxNonce,proto3" json:"unbonding_tx_nonce,omitempty"`
+	// slashing_unbonding_tx_nonces is a list of public nonces for the adaptor
+	// signatures on the slashing tx of the unbonding tx, one for each
+	// restaked finality provider in the order of finality providers of the
+	// BTC delegation
+	SlashingUnbondingTxNonces [][]byte `protobuf:"bytes,4,rep,name=slashing_unbonding_tx_nonces,json=slashingUnbondingTxNonces,proto3" json:"slashing_unbonding_tx_nonces,omitempty"`
 }
 
-func (m *SelectiveSlashingEvidence) Reset()         { *m = SelectiveSlashingEvidence{} }
-func (m *SelectiveSlashingEvidence) String() string { return proto.CompactTextString(m) }
-func (*SelectiveSlashingEvidence) ProtoMessage()    {}
-func (*SelectiveSlashingEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{8}
+func (m *CovenantMuSig2Nonces) Reset()         { *m = CovenantMuSig2Nonces{} }
+func (m *CovenantMuSig2Nonces) String() string { return proto.CompactTextString(m) }
+func (*CovenantMuSig2Nonces) ProtoMessage()    {}
+func (*CovenantMuSig2Nonces) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{10}
 }
-func (m *SelectiveSlashingEvidence) XXX_Unmarshal(b []byte) error {
+func (m *CovenantMuSig2Nonces) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SelectiveSlashingEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CovenantMuSig2Nonces) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SelectiveSlashingEvidence.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CovenantMuSig2Nonces.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -738,387 +1070,1132 @@ func (m *SelectiveSlashingEvidence) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *SelectiveSlashingEvidence) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SelectiveSlashingEvidence.Merge(m, src)
+func (m *CovenantMuSig2Nonces) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CovenantMuSig2Nonces.Merge(m, src)
 }
-func (m *SelectiveSlashingEvidence) XXX_Size() int {
+func (m *CovenantMuSig2Nonces) XXX_Size() int {
 	return m.Size()
 }
-func (m *SelectiveSlashingEvidence) XXX_DiscardUnknown() {
-	xxx_messageInfo_SelectiveSlashingEvidence.DiscardUnknown(m)
+func (m *CovenantMuSig2Nonces) XXX_DiscardUnknown() {
+	xxx_messageInfo_CovenantMuSig2Nonces.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SelectiveSlashingEvidence proto.InternalMessageInfo
+var xxx_messageInfo_CovenantMuSig2Nonces proto.InternalMessageInfo
 
-func (m *SelectiveSlashingEvidence) GetStakingTxHash() string {
+func (m *CovenantMuSig2Nonces) GetSlashingTxNonces() [][]byte {
 	if m != nil {
-		return m.StakingTxHash
+		return m.SlashingTxNonces
 	}
-	return ""
+	return nil
 }
 
-func (m *SelectiveSlashingEvidence) GetRecoveredFpBtcSk() []byte {
+func (m *CovenantMuSig2Nonces) GetUnbondingTxNonce() []byte {
 	if m != nil {
-		return m.RecoveredFpBtcSk
+		return m.UnbondingTxNonce
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("babylon.btcstaking.v1.BTCDelegationStatus", BTCDelegationStatus_name, BTCDelegationStatus_value)
-	proto.RegisterType((*FinalityProvider)(nil), "babylon.btcstaking.v1.FinalityProvider")
-	proto.RegisterType((*FinalityProviderWithMeta)(nil), "babylon.btcstaking.v1.FinalityProviderWithMeta")
-	proto.RegisterType((*BTCDelegation)(nil), "babylon.btcstaking.v1.BTCDelegation")
-	proto.RegisterType((*BTCUndelegation)(nil), "babylon.btcstaking.v1.BTCUndelegation")
-	proto.RegisterType((*BTCDelegatorDelegations)(nil), "babylon.btcstaking.v1.BTCDelegatorDelegations")
-	proto.RegisterType((*BTCDelegatorDelegationIndex)(nil), "babylon.btcstaking.v1.BTCDelegatorDelegationIndex")
-	proto.RegisterType((*SignatureInfo)(nil), "babylon.btcstaking.v1.SignatureInfo")
-	proto.RegisterType((*CovenantAdaptorSignatures)(nil), "babylon.btcstaking.v1.CovenantAdaptorSignatures")
-	proto.RegisterType((*SelectiveSlashingEvidence)(nil), "babylon.btcstaking.v1.SelectiveSlashingEvidence")
+func (m *CovenantMuSig2Nonces) GetSlashingUnbondingTxNonces() [][]byte {
+	if m != nil {
+		return m.SlashingUnbondingTxNonces
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("babylon/btcstaking/v1/btcstaking.proto", fileDescriptor_3851ae95ccfaf7db)
+// CovenantMuSig2PartialSigs is a list of MuSig2 partial signatures of a
+// covenant member on the transactions of a BTC delegation whose script
+// template has the covenant committee sign via MuSig2. Partial signatures are
+// exchanged off-chain, and the ones of all covenant members are combined into
+// the signatures under the aggregate covenant PK of MsgAddCovenantSigs. Each
+// partial signature is 32 bytes.
+type CovenantMuSig2PartialSigs struct {
+	// cov_pk is the public key of the covenant member
+	CovPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=cov_pk,json=covPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"cov_pk,omitempty"`
+	// staking_tx_hash is the hash of the staking tx of the BTC delegation
+	StakingTxHash string `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// slashing_tx_partial_sigs is a list of partial signatures towards the
+	// adaptor signatures on the slashing tx, one for each restaked finality
+	// provider in the order of finality providers of the BTC delegation
+	SlashingTxPartialSigs [][]byte `protobuf:"bytes,3,rep,name=slashing_tx_partial_sigs,json=slashingTxPartialSigs,proto3" json:"slashing_tx_partial_sigs,omitempty"`
+	// unbonding_tx_partial_sig is the partial signature towards the
+	// signature on the unbonding tx
+	UnbondingTxPartialSig []byte `protobuf:"bytes,4,opt,name=unbonding_tx_partial_sig,json=unbondingTxPartialSig,proto3" json:"unbonding_tx_partial_sig,omitempty"`
+	// slashing_unbonding_tx_partial_sigs is a list of partial signatures
+	// towards the adaptor signatures on the slashing tx of the unbonding tx,
+	// one for each restaked finality provider in the order of finality
+	// providers of the BTC delegation
+	SlashingUnbondingTxPartialSigs [][]byte `protobuf:"bytes,5,rep,name=slashing_unbonding_tx_partial_sigs,json=slashingUnbondingTxPartialSigs,proto3" json:"slashing_unbonding_tx_partial_sigs,omitempty"`
 }
 
-var fileDescriptor_3851ae95ccfaf7db = []byte{
-	// 1254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x5d, 0x6f, 0x1b, 0x45,
-	0x17, 0xce, 0xda, 0x8e, 0x53, 0x1f, 0xdb, 0x8d, 0x3b, 0x4d, 0xd3, 0x6d, 0xa3, 0x37, 0xc9, 0xeb,
-	0xb7, 0x6f, 0x15, 0x10, 0xb5, 0x9b, 0xf4, 0x43, 0xc0, 0x05, 0x52, 0x1d, 0xbb, 0x34, 0x6a, 0x9b,
-	0x9a, 0x75, 0x52, 0x04, 0x48, 0xac, 0x66, 0x77, 0x27, 0xeb, 0x95, 0xed, 0x9d, 0x65, 0x67, 0x6c,
-	0xec, 0x1f, 0x81, 0xc4, 0x2d, 0xf7, 0x5c, 0x71, 0xcd, 0x6f, 0x40, 0x5c, 0x56, 0x5c, 0xa1, 0x20,
-	0x45, 0xa8, 0xfd, 0x01, 0xfc, 0x05, 0x34, 0x33, 0xfb, 0x61, 0xb7, 0x09, 0xb4, 0x75, 0xef, 0x3c,
-	0xe7, 0xe3, 0x39, 0x67, 0xce, 0xf3, 0xec, 0xcc, 0x18, 0xae, 0x5b, 0xd8, 0x9a, 0xf4, 0xa9, 0x5f,
-	0xb7, 0xb8, 0xcd, 0x38, 0xee, 0x79, 0xbe, 0x5b, 0x1f, 0x6d, 0x4f, 0xad, 0x6a, 0x41, 0x48, 0x39,
-	0x45, 0x97, 0xa2, 0xb8, 0xda, 0x94, 0x67, 0xb4, 0x7d, 0x75, 0xc5, 0xa5, 0x2e, 0x95, 0x11, 0x75,
-	0xf1, 0x4b, 0x05, 0x5f, 0xbd, 0x62, 0x53, 0x36, 0xa0, 0xcc, 0x54, 0x0e, 0xb5, 0x88, 0x5c, 0x55,
-	0xb5, 0xaa, 0xdb, 0xe1, 0x24, 0xe0, 0xb4, 0xce, 0x88, 0x1d, 0xec, 0xdc, 0xb9, 0xdb, 0xdb, 0xae,
-	0xf7, 0xc8, 0x24, 0x8e, 0xb9, 0x16, 0xc5, 0xa4, 0xfd, 0x58, 0x84, 0xe3, 0xed, 0xfa, 0x4c, 0x47,
-	0x57, 0x37, 0x4e, 0xef, 0x3c, 0xa0, 0x81, 0x0a, 0xa8, 0xfe, 0x94, 0x83, 0xca, 0x7d, 0xcf, 0xc7,
-	0x7d, 0x8f, 0x4f, 0xda, 0x21, 0x1d, 0x79, 0x0e, 0x09, 0x51, 0x0b, 0x8a, 0x0e, 0x61, 0x76, 0xe8,
-	0x05, 0xdc, 0xa3, 0xbe, 0xae, 0x6d, 0x6a, 0x5b, 0xc5, 0x9d, 0xff, 0xd5, 0xa2, 0x1e, 0xd3, 0x9d,
-	0xc9, 0x8a, 0xb5, 0x66, 0x1a, 0x6a, 0x4c, 0xe7, 0xa1, 0xc7, 0x00, 0x36, 0x1d, 0x0c, 0x3c, 0xc6,
-	0x04, 0x4a, 0x66, 0x53, 0xdb, 0x2a, 0x34, 0x6e, 0x1c, 0x9f, 0x6c, 0xac, 0x29, 0x20, 0xe6, 0xf4,
-	0x6a, 0x1e, 0xad, 0x0f, 0x30, 0xef, 0xd6, 0x1e, 0x11, 0x17, 0xdb, 0x93, 0x26, 0xb1, 0x7f, 0xfb,
-	0xf9, 0x06, 0x44, 0x75, 0x9a, 0xc4, 0x36, 0xa6, 0x00, 0xd0, 0x27, 0x00, 0xd1, 0x6e, 0xcc, 0xa0,
-	0xa7, 0x67, 0x65, 0x53, 0x1b, 0x71, 0x53, 0x6a, 0x54, 0xb5, 0x64, 0x54, 0xb5, 0xf6, 0xd0, 0x7a,
-	0x48, 0x26, 0x46, 0x21, 0x4a, 0x69, 0xf7, 0xd0, 0x63, 0xc8, 0x5b, 0xdc, 0x16, 0xb9, 0xb9, 0x4d,
-	0x6d, 0xab, 0xd4, 0xb8, 0x7b, 0x7c, 0xb2, 0xb1, 0xe3, 0x7a, 0xbc, 0x3b, 0xb4, 0x6a, 0x36, 0x1d,
-	0xd4, 0xa3, 0x48, 0xbb, 0x8b, 0x3d, 0x3f, 0x5e, 0xd4, 0xf9, 0x24, 0x20, 0xac, 0xd6, 0xd8, 0x6b,
-	0xdf, 0xba, 0x7d, 0x33, 0x82, 0x5c, 0xb4, 0xb8, 0xdd, 0xee, 0xa1, 0x8f, 0x21, 0x1b, 0xd0, 0x40,
-	0x5f, 0x94, 0x7d, 0x6c, 0xd5, 0x4e, 0xa5, 0xbe, 0xd6, 0x0e, 0x29, 0x3d, 0x7a, 0x72, 0xd4, 0xa6,
-	0x8c, 0x11, 0xb9, 0x0b, 0x43, 0x24, 0xa1, 0xeb, 0xb0, 0x3c, 0xc0, 0x8c, 0x93, 0xd0, 0x0c, 0x86,
-	0x96, 0x19, 0x62, 0xdf, 0xd1, 0xf3, 0x62, 0x3c, 0x46, 0x59, 0x99, 0xdb, 0x43, 0xcb, 0xc0, 0xbe,
-	0x83, 0xde, 0x83, 0x4a, 0x48, 0x5c, 0x4f, 0x98, 0x88, 0x63, 0x92, 0x80, 0xda, 0x5d, 0x7d, 0x69,
-	0x53, 0xdb, 0xca, 0x19, 0xcb, 0xa9, 0xbd, 0x25, 0xcc, 0xe8, 0x36, 0xac, 0xb2, 0x3e, 0x66, 0x5d,
-	0xe2, 0x98, 0xf1, 0x94, 0xba, 0xc4, 0x73, 0xbb, 0x5c, 0x3f, 0x27, 0x13, 0x56, 0x22, 0x6f, 0x43,
-	0x39, 0x1f, 0x48, 0x1f, 0xfa, 0x00, 0x50, 0x92, 0xc5, 0xed, 0x38, 0xa3, 0x20, 0x33, 0x2a, 0x71,
-	0x06, 0xb7, 0x55, 0x74, 0xf5, 0x8f, 0x0c, 0xe8, 0x2f, 0x8b, 0xe5, 0x73, 0x8f, 0x77, 0x1f, 0x13,
-	0x8e, 0xa7, 0xc6, 0xab, 0xbd, 0x8b, 0xf1, 0xae, 0x42, 0x3e, 0xea, 0x26, 0x23, 0xbb, 0x89, 0x56,
-	0xe8, 0xbf, 0x50, 0x1a, 0x51, 0xee, 0xf9, 0xae, 0x19, 0xd0, 0x6f, 0x49, 0x28, 0x75, 0x90, 0x33,
-	0x8a, 0xca, 0xd6, 0x16, 0xa6, 0xd3, 0xa6, 0x9b, 0x7b, 0xdd, 0xe9, 0x2e, 0xbe, 0xe9, 0x74, 0xf3,
-	0x6f, 0x3c, 0xdd, 0xa5, 0x33, 0xa6, 0xfb, 0x57, 0x1e, 0xca, 0x8d, 0x83, 0xdd, 0x26, 0xe9, 0x13,
-	0x17, 0xf3, 0x57, 0x15, 0xaf, 0xcd, 0xa1, 0xf8, 0xcc, 0x3b, 0x54, 0x7c, 0xf6, 0x6d, 0x14, 0xff,
-	0x15, 0x9c, 0x3f, 0x0a, 0x4c, 0xd5, 0x8d, 0xd9, 0xf7, 0x18, 0xd7, 0x73, 0x9b, 0xd9, 0x39, 0x5a,
-	0x2a, 0x1e, 0x05, 0x0d, 0xd1, 0xd4, 0x23, 0x8f, 0x49, 0x4d, 0x30, 0x8e, 0x43, 0x1e, 0x4f, 0x58,
-	0x91, 0x58, 0x94, 0xb6, 0x88, 0x8a, 0xff, 0x00, 0x10, 0xdf, 0x99, 0x25, 0xad, 0x40, 0x7c, 0x27,
-	0x72, 0xaf, 0x41, 0x81, 0x53, 0x8e, 0xfb, 0x26, 0xc3, 0x31, 0x41, 0xe7, 0xa4, 0xa1, 0x83, 0x65,
-	0x6e, 0xb4, 0x41, 0x93, 0x8f, 0xe5, 0xe7, 0x54, 0x32, 0x0a, 0x91, 0xe5, 0x60, 0x2c, 0x59, 0x8e,
-	0xdc, 0x74, 0xc8, 0x83, 0x21, 0x37, 0x3d, 0x67, 0x2c, 0xbf, 0xa1, 0xb2, 0x51, 0x89, 0x3c, 0x4f,
-	0xa4, 0x63, 0xcf, 0x19, 0xa3, 0x1d, 0x28, 0x4a, 0xe6, 0x23, 0x34, 0x90, 0xc4, 0x5c, 0x38, 0x3e,
-	0xd9, 0x10, 0xdc, 0x77, 0x22, 0xcf, 0xc1, 0xd8, 0x00, 0x96, 0xfc, 0x46, 0x5f, 0x43, 0xd9, 0x51,
-	0xaa, 0xa0, 0xa1, 0xc9, 0x3c, 0x57, 0x2f, 0xca, 0xac, 0x8f, 0x8e, 0x4f, 0x36, 0xee, 0xbc, 0xc9,
-	0xec, 0x3a, 0x9e, 0xeb, 0x63, 0x3e, 0x0c, 0x89, 0x51, 0x4a, 0xf0, 0x3a, 0x9e, 0x8b, 0x0e, 0xa1,
-	0x6c, 0xd3, 0x11, 0xf1, 0xb1, 0xcf, 0x05, 0x3c, 0xd3, 0x4b, 0x9b, 0xd9, 0xad, 0xe2, 0xce, 0xcd,
-	0x33, 0x28, 0xde, 0x8d, 0x62, 0xef, 0x39, 0x38, 0x50, 0x08, 0x0a, 0x95, 0x19, 0xa5, 0x18, 0xa6,
-	0xe3, 0xb9, 0x0c, 0xfd, 0x1f, 0xce, 0x0f, 0x7d, 0x8b, 0xfa, 0x8e, 0xdc, 0xab, 0x37, 0x20, 0x7a,
-	0x59, 0x0e, 0xa5, 0x9c, 0x58, 0x0f, 0xbc, 0x01, 0x41, 0x9f, 0x41, 0x45, 0xe8, 0x62, 0xe8, 0x3b,
-	0x89, 0xf2, 0xf5, 0xf3, 0x52, 0x63, 0xd7, 0xcf, 0x68, 0xa0, 0x71, 0xb0, 0x7b, 0x38, 0x15, 0x6d,
-	0x2c, 0x5b, 0xdc, 0x9e, 0x36, 0x88, 0xca, 0x01, 0x0e, 0xf1, 0x80, 0x99, 0x23, 0x12, 0xca, 0xdb,
-	0x67, 0x59, 0x55, 0x56, 0xd6, 0xa7, 0xca, 0x58, 0xfd, 0x21, 0x07, 0xcb, 0x2f, 0x61, 0x09, 0x2d,
-	0x4d, 0x35, 0x3d, 0x56, 0x87, 0x99, 0x51, 0x4c, 0x5b, 0x7e, 0x85, 0xc2, 0xcc, 0xeb, 0x50, 0xf8,
-	0x0d, 0x5c, 0x4e, 0x29, 0x4c, 0x0b, 0x08, 0x32, 0xb3, 0xf3, 0x92, 0x79, 0x29, 0x41, 0x3e, 0x8c,
-	0x81, 0x05, 0xab, 0x14, 0x56, 0xa7, 0x54, 0x13, 0x37, 0x2c, 0x2a, 0xe6, 0xe6, 0xad, 0xb8, 0x92,
-	0xca, 0x27, 0xc2, 0x15, 0x05, 0x8f, 0x60, 0x35, 0x95, 0xd1, 0x54, 0x3d, 0xa6, 0x2f, 0xbe, 0xa5,
-	0x9e, 0x56, 0x12, 0x3d, 0xa5, 0x65, 0x18, 0xb2, 0x61, 0x2d, 0xa9, 0x33, 0x33, 0x4a, 0x75, 0xb0,
-	0xe4, 0x65, 0xb1, 0x6b, 0x67, 0x14, 0x4b, 0xd0, 0xf7, 0xfc, 0x23, 0x6a, 0xe8, 0x31, 0xd0, 0xf4,
-	0xe4, 0xc4, 0x99, 0x52, 0xed, 0xc0, 0xe5, 0xf4, 0x30, 0xa6, 0x61, 0x7a, 0x2a, 0x33, 0xf4, 0x21,
-	0xe4, 0x1c, 0xd2, 0x67, 0xba, 0xf6, 0x8f, 0x85, 0x66, 0x8e, 0x72, 0x43, 0x66, 0x54, 0xf7, 0x61,
-	0xed, 0x74, 0xd0, 0x3d, 0xdf, 0x21, 0x63, 0x54, 0x87, 0x95, 0xf4, 0xa0, 0x31, 0xbb, 0x98, 0x75,
-	0xd5, 0x8e, 0x44, 0xa1, 0x92, 0x71, 0x21, 0x39, 0x72, 0x1e, 0x60, 0xd6, 0x95, 0x4d, 0xfe, 0xa8,
-	0x41, 0x79, 0x66, 0x43, 0xe8, 0x3e, 0x64, 0xe6, 0xbe, 0x81, 0x33, 0x41, 0x0f, 0x3d, 0x84, 0xac,
-	0x50, 0x4a, 0x66, 0x5e, 0xa5, 0x08, 0x94, 0xea, 0x77, 0x1a, 0x5c, 0x39, 0x93, 0x64, 0x71, 0x4b,
-	0xd9, 0x74, 0xf4, 0x0e, 0x1e, 0x0e, 0x36, 0x1d, 0xb5, 0x7b, 0xe2, 0x03, 0xc6, 0xaa, 0x86, 0xd2,
-	0x5e, 0x46, 0x0e, 0xaf, 0x88, 0x93, 0xba, 0xac, 0xfa, 0x8b, 0x06, 0x57, 0x3a, 0xa4, 0x4f, 0x6c,
-	0xee, 0x8d, 0x48, 0x2c, 0xad, 0x96, 0x78, 0xce, 0xf8, 0x36, 0x11, 0xcf, 0x87, 0x97, 0x58, 0x90,
-	0x8d, 0x15, 0x8c, 0xf2, 0x0c, 0x01, 0xc8, 0x80, 0x42, 0x72, 0xa5, 0xcd, 0x79, 0xc1, 0x2e, 0x45,
-	0xb7, 0x19, 0xba, 0x01, 0x17, 0x43, 0x22, 0x34, 0x29, 0x5e, 0x24, 0x11, 0x3a, 0x53, 0x8f, 0xdd,
-	0x92, 0x51, 0x49, 0x5c, 0xf7, 0x45, 0x78, 0xa7, 0xf7, 0x7e, 0x0b, 0x2e, 0xce, 0xc8, 0xac, 0xc3,
-	0x31, 0x1f, 0x32, 0x54, 0x84, 0xa5, 0x76, 0x6b, 0xbf, 0xb9, 0xb7, 0xff, 0x69, 0x65, 0x01, 0x01,
-	0xe4, 0xef, 0xed, 0x1e, 0xec, 0x3d, 0x6d, 0x55, 0x34, 0x54, 0x82, 0x73, 0x87, 0xfb, 0x8d, 0x27,
-	0xfb, 0xcd, 0x56, 0xb3, 0x92, 0x41, 0x4b, 0x90, 0xbd, 0xb7, 0xff, 0x45, 0x25, 0xdb, 0x78, 0xf4,
-	0xeb, 0xf3, 0x75, 0xed, 0xd9, 0xf3, 0x75, 0xed, 0xcf, 0xe7, 0xeb, 0xda, 0xf7, 0x2f, 0xd6, 0x17,
-	0x9e, 0xbd, 0x58, 0x5f, 0xf8, 0xfd, 0xc5, 0xfa, 0xc2, 0x97, 0xff, 0xba, 0x99, 0xf1, 0xf4, 0x3f,
-	0x0b, 0xb9, 0x33, 0x2b, 0x2f, 0xff, 0x59, 0xdc, 0xfa, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x55, 0xdf,
-	0x12, 0xf0, 0x36, 0x0d, 0x00, 0x00,
+func (m *CovenantMuSig2PartialSigs) Reset()         { *m = CovenantMuSig2PartialSigs{} }
+func (m *CovenantMuSig2PartialSigs) String() string { return proto.CompactTextString(m) }
+func (*CovenantMuSig2PartialSigs) ProtoMessage()    {}
+func (*CovenantMuSig2PartialSigs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{11}
+}
+func (m *CovenantMuSig2PartialSigs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CovenantMuSig2PartialSigs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CovenantMuSig2PartialSigs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CovenantMuSig2PartialSigs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CovenantMuSig2PartialSigs.Merge(m, src)
+}
+func (m *CovenantMuSig2PartialSigs) XXX_Size() int {
+	return m.Size()
+}
+func (m *CovenantMuSig2PartialSigs) XXX_DiscardUnknown() {
+	xxx_messageInfo_CovenantMuSig2PartialSigs.DiscardUnknown(m)
 }
 
-func (m *FinalityProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CovenantMuSig2PartialSigs proto.InternalMessageInfo
+
+func (m *CovenantMuSig2PartialSigs) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *FinalityProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CovenantMuSig2PartialSigs) GetSlashingTxPartialSigs() [][]byte {
+	if m != nil {
+		return m.SlashingTxPartialSigs
+	}
+	return nil
 }
 
-func (m *FinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SlashedBtcHeight != 0 {
-		i = encodeVarintBtcstaking(dAtA, i, uint64(m.SlashedBtcHeight))
-		i--
-		dAtA[i] = 0x48
+func (m *CovenantMuSig2PartialSigs) GetUnbondingTxPartialSig() []byte {
+	if m != nil {
+		return m.UnbondingTxPartialSig
 	}
-	if m.SlashedBabylonHeight != 0 {
-		i = encodeVarintBtcstaking(dAtA, i, uint64(m.SlashedBabylonHeight))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.RegisteredEpoch != 0 {
-		i = encodeVarintBtcstaking(dAtA, i, uint64(m.RegisteredEpoch))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.MasterPubRand) > 0 {
-		i -= len(m.MasterPubRand)
-		copy(dAtA[i:], m.MasterPubRand)
-		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.MasterPubRand)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Pop != nil {
-		{
-			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.BtcPk != nil {
-		{
-			size := m.BtcPk.Size()
-			i -= size
-			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (m *CovenantMuSig2PartialSigs) GetSlashingUnbondingTxPartialSigs() [][]byte {
+	if m != nil {
+		return m.SlashingUnbondingTxPartialSigs
 	}
-	if m.BabylonPk != nil {
-		{
-			size, err := m.BabylonPk.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+	return nil
+}
+
+// CovenantPerformance is the signing record of a covenant signer, i.e., a
+// covenant member, or the aggregate covenant PK if the covenant committee
+// signs via MuSig2. Only BTC delegations with a recorded creation height are
+// accounted.
+type CovenantPerformance struct {
+	// cov_pk is the public key of the covenant signer
+	CovPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=cov_pk,json=covPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"cov_pk,omitempty"`
+	// num_signed is the number of BTC delegations signed by the covenant
+	// signer
+	NumSigned uint64 `protobuf:"varint,2,opt,name=num_signed,json=numSigned,proto3" json:"num_signed,omitempty"`
+	// total_signing_blocks is the sum of the numbers of Babylon blocks between
+	// the creation of each BTC delegation signed by the covenant signer and
+	// the inclusion of its signatures
+	TotalSigningBlocks uint64 `protobuf:"varint,3,opt,name=total_signing_blocks,json=totalSigningBlocks,proto3" json:"total_signing_blocks,omitempty"`
+	// num_missed is the number of BTC delegations that reached the covenant
+	// quorum or expired without the signatures of the covenant signer
+	NumMissed uint64 `protobuf:"varint,4,opt,name=num_missed,json=numMissed,proto3" json:"num_missed,omitempty"`
+}
+
+func (m *CovenantPerformance) Reset()         { *m = CovenantPerformance{} }
+func (m *CovenantPerformance) String() string { return proto.CompactTextString(m) }
+func (*CovenantPerformance) ProtoMessage()    {}
+func (*CovenantPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{12}
+}
+func (m *CovenantPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CovenantPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CovenantPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if m.Commission != nil {
-		{
-			size := m.Commission.Size()
-			i -= size
-			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+}
+func (m *CovenantPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CovenantPerformance.Merge(m, src)
+}
+func (m *CovenantPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *CovenantPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_CovenantPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CovenantPerformance proto.InternalMessageInfo
+
+func (m *CovenantPerformance) GetNumSigned() uint64 {
+	if m != nil {
+		return m.NumSigned
 	}
-	if m.Description != nil {
-		{
-			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *CovenantPerformance) GetTotalSigningBlocks() uint64 {
+	if m != nil {
+		return m.TotalSigningBlocks
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *FinalityProviderWithMeta) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CovenantPerformance) GetNumMissed() uint64 {
+	if m != nil {
+		return m.NumMissed
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *FinalityProviderWithMeta) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SelectiveSlashingEvidence is the evidence that the finality provider
+// selectively slashed a BTC delegation
+// NOTE: it's possible that a slashed finality provider exploits the
+// SelectiveSlashingEvidence endpoint while it is actually slashed due to
+// equivocation. But such behaviour does not affect the system's security
+// or gives any benefit for the adversary
+type SelectiveSlashingEvidence struct {
+	// staking_tx_hash is the hash of the staking tx.
+	// It uniquely identifies a BTC delegation
+	StakingTxHash string `protobuf:"bytes,1,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// fp_btc_pk is the BTC PK of the finality provider who
+	// launches the selective slashing offence
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// recovered_fp_btc_sk is the finality provider's BTC SK recovered from
+	// the covenant adaptor/Schnorr signature pair. It is the consequence
+	// of selective slashing.
+	RecoveredFpBtcSk []byte `protobuf:"bytes,3,opt,name=recovered_fp_btc_sk,json=recoveredFpBtcSk,proto3" json:"recovered_fp_btc_sk,omitempty"`
 }
 
-func (m *FinalityProviderWithMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SlashedBtcHeight != 0 {
-		i = encodeVarintBtcstaking(dAtA, i, uint64(m.SlashedBtcHeight))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.SlashedBabylonHeight != 0 {
-		i = encodeVarintBtcstaking(dAtA, i, uint64(m.SlashedBabylonHeight))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.RegisteredEpoch != 0 {
-		i = encodeVarintBtcstaking(dAtA, i, uint64(m.RegisteredEpoch))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.MasterPubRand) > 0 {
-		i -= len(m.MasterPubRand)
-		copy(dAtA[i:], m.MasterPubRand)
-		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.MasterPubRand)))
-		i--
-		dAtA[i] = 0x22
+func (m *SelectiveSlashingEvidence) Reset()         { *m = SelectiveSlashingEvidence{} }
+func (m *SelectiveSlashingEvidence) String() string { return proto.CompactTextString(m) }
+func (*SelectiveSlashingEvidence) ProtoMessage()    {}
+func (*SelectiveSlashingEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{13}
+}
+func (m *SelectiveSlashingEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SelectiveSlashingEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SelectiveSlashingEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.VotingPower != 0 {
-		i = encodeVarintBtcstaking(dAtA, i, uint64(m.VotingPower))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *SelectiveSlashingEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectiveSlashingEvidence.Merge(m, src)
+}
+func (m *SelectiveSlashingEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *SelectiveSlashingEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectiveSlashingEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectiveSlashingEvidence proto.InternalMessageInfo
+
+func (m *SelectiveSlashingEvidence) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
 	}
-	if m.Height != 0 {
-		i = encodeVarintBtcstaking(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *SelectiveSlashingEvidence) GetRecoveredFpBtcSk() []byte {
+	if m != nil {
+		return m.RecoveredFpBtcSk
 	}
-	if m.BtcPk != nil {
-		{
-			size := m.BtcPk.Size()
-			i -= size
-			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+	return nil
+}
+
+// FinalityProviderStatusReport is a finality provider's announcement of a
+// window of Babylon heights during which it does not operate normally
+type FinalityProviderStatusReport struct {
+	// fp_btc_pk is the BTC PK of the finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// status is the announced operational status
+	Status FinalityProviderOperationalStatus `protobuf:"varint,2,opt,name=status,proto3,enum=babylon.btcstaking.v1.FinalityProviderOperationalStatus" json:"status,omitempty"`
+	// start_height is the first Babylon height of the announced window
+	StartHeight uint64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last Babylon height of the announced window
+	EndHeight uint64 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// reason is a human-readable explanation of the announcement
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// reported_height is the Babylon height at which the report is made
+	ReportedHeight uint64 `protobuf:"varint,6,opt,name=reported_height,json=reportedHeight,proto3" json:"reported_height,omitempty"`
+}
+
+func (m *FinalityProviderStatusReport) Reset()         { *m = FinalityProviderStatusReport{} }
+func (m *FinalityProviderStatusReport) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderStatusReport) ProtoMessage()    {}
+func (*FinalityProviderStatusReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{14}
+}
+func (m *FinalityProviderStatusReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderStatusReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderStatusReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *FinalityProviderStatusReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderStatusReport.Merge(m, src)
+}
+func (m *FinalityProviderStatusReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderStatusReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderStatusReport.DiscardUnknown(m)
 }
 
-func (m *BTCDelegation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FinalityProviderStatusReport proto.InternalMessageInfo
+
+func (m *FinalityProviderStatusReport) GetStatus() FinalityProviderOperationalStatus {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return FinalityProviderOperationalStatus_OPERATIONAL
 }
 
-func (m *BTCDelegation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FinalityProviderStatusReport) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
 }
 
-func (m *BTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FinalityProviderStatusReport) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *FinalityProviderStatusReport) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *FinalityProviderStatusReport) GetReportedHeight() uint64 {
+	if m != nil {
+		return m.ReportedHeight
+	}
+	return 0
+}
+
+// PauseState is the state of the emergency pause of the processing of new BTC
+// delegations and early unbondings
+type PauseState struct {
+	// paused indicates whether the processing of MsgCreateBTCDelegation and
+	// MsgBTCUndelegate is paused
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// updated_by is the address of the authority that updates the state
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	// updated_height is the Babylon height at which the state is updated
+	UpdatedHeight uint64 `protobuf:"varint,3,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+	// reason is a human-readable explanation of the update
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *PauseState) Reset()         { *m = PauseState{} }
+func (m *PauseState) String() string { return proto.CompactTextString(m) }
+func (*PauseState) ProtoMessage()    {}
+func (*PauseState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{15}
+}
+func (m *PauseState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseState.Merge(m, src)
+}
+func (m *PauseState) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseState) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseState proto.InternalMessageInfo
+
+func (m *PauseState) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *PauseState) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return ""
+}
+
+func (m *PauseState) GetUpdatedHeight() uint64 {
+	if m != nil {
+		return m.UpdatedHeight
+	}
+	return 0
+}
+
+func (m *PauseState) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// FinalityProviderSetDiff is the change of the active finality provider set
+// of Babylon between a Babylon height and its previous height
+type FinalityProviderSetDiff struct {
+	// babylon_height is the Babylon height at which the change takes effect
+	BabylonHeight uint64 `protobuf:"varint,1,opt,name=babylon_height,json=babylonHeight,proto3" json:"babylon_height,omitempty"`
+	// entered are the finality providers that gain voting rights at the
+	// height, along with their voting power at the height
+	Entered []*FinalityProviderPower `protobuf:"bytes,2,rep,name=entered,proto3" json:"entered,omitempty"`
+	// exited are the finality providers that lose voting rights at the
+	// height, along with their voting power at the previous height
+	Exited []*FinalityProviderPower `protobuf:"bytes,3,rep,name=exited,proto3" json:"exited,omitempty"`
+}
+
+func (m *FinalityProviderSetDiff) Reset()         { *m = FinalityProviderSetDiff{} }
+func (m *FinalityProviderSetDiff) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderSetDiff) ProtoMessage()    {}
+func (*FinalityProviderSetDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{16}
+}
+func (m *FinalityProviderSetDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderSetDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderSetDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProviderSetDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderSetDiff.Merge(m, src)
+}
+func (m *FinalityProviderSetDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderSetDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderSetDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderSetDiff proto.InternalMessageInfo
+
+func (m *FinalityProviderSetDiff) GetBabylonHeight() uint64 {
+	if m != nil {
+		return m.BabylonHeight
+	}
+	return 0
+}
+
+func (m *FinalityProviderSetDiff) GetEntered() []*FinalityProviderPower {
+	if m != nil {
+		return m.Entered
+	}
+	return nil
+}
+
+func (m *FinalityProviderSetDiff) GetExited() []*FinalityProviderPower {
+	if m != nil {
+		return m.Exited
+	}
+	return nil
+}
+
+// FinalityProviderPower is the voting power of a finality provider
+type FinalityProviderPower struct {
+	// fp_btc_pk is the BTC PK of the finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// voting_power is the voting power of the finality provider
+	VotingPower uint64 `protobuf:"varint,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+}
+
+func (m *FinalityProviderPower) Reset()         { *m = FinalityProviderPower{} }
+func (m *FinalityProviderPower) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderPower) ProtoMessage()    {}
+func (*FinalityProviderPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{17}
+}
+func (m *FinalityProviderPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProviderPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderPower.Merge(m, src)
+}
+func (m *FinalityProviderPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderPower proto.InternalMessageInfo
+
+func (m *FinalityProviderPower) GetVotingPower() uint64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
+// BTCTxIndexEntry is the entry of a BTC tx of a BTC delegation other than its
+// staking tx in the BTC tx index
+type BTCTxIndexEntry struct {
+	// tx_type is the type of the BTC tx
+	TxType BTCTxType `protobuf:"varint,1,opt,name=tx_type,json=txType,proto3,enum=babylon.btcstaking.v1.BTCTxType" json:"tx_type,omitempty"`
+	// staking_tx_hash is the hash of the staking tx of the BTC delegation
+	// that the BTC tx belongs to
+	StakingTxHash []byte `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+}
+
+func (m *BTCTxIndexEntry) Reset()         { *m = BTCTxIndexEntry{} }
+func (m *BTCTxIndexEntry) String() string { return proto.CompactTextString(m) }
+func (*BTCTxIndexEntry) ProtoMessage()    {}
+func (*BTCTxIndexEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{18}
+}
+func (m *BTCTxIndexEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCTxIndexEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCTxIndexEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCTxIndexEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCTxIndexEntry.Merge(m, src)
+}
+func (m *BTCTxIndexEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCTxIndexEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCTxIndexEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCTxIndexEntry proto.InternalMessageInfo
+
+func (m *BTCTxIndexEntry) GetTxType() BTCTxType {
+	if m != nil {
+		return m.TxType
+	}
+	return BTCTxType_STAKING_TX
+}
+
+func (m *BTCTxIndexEntry) GetStakingTxHash() []byte {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("babylon.btcstaking.v1.BTCDelegationStatus", BTCDelegationStatus_name, BTCDelegationStatus_value)
+	proto.RegisterEnum("babylon.btcstaking.v1.FinalityProviderOperationalStatus", FinalityProviderOperationalStatus_name, FinalityProviderOperationalStatus_value)
+	proto.RegisterEnum("babylon.btcstaking.v1.BTCTxType", BTCTxType_name, BTCTxType_value)
+	proto.RegisterType((*FinalityProvider)(nil), "babylon.btcstaking.v1.FinalityProvider")
+	proto.RegisterType((*FinalityProviderWithMeta)(nil), "babylon.btcstaking.v1.FinalityProviderWithMeta")
+	proto.RegisterType((*BTCDelegation)(nil), "babylon.btcstaking.v1.BTCDelegation")
+	proto.RegisterType((*BTCUndelegation)(nil), "babylon.btcstaking.v1.BTCUndelegation")
+	proto.RegisterType((*BTCDelegationTxs)(nil), "babylon.btcstaking.v1.BTCDelegationTxs")
+	proto.RegisterType((*WatchtowerBackup)(nil), "babylon.btcstaking.v1.WatchtowerBackup")
+	proto.RegisterType((*BTCDelegatorDelegations)(nil), "babylon.btcstaking.v1.BTCDelegatorDelegations")
+	proto.RegisterType((*BTCDelegatorDelegationIndex)(nil), "babylon.btcstaking.v1.BTCDelegatorDelegationIndex")
+	proto.RegisterType((*SignatureInfo)(nil), "babylon.btcstaking.v1.SignatureInfo")
+	proto.RegisterType((*CovenantAdaptorSignatures)(nil), "babylon.btcstaking.v1.CovenantAdaptorSignatures")
+	proto.RegisterType((*CovenantMuSig2Nonces)(nil), "babylon.btcstaking.v1.CovenantMuSig2Nonces")
+	proto.RegisterType((*CovenantMuSig2PartialSigs)(nil), "babylon.btcstaking.v1.CovenantMuSig2PartialSigs")
+	proto.RegisterType((*CovenantPerformance)(nil), "babylon.btcstaking.v1.CovenantPerformance")
+	proto.RegisterType((*SelectiveSlashingEvidence)(nil), "babylon.btcstaking.v1.SelectiveSlashingEvidence")
+	proto.RegisterType((*FinalityProviderStatusReport)(nil), "babylon.btcstaking.v1.FinalityProviderStatusReport")
+	proto.RegisterType((*PauseState)(nil), "babylon.btcstaking.v1.PauseState")
+	proto.RegisterType((*FinalityProviderSetDiff)(nil), "babylon.btcstaking.v1.FinalityProviderSetDiff")
+	proto.RegisterType((*FinalityProviderPower)(nil), "babylon.btcstaking.v1.FinalityProviderPower")
+	proto.RegisterType((*BTCTxIndexEntry)(nil), "babylon.btcstaking.v1.BTCTxIndexEntry")
+}
+
+func init() {
+	proto.RegisterFile("babylon/btcstaking/v1/btcstaking.proto", fileDescriptor_3851ae95ccfaf7db)
+}
+
+var fileDescriptor_3851ae95ccfaf7db = []byte{
+	// 2216 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x39, 0xcb, 0x73, 0xdb, 0xc6,
+	0xf9, 0x02, 0x49, 0x53, 0xd6, 0x47, 0x52, 0xa2, 0x56, 0x2f, 0xc8, 0x4e, 0x24, 0x85, 0xbf, 0xfc,
+	0x52, 0x25, 0x8d, 0x29, 0x5b, 0x71, 0x1c, 0xa7, 0xed, 0x34, 0x23, 0x8a, 0xb4, 0xcd, 0x5a, 0xa2,
+	0x58, 0x90, 0xb2, 0x9b, 0x76, 0x5a, 0xcc, 0x12, 0x58, 0x91, 0x28, 0x49, 0x00, 0xc1, 0x2e, 0x25,
+	0xf2, 0x9a, 0x7b, 0x3b, 0xbd, 0xf6, 0xde, 0x73, 0x4f, 0xf9, 0x1b, 0x5a, 0x9f, 0x5a, 0x4f, 0xa6,
+	0x87, 0x8e, 0x3b, 0xa3, 0xe9, 0xd8, 0xff, 0x48, 0x67, 0x17, 0x8b, 0x07, 0x29, 0xa9, 0x7e, 0x48,
+	0x3d, 0x89, 0xfb, 0xbd, 0xdf, 0xbb, 0x1f, 0x04, 0x1f, 0xb5, 0x70, 0x6b, 0xd4, 0x73, 0xec, 0xad,
+	0x16, 0x33, 0x28, 0xc3, 0x5d, 0xcb, 0x6e, 0x6f, 0x1d, 0xdf, 0x89, 0x9d, 0x8a, 0xae, 0xe7, 0x30,
+	0x07, 0x2d, 0x49, 0xba, 0x62, 0x0c, 0x73, 0x7c, 0xe7, 0xc6, 0x62, 0xdb, 0x69, 0x3b, 0x82, 0x62,
+	0x8b, 0xff, 0xf2, 0x89, 0x6f, 0xac, 0x1a, 0x0e, 0xed, 0x3b, 0x54, 0xf7, 0x11, 0xfe, 0x41, 0xa2,
+	0x0a, 0xfe, 0x69, 0xcb, 0xf0, 0x46, 0x2e, 0x73, 0xb6, 0x28, 0x31, 0xdc, 0xed, 0xcf, 0xef, 0x75,
+	0xef, 0x6c, 0x75, 0xc9, 0x28, 0xa0, 0xf9, 0x50, 0xd2, 0x44, 0xf6, 0xb4, 0x08, 0xc3, 0x77, 0xb6,
+	0xc6, 0x2c, 0xba, 0xb1, 0x7e, 0xbe, 0xe5, 0xae, 0xe3, 0xfa, 0x04, 0x85, 0x57, 0x29, 0xc8, 0x3f,
+	0xb0, 0x6c, 0xdc, 0xb3, 0xd8, 0xa8, 0xee, 0x39, 0xc7, 0x96, 0x49, 0x3c, 0x54, 0x81, 0x8c, 0x49,
+	0xa8, 0xe1, 0x59, 0x2e, 0xb3, 0x1c, 0x5b, 0x55, 0x36, 0x94, 0xcd, 0xcc, 0xf6, 0xff, 0x15, 0xa5,
+	0x8d, 0x91, 0x67, 0x42, 0x63, 0xb1, 0x1c, 0x91, 0x6a, 0x71, 0x3e, 0xb4, 0x0f, 0x60, 0x38, 0xfd,
+	0xbe, 0x45, 0x29, 0x97, 0x92, 0xd8, 0x50, 0x36, 0x67, 0x4a, 0xb7, 0x5e, 0x9c, 0xae, 0xdf, 0xf4,
+	0x05, 0x51, 0xb3, 0x5b, 0xb4, 0x9c, 0xad, 0x3e, 0x66, 0x9d, 0xe2, 0x1e, 0x69, 0x63, 0x63, 0x54,
+	0x26, 0xc6, 0xf7, 0xdf, 0xdd, 0x02, 0xa9, 0xa7, 0x4c, 0x0c, 0x2d, 0x26, 0x00, 0xfd, 0x14, 0x40,
+	0x7a, 0xa3, 0xbb, 0x5d, 0x35, 0x29, 0x8c, 0x5a, 0x0f, 0x8c, 0xf2, 0x43, 0x55, 0x0c, 0x43, 0x55,
+	0xac, 0x0f, 0x5a, 0x8f, 0xc9, 0x48, 0x9b, 0x91, 0x2c, 0xf5, 0x2e, 0xda, 0x87, 0x74, 0x8b, 0x19,
+	0x9c, 0x37, 0xb5, 0xa1, 0x6c, 0x66, 0x4b, 0xf7, 0x5e, 0x9c, 0xae, 0x6f, 0xb7, 0x2d, 0xd6, 0x19,
+	0xb4, 0x8a, 0x86, 0xd3, 0xdf, 0x92, 0x94, 0x46, 0x07, 0x5b, 0x76, 0x70, 0xd8, 0x62, 0x23, 0x97,
+	0xd0, 0x62, 0xa9, 0x5a, 0xff, 0xec, 0xee, 0x6d, 0x29, 0xf2, 0x5a, 0x8b, 0x19, 0xf5, 0x2e, 0xfa,
+	0x11, 0x24, 0x5d, 0xc7, 0x55, 0xaf, 0x09, 0x3b, 0x36, 0x8b, 0xe7, 0xa6, 0xbe, 0x58, 0xf7, 0x1c,
+	0xe7, 0xe8, 0xe0, 0xa8, 0xee, 0x50, 0x4a, 0x84, 0x17, 0x1a, 0x67, 0x42, 0x1f, 0xc1, 0x5c, 0x1f,
+	0x53, 0x46, 0x3c, 0xdd, 0x1d, 0xb4, 0x74, 0x0f, 0xdb, 0xa6, 0x9a, 0xe6, 0xe1, 0xd1, 0x72, 0x3e,
+	0xb8, 0x3e, 0x68, 0x69, 0xd8, 0x36, 0xd1, 0xc7, 0x90, 0xf7, 0x48, 0xdb, 0xe2, 0x20, 0x62, 0xea,
+	0xc4, 0x75, 0x8c, 0x8e, 0x3a, 0xbd, 0xa1, 0x6c, 0xa6, 0xb4, 0xb9, 0x08, 0x5e, 0xe1, 0x60, 0x74,
+	0x17, 0x96, 0x69, 0x0f, 0xd3, 0x0e, 0x31, 0xf5, 0x20, 0x4a, 0x1d, 0x62, 0xb5, 0x3b, 0x4c, 0xbd,
+	0x2e, 0x18, 0x16, 0x25, 0xb6, 0xe4, 0x23, 0x1f, 0x09, 0x1c, 0xfa, 0x14, 0x50, 0xc8, 0xc5, 0x8c,
+	0x80, 0x63, 0x46, 0x70, 0xe4, 0x03, 0x0e, 0x66, 0x48, 0xea, 0x65, 0x48, 0xff, 0x16, 0x5b, 0x3d,
+	0x62, 0xaa, 0xb0, 0xa1, 0x6c, 0x5e, 0xd7, 0xe4, 0x09, 0xad, 0x43, 0xc6, 0x70, 0x6c, 0x3a, 0xe8,
+	0x13, 0x4f, 0xb7, 0x4c, 0x35, 0x23, 0x5c, 0x81, 0x00, 0x54, 0x35, 0x0b, 0xff, 0x4a, 0x80, 0x3a,
+	0x59, 0x65, 0x4f, 0x2d, 0xd6, 0xd9, 0x27, 0x0c, 0xc7, 0xf2, 0xa2, 0x5c, 0x45, 0x5e, 0x96, 0x21,
+	0x2d, 0xdd, 0x48, 0x08, 0x37, 0xe4, 0x09, 0x7d, 0x00, 0xd9, 0x63, 0x87, 0x59, 0x76, 0x5b, 0x77,
+	0x9d, 0x13, 0xe2, 0x89, 0x02, 0x4a, 0x69, 0x19, 0x1f, 0x56, 0xe7, 0xa0, 0xf3, 0xd2, 0x92, 0x7a,
+	0xd3, 0xb4, 0x5c, 0x7b, 0xdb, 0xb4, 0xa4, 0xdf, 0x3a, 0x2d, 0xd3, 0xe7, 0xa7, 0xa5, 0xf0, 0x2c,
+	0x03, 0xb9, 0x52, 0x73, 0xb7, 0x4c, 0x7a, 0xa4, 0x8d, 0xd9, 0xd9, 0x56, 0x51, 0x2e, 0xd1, 0x2a,
+	0x89, 0x2b, 0x6c, 0x95, 0xe4, 0xbb, 0xb4, 0xca, 0xaf, 0x60, 0xf6, 0xc8, 0xd5, 0x7d, 0x6b, 0xf4,
+	0x9e, 0x45, 0x99, 0x9a, 0xda, 0x48, 0x5e, 0xc2, 0xa4, 0xcc, 0x91, 0x5b, 0xe2, 0x46, 0xed, 0x59,
+	0x54, 0xd4, 0x04, 0x65, 0xd8, 0x63, 0x41, 0x84, 0xfd, 0x24, 0x66, 0x04, 0x4c, 0xa6, 0xe2, 0x7d,
+	0x00, 0x62, 0x9b, 0xe3, 0x49, 0x9b, 0x21, 0xb6, 0x29, 0xd1, 0x37, 0x61, 0x86, 0x39, 0x0c, 0xf7,
+	0x74, 0x8a, 0x83, 0x04, 0x5d, 0x17, 0x80, 0x06, 0x16, 0xbc, 0xd2, 0x41, 0x9d, 0x0d, 0x45, 0x1f,
+	0x66, 0xb5, 0x19, 0x09, 0x69, 0x0e, 0x45, 0x96, 0x25, 0xda, 0x19, 0x30, 0x77, 0xc0, 0x74, 0xcb,
+	0x1c, 0x8a, 0xe6, 0xcb, 0x69, 0x79, 0x89, 0x39, 0x10, 0x88, 0xaa, 0x39, 0x44, 0xdb, 0x90, 0x11,
+	0x99, 0x97, 0xd2, 0x40, 0x24, 0x66, 0xfe, 0xc5, 0xe9, 0x3a, 0xcf, 0x7d, 0x43, 0x62, 0x9a, 0x43,
+	0x0d, 0x68, 0xf8, 0x1b, 0xfd, 0x06, 0x72, 0xa6, 0x5f, 0x15, 0x8e, 0xa7, 0x53, 0xab, 0x2d, 0x5a,
+	0x33, 0x5b, 0xfa, 0xf2, 0xc5, 0xe9, 0xfa, 0xe7, 0x6f, 0x13, 0xbb, 0x86, 0xd5, 0xb6, 0x31, 0x1b,
+	0x78, 0x44, 0xcb, 0x86, 0xf2, 0x1a, 0x56, 0x1b, 0x1d, 0x42, 0xce, 0x70, 0x8e, 0x89, 0x8d, 0x6d,
+	0xc6, 0xc5, 0x53, 0x35, 0xbb, 0x91, 0xdc, 0xcc, 0x6c, 0xdf, 0xbe, 0x20, 0xc5, 0xbb, 0x92, 0x76,
+	0xc7, 0xc4, 0xae, 0x2f, 0xc1, 0x97, 0x4a, 0xb5, 0x6c, 0x20, 0xa6, 0x61, 0xb5, 0x29, 0xfa, 0x7f,
+	0x98, 0x1d, 0xd8, 0x2d, 0xc7, 0x36, 0x85, 0xaf, 0x56, 0x9f, 0xa8, 0x39, 0x11, 0x94, 0x5c, 0x08,
+	0x6d, 0x5a, 0x7d, 0x82, 0x7e, 0x0e, 0x79, 0x5e, 0x17, 0x03, 0xdb, 0x0c, 0x2b, 0x5f, 0x9d, 0x15,
+	0x35, 0xf6, 0xd1, 0x05, 0x06, 0x94, 0x9a, 0xbb, 0x87, 0x31, 0x6a, 0x6d, 0xae, 0xc5, 0x8c, 0x38,
+	0x80, 0x6b, 0x76, 0xb1, 0x87, 0xfb, 0x54, 0x3f, 0x26, 0x9e, 0xb8, 0xb6, 0xe6, 0x7c, 0xcd, 0x3e,
+	0xf4, 0x89, 0x0f, 0x44, 0xf7, 0x60, 0xc5, 0xbf, 0xe6, 0x74, 0x46, 0xfa, 0x6e, 0x0f, 0x33, 0x12,
+	0xd2, 0xe7, 0x05, 0xfd, 0x92, 0x8f, 0x6e, 0x4a, 0x6c, 0xc0, 0xf7, 0x04, 0x72, 0x61, 0x0e, 0x3d,
+	0xcc, 0x88, 0x3a, 0x2f, 0x2e, 0xc5, 0x3b, 0xcf, 0x4e, 0xd7, 0xa7, 0xde, 0xee, 0x62, 0xcc, 0x06,
+	0x72, 0x34, 0xcc, 0x08, 0x1f, 0x48, 0xa1, 0x5c, 0x6c, 0x9a, 0x1e, 0xa1, 0x54, 0x45, 0x62, 0x72,
+	0xcd, 0x05, 0xf0, 0x1d, 0x1f, 0x8c, 0x7e, 0x0c, 0x37, 0x0c, 0xa7, 0xef, 0x7a, 0x4e, 0xdf, 0xa2,
+	0x9c, 0x9c, 0xba, 0xbc, 0xbc, 0xd9, 0x50, 0xef, 0x60, 0xda, 0x51, 0x17, 0x04, 0xd3, 0x4a, 0x9c,
+	0xa2, 0xc1, 0x09, 0x9a, 0xc3, 0x47, 0x98, 0x76, 0x10, 0x82, 0x54, 0x9f, 0xf4, 0x1d, 0x75, 0x51,
+	0x90, 0x89, 0xdf, 0x7c, 0xc2, 0x19, 0x1e, 0xc1, 0xec, 0xec, 0x84, 0x5b, 0xf2, 0x27, 0x9c, 0xc4,
+	0x8e, 0x4f, 0xb8, 0x1f, 0xc2, 0x3c, 0x36, 0x98, 0x75, 0x2c, 0xc2, 0x1e, 0x30, 0x2c, 0xfb, 0x03,
+	0x2e, 0x42, 0x48, 0xe2, 0x6f, 0x60, 0x39, 0xea, 0x23, 0xbd, 0x43, 0xb0, 0x49, 0x3c, 0xdf, 0xde,
+	0x15, 0x51, 0xcf, 0x3f, 0x79, 0x71, 0xba, 0x7e, 0xff, 0x0d, 0xeb, 0xb9, 0xb9, 0xfb, 0x48, 0xf0,
+	0x73, 0x7f, 0x4a, 0x23, 0x46, 0xa8, 0xb6, 0x10, 0x76, 0x64, 0x84, 0x41, 0x5f, 0xc1, 0xac, 0x47,
+	0x4e, 0xb0, 0x67, 0x86, 0xf1, 0x54, 0x45, 0xaa, 0xd4, 0xef, 0xbf, 0xbb, 0xb5, 0x28, 0xf3, 0x20,
+	0x43, 0xda, 0x60, 0x1e, 0xcf, 0x43, 0xce, 0xa7, 0x0f, 0xe2, 0x7c, 0x17, 0x96, 0x4f, 0x2c, 0xd6,
+	0x31, 0x3d, 0x7c, 0x82, 0x7b, 0x62, 0x7e, 0x05, 0x82, 0x56, 0x45, 0xf0, 0x16, 0x23, 0x6c, 0x89,
+	0x19, 0x92, 0xab, 0xf0, 0xc7, 0x14, 0xcc, 0x4d, 0x14, 0x29, 0x1f, 0x52, 0xb1, 0x6e, 0x18, 0xfa,
+	0xb7, 0xa4, 0x96, 0x89, 0x7a, 0xe1, 0xcc, 0x6c, 0x48, 0xbc, 0xc9, 0x6c, 0xf8, 0x06, 0x56, 0xa2,
+	0xd9, 0x10, 0x29, 0xe0, 0x53, 0x22, 0x79, 0xd9, 0x29, 0xb1, 0x14, 0x4a, 0x3e, 0x0c, 0x04, 0xf3,
+	0x71, 0xe1, 0xc0, 0x72, 0xa4, 0x32, 0x34, 0x98, 0x6b, 0x4c, 0x5d, 0x56, 0xe3, 0x62, 0x34, 0x97,
+	0xa4, 0x5c, 0xae, 0xf0, 0x08, 0x96, 0xa3, 0xf9, 0x14, 0xd3, 0x47, 0xd5, 0x6b, 0xef, 0x38, 0xa8,
+	0x16, 0xc3, 0x41, 0x15, 0xa9, 0xa1, 0xc8, 0x80, 0x9b, 0xa1, 0x9e, 0xb1, 0x50, 0xfa, 0x37, 0x56,
+	0x5a, 0x28, 0xfb, 0xf0, 0x02, 0x65, 0xa1, 0xf4, 0xaa, 0x7d, 0xe4, 0x68, 0x6a, 0x20, 0x28, 0x1e,
+	0x39, 0x7e, 0x59, 0x15, 0xfe, 0xae, 0x40, 0x7e, 0xec, 0x9a, 0x6f, 0x0e, 0xe9, 0xc4, 0x15, 0xa3,
+	0x4c, 0x5e, 0x31, 0xef, 0x52, 0x18, 0x93, 0xf5, 0x96, 0x3c, 0x5b, 0x6f, 0x15, 0x58, 0x8a, 0xb9,
+	0x19, 0x53, 0x90, 0xba, 0x48, 0xc1, 0x42, 0x48, 0x1f, 0x01, 0x0b, 0x7f, 0x56, 0x20, 0xff, 0x14,
+	0x33, 0xa3, 0xc3, 0xf8, 0xf3, 0xab, 0x84, 0x8d, 0xee, 0x40, 0xbc, 0x8d, 0xe3, 0xcd, 0xce, 0xbb,
+	0x5c, 0xf1, 0x1f, 0x61, 0x51, 0x9f, 0xf2, 0x0e, 0x7d, 0x08, 0xe8, 0x24, 0xe4, 0x0d, 0x9b, 0x2b,
+	0xf1, 0x9a, 0x2e, 0x9d, 0x8f, 0x78, 0x82, 0x4e, 0xfd, 0x18, 0xf2, 0xc4, 0x16, 0x8f, 0x22, 0x31,
+	0xc2, 0xb8, 0x11, 0xd2, 0xe7, 0xb9, 0x10, 0xee, 0xdb, 0x56, 0x68, 0xc0, 0x4a, 0x94, 0x01, 0xc7,
+	0x8b, 0x52, 0x41, 0xd1, 0x7d, 0x48, 0x99, 0xa4, 0x47, 0x55, 0xe5, 0xbf, 0xe6, 0x7a, 0x2c, 0x7f,
+	0x9a, 0xe0, 0x28, 0xd4, 0xe0, 0xe6, 0xf9, 0x42, 0xab, 0xb6, 0x49, 0x86, 0x68, 0x0b, 0x16, 0x27,
+	0xe2, 0xe1, 0x17, 0x15, 0x57, 0x94, 0xd5, 0xe6, 0xc7, 0x82, 0x22, 0xea, 0xe4, 0x4f, 0x0a, 0xe4,
+	0xc6, 0x6a, 0x0a, 0x3d, 0x80, 0xc4, 0xa5, 0x5f, 0xd7, 0x09, 0xb7, 0x8b, 0x1e, 0x43, 0x92, 0x37,
+	0x6b, 0xe2, 0xb2, 0xcd, 0xca, 0xa5, 0x14, 0x7e, 0xa7, 0xc0, 0xea, 0x85, 0x7d, 0xc6, 0x5f, 0xa0,
+	0x86, 0x73, 0x7c, 0x05, 0x4b, 0x81, 0xe1, 0x1c, 0xd7, 0xbb, 0xbc, 0xa6, 0xb1, 0xaf, 0xc3, 0x6f,
+	0xff, 0x84, 0x08, 0x5e, 0x06, 0x87, 0x7a, 0x69, 0xe1, 0xdb, 0x04, 0x2c, 0x06, 0xf6, 0xec, 0x0f,
+	0x1a, 0x56, 0x7b, 0xbb, 0xe6, 0xd8, 0xc6, 0xd5, 0x9b, 0x12, 0xbc, 0xed, 0x65, 0x42, 0x6d, 0xa1,
+	0x44, 0x1a, 0x94, 0x8f, 0xda, 0x50, 0x2a, 0xff, 0x14, 0x50, 0xbc, 0x19, 0x7d, 0x72, 0x59, 0x9e,
+	0xf9, 0x58, 0x4b, 0x0a, 0x72, 0xf4, 0x15, 0xbc, 0x17, 0xca, 0x3e, 0xcb, 0x46, 0xfd, 0xa7, 0xb3,
+	0xb6, 0x1a, 0xd0, 0x1c, 0x4e, 0xf0, 0xd3, 0xc2, 0xf3, 0x04, 0xac, 0x8e, 0x07, 0xa1, 0x8e, 0x3d,
+	0x66, 0xe1, 0x9e, 0x18, 0x73, 0x57, 0x1c, 0x89, 0x73, 0x3a, 0x3d, 0x71, 0x5e, 0xa7, 0x7f, 0x01,
+	0x6a, 0x3c, 0x62, 0xae, 0x6f, 0x91, 0x9f, 0xc8, 0xa4, 0xf0, 0x68, 0x29, 0x8a, 0x5b, 0xdc, 0xde,
+	0x2f, 0x40, 0x1d, 0x8b, 0x42, 0x8c, 0xd3, 0x9f, 0x54, 0xda, 0x52, 0x2c, 0x84, 0x11, 0x27, 0xfa,
+	0x19, 0x14, 0xce, 0x8f, 0xe3, 0x98, 0xee, 0x6b, 0x42, 0xf7, 0xda, 0x39, 0xd1, 0x8c, 0x19, 0x51,
+	0xf8, 0x87, 0x02, 0x0b, 0x41, 0x48, 0xeb, 0xc4, 0x3b, 0x72, 0xbc, 0x3e, 0xe6, 0xb9, 0xba, 0xe2,
+	0x60, 0xbe, 0x0f, 0x60, 0x0f, 0xfa, 0xdc, 0x30, 0x9b, 0x98, 0x72, 0xf5, 0x9d, 0xb1, 0x07, 0xfd,
+	0x86, 0x00, 0xa0, 0xdb, 0xb0, 0x28, 0xf7, 0x14, 0xab, 0x6d, 0x73, 0x67, 0x5a, 0x3d, 0xc7, 0xe8,
+	0x52, 0xb9, 0x05, 0x23, 0x81, 0x6b, 0xf8, 0xa8, 0x92, 0xc0, 0x04, 0x02, 0xf9, 0xd7, 0x17, 0xe2,
+	0xef, 0xc1, 0xbe, 0xc0, 0x7d, 0x01, 0x28, 0xfc, 0x45, 0x81, 0xd5, 0x06, 0xe9, 0x11, 0xfe, 0x56,
+	0x23, 0xc1, 0x4c, 0xaf, 0xf0, 0xcd, 0x9e, 0x3b, 0xf7, 0xa6, 0x43, 0x5c, 0x83, 0x99, 0x70, 0xbb,
+	0xbb, 0xe4, 0xae, 0x39, 0x2d, 0x17, 0x3b, 0x74, 0x0b, 0x16, 0x3c, 0xc2, 0x6f, 0x51, 0xbe, 0x9c,
+	0x4b, 0xe9, 0xb4, 0x1b, 0xf4, 0x4c, 0x88, 0x7a, 0xc0, 0xc9, 0x1b, 0xdd, 0xc2, 0x5f, 0x13, 0xf0,
+	0xde, 0xe4, 0xb7, 0x89, 0x06, 0xc3, 0x6c, 0x40, 0x35, 0xe2, 0x3a, 0x1e, 0x1b, 0xb7, 0x51, 0xb9,
+	0x1a, 0x1b, 0xeb, 0x90, 0xa6, 0x42, 0x87, 0x70, 0x7a, 0x76, 0xfb, 0xfe, 0x05, 0xf7, 0xc5, 0xa4,
+	0x61, 0x07, 0x2e, 0xf1, 0xc4, 0xdd, 0x80, 0x7b, 0xd2, 0x46, 0x29, 0xe7, 0xcc, 0x2a, 0x9b, 0x7c,
+	0xdd, 0x2a, 0x9b, 0x9a, 0x5c, 0x65, 0x97, 0x21, 0xed, 0x11, 0x4c, 0x1d, 0x5b, 0xac, 0xc1, 0x33,
+	0x9a, 0x3c, 0xa1, 0x1f, 0xc0, 0x9c, 0x27, 0x22, 0x41, 0x26, 0xd6, 0xe0, 0xd9, 0x00, 0x2c, 0xbf,
+	0x43, 0x7c, 0xab, 0x00, 0xd4, 0xf1, 0x80, 0x12, 0x6e, 0x1a, 0xe1, 0xf2, 0x5c, 0x7e, 0x32, 0x45,
+	0xd0, 0xae, 0x6b, 0xf2, 0xc4, 0xcd, 0x18, 0xb8, 0xa6, 0xbf, 0x30, 0x8c, 0x64, 0xc7, 0xcf, 0x48,
+	0x48, 0x69, 0x24, 0x96, 0x3f, 0x89, 0x1e, 0x73, 0x25, 0x27, 0xa1, 0x67, 0xac, 0x4d, 0xc5, 0xad,
+	0x2d, 0xfc, 0x4d, 0x81, 0x95, 0x33, 0xe9, 0x24, 0xac, 0x6c, 0x1d, 0x1d, 0x71, 0xd1, 0x13, 0x2b,
+	0x8a, 0xe2, 0x8b, 0x6e, 0x8d, 0xed, 0x26, 0x0f, 0x60, 0x9a, 0xd8, 0xe2, 0x1b, 0x8e, 0x18, 0xcb,
+	0x99, 0xed, 0x4f, 0xdf, 0x30, 0x3b, 0xe2, 0x2b, 0x92, 0x16, 0x30, 0xa3, 0x32, 0xa4, 0xc9, 0xd0,
+	0x62, 0xc4, 0x54, 0x93, 0xef, 0x20, 0x46, 0xf2, 0x16, 0x7e, 0xaf, 0xc0, 0xd2, 0xb9, 0x14, 0xff,
+	0x93, 0xc2, 0x9c, 0xfc, 0x4a, 0x96, 0x38, 0xf3, 0x95, 0xac, 0xc0, 0xc4, 0x8a, 0xd2, 0x1c, 0x8a,
+	0xe7, 0x49, 0xc5, 0x66, 0xde, 0x08, 0x7d, 0x09, 0xd3, 0x6c, 0xa8, 0x73, 0xb9, 0xc2, 0x8e, 0xd9,
+	0xed, 0x8d, 0x8b, 0xdf, 0x3f, 0xcd, 0x61, 0x73, 0xe4, 0x12, 0x2d, 0xcd, 0xc4, 0xdf, 0x8b, 0x2e,
+	0x81, 0xec, 0xc4, 0xa4, 0xf8, 0xe4, 0x09, 0x2c, 0x8c, 0x3d, 0x9e, 0xfc, 0xf2, 0x47, 0x19, 0x98,
+	0xae, 0x57, 0x6a, 0xe5, 0x6a, 0xed, 0x61, 0x7e, 0x0a, 0x01, 0xa4, 0x77, 0x76, 0x9b, 0xd5, 0x27,
+	0x95, 0xbc, 0x82, 0xb2, 0x70, 0xfd, 0xb0, 0x56, 0x3a, 0xa8, 0x95, 0x2b, 0xe5, 0x7c, 0x02, 0x4d,
+	0x43, 0x72, 0xa7, 0xf6, 0x75, 0x3e, 0x89, 0xe6, 0x20, 0xb3, 0x7b, 0xb0, 0x5f, 0xd7, 0x0e, 0xf6,
+	0xab, 0x8d, 0x4a, 0x39, 0x9f, 0xfa, 0xe4, 0xd7, 0xf0, 0xc1, 0x6b, 0x9b, 0x8c, 0x73, 0x1d, 0xd4,
+	0x2b, 0xda, 0x4e, 0xb3, 0x7a, 0x50, 0xdb, 0xd9, 0xcb, 0x4f, 0xa1, 0x45, 0xc8, 0xd7, 0xf7, 0x76,
+	0x6a, 0xb5, 0x4a, 0x59, 0x2f, 0x1f, 0x3c, 0xad, 0x35, 0xab, 0xfb, 0x5c, 0xe7, 0x3c, 0xe4, 0x1e,
+	0x57, 0xbe, 0xd6, 0xf7, 0xab, 0x0f, 0x7d, 0xd2, 0x7c, 0xe2, 0x93, 0x13, 0x98, 0x09, 0x7d, 0x46,
+	0xb3, 0x00, 0x8d, 0xe6, 0xce, 0xe3, 0x6a, 0xed, 0xa1, 0xde, 0xfc, 0x45, 0x7e, 0x0a, 0xe5, 0x21,
+	0xeb, 0xdb, 0x28, 0x21, 0x0a, 0x57, 0xd4, 0xd8, 0xdb, 0x69, 0x3c, 0x92, 0x80, 0x04, 0x5a, 0x85,
+	0xa5, 0x88, 0x24, 0x8e, 0x4a, 0xa2, 0xf7, 0x40, 0xdd, 0x7d, 0x54, 0xd9, 0x7d, 0x5c, 0x3f, 0xa8,
+	0xd6, 0x9a, 0x7a, 0xe3, 0xb0, 0xb4, 0x5f, 0x6d, 0x34, 0xaa, 0x07, 0x35, 0x8e, 0x4d, 0x95, 0xf6,
+	0x9e, 0xbd, 0x5c, 0x53, 0x9e, 0xbf, 0x5c, 0x53, 0xfe, 0xfd, 0x72, 0x4d, 0xf9, 0xc3, 0xab, 0xb5,
+	0xa9, 0xe7, 0xaf, 0xd6, 0xa6, 0xfe, 0xf9, 0x6a, 0x6d, 0xea, 0x97, 0xaf, 0xad, 0x8f, 0x61, 0xfc,
+	0xbf, 0x05, 0xa2, 0x58, 0x5a, 0x69, 0xf1, 0xdf, 0x82, 0xcf, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff,
+	0x25, 0x82, 0x2e, 0x69, 0x0a, 0x19, 0x00, 0x00,
+}
+
+func (m *FinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ParamsVersion != 0 {
-		i = encodeVarintBtcstaking(dAtA, i, uint64(m.ParamsVersion))
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.ConsumerId)))
 		i--
-		dAtA[i] = 0x78
+		dAtA[i] = 0x5a
 	}
-	if m.BtcUndelegation != nil {
-		{
-			size, err := m.BtcUndelegation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x72
+		dAtA[i] = 0x50
 	}
-	if m.UnbondingTime != 0 {
-		i = encodeVarintBtcstaking(dAtA, i, uint64(m.UnbondingTime))
+	if m.SlashedBtcHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.SlashedBtcHeight))
 		i--
-		dAtA[i] = 0x68
+		dAtA[i] = 0x48
 	}
-	if len(m.CovenantSigs) > 0 {
-		for iNdEx := len(m.CovenantSigs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CovenantSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBtcstaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
-		}
+	if m.SlashedBabylonHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.SlashedBabylonHeight))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.DelegatorSig != nil {
+	if m.RegisteredEpoch != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.RegisteredEpoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MasterPubRand) > 0 {
+		i -= len(m.MasterPubRand)
+		copy(dAtA[i:], m.MasterPubRand)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.MasterPubRand)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Pop != nil {
 		{
-			size := m.DelegatorSig.Size()
-			i -= size
-			if _, err := m.DelegatorSig.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x2a
 	}
-	if m.SlashingTx != nil {
+	if m.BtcPk != nil {
 		{
-			size := m.SlashingTx.Size()
+			size := m.BtcPk.Size()
 			i -= size
-			if _, err := m.SlashingTx.MarshalTo(dAtA[i:]); err != nil {
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
 			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x22
 	}
-	if m.StakingOutputIdx != 0 {
-		i = encodeVarintBtcstaking(dAtA, i, uint64(m.StakingOutputIdx))
-		i--
+	if m.BabylonPk != nil {
+		{
+			size, err := m.BabylonPk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Commission != nil {
+		{
+			size := m.Commission.Size()
+			i -= size
+			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Description != nil {
+		{
+			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityProviderWithMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderWithMeta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderWithMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SlashedBtcHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.SlashedBtcHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SlashedBabylonHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.SlashedBabylonHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RegisteredEpoch != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.RegisteredEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MasterPubRand) > 0 {
+		i -= len(m.MasterPubRand)
+		copy(dAtA[i:], m.MasterPubRand)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.MasterPubRand)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.VotingPower != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawalBtcAddress) > 0 {
+		i -= len(m.WithdrawalBtcAddress)
+		copy(dAtA[i:], m.WithdrawalBtcAddress)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.WithdrawalBtcAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.RewardAddress) > 0 {
+		i -= len(m.RewardAddress)
+		copy(dAtA[i:], m.RewardAddress)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.RewardAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.StakingTxHeaderHash != nil {
+		{
+			size := m.StakingTxHeaderHash.Size()
+			i -= size
+			if _, err := m.StakingTxHeaderHash.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.CreatedBabylonHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.CreatedBabylonHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.CompromisingSpendTxHash) > 0 {
+		i -= len(m.CompromisingSpendTxHash)
+		copy(dAtA[i:], m.CompromisingSpendTxHash)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.CompromisingSpendTxHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.SlashingAddress) > 0 {
+		i -= len(m.SlashingAddress)
+		copy(dAtA[i:], m.SlashingAddress)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.SlashingAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	{
+		size := m.SlashingRate.Size()
+		i -= size
+		if _, err := m.SlashingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.ScriptTemplateVersion != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.ScriptTemplateVersion))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.ParamsVersion != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.BtcUndelegation != nil {
+		{
+			size, err := m.BtcUndelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.UnbondingTime != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.UnbondingTime))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.CovenantSigs) > 0 {
+		for iNdEx := len(m.CovenantSigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.DelegatorSig != nil {
+		{
+			size := m.DelegatorSig.Size()
+			i -= size
+			if _, err := m.DelegatorSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.SlashingTx != nil {
+		{
+			size := m.SlashingTx.Size()
+			i -= size
+			if _, err := m.SlashingTx.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.StakingOutputIdx != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.StakingOutputIdx))
+		i--
 		dAtA[i] = 0x48
 	}
 	if len(m.StakingTx) > 0 {
@@ -1290,7 +2367,7 @@ func (m *BTCUndelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *BTCDelegatorDelegations) Marshal() (dAtA []byte, err error) {
+func (m *BTCDelegationTxs) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1300,43 +2377,148 @@ func (m *BTCDelegatorDelegations) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BTCDelegatorDelegations) MarshalTo(dAtA []byte) (int, error) {
+func (m *BTCDelegationTxs) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BTCDelegatorDelegations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BTCDelegationTxs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Dels) > 0 {
-		for iNdEx := len(m.Dels) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Dels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+	if m.UnbondingSlashingTx != nil {
+		{
+			size := m.UnbondingSlashingTx.Size()
+			i -= size
+			if _, err := m.UnbondingSlashingTx.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *BTCDelegatorDelegationIndex) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
+	if len(m.UnbondingTx) > 0 {
+		i -= len(m.UnbondingTx)
+		copy(dAtA[i:], m.UnbondingTx)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.UnbondingTx)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SlashingTx != nil {
+		{
+			size := m.SlashingTx.Size()
+			i -= size
+			if _, err := m.SlashingTx.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingTx) > 0 {
+		i -= len(m.StakingTx)
+		copy(dAtA[i:], m.StakingTx)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.StakingTx)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchtowerBackup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchtowerBackup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchtowerBackup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncryptedBackup) > 0 {
+		i -= len(m.EncryptedBackup)
+		copy(dAtA[i:], m.EncryptedBackup)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.EncryptedBackup)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WatchtowerAddress) > 0 {
+		i -= len(m.WatchtowerAddress)
+		copy(dAtA[i:], m.WatchtowerAddress)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.WatchtowerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegatorDelegations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegatorDelegations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegatorDelegations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dels) > 0 {
+		for iNdEx := len(m.Dels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegatorDelegationIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
 func (m *BTCDelegatorDelegationIndex) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
@@ -1450,6 +2632,183 @@ func (m *CovenantAdaptorSignatures) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *CovenantMuSig2Nonces) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CovenantMuSig2Nonces) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CovenantMuSig2Nonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashingUnbondingTxNonces) > 0 {
+		for iNdEx := len(m.SlashingUnbondingTxNonces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashingUnbondingTxNonces[iNdEx])
+			copy(dAtA[i:], m.SlashingUnbondingTxNonces[iNdEx])
+			i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.SlashingUnbondingTxNonces[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UnbondingTxNonce) > 0 {
+		i -= len(m.UnbondingTxNonce)
+		copy(dAtA[i:], m.UnbondingTxNonce)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.UnbondingTxNonce)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SlashingTxNonces) > 0 {
+		for iNdEx := len(m.SlashingTxNonces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashingTxNonces[iNdEx])
+			copy(dAtA[i:], m.SlashingTxNonces[iNdEx])
+			i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.SlashingTxNonces[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CovPk != nil {
+		{
+			size := m.CovPk.Size()
+			i -= size
+			if _, err := m.CovPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CovenantMuSig2PartialSigs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CovenantMuSig2PartialSigs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CovenantMuSig2PartialSigs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashingUnbondingTxPartialSigs) > 0 {
+		for iNdEx := len(m.SlashingUnbondingTxPartialSigs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashingUnbondingTxPartialSigs[iNdEx])
+			copy(dAtA[i:], m.SlashingUnbondingTxPartialSigs[iNdEx])
+			i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.SlashingUnbondingTxPartialSigs[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.UnbondingTxPartialSig) > 0 {
+		i -= len(m.UnbondingTxPartialSig)
+		copy(dAtA[i:], m.UnbondingTxPartialSig)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.UnbondingTxPartialSig)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SlashingTxPartialSigs) > 0 {
+		for iNdEx := len(m.SlashingTxPartialSigs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashingTxPartialSigs[iNdEx])
+			copy(dAtA[i:], m.SlashingTxPartialSigs[iNdEx])
+			i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.SlashingTxPartialSigs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CovPk != nil {
+		{
+			size := m.CovPk.Size()
+			i -= size
+			if _, err := m.CovPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CovenantPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CovenantPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CovenantPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumMissed != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.NumMissed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalSigningBlocks != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.TotalSigningBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumSigned != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.NumSigned))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CovPk != nil {
+		{
+			size := m.CovPk.Size()
+			i -= size
+			if _, err := m.CovPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SelectiveSlashingEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1499,16 +2858,261 @@ func (m *SelectiveSlashingEvidence) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintBtcstaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBtcstaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FinalityProviderStatusReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderStatusReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderStatusReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReportedHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.ReportedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UpdatedHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.UpdatedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityProviderSetDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderSetDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderSetDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Exited) > 0 {
+		for iNdEx := len(m.Exited) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Exited[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Entered) > 0 {
+		for iNdEx := len(m.Entered) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entered[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BabylonHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.BabylonHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityProviderPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCTxIndexEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCTxIndexEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCTxIndexEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TxType != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.TxType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBtcstaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBtcstaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *FinalityProvider) Size() (n int) {
 	if m == nil {
@@ -1549,6 +3153,13 @@ func (m *FinalityProvider) Size() (n int) {
 	if m.SlashedBtcHeight != 0 {
 		n += 1 + sovBtcstaking(uint64(m.SlashedBtcHeight))
 	}
+	if m.Jailed {
+		n += 2
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
 	return n
 }
 
@@ -1648,6 +3259,41 @@ func (m *BTCDelegation) Size() (n int) {
 	if m.ParamsVersion != 0 {
 		n += 1 + sovBtcstaking(uint64(m.ParamsVersion))
 	}
+	if m.ScriptTemplateVersion != 0 {
+		n += 2 + sovBtcstaking(uint64(m.ScriptTemplateVersion))
+	}
+	l = m.SlashingRate.Size()
+	n += 2 + l + sovBtcstaking(uint64(l))
+	l = len(m.SlashingAddress)
+	if l > 0 {
+		n += 2 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.CompromisingSpendTxHash)
+	if l > 0 {
+		n += 2 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 2 + l + sovBtcstaking(uint64(l))
+	}
+	if m.CreatedBabylonHeight != 0 {
+		n += 2 + sovBtcstaking(uint64(m.CreatedBabylonHeight))
+	}
+	if m.ActivationHeight != 0 {
+		n += 2 + sovBtcstaking(uint64(m.ActivationHeight))
+	}
+	if m.StakingTxHeaderHash != nil {
+		l = m.StakingTxHeaderHash.Size()
+		n += 2 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.RewardAddress)
+	if l > 0 {
+		n += 2 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.WithdrawalBtcAddress)
+	if l > 0 {
+		n += 2 + l + sovBtcstaking(uint64(l))
+	}
 	return n
 }
 
@@ -1688,6 +3334,52 @@ func (m *BTCUndelegation) Size() (n int) {
 	return n
 }
 
+func (m *BTCDelegationTxs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTx)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.SlashingTx != nil {
+		l = m.SlashingTx.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.UnbondingTx)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.UnbondingSlashingTx != nil {
+		l = m.UnbondingSlashingTx.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *WatchtowerBackup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.WatchtowerAddress)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.EncryptedBackup)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	return n
+}
+
 func (m *BTCDelegatorDelegations) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1751,37 +3443,1834 @@ func (m *CovenantAdaptorSignatures) Size() (n int) {
 			n += 1 + l + sovBtcstaking(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *CovenantMuSig2Nonces) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CovPk != nil {
+		l = m.CovPk.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if len(m.SlashingTxNonces) > 0 {
+		for _, b := range m.SlashingTxNonces {
+			l = len(b)
+			n += 1 + l + sovBtcstaking(uint64(l))
+		}
+	}
+	l = len(m.UnbondingTxNonce)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if len(m.SlashingUnbondingTxNonces) > 0 {
+		for _, b := range m.SlashingUnbondingTxNonces {
+			l = len(b)
+			n += 1 + l + sovBtcstaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CovenantMuSig2PartialSigs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CovPk != nil {
+		l = m.CovPk.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if len(m.SlashingTxPartialSigs) > 0 {
+		for _, b := range m.SlashingTxPartialSigs {
+			l = len(b)
+			n += 1 + l + sovBtcstaking(uint64(l))
+		}
+	}
+	l = len(m.UnbondingTxPartialSig)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if len(m.SlashingUnbondingTxPartialSigs) > 0 {
+		for _, b := range m.SlashingUnbondingTxPartialSigs {
+			l = len(b)
+			n += 1 + l + sovBtcstaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CovenantPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CovPk != nil {
+		l = m.CovPk.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.NumSigned != 0 {
+		n += 1 + sovBtcstaking(uint64(m.NumSigned))
+	}
+	if m.TotalSigningBlocks != 0 {
+		n += 1 + sovBtcstaking(uint64(m.TotalSigningBlocks))
+	}
+	if m.NumMissed != 0 {
+		n += 1 + sovBtcstaking(uint64(m.NumMissed))
+	}
+	return n
+}
+
+func (m *SelectiveSlashingEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.RecoveredFpBtcSk)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *FinalityProviderStatusReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBtcstaking(uint64(m.Status))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovBtcstaking(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovBtcstaking(uint64(m.EndHeight))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.ReportedHeight != 0 {
+		n += 1 + sovBtcstaking(uint64(m.ReportedHeight))
+	}
+	return n
+}
+
+func (m *PauseState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.UpdatedHeight != 0 {
+		n += 1 + sovBtcstaking(uint64(m.UpdatedHeight))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *FinalityProviderSetDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BabylonHeight != 0 {
+		n += 1 + sovBtcstaking(uint64(m.BabylonHeight))
+	}
+	if len(m.Entered) > 0 {
+		for _, e := range m.Entered {
+			l = e.Size()
+			n += 1 + l + sovBtcstaking(uint64(l))
+		}
+	}
+	if len(m.Exited) > 0 {
+		for _, e := range m.Exited {
+			l = e.Size()
+			n += 1 + l + sovBtcstaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FinalityProviderPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovBtcstaking(uint64(m.VotingPower))
+	}
+	return n
+}
+
+func (m *BTCTxIndexEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxType != 0 {
+		n += 1 + sovBtcstaking(uint64(m.TxType))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	return n
+}
+
+func sovBtcstaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBtcstaking(x uint64) (n int) {
+	return sovBtcstaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Description == nil {
+				m.Description = &types.Description{}
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Commission = &v
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonPk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BabylonPk == nil {
+				m.BabylonPk = &secp256k1.PubKey{}
+			}
+			if err := m.BabylonPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pop == nil {
+				m.Pop = &ProofOfPossession{}
+			}
+			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterPubRand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MasterPubRand = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredEpoch", wireType)
+			}
+			m.RegisteredEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegisteredEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBabylonHeight", wireType)
+			}
+			m.SlashedBabylonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedBabylonHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBtcHeight", wireType)
+			}
+			m.SlashedBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalityProviderWithMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProviderWithMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProviderWithMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterPubRand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MasterPubRand = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredEpoch", wireType)
+			}
+			m.RegisteredEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegisteredEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBabylonHeight", wireType)
+			}
+			m.SlashedBabylonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedBabylonHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBtcHeight", wireType)
+			}
+			m.SlashedBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonPk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BabylonPk == nil {
+				m.BabylonPk = &secp256k1.PubKey{}
+			}
+			if err := m.BabylonPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pop == nil {
+				m.Pop = &ProofOfPossession{}
+			}
+			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPkList = append(m.FpBtcPkList, v)
+			if err := m.FpBtcPkList[len(m.FpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
+			}
+			m.TotalSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTx = append(m.StakingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.StakingTx == nil {
+				m.StakingTx = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputIdx", wireType)
+			}
+			m.StakingOutputIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingOutputIdx |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v BTCSlashingTx
+			m.SlashingTx = &v
+			if err := m.SlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.DelegatorSig = &v
+			if err := m.DelegatorSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantSigs = append(m.CovenantSigs, &CovenantAdaptorSignatures{})
+			if err := m.CovenantSigs[len(m.CovenantSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			m.UnbondingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcUndelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BtcUndelegation == nil {
+				m.BtcUndelegation = &BTCUndelegation{}
+			}
+			if err := m.BtcUndelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+			}
+			m.ParamsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParamsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptTemplateVersion", wireType)
+			}
+			m.ScriptTemplateVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScriptTemplateVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompromisingSpendTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompromisingSpendTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBabylonHeight", wireType)
+			}
+			m.CreatedBabylonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedBabylonHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHeaderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BTCHeaderHashBytes
+			m.StakingTxHeaderHash = &v
+			if err := m.StakingTxHeaderHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalBtcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalBtcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCUndelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCUndelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCUndelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingTx = append(m.UnbondingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.UnbondingTx == nil {
+				m.UnbondingTx = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v BTCSlashingTx
+			m.SlashingTx = &v
+			if err := m.SlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.DelegatorUnbondingSig = &v
+			if err := m.DelegatorUnbondingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.DelegatorSlashingSig = &v
+			if err := m.DelegatorSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSlashingSigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantSlashingSigs = append(m.CovenantSlashingSigs, &CovenantAdaptorSignatures{})
+			if err := m.CovenantSlashingSigs[len(m.CovenantSlashingSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantUnbondingSigList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantUnbondingSigList = append(m.CovenantUnbondingSigList, &SignatureInfo{})
+			if err := m.CovenantUnbondingSigList[len(m.CovenantUnbondingSigList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *SelectiveSlashingEvidence) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StakingTxHash)
-	if l > 0 {
-		n += 1 + l + sovBtcstaking(uint64(l))
-	}
-	if m.FpBtcPk != nil {
-		l = m.FpBtcPk.Size()
-		n += 1 + l + sovBtcstaking(uint64(l))
-	}
-	l = len(m.RecoveredFpBtcSk)
-	if l > 0 {
-		n += 1 + l + sovBtcstaking(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovBtcstaking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBtcstaking(x uint64) (n int) {
-	return sovBtcstaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *FinalityProvider) Unmarshal(dAtA []byte) error {
+func (m *BTCDelegationTxs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1804,17 +5293,17 @@ func (m *FinalityProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FinalityProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: BTCDelegationTxs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BTCDelegationTxs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTx", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -1824,33 +5313,31 @@ func (m *FinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Description == nil {
-				m.Description = &types.Description{}
-			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StakingTx = append(m.StakingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.StakingTx == nil {
+				m.StakingTx = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -1860,33 +5347,32 @@ func (m *FinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.Commission = &v
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v BTCSlashingTx
+			m.SlashingTx = &v
+			if err := m.SlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BabylonPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTx", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -1896,31 +5382,29 @@ func (m *FinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BabylonPk == nil {
-				m.BabylonPk = &secp256k1.PubKey{}
-			}
-			if err := m.BabylonPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.UnbondingTx = append(m.UnbondingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.UnbondingTx == nil {
+				m.UnbondingTx = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingTx", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1947,17 +5431,67 @@ func (m *FinalityProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.BtcPk = &v
-			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v BTCSlashingTx
+			m.UnbondingSlashingTx = &v
+			if err := m.UnbondingSlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchtowerBackup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchtowerBackup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchtowerBackup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -1967,31 +5501,27 @@ func (m *FinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pop == nil {
-				m.Pop = &ProofOfPossession{}
-			}
-			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterPubRand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchtowerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2019,13 +5549,97 @@ func (m *FinalityProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterPubRand = string(dAtA[iNdEx:postIndex])
+			m.WatchtowerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredEpoch", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedBackup", wireType)
 			}
-			m.RegisteredEpoch = 0
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedBackup = append(m.EncryptedBackup[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedBackup == nil {
+				m.EncryptedBackup = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCDelegatorDelegations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCDelegatorDelegations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCDelegatorDelegations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dels", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -2035,49 +5649,26 @@ func (m *FinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RegisteredEpoch |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBabylonHeight", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
 			}
-			m.SlashedBabylonHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBtcstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlashedBabylonHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBtcHeight", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.SlashedBtcHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBtcstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlashedBtcHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Dels = append(m.Dels, &BTCDelegation{})
+			if err := m.Dels[len(m.Dels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcstaking(dAtA[iNdEx:])
@@ -2099,7 +5690,7 @@ func (m *FinalityProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FinalityProviderWithMeta) Unmarshal(dAtA []byte) error {
+func (m *BTCDelegatorDelegationIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2122,15 +5713,15 @@ func (m *FinalityProviderWithMeta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FinalityProviderWithMeta: wiretype end group for non-group")
+			return fmt.Errorf("proto: BTCDelegatorDelegationIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FinalityProviderWithMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BTCDelegatorDelegationIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashList", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2157,55 +5748,64 @@ func (m *FinalityProviderWithMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.BtcPk = &v
-			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StakingTxHashList = append(m.StakingTxHashList, make([]byte, postIndex-iNdEx))
+			copy(m.StakingTxHashList[len(m.StakingTxHashList)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
 			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBtcstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignatureInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
 			}
-			m.VotingPower = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBtcstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VotingPower |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignatureInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignatureInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterPubRand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -2215,29 +5815,32 @@ func (m *FinalityProviderWithMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterPubRand = string(dAtA[iNdEx:postIndex])
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.Pk = &v
+			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredEpoch", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
 			}
-			m.RegisteredEpoch = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -2247,16 +5850,82 @@ func (m *FinalityProviderWithMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RegisteredEpoch |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBabylonHeight", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
 			}
-			m.SlashedBabylonHeight = 0
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.Sig = &v
+			if err := m.Sig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CovenantAdaptorSignatures) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CovenantAdaptorSignatures: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CovenantAdaptorSignatures: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovPk", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -2266,16 +5935,32 @@ func (m *FinalityProviderWithMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashedBabylonHeight |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBtcHeight", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.CovPk = &v
+			if err := m.CovPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SlashedBtcHeight = 0
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptorSigs", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -2285,11 +5970,24 @@ func (m *FinalityProviderWithMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashedBtcHeight |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdaptorSigs = append(m.AdaptorSigs, make([]byte, postIndex-iNdEx))
+			copy(m.AdaptorSigs[len(m.AdaptorSigs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcstaking(dAtA[iNdEx:])
@@ -2311,7 +6009,7 @@ func (m *FinalityProviderWithMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
+func (m *CovenantMuSig2Nonces) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2334,17 +6032,17 @@ func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BTCDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: CovenantMuSig2Nonces: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BTCDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CovenantMuSig2Nonces: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BabylonPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CovPk", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -2354,31 +6052,30 @@ func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BabylonPk == nil {
-				m.BabylonPk = &secp256k1.PubKey{}
-			}
-			if err := m.BabylonPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.CovPk = &v
+			if err := m.CovPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxNonces", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2405,17 +6102,14 @@ func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.BtcPk = &v
-			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SlashingTxNonces = append(m.SlashingTxNonces, make([]byte, postIndex-iNdEx))
+			copy(m.SlashingTxNonces[len(m.SlashingTxNonces)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxNonce", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -2425,31 +6119,29 @@ func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pop == nil {
-				m.Pop = &ProofOfPossession{}
-			}
-			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.UnbondingTxNonce = append(m.UnbondingTxNonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.UnbondingTxNonce == nil {
+				m.UnbondingTxNonce = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingUnbondingTxNonces", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2476,36 +6168,64 @@ func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.FpBtcPkList = append(m.FpBtcPkList, v)
-			if err := m.FpBtcPkList[len(m.FpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SlashingUnbondingTxNonces = append(m.SlashingUnbondingTxNonces, make([]byte, postIndex-iNdEx))
+			copy(m.SlashingUnbondingTxNonces[len(m.SlashingUnbondingTxNonces)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
 			}
-			m.StartHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBtcstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CovenantMuSig2PartialSigs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
 			}
-			m.EndHeight = 0
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CovenantMuSig2PartialSigs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CovenantMuSig2PartialSigs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovPk", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -2515,16 +6235,32 @@ func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndHeight |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
 			}
-			m.TotalSat = 0
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.CovPk = &v
+			if err := m.CovPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -2534,14 +6270,27 @@ func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalSat |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxPartialSigs", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2568,16 +6317,14 @@ func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingTx = append(m.StakingTx[:0], dAtA[iNdEx:postIndex]...)
-			if m.StakingTx == nil {
-				m.StakingTx = []byte{}
-			}
+			m.SlashingTxPartialSigs = append(m.SlashingTxPartialSigs, make([]byte, postIndex-iNdEx))
+			copy(m.SlashingTxPartialSigs[len(m.SlashingTxPartialSigs)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputIdx", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxPartialSig", wireType)
 			}
-			m.StakingOutputIdx = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -2587,14 +6334,29 @@ func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StakingOutputIdx |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingTxPartialSig = append(m.UnbondingTxPartialSig[:0], dAtA[iNdEx:postIndex]...)
+			if m.UnbondingTxPartialSig == nil {
+				m.UnbondingTxPartialSig = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingUnbondingTxPartialSigs", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2621,15 +6383,62 @@ func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v BTCSlashingTx
-			m.SlashingTx = &v
-			if err := m.SlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SlashingUnbondingTxPartialSigs = append(m.SlashingUnbondingTxPartialSigs, make([]byte, postIndex-iNdEx))
+			copy(m.SlashingUnbondingTxPartialSigs[len(m.SlashingUnbondingTxPartialSigs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 11:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CovenantPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CovenantPerformance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CovenantPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CovPk", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2656,51 +6465,17 @@ func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340Signature
-			m.DelegatorSig = &v
-			if err := m.DelegatorSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSigs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBtcstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthBtcstaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBtcstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CovenantSigs = append(m.CovenantSigs, &CovenantAdaptorSignatures{})
-			if err := m.CovenantSigs[len(m.CovenantSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.CovPk = &v
+			if err := m.CovPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSigned", wireType)
 			}
-			m.UnbondingTime = 0
+			m.NumSigned = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -2710,16 +6485,16 @@ func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingTime |= uint32(b&0x7F) << shift
+				m.NumSigned |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcUndelegation", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSigningBlocks", wireType)
 			}
-			var msglen int
+			m.TotalSigningBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -2729,33 +6504,16 @@ func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TotalSigningBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBtcstaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBtcstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BtcUndelegation == nil {
-				m.BtcUndelegation = &BTCUndelegation{}
-			}
-			if err := m.BtcUndelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 15:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumMissed", wireType)
 			}
-			m.ParamsVersion = 0
+			m.NumMissed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -2765,7 +6523,7 @@ func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ParamsVersion |= uint32(b&0x7F) << shift
+				m.NumMissed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2791,7 +6549,7 @@ func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BTCUndelegation) Unmarshal(dAtA []byte) error {
+func (m *SelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2814,17 +6572,17 @@ func (m *BTCUndelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BTCUndelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: SelectiveSlashingEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BTCUndelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SelectiveSlashingEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -2834,29 +6592,27 @@ func (m *BTCUndelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbondingTx = append(m.UnbondingTx[:0], dAtA[iNdEx:postIndex]...)
-			if m.UnbondingTx == nil {
-				m.UnbondingTx = []byte{}
-			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2883,15 +6639,15 @@ func (m *BTCUndelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v BTCSlashingTx
-			m.SlashingTx = &v
-			if err := m.SlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveredFpBtcSk", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2918,15 +6674,64 @@ func (m *BTCUndelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340Signature
-			m.DelegatorUnbondingSig = &v
-			if err := m.DelegatorUnbondingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RecoveredFpBtcSk = append(m.RecoveredFpBtcSk[:0], dAtA[iNdEx:postIndex]...)
+			if m.RecoveredFpBtcSk == nil {
+				m.RecoveredFpBtcSk = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalityProviderStatusReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProviderStatusReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProviderStatusReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2953,17 +6758,17 @@ func (m *BTCUndelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340Signature
-			m.DelegatorSlashingSig = &v
-			if err := m.DelegatorSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSlashingSigs", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -2973,31 +6778,54 @@ func (m *BTCUndelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Status |= FinalityProviderOperationalStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBtcstaking
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBtcstaking
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
-			m.CovenantSlashingSigs = append(m.CovenantSlashingSigs, &CovenantAdaptorSignatures{})
-			if err := m.CovenantSlashingSigs[len(m.CovenantSlashingSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CovenantUnbondingSigList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -3007,26 +6835,43 @@ func (m *BTCUndelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CovenantUnbondingSigList = append(m.CovenantUnbondingSigList, &SignatureInfo{})
-			if err := m.CovenantUnbondingSigList[len(m.CovenantUnbondingSigList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedHeight", wireType)
+			}
+			m.ReportedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcstaking(dAtA[iNdEx:])
@@ -3048,7 +6893,7 @@ func (m *BTCUndelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BTCDelegatorDelegations) Unmarshal(dAtA []byte) error {
+func (m *PauseState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3071,17 +6916,37 @@ func (m *BTCDelegatorDelegations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BTCDelegatorDelegations: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BTCDelegatorDelegations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -3091,81 +6956,48 @@ func (m *BTCDelegatorDelegations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dels = append(m.Dels, &BTCDelegation{})
-			if err := m.Dels[len(m.Dels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBtcstaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthBtcstaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BTCDelegatorDelegationIndex) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBtcstaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.UpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BTCDelegatorDelegationIndex: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BTCDelegatorDelegationIndex: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -3175,23 +7007,23 @@ func (m *BTCDelegatorDelegationIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingTxHashList = append(m.StakingTxHashList, make([]byte, postIndex-iNdEx))
-			copy(m.StakingTxHashList[len(m.StakingTxHashList)-1], dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3214,7 +7046,7 @@ func (m *BTCDelegatorDelegationIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignatureInfo) Unmarshal(dAtA []byte) error {
+func (m *FinalityProviderSetDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3237,17 +7069,36 @@ func (m *SignatureInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignatureInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalityProviderSetDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignatureInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalityProviderSetDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonHeight", wireType)
+			}
+			m.BabylonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BabylonHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entered", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -3257,32 +7108,31 @@ func (m *SignatureInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.Pk = &v
-			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entered = append(m.Entered, &FinalityProviderPower{})
+			if err := m.Entered[len(m.Entered)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exited", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -3292,24 +7142,23 @@ func (m *SignatureInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340Signature
-			m.Sig = &v
-			if err := m.Sig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Exited = append(m.Exited, &FinalityProviderPower{})
+			if err := m.Exited[len(m.Exited)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3334,7 +7183,7 @@ func (m *SignatureInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CovenantAdaptorSignatures) Unmarshal(dAtA []byte) error {
+func (m *FinalityProviderPower) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3357,15 +7206,15 @@ func (m *CovenantAdaptorSignatures) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CovenantAdaptorSignatures: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalityProviderPower: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CovenantAdaptorSignatures: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalityProviderPower: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CovPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3393,16 +7242,16 @@ func (m *CovenantAdaptorSignatures) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.CovPk = &v
-			if err := m.CovPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdaptorSigs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
 			}
-			var byteLen int
+			m.VotingPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -3412,24 +7261,11 @@ func (m *CovenantAdaptorSignatures) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.VotingPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBtcstaking
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBtcstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AdaptorSigs = append(m.AdaptorSigs, make([]byte, postIndex-iNdEx))
-			copy(m.AdaptorSigs[len(m.AdaptorSigs)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcstaking(dAtA[iNdEx:])
@@ -3451,7 +7287,7 @@ func (m *CovenantAdaptorSignatures) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
+func (m *BTCTxIndexEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3474,17 +7310,17 @@ func (m *SelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SelectiveSlashingEvidence: wiretype end group for non-group")
+			return fmt.Errorf("proto: BTCTxIndexEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SelectiveSlashingEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BTCTxIndexEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
 			}
-			var stringLen uint64
+			m.TxType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -3494,62 +7330,14 @@ func (m *SelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TxType |= BTCTxType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBtcstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBtcstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBtcstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBtcstaking
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBtcstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.FpBtcPk = &v
-			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecoveredFpBtcSk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3576,9 +7364,9 @@ func (m *SelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecoveredFpBtcSk = append(m.RecoveredFpBtcSk[:0], dAtA[iNdEx:postIndex]...)
-			if m.RecoveredFpBtcSk == nil {
-				m.RecoveredFpBtcSk = []byte{}
+			m.StakingTxHash = append(m.StakingTxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.StakingTxHash == nil {
+				m.StakingTxHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
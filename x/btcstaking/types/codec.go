@@ -12,7 +12,14 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgEditFinalityProvider{}, "btcstaking/MsgEditFinalityProvider", nil)
 	cdc.RegisterConcrete(&MsgCreateBTCDelegation{}, "btcstaking/MsgCreateBTCDelegation", nil)
 	cdc.RegisterConcrete(&MsgAddCovenantSigs{}, "btcstaking/MsgAddCovenantSigs", nil)
+	cdc.RegisterConcrete(&MsgAddCovenantMuSig2Nonces{}, "btcstaking/MsgAddCovenantMuSig2Nonces", nil)
 	cdc.RegisterConcrete(&MsgBTCUndelegate{}, "btcstaking/MsgBTCUndelegate", nil)
+	cdc.RegisterConcrete(&MsgSetDelegationOperator{}, "btcstaking/MsgSetDelegationOperator", nil)
+	cdc.RegisterConcrete(&MsgSetWatchtowerBackup{}, "btcstaking/MsgSetWatchtowerBackup", nil)
+	cdc.RegisterConcrete(&MsgReportStakingSpend{}, "btcstaking/MsgReportStakingSpend", nil)
+	cdc.RegisterConcrete(&MsgUpdateFinalityProviderStatus{}, "btcstaking/MsgUpdateFinalityProviderStatus", nil)
+	cdc.RegisterConcrete(&MsgSelectiveSlashingEvidence{}, "btcstaking/MsgSelectiveSlashingEvidence", nil)
+	cdc.RegisterConcrete(&MsgSetPause{}, "btcstaking/MsgSetPause", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "btcstaking/MsgUpdateParams", nil)
 }
 
@@ -24,7 +31,14 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgEditFinalityProvider{},
 		&MsgCreateBTCDelegation{},
 		&MsgAddCovenantSigs{},
+		&MsgAddCovenantMuSig2Nonces{},
 		&MsgBTCUndelegate{},
+		&MsgSetDelegationOperator{},
+		&MsgSetWatchtowerBackup{},
+		&MsgReportStakingSpend{},
+		&MsgUpdateFinalityProviderStatus{},
+		&MsgSelectiveSlashingEvidence{},
+		&MsgSetPause{},
 		&MsgUpdateParams{},
 	)
 
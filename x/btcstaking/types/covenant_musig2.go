@@ -0,0 +1,585 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	asig "github.com/babylonchain/babylon/crypto/schnorr-adaptor-signature"
+	bbn "github.com/babylonchain/babylon/types"
+)
+
+// Validate checks that the nonces are well-formed public nonces for a BTC
+// delegation restaking to the given number of finality providers
+func (n *CovenantMuSig2Nonces) Validate(numFps int) error {
+	if n.CovPk == nil {
+		return ErrInvalidMuSig2Nonces.Wrap("empty covenant public key")
+	}
+	if len(n.SlashingTxNonces) != numFps {
+		return ErrInvalidMuSig2Nonces.Wrapf("number of nonces on slashing tx: %d, number of finality providers being staked to: %d",
+			len(n.SlashingTxNonces), numFps)
+	}
+	if len(n.SlashingUnbondingTxNonces) != numFps {
+		return ErrInvalidMuSig2Nonces.Wrapf("number of nonces on slashing unbonding tx: %d, number of finality providers being staked to: %d",
+			len(n.SlashingUnbondingTxNonces), numFps)
+	}
+	for _, nonce := range n.allNonces() {
+		if err := btcstaking.ValidateMuSig2PubNonce(nonce); err != nil {
+			return ErrInvalidMuSig2Nonces.Wrap(err.Error())
+		}
+	}
+	return nil
+}
+
+// SigHash returns the hash that the covenant member signs to authenticate
+// its nonces for the BTC delegation with the given staking tx hash
+func (n *CovenantMuSig2Nonces) SigHash(stakingTxHash string) []byte {
+	hasher := sha256.New()
+	hasher.Write([]byte(stakingTxHash))
+	hasher.Write(n.CovPk.MustMarshal())
+	for _, nonce := range n.allNonces() {
+		hasher.Write(nonce)
+	}
+	return hasher.Sum(nil)
+}
+
+// VerifySig verifies the covenant member's signature on the nonces for the
+// BTC delegation with the given staking tx hash
+func (n *CovenantMuSig2Nonces) VerifySig(stakingTxHash string, sig *bbn.BIP340Signature) error {
+	covPK, err := n.CovPk.ToBTCPK()
+	if err != nil {
+		return ErrInvalidMuSig2Nonces.Wrapf("invalid covenant public key: %v", err)
+	}
+	btcSig, err := sig.ToBTCSig()
+	if err != nil {
+		return ErrInvalidMuSig2Nonces.Wrapf("invalid signature: %v", err)
+	}
+	if !btcSig.Verify(n.SigHash(stakingTxHash), covPK) {
+		return ErrInvalidMuSig2Nonces.Wrap("invalid signature of the covenant member on the nonces")
+	}
+	return nil
+}
+
+// allNonces returns all nonces in the order of slashing tx nonces, unbonding
+// tx nonce, and slashing unbonding tx nonces
+func (n *CovenantMuSig2Nonces) allNonces() [][]byte {
+	nonces := make([][]byte, 0, len(n.SlashingTxNonces)+1+len(n.SlashingUnbondingTxNonces))
+	nonces = append(nonces, n.SlashingTxNonces...)
+	nonces = append(nonces, n.UnbondingTxNonce)
+	nonces = append(nonces, n.SlashingUnbondingTxNonces...)
+	return nonces
+}
+
+// CovenantSigners returns the PKs whose signatures are accepted on behalf of
+// the covenant committee for BTC delegations built with the given script
+// template, and the number of such signatures required. Under script
+// templates where the covenant committee signs via MuSig2, this is the
+// aggregate covenant PK with a quorum of 1.
+func (p Params) CovenantSigners(scriptTemplateVersion uint32) ([]bbn.BIP340PubKey, uint32, error) {
+	template, err := btcstaking.GetScriptTemplate(scriptTemplateVersion)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !template.CovenantMuSig2 {
+		return p.CovenantPks, p.CovenantQuorum, nil
+	}
+	covenantBTCPKs, err := bbn.NewBTCPKsFromBIP340PKs(p.CovenantPks)
+	if err != nil {
+		return nil, 0, err
+	}
+	signers, quorum, err := template.CovenantSigners(covenantBTCPKs, p.CovenantQuorum)
+	if err != nil {
+		return nil, 0, err
+	}
+	return bbn.NewBIP340PKsFromBTCPKs(signers), quorum, nil
+}
+
+// AggregateCovenantPK returns the MuSig2 aggregate PK of the covenant
+// committee
+func (p Params) AggregateCovenantPK() (*bbn.BIP340PubKey, error) {
+	covenantBTCPKs, err := bbn.NewBTCPKsFromBIP340PKs(p.CovenantPks)
+	if err != nil {
+		return nil, err
+	}
+	aggPK, err := btcstaking.AggregateCovenantKey(covenantBTCPKs)
+	if err != nil {
+		return nil, err
+	}
+	return bbn.NewBIP340PubKeyFromBTCPK(aggPK), nil
+}
+
+// CovenantMuSig2SecNonces are the secret MuSig2 nonces of a covenant member
+// for co-signing a BTC delegation, matching the public nonces it submits via
+// MsgAddCovenantMuSig2Nonces. They never leave the covenant member, and each
+// of them must be used in at most one signing session.
+type CovenantMuSig2SecNonces struct {
+	// StakingTxHash is the hash of the staking tx of the BTC delegation
+	StakingTxHash string `json:"staking_tx_hash"`
+	// PubNonces are the public nonces submitted on-chain, in the order of
+	// slashing tx nonces, unbonding tx nonce, and slashing unbonding tx nonces
+	PubNonces [][]byte `json:"pub_nonces"`
+	// SecNonces are the secret nonces matching the public nonces
+	SecNonces [][]byte `json:"sec_nonces"`
+}
+
+// covenantMuSig2Session is a MuSig2 signing session of the covenant committee
+// on a transaction of a BTC delegation
+type covenantMuSig2Session struct {
+	sigHash []byte
+	// encKey is the encryption key of the adaptor signature on a slashing
+	// tx, or nil for the Schnorr signature on the unbonding tx
+	encKey *asig.EncryptionKey
+}
+
+// covenantMuSig2Sessions returns the signing sessions of the covenant
+// committee on the given BTC delegation, in the order of slashing tx sessions,
+// unbonding tx session, and slashing unbonding tx sessions, i.e., the order of
+// the nonces of each covenant member. The slashing tx and the unbonding
+// slashing tx are checked against the given params before any session is
+// built, as for covenant members signing individually.
+func (d *BTCDelegation) covenantMuSig2Sessions(params *Params, btcNet *chaincfg.Params) ([]*covenantMuSig2Session, error) {
+	template, err := btcstaking.GetScriptTemplate(d.ScriptTemplateVersion)
+	if err != nil {
+		return nil, err
+	}
+	if !template.CovenantMuSig2 {
+		return nil, fmt.Errorf("covenant members of script template %d do not sign via MuSig2", template.Version)
+	}
+	if err := d.checkCovenantSignable(params, btcNet); err != nil {
+		return nil, err
+	}
+
+	stakingTx, err := bbn.NewBTCTxFromBytes(d.StakingTx)
+	if err != nil {
+		return nil, err
+	}
+	unbondingTx, err := bbn.NewBTCTxFromBytes(d.BtcUndelegation.UnbondingTx)
+	if err != nil {
+		return nil, err
+	}
+	slashingTx, err := d.SlashingTx.ToMsgTx()
+	if err != nil {
+		return nil, err
+	}
+	unbondingSlashingTx, err := d.BtcUndelegation.SlashingTx.ToMsgTx()
+	if err != nil {
+		return nil, err
+	}
+	fpPKs, err := bbn.NewBTCPKsFromBIP340PKs(d.FpBtcPkList)
+	if err != nil {
+		return nil, err
+	}
+	stakingInfo, err := d.GetStakingInfo(params, btcNet)
+	if err != nil {
+		return nil, err
+	}
+	slashingPathInfo, err := stakingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+	unbondingPathInfo, err := stakingInfo.UnbondingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+	unbondingInfo, err := d.GetUnbondingInfo(params, btcNet)
+	if err != nil {
+		return nil, err
+	}
+	unbondingSlashingPathInfo, err := unbondingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	stakingOutput := stakingTx.TxOut[d.StakingOutputIdx]
+	slashingSigHash, err := btcstaking.TransactionSigHashWithOutputData(
+		slashingTx, stakingOutput.PkScript, stakingOutput.Value, slashingPathInfo.GetPkScriptPath(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	unbondingSigHash, err := btcstaking.TransactionSigHashWithOutputData(
+		unbondingTx, stakingOutput.PkScript, stakingOutput.Value, unbondingPathInfo.GetPkScriptPath(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	unbondingOutput := unbondingTx.TxOut[0]
+	unbondingSlashingSigHash, err := btcstaking.TransactionSigHashWithOutputData(
+		unbondingSlashingTx, unbondingOutput.PkScript, unbondingOutput.Value, unbondingSlashingPathInfo.GetPkScriptPath(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*covenantMuSig2Session, 0, 2*len(fpPKs)+1)
+	encKeys := make([]*asig.EncryptionKey, 0, len(fpPKs))
+	for _, fpPK := range fpPKs {
+		encKey, err := asig.NewEncryptionKeyFromBTCPK(fpPK)
+		if err != nil {
+			return nil, err
+		}
+		encKeys = append(encKeys, encKey)
+		sessions = append(sessions, &covenantMuSig2Session{sigHash: slashingSigHash, encKey: encKey})
+	}
+	sessions = append(sessions, &covenantMuSig2Session{sigHash: unbondingSigHash})
+	for _, encKey := range encKeys {
+		sessions = append(sessions, &covenantMuSig2Session{sigHash: unbondingSlashingSigHash, encKey: encKey})
+	}
+	return sessions, nil
+}
+
+// covenantMuSig2Signing is the state shared by all covenant members signing
+// a BTC delegation via MuSig2, i.e., the covenant keys in the order of
+// aggregation, the signing sessions, and the public nonces of each covenant
+// member for each session
+type covenantMuSig2Signing struct {
+	sortedCovPKs []*btcec.PublicKey
+	sessions     []*covenantMuSig2Session
+	// pubNonces maps the BIP-340 PK of each covenant member to its public
+	// nonces
+	pubNonces map[string][][musig2.PubNonceSize]byte
+	// combinedNonces are the aggregations of the public nonces of all
+	// covenant members for each session
+	combinedNonces [][musig2.PubNonceSize]byte
+}
+
+// newCovenantMuSig2Signing builds the signing sessions of the given BTC
+// delegation with the given MuSig2 nonces, which have to be the ones of all
+// covenant members as recorded on-chain
+func newCovenantMuSig2Signing(
+	btcDel *BTCDelegation,
+	params *Params,
+	noncesList []*CovenantMuSig2Nonces,
+	btcNet *chaincfg.Params,
+) (*covenantMuSig2Signing, error) {
+	sessions, err := btcDel.covenantMuSig2Sessions(params, btcNet)
+	if err != nil {
+		return nil, err
+	}
+	covPKs, err := bbn.NewBTCPKsFromBIP340PKs(params.CovenantPks)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &covenantMuSig2Signing{
+		sortedCovPKs:   btcstaking.SortKeys(covPKs),
+		sessions:       sessions,
+		pubNonces:      make(map[string][][musig2.PubNonceSize]byte, len(noncesList)),
+		combinedNonces: make([][musig2.PubNonceSize]byte, 0, len(sessions)),
+	}
+	for _, nonces := range noncesList {
+		if err := nonces.Validate(len(btcDel.FpBtcPkList)); err != nil {
+			return nil, err
+		}
+		if !params.HasCovenantPK(nonces.CovPk) {
+			return nil, fmt.Errorf("%s is not a member of the covenant committee", nonces.CovPk.MarshalHex())
+		}
+		covPKHex := nonces.CovPk.MarshalHex()
+		if _, ok := s.pubNonces[covPKHex]; ok {
+			return nil, fmt.Errorf("duplicated MuSig2 nonces of covenant member %s", covPKHex)
+		}
+		allNonces := nonces.allNonces()
+		pubNonces := make([][musig2.PubNonceSize]byte, len(allNonces))
+		for i := range allNonces {
+			copy(pubNonces[i][:], allNonces[i])
+		}
+		s.pubNonces[covPKHex] = pubNonces
+	}
+	if len(s.pubNonces) != len(params.CovenantPks) {
+		return nil, fmt.Errorf("MuSig2 nonces of %d out of %d covenant members", len(s.pubNonces), len(params.CovenantPks))
+	}
+
+	for i := range sessions {
+		sessionNonces := make([][musig2.PubNonceSize]byte, 0, len(s.pubNonces))
+		for _, pubNonces := range s.pubNonces {
+			sessionNonces = append(sessionNonces, pubNonces[i])
+		}
+		combinedNonce, err := musig2.AggregateNonces(sessionNonces)
+		if err != nil {
+			return nil, err
+		}
+		s.combinedNonces = append(s.combinedNonces, combinedNonce)
+	}
+	return s, nil
+}
+
+// verifyPartialSig verifies the partial signature of the given covenant
+// member in the session with the given index
+func (s *covenantMuSig2Signing) verifyPartialSig(i int, covPK *btcec.PublicKey, partialSig *musig2.PartialSignature) error {
+	pubNonce := s.pubNonces[bbn.NewBIP340PubKeyFromBTCPK(covPK).MarshalHex()][i]
+	session := s.sessions[i]
+	if session.encKey != nil {
+		return asig.MuSig2EncVerifyPartial(
+			partialSig, pubNonce, s.combinedNonces[i], s.sortedCovPKs, covPK, session.encKey, session.sigHash,
+		)
+	}
+	var msg [chainhash.HashSize]byte
+	copy(msg[:], session.sigHash)
+	if !partialSig.Verify(pubNonce, s.combinedNonces[i], s.sortedCovPKs, covPK, msg) {
+		return fmt.Errorf("invalid partial signature of %x", schnorr.SerializePubKey(covPK))
+	}
+	return nil
+}
+
+// NewMsgAddCovenantMuSig2Nonces generates fresh MuSig2 nonces of the given
+// covenant member for co-signing the given BTC delegation, whose covenant
+// committee signs via MuSig2. It returns the message submitting the public
+// nonces, and the secret nonces that the covenant member keeps until it
+// produces its partial signatures. The given params have to be the ones of the
+// BTC delegation's params version.
+func NewMsgAddCovenantMuSig2Nonces(
+	signer string,
+	btcDel *BTCDelegation,
+	params *Params,
+	covenantSK *btcec.PrivateKey,
+	btcNet *chaincfg.Params,
+) (*MsgAddCovenantMuSig2Nonces, *CovenantMuSig2SecNonces, error) {
+	covenantPK := bbn.NewBIP340PubKeyFromBTCPK(covenantSK.PubKey())
+	if !params.HasCovenantPK(covenantPK) {
+		return nil, nil, fmt.Errorf("%s is not a member of the covenant committee", covenantPK.MarshalHex())
+	}
+	sessions, err := btcDel.covenantMuSig2Sessions(params, btcNet)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	covenantSK = muSig2SigningKey(covenantSK)
+	stakingTxHash := btcDel.MustGetStakingTxHash().String()
+	secNonces := &CovenantMuSig2SecNonces{StakingTxHash: stakingTxHash}
+	for range sessions {
+		nonces, err := musig2.GenNonces(musig2.WithPublicKey(covenantSK.PubKey()))
+		if err != nil {
+			return nil, nil, err
+		}
+		secNonces.PubNonces = append(secNonces.PubNonces, nonces.PubNonce[:])
+		secNonces.SecNonces = append(secNonces.SecNonces, nonces.SecNonce[:])
+	}
+	numFps := len(btcDel.FpBtcPkList)
+	nonces := CovenantMuSig2Nonces{
+		CovPk:                     covenantPK,
+		SlashingTxNonces:          secNonces.PubNonces[:numFps],
+		UnbondingTxNonce:          secNonces.PubNonces[numFps],
+		SlashingUnbondingTxNonces: secNonces.PubNonces[numFps+1:],
+	}
+	sig, err := schnorr.Sign(covenantSK, nonces.SigHash(stakingTxHash))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &MsgAddCovenantMuSig2Nonces{
+		Signer:        signer,
+		StakingTxHash: stakingTxHash,
+		Nonces:        nonces,
+		Sig:           bbn.NewBIP340SignatureFromBTCSig(sig),
+	}, secNonces, nil
+}
+
+// NewCovenantMuSig2PartialSigs produces the MuSig2 partial signatures of the
+// given covenant member on the given BTC delegation, with its secret nonces
+// and the MuSig2 nonces of all covenant members as recorded on-chain. The
+// secret nonces must not be used again afterwards. The given params have to be
+// the ones of the BTC delegation's params version.
+func NewCovenantMuSig2PartialSigs(
+	btcDel *BTCDelegation,
+	params *Params,
+	covenantSK *btcec.PrivateKey,
+	secNonces *CovenantMuSig2SecNonces,
+	noncesList []*CovenantMuSig2Nonces,
+	btcNet *chaincfg.Params,
+) (*CovenantMuSig2PartialSigs, error) {
+	covenantPK := bbn.NewBIP340PubKeyFromBTCPK(covenantSK.PubKey())
+	if !params.HasCovenantPK(covenantPK) {
+		return nil, fmt.Errorf("%s is not a member of the covenant committee", covenantPK.MarshalHex())
+	}
+	stakingTxHash := btcDel.MustGetStakingTxHash().String()
+	if secNonces.StakingTxHash != stakingTxHash {
+		return nil, fmt.Errorf("secret nonces are for BTC delegation %s rather than %s", secNonces.StakingTxHash, stakingTxHash)
+	}
+	signing, err := newCovenantMuSig2Signing(btcDel, params, noncesList, btcNet)
+	if err != nil {
+		return nil, err
+	}
+	covenantSK = muSig2SigningKey(covenantSK)
+
+	// the secret nonces have to match the public nonces on-chain, since
+	// signing with other nonces would not combine
+	pubNonces := signing.pubNonces[covenantPK.MarshalHex()]
+	if len(secNonces.SecNonces) != len(pubNonces) || len(secNonces.PubNonces) != len(pubNonces) {
+		return nil, fmt.Errorf("secret nonces do not match the MuSig2 nonces of %s", covenantPK.MarshalHex())
+	}
+	for i := range pubNonces {
+		if !bytes.Equal(secNonces.PubNonces[i], pubNonces[i][:]) || len(secNonces.SecNonces[i]) != musig2.SecNonceSize {
+			return nil, fmt.Errorf("secret nonces do not match the MuSig2 nonces of %s", covenantPK.MarshalHex())
+		}
+	}
+
+	partialSigs := make([][]byte, 0, len(signing.sessions))
+	for i, session := range signing.sessions {
+		var secNonce [musig2.SecNonceSize]byte
+		copy(secNonce[:], secNonces.SecNonces[i])
+		var partialSig *musig2.PartialSignature
+		if session.encKey != nil {
+			partialSig, err = asig.MuSig2EncSign(
+				secNonce, covenantSK, signing.combinedNonces[i], signing.sortedCovPKs, session.encKey, session.sigHash,
+			)
+		} else {
+			var msg [chainhash.HashSize]byte
+			copy(msg[:], session.sigHash)
+			partialSig, err = musig2.Sign(secNonce, covenantSK, signing.combinedNonces[i], signing.sortedCovPKs, msg)
+		}
+		if err != nil {
+			return nil, err
+		}
+		// a partial signature not verifying against the public nonce
+		// means that the secret nonce is not the one on-chain
+		if err := signing.verifyPartialSig(i, covenantSK.PubKey(), partialSig); err != nil {
+			return nil, err
+		}
+		var buf bytes.Buffer
+		if err := partialSig.Encode(&buf); err != nil {
+			return nil, err
+		}
+		partialSigs = append(partialSigs, buf.Bytes())
+	}
+
+	numFps := len(btcDel.FpBtcPkList)
+	return &CovenantMuSig2PartialSigs{
+		CovPk:                          covenantPK,
+		StakingTxHash:                  stakingTxHash,
+		SlashingTxPartialSigs:          partialSigs[:numFps],
+		UnbondingTxPartialSig:          partialSigs[numFps],
+		SlashingUnbondingTxPartialSigs: partialSigs[numFps+1:],
+	}, nil
+}
+
+// NewMsgAddCovenantSigsFromMuSig2 verifies the MuSig2 partial signatures of
+// all covenant members on the given BTC delegation, and combines them into the
+// signatures under the aggregate covenant PK, i.e., the adaptor signatures on
+// the slashing tx and the unbonding slashing tx encrypted by each finality
+// provider's PK, and the Schnorr signature on the unbonding tx. The given
+// nonces have to be the ones of all covenant members as recorded on-chain, and
+// the given params the ones of the BTC delegation's params version.
+func NewMsgAddCovenantSigsFromMuSig2(
+	signer string,
+	btcDel *BTCDelegation,
+	params *Params,
+	noncesList []*CovenantMuSig2Nonces,
+	partialSigsList []*CovenantMuSig2PartialSigs,
+	btcNet *chaincfg.Params,
+) (*MsgAddCovenantSigs, error) {
+	signing, err := newCovenantMuSig2Signing(btcDel, params, noncesList, btcNet)
+	if err != nil {
+		return nil, err
+	}
+	aggPK, err := params.AggregateCovenantPK()
+	if err != nil {
+		return nil, err
+	}
+	stakingTxHash := btcDel.MustGetStakingTxHash().String()
+
+	// partial signatures of each covenant member, verified
+	sessionPartialSigs := make([][]*musig2.PartialSignature, len(signing.sessions))
+	seen := make(map[string]struct{}, len(partialSigsList))
+	for _, partialSigs := range partialSigsList {
+		if partialSigs.CovPk == nil {
+			return nil, fmt.Errorf("empty covenant public key of partial signatures")
+		}
+		covPKHex := partialSigs.CovPk.MarshalHex()
+		if _, ok := signing.pubNonces[covPKHex]; !ok {
+			return nil, fmt.Errorf("%s is not a member of the covenant committee", covPKHex)
+		}
+		if _, ok := seen[covPKHex]; ok {
+			return nil, fmt.Errorf("duplicated partial signatures of covenant member %s", covPKHex)
+		}
+		seen[covPKHex] = struct{}{}
+		if partialSigs.StakingTxHash != stakingTxHash {
+			return nil, fmt.Errorf("partial signatures of %s are for BTC delegation %s rather than %s",
+				covPKHex, partialSigs.StakingTxHash, stakingTxHash)
+		}
+		allPartialSigs := partialSigs.allPartialSigs()
+		if len(allPartialSigs) != len(signing.sessions) {
+			return nil, fmt.Errorf("%d partial signatures of %s, expected %d", len(allPartialSigs), covPKHex, len(signing.sessions))
+		}
+		covPK, err := partialSigs.CovPk.ToBTCPK()
+		if err != nil {
+			return nil, err
+		}
+		for i, sigBytes := range allPartialSigs {
+			var partialSig musig2.PartialSignature
+			if len(sigBytes) != chainhash.HashSize {
+				return nil, fmt.Errorf("partial signature of %s must be %d bytes", covPKHex, chainhash.HashSize)
+			}
+			if err := partialSig.Decode(bytes.NewReader(sigBytes)); err != nil {
+				return nil, fmt.Errorf("invalid partial signature of %s: %w", covPKHex, err)
+			}
+			if err := signing.verifyPartialSig(i, covPK, &partialSig); err != nil {
+				return nil, err
+			}
+			sessionPartialSigs[i] = append(sessionPartialSigs[i], &partialSig)
+		}
+	}
+	if len(seen) != len(params.CovenantPks) {
+		return nil, fmt.Errorf("partial signatures of %d out of %d covenant members", len(seen), len(params.CovenantPks))
+	}
+
+	// combine the partial signatures of each session
+	numFps := len(btcDel.FpBtcPkList)
+	msg := &MsgAddCovenantSigs{
+		Signer:        signer,
+		Pk:            aggPK,
+		StakingTxHash: stakingTxHash,
+	}
+	for i, session := range signing.sessions {
+		if session.encKey == nil {
+			sig, err := btcstaking.CombineMuSig2Sigs(signing.combinedNonces[i], signing.sortedCovPKs, session.sigHash, sessionPartialSigs[i])
+			if err != nil {
+				return nil, err
+			}
+			msg.UnbondingTxSig = bbn.NewBIP340SignatureFromBTCSig(sig)
+			continue
+		}
+		adaptorSig, err := asig.MuSig2CombineEncSigs(
+			signing.combinedNonces[i], signing.sortedCovPKs, session.encKey, session.sigHash, sessionPartialSigs[i],
+		)
+		if err != nil {
+			return nil, err
+		}
+		if i < numFps {
+			msg.SlashingTxSigs = append(msg.SlashingTxSigs, adaptorSig.MustMarshal())
+		} else {
+			msg.SlashingUnbondingTxSigs = append(msg.SlashingUnbondingTxSigs, adaptorSig.MustMarshal())
+		}
+	}
+	return msg, nil
+}
+
+// allPartialSigs returns all partial signatures in the order of slashing tx
+// partial signatures, unbonding tx partial signature, and slashing unbonding
+// tx partial signatures
+func (s *CovenantMuSig2PartialSigs) allPartialSigs() [][]byte {
+	sigs := make([][]byte, 0, len(s.SlashingTxPartialSigs)+1+len(s.SlashingUnbondingTxPartialSigs))
+	sigs = append(sigs, s.SlashingTxPartialSigs...)
+	sigs = append(sigs, s.UnbondingTxPartialSig)
+	sigs = append(sigs, s.SlashingUnbondingTxPartialSigs...)
+	return sigs
+}
+
+// muSig2SigningKey returns the given covenant member's SK, negated if its PK
+// has an odd y coordinate. Covenant PKs are BIP-340 PKs, so the aggregate
+// covenant PK aggregates their even y coordinate points.
+func muSig2SigningKey(sk *btcec.PrivateKey) *btcec.PrivateKey {
+	if sk.PubKey().Y().Bit(0) == 0 {
+		return sk
+	}
+	var negated btcec.ModNScalar
+	negated.Set(&sk.Key)
+	negated.Negate()
+	return btcec.PrivKeyFromScalar(&negated)
+}
@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+func FuzzCovenantMuSig2Nonces(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		covSK, covPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		numFps := int(datagen.RandomInt(r, 5) + 1)
+		stakingTxHash := datagen.GenRandomHexStr(r, 32)
+
+		genNonce := func() []byte {
+			nonces, err := musig2.GenNonces(musig2.WithPublicKey(covPK))
+			require.NoError(t, err)
+			return nonces.PubNonce[:]
+		}
+		nonces := &types.CovenantMuSig2Nonces{
+			CovPk:            bbn.NewBIP340PubKeyFromBTCPK(covPK),
+			UnbondingTxNonce: genNonce(),
+		}
+		for i := 0; i < numFps; i++ {
+			nonces.SlashingTxNonces = append(nonces.SlashingTxNonces, genNonce())
+			nonces.SlashingUnbondingTxNonces = append(nonces.SlashingUnbondingTxNonces, genNonce())
+		}
+		require.NoError(t, nonces.Validate(numFps))
+
+		// the nonces are authenticated by the covenant member's signature
+		schnorrSig, err := schnorr.Sign(covSK, nonces.SigHash(stakingTxHash))
+		require.NoError(t, err)
+		sig := bbn.NewBIP340SignatureFromBTCSig(schnorrSig)
+		require.NoError(t, nonces.VerifySig(stakingTxHash, sig))
+		require.ErrorIs(t, nonces.VerifySig(datagen.GenRandomHexStr(r, 32), sig), types.ErrInvalidMuSig2Nonces)
+
+		// there has to be a nonce for each finality provider
+		require.ErrorIs(t, nonces.Validate(numFps+1), types.ErrInvalidMuSig2Nonces)
+
+		// malformed nonces are rejected
+		malformedNonce := datagen.GenRandomByteArray(r, uint64(len(nonces.UnbondingTxNonce)))
+		malformedNonce[0] = 0x05 // not a prefix of compressed points
+		nonces.UnbondingTxNonce = malformedNonce
+		require.ErrorIs(t, nonces.Validate(numFps), types.ErrInvalidMuSig2Nonces)
+		require.ErrorIs(t, nonces.VerifySig(stakingTxHash, sig), types.ErrInvalidMuSig2Nonces)
+	})
+}
@@ -0,0 +1,17 @@
+package types
+
+import "fmt"
+
+// Validate checks that the signing record of a covenant signer is well-formed
+func (p *CovenantPerformance) Validate() error {
+	if p.CovPk == nil {
+		return fmt.Errorf("empty covenant PK")
+	}
+	if _, err := p.CovPk.ToBTCPK(); err != nil {
+		return fmt.Errorf("invalid covenant PK: %w", err)
+	}
+	if p.NumSigned == 0 && p.TotalSigningBlocks > 0 {
+		return fmt.Errorf("covenant %s has signing blocks without any signed BTC delegation", p.CovPk.MarshalHex())
+	}
+	return nil
+}
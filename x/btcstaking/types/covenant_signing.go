@@ -0,0 +1,333 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	asig "github.com/babylonchain/babylon/crypto/schnorr-adaptor-signature"
+	bbn "github.com/babylonchain/babylon/types"
+)
+
+// ParseBTCDelegation parses the BTC delegation in the work item
+func (w *CovenantSigningWorkItem) ParseBTCDelegation() (*BTCDelegation, error) {
+	btcDel, err := parseBTCDelegationResponse(w.StakingTxHashHex, w.BtcDelegation)
+	if err != nil {
+		return nil, fmt.Errorf("work item of staking tx %s: %w", w.StakingTxHashHex, err)
+	}
+
+	return btcDel, nil
+}
+
+// parseBTCDelegationResponse parses the given BTC delegation response into a
+// BTC delegation, and ensures its staking tx matches the given staking tx hash
+func parseBTCDelegationResponse(stakingTxHashHex string, resp *BTCDelegationResponse) (*BTCDelegation, error) {
+	if resp == nil || resp.UndelegationResponse == nil {
+		return nil, fmt.Errorf("no BTC delegation")
+	}
+	if resp.BtcPk == nil {
+		return nil, fmt.Errorf("no staker BTC PK")
+	}
+	if _, err := resp.BtcPk.ToBTCPK(); err != nil {
+		return nil, fmt.Errorf("invalid staker BTC PK: %w", err)
+	}
+	stakingTx, err := hex.DecodeString(resp.StakingTxHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid staking tx: %w", err)
+	}
+	slashingTx, err := NewBTCSlashingTxFromHex(resp.SlashingTxHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid slashing tx: %w", err)
+	}
+	unbondingTx, err := hex.DecodeString(resp.UndelegationResponse.UnbondingTxHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid unbonding tx: %w", err)
+	}
+	unbondingSlashingTx, err := NewBTCSlashingTxFromHex(resp.UndelegationResponse.SlashingTxHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid unbonding slashing tx: %w", err)
+	}
+
+	btcDel := &BTCDelegation{
+		BtcPk:            resp.BtcPk,
+		FpBtcPkList:      resp.FpBtcPkList,
+		StartHeight:      resp.StartHeight,
+		EndHeight:        resp.EndHeight,
+		TotalSat:         resp.TotalSat,
+		StakingTx:        stakingTx,
+		StakingOutputIdx: resp.StakingOutputIdx,
+		SlashingTx:       slashingTx,
+		CovenantSigs:     resp.CovenantSigs,
+		UnbondingTime:    resp.UnbondingTime,
+		BtcUndelegation: &BTCUndelegation{
+			UnbondingTx:              unbondingTx,
+			SlashingTx:               unbondingSlashingTx,
+			CovenantSlashingSigs:     resp.UndelegationResponse.CovenantSlashingSigs,
+			CovenantUnbondingSigList: resp.UndelegationResponse.CovenantUnbondingSigList,
+		},
+		ParamsVersion:         resp.ParamsVersion,
+		ScriptTemplateVersion: resp.ScriptTemplateVersion,
+		SlashingRate:          resp.SlashingRate,
+		SlashingAddress:       resp.SlashingAddress,
+	}
+	if btcDel.EndHeight < btcDel.StartHeight || btcDel.EndHeight-btcDel.StartHeight > math.MaxUint16 {
+		return nil, fmt.Errorf("invalid staking period [%d, %d]", btcDel.StartHeight, btcDel.EndHeight)
+	}
+	if stakingTxHash, err := btcDel.GetStakingTxHash(); err != nil || stakingTxHash.String() != stakingTxHashHex {
+		return nil, fmt.Errorf("staking tx does not match staking tx hash %s", stakingTxHashHex)
+	}
+
+	return btcDel, nil
+}
+
+// Verify parses the BTC delegation in the work item and verifies that the
+// outputs and the scripts in the work item and the transactions of the BTC
+// delegation are the ones rebuilt locally from the BTC delegation, so that
+// covenant members do not have to trust the source of the work item. The given
+// params have to be the ones of the BTC delegation's params version, as queried
+// from Babylon, rather than the covenant committee carried by the work item.
+func (w *CovenantSigningWorkItem) Verify(params *Params, btcNet *chaincfg.Params) (*BTCDelegation, error) {
+	btcDel, err := w.ParseBTCDelegation()
+	if err != nil {
+		return nil, err
+	}
+	sameCommittee := w.CovenantQuorum == params.CovenantQuorum && len(w.CovenantPks) == len(params.CovenantPks)
+	for i := range w.CovenantPks {
+		sameCommittee = sameCommittee && params.HasCovenantPK(&w.CovenantPks[i])
+	}
+	if !sameCommittee {
+		return nil, fmt.Errorf("covenant committee in the work item does not match the params of version %d", btcDel.ParamsVersion)
+	}
+	stakingTx, err := bbn.NewBTCTxFromBytes(btcDel.StakingTx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid staking tx: %w", err)
+	}
+	unbondingTx, err := bbn.NewBTCTxFromBytes(btcDel.BtcUndelegation.UnbondingTx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid unbonding tx: %w", err)
+	}
+
+	// staking output and its spend paths
+	stakingInfo, err := btcDel.GetStakingInfo(params, btcNet)
+	if err != nil {
+		return nil, err
+	}
+	if int(btcDel.StakingOutputIdx) >= len(stakingTx.TxOut) {
+		return nil, fmt.Errorf("staking output index %d out of range", btcDel.StakingOutputIdx)
+	}
+	stakingOutput := stakingTx.TxOut[btcDel.StakingOutputIdx]
+	if !bytes.Equal(stakingOutput.PkScript, stakingInfo.StakingOutput.PkScript) || stakingOutput.Value != stakingInfo.StakingOutput.Value {
+		return nil, fmt.Errorf("staking output does not match the staking scripts")
+	}
+	if w.StakingOutputPkScriptHex != hex.EncodeToString(stakingOutput.PkScript) || w.StakingOutputValue != stakingOutput.Value {
+		return nil, fmt.Errorf("staking output in the work item does not match the staking tx")
+	}
+	slashingPathInfo, err := stakingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+	if w.StakingSlashingPathScriptHex != hex.EncodeToString(slashingPathInfo.GetPkScriptPath()) {
+		return nil, fmt.Errorf("slashing path script in the work item does not match the staking scripts")
+	}
+	unbondingPathInfo, err := stakingInfo.UnbondingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+	if w.StakingUnbondingPathScriptHex != hex.EncodeToString(unbondingPathInfo.GetPkScriptPath()) {
+		return nil, fmt.Errorf("unbonding path script in the work item does not match the staking scripts")
+	}
+
+	// the unbonding tx spends the staking output
+	if len(unbondingTx.TxIn) != 1 || len(unbondingTx.TxOut) != 1 {
+		return nil, fmt.Errorf("unbonding tx must have exactly one input and one output")
+	}
+	stakingTxHash := stakingTx.TxHash()
+	if !unbondingTx.TxIn[0].PreviousOutPoint.Hash.IsEqual(&stakingTxHash) ||
+		unbondingTx.TxIn[0].PreviousOutPoint.Index != btcDel.StakingOutputIdx {
+		return nil, fmt.Errorf("unbonding tx does not spend the staking output")
+	}
+
+	// unbonding output and its spend paths
+	unbondingInfo, err := btcDel.GetUnbondingInfo(params, btcNet)
+	if err != nil {
+		return nil, err
+	}
+	unbondingOutput := unbondingTx.TxOut[0]
+	if !bytes.Equal(unbondingOutput.PkScript, unbondingInfo.UnbondingOutput.PkScript) {
+		return nil, fmt.Errorf("unbonding output does not match the unbonding scripts")
+	}
+	if w.UnbondingOutputPkScriptHex != hex.EncodeToString(unbondingOutput.PkScript) || w.UnbondingOutputValue != unbondingOutput.Value {
+		return nil, fmt.Errorf("unbonding output in the work item does not match the unbonding tx")
+	}
+	unbondingSlashingPathInfo, err := unbondingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+	if w.UnbondingSlashingPathScriptHex != hex.EncodeToString(unbondingSlashingPathInfo.GetPkScriptPath()) {
+		return nil, fmt.Errorf("unbonding slashing path script in the work item does not match the unbonding scripts")
+	}
+
+	// the slashing txs and the unbonding tx conform to the params
+	if err := btcDel.checkCovenantSignable(params, btcNet); err != nil {
+		return nil, err
+	}
+
+	return btcDel, nil
+}
+
+// checkCovenantSignable checks that the slashing tx and the unbonding slashing
+// tx of the BTC delegation pay the slashing address at the slashing rate
+// recorded on the BTC delegation, or in the given params if none is recorded,
+// with the minimum fee in the given params, and that the unbonding time and the
+// unbonding value of the unbonding tx respect the params
+func (d *BTCDelegation) checkCovenantSignable(params *Params, btcNet *chaincfg.Params) error {
+	if d.BtcUndelegation == nil {
+		return fmt.Errorf("empty undelegation")
+	}
+	if d.UnbondingTime <= params.MinUnbondingTime || d.UnbondingTime > math.MaxUint16 {
+		return fmt.Errorf("unbonding time %d must be larger than %d and fit in 16 bits", d.UnbondingTime, params.MinUnbondingTime)
+	}
+	stakingTx, err := bbn.NewBTCTxFromBytes(d.StakingTx)
+	if err != nil {
+		return fmt.Errorf("invalid staking tx: %w", err)
+	}
+	unbondingTx, err := bbn.NewBTCTxFromBytes(d.BtcUndelegation.UnbondingTx)
+	if err != nil {
+		return fmt.Errorf("invalid unbonding tx: %w", err)
+	}
+	if int(d.StakingOutputIdx) >= len(stakingTx.TxOut) || len(unbondingTx.TxOut) != 1 {
+		return fmt.Errorf("invalid staking output index or unbonding tx outputs")
+	}
+	stakingOutput := stakingTx.TxOut[d.StakingOutputIdx]
+	unbondingValue := unbondingTx.TxOut[0].Value
+	if unbondingValue >= stakingOutput.Value {
+		return fmt.Errorf("unbonding tx fee must be larger than 0")
+	}
+	if minUnbondingValue := MinimumUnbondingValue(stakingOutput, params); btcutil.Amount(unbondingValue) < minUnbondingValue {
+		return fmt.Errorf("unbonding output value must be at least %s", minUnbondingValue)
+	}
+
+	return d.CheckSlashingTxs(d.ParamsWithRecordedSlashing(params), btcNet)
+}
+
+// NewMsgAddCovenantSigs produces the signatures of the given covenant member
+// on the given BTC delegation, i.e., the adaptor signatures on the slashing tx
+// and the unbonding slashing tx encrypted by each finality provider's PK, and
+// the Schnorr signature on the unbonding tx. The given params have to be the
+// ones of the BTC delegation's params version, against which the slashing txs
+// and the unbonding tx are checked before signing.
+func NewMsgAddCovenantSigs(
+	signer string,
+	btcDel *BTCDelegation,
+	params *Params,
+	covenantSK *btcec.PrivateKey,
+	btcNet *chaincfg.Params,
+) (*MsgAddCovenantSigs, error) {
+	covenantPK := bbn.NewBIP340PubKeyFromBTCPK(covenantSK.PubKey())
+	if !params.HasCovenantPK(covenantPK) {
+		return nil, fmt.Errorf("%s is not a member of the covenant committee", covenantPK.MarshalHex())
+	}
+	if err := btcDel.checkCovenantSignable(params, btcNet); err != nil {
+		return nil, err
+	}
+	template, err := btcstaking.GetScriptTemplate(btcDel.ScriptTemplateVersion)
+	if err != nil {
+		return nil, err
+	}
+	if template.CovenantMuSig2 {
+		return nil, fmt.Errorf("covenant members of script template %d sign via MuSig2", template.Version)
+	}
+
+	stakingTx, err := bbn.NewBTCTxFromBytes(btcDel.StakingTx)
+	if err != nil {
+		return nil, err
+	}
+	unbondingTx, err := bbn.NewBTCTxFromBytes(btcDel.BtcUndelegation.UnbondingTx)
+	if err != nil {
+		return nil, err
+	}
+	fpPKs, err := bbn.NewBTCPKsFromBIP340PKs(btcDel.FpBtcPkList)
+	if err != nil {
+		return nil, err
+	}
+	stakingInfo, err := btcDel.GetStakingInfo(params, btcNet)
+	if err != nil {
+		return nil, err
+	}
+	slashingPathInfo, err := stakingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+	unbondingPathInfo, err := stakingInfo.UnbondingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+	unbondingInfo, err := btcDel.GetUnbondingInfo(params, btcNet)
+	if err != nil {
+		return nil, err
+	}
+	unbondingSlashingPathInfo, err := unbondingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	// adaptor signatures on the slashing txs, encrypted by each finality
+	// provider's PK
+	slashingTxSigs := make([][]byte, 0, len(fpPKs))
+	unbondingSlashingTxSigs := make([][]byte, 0, len(fpPKs))
+	for _, fpPK := range fpPKs {
+		encKey, err := asig.NewEncryptionKeyFromBTCPK(fpPK)
+		if err != nil {
+			return nil, err
+		}
+		slashingTxSig, err := btcDel.SlashingTx.EncSign(
+			stakingTx,
+			btcDel.StakingOutputIdx,
+			slashingPathInfo.GetPkScriptPath(),
+			covenantSK,
+			encKey,
+		)
+		if err != nil {
+			return nil, err
+		}
+		slashingTxSigs = append(slashingTxSigs, slashingTxSig.MustMarshal())
+		unbondingSlashingTxSig, err := btcDel.BtcUndelegation.SlashingTx.EncSign(
+			unbondingTx,
+			0,
+			unbondingSlashingPathInfo.GetPkScriptPath(),
+			covenantSK,
+			encKey,
+		)
+		if err != nil {
+			return nil, err
+		}
+		unbondingSlashingTxSigs = append(unbondingSlashingTxSigs, unbondingSlashingTxSig.MustMarshal())
+	}
+
+	// Schnorr signature on the unbonding tx
+	unbondingTxSig, err := btcstaking.SignTxWithOneScriptSpendInputStrict(
+		unbondingTx,
+		stakingTx,
+		btcDel.StakingOutputIdx,
+		unbondingPathInfo.GetPkScriptPath(),
+		covenantSK,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgAddCovenantSigs{
+		Signer:                  signer,
+		Pk:                      covenantPK,
+		StakingTxHash:           btcDel.MustGetStakingTxHash().String(),
+		SlashingTxSigs:          slashingTxSigs,
+		UnbondingTxSig:          bbn.NewBIP340SignatureFromBTCSig(unbondingTxSig),
+		SlashingUnbondingTxSigs: unbondingSlashingTxSigs,
+	}, nil
+}
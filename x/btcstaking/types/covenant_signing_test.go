@@ -0,0 +1,147 @@
+package types_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+
+	asig "github.com/babylonchain/babylon/crypto/schnorr-adaptor-signature"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+func FuzzCovenantSigningWorkItem(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		net := &chaincfg.SimNetParams
+
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		numRestakedFPs := int(datagen.RandomInt(r, 5) + 1)
+		_, fpPKs, err := datagen.GenRandomBTCKeyPairs(r, numRestakedFPs)
+		require.NoError(t, err)
+		fpBTCPKs := bbn.NewBIP340PKsFromBTCPKs(fpPKs)
+
+		// (3, 5) covenant committee
+		covenantSKs, covenantPKs, err := datagen.GenRandomBTCKeyPairs(r, 5)
+		require.NoError(t, err)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+		bsParams := types.DefaultParams()
+		bsParams.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(covenantPKs)
+		bsParams.CovenantQuorum = 3
+		bsParams.SlashingAddress = slashingAddress.EncodeAddress()
+		bsParams.SlashingRate = slashingRate
+		bsParams.MinUnbondingTime = 100
+
+		btcDel, err := datagen.GenRandomBTCDelegation(
+			r,
+			t,
+			fpBTCPKs,
+			delSK,
+			covenantSKs,
+			bsParams.CovenantQuorum,
+			slashingAddress.EncodeAddress(),
+			1000,
+			1000+uint64(datagen.RandomInt(r, 1000)+10),
+			uint64(2*10e8),
+			slashingRate,
+			uint16(101),
+		)
+		require.NoError(t, err)
+
+		// the work item exported from Babylon passes local verification
+		workItem, err := types.NewCovenantSigningWorkItem(btcDel, types.BTCDelegationStatus_PENDING, &bsParams, net)
+		require.NoError(t, err)
+		parsedDel, err := workItem.Verify(&bsParams, net)
+		require.NoError(t, err)
+
+		// a covenant member produces valid signatures on the parsed BTC delegation
+		covenantSK := covenantSKs[r.Intn(len(covenantSKs))]
+		msg, err := types.NewMsgAddCovenantSigs(datagen.GenRandomAccount().Address, parsedDel, &bsParams, covenantSK, net)
+		require.NoError(t, err)
+		require.Equal(t, bbn.NewBIP340PubKeyFromBTCPK(covenantSK.PubKey()), msg.Pk)
+		require.Equal(t, workItem.StakingTxHashHex, msg.StakingTxHash)
+		require.Len(t, msg.SlashingTxSigs, numRestakedFPs)
+		require.Len(t, msg.SlashingUnbondingTxSigs, numRestakedFPs)
+
+		stakingInfo, err := btcDel.GetStakingInfo(&bsParams, net)
+		require.NoError(t, err)
+		slashingPathInfo, err := stakingInfo.SlashingPathSpendInfo()
+		require.NoError(t, err)
+		for i, fpPK := range fpPKs {
+			encKey, err := asig.NewEncryptionKeyFromBTCPK(fpPK)
+			require.NoError(t, err)
+			sig, err := asig.NewAdaptorSignatureFromBytes(msg.SlashingTxSigs[i])
+			require.NoError(t, err)
+			err = btcDel.SlashingTx.EncVerifyAdaptorSignature(
+				stakingInfo.StakingOutput.PkScript,
+				stakingInfo.StakingOutput.Value,
+				slashingPathInfo.GetPkScriptPath(),
+				covenantSK.PubKey(),
+				encKey,
+				sig,
+			)
+			require.NoError(t, err)
+		}
+
+		// a key outside the covenant committee cannot sign
+		nonMemberSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		_, err = types.NewMsgAddCovenantSigs(datagen.GenRandomAccount().Address, parsedDel, &bsParams, nonMemberSK, net)
+		require.Error(t, err)
+
+		// a work item whose scripts do not match the BTC delegation fails
+		// local verification
+		tamperedItem := *workItem
+		tamperedItem.StakingSlashingPathScriptHex = tamperedItem.StakingUnbondingPathScriptHex
+		_, err = tamperedItem.Verify(&bsParams, net)
+		require.Error(t, err)
+
+		// a work item with a different covenant committee than the params
+		// fails local verification
+		tamperedItem = *workItem
+		tamperedItem.CovenantPks = tamperedItem.CovenantPks[1:]
+		_, err = tamperedItem.Verify(&bsParams, net)
+		require.Error(t, err)
+
+		// slashing txs that do not pay the slashing address recorded on the
+		// BTC delegation are not signed, while the slashing address in the
+		// params only applies to BTC delegations without a recorded one
+		otherSlashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		otherParams := bsParams
+		otherParams.SlashingAddress = otherSlashingAddress.EncodeAddress()
+		_, err = workItem.Verify(&otherParams, net)
+		require.NoError(t, err)
+		tamperedItem = *workItem
+		tamperedDelResp := *workItem.BtcDelegation
+		tamperedDelResp.SlashingAddress = otherSlashingAddress.EncodeAddress()
+		tamperedItem.BtcDelegation = &tamperedDelResp
+		_, err = tamperedItem.Verify(&bsParams, net)
+		require.Error(t, err)
+		tamperedDel := *parsedDel
+		tamperedDel.SlashingAddress = otherSlashingAddress.EncodeAddress()
+		_, err = types.NewMsgAddCovenantSigs(datagen.GenRandomAccount().Address, &tamperedDel, &bsParams, covenantSK, net)
+		require.Error(t, err)
+		legacyDel := *parsedDel
+		legacyDel.SlashingRate = sdkmath.LegacyDec{}
+		legacyDel.SlashingAddress = ""
+		_, err = types.NewMsgAddCovenantSigs(datagen.GenRandomAccount().Address, &legacyDel, &otherParams, covenantSK, net)
+		require.Error(t, err)
+
+		// nor are BTC delegations whose unbonding time is below the minimum
+		// unbonding time in the params
+		otherParams = bsParams
+		otherParams.MinUnbondingTime = parsedDel.UnbondingTime
+		_, err = types.NewMsgAddCovenantSigs(datagen.GenRandomAccount().Address, parsedDel, &otherParams, covenantSK, net)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,257 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	bbn "github.com/babylonchain/babylon/types"
+)
+
+// NewBTCDelegationBundle returns the bundle of the given BTC delegation, whose
+// spend paths are built under the given parameters of the BTC delegation and
+// the given BTC network
+func NewBTCDelegationBundle(
+	stakingTxHashHex string,
+	btcDelResp *BTCDelegationResponse,
+	params *Params,
+	btcNetName string,
+) (*BTCDelegationBundle, error) {
+	btcNet, err := bbn.GetBtcNetworkParams(btcNetName)
+	if err != nil {
+		return nil, err
+	}
+	btcDel, err := parseBTCDelegationResponse(stakingTxHashHex, btcDelResp)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &BTCDelegationBundle{
+		BtcNetwork:       btcNetName,
+		StakingTxHashHex: stakingTxHashHex,
+		BtcDelegation:    btcDelResp,
+		CovenantPks:      params.CovenantPks,
+		CovenantQuorum:   params.CovenantQuorum,
+		SlashingAddress:  btcDel.ParamsWithRecordedSlashing(params).SlashingAddress,
+	}
+	if err := bundle.fillSpendPaths(btcDel, btcNet); err != nil {
+		return nil, err
+	}
+
+	return bundle, nil
+}
+
+// fillSpendPaths fills the outputs and the spend paths of the bundle, rebuilt
+// from the given BTC delegation
+func (b *BTCDelegationBundle) fillSpendPaths(btcDel *BTCDelegation, btcNet *chaincfg.Params) error {
+	params := b.params()
+	unbondingTx, err := bbn.NewBTCTxFromBytes(btcDel.BtcUndelegation.UnbondingTx)
+	if err != nil {
+		return fmt.Errorf("invalid unbonding tx: %w", err)
+	}
+	if len(unbondingTx.TxIn) != 1 || len(unbondingTx.TxOut) != 1 {
+		return fmt.Errorf("unbonding tx must have exactly one input and one output")
+	}
+
+	stakingInfo, err := btcDel.GetStakingInfo(params, btcNet)
+	if err != nil {
+		return err
+	}
+	stakingTimeLockPath, err := stakingInfo.TimeLockPathSpendInfo()
+	if err != nil {
+		return err
+	}
+	stakingUnbondingPath, err := stakingInfo.UnbondingPathSpendInfo()
+	if err != nil {
+		return err
+	}
+	unbondingInfo, err := btcDel.GetUnbondingInfo(params, btcNet)
+	if err != nil {
+		return err
+	}
+	unbondingTimeLockPath, err := unbondingInfo.TimeLockPathSpendInfo()
+	if err != nil {
+		return err
+	}
+
+	b.StakingOutputPkScriptHex = hex.EncodeToString(stakingInfo.StakingOutput.PkScript)
+	b.StakingOutputValue = stakingInfo.StakingOutput.Value
+	if b.StakingTimelockPath, err = newSpendPath(stakingTimeLockPath); err != nil {
+		return err
+	}
+	if b.StakingUnbondingPath, err = newSpendPath(stakingUnbondingPath); err != nil {
+		return err
+	}
+	b.UnbondingOutputPkScriptHex = hex.EncodeToString(unbondingInfo.UnbondingOutput.PkScript)
+	b.UnbondingOutputValue = unbondingInfo.UnbondingOutput.Value
+	if b.UnbondingTimelockPath, err = newSpendPath(unbondingTimeLockPath); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func newSpendPath(spendInfo *btcstaking.SpendInfo) (*SpendPath, error) {
+	controlBlock, err := spendInfo.ControlBlock.ToBytes()
+	if err != nil {
+		return nil, err
+	}
+	return &SpendPath{
+		ScriptHex:       hex.EncodeToString(spendInfo.GetPkScriptPath()),
+		ControlBlockHex: hex.EncodeToString(controlBlock),
+	}, nil
+}
+
+func (b *BTCDelegationBundle) params() *Params {
+	return &Params{
+		CovenantPks:    b.CovenantPks,
+		CovenantQuorum: b.CovenantQuorum,
+	}
+}
+
+// Verify checks the internal consistency of the bundle without trusting its
+// source, i.e., that the outputs and the spend paths in the bundle are the
+// ones rebuilt locally from the BTC delegation, that the staking tx and the
+// unbonding tx carry these outputs, that the slashing tx pays to the slashing
+// address of the BTC network of the bundle, and that the covenant signatures
+// on the unbonding tx are valid signatures of the covenant committee
+func (b *BTCDelegationBundle) Verify() error {
+	btcNet, err := bbn.GetBtcNetworkParams(b.BtcNetwork)
+	if err != nil {
+		return err
+	}
+	btcDel, err := parseBTCDelegationResponse(b.StakingTxHashHex, b.BtcDelegation)
+	if err != nil {
+		return err
+	}
+
+	// the outputs and the spend paths in the bundle are the rebuilt ones
+	rebuilt := &BTCDelegationBundle{
+		CovenantPks:    b.CovenantPks,
+		CovenantQuorum: b.CovenantQuorum,
+	}
+	if err := rebuilt.fillSpendPaths(btcDel, btcNet); err != nil {
+		return err
+	}
+	if b.StakingOutputPkScriptHex != rebuilt.StakingOutputPkScriptHex || b.StakingOutputValue != rebuilt.StakingOutputValue {
+		return fmt.Errorf("staking output in the bundle does not match the staking scripts")
+	}
+	if !b.StakingTimelockPath.Equal(rebuilt.StakingTimelockPath) {
+		return fmt.Errorf("staking timelock path in the bundle does not match the staking scripts")
+	}
+	if !b.StakingUnbondingPath.Equal(rebuilt.StakingUnbondingPath) {
+		return fmt.Errorf("staking unbonding path in the bundle does not match the staking scripts")
+	}
+	if b.UnbondingOutputPkScriptHex != rebuilt.UnbondingOutputPkScriptHex || b.UnbondingOutputValue != rebuilt.UnbondingOutputValue {
+		return fmt.Errorf("unbonding output in the bundle does not match the unbonding scripts")
+	}
+	if !b.UnbondingTimelockPath.Equal(rebuilt.UnbondingTimelockPath) {
+		return fmt.Errorf("unbonding timelock path in the bundle does not match the unbonding scripts")
+	}
+
+	// the staking tx carries the staking output
+	stakingTx, err := bbn.NewBTCTxFromBytes(btcDel.StakingTx)
+	if err != nil {
+		return fmt.Errorf("invalid staking tx: %w", err)
+	}
+	if int(btcDel.StakingOutputIdx) >= len(stakingTx.TxOut) {
+		return fmt.Errorf("staking output index %d out of range", btcDel.StakingOutputIdx)
+	}
+	stakingOutput := stakingTx.TxOut[btcDel.StakingOutputIdx]
+	if hex.EncodeToString(stakingOutput.PkScript) != b.StakingOutputPkScriptHex || stakingOutput.Value != b.StakingOutputValue {
+		return fmt.Errorf("staking tx does not carry the staking output")
+	}
+
+	// the unbonding tx spends the staking output into the unbonding output
+	unbondingTx, err := bbn.NewBTCTxFromBytes(btcDel.BtcUndelegation.UnbondingTx)
+	if err != nil {
+		return fmt.Errorf("invalid unbonding tx: %w", err)
+	}
+	if len(unbondingTx.TxIn) != 1 || len(unbondingTx.TxOut) != 1 {
+		return fmt.Errorf("unbonding tx must have exactly one input and one output")
+	}
+	stakingTxHash := stakingTx.TxHash()
+	if !unbondingTx.TxIn[0].PreviousOutPoint.Hash.IsEqual(&stakingTxHash) ||
+		unbondingTx.TxIn[0].PreviousOutPoint.Index != btcDel.StakingOutputIdx {
+		return fmt.Errorf("unbonding tx does not spend the staking output")
+	}
+	unbondingOutput := unbondingTx.TxOut[0]
+	if hex.EncodeToString(unbondingOutput.PkScript) != b.UnbondingOutputPkScriptHex || unbondingOutput.Value != b.UnbondingOutputValue {
+		return fmt.Errorf("unbonding tx does not carry the unbonding output")
+	}
+
+	// the slashing tx pays to the slashing address, which has to be an
+	// address of the BTC network of the bundle
+	slashingAddr, err := btcutil.DecodeAddress(b.SlashingAddress, btcNet)
+	if err != nil {
+		return fmt.Errorf("invalid slashing address for BTC network %s: %w", b.BtcNetwork, err)
+	}
+	if !slashingAddr.IsForNet(btcNet) {
+		return fmt.Errorf("slashing address is not for BTC network %s", b.BtcNetwork)
+	}
+	slashingPkScript, err := txscript.PayToAddrScript(slashingAddr)
+	if err != nil {
+		return err
+	}
+	slashingTx, err := btcDel.SlashingTx.ToMsgTx()
+	if err != nil {
+		return fmt.Errorf("invalid slashing tx: %w", err)
+	}
+	if len(slashingTx.TxOut) == 0 || !bytes.Equal(slashingTx.TxOut[0].PkScript, slashingPkScript) {
+		return fmt.Errorf("slashing tx does not pay to the slashing address")
+	}
+
+	// the covenant signatures on the unbonding tx are valid
+	params := b.params()
+	template, err := btcstaking.GetScriptTemplate(btcDel.ScriptTemplateVersion)
+	if err != nil {
+		return err
+	}
+	var aggCovenantPK *bbn.BIP340PubKey
+	if template.CovenantMuSig2 {
+		if aggCovenantPK, err = params.AggregateCovenantPK(); err != nil {
+			return err
+		}
+	}
+	unbondingPathScript, err := hex.DecodeString(b.StakingUnbondingPath.ScriptHex)
+	if err != nil {
+		return err
+	}
+	for _, sigInfo := range btcDel.BtcUndelegation.CovenantUnbondingSigList {
+		if sigInfo == nil || sigInfo.Pk == nil || sigInfo.Sig == nil {
+			return fmt.Errorf("incomplete covenant signature on the unbonding tx")
+		}
+		if !params.HasCovenantPK(sigInfo.Pk) && (aggCovenantPK == nil || !aggCovenantPK.Equals(sigInfo.Pk)) {
+			return fmt.Errorf("covenant signature on the unbonding tx by non-covenant PK %s", sigInfo.Pk.MarshalHex())
+		}
+		covenantPK, err := sigInfo.Pk.ToBTCPK()
+		if err != nil {
+			return err
+		}
+		if err := btcstaking.VerifyTransactionSigWithOutput(
+			unbondingTx,
+			stakingOutput,
+			unbondingPathScript,
+			covenantPK,
+			sigInfo.Sig.MustMarshal(),
+		); err != nil {
+			return fmt.Errorf("invalid covenant signature on the unbonding tx by %s: %w", sigInfo.Pk.MarshalHex(), err)
+		}
+	}
+
+	return nil
+}
+
+// Equal returns whether the spend path has the same script and control block
+// as the given one
+func (p *SpendPath) Equal(other *SpendPath) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	return p.ScriptHex == other.ScriptHex && p.ControlBlockHex == other.ControlBlockHex
+}
@@ -0,0 +1,117 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+func FuzzBTCDelegationBundle(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		net := &chaincfg.SimNetParams
+
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		numRestakedFPs := int(datagen.RandomInt(r, 5) + 1)
+		_, fpPKs, err := datagen.GenRandomBTCKeyPairs(r, numRestakedFPs)
+		require.NoError(t, err)
+
+		// (3, 5) covenant committee
+		covenantSKs, covenantPKs, err := datagen.GenRandomBTCKeyPairs(r, 5)
+		require.NoError(t, err)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		bsParams := &types.Params{
+			CovenantPks:     bbn.NewBIP340PKsFromBTCPKs(covenantPKs),
+			CovenantQuorum:  3,
+			SlashingAddress: slashingAddress.EncodeAddress(),
+		}
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+		btcDel, err := datagen.GenRandomBTCDelegation(
+			r,
+			t,
+			bbn.NewBIP340PKsFromBTCPKs(fpPKs),
+			delSK,
+			covenantSKs,
+			bsParams.CovenantQuorum,
+			slashingAddress.EncodeAddress(),
+			1000,
+			1000+uint64(datagen.RandomInt(r, 1000)+10),
+			uint64(2*10e8),
+			slashingRate,
+			uint16(101),
+		)
+		require.NoError(t, err)
+		stakingTxHashHex := btcDel.MustGetStakingTxHash().String()
+		btcDelResp := types.NewBTCDelegationResponse(btcDel, types.BTCDelegationStatus_ACTIVE)
+
+		// the exported bundle passes the consistency check
+		bundle, err := types.NewBTCDelegationBundle(stakingTxHashHex, btcDelResp, bsParams, string(bbn.BtcSimnet))
+		require.NoError(t, err)
+		require.NoError(t, bundle.Verify())
+
+		// the bundle carries the timelock path of the staking output
+		stakingInfo, err := btcDel.GetStakingInfo(bsParams, net)
+		require.NoError(t, err)
+		timeLockPathInfo, err := stakingInfo.TimeLockPathSpendInfo()
+		require.NoError(t, err)
+		controlBlock, err := timeLockPathInfo.ControlBlock.ToBytes()
+		require.NoError(t, err)
+		require.Equal(t, &types.SpendPath{
+			ScriptHex:       hex.EncodeToString(timeLockPathInfo.GetPkScriptPath()),
+			ControlBlockHex: hex.EncodeToString(controlBlock),
+		}, bundle.StakingTimelockPath)
+
+		// a bundle with swapped spend paths fails the consistency check
+		tampered := *bundle
+		tampered.StakingTimelockPath = tampered.StakingUnbondingPath
+		require.Error(t, tampered.Verify())
+
+		// a bundle with a different covenant committee fails the consistency
+		// check
+		tampered = *bundle
+		tampered.CovenantPks = tampered.CovenantPks[1:]
+		require.Error(t, tampered.Verify())
+
+		// a bundle for another BTC network fails the consistency check, as
+		// the slashing address is not an address of that BTC network
+		tampered = *bundle
+		tampered.BtcNetwork = string(bbn.BtcMainnet)
+		require.Error(t, tampered.Verify())
+
+		// a bundle with another slashing address fails the consistency check
+		otherSlashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		tampered = *bundle
+		tampered.SlashingAddress = otherSlashingAddress.EncodeAddress()
+		require.Error(t, tampered.Verify())
+
+		// a bundle with an invalid covenant signature on the unbonding tx
+		// fails the consistency check
+		tamperedResp := *btcDelResp
+		tamperedUndel := *btcDelResp.UndelegationResponse
+		tamperedSigs := append([]*types.SignatureInfo{}, tamperedUndel.CovenantUnbondingSigList...)
+		tamperedSigs[0] = &types.SignatureInfo{Pk: tamperedSigs[0].Pk, Sig: tamperedSigs[len(tamperedSigs)-1].Sig}
+		if len(tamperedSigs) == 1 {
+			randSig := bbn.BIP340Signature(datagen.GenRandomByteArray(r, bbn.BIP340SignatureLen))
+			tamperedSigs[0].Sig = &randSig
+		}
+		tamperedUndel.CovenantUnbondingSigList = tamperedSigs
+		tamperedResp.UndelegationResponse = &tamperedUndel
+		tampered = *bundle
+		tampered.BtcDelegation = &tamperedResp
+		require.Error(t, tampered.Verify())
+	})
+}
@@ -31,4 +31,24 @@ var (
 	ErrVotingPowerTableNotUpdated   = errorsmod.Register(ModuleName, 1122, "voting power table has not been updated")
 	ErrVotingPowerDistCacheNotFound = errorsmod.Register(ModuleName, 1123, "the voting power distribution cache is not found")
 	ErrParamsNotFound               = errorsmod.Register(ModuleName, 1124, "the parameters are not found")
+	ErrSlashingRateReportNotFound   = errorsmod.Register(ModuleName, 1125, "the slashing rate change report is not found")
+	ErrInvalidParamsActivation      = errorsmod.Register(ModuleName, 1126, "the activation of the scheduled parameters is not valid")
+	ErrUnauthorizedSigner           = errorsmod.Register(ModuleName, 1127, "the signer is not authorized to operate on the BTC delegation")
+	ErrFpAlreadyJailed              = errorsmod.Register(ModuleName, 1128, "the finality provider has already been jailed")
+	ErrInvalidFpStatusReport        = errorsmod.Register(ModuleName, 1129, "the finality provider status report is not valid")
+	ErrFpStatusReportNotFound       = errorsmod.Register(ModuleName, 1130, "the finality provider status report is not found")
+	ErrInvalidMuSig2Nonces          = errorsmod.Register(ModuleName, 1131, "the MuSig2 nonces of the covenant member are not valid")
+	ErrDuplicatedMuSig2Nonces       = errorsmod.Register(ModuleName, 1132, "the covenant member has already submitted MuSig2 nonces for the BTC delegation")
+	ErrConsumerNotRegistered        = errorsmod.Register(ModuleName, 1133, "the consumer chain is not registered")
+	ErrWatchtowerBackupNotFound     = errorsmod.Register(ModuleName, 1134, "the BTC delegation has no watchtower backup")
+	ErrInvalidStakingSpend          = errorsmod.Register(ModuleName, 1135, "the reported spend of the staking output is not valid")
+	ErrMsgTooLarge                  = errorsmod.Register(ModuleName, 1136, "the message exceeds the size limits of the module")
+	ErrInvalidWithdrawalAddress     = errorsmod.Register(ModuleName, 1137, "the BTC withdrawal address of the BTC delegation is not valid")
+	ErrUnauthorizedFpSigner         = errorsmod.Register(ModuleName, 1138, "the signer is not authorized to register the finality provider")
+	ErrTooManyPendingBTCDelegations = errorsmod.Register(ModuleName, 1139, "the staker has too many pending BTC delegations")
+	ErrInsufficientTxFee            = errorsmod.Register(ModuleName, 1140, "the fee of the BTC tx is lower than the minimum fee at the minimum fee rate")
+	ErrMsgProcessingPaused          = errorsmod.Register(ModuleName, 1141, "the processing of the message is paused")
+	ErrUnauthorizedPauseSigner      = errorsmod.Register(ModuleName, 1142, "the signer is neither the governance account nor the pause authority")
+	ErrBTCTxNotFound                = errorsmod.Register(ModuleName, 1143, "the BTC tx is not known to Babylon")
+	ErrFpNotJailed                  = errorsmod.Register(ModuleName, 1144, "the finality provider is not jailed")
 )
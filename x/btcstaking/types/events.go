@@ -12,6 +12,26 @@ func NewEventPowerDistUpdateWithBTCDel(ev *EventBTCDelegationStateUpdate) *Event
 	}
 }
 
+func NewEventPowerDistUpdateWithJailedFP(fpBTCPK *bbn.BIP340PubKey) *EventPowerDistUpdate {
+	return &EventPowerDistUpdate{
+		Ev: &EventPowerDistUpdate_JailedFp{
+			JailedFp: &EventPowerDistUpdate_EventJailedFinalityProvider{
+				Pk: fpBTCPK,
+			},
+		},
+	}
+}
+
+func NewEventPowerDistUpdateWithUnjailedFP(fpBTCPK *bbn.BIP340PubKey) *EventPowerDistUpdate {
+	return &EventPowerDistUpdate{
+		Ev: &EventPowerDistUpdate_UnjailedFp{
+			UnjailedFp: &EventPowerDistUpdate_EventUnjailedFinalityProvider{
+				Pk: fpBTCPK,
+			},
+		},
+	}
+}
+
 func NewEventPowerDistUpdateWithSlashedFP(fpBTCPK *bbn.BIP340PubKey) *EventPowerDistUpdate {
 	return &EventPowerDistUpdate{
 		Ev: &EventPowerDistUpdate_SlashedFp{
@@ -21,3 +41,9 @@ func NewEventPowerDistUpdateWithSlashedFP(fpBTCPK *bbn.BIP340PubKey) *EventPower
 		},
 	}
 }
+
+func NewEventFinalityProviderStatusUpdated(report *FinalityProviderStatusReport) *EventFinalityProviderStatusUpdated {
+	return &EventFinalityProviderStatusUpdated{
+		Report: report,
+	}
+}
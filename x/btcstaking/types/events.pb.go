@@ -74,12 +74,16 @@ func (m *EventNewFinalityProvider) GetFp() *FinalityProvider {
 // - non-existing -> pending, which happens upon `MsgCreateBTCDelegation`
 // - pending -> active, which happens upon `MsgAddCovenantSigs`
 // - active -> unbonded, which happens upon `MsgBTCUndelegate` or upon staking tx timelock expires
+// - pending/active -> compromised, which happens upon `MsgReportStakingSpend`
 type EventBTCDelegationStateUpdate struct {
 	// staking_tx_hash is the hash of the staking tx.
 	// It uniquely identifies a BTC delegation
 	StakingTxHash string `protobuf:"bytes,1,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
 	// new_state is the new state of this BTC delegation
 	NewState BTCDelegationStatus `protobuf:"varint,2,opt,name=new_state,json=newState,proto3,enum=babylon.btcstaking.v1.BTCDelegationStatus" json:"new_state,omitempty"`
+	// memo is the optional label of the BTC delegation set by the staker. It
+	// is only set upon the creation of the BTC delegation
+	Memo string `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
 }
 
 func (m *EventBTCDelegationStateUpdate) Reset()         { *m = EventBTCDelegationStateUpdate{} }
@@ -129,6 +133,13 @@ func (m *EventBTCDelegationStateUpdate) GetNewState() BTCDelegationStatus {
 	return BTCDelegationStatus_PENDING
 }
 
+func (m *EventBTCDelegationStateUpdate) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
 // EventSelectiveSlashing is the event emitted when an adversarial
 // finality provider selectively slashes a BTC delegation. This will
 // result in slashing of all BTC delegations under this finality provider.
@@ -185,6 +196,8 @@ type EventPowerDistUpdate struct {
 	// Types that are valid to be assigned to Ev:
 	//	*EventPowerDistUpdate_SlashedFp
 	//	*EventPowerDistUpdate_BtcDelStateUpdate
+	//	*EventPowerDistUpdate_JailedFp
+	//	*EventPowerDistUpdate_UnjailedFp
 	Ev isEventPowerDistUpdate_Ev `protobuf_oneof:"ev"`
 }
 
@@ -233,9 +246,17 @@ type EventPowerDistUpdate_SlashedFp struct {
 type EventPowerDistUpdate_BtcDelStateUpdate struct {
 	BtcDelStateUpdate *EventBTCDelegationStateUpdate `protobuf:"bytes,2,opt,name=btc_del_state_update,json=btcDelStateUpdate,proto3,oneof" json:"btc_del_state_update,omitempty"`
 }
+type EventPowerDistUpdate_JailedFp struct {
+	JailedFp *EventPowerDistUpdate_EventJailedFinalityProvider `protobuf:"bytes,3,opt,name=jailed_fp,json=jailedFp,proto3,oneof" json:"jailed_fp,omitempty"`
+}
+type EventPowerDistUpdate_UnjailedFp struct {
+	UnjailedFp *EventPowerDistUpdate_EventUnjailedFinalityProvider `protobuf:"bytes,4,opt,name=unjailed_fp,json=unjailedFp,proto3,oneof" json:"unjailed_fp,omitempty"`
+}
 
 func (*EventPowerDistUpdate_SlashedFp) isEventPowerDistUpdate_Ev()         {}
 func (*EventPowerDistUpdate_BtcDelStateUpdate) isEventPowerDistUpdate_Ev() {}
+func (*EventPowerDistUpdate_JailedFp) isEventPowerDistUpdate_Ev()          {}
+func (*EventPowerDistUpdate_UnjailedFp) isEventPowerDistUpdate_Ev()        {}
 
 func (m *EventPowerDistUpdate) GetEv() isEventPowerDistUpdate_Ev {
 	if m != nil {
@@ -258,11 +279,27 @@ func (m *EventPowerDistUpdate) GetBtcDelStateUpdate() *EventBTCDelegationStateUp
 	return nil
 }
 
+func (m *EventPowerDistUpdate) GetJailedFp() *EventPowerDistUpdate_EventJailedFinalityProvider {
+	if x, ok := m.GetEv().(*EventPowerDistUpdate_JailedFp); ok {
+		return x.JailedFp
+	}
+	return nil
+}
+
+func (m *EventPowerDistUpdate) GetUnjailedFp() *EventPowerDistUpdate_EventUnjailedFinalityProvider {
+	if x, ok := m.GetEv().(*EventPowerDistUpdate_UnjailedFp); ok {
+		return x.UnjailedFp
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*EventPowerDistUpdate) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*EventPowerDistUpdate_SlashedFp)(nil),
 		(*EventPowerDistUpdate_BtcDelStateUpdate)(nil),
+		(*EventPowerDistUpdate_JailedFp)(nil),
+		(*EventPowerDistUpdate_UnjailedFp)(nil),
 	}
 }
 
@@ -310,231 +347,568 @@ func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) XXX_DiscardUnknown()
 
 var xxx_messageInfo_EventPowerDistUpdate_EventSlashedFinalityProvider proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*EventNewFinalityProvider)(nil), "babylon.btcstaking.v1.EventNewFinalityProvider")
-	proto.RegisterType((*EventBTCDelegationStateUpdate)(nil), "babylon.btcstaking.v1.EventBTCDelegationStateUpdate")
-	proto.RegisterType((*EventSelectiveSlashing)(nil), "babylon.btcstaking.v1.EventSelectiveSlashing")
-	proto.RegisterType((*EventPowerDistUpdate)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate")
-	proto.RegisterType((*EventPowerDistUpdate_EventSlashedFinalityProvider)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventSlashedFinalityProvider")
+// EventJailedFinalityProvider defines an event that a finality provider
+// is jailed due to insufficient liveness
+type EventPowerDistUpdate_EventJailedFinalityProvider struct {
+	Pk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=pk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"pk,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("babylon/btcstaking/v1/events.proto", fileDescriptor_74118427820fff75)
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) Reset() {
+	*m = EventPowerDistUpdate_EventJailedFinalityProvider{}
+}
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EventPowerDistUpdate_EventJailedFinalityProvider) ProtoMessage() {}
+func (*EventPowerDistUpdate_EventJailedFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{3, 1}
+}
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPowerDistUpdate_EventJailedFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPowerDistUpdate_EventJailedFinalityProvider.Merge(m, src)
+}
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPowerDistUpdate_EventJailedFinalityProvider.DiscardUnknown(m)
 }
 
-var fileDescriptor_74118427820fff75 = []byte{
-	// 463 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x4f, 0x6f, 0xd3, 0x40,
-	0x10, 0xc5, 0x6d, 0x0b, 0xa1, 0x66, 0xcb, 0x1f, 0x61, 0x05, 0x14, 0x45, 0x60, 0x2a, 0x1f, 0x4a,
-	0xc5, 0xc1, 0x6e, 0xd3, 0x0a, 0xee, 0x26, 0x0d, 0x41, 0x54, 0x28, 0xb2, 0xcb, 0x85, 0x8b, 0xb5,
-	0x76, 0x26, 0xf6, 0x2a, 0x66, 0xd7, 0xca, 0x4e, 0x9c, 0xe4, 0x5b, 0xf4, 0x63, 0x71, 0xec, 0xb1,
-	0xe2, 0x80, 0x50, 0xf2, 0x45, 0x90, 0x37, 0xa6, 0x44, 0x6d, 0x1c, 0x6e, 0xc9, 0xe8, 0xbd, 0xf7,
-	0x7b, 0x33, 0xb6, 0x89, 0x1d, 0xd1, 0x68, 0x91, 0x09, 0xee, 0x46, 0x18, 0x4b, 0xa4, 0x63, 0xc6,
-	0x13, 0xb7, 0x38, 0x71, 0xa1, 0x00, 0x8e, 0xd2, 0xc9, 0x27, 0x02, 0x85, 0xf9, 0xbc, 0xd2, 0x38,
-	0xff, 0x34, 0x4e, 0x71, 0xd2, 0x6e, 0x26, 0x22, 0x11, 0x4a, 0xe1, 0x96, 0xbf, 0xd6, 0xe2, 0xf6,
-	0xe1, 0xf6, 0xc0, 0x0d, 0xab, 0xd2, 0xd9, 0x01, 0x69, 0x9d, 0x97, 0x90, 0x2f, 0x30, 0xeb, 0x31,
-	0x4e, 0x33, 0x86, 0x8b, 0xc1, 0x44, 0x14, 0x6c, 0x08, 0x13, 0xf3, 0x3d, 0x31, 0x46, 0x79, 0x4b,
-	0x3f, 0xd0, 0x8f, 0xf6, 0x3b, 0x6f, 0x9c, 0xad, 0x74, 0xe7, 0xae, 0xc9, 0x37, 0x46, 0xb9, 0x7d,
-	0xa5, 0x93, 0x57, 0x2a, 0xd5, 0xbb, 0xfc, 0xd0, 0x85, 0x0c, 0x12, 0x8a, 0x4c, 0xf0, 0x00, 0x29,
-	0xc2, 0xd7, 0x7c, 0x48, 0x11, 0xcc, 0x43, 0xf2, 0xb4, 0x0a, 0x09, 0x71, 0x1e, 0xa6, 0x54, 0xa6,
-	0x8a, 0xd3, 0xf0, 0x1f, 0x57, 0xe3, 0xcb, 0x79, 0x9f, 0xca, 0xd4, 0xfc, 0x48, 0x1a, 0x1c, 0x66,
-	0xa1, 0x2c, 0xad, 0x2d, 0xe3, 0x40, 0x3f, 0x7a, 0xd2, 0x79, 0x5b, 0xd3, 0xe4, 0x1e, 0x6b, 0x2a,
-	0xfd, 0x3d, 0x0e, 0x33, 0x85, 0xb5, 0x47, 0xe4, 0x85, 0x6a, 0x14, 0x40, 0x06, 0x31, 0xb2, 0x02,
-	0x82, 0x8c, 0xca, 0x94, 0xf1, 0xc4, 0xbc, 0x20, 0x7b, 0x50, 0x56, 0xe7, 0x31, 0x54, 0xbb, 0x1e,
-	0xd7, 0x10, 0xee, 0x79, 0xcf, 0x2b, 0x9f, 0x7f, 0x9b, 0x60, 0xdf, 0x18, 0xa4, 0xa9, 0x40, 0x03,
-	0x31, 0x83, 0x49, 0x97, 0x49, 0xac, 0x36, 0x66, 0x84, 0xc8, 0xd2, 0x06, 0xc3, 0xf0, 0xf6, 0xa8,
-	0xfd, 0x1a, 0xd0, 0xb6, 0x80, 0xf5, 0x30, 0x58, 0x47, 0xdc, 0xbd, 0x7a, 0x5f, 0xf3, 0x1b, 0x55,
-	0x7a, 0x2f, 0x37, 0x13, 0xd2, 0x8c, 0x30, 0x0e, 0x87, 0x90, 0xad, 0x0f, 0x17, 0x4e, 0x55, 0x82,
-	0xba, 0xdf, 0x7e, 0xe7, 0x6c, 0x17, 0xb4, 0xee, 0x81, 0xf5, 0x35, 0xff, 0x59, 0x84, 0x71, 0x17,
-	0xb2, 0x8d, 0x61, 0x7b, 0x44, 0x5e, 0xee, 0x6a, 0x65, 0xf6, 0x88, 0x91, 0x8f, 0xd5, 0xae, 0x8f,
-	0xbc, 0x77, 0x3f, 0x7f, 0xbd, 0xee, 0x24, 0x0c, 0xd3, 0x69, 0xe4, 0xc4, 0xe2, 0xbb, 0x5b, 0x95,
-	0x88, 0x53, 0xca, 0xf8, 0xdf, 0x3f, 0x2e, 0x2e, 0x72, 0x90, 0x8e, 0xf7, 0x69, 0x70, 0x7a, 0x76,
-	0x3c, 0x98, 0x46, 0x9f, 0x61, 0xe1, 0x1b, 0xf9, 0xd8, 0x7b, 0x40, 0x0c, 0x28, 0xbc, 0x8b, 0x1f,
-	0x4b, 0x4b, 0xbf, 0x5e, 0x5a, 0xfa, 0xef, 0xa5, 0xa5, 0x5f, 0xad, 0x2c, 0xed, 0x7a, 0x65, 0x69,
-	0x37, 0x2b, 0x4b, 0xfb, 0xf6, 0xdf, 0xdc, 0xf9, 0xe6, 0x67, 0xa0, 0x20, 0xd1, 0x43, 0xf5, 0xfe,
-	0x9f, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0xc4, 0xc4, 0x7d, 0x08, 0x7a, 0x03, 0x00, 0x00,
+var xxx_messageInfo_EventPowerDistUpdate_EventJailedFinalityProvider proto.InternalMessageInfo
+
+// EventUnjailedFinalityProvider defines an event that a jailed finality
+// provider is unjailed
+type EventPowerDistUpdate_EventUnjailedFinalityProvider struct {
+	Pk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=pk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"pk,omitempty"`
 }
 
-func (m *EventNewFinalityProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) Reset() {
+	*m = EventPowerDistUpdate_EventUnjailedFinalityProvider{}
+}
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EventPowerDistUpdate_EventUnjailedFinalityProvider) ProtoMessage() {}
+func (*EventPowerDistUpdate_EventUnjailedFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{3, 2}
+}
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPowerDistUpdate_EventUnjailedFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPowerDistUpdate_EventUnjailedFinalityProvider.Merge(m, src)
+}
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPowerDistUpdate_EventUnjailedFinalityProvider.DiscardUnknown(m)
 }
 
-func (m *EventNewFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EventPowerDistUpdate_EventUnjailedFinalityProvider proto.InternalMessageInfo
+
+// EventFinalityProviderStatusUpdated is the event emitted when a finality
+// provider announces a planned downtime or key migration window, or withdraws
+// its announcement
+type EventFinalityProviderStatusUpdated struct {
+	// report is the announced status report. Its status is OPERATIONAL if the
+	// finality provider withdraws its announcement
+	Report *FinalityProviderStatusReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
 }
 
-func (m *EventNewFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Fp != nil {
-		{
-			size, err := m.Fp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+func (m *EventFinalityProviderStatusUpdated) Reset()         { *m = EventFinalityProviderStatusUpdated{} }
+func (m *EventFinalityProviderStatusUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventFinalityProviderStatusUpdated) ProtoMessage()    {}
+func (*EventFinalityProviderStatusUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{4}
+}
+func (m *EventFinalityProviderStatusUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFinalityProviderStatusUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFinalityProviderStatusUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventFinalityProviderStatusUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFinalityProviderStatusUpdated.Merge(m, src)
+}
+func (m *EventFinalityProviderStatusUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFinalityProviderStatusUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFinalityProviderStatusUpdated.DiscardUnknown(m)
 }
 
-func (m *EventBTCDelegationStateUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventFinalityProviderStatusUpdated proto.InternalMessageInfo
+
+func (m *EventFinalityProviderStatusUpdated) GetReport() *FinalityProviderStatusReport {
+	if m != nil {
+		return m.Report
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventBTCDelegationStateUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventSlashingRateChanged is the event emitted when governance changes the
+// slashing rate
+type EventSlashingRateChanged struct {
+	// report is the report of the active BTC delegations grandfathered by the
+	// change
+	Report *SlashingRateChangeReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
 }
 
-func (m *EventBTCDelegationStateUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NewState != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.NewState))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.StakingTxHash) > 0 {
-		i -= len(m.StakingTxHash)
-		copy(dAtA[i:], m.StakingTxHash)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.StakingTxHash)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventSlashingRateChanged) Reset()         { *m = EventSlashingRateChanged{} }
+func (m *EventSlashingRateChanged) String() string { return proto.CompactTextString(m) }
+func (*EventSlashingRateChanged) ProtoMessage()    {}
+func (*EventSlashingRateChanged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{5}
+}
+func (m *EventSlashingRateChanged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSlashingRateChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSlashingRateChanged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventSlashingRateChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSlashingRateChanged.Merge(m, src)
+}
+func (m *EventSlashingRateChanged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSlashingRateChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSlashingRateChanged.DiscardUnknown(m)
 }
 
-func (m *EventSelectiveSlashing) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventSlashingRateChanged proto.InternalMessageInfo
+
+func (m *EventSlashingRateChanged) GetReport() *SlashingRateChangeReport {
+	if m != nil {
+		return m.Report
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventSelectiveSlashing) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventPendingBTCDelegationExpired is the event emitted when a BTC delegation
+// is removed since it has not received covenant quorum within
+// pending_btc_delegation_expiry_blocks BTC blocks after the inclusion of its
+// staking tx
+type EventPendingBTCDelegationExpired struct {
+	// staking_tx_hash is the hash of the staking tx of the removed BTC
+	// delegation
+	StakingTxHash string `protobuf:"bytes,1,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// staker_btc_pk is the BTC PK of the staker
+	StakerBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=staker_btc_pk,json=stakerBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"staker_btc_pk,omitempty"`
+	// btc_height is the BTC height at which the BTC delegation expired
+	BtcHeight uint64 `protobuf:"varint,3,opt,name=btc_height,json=btcHeight,proto3" json:"btc_height,omitempty"`
 }
 
-func (m *EventSelectiveSlashing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Evidence != nil {
-		{
-			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+func (m *EventPendingBTCDelegationExpired) Reset()         { *m = EventPendingBTCDelegationExpired{} }
+func (m *EventPendingBTCDelegationExpired) String() string { return proto.CompactTextString(m) }
+func (*EventPendingBTCDelegationExpired) ProtoMessage()    {}
+func (*EventPendingBTCDelegationExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{6}
+}
+func (m *EventPendingBTCDelegationExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPendingBTCDelegationExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPendingBTCDelegationExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *EventPowerDistUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EventPendingBTCDelegationExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPendingBTCDelegationExpired.Merge(m, src)
+}
+func (m *EventPendingBTCDelegationExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPendingBTCDelegationExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPendingBTCDelegationExpired.DiscardUnknown(m)
 }
 
-func (m *EventPowerDistUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EventPendingBTCDelegationExpired proto.InternalMessageInfo
+
+func (m *EventPendingBTCDelegationExpired) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
 }
 
-func (m *EventPowerDistUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Ev != nil {
-		{
-			size := m.Ev.Size()
-			i -= size
-			if _, err := m.Ev.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+func (m *EventPendingBTCDelegationExpired) GetBtcHeight() uint64 {
+	if m != nil {
+		return m.BtcHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventPowerDistUpdate_SlashedFp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventBTCDelegationActivated is the event emitted when a BTC delegation
+// becomes active, i.e., when it has covenant quorum and its staking tx is
+// deep enough in the BTC chain. This happens upon the `MsgAddCovenantSigs`
+// reaching covenant quorum if the staking tx is already deep enough, or
+// otherwise at the end of the Babylon block in which the BTC chain reaches
+// the activation height of the BTC delegation
+type EventBTCDelegationActivated struct {
+	// staking_tx_hash is the hash of the staking tx of the BTC delegation
+	StakingTxHash string `protobuf:"bytes,1,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// fp_btc_pk_list is the list of BTC PKs of the finality providers that
+	// the BTC delegation is restaked to
+	FpBtcPkList []github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,rep,name=fp_btc_pk_list,json=fpBtcPkList,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk_list,omitempty"`
+	// staker_btc_pk is the BTC PK of the staker
+	StakerBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,3,opt,name=staker_btc_pk,json=stakerBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"staker_btc_pk,omitempty"`
+	// total_sat is the total amount of satoshis of the BTC delegation
+	TotalSat uint64 `protobuf:"varint,4,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
+	// babylon_height is the Babylon height at which the BTC delegation
+	// becomes active
+	BabylonHeight uint64 `protobuf:"varint,5,opt,name=babylon_height,json=babylonHeight,proto3" json:"babylon_height,omitempty"`
+	// btc_height is the BTC height at which the BTC delegation becomes active
+	BtcHeight uint64 `protobuf:"varint,6,opt,name=btc_height,json=btcHeight,proto3" json:"btc_height,omitempty"`
 }
 
-func (m *EventPowerDistUpdate_SlashedFp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.SlashedFp != nil {
-		{
-			size, err := m.SlashedFp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+func (m *EventBTCDelegationActivated) Reset()         { *m = EventBTCDelegationActivated{} }
+func (m *EventBTCDelegationActivated) String() string { return proto.CompactTextString(m) }
+func (*EventBTCDelegationActivated) ProtoMessage()    {}
+func (*EventBTCDelegationActivated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{7}
+}
+func (m *EventBTCDelegationActivated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBTCDelegationActivated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBTCDelegationActivated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *EventPowerDistUpdate_BtcDelStateUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventBTCDelegationActivated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBTCDelegationActivated.Merge(m, src)
 }
-
-func (m *EventPowerDistUpdate_BtcDelStateUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.BtcDelStateUpdate != nil {
-		{
-			size, err := m.BtcDelStateUpdate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+func (m *EventBTCDelegationActivated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBTCDelegationActivated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBTCDelegationActivated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBTCDelegationActivated proto.InternalMessageInfo
+
+func (m *EventBTCDelegationActivated) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
+}
+
+func (m *EventBTCDelegationActivated) GetTotalSat() uint64 {
+	if m != nil {
+		return m.TotalSat
+	}
+	return 0
+}
+
+func (m *EventBTCDelegationActivated) GetBabylonHeight() uint64 {
+	if m != nil {
+		return m.BabylonHeight
+	}
+	return 0
+}
+
+func (m *EventBTCDelegationActivated) GetBtcHeight() uint64 {
+	if m != nil {
+		return m.BtcHeight
+	}
+	return 0
+}
+
+// EventPauseStateUpdated is the event emitted when the governance account or
+// the pause authority pauses or resumes the processing of new BTC delegations
+// and early unbondings
+type EventPauseStateUpdated struct {
+	// pause_state is the updated pause state
+	PauseState *PauseState `protobuf:"bytes,1,opt,name=pause_state,json=pauseState,proto3" json:"pause_state,omitempty"`
+}
+
+func (m *EventPauseStateUpdated) Reset()         { *m = EventPauseStateUpdated{} }
+func (m *EventPauseStateUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventPauseStateUpdated) ProtoMessage()    {}
+func (*EventPauseStateUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{8}
+}
+func (m *EventPauseStateUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPauseStateUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPauseStateUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Marshal() (dAtA []byte, err error) {
+func (m *EventPauseStateUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPauseStateUpdated.Merge(m, src)
+}
+func (m *EventPauseStateUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPauseStateUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPauseStateUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPauseStateUpdated proto.InternalMessageInfo
+
+func (m *EventPauseStateUpdated) GetPauseState() *PauseState {
+	if m != nil {
+		return m.PauseState
+	}
+	return nil
+}
+
+// EventFinalityProviderSetChange is the event emitted at the end of a Babylon
+// block when the active finality provider set of Babylon differs from the one
+// at the previous height, e.g., when a finality provider drops out of or
+// re-enters the top `max_active_finality_providers` by voting power
+type EventFinalityProviderSetChange struct {
+	// diff is the change of the active finality provider set
+	Diff *FinalityProviderSetDiff `protobuf:"bytes,1,opt,name=diff,proto3" json:"diff,omitempty"`
+}
+
+func (m *EventFinalityProviderSetChange) Reset()         { *m = EventFinalityProviderSetChange{} }
+func (m *EventFinalityProviderSetChange) String() string { return proto.CompactTextString(m) }
+func (*EventFinalityProviderSetChange) ProtoMessage()    {}
+func (*EventFinalityProviderSetChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{9}
+}
+func (m *EventFinalityProviderSetChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFinalityProviderSetChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFinalityProviderSetChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFinalityProviderSetChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFinalityProviderSetChange.Merge(m, src)
+}
+func (m *EventFinalityProviderSetChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFinalityProviderSetChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFinalityProviderSetChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFinalityProviderSetChange proto.InternalMessageInfo
+
+func (m *EventFinalityProviderSetChange) GetDiff() *FinalityProviderSetDiff {
+	if m != nil {
+		return m.Diff
+	}
+	return nil
+}
+
+// EventParamsUpdated is the event emitted when a new version of the params
+// takes effect, either immediately upon `MsgUpdateParams` or at the
+// activation height or epoch of the scheduled params
+type EventParamsUpdated struct {
+	// version is the version of the new params
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// params is the new params
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *EventParamsUpdated) Reset()         { *m = EventParamsUpdated{} }
+func (m *EventParamsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventParamsUpdated) ProtoMessage()    {}
+func (*EventParamsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{10}
+}
+func (m *EventParamsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventParamsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventParamsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventParamsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventParamsUpdated.Merge(m, src)
+}
+func (m *EventParamsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventParamsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventParamsUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventParamsUpdated proto.InternalMessageInfo
+
+func (m *EventParamsUpdated) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *EventParamsUpdated) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func init() {
+	proto.RegisterType((*EventNewFinalityProvider)(nil), "babylon.btcstaking.v1.EventNewFinalityProvider")
+	proto.RegisterType((*EventBTCDelegationStateUpdate)(nil), "babylon.btcstaking.v1.EventBTCDelegationStateUpdate")
+	proto.RegisterType((*EventSelectiveSlashing)(nil), "babylon.btcstaking.v1.EventSelectiveSlashing")
+	proto.RegisterType((*EventPowerDistUpdate)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate")
+	proto.RegisterType((*EventPowerDistUpdate_EventSlashedFinalityProvider)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventSlashedFinalityProvider")
+	proto.RegisterType((*EventPowerDistUpdate_EventJailedFinalityProvider)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventJailedFinalityProvider")
+	proto.RegisterType((*EventPowerDistUpdate_EventUnjailedFinalityProvider)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventUnjailedFinalityProvider")
+	proto.RegisterType((*EventFinalityProviderStatusUpdated)(nil), "babylon.btcstaking.v1.EventFinalityProviderStatusUpdated")
+	proto.RegisterType((*EventSlashingRateChanged)(nil), "babylon.btcstaking.v1.EventSlashingRateChanged")
+	proto.RegisterType((*EventPendingBTCDelegationExpired)(nil), "babylon.btcstaking.v1.EventPendingBTCDelegationExpired")
+	proto.RegisterType((*EventBTCDelegationActivated)(nil), "babylon.btcstaking.v1.EventBTCDelegationActivated")
+	proto.RegisterType((*EventPauseStateUpdated)(nil), "babylon.btcstaking.v1.EventPauseStateUpdated")
+	proto.RegisterType((*EventFinalityProviderSetChange)(nil), "babylon.btcstaking.v1.EventFinalityProviderSetChange")
+	proto.RegisterType((*EventParamsUpdated)(nil), "babylon.btcstaking.v1.EventParamsUpdated")
+}
+
+func init() {
+	proto.RegisterFile("babylon/btcstaking/v1/events.proto", fileDescriptor_74118427820fff75)
+}
+
+var fileDescriptor_74118427820fff75 = []byte{
+	// 863 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xc1, 0x6e, 0xdb, 0x46,
+	0x10, 0x95, 0x68, 0xc5, 0xb5, 0x46, 0xb5, 0x8b, 0x2e, 0xdc, 0x42, 0x70, 0x6a, 0xc5, 0x25, 0xd0,
+	0x34, 0xe8, 0x81, 0x4a, 0xec, 0xa0, 0x3d, 0xf4, 0x54, 0xc6, 0x96, 0x95, 0xc6, 0x28, 0x04, 0x2a,
+	0xb9, 0xa4, 0x05, 0x88, 0x25, 0x39, 0x24, 0x37, 0xa2, 0x97, 0x5b, 0x72, 0x25, 0xdb, 0x7f, 0xd1,
+	0x8f, 0xe8, 0xaf, 0x14, 0xc8, 0x31, 0xc7, 0xa2, 0x87, 0xa0, 0xb5, 0xff, 0xa3, 0x28, 0xb8, 0x5c,
+	0xd9, 0x8e, 0x2c, 0xaa, 0x36, 0xec, 0x1b, 0x39, 0x98, 0x79, 0x6f, 0xe6, 0xcd, 0xce, 0xec, 0x82,
+	0xe9, 0x51, 0xef, 0x24, 0x49, 0x79, 0xd7, 0x93, 0x7e, 0x2e, 0xe9, 0x88, 0xf1, 0xa8, 0x3b, 0x79,
+	0xd2, 0xc5, 0x09, 0x72, 0x99, 0x5b, 0x22, 0x4b, 0x65, 0x4a, 0x3e, 0xd3, 0x3e, 0xd6, 0x85, 0x8f,
+	0x35, 0x79, 0xb2, 0xb1, 0x1e, 0xa5, 0x51, 0xaa, 0x3c, 0xba, 0xc5, 0x57, 0xe9, 0xbc, 0xf1, 0x70,
+	0x3e, 0xe0, 0xa5, 0xd0, 0xd2, 0xaf, 0x82, 0x58, 0xd0, 0x8c, 0x1e, 0x6a, 0x62, 0x73, 0x08, 0xed,
+	0xbd, 0x22, 0x91, 0x9f, 0xf0, 0xa8, 0xc7, 0x38, 0x4d, 0x98, 0x3c, 0x19, 0x64, 0xe9, 0x84, 0x05,
+	0x98, 0x91, 0xef, 0xc0, 0x08, 0x45, 0xbb, 0xbe, 0x55, 0x7f, 0xd4, 0xda, 0xfe, 0xda, 0x9a, 0x9b,
+	0xa1, 0x35, 0x1b, 0xe4, 0x18, 0xa1, 0x30, 0x7f, 0xaf, 0xc3, 0xa6, 0x42, 0xb5, 0x5f, 0x3e, 0xdb,
+	0xc5, 0x04, 0x23, 0x2a, 0x59, 0xca, 0x87, 0x92, 0x4a, 0x7c, 0x25, 0x02, 0x2a, 0x91, 0x3c, 0x84,
+	0x4f, 0x34, 0x88, 0x2b, 0x8f, 0xdd, 0x98, 0xe6, 0xb1, 0xe2, 0x69, 0x3a, 0xab, 0xda, 0xfc, 0xf2,
+	0xb8, 0x4f, 0xf3, 0x98, 0xec, 0x43, 0x93, 0xe3, 0x91, 0x9b, 0x17, 0xa1, 0x6d, 0x63, 0xab, 0xfe,
+	0x68, 0x6d, 0xfb, 0x9b, 0x8a, 0x4c, 0xae, 0x70, 0x8d, 0x73, 0x67, 0x85, 0xe3, 0x91, 0xa2, 0x25,
+	0x04, 0x1a, 0x87, 0x78, 0x98, 0xb6, 0x97, 0x14, 0x8b, 0xfa, 0x36, 0x43, 0xf8, 0x5c, 0x65, 0x39,
+	0xc4, 0x04, 0x7d, 0xc9, 0x26, 0x38, 0x4c, 0x68, 0x1e, 0x33, 0x1e, 0x91, 0x03, 0x58, 0xc1, 0xa2,
+	0x1c, 0xee, 0xa3, 0xae, 0xff, 0x71, 0x05, 0xeb, 0x95, 0xd8, 0x3d, 0x1d, 0xe7, 0x9c, 0x23, 0x98,
+	0xff, 0xde, 0x83, 0x75, 0x45, 0x34, 0x48, 0x8f, 0x30, 0xdb, 0x65, 0xb9, 0xd4, 0x2a, 0x30, 0x80,
+	0xbc, 0x08, 0xc3, 0xc0, 0x3d, 0x17, 0xba, 0x5f, 0x41, 0x34, 0x0f, 0xa0, 0x34, 0x0e, 0x4b, 0x88,
+	0xd9, 0x4e, 0xf4, 0x6b, 0x4e, 0x53, 0xa3, 0xf7, 0x04, 0x89, 0x60, 0xdd, 0x93, 0xbe, 0x1b, 0x60,
+	0x52, 0x8a, 0xe9, 0x8e, 0x45, 0x30, 0xd5, 0xb4, 0xb5, 0xfd, 0x74, 0x11, 0x69, 0x55, 0x13, 0xfb,
+	0x35, 0xe7, 0x53, 0x4f, 0xfa, 0xbb, 0x98, 0x5c, 0xee, 0x6c, 0x08, 0xcd, 0x37, 0x94, 0x25, 0x65,
+	0x49, 0x4b, 0x0a, 0x7d, 0xff, 0xc6, 0x25, 0xfd, 0xa8, 0x10, 0xe6, 0x54, 0xb4, 0x52, 0x62, 0xf7,
+	0x04, 0x49, 0xa0, 0x35, 0xe6, 0x17, 0x4c, 0x0d, 0xc5, 0xf4, 0xfc, 0xc6, 0x4c, 0xaf, 0xf8, 0x9b,
+	0x2a, 0x2e, 0x98, 0xe2, 0xf7, 0xc4, 0x46, 0x08, 0x5f, 0x2c, 0xd2, 0x9a, 0xf4, 0xc0, 0x10, 0x23,
+	0xd5, 0xc1, 0x8f, 0xed, 0x6f, 0xff, 0x7a, 0xff, 0x60, 0x3b, 0x62, 0x32, 0x1e, 0x7b, 0x96, 0x9f,
+	0x1e, 0x76, 0x75, 0x4a, 0x7e, 0x4c, 0x19, 0x9f, 0xfe, 0x74, 0xe5, 0x89, 0xc0, 0xdc, 0xb2, 0x9f,
+	0x0f, 0x76, 0x9e, 0x3e, 0x1e, 0x8c, 0xbd, 0x17, 0x78, 0xe2, 0x18, 0x62, 0xb4, 0x81, 0x70, 0x7f,
+	0x81, 0x00, 0x77, 0x46, 0x13, 0xc1, 0xe6, 0xc2, 0xea, 0xef, 0x8a, 0xc8, 0x6e, 0x80, 0x81, 0x13,
+	0xf3, 0x57, 0x30, 0x15, 0xdd, 0x2c, 0x4d, 0x39, 0xa5, 0x65, 0x3b, 0x02, 0xf2, 0x02, 0x96, 0x33,
+	0x14, 0x69, 0x26, 0xf5, 0x24, 0xec, 0x5c, 0x73, 0xe5, 0xe8, 0x59, 0x57, 0xa1, 0x8e, 0x86, 0x30,
+	0x7d, 0x68, 0x5f, 0x34, 0x8c, 0xf1, 0xc8, 0xa1, 0x12, 0x9f, 0xc5, 0x94, 0x47, 0x18, 0x90, 0xfd,
+	0x19, 0xa2, 0x6e, 0xd5, 0x6c, 0x5f, 0x89, 0x9d, 0x21, 0xf9, 0xa3, 0x0e, 0x5b, 0xe5, 0xd1, 0x42,
+	0x1e, 0x30, 0x1e, 0x7d, 0x30, 0x29, 0x7b, 0xc7, 0x82, 0x65, 0x18, 0x5c, 0x7b, 0xd5, 0xbd, 0x06,
+	0x65, 0xc0, 0xcc, 0x2d, 0x06, 0x55, 0x8c, 0xda, 0xc6, 0xad, 0xd4, 0x6f, 0x95, 0x60, 0xb6, 0xf4,
+	0x07, 0x23, 0xb2, 0x09, 0x50, 0x80, 0xc6, 0xc8, 0xa2, 0x58, 0xaa, 0xa9, 0x6c, 0x38, 0x4d, 0x4f,
+	0xfa, 0x7d, 0x65, 0x30, 0xff, 0x31, 0xf4, 0xb1, 0xfb, 0xa0, 0x80, 0x1f, 0x8a, 0xbd, 0x46, 0xe5,
+	0x0d, 0x4a, 0xf8, 0x19, 0xd6, 0x42, 0xa1, 0xd3, 0x77, 0x13, 0x96, 0xcb, 0xb6, 0xb1, 0xb5, 0x74,
+	0x9b, 0x1a, 0x42, 0xa1, 0xf2, 0x3f, 0x60, 0xb9, 0xbc, 0xaa, 0xcf, 0xd2, 0xdd, 0xe9, 0x73, 0x1f,
+	0x9a, 0x32, 0x95, 0x34, 0x71, 0x73, 0x2a, 0xd5, 0x2a, 0x69, 0x38, 0x2b, 0xca, 0x30, 0xa4, 0x92,
+	0x7c, 0x05, 0x6b, 0x1a, 0x67, 0x2a, 0xe0, 0x3d, 0xe5, 0xb1, 0xaa, 0xad, 0xa5, 0x88, 0x33, 0x1a,
+	0x2f, 0xcf, 0x6a, 0xfc, 0x8b, 0xbe, 0x6c, 0x06, 0x74, 0x9c, 0xe3, 0xa5, 0x85, 0x19, 0x10, 0x1b,
+	0x5a, 0xa2, 0x30, 0xea, 0x5b, 0xae, 0x3c, 0x93, 0x5f, 0x56, 0x9c, 0xc9, 0x8b, 0x70, 0x07, 0xc4,
+	0xf9, 0xb7, 0x19, 0x40, 0x67, 0xfe, 0x84, 0xa1, 0x2c, 0x4f, 0x2e, 0xb1, 0xa1, 0x11, 0xb0, 0x30,
+	0xd4, 0xf0, 0xd6, 0x75, 0x67, 0x0b, 0xe5, 0x2e, 0x0b, 0x43, 0x47, 0xc5, 0x9a, 0x23, 0x20, 0xba,
+	0x86, 0xe2, 0x05, 0x31, 0xcd, 0xbf, 0x0d, 0x1f, 0x4d, 0x30, 0xcb, 0x59, 0xca, 0x15, 0xf8, 0xaa,
+	0x33, 0xfd, 0x25, 0xdf, 0xc3, 0x72, 0xf9, 0xd8, 0xd0, 0xd7, 0xcc, 0x66, 0x65, 0x51, 0x85, 0x93,
+	0xdd, 0x78, 0xfb, 0xfe, 0x41, 0xcd, 0xd1, 0x21, 0xf6, 0xc1, 0xdb, 0xd3, 0x4e, 0xfd, 0xdd, 0x69,
+	0xa7, 0xfe, 0xf7, 0x69, 0xa7, 0xfe, 0xdb, 0x59, 0xa7, 0xf6, 0xee, 0xac, 0x53, 0xfb, 0xf3, 0xac,
+	0x53, 0x7b, 0xfd, 0xbf, 0xed, 0x3e, 0xbe, 0xfc, 0xe2, 0x51, 0xbd, 0xf7, 0x96, 0xd5, 0x73, 0x67,
+	0xe7, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcd, 0xbe, 0xa6, 0x9f, 0x8d, 0x09, 0x00, 0x00,
+}
+
+func (m *EventNewFinalityProvider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -544,23 +918,23 @@ func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Marshal() (dAtA []by
 	return dAtA[:n], nil
 }
 
-func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventNewFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventNewFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pk != nil {
+	if m.Fp != nil {
 		{
-			size := m.Pk.Size()
-			i -= size
-			if _, err := m.Pk.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.Fp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
@@ -569,115 +943,1803 @@ func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) MarshalToSizedBuffer
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventBTCDelegationStateUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventNewFinalityProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Fp != nil {
-		l = m.Fp.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+
+func (m *EventBTCDelegationStateUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventBTCDelegationStateUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventBTCDelegationStateUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.StakingTxHash)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.NewState != 0 {
-		n += 1 + sovEvents(uint64(m.NewState))
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewState))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSelectiveSlashing) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSelectiveSlashing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSelectiveSlashing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSelectiveSlashing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Evidence != nil {
-		l = m.Evidence.Size()
-		n += 1 + l + sovEvents(uint64(l))
+		{
+			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventPowerDistUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventPowerDistUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPowerDistUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Ev != nil {
-		n += m.Ev.Size()
-	}
-	return n
-}
+		{
+			size := m.Ev.Size()
+			i -= size
+			if _, err := m.Ev.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
 
-func (m *EventPowerDistUpdate_SlashedFp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventPowerDistUpdate_SlashedFp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_SlashedFp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SlashedFp != nil {
+		{
+			size, err := m.SlashedFp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventPowerDistUpdate_BtcDelStateUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_BtcDelStateUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BtcDelStateUpdate != nil {
+		{
+			size, err := m.BtcDelStateUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventPowerDistUpdate_JailedFp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_JailedFp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JailedFp != nil {
+		{
+			size, err := m.JailedFp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventPowerDistUpdate_UnjailedFp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_UnjailedFp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UnjailedFp != nil {
+		{
+			size, err := m.UnjailedFp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SlashedFp != nil {
-		l = m.SlashedFp.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Pk != nil {
+		{
+			size := m.Pk.Size()
+			i -= size
+			if _, err := m.Pk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *EventPowerDistUpdate_BtcDelStateUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BtcDelStateUpdate != nil {
-		l = m.BtcDelStateUpdate.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Pk != nil {
+		{
+			size := m.Pk.Size()
+			i -= size
+			if _, err := m.Pk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pk != nil {
-		l = m.Pk.Size()
-		n += 1 + l + sovEvents(uint64(l))
+		{
+			size := m.Pk.Size()
+			i -= size
+			if _, err := m.Pk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFinalityProviderStatusUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFinalityProviderStatusUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFinalityProviderStatusUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Report != nil {
+		{
+			size, err := m.Report.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSlashingRateChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSlashingRateChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSlashingRateChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Report != nil {
+		{
+			size, err := m.Report.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPendingBTCDelegationExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPendingBTCDelegationExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPendingBTCDelegationExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BtcHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StakerBtcPk != nil {
+		{
+			size := m.StakerBtcPk.Size()
+			i -= size
+			if _, err := m.StakerBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBTCDelegationActivated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBTCDelegationActivated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBTCDelegationActivated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BtcHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BabylonHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BabylonHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalSat != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TotalSat))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StakerBtcPk != nil {
+		{
+			size := m.StakerBtcPk.Size()
+			i -= size
+			if _, err := m.StakerBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.FpBtcPkList[iNdEx].Size()
+				i -= size
+				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPauseStateUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPauseStateUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPauseStateUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PauseState != nil {
+		{
+			size, err := m.PauseState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFinalityProviderSetChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFinalityProviderSetChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFinalityProviderSetChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Diff != nil {
+		{
+			size, err := m.Diff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventParamsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Version != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventNewFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fp != nil {
+		l = m.Fp.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBTCDelegationStateUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.NewState != 0 {
+		n += 1 + sovEvents(uint64(m.NewState))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSelectiveSlashing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Evidence != nil {
+		l = m.Evidence.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPowerDistUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ev != nil {
+		n += m.Ev.Size()
+	}
+	return n
+}
+
+func (m *EventPowerDistUpdate_SlashedFp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashedFp != nil {
+		l = m.SlashedFp.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventPowerDistUpdate_BtcDelStateUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcDelStateUpdate != nil {
+		l = m.BtcDelStateUpdate.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventPowerDistUpdate_JailedFp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JailedFp != nil {
+		l = m.JailedFp.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventPowerDistUpdate_UnjailedFp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnjailedFp != nil {
+		l = m.UnjailedFp.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pk != nil {
+		l = m.Pk.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pk != nil {
+		l = m.Pk.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pk != nil {
+		l = m.Pk.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFinalityProviderStatusUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Report != nil {
+		l = m.Report.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSlashingRateChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Report != nil {
+		l = m.Report.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPendingBTCDelegationExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.StakerBtcPk != nil {
+		l = m.StakerBtcPk.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BtcHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BtcHeight))
+	}
+	return n
+}
+
+func (m *EventBTCDelegationActivated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for _, e := range m.FpBtcPkList {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.StakerBtcPk != nil {
+		l = m.StakerBtcPk.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.TotalSat != 0 {
+		n += 1 + sovEvents(uint64(m.TotalSat))
+	}
+	if m.BabylonHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BabylonHeight))
+	}
+	if m.BtcHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BtcHeight))
+	}
+	return n
+}
+
+func (m *EventPauseStateUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PauseState != nil {
+		l = m.PauseState.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFinalityProviderSetChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Diff != nil {
+		l = m.Diff.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventParamsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovEvents(uint64(m.Version))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventNewFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNewFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNewFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fp == nil {
+				m.Fp = &FinalityProvider{}
+			}
+			if err := m.Fp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBTCDelegationStateUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBTCDelegationStateUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBTCDelegationStateUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewState", wireType)
+			}
+			m.NewState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewState |= BTCDelegationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSelectiveSlashing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSelectiveSlashing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSelectiveSlashing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Evidence == nil {
+				m.Evidence = &SelectiveSlashingEvidence{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPowerDistUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPowerDistUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPowerDistUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedFp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventPowerDistUpdate_EventSlashedFinalityProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ev = &EventPowerDistUpdate_SlashedFp{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelStateUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventBTCDelegationStateUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ev = &EventPowerDistUpdate_BtcDelStateUpdate{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedFp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventPowerDistUpdate_EventJailedFinalityProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ev = &EventPowerDistUpdate_JailedFp{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailedFp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventPowerDistUpdate_EventUnjailedFinalityProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ev = &EventPowerDistUpdate_UnjailedFp{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSlashedFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSlashedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.Pk = &v
+			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventJailedFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventJailedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.Pk = &v
+			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUnjailedFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUnjailedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.Pk = &v
+			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFinalityProviderStatusUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFinalityProviderStatusUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFinalityProviderStatusUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Report == nil {
+				m.Report = &FinalityProviderStatusReport{}
+			}
+			if err := m.Report.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSlashingRateChanged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSlashingRateChanged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSlashingRateChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Report == nil {
+				m.Report = &SlashingRateChangeReport{}
+			}
+			if err := m.Report.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventNewFinalityProvider) Unmarshal(dAtA []byte) error {
+func (m *EventPendingBTCDelegationExpired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -700,17 +2762,17 @@ func (m *EventNewFinalityProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNewFinalityProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPendingBTCDelegationExpired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNewFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPendingBTCDelegationExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -720,28 +2782,78 @@ func (m *EventNewFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fp == nil {
-				m.Fp = &FinalityProvider{}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerBtcPk", wireType)
 			}
-			if err := m.Fp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.StakerBtcPk = &v
+			if err := m.StakerBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeight", wireType)
+			}
+			m.BtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -763,7 +2875,7 @@ func (m *EventNewFinalityProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBTCDelegationStateUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventBTCDelegationActivated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -786,10 +2898,10 @@ func (m *EventBTCDelegationStateUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBTCDelegationStateUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBTCDelegationActivated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBTCDelegationStateUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBTCDelegationActivated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -825,10 +2937,80 @@ func (m *EventBTCDelegationStateUpdate) Unmarshal(dAtA []byte) error {
 			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPkList = append(m.FpBtcPkList, v)
+			if err := m.FpBtcPkList[len(m.FpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.StakerBtcPk = &v
+			if err := m.StakerBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
 			}
-			m.NewState = 0
+			m.TotalSat = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -838,7 +3020,45 @@ func (m *EventBTCDelegationStateUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewState |= BTCDelegationStatus(b&0x7F) << shift
+				m.TotalSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonHeight", wireType)
+			}
+			m.BabylonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BabylonHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeight", wireType)
+			}
+			m.BtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -864,7 +3084,7 @@ func (m *EventBTCDelegationStateUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSelectiveSlashing) Unmarshal(dAtA []byte) error {
+func (m *EventPauseStateUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -887,15 +3107,15 @@ func (m *EventSelectiveSlashing) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSelectiveSlashing: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPauseStateUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSelectiveSlashing: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPauseStateUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -922,10 +3142,10 @@ func (m *EventSelectiveSlashing) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Evidence == nil {
-				m.Evidence = &SelectiveSlashingEvidence{}
+			if m.PauseState == nil {
+				m.PauseState = &PauseState{}
 			}
-			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PauseState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -950,7 +3170,7 @@ func (m *EventSelectiveSlashing) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPowerDistUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventFinalityProviderSetChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -973,15 +3193,15 @@ func (m *EventPowerDistUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPowerDistUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFinalityProviderSetChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPowerDistUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFinalityProviderSetChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashedFp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1008,46 +3228,12 @@ func (m *EventPowerDistUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventPowerDistUpdate_EventSlashedFinalityProvider{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Ev = &EventPowerDistUpdate_SlashedFp{v}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelStateUpdate", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Diff == nil {
+				m.Diff = &FinalityProviderSetDiff{}
 			}
-			v := &EventBTCDelegationStateUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Diff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Ev = &EventPowerDistUpdate_BtcDelStateUpdate{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1070,7 +3256,7 @@ func (m *EventPowerDistUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Unmarshal(dAtA []byte) error {
+func (m *EventParamsUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1093,17 +3279,36 @@ func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Unmarshal(dAtA []byt
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSlashedFinalityProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventParamsUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSlashedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1113,24 +3318,22 @@ func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Unmarshal(dAtA []byt
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.Pk = &v
-			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
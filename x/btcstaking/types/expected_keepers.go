@@ -3,13 +3,29 @@ package types
 import (
 	"context"
 	"math/big"
+	"time"
 
 	bbn "github.com/babylonchain/babylon/types"
 	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
 	etypes "github.com/babylonchain/babylon/x/epoching/types"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
+// AccountKeeper defines the expected account keeper used for simulations (noalias)
+type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+}
+
+// BankKeeper defines the expected interface needed to retrieve account balances
+// in simulations
+type BankKeeper interface {
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
 type BTCLightClientKeeper interface {
 	GetBaseBTCHeader(ctx context.Context) *btclctypes.BTCHeaderInfo
 	GetTipInfo(ctx context.Context) *btclctypes.BTCHeaderInfo
@@ -19,9 +35,31 @@ type BTCLightClientKeeper interface {
 type BtcCheckpointKeeper interface {
 	GetPowLimit() *big.Int
 	GetParams(ctx context.Context) (p btcctypes.Params)
+	GetSubmissionKeyByTxHash(ctx context.Context, txHash *chainhash.Hash) *btcctypes.SubmissionKey
+	GetSubmissionData(ctx context.Context, sk btcctypes.SubmissionKey) *btcctypes.SubmissionData
 }
 
 type CheckpointingKeeper interface {
 	GetEpoch(ctx context.Context) *etypes.Epoch
 	GetLastFinalizedEpoch(ctx context.Context) uint64
 }
+
+type IncentiveKeeper interface {
+	IndexRefundableMsg(ctx context.Context, msg sdk.Msg)
+}
+
+type AuthzKeeper interface {
+	GetAuthorization(ctx context.Context, grantee, granter sdk.AccAddress, msgType string) (authz.Authorization, *time.Time)
+	SaveGrant(ctx context.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time) error
+	DeleteGrant(ctx context.Context, grantee, granter sdk.AccAddress, msgType string) error
+}
+
+type ZoneConciergeKeeper interface {
+	HasConsumer(ctx context.Context, consumerID string) bool
+}
+
+type BtcStakingHooks interface {
+	AfterFinalityProviderSlashed(ctx context.Context, fp *FinalityProvider, infractionHeight uint64) // Must be called after a finality provider is slashed
+	AfterFinalityProviderJailed(ctx context.Context, fp *FinalityProvider)                           // Must be called after a finality provider is jailed
+	AfterBTCDelegationActivated(ctx context.Context, btcDel *BTCDelegation)                          // Must be called after a BTC delegation becomes active
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeGrantableMsgTypeURLs returns the type URLs of the messages submitted by
+// finality providers, stakers and covenant members, whose fees can be paid by
+// a fee granter, e.g., a relayer. MsgUpdateParams and MsgSetPause are excluded
+// as they are only executed by governance or the pause authority
+func FeeGrantableMsgTypeURLs() []string {
+	return []string{
+		sdk.MsgTypeURL(&MsgCreateFinalityProvider{}),
+		sdk.MsgTypeURL(&MsgEditFinalityProvider{}),
+		sdk.MsgTypeURL(&MsgCreateBTCDelegation{}),
+		sdk.MsgTypeURL(&MsgAddCovenantSigs{}),
+		sdk.MsgTypeURL(&MsgAddCovenantMuSig2Nonces{}),
+		sdk.MsgTypeURL(&MsgBTCUndelegate{}),
+		sdk.MsgTypeURL(&MsgSetDelegationOperator{}),
+		sdk.MsgTypeURL(&MsgSetWatchtowerBackup{}),
+		sdk.MsgTypeURL(&MsgReportStakingSpend{}),
+		sdk.MsgTypeURL(&MsgUpdateFinalityProviderStatus{}),
+		sdk.MsgTypeURL(&MsgSelectiveSlashingEvidence{}),
+	}
+}
+
+// NewFeeAllowance restricts the given fee allowance to txs consisting of
+// messages of this module only, so that a relayer granting it pays the fees
+// of finality providers or stakers without paying for anything else
+func NewFeeAllowance(allowance feegrant.FeeAllowanceI) (*feegrant.AllowedMsgAllowance, error) {
+	return feegrant.NewAllowedMsgAllowance(allowance, FeeGrantableMsgTypeURLs())
+}
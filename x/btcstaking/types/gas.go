@@ -0,0 +1,20 @@
+package types
+
+// Gas charged for verifying BTC signatures and SPV proofs in messages. The
+// gas is charged explicitly before the verification, so that the gas of a
+// message is stable and reflects the cost of verifying its BTC scripts and
+// signatures, which is otherwise not metered
+const (
+	// GasSchnorrSigVerification is the gas of verifying a BIP-340 Schnorr
+	// signature over a BTC tx, including computing the sighash of the tx
+	GasSchnorrSigVerification uint64 = 4_000
+	// GasAdaptorSigVerification is the gas of verifying an adaptor signature
+	// over a BTC tx, including computing the sighash of the tx
+	GasAdaptorSigVerification uint64 = 6_000
+	// GasPoPVerification is the gas of verifying a proof of possession, which
+	// consists of a signature by the Babylon key and a signature by the BTC key
+	GasPoPVerification uint64 = 2 * GasSchnorrSigVerification
+	// GasSPVProofNode is the gas of verifying each node in the Merkle proof of
+	// an SPV proof of the inclusion of a BTC tx
+	GasSPVProofNode uint64 = 200
+)
@@ -4,7 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bbn "github.com/babylonchain/babylon/types"
 )
 
 // DefaultGenesis returns the default genesis state
@@ -22,12 +26,168 @@ func (gs GenesisState) Validate() error {
 		return fmt.Errorf("params cannot be empty")
 	}
 
-	// TODO: add validation to other properties of genstate.
 	for _, params := range gs.Params {
 		if err := params.Validate(); err != nil {
 			return err
 		}
 	}
+	if err := gs.validateFinalityProviders(); err != nil {
+		return err
+	}
+	if err := gs.validateBTCDelegations(); err != nil {
+		return err
+	}
+	for _, vp := range gs.VotingPowers {
+		if vp.FpBtcPk == nil {
+			return fmt.Errorf("empty finality provider BTC PK in voting power at height %d", vp.BlockHeight)
+		}
+	}
+	for _, del := range gs.BtcDelegators {
+		if del.Idx == nil || del.FpBtcPk == nil || del.DelBtcPk == nil {
+			return fmt.Errorf("incomplete BTC delegator index")
+		}
+	}
+	for _, evt := range gs.Events {
+		if evt.Event == nil {
+			return fmt.Errorf("empty power distribution update event at BTC height %d", evt.BlockHeightBtc)
+		}
+	}
+	for _, vpCache := range gs.VpDstCache {
+		if vpCache.VpDistribution == nil {
+			return fmt.Errorf("empty voting power distribution cache at height %d", vpCache.BlockHeight)
+		}
+	}
+	if gs.ScheduledParams != nil {
+		if err := gs.ScheduledParams.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, op := range gs.DelegationOperators {
+		if _, err := chainhash.NewHashFromStr(op.StakingTxHash); err != nil {
+			return fmt.Errorf("invalid staking tx hash of delegation operator: %w", err)
+		}
+		if _, err := sdk.AccAddressFromBech32(op.Operator); err != nil {
+			return fmt.Errorf("invalid delegation operator address: %w", err)
+		}
+	}
+	for _, backup := range gs.WatchtowerBackups {
+		if err := backup.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, report := range gs.FpStatusReports {
+		if err := report.Validate(); err != nil {
+			return err
+		}
+		if report.Status == FinalityProviderOperationalStatus_OPERATIONAL {
+			return fmt.Errorf("the status report of finality provider %s has the OPERATIONAL status", report.FpBtcPk.MarshalHex())
+		}
+	}
+	for _, entry := range gs.CovenantMusig2Nonces {
+		if _, err := chainhash.NewHashFromStr(entry.StakingTxHash); err != nil {
+			return fmt.Errorf("invalid staking tx hash of covenant MuSig2 nonces: %w", err)
+		}
+		if entry.Nonces == nil || entry.Nonces.CovPk == nil {
+			return fmt.Errorf("empty covenant MuSig2 nonces of staking tx %s", entry.StakingTxHash)
+		}
+	}
+	covPerfPKs := make(map[string]struct{}, len(gs.CovenantPerformances))
+	for _, perf := range gs.CovenantPerformances {
+		if err := perf.Validate(); err != nil {
+			return fmt.Errorf("invalid covenant performance: %w", err)
+		}
+		covPKHex := perf.CovPk.MarshalHex()
+		if _, ok := covPerfPKs[covPKHex]; ok {
+			return fmt.Errorf("duplicate performance of covenant %s", covPKHex)
+		}
+		covPerfPKs[covPKHex] = struct{}{}
+	}
+	maturingDels := make(map[string]struct{}, len(gs.MaturingBtcDelegations))
+	for _, stakingTxHash := range gs.MaturingBtcDelegations {
+		if _, err := chainhash.NewHashFromStr(stakingTxHash); err != nil {
+			return fmt.Errorf("invalid staking tx hash of maturing BTC delegation: %w", err)
+		}
+		if _, ok := maturingDels[stakingTxHash]; ok {
+			return fmt.Errorf("duplicate maturing BTC delegation %s", stakingTxHash)
+		}
+		maturingDels[stakingTxHash] = struct{}{}
+	}
+	pendingDels := make(map[string]struct{}, len(gs.PendingBtcDelegations))
+	for _, stakingTxHash := range gs.PendingBtcDelegations {
+		if _, err := chainhash.NewHashFromStr(stakingTxHash); err != nil {
+			return fmt.Errorf("invalid staking tx hash of pending BTC delegation: %w", err)
+		}
+		if _, ok := pendingDels[stakingTxHash]; ok {
+			return fmt.Errorf("duplicate pending BTC delegation %s", stakingTxHash)
+		}
+		pendingDels[stakingTxHash] = struct{}{}
+	}
+	if gs.PauseState != nil {
+		if err := gs.PauseState.Validate(); err != nil {
+			return fmt.Errorf("invalid pause state: %w", err)
+		}
+	}
+	if gs.LastFpSetDiff != nil {
+		for _, fps := range [][]*FinalityProviderPower{gs.LastFpSetDiff.Entered, gs.LastFpSetDiff.Exited} {
+			for _, fp := range fps {
+				if fp == nil || fp.FpBtcPk == nil {
+					return fmt.Errorf("empty finality provider BTC PK in the last finality provider set diff")
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validateFinalityProviders ensures that each finality provider in the genesis
+// is well-formed and registered only once
+func (gs GenesisState) validateFinalityProviders() error {
+	fpBTCPKs := make(map[string]struct{}, len(gs.FinalityProviders))
+	for _, fp := range gs.FinalityProviders {
+		if err := fp.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid finality provider: %w", err)
+		}
+		fpBTCPKHex := fp.BtcPk.MarshalHex()
+		if _, ok := fpBTCPKs[fpBTCPKHex]; ok {
+			return fmt.Errorf("duplicated finality provider %s", fpBTCPKHex)
+		}
+		fpBTCPKs[fpBTCPKHex] = struct{}{}
+	}
+	return nil
+}
+
+// validateBTCDelegations ensures that each BTC delegation in the genesis is
+// well-formed, appears only once, and carries its undelegation along with
+// consistent covenant signatures
+func (gs GenesisState) validateBTCDelegations() error {
+	stakingTxHashes := make(map[string]struct{}, len(gs.BtcDelegations))
+	for _, btcDel := range gs.BtcDelegations {
+		if err := btcDel.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid BTC delegation: %w", err)
+		}
+		stakingTxHash := btcDel.MustGetStakingTxHash().String()
+		if _, ok := stakingTxHashes[stakingTxHash]; ok {
+			return fmt.Errorf("duplicated BTC delegation %s", stakingTxHash)
+		}
+		stakingTxHashes[stakingTxHash] = struct{}{}
+
+		ud := btcDel.BtcUndelegation
+		if ud == nil {
+			return fmt.Errorf("BTC delegation %s has no undelegation", stakingTxHash)
+		}
+		if _, err := bbn.NewBTCTxFromBytes(ud.UnbondingTx); err != nil {
+			return fmt.Errorf("invalid unbonding tx of BTC delegation %s: %w", stakingTxHash, err)
+		}
+		if ud.SlashingTx == nil {
+			return fmt.Errorf("BTC delegation %s has no unbonding slashing tx", stakingTxHash)
+		}
+		// covenant members sign the staking slashing tx, the unbonding tx and
+		// the unbonding slashing tx altogether
+		numCovSigs := len(btcDel.CovenantSigs)
+		if len(ud.CovenantUnbondingSigList) != numCovSigs || len(ud.CovenantSlashingSigs) != numCovSigs {
+			return fmt.Errorf("BTC delegation %s has inconsistent covenant signatures on its undelegation", stakingTxHash)
+		}
+	}
 	return nil
 }
 
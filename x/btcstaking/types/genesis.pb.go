@@ -43,6 +43,36 @@ type GenesisState struct {
 	// vp_dst_cache is the table of all providers voting power with the total at one specific block.
 	// TODO: remove this after not storing in the keeper store it anymore.
 	VpDstCache []*VotingPowerDistCacheBlkHeight `protobuf:"bytes,8,rep,name=vp_dst_cache,json=vpDstCache,proto3" json:"vp_dst_cache,omitempty"`
+	// slashing_rate_reports are the reports of all slashing rate changes
+	SlashingRateReports []*SlashingRateChangeReport `protobuf:"bytes,9,rep,name=slashing_rate_reports,json=slashingRateReports,proto3" json:"slashing_rate_reports,omitempty"`
+	// scheduled_params are the params scheduled to take effect in the future,
+	// if any
+	ScheduledParams *ScheduledParams `protobuf:"bytes,10,opt,name=scheduled_params,json=scheduledParams,proto3" json:"scheduled_params,omitempty"`
+	// delegation_operators are the operators authorized to undelegate BTC
+	// delegations on behalf of their stakers
+	DelegationOperators []*DelegationOperator `protobuf:"bytes,11,rep,name=delegation_operators,json=delegationOperators,proto3" json:"delegation_operators,omitempty"`
+	// fp_status_reports are the status reports announced by finality providers
+	FpStatusReports []*FinalityProviderStatusReport `protobuf:"bytes,12,rep,name=fp_status_reports,json=fpStatusReports,proto3" json:"fp_status_reports,omitempty"`
+	// covenant_musig2_nonces are the MuSig2 nonces submitted by covenant members
+	// for BTC delegations that have not received covenant signatures yet
+	CovenantMusig2Nonces []*CovenantMuSig2NoncesEntry `protobuf:"bytes,13,rep,name=covenant_musig2_nonces,json=covenantMusig2Nonces,proto3" json:"covenant_musig2_nonces,omitempty"`
+	// covenant_performances are the signing records of covenant signers
+	CovenantPerformances []*CovenantPerformance `protobuf:"bytes,14,rep,name=covenant_performances,json=covenantPerformances,proto3" json:"covenant_performances,omitempty"`
+	// maturing_btc_delegations are the staking tx hashes of the BTC delegations
+	// with covenant quorum that are waiting for their activation heights
+	MaturingBtcDelegations []string `protobuf:"bytes,15,rep,name=maturing_btc_delegations,json=maturingBtcDelegations,proto3" json:"maturing_btc_delegations,omitempty"`
+	// pending_btc_delegations are the staking tx hashes of the BTC delegations
+	// that have not received covenant quorum yet
+	PendingBtcDelegations []string `protobuf:"bytes,16,rep,name=pending_btc_delegations,json=pendingBtcDelegations,proto3" json:"pending_btc_delegations,omitempty"`
+	// pause_state is the pause state of the processing of new BTC delegations
+	// and early unbondings, if any
+	PauseState *PauseState `protobuf:"bytes,17,opt,name=pause_state,json=pauseState,proto3" json:"pause_state,omitempty"`
+	// last_fp_set_diff is the last change of the active finality provider set,
+	// if any
+	LastFpSetDiff *FinalityProviderSetDiff `protobuf:"bytes,18,opt,name=last_fp_set_diff,json=lastFpSetDiff,proto3" json:"last_fp_set_diff,omitempty"`
+	// watchtower_backups are the backup data deposited by stakers for their
+	// watchtowers
+	WatchtowerBackups []*WatchtowerBackup `protobuf:"bytes,19,rep,name=watchtower_backups,json=watchtowerBackups,proto3" json:"watchtower_backups,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -134,6 +164,83 @@ func (m *GenesisState) GetVpDstCache() []*VotingPowerDistCacheBlkHeight {
 	return nil
 }
 
+func (m *GenesisState) GetSlashingRateReports() []*SlashingRateChangeReport {
+	if m != nil {
+		return m.SlashingRateReports
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledParams() *ScheduledParams {
+	if m != nil {
+		return m.ScheduledParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegationOperators() []*DelegationOperator {
+	if m != nil {
+		return m.DelegationOperators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFpStatusReports() []*FinalityProviderStatusReport {
+	if m != nil {
+		return m.FpStatusReports
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCovenantMusig2Nonces() []*CovenantMuSig2NoncesEntry {
+	if m != nil {
+		return m.CovenantMusig2Nonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCovenantPerformances() []*CovenantPerformance {
+	if m != nil {
+		return m.CovenantPerformances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMaturingBtcDelegations() []string {
+	if m != nil {
+		return m.MaturingBtcDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingBtcDelegations() []string {
+	if m != nil {
+		return m.PendingBtcDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPauseState() *PauseState {
+	if m != nil {
+		return m.PauseState
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastFpSetDiff() *FinalityProviderSetDiff {
+	if m != nil {
+		return m.LastFpSetDiff
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWatchtowerBackups() []*WatchtowerBackup {
+	if m != nil {
+		return m.WatchtowerBackups
+	}
+	return nil
+}
+
 // VotingPowerFP contains the information about the voting power
 // of an finality provider in a specific block height.
 type VotingPowerFP struct {
@@ -247,6 +354,118 @@ func (m *VotingPowerDistCacheBlkHeight) GetVpDistribution() *VotingPowerDistCach
 	return nil
 }
 
+// DelegationOperator is the operator authorized to undelegate a BTC delegation
+// on behalf of the staker
+type DelegationOperator struct {
+	// staking_tx_hash is the hash of the staking tx of the BTC delegation
+	StakingTxHash string `protobuf:"bytes,1,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// operator is the Babylon address of the operator
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *DelegationOperator) Reset()         { *m = DelegationOperator{} }
+func (m *DelegationOperator) String() string { return proto.CompactTextString(m) }
+func (*DelegationOperator) ProtoMessage()    {}
+func (*DelegationOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85d7b95fa5620238, []int{3}
+}
+func (m *DelegationOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationOperator.Merge(m, src)
+}
+func (m *DelegationOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationOperator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationOperator proto.InternalMessageInfo
+
+func (m *DelegationOperator) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
+}
+
+func (m *DelegationOperator) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+// CovenantMuSig2NoncesEntry contains the MuSig2 nonces of a covenant member
+// for co-signing a BTC delegation
+type CovenantMuSig2NoncesEntry struct {
+	// staking_tx_hash is the hash of the staking tx of the BTC delegation
+	StakingTxHash string `protobuf:"bytes,1,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// nonces are the MuSig2 nonces of the covenant member
+	Nonces *CovenantMuSig2Nonces `protobuf:"bytes,2,opt,name=nonces,proto3" json:"nonces,omitempty"`
+}
+
+func (m *CovenantMuSig2NoncesEntry) Reset()         { *m = CovenantMuSig2NoncesEntry{} }
+func (m *CovenantMuSig2NoncesEntry) String() string { return proto.CompactTextString(m) }
+func (*CovenantMuSig2NoncesEntry) ProtoMessage()    {}
+func (*CovenantMuSig2NoncesEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85d7b95fa5620238, []int{4}
+}
+func (m *CovenantMuSig2NoncesEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CovenantMuSig2NoncesEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CovenantMuSig2NoncesEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CovenantMuSig2NoncesEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CovenantMuSig2NoncesEntry.Merge(m, src)
+}
+func (m *CovenantMuSig2NoncesEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *CovenantMuSig2NoncesEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CovenantMuSig2NoncesEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CovenantMuSig2NoncesEntry proto.InternalMessageInfo
+
+func (m *CovenantMuSig2NoncesEntry) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
+}
+
+func (m *CovenantMuSig2NoncesEntry) GetNonces() *CovenantMuSig2Nonces {
+	if m != nil {
+		return m.Nonces
+	}
+	return nil
+}
+
 // BlockHeightBbnToBtc stores the btc <-> bbn block.
 type BlockHeightBbnToBtc struct {
 	// block_height_bbn is the height of the block in the babylon chain.
@@ -259,7 +478,7 @@ func (m *BlockHeightBbnToBtc) Reset()         { *m = BlockHeightBbnToBtc{} }
 func (m *BlockHeightBbnToBtc) String() string { return proto.CompactTextString(m) }
 func (*BlockHeightBbnToBtc) ProtoMessage()    {}
 func (*BlockHeightBbnToBtc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_85d7b95fa5620238, []int{3}
+	return fileDescriptor_85d7b95fa5620238, []int{5}
 }
 func (m *BlockHeightBbnToBtc) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -316,7 +535,7 @@ func (m *BTCDelegator) Reset()         { *m = BTCDelegator{} }
 func (m *BTCDelegator) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegator) ProtoMessage()    {}
 func (*BTCDelegator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_85d7b95fa5620238, []int{4}
+	return fileDescriptor_85d7b95fa5620238, []int{6}
 }
 func (m *BTCDelegator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -366,7 +585,7 @@ func (m *EventIndex) Reset()         { *m = EventIndex{} }
 func (m *EventIndex) String() string { return proto.CompactTextString(m) }
 func (*EventIndex) ProtoMessage()    {}
 func (*EventIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_85d7b95fa5620238, []int{5}
+	return fileDescriptor_85d7b95fa5620238, []int{7}
 }
 func (m *EventIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -420,6 +639,8 @@ func init() {
 	proto.RegisterType((*GenesisState)(nil), "babylon.btcstaking.v1.GenesisState")
 	proto.RegisterType((*VotingPowerFP)(nil), "babylon.btcstaking.v1.VotingPowerFP")
 	proto.RegisterType((*VotingPowerDistCacheBlkHeight)(nil), "babylon.btcstaking.v1.VotingPowerDistCacheBlkHeight")
+	proto.RegisterType((*DelegationOperator)(nil), "babylon.btcstaking.v1.DelegationOperator")
+	proto.RegisterType((*CovenantMuSig2NoncesEntry)(nil), "babylon.btcstaking.v1.CovenantMuSig2NoncesEntry")
 	proto.RegisterType((*BlockHeightBbnToBtc)(nil), "babylon.btcstaking.v1.BlockHeightBbnToBtc")
 	proto.RegisterType((*BTCDelegator)(nil), "babylon.btcstaking.v1.BTCDelegator")
 	proto.RegisterType((*EventIndex)(nil), "babylon.btcstaking.v1.EventIndex")
@@ -430,51 +651,75 @@ func init() {
 }
 
 var fileDescriptor_85d7b95fa5620238 = []byte{
-	// 694 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xdd, 0x4e, 0xd4, 0x4e,
-	0x18, 0xc6, 0x29, 0xbb, 0x2c, 0xfc, 0x67, 0x97, 0x05, 0x86, 0xbf, 0x49, 0x43, 0xc2, 0x0a, 0xc5,
-	0x8f, 0x8d, 0x26, 0x5d, 0x59, 0xd0, 0xc4, 0x43, 0xcb, 0x8a, 0xe2, 0x47, 0xd2, 0xd4, 0x95, 0x03,
-	0x4e, 0x9a, 0x4e, 0x3b, 0xdb, 0x9d, 0x6c, 0x99, 0x69, 0x3a, 0x43, 0x65, 0xaf, 0xc1, 0x13, 0x0f,
-	0xbd, 0x05, 0xef, 0xc4, 0x43, 0x0e, 0x8d, 0x07, 0xc6, 0xc0, 0x1d, 0x78, 0x01, 0xc6, 0x74, 0x5a,
-	0x68, 0xc1, 0x5d, 0xc0, 0x18, 0xcf, 0x3a, 0x93, 0xe7, 0xfd, 0xcd, 0xfb, 0xbc, 0xf3, 0x4c, 0x0a,
-	0xd6, 0x90, 0x83, 0x86, 0x01, 0xa3, 0x2d, 0x24, 0x5c, 0x2e, 0x9c, 0x01, 0xa1, 0x7e, 0x2b, 0x5e,
-	0x6f, 0xf9, 0x98, 0x62, 0x4e, 0xb8, 0x1e, 0x46, 0x4c, 0x30, 0x78, 0x23, 0x13, 0xe9, 0xb9, 0x48,
-	0x8f, 0xd7, 0x97, 0xfe, 0xf7, 0x99, 0xcf, 0xa4, 0xa2, 0x95, 0x7c, 0xa5, 0xe2, 0x25, 0x6d, 0x34,
-	0x31, 0x74, 0x22, 0x67, 0x3f, 0x03, 0x2e, 0xdd, 0x19, 0xad, 0x29, 0xe0, 0x53, 0xdd, 0xed, 0xd1,
-	0x3a, 0x42, 0x5d, 0x4c, 0x05, 0x89, 0xf1, 0xe5, 0x47, 0xe2, 0x18, 0x53, 0x91, 0x1d, 0xa9, 0xfd,
-	0x28, 0x83, 0xda, 0xb3, 0xd4, 0xd5, 0x1b, 0xe1, 0x08, 0x0c, 0x1f, 0x82, 0x4a, 0xda, 0x93, 0xaa,
-	0xac, 0x94, 0x9a, 0xd5, 0xf6, 0xb2, 0x3e, 0xd2, 0xa5, 0x6e, 0x4a, 0x91, 0x95, 0x89, 0xe1, 0x2e,
-	0x80, 0x3d, 0x42, 0x9d, 0x80, 0x88, 0xa1, 0x1d, 0x46, 0x2c, 0x26, 0x1e, 0x8e, 0xb8, 0x3a, 0x29,
-	0x11, 0x77, 0xc7, 0x20, 0xb6, 0xb3, 0x02, 0x33, 0xd3, 0x5b, 0x0b, 0xbd, 0x0b, 0x3b, 0x1c, 0xbe,
-	0x06, 0x73, 0x48, 0xb8, 0xb6, 0x87, 0x03, 0xec, 0x3b, 0x82, 0x30, 0xca, 0xd5, 0x92, 0x84, 0xde,
-	0x1a, 0x03, 0x35, 0xba, 0x5b, 0x9d, 0x33, 0xb1, 0x55, 0x47, 0xc2, 0xcd, 0x97, 0x1c, 0xee, 0x80,
-	0xd9, 0x98, 0x09, 0x42, 0x7d, 0x3b, 0x64, 0xef, 0x92, 0x0e, 0xcb, 0x97, 0xc2, 0x76, 0xa5, 0xd6,
-	0x4c, 0xa4, 0xdb, 0xa6, 0x55, 0x8b, 0xf3, 0x25, 0x87, 0x7b, 0x60, 0x11, 0x05, 0xcc, 0x1d, 0xd8,
-	0x7d, 0x4c, 0xfc, 0xbe, 0xb0, 0xdd, 0xbe, 0x43, 0x28, 0x57, 0xa7, 0x24, 0xf0, 0xde, 0xb8, 0xee,
-	0x92, 0x8a, 0xe7, 0xb2, 0xc0, 0x40, 0xb4, 0xcb, 0x0c, 0xe1, 0x5a, 0x0b, 0x28, 0xdf, 0xdc, 0x92,
-	0x10, 0xf8, 0x02, 0xd4, 0x0b, 0xae, 0x59, 0xc4, 0xd5, 0x8a, 0xc4, 0xae, 0x5d, 0x69, 0x9a, 0x45,
-	0xd6, 0x6c, 0xee, 0x99, 0x45, 0x1c, 0x3e, 0x06, 0x95, 0xf4, 0xc6, 0xd5, 0x69, 0xc9, 0x58, 0x1d,
-	0xc3, 0x78, 0x9a, 0x88, 0x76, 0xa8, 0x87, 0x0f, 0xad, 0xac, 0x00, 0xee, 0x82, 0x5a, 0x1c, 0xda,
-	0x1e, 0x17, 0xb6, 0xeb, 0xb8, 0x7d, 0xac, 0xce, 0x48, 0xc0, 0xe6, 0xd5, 0xc3, 0xea, 0x10, 0x2e,
-	0xb6, 0x92, 0x12, 0x23, 0xc8, 0x8c, 0x59, 0x20, 0x0e, 0x3b, 0xd9, 0xa6, 0xf6, 0x49, 0x01, 0xb3,
-	0xe7, 0x46, 0x0b, 0x57, 0x41, 0xad, 0x38, 0x4c, 0x55, 0x59, 0x51, 0x9a, 0x65, 0xab, 0x5a, 0x98,
-	0x0c, 0xb4, 0xc0, 0x7f, 0xbd, 0xd0, 0x4e, 0xc6, 0x12, 0x0e, 0xd4, 0xc9, 0x15, 0xa5, 0x59, 0x33,
-	0x1e, 0x7d, 0xfd, 0x76, 0xb3, 0xed, 0x13, 0xd1, 0x3f, 0x40, 0xba, 0xcb, 0xf6, 0x5b, 0x59, 0x5f,
-	0xf2, 0x26, 0x4e, 0x17, 0x2d, 0x31, 0x0c, 0x31, 0xd7, 0x8d, 0x1d, 0x73, 0x63, 0xf3, 0x81, 0x79,
-	0x80, 0x5e, 0xe2, 0xa1, 0x35, 0xdd, 0x0b, 0x0d, 0xe1, 0x9a, 0x83, 0xe4, 0xd8, 0x62, 0x1c, 0xd4,
-	0x52, 0x7a, 0x6c, 0xe1, 0x9e, 0xb5, 0x8f, 0x0a, 0x58, 0xbe, 0xd4, 0xd9, 0x75, 0x7a, 0xef, 0x82,
-	0xb9, 0x64, 0x90, 0x84, 0x8b, 0x88, 0xa0, 0x83, 0x24, 0x8a, 0xd2, 0x41, 0xb5, 0x7d, 0xff, 0x0f,
-	0x66, 0x69, 0xd5, 0xe3, 0xb0, 0x53, 0x40, 0x68, 0x04, 0x2c, 0x8e, 0xc8, 0x13, 0x6c, 0x82, 0xf9,
-	0x73, 0xc1, 0x44, 0x88, 0x66, 0x3d, 0xd5, 0xd1, 0x39, 0xf9, 0xef, 0x4a, 0xe1, 0xca, 0xbe, 0x2e,
-	0x28, 0x85, 0xab, 0xfd, 0x54, 0x40, 0xad, 0x18, 0x32, 0xd8, 0x01, 0x25, 0xe2, 0x1d, 0x4a, 0x6e,
-	0xb5, 0xdd, 0xbe, 0x46, 0x2c, 0xf3, 0x57, 0x98, 0x66, 0x2c, 0x29, 0xff, 0x27, 0x77, 0xda, 0x05,
-	0xc0, 0xc3, 0xc1, 0x29, 0xb4, 0xf4, 0x57, 0xd0, 0x19, 0x0f, 0x07, 0x92, 0xaa, 0xbd, 0x57, 0x00,
-	0xc8, 0x5f, 0x08, 0x9c, 0xcf, 0xed, 0x97, 0x53, 0x2b, 0xd7, 0x9e, 0x25, 0x7c, 0x02, 0xa6, 0xe4,
-	0xfb, 0x92, 0xbd, 0x8d, 0x8f, 0x80, 0x3c, 0xed, 0x2c, 0x01, 0x6f, 0x43, 0xcf, 0x11, 0xd8, 0x4a,
-	0x2b, 0x8d, 0x57, 0x9f, 0x8f, 0x1b, 0xca, 0xd1, 0x71, 0x43, 0xf9, 0x7e, 0xdc, 0x50, 0x3e, 0x9c,
-	0x34, 0x26, 0x8e, 0x4e, 0x1a, 0x13, 0x5f, 0x4e, 0x1a, 0x13, 0x7b, 0x57, 0xba, 0x3c, 0x2c, 0xfe,
-	0x0d, 0xa4, 0x65, 0x54, 0x91, 0xbf, 0x82, 0x8d, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x75, 0xe9,
-	0x80, 0x92, 0xf5, 0x06, 0x00, 0x00,
+	// 1087 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xd1, 0x6e, 0xe3, 0x44,
+	0x14, 0xad, 0x9b, 0x6e, 0xb7, 0x9d, 0x24, 0x4d, 0x3b, 0x6d, 0x17, 0x53, 0x69, 0x43, 0x36, 0x0b,
+	0x25, 0x80, 0x94, 0xec, 0xa6, 0xcb, 0x0a, 0x1e, 0x71, 0xb2, 0x65, 0x0b, 0x2c, 0x84, 0x69, 0xe9,
+	0xa2, 0x15, 0x92, 0x35, 0xb6, 0xc7, 0xf6, 0xa8, 0xa9, 0x3d, 0xf2, 0x4c, 0xdc, 0xf6, 0x0f, 0x90,
+	0x78, 0xe1, 0x11, 0x3e, 0x81, 0x3f, 0xe1, 0x71, 0x1f, 0x11, 0x0f, 0x08, 0xb5, 0xff, 0x81, 0x90,
+	0xc7, 0x93, 0xd8, 0x69, 0x93, 0x34, 0x08, 0xed, 0x9b, 0xe7, 0xce, 0x39, 0xe7, 0xce, 0x9d, 0x3b,
+	0x73, 0xc6, 0xe0, 0xa1, 0x85, 0xad, 0x8b, 0x7e, 0x18, 0xb4, 0x2c, 0x61, 0x73, 0x81, 0x4f, 0x68,
+	0xe0, 0xb5, 0xe2, 0xc7, 0x2d, 0x8f, 0x04, 0x84, 0x53, 0xde, 0x64, 0x51, 0x28, 0x42, 0xb8, 0xad,
+	0x40, 0xcd, 0x0c, 0xd4, 0x8c, 0x1f, 0xef, 0x6c, 0x79, 0xa1, 0x17, 0x4a, 0x44, 0x2b, 0xf9, 0x4a,
+	0xc1, 0x3b, 0xf5, 0xc9, 0x8a, 0x0c, 0x47, 0xf8, 0x54, 0x09, 0xee, 0xec, 0x4e, 0xc6, 0xe4, 0xe4,
+	0x53, 0xdc, 0x7b, 0x93, 0x71, 0x34, 0xb0, 0x49, 0x20, 0x68, 0x4c, 0x66, 0xa7, 0x24, 0x31, 0x09,
+	0x84, 0x4a, 0x59, 0xff, 0xb5, 0x04, 0x4a, 0x9f, 0xa7, 0x55, 0x1d, 0x0a, 0x2c, 0x08, 0xfc, 0x18,
+	0x2c, 0xa7, 0x6b, 0xd2, 0xb5, 0x5a, 0xa1, 0x51, 0x6c, 0xdf, 0x6f, 0x4e, 0xac, 0xb2, 0xd9, 0x93,
+	0x20, 0xa4, 0xc0, 0xf0, 0x18, 0x40, 0x97, 0x06, 0xb8, 0x4f, 0xc5, 0x85, 0xc9, 0xa2, 0x30, 0xa6,
+	0x0e, 0x89, 0xb8, 0xbe, 0x28, 0x25, 0xde, 0x9f, 0x22, 0xb1, 0xaf, 0x08, 0x3d, 0x85, 0x47, 0x1b,
+	0xee, 0xb5, 0x08, 0x87, 0x2f, 0x40, 0xc5, 0x12, 0xb6, 0xe9, 0x90, 0x3e, 0xf1, 0xb0, 0xa0, 0x61,
+	0xc0, 0xf5, 0x82, 0x14, 0x7d, 0x77, 0x8a, 0xa8, 0x71, 0xd4, 0xe9, 0x8e, 0xc0, 0x68, 0xcd, 0x12,
+	0x76, 0x36, 0xe4, 0xf0, 0x00, 0x94, 0xe3, 0x50, 0xd0, 0xc0, 0x33, 0x59, 0x78, 0x96, 0xac, 0x70,
+	0x69, 0xa6, 0xd8, 0xb1, 0xc4, 0xf6, 0x12, 0xe8, 0x7e, 0x0f, 0x95, 0xe2, 0x6c, 0xc8, 0xe1, 0x2b,
+	0xb0, 0x69, 0xf5, 0x43, 0xfb, 0xc4, 0xf4, 0x09, 0xf5, 0x7c, 0x61, 0xda, 0x3e, 0xa6, 0x01, 0xd7,
+	0xef, 0x48, 0xc1, 0x0f, 0xa7, 0xad, 0x2e, 0x61, 0x3c, 0x97, 0x04, 0xc3, 0x0a, 0x8e, 0x42, 0x43,
+	0xd8, 0x68, 0xc3, 0xca, 0x82, 0x1d, 0x29, 0x02, 0xbf, 0x00, 0x6b, 0xb9, 0xaa, 0xc3, 0x88, 0xeb,
+	0xcb, 0x52, 0xf6, 0xe1, 0xad, 0x45, 0x87, 0x11, 0x2a, 0x67, 0x35, 0x87, 0x11, 0x87, 0x9f, 0x82,
+	0xe5, 0xb4, 0xe3, 0xfa, 0x5d, 0xa9, 0xf1, 0x60, 0x8a, 0xc6, 0xb3, 0x04, 0x74, 0x10, 0x38, 0xe4,
+	0x1c, 0x29, 0x02, 0x3c, 0x06, 0xa5, 0x98, 0x99, 0x0e, 0x17, 0xa6, 0x8d, 0x6d, 0x9f, 0xe8, 0x2b,
+	0x52, 0xe0, 0xc9, 0xed, 0x9b, 0xd5, 0xa5, 0x5c, 0x74, 0x12, 0x8a, 0xd1, 0x57, 0x85, 0x21, 0x10,
+	0xb3, 0xae, 0x0a, 0x42, 0x1b, 0x6c, 0xf3, 0x3e, 0xe6, 0x7e, 0xd2, 0x87, 0x08, 0x0b, 0x62, 0x46,
+	0x84, 0x85, 0x91, 0xe0, 0xfa, 0xaa, 0x4c, 0xd0, 0x9a, 0x92, 0xe0, 0x50, 0x71, 0x10, 0x16, 0xa4,
+	0xe3, 0xe3, 0xc0, 0x23, 0x48, 0xf2, 0xd0, 0x26, 0xcf, 0xcd, 0xa4, 0x31, 0x0e, 0xbf, 0x05, 0xeb,
+	0xdc, 0xf6, 0x89, 0x33, 0xe8, 0x13, 0xc7, 0x54, 0x47, 0x1a, 0xd4, 0xb4, 0x46, 0xb1, 0xbd, 0x3b,
+	0x4d, 0x7f, 0x08, 0x57, 0x67, 0xbb, 0xc2, 0xc7, 0x03, 0xf0, 0x07, 0xb0, 0x95, 0x1d, 0x44, 0x33,
+	0x64, 0x24, 0x4a, 0x9b, 0x53, 0x94, 0xcb, 0xfe, 0x60, 0x8a, 0x6c, 0x76, 0xfe, 0xbe, 0x51, 0x0c,
+	0xb4, 0xe9, 0xdc, 0x88, 0x71, 0x68, 0x82, 0x0d, 0x97, 0x99, 0x5c, 0x60, 0x31, 0xe0, 0xa3, 0x1d,
+	0x29, 0x49, 0xe9, 0xbd, 0x39, 0x6f, 0xd0, 0xa1, 0x24, 0xab, 0x5d, 0xa9, 0xb8, 0x2c, 0x3f, 0xe6,
+	0xd0, 0x05, 0xf7, 0xec, 0x30, 0x26, 0x01, 0x0e, 0x84, 0x79, 0x3a, 0xe0, 0xd4, 0x6b, 0x9b, 0x41,
+	0x18, 0xd8, 0x84, 0xeb, 0x65, 0x99, 0xe5, 0xd1, 0x94, 0x2c, 0x1d, 0x45, 0x7a, 0x31, 0x38, 0xa4,
+	0x5e, 0xfb, 0x6b, 0x49, 0x79, 0x16, 0x88, 0xe8, 0x02, 0x6d, 0xd9, 0xa3, 0x29, 0x3e, 0x9a, 0x82,
+	0x26, 0xd8, 0x1e, 0xe5, 0x61, 0x24, 0x72, 0xc3, 0xe8, 0x14, 0xcb, 0x34, 0x6b, 0x33, 0xef, 0xc6,
+	0x30, 0x4d, 0x2f, 0xa3, 0x64, 0x09, 0x72, 0x41, 0x0e, 0x3f, 0x01, 0xfa, 0x29, 0x16, 0x83, 0x28,
+	0x39, 0x3f, 0xd7, 0xdd, 0xa1, 0x52, 0x2b, 0x34, 0x56, 0xd1, 0xbd, 0xe1, 0xbc, 0x31, 0x7e, 0xff,
+	0x9f, 0x82, 0xb7, 0x18, 0x09, 0x9c, 0x49, 0xc4, 0x75, 0x49, 0xdc, 0x56, 0xd3, 0xd7, 0x78, 0x06,
+	0x28, 0x32, 0x3c, 0xe0, 0x44, 0xb6, 0x87, 0xe8, 0x1b, 0x35, 0x6d, 0xc6, 0x4d, 0xea, 0x25, 0x48,
+	0xe9, 0xa6, 0x08, 0xb0, 0xd1, 0x37, 0x7c, 0x09, 0xd6, 0xfb, 0x98, 0x0b, 0x33, 0x69, 0x32, 0x11,
+	0xa6, 0x43, 0x5d, 0x57, 0x87, 0x52, 0xa8, 0x39, 0x6f, 0x7b, 0x89, 0xe8, 0x52, 0xd7, 0x45, 0xe5,
+	0x44, 0x67, 0x9f, 0xa9, 0x61, 0xe2, 0xbd, 0x67, 0x58, 0xd8, 0xbe, 0x48, 0xae, 0x9e, 0x69, 0x61,
+	0xfb, 0x64, 0xc0, 0xb8, 0xbe, 0x39, 0xd3, 0x7b, 0x5f, 0x8e, 0x08, 0x86, 0xc4, 0xa3, 0x8d, 0xb3,
+	0x6b, 0x11, 0x5e, 0xff, 0x4d, 0x03, 0xe5, 0x31, 0x07, 0x84, 0x0f, 0x40, 0x29, 0xef, 0x79, 0xba,
+	0x56, 0xd3, 0x1a, 0x4b, 0xa8, 0x98, 0x33, 0x30, 0x88, 0xc0, 0xaa, 0xcb, 0xe4, 0xe6, 0xb2, 0x13,
+	0x7d, 0xb1, 0xa6, 0x35, 0x4a, 0xc6, 0xd3, 0x3f, 0xff, 0x7a, 0xa7, 0xed, 0x51, 0xe1, 0x0f, 0xac,
+	0xa6, 0x1d, 0x9e, 0xb6, 0xd4, 0x8a, 0xa4, 0x61, 0x0e, 0x07, 0x2d, 0x71, 0xc1, 0x08, 0x6f, 0x1a,
+	0x07, 0xbd, 0xbd, 0x27, 0x8f, 0x7a, 0x03, 0xeb, 0x4b, 0x72, 0x81, 0xee, 0xba, 0xcc, 0x10, 0x76,
+	0xef, 0x24, 0x49, 0x9b, 0x77, 0x6d, 0xbd, 0x90, 0xa6, 0xcd, 0xd9, 0x71, 0xfd, 0x17, 0x0d, 0xdc,
+	0x9f, 0x69, 0x40, 0xf3, 0xac, 0xfd, 0x08, 0x54, 0x12, 0xbf, 0xa3, 0x5c, 0x44, 0xd4, 0x1a, 0x24,
+	0x9d, 0x97, 0x15, 0x14, 0xdb, 0x1f, 0xfd, 0x07, 0xcb, 0x43, 0x6b, 0x31, 0xeb, 0xe6, 0x24, 0xea,
+	0xdf, 0x03, 0x78, 0xd3, 0x02, 0xe0, 0x2e, 0xa8, 0x28, 0x21, 0x53, 0x9c, 0x9b, 0x3e, 0xe6, 0xbe,
+	0x5c, 0xd1, 0x2a, 0x2a, 0xab, 0xf0, 0xd1, 0xf9, 0x73, 0xcc, 0x7d, 0xb8, 0x03, 0x56, 0x86, 0x46,
+	0x23, 0x17, 0xb3, 0x8a, 0x46, 0xe3, 0xfa, 0x8f, 0x1a, 0x78, 0x7b, 0xea, 0xe5, 0x9c, 0x3b, 0x43,
+	0x07, 0x2c, 0x2b, 0x1b, 0x98, 0x5d, 0xec, 0xa4, 0x4c, 0x48, 0x51, 0xeb, 0x14, 0x6c, 0x4e, 0x78,
+	0xdb, 0x60, 0x03, 0xac, 0x8f, 0x3d, 0x92, 0x96, 0x15, 0xa8, 0x8d, 0x5f, 0xb3, 0xc6, 0xe0, 0x37,
+	0x91, 0xc2, 0xd6, 0x17, 0x6f, 0x22, 0x85, 0x5d, 0xff, 0x47, 0x03, 0xa5, 0xfc, 0x83, 0x07, 0xbb,
+	0xa0, 0x40, 0x9d, 0x73, 0xa9, 0x5b, 0x6c, 0xb7, 0xe7, 0x78, 0x22, 0xb3, 0x76, 0xa4, 0xef, 0x5d,
+	0x42, 0x7f, 0x23, 0x07, 0xf7, 0x08, 0x00, 0x87, 0xf4, 0x87, 0xa2, 0x85, 0xff, 0x25, 0xba, 0xe2,
+	0x90, 0xbe, 0x54, 0xad, 0xff, 0xa4, 0x01, 0x90, 0xbd, 0xd6, 0x70, 0x3d, 0x2b, 0x7f, 0x29, 0x2d,
+	0x65, 0xee, 0xbd, 0x84, 0x9f, 0x81, 0x3b, 0xf2, 0xad, 0xd7, 0x0b, 0x33, 0x5b, 0x2f, 0xb3, 0x8d,
+	0x8e, 0xf9, 0x77, 0xcc, 0x49, 0xbc, 0x2d, 0x65, 0x1a, 0x5f, 0xfd, 0x7e, 0x59, 0xd5, 0x5e, 0x5f,
+	0x56, 0xb5, 0xbf, 0x2f, 0xab, 0xda, 0xcf, 0x57, 0xd5, 0x85, 0xd7, 0x57, 0xd5, 0x85, 0x3f, 0xae,
+	0xaa, 0x0b, 0xaf, 0x6e, 0xad, 0xf2, 0x3c, 0xff, 0x67, 0x2a, 0x4b, 0xb6, 0x96, 0xe5, 0x6f, 0xe9,
+	0xde, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x96, 0xf8, 0x78, 0xd1, 0x81, 0x0b, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -497,6 +742,152 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WatchtowerBackups) > 0 {
+		for iNdEx := len(m.WatchtowerBackups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WatchtowerBackups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.LastFpSetDiff != nil {
+		{
+			size, err := m.LastFpSetDiff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.PauseState != nil {
+		{
+			size, err := m.PauseState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.PendingBtcDelegations) > 0 {
+		for iNdEx := len(m.PendingBtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PendingBtcDelegations[iNdEx])
+			copy(dAtA[i:], m.PendingBtcDelegations[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PendingBtcDelegations[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.MaturingBtcDelegations) > 0 {
+		for iNdEx := len(m.MaturingBtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MaturingBtcDelegations[iNdEx])
+			copy(dAtA[i:], m.MaturingBtcDelegations[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.MaturingBtcDelegations[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.CovenantPerformances) > 0 {
+		for iNdEx := len(m.CovenantPerformances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantPerformances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.CovenantMusig2Nonces) > 0 {
+		for iNdEx := len(m.CovenantMusig2Nonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantMusig2Nonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.FpStatusReports) > 0 {
+		for iNdEx := len(m.FpStatusReports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FpStatusReports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.DelegationOperators) > 0 {
+		for iNdEx := len(m.DelegationOperators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationOperators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.ScheduledParams != nil {
+		{
+			size, err := m.ScheduledParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.SlashingRateReports) > 0 {
+		for iNdEx := len(m.SlashingRateReports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashingRateReports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.VpDstCache) > 0 {
 		for iNdEx := len(m.VpDstCache) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -697,7 +1088,7 @@ func (m *VotingPowerDistCacheBlkHeight) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockHeightBbnToBtc) Marshal() (dAtA []byte, err error) {
+func (m *DelegationOperator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -707,30 +1098,34 @@ func (m *BlockHeightBbnToBtc) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockHeightBbnToBtc) MarshalTo(dAtA []byte) (int, error) {
+func (m *DelegationOperator) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockHeightBbnToBtc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DelegationOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockHeightBtc != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeightBtc))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Operator)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.BlockHeightBbn != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeightBbn))
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StakingTxHash)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BTCDelegator) Marshal() (dAtA []byte, err error) {
+func (m *CovenantMuSig2NoncesEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -740,49 +1135,124 @@ func (m *BTCDelegator) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BTCDelegator) MarshalTo(dAtA []byte) (int, error) {
+func (m *CovenantMuSig2NoncesEntry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BTCDelegator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CovenantMuSig2NoncesEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DelBtcPk != nil {
+	if m.Nonces != nil {
 		{
-			size := m.DelBtcPk.Size()
-			i -= size
-			if _, err := m.DelBtcPk.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.Nonces.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.FpBtcPk != nil {
-		{
-			size := m.FpBtcPk.Size()
 			i -= size
-			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Idx != nil {
-		{
-			size, err := m.Idx.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockHeightBbnToBtc) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockHeightBbnToBtc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockHeightBbnToBtc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeightBtc != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeightBtc))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeightBbn != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeightBbn))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelBtcPk != nil {
+		{
+			size := m.DelBtcPk.Size()
+			i -= size
+			if _, err := m.DelBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Idx != nil {
+		{
+			size, err := m.Idx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
@@ -899,6 +1369,66 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SlashingRateReports) > 0 {
+		for _, e := range m.SlashingRateReports {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ScheduledParams != nil {
+		l = m.ScheduledParams.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.DelegationOperators) > 0 {
+		for _, e := range m.DelegationOperators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FpStatusReports) > 0 {
+		for _, e := range m.FpStatusReports {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CovenantMusig2Nonces) > 0 {
+		for _, e := range m.CovenantMusig2Nonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CovenantPerformances) > 0 {
+		for _, e := range m.CovenantPerformances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MaturingBtcDelegations) > 0 {
+		for _, s := range m.MaturingBtcDelegations {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingBtcDelegations) > 0 {
+		for _, s := range m.PendingBtcDelegations {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PauseState != nil {
+		l = m.PauseState.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.LastFpSetDiff != nil {
+		l = m.LastFpSetDiff.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.WatchtowerBackups) > 0 {
+		for _, e := range m.WatchtowerBackups {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -937,6 +1467,40 @@ func (m *VotingPowerDistCacheBlkHeight) Size() (n int) {
 	return n
 }
 
+func (m *DelegationOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *CovenantMuSig2NoncesEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonces != nil {
+		l = m.Nonces.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func (m *BlockHeightBbnToBtc) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1299,61 +1863,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *VotingPowerFP) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VotingPowerFP: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VotingPowerFP: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingRateReports", wireType)
 			}
-			m.BlockHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1363,16 +1877,31 @@ func (m *VotingPowerFP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingRateReports = append(m.SlashingRateReports, &SlashingRateChangeReport{})
+			if err := m.SlashingRateReports[len(m.SlashingRateReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledParams", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1382,32 +1911,33 @@ func (m *VotingPowerFP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.FpBtcPk = &v
-			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ScheduledParams == nil {
+				m.ScheduledParams = &ScheduledParams{}
+			}
+			if err := m.ScheduledParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationOperators", wireType)
 			}
-			m.VotingPower = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1417,26 +1947,436 @@ func (m *VotingPowerFP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotingPower |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
+			m.DelegationOperators = append(m.DelegationOperators, &DelegationOperator{})
+			if err := m.DelegationOperators[len(m.DelegationOperators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpStatusReports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpStatusReports = append(m.FpStatusReports, &FinalityProviderStatusReport{})
+			if err := m.FpStatusReports[len(m.FpStatusReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantMusig2Nonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantMusig2Nonces = append(m.CovenantMusig2Nonces, &CovenantMuSig2NoncesEntry{})
+			if err := m.CovenantMusig2Nonces[len(m.CovenantMusig2Nonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantPerformances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantPerformances = append(m.CovenantPerformances, &CovenantPerformance{})
+			if err := m.CovenantPerformances[len(m.CovenantPerformances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaturingBtcDelegations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaturingBtcDelegations = append(m.MaturingBtcDelegations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBtcDelegations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingBtcDelegations = append(m.PendingBtcDelegations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PauseState == nil {
+				m.PauseState = &PauseState{}
+			}
+			if err := m.PauseState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFpSetDiff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastFpSetDiff == nil {
+				m.LastFpSetDiff = &FinalityProviderSetDiff{}
+			}
+			if err := m.LastFpSetDiff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchtowerBackups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WatchtowerBackups = append(m.WatchtowerBackups, &WatchtowerBackup{})
+			if err := m.WatchtowerBackups[len(m.WatchtowerBackups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VotingPowerFP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotingPowerFP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotingPowerFP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -1548,6 +2488,238 @@ func (m *VotingPowerDistCacheBlkHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DelegationOperator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationOperator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CovenantMuSig2NoncesEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CovenantMuSig2NoncesEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CovenantMuSig2NoncesEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nonces == nil {
+				m.Nonces = &CovenantMuSig2Nonces{}
+			}
+			if err := m.Nonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BlockHeightBbnToBtc) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"context"
+)
+
+var _ BtcStakingHooks = &MultiBtcStakingHooks{}
+
+type MultiBtcStakingHooks []BtcStakingHooks
+
+func NewMultiBtcStakingHooks(hooks ...BtcStakingHooks) MultiBtcStakingHooks {
+	return hooks
+}
+
+func (h MultiBtcStakingHooks) AfterFinalityProviderSlashed(ctx context.Context, fp *FinalityProvider, infractionHeight uint64) {
+	for i := range h {
+		h[i].AfterFinalityProviderSlashed(ctx, fp, infractionHeight)
+	}
+}
+
+func (h MultiBtcStakingHooks) AfterFinalityProviderJailed(ctx context.Context, fp *FinalityProvider) {
+	for i := range h {
+		h[i].AfterFinalityProviderJailed(ctx, fp)
+	}
+}
+
+func (h MultiBtcStakingHooks) AfterBTCDelegationActivated(ctx context.Context, btcDel *BTCDelegation) {
+	for i := range h {
+		h[i].AfterBTCDelegationActivated(ctx, btcDel)
+	}
+}
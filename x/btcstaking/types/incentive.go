@@ -34,8 +34,61 @@ func (dc *VotingPowerDistCache) ApplyActiveFinalityProviders(maxActiveFPs uint32
 	}
 }
 
+// ApplyMinSelfDelegation marks the finality providers whose self-delegated
+// Satoshis are below the given minimum, so that they are not active. It has
+// to be invoked before ApplyActiveFinalityProviders.
+func (dc *VotingPowerDistCache) ApplyMinSelfDelegation(minSelfDelegationSat uint64) {
+	for _, fp := range dc.FinalityProviders {
+		fp.InsufficientSelfDelegation = fp.SelfDelegatedSat < minSelfDelegationSat
+	}
+}
+
+// GetNumActiveFPs returns the number of active finality providers of Babylon,
+// i.e., the number of eligible finality providers not securing a consumer
+// chain capped by maxActiveFPs. It assumes the finality providers are sorted
+// by SortFinalityProviders, so that the active ones are a prefix of them.
 func (dc *VotingPowerDistCache) GetNumActiveFPs(maxActiveFPs uint32) uint32 {
-	return min(maxActiveFPs, uint32(len(dc.FinalityProviders)))
+	numEligibleFPs := uint32(0)
+	for _, fp := range dc.FinalityProviders {
+		if fp.IsEligible() && !fp.SecuresConsumer() {
+			numEligibleFPs++
+		}
+	}
+	return min(maxActiveFPs, numEligibleFPs)
+}
+
+// GetActiveConsumerFinalityProviders returns the list of active finality
+// providers securing the given consumer chain, i.e., top N of its eligible
+// finality providers in terms of voting power. It assumes the finality
+// providers are sorted by SortFinalityProviders.
+func (dc *VotingPowerDistCache) GetActiveConsumerFinalityProviders(consumerID string, maxActiveFPs uint32) []*FinalityProviderDistInfo {
+	activeFPs := []*FinalityProviderDistInfo{}
+	for _, fp := range dc.FinalityProviders {
+		if uint32(len(activeFPs)) >= maxActiveFPs {
+			break
+		}
+		if fp.ConsumerId == consumerID && fp.IsEligible() {
+			activeFPs = append(activeFPs, fp)
+		}
+	}
+	return activeFPs
+}
+
+// GetConsumerIDs returns the chain IDs of all consumer chains secured by the
+// finality providers in the cache, in the order of their first appearance
+func (dc *VotingPowerDistCache) GetConsumerIDs() []string {
+	seen := map[string]struct{}{}
+	consumerIDs := []string{}
+	for _, fp := range dc.FinalityProviders {
+		if !fp.SecuresConsumer() {
+			continue
+		}
+		if _, ok := seen[fp.ConsumerId]; !ok {
+			seen[fp.ConsumerId] = struct{}{}
+			consumerIDs = append(consumerIDs, fp.ConsumerId)
+		}
+	}
+	return consumerIDs
 }
 
 // GetActiveFinalityProviders returns the list of active finality providers
@@ -77,27 +130,44 @@ func NewFinalityProviderDistInfo(fp *FinalityProvider) *FinalityProviderDistInfo
 		Commission:       fp.Commission,
 		TotalVotingPower: 0,
 		BtcDels:          []*BTCDelDistInfo{},
+		IsJailed:         fp.Jailed,
+		ConsumerId:       fp.ConsumerId,
 	}
 }
 
+// SecuresConsumer returns whether the finality provider secures a consumer
+// chain rather than Babylon
+func (v *FinalityProviderDistInfo) SecuresConsumer() bool {
+	return v.ConsumerId != ""
+}
+
+// IsEligible returns whether the finality provider can be active, i.e., it
+// is not jailed and has sufficient self-delegation
+func (v *FinalityProviderDistInfo) IsEligible() bool {
+	return !v.IsJailed && !v.InsufficientSelfDelegation
+}
+
 func (v *FinalityProviderDistInfo) GetAddress() sdk.AccAddress {
 	return sdk.AccAddress(v.BabylonPk.Address())
 }
 
+// IsSelfDelegation returns whether the given BTC delegation is a
+// self-delegation of the finality provider, i.e., its staker BTC PK is the
+// BTC PK of the finality provider
+func (v *FinalityProviderDistInfo) IsSelfDelegation(d *BTCDelDistInfo) bool {
+	return d.BtcPk.Equals(v.BtcPk)
+}
+
 func (v *FinalityProviderDistInfo) AddBTCDel(btcDel *BTCDelegation) {
-	btcDelDistInfo := &BTCDelDistInfo{
-		BtcPk:         btcDel.BtcPk,
-		BabylonPk:     btcDel.BabylonPk,
-		StakingTxHash: btcDel.MustGetStakingTxHash().String(),
-		VotingPower:   btcDel.TotalSat,
-	}
-	v.BtcDels = append(v.BtcDels, btcDelDistInfo)
-	v.TotalVotingPower += btcDelDistInfo.VotingPower
+	v.AddBTCDelDistInfo(NewBTCDelDistInfo(btcDel.MustGetStakingTxHash().String(), btcDel))
 }
 
 func (v *FinalityProviderDistInfo) AddBTCDelDistInfo(d *BTCDelDistInfo) {
 	v.BtcDels = append(v.BtcDels, d)
 	v.TotalVotingPower += d.VotingPower
+	if v.IsSelfDelegation(d) {
+		v.SelfDelegatedSat += d.VotingPower
+	}
 }
 
 // GetBTCDelPortion returns the portion of a BTC delegation's voting power out of
@@ -106,6 +176,44 @@ func (v *FinalityProviderDistInfo) GetBTCDelPortion(d *BTCDelDistInfo) sdkmath.L
 	return sdkmath.LegacyNewDec(int64(d.VotingPower)).QuoTruncate(sdkmath.LegacyNewDec(int64(v.TotalVotingPower)))
 }
 
+// GetSelfDelegationPortion returns the portion of the finality provider's
+// self-delegated Satoshis out of its total voting power
+func (v *FinalityProviderDistInfo) GetSelfDelegationPortion() sdkmath.LegacyDec {
+	return sdkmath.LegacyNewDec(int64(v.SelfDelegatedSat)).QuoTruncate(sdkmath.LegacyNewDec(int64(v.TotalVotingPower)))
+}
+
+// GetBTCDelRewardPortion returns the portion of a BTC delegation's voting
+// power out of the voting power of its kind under the finality provider,
+// i.e., self-delegations or the other BTC delegations. Since self-delegations
+// are exempt from the commission, rewards are split between the two kinds
+// before the commission is charged.
+func (v *FinalityProviderDistInfo) GetBTCDelRewardPortion(d *BTCDelDistInfo) sdkmath.LegacyDec {
+	kindPower := v.TotalVotingPower - v.SelfDelegatedSat
+	if v.IsSelfDelegation(d) {
+		kindPower = v.SelfDelegatedSat
+	}
+	return sdkmath.LegacyNewDec(int64(d.VotingPower)).QuoTruncate(sdkmath.LegacyNewDec(int64(kindPower)))
+}
+
+// NewBTCDelDistInfo returns the distribution info of the given BTC delegation
+// with the given staking tx hash. The BTC delegation does not need to carry
+// its raw BTC txs
+func NewBTCDelDistInfo(stakingTxHash string, btcDel *BTCDelegation) *BTCDelDistInfo {
+	return &BTCDelDistInfo{
+		BtcPk:         btcDel.BtcPk,
+		BabylonPk:     btcDel.BabylonPk,
+		StakingTxHash: stakingTxHash,
+		VotingPower:   btcDel.TotalSat,
+		RewardAddress: btcDel.RewardAddress,
+	}
+}
+
+// GetAddress returns the address receiving the rewards of the BTC delegation,
+// i.e., its reward address if specified, and otherwise the address of its
+// Babylon PK
 func (d *BTCDelDistInfo) GetAddress() sdk.AccAddress {
+	if d.RewardAddress != "" {
+		return sdk.MustAccAddressFromBech32(d.RewardAddress)
+	}
 	return sdk.AccAddress(d.BabylonPk.Address())
 }
@@ -95,6 +95,21 @@ type FinalityProviderDistInfo struct {
 	TotalVotingPower uint64 `protobuf:"varint,4,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
 	// btc_dels is a list of BTC delegations' voting power information under this finality provider
 	BtcDels []*BTCDelDistInfo `protobuf:"bytes,5,rep,name=btc_dels,json=btcDels,proto3" json:"btc_dels,omitempty"`
+	// is_jailed indicates whether the finality provider is jailed, in which
+	// case it is not active regardless of its voting power
+	IsJailed bool `protobuf:"varint,6,opt,name=is_jailed,json=isJailed,proto3" json:"is_jailed,omitempty"`
+	// consumer_id is the chain ID of the consumer chain secured by the
+	// finality provider. It is empty if the finality provider secures Babylon
+	ConsumerId string `protobuf:"bytes,7,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// self_delegated_sat is the voting power of the BTC delegations whose
+	// staker BTC PK is the BTC PK of the finality provider, i.e., its
+	// self-delegations. It is included in total_voting_power
+	SelfDelegatedSat uint64 `protobuf:"varint,8,opt,name=self_delegated_sat,json=selfDelegatedSat,proto3" json:"self_delegated_sat,omitempty"`
+	// insufficient_self_delegation indicates whether the self-delegated
+	// Satoshis of the finality provider are below min_self_delegation_sat in
+	// the parameters, in which case it is not active regardless of its voting
+	// power
+	InsufficientSelfDelegation bool `protobuf:"varint,9,opt,name=insufficient_self_delegation,json=insufficientSelfDelegation,proto3" json:"insufficient_self_delegation,omitempty"`
 }
 
 func (m *FinalityProviderDistInfo) Reset()         { *m = FinalityProviderDistInfo{} }
@@ -151,6 +166,34 @@ func (m *FinalityProviderDistInfo) GetBtcDels() []*BTCDelDistInfo {
 	return nil
 }
 
+func (m *FinalityProviderDistInfo) GetIsJailed() bool {
+	if m != nil {
+		return m.IsJailed
+	}
+	return false
+}
+
+func (m *FinalityProviderDistInfo) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *FinalityProviderDistInfo) GetSelfDelegatedSat() uint64 {
+	if m != nil {
+		return m.SelfDelegatedSat
+	}
+	return 0
+}
+
+func (m *FinalityProviderDistInfo) GetInsufficientSelfDelegation() bool {
+	if m != nil {
+		return m.InsufficientSelfDelegation
+	}
+	return false
+}
+
 // BTCDelDistInfo contains the information related to reward distribution for a BTC delegation
 type BTCDelDistInfo struct {
 	// btc_pk is the Bitcoin secp256k1 PK of this BTC delegation
@@ -162,6 +205,9 @@ type BTCDelDistInfo struct {
 	StakingTxHash string `protobuf:"bytes,3,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
 	// voting_power is the voting power of the BTC delegation
 	VotingPower uint64 `protobuf:"varint,4,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// reward_address is the Babylon address receiving the rewards of the BTC
+	// delegation. If empty, the rewards go to the address of babylon_pk
+	RewardAddress string `protobuf:"bytes,5,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"`
 }
 
 func (m *BTCDelDistInfo) Reset()         { *m = BTCDelDistInfo{} }
@@ -218,6 +264,13 @@ func (m *BTCDelDistInfo) GetVotingPower() uint64 {
 	return 0
 }
 
+func (m *BTCDelDistInfo) GetRewardAddress() string {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*VotingPowerDistCache)(nil), "babylon.btcstaking.v1.VotingPowerDistCache")
 	proto.RegisterType((*FinalityProviderDistInfo)(nil), "babylon.btcstaking.v1.FinalityProviderDistInfo")
@@ -229,39 +282,46 @@ func init() {
 }
 
 var fileDescriptor_ac354c3bd6d7a66b = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x9b, 0xee, 0x0f, 0xcc, 0x1d, 0xff, 0xa2, 0x21, 0x95, 0x21, 0xa5, 0xa5, 0xd2, 0x50,
-	0x0f, 0xcc, 0xa6, 0x1d, 0xec, 0x88, 0x50, 0x16, 0x21, 0x26, 0x36, 0x29, 0x8a, 0x26, 0x0e, 0x1c,
-	0x88, 0x1c, 0xd7, 0x4d, 0xac, 0x24, 0x76, 0x54, 0x7b, 0xa1, 0xf9, 0x16, 0x7c, 0x08, 0x3e, 0x02,
-	0x1f, 0x82, 0xe3, 0xc4, 0x09, 0xed, 0x30, 0xa1, 0xf6, 0x82, 0xf8, 0x14, 0x28, 0x89, 0xd9, 0x0a,
-	0x5a, 0xc5, 0x95, 0x5b, 0xde, 0x3c, 0xcf, 0xfb, 0xbe, 0x7e, 0x7e, 0x96, 0xc1, 0x4e, 0x80, 0x83,
-	0x22, 0x11, 0x1c, 0x05, 0x8a, 0x48, 0x85, 0x63, 0xc6, 0x43, 0x94, 0x0f, 0x10, 0xe3, 0x84, 0x72,
-	0xc5, 0x72, 0x0a, 0xb3, 0x89, 0x50, 0xc2, 0xbc, 0xaf, 0x6d, 0xf0, 0xca, 0x06, 0xf3, 0xc1, 0xf6,
-	0x56, 0x28, 0x42, 0x51, 0x39, 0x50, 0xf9, 0x55, 0x9b, 0xb7, 0x1f, 0x10, 0x21, 0x53, 0x21, 0xfd,
-	0x5a, 0xa8, 0x0b, 0x2d, 0xf5, 0xea, 0x0a, 0x91, 0x49, 0x91, 0x29, 0x81, 0x24, 0x25, 0xd9, 0xf0,
-	0xf9, 0x7e, 0x3c, 0x40, 0x31, 0x2d, 0xb4, 0xa7, 0xf7, 0xc9, 0x00, 0x5b, 0x6f, 0x85, 0x62, 0x3c,
-	0x74, 0xc5, 0x07, 0x3a, 0x71, 0x98, 0x54, 0x07, 0x98, 0x44, 0xd4, 0x7c, 0x02, 0x4c, 0x25, 0x14,
-	0x4e, 0xfc, 0xbc, 0x52, 0xfd, 0xac, 0x94, 0xdb, 0x46, 0xd7, 0xe8, 0xaf, 0x7a, 0x77, 0x2b, 0x65,
-	0xa1, 0xcd, 0x7c, 0x0f, 0xcc, 0x31, 0xe3, 0x38, 0x61, 0xaa, 0x28, 0x4f, 0x92, 0xb3, 0x11, 0x9d,
-	0xc8, 0x76, 0xb3, 0xbb, 0xd2, 0x6f, 0x0d, 0x11, 0xbc, 0x36, 0x0f, 0x7c, 0xa5, 0x1b, 0x5c, 0xed,
-	0x2f, 0x77, 0x1f, 0xf2, 0xb1, 0xf0, 0xee, 0x8d, 0xff, 0x52, 0x64, 0xef, 0x47, 0x13, 0xb4, 0x97,
-	0xf9, 0xcd, 0x63, 0xb0, 0x1e, 0x28, 0xe2, 0x67, 0x71, 0x75, 0xbc, 0x4d, 0x7b, 0xff, 0xfc, 0xa2,
-	0x33, 0x0c, 0x99, 0x8a, 0x4e, 0x03, 0x48, 0x44, 0x8a, 0xf4, 0x7a, 0x12, 0x61, 0xc6, 0x7f, 0x17,
-	0x48, 0x15, 0x19, 0x95, 0xd0, 0x3e, 0x74, 0xf7, 0x9e, 0x3d, 0x75, 0x4f, 0x83, 0x37, 0xb4, 0xf0,
-	0xd6, 0x02, 0x45, 0xdc, 0xd8, 0x7c, 0x01, 0x80, 0x36, 0x95, 0x23, 0x9b, 0x5d, 0xa3, 0xdf, 0x1a,
-	0x76, 0xa0, 0x26, 0x5b, 0xb3, 0x84, 0x97, 0x2c, 0xa1, 0xee, 0xdd, 0xd0, 0x2d, 0x6e, 0x6c, 0x1e,
-	0x03, 0x40, 0x44, 0x9a, 0x32, 0x29, 0x99, 0xe0, 0xed, 0x95, 0xae, 0xd1, 0xdf, 0xb0, 0x77, 0xcf,
-	0x2f, 0x3a, 0x0f, 0xeb, 0x11, 0x72, 0x14, 0x43, 0x26, 0x50, 0x8a, 0x55, 0x04, 0x8f, 0x68, 0x88,
-	0x49, 0xe1, 0x50, 0xf2, 0xf5, 0xf3, 0x2e, 0xd0, 0x1b, 0x1c, 0x4a, 0xbc, 0x85, 0x01, 0x4b, 0x2e,
-	0x62, 0x75, 0xc9, 0x45, 0xbc, 0x04, 0x37, 0x4b, 0x16, 0x23, 0x9a, 0xc8, 0xf6, 0x5a, 0x85, 0x7f,
-	0x67, 0x09, 0x7e, 0xfb, 0xe4, 0xc0, 0xa1, 0xc9, 0x25, 0xf4, 0x1b, 0x81, 0x22, 0x0e, 0x4d, 0x64,
-	0xef, 0xa7, 0x01, 0x6e, 0xff, 0xa9, 0xfd, 0x6f, 0x80, 0x1f, 0x83, 0x3b, 0x3a, 0x87, 0xaf, 0xa6,
-	0x7e, 0x84, 0x65, 0x54, 0x53, 0xf6, 0x6e, 0xe9, 0xdf, 0x27, 0xd3, 0xd7, 0x58, 0x46, 0xe6, 0x23,
-	0xb0, 0x79, 0x0d, 0xb3, 0x56, 0x7e, 0x85, 0xcb, 0x3e, 0xfa, 0x32, 0xb3, 0x8c, 0xb3, 0x99, 0x65,
-	0x7c, 0x9f, 0x59, 0xc6, 0xc7, 0xb9, 0xd5, 0x38, 0x9b, 0x5b, 0x8d, 0x6f, 0x73, 0xab, 0xf1, 0xee,
-	0x9f, 0xf9, 0xa6, 0x8b, 0xaf, 0xb8, 0x0a, 0x1b, 0xac, 0x57, 0x6f, 0x6a, 0xef, 0x57, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x0c, 0xa8, 0xb2, 0x95, 0xe8, 0x03, 0x00, 0x00,
+	// 622 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xad, 0xfb, 0x9b, 0x4c, 0xfa, 0xf5, 0x83, 0x51, 0x91, 0x4c, 0x8b, 0x92, 0x10, 0xa9, 0x28,
+	0x0b, 0x3a, 0xa6, 0x29, 0x74, 0x89, 0x4a, 0x1a, 0x21, 0x0a, 0xad, 0x14, 0xb9, 0x15, 0x0b, 0x16,
+	0x58, 0xe3, 0xf1, 0xc4, 0x1e, 0xec, 0xcc, 0x44, 0x9e, 0x49, 0x5a, 0xbf, 0x05, 0x6c, 0x78, 0x02,
+	0x1e, 0x81, 0x87, 0x60, 0x59, 0xb1, 0x42, 0x5d, 0x54, 0xa8, 0x7d, 0x11, 0x34, 0xf6, 0xa4, 0x0d,
+	0xa8, 0x11, 0x5b, 0x76, 0xbe, 0xf7, 0x9c, 0xfb, 0x77, 0x8e, 0x35, 0x60, 0xc3, 0xc7, 0x7e, 0x96,
+	0x08, 0xee, 0xf8, 0x8a, 0x48, 0x85, 0x63, 0xc6, 0x43, 0x67, 0xb4, 0xe5, 0x30, 0x4e, 0x28, 0x57,
+	0x6c, 0x44, 0xd1, 0x20, 0x15, 0x4a, 0xc0, 0x7b, 0x86, 0x86, 0x6e, 0x68, 0x68, 0xb4, 0xb5, 0xb6,
+	0x1a, 0x8a, 0x50, 0xe4, 0x0c, 0x47, 0x7f, 0x15, 0xe4, 0xb5, 0xfb, 0x44, 0xc8, 0xbe, 0x90, 0x5e,
+	0x01, 0x14, 0x81, 0x81, 0x1a, 0x45, 0xe4, 0x90, 0x34, 0x1b, 0x28, 0xe1, 0x48, 0x4a, 0x06, 0xad,
+	0x67, 0x3b, 0xf1, 0x96, 0x13, 0xd3, 0xcc, 0x70, 0x1a, 0x5f, 0x2c, 0xb0, 0xfa, 0x56, 0x28, 0xc6,
+	0xc3, 0xae, 0x38, 0xa1, 0x69, 0x87, 0x49, 0xb5, 0x87, 0x49, 0x44, 0xe1, 0x63, 0x00, 0x95, 0x50,
+	0x38, 0xf1, 0x46, 0x39, 0xea, 0x0d, 0x34, 0x6c, 0x5b, 0x75, 0xab, 0x39, 0xef, 0xde, 0xc9, 0x91,
+	0x89, 0x32, 0xf8, 0x1e, 0xc0, 0x1e, 0xe3, 0x38, 0x61, 0x2a, 0xd3, 0x9b, 0x8c, 0x58, 0x40, 0x53,
+	0x69, 0xcf, 0xd6, 0xe7, 0x9a, 0x95, 0x96, 0x83, 0x6e, 0xbd, 0x07, 0xbd, 0x34, 0x05, 0x5d, 0xc3,
+	0xd7, 0xb3, 0xf7, 0x79, 0x4f, 0xb8, 0x77, 0x7b, 0x7f, 0x20, 0xb2, 0xf1, 0x79, 0x1e, 0xd8, 0xd3,
+	0xf8, 0xf0, 0x10, 0x2c, 0xfa, 0x8a, 0x78, 0x83, 0x38, 0x5f, 0x6f, 0xb9, 0xbd, 0x73, 0x7e, 0x51,
+	0x6b, 0x85, 0x4c, 0x45, 0x43, 0x1f, 0x11, 0xd1, 0x77, 0xcc, 0x78, 0x12, 0x61, 0xc6, 0xc7, 0x81,
+	0xa3, 0xb2, 0x01, 0x95, 0xa8, 0xbd, 0xdf, 0xdd, 0x7e, 0xfa, 0xa4, 0x3b, 0xf4, 0xdf, 0xd0, 0xcc,
+	0x5d, 0xf0, 0x15, 0xe9, 0xc6, 0xf0, 0x39, 0x00, 0x86, 0xa4, 0x5b, 0xce, 0xd6, 0xad, 0x66, 0xa5,
+	0x55, 0x43, 0x46, 0xd9, 0x42, 0x4b, 0x74, 0xad, 0x25, 0x32, 0xb5, 0x65, 0x53, 0xd2, 0x8d, 0xe1,
+	0x21, 0x00, 0x44, 0xf4, 0xfb, 0x4c, 0x4a, 0x26, 0xb8, 0x3d, 0x57, 0xb7, 0x9a, 0xe5, 0xf6, 0xe6,
+	0xf9, 0x45, 0x6d, 0xbd, 0x68, 0x21, 0x83, 0x18, 0x31, 0xe1, 0xf4, 0xb1, 0x8a, 0xd0, 0x01, 0x0d,
+	0x31, 0xc9, 0x3a, 0x94, 0x7c, 0xff, 0xba, 0x09, 0xcc, 0x84, 0x0e, 0x25, 0xee, 0x44, 0x83, 0x29,
+	0x46, 0xcc, 0x4f, 0x31, 0x62, 0x17, 0x94, 0xb4, 0x16, 0x01, 0x4d, 0xa4, 0xbd, 0x90, 0xcb, 0xbf,
+	0x31, 0x45, 0xfe, 0xf6, 0xf1, 0x5e, 0x87, 0x26, 0xd7, 0xa2, 0x2f, 0xf9, 0x8a, 0x74, 0x68, 0x22,
+	0xe1, 0x3a, 0x28, 0x33, 0xe9, 0x7d, 0xc0, 0x2c, 0xa1, 0x81, 0xbd, 0x58, 0xb7, 0x9a, 0x25, 0xb7,
+	0xc4, 0xe4, 0xeb, 0x3c, 0x86, 0x35, 0x50, 0x21, 0x82, 0xcb, 0x61, 0x9f, 0xa6, 0x1e, 0x0b, 0xec,
+	0x25, 0x7d, 0x9c, 0x0b, 0xc6, 0xa9, 0xfd, 0x40, 0x6f, 0x2b, 0x69, 0xd2, 0xd3, 0x0b, 0xd0, 0x10,
+	0x2b, 0x1a, 0x78, 0x12, 0x2b, 0xbb, 0x54, 0x6c, 0xab, 0x91, 0xce, 0x18, 0x38, 0xc2, 0x0a, 0xee,
+	0x82, 0x07, 0x8c, 0xcb, 0x61, 0xaf, 0xc7, 0x08, 0xa3, 0x5c, 0x79, 0x93, 0xa5, 0x5a, 0xbc, 0x72,
+	0x3e, 0x7e, 0x6d, 0x92, 0x73, 0x74, 0xd3, 0x83, 0x09, 0xde, 0xf8, 0x34, 0x0b, 0x56, 0x7e, 0xbf,
+	0xe4, 0x5f, 0xfb, 0x1d, 0x1e, 0x81, 0xff, 0x8d, 0xea, 0x9e, 0x3a, 0xf5, 0x22, 0x2c, 0xa3, 0xe2,
+	0x9f, 0x70, 0xff, 0x33, 0xe9, 0xe3, 0xd3, 0x57, 0x58, 0x46, 0xf0, 0x21, 0x58, 0xbe, 0xc5, 0xe1,
+	0xca, 0x68, 0xc2, 0xdc, 0x0d, 0xb0, 0x92, 0xd2, 0x13, 0x9c, 0x06, 0x1e, 0x0e, 0x82, 0x94, 0x4a,
+	0x6d, 0x71, 0xde, 0xa9, 0xc8, 0xbe, 0x28, 0x92, 0xed, 0x83, 0x6f, 0x97, 0x55, 0xeb, 0xec, 0xb2,
+	0x6a, 0xfd, 0xbc, 0xac, 0x5a, 0x1f, 0xaf, 0xaa, 0x33, 0x67, 0x57, 0xd5, 0x99, 0x1f, 0x57, 0xd5,
+	0x99, 0x77, 0x7f, 0x95, 0xe1, 0x74, 0xf2, 0x69, 0xca, 0x35, 0xf1, 0x17, 0xf3, 0x87, 0x62, 0xfb,
+	0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8f, 0xe8, 0x03, 0x6e, 0xbd, 0x04, 0x00, 0x00,
 }
 
 func (m *VotingPowerDistCache) Marshal() (dAtA []byte, err error) {
@@ -326,6 +386,38 @@ func (m *FinalityProviderDistInfo) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.InsufficientSelfDelegation {
+		i--
+		if m.InsufficientSelfDelegation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SelfDelegatedSat != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.SelfDelegatedSat))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintIncentive(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.IsJailed {
+		i--
+		if m.IsJailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.BtcDels) > 0 {
 		for iNdEx := len(m.BtcDels) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -404,6 +496,13 @@ func (m *BTCDelDistInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RewardAddress) > 0 {
+		i -= len(m.RewardAddress)
+		copy(dAtA[i:], m.RewardAddress)
+		i = encodeVarintIncentive(dAtA, i, uint64(len(m.RewardAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.VotingPower != 0 {
 		i = encodeVarintIncentive(dAtA, i, uint64(m.VotingPower))
 		i--
@@ -499,6 +598,19 @@ func (m *FinalityProviderDistInfo) Size() (n int) {
 			n += 1 + l + sovIncentive(uint64(l))
 		}
 	}
+	if m.IsJailed {
+		n += 2
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovIncentive(uint64(l))
+	}
+	if m.SelfDelegatedSat != 0 {
+		n += 1 + sovIncentive(uint64(m.SelfDelegatedSat))
+	}
+	if m.InsufficientSelfDelegation {
+		n += 2
+	}
 	return n
 }
 
@@ -523,6 +635,10 @@ func (m *BTCDelDistInfo) Size() (n int) {
 	if m.VotingPower != 0 {
 		n += 1 + sovIncentive(uint64(m.VotingPower))
 	}
+	l = len(m.RewardAddress)
+	if l > 0 {
+		n += 1 + l + sovIncentive(uint64(l))
+	}
 	return n
 }
 
@@ -824,6 +940,97 @@ func (m *FinalityProviderDistInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsJailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsJailed = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfDelegatedSat", wireType)
+			}
+			m.SelfDelegatedSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfDelegatedSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsufficientSelfDelegation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsufficientSelfDelegation = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIncentive(dAtA[iNdEx:])
@@ -996,6 +1203,38 @@ func (m *BTCDelDistInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIncentive(dAtA[iNdEx:])
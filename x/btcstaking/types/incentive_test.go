@@ -0,0 +1,64 @@
+package types_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+func FuzzApplyMinSelfDelegation(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		minSelfDelegationSat := datagen.RandomInt(r, 1000) + 1
+		maxActiveFPs := uint32(datagen.RandomInt(r, 10) + 1)
+
+		dc := types.NewVotingPowerDistCache()
+		numFPs := datagen.RandomInt(r, 10) + 1
+		for i := uint64(0); i < numFPs; i++ {
+			fp, err := datagen.GenRandomFinalityProviderDistInfo(r)
+			require.NoError(t, err)
+			// some finality providers self-delegate
+			if datagen.OneInN(r, 2) {
+				selfDel, err := datagen.GenRandomBTCDelDistInfo(r)
+				require.NoError(t, err)
+				selfDel.BtcPk = fp.BtcPk
+				fp.AddBTCDelDistInfo(selfDel)
+				require.True(t, fp.IsSelfDelegation(selfDel))
+				require.Equal(t, selfDel.VotingPower, fp.SelfDelegatedSat)
+			}
+			dc.AddFinalityProviderDistInfo(fp)
+		}
+
+		dc.ApplyMinSelfDelegation(minSelfDelegationSat)
+		dc.ApplyActiveFinalityProviders(maxActiveFPs)
+
+		// only finality providers with sufficient self-delegation are active
+		numEligibleFPs := uint32(0)
+		for _, fp := range dc.FinalityProviders {
+			require.Equal(t, fp.SelfDelegatedSat < minSelfDelegationSat, fp.InsufficientSelfDelegation)
+			if fp.IsEligible() {
+				numEligibleFPs++
+			}
+		}
+		activeFPs := dc.GetActiveFinalityProviders(maxActiveFPs)
+		require.Len(t, activeFPs, int(min(maxActiveFPs, numEligibleFPs)))
+		totalVotingPower := uint64(0)
+		for _, fp := range activeFPs {
+			require.GreaterOrEqual(t, fp.SelfDelegatedSat, minSelfDelegationSat)
+			totalVotingPower += fp.TotalVotingPower
+		}
+		require.Equal(t, totalVotingPower, dc.TotalVotingPower)
+
+		// without a minimum, all non-jailed finality providers are eligible
+		dc.ApplyMinSelfDelegation(0)
+		dc.ApplyActiveFinalityProviders(maxActiveFPs)
+		require.Len(t, dc.GetActiveFinalityProviders(maxActiveFPs), int(min(maxActiveFPs, uint32(numFPs))))
+	})
+}
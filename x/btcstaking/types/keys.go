@@ -14,6 +14,10 @@ const (
 	MemStoreKey = "mem_btcstaking"
 )
 
+// MaxConsumerIDLength is the maximum length of a consumer chain ID, which is
+// length-prefixed in the keys of the consumer chain stores
+const MaxConsumerIDLength = 255
+
 var (
 	ParamsKey               = []byte{0x01} // key prefix for the parameters
 	FinalityProviderKey     = []byte{0x02} // key prefix for the finality providers
@@ -23,4 +27,20 @@ var (
 	BTCHeightKey            = []byte{0x06} // key prefix for the BTC heights
 	VotingPowerDistCacheKey = []byte{0x07} // key prefix for voting power distribution cache
 	PowerDistUpdateKey      = []byte{0x08} // key prefix for power distribution update events
+	SlashingRateReportKey   = []byte{0x09} // key prefix for slashing rate change reports
+	ScheduledParamsKey      = []byte{0x0A} // key for the scheduled parameters
+	DelegationOperatorKey   = []byte{0x0B} // key prefix for the BTC delegation operators
+	FpStatusReportKey       = []byte{0x0C} // key prefix for the finality provider status reports
+	CovenantMuSig2NoncesKey = []byte{0x0D} // key prefix for the MuSig2 nonces of covenant members
+	ConsumerFPKey           = []byte{0x0E} // key prefix for the finality providers of consumer chains
+	ConsumerVotingPowerKey  = []byte{0x0F} // key prefix for the voting power of consumer chains
+	CovenantPerformanceKey  = []byte{0x10} // key prefix for the signing records of covenant signers
+	BTCDelActivationKey     = []byte{0x11} // key prefix for the BTC delegations indexed by activation height
+	BTCDelegationTxsKey     = []byte{0x12} // key prefix for the raw BTC txs of the BTC delegations
+	PendingBTCDelStakerKey  = []byte{0x13} // key prefix for the pending BTC delegations indexed by staker
+	PendingBTCDelHeightKey  = []byte{0x14} // key prefix for the pending BTC delegations indexed by inclusion height
+	PauseStateKey           = []byte{0x15} // key for the pause state of new BTC delegations and early unbondings
+	LastFPSetDiffKey        = []byte{0x16} // key for the last change of the active finality provider set
+	BTCTxIndexKey           = []byte{0x17} // key prefix for the BTC delegations indexed by their other BTC txs
+	WatchtowerBackupKey     = []byte{0x18} // key prefix for the watchtower backups of the BTC delegations
 )
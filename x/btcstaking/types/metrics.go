@@ -10,7 +10,12 @@ const (
 	MetricsKeyCreateFinalityProvider    = "create_finality_provider"
 	MetricsKeyCreateBTCDelegation       = "create_btc_delegation"
 	MetricsKeyAddCovenantSigs           = "add_covenant_sigs"
+	MetricsKeyAddCovenantMuSig2Nonces   = "add_covenant_musig2_nonces"
 	MetricsKeyBTCUndelegate             = "btc_undelegate"
+	MetricsKeySetDelegationOperator     = "set_delegation_operator"
+	MetricsKeySetWatchtowerBackup       = "set_watchtower_backup"
+	MetricsKeyReportStakingSpend        = "report_staking_spend"
+	MetricsKeyUpdateFpStatus            = "update_finality_provider_status"
 	MetricsKeySelectiveSlashingEvidence = "selective_slashing_evidence"
 )
 
@@ -89,3 +94,55 @@ func RecordMetricsKeyStakedBitcoins(amount float32) {
 		labels,
 	)
 }
+
+// Metrics for monitoring the covenant committee and the gas usage of messages
+const (
+	// MetricsKeyNewBTCDelegations is the key of the counter recording the
+	// number of BTC delegations that newly become {pending, active, unbonded}
+	MetricsKeyNewBTCDelegations = "new_btc_delegations"
+	// MetricsKeyCovenantQuorumLatency is the key of the histogram recording the
+	// number of BTC blocks between the inclusion of a BTC delegation's staking
+	// tx and the BTC delegation receiving a covenant quorum
+	MetricsKeyCovenantQuorumLatency = "covenant_quorum_latency"
+	// MetricsKeyGasUsed is the key of the histogram recording the gas used by
+	// each message
+	MetricsKeyGasUsed = "gas_used"
+)
+
+// RecordNewBTCDelegation increments the number of BTC delegations that newly
+// become the given status.
+// It is triggered upon a BTC delegation becomes pending, active or unbonded.
+func RecordNewBTCDelegation(status BTCDelegationStatus) {
+	keys := []string{MetricsKeyNewBTCDelegations, status.String()}
+	labels := []metrics.Label{telemetry.NewLabel(telemetry.MetricLabelNameModule, ModuleName)}
+	telemetry.IncrCounterWithLabels(
+		keys,
+		1,
+		labels,
+	)
+}
+
+// RecordCovenantQuorumLatency records the number of BTC blocks a BTC
+// delegation waits for a covenant quorum since its staking tx is included.
+// It is triggered upon a BTC delegation receives a covenant quorum.
+func RecordCovenantQuorumLatency(numBTCBlocks uint64) {
+	keys := []string{MetricsKeyCovenantQuorumLatency}
+	labels := []metrics.Label{telemetry.NewLabel(telemetry.MetricLabelNameModule, ModuleName)}
+	metrics.AddSampleWithLabels(
+		keys,
+		float32(numBTCBlocks),
+		labels,
+	)
+}
+
+// RecordMsgGasUsed records the gas used by the message with the given metrics key.
+// It is triggered upon the message handler returns.
+func RecordMsgGasUsed(msgKey string, gasUsed uint64) {
+	keys := []string{MetricsKeyGasUsed, msgKey}
+	labels := []metrics.Label{telemetry.NewLabel(telemetry.MetricLabelNameModule, ModuleName)}
+	metrics.AddSampleWithLabels(
+		keys,
+		float32(gasUsed),
+		labels,
+	)
+}
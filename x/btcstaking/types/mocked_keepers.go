@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: x/btcstaking/types/expected_keepers.go
+// Source: types/expected_keepers.go
 
 // Package types is a generated GoMock package.
 package types
@@ -8,14 +8,106 @@ import (
 	context "context"
 	big "math/big"
 	reflect "reflect"
+	time "time"
 
 	types "github.com/babylonchain/babylon/types"
 	types0 "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	types1 "github.com/babylonchain/babylon/x/btclightclient/types"
 	types2 "github.com/babylonchain/babylon/x/epoching/types"
+	chainhash "github.com/btcsuite/btcd/chaincfg/chainhash"
+	types3 "github.com/cosmos/cosmos-sdk/types"
+	authz "github.com/cosmos/cosmos-sdk/x/authz"
 	gomock "github.com/golang/mock/gomock"
 )
 
+// MockAccountKeeper is a mock of AccountKeeper interface.
+type MockAccountKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountKeeperMockRecorder
+}
+
+// MockAccountKeeperMockRecorder is the mock recorder for MockAccountKeeper.
+type MockAccountKeeperMockRecorder struct {
+	mock *MockAccountKeeper
+}
+
+// NewMockAccountKeeper creates a new mock instance.
+func NewMockAccountKeeper(ctrl *gomock.Controller) *MockAccountKeeper {
+	mock := &MockAccountKeeper{ctrl: ctrl}
+	mock.recorder = &MockAccountKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountKeeper) EXPECT() *MockAccountKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetAccount mocks base method.
+func (m *MockAccountKeeper) GetAccount(ctx context.Context, addr types3.AccAddress) types3.AccountI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", ctx, addr)
+	ret0, _ := ret[0].(types3.AccountI)
+	return ret0
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockAccountKeeperMockRecorder) GetAccount(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockAccountKeeper)(nil).GetAccount), ctx, addr)
+}
+
+// MockBankKeeper is a mock of BankKeeper interface.
+type MockBankKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockBankKeeperMockRecorder
+}
+
+// MockBankKeeperMockRecorder is the mock recorder for MockBankKeeper.
+type MockBankKeeperMockRecorder struct {
+	mock *MockBankKeeper
+}
+
+// NewMockBankKeeper creates a new mock instance.
+func NewMockBankKeeper(ctrl *gomock.Controller) *MockBankKeeper {
+	mock := &MockBankKeeper{ctrl: ctrl}
+	mock.recorder = &MockBankKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetBalance mocks base method.
+func (m *MockBankKeeper) GetBalance(ctx context.Context, addr types3.AccAddress, denom string) types3.Coin {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", ctx, addr, denom)
+	ret0, _ := ret[0].(types3.Coin)
+	return ret0
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockBankKeeperMockRecorder) GetBalance(ctx, addr, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockBankKeeper)(nil).GetBalance), ctx, addr, denom)
+}
+
+// SpendableCoins mocks base method.
+func (m *MockBankKeeper) SpendableCoins(ctx context.Context, addr types3.AccAddress) types3.Coins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpendableCoins", ctx, addr)
+	ret0, _ := ret[0].(types3.Coins)
+	return ret0
+}
+
+// SpendableCoins indicates an expected call of SpendableCoins.
+func (mr *MockBankKeeperMockRecorder) SpendableCoins(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), ctx, addr)
+}
+
 // MockBTCLightClientKeeper is a mock of BTCLightClientKeeper interface.
 type MockBTCLightClientKeeper struct {
 	ctrl     *gomock.Controller
@@ -132,6 +224,34 @@ func (mr *MockBtcCheckpointKeeperMockRecorder) GetPowLimit() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPowLimit", reflect.TypeOf((*MockBtcCheckpointKeeper)(nil).GetPowLimit))
 }
 
+// GetSubmissionData mocks base method.
+func (m *MockBtcCheckpointKeeper) GetSubmissionData(ctx context.Context, sk types0.SubmissionKey) *types0.SubmissionData {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubmissionData", ctx, sk)
+	ret0, _ := ret[0].(*types0.SubmissionData)
+	return ret0
+}
+
+// GetSubmissionData indicates an expected call of GetSubmissionData.
+func (mr *MockBtcCheckpointKeeperMockRecorder) GetSubmissionData(ctx, sk interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubmissionData", reflect.TypeOf((*MockBtcCheckpointKeeper)(nil).GetSubmissionData), ctx, sk)
+}
+
+// GetSubmissionKeyByTxHash mocks base method.
+func (m *MockBtcCheckpointKeeper) GetSubmissionKeyByTxHash(ctx context.Context, txHash *chainhash.Hash) *types0.SubmissionKey {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubmissionKeyByTxHash", ctx, txHash)
+	ret0, _ := ret[0].(*types0.SubmissionKey)
+	return ret0
+}
+
+// GetSubmissionKeyByTxHash indicates an expected call of GetSubmissionKeyByTxHash.
+func (mr *MockBtcCheckpointKeeperMockRecorder) GetSubmissionKeyByTxHash(ctx, txHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubmissionKeyByTxHash", reflect.TypeOf((*MockBtcCheckpointKeeper)(nil).GetSubmissionKeyByTxHash), ctx, txHash)
+}
+
 // MockCheckpointingKeeper is a mock of CheckpointingKeeper interface.
 type MockCheckpointingKeeper struct {
 	ctrl     *gomock.Controller
@@ -182,3 +302,200 @@ func (mr *MockCheckpointingKeeperMockRecorder) GetLastFinalizedEpoch(ctx interfa
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastFinalizedEpoch", reflect.TypeOf((*MockCheckpointingKeeper)(nil).GetLastFinalizedEpoch), ctx)
 }
+
+// MockIncentiveKeeper is a mock of IncentiveKeeper interface.
+type MockIncentiveKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockIncentiveKeeperMockRecorder
+}
+
+// MockIncentiveKeeperMockRecorder is the mock recorder for MockIncentiveKeeper.
+type MockIncentiveKeeperMockRecorder struct {
+	mock *MockIncentiveKeeper
+}
+
+// NewMockIncentiveKeeper creates a new mock instance.
+func NewMockIncentiveKeeper(ctrl *gomock.Controller) *MockIncentiveKeeper {
+	mock := &MockIncentiveKeeper{ctrl: ctrl}
+	mock.recorder = &MockIncentiveKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIncentiveKeeper) EXPECT() *MockIncentiveKeeperMockRecorder {
+	return m.recorder
+}
+
+// IndexRefundableMsg mocks base method.
+func (m *MockIncentiveKeeper) IndexRefundableMsg(ctx context.Context, msg types3.Msg) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IndexRefundableMsg", ctx, msg)
+}
+
+// IndexRefundableMsg indicates an expected call of IndexRefundableMsg.
+func (mr *MockIncentiveKeeperMockRecorder) IndexRefundableMsg(ctx, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexRefundableMsg", reflect.TypeOf((*MockIncentiveKeeper)(nil).IndexRefundableMsg), ctx, msg)
+}
+
+// MockAuthzKeeper is a mock of AuthzKeeper interface.
+type MockAuthzKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthzKeeperMockRecorder
+}
+
+// MockAuthzKeeperMockRecorder is the mock recorder for MockAuthzKeeper.
+type MockAuthzKeeperMockRecorder struct {
+	mock *MockAuthzKeeper
+}
+
+// NewMockAuthzKeeper creates a new mock instance.
+func NewMockAuthzKeeper(ctrl *gomock.Controller) *MockAuthzKeeper {
+	mock := &MockAuthzKeeper{ctrl: ctrl}
+	mock.recorder = &MockAuthzKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthzKeeper) EXPECT() *MockAuthzKeeperMockRecorder {
+	return m.recorder
+}
+
+// DeleteGrant mocks base method.
+func (m *MockAuthzKeeper) DeleteGrant(ctx context.Context, grantee, granter types3.AccAddress, msgType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGrant", ctx, grantee, granter, msgType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGrant indicates an expected call of DeleteGrant.
+func (mr *MockAuthzKeeperMockRecorder) DeleteGrant(ctx, grantee, granter, msgType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGrant", reflect.TypeOf((*MockAuthzKeeper)(nil).DeleteGrant), ctx, grantee, granter, msgType)
+}
+
+// GetAuthorization mocks base method.
+func (m *MockAuthzKeeper) GetAuthorization(ctx context.Context, grantee, granter types3.AccAddress, msgType string) (authz.Authorization, *time.Time) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthorization", ctx, grantee, granter, msgType)
+	ret0, _ := ret[0].(authz.Authorization)
+	ret1, _ := ret[1].(*time.Time)
+	return ret0, ret1
+}
+
+// GetAuthorization indicates an expected call of GetAuthorization.
+func (mr *MockAuthzKeeperMockRecorder) GetAuthorization(ctx, grantee, granter, msgType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorization", reflect.TypeOf((*MockAuthzKeeper)(nil).GetAuthorization), ctx, grantee, granter, msgType)
+}
+
+// SaveGrant mocks base method.
+func (m *MockAuthzKeeper) SaveGrant(ctx context.Context, grantee, granter types3.AccAddress, authorization authz.Authorization, expiration *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveGrant", ctx, grantee, granter, authorization, expiration)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveGrant indicates an expected call of SaveGrant.
+func (mr *MockAuthzKeeperMockRecorder) SaveGrant(ctx, grantee, granter, authorization, expiration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveGrant", reflect.TypeOf((*MockAuthzKeeper)(nil).SaveGrant), ctx, grantee, granter, authorization, expiration)
+}
+
+// MockZoneConciergeKeeper is a mock of ZoneConciergeKeeper interface.
+type MockZoneConciergeKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockZoneConciergeKeeperMockRecorder
+}
+
+// MockZoneConciergeKeeperMockRecorder is the mock recorder for MockZoneConciergeKeeper.
+type MockZoneConciergeKeeperMockRecorder struct {
+	mock *MockZoneConciergeKeeper
+}
+
+// NewMockZoneConciergeKeeper creates a new mock instance.
+func NewMockZoneConciergeKeeper(ctrl *gomock.Controller) *MockZoneConciergeKeeper {
+	mock := &MockZoneConciergeKeeper{ctrl: ctrl}
+	mock.recorder = &MockZoneConciergeKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockZoneConciergeKeeper) EXPECT() *MockZoneConciergeKeeperMockRecorder {
+	return m.recorder
+}
+
+// HasConsumer mocks base method.
+func (m *MockZoneConciergeKeeper) HasConsumer(ctx context.Context, consumerID string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasConsumer", ctx, consumerID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasConsumer indicates an expected call of HasConsumer.
+func (mr *MockZoneConciergeKeeperMockRecorder) HasConsumer(ctx, consumerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasConsumer", reflect.TypeOf((*MockZoneConciergeKeeper)(nil).HasConsumer), ctx, consumerID)
+}
+
+// MockBtcStakingHooks is a mock of BtcStakingHooks interface.
+type MockBtcStakingHooks struct {
+	ctrl     *gomock.Controller
+	recorder *MockBtcStakingHooksMockRecorder
+}
+
+// MockBtcStakingHooksMockRecorder is the mock recorder for MockBtcStakingHooks.
+type MockBtcStakingHooksMockRecorder struct {
+	mock *MockBtcStakingHooks
+}
+
+// NewMockBtcStakingHooks creates a new mock instance.
+func NewMockBtcStakingHooks(ctrl *gomock.Controller) *MockBtcStakingHooks {
+	mock := &MockBtcStakingHooks{ctrl: ctrl}
+	mock.recorder = &MockBtcStakingHooksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBtcStakingHooks) EXPECT() *MockBtcStakingHooksMockRecorder {
+	return m.recorder
+}
+
+// AfterBTCDelegationActivated mocks base method.
+func (m *MockBtcStakingHooks) AfterBTCDelegationActivated(ctx context.Context, btcDel *BTCDelegation) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AfterBTCDelegationActivated", ctx, btcDel)
+}
+
+// AfterBTCDelegationActivated indicates an expected call of AfterBTCDelegationActivated.
+func (mr *MockBtcStakingHooksMockRecorder) AfterBTCDelegationActivated(ctx, btcDel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterBTCDelegationActivated", reflect.TypeOf((*MockBtcStakingHooks)(nil).AfterBTCDelegationActivated), ctx, btcDel)
+}
+
+// AfterFinalityProviderJailed mocks base method.
+func (m *MockBtcStakingHooks) AfterFinalityProviderJailed(ctx context.Context, fp *FinalityProvider) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AfterFinalityProviderJailed", ctx, fp)
+}
+
+// AfterFinalityProviderJailed indicates an expected call of AfterFinalityProviderJailed.
+func (mr *MockBtcStakingHooksMockRecorder) AfterFinalityProviderJailed(ctx, fp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterFinalityProviderJailed", reflect.TypeOf((*MockBtcStakingHooks)(nil).AfterFinalityProviderJailed), ctx, fp)
+}
+
+// AfterFinalityProviderSlashed mocks base method.
+func (m *MockBtcStakingHooks) AfterFinalityProviderSlashed(ctx context.Context, fp *FinalityProvider, infractionHeight uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AfterFinalityProviderSlashed", ctx, fp, infractionHeight)
+}
+
+// AfterFinalityProviderSlashed indicates an expected call of AfterFinalityProviderSlashed.
+func (mr *MockBtcStakingHooksMockRecorder) AfterFinalityProviderSlashed(ctx, fp, infractionHeight interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterFinalityProviderSlashed", reflect.TypeOf((*MockBtcStakingHooks)(nil).AfterFinalityProviderSlashed), ctx, fp, infractionHeight)
+}
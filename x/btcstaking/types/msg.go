@@ -17,7 +17,13 @@ var (
 	_ sdk.Msg = &MsgEditFinalityProvider{}
 	_ sdk.Msg = &MsgCreateBTCDelegation{}
 	_ sdk.Msg = &MsgAddCovenantSigs{}
+	_ sdk.Msg = &MsgAddCovenantMuSig2Nonces{}
 	_ sdk.Msg = &MsgBTCUndelegate{}
+	_ sdk.Msg = &MsgSetDelegationOperator{}
+	_ sdk.Msg = &MsgSetWatchtowerBackup{}
+	_ sdk.Msg = &MsgReportStakingSpend{}
+	_ sdk.Msg = &MsgUpdateFinalityProviderStatus{}
+	_ sdk.Msg = &MsgSetPause{}
 )
 
 func (m *MsgCreateFinalityProvider) ValidateBasic() error {
@@ -45,6 +51,9 @@ func (m *MsgCreateFinalityProvider) ValidateBasic() error {
 	if m.Pop == nil {
 		return fmt.Errorf("empty proof of possession")
 	}
+	if len(m.ConsumerId) > MaxConsumerIDLength {
+		return fmt.Errorf("consumer ID is longer than %d bytes", MaxConsumerIDLength)
+	}
 	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
 		return err
 	}
@@ -82,6 +91,10 @@ func (m *MsgEditFinalityProvider) ValidateBasic() error {
 }
 
 func (m *MsgCreateBTCDelegation) ValidateBasic() error {
+	// reject oversized messages before parsing any of their fields
+	if err := m.validateSizeLimits(); err != nil {
+		return err
+	}
 	if m.BabylonPk == nil {
 		return fmt.Errorf("empty Babylon public key")
 	}
@@ -129,6 +142,14 @@ func (m *MsgCreateBTCDelegation) ValidateBasic() error {
 	if ExistsDup(m.FpBtcPkList) {
 		return ErrDuplicatedFp
 	}
+	if len(m.Memo) > MaxBTCDelegationMemoLength {
+		return fmt.Errorf("memo is longer than %d bytes", MaxBTCDelegationMemoLength)
+	}
+	if m.RewardAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(m.RewardAddress); err != nil {
+			return fmt.Errorf("invalid reward address: %w", err)
+		}
+	}
 
 	// staking tx should be correctly formatted
 	if err := m.StakingTx.ValidateBasic(); err != nil {
@@ -174,6 +195,9 @@ func (m *MsgCreateBTCDelegation) ValidateBasic() error {
 }
 
 func (m *MsgAddCovenantSigs) ValidateBasic() error {
+	if err := m.validateSizeLimits(); err != nil {
+		return err
+	}
 	if m.Pk == nil {
 		return fmt.Errorf("empty BTC covenant public key")
 	}
@@ -203,6 +227,32 @@ func (m *MsgAddCovenantSigs) ValidateBasic() error {
 	return nil
 }
 
+func (m *MsgAddCovenantMuSig2Nonces) ValidateBasic() error {
+	if err := m.validateSizeLimits(); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return fmt.Errorf("invalid signer address: %w", err)
+	}
+	if len(m.StakingTxHash) != chainhash.MaxHashStringSize {
+		return fmt.Errorf("staking tx hash is not %d", chainhash.MaxHashStringSize)
+	}
+	if m.Nonces.CovPk == nil {
+		return fmt.Errorf("empty BTC covenant public key")
+	}
+	if _, err := m.Nonces.CovPk.ToBTCPK(); err != nil {
+		return fmt.Errorf("invalid BTC public key: %v", err)
+	}
+	if m.Sig == nil {
+		return fmt.Errorf("empty signature of the covenant member on the nonces")
+	}
+	if _, err := m.Sig.ToBTCSig(); err != nil {
+		return fmt.Errorf("invalid signature of the covenant member on the nonces: %w", err)
+	}
+
+	return nil
+}
+
 func (m *MsgBTCUndelegate) ValidateBasic() error {
 	if len(m.StakingTxHash) != chainhash.MaxHashStringSize {
 		return fmt.Errorf("staking tx hash is not %d", chainhash.MaxHashStringSize)
@@ -218,3 +268,102 @@ func (m *MsgBTCUndelegate) ValidateBasic() error {
 
 	return nil
 }
+
+func (m *MsgSetDelegationOperator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return fmt.Errorf("invalid signer address: %w", err)
+	}
+	if len(m.StakingTxHash) != chainhash.MaxHashStringSize {
+		return fmt.Errorf("staking tx hash is not %d", chainhash.MaxHashStringSize)
+	}
+	// an empty operator revokes the existing operator
+	if len(m.Operator) == 0 {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return fmt.Errorf("invalid operator address: %w", err)
+	}
+	if m.Operator == m.Signer {
+		return fmt.Errorf("the operator cannot be the staker itself")
+	}
+	return nil
+}
+
+func (m *MsgSetWatchtowerBackup) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return fmt.Errorf("invalid signer address: %w", err)
+	}
+	if len(m.StakingTxHash) != chainhash.MaxHashStringSize {
+		return fmt.Errorf("staking tx hash is not %d", chainhash.MaxHashStringSize)
+	}
+	// an empty watchtower along with empty backup data removes the existing
+	// backup data
+	if len(m.WatchtowerAddress) == 0 {
+		if len(m.EncryptedBackup) != 0 {
+			return fmt.Errorf("backup data without watchtower address")
+		}
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(m.WatchtowerAddress); err != nil {
+		return fmt.Errorf("invalid watchtower address: %w", err)
+	}
+	if len(m.EncryptedBackup) == 0 {
+		return fmt.Errorf("empty backup data")
+	}
+	if len(m.EncryptedBackup) > MaxWatchtowerBackupSize {
+		return fmt.Errorf("backup data is larger than %d bytes", MaxWatchtowerBackupSize)
+	}
+	return nil
+}
+
+func (m *MsgReportStakingSpend) ValidateBasic() error {
+	if err := m.validateSizeLimits(); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return fmt.Errorf("invalid signer address: %w", err)
+	}
+	if len(m.StakingTxHash) != chainhash.MaxHashStringSize {
+		return fmt.Errorf("staking tx hash is not %d", chainhash.MaxHashStringSize)
+	}
+	if m.SpendTx == nil {
+		return fmt.Errorf("empty spend tx")
+	}
+	if err := m.SpendTx.ValidateBasic(); err != nil {
+		return err
+	}
+	if _, err := bbn.NewBTCTxFromBytes(m.SpendTx.Transaction); err != nil {
+		return fmt.Errorf("invalid spend tx: %w", err)
+	}
+	return nil
+}
+
+func (m *MsgUpdateFinalityProviderStatus) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return fmt.Errorf("invalid signer address: %w", err)
+	}
+	return m.StatusReport(0).Validate()
+}
+
+// StatusReport returns the status report announced by the message at the
+// given Babylon height
+func (m *MsgUpdateFinalityProviderStatus) StatusReport(reportedHeight uint64) *FinalityProviderStatusReport {
+	return &FinalityProviderStatusReport{
+		FpBtcPk:        m.FpBtcPk,
+		Status:         m.Status,
+		StartHeight:    m.StartHeight,
+		EndHeight:      m.EndHeight,
+		Reason:         m.Reason,
+		ReportedHeight: reportedHeight,
+	}
+}
+
+func (m *MsgSetPause) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return fmt.Errorf("invalid signer address: %w", err)
+	}
+	if len(m.Reason) > MaxPauseReasonLength {
+		return fmt.Errorf("reason is longer than %d bytes", MaxPauseReasonLength)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+)
+
+const (
+	// MaxStakingTxSize is the maximum size in bytes of a serialized BTC tx
+	// that is included in a BTC block and submitted along with its inclusion
+	// proof, i.e., the staking tx or a tx spending the staking output. The
+	// serialized size of a BTC tx never exceeds its weight, so this admits any
+	// standard BTC tx, whose weight is at most 400,000 weight units
+	MaxStakingTxSize = 400_000
+	// MaxTxInclusionProofSize is the maximum size of the Merkle proof of a BTC
+	// tx, which is enough for any BTC block
+	MaxTxInclusionProofSize = 32 * 32
+	// MaxPreSignedTxSize is the maximum size of a serialized unbonding tx or
+	// slashing tx. Pre-signed txs have a single input and at most two outputs,
+	// and are submitted without witness
+	MaxPreSignedTxSize = 10_000
+	// MaxRestakedFinalityProviders is the maximum number of finality providers
+	// that a BTC delegation can restake to, which also bounds the number of
+	// covenant adaptor signatures and MuSig2 nonces per message
+	MaxRestakedFinalityProviders = 100
+)
+
+// msgWithSizeLimits is a message whose fields are bounded by the size limits
+// of the module
+type msgWithSizeLimits interface {
+	validateSizeLimits() error
+}
+
+// ValidateMsgSizeLimits checks that the given message does not exceed the size
+// limits of the module. Messages of other modules always pass. The checks only
+// look at the lengths of the fields so that oversized messages are rejected
+// before any parsing
+func ValidateMsgSizeLimits(msg sdk.Msg) error {
+	if m, ok := msg.(msgWithSizeLimits); ok {
+		return m.validateSizeLimits()
+	}
+	return nil
+}
+
+func validateTxInfoSizeLimits(name string, txInfo *btcctypes.TransactionInfo) error {
+	if txInfo == nil {
+		return nil
+	}
+	if len(txInfo.Transaction) > MaxStakingTxSize {
+		return ErrMsgTooLarge.Wrapf("%s is larger than %d bytes", name, MaxStakingTxSize)
+	}
+	if len(txInfo.Proof) > MaxTxInclusionProofSize {
+		return ErrMsgTooLarge.Wrapf("inclusion proof of %s is larger than %d bytes", name, MaxTxInclusionProofSize)
+	}
+	return nil
+}
+
+func (m *MsgCreateBTCDelegation) validateSizeLimits() error {
+	if err := validateTxInfoSizeLimits("staking tx", m.StakingTx); err != nil {
+		return err
+	}
+	if m.SlashingTx != nil && len(*m.SlashingTx) > MaxPreSignedTxSize {
+		return ErrMsgTooLarge.Wrapf("slashing tx is larger than %d bytes", MaxPreSignedTxSize)
+	}
+	if len(m.UnbondingTx) > MaxPreSignedTxSize {
+		return ErrMsgTooLarge.Wrapf("unbonding tx is larger than %d bytes", MaxPreSignedTxSize)
+	}
+	if m.UnbondingSlashingTx != nil && len(*m.UnbondingSlashingTx) > MaxPreSignedTxSize {
+		return ErrMsgTooLarge.Wrapf("unbonding slashing tx is larger than %d bytes", MaxPreSignedTxSize)
+	}
+	if len(m.FpBtcPkList) > MaxRestakedFinalityProviders {
+		return ErrMsgTooLarge.Wrapf("more than %d finality providers", MaxRestakedFinalityProviders)
+	}
+	return nil
+}
+
+func (m *MsgAddCovenantSigs) validateSizeLimits() error {
+	if len(m.SlashingTxSigs) > MaxRestakedFinalityProviders {
+		return ErrMsgTooLarge.Wrapf("more than %d covenant signatures on slashing tx", MaxRestakedFinalityProviders)
+	}
+	if len(m.SlashingUnbondingTxSigs) > MaxRestakedFinalityProviders {
+		return ErrMsgTooLarge.Wrapf("more than %d covenant signatures on unbonding slashing tx", MaxRestakedFinalityProviders)
+	}
+	return nil
+}
+
+func (m *MsgAddCovenantMuSig2Nonces) validateSizeLimits() error {
+	if len(m.Nonces.SlashingTxNonces) > MaxRestakedFinalityProviders {
+		return ErrMsgTooLarge.Wrapf("more than %d nonces for slashing tx", MaxRestakedFinalityProviders)
+	}
+	if len(m.Nonces.SlashingUnbondingTxNonces) > MaxRestakedFinalityProviders {
+		return ErrMsgTooLarge.Wrapf("more than %d nonces for unbonding slashing tx", MaxRestakedFinalityProviders)
+	}
+	return nil
+}
+
+func (m *MsgReportStakingSpend) validateSizeLimits() error {
+	return validateTxInfoSizeLimits("spend tx", m.SpendTx)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
@@ -21,6 +22,16 @@ const (
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+// supportedBTCNets are the BTC networks that a Babylon node can be configured
+// with
+var supportedBTCNets = []*chaincfg.Params{
+	&chaincfg.MainNetParams,
+	&chaincfg.TestNet3Params,
+	&chaincfg.SimNetParams,
+	&chaincfg.RegressionNetParams,
+	&chaincfg.SigNetParams,
+}
+
 // DefaultCovenantCommittee deterministically generates a covenant committee
 // with 5 members and quorum size of 3
 func DefaultCovenantCommittee() ([]*btcec.PrivateKey, []*btcec.PublicKey, uint32) {
@@ -44,6 +55,16 @@ func defaultSlashingAddress() string {
 	return addr.EncodeAddress()
 }
 
+// defaultSlashingAddressTypes allows the slashing address to be of any
+// supported address type
+func defaultSlashingAddressTypes() []string {
+	addrTypes := make([]string, 0, len(btcstaking.SupportedAddressTypes))
+	for _, t := range btcstaking.SupportedAddressTypes {
+		addrTypes = append(addrTypes, string(t))
+	}
+	return addrTypes
+}
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -65,7 +86,9 @@ func DefaultParams() Params {
 		// finalization timeout.
 		MinUnbondingTime: 0,
 		// By default unbonding value is 0.8
-		MinUnbondingRate: sdkmath.LegacyNewDecWithPrec(8, 1), // 8 * 10^{-1} = 0.8
+		MinUnbondingRate:            sdkmath.LegacyNewDecWithPrec(8, 1), // 8 * 10^{-1} = 0.8
+		ScriptTemplateVersion:       btcstaking.DefaultScriptTemplateVersion,
+		AllowedSlashingAddressTypes: defaultSlashingAddressTypes(),
 	}
 }
 
@@ -121,11 +144,48 @@ func validateMinUnbondingTime(minUnbondingTimeBlocks uint32) error {
 	return nil
 }
 
-// Validate validates the set of params
+// validateSlashingAddressTypes checks that the allowed slashing address types
+// are supported and do not contain duplicates
+func validateSlashingAddressTypes(addrTypes []string) error {
+	seen := make(map[btcstaking.AddressType]struct{}, len(addrTypes))
+	for _, s := range addrTypes {
+		t, err := btcstaking.ParseAddressType(s)
+		if err != nil {
+			return fmt.Errorf("invalid slashing address type: %w", err)
+		}
+		if _, ok := seen[t]; ok {
+			return fmt.Errorf("duplicate slashing address type %s", t)
+		}
+		seen[t] = struct{}{}
+	}
+	return nil
+}
+
+// validateSlashingAddress checks that the slashing address is an address of
+// an allowed address type on any of the supported BTC networks. Whether it is
+// an address of the BTC network the node is configured with is checked by
+// ValidateWithBTCNet
+func (p Params) validateSlashingAddress() error {
+	if p.SlashingAddress == "" {
+		return fmt.Errorf("slashing address cannot be empty")
+	}
+	for _, btcNet := range supportedBTCNets {
+		if _, err := p.DecodeSlashingAddress(btcNet); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("slashing address %s is not an address of an allowed type on any supported BTC network", p.SlashingAddress)
+}
+
+// Validate validates the set of params, including the constraints across
+// fields
 func (p Params) Validate() error {
 	if p.CovenantQuorum == 0 {
 		return fmt.Errorf("covenant quorum size has to be positive")
 	}
+	if p.CovenantQuorum > uint32(len(p.CovenantPks)) {
+		return fmt.Errorf("covenant quorum size %d cannot be greater than the covenant committee size %d", p.CovenantQuorum, len(p.CovenantPks))
+	}
 	if p.CovenantQuorum*2 <= uint32(len(p.CovenantPks)) {
 		return fmt.Errorf("covenant quorum size has to be more than 1/2 of the covenant committee size")
 	}
@@ -156,6 +216,42 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateStakingTxTag(p.StakingTxTag); err != nil {
+		return err
+	}
+
+	if err := validateSlashingAddressTypes(p.AllowedSlashingAddressTypes); err != nil {
+		return err
+	}
+
+	if err := p.validateSlashingAddress(); err != nil {
+		return err
+	}
+
+	// the covenant committee must be able to sign under the script template,
+	// e.g., MuSig2 covenant signing requires all covenant members to sign
+	if _, _, err := p.CovenantSigners(p.ScriptTemplateVersion); err != nil {
+		return err
+	}
+
+	if p.PauseAuthority != "" {
+		if _, err := sdk.AccAddressFromBech32(p.PauseAuthority); err != nil {
+			return fmt.Errorf("invalid pause authority: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateWithBTCNet validates the set of params, and checks that the slashing
+// address is an address of the given BTC network
+func (p Params) ValidateWithBTCNet(btcNet *chaincfg.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	if _, err := p.DecodeSlashingAddress(btcNet); err != nil {
+		return fmt.Errorf("invalid slashing address: %w", err)
+	}
 	return nil
 }
 
@@ -174,8 +270,25 @@ func (p Params) HasCovenantPK(pk *bbn.BIP340PubKey) bool {
 	return false
 }
 
+// SlashingAddressTypes returns the address types that the slashing address
+// may be of. All supported address types are allowed if the returned slice
+// is empty
+func (p Params) SlashingAddressTypes() []btcstaking.AddressType {
+	addrTypes := make([]btcstaking.AddressType, 0, len(p.AllowedSlashingAddressTypes))
+	for _, t := range p.AllowedSlashingAddressTypes {
+		addrTypes = append(addrTypes, btcstaking.AddressType(t))
+	}
+	return addrTypes
+}
+
+// DecodeSlashingAddress decodes the slashing address and checks that it is an
+// address of the given BTC network and of an allowed address type
+func (p Params) DecodeSlashingAddress(btcParams *chaincfg.Params) (btcutil.Address, error) {
+	return btcstaking.DecodeAddress(p.SlashingAddress, btcParams, p.SlashingAddressTypes())
+}
+
 func (p Params) MustGetSlashingAddress(btcParams *chaincfg.Params) btcutil.Address {
-	slashingAddr, err := btcutil.DecodeAddress(p.SlashingAddress, btcParams)
+	slashingAddr, err := p.DecodeSlashingAddress(btcParams)
 	if err != nil {
 		panic(fmt.Errorf("failed to decode slashing address in genesis: %w", err))
 	}
@@ -189,3 +302,11 @@ func (p Params) CovenantPksHex() []string {
 	}
 	return covPksHex
 }
+
+// Validate validates the scheduled params
+func (sp *ScheduledParams) Validate() error {
+	if (sp.ActivationHeight == 0) == (sp.ActivationEpoch == 0) {
+		return ErrInvalidParamsActivation.Wrap("exactly one of activation height and activation epoch has to be specified")
+	}
+	return sp.Params.Validate()
+}
@@ -56,6 +56,51 @@ type Params struct {
 	// must be at least 90% of staking output, for staking request to be considered
 	// valid
 	MinUnbondingRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=min_unbonding_rate,json=minUnbondingRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_unbonding_rate"`
+	// script_template_version is the version of the script template that the
+	// staking and unbonding outputs of new BTC delegations are built with.
+	// 0 refers to the script template of BTC delegations created before script
+	// templates were versioned
+	ScriptTemplateVersion uint32 `protobuf:"varint,10,opt,name=script_template_version,json=scriptTemplateVersion,proto3" json:"script_template_version,omitempty"`
+	// staking_tx_tag is the 4-byte tag that staking txs must carry in an
+	// OP_RETURN output along with the version, the staker PK, the finality
+	// provider PK and the staking time. This allows identifying staking txs
+	// on Bitcoin without knowing the covenant committee. Staking txs are not
+	// required to carry the OP_RETURN output if it is empty.
+	StakingTxTag []byte `protobuf:"bytes,11,opt,name=staking_tx_tag,json=stakingTxTag,proto3" json:"staking_tx_tag,omitempty"`
+	// allowed_slashing_address_types are the types of the Bitcoin addresses
+	// that the slashing address may be of, i.e., any of "p2pkh", "p2sh",
+	// "p2wpkh", "p2wsh" and "p2tr". All these types are allowed if empty
+	AllowedSlashingAddressTypes []string `protobuf:"bytes,12,rep,name=allowed_slashing_address_types,json=allowedSlashingAddressTypes,proto3" json:"allowed_slashing_address_types,omitempty"`
+	// staking_tx_activation_depth is the number of BTC blocks on top of the
+	// block including the staking tx, after which a BTC delegation with
+	// covenant quorum becomes active. The BTC confirmation depth k of the BTC
+	// checkpoint module is used if it is 0
+	StakingTxActivationDepth uint32 `protobuf:"varint,14,opt,name=staking_tx_activation_depth,json=stakingTxActivationDepth,proto3" json:"staking_tx_activation_depth,omitempty"`
+	// max_pending_btc_delegations_per_staker is the maximum number of pending
+	// BTC delegations, i.e., BTC delegations without covenant quorum, that a
+	// staker can have at the same time. There is no limit if it is 0
+	MaxPendingBtcDelegationsPerStaker uint32 `protobuf:"varint,15,opt,name=max_pending_btc_delegations_per_staker,json=maxPendingBtcDelegationsPerStaker,proto3" json:"max_pending_btc_delegations_per_staker,omitempty"`
+	// pending_btc_delegation_expiry_blocks is the number of BTC blocks on top
+	// of the block including the staking tx, after which a BTC delegation that
+	// has not received covenant quorum is removed. Pending BTC delegations do
+	// not expire if it is 0
+	PendingBtcDelegationExpiryBlocks uint32 `protobuf:"varint,16,opt,name=pending_btc_delegation_expiry_blocks,json=pendingBtcDelegationExpiryBlocks,proto3" json:"pending_btc_delegation_expiry_blocks,omitempty"`
+	// min_tx_fee_rate_sat_per_vbyte is the minimum fee rate, in satoshis per
+	// virtual byte, of the pre-signed slashing tx, unbonding tx and unbonding
+	// slashing tx of new BTC delegations. The fee of each of these txs must be
+	// at least its estimated virtual size once signed times this fee rate.
+	// Governance is expected to follow the BTC fee market with this parameter.
+	// Fee rates are not checked if it is 0
+	MinTxFeeRateSatPerVbyte uint64 `protobuf:"varint,17,opt,name=min_tx_fee_rate_sat_per_vbyte,json=minTxFeeRateSatPerVbyte,proto3" json:"min_tx_fee_rate_sat_per_vbyte,omitempty"`
+	// pause_authority is the address that is allowed to pause and resume the
+	// processing of new BTC delegations and early unbondings, in addition to
+	// the governance account. Only the governance account can do so if it is
+	// empty
+	PauseAuthority string `protobuf:"bytes,18,opt,name=pause_authority,json=pauseAuthority,proto3" json:"pause_authority,omitempty"`
+	// min_self_delegation_sat is the minimum amount of Satoshis that a finality
+	// provider has to self-delegate, i.e., stake under its own BTC PK, in order
+	// to be in the active finality provider set. There is no minimum if it is 0
+	MinSelfDelegationSat uint64 `protobuf:"varint,19,opt,name=min_self_delegation_sat,json=minSelfDelegationSat,proto3" json:"min_self_delegation_sat,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -125,6 +170,69 @@ func (m *Params) GetMinUnbondingTime() uint32 {
 	return 0
 }
 
+func (m *Params) GetScriptTemplateVersion() uint32 {
+	if m != nil {
+		return m.ScriptTemplateVersion
+	}
+	return 0
+}
+
+func (m *Params) GetStakingTxTag() []byte {
+	if m != nil {
+		return m.StakingTxTag
+	}
+	return nil
+}
+
+func (m *Params) GetAllowedSlashingAddressTypes() []string {
+	if m != nil {
+		return m.AllowedSlashingAddressTypes
+	}
+	return nil
+}
+
+func (m *Params) GetStakingTxActivationDepth() uint32 {
+	if m != nil {
+		return m.StakingTxActivationDepth
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPendingBtcDelegationsPerStaker() uint32 {
+	if m != nil {
+		return m.MaxPendingBtcDelegationsPerStaker
+	}
+	return 0
+}
+
+func (m *Params) GetPendingBtcDelegationExpiryBlocks() uint32 {
+	if m != nil {
+		return m.PendingBtcDelegationExpiryBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMinTxFeeRateSatPerVbyte() uint64 {
+	if m != nil {
+		return m.MinTxFeeRateSatPerVbyte
+	}
+	return 0
+}
+
+func (m *Params) GetPauseAuthority() string {
+	if m != nil {
+		return m.PauseAuthority
+	}
+	return ""
+}
+
+func (m *Params) GetMinSelfDelegationSat() uint64 {
+	if m != nil {
+		return m.MinSelfDelegationSat
+	}
+	return 0
+}
+
 // StoredParams attach information about the version of stored parameters
 type StoredParams struct {
 	// version of the stored parameters. Each parameters update
@@ -181,9 +289,237 @@ func (m *StoredParams) GetParams() Params {
 	return Params{}
 }
 
+// ScheduledParams are parameters that take effect at a future Babylon height
+// or epoch, giving covenant members and staking providers lead time to adapt.
+// Exactly one of activation_height and activation_epoch is non-zero.
+type ScheduledParams struct {
+	// params are the parameters to take effect
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// activation_height is the Babylon height at which the params take effect
+	ActivationHeight uint64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// activation_epoch is the epoch at whose first block the params take effect
+	ActivationEpoch uint64 `protobuf:"varint,3,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"`
+	// scheduled_height is the Babylon height when the params are scheduled
+	ScheduledHeight uint64 `protobuf:"varint,4,opt,name=scheduled_height,json=scheduledHeight,proto3" json:"scheduled_height,omitempty"`
+}
+
+func (m *ScheduledParams) Reset()         { *m = ScheduledParams{} }
+func (m *ScheduledParams) String() string { return proto.CompactTextString(m) }
+func (*ScheduledParams) ProtoMessage()    {}
+func (*ScheduledParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d1392776a3e15b9, []int{2}
+}
+func (m *ScheduledParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledParams.Merge(m, src)
+}
+func (m *ScheduledParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledParams proto.InternalMessageInfo
+
+func (m *ScheduledParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *ScheduledParams) GetActivationHeight() uint64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *ScheduledParams) GetActivationEpoch() uint64 {
+	if m != nil {
+		return m.ActivationEpoch
+	}
+	return 0
+}
+
+func (m *ScheduledParams) GetScheduledHeight() uint64 {
+	if m != nil {
+		return m.ScheduledHeight
+	}
+	return 0
+}
+
+// SlashingRateChangeReport reports the BTC delegations that are active when
+// governance changes the slashing rate. As the slashing txs of a BTC delegation
+// are pre-signed upon its creation, an active BTC delegation is always slashed
+// at the slashing rate of the params version it is created under, i.e., it is
+// grandfathered by the change.
+type SlashingRateChangeReport struct {
+	// params_version is the version of the params changing the slashing rate
+	ParamsVersion uint32 `protobuf:"varint,1,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// old_slashing_rate is the slashing rate before the change
+	OldSlashingRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=old_slashing_rate,json=oldSlashingRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"old_slashing_rate"`
+	// new_slashing_rate is the slashing rate after the change, which applies to
+	// BTC delegations created from now on
+	NewSlashingRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=new_slashing_rate,json=newSlashingRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"new_slashing_rate"`
+	// babylon_height is the Babylon height when the change happens
+	BabylonHeight uint64 `protobuf:"varint,4,opt,name=babylon_height,json=babylonHeight,proto3" json:"babylon_height,omitempty"`
+	// btc_height is the BTC tip height when the change happens
+	BtcHeight uint64 `protobuf:"varint,5,opt,name=btc_height,json=btcHeight,proto3" json:"btc_height,omitempty"`
+	// cohorts are the active BTC delegations grouped by the params version
+	// they are created under, in ascending order of the params version
+	Cohorts []*SlashingRateCohort `protobuf:"bytes,6,rep,name=cohorts,proto3" json:"cohorts,omitempty"`
+}
+
+func (m *SlashingRateChangeReport) Reset()         { *m = SlashingRateChangeReport{} }
+func (m *SlashingRateChangeReport) String() string { return proto.CompactTextString(m) }
+func (*SlashingRateChangeReport) ProtoMessage()    {}
+func (*SlashingRateChangeReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d1392776a3e15b9, []int{3}
+}
+func (m *SlashingRateChangeReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashingRateChangeReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashingRateChangeReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashingRateChangeReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashingRateChangeReport.Merge(m, src)
+}
+func (m *SlashingRateChangeReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashingRateChangeReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashingRateChangeReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashingRateChangeReport proto.InternalMessageInfo
+
+func (m *SlashingRateChangeReport) GetParamsVersion() uint32 {
+	if m != nil {
+		return m.ParamsVersion
+	}
+	return 0
+}
+
+func (m *SlashingRateChangeReport) GetBabylonHeight() uint64 {
+	if m != nil {
+		return m.BabylonHeight
+	}
+	return 0
+}
+
+func (m *SlashingRateChangeReport) GetBtcHeight() uint64 {
+	if m != nil {
+		return m.BtcHeight
+	}
+	return 0
+}
+
+func (m *SlashingRateChangeReport) GetCohorts() []*SlashingRateCohort {
+	if m != nil {
+		return m.Cohorts
+	}
+	return nil
+}
+
+// SlashingRateCohort is the set of active BTC delegations created under the
+// same params version
+type SlashingRateCohort struct {
+	// params_version is the params version the BTC delegations are created under
+	ParamsVersion uint32 `protobuf:"varint,1,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// slashing_rate is the slashing rate applying to the BTC delegations
+	SlashingRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=slashing_rate,json=slashingRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slashing_rate"`
+	// num_active_delegations is the number of active BTC delegations
+	NumActiveDelegations uint64 `protobuf:"varint,3,opt,name=num_active_delegations,json=numActiveDelegations,proto3" json:"num_active_delegations,omitempty"`
+	// total_sat is the total amount of satoshis of the active BTC delegations
+	TotalSat uint64 `protobuf:"varint,4,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
+}
+
+func (m *SlashingRateCohort) Reset()         { *m = SlashingRateCohort{} }
+func (m *SlashingRateCohort) String() string { return proto.CompactTextString(m) }
+func (*SlashingRateCohort) ProtoMessage()    {}
+func (*SlashingRateCohort) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d1392776a3e15b9, []int{4}
+}
+func (m *SlashingRateCohort) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashingRateCohort) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashingRateCohort.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashingRateCohort) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashingRateCohort.Merge(m, src)
+}
+func (m *SlashingRateCohort) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashingRateCohort) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashingRateCohort.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashingRateCohort proto.InternalMessageInfo
+
+func (m *SlashingRateCohort) GetParamsVersion() uint32 {
+	if m != nil {
+		return m.ParamsVersion
+	}
+	return 0
+}
+
+func (m *SlashingRateCohort) GetNumActiveDelegations() uint64 {
+	if m != nil {
+		return m.NumActiveDelegations
+	}
+	return 0
+}
+
+func (m *SlashingRateCohort) GetTotalSat() uint64 {
+	if m != nil {
+		return m.TotalSat
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.btcstaking.v1.Params")
 	proto.RegisterType((*StoredParams)(nil), "babylon.btcstaking.v1.StoredParams")
+	proto.RegisterType((*ScheduledParams)(nil), "babylon.btcstaking.v1.ScheduledParams")
+	proto.RegisterType((*SlashingRateChangeReport)(nil), "babylon.btcstaking.v1.SlashingRateChangeReport")
+	proto.RegisterType((*SlashingRateCohort)(nil), "babylon.btcstaking.v1.SlashingRateCohort")
 }
 
 func init() {
@@ -191,42 +527,74 @@ func init() {
 }
 
 var fileDescriptor_8d1392776a3e15b9 = []byte{
-	// 547 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0xbf, 0xe4, 0x4b, 0xe9, 0x34, 0xa5, 0x65, 0x00, 0x61, 0x82, 0xea, 0x44, 0x61, 0x41,
-	0x90, 0xc0, 0x26, 0x6d, 0xc5, 0x02, 0x56, 0x09, 0xa8, 0x12, 0xa2, 0x8b, 0xe0, 0x14, 0x24, 0xd8,
-	0x8c, 0xc6, 0xf6, 0xd4, 0x19, 0x25, 0x33, 0x13, 0x3c, 0x13, 0xcb, 0x7e, 0x0b, 0x96, 0x2c, 0x79,
-	0x08, 0x1e, 0xa2, 0xcb, 0x8a, 0x15, 0xea, 0x22, 0x42, 0xc9, 0x1b, 0xf0, 0x04, 0xc8, 0x63, 0x3b,
-	0xfc, 0x08, 0x09, 0xc4, 0xce, 0xf7, 0xdc, 0x73, 0xcf, 0xfd, 0xf1, 0x19, 0xd0, 0xf1, 0xb0, 0x97,
-	0x4e, 0x05, 0x77, 0x3c, 0xe5, 0x4b, 0x85, 0x27, 0x94, 0x87, 0x4e, 0xdc, 0x73, 0x66, 0x38, 0xc2,
-	0x4c, 0xda, 0xb3, 0x48, 0x28, 0x01, 0xaf, 0x17, 0x1c, 0xfb, 0x3b, 0xc7, 0x8e, 0x7b, 0xcd, 0x6b,
-	0xa1, 0x08, 0x85, 0x66, 0x38, 0xd9, 0x57, 0x4e, 0x6e, 0xde, 0xf4, 0x85, 0x64, 0x42, 0xa2, 0x3c,
-	0x91, 0x07, 0x79, 0xaa, 0xf3, 0xb5, 0x06, 0xea, 0x43, 0x2d, 0x0c, 0x5f, 0x83, 0x86, 0x2f, 0x62,
-	0xc2, 0x31, 0x57, 0x68, 0x36, 0x91, 0xa6, 0xd1, 0xae, 0x76, 0x1b, 0x83, 0x87, 0x17, 0x8b, 0xd6,
-	0x7e, 0x48, 0xd5, 0x78, 0xee, 0xd9, 0xbe, 0x60, 0x4e, 0xd1, 0xd7, 0x1f, 0x63, 0xca, 0xcb, 0xc0,
-	0x51, 0xe9, 0x8c, 0x48, 0x7b, 0xf0, 0x6c, 0x78, 0x70, 0xf8, 0x60, 0x38, 0xf7, 0x9e, 0x93, 0xd4,
-	0xdd, 0x2a, 0xb5, 0x86, 0x13, 0x09, 0xef, 0x80, 0x9d, 0xb5, 0xf4, 0xdb, 0xb9, 0x88, 0xe6, 0xcc,
-	0xfc, 0xaf, 0x6d, 0x74, 0xb7, 0xdd, 0xcb, 0x25, 0xfc, 0x42, 0xa3, 0xf0, 0x2e, 0xd8, 0x95, 0x53,
-	0x2c, 0xc7, 0x94, 0x87, 0x08, 0x07, 0x41, 0x44, 0xa4, 0x34, 0xab, 0x6d, 0xa3, 0xbb, 0xe9, 0xee,
-	0x94, 0x78, 0x3f, 0x87, 0xe1, 0x21, 0xb8, 0xc1, 0x28, 0x47, 0x6b, 0xba, 0x4a, 0xd0, 0x29, 0x21,
-	0x48, 0x62, 0x65, 0xd6, 0xda, 0x46, 0xb7, 0xea, 0x5e, 0x65, 0x94, 0x8f, 0x8a, 0xec, 0x49, 0x72,
-	0x44, 0xc8, 0x08, 0x2b, 0x38, 0x02, 0x19, 0x8c, 0x7c, 0xc1, 0x18, 0x95, 0x92, 0x0a, 0x8e, 0x22,
-	0xac, 0x88, 0xf9, 0x7f, 0xd6, 0x63, 0x70, 0xfb, 0x6c, 0xd1, 0xaa, 0x5c, 0x2c, 0x5a, 0xb7, 0xf2,
-	0x13, 0xc9, 0x60, 0x62, 0x53, 0xe1, 0x30, 0xac, 0xc6, 0xf6, 0x31, 0x09, 0xb1, 0x9f, 0x3e, 0x25,
-	0xbe, 0x7b, 0x85, 0x51, 0xfe, 0x64, 0x5d, 0xee, 0x62, 0x45, 0xe0, 0x2b, 0xb0, 0xbd, 0x1e, 0x43,
-	0xcb, 0xd5, 0xb5, 0x5c, 0xef, 0x2f, 0xe4, 0x3e, 0x7d, 0xbc, 0x0f, 0x8a, 0x1f, 0x92, 0x89, 0x37,
-	0x4a, 0x1d, 0xad, 0xdb, 0x07, 0x7b, 0x0c, 0x27, 0x08, 0xfb, 0x8a, 0xc6, 0x04, 0x9d, 0x52, 0x8e,
-	0xa7, 0x54, 0xa5, 0xd9, 0x6f, 0x8c, 0x69, 0x40, 0x22, 0x69, 0x6e, 0xe8, 0x23, 0x36, 0x19, 0x4e,
-	0xfa, 0x9a, 0x73, 0x54, 0x50, 0x86, 0x25, 0x03, 0xde, 0x03, 0x30, 0xdb, 0x77, 0xce, 0x3d, 0xc1,
-	0x03, 0x7d, 0x26, 0xca, 0x88, 0x79, 0x49, 0xd7, 0xed, 0x32, 0xca, 0x5f, 0x96, 0x89, 0x13, 0xca,
-	0x08, 0x44, 0xbf, 0xb2, 0xf5, 0x36, 0x9b, 0xff, 0xba, 0xcd, 0x4f, 0x0d, 0xb2, 0x8d, 0x1e, 0xd5,
-	0xde, 0x7f, 0x68, 0x55, 0x3a, 0x04, 0x34, 0x46, 0x4a, 0x44, 0x24, 0x28, 0x9c, 0x67, 0x82, 0x8d,
-	0x98, 0x44, 0xd9, 0x39, 0x4d, 0x43, 0x4f, 0x56, 0x86, 0xf0, 0x31, 0xa8, 0xe7, 0xb6, 0xd7, 0x7e,
-	0xd9, 0xda, 0xdf, 0xb3, 0x7f, 0xeb, 0x7b, 0x3b, 0x17, 0x1a, 0xd4, 0xb2, 0x19, 0xdd, 0xa2, 0x64,
-	0x70, 0x7c, 0xb6, 0xb4, 0x8c, 0xf3, 0xa5, 0x65, 0x7c, 0x59, 0x5a, 0xc6, 0xbb, 0x95, 0x55, 0x39,
-	0x5f, 0x59, 0x95, 0xcf, 0x2b, 0xab, 0xf2, 0xe6, 0x8f, 0x86, 0x4e, 0x7e, 0x7c, 0x7b, 0xda, 0xdd,
-	0x5e, 0x5d, 0x3f, 0x98, 0x83, 0x6f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x91, 0xdb, 0xb2, 0x9e,
-	0x03, 0x00, 0x00,
+	// 1072 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xce, 0xd6, 0x6e, 0x1a, 0x4f, 0x1c, 0x3b, 0x99, 0xa6, 0x74, 0x49, 0x14, 0xc7, 0x98, 0x16,
+	0x5c, 0x41, 0x6d, 0x92, 0x86, 0x5e, 0x80, 0x40, 0xb2, 0x93, 0x56, 0xfc, 0x54, 0xc8, 0xdd, 0x35,
+	0x91, 0x40, 0x42, 0xa3, 0xd9, 0xdd, 0x93, 0xdd, 0x55, 0x76, 0x77, 0x96, 0x9d, 0xb1, 0x63, 0xbf,
+	0x02, 0x57, 0x5c, 0x72, 0xc9, 0x43, 0x54, 0xe2, 0x15, 0x7a, 0x59, 0x7a, 0x85, 0x7a, 0x11, 0xa1,
+	0x44, 0xe2, 0x39, 0xd0, 0xcc, 0xee, 0xfa, 0x27, 0x0d, 0xa2, 0x44, 0xdc, 0xed, 0x9e, 0xf3, 0x9d,
+	0xef, 0x9c, 0x39, 0x7f, 0x33, 0xa8, 0x61, 0x51, 0x6b, 0x1c, 0xb0, 0xa8, 0x6d, 0x09, 0x9b, 0x0b,
+	0x7a, 0xec, 0x47, 0x6e, 0x7b, 0xb8, 0xd3, 0x8e, 0x69, 0x42, 0x43, 0xde, 0x8a, 0x13, 0x26, 0x18,
+	0xbe, 0x95, 0x61, 0x5a, 0x53, 0x4c, 0x6b, 0xb8, 0xb3, 0xb1, 0xee, 0x32, 0x97, 0x29, 0x44, 0x5b,
+	0x7e, 0xa5, 0xe0, 0x8d, 0xb7, 0x6d, 0xc6, 0x43, 0xc6, 0x49, 0xaa, 0x48, 0x7f, 0x52, 0x55, 0xe3,
+	0xb7, 0x12, 0x5a, 0xec, 0x29, 0x62, 0xfc, 0x1d, 0x2a, 0xdb, 0x6c, 0x08, 0x11, 0x8d, 0x04, 0x89,
+	0x8f, 0xb9, 0xae, 0xd5, 0x0b, 0xcd, 0x72, 0xf7, 0xe1, 0xab, 0xd3, 0xed, 0x5d, 0xd7, 0x17, 0xde,
+	0xc0, 0x6a, 0xd9, 0x2c, 0x6c, 0x67, 0x7e, 0x6d, 0x8f, 0xfa, 0x51, 0xfe, 0xd3, 0x16, 0xe3, 0x18,
+	0x78, 0xab, 0xfb, 0x65, 0xef, 0xc1, 0xde, 0x47, 0xbd, 0x81, 0xf5, 0x35, 0x8c, 0x8d, 0xe5, 0x9c,
+	0xab, 0x77, 0xcc, 0xf1, 0xfb, 0xa8, 0x3a, 0xa1, 0xfe, 0x71, 0xc0, 0x92, 0x41, 0xa8, 0x5f, 0xab,
+	0x6b, 0xcd, 0x15, 0xa3, 0x92, 0x8b, 0x9f, 0x2a, 0x29, 0xbe, 0x87, 0x56, 0x79, 0x40, 0xb9, 0xe7,
+	0x47, 0x2e, 0xa1, 0x8e, 0x93, 0x00, 0xe7, 0x7a, 0xa1, 0xae, 0x35, 0x4b, 0x46, 0x35, 0x97, 0x77,
+	0x52, 0x31, 0xde, 0x43, 0xb7, 0x43, 0x3f, 0x22, 0x13, 0xb8, 0x18, 0x91, 0x23, 0x00, 0xc2, 0xa9,
+	0xd0, 0x8b, 0x75, 0xad, 0x59, 0x30, 0x6e, 0x86, 0x7e, 0x64, 0x66, 0xda, 0xfe, 0xe8, 0x31, 0x80,
+	0x49, 0x05, 0x36, 0x91, 0x14, 0x13, 0x9b, 0x85, 0xa1, 0xcf, 0xb9, 0xcf, 0x22, 0x92, 0x50, 0x01,
+	0xfa, 0x75, 0xe9, 0xa3, 0xfb, 0xee, 0xf3, 0xd3, 0xed, 0x85, 0x57, 0xa7, 0xdb, 0x9b, 0x69, 0x8a,
+	0xb8, 0x73, 0xdc, 0xf2, 0x59, 0x3b, 0xa4, 0xc2, 0x6b, 0x3d, 0x01, 0x97, 0xda, 0xe3, 0x03, 0xb0,
+	0x8d, 0xb5, 0xd0, 0x8f, 0xf6, 0x27, 0xe6, 0x06, 0x15, 0x80, 0x0f, 0xd1, 0xca, 0x24, 0x0c, 0x45,
+	0xb7, 0xa8, 0xe8, 0x76, 0xde, 0x80, 0xee, 0xe5, 0xb3, 0xfb, 0x28, 0x2b, 0x88, 0x24, 0x2f, 0xe7,
+	0x3c, 0x8a, 0xb7, 0x83, 0xb6, 0x42, 0x3a, 0x22, 0xd4, 0x16, 0xfe, 0x10, 0xc8, 0x91, 0x1f, 0xd1,
+	0xc0, 0x17, 0x63, 0x59, 0xc6, 0xa1, 0xef, 0x40, 0xc2, 0xf5, 0x1b, 0x2a, 0x89, 0x1b, 0x21, 0x1d,
+	0x75, 0x14, 0xe6, 0x71, 0x06, 0xe9, 0xe5, 0x08, 0xfc, 0x21, 0xc2, 0xf2, 0xbc, 0x83, 0xc8, 0x62,
+	0x91, 0xa3, 0xd2, 0xe4, 0x87, 0xa0, 0x2f, 0x29, 0xbb, 0xd5, 0xd0, 0x8f, 0xbe, 0xcd, 0x15, 0x7d,
+	0x3f, 0x04, 0x4c, 0x2e, 0xa2, 0xd5, 0x69, 0x4a, 0x57, 0x3d, 0xcd, 0x9c, 0x03, 0x75, 0xa2, 0x87,
+	0xe8, 0x36, 0xb7, 0x13, 0x3f, 0x16, 0x44, 0x40, 0x18, 0x07, 0x54, 0x00, 0x19, 0x42, 0x22, 0x13,
+	0xa9, 0x23, 0x15, 0xd3, 0xad, 0x54, 0xdd, 0xcf, 0xb4, 0x87, 0xa9, 0x12, 0xdf, 0x41, 0x95, 0xac,
+	0xcb, 0x65, 0x9d, 0x05, 0x75, 0xf5, 0xe5, 0xba, 0xd6, 0x2c, 0x1b, 0xe5, 0x4c, 0xda, 0x1f, 0xf5,
+	0xa9, 0x8b, 0xf7, 0x51, 0x8d, 0x06, 0x01, 0x3b, 0x01, 0x87, 0x5c, 0xec, 0x22, 0xa2, 0x5a, 0x54,
+	0x2f, 0xd7, 0x0b, 0xcd, 0x92, 0xb1, 0x99, 0xa1, 0xcc, 0xf9, 0x96, 0xea, 0x4b, 0x08, 0xfe, 0x0c,
+	0x6d, 0xce, 0xb8, 0x52, 0xb9, 0xa7, 0x42, 0x36, 0x8a, 0x03, 0xb1, 0xf0, 0xf4, 0x8a, 0x0a, 0x53,
+	0x9f, 0xf8, 0xed, 0x4c, 0x00, 0x07, 0x52, 0x8f, 0x9f, 0xa2, 0xf7, 0x64, 0xcd, 0x62, 0x48, 0x13,
+	0x68, 0x09, 0x9b, 0x38, 0x10, 0x80, 0xab, 0x20, 0x9c, 0xc4, 0x90, 0x10, 0x69, 0x0b, 0x89, 0x5e,
+	0x55, 0x4c, 0xef, 0x84, 0x74, 0xd4, 0x4b, 0xc1, 0x5d, 0x61, 0x1f, 0x4c, 0xa1, 0x3d, 0x48, 0x4c,
+	0x05, 0xc4, 0xdf, 0xa0, 0x3b, 0x97, 0xd3, 0x11, 0x18, 0xc5, 0x7e, 0x32, 0x26, 0x56, 0xc0, 0xec,
+	0x63, 0xae, 0xaf, 0x2a, 0xc2, 0x7a, 0x7c, 0x09, 0xdb, 0x23, 0x05, 0xec, 0x2a, 0x1c, 0xfe, 0x1c,
+	0x6d, 0xc9, 0x2a, 0x67, 0x03, 0x23, 0x4b, 0x2c, 0xa7, 0x46, 0x85, 0x36, 0xb4, 0xc6, 0x02, 0xf4,
+	0xb5, 0xba, 0xd6, 0x2c, 0x1a, 0x72, 0xbc, 0xd4, 0xdc, 0xc8, 0xca, 0x99, 0x54, 0xf4, 0x20, 0x39,
+	0x94, 0x6a, 0xdc, 0x41, 0xd5, 0x98, 0x0e, 0x38, 0x10, 0x3a, 0x10, 0x1e, 0x4b, 0x7c, 0x31, 0xd6,
+	0xb1, 0x6a, 0x11, 0xfd, 0xe5, 0xb3, 0xfb, 0xeb, 0x59, 0xfd, 0xb3, 0x9c, 0x9a, 0x22, 0x91, 0xb5,
+	0xaf, 0x28, 0x83, 0x4e, 0x8e, 0xc7, 0x1f, 0x67, 0xc3, 0x0b, 0xc1, 0xd1, 0xec, 0x79, 0xe4, 0xf0,
+	0xde, 0x54, 0xce, 0xd7, 0xe5, 0xf0, 0x42, 0x70, 0x34, 0x3d, 0x82, 0x49, 0xc5, 0x27, 0xc5, 0x5f,
+	0x7e, 0xdd, 0x5e, 0xf8, 0xaa, 0xb8, 0xb4, 0xb2, 0x5a, 0x69, 0x00, 0x2a, 0x9b, 0x82, 0x25, 0xe0,
+	0x64, 0xeb, 0x4b, 0x47, 0x37, 0xf2, 0x56, 0xd2, 0x54, 0x22, 0xf2, 0x5f, 0xfc, 0x29, 0x5a, 0x4c,
+	0x77, 0xa7, 0x5a, 0x3a, 0xcb, 0xbb, 0x5b, 0xad, 0x4b, 0x97, 0x67, 0x2b, 0x25, 0xea, 0x16, 0x65,
+	0xa3, 0x1b, 0x99, 0x49, 0xe3, 0x77, 0x0d, 0x55, 0x4d, 0xdb, 0x03, 0x67, 0x10, 0x4c, 0x5c, 0x4d,
+	0x09, 0xb5, 0xff, 0x4c, 0x88, 0x3f, 0x40, 0x6b, 0x33, 0x4d, 0xe5, 0x81, 0xef, 0x7a, 0x42, 0x05,
+	0x56, 0x34, 0x56, 0xa7, 0x8a, 0x2f, 0x94, 0x5c, 0xee, 0xc3, 0x19, 0x30, 0xc4, 0xcc, 0xf6, 0xd4,
+	0x3e, 0x2c, 0x1a, 0xd5, 0xa9, 0xfc, 0x91, 0x14, 0x4b, 0x28, 0xcf, 0xe3, 0xcc, 0x69, 0x8b, 0x29,
+	0x74, 0x22, 0x4f, 0x59, 0x1b, 0x3f, 0x15, 0x90, 0x6e, 0xce, 0x2c, 0x9a, 0x7d, 0x8f, 0x46, 0x2e,
+	0x18, 0x10, 0xb3, 0x44, 0xe0, 0xbb, 0xa8, 0x92, 0x46, 0x4a, 0xe6, 0xd3, 0xb9, 0x92, 0x4a, 0xf3,
+	0x89, 0xfc, 0x01, 0xad, 0xb1, 0x60, 0x66, 0xce, 0xd4, 0xa6, 0xb8, 0x76, 0xd5, 0x4d, 0x51, 0x65,
+	0x81, 0x33, 0x1b, 0x91, 0xa4, 0x8f, 0xe0, 0xe4, 0x02, 0x7d, 0xe1, 0xca, 0xf4, 0x11, 0x9c, 0xcc,
+	0xd1, 0xdf, 0x45, 0x95, 0xac, 0x64, 0xf3, 0xa9, 0x5a, 0xc9, 0xa4, 0x59, 0xfa, 0xb7, 0x10, 0x92,
+	0x13, 0x97, 0x41, 0xae, 0x2b, 0x48, 0xc9, 0x12, 0x76, 0xa6, 0xde, 0x47, 0x37, 0x6c, 0xe6, 0xb1,
+	0x44, 0x70, 0x7d, 0xb1, 0x5e, 0x68, 0x2e, 0xef, 0xde, 0xfb, 0x87, 0x46, 0x98, 0x4b, 0xb6, 0xb2,
+	0x30, 0x72, 0xcb, 0xc6, 0x5f, 0x1a, 0xc2, 0xaf, 0xeb, 0xdf, 0xb4, 0x0c, 0xaf, 0x5d, 0x3d, 0xd7,
+	0xfe, 0x9f, 0xab, 0x67, 0x0f, 0xbd, 0x15, 0x0d, 0xc2, 0xfc, 0xea, 0x99, 0xd9, 0x60, 0x59, 0xfb,
+	0xad, 0x47, 0x83, 0x30, 0xbd, 0x73, 0x66, 0x56, 0x16, 0xde, 0x44, 0x25, 0xc1, 0x04, 0x0d, 0x26,
+	0xb7, 0x70, 0xd1, 0x58, 0x52, 0x02, 0x93, 0x8a, 0xee, 0x93, 0xe7, 0x67, 0x35, 0xed, 0xc5, 0x59,
+	0x4d, 0xfb, 0xf3, 0xac, 0xa6, 0xfd, 0x7c, 0x5e, 0x5b, 0x78, 0x71, 0x5e, 0x5b, 0xf8, 0xe3, 0xbc,
+	0xb6, 0xf0, 0xfd, 0xbf, 0xbe, 0x2f, 0x46, 0xb3, 0x4f, 0x21, 0xb5, 0xc9, 0xad, 0x45, 0xf5, 0x7e,
+	0x79, 0xf0, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2b, 0xdb, 0xd3, 0xb3, 0x2d, 0x09, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -249,6 +617,67 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinSelfDelegationSat != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinSelfDelegationSat))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.PauseAuthority) > 0 {
+		i -= len(m.PauseAuthority)
+		copy(dAtA[i:], m.PauseAuthority)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PauseAuthority)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.MinTxFeeRateSatPerVbyte != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinTxFeeRateSatPerVbyte))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.PendingBtcDelegationExpiryBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PendingBtcDelegationExpiryBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxPendingBtcDelegationsPerStaker != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPendingBtcDelegationsPerStaker))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.StakingTxActivationDepth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StakingTxActivationDepth))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.AllowedSlashingAddressTypes) > 0 {
+		for iNdEx := len(m.AllowedSlashingAddressTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSlashingAddressTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedSlashingAddressTypes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedSlashingAddressTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.StakingTxTag) > 0 {
+		i -= len(m.StakingTxTag)
+		copy(dAtA[i:], m.StakingTxTag)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.StakingTxTag)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ScriptTemplateVersion != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ScriptTemplateVersion))
+		i--
+		dAtA[i] = 0x50
+	}
 	{
 		size := m.MinUnbondingRate.Size()
 		i -= size
@@ -361,75 +790,952 @@ func (m *StoredParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ScheduledParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ScheduledParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduledHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ScheduledHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ActivationEpoch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ActivationEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashingRateChangeReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashingRateChangeReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashingRateChangeReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CovenantPks) > 0 {
-		for _, e := range m.CovenantPks {
-			l = e.Size()
-			n += 1 + l + sovParams(uint64(l))
+	if len(m.Cohorts) > 0 {
+		for iNdEx := len(m.Cohorts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cohorts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.BtcHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BtcHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BabylonHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BabylonHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.NewSlashingRate.Size()
+		i -= size
+		if _, err := m.NewSlashingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.OldSlashingRate.Size()
+		i -= size
+		if _, err := m.OldSlashingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ParamsVersion != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashingRateCohort) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashingRateCohort) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashingRateCohort) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalSat != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TotalSat))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NumActiveDelegations != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.NumActiveDelegations))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.SlashingRate.Size()
+		i -= size
+		if _, err := m.SlashingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ParamsVersion != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CovenantPks) > 0 {
+		for _, e := range m.CovenantPks {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.CovenantQuorum != 0 {
+		n += 1 + sovParams(uint64(m.CovenantQuorum))
+	}
+	l = len(m.SlashingAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MinSlashingTxFeeSat != 0 {
+		n += 1 + sovParams(uint64(m.MinSlashingTxFeeSat))
+	}
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SlashingRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxActiveFinalityProviders != 0 {
+		n += 1 + sovParams(uint64(m.MaxActiveFinalityProviders))
+	}
+	if m.MinUnbondingTime != 0 {
+		n += 1 + sovParams(uint64(m.MinUnbondingTime))
+	}
+	l = m.MinUnbondingRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ScriptTemplateVersion != 0 {
+		n += 1 + sovParams(uint64(m.ScriptTemplateVersion))
+	}
+	l = len(m.StakingTxTag)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.AllowedSlashingAddressTypes) > 0 {
+		for _, s := range m.AllowedSlashingAddressTypes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.StakingTxActivationDepth != 0 {
+		n += 1 + sovParams(uint64(m.StakingTxActivationDepth))
+	}
+	if m.MaxPendingBtcDelegationsPerStaker != 0 {
+		n += 1 + sovParams(uint64(m.MaxPendingBtcDelegationsPerStaker))
+	}
+	if m.PendingBtcDelegationExpiryBlocks != 0 {
+		n += 2 + sovParams(uint64(m.PendingBtcDelegationExpiryBlocks))
+	}
+	if m.MinTxFeeRateSatPerVbyte != 0 {
+		n += 2 + sovParams(uint64(m.MinTxFeeRateSatPerVbyte))
+	}
+	l = len(m.PauseAuthority)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if m.MinSelfDelegationSat != 0 {
+		n += 2 + sovParams(uint64(m.MinSelfDelegationSat))
+	}
+	return n
+}
+
+func (m *StoredParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovParams(uint64(m.Version))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *ScheduledParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ActivationHeight != 0 {
+		n += 1 + sovParams(uint64(m.ActivationHeight))
+	}
+	if m.ActivationEpoch != 0 {
+		n += 1 + sovParams(uint64(m.ActivationEpoch))
+	}
+	if m.ScheduledHeight != 0 {
+		n += 1 + sovParams(uint64(m.ScheduledHeight))
+	}
+	return n
+}
+
+func (m *SlashingRateChangeReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParamsVersion != 0 {
+		n += 1 + sovParams(uint64(m.ParamsVersion))
+	}
+	l = m.OldSlashingRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.NewSlashingRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.BabylonHeight != 0 {
+		n += 1 + sovParams(uint64(m.BabylonHeight))
+	}
+	if m.BtcHeight != 0 {
+		n += 1 + sovParams(uint64(m.BtcHeight))
+	}
+	if len(m.Cohorts) > 0 {
+		for _, e := range m.Cohorts {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SlashingRateCohort) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParamsVersion != 0 {
+		n += 1 + sovParams(uint64(m.ParamsVersion))
+	}
+	l = m.SlashingRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.NumActiveDelegations != 0 {
+		n += 1 + sovParams(uint64(m.NumActiveDelegations))
+	}
+	if m.TotalSat != 0 {
+		n += 1 + sovParams(uint64(m.TotalSat))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantPks", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.CovenantPks = append(m.CovenantPks, v)
+			if err := m.CovenantPks[len(m.CovenantPks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantQuorum", wireType)
+			}
+			m.CovenantQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CovenantQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSlashingTxFeeSat", wireType)
+			}
+			m.MinSlashingTxFeeSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSlashingTxFeeSat |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveFinalityProviders", wireType)
+			}
+			m.MaxActiveFinalityProviders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActiveFinalityProviders |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUnbondingTime", wireType)
+			}
+			m.MinUnbondingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinUnbondingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUnbondingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinUnbondingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptTemplateVersion", wireType)
+			}
+			m.ScriptTemplateVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScriptTemplateVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxTag", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxTag = append(m.StakingTxTag[:0], dAtA[iNdEx:postIndex]...)
+			if m.StakingTxTag == nil {
+				m.StakingTxTag = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSlashingAddressTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSlashingAddressTypes = append(m.AllowedSlashingAddressTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxActivationDepth", wireType)
+			}
+			m.StakingTxActivationDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingTxActivationDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPendingBtcDelegationsPerStaker", wireType)
+			}
+			m.MaxPendingBtcDelegationsPerStaker = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPendingBtcDelegationsPerStaker |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBtcDelegationExpiryBlocks", wireType)
+			}
+			m.PendingBtcDelegationExpiryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingBtcDelegationExpiryBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTxFeeRateSatPerVbyte", wireType)
+			}
+			m.MinTxFeeRateSatPerVbyte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinTxFeeRateSatPerVbyte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PauseAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegationSat", wireType)
+			}
+			m.MinSelfDelegationSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSelfDelegationSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoredParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoredParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoredParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.CovenantQuorum != 0 {
-		n += 1 + sovParams(uint64(m.CovenantQuorum))
-	}
-	l = len(m.SlashingAddress)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
-	}
-	if m.MinSlashingTxFeeSat != 0 {
-		n += 1 + sovParams(uint64(m.MinSlashingTxFeeSat))
-	}
-	l = m.MinCommissionRate.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.SlashingRate.Size()
-	n += 1 + l + sovParams(uint64(l))
-	if m.MaxActiveFinalityProviders != 0 {
-		n += 1 + sovParams(uint64(m.MaxActiveFinalityProviders))
-	}
-	if m.MinUnbondingTime != 0 {
-		n += 1 + sovParams(uint64(m.MinUnbondingTime))
-	}
-	l = m.MinUnbondingRate.Size()
-	n += 1 + l + sovParams(uint64(l))
-	return n
-}
 
-func (m *StoredParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Version != 0 {
-		n += 1 + sovParams(uint64(m.Version))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovParams(uint64(l))
-	return n
-}
-
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ScheduledParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -452,17 +1758,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CovenantPks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -472,32 +1778,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.CovenantPks = append(m.CovenantPks, v)
-			if err := m.CovenantPks[len(m.CovenantPks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CovenantQuorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
 			}
-			m.CovenantQuorum = 0
+			m.ActivationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -507,16 +1811,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CovenantQuorum |= uint32(b&0x7F) << shift
+				m.ActivationHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationEpoch", wireType)
 			}
-			var stringLen uint64
+			m.ActivationEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -526,29 +1830,85 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ActivationEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledHeight", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			m.ScheduledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthParams
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashingAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashingRateChangeReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashingRateChangeReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashingRateChangeReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSlashingTxFeeSat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
 			}
-			m.MinSlashingTxFeeSat = 0
+			m.ParamsVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -558,14 +1918,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinSlashingTxFeeSat |= int64(b&0x7F) << shift
+				m.ParamsVersion |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldSlashingRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -593,13 +1953,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OldSlashingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSlashingRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -627,15 +1987,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewSlashingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveFinalityProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonHeight", wireType)
 			}
-			m.MaxActiveFinalityProviders = 0
+			m.BabylonHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -645,16 +2005,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxActiveFinalityProviders |= uint32(b&0x7F) << shift
+				m.BabylonHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinUnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeight", wireType)
 			}
-			m.MinUnbondingTime = 0
+			m.BtcHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -664,16 +2024,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinUnbondingTime |= uint32(b&0x7F) << shift
+				m.BtcHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinUnbondingRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cohorts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -683,23 +2043,23 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinUnbondingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Cohorts = append(m.Cohorts, &SlashingRateCohort{})
+			if err := m.Cohorts[len(m.Cohorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -724,7 +2084,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StoredParams) Unmarshal(dAtA []byte) error {
+func (m *SlashingRateCohort) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -747,17 +2107,17 @@ func (m *StoredParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StoredParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashingRateCohort: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StoredParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashingRateCohort: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
 			}
-			m.Version = 0
+			m.ParamsVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -767,16 +2127,16 @@ func (m *StoredParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				m.ParamsVersion |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -786,25 +2146,64 @@ func (m *StoredParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SlashingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumActiveDelegations", wireType)
+			}
+			m.NumActiveDelegations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumActiveDelegations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
+			}
+			m.TotalSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
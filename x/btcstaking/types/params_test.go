@@ -0,0 +1,102 @@
+package types_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+func TestParams_Validate(t *testing.T) {
+	tests := []struct {
+		desc   string
+		modify func(p *types.Params)
+		valid  bool
+	}{
+		{
+			desc:   "default is valid",
+			modify: func(p *types.Params) {},
+			valid:  true,
+		},
+		{
+			desc:   "covenant quorum greater than the committee size",
+			modify: func(p *types.Params) { p.CovenantQuorum = uint32(len(p.CovenantPks)) + 1 },
+			valid:  false,
+		},
+		{
+			desc:   "covenant quorum equal to the committee size",
+			modify: func(p *types.Params) { p.CovenantQuorum = uint32(len(p.CovenantPks)) },
+			valid:  true,
+		},
+		{
+			desc:   "duplicate covenant PKs",
+			modify: func(p *types.Params) { p.CovenantPks[1] = p.CovenantPks[0] },
+			valid:  false,
+		},
+		{
+			desc:   "slashing rate with more than 2 decimal places",
+			modify: func(p *types.Params) { p.SlashingRate = sdkmath.LegacyMustNewDecFromStr("0.123") },
+			valid:  false,
+		},
+		{
+			desc:   "slashing rate of 1",
+			modify: func(p *types.Params) { p.SlashingRate = sdkmath.LegacyOneDec() },
+			valid:  false,
+		},
+		{
+			desc:   "minimum commission rate greater than 1",
+			modify: func(p *types.Params) { p.MinCommissionRate = sdkmath.LegacyMustNewDecFromStr("1.01") },
+			valid:  false,
+		},
+		{
+			desc:   "empty slashing address",
+			modify: func(p *types.Params) { p.SlashingAddress = "" },
+			valid:  false,
+		},
+		{
+			desc:   "malformed slashing address",
+			modify: func(p *types.Params) { p.SlashingAddress = "not-a-btc-address" },
+			valid:  false,
+		},
+		{
+			desc:   "slashing address of a disallowed type",
+			modify: func(p *types.Params) { p.AllowedSlashingAddressTypes = []string{"p2tr"} },
+			valid:  false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			p := types.DefaultParams()
+			tc.modify(&p)
+			err := p.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParams_ValidateWithBTCNet(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+
+	// the default slashing address is a simnet address
+	p := types.DefaultParams()
+	require.NoError(t, p.ValidateWithBTCNet(&chaincfg.SimNetParams))
+	require.Error(t, p.ValidateWithBTCNet(&chaincfg.MainNetParams))
+
+	// a mainnet slashing address is valid regardless of the network, but
+	// only matches mainnet
+	mainnetAddr, err := datagen.GenRandomBTCAddress(r, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+	p.SlashingAddress = mainnetAddr.EncodeAddress()
+	require.NoError(t, p.Validate())
+	require.NoError(t, p.ValidateWithBTCNet(&chaincfg.MainNetParams))
+	require.Error(t, p.ValidateWithBTCNet(&chaincfg.SimNetParams))
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 
 	"github.com/babylonchain/babylon/crypto/bip322"
@@ -16,6 +17,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type checkStakerKey func(stakerKey *bbn.BIP340PubKey) error
@@ -56,6 +58,94 @@ func NewPoP(babylonSK cryptotypes.PrivKey, btcSK *btcec.PrivateKey) (*ProofOfPos
 	return &pop, nil
 }
 
+// NewPoPWithADR036BabylonSig generates a new proof of possession where the
+// Babylon signature is over an ADR-036 sign doc, as produced by wallets that
+// can only sign arbitrary data via ADR-036, e.g., Keplr
+// a proof of possession contains two signatures:
+// - pop.BabylonSig = sign(sk_Babylon, adr036_sign_doc(addr_Babylon, hex(pk_BTC)))
+// - pop.BtcSig = schnorr_sign(sk_BTC, pop.BabylonSig)
+func NewPoPWithADR036BabylonSig(babylonSK cryptotypes.PrivKey, btcSK *btcec.PrivateKey) (*ProofOfPossession, error) {
+	pop := ProofOfPossession{
+		BtcSigType:     BTCSigType_BIP340,
+		BabylonSigType: BabylonSigType_ADR036,
+	}
+
+	// generate pop.BabylonSig = sign(sk_Babylon, adr036_sign_doc(addr_Babylon, hex(pk_BTC)))
+	bip340PK := bbn.NewBIP340PubKeyFromBTCPK(btcSK.PubKey())
+	signDoc, err := NewADR036SignDocForPoP(babylonSK.PubKey(), bip340PK)
+	if err != nil {
+		return nil, err
+	}
+	babylonSig, err := babylonSK.Sign(signDoc)
+	if err != nil {
+		return nil, err
+	}
+	pop.BabylonSig = babylonSig
+
+	// generate pop.BtcSig = schnorr_sign(sk_BTC, pop.BabylonSig)
+	babylonSigHash := tmhash.Sum(pop.BabylonSig)
+	btcSig, err := schnorr.Sign(btcSK, babylonSigHash)
+	if err != nil {
+		return nil, err
+	}
+	pop.BtcSig = bbn.NewBIP340SignatureFromBTCSig(btcSig).MustMarshal()
+
+	return &pop, nil
+}
+
+// adr036SignDoc is the amino JSON sign doc of ADR-036 for signing arbitrary
+// data off-chain. The fields are in alphabetical order, as required by amino
+// JSON.
+// ref: https://github.com/cosmos/cosmos-sdk/blob/main/docs/architecture/adr-036-arbitrary-signature.md
+type adr036SignDoc struct {
+	AccountNumber string      `json:"account_number"`
+	ChainID       string      `json:"chain_id"`
+	Fee           adr036Fee   `json:"fee"`
+	Memo          string      `json:"memo"`
+	Msgs          []adr036Msg `json:"msgs"`
+	Sequence      string      `json:"sequence"`
+}
+
+type adr036Fee struct {
+	Amount []struct{} `json:"amount"`
+	Gas    string     `json:"gas"`
+}
+
+type adr036Msg struct {
+	Type  string            `json:"type"`
+	Value adr036MsgSignData `json:"value"`
+}
+
+type adr036MsgSignData struct {
+	// Data is encoded in base64 by encoding/json
+	Data   []byte `json:"data"`
+	Signer string `json:"signer"`
+}
+
+// NewADR036SignDoc returns the bytes of the ADR-036 sign doc of the given
+// data signed off-chain by the given Babylon address
+func NewADR036SignDoc(signer string, data []byte) ([]byte, error) {
+	return json.Marshal(adr036SignDoc{
+		AccountNumber: "0",
+		ChainID:       "",
+		Fee:           adr036Fee{Amount: []struct{}{}, Gas: "0"},
+		Memo:          "",
+		Msgs: []adr036Msg{{
+			Type:  "sign/MsgSignData",
+			Value: adr036MsgSignData{Data: data, Signer: signer},
+		}},
+		Sequence: "0",
+	})
+}
+
+// NewADR036SignDocForPoP returns the bytes of the ADR-036 sign doc that the
+// Babylon key signs in a proof of possession, i.e., the hex-encoded BTC PK
+// signed by the Babylon address of the Babylon PK
+func NewADR036SignDocForPoP(babylonPK cryptotypes.PubKey, bip340PK *bbn.BIP340PubKey) ([]byte, error) {
+	signer := sdk.AccAddress(babylonPK.Address()).String()
+	return NewADR036SignDoc(signer, []byte(bip340PK.MarshalHex()))
+}
+
 // NewPoPWithECDSABTCSig generates a new proof of possession where Bitcoin signature is in ECDSA format
 // a proof of possession contains two signatures:
 // - pop.BabylonSig = sign(sk_Babylon, pk_BTC)
@@ -192,6 +282,28 @@ func (pop *ProofOfPossession) Verify(babylonPK cryptotypes.PubKey, bip340PK *bbn
 	}
 }
 
+// verifyBabylonSig verifies pop.BabylonSig over the BTC PK, either directly
+// or wrapped in an ADR-036 sign doc, depending on pop.BabylonSigType
+func (pop *ProofOfPossession) verifyBabylonSig(babylonPK cryptotypes.PubKey, bip340PK *bbn.BIP340PubKey) error {
+	var msg []byte
+	switch pop.BabylonSigType {
+	case BabylonSigType_RAW:
+		msg = *bip340PK
+	case BabylonSigType_ADR036:
+		signDoc, err := NewADR036SignDocForPoP(babylonPK, bip340PK)
+		if err != nil {
+			return err
+		}
+		msg = signDoc
+	default:
+		return fmt.Errorf("invalid Babylon signature type")
+	}
+	if !babylonPK.VerifySignature(msg, pop.BabylonSig) {
+		return fmt.Errorf("failed to verify pop.BabylonSig")
+	}
+	return nil
+}
+
 // VerifyBIP340 verifies the validity of PoP where Bitcoin signature is in BIP-340
 // 1. verify(sig=sig_btc, pubkey=pk_btc, msg=pop.BabylonSig)?
 // 2. verify(sig=pop.BabylonSig, pubkey=pk_babylon, msg=pk_btc)?
@@ -221,8 +333,8 @@ func (pop *ProofOfPossession) VerifyBIP340(babylonPK cryptotypes.PubKey, bip340P
 	}
 
 	// rule 2: verify(sig=pop.BabylonSig, pubkey=pk_babylon, msg=pk_btc)?
-	if !babylonPK.VerifySignature(*bip340PK, pop.BabylonSig) {
-		return fmt.Errorf("failed to verify pop.BabylonSig")
+	if err := pop.verifyBabylonSig(babylonPK, bip340PK); err != nil {
+		return err
 	}
 
 	return nil
@@ -395,8 +507,8 @@ func (pop *ProofOfPossession) VerifyBIP322(babylonPK cryptotypes.PubKey, bip340P
 	}
 
 	// rule 2: verify(sig=pop.BabylonSig, pubkey=pk_babylon, msg=pk_btc)?
-	if !babylonPK.VerifySignature(*bip340PK, pop.BabylonSig) {
-		return fmt.Errorf("failed to verify pop.BabylonSig")
+	if err := pop.verifyBabylonSig(babylonPK, bip340PK); err != nil {
+		return err
 	}
 
 	return nil
@@ -423,8 +535,8 @@ func (pop *ProofOfPossession) VerifyECDSA(babylonPK cryptotypes.PubKey, bip340PK
 	}
 
 	// rule 2: verify(sig=pop.BabylonSig, pubkey=pk_babylon, msg=pk_btc)?
-	if !babylonPK.VerifySignature(*bip340PK, pop.BabylonSig) {
-		return fmt.Errorf("failed to verify pop.BabylonSig")
+	if err := pop.verifyBabylonSig(babylonPK, bip340PK); err != nil {
+		return err
 	}
 
 	return nil
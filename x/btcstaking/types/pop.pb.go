@@ -55,6 +55,37 @@ func (BTCSigType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9d6ceb088d9e9f3a, []int{0}
 }
 
+// BabylonSigType indicates the type of babylon_sig in a pop
+type BabylonSigType int32
+
+const (
+	// RAW means the babylon_sig is a signature over the BTC PK itself
+	BabylonSigType_RAW BabylonSigType = 0
+	// ADR036 means the babylon_sig is a signature over an ADR-036 sign doc
+	// whose signer is the Babylon address and whose data is the hex-encoded
+	// BTC PK, i.e., the signature that wallets like Keplr produce via
+	// signArbitrary
+	BabylonSigType_ADR036 BabylonSigType = 1
+)
+
+var BabylonSigType_name = map[int32]string{
+	0: "RAW",
+	1: "ADR036",
+}
+
+var BabylonSigType_value = map[string]int32{
+	"RAW":    0,
+	"ADR036": 1,
+}
+
+func (x BabylonSigType) String() string {
+	return proto.EnumName(BabylonSigType_name, int32(x))
+}
+
+func (BabylonSigType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9d6ceb088d9e9f3a, []int{1}
+}
+
 // ProofOfPossession is the proof of possession that a Babylon secp256k1
 // secret key and a Bitcoin secp256k1 secret key are held by the same
 // person
@@ -66,6 +97,8 @@ type ProofOfPossession struct {
 	// btc_sig is the signature generated via sign(sk_btc, babylon_sig)
 	// the signature follows encoding in either BIP-340 spec or BIP-322 spec
 	BtcSig []byte `protobuf:"bytes,3,opt,name=btc_sig,json=btcSig,proto3" json:"btc_sig,omitempty"`
+	// babylon_sig_type indicates the type of babylon_sig in the pop
+	BabylonSigType BabylonSigType `protobuf:"varint,4,opt,name=babylon_sig_type,json=babylonSigType,proto3,enum=babylon.btcstaking.v1.BabylonSigType" json:"babylon_sig_type,omitempty"`
 }
 
 func (m *ProofOfPossession) Reset()         { *m = ProofOfPossession{} }
@@ -122,6 +155,13 @@ func (m *ProofOfPossession) GetBtcSig() []byte {
 	return nil
 }
 
+func (m *ProofOfPossession) GetBabylonSigType() BabylonSigType {
+	if m != nil {
+		return m.BabylonSigType
+	}
+	return BabylonSigType_RAW
+}
+
 // BIP322Sig is a BIP-322 signature together with the address corresponding to
 // the signer
 type BIP322Sig struct {
@@ -180,6 +220,7 @@ func (m *BIP322Sig) GetSig() []byte {
 
 func init() {
 	proto.RegisterEnum("babylon.btcstaking.v1.BTCSigType", BTCSigType_name, BTCSigType_value)
+	proto.RegisterEnum("babylon.btcstaking.v1.BabylonSigType", BabylonSigType_name, BabylonSigType_value)
 	proto.RegisterType((*ProofOfPossession)(nil), "babylon.btcstaking.v1.ProofOfPossession")
 	proto.RegisterType((*BIP322Sig)(nil), "babylon.btcstaking.v1.BIP322Sig")
 }
@@ -187,26 +228,29 @@ func init() {
 func init() { proto.RegisterFile("babylon/btcstaking/v1/pop.proto", fileDescriptor_9d6ceb088d9e9f3a) }
 
 var fileDescriptor_9d6ceb088d9e9f3a = []byte{
-	// 298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x41, 0x4f, 0xc2, 0x30,
-	0x1c, 0xc5, 0x57, 0x88, 0x10, 0xfe, 0x12, 0x33, 0x9b, 0x18, 0x77, 0x2a, 0xc8, 0x89, 0x78, 0x68,
-	0xa5, 0x98, 0x78, 0x16, 0xf4, 0x60, 0x62, 0x22, 0x01, 0x4e, 0x5e, 0xc8, 0x3a, 0xc6, 0x68, 0xd4,
-	0x75, 0xa1, 0x95, 0xc8, 0xb7, 0x30, 0x7e, 0x2a, 0x8f, 0x1c, 0x3d, 0x9a, 0xed, 0x8b, 0x98, 0xcd,
-	0x2e, 0xf3, 0xe0, 0xed, 0xbd, 0xf6, 0xf5, 0xd7, 0x97, 0x07, 0x1d, 0xe1, 0x8b, 0xdd, 0xb3, 0x8a,
-	0x99, 0x30, 0x81, 0x36, 0xfe, 0x93, 0x8c, 0x23, 0xb6, 0x1d, 0xb0, 0x44, 0x25, 0x34, 0xd9, 0x28,
-	0xa3, 0xf0, 0x89, 0x0d, 0xd0, 0x2a, 0x40, 0xb7, 0x83, 0xde, 0x07, 0x82, 0xe3, 0xc9, 0x46, 0xa9,
-	0xd5, 0xc3, 0x6a, 0xa2, 0xb4, 0x0e, 0xb5, 0x96, 0x2a, 0xc6, 0x63, 0x68, 0x0b, 0x13, 0x2c, 0xb4,
-	0x8c, 0x16, 0x66, 0x97, 0x84, 0x1e, 0xea, 0xa2, 0xfe, 0x11, 0x3f, 0xa3, 0xff, 0x32, 0xe8, 0x68,
-	0x3e, 0x9e, 0xc9, 0x68, 0xbe, 0x4b, 0xc2, 0x29, 0x08, 0x13, 0x58, 0x8d, 0x3b, 0x70, 0x68, 0xf3,
-	0x39, 0xc8, 0xab, 0x75, 0x51, 0xbf, 0x3d, 0x05, 0x7b, 0x34, 0x93, 0x11, 0x3e, 0x85, 0xa6, 0xfd,
-	0xc5, 0xab, 0x17, 0x97, 0x8d, 0xdf, 0xd7, 0xbd, 0x2b, 0x68, 0x8d, 0xee, 0x26, 0x43, 0xce, 0xf3,
-	0x94, 0x07, 0x4d, 0x7f, 0xb9, 0xdc, 0x84, 0x5a, 0x17, 0x35, 0x5a, 0xd3, 0xd2, 0x62, 0x17, 0xea,
-	0x15, 0x38, 0x97, 0xe7, 0x0c, 0xa0, 0x2a, 0x83, 0x01, 0x1a, 0x39, 0xe6, 0xf2, 0xc2, 0x75, 0x4a,
-	0xcd, 0xb9, 0x8b, 0x70, 0x0b, 0x0e, 0x6e, 0xc7, 0x37, 0xb3, 0x6b, 0xb7, 0x36, 0xba, 0xff, 0x4c,
-	0x09, 0xda, 0xa7, 0x04, 0x7d, 0xa7, 0x04, 0xbd, 0x67, 0xc4, 0xd9, 0x67, 0xc4, 0xf9, 0xca, 0x88,
-	0xf3, 0xc8, 0x23, 0x69, 0xd6, 0xaf, 0x82, 0x06, 0xea, 0x85, 0xd9, 0xce, 0xc1, 0xda, 0x97, 0x71,
-	0x69, 0xd8, 0xdb, 0xdf, 0xa9, 0xf3, 0x95, 0xb4, 0x68, 0x14, 0x53, 0x0f, 0x7f, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x89, 0x18, 0xe3, 0x67, 0x8d, 0x01, 0x00, 0x00,
+	// 343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x4f, 0x6b, 0xc2, 0x30,
+	0x18, 0xc6, 0x1b, 0xdd, 0x14, 0xdf, 0x89, 0x74, 0x81, 0xb1, 0x9e, 0xaa, 0x13, 0x04, 0xf1, 0xd0,
+	0x6a, 0x1c, 0xdb, 0xd9, 0x3f, 0x3b, 0x0c, 0x06, 0x4a, 0x15, 0x06, 0xbb, 0x48, 0x53, 0x6b, 0x0d,
+	0xdb, 0x9a, 0x62, 0x32, 0x99, 0xdf, 0x62, 0x1f, 0x6b, 0x47, 0x8f, 0x3b, 0x0e, 0xfb, 0x45, 0x46,
+	0x6b, 0x9c, 0x0a, 0xdb, 0xed, 0x7d, 0x93, 0xf7, 0x79, 0xf2, 0x7b, 0xf2, 0x42, 0x99, 0xba, 0x74,
+	0xf5, 0xc2, 0x43, 0x9b, 0x4a, 0x4f, 0x48, 0xf7, 0x99, 0x85, 0x81, 0xbd, 0x6c, 0xd9, 0x11, 0x8f,
+	0xac, 0x68, 0xc1, 0x25, 0xc7, 0x17, 0x6a, 0xc0, 0xda, 0x0f, 0x58, 0xcb, 0x56, 0x35, 0x46, 0x70,
+	0x3e, 0x5c, 0x70, 0x3e, 0x1b, 0xcc, 0x86, 0x5c, 0x08, 0x5f, 0x08, 0xc6, 0x43, 0xdc, 0x83, 0x22,
+	0x95, 0xde, 0x44, 0xb0, 0x60, 0x22, 0x57, 0x91, 0x6f, 0xa0, 0x0a, 0xaa, 0x97, 0xc8, 0x95, 0xf5,
+	0xa7, 0x87, 0xd5, 0x1d, 0xf7, 0x46, 0x2c, 0x18, 0xaf, 0x22, 0xdf, 0x01, 0x2a, 0x3d, 0x55, 0xe3,
+	0x32, 0x9c, 0xa9, 0xf9, 0xc4, 0xc8, 0xc8, 0x54, 0x50, 0xbd, 0xe8, 0x80, 0x3a, 0x1a, 0xb1, 0x00,
+	0x5f, 0x42, 0x5e, 0xbd, 0x62, 0x64, 0xd3, 0xcb, 0xdc, 0x56, 0x8d, 0x07, 0xa0, 0x1f, 0x28, 0xb7,
+	0x08, 0x27, 0x29, 0x42, 0xed, 0x3f, 0x84, 0x5f, 0xd7, 0x14, 0xa3, 0x44, 0x8f, 0xfa, 0xea, 0x2d,
+	0x14, 0xba, 0xf7, 0xc3, 0x36, 0x21, 0x89, 0xbb, 0x01, 0x79, 0x77, 0x3a, 0x5d, 0xf8, 0x42, 0xa4,
+	0xb9, 0x0a, 0xce, 0xae, 0xc5, 0x3a, 0x64, 0xf7, 0xa4, 0x49, 0xd9, 0xb0, 0x01, 0xf6, 0xe9, 0x30,
+	0x40, 0x2e, 0xb1, 0xb9, 0x6e, 0xea, 0xda, 0xae, 0x26, 0x44, 0x47, 0xb8, 0x00, 0xa7, 0x77, 0xbd,
+	0xfe, 0xa8, 0xa3, 0x67, 0x1a, 0x35, 0x28, 0x1d, 0xb3, 0xe0, 0x3c, 0x64, 0x9d, 0xce, 0xe3, 0x56,
+	0xd1, 0xe9, 0x3b, 0xcd, 0xf6, 0x8d, 0x8e, 0xba, 0x0f, 0x9f, 0x1b, 0x13, 0xad, 0x37, 0x26, 0xfa,
+	0xde, 0x98, 0xe8, 0x23, 0x36, 0xb5, 0x75, 0x6c, 0x6a, 0x5f, 0xb1, 0xa9, 0x3d, 0x91, 0x80, 0xc9,
+	0xf9, 0x1b, 0xb5, 0x3c, 0xfe, 0x6a, 0xab, 0x14, 0xde, 0xdc, 0x65, 0xe1, 0xae, 0xb1, 0xdf, 0x0f,
+	0x57, 0x9c, 0x7c, 0x8d, 0xa0, 0xb9, 0x74, 0xc5, 0xed, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd3,
+	0x5b, 0xe9, 0xbe, 0x05, 0x02, 0x00, 0x00,
 }
 
 func (m *ProofOfPossession) Marshal() (dAtA []byte, err error) {
@@ -229,6 +273,11 @@ func (m *ProofOfPossession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BabylonSigType != 0 {
+		i = encodeVarintPop(dAtA, i, uint64(m.BabylonSigType))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.BtcSig) > 0 {
 		i -= len(m.BtcSig)
 		copy(dAtA[i:], m.BtcSig)
@@ -316,6 +365,9 @@ func (m *ProofOfPossession) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPop(uint64(l))
 	}
+	if m.BabylonSigType != 0 {
+		n += 1 + sovPop(uint64(m.BabylonSigType))
+	}
 	return n
 }
 
@@ -458,6 +510,25 @@ func (m *ProofOfPossession) Unmarshal(dAtA []byte) error {
 				m.BtcSig = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonSigType", wireType)
+			}
+			m.BabylonSigType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BabylonSigType |= BabylonSigType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPop(dAtA[iNdEx:])
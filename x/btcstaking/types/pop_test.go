@@ -101,6 +101,56 @@ func FuzzPoP_ECDSA(f *testing.F) {
 	})
 }
 
+func FuzzPoP_ADR036(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		// generate BTC key pair
+		btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		bip340PK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
+
+		// generate Babylon key pair
+		babylonSK, babylonPK, err := datagen.GenRandomSecp256k1KeyPair(r)
+		require.NoError(t, err)
+
+		// generate and verify PoP, correct case
+		pop, err := types.NewPoPWithADR036BabylonSig(babylonSK, btcSK)
+		require.NoError(t, err)
+		err = pop.Verify(babylonPK, bip340PK, net)
+		require.NoError(t, err)
+
+		// the Babylon signature does not verify under a different type
+		invalidPoP := *pop
+		invalidPoP.BabylonSigType = types.BabylonSigType_RAW
+		err = invalidPoP.Verify(babylonPK, bip340PK, net)
+		require.Error(t, err)
+		rawPoP, err := types.NewPoP(babylonSK, btcSK)
+		require.NoError(t, err)
+		rawPoP.BabylonSigType = types.BabylonSigType_ADR036
+		err = rawPoP.Verify(babylonPK, bip340PK, net)
+		require.Error(t, err)
+
+		// the sign doc commits to the BTC PK
+		_, otherBTCPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		err = pop.Verify(babylonPK, bbn.NewBIP340PubKeyFromBTCPK(otherBTCPK), net)
+		require.Error(t, err)
+	})
+}
+
+func TestADR036SignDoc(t *testing.T) {
+	signDoc, err := types.NewADR036SignDoc("bbn1signer", []byte("data"))
+	require.NoError(t, err)
+	require.Equal(t,
+		`{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"",`+
+			`"msgs":[{"type":"sign/MsgSignData","value":{"data":"ZGF0YQ==","signer":"bbn1signer"}}],"sequence":"0"}`,
+		string(signDoc),
+	)
+}
+
 func FuzzPoP_BIP322_P2WPKH(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 
@@ -2,25 +2,35 @@ package types
 
 import (
 	"encoding/hex"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/chaincfg"
 )
 
 // NewBTCDelegationResponse returns a new delegation response structure.
 func NewBTCDelegationResponse(btcDel *BTCDelegation, status BTCDelegationStatus) (resp *BTCDelegationResponse) {
 	resp = &BTCDelegationResponse{
-		BtcPk:                btcDel.BtcPk,
-		FpBtcPkList:          btcDel.FpBtcPkList,
-		StartHeight:          btcDel.StartHeight,
-		EndHeight:            btcDel.EndHeight,
-		TotalSat:             btcDel.TotalSat,
-		StakingTxHex:         hex.EncodeToString(btcDel.StakingTx),
-		DelegatorSlashSigHex: btcDel.DelegatorSig.ToHexStr(),
-		CovenantSigs:         btcDel.CovenantSigs,
-		StakingOutputIdx:     btcDel.StakingOutputIdx,
-		Active:               status == BTCDelegationStatus_ACTIVE,
-		StatusDesc:           status.String(),
-		UnbondingTime:        btcDel.UnbondingTime,
-		UndelegationResponse: nil,
-		ParamsVersion:        btcDel.ParamsVersion,
+		BtcPk:                 btcDel.BtcPk,
+		FpBtcPkList:           btcDel.FpBtcPkList,
+		StartHeight:           btcDel.StartHeight,
+		EndHeight:             btcDel.EndHeight,
+		TotalSat:              btcDel.TotalSat,
+		StakingTxHex:          hex.EncodeToString(btcDel.StakingTx),
+		DelegatorSlashSigHex:  btcDel.DelegatorSig.ToHexStr(),
+		CovenantSigs:          btcDel.CovenantSigs,
+		StakingOutputIdx:      btcDel.StakingOutputIdx,
+		Active:                status == BTCDelegationStatus_ACTIVE,
+		StatusDesc:            status.String(),
+		UnbondingTime:         btcDel.UnbondingTime,
+		UndelegationResponse:  nil,
+		ParamsVersion:         btcDel.ParamsVersion,
+		ScriptTemplateVersion: btcDel.ScriptTemplateVersion,
+		SlashingRate:          btcDel.SlashingRate,
+		SlashingAddress:       btcDel.SlashingAddress,
+		Memo:                  btcDel.Memo,
+		ActivationHeight:      btcDel.ActivationHeight,
+		RewardAddress:         btcDel.RewardAddress,
+		WithdrawalBtcAddress:  btcDel.WithdrawalBtcAddress,
 	}
 
 	if btcDel.SlashingTx != nil {
@@ -34,6 +44,54 @@ func NewBTCDelegationResponse(btcDel *BTCDelegation, status BTCDelegationStatus)
 	return resp
 }
 
+// NewCovenantSigningWorkItem returns the work item of the given pending BTC
+// delegation for covenant members, which carries the outputs and the scripts
+// the covenant signatures commit to, built under the given parameters of the
+// BTC delegation.
+func NewCovenantSigningWorkItem(btcDel *BTCDelegation, status BTCDelegationStatus, bsParams *Params, btcNet *chaincfg.Params) (*CovenantSigningWorkItem, error) {
+	stakingInfo, err := btcDel.GetStakingInfo(bsParams, btcNet)
+	if err != nil {
+		return nil, err
+	}
+	stakingSlashingSpendInfo, err := stakingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+	stakingUnbondingSpendInfo, err := stakingInfo.UnbondingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+	unbondingInfo, err := btcDel.GetUnbondingInfo(bsParams, btcNet)
+	if err != nil {
+		return nil, err
+	}
+	unbondingSlashingSpendInfo, err := unbondingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	// the BTC delegation carries the slashing rate and the slashing address
+	// its slashing txs commit to, even if it predates their recording
+	slashingParams := btcDel.ParamsWithRecordedSlashing(bsParams)
+	btcDelResp := NewBTCDelegationResponse(btcDel, status)
+	btcDelResp.SlashingRate = slashingParams.SlashingRate
+	btcDelResp.SlashingAddress = slashingParams.SlashingAddress
+
+	return &CovenantSigningWorkItem{
+		StakingTxHashHex:               btcDel.MustGetStakingTxHash().String(),
+		BtcDelegation:                  btcDelResp,
+		CovenantPks:                    bsParams.CovenantPks,
+		CovenantQuorum:                 bsParams.CovenantQuorum,
+		StakingOutputPkScriptHex:       hex.EncodeToString(stakingInfo.StakingOutput.PkScript),
+		StakingOutputValue:             stakingInfo.StakingOutput.Value,
+		StakingSlashingPathScriptHex:   hex.EncodeToString(stakingSlashingSpendInfo.GetPkScriptPath()),
+		StakingUnbondingPathScriptHex:  hex.EncodeToString(stakingUnbondingSpendInfo.GetPkScriptPath()),
+		UnbondingOutputPkScriptHex:     hex.EncodeToString(unbondingInfo.UnbondingOutput.PkScript),
+		UnbondingOutputValue:           unbondingInfo.UnbondingOutput.Value,
+		UnbondingSlashingPathScriptHex: hex.EncodeToString(unbondingSlashingSpendInfo.GetPkScriptPath()),
+	}, nil
+}
+
 // ToResponse parses an BTCUndelegation into BTCUndelegationResponse.
 func (ud *BTCUndelegation) ToResponse() (resp *BTCUndelegationResponse) {
 	resp = &BTCUndelegationResponse{
@@ -69,5 +127,24 @@ func NewFinalityProviderResponse(f *FinalityProvider, bbnBlockHeight, votingPowe
 		SlashedBtcHeight:     f.SlashedBtcHeight,
 		Height:               bbnBlockHeight,
 		VotingPower:          votingPower,
+		ConsumerId:           f.ConsumerId,
+	}
+}
+
+// NewCovenantPerformanceResponse returns the response of the given signing
+// record of a covenant signer, where inCommittee indicates whether it is a
+// covenant signer under the current parameters
+func NewCovenantPerformanceResponse(perf *CovenantPerformance, inCommittee bool) *CovenantPerformanceResponse {
+	avgSigningBlocks := sdkmath.LegacyZeroDec()
+	if perf.NumSigned > 0 {
+		avgSigningBlocks = sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(perf.TotalSigningBlocks)).
+			QuoInt(sdkmath.NewIntFromUint64(perf.NumSigned))
+	}
+	return &CovenantPerformanceResponse{
+		CovPkHex:             perf.CovPk.MarshalHex(),
+		NumSigned:            perf.NumSigned,
+		AverageSigningBlocks: avgSigningBlocks,
+		NumMissed:            perf.NumMissed,
+		InCommittee:          inCommittee,
 	}
 }
@@ -8,6 +8,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
+	types1 "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	_ "github.com/cosmos/cosmos-proto"
 	secp256k1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	query "github.com/cosmos/cosmos-sdk/types/query"
@@ -76,6 +77,8 @@ var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 type QueryParamsResponse struct {
 	// params holds all the parameters of this module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// version is the version of the parameters
+	Version uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -118,6 +121,13 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+func (m *QueryParamsResponse) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsByVersionRequest struct {
 	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
@@ -312,6 +322,123 @@ func (m *QueryFinalityProvidersResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryFinalityProvidersByConsumerRequest is the request type for the
+// Query/FinalityProvidersByConsumer RPC method.
+type QueryFinalityProvidersByConsumerRequest struct {
+	// consumer_id is the chain ID of the consumer chain
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFinalityProvidersByConsumerRequest) Reset() {
+	*m = QueryFinalityProvidersByConsumerRequest{}
+}
+func (m *QueryFinalityProvidersByConsumerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalityProvidersByConsumerRequest) ProtoMessage()    {}
+func (*QueryFinalityProvidersByConsumerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{6}
+}
+func (m *QueryFinalityProvidersByConsumerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProvidersByConsumerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProvidersByConsumerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalityProvidersByConsumerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProvidersByConsumerRequest.Merge(m, src)
+}
+func (m *QueryFinalityProvidersByConsumerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProvidersByConsumerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProvidersByConsumerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityProvidersByConsumerRequest proto.InternalMessageInfo
+
+func (m *QueryFinalityProvidersByConsumerRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *QueryFinalityProvidersByConsumerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFinalityProvidersByConsumerResponse is the response type for the
+// Query/FinalityProvidersByConsumer RPC method.
+type QueryFinalityProvidersByConsumerResponse struct {
+	// finality_providers contains all the finality providers securing the
+	// consumer chain
+	FinalityProviders []*FinalityProviderResponse `protobuf:"bytes,1,rep,name=finality_providers,json=finalityProviders,proto3" json:"finality_providers,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFinalityProvidersByConsumerResponse) Reset() {
+	*m = QueryFinalityProvidersByConsumerResponse{}
+}
+func (m *QueryFinalityProvidersByConsumerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalityProvidersByConsumerResponse) ProtoMessage()    {}
+func (*QueryFinalityProvidersByConsumerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{7}
+}
+func (m *QueryFinalityProvidersByConsumerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProvidersByConsumerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProvidersByConsumerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalityProvidersByConsumerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProvidersByConsumerResponse.Merge(m, src)
+}
+func (m *QueryFinalityProvidersByConsumerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProvidersByConsumerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProvidersByConsumerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityProvidersByConsumerResponse proto.InternalMessageInfo
+
+func (m *QueryFinalityProvidersByConsumerResponse) GetFinalityProviders() []*FinalityProviderResponse {
+	if m != nil {
+		return m.FinalityProviders
+	}
+	return nil
+}
+
+func (m *QueryFinalityProvidersByConsumerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryFinalityProviderRequest requests information about a finality provider
 type QueryFinalityProviderRequest struct {
 	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the finality provider
@@ -322,7 +449,7 @@ func (m *QueryFinalityProviderRequest) Reset()         { *m = QueryFinalityProvi
 func (m *QueryFinalityProviderRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFinalityProviderRequest) ProtoMessage()    {}
 func (*QueryFinalityProviderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{6}
+	return fileDescriptor_74d49d26f7429697, []int{8}
 }
 func (m *QueryFinalityProviderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -368,7 +495,7 @@ func (m *QueryFinalityProviderResponse) Reset()         { *m = QueryFinalityProv
 func (m *QueryFinalityProviderResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFinalityProviderResponse) ProtoMessage()    {}
 func (*QueryFinalityProviderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{7}
+	return fileDescriptor_74d49d26f7429697, []int{9}
 }
 func (m *QueryFinalityProviderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -404,6 +531,110 @@ func (m *QueryFinalityProviderResponse) GetFinalityProvider() *FinalityProviderR
 	return nil
 }
 
+// QueryFinalityProviderStatusRequest is the request type for the
+// Query/FinalityProviderStatus RPC method.
+type QueryFinalityProviderStatusRequest struct {
+	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the finality provider
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+}
+
+func (m *QueryFinalityProviderStatusRequest) Reset()         { *m = QueryFinalityProviderStatusRequest{} }
+func (m *QueryFinalityProviderStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalityProviderStatusRequest) ProtoMessage()    {}
+func (*QueryFinalityProviderStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{10}
+}
+func (m *QueryFinalityProviderStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalityProviderStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderStatusRequest.Merge(m, src)
+}
+func (m *QueryFinalityProviderStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityProviderStatusRequest proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderStatusRequest) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
+	}
+	return ""
+}
+
+// QueryFinalityProviderStatusResponse is the response type for the
+// Query/FinalityProviderStatus RPC method.
+type QueryFinalityProviderStatusResponse struct {
+	// report is the status report announced by the finality provider
+	Report *FinalityProviderStatusReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	// in_window is whether the current Babylon height is within the announced
+	// window
+	InWindow bool `protobuf:"varint,2,opt,name=in_window,json=inWindow,proto3" json:"in_window,omitempty"`
+}
+
+func (m *QueryFinalityProviderStatusResponse) Reset()         { *m = QueryFinalityProviderStatusResponse{} }
+func (m *QueryFinalityProviderStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalityProviderStatusResponse) ProtoMessage()    {}
+func (*QueryFinalityProviderStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{11}
+}
+func (m *QueryFinalityProviderStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalityProviderStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderStatusResponse.Merge(m, src)
+}
+func (m *QueryFinalityProviderStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityProviderStatusResponse proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderStatusResponse) GetReport() *FinalityProviderStatusReport {
+	if m != nil {
+		return m.Report
+	}
+	return nil
+}
+
+func (m *QueryFinalityProviderStatusResponse) GetInWindow() bool {
+	if m != nil {
+		return m.InWindow
+	}
+	return false
+}
+
 // QueryBTCDelegationsRequest is the request type for the
 // Query/BTCDelegations RPC method.
 type QueryBTCDelegationsRequest struct {
@@ -417,7 +648,7 @@ func (m *QueryBTCDelegationsRequest) Reset()         { *m = QueryBTCDelegationsR
 func (m *QueryBTCDelegationsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBTCDelegationsRequest) ProtoMessage()    {}
 func (*QueryBTCDelegationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{8}
+	return fileDescriptor_74d49d26f7429697, []int{12}
 }
 func (m *QueryBTCDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -473,7 +704,7 @@ func (m *QueryBTCDelegationsResponse) Reset()         { *m = QueryBTCDelegations
 func (m *QueryBTCDelegationsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBTCDelegationsResponse) ProtoMessage()    {}
 func (*QueryBTCDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{9}
+	return fileDescriptor_74d49d26f7429697, []int{13}
 }
 func (m *QueryBTCDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -535,7 +766,7 @@ func (m *QueryFinalityProviderPowerAtHeightRequest) String() string {
 }
 func (*QueryFinalityProviderPowerAtHeightRequest) ProtoMessage() {}
 func (*QueryFinalityProviderPowerAtHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{10}
+	return fileDescriptor_74d49d26f7429697, []int{14}
 }
 func (m *QueryFinalityProviderPowerAtHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -593,7 +824,7 @@ func (m *QueryFinalityProviderPowerAtHeightResponse) String() string {
 }
 func (*QueryFinalityProviderPowerAtHeightResponse) ProtoMessage() {}
 func (*QueryFinalityProviderPowerAtHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{11}
+	return fileDescriptor_74d49d26f7429697, []int{15}
 }
 func (m *QueryFinalityProviderPowerAtHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -644,7 +875,7 @@ func (m *QueryFinalityProviderCurrentPowerRequest) Reset() {
 func (m *QueryFinalityProviderCurrentPowerRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFinalityProviderCurrentPowerRequest) ProtoMessage()    {}
 func (*QueryFinalityProviderCurrentPowerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{12}
+	return fileDescriptor_74d49d26f7429697, []int{16}
 }
 func (m *QueryFinalityProviderCurrentPowerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -697,7 +928,7 @@ func (m *QueryFinalityProviderCurrentPowerResponse) String() string {
 }
 func (*QueryFinalityProviderCurrentPowerResponse) ProtoMessage() {}
 func (*QueryFinalityProviderCurrentPowerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{13}
+	return fileDescriptor_74d49d26f7429697, []int{17}
 }
 func (m *QueryFinalityProviderCurrentPowerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -757,7 +988,7 @@ func (m *QueryActiveFinalityProvidersAtHeightRequest) String() string {
 }
 func (*QueryActiveFinalityProvidersAtHeightRequest) ProtoMessage() {}
 func (*QueryActiveFinalityProvidersAtHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{14}
+	return fileDescriptor_74d49d26f7429697, []int{18}
 }
 func (m *QueryActiveFinalityProvidersAtHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -817,7 +1048,7 @@ func (m *QueryActiveFinalityProvidersAtHeightResponse) String() string {
 }
 func (*QueryActiveFinalityProvidersAtHeightResponse) ProtoMessage() {}
 func (*QueryActiveFinalityProvidersAtHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{15}
+	return fileDescriptor_74d49d26f7429697, []int{19}
 }
 func (m *QueryActiveFinalityProvidersAtHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -860,6 +1091,150 @@ func (m *QueryActiveFinalityProvidersAtHeightResponse) GetPagination() *query.Pa
 	return nil
 }
 
+// QueryActiveFinalityProvidersByPowerAtHeightRequest is the request type for
+// the Query/ActiveFinalityProvidersByPowerAtHeight RPC method.
+type QueryActiveFinalityProvidersByPowerAtHeightRequest struct {
+	// height defines at which Babylon height to query the finality providers info.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// pagination defines an optional pagination for the request. The key of
+	// the pagination is an opaque cursor returned as the next key of the
+	// previous page. Reverse pagination is not supported.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryActiveFinalityProvidersByPowerAtHeightRequest) Reset() {
+	*m = QueryActiveFinalityProvidersByPowerAtHeightRequest{}
+}
+func (m *QueryActiveFinalityProvidersByPowerAtHeightRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryActiveFinalityProvidersByPowerAtHeightRequest) ProtoMessage() {}
+func (*QueryActiveFinalityProvidersByPowerAtHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{20}
+}
+func (m *QueryActiveFinalityProvidersByPowerAtHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveFinalityProvidersByPowerAtHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveFinalityProvidersByPowerAtHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActiveFinalityProvidersByPowerAtHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveFinalityProvidersByPowerAtHeightRequest.Merge(m, src)
+}
+func (m *QueryActiveFinalityProvidersByPowerAtHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveFinalityProvidersByPowerAtHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveFinalityProvidersByPowerAtHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActiveFinalityProvidersByPowerAtHeightRequest proto.InternalMessageInfo
+
+func (m *QueryActiveFinalityProvidersByPowerAtHeightRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryActiveFinalityProvidersByPowerAtHeightRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryActiveFinalityProvidersByPowerAtHeightResponse is the response type
+// for the Query/ActiveFinalityProvidersByPowerAtHeight RPC method.
+type QueryActiveFinalityProvidersByPowerAtHeightResponse struct {
+	// finality_providers contains the queried active finality providers,
+	// sorted by voting power in descending order, and by BTC PK in ascending
+	// order for the same voting power
+	FinalityProviders []*FinalityProviderWithMeta `protobuf:"bytes,1,rep,name=finality_providers,json=finalityProviders,proto3" json:"finality_providers,omitempty"`
+	// total_voting_power is the total voting power of all active finality
+	// providers at the given height
+	TotalVotingPower uint64 `protobuf:"varint,2,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+	// num_active_finality_providers is the number of all active finality
+	// providers at the given height
+	NumActiveFinalityProviders uint32 `protobuf:"varint,3,opt,name=num_active_finality_providers,json=numActiveFinalityProviders,proto3" json:"num_active_finality_providers,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryActiveFinalityProvidersByPowerAtHeightResponse) Reset() {
+	*m = QueryActiveFinalityProvidersByPowerAtHeightResponse{}
+}
+func (m *QueryActiveFinalityProvidersByPowerAtHeightResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryActiveFinalityProvidersByPowerAtHeightResponse) ProtoMessage() {}
+func (*QueryActiveFinalityProvidersByPowerAtHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{21}
+}
+func (m *QueryActiveFinalityProvidersByPowerAtHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveFinalityProvidersByPowerAtHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveFinalityProvidersByPowerAtHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActiveFinalityProvidersByPowerAtHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveFinalityProvidersByPowerAtHeightResponse.Merge(m, src)
+}
+func (m *QueryActiveFinalityProvidersByPowerAtHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveFinalityProvidersByPowerAtHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveFinalityProvidersByPowerAtHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActiveFinalityProvidersByPowerAtHeightResponse proto.InternalMessageInfo
+
+func (m *QueryActiveFinalityProvidersByPowerAtHeightResponse) GetFinalityProviders() []*FinalityProviderWithMeta {
+	if m != nil {
+		return m.FinalityProviders
+	}
+	return nil
+}
+
+func (m *QueryActiveFinalityProvidersByPowerAtHeightResponse) GetTotalVotingPower() uint64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
+func (m *QueryActiveFinalityProvidersByPowerAtHeightResponse) GetNumActiveFinalityProviders() uint32 {
+	if m != nil {
+		return m.NumActiveFinalityProviders
+	}
+	return 0
+}
+
+func (m *QueryActiveFinalityProvidersByPowerAtHeightResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryActivatedHeightRequest is the request type for the Query/ActivatedHeight RPC method.
 type QueryActivatedHeightRequest struct {
 }
@@ -868,7 +1243,7 @@ func (m *QueryActivatedHeightRequest) Reset()         { *m = QueryActivatedHeigh
 func (m *QueryActivatedHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryActivatedHeightRequest) ProtoMessage()    {}
 func (*QueryActivatedHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{16}
+	return fileDescriptor_74d49d26f7429697, []int{22}
 }
 func (m *QueryActivatedHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -906,7 +1281,7 @@ func (m *QueryActivatedHeightResponse) Reset()         { *m = QueryActivatedHeig
 func (m *QueryActivatedHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryActivatedHeightResponse) ProtoMessage()    {}
 func (*QueryActivatedHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{17}
+	return fileDescriptor_74d49d26f7429697, []int{23}
 }
 func (m *QueryActivatedHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -959,7 +1334,7 @@ func (m *QueryFinalityProviderDelegationsRequest) Reset() {
 func (m *QueryFinalityProviderDelegationsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFinalityProviderDelegationsRequest) ProtoMessage()    {}
 func (*QueryFinalityProviderDelegationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{18}
+	return fileDescriptor_74d49d26f7429697, []int{24}
 }
 func (m *QueryFinalityProviderDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1017,7 +1392,7 @@ func (m *QueryFinalityProviderDelegationsResponse) Reset() {
 func (m *QueryFinalityProviderDelegationsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFinalityProviderDelegationsResponse) ProtoMessage()    {}
 func (*QueryFinalityProviderDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{19}
+	return fileDescriptor_74d49d26f7429697, []int{25}
 }
 func (m *QueryFinalityProviderDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1071,7 +1446,7 @@ func (m *QueryBTCDelegationRequest) Reset()         { *m = QueryBTCDelegationReq
 func (m *QueryBTCDelegationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBTCDelegationRequest) ProtoMessage()    {}
 func (*QueryBTCDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{20}
+	return fileDescriptor_74d49d26f7429697, []int{26}
 }
 func (m *QueryBTCDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1118,7 +1493,7 @@ func (m *QueryBTCDelegationResponse) Reset()         { *m = QueryBTCDelegationRe
 func (m *QueryBTCDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBTCDelegationResponse) ProtoMessage()    {}
 func (*QueryBTCDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{21}
+	return fileDescriptor_74d49d26f7429697, []int{27}
 }
 func (m *QueryBTCDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1196,13 +1571,32 @@ type BTCDelegationResponse struct {
 	UndelegationResponse *BTCUndelegationResponse `protobuf:"bytes,14,opt,name=undelegation_response,json=undelegationResponse,proto3" json:"undelegation_response,omitempty"`
 	// params version used to validate delegation
 	ParamsVersion uint32 `protobuf:"varint,15,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// script_template_version is the version of the script template that the
+	// staking and unbonding outputs of the delegation are built with
+	ScriptTemplateVersion uint32 `protobuf:"varint,16,opt,name=script_template_version,json=scriptTemplateVersion,proto3" json:"script_template_version,omitempty"`
+	// slashing_rate is the slashing rate the slashing txs of the delegation
+	// commit to
+	SlashingRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,17,opt,name=slashing_rate,json=slashingRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slashing_rate"`
+	// slashing_address is the address the slashing txs of the delegation pay to
+	SlashingAddress string `protobuf:"bytes,18,opt,name=slashing_address,json=slashingAddress,proto3" json:"slashing_address,omitempty"`
+	// memo is the optional label of the delegation set by the staker
+	Memo string `protobuf:"bytes,19,opt,name=memo,proto3" json:"memo,omitempty"`
+	// activation_height is the BTC height at which the delegation becomes
+	// active once it has covenant quorum
+	ActivationHeight uint64 `protobuf:"varint,20,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// reward_address is the Babylon address receiving the rewards of the
+	// delegation, if different from the address of its Babylon PK
+	RewardAddress string `protobuf:"bytes,21,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"`
+	// withdrawal_btc_address is the BTC address the staker intends to withdraw
+	// the staked BTC to, if specified
+	WithdrawalBtcAddress string `protobuf:"bytes,22,opt,name=withdrawal_btc_address,json=withdrawalBtcAddress,proto3" json:"withdrawal_btc_address,omitempty"`
 }
 
 func (m *BTCDelegationResponse) Reset()         { *m = BTCDelegationResponse{} }
 func (m *BTCDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegationResponse) ProtoMessage()    {}
 func (*BTCDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{22}
+	return fileDescriptor_74d49d26f7429697, []int{28}
 }
 func (m *BTCDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1322,6 +1716,48 @@ func (m *BTCDelegationResponse) GetParamsVersion() uint32 {
 	return 0
 }
 
+func (m *BTCDelegationResponse) GetScriptTemplateVersion() uint32 {
+	if m != nil {
+		return m.ScriptTemplateVersion
+	}
+	return 0
+}
+
+func (m *BTCDelegationResponse) GetSlashingAddress() string {
+	if m != nil {
+		return m.SlashingAddress
+	}
+	return ""
+}
+
+func (m *BTCDelegationResponse) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *BTCDelegationResponse) GetActivationHeight() uint64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *BTCDelegationResponse) GetRewardAddress() string {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return ""
+}
+
+func (m *BTCDelegationResponse) GetWithdrawalBtcAddress() string {
+	if m != nil {
+		return m.WithdrawalBtcAddress
+	}
+	return ""
+}
+
 // BTCUndelegationResponse provides all necessary info about the undeleagation
 type BTCUndelegationResponse struct {
 	// unbonding_tx is the transaction which will transfer the funds from staking
@@ -1354,7 +1790,7 @@ func (m *BTCUndelegationResponse) Reset()         { *m = BTCUndelegationResponse
 func (m *BTCUndelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*BTCUndelegationResponse) ProtoMessage()    {}
 func (*BTCUndelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{23}
+	return fileDescriptor_74d49d26f7429697, []int{29}
 }
 func (m *BTCUndelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1434,7 +1870,7 @@ func (m *BTCDelegatorDelegationsResponse) Reset()         { *m = BTCDelegatorDel
 func (m *BTCDelegatorDelegationsResponse) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegatorDelegationsResponse) ProtoMessage()    {}
 func (*BTCDelegatorDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{24}
+	return fileDescriptor_74d49d26f7429697, []int{30}
 }
 func (m *BTCDelegatorDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1500,13 +1936,16 @@ type FinalityProviderResponse struct {
 	Height uint64 `protobuf:"varint,10,opt,name=height,proto3" json:"height,omitempty"`
 	// voting_power is the voting power of this finality provider at the given height
 	VotingPower uint64 `protobuf:"varint,11,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// consumer_id is the chain ID of the consumer chain secured by the
+	// finality provider. It is empty if the finality provider secures Babylon
+	ConsumerId string `protobuf:"bytes,12,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
 func (m *FinalityProviderResponse) Reset()         { *m = FinalityProviderResponse{} }
 func (m *FinalityProviderResponse) String() string { return proto.CompactTextString(m) }
 func (*FinalityProviderResponse) ProtoMessage()    {}
 func (*FinalityProviderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{25}
+	return fileDescriptor_74d49d26f7429697, []int{31}
 }
 func (m *FinalityProviderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1598,2298 +2037,12690 @@ func (m *FinalityProviderResponse) GetVotingPower() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.btcstaking.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.btcstaking.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryParamsByVersionRequest)(nil), "babylon.btcstaking.v1.QueryParamsByVersionRequest")
-	proto.RegisterType((*QueryParamsByVersionResponse)(nil), "babylon.btcstaking.v1.QueryParamsByVersionResponse")
-	proto.RegisterType((*QueryFinalityProvidersRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersRequest")
-	proto.RegisterType((*QueryFinalityProvidersResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersResponse")
-	proto.RegisterType((*QueryFinalityProviderRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderRequest")
-	proto.RegisterType((*QueryFinalityProviderResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderResponse")
-	proto.RegisterType((*QueryBTCDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsRequest")
-	proto.RegisterType((*QueryBTCDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsResponse")
-	proto.RegisterType((*QueryFinalityProviderPowerAtHeightRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderPowerAtHeightRequest")
-	proto.RegisterType((*QueryFinalityProviderPowerAtHeightResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderPowerAtHeightResponse")
-	proto.RegisterType((*QueryFinalityProviderCurrentPowerRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderCurrentPowerRequest")
-	proto.RegisterType((*QueryFinalityProviderCurrentPowerResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderCurrentPowerResponse")
-	proto.RegisterType((*QueryActiveFinalityProvidersAtHeightRequest)(nil), "babylon.btcstaking.v1.QueryActiveFinalityProvidersAtHeightRequest")
-	proto.RegisterType((*QueryActiveFinalityProvidersAtHeightResponse)(nil), "babylon.btcstaking.v1.QueryActiveFinalityProvidersAtHeightResponse")
-	proto.RegisterType((*QueryActivatedHeightRequest)(nil), "babylon.btcstaking.v1.QueryActivatedHeightRequest")
-	proto.RegisterType((*QueryActivatedHeightResponse)(nil), "babylon.btcstaking.v1.QueryActivatedHeightResponse")
-	proto.RegisterType((*QueryFinalityProviderDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsRequest")
-	proto.RegisterType((*QueryFinalityProviderDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsResponse")
-	proto.RegisterType((*QueryBTCDelegationRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationRequest")
-	proto.RegisterType((*QueryBTCDelegationResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationResponse")
-	proto.RegisterType((*BTCDelegationResponse)(nil), "babylon.btcstaking.v1.BTCDelegationResponse")
-	proto.RegisterType((*BTCUndelegationResponse)(nil), "babylon.btcstaking.v1.BTCUndelegationResponse")
-	proto.RegisterType((*BTCDelegatorDelegationsResponse)(nil), "babylon.btcstaking.v1.BTCDelegatorDelegationsResponse")
-	proto.RegisterType((*FinalityProviderResponse)(nil), "babylon.btcstaking.v1.FinalityProviderResponse")
+func (m *FinalityProviderResponse) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("babylon/btcstaking/v1/query.proto", fileDescriptor_74d49d26f7429697) }
-
-var fileDescriptor_74d49d26f7429697 = []byte{
-	// 1916 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4f, 0x6c, 0x13, 0xd9,
-	0x19, 0x67, 0x12, 0xe3, 0x25, 0x9f, 0xe3, 0x24, 0xbc, 0x0d, 0x60, 0x1c, 0x12, 0xc3, 0x94, 0x85,
-	0x84, 0x05, 0x0f, 0x31, 0x81, 0x4a, 0xbb, 0x2d, 0x10, 0x13, 0x16, 0xd8, 0x25, 0xc2, 0x9d, 0x40,
-	0x2b, 0x75, 0xab, 0x8e, 0xc6, 0xe3, 0xe7, 0xf1, 0x28, 0xf6, 0xcc, 0x30, 0xef, 0x39, 0xb5, 0x85,
-	0x72, 0xe9, 0xa1, 0xb7, 0x4a, 0x95, 0xda, 0x43, 0x2f, 0x3d, 0xb7, 0x52, 0x8f, 0xdd, 0x53, 0xa5,
-	0xde, 0xb7, 0xb7, 0xd5, 0xf6, 0xd0, 0x6a, 0x0f, 0xa8, 0x82, 0xaa, 0x95, 0x2a, 0x71, 0xed, 0xb9,
-	0x9a, 0xf7, 0xde, 0x78, 0xc6, 0xf6, 0x8c, 0x63, 0x27, 0xd9, 0x5b, 0xfc, 0xde, 0xf7, 0xff, 0xfb,
-	0xbd, 0xdf, 0x9b, 0xf7, 0x05, 0x2e, 0x55, 0xf5, 0x6a, 0xb7, 0xe9, 0xd8, 0x4a, 0x95, 0x1a, 0x84,
-	0xea, 0xbb, 0x96, 0x6d, 0x2a, 0x7b, 0xeb, 0xca, 0xcb, 0x36, 0xf6, 0xba, 0x45, 0xd7, 0x73, 0xa8,
-	0x83, 0xce, 0x08, 0x91, 0x62, 0x28, 0x52, 0xdc, 0x5b, 0xcf, 0x2f, 0x9a, 0x8e, 0xe9, 0x30, 0x09,
-	0xc5, 0xff, 0x8b, 0x0b, 0xe7, 0x2f, 0x98, 0x8e, 0x63, 0x36, 0xb1, 0xa2, 0xbb, 0x96, 0xa2, 0xdb,
-	0xb6, 0x43, 0x75, 0x6a, 0x39, 0x36, 0x11, 0xbb, 0xe7, 0x0d, 0x87, 0xb4, 0x1c, 0xa2, 0x71, 0x35,
-	0xfe, 0x43, 0x6c, 0xc9, 0xfc, 0x97, 0x62, 0x78, 0x5d, 0x97, 0x3a, 0x0a, 0xc1, 0x86, 0x5b, 0xba,
-	0x7d, 0x67, 0x77, 0x5d, 0xd9, 0xc5, 0xdd, 0x40, 0xe6, 0xb2, 0x90, 0x09, 0x03, 0xad, 0x62, 0xaa,
-	0xaf, 0x07, 0xbf, 0x85, 0xd4, 0x35, 0x21, 0x55, 0xd5, 0x09, 0xe6, 0x89, 0xf4, 0x04, 0x5d, 0xdd,
-	0xb4, 0x6c, 0x16, 0x51, 0xe0, 0x35, 0x3e, 0x7d, 0x57, 0xf7, 0xf4, 0x56, 0xe0, 0xf5, 0x4a, 0xbc,
-	0x4c, 0xa4, 0x1a, 0x5c, 0xae, 0x90, 0x60, 0xcb, 0x71, 0xb9, 0x80, 0xbc, 0x08, 0xe8, 0x07, 0x7e,
-	0x38, 0x15, 0x66, 0x5d, 0xc5, 0x2f, 0xdb, 0x98, 0x50, 0x59, 0x85, 0xf7, 0xfb, 0x56, 0x89, 0xeb,
-	0xd8, 0x04, 0xa3, 0x8f, 0x21, 0xcd, 0xa3, 0xc8, 0x49, 0x17, 0xa5, 0xd5, 0x4c, 0x69, 0xb9, 0x18,
-	0xdb, 0x86, 0x22, 0x57, 0x2b, 0xa7, 0xbe, 0x7c, 0x5d, 0x38, 0xa1, 0x0a, 0x15, 0xf9, 0xbb, 0xb0,
-	0x14, 0xb1, 0x59, 0xee, 0xfe, 0x10, 0x7b, 0xc4, 0x72, 0x6c, 0xe1, 0x12, 0xe5, 0xe0, 0xbd, 0x3d,
-	0xbe, 0xc2, 0x8c, 0x67, 0xd5, 0xe0, 0xa7, 0xfc, 0x39, 0x5c, 0x88, 0x57, 0x3c, 0x8e, 0xa8, 0x4c,
-	0x58, 0x66, 0xc6, 0x3f, 0xb1, 0x6c, 0xbd, 0x69, 0xd1, 0x6e, 0xc5, 0x73, 0xf6, 0xac, 0x1a, 0xf6,
-	0x82, 0x52, 0xa0, 0x4f, 0x00, 0xc2, 0x0e, 0x09, 0x0f, 0x57, 0x8a, 0x02, 0x26, 0x7e, 0x3b, 0x8b,
-	0x1c, 0x97, 0xa2, 0x9d, 0xc5, 0x8a, 0x6e, 0x62, 0xa1, 0xab, 0x46, 0x34, 0xe5, 0xbf, 0x4a, 0xb0,
-	0x92, 0xe4, 0x49, 0x24, 0xf2, 0x53, 0x40, 0x75, 0xb1, 0xe9, 0xa3, 0x91, 0xef, 0xe6, 0xa4, 0x8b,
-	0xd3, 0xab, 0x99, 0x92, 0x92, 0x90, 0xd4, 0xa0, 0xb5, 0xc0, 0x98, 0x7a, 0xba, 0x3e, 0xe8, 0x07,
-	0x3d, 0xea, 0x4b, 0x65, 0x8a, 0xa5, 0x72, 0xf5, 0xc0, 0x54, 0x84, 0xbd, 0x68, 0x2e, 0x9b, 0xa2,
-	0x23, 0xc3, 0xce, 0x79, 0xcd, 0x2e, 0x41, 0xb6, 0xee, 0x6a, 0x55, 0x6a, 0x68, 0xee, 0xae, 0xd6,
-	0xc0, 0x1d, 0x56, 0xb6, 0x19, 0x15, 0xea, 0x6e, 0x99, 0x1a, 0x95, 0xdd, 0xc7, 0xb8, 0x23, 0xef,
-	0x27, 0xd4, 0xbd, 0x57, 0x8c, 0x9f, 0xc0, 0xe9, 0xa1, 0x62, 0x88, 0xf2, 0x4f, 0x5c, 0x8b, 0x85,
-	0xc1, 0x5a, 0xc8, 0x7f, 0x90, 0x20, 0xcf, 0xfc, 0x97, 0x9f, 0x3f, 0xd8, 0xc2, 0x4d, 0x6c, 0x72,
-	0x4a, 0x08, 0x12, 0x28, 0x43, 0x9a, 0x50, 0x9d, 0xb6, 0x39, 0xa4, 0xe6, 0x4a, 0xd7, 0x12, 0x3c,
-	0xf6, 0x69, 0xef, 0x30, 0x0d, 0x55, 0x68, 0x0e, 0x00, 0x67, 0xea, 0xd0, 0xc0, 0xf9, 0x8b, 0x24,
-	0x0e, 0xce, 0x60, 0xa8, 0xa2, 0x50, 0x2f, 0x60, 0xde, 0xaf, 0x74, 0x2d, 0xdc, 0x12, 0x90, 0xb9,
-	0x3e, 0x4e, 0xd0, 0xbd, 0x1a, 0xcd, 0x55, 0xa9, 0x11, 0x31, 0x7f, 0x7c, 0x60, 0xa9, 0xc3, 0x5a,
-	0x6c, 0xa7, 0x2b, 0xce, 0xcf, 0xb0, 0xb7, 0x49, 0x1f, 0x63, 0xcb, 0x6c, 0xd0, 0xf1, 0x91, 0x83,
-	0xce, 0x42, 0xba, 0xc1, 0x74, 0x58, 0x50, 0x29, 0x55, 0xfc, 0x92, 0x9f, 0xc1, 0xb5, 0x71, 0xfc,
-	0x88, 0xaa, 0x5d, 0x82, 0xd9, 0x3d, 0x87, 0x5a, 0xb6, 0xa9, 0xb9, 0xfe, 0x3e, 0xf3, 0x93, 0x52,
-	0x33, 0x7c, 0x8d, 0xa9, 0xc8, 0xdb, 0xb0, 0x1a, 0x6b, 0xf0, 0x41, 0xdb, 0xf3, 0xb0, 0x4d, 0x99,
-	0xd0, 0x04, 0x88, 0x4f, 0xaa, 0x43, 0xbf, 0x39, 0x11, 0x5e, 0x98, 0xa4, 0x14, 0x4d, 0x72, 0x28,
-	0xec, 0xa9, 0xe1, 0xb0, 0x7f, 0x29, 0xc1, 0x87, 0xcc, 0xd1, 0xa6, 0x41, 0xad, 0x3d, 0x3c, 0x44,
-	0x37, 0x83, 0x25, 0x4f, 0x72, 0x75, 0x5c, 0xf8, 0xfd, 0xbb, 0x04, 0xd7, 0xc7, 0x8b, 0xe7, 0x18,
-	0x69, 0xf0, 0x47, 0x16, 0x6d, 0x6c, 0x63, 0xaa, 0x7f, 0xab, 0x34, 0xb8, 0x2c, 0x0e, 0x26, 0x4b,
-	0x4c, 0xa7, 0xb8, 0xd6, 0x57, 0x58, 0xf9, 0x8e, 0x60, 0xc9, 0xa1, 0xed, 0xd1, 0x3d, 0x96, 0x7f,
-	0x23, 0xc1, 0xd5, 0x58, 0xa4, 0xc4, 0x10, 0xd5, 0x18, 0xe7, 0xe5, 0xb8, 0xfa, 0xf8, 0x1f, 0x29,
-	0xe1, 0x3c, 0xc4, 0x91, 0x92, 0x07, 0xe7, 0x23, 0xa4, 0xe4, 0x78, 0x31, 0xf4, 0x74, 0xe7, 0x40,
-	0x7a, 0x72, 0xe2, 0x4c, 0xab, 0xe7, 0x42, 0xa2, 0xea, 0x13, 0x38, 0xbe, 0xbe, 0x7e, 0x0a, 0xe7,
-	0x87, 0x09, 0x37, 0xa8, 0xf8, 0x0d, 0x78, 0x5f, 0x04, 0xab, 0xd1, 0x8e, 0xd6, 0xd0, 0x49, 0x23,
-	0x52, 0xf7, 0x05, 0xb1, 0xf5, 0xbc, 0xf3, 0x58, 0x27, 0x0d, 0xff, 0xd4, 0xbf, 0x8c, 0xbb, 0x67,
-	0x7a, 0x65, 0xda, 0x81, 0xb9, 0x7e, 0xee, 0x16, 0x37, 0xdc, 0x64, 0xd4, 0x9d, 0xed, 0xa3, 0x6e,
-	0xf9, 0xb7, 0x69, 0x38, 0x13, 0xef, 0x6e, 0x1b, 0xd2, 0x1c, 0x2a, 0xcc, 0xcd, 0x6c, 0xf9, 0xce,
-	0x37, 0xaf, 0x0b, 0x25, 0xd3, 0xa2, 0x8d, 0x76, 0xb5, 0x68, 0x38, 0x2d, 0x45, 0x38, 0x35, 0x1a,
-	0xba, 0x65, 0x07, 0x3f, 0x14, 0xda, 0x75, 0x31, 0x29, 0x96, 0x9f, 0x54, 0x6e, 0x6d, 0xdc, 0xac,
-	0xb4, 0xab, 0x9f, 0xe1, 0xae, 0x7a, 0xb2, 0xea, 0x83, 0x0b, 0x7d, 0x0e, 0x73, 0x21, 0xf8, 0x9a,
-	0x16, 0xf1, 0x19, 0x79, 0xfa, 0x08, 0x66, 0x33, 0x02, 0xb5, 0x4f, 0x2d, 0x86, 0xec, 0x59, 0x42,
-	0x75, 0x8f, 0x6a, 0xe2, 0x8c, 0x4c, 0x73, 0xa6, 0x63, 0x6b, 0xfc, 0x20, 0xa1, 0x65, 0x00, 0x6c,
-	0xd7, 0x02, 0x81, 0x14, 0x13, 0x98, 0xc1, 0xb6, 0x38, 0x67, 0x68, 0x09, 0x66, 0xa8, 0x43, 0xf5,
-	0xa6, 0x46, 0x74, 0x9a, 0x3b, 0xc9, 0x76, 0x4f, 0xb1, 0x85, 0x1d, 0x9d, 0xa2, 0xcb, 0x30, 0x17,
-	0x6d, 0x23, 0xee, 0xe4, 0xd2, 0xac, 0x83, 0xb3, 0x61, 0x07, 0x71, 0x07, 0x5d, 0x81, 0x79, 0xd2,
-	0xd4, 0x49, 0x23, 0x22, 0xf6, 0x1e, 0x13, 0xcb, 0x06, 0xcb, 0x5c, 0xee, 0x36, 0x9c, 0x0b, 0xa1,
-	0xce, 0xb6, 0x34, 0x62, 0x99, 0x4c, 0xfe, 0x14, 0x93, 0x5f, 0xec, 0x6d, 0xef, 0xf8, 0xbb, 0x3b,
-	0x96, 0xe9, 0xab, 0xbd, 0x80, 0xac, 0xe1, 0xec, 0x61, 0x5b, 0xb7, 0xa9, 0x2f, 0x4f, 0x72, 0x33,
-	0xec, 0x64, 0xdc, 0x4c, 0xe8, 0xfe, 0x03, 0x21, 0xbb, 0x59, 0xd3, 0x5d, 0xdf, 0x92, 0x65, 0xda,
-	0x3a, 0x6d, 0x7b, 0x98, 0xa8, 0xb3, 0x81, 0x99, 0x1d, 0xcb, 0x24, 0xe8, 0x3a, 0xa0, 0x20, 0x37,
-	0xa7, 0x4d, 0xdd, 0x36, 0xd5, 0xac, 0x5a, 0x27, 0x07, 0xec, 0xab, 0x3a, 0x40, 0xe8, 0x33, 0xb6,
-	0xf1, 0xa4, 0xc6, 0xee, 0x53, 0x9d, 0x31, 0x73, 0x2e, 0x73, 0x51, 0x5a, 0x3d, 0xa5, 0x8a, 0x5f,
-	0xa8, 0x00, 0x19, 0xfe, 0x25, 0xa3, 0xd5, 0x30, 0x31, 0x72, 0xb3, 0x9c, 0x58, 0xf8, 0xd2, 0x16,
-	0x26, 0x06, 0xfa, 0x00, 0xe6, 0xda, 0x76, 0xd5, 0xb1, 0x6b, 0xac, 0x3a, 0x56, 0x0b, 0xe7, 0xb2,
-	0xcc, 0x45, 0xb6, 0xb7, 0xfa, 0xdc, 0x6a, 0x61, 0x64, 0xc0, 0x99, 0xb6, 0x1d, 0x22, 0x5c, 0xf3,
-	0x04, 0x1a, 0x73, 0x73, 0x0c, 0xea, 0xc5, 0x64, 0xa8, 0xbf, 0x88, 0xa8, 0xf5, 0xc0, 0xbe, 0xd8,
-	0x8e, 0x59, 0xf5, 0x63, 0xe1, 0x1f, 0xf4, 0x5a, 0xf0, 0x88, 0x98, 0xe7, 0xb1, 0xf0, 0x55, 0xf1,
-	0x64, 0x90, 0xbf, 0x98, 0x86, 0x73, 0x09, 0x86, 0xd1, 0x2a, 0x2c, 0x44, 0xd2, 0xe9, 0x44, 0x4e,
-	0x75, 0x98, 0x26, 0xef, 0xf6, 0xf7, 0x61, 0x29, 0xec, 0x76, 0xa8, 0x13, 0x74, 0x7c, 0x8a, 0x29,
-	0xe5, 0x7a, 0x22, 0x2f, 0x02, 0x09, 0xd1, 0x75, 0x03, 0x96, 0x7a, 0x5d, 0xef, 0xd7, 0x66, 0x67,
-	0x68, 0x9a, 0x61, 0xe0, 0x72, 0x42, 0x59, 0x7a, 0x4d, 0x7f, 0x62, 0xd7, 0x1d, 0x35, 0x17, 0x18,
-	0x8a, 0xfa, 0x60, 0xc7, 0x27, 0x06, 0xb9, 0xa9, 0x38, 0xe4, 0x7e, 0x0c, 0xf9, 0x01, 0xe4, 0x46,
-	0x53, 0x39, 0xc9, 0x54, 0xce, 0xf5, 0x83, 0x37, 0xcc, 0xa4, 0x0e, 0x67, 0x43, 0xfc, 0x46, 0x74,
-	0x49, 0x2e, 0x7d, 0x48, 0x20, 0x2f, 0xf6, 0x80, 0x1c, 0x7a, 0x22, 0xb2, 0x01, 0x85, 0x03, 0x6e,
-	0x05, 0x74, 0x1f, 0x52, 0x35, 0xdc, 0x3c, 0xdc, 0xa7, 0x2f, 0xd3, 0x94, 0xdf, 0xa5, 0x20, 0x97,
-	0xf8, 0x1a, 0x79, 0x08, 0x19, 0xff, 0x14, 0x78, 0x96, 0x1b, 0x61, 0xe9, 0xef, 0x04, 0x97, 0x4b,
-	0xe8, 0x81, 0xdf, 0x2c, 0x5b, 0xa1, 0xa8, 0x1a, 0xd5, 0x43, 0xdb, 0x00, 0x86, 0xd3, 0x6a, 0x59,
-	0x84, 0x04, 0x57, 0xd4, 0x4c, 0xf9, 0xc6, 0x37, 0xaf, 0x0b, 0x4b, 0xdc, 0x10, 0xa9, 0xed, 0x16,
-	0x2d, 0x47, 0x69, 0xe9, 0xb4, 0x51, 0x7c, 0x8a, 0x4d, 0xdd, 0xe8, 0x6e, 0x61, 0xe3, 0xeb, 0x2f,
-	0x6e, 0x80, 0xf0, 0xb3, 0x85, 0x0d, 0x35, 0x62, 0x00, 0xdd, 0x05, 0x10, 0x79, 0xfa, 0x9c, 0x3e,
-	0xcd, 0x82, 0x2a, 0x04, 0x41, 0xf1, 0xa1, 0x45, 0xb1, 0x37, 0xb4, 0x28, 0x0a, 0x96, 0x9d, 0x11,
-	0x2a, 0x95, 0xdd, 0xc8, 0x7d, 0x90, 0x3a, 0x8e, 0xfb, 0xe0, 0x23, 0x98, 0x76, 0x1d, 0x97, 0x81,
-	0x26, 0x53, 0x5a, 0x4d, 0x7a, 0x85, 0x7b, 0x8e, 0x53, 0x7f, 0x56, 0xaf, 0x38, 0x84, 0x60, 0x96,
-	0x85, 0xea, 0x2b, 0xf9, 0x78, 0x6d, 0xe9, 0x84, 0x62, 0x4f, 0x73, 0xdb, 0x55, 0xcd, 0xd3, 0xed,
-	0x9a, 0x20, 0xe4, 0x2c, 0x5f, 0xae, 0xb4, 0xab, 0xaa, 0x6e, 0xd7, 0xd0, 0x1a, 0x2c, 0x78, 0xd8,
-	0xb4, 0xfc, 0x25, 0x5c, 0xd3, 0xb0, 0xeb, 0x18, 0x0d, 0x46, 0xc9, 0x29, 0x75, 0x3e, 0x5c, 0x7f,
-	0xe8, 0x2f, 0xa3, 0x0d, 0x38, 0xcb, 0x40, 0x89, 0x6b, 0x5a, 0x50, 0x25, 0x71, 0x55, 0x9c, 0x62,
-	0x0a, 0x8b, 0x62, 0xb7, 0xcc, 0x37, 0xc5, 0xad, 0xe1, 0x93, 0x67, 0xa0, 0x45, 0x8d, 0x40, 0x63,
-	0x86, 0x69, 0x2c, 0x04, 0x1a, 0xd4, 0x10, 0xd2, 0xe1, 0x37, 0x1c, 0x8c, 0xfc, 0x4e, 0xcf, 0x0c,
-	0x7d, 0xa7, 0x97, 0x7e, 0x77, 0x1a, 0x4e, 0xb2, 0x4f, 0x03, 0xf4, 0x0b, 0x09, 0xd2, 0x7c, 0x38,
-	0x81, 0xd6, 0x12, 0xaa, 0x36, 0x3c, 0xa3, 0xc9, 0x5f, 0x1b, 0x47, 0x94, 0xc3, 0x57, 0xfe, 0xe0,
-	0xe7, 0x7f, 0xfb, 0xd7, 0xaf, 0xa7, 0x0a, 0x68, 0x59, 0x19, 0x35, 0x5b, 0x42, 0x7f, 0x94, 0x60,
-	0x7e, 0x60, 0xca, 0x82, 0x4a, 0x07, 0xbb, 0x19, 0x9c, 0xe5, 0xe4, 0x6f, 0x4d, 0xa4, 0x23, 0x62,
-	0x54, 0x58, 0x8c, 0x6b, 0xe8, 0xea, 0xc8, 0x18, 0x95, 0x57, 0x82, 0xe0, 0xf7, 0xd1, 0x9f, 0x24,
-	0x38, 0x3d, 0xf4, 0x9a, 0x40, 0x1b, 0xa3, 0x7c, 0x27, 0x4d, 0x79, 0xf2, 0xb7, 0x27, 0xd4, 0x12,
-	0x31, 0xaf, 0xb3, 0x98, 0x3f, 0x44, 0x6b, 0x09, 0x31, 0x0f, 0xbf, 0x63, 0xd0, 0xd7, 0x12, 0x2c,
-	0x0c, 0x1a, 0x44, 0xb7, 0x26, 0x71, 0x1f, 0xc4, 0xbc, 0x31, 0x99, 0x92, 0x08, 0x79, 0x87, 0x85,
-	0xbc, 0x8d, 0x3e, 0x1b, 0x3b, 0x64, 0xe5, 0x55, 0xdf, 0x13, 0x63, 0x7f, 0x58, 0x04, 0xfd, 0x5e,
-	0x82, 0xb9, 0xfe, 0xf1, 0x04, 0x5a, 0x1f, 0x15, 0x5d, 0xec, 0xd4, 0x25, 0x5f, 0x9a, 0x44, 0x45,
-	0xa4, 0x53, 0x64, 0xe9, 0xac, 0xa2, 0x2b, 0x4a, 0xe2, 0x44, 0x34, 0xfa, 0xf6, 0x40, 0xff, 0x96,
-	0xa0, 0x70, 0xc0, 0x43, 0x14, 0x95, 0x47, 0xc5, 0x31, 0xde, 0xab, 0x3a, 0xff, 0xe0, 0x48, 0x36,
-	0x44, 0x72, 0x1f, 0xb1, 0xe4, 0x36, 0x50, 0x69, 0x82, 0x5e, 0x71, 0x02, 0xda, 0x47, 0xff, 0x93,
-	0x60, 0x79, 0xe4, 0x28, 0x04, 0xdd, 0x9f, 0x04, 0x3f, 0x71, 0xd3, 0x9a, 0xfc, 0xe6, 0x11, 0x2c,
-	0x88, 0x14, 0x2b, 0x2c, 0xc5, 0x4f, 0xd1, 0xe3, 0xc3, 0xc3, 0x91, 0x31, 0x6c, 0x98, 0xf8, 0x7f,
-	0x25, 0xb8, 0x30, 0x6a, 0xc6, 0x82, 0xee, 0x4d, 0x12, 0x75, 0xcc, 0xb0, 0x27, 0x7f, 0xff, 0xf0,
-	0x06, 0x44, 0xd6, 0x8f, 0x58, 0xd6, 0x9b, 0xe8, 0xde, 0x11, 0xb3, 0x66, 0x8c, 0x3d, 0x30, 0x5f,
-	0x18, 0xcd, 0xd8, 0xf1, 0xb3, 0x8a, 0xd1, 0x8c, 0x9d, 0x30, 0xc0, 0x38, 0x90, 0xb1, 0xf5, 0x40,
-	0x4f, 0xdc, 0xa2, 0xe8, 0x9d, 0x04, 0x4b, 0x23, 0xa6, 0x07, 0xe8, 0xee, 0x24, 0x85, 0x8d, 0x21,
-	0x90, 0x7b, 0x87, 0xd6, 0x17, 0x19, 0x6d, 0xb3, 0x8c, 0x1e, 0xa1, 0x87, 0x87, 0xef, 0x4b, 0x94,
-	0x6c, 0xfe, 0x2c, 0x41, 0xb6, 0x8f, 0xb7, 0xd0, 0xcd, 0xb1, 0x29, 0x2e, 0xc8, 0x69, 0x7d, 0x02,
-	0x0d, 0x91, 0xc5, 0x16, 0xcb, 0xe2, 0x2e, 0xfa, 0xde, 0x78, 0x9c, 0xa8, 0xbc, 0x8a, 0x19, 0x68,
-	0xec, 0x97, 0x9f, 0x7e, 0xf9, 0x66, 0x45, 0xfa, 0xea, 0xcd, 0x8a, 0xf4, 0xcf, 0x37, 0x2b, 0xd2,
-	0xaf, 0xde, 0xae, 0x9c, 0xf8, 0xea, 0xed, 0xca, 0x89, 0x7f, 0xbc, 0x5d, 0x39, 0xf1, 0xe3, 0x03,
-	0x3f, 0x11, 0x3b, 0x51, 0x87, 0xec, 0x7b, 0xb1, 0x9a, 0x66, 0xff, 0x6e, 0xba, 0xf5, 0xff, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xf5, 0xa2, 0xf3, 0xc9, 0xdc, 0x1b, 0x00, 0x00,
+// QuerySlashingRateChangeReportsRequest is the request type for the
+// Query/SlashingRateChangeReports RPC method.
+type QuerySlashingRateChangeReportsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QuerySlashingRateChangeReportsRequest) Reset()         { *m = QuerySlashingRateChangeReportsRequest{} }
+func (m *QuerySlashingRateChangeReportsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashingRateChangeReportsRequest) ProtoMessage()    {}
+func (*QuerySlashingRateChangeReportsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{32}
+}
+func (m *QuerySlashingRateChangeReportsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashingRateChangeReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashingRateChangeReportsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashingRateChangeReportsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashingRateChangeReportsRequest.Merge(m, src)
+}
+func (m *QuerySlashingRateChangeReportsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashingRateChangeReportsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashingRateChangeReportsRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QuerySlashingRateChangeReportsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ParamsByVersion queries the parameters of the module for a specific version of past params.
-	ParamsByVersion(ctx context.Context, in *QueryParamsByVersionRequest, opts ...grpc.CallOption) (*QueryParamsByVersionResponse, error)
-	// FinalityProviders queries all finality providers
-	FinalityProviders(ctx context.Context, in *QueryFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersResponse, error)
-	// FinalityProvider info about one finality provider
-	FinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error)
-	// BTCDelegations queries all BTC delegations under a given status
-	BTCDelegations(ctx context.Context, in *QueryBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsResponse, error)
-	// ActiveFinalityProvidersAtHeight queries finality providers with non zero voting power at given height.
-	ActiveFinalityProvidersAtHeight(ctx context.Context, in *QueryActiveFinalityProvidersAtHeightRequest, opts ...grpc.CallOption) (*QueryActiveFinalityProvidersAtHeightResponse, error)
-	// FinalityProviderPowerAtHeight queries the voting power of a finality provider at a given height
-	FinalityProviderPowerAtHeight(ctx context.Context, in *QueryFinalityProviderPowerAtHeightRequest, opts ...grpc.CallOption) (*QueryFinalityProviderPowerAtHeightResponse, error)
-	// FinalityProviderCurrentPower queries the voting power of a finality provider at the current height
-	FinalityProviderCurrentPower(ctx context.Context, in *QueryFinalityProviderCurrentPowerRequest, opts ...grpc.CallOption) (*QueryFinalityProviderCurrentPowerResponse, error)
-	// ActivatedHeight queries the height when BTC staking protocol is activated, i.e., the first height when
-	// there exists 1 finality provider with voting power
-	ActivatedHeight(ctx context.Context, in *QueryActivatedHeightRequest, opts ...grpc.CallOption) (*QueryActivatedHeightResponse, error)
-	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
-	FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error)
-	// BTCDelegation retrieves delegation by corresponding staking tx hash
-	BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error)
+func (m *QuerySlashingRateChangeReportsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QuerySlashingRateChangeReportsResponse is the response type for the
+// Query/SlashingRateChangeReports RPC method.
+type QuerySlashingRateChangeReportsResponse struct {
+	// reports are the reports of slashing rate changes
+	Reports []*SlashingRateChangeReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QuerySlashingRateChangeReportsResponse) Reset() {
+	*m = QuerySlashingRateChangeReportsResponse{}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySlashingRateChangeReportsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashingRateChangeReportsResponse) ProtoMessage()    {}
+func (*QuerySlashingRateChangeReportsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{33}
+}
+func (m *QuerySlashingRateChangeReportsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashingRateChangeReportsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashingRateChangeReportsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySlashingRateChangeReportsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashingRateChangeReportsResponse.Merge(m, src)
+}
+func (m *QuerySlashingRateChangeReportsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashingRateChangeReportsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashingRateChangeReportsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ParamsByVersion(ctx context.Context, in *QueryParamsByVersionRequest, opts ...grpc.CallOption) (*QueryParamsByVersionResponse, error) {
-	out := new(QueryParamsByVersionResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ParamsByVersion", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySlashingRateChangeReportsResponse proto.InternalMessageInfo
+
+func (m *QuerySlashingRateChangeReportsResponse) GetReports() []*SlashingRateChangeReport {
+	if m != nil {
+		return m.Reports
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) FinalityProviders(ctx context.Context, in *QueryFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersResponse, error) {
-	out := new(QueryFinalityProvidersResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySlashingRateChangeReportsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) FinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error) {
-	out := new(QueryFinalityProviderResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QuerySlashingRateChangeReportRequest is the request type for the
+// Query/SlashingRateChangeReport RPC method.
+type QuerySlashingRateChangeReportRequest struct {
+	// params_version is the version of the params changing the slashing rate
+	ParamsVersion uint32 `protobuf:"varint,1,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
 }
 
-func (c *queryClient) BTCDelegations(ctx context.Context, in *QueryBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsResponse, error) {
-	out := new(QueryBTCDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySlashingRateChangeReportRequest) Reset()         { *m = QuerySlashingRateChangeReportRequest{} }
+func (m *QuerySlashingRateChangeReportRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashingRateChangeReportRequest) ProtoMessage()    {}
+func (*QuerySlashingRateChangeReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{34}
+}
+func (m *QuerySlashingRateChangeReportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashingRateChangeReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashingRateChangeReportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySlashingRateChangeReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashingRateChangeReportRequest.Merge(m, src)
+}
+func (m *QuerySlashingRateChangeReportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashingRateChangeReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashingRateChangeReportRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ActiveFinalityProvidersAtHeight(ctx context.Context, in *QueryActiveFinalityProvidersAtHeightRequest, opts ...grpc.CallOption) (*QueryActiveFinalityProvidersAtHeightResponse, error) {
-	out := new(QueryActiveFinalityProvidersAtHeightResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ActiveFinalityProvidersAtHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySlashingRateChangeReportRequest proto.InternalMessageInfo
+
+func (m *QuerySlashingRateChangeReportRequest) GetParamsVersion() uint32 {
+	if m != nil {
+		return m.ParamsVersion
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) FinalityProviderPowerAtHeight(ctx context.Context, in *QueryFinalityProviderPowerAtHeightRequest, opts ...grpc.CallOption) (*QueryFinalityProviderPowerAtHeightResponse, error) {
-	out := new(QueryFinalityProviderPowerAtHeightResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderPowerAtHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QuerySlashingRateChangeReportResponse is the response type for the
+// Query/SlashingRateChangeReport RPC method.
+type QuerySlashingRateChangeReportResponse struct {
+	// report is the report of the slashing rate change
+	Report *SlashingRateChangeReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
 }
 
-func (c *queryClient) FinalityProviderCurrentPower(ctx context.Context, in *QueryFinalityProviderCurrentPowerRequest, opts ...grpc.CallOption) (*QueryFinalityProviderCurrentPowerResponse, error) {
-	out := new(QueryFinalityProviderCurrentPowerResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderCurrentPower", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySlashingRateChangeReportResponse) Reset()         { *m = QuerySlashingRateChangeReportResponse{} }
+func (m *QuerySlashingRateChangeReportResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashingRateChangeReportResponse) ProtoMessage()    {}
+func (*QuerySlashingRateChangeReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{35}
+}
+func (m *QuerySlashingRateChangeReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashingRateChangeReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashingRateChangeReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySlashingRateChangeReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashingRateChangeReportResponse.Merge(m, src)
+}
+func (m *QuerySlashingRateChangeReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashingRateChangeReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashingRateChangeReportResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ActivatedHeight(ctx context.Context, in *QueryActivatedHeightRequest, opts ...grpc.CallOption) (*QueryActivatedHeightResponse, error) {
-	out := new(QueryActivatedHeightResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ActivatedHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySlashingRateChangeReportResponse proto.InternalMessageInfo
+
+func (m *QuerySlashingRateChangeReportResponse) GetReport() *SlashingRateChangeReport {
+	if m != nil {
+		return m.Report
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error) {
-	out := new(QueryFinalityProviderDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryScheduledParamsRequest is the request type for the
+// Query/ScheduledParams RPC method.
+type QueryScheduledParamsRequest struct {
 }
 
-func (c *queryClient) BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error) {
-	out := new(QueryBTCDelegationResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryScheduledParamsRequest) Reset()         { *m = QueryScheduledParamsRequest{} }
+func (m *QueryScheduledParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledParamsRequest) ProtoMessage()    {}
+func (*QueryScheduledParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{36}
+}
+func (m *QueryScheduledParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ParamsByVersion queries the parameters of the module for a specific version of past params.
-	ParamsByVersion(context.Context, *QueryParamsByVersionRequest) (*QueryParamsByVersionResponse, error)
-	// FinalityProviders queries all finality providers
-	FinalityProviders(context.Context, *QueryFinalityProvidersRequest) (*QueryFinalityProvidersResponse, error)
-	// FinalityProvider info about one finality provider
-	FinalityProvider(context.Context, *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error)
-	// BTCDelegations queries all BTC delegations under a given status
-	BTCDelegations(context.Context, *QueryBTCDelegationsRequest) (*QueryBTCDelegationsResponse, error)
-	// ActiveFinalityProvidersAtHeight queries finality providers with non zero voting power at given height.
-	ActiveFinalityProvidersAtHeight(context.Context, *QueryActiveFinalityProvidersAtHeightRequest) (*QueryActiveFinalityProvidersAtHeightResponse, error)
-	// FinalityProviderPowerAtHeight queries the voting power of a finality provider at a given height
-	FinalityProviderPowerAtHeight(context.Context, *QueryFinalityProviderPowerAtHeightRequest) (*QueryFinalityProviderPowerAtHeightResponse, error)
-	// FinalityProviderCurrentPower queries the voting power of a finality provider at the current height
-	FinalityProviderCurrentPower(context.Context, *QueryFinalityProviderCurrentPowerRequest) (*QueryFinalityProviderCurrentPowerResponse, error)
-	// ActivatedHeight queries the height when BTC staking protocol is activated, i.e., the first height when
-	// there exists 1 finality provider with voting power
-	ActivatedHeight(context.Context, *QueryActivatedHeightRequest) (*QueryActivatedHeightResponse, error)
-	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
-	FinalityProviderDelegations(context.Context, *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error)
-	// BTCDelegation retrieves delegation by corresponding staking tx hash
-	BTCDelegation(context.Context, *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error)
+func (m *QueryScheduledParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledParamsRequest.Merge(m, src)
+}
+func (m *QueryScheduledParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledParamsRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryScheduledParamsRequest proto.InternalMessageInfo
+
+// QueryScheduledParamsResponse is the response type for the
+// Query/ScheduledParams RPC method.
+type QueryScheduledParamsResponse struct {
+	// scheduled_params are the params scheduled to take effect in the future,
+	// which is nil if no params are scheduled
+	ScheduledParams *ScheduledParams `protobuf:"bytes,1,opt,name=scheduled_params,json=scheduledParams,proto3" json:"scheduled_params,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryScheduledParamsResponse) Reset()         { *m = QueryScheduledParamsResponse{} }
+func (m *QueryScheduledParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledParamsResponse) ProtoMessage()    {}
+func (*QueryScheduledParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{37}
 }
-func (*UnimplementedQueryServer) ParamsByVersion(ctx context.Context, req *QueryParamsByVersionRequest) (*QueryParamsByVersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ParamsByVersion not implemented")
+func (m *QueryScheduledParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) FinalityProviders(ctx context.Context, req *QueryFinalityProvidersRequest) (*QueryFinalityProvidersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviders not implemented")
+func (m *QueryScheduledParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) FinalityProvider(ctx context.Context, req *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalityProvider not implemented")
+func (m *QueryScheduledParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledParamsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) BTCDelegations(ctx context.Context, req *QueryBTCDelegationsRequest) (*QueryBTCDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegations not implemented")
+func (m *QueryScheduledParamsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ActiveFinalityProvidersAtHeight(ctx context.Context, req *QueryActiveFinalityProvidersAtHeightRequest) (*QueryActiveFinalityProvidersAtHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActiveFinalityProvidersAtHeight not implemented")
+func (m *QueryScheduledParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledParamsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) FinalityProviderPowerAtHeight(ctx context.Context, req *QueryFinalityProviderPowerAtHeightRequest) (*QueryFinalityProviderPowerAtHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderPowerAtHeight not implemented")
+
+var xxx_messageInfo_QueryScheduledParamsResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledParamsResponse) GetScheduledParams() *ScheduledParams {
+	if m != nil {
+		return m.ScheduledParams
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) FinalityProviderCurrentPower(ctx context.Context, req *QueryFinalityProviderCurrentPowerRequest) (*QueryFinalityProviderCurrentPowerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderCurrentPower not implemented")
+
+// QueryStakingTxTemplateRequest is the request type for the
+// Query/StakingTxTemplate RPC method.
+type QueryStakingTxTemplateRequest struct {
+	// staker_btc_pk_hex is the hex str of the staker's BTC PK
+	StakerBtcPkHex string `protobuf:"bytes,1,opt,name=staker_btc_pk_hex,json=stakerBtcPkHex,proto3" json:"staker_btc_pk_hex,omitempty"`
+	// fp_btc_pk_hex_list is the list of hex strs of the BTC PKs of the finality
+	// providers to stake with
+	FpBtcPkHexList []string `protobuf:"bytes,2,rep,name=fp_btc_pk_hex_list,json=fpBtcPkHexList,proto3" json:"fp_btc_pk_hex_list,omitempty"`
+	// staking_value is the amount of satoshis to stake
+	StakingValue int64 `protobuf:"varint,3,opt,name=staking_value,json=stakingValue,proto3" json:"staking_value,omitempty"`
+	// staking_time is the timelock of the staking output in BTC blocks
+	StakingTime uint32 `protobuf:"varint,4,opt,name=staking_time,json=stakingTime,proto3" json:"staking_time,omitempty"`
+	// unbonding_time is the timelock of the unbonding output in BTC blocks.
+	// If zero, the minimum unbonding time allowed by the parameters is used.
+	UnbondingTime uint32 `protobuf:"varint,5,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
+	// unbonding_fee_sat is the fee of the unbonding tx in satoshis
+	UnbondingFeeSat int64 `protobuf:"varint,6,opt,name=unbonding_fee_sat,json=unbondingFeeSat,proto3" json:"unbonding_fee_sat,omitempty"`
+}
+
+func (m *QueryStakingTxTemplateRequest) Reset()         { *m = QueryStakingTxTemplateRequest{} }
+func (m *QueryStakingTxTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingTxTemplateRequest) ProtoMessage()    {}
+func (*QueryStakingTxTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{38}
+}
+func (m *QueryStakingTxTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ActivatedHeight(ctx context.Context, req *QueryActivatedHeightRequest) (*QueryActivatedHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActivatedHeight not implemented")
+func (m *QueryStakingTxTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingTxTemplateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) FinalityProviderDelegations(ctx context.Context, req *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderDelegations not implemented")
+func (m *QueryStakingTxTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingTxTemplateRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) BTCDelegation(ctx context.Context, req *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegation not implemented")
+func (m *QueryStakingTxTemplateRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryStakingTxTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingTxTemplateRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryStakingTxTemplateRequest proto.InternalMessageInfo
+
+func (m *QueryStakingTxTemplateRequest) GetStakerBtcPkHex() string {
+	if m != nil {
+		return m.StakerBtcPkHex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ParamsByVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsByVersionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ParamsByVersion(ctx, in)
+func (m *QueryStakingTxTemplateRequest) GetFpBtcPkHexList() []string {
+	if m != nil {
+		return m.FpBtcPkHexList
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/ParamsByVersion",
+	return nil
+}
+
+func (m *QueryStakingTxTemplateRequest) GetStakingValue() int64 {
+	if m != nil {
+		return m.StakingValue
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ParamsByVersion(ctx, req.(*QueryParamsByVersionRequest))
+	return 0
+}
+
+func (m *QueryStakingTxTemplateRequest) GetStakingTime() uint32 {
+	if m != nil {
+		return m.StakingTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_FinalityProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalityProvidersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryStakingTxTemplateRequest) GetUnbondingTime() uint32 {
+	if m != nil {
+		return m.UnbondingTime
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalityProviders(ctx, in)
+	return 0
+}
+
+func (m *QueryStakingTxTemplateRequest) GetUnbondingFeeSat() int64 {
+	if m != nil {
+		return m.UnbondingFeeSat
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviders",
+	return 0
+}
+
+// QueryStakingTxTemplateResponse is the response type for the
+// Query/StakingTxTemplate RPC method.
+type QueryStakingTxTemplateResponse struct {
+	Template *StakingTxTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (m *QueryStakingTxTemplateResponse) Reset()         { *m = QueryStakingTxTemplateResponse{} }
+func (m *QueryStakingTxTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingTxTemplateResponse) ProtoMessage()    {}
+func (*QueryStakingTxTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{39}
+}
+func (m *QueryStakingTxTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakingTxTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingTxTemplateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalityProviders(ctx, req.(*QueryFinalityProvidersRequest))
+}
+func (m *QueryStakingTxTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingTxTemplateResponse.Merge(m, src)
+}
+func (m *QueryStakingTxTemplateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakingTxTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingTxTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakingTxTemplateResponse proto.InternalMessageInfo
+
+func (m *QueryStakingTxTemplateResponse) GetTemplate() *StakingTxTemplate {
+	if m != nil {
+		return m.Template
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_FinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalityProviderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// StakingTxTemplate contains the unsigned transactions and the message that a
+// wallet needs for staking.
+// The staking tx has no inputs, and has to be funded by the wallet. The
+// previous outpoints of the slashing tx and the unbonding tx are placeholders
+// with zero hashes, which have to be replaced with the staking output of the
+// funded staking tx. Likewise, the previous outpoint of the unbonding slashing
+// tx has to be replaced with the unbonding output of the unbonding tx.
+type StakingTxTemplate struct {
+	// template_version is the version of the template format. It is bumped
+	// whenever the construction of the transactions or the message changes,
+	// so that wallets can detect protocol changes.
+	TemplateVersion uint32 `protobuf:"varint,1,opt,name=template_version,json=templateVersion,proto3" json:"template_version,omitempty"`
+	// params_version is the version of the parameters the template is built
+	// against. The template has to be rebuilt if the parameters change before
+	// the BTC delegation is created.
+	ParamsVersion uint32 `protobuf:"varint,2,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// staking_tx_hex is the hex str of the unsigned staking tx without inputs,
+	// serialized without witness
+	StakingTxHex string `protobuf:"bytes,3,opt,name=staking_tx_hex,json=stakingTxHex,proto3" json:"staking_tx_hex,omitempty"`
+	// staking_output_idx is the index of the staking output in the staking tx
+	StakingOutputIdx uint32 `protobuf:"varint,4,opt,name=staking_output_idx,json=stakingOutputIdx,proto3" json:"staking_output_idx,omitempty"`
+	// staking_output_pk_script_hex is the hex str of the pk script of the
+	// staking output
+	StakingOutputPkScriptHex string `protobuf:"bytes,5,opt,name=staking_output_pk_script_hex,json=stakingOutputPkScriptHex,proto3" json:"staking_output_pk_script_hex,omitempty"`
+	// timelock_script_hex is the hex str of the timelock path script of the
+	// staking output
+	TimelockScriptHex string `protobuf:"bytes,6,opt,name=timelock_script_hex,json=timelockScriptHex,proto3" json:"timelock_script_hex,omitempty"`
+	// unbonding_script_hex is the hex str of the unbonding path script of the
+	// staking output
+	UnbondingScriptHex string `protobuf:"bytes,7,opt,name=unbonding_script_hex,json=unbondingScriptHex,proto3" json:"unbonding_script_hex,omitempty"`
+	// slashing_script_hex is the hex str of the slashing path script of the
+	// staking output
+	SlashingScriptHex string `protobuf:"bytes,8,opt,name=slashing_script_hex,json=slashingScriptHex,proto3" json:"slashing_script_hex,omitempty"`
+	// slashing_tx_hex is the hex str of the unsigned slashing tx spending the
+	// staking output
+	SlashingTxHex string `protobuf:"bytes,9,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
+	// unbonding_tx_hex is the hex str of the unsigned unbonding tx spending the
+	// staking output
+	UnbondingTxHex string `protobuf:"bytes,10,opt,name=unbonding_tx_hex,json=unbondingTxHex,proto3" json:"unbonding_tx_hex,omitempty"`
+	// unbonding_slashing_tx_hex is the hex str of the unsigned slashing tx
+	// spending the unbonding output
+	UnbondingSlashingTxHex string `protobuf:"bytes,11,opt,name=unbonding_slashing_tx_hex,json=unbondingSlashingTxHex,proto3" json:"unbonding_slashing_tx_hex,omitempty"`
+	// msg_create_btc_delegation is the message to submit once the staking tx
+	// is included in BTC. The fields depending on the funded staking tx, the
+	// signatures and the proof of possession have to be filled by the wallet.
+	MsgCreateBtcDelegation *MsgCreateBTCDelegation `protobuf:"bytes,12,opt,name=msg_create_btc_delegation,json=msgCreateBtcDelegation,proto3" json:"msg_create_btc_delegation,omitempty"`
+}
+
+func (m *StakingTxTemplate) Reset()         { *m = StakingTxTemplate{} }
+func (m *StakingTxTemplate) String() string { return proto.CompactTextString(m) }
+func (*StakingTxTemplate) ProtoMessage()    {}
+func (*StakingTxTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{40}
+}
+func (m *StakingTxTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakingTxTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakingTxTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalityProvider(ctx, in)
+}
+func (m *StakingTxTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingTxTemplate.Merge(m, src)
+}
+func (m *StakingTxTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakingTxTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingTxTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakingTxTemplate proto.InternalMessageInfo
+
+func (m *StakingTxTemplate) GetTemplateVersion() uint32 {
+	if m != nil {
+		return m.TemplateVersion
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProvider",
+	return 0
+}
+
+func (m *StakingTxTemplate) GetParamsVersion() uint32 {
+	if m != nil {
+		return m.ParamsVersion
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalityProvider(ctx, req.(*QueryFinalityProviderRequest))
+	return 0
+}
+
+func (m *StakingTxTemplate) GetStakingTxHex() string {
+	if m != nil {
+		return m.StakingTxHex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_BTCDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBTCDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *StakingTxTemplate) GetStakingOutputIdx() uint32 {
+	if m != nil {
+		return m.StakingOutputIdx
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).BTCDelegations(ctx, in)
+	return 0
+}
+
+func (m *StakingTxTemplate) GetStakingOutputPkScriptHex() string {
+	if m != nil {
+		return m.StakingOutputPkScriptHex
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegations",
+	return ""
+}
+
+func (m *StakingTxTemplate) GetTimelockScriptHex() string {
+	if m != nil {
+		return m.TimelockScriptHex
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BTCDelegations(ctx, req.(*QueryBTCDelegationsRequest))
+	return ""
+}
+
+func (m *StakingTxTemplate) GetUnbondingScriptHex() string {
+	if m != nil {
+		return m.UnbondingScriptHex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ActiveFinalityProvidersAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryActiveFinalityProvidersAtHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ActiveFinalityProvidersAtHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/ActiveFinalityProvidersAtHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ActiveFinalityProvidersAtHeight(ctx, req.(*QueryActiveFinalityProvidersAtHeightRequest))
+func (m *StakingTxTemplate) GetSlashingScriptHex() string {
+	if m != nil {
+		return m.SlashingScriptHex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_FinalityProviderPowerAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalityProviderPowerAtHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalityProviderPowerAtHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderPowerAtHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalityProviderPowerAtHeight(ctx, req.(*QueryFinalityProviderPowerAtHeightRequest))
+func (m *StakingTxTemplate) GetSlashingTxHex() string {
+	if m != nil {
+		return m.SlashingTxHex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_FinalityProviderCurrentPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalityProviderCurrentPowerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalityProviderCurrentPower(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderCurrentPower",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalityProviderCurrentPower(ctx, req.(*QueryFinalityProviderCurrentPowerRequest))
+func (m *StakingTxTemplate) GetUnbondingTxHex() string {
+	if m != nil {
+		return m.UnbondingTxHex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ActivatedHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryActivatedHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ActivatedHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/ActivatedHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ActivatedHeight(ctx, req.(*QueryActivatedHeightRequest))
+func (m *StakingTxTemplate) GetUnbondingSlashingTxHex() string {
+	if m != nil {
+		return m.UnbondingSlashingTxHex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_FinalityProviderDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalityProviderDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalityProviderDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalityProviderDelegations(ctx, req.(*QueryFinalityProviderDelegationsRequest))
+func (m *StakingTxTemplate) GetMsgCreateBtcDelegation() *MsgCreateBTCDelegation {
+	if m != nil {
+		return m.MsgCreateBtcDelegation
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_BTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBTCDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BTCDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BTCDelegation(ctx, req.(*QueryBTCDelegationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryPendingBTCDelegationsRequest is the request type for the
+// Query/PendingBTCDelegations RPC method.
+type QueryPendingBTCDelegationsRequest struct {
+	// covenant_pk_hex is the hex str of the BIP-340 PK of a covenant member.
+	// If set, only the BTC delegations that are not yet fully signed by this
+	// covenant member are returned
+	CovenantPkHex string `protobuf:"bytes,1,opt,name=covenant_pk_hex,json=covenantPkHex,proto3" json:"covenant_pk_hex,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylon.btcstaking.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ParamsByVersion",
-			Handler:    _Query_ParamsByVersion_Handler,
-		},
-		{
-			MethodName: "FinalityProviders",
-			Handler:    _Query_FinalityProviders_Handler,
-		},
-		{
-			MethodName: "FinalityProvider",
-			Handler:    _Query_FinalityProvider_Handler,
-		},
-		{
-			MethodName: "BTCDelegations",
-			Handler:    _Query_BTCDelegations_Handler,
-		},
-		{
-			MethodName: "ActiveFinalityProvidersAtHeight",
-			Handler:    _Query_ActiveFinalityProvidersAtHeight_Handler,
-		},
-		{
-			MethodName: "FinalityProviderPowerAtHeight",
-			Handler:    _Query_FinalityProviderPowerAtHeight_Handler,
-		},
-		{
-			MethodName: "FinalityProviderCurrentPower",
-			Handler:    _Query_FinalityProviderCurrentPower_Handler,
-		},
-		{
-			MethodName: "ActivatedHeight",
-			Handler:    _Query_ActivatedHeight_Handler,
-		},
-		{
-			MethodName: "FinalityProviderDelegations",
-			Handler:    _Query_FinalityProviderDelegations_Handler,
-		},
-		{
-			MethodName: "BTCDelegation",
-			Handler:    _Query_BTCDelegation_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "babylon/btcstaking/v1/query.proto",
+func (m *QueryPendingBTCDelegationsRequest) Reset()         { *m = QueryPendingBTCDelegationsRequest{} }
+func (m *QueryPendingBTCDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingBTCDelegationsRequest) ProtoMessage()    {}
+func (*QueryPendingBTCDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{41}
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingBTCDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingBTCDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingBTCDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingBTCDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingBTCDelegationsRequest.Merge(m, src)
+}
+func (m *QueryPendingBTCDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingBTCDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingBTCDelegationsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryPendingBTCDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingBTCDelegationsRequest) GetCovenantPkHex() string {
+	if m != nil {
+		return m.CovenantPkHex
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingBTCDelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPendingBTCDelegationsResponse is the response type for the
+// Query/PendingBTCDelegations RPC method.
+type QueryPendingBTCDelegationsResponse struct {
+	// work_items are the pending BTC delegations along with the data needed
+	// for signing them
+	WorkItems []*CovenantSigningWorkItem `protobuf:"bytes,1,rep,name=work_items,json=workItems,proto3" json:"work_items,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPendingBTCDelegationsResponse) Reset()         { *m = QueryPendingBTCDelegationsResponse{} }
+func (m *QueryPendingBTCDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingBTCDelegationsResponse) ProtoMessage()    {}
+func (*QueryPendingBTCDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{42}
+}
+func (m *QueryPendingBTCDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingBTCDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingBTCDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryParamsByVersionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPendingBTCDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingBTCDelegationsResponse.Merge(m, src)
 }
-
-func (m *QueryParamsByVersionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingBTCDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingBTCDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingBTCDelegationsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsByVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryPendingBTCDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingBTCDelegationsResponse) GetWorkItems() []*CovenantSigningWorkItem {
+	if m != nil {
+		return m.WorkItems
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryParamsByVersionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingBTCDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsByVersionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CovenantSigningWorkItem contains a pending BTC delegation, i.e., a BTC
+// delegation without covenant quorum, and all data that a covenant member
+// needs for signing it, so that the scripts do not need to be re-derived
+// off-chain.
+type CovenantSigningWorkItem struct {
+	// staking_tx_hash_hex is the hash of the staking tx of the BTC delegation
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+	// btc_delegation is the pending BTC delegation
+	BtcDelegation *BTCDelegationResponse `protobuf:"bytes,2,opt,name=btc_delegation,json=btcDelegation,proto3" json:"btc_delegation,omitempty"`
+	// covenant_pks is the list of PKs of the covenant committee in the
+	// parameters of the BTC delegation
+	CovenantPks []github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,3,rep,name=covenant_pks,json=covenantPks,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"covenant_pks,omitempty"`
+	// covenant_quorum is the minimum number of signatures needed from the
+	// covenant committee in the parameters of the BTC delegation
+	CovenantQuorum uint32 `protobuf:"varint,4,opt,name=covenant_quorum,json=covenantQuorum,proto3" json:"covenant_quorum,omitempty"`
+	// staking_output_pk_script_hex is the hex str of the pk script of the
+	// staking output, which is spent by the slashing tx and the unbonding tx
+	StakingOutputPkScriptHex string `protobuf:"bytes,5,opt,name=staking_output_pk_script_hex,json=stakingOutputPkScriptHex,proto3" json:"staking_output_pk_script_hex,omitempty"`
+	// staking_output_value is the value of the staking output in satoshi
+	StakingOutputValue int64 `protobuf:"varint,6,opt,name=staking_output_value,json=stakingOutputValue,proto3" json:"staking_output_value,omitempty"`
+	// staking_slashing_path_script_hex is the hex str of the slashing path
+	// script of the staking output, which the covenant adaptor signatures on
+	// the slashing tx commit to
+	StakingSlashingPathScriptHex string `protobuf:"bytes,7,opt,name=staking_slashing_path_script_hex,json=stakingSlashingPathScriptHex,proto3" json:"staking_slashing_path_script_hex,omitempty"`
+	// staking_unbonding_path_script_hex is the hex str of the unbonding path
+	// script of the staking output, which the covenant signature on the
+	// unbonding tx commits to
+	StakingUnbondingPathScriptHex string `protobuf:"bytes,8,opt,name=staking_unbonding_path_script_hex,json=stakingUnbondingPathScriptHex,proto3" json:"staking_unbonding_path_script_hex,omitempty"`
+	// unbonding_output_pk_script_hex is the hex str of the pk script of the
+	// unbonding output, which is spent by the unbonding slashing tx
+	UnbondingOutputPkScriptHex string `protobuf:"bytes,9,opt,name=unbonding_output_pk_script_hex,json=unbondingOutputPkScriptHex,proto3" json:"unbonding_output_pk_script_hex,omitempty"`
+	// unbonding_output_value is the value of the unbonding output in satoshi
+	UnbondingOutputValue int64 `protobuf:"varint,10,opt,name=unbonding_output_value,json=unbondingOutputValue,proto3" json:"unbonding_output_value,omitempty"`
+	// unbonding_slashing_path_script_hex is the hex str of the slashing path
+	// script of the unbonding output, which the covenant adaptor signatures on
+	// the unbonding slashing tx commit to
+	UnbondingSlashingPathScriptHex string `protobuf:"bytes,11,opt,name=unbonding_slashing_path_script_hex,json=unbondingSlashingPathScriptHex,proto3" json:"unbonding_slashing_path_script_hex,omitempty"`
+}
+
+func (m *CovenantSigningWorkItem) Reset()         { *m = CovenantSigningWorkItem{} }
+func (m *CovenantSigningWorkItem) String() string { return proto.CompactTextString(m) }
+func (*CovenantSigningWorkItem) ProtoMessage()    {}
+func (*CovenantSigningWorkItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{43}
+}
+func (m *CovenantSigningWorkItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsByVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *CovenantSigningWorkItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CovenantSigningWorkItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *CovenantSigningWorkItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CovenantSigningWorkItem.Merge(m, src)
+}
+func (m *CovenantSigningWorkItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *CovenantSigningWorkItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_CovenantSigningWorkItem.DiscardUnknown(m)
 }
 
-func (m *QueryFinalityProvidersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CovenantSigningWorkItem proto.InternalMessageInfo
+
+func (m *CovenantSigningWorkItem) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryFinalityProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CovenantSigningWorkItem) GetBtcDelegation() *BTCDelegationResponse {
+	if m != nil {
+		return m.BtcDelegation
+	}
+	return nil
 }
 
-func (m *QueryFinalityProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *CovenantSigningWorkItem) GetCovenantQuorum() uint32 {
+	if m != nil {
+		return m.CovenantQuorum
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryFinalityProvidersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CovenantSigningWorkItem) GetStakingOutputPkScriptHex() string {
+	if m != nil {
+		return m.StakingOutputPkScriptHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryFinalityProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CovenantSigningWorkItem) GetStakingOutputValue() int64 {
+	if m != nil {
+		return m.StakingOutputValue
+	}
+	return 0
 }
 
-func (m *QueryFinalityProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FinalityProviders) > 0 {
-		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *CovenantSigningWorkItem) GetStakingSlashingPathScriptHex() string {
+	if m != nil {
+		return m.StakingSlashingPathScriptHex
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryFinalityProviderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CovenantSigningWorkItem) GetStakingUnbondingPathScriptHex() string {
+	if m != nil {
+		return m.StakingUnbondingPathScriptHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryFinalityProviderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CovenantSigningWorkItem) GetUnbondingOutputPkScriptHex() string {
+	if m != nil {
+		return m.UnbondingOutputPkScriptHex
+	}
+	return ""
 }
 
-func (m *QueryFinalityProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FpBtcPkHex) > 0 {
-		i -= len(m.FpBtcPkHex)
-		copy(dAtA[i:], m.FpBtcPkHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
-		i--
-		dAtA[i] = 0xa
+func (m *CovenantSigningWorkItem) GetUnbondingOutputValue() int64 {
+	if m != nil {
+		return m.UnbondingOutputValue
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CovenantSigningWorkItem) GetUnbondingSlashingPathScriptHex() string {
+	if m != nil {
+		return m.UnbondingSlashingPathScriptHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BTCDelegationBundle is a self-contained bundle of a BTC delegation, which
+// carries everything its staker needs for constructing the spends of the
+// staking output and the unbonding output offline, i.e., the BTC txs, the
+// scripts and control blocks of the spend paths, and the covenant signatures
+// on the unbonding tx
+type BTCDelegationBundle struct {
+	// btc_network is the BTC network of the BTC delegation, e.g., "mainnet"
+	BtcNetwork string `protobuf:"bytes,1,opt,name=btc_network,json=btcNetwork,proto3" json:"btc_network,omitempty"`
+	// staking_tx_hash_hex is the hash of the staking tx of the BTC delegation
+	StakingTxHashHex string `protobuf:"bytes,2,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+	// btc_delegation is the BTC delegation, along with its txs and signatures
+	BtcDelegation *BTCDelegationResponse `protobuf:"bytes,3,opt,name=btc_delegation,json=btcDelegation,proto3" json:"btc_delegation,omitempty"`
+	// covenant_pks is the list of PKs of the covenant committee in the
+	// parameters of the BTC delegation
+	CovenantPks []github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,4,rep,name=covenant_pks,json=covenantPks,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"covenant_pks,omitempty"`
+	// covenant_quorum is the minimum number of signatures needed from the
+	// covenant committee in the parameters of the BTC delegation
+	CovenantQuorum uint32 `protobuf:"varint,5,opt,name=covenant_quorum,json=covenantQuorum,proto3" json:"covenant_quorum,omitempty"`
+	// staking_output_pk_script_hex is the hex str of the pk script of the
+	// staking output
+	StakingOutputPkScriptHex string `protobuf:"bytes,6,opt,name=staking_output_pk_script_hex,json=stakingOutputPkScriptHex,proto3" json:"staking_output_pk_script_hex,omitempty"`
+	// staking_output_value is the value of the staking output in satoshi
+	StakingOutputValue int64 `protobuf:"varint,7,opt,name=staking_output_value,json=stakingOutputValue,proto3" json:"staking_output_value,omitempty"`
+	// staking_timelock_path is the spend path of the staking output for
+	// withdrawing once the staking time expires
+	StakingTimelockPath *SpendPath `protobuf:"bytes,8,opt,name=staking_timelock_path,json=stakingTimelockPath,proto3" json:"staking_timelock_path,omitempty"`
+	// staking_unbonding_path is the spend path of the staking output that the
+	// unbonding tx spends with the covenant signatures
+	StakingUnbondingPath *SpendPath `protobuf:"bytes,9,opt,name=staking_unbonding_path,json=stakingUnbondingPath,proto3" json:"staking_unbonding_path,omitempty"`
+	// unbonding_output_pk_script_hex is the hex str of the pk script of the
+	// unbonding output
+	UnbondingOutputPkScriptHex string `protobuf:"bytes,10,opt,name=unbonding_output_pk_script_hex,json=unbondingOutputPkScriptHex,proto3" json:"unbonding_output_pk_script_hex,omitempty"`
+	// unbonding_output_value is the value of the unbonding output in satoshi
+	UnbondingOutputValue int64 `protobuf:"varint,11,opt,name=unbonding_output_value,json=unbondingOutputValue,proto3" json:"unbonding_output_value,omitempty"`
+	// unbonding_timelock_path is the spend path of the unbonding output for
+	// withdrawing once the unbonding time expires
+	UnbondingTimelockPath *SpendPath `protobuf:"bytes,12,opt,name=unbonding_timelock_path,json=unbondingTimelockPath,proto3" json:"unbonding_timelock_path,omitempty"`
+	// slashing_address is the slashing address in the parameters of the BTC
+	// delegation, which the slashing tx pays to. As an address of btc_network,
+	// it binds the bundle to the BTC network
+	SlashingAddress string `protobuf:"bytes,13,opt,name=slashing_address,json=slashingAddress,proto3" json:"slashing_address,omitempty"`
+}
+
+func (m *BTCDelegationBundle) Reset()         { *m = BTCDelegationBundle{} }
+func (m *BTCDelegationBundle) String() string { return proto.CompactTextString(m) }
+func (*BTCDelegationBundle) ProtoMessage()    {}
+func (*BTCDelegationBundle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{44}
+}
+func (m *BTCDelegationBundle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FinalityProvider != nil {
-		{
-			size, err := m.FinalityProvider.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *BTCDelegationBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCDelegationBundle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BTCDelegationBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCDelegationBundle.Merge(m, src)
+}
+func (m *BTCDelegationBundle) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCDelegationBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCDelegationBundle.DiscardUnknown(m)
 }
 
-func (m *QueryBTCDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BTCDelegationBundle proto.InternalMessageInfo
+
+func (m *BTCDelegationBundle) GetBtcNetwork() string {
+	if m != nil {
+		return m.BtcNetwork
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBTCDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BTCDelegationBundle) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
+	}
+	return ""
 }
 
-func (m *QueryBTCDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *BTCDelegationBundle) GetBtcDelegation() *BTCDelegationResponse {
+	if m != nil {
+		return m.BtcDelegation
 	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *BTCDelegationBundle) GetCovenantQuorum() uint32 {
+	if m != nil {
+		return m.CovenantQuorum
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryBTCDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationBundle) GetStakingOutputPkScriptHex() string {
+	if m != nil {
+		return m.StakingOutputPkScriptHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBTCDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BTCDelegationBundle) GetStakingOutputValue() int64 {
+	if m != nil {
+		return m.StakingOutputValue
+	}
+	return 0
 }
 
-func (m *QueryBTCDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BtcDelegations) > 0 {
-		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *BTCDelegationBundle) GetStakingTimelockPath() *SpendPath {
+	if m != nil {
+		return m.StakingTimelockPath
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryFinalityProviderPowerAtHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationBundle) GetStakingUnbondingPath() *SpendPath {
+	if m != nil {
+		return m.StakingUnbondingPath
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFinalityProviderPowerAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BTCDelegationBundle) GetUnbondingOutputPkScriptHex() string {
+	if m != nil {
+		return m.UnbondingOutputPkScriptHex
+	}
+	return ""
 }
 
-func (m *QueryFinalityProviderPowerAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.FpBtcPkHex) > 0 {
-		i -= len(m.FpBtcPkHex)
-		copy(dAtA[i:], m.FpBtcPkHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
-		i--
-		dAtA[i] = 0xa
+func (m *BTCDelegationBundle) GetUnbondingOutputValue() int64 {
+	if m != nil {
+		return m.UnbondingOutputValue
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryFinalityProviderPowerAtHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationBundle) GetUnbondingTimelockPath() *SpendPath {
+	if m != nil {
+		return m.UnbondingTimelockPath
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFinalityProviderPowerAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BTCDelegationBundle) GetSlashingAddress() string {
+	if m != nil {
+		return m.SlashingAddress
+	}
+	return ""
 }
 
-func (m *QueryFinalityProviderPowerAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VotingPower != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// SpendPath is a script path spend of a taproot output
+type SpendPath struct {
+	// script_hex is the hex str of the script of the spend path
+	ScriptHex string `protobuf:"bytes,1,opt,name=script_hex,json=scriptHex,proto3" json:"script_hex,omitempty"`
+	// control_block_hex is the hex str of the control block proving the
+	// inclusion of the script in the taproot output
+	ControlBlockHex string `protobuf:"bytes,2,opt,name=control_block_hex,json=controlBlockHex,proto3" json:"control_block_hex,omitempty"`
 }
 
-func (m *QueryFinalityProviderCurrentPowerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SpendPath) Reset()         { *m = SpendPath{} }
+func (m *SpendPath) String() string { return proto.CompactTextString(m) }
+func (*SpendPath) ProtoMessage()    {}
+func (*SpendPath) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{45}
+}
+func (m *SpendPath) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendPath) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendPath.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryFinalityProviderCurrentPowerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SpendPath) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendPath.Merge(m, src)
+}
+func (m *SpendPath) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendPath) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendPath.DiscardUnknown(m)
 }
 
-func (m *QueryFinalityProviderCurrentPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FpBtcPkHex) > 0 {
-		i -= len(m.FpBtcPkHex)
-		copy(dAtA[i:], m.FpBtcPkHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_SpendPath proto.InternalMessageInfo
+
+func (m *SpendPath) GetScriptHex() string {
+	if m != nil {
+		return m.ScriptHex
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryFinalityProviderCurrentPowerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SpendPath) GetControlBlockHex() string {
+	if m != nil {
+		return m.ControlBlockHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryFinalityProviderCurrentPowerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCovenantMuSig2NoncesRequest is the request type for the
+// Query/CovenantMuSig2Nonces RPC method.
+type QueryCovenantMuSig2NoncesRequest struct {
+	// staking_tx_hash_hex is the hex str of the hash of the staking tx that
+	// identifies the BTC delegation
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
 }
 
-func (m *QueryFinalityProviderCurrentPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VotingPower != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryCovenantMuSig2NoncesRequest) Reset()         { *m = QueryCovenantMuSig2NoncesRequest{} }
+func (m *QueryCovenantMuSig2NoncesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantMuSig2NoncesRequest) ProtoMessage()    {}
+func (*QueryCovenantMuSig2NoncesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{46}
+}
+func (m *QueryCovenantMuSig2NoncesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantMuSig2NoncesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantMuSig2NoncesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCovenantMuSig2NoncesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantMuSig2NoncesRequest.Merge(m, src)
+}
+func (m *QueryCovenantMuSig2NoncesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantMuSig2NoncesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantMuSig2NoncesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryActiveFinalityProvidersAtHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCovenantMuSig2NoncesRequest proto.InternalMessageInfo
+
+func (m *QueryCovenantMuSig2NoncesRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryActiveFinalityProvidersAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCovenantMuSig2NoncesResponse is the response type for the
+// Query/CovenantMuSig2Nonces RPC method.
+type QueryCovenantMuSig2NoncesResponse struct {
+	// nonces is the list of public nonces of each covenant member that has
+	// submitted them
+	Nonces []*CovenantMuSig2Nonces `protobuf:"bytes,1,rep,name=nonces,proto3" json:"nonces,omitempty"`
+	// aggregate_covenant_pk_hex is the hex str of the MuSig2 aggregate key of
+	// the covenant committee, under which the aggregate signatures are
+	// submitted
+	AggregateCovenantPkHex string `protobuf:"bytes,2,opt,name=aggregate_covenant_pk_hex,json=aggregateCovenantPkHex,proto3" json:"aggregate_covenant_pk_hex,omitempty"`
 }
 
-func (m *QueryActiveFinalityProvidersAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryCovenantMuSig2NoncesResponse) Reset()         { *m = QueryCovenantMuSig2NoncesResponse{} }
+func (m *QueryCovenantMuSig2NoncesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantMuSig2NoncesResponse) ProtoMessage()    {}
+func (*QueryCovenantMuSig2NoncesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{47}
+}
+func (m *QueryCovenantMuSig2NoncesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantMuSig2NoncesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantMuSig2NoncesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryCovenantMuSig2NoncesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantMuSig2NoncesResponse.Merge(m, src)
+}
+func (m *QueryCovenantMuSig2NoncesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantMuSig2NoncesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantMuSig2NoncesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCovenantMuSig2NoncesResponse proto.InternalMessageInfo
+
+func (m *QueryCovenantMuSig2NoncesResponse) GetNonces() []*CovenantMuSig2Nonces {
+	if m != nil {
+		return m.Nonces
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryActiveFinalityProvidersAtHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCovenantMuSig2NoncesResponse) GetAggregateCovenantPkHex() string {
+	if m != nil {
+		return m.AggregateCovenantPkHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryActiveFinalityProvidersAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryWatchtowerBackupRequest is the request type for the
+// Query/WatchtowerBackup RPC method.
+type QueryWatchtowerBackupRequest struct {
+	// staking_tx_hash_hex is the hex str of the hash of the staking tx that
+	// identifies the BTC delegation
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
 }
 
-func (m *QueryActiveFinalityProvidersAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FinalityProviders) > 0 {
-		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryWatchtowerBackupRequest) Reset()         { *m = QueryWatchtowerBackupRequest{} }
+func (m *QueryWatchtowerBackupRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWatchtowerBackupRequest) ProtoMessage()    {}
+func (*QueryWatchtowerBackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{48}
+}
+func (m *QueryWatchtowerBackupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWatchtowerBackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWatchtowerBackupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryWatchtowerBackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWatchtowerBackupRequest.Merge(m, src)
+}
+func (m *QueryWatchtowerBackupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWatchtowerBackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWatchtowerBackupRequest.DiscardUnknown(m)
 }
 
-func (m *QueryActivatedHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryWatchtowerBackupRequest proto.InternalMessageInfo
+
+func (m *QueryWatchtowerBackupRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryActivatedHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryWatchtowerBackupResponse is the response type for the
+// Query/WatchtowerBackup RPC method.
+type QueryWatchtowerBackupResponse struct {
+	// watchtower_address is the Babylon address of the watchtower
+	WatchtowerAddress string `protobuf:"bytes,1,opt,name=watchtower_address,json=watchtowerAddress,proto3" json:"watchtower_address,omitempty"`
+	// encrypted_backup is the backup data encrypted for the watchtower. It is
+	// public ciphertext
+	EncryptedBackup []byte `protobuf:"bytes,2,opt,name=encrypted_backup,json=encryptedBackup,proto3" json:"encrypted_backup,omitempty"`
 }
 
-func (m *QueryActivatedHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryWatchtowerBackupResponse) Reset()         { *m = QueryWatchtowerBackupResponse{} }
+func (m *QueryWatchtowerBackupResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWatchtowerBackupResponse) ProtoMessage()    {}
+func (*QueryWatchtowerBackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{49}
 }
-
-func (m *QueryActivatedHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryWatchtowerBackupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWatchtowerBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWatchtowerBackupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryActivatedHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryWatchtowerBackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWatchtowerBackupResponse.Merge(m, src)
+}
+func (m *QueryWatchtowerBackupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWatchtowerBackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWatchtowerBackupResponse.DiscardUnknown(m)
 }
 
-func (m *QueryActivatedHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryWatchtowerBackupResponse proto.InternalMessageInfo
+
+func (m *QueryWatchtowerBackupResponse) GetWatchtowerAddress() string {
+	if m != nil {
+		return m.WatchtowerAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryWatchtowerBackupResponse) GetEncryptedBackup() []byte {
+	if m != nil {
+		return m.EncryptedBackup
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCovenantPerformanceRequest is the request type for the
+// Query/CovenantPerformance RPC method.
+type QueryCovenantPerformanceRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryCovenantPerformanceRequest) Reset()         { *m = QueryCovenantPerformanceRequest{} }
+func (m *QueryCovenantPerformanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantPerformanceRequest) ProtoMessage()    {}
+func (*QueryCovenantPerformanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{50}
+}
+func (m *QueryCovenantPerformanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantPerformanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantPerformanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FpBtcPkHex) > 0 {
-		i -= len(m.FpBtcPkHex)
-		copy(dAtA[i:], m.FpBtcPkHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCovenantPerformanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantPerformanceRequest.Merge(m, src)
+}
+func (m *QueryCovenantPerformanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantPerformanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantPerformanceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCovenantPerformanceRequest proto.InternalMessageInfo
+
+func (m *QueryCovenantPerformanceRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCovenantPerformanceResponse is the response type for the
+// Query/CovenantPerformance RPC method.
+type QueryCovenantPerformanceResponse struct {
+	// covenant_performances are the signing records of the covenant signers
+	CovenantPerformances []*CovenantPerformanceResponse `protobuf:"bytes,1,rep,name=covenant_performances,json=covenantPerformances,proto3" json:"covenant_performances,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BtcDelegatorDelegations) > 0 {
-		for iNdEx := len(m.BtcDelegatorDelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BtcDelegatorDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryCovenantPerformanceResponse) Reset()         { *m = QueryCovenantPerformanceResponse{} }
+func (m *QueryCovenantPerformanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantPerformanceResponse) ProtoMessage()    {}
+func (*QueryCovenantPerformanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{51}
+}
+func (m *QueryCovenantPerformanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantPerformanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCovenantPerformanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantPerformanceResponse.Merge(m, src)
+}
+func (m *QueryCovenantPerformanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantPerformanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantPerformanceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBTCDelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCovenantPerformanceResponse proto.InternalMessageInfo
+
+func (m *QueryCovenantPerformanceResponse) GetCovenantPerformances() []*CovenantPerformanceResponse {
+	if m != nil {
+		return m.CovenantPerformances
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBTCDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCovenantPerformanceResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryBTCDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StakingTxHashHex) > 0 {
-		i -= len(m.StakingTxHashHex)
-		copy(dAtA[i:], m.StakingTxHashHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
-		i--
-		dAtA[i] = 0xa
+// CovenantPerformanceResponse is the signing record of a covenant signer
+type CovenantPerformanceResponse struct {
+	// cov_pk_hex is the hex str of the public key of the covenant signer
+	CovPkHex string `protobuf:"bytes,1,opt,name=cov_pk_hex,json=covPkHex,proto3" json:"cov_pk_hex,omitempty"`
+	// num_signed is the number of BTC delegations signed by the covenant
+	// signer
+	NumSigned uint64 `protobuf:"varint,2,opt,name=num_signed,json=numSigned,proto3" json:"num_signed,omitempty"`
+	// average_signing_blocks is the average number of Babylon blocks between
+	// the creation of a BTC delegation signed by the covenant signer and the
+	// inclusion of its signatures
+	AverageSigningBlocks cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=average_signing_blocks,json=averageSigningBlocks,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"average_signing_blocks"`
+	// num_missed is the number of BTC delegations that reached the covenant
+	// quorum or expired without the signatures of the covenant signer
+	NumMissed uint64 `protobuf:"varint,4,opt,name=num_missed,json=numMissed,proto3" json:"num_missed,omitempty"`
+	// in_committee indicates whether the covenant signer is a covenant signer
+	// under the current parameters
+	InCommittee bool `protobuf:"varint,5,opt,name=in_committee,json=inCommittee,proto3" json:"in_committee,omitempty"`
+}
+
+func (m *CovenantPerformanceResponse) Reset()         { *m = CovenantPerformanceResponse{} }
+func (m *CovenantPerformanceResponse) String() string { return proto.CompactTextString(m) }
+func (*CovenantPerformanceResponse) ProtoMessage()    {}
+func (*CovenantPerformanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{52}
+}
+func (m *CovenantPerformanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CovenantPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CovenantPerformanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CovenantPerformanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CovenantPerformanceResponse.Merge(m, src)
+}
+func (m *CovenantPerformanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CovenantPerformanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CovenantPerformanceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CovenantPerformanceResponse proto.InternalMessageInfo
+
+func (m *CovenantPerformanceResponse) GetCovPkHex() string {
+	if m != nil {
+		return m.CovPkHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CovenantPerformanceResponse) GetNumSigned() uint64 {
+	if m != nil {
+		return m.NumSigned
+	}
+	return 0
 }
 
-func (m *QueryBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BtcDelegation != nil {
-		{
-			size, err := m.BtcDelegation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *CovenantPerformanceResponse) GetNumMissed() uint64 {
+	if m != nil {
+		return m.NumMissed
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *BTCDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CovenantPerformanceResponse) GetInCommittee() bool {
+	if m != nil {
+		return m.InCommittee
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *BTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBTCDelegationTxFeesRequest is the request type for the
+// Query/BTCDelegationTxFees RPC method.
+type QueryBTCDelegationTxFeesRequest struct {
+	// staking_tx_hash_hex is the hash of the staking tx of the BTC delegation
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+	// fee_rate is the fee rate in satoshis per virtual byte
+	FeeRate uint64 `protobuf:"varint,2,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
 }
 
-func (m *BTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ParamsVersion != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
-		i--
-		dAtA[i] = 0x78
-	}
-	if m.UndelegationResponse != nil {
-		{
-			size, err := m.UndelegationResponse.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryBTCDelegationTxFeesRequest) Reset()         { *m = QueryBTCDelegationTxFeesRequest{} }
+func (m *QueryBTCDelegationTxFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationTxFeesRequest) ProtoMessage()    {}
+func (*QueryBTCDelegationTxFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{53}
+}
+func (m *QueryBTCDelegationTxFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationTxFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationTxFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x72
-	}
-	if m.UnbondingTime != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingTime))
-		i--
-		dAtA[i] = 0x68
-	}
-	if len(m.StatusDesc) > 0 {
-		i -= len(m.StatusDesc)
-		copy(dAtA[i:], m.StatusDesc)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StatusDesc)))
-		i--
-		dAtA[i] = 0x62
+		return b[:n], nil
 	}
-	if m.Active {
-		i--
-		if m.Active {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
+}
+func (m *QueryBTCDelegationTxFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationTxFeesRequest.Merge(m, src)
+}
+func (m *QueryBTCDelegationTxFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationTxFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationTxFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationTxFeesRequest proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationTxFeesRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	if m.StakingOutputIdx != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StakingOutputIdx))
-		i--
-		dAtA[i] = 0x50
+	return ""
+}
+
+func (m *QueryBTCDelegationTxFeesRequest) GetFeeRate() uint64 {
+	if m != nil {
+		return m.FeeRate
 	}
-	if len(m.CovenantSigs) > 0 {
-		for iNdEx := len(m.CovenantSigs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CovenantSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
+	return 0
+}
+
+// QueryBTCDelegationTxFeesResponse is the response type for the
+// Query/BTCDelegationTxFees RPC method.
+type QueryBTCDelegationTxFeesResponse struct {
+	// slashing_tx is the fee estimation of the slashing tx spending the
+	// staking output
+	SlashingTx *TxFeeEstimate `protobuf:"bytes,1,opt,name=slashing_tx,json=slashingTx,proto3" json:"slashing_tx,omitempty"`
+	// unbonding_tx is the fee estimation of the unbonding tx
+	UnbondingTx *TxFeeEstimate `protobuf:"bytes,2,opt,name=unbonding_tx,json=unbondingTx,proto3" json:"unbonding_tx,omitempty"`
+	// unbonding_slashing_tx is the fee estimation of the slashing tx spending
+	// the unbonding output
+	UnbondingSlashingTx *TxFeeEstimate `protobuf:"bytes,3,opt,name=unbonding_slashing_tx,json=unbondingSlashingTx,proto3" json:"unbonding_slashing_tx,omitempty"`
+}
+
+func (m *QueryBTCDelegationTxFeesResponse) Reset()         { *m = QueryBTCDelegationTxFeesResponse{} }
+func (m *QueryBTCDelegationTxFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationTxFeesResponse) ProtoMessage()    {}
+func (*QueryBTCDelegationTxFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{54}
+}
+func (m *QueryBTCDelegationTxFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationTxFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationTxFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.DelegatorSlashSigHex) > 0 {
-		i -= len(m.DelegatorSlashSigHex)
-		copy(dAtA[i:], m.DelegatorSlashSigHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashSigHex)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.SlashingTxHex) > 0 {
-		i -= len(m.SlashingTxHex)
-		copy(dAtA[i:], m.SlashingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
-		i--
-		dAtA[i] = 0x3a
+}
+func (m *QueryBTCDelegationTxFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationTxFeesResponse.Merge(m, src)
+}
+func (m *QueryBTCDelegationTxFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationTxFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationTxFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationTxFeesResponse proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationTxFeesResponse) GetSlashingTx() *TxFeeEstimate {
+	if m != nil {
+		return m.SlashingTx
 	}
-	if len(m.StakingTxHex) > 0 {
-		i -= len(m.StakingTxHex)
-		copy(dAtA[i:], m.StakingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHex)))
-		i--
-		dAtA[i] = 0x32
+	return nil
+}
+
+func (m *QueryBTCDelegationTxFeesResponse) GetUnbondingTx() *TxFeeEstimate {
+	if m != nil {
+		return m.UnbondingTx
 	}
-	if m.TotalSat != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSat))
-		i--
-		dAtA[i] = 0x28
+	return nil
+}
+
+func (m *QueryBTCDelegationTxFeesResponse) GetUnbondingSlashingTx() *TxFeeEstimate {
+	if m != nil {
+		return m.UnbondingSlashingTx
 	}
-	if m.EndHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
-		i--
-		dAtA[i] = 0x20
+	return nil
+}
+
+// TxFeeEstimate is the fee estimation of a pre-signed tx of a BTC delegation
+type TxFeeEstimate struct {
+	// virtual_size is the upper bound of the virtual size of the tx once it is
+	// signed via the script path of its input
+	VirtualSize int64 `protobuf:"varint,1,opt,name=virtual_size,json=virtualSize,proto3" json:"virtual_size,omitempty"`
+	// min_fee is the minimum fee in satoshis of the tx at the given fee rate
+	MinFee int64 `protobuf:"varint,2,opt,name=min_fee,json=minFee,proto3" json:"min_fee,omitempty"`
+	// fee is the fee in satoshis that the tx pays
+	Fee int64 `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	// sufficient indicates whether the fee of the tx is no lower than the
+	// minimum fee
+	Sufficient bool `protobuf:"varint,4,opt,name=sufficient,proto3" json:"sufficient,omitempty"`
+}
+
+func (m *TxFeeEstimate) Reset()         { *m = TxFeeEstimate{} }
+func (m *TxFeeEstimate) String() string { return proto.CompactTextString(m) }
+func (*TxFeeEstimate) ProtoMessage()    {}
+func (*TxFeeEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{55}
+}
+func (m *TxFeeEstimate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxFeeEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxFeeEstimate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.StartHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *TxFeeEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxFeeEstimate.Merge(m, src)
+}
+func (m *TxFeeEstimate) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxFeeEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxFeeEstimate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxFeeEstimate proto.InternalMessageInfo
+
+func (m *TxFeeEstimate) GetVirtualSize() int64 {
+	if m != nil {
+		return m.VirtualSize
 	}
-	if len(m.FpBtcPkList) > 0 {
-		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.FpBtcPkList[iNdEx].Size()
-				i -= size
-				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	return 0
+}
+
+func (m *TxFeeEstimate) GetMinFee() int64 {
+	if m != nil {
+		return m.MinFee
 	}
-	if m.BtcPk != nil {
-		{
-			size := m.BtcPk.Size()
-			i -= size
-			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *TxFeeEstimate) GetFee() int64 {
+	if m != nil {
+		return m.Fee
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *BTCUndelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TxFeeEstimate) GetSufficient() bool {
+	if m != nil {
+		return m.Sufficient
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *BTCUndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPauseStateRequest is the request type for the Query/PauseState RPC
+// method.
+type QueryPauseStateRequest struct {
 }
 
-func (m *BTCUndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CovenantSlashingSigs) > 0 {
-		for iNdEx := len(m.CovenantSlashingSigs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CovenantSlashingSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
+func (m *QueryPauseStateRequest) Reset()         { *m = QueryPauseStateRequest{} }
+func (m *QueryPauseStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseStateRequest) ProtoMessage()    {}
+func (*QueryPauseStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{56}
+}
+func (m *QueryPauseStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.DelegatorSlashingSigHex) > 0 {
-		i -= len(m.DelegatorSlashingSigHex)
-		copy(dAtA[i:], m.DelegatorSlashingSigHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashingSigHex)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.SlashingTxHex) > 0 {
-		i -= len(m.SlashingTxHex)
-		copy(dAtA[i:], m.SlashingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.CovenantUnbondingSigList) > 0 {
-		for iNdEx := len(m.CovenantUnbondingSigList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CovenantUnbondingSigList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+}
+func (m *QueryPauseStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseStateRequest.Merge(m, src)
+}
+func (m *QueryPauseStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPauseStateRequest proto.InternalMessageInfo
+
+// QueryPauseStateResponse is the response type for the Query/PauseState RPC
+// method.
+type QueryPauseStateResponse struct {
+	// pause_state is the pause state of the processing of new BTC delegations
+	// and early unbondings
+	PauseState PauseState `protobuf:"bytes,1,opt,name=pause_state,json=pauseState,proto3" json:"pause_state"`
+}
+
+func (m *QueryPauseStateResponse) Reset()         { *m = QueryPauseStateResponse{} }
+func (m *QueryPauseStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseStateResponse) ProtoMessage()    {}
+func (*QueryPauseStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{57}
+}
+func (m *QueryPauseStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.DelegatorUnbondingSigHex) > 0 {
-		i -= len(m.DelegatorUnbondingSigHex)
-		copy(dAtA[i:], m.DelegatorUnbondingSigHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorUnbondingSigHex)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.UnbondingTxHex) > 0 {
-		i -= len(m.UnbondingTxHex)
-		copy(dAtA[i:], m.UnbondingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingTxHex)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryPauseStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseStateResponse.Merge(m, src)
+}
+func (m *QueryPauseStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPauseStateResponse proto.InternalMessageInfo
+
+func (m *QueryPauseStateResponse) GetPauseState() PauseState {
+	if m != nil {
+		return m.PauseState
 	}
-	return len(dAtA) - i, nil
+	return PauseState{}
 }
 
-func (m *BTCDelegatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QueryLastFinalityProviderSetDiffRequest is the request type for the
+// Query/LastFinalityProviderSetDiff RPC method.
+type QueryLastFinalityProviderSetDiffRequest struct {
+}
+
+func (m *QueryLastFinalityProviderSetDiffRequest) Reset() {
+	*m = QueryLastFinalityProviderSetDiffRequest{}
+}
+func (m *QueryLastFinalityProviderSetDiffRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLastFinalityProviderSetDiffRequest) ProtoMessage()    {}
+func (*QueryLastFinalityProviderSetDiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{58}
+}
+func (m *QueryLastFinalityProviderSetDiffRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastFinalityProviderSetDiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastFinalityProviderSetDiffRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryLastFinalityProviderSetDiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastFinalityProviderSetDiffRequest.Merge(m, src)
+}
+func (m *QueryLastFinalityProviderSetDiffRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastFinalityProviderSetDiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastFinalityProviderSetDiffRequest.DiscardUnknown(m)
 }
 
-func (m *BTCDelegatorDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryLastFinalityProviderSetDiffRequest proto.InternalMessageInfo
+
+// QueryLastFinalityProviderSetDiffResponse is the response type for the
+// Query/LastFinalityProviderSetDiff RPC method.
+type QueryLastFinalityProviderSetDiffResponse struct {
+	// diff is the last change of the active finality provider set, which is nil
+	// if the set has never changed
+	Diff *FinalityProviderSetDiff `protobuf:"bytes,1,opt,name=diff,proto3" json:"diff,omitempty"`
 }
 
-func (m *BTCDelegatorDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Dels) > 0 {
-		for iNdEx := len(m.Dels) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Dels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryLastFinalityProviderSetDiffResponse) Reset() {
+	*m = QueryLastFinalityProviderSetDiffResponse{}
+}
+func (m *QueryLastFinalityProviderSetDiffResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLastFinalityProviderSetDiffResponse) ProtoMessage()    {}
+func (*QueryLastFinalityProviderSetDiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{59}
+}
+func (m *QueryLastFinalityProviderSetDiffResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastFinalityProviderSetDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastFinalityProviderSetDiffResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryLastFinalityProviderSetDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastFinalityProviderSetDiffResponse.Merge(m, src)
+}
+func (m *QueryLastFinalityProviderSetDiffResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastFinalityProviderSetDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastFinalityProviderSetDiffResponse.DiscardUnknown(m)
 }
 
-func (m *FinalityProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLastFinalityProviderSetDiffResponse proto.InternalMessageInfo
+
+func (m *QueryLastFinalityProviderSetDiffResponse) GetDiff() *FinalityProviderSetDiff {
+	if m != nil {
+		return m.Diff
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *FinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTxIndexRequest is the request type for the Query/TxIndex RPC method.
+type QueryTxIndexRequest struct {
+	// btc_tx_hash_hex is the hash of the BTC tx in hex
+	BtcTxHashHex string `protobuf:"bytes,1,opt,name=btc_tx_hash_hex,json=btcTxHashHex,proto3" json:"btc_tx_hash_hex,omitempty"`
 }
 
-func (m *FinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VotingPower != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
-		i--
-		dAtA[i] = 0x58
+func (m *QueryTxIndexRequest) Reset()         { *m = QueryTxIndexRequest{} }
+func (m *QueryTxIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxIndexRequest) ProtoMessage()    {}
+func (*QueryTxIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{60}
+}
+func (m *QueryTxIndexRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxIndexRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x50
+}
+func (m *QueryTxIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxIndexRequest.Merge(m, src)
+}
+func (m *QueryTxIndexRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxIndexRequest proto.InternalMessageInfo
+
+func (m *QueryTxIndexRequest) GetBtcTxHashHex() string {
+	if m != nil {
+		return m.BtcTxHashHex
 	}
-	if m.SlashedBtcHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBtcHeight))
-		i--
-		dAtA[i] = 0x48
+	return ""
+}
+
+// QueryTxIndexResponse is the response type for the Query/TxIndex RPC method.
+type QueryTxIndexResponse struct {
+	// tx_type is the type of the BTC tx
+	TxType BTCTxType `protobuf:"varint,1,opt,name=tx_type,json=txType,proto3,enum=babylon.btcstaking.v1.BTCTxType" json:"tx_type,omitempty"`
+	// staking_tx_hash_hex is the staking tx hash in hex of the BTC delegation
+	// that the BTC tx belongs to. It is empty for checkpoint submission txs
+	StakingTxHashHex string `protobuf:"bytes,2,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+	// epoch_num is the epoch checkpointed by the submission that the BTC tx
+	// belongs to. It is 0 for BTC txs of BTC delegations
+	EpochNum uint64 `protobuf:"varint,3,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// submission_key is the key of the submission that the BTC tx belongs to.
+	// It is nil for BTC txs of BTC delegations
+	SubmissionKey *types1.SubmissionKey `protobuf:"bytes,4,opt,name=submission_key,json=submissionKey,proto3" json:"submission_key,omitempty"`
+}
+
+func (m *QueryTxIndexResponse) Reset()         { *m = QueryTxIndexResponse{} }
+func (m *QueryTxIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTxIndexResponse) ProtoMessage()    {}
+func (*QueryTxIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{61}
+}
+func (m *QueryTxIndexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxIndexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.SlashedBabylonHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBabylonHeight))
-		i--
-		dAtA[i] = 0x40
+}
+func (m *QueryTxIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxIndexResponse.Merge(m, src)
+}
+func (m *QueryTxIndexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxIndexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxIndexResponse proto.InternalMessageInfo
+
+func (m *QueryTxIndexResponse) GetTxType() BTCTxType {
+	if m != nil {
+		return m.TxType
 	}
-	if m.RegisteredEpoch != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.RegisteredEpoch))
-		i--
-		dAtA[i] = 0x38
+	return BTCTxType_STAKING_TX
+}
+
+func (m *QueryTxIndexResponse) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	if len(m.MasterPubRand) > 0 {
-		i -= len(m.MasterPubRand)
-		copy(dAtA[i:], m.MasterPubRand)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MasterPubRand)))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *QueryTxIndexResponse) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
 	}
-	if m.Pop != nil {
-		{
-			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+	return 0
+}
+
+func (m *QueryTxIndexResponse) GetSubmissionKey() *types1.SubmissionKey {
+	if m != nil {
+		return m.SubmissionKey
 	}
-	if m.BtcPk != nil {
-		{
-			size := m.BtcPk.Size()
-			i -= size
-			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.BabylonPk != nil {
-		{
-			size, err := m.BabylonPk.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Commission != nil {
-		{
-			size := m.Commission.Size()
-			i -= size
-			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Description != nil {
-		{
-			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.btcstaking.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.btcstaking.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryParamsByVersionRequest)(nil), "babylon.btcstaking.v1.QueryParamsByVersionRequest")
+	proto.RegisterType((*QueryParamsByVersionResponse)(nil), "babylon.btcstaking.v1.QueryParamsByVersionResponse")
+	proto.RegisterType((*QueryFinalityProvidersRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersRequest")
+	proto.RegisterType((*QueryFinalityProvidersResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersResponse")
+	proto.RegisterType((*QueryFinalityProvidersByConsumerRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersByConsumerRequest")
+	proto.RegisterType((*QueryFinalityProvidersByConsumerResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersByConsumerResponse")
+	proto.RegisterType((*QueryFinalityProviderRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderRequest")
+	proto.RegisterType((*QueryFinalityProviderResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderResponse")
+	proto.RegisterType((*QueryFinalityProviderStatusRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderStatusRequest")
+	proto.RegisterType((*QueryFinalityProviderStatusResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderStatusResponse")
+	proto.RegisterType((*QueryBTCDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsRequest")
+	proto.RegisterType((*QueryBTCDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsResponse")
+	proto.RegisterType((*QueryFinalityProviderPowerAtHeightRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderPowerAtHeightRequest")
+	proto.RegisterType((*QueryFinalityProviderPowerAtHeightResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderPowerAtHeightResponse")
+	proto.RegisterType((*QueryFinalityProviderCurrentPowerRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderCurrentPowerRequest")
+	proto.RegisterType((*QueryFinalityProviderCurrentPowerResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderCurrentPowerResponse")
+	proto.RegisterType((*QueryActiveFinalityProvidersAtHeightRequest)(nil), "babylon.btcstaking.v1.QueryActiveFinalityProvidersAtHeightRequest")
+	proto.RegisterType((*QueryActiveFinalityProvidersAtHeightResponse)(nil), "babylon.btcstaking.v1.QueryActiveFinalityProvidersAtHeightResponse")
+	proto.RegisterType((*QueryActiveFinalityProvidersByPowerAtHeightRequest)(nil), "babylon.btcstaking.v1.QueryActiveFinalityProvidersByPowerAtHeightRequest")
+	proto.RegisterType((*QueryActiveFinalityProvidersByPowerAtHeightResponse)(nil), "babylon.btcstaking.v1.QueryActiveFinalityProvidersByPowerAtHeightResponse")
+	proto.RegisterType((*QueryActivatedHeightRequest)(nil), "babylon.btcstaking.v1.QueryActivatedHeightRequest")
+	proto.RegisterType((*QueryActivatedHeightResponse)(nil), "babylon.btcstaking.v1.QueryActivatedHeightResponse")
+	proto.RegisterType((*QueryFinalityProviderDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsRequest")
+	proto.RegisterType((*QueryFinalityProviderDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsResponse")
+	proto.RegisterType((*QueryBTCDelegationRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationRequest")
+	proto.RegisterType((*QueryBTCDelegationResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationResponse")
+	proto.RegisterType((*BTCDelegationResponse)(nil), "babylon.btcstaking.v1.BTCDelegationResponse")
+	proto.RegisterType((*BTCUndelegationResponse)(nil), "babylon.btcstaking.v1.BTCUndelegationResponse")
+	proto.RegisterType((*BTCDelegatorDelegationsResponse)(nil), "babylon.btcstaking.v1.BTCDelegatorDelegationsResponse")
+	proto.RegisterType((*FinalityProviderResponse)(nil), "babylon.btcstaking.v1.FinalityProviderResponse")
+	proto.RegisterType((*QuerySlashingRateChangeReportsRequest)(nil), "babylon.btcstaking.v1.QuerySlashingRateChangeReportsRequest")
+	proto.RegisterType((*QuerySlashingRateChangeReportsResponse)(nil), "babylon.btcstaking.v1.QuerySlashingRateChangeReportsResponse")
+	proto.RegisterType((*QuerySlashingRateChangeReportRequest)(nil), "babylon.btcstaking.v1.QuerySlashingRateChangeReportRequest")
+	proto.RegisterType((*QuerySlashingRateChangeReportResponse)(nil), "babylon.btcstaking.v1.QuerySlashingRateChangeReportResponse")
+	proto.RegisterType((*QueryScheduledParamsRequest)(nil), "babylon.btcstaking.v1.QueryScheduledParamsRequest")
+	proto.RegisterType((*QueryScheduledParamsResponse)(nil), "babylon.btcstaking.v1.QueryScheduledParamsResponse")
+	proto.RegisterType((*QueryStakingTxTemplateRequest)(nil), "babylon.btcstaking.v1.QueryStakingTxTemplateRequest")
+	proto.RegisterType((*QueryStakingTxTemplateResponse)(nil), "babylon.btcstaking.v1.QueryStakingTxTemplateResponse")
+	proto.RegisterType((*StakingTxTemplate)(nil), "babylon.btcstaking.v1.StakingTxTemplate")
+	proto.RegisterType((*QueryPendingBTCDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryPendingBTCDelegationsRequest")
+	proto.RegisterType((*QueryPendingBTCDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryPendingBTCDelegationsResponse")
+	proto.RegisterType((*CovenantSigningWorkItem)(nil), "babylon.btcstaking.v1.CovenantSigningWorkItem")
+	proto.RegisterType((*BTCDelegationBundle)(nil), "babylon.btcstaking.v1.BTCDelegationBundle")
+	proto.RegisterType((*SpendPath)(nil), "babylon.btcstaking.v1.SpendPath")
+	proto.RegisterType((*QueryCovenantMuSig2NoncesRequest)(nil), "babylon.btcstaking.v1.QueryCovenantMuSig2NoncesRequest")
+	proto.RegisterType((*QueryCovenantMuSig2NoncesResponse)(nil), "babylon.btcstaking.v1.QueryCovenantMuSig2NoncesResponse")
+	proto.RegisterType((*QueryWatchtowerBackupRequest)(nil), "babylon.btcstaking.v1.QueryWatchtowerBackupRequest")
+	proto.RegisterType((*QueryWatchtowerBackupResponse)(nil), "babylon.btcstaking.v1.QueryWatchtowerBackupResponse")
+	proto.RegisterType((*QueryCovenantPerformanceRequest)(nil), "babylon.btcstaking.v1.QueryCovenantPerformanceRequest")
+	proto.RegisterType((*QueryCovenantPerformanceResponse)(nil), "babylon.btcstaking.v1.QueryCovenantPerformanceResponse")
+	proto.RegisterType((*CovenantPerformanceResponse)(nil), "babylon.btcstaking.v1.CovenantPerformanceResponse")
+	proto.RegisterType((*QueryBTCDelegationTxFeesRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationTxFeesRequest")
+	proto.RegisterType((*QueryBTCDelegationTxFeesResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationTxFeesResponse")
+	proto.RegisterType((*TxFeeEstimate)(nil), "babylon.btcstaking.v1.TxFeeEstimate")
+	proto.RegisterType((*QueryPauseStateRequest)(nil), "babylon.btcstaking.v1.QueryPauseStateRequest")
+	proto.RegisterType((*QueryPauseStateResponse)(nil), "babylon.btcstaking.v1.QueryPauseStateResponse")
+	proto.RegisterType((*QueryLastFinalityProviderSetDiffRequest)(nil), "babylon.btcstaking.v1.QueryLastFinalityProviderSetDiffRequest")
+	proto.RegisterType((*QueryLastFinalityProviderSetDiffResponse)(nil), "babylon.btcstaking.v1.QueryLastFinalityProviderSetDiffResponse")
+	proto.RegisterType((*QueryTxIndexRequest)(nil), "babylon.btcstaking.v1.QueryTxIndexRequest")
+	proto.RegisterType((*QueryTxIndexResponse)(nil), "babylon.btcstaking.v1.QueryTxIndexResponse")
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func init() { proto.RegisterFile("babylon/btcstaking/v1/query.proto", fileDescriptor_74d49d26f7429697) }
+
+var fileDescriptor_74d49d26f7429697 = []byte{
+	// 4182 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5c, 0x4d, 0x70, 0x1b, 0x47,
+	0x76, 0xf6, 0x90, 0x10, 0x45, 0x3e, 0xf0, 0xb7, 0xc5, 0x1f, 0x08, 0x14, 0x7f, 0x34, 0x96, 0x65,
+	0x91, 0x92, 0x00, 0x91, 0x94, 0x65, 0xcb, 0x3f, 0x92, 0x09, 0x52, 0x3f, 0x94, 0x45, 0x99, 0x06,
+	0x69, 0x79, 0x37, 0x9b, 0xca, 0xd4, 0x60, 0xd0, 0x00, 0xa6, 0x08, 0xcc, 0x40, 0x33, 0x0d, 0xfe,
+	0xac, 0x4a, 0x97, 0xad, 0x24, 0xa7, 0xfc, 0xef, 0x56, 0xe5, 0x94, 0x53, 0x0e, 0x49, 0x55, 0x2e,
+	0xa9, 0x8a, 0x4f, 0xc9, 0xe6, 0x96, 0xc3, 0xe6, 0x92, 0x6c, 0xed, 0x6e, 0x2a, 0xc9, 0x56, 0xd6,
+	0x95, 0xb2, 0x53, 0x49, 0x25, 0x29, 0x1f, 0x72, 0xc9, 0x39, 0xd5, 0x7f, 0xf3, 0x03, 0xcc, 0x80,
+	0x00, 0x48, 0xa7, 0x6a, 0x4f, 0x26, 0xba, 0xdf, 0x7b, 0xfd, 0xbe, 0xee, 0xf7, 0x5e, 0xbf, 0x7e,
+	0xf3, 0x2c, 0xb8, 0x5c, 0xd0, 0x0b, 0xc7, 0x55, 0xdb, 0xca, 0x16, 0x88, 0xe1, 0x12, 0x7d, 0xdf,
+	0xb4, 0xca, 0xd9, 0x83, 0x95, 0xec, 0x8b, 0x06, 0x76, 0x8e, 0x33, 0x75, 0xc7, 0x26, 0x36, 0x9a,
+	0x12, 0x24, 0x19, 0x9f, 0x24, 0x73, 0xb0, 0x92, 0x9e, 0x2c, 0xdb, 0x65, 0x9b, 0x51, 0x64, 0xe9,
+	0x5f, 0x9c, 0x38, 0x7d, 0xa9, 0x6c, 0xdb, 0xe5, 0x2a, 0xce, 0xea, 0x75, 0x33, 0xab, 0x5b, 0x96,
+	0x4d, 0x74, 0x62, 0xda, 0x96, 0x2b, 0x66, 0x2f, 0x1a, 0xb6, 0x5b, 0xb3, 0x5d, 0x8d, 0xb3, 0xf1,
+	0x1f, 0x62, 0x4a, 0xe5, 0xbf, 0xb2, 0x86, 0x73, 0x5c, 0x27, 0x76, 0xd6, 0xc5, 0x46, 0x7d, 0xf5,
+	0xad, 0x3b, 0xfb, 0x2b, 0xd9, 0x7d, 0x7c, 0x2c, 0x69, 0xae, 0x08, 0x1a, 0x5f, 0xd1, 0x02, 0x26,
+	0xfa, 0x8a, 0xfc, 0x2d, 0xa8, 0x96, 0x05, 0x55, 0x41, 0x77, 0x31, 0x07, 0xe2, 0x11, 0xd6, 0xf5,
+	0xb2, 0x69, 0x31, 0x8d, 0xe4, 0xaa, 0xd1, 0xf0, 0xeb, 0xba, 0xa3, 0xd7, 0xe4, 0xaa, 0x57, 0xa3,
+	0x69, 0xfc, 0x5f, 0x82, 0x6e, 0x21, 0x46, 0x96, 0x5d, 0x17, 0x04, 0xf3, 0xd1, 0x04, 0xe4, 0x48,
+	0xcc, 0xdf, 0x08, 0xcc, 0x1b, 0x15, 0x6c, 0xec, 0xd7, 0x6d, 0xd3, 0x22, 0x62, 0x2d, 0x7f, 0x80,
+	0x53, 0xab, 0x93, 0x80, 0x3e, 0xa1, 0xe0, 0x76, 0x98, 0xae, 0x79, 0xfc, 0xa2, 0x81, 0x5d, 0xa2,
+	0x56, 0xe1, 0x42, 0x68, 0xd4, 0xad, 0xdb, 0x96, 0x8b, 0xd1, 0x7b, 0x30, 0xc0, 0x31, 0xa5, 0x94,
+	0x45, 0xe5, 0x5a, 0x72, 0x75, 0x2e, 0x13, 0x79, 0xa8, 0x19, 0xce, 0x96, 0x4b, 0xfc, 0xe8, 0x8b,
+	0x85, 0xd7, 0xf2, 0x82, 0x05, 0xa5, 0xe0, 0xfc, 0x01, 0x76, 0x5c, 0xd3, 0xb6, 0x52, 0x7d, 0x8b,
+	0xca, 0xb5, 0x91, 0xbc, 0xfc, 0xa9, 0xbe, 0x0d, 0xb3, 0x81, 0xd5, 0x72, 0xc7, 0xcf, 0xf9, 0xb8,
+	0x50, 0x26, 0xc8, 0xa8, 0x84, 0x19, 0xbf, 0x03, 0x97, 0xa2, 0x19, 0xcf, 0x40, 0x5f, 0xb5, 0x0c,
+	0x73, 0x4c, 0xf8, 0x43, 0xd3, 0xd2, 0xab, 0x26, 0x39, 0xde, 0x71, 0xec, 0x03, 0xb3, 0x88, 0x1d,
+	0xb9, 0x49, 0xe8, 0x21, 0x80, 0x6f, 0x09, 0x62, 0x85, 0xab, 0x19, 0x61, 0x8e, 0xd4, 0x6c, 0x32,
+	0xdc, 0xfe, 0x85, 0xd9, 0x64, 0x76, 0xf4, 0x32, 0x16, 0xbc, 0xf9, 0x00, 0xa7, 0xfa, 0xb7, 0x0a,
+	0xcc, 0xc7, 0xad, 0x24, 0x80, 0xfc, 0x1a, 0xa0, 0x92, 0x98, 0xd4, 0xea, 0x72, 0x36, 0xa5, 0x2c,
+	0xf6, 0x5f, 0x4b, 0xae, 0x66, 0x63, 0x40, 0x35, 0x4b, 0x93, 0xc2, 0xf2, 0x13, 0xa5, 0xe6, 0x75,
+	0xd0, 0xa3, 0x10, 0x94, 0x3e, 0x06, 0xe5, 0xcd, 0x13, 0xa1, 0x08, 0x79, 0x41, 0x2c, 0x7f, 0xa0,
+	0xc0, 0x9b, 0xd1, 0x58, 0x72, 0xc7, 0x1b, 0xb6, 0xe5, 0x36, 0x6a, 0xd8, 0x11, 0x7b, 0x80, 0x16,
+	0x20, 0x69, 0x88, 0x21, 0xcd, 0x2c, 0xb2, 0x0d, 0x1c, 0xca, 0x83, 0x1c, 0xda, 0x2a, 0xa2, 0x87,
+	0x11, 0x5a, 0xf5, 0xb2, 0xc1, 0x3f, 0x55, 0xe0, 0xda, 0xc9, 0x4a, 0xfd, 0xb2, 0x6d, 0xf5, 0xba,
+	0x30, 0xfe, 0xd6, 0xc5, 0xf9, 0xf6, 0x5e, 0x86, 0x91, 0x52, 0x5d, 0x2b, 0x10, 0x43, 0xab, 0xef,
+	0x6b, 0x15, 0x7c, 0x24, 0x37, 0xb8, 0x54, 0xcf, 0x11, 0x63, 0x67, 0xff, 0x31, 0x3e, 0x52, 0x5f,
+	0xc5, 0x98, 0xb8, 0xb7, 0x19, 0xbf, 0x0a, 0x13, 0x2d, 0x9b, 0x21, 0x2c, 0xbd, 0xeb, 0xbd, 0x18,
+	0x6f, 0xde, 0x0b, 0xf5, 0x11, 0xa8, 0x91, 0xcb, 0xef, 0x12, 0x9d, 0x34, 0xdc, 0x2e, 0x70, 0xfc,
+	0xae, 0x02, 0xaf, 0xb7, 0x95, 0x24, 0xe0, 0x7c, 0x04, 0x03, 0x0e, 0xae, 0xdb, 0x0e, 0x11, 0x18,
+	0xd6, 0x3a, 0xc4, 0x20, 0xc5, 0x50, 0xd6, 0xbc, 0x10, 0x81, 0x66, 0x61, 0xc8, 0xb4, 0xb4, 0x43,
+	0xd3, 0x2a, 0xda, 0x87, 0xec, 0x1c, 0x07, 0xf3, 0x83, 0xa6, 0xf5, 0x19, 0xfb, 0xad, 0xfe, 0xa9,
+	0x02, 0x69, 0xa6, 0x51, 0x6e, 0x6f, 0x63, 0x13, 0x57, 0x71, 0x99, 0x5f, 0x60, 0x12, 0x53, 0x0e,
+	0x06, 0x5c, 0x26, 0x93, 0x29, 0x32, 0xba, 0xba, 0x1c, 0xa3, 0x48, 0x88, 0x5b, 0x68, 0x21, 0x38,
+	0xcf, 0xcc, 0x3b, 0xfe, 0x5a, 0x11, 0xe1, 0xb7, 0x59, 0x55, 0xb1, 0x69, 0x9f, 0xc2, 0x18, 0xdd,
+	0xfc, 0xa2, 0x3f, 0x25, 0xbc, 0xe1, 0x46, 0x27, 0x4a, 0x7b, 0xc7, 0x3f, 0x5a, 0x20, 0x46, 0x40,
+	0xfc, 0xd9, 0xf9, 0x41, 0x09, 0x96, 0x22, 0xcf, 0x7e, 0xc7, 0x3e, 0xc4, 0xce, 0x3a, 0x79, 0x8c,
+	0xcd, 0x72, 0x85, 0x74, 0x6e, 0x4c, 0x68, 0x1a, 0x06, 0x2a, 0x8c, 0x87, 0x29, 0x95, 0xc8, 0x8b,
+	0x5f, 0xea, 0xc7, 0xb0, 0xdc, 0xc9, 0x3a, 0x62, 0xd7, 0x2e, 0xc3, 0xf0, 0x81, 0x4d, 0x4c, 0xab,
+	0xac, 0xd5, 0xe9, 0x3c, 0x5b, 0x27, 0x91, 0x4f, 0xf2, 0x31, 0xc6, 0xa2, 0x6e, 0xc7, 0x44, 0xa5,
+	0x8d, 0x86, 0xe3, 0x60, 0x8b, 0x30, 0xa2, 0x2e, 0x9c, 0x20, 0x6e, 0x1f, 0xc2, 0xe2, 0x84, 0x7a,
+	0x3e, 0x48, 0x25, 0x08, 0xb2, 0x45, 0xed, 0xbe, 0x56, 0xb5, 0x7f, 0x5b, 0x81, 0xeb, 0x6c, 0xa1,
+	0x75, 0x83, 0x98, 0x07, 0xb8, 0x79, 0x39, 0xb7, 0x79, 0xcb, 0xe3, 0x96, 0x3a, 0x2b, 0xfb, 0xfd,
+	0x47, 0x05, 0x6e, 0x74, 0xa6, 0xcf, 0x19, 0x46, 0xf8, 0xcf, 0x4c, 0x52, 0xd9, 0xc6, 0x44, 0xff,
+	0x46, 0x23, 0xfc, 0x0f, 0x14, 0x58, 0x6d, 0x87, 0x2c, 0x77, 0x1c, 0x69, 0xe3, 0xdf, 0xf4, 0x86,
+	0xff, 0x7d, 0x1f, 0xac, 0x75, 0xa5, 0xd6, 0xff, 0xd3, 0xbe, 0xdf, 0x00, 0x44, 0x6c, 0xa2, 0x57,
+	0xb5, 0x08, 0x0b, 0x1e, 0x67, 0x33, 0xcf, 0x7d, 0x33, 0x46, 0xeb, 0x30, 0x67, 0x35, 0x6a, 0x9a,
+	0xce, 0x30, 0x68, 0x11, 0x8a, 0xf5, 0xb3, 0x5c, 0x33, 0x6d, 0x35, 0x6a, 0x31, 0x38, 0x9b, 0x0e,
+	0x3a, 0xd1, 0xfb, 0x41, 0xcf, 0x89, 0x08, 0xcc, 0x16, 0xd2, 0x09, 0x2e, 0x86, 0x0e, 0x54, 0xbd,
+	0x03, 0x97, 0xa2, 0xa7, 0xdb, 0x3b, 0xb3, 0xfa, 0x83, 0xb8, 0x64, 0x2c, 0xe2, 0x46, 0xea, 0x20,
+	0x30, 0x9e, 0x95, 0xfd, 0xfc, 0x47, 0x5c, 0x3a, 0x16, 0x75, 0xfb, 0x38, 0x70, 0x31, 0x70, 0xfb,
+	0xd8, 0x4e, 0xc4, 0x3d, 0x74, 0xe7, 0xc4, 0x7b, 0xc8, 0x8e, 0x12, 0x9d, 0x9f, 0xf1, 0x6f, 0xa4,
+	0x10, 0xc1, 0xd9, 0x39, 0xf0, 0x13, 0xb8, 0xd8, 0x7a, 0xb3, 0xca, 0x1d, 0xbf, 0x09, 0x17, 0x84,
+	0xb2, 0x1a, 0x39, 0xd2, 0x2a, 0xba, 0x5b, 0x09, 0xec, 0xfb, 0xb8, 0x98, 0xda, 0x3b, 0x7a, 0xac,
+	0xbb, 0x15, 0x1a, 0xde, 0x5f, 0x44, 0x25, 0x14, 0xde, 0x36, 0xed, 0xc2, 0x68, 0xf8, 0x92, 0x16,
+	0x19, 0x4e, 0x77, 0x77, 0xf4, 0x48, 0xe8, 0x8e, 0x56, 0xff, 0x67, 0x10, 0xa6, 0xa2, 0x97, 0xdb,
+	0x86, 0x01, 0x6e, 0x2a, 0x6c, 0x99, 0xe1, 0xdc, 0x9d, 0x9f, 0x7f, 0xb1, 0xb0, 0x5a, 0x36, 0x49,
+	0xa5, 0x51, 0xc8, 0x18, 0x76, 0x2d, 0x2b, 0x16, 0x35, 0x2a, 0xba, 0x69, 0xc9, 0x1f, 0x59, 0x72,
+	0x5c, 0xc7, 0x6e, 0x26, 0xb7, 0xb5, 0xb3, 0x76, 0xfb, 0xd6, 0x4e, 0xa3, 0xf0, 0x11, 0x3e, 0xce,
+	0x9f, 0x2b, 0x50, 0xe3, 0x42, 0xdf, 0x81, 0x51, 0xdf, 0xf8, 0xaa, 0xa6, 0x4b, 0xaf, 0xde, 0xfe,
+	0x53, 0x88, 0x4d, 0x0a, 0xab, 0x7d, 0x6a, 0x32, 0xcb, 0x1e, 0x76, 0x89, 0xee, 0x10, 0x4d, 0xf8,
+	0x48, 0x3f, 0xbf, 0xd2, 0xd8, 0x18, 0x77, 0x24, 0x34, 0x07, 0x80, 0xad, 0xa2, 0x24, 0x48, 0x30,
+	0x82, 0x21, 0x6c, 0x09, 0x3f, 0xa3, 0x99, 0x1e, 0x0f, 0x2c, 0xae, 0x4e, 0x52, 0xe7, 0xd8, 0xec,
+	0x20, 0x1b, 0xd8, 0xd5, 0x09, 0xba, 0x02, 0xa3, 0xc1, 0x63, 0xc4, 0x47, 0xa9, 0x01, 0x76, 0x82,
+	0xc3, 0xfe, 0x09, 0xe2, 0x23, 0x74, 0x15, 0xc6, 0xdc, 0xaa, 0xee, 0x56, 0x02, 0x64, 0xe7, 0x19,
+	0xd9, 0x88, 0x1c, 0xe6, 0x74, 0x6f, 0xc1, 0x8c, 0x6f, 0xea, 0x6c, 0x4a, 0x73, 0xcd, 0x32, 0xa3,
+	0x1f, 0x64, 0xf4, 0x93, 0xde, 0xf4, 0x2e, 0x9d, 0xdd, 0x35, 0xcb, 0x94, 0xed, 0x53, 0x18, 0x31,
+	0xec, 0x03, 0x6c, 0xe9, 0x16, 0xa1, 0xf4, 0x6e, 0x6a, 0x88, 0x79, 0xc6, 0xad, 0x98, 0xd3, 0xdf,
+	0x10, 0xb4, 0xeb, 0x45, 0xbd, 0x4e, 0x25, 0x99, 0x65, 0x4b, 0x27, 0x0d, 0x07, 0xbb, 0xf9, 0x61,
+	0x29, 0x66, 0xd7, 0x2c, 0xb3, 0x88, 0x2a, 0xb1, 0xd9, 0x0d, 0x52, 0x6f, 0x10, 0xcd, 0x2c, 0x1e,
+	0xa5, 0x80, 0x05, 0x46, 0x69, 0xa1, 0x1f, 0xb3, 0x89, 0xad, 0x22, 0x4b, 0x9c, 0x78, 0x34, 0x4d,
+	0x25, 0x59, 0x36, 0x2c, 0x7e, 0xd1, 0x77, 0x1e, 0x4f, 0x59, 0xb5, 0x22, 0x76, 0x8d, 0xd4, 0x30,
+	0x0f, 0x2c, 0x7c, 0x68, 0x13, 0xbb, 0x06, 0x7a, 0x03, 0x46, 0x1b, 0x56, 0xc1, 0xb6, 0x8a, 0x6c,
+	0x77, 0xcc, 0x1a, 0x4e, 0x8d, 0xb0, 0x25, 0x46, 0xbc, 0xd1, 0x3d, 0xb3, 0x86, 0x91, 0x01, 0x53,
+	0x0d, 0xcb, 0xb7, 0x70, 0xcd, 0x11, 0xd6, 0x98, 0x1a, 0x65, 0xa6, 0x9e, 0x89, 0x37, 0xf5, 0x4f,
+	0xad, 0x62, 0x8b, 0x0d, 0xe7, 0x27, 0x1b, 0x11, 0xa3, 0x54, 0x17, 0xfe, 0xfe, 0xd7, 0x64, 0xcd,
+	0x61, 0x8c, 0xeb, 0xc2, 0x47, 0x45, 0x85, 0x01, 0xdd, 0x81, 0x19, 0xd7, 0x70, 0xcc, 0x3a, 0xd1,
+	0x08, 0xae, 0xd5, 0xab, 0x3a, 0xc1, 0x1e, 0xfd, 0x38, 0xa3, 0x9f, 0xe2, 0xd3, 0x7b, 0x62, 0x56,
+	0xf2, 0x3d, 0x07, 0xef, 0xc0, 0x35, 0x47, 0x27, 0x38, 0x35, 0x41, 0x77, 0x23, 0xb7, 0x42, 0x2b,
+	0x0f, 0x3f, 0xff, 0x62, 0x61, 0x96, 0x07, 0x19, 0xb7, 0xb8, 0x9f, 0x31, 0xed, 0x6c, 0x4d, 0x27,
+	0x95, 0xcc, 0x53, 0x5c, 0xd6, 0x8d, 0xe3, 0x4d, 0x6c, 0xfc, 0xe4, 0xf3, 0x9b, 0xc0, 0xa7, 0x33,
+	0x9b, 0xd8, 0xc8, 0x0f, 0x4b, 0x39, 0x79, 0x9d, 0x60, 0xb4, 0x04, 0xe3, 0x9e, 0x5c, 0xbd, 0x58,
+	0x74, 0xb0, 0xeb, 0xa6, 0x10, 0xdb, 0x68, 0xcf, 0xee, 0xd6, 0xf9, 0x30, 0x42, 0x90, 0xa8, 0xe1,
+	0x9a, 0x9d, 0xba, 0xc0, 0xa6, 0xd9, 0xdf, 0xe8, 0x3a, 0x4c, 0xe8, 0xfc, 0x72, 0xa1, 0x1b, 0x2b,
+	0xfc, 0x60, 0x92, 0xdf, 0x9c, 0xfe, 0x84, 0x70, 0x87, 0x37, 0x60, 0xd4, 0xc1, 0x87, 0xba, 0x53,
+	0xf4, 0x56, 0x9a, 0xe2, 0xa6, 0xcc, 0x47, 0xe5, 0x3a, 0xb7, 0x61, 0xfa, 0xd0, 0x24, 0x95, 0xa2,
+	0xa3, 0x1f, 0xea, 0x55, 0xe6, 0xdc, 0x92, 0x7c, 0x9a, 0x5b, 0xb2, 0x3f, 0x9b, 0x23, 0x86, 0xe0,
+	0x52, 0x3f, 0xef, 0x87, 0x99, 0x98, 0x13, 0x43, 0xd7, 0x60, 0x3c, 0x60, 0x27, 0x47, 0x81, 0x70,
+	0xe9, 0xdb, 0x0f, 0x77, 0xa3, 0x0f, 0x60, 0xd6, 0x77, 0x23, 0x9f, 0x47, 0xba, 0x52, 0x1f, 0x63,
+	0x4a, 0x79, 0x24, 0x9f, 0x4a, 0x0a, 0xe1, 0x4e, 0x06, 0xcc, 0x7a, 0xee, 0x14, 0xe6, 0x66, 0xc1,
+	0xa9, 0x9f, 0x39, 0xd7, 0x95, 0x18, 0x7b, 0xf3, 0xbc, 0x69, 0xcb, 0x2a, 0xd9, 0xf9, 0x94, 0x14,
+	0x14, 0x5c, 0x83, 0xc5, 0xa5, 0x88, 0x90, 0x90, 0x88, 0x0a, 0x09, 0xef, 0x41, 0xba, 0x29, 0x24,
+	0x04, 0xa1, 0x9c, 0x63, 0x2c, 0x33, 0xe1, 0xa8, 0xe0, 0x23, 0x29, 0xc1, 0xb4, 0x1f, 0x18, 0x02,
+	0xbc, 0x6e, 0x6a, 0xa0, 0xc7, 0x08, 0x31, 0xe9, 0x45, 0x08, 0x7f, 0x25, 0x57, 0x35, 0x60, 0xe1,
+	0x84, 0xeb, 0x16, 0x7d, 0x08, 0x89, 0x22, 0xae, 0xf6, 0xf6, 0x78, 0x64, 0x9c, 0xea, 0xef, 0x9c,
+	0x83, 0x54, 0x6c, 0xa9, 0xe2, 0x01, 0x24, 0x8b, 0x98, 0x3b, 0x9d, 0x7f, 0xfd, 0xbd, 0x2e, 0x6f,
+	0x6d, 0x7f, 0x05, 0x7e, 0x65, 0x6f, 0xfa, 0xa4, 0xf9, 0x20, 0x1f, 0xda, 0x06, 0x30, 0xec, 0x5a,
+	0xcd, 0x74, 0xbd, 0x42, 0xe5, 0x50, 0xee, 0x66, 0x77, 0x9e, 0x19, 0x10, 0x80, 0xee, 0x01, 0x08,
+	0x9c, 0xf4, 0xb2, 0xec, 0x67, 0x4a, 0x2d, 0x48, 0xa5, 0x78, 0x91, 0x3a, 0xe3, 0x15, 0xa9, 0x33,
+	0xe2, 0xfa, 0x1a, 0x12, 0x2c, 0x3b, 0xfb, 0x81, 0x8b, 0x36, 0x71, 0x16, 0x17, 0xed, 0xbb, 0xd0,
+	0x5f, 0xb7, 0xeb, 0xcc, 0x68, 0x92, 0xab, 0xd7, 0xe2, 0xaa, 0xa1, 0x8e, 0x6d, 0x97, 0x3e, 0x2e,
+	0xed, 0xd8, 0xae, 0x8b, 0x19, 0x8a, 0x3c, 0x65, 0xa2, 0xf6, 0x5a, 0xd3, 0x5d, 0x82, 0x1d, 0xad,
+	0xde, 0x28, 0x68, 0x8e, 0x6e, 0x15, 0xc5, 0x4d, 0x37, 0xc2, 0x87, 0x77, 0x1a, 0x85, 0xbc, 0x6e,
+	0x15, 0x69, 0x28, 0x72, 0x70, 0xd9, 0xa4, 0x43, 0xb8, 0xa8, 0xe1, 0xba, 0x6d, 0x54, 0xd8, 0x5d,
+	0x97, 0xc8, 0x8f, 0xf9, 0xe3, 0x0f, 0xe8, 0x30, 0x0d, 0x11, 0xcc, 0x28, 0x71, 0x51, 0x93, 0xbb,
+	0x24, 0x62, 0xcf, 0x20, 0x63, 0x98, 0x14, 0xb3, 0x39, 0x3e, 0x29, 0xe2, 0x0f, 0xbd, 0x95, 0x24,
+	0x17, 0x31, 0x24, 0xc7, 0x10, 0x8f, 0x56, 0x92, 0x83, 0x18, 0x82, 0xda, 0x4f, 0x8e, 0xa1, 0xed,
+	0x4b, 0x37, 0xd9, 0xf2, 0xd2, 0x6d, 0x2e, 0x50, 0x0e, 0x37, 0x17, 0x28, 0x55, 0x1b, 0xde, 0x60,
+	0x39, 0xd9, 0x6e, 0x20, 0x14, 0x6f, 0x54, 0x74, 0x8b, 0xa6, 0x83, 0xb4, 0x46, 0x74, 0xe6, 0xa5,
+	0xe2, 0x1f, 0x2a, 0x70, 0xf5, 0xa4, 0x15, 0x85, 0x3f, 0x6c, 0xc1, 0x79, 0x5e, 0xa8, 0x3a, 0xe9,
+	0x89, 0x15, 0x27, 0x2a, 0x2f, 0xf9, 0xcf, 0x2e, 0x1f, 0xde, 0x86, 0x2b, 0x6d, 0xb5, 0x97, 0xdb,
+	0xd5, 0x7a, 0x09, 0x2b, 0x11, 0x97, 0xb0, 0x5a, 0x3f, 0x61, 0xfb, 0xbd, 0xbd, 0x78, 0xd4, 0x54,
+	0xf7, 0xeb, 0x7a, 0x2b, 0x04, 0xbb, 0xf7, 0x50, 0xdb, 0x35, 0x2a, 0xb8, 0xd8, 0xa8, 0xe2, 0x62,
+	0xf8, 0xb3, 0xc9, 0x0b, 0xb8, 0x14, 0x3d, 0x2d, 0xf4, 0xf8, 0x04, 0xc6, 0x5d, 0x39, 0xa5, 0x85,
+	0xbe, 0x4c, 0x5c, 0x8d, 0xd3, 0xa8, 0x49, 0xd2, 0x98, 0x1b, 0x1e, 0x50, 0x7f, 0xbf, 0x4f, 0xd4,
+	0x70, 0x77, 0x65, 0xba, 0x29, 0x53, 0x0e, 0xb9, 0x99, 0x4b, 0x30, 0x41, 0x05, 0x62, 0xa7, 0xf5,
+	0x75, 0x37, 0xca, 0x27, 0xbc, 0x17, 0xde, 0x32, 0xa0, 0xd0, 0x23, 0xd0, 0xcf, 0xc5, 0x87, 0xf2,
+	0xa3, 0xfe, 0x4b, 0x90, 0x5d, 0x5f, 0xaf, 0xc3, 0x88, 0xcc, 0x0d, 0x0f, 0xf4, 0x6a, 0x03, 0xb3,
+	0xe0, 0xd6, 0xef, 0xa5, 0xbd, 0xcf, 0xe9, 0x98, 0xc8, 0xbd, 0xf7, 0xbd, 0xbc, 0x2e, 0xc1, 0x8e,
+	0x31, 0x29, 0x53, 0x63, 0x9a, 0xd5, 0xb5, 0x26, 0x7f, 0xe7, 0xa2, 0x92, 0xbf, 0x65, 0x98, 0xf0,
+	0xc9, 0x4a, 0x18, 0xb3, 0x5c, 0x7c, 0x80, 0x2d, 0x39, 0xe6, 0x4d, 0x3c, 0xc4, 0x78, 0x57, 0x27,
+	0x6a, 0x09, 0xe6, 0xe3, 0xb6, 0x44, 0x1c, 0xc4, 0x26, 0x0c, 0xca, 0xbc, 0x2d, 0xa5, 0xb4, 0x0d,
+	0x86, 0xad, 0x32, 0x3c, 0x4e, 0xf5, 0x7b, 0xe7, 0x60, 0xa2, 0x65, 0x9e, 0xc6, 0xbf, 0x96, 0x9c,
+	0x90, 0x9b, 0xef, 0x18, 0x69, 0xca, 0x06, 0x5b, 0xed, 0xbc, 0x2f, 0x2a, 0xd9, 0x6c, 0x7d, 0x62,
+	0xf4, 0x47, 0x3c, 0x31, 0xa2, 0x93, 0xf5, 0x44, 0x4c, 0xb2, 0x7e, 0x0f, 0x2e, 0x35, 0x51, 0xd7,
+	0xf7, 0x35, 0x91, 0xd2, 0xfa, 0x79, 0x45, 0x2a, 0xc4, 0xb7, 0xb3, 0xbf, 0xcb, 0x08, 0xe8, 0x6a,
+	0x19, 0xb8, 0x40, 0x0f, 0xab, 0x6a, 0x1b, 0x21, 0x36, 0x7e, 0x23, 0x4c, 0xc8, 0x29, 0x9f, 0xfe,
+	0x16, 0x4c, 0xfa, 0xe7, 0x17, 0x60, 0xe0, 0xaf, 0x20, 0xe4, 0xcd, 0x85, 0x56, 0xf0, 0x33, 0x16,
+	0x9f, 0x81, 0x3f, 0x83, 0x26, 0xe4, 0x94, 0x4f, 0x1f, 0x91, 0x4f, 0x0d, 0x45, 0xe5, 0x53, 0x51,
+	0x59, 0x24, 0x44, 0x66, 0x91, 0x77, 0xe1, 0x62, 0x40, 0xe7, 0x26, 0xd9, 0x49, 0xc6, 0x32, 0xed,
+	0x2b, 0x1e, 0x5a, 0xa4, 0x02, 0x17, 0x6b, 0x6e, 0x59, 0x33, 0x1c, 0x4c, 0xcd, 0xa0, 0xe9, 0x69,
+	0x3e, 0xcc, 0x2c, 0xee, 0x66, 0x8c, 0xc5, 0x6d, 0xbb, 0xe5, 0x0d, 0xc6, 0x16, 0x4e, 0x85, 0xa6,
+	0x6b, 0xde, 0x78, 0xe8, 0x91, 0xfe, 0x7d, 0x05, 0x2e, 0xf3, 0x8f, 0xa0, 0x98, 0xe9, 0x11, 0xfd,
+	0xc1, 0xe1, 0x2a, 0x8c, 0x79, 0x79, 0x60, 0x28, 0x04, 0x78, 0xef, 0xc6, 0xb3, 0xad, 0xf1, 0xfc,
+	0x50, 0x01, 0xb5, 0x9d, 0x56, 0x5e, 0x1d, 0x01, 0x0e, 0x6d, 0x67, 0x5f, 0x33, 0x09, 0xae, 0xc9,
+	0x7b, 0x2a, 0x73, 0x42, 0x4a, 0x4a, 0x73, 0x51, 0xd3, 0x2a, 0x7f, 0x66, 0x3b, 0xfb, 0x5b, 0x04,
+	0xd7, 0xf2, 0x43, 0x87, 0xe2, 0xaf, 0x33, 0xbc, 0xa8, 0xfe, 0xfb, 0x1c, 0xcc, 0xc4, 0xac, 0xd7,
+	0x65, 0xdd, 0x26, 0xa2, 0x32, 0xd3, 0x77, 0xea, 0xca, 0x0c, 0xfa, 0x36, 0x0c, 0x07, 0x8e, 0xd3,
+	0x65, 0x2f, 0x92, 0x53, 0x94, 0x4b, 0x7c, 0x1b, 0x70, 0xd1, 0x9b, 0x01, 0x4b, 0x79, 0xd1, 0xb0,
+	0x9d, 0x46, 0x4d, 0xc4, 0x90, 0x51, 0x39, 0xfc, 0x09, 0x1b, 0x3d, 0x75, 0x04, 0xb9, 0x05, 0x93,
+	0x4d, 0xfc, 0xfc, 0x1e, 0xe1, 0x41, 0x1d, 0x85, 0xf8, 0xf8, 0x6d, 0xf2, 0x10, 0x16, 0x25, 0x87,
+	0xe7, 0x8d, 0x75, 0x9d, 0x54, 0x5a, 0xe3, 0x89, 0xd4, 0x4c, 0x3a, 0xe5, 0x8e, 0x4e, 0x2a, 0xfe,
+	0xca, 0x8f, 0xe1, 0xb2, 0x94, 0xe3, 0xfb, 0x77, 0xb3, 0x20, 0x1e, 0x67, 0xe6, 0x04, 0xa1, 0xf7,
+	0x7a, 0x0b, 0x4b, 0xca, 0xc1, 0xbc, 0x2f, 0x21, 0x72, 0x17, 0x78, 0x08, 0x4a, 0x7b, 0x54, 0xad,
+	0xfb, 0x70, 0x1b, 0xa6, 0x5b, 0x64, 0xf0, 0x9d, 0x00, 0xb6, 0x13, 0x93, 0x4d, 0xbc, 0x7c, 0x2f,
+	0x9e, 0x80, 0x1a, 0x11, 0x9b, 0x9a, 0x41, 0xf0, 0x20, 0x35, 0xdf, 0x12, 0xa4, 0x42, 0x28, 0xd4,
+	0x5f, 0x0c, 0xc0, 0x85, 0x90, 0xd9, 0xe5, 0x1a, 0x56, 0xb1, 0xca, 0x0a, 0x37, 0xd4, 0x74, 0x2d,
+	0x4c, 0xa8, 0x8b, 0xc9, 0x8a, 0x70, 0x81, 0x18, 0xcf, 0xf8, 0x48, 0x9c, 0x2b, 0xf4, 0x75, 0xec,
+	0x0a, 0xfd, 0x67, 0xef, 0x0a, 0x89, 0x6f, 0xd4, 0x15, 0xce, 0xf5, 0xe4, 0x0a, 0x03, 0x3d, 0xba,
+	0xc2, 0xf9, 0x58, 0x57, 0xd8, 0x83, 0xa9, 0x60, 0x62, 0xc5, 0xae, 0x61, 0x7a, 0xf8, 0xcc, 0x6c,
+	0x93, 0xab, 0x8b, 0x71, 0xd9, 0x4c, 0x1d, 0x5b, 0x45, 0x7a, 0xf8, 0xf9, 0x0b, 0x81, 0x1c, 0x8c,
+	0x72, 0xd3, 0x41, 0xf4, 0x1c, 0xa6, 0xa3, 0x1d, 0x23, 0x35, 0xd4, 0xa1, 0xd8, 0xc9, 0x28, 0x7f,
+	0xe9, 0xc0, 0x4d, 0xe0, 0x14, 0x6e, 0x92, 0x6c, 0xe3, 0x26, 0xdf, 0x82, 0x99, 0x70, 0x76, 0xe9,
+	0xef, 0xd4, 0x70, 0x87, 0x90, 0xa6, 0x42, 0x89, 0xa8, 0xb7, 0x57, 0x51, 0x15, 0xb7, 0x91, 0xc8,
+	0x8a, 0x9b, 0xfa, 0x1c, 0x86, 0x3c, 0x71, 0xb4, 0xd6, 0x1c, 0xc0, 0xcd, 0x7d, 0x6a, 0xc8, 0xf5,
+	0x60, 0x2e, 0xc3, 0x84, 0x61, 0x5b, 0xc4, 0xb1, 0xab, 0x5a, 0x81, 0xe9, 0xea, 0x3b, 0xd4, 0x98,
+	0x98, 0xc8, 0xd1, 0x71, 0xea, 0xb7, 0x9f, 0xc0, 0x22, 0xbb, 0x63, 0xe5, 0x4d, 0xb5, 0xdd, 0xd8,
+	0x35, 0xcb, 0xab, 0xcf, 0x6c, 0xcb, 0xc0, 0x6e, 0x8f, 0x5f, 0x19, 0xfe, 0x58, 0x66, 0x13, 0xd1,
+	0x32, 0xc5, 0xb5, 0xbd, 0x01, 0x03, 0x16, 0x1b, 0x11, 0x57, 0xf6, 0xf5, 0x13, 0xae, 0xec, 0x90,
+	0x10, 0xc1, 0x4a, 0xb3, 0x2b, 0xbd, 0x5c, 0x76, 0xa8, 0x1f, 0x63, 0xad, 0x39, 0x39, 0xe1, 0x88,
+	0xa7, 0x3d, 0x82, 0x8d, 0x60, 0x96, 0xa2, 0x7e, 0x5b, 0xbc, 0xb3, 0x3e, 0xd3, 0x89, 0x51, 0x21,
+	0xf4, 0xad, 0x9e, 0xd3, 0x8d, 0xfd, 0x46, 0xbd, 0x37, 0xd0, 0x4f, 0x12, 0x83, 0x7d, 0xe3, 0xfd,
+	0x4f, 0x12, 0x83, 0xfd, 0xe3, 0x09, 0xf5, 0x18, 0xe6, 0x62, 0x44, 0x0b, 0xec, 0x37, 0x01, 0x1d,
+	0x7a, 0x73, 0xde, 0xc9, 0x73, 0xd1, 0x13, 0xfe, 0x8c, 0xac, 0x82, 0x2e, 0xc1, 0x38, 0xb6, 0x58,
+	0xa1, 0x87, 0x15, 0x39, 0xa8, 0x28, 0x06, 0x6e, 0x38, 0x3f, 0xe6, 0x8d, 0xf3, 0x15, 0x54, 0x13,
+	0x16, 0x42, 0x5b, 0xbf, 0x83, 0x9d, 0x92, 0xed, 0xd4, 0x74, 0xcb, 0xc0, 0x67, 0x5d, 0x47, 0xf8,
+	0x99, 0x02, 0x8b, 0xf1, 0x6b, 0x09, 0xa4, 0x65, 0x98, 0xf2, 0x8f, 0xc5, 0x9f, 0x97, 0x87, 0xbe,
+	0x7a, 0xc2, 0xa1, 0x47, 0x88, 0xf4, 0x8b, 0x87, 0x81, 0xc9, 0x33, 0x4c, 0xdb, 0x7e, 0xa3, 0x0f,
+	0x66, 0xdb, 0x21, 0xba, 0x44, 0x8b, 0x7b, 0x07, 0xe1, 0x04, 0x78, 0xd0, 0xb0, 0x0f, 0x78, 0xee,
+	0x3b, 0x07, 0x40, 0xbf, 0x08, 0xbb, 0x66, 0xd9, 0xc2, 0x45, 0xf1, 0xdd, 0x78, 0xc8, 0x6a, 0xd4,
+	0x76, 0xd9, 0x00, 0x2a, 0xc3, 0xb4, 0x7e, 0x80, 0x1d, 0xbd, 0x8c, 0x19, 0x09, 0x35, 0x2e, 0xe6,
+	0xa1, 0xfc, 0x4b, 0x71, 0x4f, 0x35, 0xfc, 0x49, 0x21, 0x50, 0xa4, 0x98, 0xcc, 0xb1, 0x5d, 0xa9,
+	0x07, 0x2d, 0x21, 0xe2, 0xa2, 0xfc, 0x1a, 0x65, 0x35, 0x6a, 0xdb, 0x6c, 0x80, 0xbe, 0xa9, 0x4d,
+	0x4b, 0x63, 0x35, 0x46, 0x42, 0x30, 0x7f, 0x2e, 0x0f, 0xe6, 0x93, 0xa6, 0xb5, 0x21, 0x87, 0xd4,
+	0x7d, 0x61, 0x49, 0xa1, 0x0b, 0x74, 0xef, 0xe8, 0x21, 0xee, 0x35, 0x2e, 0xa0, 0x8b, 0x30, 0x48,
+	0x1f, 0xdd, 0xec, 0x93, 0x05, 0xdf, 0x99, 0xf3, 0x25, 0x8c, 0xf3, 0xf4, 0x15, 0xfc, 0x5b, 0x7d,
+	0xb0, 0x18, 0xbf, 0x9a, 0x5f, 0x9d, 0x0d, 0x3c, 0xa0, 0x84, 0xe5, 0xc6, 0x55, 0xd0, 0x19, 0xef,
+	0x03, 0x97, 0x98, 0x35, 0xfa, 0xde, 0x06, 0xff, 0xf9, 0x86, 0x1e, 0xc1, 0x70, 0xf0, 0xed, 0x96,
+	0xea, 0xeb, 0x42, 0x4e, 0x32, 0xf0, 0xba, 0x43, 0xdf, 0x82, 0xa9, 0xc8, 0xa7, 0x5d, 0xaa, 0xbf,
+	0x0b, 0x89, 0x17, 0x22, 0x1e, 0x7f, 0xea, 0x2b, 0x18, 0x09, 0x51, 0xb1, 0x42, 0xa3, 0xe9, 0x90,
+	0x06, 0xfd, 0x7e, 0x68, 0x7e, 0x97, 0xd7, 0x1b, 0xfa, 0xf3, 0x49, 0x31, 0xb6, 0x6b, 0x7e, 0x17,
+	0xa3, 0x19, 0x38, 0x5f, 0x33, 0x2d, 0x5a, 0xd6, 0x60, 0x88, 0xfa, 0xf3, 0x03, 0x35, 0xd3, 0x7a,
+	0x88, 0x31, 0x1a, 0x87, 0x7e, 0x3a, 0xc8, 0x4b, 0x2b, 0xf4, 0x4f, 0x34, 0x0f, 0xe0, 0x36, 0x4a,
+	0x25, 0xd3, 0x30, 0xb1, 0xc5, 0x3f, 0x55, 0x0e, 0xe6, 0x03, 0x23, 0x6a, 0x0a, 0xa6, 0x45, 0x4b,
+	0x6c, 0xc3, 0xc5, 0xb4, 0x63, 0x4c, 0xfa, 0xbf, 0x6a, 0xc0, 0x4c, 0xcb, 0x8c, 0x38, 0x9d, 0xc7,
+	0x90, 0xac, 0xd3, 0x51, 0xcd, 0x25, 0x7e, 0x45, 0xe4, 0x72, 0x6c, 0xb3, 0xac, 0xe4, 0x17, 0x0d,
+	0xb3, 0x50, 0xf7, 0x46, 0xd4, 0x25, 0xd1, 0x71, 0xf0, 0x54, 0x77, 0x49, 0x4b, 0x17, 0x1d, 0x26,
+	0x9b, 0x66, 0xa9, 0x24, 0xf5, 0xb1, 0xe0, 0xda, 0xc9, 0xa4, 0x42, 0xc1, 0x1c, 0x24, 0x8a, 0x66,
+	0xa9, 0x24, 0x34, 0xcb, 0x74, 0xda, 0xb6, 0x27, 0xa4, 0x30, 0x5e, 0xf5, 0x7d, 0xd1, 0xd3, 0xbc,
+	0x77, 0xb4, 0x65, 0x15, 0xf1, 0x91, 0x5f, 0x6b, 0x64, 0xed, 0x6d, 0xad, 0x4e, 0x30, 0x5c, 0x20,
+	0x86, 0x7f, 0x31, 0x7e, 0xad, 0xc0, 0x64, 0x98, 0x5d, 0xa8, 0x76, 0x17, 0xce, 0x93, 0x23, 0x8d,
+	0xa6, 0x92, 0xa2, 0x97, 0x6f, 0x31, 0x3e, 0x9b, 0xdd, 0x3b, 0xda, 0x3b, 0xae, 0xe3, 0xfc, 0x00,
+	0x61, 0xff, 0xed, 0x36, 0x7d, 0x9e, 0x85, 0x21, 0x56, 0x4d, 0xd7, 0xac, 0x46, 0x4d, 0x7c, 0xc5,
+	0x1e, 0x64, 0x03, 0xcf, 0x1a, 0x35, 0xf4, 0x0c, 0x46, 0xdd, 0x46, 0x41, 0x7c, 0x76, 0xd0, 0xf6,
+	0xf1, 0xb1, 0xd7, 0x8f, 0x12, 0xd0, 0x26, 0xd0, 0xfd, 0x4d, 0x53, 0x1c, 0x8f, 0x9e, 0x66, 0xbe,
+	0x23, 0x6e, 0xf0, 0xe7, 0xea, 0x9f, 0x2f, 0xc1, 0x39, 0x86, 0x17, 0xfd, 0xa6, 0x02, 0x03, 0xbc,
+	0xd8, 0x88, 0x96, 0x62, 0xa0, 0xb5, 0x76, 0x90, 0xa7, 0x97, 0x3b, 0x21, 0xe5, 0x5b, 0xa8, 0xbe,
+	0xf1, 0xbd, 0x9f, 0xfe, 0xdb, 0xf7, 0xfb, 0x16, 0xd0, 0x5c, 0xb6, 0x5d, 0x1f, 0x3d, 0xfa, 0x33,
+	0x05, 0xc6, 0x9a, 0x3a, 0xbd, 0xd1, 0xea, 0xc9, 0xcb, 0x34, 0xf7, 0x93, 0xa7, 0xd7, 0xba, 0xe2,
+	0x11, 0x3a, 0x66, 0x99, 0x8e, 0x4b, 0xe8, 0xcd, 0xb6, 0x3a, 0x66, 0x5f, 0x8a, 0x42, 0xde, 0x2b,
+	0xf4, 0x17, 0x0a, 0x4c, 0xb4, 0xb6, 0x0c, 0xdd, 0x6e, 0xb7, 0x76, 0x5c, 0xa7, 0x79, 0xfa, 0xad,
+	0x2e, 0xb9, 0x84, 0xce, 0x2b, 0x4c, 0xe7, 0xeb, 0x68, 0x29, 0x46, 0xe7, 0xd6, 0xa6, 0x27, 0xf4,
+	0x9f, 0x0a, 0xcc, 0xb6, 0xe9, 0x92, 0x46, 0xf7, 0xba, 0xd2, 0xa4, 0xa5, 0xe7, 0x3b, 0x7d, 0xbf,
+	0x67, 0x7e, 0x81, 0x69, 0x8b, 0x61, 0xda, 0x40, 0xeb, 0x31, 0x98, 0xe4, 0xd7, 0x19, 0x37, 0xfb,
+	0x32, 0xf0, 0xed, 0xe6, 0x55, 0x14, 0xd6, 0x9f, 0x28, 0x30, 0xde, 0xbc, 0x24, 0x5a, 0xeb, 0x46,
+	0x41, 0x89, 0xea, 0x76, 0x77, 0x4c, 0x02, 0xca, 0x2e, 0x83, 0xb2, 0x8d, 0x3e, 0xea, 0xf8, 0x78,
+	0xb2, 0x2f, 0x43, 0xe5, 0xf9, 0x08, 0x54, 0xe8, 0x9f, 0x15, 0x98, 0x8e, 0x6e, 0x5f, 0x46, 0x77,
+	0xbb, 0xd1, 0x32, 0xd4, 0x83, 0x9d, 0x7e, 0xb7, 0x17, 0x56, 0x01, 0xf3, 0x31, 0x83, 0x99, 0x43,
+	0x1f, 0xf6, 0x0e, 0x53, 0x74, 0x3c, 0xff, 0x89, 0x02, 0xa3, 0xe1, 0x42, 0x22, 0x5a, 0x69, 0xa7,
+	0x58, 0x64, 0x29, 0x34, 0xbd, 0xda, 0x0d, 0x8b, 0xc0, 0x90, 0x61, 0x18, 0xae, 0xa1, 0xab, 0xd9,
+	0xd8, 0xff, 0x8b, 0x27, 0xd8, 0x98, 0x86, 0xfe, 0x5d, 0x81, 0x85, 0x13, 0xda, 0x51, 0x51, 0xae,
+	0x9d, 0x1e, 0x9d, 0xf5, 0xd6, 0xa6, 0x37, 0x4e, 0x25, 0x43, 0x80, 0x7b, 0x97, 0x81, 0xbb, 0x8d,
+	0x56, 0xbb, 0x38, 0x20, 0xfe, 0x11, 0xf5, 0x15, 0xfa, 0xf5, 0x3e, 0xb8, 0xda, 0x59, 0x1b, 0x28,
+	0xda, 0xea, 0x41, 0xd7, 0xe8, 0x0e, 0xd7, 0xf4, 0x93, 0xb3, 0x10, 0x25, 0xd0, 0x6f, 0x30, 0xf4,
+	0x1f, 0xa0, 0xf7, 0xba, 0x47, 0x9f, 0x2d, 0x1c, 0xf3, 0x8f, 0xc7, 0xe8, 0x7f, 0x15, 0x98, 0x6b,
+	0xdb, 0x17, 0x8e, 0x3e, 0xec, 0xc6, 0x83, 0x22, 0x41, 0xaf, 0x9f, 0x42, 0x82, 0xc0, 0xba, 0xc3,
+	0xb0, 0x3e, 0x41, 0x8f, 0x7b, 0x77, 0x45, 0x86, 0xd7, 0x3f, 0xff, 0xff, 0x52, 0xe0, 0x52, 0xbb,
+	0x86, 0x73, 0xd4, 0x55, 0xc0, 0x8f, 0xe8, 0x7c, 0x4f, 0x7f, 0xd8, 0xbb, 0x00, 0x81, 0xfa, 0x11,
+	0x43, 0xbd, 0x8e, 0xee, 0x9f, 0x12, 0x35, 0x4b, 0x40, 0x9a, 0x7a, 0x70, 0xdb, 0x27, 0x20, 0xd1,
+	0xfd, 0xbc, 0xe9, 0xb5, 0xae, 0x78, 0x3a, 0x4c, 0x40, 0x74, 0xc9, 0x27, 0x1a, 0x22, 0xd0, 0xd7,
+	0x11, 0x57, 0x79, 0x30, 0x74, 0x76, 0x75, 0x95, 0x47, 0xc4, 0xd1, 0xfb, 0x3d, 0xf3, 0x0b, 0x44,
+	0xdb, 0x0c, 0xd1, 0x23, 0xf4, 0xa0, 0xf7, 0x73, 0x09, 0xc6, 0xdc, 0xbf, 0x54, 0x60, 0x24, 0x14,
+	0xbe, 0xd1, 0xad, 0x8e, 0x23, 0xbd, 0xc4, 0xb4, 0xd2, 0x05, 0x87, 0x40, 0xb1, 0xc9, 0x50, 0xdc,
+	0x43, 0xef, 0x77, 0x76, 0x35, 0x64, 0x5f, 0x46, 0xa4, 0xfc, 0xaf, 0xd0, 0xdf, 0x29, 0x70, 0x31,
+	0xb6, 0xa7, 0x03, 0xbd, 0xdf, 0x4e, 0xad, 0x93, 0x9a, 0x4f, 0xd2, 0x1f, 0xf4, 0xc8, 0x2d, 0x00,
+	0xde, 0x66, 0x00, 0x33, 0xe8, 0x46, 0x0c, 0xc0, 0x50, 0x3f, 0xa3, 0x26, 0x7b, 0x46, 0xfe, 0x45,
+	0x81, 0x54, 0x9c, 0x6c, 0xf4, 0x5e, 0x2f, 0x1a, 0x49, 0x38, 0xef, 0xf7, 0xc6, 0x2c, 0xd0, 0x3c,
+	0x60, 0x68, 0xee, 0xa3, 0x0f, 0xba, 0x41, 0x93, 0x7d, 0x19, 0xfe, 0x4c, 0xff, 0x8a, 0x85, 0x82,
+	0xa6, 0xde, 0x8c, 0xf6, 0xa1, 0x20, 0xba, 0x63, 0x24, 0xbd, 0xd6, 0x15, 0x4f, 0x87, 0xa1, 0xa0,
+	0xb9, 0xc7, 0x04, 0x7d, 0xae, 0x44, 0x35, 0x2a, 0xb4, 0xcd, 0x5a, 0xe3, 0xda, 0x49, 0xd2, 0x6f,
+	0x75, 0xc9, 0x25, 0x74, 0x5e, 0x65, 0x3a, 0xdf, 0x40, 0xcb, 0x71, 0x3a, 0xfb, 0x5e, 0x21, 0xbb,
+	0x24, 0xd0, 0xdf, 0x28, 0x30, 0x15, 0xf9, 0xfd, 0x18, 0xbd, 0xd3, 0xf6, 0x09, 0xd7, 0xe6, 0x43,
+	0x78, 0xfa, 0x6e, 0x0f, 0x9c, 0x02, 0xc2, 0x1d, 0x06, 0xe1, 0x16, 0xca, 0xc4, 0x3d, 0x01, 0x39,
+	0xb7, 0xd6, 0x9c, 0x0c, 0xfe, 0x42, 0x81, 0xc9, 0xa8, 0x4a, 0x38, 0x7a, 0xbb, 0x9d, 0x2e, 0x6d,
+	0x8a, 0xfa, 0xe9, 0x77, 0xba, 0x67, 0x14, 0x18, 0xf2, 0x0c, 0xc3, 0x53, 0xf4, 0xe4, 0x34, 0xd1,
+	0x2a, 0x5b, 0x6b, 0xb8, 0x66, 0x79, 0x55, 0x13, 0x85, 0xfc, 0x7f, 0x50, 0x60, 0xbc, 0xb9, 0x5c,
+	0xde, 0xfe, 0x1d, 0x15, 0x53, 0xb7, 0x4f, 0xdf, 0xee, 0x8e, 0x49, 0x60, 0x7a, 0xce, 0x30, 0xed,
+	0xa0, 0x67, 0xa7, 0xc2, 0x14, 0x28, 0xea, 0xf3, 0x32, 0x3d, 0xfa, 0x2b, 0x05, 0x2e, 0x44, 0x54,
+	0x93, 0xd1, 0x9d, 0x4e, 0x76, 0xbf, 0xb5, 0x78, 0x9f, 0x7e, 0xbb, 0x6b, 0x3e, 0x01, 0x70, 0x8d,
+	0x01, 0xbc, 0x89, 0xae, 0xc7, 0xbe, 0x79, 0x5b, 0xab, 0xf4, 0xe8, 0x67, 0x4a, 0xd3, 0x47, 0x5d,
+	0x5e, 0x91, 0x6d, 0xaf, 0x7d, 0x7c, 0xc1, 0x38, 0xfd, 0x76, 0xd7, 0x7c, 0x42, 0xfb, 0xa7, 0x4c,
+	0xfb, 0x87, 0x68, 0xf3, 0x54, 0xc7, 0x43, 0x8e, 0x68, 0x79, 0xd4, 0x45, 0x7f, 0xa8, 0x00, 0xf8,
+	0x15, 0x48, 0x74, 0xb3, 0x7d, 0x2d, 0xa7, 0xa9, 0x06, 0x9a, 0xce, 0x74, 0x4a, 0x2e, 0x74, 0x5f,
+	0x66, 0xba, 0x5f, 0x41, 0x6a, 0x6c, 0xd5, 0xc7, 0xab, 0x9a, 0xa2, 0x2f, 0x14, 0x98, 0x6d, 0x53,
+	0xcb, 0x6c, 0x9f, 0x6f, 0x9d, 0x5c, 0x2f, 0x4d, 0xdf, 0xef, 0x99, 0x5f, 0x80, 0xb9, 0xc7, 0xc0,
+	0xbc, 0x83, 0xee, 0xc4, 0x80, 0xa9, 0xea, 0x2e, 0x69, 0xfd, 0x1f, 0xe1, 0x34, 0x17, 0x13, 0x8d,
+	0x16, 0x50, 0xd1, 0x1f, 0x29, 0x70, 0x5e, 0x54, 0x3f, 0x51, 0xdb, 0xf2, 0x5e, 0xb8, 0xc2, 0x9a,
+	0xbe, 0xde, 0x11, 0xad, 0x50, 0xf2, 0x2e, 0x53, 0x72, 0x0d, 0xad, 0x64, 0xe3, 0xfe, 0x99, 0x0b,
+	0xcd, 0xa4, 0x0c, 0xd9, 0x97, 0x4d, 0x55, 0xdb, 0x57, 0xb9, 0xa7, 0x3f, 0xfa, 0x72, 0x5e, 0xf9,
+	0xf1, 0x97, 0xf3, 0xca, 0xbf, 0x7e, 0x39, 0xaf, 0xfc, 0xde, 0x57, 0xf3, 0xaf, 0xfd, 0xf8, 0xab,
+	0xf9, 0xd7, 0xfe, 0xe9, 0xab, 0xf9, 0xd7, 0x7e, 0xe5, 0xc4, 0x4e, 0x80, 0xa3, 0xe0, 0x2a, 0xac,
+	0x2d, 0xa0, 0x30, 0xc0, 0xfe, 0x7d, 0x8c, 0xb5, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x52, 0x73,
+	0x97, 0x01, 0xdb, 0x44, 0x00, 0x00,
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ParamsByVersion queries the parameters of the module for a specific version of past params.
+	ParamsByVersion(ctx context.Context, in *QueryParamsByVersionRequest, opts ...grpc.CallOption) (*QueryParamsByVersionResponse, error)
+	// FinalityProviders queries all finality providers
+	FinalityProviders(ctx context.Context, in *QueryFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersResponse, error)
+	// FinalityProvidersByConsumer queries all finality providers securing the
+	// given consumer chain
+	FinalityProvidersByConsumer(ctx context.Context, in *QueryFinalityProvidersByConsumerRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersByConsumerResponse, error)
+	// FinalityProvider info about one finality provider
+	FinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error)
+	// FinalityProviderStatus queries the status report announced by a given
+	// finality provider
+	FinalityProviderStatus(ctx context.Context, in *QueryFinalityProviderStatusRequest, opts ...grpc.CallOption) (*QueryFinalityProviderStatusResponse, error)
+	// BTCDelegations queries all BTC delegations under a given status
+	BTCDelegations(ctx context.Context, in *QueryBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsResponse, error)
+	// ActiveFinalityProvidersAtHeight queries finality providers with non zero voting power at given height.
+	ActiveFinalityProvidersAtHeight(ctx context.Context, in *QueryActiveFinalityProvidersAtHeightRequest, opts ...grpc.CallOption) (*QueryActiveFinalityProvidersAtHeightResponse, error)
+	// ActiveFinalityProvidersByPowerAtHeight queries the active finality
+	// providers at a given height, sorted by voting power in descending order
+	ActiveFinalityProvidersByPowerAtHeight(ctx context.Context, in *QueryActiveFinalityProvidersByPowerAtHeightRequest, opts ...grpc.CallOption) (*QueryActiveFinalityProvidersByPowerAtHeightResponse, error)
+	// FinalityProviderPowerAtHeight queries the voting power of a finality provider at a given height
+	FinalityProviderPowerAtHeight(ctx context.Context, in *QueryFinalityProviderPowerAtHeightRequest, opts ...grpc.CallOption) (*QueryFinalityProviderPowerAtHeightResponse, error)
+	// FinalityProviderCurrentPower queries the voting power of a finality provider at the current height
+	FinalityProviderCurrentPower(ctx context.Context, in *QueryFinalityProviderCurrentPowerRequest, opts ...grpc.CallOption) (*QueryFinalityProviderCurrentPowerResponse, error)
+	// ActivatedHeight queries the height when BTC staking protocol is activated, i.e., the first height when
+	// there exists 1 finality provider with voting power
+	ActivatedHeight(ctx context.Context, in *QueryActivatedHeightRequest, opts ...grpc.CallOption) (*QueryActivatedHeightResponse, error)
+	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
+	FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error)
+	// BTCDelegation retrieves delegation by corresponding staking tx hash
+	BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error)
+	// SlashingRateChangeReports queries the reports of all slashing rate changes
+	SlashingRateChangeReports(ctx context.Context, in *QuerySlashingRateChangeReportsRequest, opts ...grpc.CallOption) (*QuerySlashingRateChangeReportsResponse, error)
+	// SlashingRateChangeReport queries the report of the slashing rate change
+	// made by the given params version
+	SlashingRateChangeReport(ctx context.Context, in *QuerySlashingRateChangeReportRequest, opts ...grpc.CallOption) (*QuerySlashingRateChangeReportResponse, error)
+	// ScheduledParams queries the params scheduled to take effect in the future
+	ScheduledParams(ctx context.Context, in *QueryScheduledParamsRequest, opts ...grpc.CallOption) (*QueryScheduledParamsResponse, error)
+	// StakingTxTemplate builds the unsigned transactions and the message that a
+	// wallet needs for staking with the given finality providers under the
+	// current parameters
+	StakingTxTemplate(ctx context.Context, in *QueryStakingTxTemplateRequest, opts ...grpc.CallOption) (*QueryStakingTxTemplateResponse, error)
+	// PendingBTCDelegations queries the BTC delegations that are waiting for
+	// covenant signatures, along with all data covenant members need for
+	// signing them. Covenant emulators are expected to poll it with the
+	// pagination key returned by the previous response.
+	PendingBTCDelegations(ctx context.Context, in *QueryPendingBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryPendingBTCDelegationsResponse, error)
+	// CovenantMuSig2Nonces queries the MuSig2 public nonces that covenant
+	// members have submitted for co-signing a BTC delegation
+	CovenantMuSig2Nonces(ctx context.Context, in *QueryCovenantMuSig2NoncesRequest, opts ...grpc.CallOption) (*QueryCovenantMuSig2NoncesResponse, error)
+	// WatchtowerBackup queries the backup data that the staker of a BTC
+	// delegation has deposited for its watchtower. The backup data is public
+	// ciphertext encrypted by the staker for the watchtower, so anyone can
+	// query it but only the watchtower can decrypt it
+	WatchtowerBackup(ctx context.Context, in *QueryWatchtowerBackupRequest, opts ...grpc.CallOption) (*QueryWatchtowerBackupResponse, error)
+	// CovenantPerformance queries the signing records of all covenant signers,
+	// so that governance can evaluate the covenant committee members
+	CovenantPerformance(ctx context.Context, in *QueryCovenantPerformanceRequest, opts ...grpc.CallOption) (*QueryCovenantPerformanceResponse, error)
+	// BTCDelegationTxFees estimates the virtual sizes and the minimum fees at a
+	// given fee rate of the slashing tx, the unbonding tx and the unbonding
+	// slashing tx of a BTC delegation
+	BTCDelegationTxFees(ctx context.Context, in *QueryBTCDelegationTxFeesRequest, opts ...grpc.CallOption) (*QueryBTCDelegationTxFeesResponse, error)
+	// PauseState queries whether the processing of new BTC delegations and
+	// early unbondings is paused
+	PauseState(ctx context.Context, in *QueryPauseStateRequest, opts ...grpc.CallOption) (*QueryPauseStateResponse, error)
+	// LastFinalityProviderSetDiff queries the last change of the active
+	// finality provider set
+	LastFinalityProviderSetDiff(ctx context.Context, in *QueryLastFinalityProviderSetDiffRequest, opts ...grpc.CallOption) (*QueryLastFinalityProviderSetDiffResponse, error)
+	// TxIndex queries the Babylon object that a BTC tx belongs to, i.e., the
+	// BTC delegation of a staking, unbonding or slashing tx, or the checkpoint
+	// submission of a checkpoint tx
+	TxIndex(ctx context.Context, in *QueryTxIndexRequest, opts ...grpc.CallOption) (*QueryTxIndexResponse, error)
 }
 
-func (m *QueryParamsByVersionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Version != 0 {
-		n += 1 + sovQuery(uint64(m.Version))
-	}
-	return n
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryParamsByVersionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryFinalityProvidersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFinalityProvidersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.FinalityProviders) > 0 {
-		for _, e := range m.FinalityProviders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) ParamsByVersion(ctx context.Context, in *QueryParamsByVersionRequest, opts ...grpc.CallOption) (*QueryParamsByVersionResponse, error) {
+	out := new(QueryParamsByVersionResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ParamsByVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFinalityProviderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) FinalityProviders(ctx context.Context, in *QueryFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersResponse, error) {
+	out := new(QueryFinalityProvidersResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFinalityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FinalityProvider != nil {
-		l = m.FinalityProvider.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) FinalityProvidersByConsumer(ctx context.Context, in *QueryFinalityProvidersByConsumerRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersByConsumerResponse, error) {
+	out := new(QueryFinalityProvidersByConsumerResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProvidersByConsumer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryBTCDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) FinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error) {
+	out := new(QueryFinalityProviderResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+	return out, nil
+}
+
+func (c *queryClient) FinalityProviderStatus(ctx context.Context, in *QueryFinalityProviderStatusRequest, opts ...grpc.CallOption) (*QueryFinalityProviderStatusResponse, error) {
+	out := new(QueryFinalityProviderStatusResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegations(ctx context.Context, in *QueryBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsResponse, error) {
+	out := new(QueryBTCDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryBTCDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.BtcDelegations) > 0 {
-		for _, e := range m.BtcDelegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) ActiveFinalityProvidersAtHeight(ctx context.Context, in *QueryActiveFinalityProvidersAtHeightRequest, opts ...grpc.CallOption) (*QueryActiveFinalityProvidersAtHeightResponse, error) {
+	out := new(QueryActiveFinalityProvidersAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ActiveFinalityProvidersAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFinalityProviderPowerAtHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+func (c *queryClient) ActiveFinalityProvidersByPowerAtHeight(ctx context.Context, in *QueryActiveFinalityProvidersByPowerAtHeightRequest, opts ...grpc.CallOption) (*QueryActiveFinalityProvidersByPowerAtHeightResponse, error) {
+	out := new(QueryActiveFinalityProvidersByPowerAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ActiveFinalityProvidersByPowerAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFinalityProviderPowerAtHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.VotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.VotingPower))
+func (c *queryClient) FinalityProviderPowerAtHeight(ctx context.Context, in *QueryFinalityProviderPowerAtHeightRequest, opts ...grpc.CallOption) (*QueryFinalityProviderPowerAtHeightResponse, error) {
+	out := new(QueryFinalityProviderPowerAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderPowerAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFinalityProviderCurrentPowerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) FinalityProviderCurrentPower(ctx context.Context, in *QueryFinalityProviderCurrentPowerRequest, opts ...grpc.CallOption) (*QueryFinalityProviderCurrentPowerResponse, error) {
+	out := new(QueryFinalityProviderCurrentPowerResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderCurrentPower", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFinalityProviderCurrentPowerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
-	if m.VotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.VotingPower))
+func (c *queryClient) ActivatedHeight(ctx context.Context, in *QueryActivatedHeightRequest, opts ...grpc.CallOption) (*QueryActivatedHeightResponse, error) {
+	out := new(QueryActivatedHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ActivatedHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryActiveFinalityProvidersAtHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error) {
+	out := new(QueryFinalityProviderDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryActiveFinalityProvidersAtHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.FinalityProviders) > 0 {
-		for _, e := range m.FinalityProviders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error) {
+	out := new(QueryBTCDelegationResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryActivatedHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) SlashingRateChangeReports(ctx context.Context, in *QuerySlashingRateChangeReportsRequest, opts ...grpc.CallOption) (*QuerySlashingRateChangeReportsResponse, error) {
+	out := new(QuerySlashingRateChangeReportsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/SlashingRateChangeReports", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryActivatedHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+func (c *queryClient) SlashingRateChangeReport(ctx context.Context, in *QuerySlashingRateChangeReportRequest, opts ...grpc.CallOption) (*QuerySlashingRateChangeReportResponse, error) {
+	out := new(QuerySlashingRateChangeReportResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/SlashingRateChangeReport", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) ScheduledParams(ctx context.Context, in *QueryScheduledParamsRequest, opts ...grpc.CallOption) (*QueryScheduledParamsResponse, error) {
+	out := new(QueryScheduledParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ScheduledParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.BtcDelegatorDelegations) > 0 {
-		for _, e := range m.BtcDelegatorDelegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) StakingTxTemplate(ctx context.Context, in *QueryStakingTxTemplateRequest, opts ...grpc.CallOption) (*QueryStakingTxTemplateResponse, error) {
+	out := new(QueryStakingTxTemplateResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/StakingTxTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryBTCDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StakingTxHashHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) PendingBTCDelegations(ctx context.Context, in *QueryPendingBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryPendingBTCDelegationsResponse, error) {
+	out := new(QueryPendingBTCDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/PendingBTCDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryBTCDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) CovenantMuSig2Nonces(ctx context.Context, in *QueryCovenantMuSig2NoncesRequest, opts ...grpc.CallOption) (*QueryCovenantMuSig2NoncesResponse, error) {
+	out := new(QueryCovenantMuSig2NoncesResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/CovenantMuSig2Nonces", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.BtcDelegation != nil {
-		l = m.BtcDelegation.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) WatchtowerBackup(ctx context.Context, in *QueryWatchtowerBackupRequest, opts ...grpc.CallOption) (*QueryWatchtowerBackupResponse, error) {
+	out := new(QueryWatchtowerBackupResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/WatchtowerBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *BTCDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) CovenantPerformance(ctx context.Context, in *QueryCovenantPerformanceRequest, opts ...grpc.CallOption) (*QueryCovenantPerformanceResponse, error) {
+	out := new(QueryCovenantPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/CovenantPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegationTxFees(ctx context.Context, in *QueryBTCDelegationTxFeesRequest, opts ...grpc.CallOption) (*QueryBTCDelegationTxFeesResponse, error) {
+	out := new(QueryBTCDelegationTxFeesResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegationTxFees", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.FpBtcPkList) > 0 {
-		for _, e := range m.FpBtcPkList {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) PauseState(ctx context.Context, in *QueryPauseStateRequest, opts ...grpc.CallOption) (*QueryPauseStateResponse, error) {
+	out := new(QueryPauseStateResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/PauseState", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.StartHeight != 0 {
-		n += 1 + sovQuery(uint64(m.StartHeight))
+	return out, nil
+}
+
+func (c *queryClient) LastFinalityProviderSetDiff(ctx context.Context, in *QueryLastFinalityProviderSetDiffRequest, opts ...grpc.CallOption) (*QueryLastFinalityProviderSetDiffResponse, error) {
+	out := new(QueryLastFinalityProviderSetDiffResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/LastFinalityProviderSetDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.EndHeight != 0 {
-		n += 1 + sovQuery(uint64(m.EndHeight))
+	return out, nil
+}
+
+func (c *queryClient) TxIndex(ctx context.Context, in *QueryTxIndexRequest, opts ...grpc.CallOption) (*QueryTxIndexResponse, error) {
+	out := new(QueryTxIndexResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/TxIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.TotalSat != 0 {
-		n += 1 + sovQuery(uint64(m.TotalSat))
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ParamsByVersion queries the parameters of the module for a specific version of past params.
+	ParamsByVersion(context.Context, *QueryParamsByVersionRequest) (*QueryParamsByVersionResponse, error)
+	// FinalityProviders queries all finality providers
+	FinalityProviders(context.Context, *QueryFinalityProvidersRequest) (*QueryFinalityProvidersResponse, error)
+	// FinalityProvidersByConsumer queries all finality providers securing the
+	// given consumer chain
+	FinalityProvidersByConsumer(context.Context, *QueryFinalityProvidersByConsumerRequest) (*QueryFinalityProvidersByConsumerResponse, error)
+	// FinalityProvider info about one finality provider
+	FinalityProvider(context.Context, *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error)
+	// FinalityProviderStatus queries the status report announced by a given
+	// finality provider
+	FinalityProviderStatus(context.Context, *QueryFinalityProviderStatusRequest) (*QueryFinalityProviderStatusResponse, error)
+	// BTCDelegations queries all BTC delegations under a given status
+	BTCDelegations(context.Context, *QueryBTCDelegationsRequest) (*QueryBTCDelegationsResponse, error)
+	// ActiveFinalityProvidersAtHeight queries finality providers with non zero voting power at given height.
+	ActiveFinalityProvidersAtHeight(context.Context, *QueryActiveFinalityProvidersAtHeightRequest) (*QueryActiveFinalityProvidersAtHeightResponse, error)
+	// ActiveFinalityProvidersByPowerAtHeight queries the active finality
+	// providers at a given height, sorted by voting power in descending order
+	ActiveFinalityProvidersByPowerAtHeight(context.Context, *QueryActiveFinalityProvidersByPowerAtHeightRequest) (*QueryActiveFinalityProvidersByPowerAtHeightResponse, error)
+	// FinalityProviderPowerAtHeight queries the voting power of a finality provider at a given height
+	FinalityProviderPowerAtHeight(context.Context, *QueryFinalityProviderPowerAtHeightRequest) (*QueryFinalityProviderPowerAtHeightResponse, error)
+	// FinalityProviderCurrentPower queries the voting power of a finality provider at the current height
+	FinalityProviderCurrentPower(context.Context, *QueryFinalityProviderCurrentPowerRequest) (*QueryFinalityProviderCurrentPowerResponse, error)
+	// ActivatedHeight queries the height when BTC staking protocol is activated, i.e., the first height when
+	// there exists 1 finality provider with voting power
+	ActivatedHeight(context.Context, *QueryActivatedHeightRequest) (*QueryActivatedHeightResponse, error)
+	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
+	FinalityProviderDelegations(context.Context, *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error)
+	// BTCDelegation retrieves delegation by corresponding staking tx hash
+	BTCDelegation(context.Context, *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error)
+	// SlashingRateChangeReports queries the reports of all slashing rate changes
+	SlashingRateChangeReports(context.Context, *QuerySlashingRateChangeReportsRequest) (*QuerySlashingRateChangeReportsResponse, error)
+	// SlashingRateChangeReport queries the report of the slashing rate change
+	// made by the given params version
+	SlashingRateChangeReport(context.Context, *QuerySlashingRateChangeReportRequest) (*QuerySlashingRateChangeReportResponse, error)
+	// ScheduledParams queries the params scheduled to take effect in the future
+	ScheduledParams(context.Context, *QueryScheduledParamsRequest) (*QueryScheduledParamsResponse, error)
+	// StakingTxTemplate builds the unsigned transactions and the message that a
+	// wallet needs for staking with the given finality providers under the
+	// current parameters
+	StakingTxTemplate(context.Context, *QueryStakingTxTemplateRequest) (*QueryStakingTxTemplateResponse, error)
+	// PendingBTCDelegations queries the BTC delegations that are waiting for
+	// covenant signatures, along with all data covenant members need for
+	// signing them. Covenant emulators are expected to poll it with the
+	// pagination key returned by the previous response.
+	PendingBTCDelegations(context.Context, *QueryPendingBTCDelegationsRequest) (*QueryPendingBTCDelegationsResponse, error)
+	// CovenantMuSig2Nonces queries the MuSig2 public nonces that covenant
+	// members have submitted for co-signing a BTC delegation
+	CovenantMuSig2Nonces(context.Context, *QueryCovenantMuSig2NoncesRequest) (*QueryCovenantMuSig2NoncesResponse, error)
+	// WatchtowerBackup queries the backup data that the staker of a BTC
+	// delegation has deposited for its watchtower. The backup data is public
+	// ciphertext encrypted by the staker for the watchtower, so anyone can
+	// query it but only the watchtower can decrypt it
+	WatchtowerBackup(context.Context, *QueryWatchtowerBackupRequest) (*QueryWatchtowerBackupResponse, error)
+	// CovenantPerformance queries the signing records of all covenant signers,
+	// so that governance can evaluate the covenant committee members
+	CovenantPerformance(context.Context, *QueryCovenantPerformanceRequest) (*QueryCovenantPerformanceResponse, error)
+	// BTCDelegationTxFees estimates the virtual sizes and the minimum fees at a
+	// given fee rate of the slashing tx, the unbonding tx and the unbonding
+	// slashing tx of a BTC delegation
+	BTCDelegationTxFees(context.Context, *QueryBTCDelegationTxFeesRequest) (*QueryBTCDelegationTxFeesResponse, error)
+	// PauseState queries whether the processing of new BTC delegations and
+	// early unbondings is paused
+	PauseState(context.Context, *QueryPauseStateRequest) (*QueryPauseStateResponse, error)
+	// LastFinalityProviderSetDiff queries the last change of the active
+	// finality provider set
+	LastFinalityProviderSetDiff(context.Context, *QueryLastFinalityProviderSetDiffRequest) (*QueryLastFinalityProviderSetDiffResponse, error)
+	// TxIndex queries the Babylon object that a BTC tx belongs to, i.e., the
+	// BTC delegation of a staking, unbonding or slashing tx, or the checkpoint
+	// submission of a checkpoint tx
+	TxIndex(context.Context, *QueryTxIndexRequest) (*QueryTxIndexResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ParamsByVersion(ctx context.Context, req *QueryParamsByVersionRequest) (*QueryParamsByVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsByVersion not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviders(ctx context.Context, req *QueryFinalityProvidersRequest) (*QueryFinalityProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviders not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProvidersByConsumer(ctx context.Context, req *QueryFinalityProvidersByConsumerRequest) (*QueryFinalityProvidersByConsumerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProvidersByConsumer not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProvider(ctx context.Context, req *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProvider not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderStatus(ctx context.Context, req *QueryFinalityProviderStatusRequest) (*QueryFinalityProviderStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderStatus not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegations(ctx context.Context, req *QueryBTCDelegationsRequest) (*QueryBTCDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegations not implemented")
+}
+func (*UnimplementedQueryServer) ActiveFinalityProvidersAtHeight(ctx context.Context, req *QueryActiveFinalityProvidersAtHeightRequest) (*QueryActiveFinalityProvidersAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveFinalityProvidersAtHeight not implemented")
+}
+func (*UnimplementedQueryServer) ActiveFinalityProvidersByPowerAtHeight(ctx context.Context, req *QueryActiveFinalityProvidersByPowerAtHeightRequest) (*QueryActiveFinalityProvidersByPowerAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveFinalityProvidersByPowerAtHeight not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderPowerAtHeight(ctx context.Context, req *QueryFinalityProviderPowerAtHeightRequest) (*QueryFinalityProviderPowerAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderPowerAtHeight not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderCurrentPower(ctx context.Context, req *QueryFinalityProviderCurrentPowerRequest) (*QueryFinalityProviderCurrentPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderCurrentPower not implemented")
+}
+func (*UnimplementedQueryServer) ActivatedHeight(ctx context.Context, req *QueryActivatedHeightRequest) (*QueryActivatedHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivatedHeight not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderDelegations(ctx context.Context, req *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderDelegations not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegation(ctx context.Context, req *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegation not implemented")
+}
+func (*UnimplementedQueryServer) SlashingRateChangeReports(ctx context.Context, req *QuerySlashingRateChangeReportsRequest) (*QuerySlashingRateChangeReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashingRateChangeReports not implemented")
+}
+func (*UnimplementedQueryServer) SlashingRateChangeReport(ctx context.Context, req *QuerySlashingRateChangeReportRequest) (*QuerySlashingRateChangeReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashingRateChangeReport not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledParams(ctx context.Context, req *QueryScheduledParamsRequest) (*QueryScheduledParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledParams not implemented")
+}
+func (*UnimplementedQueryServer) StakingTxTemplate(ctx context.Context, req *QueryStakingTxTemplateRequest) (*QueryStakingTxTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakingTxTemplate not implemented")
+}
+func (*UnimplementedQueryServer) PendingBTCDelegations(ctx context.Context, req *QueryPendingBTCDelegationsRequest) (*QueryPendingBTCDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingBTCDelegations not implemented")
+}
+func (*UnimplementedQueryServer) CovenantMuSig2Nonces(ctx context.Context, req *QueryCovenantMuSig2NoncesRequest) (*QueryCovenantMuSig2NoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CovenantMuSig2Nonces not implemented")
+}
+func (*UnimplementedQueryServer) WatchtowerBackup(ctx context.Context, req *QueryWatchtowerBackupRequest) (*QueryWatchtowerBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WatchtowerBackup not implemented")
+}
+func (*UnimplementedQueryServer) CovenantPerformance(ctx context.Context, req *QueryCovenantPerformanceRequest) (*QueryCovenantPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CovenantPerformance not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegationTxFees(ctx context.Context, req *QueryBTCDelegationTxFeesRequest) (*QueryBTCDelegationTxFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegationTxFees not implemented")
+}
+func (*UnimplementedQueryServer) PauseState(ctx context.Context, req *QueryPauseStateRequest) (*QueryPauseStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseState not implemented")
+}
+func (*UnimplementedQueryServer) LastFinalityProviderSetDiff(ctx context.Context, req *QueryLastFinalityProviderSetDiffRequest) (*QueryLastFinalityProviderSetDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastFinalityProviderSetDiff not implemented")
+}
+func (*UnimplementedQueryServer) TxIndex(ctx context.Context, req *QueryTxIndexRequest) (*QueryTxIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxIndex not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.StakingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	l = len(m.SlashingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/Params",
 	}
-	l = len(m.DelegatorSlashSigHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
 	}
-	if len(m.CovenantSigs) > 0 {
-		for _, e := range m.CovenantSigs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParamsByVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsByVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.StakingOutputIdx != 0 {
-		n += 1 + sovQuery(uint64(m.StakingOutputIdx))
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsByVersion(ctx, in)
 	}
-	if m.Active {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/ParamsByVersion",
 	}
-	l = len(m.StatusDesc)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsByVersion(ctx, req.(*QueryParamsByVersionRequest))
 	}
-	if m.UnbondingTime != 0 {
-		n += 1 + sovQuery(uint64(m.UnbondingTime))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.UndelegationResponse != nil {
-		l = m.UndelegationResponse.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviders(ctx, in)
 	}
-	if m.ParamsVersion != 0 {
-		n += 1 + sovQuery(uint64(m.ParamsVersion))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviders",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviders(ctx, req.(*QueryFinalityProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BTCUndelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_FinalityProvidersByConsumer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProvidersByConsumerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.UnbondingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProvidersByConsumer(ctx, in)
 	}
-	l = len(m.DelegatorUnbondingSigHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProvidersByConsumer",
 	}
-	if len(m.CovenantUnbondingSigList) > 0 {
-		for _, e := range m.CovenantUnbondingSigList {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProvidersByConsumer(ctx, req.(*QueryFinalityProvidersByConsumerRequest))
 	}
-	l = len(m.SlashingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.DelegatorSlashingSigHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProvider(ctx, in)
 	}
-	if len(m.CovenantSlashingSigs) > 0 {
-		for _, e := range m.CovenantSlashingSigs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProvider",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProvider(ctx, req.(*QueryFinalityProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BTCDelegatorDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_FinalityProviderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Dels) > 0 {
-		for _, e := range m.Dels {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderStatus(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderStatus(ctx, req.(*QueryFinalityProviderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FinalityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Description != nil {
-		l = m.Description.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func _Query_BTCDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Commission != nil {
-		l = m.Commission.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegations(ctx, in)
 	}
-	if m.BabylonPk != nil {
-		l = m.BabylonPk.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegations",
 	}
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegations(ctx, req.(*QueryBTCDelegationsRequest))
 	}
-	if m.Pop != nil {
-		l = m.Pop.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActiveFinalityProvidersAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveFinalityProvidersAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.MasterPubRand)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveFinalityProvidersAtHeight(ctx, in)
 	}
-	if m.RegisteredEpoch != 0 {
-		n += 1 + sovQuery(uint64(m.RegisteredEpoch))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/ActiveFinalityProvidersAtHeight",
 	}
-	if m.SlashedBabylonHeight != 0 {
-		n += 1 + sovQuery(uint64(m.SlashedBabylonHeight))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveFinalityProvidersAtHeight(ctx, req.(*QueryActiveFinalityProvidersAtHeightRequest))
 	}
-	if m.SlashedBtcHeight != 0 {
-		n += 1 + sovQuery(uint64(m.SlashedBtcHeight))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActiveFinalityProvidersByPowerAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveFinalityProvidersByPowerAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveFinalityProvidersByPowerAtHeight(ctx, in)
 	}
-	if m.VotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.VotingPower))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/ActiveFinalityProvidersByPowerAtHeight",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveFinalityProvidersByPowerAtHeight(ctx, req.(*QueryActiveFinalityProvidersByPowerAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func _Query_FinalityProviderPowerAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderPowerAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderPowerAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderPowerAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderPowerAtHeight(ctx, req.(*QueryFinalityProviderPowerAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func _Query_FinalityProviderCurrentPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderCurrentPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderCurrentPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderCurrentPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderCurrentPower(ctx, req.(*QueryFinalityProviderCurrentPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func _Query_ActivatedHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActivatedHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActivatedHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/ActivatedHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActivatedHeight(ctx, req.(*QueryActivatedHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProviderDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderDelegations(ctx, req.(*QueryFinalityProviderDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegation(ctx, req.(*QueryBTCDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashingRateChangeReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashingRateChangeReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashingRateChangeReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/SlashingRateChangeReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashingRateChangeReports(ctx, req.(*QuerySlashingRateChangeReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashingRateChangeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashingRateChangeReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashingRateChangeReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/SlashingRateChangeReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashingRateChangeReport(ctx, req.(*QuerySlashingRateChangeReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/ScheduledParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledParams(ctx, req.(*QueryScheduledParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StakingTxTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakingTxTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StakingTxTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/StakingTxTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakingTxTemplate(ctx, req.(*QueryStakingTxTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingBTCDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingBTCDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingBTCDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/PendingBTCDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingBTCDelegations(ctx, req.(*QueryPendingBTCDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CovenantMuSig2Nonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCovenantMuSig2NoncesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CovenantMuSig2Nonces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/CovenantMuSig2Nonces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CovenantMuSig2Nonces(ctx, req.(*QueryCovenantMuSig2NoncesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WatchtowerBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWatchtowerBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WatchtowerBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/WatchtowerBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WatchtowerBackup(ctx, req.(*QueryWatchtowerBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CovenantPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCovenantPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CovenantPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/CovenantPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CovenantPerformance(ctx, req.(*QueryCovenantPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegationTxFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationTxFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegationTxFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegationTxFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegationTxFees(ctx, req.(*QueryBTCDelegationTxFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PauseState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauseStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PauseState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/PauseState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PauseState(ctx, req.(*QueryPauseStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LastFinalityProviderSetDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastFinalityProviderSetDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastFinalityProviderSetDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/LastFinalityProviderSetDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastFinalityProviderSetDiff(ctx, req.(*QueryLastFinalityProviderSetDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TxIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/TxIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxIndex(ctx, req.(*QueryTxIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.btcstaking.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ParamsByVersion",
+			Handler:    _Query_ParamsByVersion_Handler,
+		},
+		{
+			MethodName: "FinalityProviders",
+			Handler:    _Query_FinalityProviders_Handler,
+		},
+		{
+			MethodName: "FinalityProvidersByConsumer",
+			Handler:    _Query_FinalityProvidersByConsumer_Handler,
+		},
+		{
+			MethodName: "FinalityProvider",
+			Handler:    _Query_FinalityProvider_Handler,
+		},
+		{
+			MethodName: "FinalityProviderStatus",
+			Handler:    _Query_FinalityProviderStatus_Handler,
+		},
+		{
+			MethodName: "BTCDelegations",
+			Handler:    _Query_BTCDelegations_Handler,
+		},
+		{
+			MethodName: "ActiveFinalityProvidersAtHeight",
+			Handler:    _Query_ActiveFinalityProvidersAtHeight_Handler,
+		},
+		{
+			MethodName: "ActiveFinalityProvidersByPowerAtHeight",
+			Handler:    _Query_ActiveFinalityProvidersByPowerAtHeight_Handler,
+		},
+		{
+			MethodName: "FinalityProviderPowerAtHeight",
+			Handler:    _Query_FinalityProviderPowerAtHeight_Handler,
+		},
+		{
+			MethodName: "FinalityProviderCurrentPower",
+			Handler:    _Query_FinalityProviderCurrentPower_Handler,
+		},
+		{
+			MethodName: "ActivatedHeight",
+			Handler:    _Query_ActivatedHeight_Handler,
+		},
+		{
+			MethodName: "FinalityProviderDelegations",
+			Handler:    _Query_FinalityProviderDelegations_Handler,
+		},
+		{
+			MethodName: "BTCDelegation",
+			Handler:    _Query_BTCDelegation_Handler,
+		},
+		{
+			MethodName: "SlashingRateChangeReports",
+			Handler:    _Query_SlashingRateChangeReports_Handler,
+		},
+		{
+			MethodName: "SlashingRateChangeReport",
+			Handler:    _Query_SlashingRateChangeReport_Handler,
+		},
+		{
+			MethodName: "ScheduledParams",
+			Handler:    _Query_ScheduledParams_Handler,
+		},
+		{
+			MethodName: "StakingTxTemplate",
+			Handler:    _Query_StakingTxTemplate_Handler,
+		},
+		{
+			MethodName: "PendingBTCDelegations",
+			Handler:    _Query_PendingBTCDelegations_Handler,
+		},
+		{
+			MethodName: "CovenantMuSig2Nonces",
+			Handler:    _Query_CovenantMuSig2Nonces_Handler,
+		},
+		{
+			MethodName: "WatchtowerBackup",
+			Handler:    _Query_WatchtowerBackup_Handler,
+		},
+		{
+			MethodName: "CovenantPerformance",
+			Handler:    _Query_CovenantPerformance_Handler,
+		},
+		{
+			MethodName: "BTCDelegationTxFees",
+			Handler:    _Query_BTCDelegationTxFees_Handler,
+		},
+		{
+			MethodName: "PauseState",
+			Handler:    _Query_PauseState_Handler,
+		},
+		{
+			MethodName: "LastFinalityProviderSetDiff",
+			Handler:    _Query_LastFinalityProviderSetDiff_Handler,
+		},
+		{
+			MethodName: "TxIndex",
+			Handler:    _Query_TxIndex_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylon/btcstaking/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsByVersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsByVersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsByVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsByVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsByVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsByVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProvidersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FinalityProviders) > 0 {
+		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProvidersByConsumerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProvidersByConsumerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProvidersByConsumerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProvidersByConsumerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProvidersByConsumerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProvidersByConsumerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FinalityProviders) > 0 {
+		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalityProvider != nil {
+		{
+			size, err := m.FinalityProvider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InWindow {
+		i--
+		if m.InWindow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Report != nil {
+		{
+			size, err := m.Report.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderPowerAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderPowerAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderPowerAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderPowerAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderPowerAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderPowerAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderCurrentPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderCurrentPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderCurrentPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderCurrentPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderCurrentPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderCurrentPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FinalityProviders) > 0 {
+		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveFinalityProvidersByPowerAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveFinalityProvidersByPowerAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveFinalityProvidersByPowerAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveFinalityProvidersByPowerAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveFinalityProvidersByPowerAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveFinalityProvidersByPowerAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NumActiveFinalityProviders != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumActiveFinalityProviders))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FinalityProviders) > 0 {
+		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActivatedHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActivatedHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActivatedHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActivatedHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActivatedHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActivatedHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcDelegatorDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegatorDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegatorDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcDelegation != nil {
+		{
+			size, err := m.BtcDelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawalBtcAddress) > 0 {
+		i -= len(m.WithdrawalBtcAddress)
+		copy(dAtA[i:], m.WithdrawalBtcAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawalBtcAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.RewardAddress) > 0 {
+		i -= len(m.RewardAddress)
+		copy(dAtA[i:], m.RewardAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RewardAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.SlashingAddress) > 0 {
+		i -= len(m.SlashingAddress)
+		copy(dAtA[i:], m.SlashingAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	{
+		size := m.SlashingRate.Size()
+		i -= size
+		if _, err := m.SlashingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.ScriptTemplateVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ScriptTemplateVersion))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.ParamsVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.UndelegationResponse != nil {
+		{
+			size, err := m.UndelegationResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.UnbondingTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingTime))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.StatusDesc) > 0 {
+		i -= len(m.StatusDesc)
+		copy(dAtA[i:], m.StatusDesc)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StatusDesc)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.StakingOutputIdx != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingOutputIdx))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.CovenantSigs) > 0 {
+		for iNdEx := len(m.CovenantSigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DelegatorSlashSigHex) > 0 {
+		i -= len(m.DelegatorSlashSigHex)
+		copy(dAtA[i:], m.DelegatorSlashSigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashSigHex)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.SlashingTxHex) > 0 {
+		i -= len(m.SlashingTxHex)
+		copy(dAtA[i:], m.SlashingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.StakingTxHex) > 0 {
+		i -= len(m.StakingTxHex)
+		copy(dAtA[i:], m.StakingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHex)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TotalSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSat))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.FpBtcPkList[iNdEx].Size()
+				i -= size
+				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCUndelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCUndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCUndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CovenantSlashingSigs) > 0 {
+		for iNdEx := len(m.CovenantSlashingSigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantSlashingSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DelegatorSlashingSigHex) > 0 {
+		i -= len(m.DelegatorSlashingSigHex)
+		copy(dAtA[i:], m.DelegatorSlashingSigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashingSigHex)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SlashingTxHex) > 0 {
+		i -= len(m.SlashingTxHex)
+		copy(dAtA[i:], m.SlashingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CovenantUnbondingSigList) > 0 {
+		for iNdEx := len(m.CovenantUnbondingSigList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantUnbondingSigList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DelegatorUnbondingSigHex) > 0 {
+		i -= len(m.DelegatorUnbondingSigHex)
+		copy(dAtA[i:], m.DelegatorUnbondingSigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorUnbondingSigHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UnbondingTxHex) > 0 {
+		i -= len(m.UnbondingTxHex)
+		copy(dAtA[i:], m.UnbondingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingTxHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegatorDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegatorDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dels) > 0 {
+		for iNdEx := len(m.Dels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.VotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.SlashedBtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBtcHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SlashedBabylonHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBabylonHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RegisteredEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RegisteredEpoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MasterPubRand) > 0 {
+		i -= len(m.MasterPubRand)
+		copy(dAtA[i:], m.MasterPubRand)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MasterPubRand)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Pop != nil {
+		{
+			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BabylonPk != nil {
+		{
+			size, err := m.BabylonPk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Commission != nil {
+		{
+			size := m.Commission.Size()
+			i -= size
+			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Description != nil {
+		{
+			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashingRateChangeReportsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashingRateChangeReportsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashingRateChangeReportsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashingRateChangeReportsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashingRateChangeReportsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashingRateChangeReportsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reports) > 0 {
+		for iNdEx := len(m.Reports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashingRateChangeReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashingRateChangeReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashingRateChangeReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ParamsVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashingRateChangeReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashingRateChangeReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashingRateChangeReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Report != nil {
+		{
+			size, err := m.Report.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduledParams != nil {
+		{
+			size, err := m.ScheduledParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingTxTemplateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingTxTemplateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingTxTemplateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingFeeSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingFeeSat))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UnbondingTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StakingTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StakingValue != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingValue))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FpBtcPkHexList) > 0 {
+		for iNdEx := len(m.FpBtcPkHexList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FpBtcPkHexList[iNdEx])
+			copy(dAtA[i:], m.FpBtcPkHexList[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHexList[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.StakerBtcPkHex) > 0 {
+		i -= len(m.StakerBtcPkHex)
+		copy(dAtA[i:], m.StakerBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingTxTemplateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingTxTemplateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingTxTemplateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Template != nil {
+		{
+			size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakingTxTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakingTxTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakingTxTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MsgCreateBtcDelegation != nil {
+		{
+			size, err := m.MsgCreateBtcDelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.UnbondingSlashingTxHex) > 0 {
+		i -= len(m.UnbondingSlashingTxHex)
+		copy(dAtA[i:], m.UnbondingSlashingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingSlashingTxHex)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.UnbondingTxHex) > 0 {
+		i -= len(m.UnbondingTxHex)
+		copy(dAtA[i:], m.UnbondingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingTxHex)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.SlashingTxHex) > 0 {
+		i -= len(m.SlashingTxHex)
+		copy(dAtA[i:], m.SlashingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.SlashingScriptHex) > 0 {
+		i -= len(m.SlashingScriptHex)
+		copy(dAtA[i:], m.SlashingScriptHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingScriptHex)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.UnbondingScriptHex) > 0 {
+		i -= len(m.UnbondingScriptHex)
+		copy(dAtA[i:], m.UnbondingScriptHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingScriptHex)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TimelockScriptHex) > 0 {
+		i -= len(m.TimelockScriptHex)
+		copy(dAtA[i:], m.TimelockScriptHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TimelockScriptHex)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StakingOutputPkScriptHex) > 0 {
+		i -= len(m.StakingOutputPkScriptHex)
+		copy(dAtA[i:], m.StakingOutputPkScriptHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingOutputPkScriptHex)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StakingOutputIdx != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingOutputIdx))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.StakingTxHex) > 0 {
+		i -= len(m.StakingTxHex)
+		copy(dAtA[i:], m.StakingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ParamsVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TemplateVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TemplateVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingBTCDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingBTCDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingBTCDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CovenantPkHex) > 0 {
+		i -= len(m.CovenantPkHex)
+		copy(dAtA[i:], m.CovenantPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CovenantPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingBTCDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingBTCDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingBTCDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WorkItems) > 0 {
+		for iNdEx := len(m.WorkItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WorkItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CovenantSigningWorkItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CovenantSigningWorkItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CovenantSigningWorkItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnbondingSlashingPathScriptHex) > 0 {
+		i -= len(m.UnbondingSlashingPathScriptHex)
+		copy(dAtA[i:], m.UnbondingSlashingPathScriptHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingSlashingPathScriptHex)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.UnbondingOutputValue != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingOutputValue))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.UnbondingOutputPkScriptHex) > 0 {
+		i -= len(m.UnbondingOutputPkScriptHex)
+		copy(dAtA[i:], m.UnbondingOutputPkScriptHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingOutputPkScriptHex)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.StakingUnbondingPathScriptHex) > 0 {
+		i -= len(m.StakingUnbondingPathScriptHex)
+		copy(dAtA[i:], m.StakingUnbondingPathScriptHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingUnbondingPathScriptHex)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.StakingSlashingPathScriptHex) > 0 {
+		i -= len(m.StakingSlashingPathScriptHex)
+		copy(dAtA[i:], m.StakingSlashingPathScriptHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingSlashingPathScriptHex)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.StakingOutputValue != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingOutputValue))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.StakingOutputPkScriptHex) > 0 {
+		i -= len(m.StakingOutputPkScriptHex)
+		copy(dAtA[i:], m.StakingOutputPkScriptHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingOutputPkScriptHex)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CovenantQuorum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CovenantQuorum))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CovenantPks) > 0 {
+		for iNdEx := len(m.CovenantPks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CovenantPks[iNdEx].Size()
+				i -= size
+				if _, err := m.CovenantPks[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BtcDelegation != nil {
+		{
+			size, err := m.BtcDelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegationBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegationBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegationBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashingAddress) > 0 {
+		i -= len(m.SlashingAddress)
+		copy(dAtA[i:], m.SlashingAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingAddress)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.UnbondingTimelockPath != nil {
+		{
+			size, err := m.UnbondingTimelockPath.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.UnbondingOutputValue != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingOutputValue))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.UnbondingOutputPkScriptHex) > 0 {
+		i -= len(m.UnbondingOutputPkScriptHex)
+		copy(dAtA[i:], m.UnbondingOutputPkScriptHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingOutputPkScriptHex)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.StakingUnbondingPath != nil {
+		{
+			size, err := m.StakingUnbondingPath.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.StakingTimelockPath != nil {
+		{
+			size, err := m.StakingTimelockPath.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.StakingOutputValue != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingOutputValue))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.StakingOutputPkScriptHex) > 0 {
+		i -= len(m.StakingOutputPkScriptHex)
+		copy(dAtA[i:], m.StakingOutputPkScriptHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingOutputPkScriptHex)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CovenantQuorum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CovenantQuorum))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CovenantPks) > 0 {
+		for iNdEx := len(m.CovenantPks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CovenantPks[iNdEx].Size()
+				i -= size
+				if _, err := m.CovenantPks[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.BtcDelegation != nil {
+		{
+			size, err := m.BtcDelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcNetwork) > 0 {
+		i -= len(m.BtcNetwork)
+		copy(dAtA[i:], m.BtcNetwork)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BtcNetwork)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpendPath) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendPath) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendPath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ControlBlockHex) > 0 {
+		i -= len(m.ControlBlockHex)
+		copy(dAtA[i:], m.ControlBlockHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ControlBlockHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScriptHex) > 0 {
+		i -= len(m.ScriptHex)
+		copy(dAtA[i:], m.ScriptHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScriptHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantMuSig2NoncesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantMuSig2NoncesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantMuSig2NoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantMuSig2NoncesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantMuSig2NoncesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantMuSig2NoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AggregateCovenantPkHex) > 0 {
+		i -= len(m.AggregateCovenantPkHex)
+		copy(dAtA[i:], m.AggregateCovenantPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AggregateCovenantPkHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nonces) > 0 {
+		for iNdEx := len(m.Nonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWatchtowerBackupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWatchtowerBackupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWatchtowerBackupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWatchtowerBackupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWatchtowerBackupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWatchtowerBackupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncryptedBackup) > 0 {
+		i -= len(m.EncryptedBackup)
+		copy(dAtA[i:], m.EncryptedBackup)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EncryptedBackup)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WatchtowerAddress) > 0 {
+		i -= len(m.WatchtowerAddress)
+		copy(dAtA[i:], m.WatchtowerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WatchtowerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantPerformanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantPerformanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantPerformanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantPerformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CovenantPerformances) > 0 {
+		for iNdEx := len(m.CovenantPerformances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantPerformances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CovenantPerformanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CovenantPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CovenantPerformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InCommittee {
+		i--
+		if m.InCommittee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NumMissed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumMissed))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.AverageSigningBlocks.Size()
+		i -= size
+		if _, err := m.AverageSigningBlocks.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.NumSigned != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumSigned))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CovPkHex) > 0 {
+		i -= len(m.CovPkHex)
+		copy(dAtA[i:], m.CovPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CovPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationTxFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationTxFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationTxFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeRate != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FeeRate))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationTxFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationTxFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationTxFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingSlashingTx != nil {
+		{
+			size, err := m.UnbondingSlashingTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.UnbondingTx != nil {
+		{
+			size, err := m.UnbondingTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SlashingTx != nil {
+		{
+			size, err := m.SlashingTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxFeeEstimate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxFeeEstimate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxFeeEstimate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sufficient {
+		i--
+		if m.Sufficient {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Fee != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinFee != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinFee))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.VirtualSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VirtualSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PauseState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastFinalityProviderSetDiffRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastFinalityProviderSetDiffRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastFinalityProviderSetDiffRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastFinalityProviderSetDiffResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastFinalityProviderSetDiffResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastFinalityProviderSetDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Diff != nil {
+		{
+			size, err := m.Diff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BtcTxHashHex) > 0 {
+		i -= len(m.BtcTxHashHex)
+		copy(dAtA[i:], m.BtcTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BtcTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubmissionKey != nil {
+		{
+			size, err := m.SubmissionKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TxType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryParamsByVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryParamsByVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFinalityProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FinalityProviders) > 0 {
+		for _, e := range m.FinalityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProvidersByConsumerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProvidersByConsumerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FinalityProviders) > 0 {
+		for _, e := range m.FinalityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FinalityProvider != nil {
+		l = m.FinalityProvider.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Report != nil {
+		l = m.Report.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InWindow {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderPowerAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderPowerAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderCurrentPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderCurrentPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	return n
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FinalityProviders) > 0 {
+		for _, e := range m.FinalityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveFinalityProvidersByPowerAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveFinalityProvidersByPowerAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FinalityProviders) > 0 {
+		for _, e := range m.FinalityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalVotingPower))
+	}
+	if m.NumActiveFinalityProviders != 0 {
+		n += 1 + sovQuery(uint64(m.NumActiveFinalityProviders))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActivatedHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryActivatedHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegatorDelegations) > 0 {
+		for _, e := range m.BtcDelegatorDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcDelegation != nil {
+		l = m.BtcDelegation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for _, e := range m.FpBtcPkList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.TotalSat != 0 {
+		n += 1 + sovQuery(uint64(m.TotalSat))
+	}
+	l = len(m.StakingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SlashingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegatorSlashSigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CovenantSigs) > 0 {
+		for _, e := range m.CovenantSigs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.StakingOutputIdx != 0 {
+		n += 1 + sovQuery(uint64(m.StakingOutputIdx))
+	}
+	if m.Active {
+		n += 2
+	}
+	l = len(m.StatusDesc)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UnbondingTime != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondingTime))
+	}
+	if m.UndelegationResponse != nil {
+		l = m.UndelegationResponse.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ParamsVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ParamsVersion))
+	}
+	if m.ScriptTemplateVersion != 0 {
+		n += 2 + sovQuery(uint64(m.ScriptTemplateVersion))
+	}
+	l = m.SlashingRate.Size()
+	n += 2 + l + sovQuery(uint64(l))
+	l = len(m.SlashingAddress)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 2 + sovQuery(uint64(m.ActivationHeight))
+	}
+	l = len(m.RewardAddress)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	l = len(m.WithdrawalBtcAddress)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCUndelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UnbondingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegatorUnbondingSigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CovenantUnbondingSigList) > 0 {
+		for _, e := range m.CovenantUnbondingSigList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.SlashingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegatorSlashingSigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CovenantSlashingSigs) > 0 {
+		for _, e := range m.CovenantSlashingSigs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BTCDelegatorDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Dels) > 0 {
+		for _, e := range m.Dels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Description != nil {
+		l = m.Description.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Commission != nil {
+		l = m.Commission.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BabylonPk != nil {
+		l = m.BabylonPk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pop != nil {
+		l = m.Pop.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MasterPubRand)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RegisteredEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.RegisteredEpoch))
+	}
+	if m.SlashedBabylonHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SlashedBabylonHeight))
+	}
+	if m.SlashedBtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SlashedBtcHeight))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashingRateChangeReportsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashingRateChangeReportsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reports) > 0 {
+		for _, e := range m.Reports {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashingRateChangeReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParamsVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ParamsVersion))
+	}
+	return n
+}
+
+func (m *QuerySlashingRateChangeReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Report != nil {
+		l = m.Report.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryScheduledParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduledParams != nil {
+		l = m.ScheduledParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakingTxTemplateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.FpBtcPkHexList) > 0 {
+		for _, s := range m.FpBtcPkHexList {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.StakingValue != 0 {
+		n += 1 + sovQuery(uint64(m.StakingValue))
+	}
+	if m.StakingTime != 0 {
+		n += 1 + sovQuery(uint64(m.StakingTime))
+	}
+	if m.UnbondingTime != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondingTime))
+	}
+	if m.UnbondingFeeSat != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondingFeeSat))
+	}
+	return n
+}
+
+func (m *QueryStakingTxTemplateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Template != nil {
+		l = m.Template.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *StakingTxTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TemplateVersion != 0 {
+		n += 1 + sovQuery(uint64(m.TemplateVersion))
+	}
+	if m.ParamsVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ParamsVersion))
+	}
+	l = len(m.StakingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StakingOutputIdx != 0 {
+		n += 1 + sovQuery(uint64(m.StakingOutputIdx))
+	}
+	l = len(m.StakingOutputPkScriptHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TimelockScriptHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UnbondingScriptHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SlashingScriptHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SlashingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UnbondingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UnbondingSlashingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MsgCreateBtcDelegation != nil {
+		l = m.MsgCreateBtcDelegation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingBTCDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CovenantPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingBTCDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WorkItems) > 0 {
+		for _, e := range m.WorkItems {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CovenantSigningWorkItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BtcDelegation != nil {
+		l = m.BtcDelegation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CovenantPks) > 0 {
+		for _, e := range m.CovenantPks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CovenantQuorum != 0 {
+		n += 1 + sovQuery(uint64(m.CovenantQuorum))
+	}
+	l = len(m.StakingOutputPkScriptHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StakingOutputValue != 0 {
+		n += 1 + sovQuery(uint64(m.StakingOutputValue))
+	}
+	l = len(m.StakingSlashingPathScriptHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakingUnbondingPathScriptHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UnbondingOutputPkScriptHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UnbondingOutputValue != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondingOutputValue))
+	}
+	l = len(m.UnbondingSlashingPathScriptHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCDelegationBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BtcNetwork)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BtcDelegation != nil {
+		l = m.BtcDelegation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CovenantPks) > 0 {
+		for _, e := range m.CovenantPks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CovenantQuorum != 0 {
+		n += 1 + sovQuery(uint64(m.CovenantQuorum))
+	}
+	l = len(m.StakingOutputPkScriptHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StakingOutputValue != 0 {
+		n += 1 + sovQuery(uint64(m.StakingOutputValue))
+	}
+	if m.StakingTimelockPath != nil {
+		l = m.StakingTimelockPath.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StakingUnbondingPath != nil {
+		l = m.StakingUnbondingPath.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UnbondingOutputPkScriptHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UnbondingOutputValue != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondingOutputValue))
+	}
+	if m.UnbondingTimelockPath != nil {
+		l = m.UnbondingTimelockPath.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SlashingAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SpendPath) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScriptHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ControlBlockHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCovenantMuSig2NoncesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCovenantMuSig2NoncesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nonces) > 0 {
+		for _, e := range m.Nonces {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.AggregateCovenantPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWatchtowerBackupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWatchtowerBackupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WatchtowerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EncryptedBackup)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCovenantPerformanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCovenantPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CovenantPerformances) > 0 {
+		for _, e := range m.CovenantPerformances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CovenantPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CovPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumSigned != 0 {
+		n += 1 + sovQuery(uint64(m.NumSigned))
+	}
+	l = m.AverageSigningBlocks.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.NumMissed != 0 {
+		n += 1 + sovQuery(uint64(m.NumMissed))
+	}
+	if m.InCommittee {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationTxFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FeeRate != 0 {
+		n += 1 + sovQuery(uint64(m.FeeRate))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationTxFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashingTx != nil {
+		l = m.SlashingTx.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UnbondingTx != nil {
+		l = m.UnbondingTx.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UnbondingSlashingTx != nil {
+		l = m.UnbondingSlashingTx.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TxFeeEstimate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VirtualSize != 0 {
+		n += 1 + sovQuery(uint64(m.VirtualSize))
+	}
+	if m.MinFee != 0 {
+		n += 1 + sovQuery(uint64(m.MinFee))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovQuery(uint64(m.Fee))
+	}
+	if m.Sufficient {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPauseStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPauseStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PauseState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLastFinalityProviderSetDiffRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLastFinalityProviderSetDiffResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Diff != nil {
+		l = m.Diff.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BtcTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxType != 0 {
+		n += 1 + sovQuery(uint64(m.TxType))
+	}
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	if m.SubmissionKey != nil {
+		l = m.SubmissionKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByVersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProvidersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProvidersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderResponse{})
+			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProvidersByConsumerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProvidersByConsumerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProvidersByConsumerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProvidersByConsumerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProvidersByConsumerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProvidersByConsumerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderResponse{})
+			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalityProvider == nil {
+				m.FinalityProvider = &FinalityProviderResponse{}
+			}
+			if err := m.FinalityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Report == nil {
+				m.Report = &FinalityProviderStatusReport{}
+			}
+			if err := m.Report.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InWindow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InWindow = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BTCDelegationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderPowerAtHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderPowerAtHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderCurrentPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderWithMeta{})
+			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveFinalityProvidersByPowerAtHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveFinalityProvidersByPowerAtHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveFinalityProvidersByPowerAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveFinalityProvidersByPowerAtHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveFinalityProvidersByPowerAtHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveFinalityProvidersByPowerAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderWithMeta{})
+			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumActiveFinalityProviders", wireType)
+			}
+			m.NumActiveFinalityProviders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumActiveFinalityProviders |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivatedHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivatedHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivatedHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivatedHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivatedHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivatedHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegatorDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegatorDelegations = append(m.BtcDelegatorDelegations, &BTCDelegatorDelegationsResponse{})
+			if err := m.BtcDelegatorDelegations[len(m.BtcDelegatorDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BtcDelegation == nil {
+				m.BtcDelegation = &BTCDelegationResponse{}
+			}
+			if err := m.BtcDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPkList = append(m.FpBtcPkList, v)
+			if err := m.FpBtcPkList[len(m.FpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
+			}
+			m.TotalSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashSigHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorSlashSigHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantSigs = append(m.CovenantSigs, &CovenantAdaptorSignatures{})
+			if err := m.CovenantSigs[len(m.CovenantSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputIdx", wireType)
+			}
+			m.StakingOutputIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingOutputIdx |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusDesc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusDesc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			m.UnbondingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UndelegationResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UndelegationResponse == nil {
+				m.UndelegationResponse = &BTCUndelegationResponse{}
+			}
+			if err := m.UndelegationResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+			}
+			m.ParamsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParamsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptTemplateVersion", wireType)
+			}
+			m.ScriptTemplateVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScriptTemplateVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalBtcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalBtcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCUndelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCUndelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSigHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorUnbondingSigHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantUnbondingSigList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantUnbondingSigList = append(m.CovenantUnbondingSigList, &SignatureInfo{})
+			if err := m.CovenantUnbondingSigList[len(m.CovenantUnbondingSigList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSigHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorSlashingSigHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSlashingSigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantSlashingSigs = append(m.CovenantSlashingSigs, &CovenantAdaptorSignatures{})
+			if err := m.CovenantSlashingSigs[len(m.CovenantSlashingSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCDelegatorDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCDelegatorDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCDelegatorDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dels = append(m.Dels, &BTCDelegationResponse{})
+			if err := m.Dels[len(m.Dels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Description == nil {
+				m.Description = &types.Description{}
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Commission = &v
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonPk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BabylonPk == nil {
+				m.BabylonPk = &secp256k1.PubKey{}
+			}
+			if err := m.BabylonPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pop == nil {
+				m.Pop = &ProofOfPossession{}
+			}
+			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterPubRand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MasterPubRand = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredEpoch", wireType)
+			}
+			m.RegisteredEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegisteredEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBabylonHeight", wireType)
+			}
+			m.SlashedBabylonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedBabylonHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBtcHeight", wireType)
+			}
+			m.SlashedBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashingRateChangeReportsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashingRateChangeReportsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashingRateChangeReportsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashingRateChangeReportsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashingRateChangeReportsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashingRateChangeReportsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reports = append(m.Reports, &SlashingRateChangeReport{})
+			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashingRateChangeReportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashingRateChangeReportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashingRateChangeReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+			}
+			m.ParamsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParamsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashingRateChangeReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashingRateChangeReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashingRateChangeReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Report == nil {
+				m.Report = &SlashingRateChangeReport{}
+			}
+			if err := m.Report.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledParams == nil {
+				m.ScheduledParams = &ScheduledParams{}
+			}
+			if err := m.ScheduledParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingTxTemplateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingTxTemplateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingTxTemplateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHexList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHexList = append(m.FpBtcPkHexList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingValue", wireType)
+			}
+			m.StakingValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTime", wireType)
+			}
+			m.StakingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			m.UnbondingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingFeeSat", wireType)
+			}
+			m.UnbondingFeeSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingFeeSat |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingTxTemplateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingTxTemplateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingTxTemplateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Template == nil {
+				m.Template = &StakingTxTemplate{}
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakingTxTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakingTxTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakingTxTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateVersion", wireType)
+			}
+			m.TemplateVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TemplateVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+			}
+			m.ParamsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParamsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputIdx", wireType)
+			}
+			m.StakingOutputIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingOutputIdx |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputPkScriptHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingOutputPkScriptHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockScriptHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimelockScriptHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingScriptHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingScriptHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingScriptHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingScriptHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingSlashingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgCreateBtcDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.MsgCreateBtcDelegation == nil {
+				m.MsgCreateBtcDelegation = &MsgCreateBTCDelegation{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.MsgCreateBtcDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3911,7 +14742,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3934,15 +14765,47 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingBTCDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingBTCDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3969,7 +14832,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3994,7 +14860,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4017,17 +14883,17 @@ func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByVersionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingBTCDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingBTCDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkItems", wireType)
 			}
-			m.Version = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4037,64 +14903,29 @@ func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.WorkItems = append(m.WorkItems, &CovenantSigningWorkItem{})
+			if err := m.WorkItems[len(m.WorkItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByVersionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4121,7 +14952,10 @@ func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4146,7 +14980,7 @@ func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
+func (m *CovenantSigningWorkItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4169,15 +15003,47 @@ func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProvidersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CovenantSigningWorkItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CovenantSigningWorkItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4204,68 +15070,72 @@ func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.BtcDelegation == nil {
+				m.BtcDelegation = &BTCDelegationResponse{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BtcDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantPks", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.CovenantPks = append(m.CovenantPks, v)
+			if err := m.CovenantPks[len(m.CovenantPks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProvidersResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantQuorum", wireType)
+			}
+			m.CovenantQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CovenantQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputPkScriptHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4275,31 +15145,48 @@ func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderResponse{})
-			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingOutputPkScriptHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputValue", wireType)
+			}
+			m.StakingOutputValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingOutputValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingSlashingPathScriptHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4309,81 +15196,27 @@ func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingSlashingPathScriptHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingUnbondingPathScriptHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4411,63 +15244,13 @@ func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			m.StakingUnbondingPathScriptHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOutputPkScriptHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4477,83 +15260,29 @@ func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinalityProvider == nil {
-				m.FinalityProvider = &FinalityProviderResponse{}
-			}
-			if err := m.FinalityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.UnbondingOutputPkScriptHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOutputValue", wireType)
 			}
-			m.Status = 0
+			m.UnbondingOutputValue = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4563,16 +15292,16 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BTCDelegationStatus(b&0x7F) << shift
+				m.UnbondingOutputValue |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingPathScriptHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4582,27 +15311,23 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingSlashingPathScriptHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4625,7 +15350,7 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *BTCDelegationBundle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4648,17 +15373,17 @@ func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BTCDelegationBundle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BTCDelegationBundle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcNetwork", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4668,31 +15393,29 @@ func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
-			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BtcNetwork = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4702,83 +15425,65 @@ func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegation", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFinalityProviderPowerAtHeightRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.BtcDelegation == nil {
+				m.BtcDelegation = &BTCDelegationResponse{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.BtcDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantPks", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4788,29 +15493,32 @@ func (m *QueryFinalityProviderPowerAtHeightRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.CovenantPks = append(m.CovenantPks, v)
+			if err := m.CovenantPks[len(m.CovenantPks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantQuorum", wireType)
 			}
-			m.Height = 0
+			m.CovenantQuorum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4820,66 +15528,16 @@ func (m *QueryFinalityProviderPowerAtHeightRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.CovenantQuorum |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFinalityProviderPowerAtHeightResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputPkScriptHex", wireType)
 			}
-			m.VotingPower = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4889,66 +15547,48 @@ func (m *QueryFinalityProviderPowerAtHeightResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotingPower |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFinalityProviderCurrentPowerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.StakingOutputPkScriptHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputValue", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.StakingOutputValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingOutputValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTimelockPath", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4958,79 +15598,33 @@ func (m *QueryFinalityProviderCurrentPowerRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.StakingTimelockPath == nil {
+				m.StakingTimelockPath = &SpendPath{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.StakingTimelockPath.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingUnbondingPath", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5040,16 +15634,33 @@ func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.VotingPower = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StakingUnbondingPath == nil {
+				m.StakingUnbondingPath = &SpendPath{}
+			}
+			if err := m.StakingUnbondingPath.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOutputPkScriptHex", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5059,66 +15670,29 @@ func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotingPower |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.UnbondingOutputPkScriptHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOutputValue", wireType)
 			}
-			m.Height = 0
+			m.UnbondingOutputValue = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5128,14 +15702,14 @@ func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.UnbondingOutputValue |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTimelockPath", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5162,13 +15736,45 @@ func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.UnbondingTimelockPath == nil {
+				m.UnbondingTimelockPath = &SpendPath{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UnbondingTimelockPath.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5190,7 +15796,7 @@ func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *SpendPath) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5213,17 +15819,17 @@ func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpendPath: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpendPath: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5233,31 +15839,29 @@ func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) er
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderWithMeta{})
-			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ScriptHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ControlBlockHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5267,27 +15871,23 @@ func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) er
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ControlBlockHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5310,7 +15910,7 @@ func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *QueryActivatedHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCovenantMuSig2NoncesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5329,16 +15929,48 @@ func (m *QueryActivatedHeightRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivatedHeightRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivatedHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCovenantMuSig2NoncesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCovenantMuSig2NoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5360,7 +15992,7 @@ func (m *QueryActivatedHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActivatedHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCovenantMuSig2NoncesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5383,17 +16015,17 @@ func (m *QueryActivatedHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivatedHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCovenantMuSig2NoncesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivatedHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCovenantMuSig2NoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5403,11 +16035,58 @@ func (m *QueryActivatedHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonces = append(m.Nonces, &CovenantMuSig2Nonces{})
+			if err := m.Nonces[len(m.Nonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateCovenantPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregateCovenantPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5429,7 +16108,7 @@ func (m *QueryActivatedHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWatchtowerBackupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5452,15 +16131,15 @@ func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWatchtowerBackupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWatchtowerBackupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5488,43 +16167,7 @@ func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5547,7 +16190,7 @@ func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWatchtowerBackupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5570,17 +16213,17 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWatchtowerBackupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWatchtowerBackupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegatorDelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchtowerAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5590,31 +16233,29 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BtcDelegatorDelegations = append(m.BtcDelegatorDelegations, &BTCDelegatorDelegationsResponse{})
-			if err := m.BtcDelegatorDelegations[len(m.BtcDelegatorDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WatchtowerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedBackup", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5624,26 +16265,24 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.EncryptedBackup = append(m.EncryptedBackup[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedBackup == nil {
+				m.EncryptedBackup = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5667,7 +16306,7 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCovenantPerformanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5690,17 +16329,17 @@ func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCovenantPerformanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCovenantPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5710,23 +16349,27 @@ func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5749,7 +16392,7 @@ func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCovenantPerformanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5772,15 +16415,15 @@ func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCovenantPerformanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCovenantPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantPerformances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5807,10 +16450,44 @@ func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BtcDelegation == nil {
-				m.BtcDelegation = &BTCDelegationResponse{}
+			m.CovenantPerformances = append(m.CovenantPerformances, &CovenantPerformanceResponse{})
+			if err := m.CovenantPerformances[len(m.CovenantPerformances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.BtcDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5835,7 +16512,7 @@ func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *CovenantPerformanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5858,17 +16535,17 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BTCDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CovenantPerformanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CovenantPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CovPkHex", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5878,32 +16555,29 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.BtcPk = &v
-			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CovPkHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSigned", wireType)
 			}
-			var byteLen int
+			m.NumSigned = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5913,32 +16587,16 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.NumSigned |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.FpBtcPkList = append(m.FpBtcPkList, v)
-			if err := m.FpBtcPkList[len(m.FpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageSigningBlocks", wireType)
 			}
-			m.StartHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5948,16 +16606,31 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AverageSigningBlocks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumMissed", wireType)
 			}
-			m.EndHeight = 0
+			m.NumMissed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5967,16 +16640,16 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndHeight |= uint64(b&0x7F) << shift
+				m.NumMissed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InCommittee", wireType)
 			}
-			m.TotalSat = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5986,14 +16659,65 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalSat |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			m.InCommittee = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationTxFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationTxFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationTxFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6021,13 +16745,13 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingTxHex = string(dAtA[iNdEx:postIndex])
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxHex", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
 			}
-			var stringLen uint64
+			m.FeeRate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6037,29 +16761,66 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.FeeRate |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashingTxHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationTxFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationTxFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationTxFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashSigHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6069,27 +16830,31 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorSlashSigHex = string(dAtA[iNdEx:postIndex])
+			if m.SlashingTx == nil {
+				m.SlashingTx = &TxFeeEstimate{}
+			}
+			if err := m.SlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSigs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6116,16 +16881,18 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CovenantSigs = append(m.CovenantSigs, &CovenantAdaptorSignatures{})
-			if err := m.CovenantSigs[len(m.CovenantSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.UnbondingTx == nil {
+				m.UnbondingTx = &TxFeeEstimate{}
+			}
+			if err := m.UnbondingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputIdx", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingTx", wireType)
 			}
-			m.StakingOutputIdx = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6135,36 +16902,83 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StakingOutputIdx |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.Active = bool(v != 0)
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusDesc", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
+			if m.UnbondingSlashingTx == nil {
+				m.UnbondingSlashingTx = &TxFeeEstimate{}
+			}
+			if err := m.UnbondingSlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxFeeEstimate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxFeeEstimate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxFeeEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualSize", wireType)
+			}
+			m.VirtualSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6174,29 +16988,16 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.VirtualSize |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StatusDesc = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
 			}
-			m.UnbondingTime = 0
+			m.MinFee = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6206,16 +17007,16 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingTime |= uint32(b&0x7F) << shift
+				m.MinFee |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UndelegationResponse", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var msglen int
+			m.Fee = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6225,33 +17026,16 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Fee |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.UndelegationResponse == nil {
-				m.UndelegationResponse = &BTCUndelegationResponse{}
-			}
-			if err := m.UndelegationResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 15:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sufficient", wireType)
 			}
-			m.ParamsVersion = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6261,11 +17045,12 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ParamsVersion |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Sufficient = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6287,7 +17072,7 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPauseStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6310,79 +17095,65 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BTCUndelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPauseStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BTCUndelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPauseStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxHex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbondingTxHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSigHex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPauseStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorUnbondingSigHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPauseStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPauseStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CovenantUnbondingSigList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6409,78 +17180,113 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CovenantUnbondingSigList = append(m.CovenantUnbondingSigList, &SignatureInfo{})
-			if err := m.CovenantUnbondingSigList[len(m.CovenantUnbondingSigList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PauseState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxHex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastFinalityProviderSetDiffRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashingTxHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSigHex", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastFinalityProviderSetDiffRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastFinalityProviderSetDiffRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastFinalityProviderSetDiffResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorSlashingSigHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastFinalityProviderSetDiffResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastFinalityProviderSetDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSlashingSigs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6507,8 +17313,10 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CovenantSlashingSigs = append(m.CovenantSlashingSigs, &CovenantAdaptorSignatures{})
-			if err := m.CovenantSlashingSigs[len(m.CovenantSlashingSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Diff == nil {
+				m.Diff = &FinalityProviderSetDiff{}
+			}
+			if err := m.Diff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6533,7 +17341,7 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BTCDelegatorDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTxIndexRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6556,17 +17364,17 @@ func (m *BTCDelegatorDelegationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BTCDelegatorDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxIndexRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BTCDelegatorDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcTxHashHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6576,25 +17384,23 @@ func (m *BTCDelegatorDelegationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dels = append(m.Dels, &BTCDelegationResponse{})
-			if err := m.Dels[len(m.Dels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BtcTxHashHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6617,7 +17423,7 @@ func (m *BTCDelegatorDelegationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTxIndexResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6640,17 +17446,17 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FinalityProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxIndexResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
 			}
-			var msglen int
+			m.TxType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6660,31 +17466,14 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TxType |= BTCTxType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Description == nil {
-				m.Description = &types.Description{}
-			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6712,17 +17501,13 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.Commission = &v
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BabylonPk", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
 			}
-			var msglen int
+			m.EpochNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6732,66 +17517,14 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EpochNum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BabylonPk == nil {
-				m.BabylonPk = &secp256k1.PubKey{}
-			}
-			if err := m.BabylonPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.BtcPk = &v
-			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6818,140 +17551,13 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pop == nil {
-				m.Pop = &ProofOfPossession{}
+			if m.SubmissionKey == nil {
+				m.SubmissionKey = &types1.SubmissionKey{}
 			}
-			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SubmissionKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterPubRand", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MasterPubRand = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredEpoch", wireType)
-			}
-			m.RegisteredEpoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RegisteredEpoch |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBabylonHeight", wireType)
-			}
-			m.SlashedBabylonHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlashedBabylonHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBtcHeight", wireType)
-			}
-			m.SlashedBtcHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlashedBtcHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
-			}
-			m.VotingPower = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VotingPower |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	bbn "github.com/babylonchain/babylon/types"
+)
+
+// HasStakingTxTag returns whether staking txs are required to carry the
+// OP_RETURN output with the staking tx tag
+func (p Params) HasStakingTxTag() bool {
+	return len(p.StakingTxTag) > 0
+}
+
+// validateStakingTxTag checks that the staking tx tag is either empty or of
+// the length of the magic bytes of identifiable staking txs
+func validateStakingTxTag(tag []byte) error {
+	if len(tag) != 0 && len(tag) != btcstaking.MagicBytesLen {
+		return fmt.Errorf("staking tx tag must be empty or %d bytes, got %d", btcstaking.MagicBytesLen, len(tag))
+	}
+	return nil
+}
+
+// BuildStakingTxTagOutput builds the OP_RETURN output that a staking tx of the
+// given staker, finality providers and staking time has to carry. It returns
+// nil if staking txs are not required to carry it.
+func (p Params) BuildStakingTxTagOutput(stakerPK *btcec.PublicKey, fpPKs []*btcec.PublicKey, stakingTime uint16) (*wire.TxOut, error) {
+	if !p.HasStakingTxTag() {
+		return nil, nil
+	}
+	// the OP_RETURN output has room for a single finality provider
+	if len(fpPKs) != 1 {
+		return nil, ErrInvalidStakingTx.Wrapf("staking txs with a tag must stake to a single finality provider, got %d", len(fpPKs))
+	}
+	opReturnData, err := btcstaking.NewV0OpReturnDataFromParsed(p.StakingTxTag, stakerPK, fpPKs[0], stakingTime)
+	if err != nil {
+		return nil, ErrInvalidStakingTx.Wrap(err.Error())
+	}
+	return opReturnData.ToTxOutput()
+}
+
+// VerifyStakingTxTag verifies that the given staking tx carries the OP_RETURN
+// output with the staking tx tag, and the OP_RETURN output is consistent with
+// the staking output at the given index, the staker, the finality providers
+// and the staking time. It is a no-op if staking txs are not required to
+// carry the OP_RETURN output.
+func (p Params) VerifyStakingTxTag(
+	stakingTx *wire.MsgTx,
+	stakingOutputIdx uint32,
+	stakerPK *bbn.BIP340PubKey,
+	fpPKs []bbn.BIP340PubKey,
+	stakingTime uint16,
+	net *chaincfg.Params,
+) error {
+	if !p.HasStakingTxTag() {
+		return nil
+	}
+	if len(fpPKs) != 1 {
+		return ErrInvalidStakingTx.Wrapf("staking txs with a tag must stake to a single finality provider, got %d", len(fpPKs))
+	}
+	covenantPKs, err := bbn.NewBTCPKsFromBIP340PKs(p.CovenantPks)
+	if err != nil {
+		return err
+	}
+
+	identifiedTx, err := btcstaking.IdentifyStakingTx(stakingTx, &btcstaking.StakingTxIdentificationParams{
+		MagicBytes:            p.StakingTxTag,
+		CovenantKeys:          covenantPKs,
+		CovenantQuorum:        p.CovenantQuorum,
+		ScriptTemplateVersion: p.ScriptTemplateVersion,
+	}, net)
+	if errors.Is(err, btcstaking.ErrNotStakingTx) {
+		return ErrInvalidStakingTx.Wrapf("staking tx does not carry a valid tagged OP_RETURN output: %v", err)
+	} else if err != nil {
+		return err
+	}
+
+	if identifiedTx.StakingOutputIdx != stakingOutputIdx {
+		return ErrInvalidStakingTx.Wrapf("the tagged OP_RETURN output commits to staking output %d rather than %d",
+			identifiedTx.StakingOutputIdx, stakingOutputIdx)
+	}
+	if !bbn.NewBIP340PubKeyFromBTCPK(identifiedTx.StakerKey).Equals(stakerPK) {
+		return ErrInvalidStakingTx.Wrap("the tagged OP_RETURN output commits to another staker")
+	}
+	if !bbn.NewBIP340PubKeyFromBTCPK(identifiedTx.FinalityProviderKeys[0]).Equals(&fpPKs[0]) {
+		return ErrInvalidStakingTx.Wrap("the tagged OP_RETURN output commits to another finality provider")
+	}
+	if identifiedTx.StakingTime != stakingTime {
+		return ErrInvalidStakingTx.Wrapf("the tagged OP_RETURN output commits to staking time %d rather than %d",
+			identifiedTx.StakingTime, stakingTime)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+func FuzzStakingTxTag(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		net := &chaincfg.SimNetParams
+
+		params := types.DefaultParams()
+		params.StakingTxTag = datagen.GenRandomByteArray(r, btcstaking.MagicBytesLen)
+		require.NoError(t, params.Validate())
+		covenantPKs, err := bbn.NewBTCPKsFromBIP340PKs(params.CovenantPks)
+		require.NoError(t, err)
+
+		_, stakerPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		_, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		stakingTime := uint16(datagen.RandomInt(r, 10000) + 100)
+		stakerBIP340PK := bbn.NewBIP340PubKeyFromBTCPK(stakerPK)
+		fpBIP340PKs := []bbn.BIP340PubKey{*bbn.NewBIP340PubKeyFromBTCPK(fpPK)}
+
+		stakingInfo, err := btcstaking.BuildStakingInfo(
+			stakerPK,
+			[]*btcec.PublicKey{fpPK},
+			covenantPKs,
+			params.CovenantQuorum,
+			stakingTime,
+			btcutil.Amount(datagen.RandomInt(r, 100000)+10000),
+			net,
+		)
+		require.NoError(t, err)
+		tagOutput, err := params.BuildStakingTxTagOutput(stakerPK, []*btcec.PublicKey{fpPK}, stakingTime)
+		require.NoError(t, err)
+		require.NotNil(t, tagOutput)
+
+		// a staking tx carrying the tagged OP_RETURN output passes verification
+		stakingTx := wire.NewMsgTx(2)
+		stakingTx.AddTxOut(stakingInfo.StakingOutput)
+		stakingTx.AddTxOut(tagOutput)
+		require.NoError(t, params.VerifyStakingTxTag(stakingTx, 0, stakerBIP340PK, fpBIP340PKs, stakingTime, net))
+
+		// the tagged OP_RETURN output has to be consistent with the request
+		require.ErrorIs(t, params.VerifyStakingTxTag(stakingTx, 1, stakerBIP340PK, fpBIP340PKs, stakingTime, net), types.ErrInvalidStakingTx)
+		require.ErrorIs(t, params.VerifyStakingTxTag(stakingTx, 0, stakerBIP340PK, fpBIP340PKs, stakingTime+1, net), types.ErrInvalidStakingTx)
+		require.ErrorIs(t, params.VerifyStakingTxTag(stakingTx, 0, stakerBIP340PK, append(fpBIP340PKs, fpBIP340PKs[0]), stakingTime, net), types.ErrInvalidStakingTx)
+
+		// a staking tx without the tagged OP_RETURN output is rejected, unless
+		// the params do not require it
+		untaggedStakingTx := wire.NewMsgTx(2)
+		untaggedStakingTx.AddTxOut(stakingInfo.StakingOutput)
+		require.ErrorIs(t, params.VerifyStakingTxTag(untaggedStakingTx, 0, stakerBIP340PK, fpBIP340PKs, stakingTime, net), types.ErrInvalidStakingTx)
+		untaggedParams := types.DefaultParams()
+		require.NoError(t, untaggedParams.VerifyStakingTxTag(untaggedStakingTx, 0, stakerBIP340PK, fpBIP340PKs, stakingTime, net))
+		tagOutput, err = untaggedParams.BuildStakingTxTagOutput(stakerPK, []*btcec.PublicKey{fpPK}, stakingTime)
+		require.NoError(t, err)
+		require.Nil(t, tagOutput)
+
+		// the tag has to be of the length of the magic bytes
+		params.StakingTxTag = datagen.GenRandomByteArray(r, btcstaking.MagicBytesLen+1)
+		require.Error(t, params.Validate())
+	})
+}
@@ -0,0 +1,240 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+)
+
+// StakingTxTemplateVersion is the version of the format of staking tx
+// templates. It has to be bumped whenever the construction of the
+// transactions or the message in the template changes.
+// Version 2 adds the tagged OP_RETURN output to the staking tx if the params
+// require so.
+const StakingTxTemplateVersion uint32 = 2
+
+// MinimumUnbondingValue returns the minimum value of the unbonding output
+// spending the given staking output under the given params
+func MinimumUnbondingValue(stakingOutput *wire.TxOut, params *Params) btcutil.Amount {
+	// this conversions must always succeed, as it is part of our params
+	minUnbondingRate := params.MinUnbondingRate.MustFloat64()
+	return btcutil.Amount(stakingOutput.Value).MulF64(minUnbondingRate)
+}
+
+// BuildStakingTxTemplate builds the unsigned transactions and the message
+// that a wallet needs for staking with the given finality providers under
+// the given parameters. It does not check whether the finality providers are
+// known to Babylon, so that it can be used without access to the chain state,
+// e.g., by wallets building staking txs offline.
+func BuildStakingTxTemplate(
+	req *QueryStakingTxTemplateRequest,
+	params *Params,
+	paramsVersion uint32,
+	btccParams *btcctypes.Params,
+	btcNet *chaincfg.Params,
+) (*StakingTxTemplate, error) {
+	stakerPK, err := bbn.NewBIP340PubKeyFromHex(req.StakerBtcPkHex)
+	if err != nil {
+		return nil, ErrInvalidStakingTx.Wrapf("invalid staker BTC PK: %v", err)
+	}
+	if len(req.FpBtcPkHexList) == 0 {
+		return nil, ErrInvalidStakingTx.Wrap("empty list of finality providers")
+	}
+	if req.StakingValue <= 0 {
+		return nil, ErrInvalidStakingTx.Wrapf("staking value %d must be positive", req.StakingValue)
+	}
+	if req.StakingTime > math.MaxUint16 {
+		return nil, ErrInvalidStakingTx.Wrapf("staking time %d must not exceed %d", req.StakingTime, math.MaxUint16)
+	}
+
+	// the staking tx has to have more than w BTC blocks left in its timelock
+	// when the BTC delegation becomes active
+	activationDepth, wValue := StakingTxActivationDepth(*params, *btccParams), btccParams.CheckpointFinalizationTimeout
+	if uint64(req.StakingTime) <= activationDepth+wValue {
+		return nil, ErrInvalidStakingTx.Wrapf("staking time %d must be larger than activation depth + w=%d", req.StakingTime, activationDepth+wValue)
+	}
+
+	minUnbondingTime := MinimumUnbondingTime(*params, *btccParams)
+	unbondingTime := uint64(req.UnbondingTime)
+	if unbondingTime == 0 {
+		unbondingTime = minUnbondingTime + 1
+	}
+	if unbondingTime <= minUnbondingTime {
+		return nil, ErrInvalidUnbondingTx.Wrapf("unbonding time %d must be larger than %d", unbondingTime, minUnbondingTime)
+	}
+	if unbondingTime > math.MaxUint16 {
+		return nil, ErrInvalidUnbondingTx.Wrapf("unbonding time %d must not exceed %d", unbondingTime, math.MaxUint16)
+	}
+
+	fpBTCPKs := make([]bbn.BIP340PubKey, 0, len(req.FpBtcPkHexList))
+	for _, fpPKHex := range req.FpBtcPkHexList {
+		fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(fpPKHex)
+		if err != nil {
+			return nil, ErrInvalidStakingTx.Wrapf("invalid finality provider BTC PK: %v", err)
+		}
+		fpBTCPKs = append(fpBTCPKs, *fpBTCPK)
+	}
+	fpPKs, err := bbn.NewBTCPKsFromBIP340PKs(fpBTCPKs)
+	if err != nil {
+		return nil, ErrInvalidStakingTx.Wrapf("cannot parse finality provider PK list: %v", err)
+	}
+	covenantPKs, err := bbn.NewBTCPKsFromBIP340PKs(params.CovenantPks)
+	if err != nil {
+		return nil, ErrInvalidStakingTx.Wrapf("cannot parse covenant PK list: %v", err)
+	}
+	stakerBTCPK := stakerPK.MustToBTCPK()
+	slashingAddr, err := params.DecodeSlashingAddress(btcNet)
+	if err != nil {
+		return nil, ErrInvalidSlashingTx.Wrapf("invalid slashing address: %v", err)
+	}
+
+	// staking tx without inputs, built with the script template in the
+	// given params
+	scriptTemplate, err := btcstaking.GetScriptTemplate(params.ScriptTemplateVersion)
+	if err != nil {
+		return nil, err
+	}
+	stakingInfo, err := scriptTemplate.BuildStakingInfo(
+		stakerBTCPK,
+		fpPKs,
+		covenantPKs,
+		params.CovenantQuorum,
+		uint16(req.StakingTime),
+		btcutil.Amount(req.StakingValue),
+		btcNet,
+	)
+	if err != nil {
+		return nil, ErrInvalidStakingTx.Wrapf("err: %v", err)
+	}
+	stakingTx := wire.NewMsgTx(2)
+	stakingTx.AddTxOut(stakingInfo.StakingOutput)
+	stakingOutputIdx := uint32(0)
+	// the tagged OP_RETURN output, if required by the params
+	tagOutput, err := params.BuildStakingTxTagOutput(stakerBTCPK, fpPKs, uint16(req.StakingTime))
+	if err != nil {
+		return nil, err
+	}
+	if tagOutput != nil {
+		stakingTx.AddTxOut(tagOutput)
+	}
+
+	timeLockSpendInfo, err := stakingInfo.TimeLockPathSpendInfo()
+	if err != nil {
+		panic(fmt.Errorf("failed to construct timelock path from the staking tx: %w", err))
+	}
+	unbondingSpendInfo, err := stakingInfo.UnbondingPathSpendInfo()
+	if err != nil {
+		panic(fmt.Errorf("failed to construct unbonding path from the staking tx: %w", err))
+	}
+	slashingSpendInfo, err := stakingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		panic(fmt.Errorf("failed to construct slashing path from the staking tx: %w", err))
+	}
+
+	slashingTx, err := btcstaking.BuildSlashingTxFromStakingTxStrict(
+		stakingTx,
+		stakingOutputIdx,
+		slashingAddr,
+		stakerBTCPK,
+		uint16(unbondingTime),
+		params.MinSlashingTxFeeSat,
+		params.SlashingRate,
+		btcNet,
+	)
+	if err != nil {
+		return nil, ErrInvalidSlashingTx.Wrapf("err: %v", err)
+	}
+
+	// unbonding tx spending the staking output
+	if req.UnbondingFeeSat <= 0 {
+		return nil, ErrInvalidUnbondingTx.Wrapf("unbonding tx fee %d must be positive", req.UnbondingFeeSat)
+	}
+	unbondingValue := req.StakingValue - req.UnbondingFeeSat
+	minUnbondingValue := MinimumUnbondingValue(stakingInfo.StakingOutput, params)
+	if btcutil.Amount(unbondingValue) < minUnbondingValue {
+		return nil, ErrInvalidUnbondingTx.Wrapf("unbonding output value must be at least %s, based on staking output", minUnbondingValue)
+	}
+	unbondingInfo, err := scriptTemplate.BuildUnbondingInfo(
+		stakerBTCPK,
+		fpPKs,
+		covenantPKs,
+		params.CovenantQuorum,
+		uint16(unbondingTime),
+		btcutil.Amount(unbondingValue),
+		btcNet,
+	)
+	if err != nil {
+		return nil, ErrInvalidUnbondingTx.Wrapf("err: %v", err)
+	}
+	unbondingTx := wire.NewMsgTx(2)
+	unbondingTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, stakingOutputIdx), nil, nil))
+	unbondingTx.AddTxOut(unbondingInfo.UnbondingOutput)
+
+	unbondingSlashingTx, err := btcstaking.BuildSlashingTxFromStakingTxStrict(
+		unbondingTx,
+		0,
+		slashingAddr,
+		stakerBTCPK,
+		uint16(unbondingTime),
+		params.MinSlashingTxFeeSat,
+		params.SlashingRate,
+		btcNet,
+	)
+	if err != nil {
+		return nil, ErrInvalidSlashingTx.Wrapf("err: %v", err)
+	}
+
+	// the previous outpoints of the slashing txs are placeholders, as the
+	// hashes of the staking tx and the unbonding tx are unknown before the
+	// staking tx is funded
+	slashingTx.TxIn[0].PreviousOutPoint.Hash = chainhash.Hash{}
+	unbondingSlashingTx.TxIn[0].PreviousOutPoint.Hash = chainhash.Hash{}
+
+	stakingTxBytes, err := bbn.SerializeBTCTx(stakingTx)
+	if err != nil {
+		return nil, err
+	}
+	slashingTxBytes, err := bbn.SerializeBTCTx(slashingTx)
+	if err != nil {
+		return nil, err
+	}
+	unbondingTxBytes, err := bbn.SerializeBTCTx(unbondingTx)
+	if err != nil {
+		return nil, err
+	}
+	unbondingSlashingTxBytes, err := bbn.SerializeBTCTx(unbondingSlashingTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StakingTxTemplate{
+		TemplateVersion:          StakingTxTemplateVersion,
+		ParamsVersion:            paramsVersion,
+		StakingTxHex:             hex.EncodeToString(stakingTxBytes),
+		StakingOutputIdx:         stakingOutputIdx,
+		StakingOutputPkScriptHex: hex.EncodeToString(stakingInfo.StakingOutput.PkScript),
+		TimelockScriptHex:        hex.EncodeToString(timeLockSpendInfo.GetPkScriptPath()),
+		UnbondingScriptHex:       hex.EncodeToString(unbondingSpendInfo.GetPkScriptPath()),
+		SlashingScriptHex:        hex.EncodeToString(slashingSpendInfo.GetPkScriptPath()),
+		SlashingTxHex:            hex.EncodeToString(slashingTxBytes),
+		UnbondingTxHex:           hex.EncodeToString(unbondingTxBytes),
+		UnbondingSlashingTxHex:   hex.EncodeToString(unbondingSlashingTxBytes),
+		MsgCreateBtcDelegation: &MsgCreateBTCDelegation{
+			BtcPk:          stakerPK,
+			FpBtcPkList:    fpBTCPKs,
+			StakingTime:    req.StakingTime,
+			StakingValue:   req.StakingValue,
+			UnbondingTime:  uint32(unbondingTime),
+			UnbondingValue: unbondingValue,
+		},
+	}, nil
+}
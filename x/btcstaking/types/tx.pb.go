@@ -52,6 +52,10 @@ type MsgCreateFinalityProvider struct {
 	// master_pub_rand is the master public randomness of the finality provider
 	// encoded as a base58 string
 	MasterPubRand string `protobuf:"bytes,7,opt,name=master_pub_rand,json=masterPubRand,proto3" json:"master_pub_rand,omitempty"`
+	// consumer_id is the chain ID of the consumer chain that the finality
+	// provider secures. It has to be registered in zoneconcierge. An empty
+	// consumer_id means the finality provider secures Babylon
+	ConsumerId string `protobuf:"bytes,8,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
 func (m *MsgCreateFinalityProvider) Reset()         { *m = MsgCreateFinalityProvider{} }
@@ -122,6 +126,13 @@ func (m *MsgCreateFinalityProvider) GetMasterPubRand() string {
 	return ""
 }
 
+func (m *MsgCreateFinalityProvider) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
 // MsgCreateFinalityProviderResponse is the response for MsgCreateFinalityProvider
 type MsgCreateFinalityProviderResponse struct {
 }
@@ -306,6 +317,17 @@ type MsgCreateBTCDelegation struct {
 	UnbondingSlashingTx *BTCSlashingTx `protobuf:"bytes,14,opt,name=unbonding_slashing_tx,json=unbondingSlashingTx,proto3,customtype=BTCSlashingTx" json:"unbonding_slashing_tx,omitempty"`
 	// delegator_unbonding_slashing_sig is the signature on the slashing tx by the delegator (i.e., SK corresponding to btc_pk).
 	DelegatorUnbondingSlashingSig *github_com_babylonchain_babylon_types.BIP340Signature `protobuf:"bytes,15,opt,name=delegator_unbonding_slashing_sig,json=delegatorUnbondingSlashingSig,proto3,customtype=github.com/babylonchain/babylon/types.BIP340Signature" json:"delegator_unbonding_slashing_sig,omitempty"`
+	// memo is an optional label of the BTC delegation of at most 256 bytes,
+	// e.g., a client or batch ID of a custodian. It is stored on the BTC
+	// delegation and included in the event of its creation
+	Memo string `protobuf:"bytes,16,opt,name=memo,proto3" json:"memo,omitempty"`
+	// reward_address is the optional Babylon address receiving the rewards of
+	// the BTC delegation. If empty, the rewards go to the address of babylon_pk
+	RewardAddress string `protobuf:"bytes,17,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"`
+	// withdrawal_btc_address is the optional BTC address the staker intends to
+	// withdraw the staked BTC to, e.g., the custodian's cold wallet. It is only
+	// recorded on the BTC delegation for off-chain tooling
+	WithdrawalBtcAddress string `protobuf:"bytes,18,opt,name=withdrawal_btc_address,json=withdrawalBtcAddress,proto3" json:"withdrawal_btc_address,omitempty"`
 }
 
 func (m *MsgCreateBTCDelegation) Reset()         { *m = MsgCreateBTCDelegation{} }
@@ -404,6 +426,27 @@ func (m *MsgCreateBTCDelegation) GetUnbondingValue() int64 {
 	return 0
 }
 
+func (m *MsgCreateBTCDelegation) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *MsgCreateBTCDelegation) GetRewardAddress() string {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return ""
+}
+
+func (m *MsgCreateBTCDelegation) GetWithdrawalBtcAddress() string {
+	if m != nil {
+		return m.WithdrawalBtcAddress
+	}
+	return ""
+}
+
 // MsgCreateBTCDelegationResponse is the response for MsgCreateBTCDelegation
 type MsgCreateBTCDelegationResponse struct {
 }
@@ -561,6 +604,115 @@ func (m *MsgAddCovenantSigsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddCovenantSigsResponse proto.InternalMessageInfo
 
+// MsgAddCovenantMuSig2Nonces is the message for handling the MuSig2 public
+// nonces of a covenant member for co-signing a BTC delegation whose script
+// template has the covenant committee sign via MuSig2. Once all covenant
+// members have submitted their nonces, they produce partial signatures
+// off-chain, and the aggregate signatures are submitted via
+// MsgAddCovenantSigs under the aggregate covenant key.
+type MsgAddCovenantMuSig2Nonces struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// staking_tx_hash is the hash of the staking tx.
+	// It uniquely identifies a BTC delegation
+	StakingTxHash string `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// nonces is the list of public nonces of the covenant member
+	Nonces CovenantMuSig2Nonces `protobuf:"bytes,3,opt,name=nonces,proto3" json:"nonces"`
+	// sig is the BIP-340 signature of the covenant member over the hash of
+	// the staking tx hash and the nonces, which authenticates the nonces
+	Sig *github_com_babylonchain_babylon_types.BIP340Signature `protobuf:"bytes,4,opt,name=sig,proto3,customtype=github.com/babylonchain/babylon/types.BIP340Signature" json:"sig,omitempty"`
+}
+
+func (m *MsgAddCovenantMuSig2Nonces) Reset()         { *m = MsgAddCovenantMuSig2Nonces{} }
+func (m *MsgAddCovenantMuSig2Nonces) String() string { return proto.CompactTextString(m) }
+func (*MsgAddCovenantMuSig2Nonces) ProtoMessage()    {}
+func (*MsgAddCovenantMuSig2Nonces) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{8}
+}
+func (m *MsgAddCovenantMuSig2Nonces) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddCovenantMuSig2Nonces) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddCovenantMuSig2Nonces.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddCovenantMuSig2Nonces) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddCovenantMuSig2Nonces.Merge(m, src)
+}
+func (m *MsgAddCovenantMuSig2Nonces) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddCovenantMuSig2Nonces) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddCovenantMuSig2Nonces.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddCovenantMuSig2Nonces proto.InternalMessageInfo
+
+func (m *MsgAddCovenantMuSig2Nonces) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgAddCovenantMuSig2Nonces) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
+}
+
+func (m *MsgAddCovenantMuSig2Nonces) GetNonces() CovenantMuSig2Nonces {
+	if m != nil {
+		return m.Nonces
+	}
+	return CovenantMuSig2Nonces{}
+}
+
+// MsgAddCovenantMuSig2NoncesResponse is the response for MsgAddCovenantMuSig2Nonces
+type MsgAddCovenantMuSig2NoncesResponse struct {
+}
+
+func (m *MsgAddCovenantMuSig2NoncesResponse) Reset()         { *m = MsgAddCovenantMuSig2NoncesResponse{} }
+func (m *MsgAddCovenantMuSig2NoncesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddCovenantMuSig2NoncesResponse) ProtoMessage()    {}
+func (*MsgAddCovenantMuSig2NoncesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{9}
+}
+func (m *MsgAddCovenantMuSig2NoncesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddCovenantMuSig2NoncesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddCovenantMuSig2NoncesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddCovenantMuSig2NoncesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddCovenantMuSig2NoncesResponse.Merge(m, src)
+}
+func (m *MsgAddCovenantMuSig2NoncesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddCovenantMuSig2NoncesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddCovenantMuSig2NoncesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddCovenantMuSig2NoncesResponse proto.InternalMessageInfo
+
 // MsgBTCUndelegate is the message for handling signature on unbonding tx
 // from its delegator. This signature effectively proves that the delegator
 // wants to unbond this BTC delegation
@@ -578,7 +730,7 @@ func (m *MsgBTCUndelegate) Reset()         { *m = MsgBTCUndelegate{} }
 func (m *MsgBTCUndelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgBTCUndelegate) ProtoMessage()    {}
 func (*MsgBTCUndelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{8}
+	return fileDescriptor_4baddb53e97f38f2, []int{10}
 }
 func (m *MsgBTCUndelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -629,7 +781,7 @@ func (m *MsgBTCUndelegateResponse) Reset()         { *m = MsgBTCUndelegateRespon
 func (m *MsgBTCUndelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBTCUndelegateResponse) ProtoMessage()    {}
 func (*MsgBTCUndelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{9}
+	return fileDescriptor_4baddb53e97f38f2, []int{11}
 }
 func (m *MsgBTCUndelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -658,32 +810,32 @@ func (m *MsgBTCUndelegateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgBTCUndelegateResponse proto.InternalMessageInfo
 
-// MsgSelectiveSlashingEvidence is the message for handling evidence of selective slashing
-// launched by a finality provider
-type MsgSelectiveSlashingEvidence struct {
+// MsgSetDelegationOperator is the message for authorizing an operator to
+// undelegate a BTC delegation on behalf of the staker
+type MsgSetDelegationOperator struct {
+	// signer is the staker's Babylon account, i.e., the address of the BTC
+	// delegation's Babylon PK
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	// staking_tx_hash is the hash of the staking tx.
 	// It uniquely identifies a BTC delegation
 	StakingTxHash string `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
-	// recovered_fp_btc_sk is the BTC SK of the finality provider who
-	// launches the selective slashing offence. The SK is recovered by
-	// using a covenant adaptor signature and the corresponding Schnorr
-	// signature
-	RecoveredFpBtcSk []byte `protobuf:"bytes,3,opt,name=recovered_fp_btc_sk,json=recoveredFpBtcSk,proto3" json:"recovered_fp_btc_sk,omitempty"`
+	// operator is the Babylon address authorized to undelegate the BTC
+	// delegation. An empty operator revokes the existing operator, if any
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
 }
 
-func (m *MsgSelectiveSlashingEvidence) Reset()         { *m = MsgSelectiveSlashingEvidence{} }
-func (m *MsgSelectiveSlashingEvidence) String() string { return proto.CompactTextString(m) }
-func (*MsgSelectiveSlashingEvidence) ProtoMessage()    {}
-func (*MsgSelectiveSlashingEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{10}
+func (m *MsgSetDelegationOperator) Reset()         { *m = MsgSetDelegationOperator{} }
+func (m *MsgSetDelegationOperator) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDelegationOperator) ProtoMessage()    {}
+func (*MsgSetDelegationOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{12}
 }
-func (m *MsgSelectiveSlashingEvidence) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetDelegationOperator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSelectiveSlashingEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetDelegationOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSelectiveSlashingEvidence.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetDelegationOperator.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -693,55 +845,55 @@ func (m *MsgSelectiveSlashingEvidence) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgSelectiveSlashingEvidence) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSelectiveSlashingEvidence.Merge(m, src)
+func (m *MsgSetDelegationOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDelegationOperator.Merge(m, src)
 }
-func (m *MsgSelectiveSlashingEvidence) XXX_Size() int {
+func (m *MsgSetDelegationOperator) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSelectiveSlashingEvidence) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSelectiveSlashingEvidence.DiscardUnknown(m)
+func (m *MsgSetDelegationOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDelegationOperator.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSelectiveSlashingEvidence proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetDelegationOperator proto.InternalMessageInfo
 
-func (m *MsgSelectiveSlashingEvidence) GetSigner() string {
+func (m *MsgSetDelegationOperator) GetSigner() string {
 	if m != nil {
 		return m.Signer
 	}
 	return ""
 }
 
-func (m *MsgSelectiveSlashingEvidence) GetStakingTxHash() string {
+func (m *MsgSetDelegationOperator) GetStakingTxHash() string {
 	if m != nil {
 		return m.StakingTxHash
 	}
 	return ""
 }
 
-func (m *MsgSelectiveSlashingEvidence) GetRecoveredFpBtcSk() []byte {
+func (m *MsgSetDelegationOperator) GetOperator() string {
 	if m != nil {
-		return m.RecoveredFpBtcSk
+		return m.Operator
 	}
-	return nil
+	return ""
 }
 
-// MsgSelectiveSlashingEvidenceResponse is the response for MsgSelectiveSlashingEvidence
-type MsgSelectiveSlashingEvidenceResponse struct {
+// MsgSetDelegationOperatorResponse is the response for MsgSetDelegationOperator
+type MsgSetDelegationOperatorResponse struct {
 }
 
-func (m *MsgSelectiveSlashingEvidenceResponse) Reset()         { *m = MsgSelectiveSlashingEvidenceResponse{} }
-func (m *MsgSelectiveSlashingEvidenceResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSelectiveSlashingEvidenceResponse) ProtoMessage()    {}
-func (*MsgSelectiveSlashingEvidenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{11}
+func (m *MsgSetDelegationOperatorResponse) Reset()         { *m = MsgSetDelegationOperatorResponse{} }
+func (m *MsgSetDelegationOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDelegationOperatorResponse) ProtoMessage()    {}
+func (*MsgSetDelegationOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{13}
 }
-func (m *MsgSelectiveSlashingEvidenceResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetDelegationOperatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSelectiveSlashingEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetDelegationOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSelectiveSlashingEvidenceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetDelegationOperatorResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -751,43 +903,49 @@ func (m *MsgSelectiveSlashingEvidenceResponse) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *MsgSelectiveSlashingEvidenceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSelectiveSlashingEvidenceResponse.Merge(m, src)
+func (m *MsgSetDelegationOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDelegationOperatorResponse.Merge(m, src)
 }
-func (m *MsgSelectiveSlashingEvidenceResponse) XXX_Size() int {
+func (m *MsgSetDelegationOperatorResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSelectiveSlashingEvidenceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSelectiveSlashingEvidenceResponse.DiscardUnknown(m)
+func (m *MsgSetDelegationOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDelegationOperatorResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSelectiveSlashingEvidenceResponse proto.InternalMessageInfo
-
-// MsgUpdateParams defines a message for updating btcstaking module parameters.
-type MsgUpdateParams struct {
-	// authority is the address of the governance account.
-	// just FYI: cosmos.AddressString marks that this field should use type alias
-	// for AddressString instead of string, but the functionality is not yet implemented
-	// in cosmos-proto
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the finality parameters to update.
-	//
-	// NOTE: All parameters must be supplied.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
-}
+var xxx_messageInfo_MsgSetDelegationOperatorResponse proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{12}
-}
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+// MsgSetWatchtowerBackup is the message for depositing backup data of a BTC
+// delegation for a watchtower designated by the staker, so that the staker
+// can recover its funds via the watchtower if it loses its keys
+type MsgSetWatchtowerBackup struct {
+	// signer is the staker's Babylon account, i.e., the address of the BTC
+	// delegation's Babylon PK
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// staking_tx_hash is the hash of the staking tx.
+	// It uniquely identifies a BTC delegation
+	StakingTxHash string `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// watchtower_address is the Babylon address of the watchtower allowed to
+	// retrieve the backup data. An empty watchtower_address along with an
+	// empty encrypted_backup removes the existing backup data, if any
+	WatchtowerAddress string `protobuf:"bytes,3,opt,name=watchtower_address,json=watchtowerAddress,proto3" json:"watchtower_address,omitempty"`
+	// encrypted_backup is the backup data encrypted for the watchtower, e.g.,
+	// a pre-signed tx sweeping the timelock path of the staking output
+	EncryptedBackup []byte `protobuf:"bytes,4,opt,name=encrypted_backup,json=encryptedBackup,proto3" json:"encrypted_backup,omitempty"`
+}
+
+func (m *MsgSetWatchtowerBackup) Reset()         { *m = MsgSetWatchtowerBackup{} }
+func (m *MsgSetWatchtowerBackup) String() string { return proto.CompactTextString(m) }
+func (*MsgSetWatchtowerBackup) ProtoMessage()    {}
+func (*MsgSetWatchtowerBackup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{14}
+}
+func (m *MsgSetWatchtowerBackup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetWatchtowerBackup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetWatchtowerBackup.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -797,48 +955,62 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgSetWatchtowerBackup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetWatchtowerBackup.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgSetWatchtowerBackup) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgSetWatchtowerBackup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetWatchtowerBackup.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetWatchtowerBackup proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgSetWatchtowerBackup) GetSigner() string {
 	if m != nil {
-		return m.Authority
+		return m.Signer
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
+func (m *MsgSetWatchtowerBackup) GetStakingTxHash() string {
 	if m != nil {
-		return m.Params
+		return m.StakingTxHash
 	}
-	return Params{}
+	return ""
 }
 
-// MsgUpdateParamsResponse is the response to the MsgUpdateParams message.
-type MsgUpdateParamsResponse struct {
+func (m *MsgSetWatchtowerBackup) GetWatchtowerAddress() string {
+	if m != nil {
+		return m.WatchtowerAddress
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{13}
+func (m *MsgSetWatchtowerBackup) GetEncryptedBackup() []byte {
+	if m != nil {
+		return m.EncryptedBackup
+	}
+	return nil
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+
+// MsgSetWatchtowerBackupResponse is the response for MsgSetWatchtowerBackup
+type MsgSetWatchtowerBackupResponse struct {
+}
+
+func (m *MsgSetWatchtowerBackupResponse) Reset()         { *m = MsgSetWatchtowerBackupResponse{} }
+func (m *MsgSetWatchtowerBackupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetWatchtowerBackupResponse) ProtoMessage()    {}
+func (*MsgSetWatchtowerBackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{15}
+}
+func (m *MsgSetWatchtowerBackupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetWatchtowerBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetWatchtowerBackupResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -848,1034 +1020,1372 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgSetWatchtowerBackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetWatchtowerBackupResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgSetWatchtowerBackupResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgSetWatchtowerBackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetWatchtowerBackupResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetWatchtowerBackupResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateFinalityProvider)(nil), "babylon.btcstaking.v1.MsgCreateFinalityProvider")
-	proto.RegisterType((*MsgCreateFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgCreateFinalityProviderResponse")
-	proto.RegisterType((*MsgEditFinalityProvider)(nil), "babylon.btcstaking.v1.MsgEditFinalityProvider")
-	proto.RegisterType((*MsgEditFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgEditFinalityProviderResponse")
-	proto.RegisterType((*MsgCreateBTCDelegation)(nil), "babylon.btcstaking.v1.MsgCreateBTCDelegation")
-	proto.RegisterType((*MsgCreateBTCDelegationResponse)(nil), "babylon.btcstaking.v1.MsgCreateBTCDelegationResponse")
-	proto.RegisterType((*MsgAddCovenantSigs)(nil), "babylon.btcstaking.v1.MsgAddCovenantSigs")
-	proto.RegisterType((*MsgAddCovenantSigsResponse)(nil), "babylon.btcstaking.v1.MsgAddCovenantSigsResponse")
-	proto.RegisterType((*MsgBTCUndelegate)(nil), "babylon.btcstaking.v1.MsgBTCUndelegate")
-	proto.RegisterType((*MsgBTCUndelegateResponse)(nil), "babylon.btcstaking.v1.MsgBTCUndelegateResponse")
-	proto.RegisterType((*MsgSelectiveSlashingEvidence)(nil), "babylon.btcstaking.v1.MsgSelectiveSlashingEvidence")
-	proto.RegisterType((*MsgSelectiveSlashingEvidenceResponse)(nil), "babylon.btcstaking.v1.MsgSelectiveSlashingEvidenceResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.btcstaking.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.btcstaking.v1.MsgUpdateParamsResponse")
+// MsgReportStakingSpend is the message for reporting that the staking output
+// of a BTC delegation is spent by a tx that is neither its unbonding tx nor
+// its slashing tx
+type MsgReportStakingSpend struct {
+	// signer is the address of the reporter, which can be anyone
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// staking_tx_hash is the hash of the staking tx.
+	// It uniquely identifies a BTC delegation
+	StakingTxHash string `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// spend_tx is the tx spending the staking output, along with the proof of
+	// its inclusion in the Bitcoin chain
+	SpendTx *types1.TransactionInfo `protobuf:"bytes,3,opt,name=spend_tx,json=spendTx,proto3" json:"spend_tx,omitempty"`
 }
 
-func init() { proto.RegisterFile("babylon/btcstaking/v1/tx.proto", fileDescriptor_4baddb53e97f38f2) }
-
-var fileDescriptor_4baddb53e97f38f2 = []byte{
-	// 1307 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0x13, 0xc7,
-	0x1b, 0xce, 0xc6, 0x89, 0xf9, 0xe5, 0x75, 0x9c, 0xe4, 0xb7, 0x84, 0xb0, 0xd9, 0x82, 0x9d, 0x04,
-	0x0a, 0x01, 0x35, 0x6b, 0x12, 0x4a, 0xd4, 0x82, 0x54, 0x09, 0x27, 0x41, 0xa0, 0x62, 0xd5, 0x5a,
-	0x27, 0x3d, 0xb4, 0x07, 0x6b, 0xbd, 0x3b, 0x59, 0x8f, 0x6c, 0xef, 0xac, 0x76, 0xc6, 0x96, 0xad,
-	0x4a, 0x55, 0x85, 0x7a, 0xad, 0xd4, 0x53, 0x0f, 0xfd, 0x14, 0x1c, 0xf8, 0x08, 0x3d, 0xd0, 0x1b,
-	0xe2, 0x54, 0xa5, 0x52, 0x54, 0x41, 0x25, 0x0e, 0x3d, 0xf7, 0x5e, 0xed, 0xec, 0xec, 0x1f, 0xbb,
-	0xde, 0x92, 0x10, 0x6e, 0xde, 0x99, 0xe7, 0xfd, 0xf7, 0xbc, 0xcf, 0xbc, 0x33, 0x86, 0x42, 0xc3,
-	0x68, 0x0c, 0xda, 0xc4, 0x29, 0x35, 0x98, 0x49, 0x99, 0xd1, 0xc2, 0x8e, 0x5d, 0xea, 0x6d, 0x96,
-	0x58, 0x5f, 0x73, 0x3d, 0xc2, 0x88, 0x7c, 0x41, 0xec, 0x6b, 0xf1, 0xbe, 0xd6, 0xdb, 0x54, 0x17,
-	0x6d, 0x62, 0x13, 0x8e, 0x28, 0xf9, 0xbf, 0x02, 0xb0, 0xba, 0x6c, 0x12, 0xda, 0x21, 0xb4, 0x1e,
-	0x6c, 0x04, 0x1f, 0x62, 0xeb, 0x62, 0xf0, 0x55, 0xea, 0x50, 0xee, 0xbf, 0x43, 0x6d, 0xb1, 0xb1,
-	0x26, 0x36, 0x4c, 0x6f, 0xe0, 0x32, 0x52, 0xa2, 0xc8, 0x74, 0xb7, 0xee, 0x6c, 0xb7, 0x36, 0x4b,
-	0x2d, 0x34, 0x08, 0x8d, 0xd7, 0xc6, 0x27, 0xe9, 0x1a, 0x9e, 0xd1, 0x09, 0x31, 0x1f, 0x25, 0x30,
-	0x66, 0x13, 0x99, 0x2d, 0x97, 0x60, 0x87, 0xf9, 0xb0, 0xa1, 0x05, 0x81, 0xbe, 0x2a, 0xa2, 0xc6,
-	0xde, 0x1a, 0x88, 0x19, 0x9b, 0xe1, 0xb7, 0x40, 0x15, 0x53, 0xe2, 0x12, 0x37, 0x00, 0xac, 0xfd,
-	0x9a, 0x81, 0xe5, 0x0a, 0xb5, 0x77, 0x3c, 0x64, 0x30, 0xf4, 0x00, 0x3b, 0x46, 0x1b, 0xb3, 0x41,
-	0xd5, 0x23, 0x3d, 0x6c, 0x21, 0x4f, 0x5e, 0x82, 0x2c, 0xc5, 0xb6, 0x83, 0x3c, 0x45, 0x5a, 0x91,
-	0xd6, 0x67, 0x74, 0xf1, 0x25, 0xef, 0x41, 0xce, 0x42, 0xd4, 0xf4, 0xb0, 0xcb, 0x30, 0x71, 0x94,
-	0xc9, 0x15, 0x69, 0x3d, 0xb7, 0x75, 0x45, 0x13, 0x7c, 0xc5, 0x2c, 0xf3, 0x94, 0xb4, 0xdd, 0x18,
-	0xaa, 0x27, 0xed, 0xe4, 0x0a, 0x80, 0x49, 0x3a, 0x1d, 0x4c, 0xa9, 0xef, 0x25, 0xe3, 0x87, 0x28,
-	0x6f, 0x1c, 0x1d, 0x17, 0x3f, 0x08, 0x1c, 0x51, 0xab, 0xa5, 0x61, 0x52, 0xea, 0x18, 0xac, 0xa9,
-	0x3d, 0x46, 0xb6, 0x61, 0x0e, 0x76, 0x91, 0xf9, 0xf2, 0xd9, 0x06, 0x88, 0x38, 0xbb, 0xc8, 0xd4,
-	0x13, 0x0e, 0xe4, 0xcf, 0x00, 0x44, 0xb9, 0x75, 0xb7, 0xa5, 0x4c, 0xf1, 0xa4, 0x8a, 0x61, 0x52,
-	0x41, 0x77, 0xb4, 0xa8, 0x3b, 0x5a, 0xb5, 0xdb, 0xf8, 0x1c, 0x0d, 0xf4, 0x19, 0x61, 0x52, 0x6d,
-	0xc9, 0x15, 0xc8, 0x36, 0x98, 0xe9, 0xdb, 0x4e, 0xaf, 0x48, 0xeb, 0xb3, 0xe5, 0xed, 0xa3, 0xe3,
-	0xe2, 0x96, 0x8d, 0x59, 0xb3, 0xdb, 0xd0, 0x4c, 0xd2, 0x29, 0x09, 0xa4, 0xd9, 0x34, 0xb0, 0x13,
-	0x7e, 0x94, 0xd8, 0xc0, 0x45, 0x54, 0x2b, 0x3f, 0xaa, 0xde, 0xfe, 0xf8, 0x96, 0x70, 0x39, 0xdd,
-	0x60, 0x66, 0xb5, 0x25, 0xdf, 0x85, 0x8c, 0x4b, 0x5c, 0x25, 0xcb, 0xf3, 0x58, 0xd7, 0xc6, 0xca,
-	0x50, 0xab, 0x7a, 0x84, 0x1c, 0x7e, 0x71, 0x58, 0x25, 0x94, 0x22, 0x5e, 0x85, 0xee, 0x1b, 0xc9,
-	0xd7, 0x60, 0xbe, 0x63, 0x50, 0x86, 0xbc, 0xba, 0xdb, 0x6d, 0xd4, 0x3d, 0xc3, 0xb1, 0x94, 0x73,
-	0xbc, 0x03, 0xf9, 0x60, 0xb9, 0xda, 0x6d, 0xe8, 0x86, 0x63, 0xdd, 0xcd, 0x3d, 0x79, 0xf3, 0xf4,
-	0xa6, 0xe8, 0xca, 0xda, 0x15, 0x58, 0x4d, 0x6d, 0xa5, 0x8e, 0xa8, 0x4b, 0x1c, 0x8a, 0xd6, 0xfe,
-	0x92, 0xe0, 0x62, 0x85, 0xda, 0x7b, 0x16, 0x66, 0x27, 0x6e, 0xf7, 0x85, 0x88, 0x18, 0xbf, 0xd3,
-	0xb3, 0x61, 0x81, 0x23, 0x2a, 0xc8, 0xbc, 0x17, 0x15, 0x4c, 0x9d, 0x51, 0x05, 0xc3, 0x94, 0xac,
-	0x42, 0x31, 0xa5, 0xd8, 0x88, 0x90, 0xdf, 0xcf, 0xc1, 0x52, 0x44, 0x5b, 0x79, 0x7f, 0x67, 0x17,
-	0xb5, 0x91, 0x6d, 0xf0, 0xcc, 0xd2, 0xf8, 0x18, 0x16, 0xda, 0xe4, 0xa9, 0x85, 0x26, 0x94, 0x91,
-	0x79, 0x17, 0x65, 0xc4, 0x22, 0x9d, 0x7a, 0x1f, 0x22, 0xfd, 0x1a, 0xe6, 0x0e, 0xdd, 0x7a, 0xe0,
-	0xb1, 0xde, 0xc6, 0x94, 0x29, 0xd3, 0x2b, 0x99, 0x33, 0xb8, 0xcd, 0x1d, 0xba, 0x65, 0xdf, 0xf1,
-	0x63, 0x4c, 0x99, 0xbc, 0x0a, 0xb3, 0xa2, 0xa0, 0x3a, 0xc3, 0x1d, 0xc4, 0x8f, 0x42, 0x5e, 0xcf,
-	0x89, 0xb5, 0x7d, 0xdc, 0x41, 0xf2, 0x15, 0xc8, 0x87, 0x90, 0x9e, 0xd1, 0xee, 0x22, 0x2e, 0xf3,
-	0x8c, 0x1e, 0xda, 0x7d, 0xe9, 0xaf, 0xc9, 0x0f, 0x01, 0x22, 0x3f, 0x7d, 0xe5, 0x7f, 0x9c, 0xb6,
-	0x1b, 0x49, 0xda, 0x12, 0xd3, 0xb1, 0xb7, 0xa9, 0xed, 0x7b, 0x86, 0x43, 0x0d, 0xd3, 0x6f, 0xe1,
-	0x23, 0xe7, 0x90, 0xe8, 0x33, 0x61, 0xc0, 0xbe, 0xbc, 0x05, 0x39, 0xda, 0x36, 0x68, 0x53, 0xb8,
-	0x9a, 0xe1, 0x14, 0xfe, 0xff, 0xe8, 0xb8, 0x98, 0x2f, 0xef, 0xef, 0xd4, 0xc4, 0xce, 0x7e, 0x5f,
-	0x07, 0x1a, 0xfd, 0x96, 0x09, 0x2c, 0x59, 0x81, 0x26, 0x88, 0x57, 0x8f, 0xac, 0x29, 0xb6, 0x15,
-	0xe0, 0xe6, 0x9f, 0x1e, 0x1d, 0x17, 0xef, 0x9c, 0x86, 0xaa, 0x1a, 0xb6, 0x1d, 0x83, 0x75, 0x3d,
-	0xa4, 0x2f, 0x46, 0x8e, 0xc3, 0xd8, 0x35, 0x6c, 0xcb, 0x1f, 0xc2, 0x5c, 0xd7, 0x69, 0x10, 0xc7,
-	0x8a, 0x88, 0xcb, 0x71, 0xe2, 0xf2, 0xd1, 0x2a, 0xa7, 0x6e, 0x15, 0x66, 0x13, 0xb0, 0xbe, 0x32,
-	0xcb, 0xcf, 0x66, 0x2e, 0x06, 0xf5, 0xe5, 0xeb, 0x30, 0x1f, 0x43, 0x02, 0x7e, 0xf3, 0x9c, 0xdf,
-	0x38, 0x40, 0xc0, 0xf0, 0x1e, 0x5c, 0x88, 0x81, 0x49, 0x86, 0xe6, 0xd2, 0x18, 0x3a, 0x1f, 0xe1,
-	0xe3, 0x45, 0xf9, 0x89, 0x04, 0x2b, 0x31, 0x57, 0x63, 0x3c, 0xfa, 0xac, 0xcd, 0x9f, 0x95, 0xb5,
-	0xcb, 0x51, 0x88, 0x83, 0xd1, 0x1c, 0x6a, 0xd8, 0x1e, 0x1e, 0x00, 0x2b, 0x50, 0x18, 0x7f, 0xb8,
-	0xa3, 0xf3, 0xff, 0xf7, 0x24, 0xc8, 0x15, 0x6a, 0xdf, 0xb7, 0xac, 0x1d, 0xd2, 0x43, 0x8e, 0xe1,
-	0xb0, 0x1a, 0xb6, 0x69, 0xea, 0xd9, 0x7f, 0x00, 0x93, 0xe1, 0x1c, 0x7c, 0xe7, 0x43, 0x32, 0xe9,
-	0xb6, 0xfc, 0x09, 0x1f, 0x6b, 0xba, 0xde, 0x34, 0x68, 0x33, 0xb8, 0x00, 0xf5, 0x7c, 0xa4, 0xd6,
-	0x87, 0x06, 0x6d, 0xca, 0xeb, 0xb0, 0x90, 0xe8, 0x87, 0x4f, 0x20, 0x55, 0xa6, 0xfc, 0x23, 0xaa,
-	0xcf, 0xc5, 0x1a, 0xe5, 0x19, 0x9b, 0xb0, 0x90, 0xd4, 0x03, 0xe7, 0x7a, 0xfa, 0xac, 0x5c, 0xcf,
-	0x25, 0xe4, 0xe4, 0x6b, 0xf3, 0x1e, 0xa8, 0x51, 0x3a, 0xa3, 0xd1, 0xa8, 0x92, 0xe5, 0x89, 0x5d,
-	0x0c, 0x11, 0x07, 0x43, 0xb6, 0x74, 0xb8, 0x33, 0x97, 0x40, 0xfd, 0x37, 0xed, 0x51, 0x57, 0x7e,
-	0x91, 0x60, 0xa1, 0x42, 0xed, 0xf2, 0xfe, 0xce, 0x81, 0x23, 0xda, 0x8d, 0x52, 0x7b, 0x32, 0x86,
-	0xcb, 0xc9, 0x71, 0x5c, 0x8e, 0x63, 0x28, 0xf3, 0x9e, 0x19, 0x1a, 0x2e, 0x52, 0x05, 0x65, 0xb4,
-	0x8a, 0xa8, 0xc4, 0x9f, 0x25, 0xb8, 0x54, 0xa1, 0x76, 0x0d, 0xb5, 0x91, 0xc9, 0x70, 0x0f, 0x85,
-	0x1a, 0xde, 0xf3, 0xef, 0x27, 0xc7, 0x3c, 0x7b, 0xb9, 0x1b, 0x70, 0xde, 0x43, 0x26, 0xe9, 0x21,
-	0x0f, 0x59, 0x75, 0x31, 0xe5, 0x69, 0x2b, 0xa8, 0x58, 0x5f, 0x88, 0xb6, 0x1e, 0xf8, 0x13, 0xbb,
-	0xd6, 0x1a, 0x4e, 0xfc, 0x1a, 0x5c, 0xfd, 0xaf, 0xdc, 0xa2, 0x22, 0x7e, 0x92, 0x60, 0xbe, 0x42,
-	0xed, 0x03, 0xd7, 0x32, 0x18, 0xaa, 0xf2, 0xe7, 0xac, 0xbc, 0x0d, 0x33, 0x46, 0x97, 0x35, 0x89,
-	0x87, 0xd9, 0x20, 0x48, 0xbd, 0xac, 0xbc, 0x7c, 0xb6, 0xb1, 0x28, 0x2e, 0xc8, 0xfb, 0x96, 0xe5,
-	0x21, 0x4a, 0x6b, 0xcc, 0xc3, 0x8e, 0xad, 0xc7, 0x50, 0xf9, 0x1e, 0x64, 0x83, 0x07, 0xb1, 0xb8,
-	0x52, 0x2f, 0xa7, 0xdd, 0x8c, 0x1c, 0x54, 0x9e, 0x7a, 0x7e, 0x5c, 0x9c, 0xd0, 0x85, 0xc9, 0xdd,
-	0x39, 0x3f, 0xfb, 0xd8, 0xd9, 0xda, 0x32, 0x7f, 0xe6, 0x24, 0xf3, 0x0a, 0x73, 0xde, 0xfa, 0x33,
-	0x0b, 0x99, 0x0a, 0xb5, 0xe5, 0xef, 0x25, 0x58, 0x4a, 0x79, 0xf8, 0xde, 0x4a, 0x09, 0x9d, 0xfa,
-	0xbe, 0x52, 0x3f, 0x39, 0xad, 0x45, 0x98, 0x8e, 0xfc, 0x2d, 0x2c, 0x8e, 0x7d, 0x8d, 0x69, 0xe9,
-	0x1e, 0xc7, 0xe1, 0xd5, 0xed, 0xd3, 0xe1, 0xa3, 0xf8, 0xdf, 0xc0, 0xf9, 0x71, 0x8f, 0x9f, 0x8d,
-	0xb7, 0x15, 0x34, 0x04, 0x57, 0xef, 0x9c, 0x0a, 0x1e, 0x05, 0x27, 0x30, 0x3f, 0x3a, 0x79, 0x6f,
-	0xa4, 0x7b, 0x1a, 0x81, 0xaa, 0x9b, 0x27, 0x86, 0x46, 0x01, 0x31, 0xe4, 0x87, 0x87, 0xca, 0xf5,
-	0x74, 0x1f, 0x43, 0x40, 0xb5, 0x74, 0x42, 0x60, 0x14, 0xea, 0x07, 0x09, 0x96, 0xd3, 0x4f, 0xf7,
-	0xed, 0x74, 0x77, 0xa9, 0x46, 0xea, 0xbd, 0x77, 0x30, 0x8a, 0xf2, 0x39, 0x84, 0xd9, 0xa1, 0x73,
-	0x7a, 0x2d, 0xdd, 0x59, 0x12, 0xa7, 0x6a, 0x27, 0xc3, 0x85, 0x71, 0xd4, 0xe9, 0xef, 0xde, 0x3c,
-	0xbd, 0x29, 0x95, 0x1f, 0x3f, 0x7f, 0x55, 0x90, 0x5e, 0xbc, 0x2a, 0x48, 0x7f, 0xbc, 0x2a, 0x48,
-	0x3f, 0xbe, 0x2e, 0x4c, 0xbc, 0x78, 0x5d, 0x98, 0xf8, 0xed, 0x75, 0x61, 0xe2, 0xab, 0xb7, 0xde,
-	0x99, 0xfd, 0xe4, 0xff, 0x55, 0x3e, 0x76, 0x1b, 0x59, 0xfe, 0x7f, 0xf5, 0xf6, 0x3f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xa3, 0xb5, 0x5f, 0xec, 0xef, 0x0f, 0x00, 0x00,
+func (m *MsgReportStakingSpend) Reset()         { *m = MsgReportStakingSpend{} }
+func (m *MsgReportStakingSpend) String() string { return proto.CompactTextString(m) }
+func (*MsgReportStakingSpend) ProtoMessage()    {}
+func (*MsgReportStakingSpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{16}
+}
+func (m *MsgReportStakingSpend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportStakingSpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportStakingSpend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReportStakingSpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportStakingSpend.Merge(m, src)
+}
+func (m *MsgReportStakingSpend) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportStakingSpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportStakingSpend.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgReportStakingSpend proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgReportStakingSpend) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateFinalityProvider creates a new finality provider
-	CreateFinalityProvider(ctx context.Context, in *MsgCreateFinalityProvider, opts ...grpc.CallOption) (*MsgCreateFinalityProviderResponse, error)
-	// EditFinalityProvider edits an existing finality provider
-	EditFinalityProvider(ctx context.Context, in *MsgEditFinalityProvider, opts ...grpc.CallOption) (*MsgEditFinalityProviderResponse, error)
-	// CreateBTCDelegation creates a new BTC delegation
-	CreateBTCDelegation(ctx context.Context, in *MsgCreateBTCDelegation, opts ...grpc.CallOption) (*MsgCreateBTCDelegationResponse, error)
-	// AddCovenantSigs handles signatures from a covenant member
-	AddCovenantSigs(ctx context.Context, in *MsgAddCovenantSigs, opts ...grpc.CallOption) (*MsgAddCovenantSigsResponse, error)
-	// BTCUndelegate handles a signature on unbonding tx from its delegator
-	BTCUndelegate(ctx context.Context, in *MsgBTCUndelegate, opts ...grpc.CallOption) (*MsgBTCUndelegateResponse, error)
-	// SelectiveSlashingEvidence handles the evidence of selective slashing launched
-	// by a finality provider
-	SelectiveSlashingEvidence(ctx context.Context, in *MsgSelectiveSlashingEvidence, opts ...grpc.CallOption) (*MsgSelectiveSlashingEvidenceResponse, error)
-	// UpdateParams updates the btcstaking module parameters.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgReportStakingSpend) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgReportStakingSpend) GetSpendTx() *types1.TransactionInfo {
+	if m != nil {
+		return m.SpendTx
+	}
+	return nil
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgReportStakingSpendResponse is the response for MsgReportStakingSpend
+type MsgReportStakingSpendResponse struct {
 }
 
-func (c *msgClient) CreateFinalityProvider(ctx context.Context, in *MsgCreateFinalityProvider, opts ...grpc.CallOption) (*MsgCreateFinalityProviderResponse, error) {
-	out := new(MsgCreateFinalityProviderResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/CreateFinalityProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgReportStakingSpendResponse) Reset()         { *m = MsgReportStakingSpendResponse{} }
+func (m *MsgReportStakingSpendResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReportStakingSpendResponse) ProtoMessage()    {}
+func (*MsgReportStakingSpendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{17}
 }
-
-func (c *msgClient) EditFinalityProvider(ctx context.Context, in *MsgEditFinalityProvider, opts ...grpc.CallOption) (*MsgEditFinalityProviderResponse, error) {
-	out := new(MsgEditFinalityProviderResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/EditFinalityProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgReportStakingSpendResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportStakingSpendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportStakingSpendResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgReportStakingSpendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportStakingSpendResponse.Merge(m, src)
+}
+func (m *MsgReportStakingSpendResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportStakingSpendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportStakingSpendResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateBTCDelegation(ctx context.Context, in *MsgCreateBTCDelegation, opts ...grpc.CallOption) (*MsgCreateBTCDelegationResponse, error) {
-	out := new(MsgCreateBTCDelegationResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/CreateBTCDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgReportStakingSpendResponse proto.InternalMessageInfo
+
+// MsgUpdateFinalityProviderStatus is the message for a finality provider to
+// announce a planned downtime or key migration window, or to withdraw its
+// announcement by reporting the OPERATIONAL status
+type MsgUpdateFinalityProviderStatus struct {
+	// NOTE: this signer needs to correspond to babylon_pk of the finality provider
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// fp_btc_pk is the BTC PK of the finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// status is the announced operational status
+	Status FinalityProviderOperationalStatus `protobuf:"varint,3,opt,name=status,proto3,enum=babylon.btcstaking.v1.FinalityProviderOperationalStatus" json:"status,omitempty"`
+	// start_height is the first Babylon height of the announced window
+	StartHeight uint64 `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last Babylon height of the announced window
+	EndHeight uint64 `protobuf:"varint,5,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// reason is a human-readable explanation of the announcement
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgUpdateFinalityProviderStatus) Reset()         { *m = MsgUpdateFinalityProviderStatus{} }
+func (m *MsgUpdateFinalityProviderStatus) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFinalityProviderStatus) ProtoMessage()    {}
+func (*MsgUpdateFinalityProviderStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{18}
+}
+func (m *MsgUpdateFinalityProviderStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFinalityProviderStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFinalityProviderStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateFinalityProviderStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFinalityProviderStatus.Merge(m, src)
+}
+func (m *MsgUpdateFinalityProviderStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFinalityProviderStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFinalityProviderStatus.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddCovenantSigs(ctx context.Context, in *MsgAddCovenantSigs, opts ...grpc.CallOption) (*MsgAddCovenantSigsResponse, error) {
-	out := new(MsgAddCovenantSigsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/AddCovenantSigs", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateFinalityProviderStatus proto.InternalMessageInfo
+
+func (m *MsgUpdateFinalityProviderStatus) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) BTCUndelegate(ctx context.Context, in *MsgBTCUndelegate, opts ...grpc.CallOption) (*MsgBTCUndelegateResponse, error) {
-	out := new(MsgBTCUndelegateResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/BTCUndelegate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateFinalityProviderStatus) GetStatus() FinalityProviderOperationalStatus {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return FinalityProviderOperationalStatus_OPERATIONAL
 }
 
-func (c *msgClient) SelectiveSlashingEvidence(ctx context.Context, in *MsgSelectiveSlashingEvidence, opts ...grpc.CallOption) (*MsgSelectiveSlashingEvidenceResponse, error) {
-	out := new(MsgSelectiveSlashingEvidenceResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/SelectiveSlashingEvidence", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateFinalityProviderStatus) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateFinalityProviderStatus) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateFinalityProvider creates a new finality provider
-	CreateFinalityProvider(context.Context, *MsgCreateFinalityProvider) (*MsgCreateFinalityProviderResponse, error)
-	// EditFinalityProvider edits an existing finality provider
-	EditFinalityProvider(context.Context, *MsgEditFinalityProvider) (*MsgEditFinalityProviderResponse, error)
-	// CreateBTCDelegation creates a new BTC delegation
-	CreateBTCDelegation(context.Context, *MsgCreateBTCDelegation) (*MsgCreateBTCDelegationResponse, error)
-	// AddCovenantSigs handles signatures from a covenant member
-	AddCovenantSigs(context.Context, *MsgAddCovenantSigs) (*MsgAddCovenantSigsResponse, error)
-	// BTCUndelegate handles a signature on unbonding tx from its delegator
-	BTCUndelegate(context.Context, *MsgBTCUndelegate) (*MsgBTCUndelegateResponse, error)
-	// SelectiveSlashingEvidence handles the evidence of selective slashing launched
-	// by a finality provider
-	SelectiveSlashingEvidence(context.Context, *MsgSelectiveSlashingEvidence) (*MsgSelectiveSlashingEvidenceResponse, error)
-	// UpdateParams updates the btcstaking module parameters.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgUpdateFinalityProviderStatus) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgUpdateFinalityProviderStatusResponse is the response for MsgUpdateFinalityProviderStatus
+type MsgUpdateFinalityProviderStatusResponse struct {
 }
 
-func (*UnimplementedMsgServer) CreateFinalityProvider(ctx context.Context, req *MsgCreateFinalityProvider) (*MsgCreateFinalityProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateFinalityProvider not implemented")
+func (m *MsgUpdateFinalityProviderStatusResponse) Reset() {
+	*m = MsgUpdateFinalityProviderStatusResponse{}
 }
-func (*UnimplementedMsgServer) EditFinalityProvider(ctx context.Context, req *MsgEditFinalityProvider) (*MsgEditFinalityProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditFinalityProvider not implemented")
+func (m *MsgUpdateFinalityProviderStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFinalityProviderStatusResponse) ProtoMessage()    {}
+func (*MsgUpdateFinalityProviderStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{19}
 }
-func (*UnimplementedMsgServer) CreateBTCDelegation(ctx context.Context, req *MsgCreateBTCDelegation) (*MsgCreateBTCDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBTCDelegation not implemented")
+func (m *MsgUpdateFinalityProviderStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) AddCovenantSigs(ctx context.Context, req *MsgAddCovenantSigs) (*MsgAddCovenantSigsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddCovenantSigs not implemented")
+func (m *MsgUpdateFinalityProviderStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFinalityProviderStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) BTCUndelegate(ctx context.Context, req *MsgBTCUndelegate) (*MsgBTCUndelegateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BTCUndelegate not implemented")
+func (m *MsgUpdateFinalityProviderStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFinalityProviderStatusResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SelectiveSlashingEvidence(ctx context.Context, req *MsgSelectiveSlashingEvidence) (*MsgSelectiveSlashingEvidenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SelectiveSlashingEvidence not implemented")
+func (m *MsgUpdateFinalityProviderStatusResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgUpdateFinalityProviderStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFinalityProviderStatusResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgUpdateFinalityProviderStatusResponse proto.InternalMessageInfo
+
+// MsgSelectiveSlashingEvidence is the message for handling evidence of selective slashing
+// launched by a finality provider
+type MsgSelectiveSlashingEvidence struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// staking_tx_hash is the hash of the staking tx.
+	// It uniquely identifies a BTC delegation
+	StakingTxHash string `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// recovered_fp_btc_sk is the BTC SK of the finality provider who
+	// launches the selective slashing offence. The SK is recovered by
+	// using a covenant adaptor signature and the corresponding Schnorr
+	// signature
+	RecoveredFpBtcSk []byte `protobuf:"bytes,3,opt,name=recovered_fp_btc_sk,json=recoveredFpBtcSk,proto3" json:"recovered_fp_btc_sk,omitempty"`
 }
 
-func _Msg_CreateFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateFinalityProvider)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateFinalityProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Msg/CreateFinalityProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateFinalityProvider(ctx, req.(*MsgCreateFinalityProvider))
+func (m *MsgSelectiveSlashingEvidence) Reset()         { *m = MsgSelectiveSlashingEvidence{} }
+func (m *MsgSelectiveSlashingEvidence) String() string { return proto.CompactTextString(m) }
+func (*MsgSelectiveSlashingEvidence) ProtoMessage()    {}
+func (*MsgSelectiveSlashingEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{20}
+}
+func (m *MsgSelectiveSlashingEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSelectiveSlashingEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSelectiveSlashingEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSelectiveSlashingEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSelectiveSlashingEvidence.Merge(m, src)
+}
+func (m *MsgSelectiveSlashingEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSelectiveSlashingEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSelectiveSlashingEvidence.DiscardUnknown(m)
 }
 
-func _Msg_EditFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgEditFinalityProvider)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).EditFinalityProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Msg/EditFinalityProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).EditFinalityProvider(ctx, req.(*MsgEditFinalityProvider))
+var xxx_messageInfo_MsgSelectiveSlashingEvidence proto.InternalMessageInfo
+
+func (m *MsgSelectiveSlashingEvidence) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateBTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateBTCDelegation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateBTCDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Msg/CreateBTCDelegation",
+func (m *MsgSelectiveSlashingEvidence) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateBTCDelegation(ctx, req.(*MsgCreateBTCDelegation))
+	return ""
+}
+
+func (m *MsgSelectiveSlashingEvidence) GetRecoveredFpBtcSk() []byte {
+	if m != nil {
+		return m.RecoveredFpBtcSk
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_AddCovenantSigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddCovenantSigs)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddCovenantSigs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Msg/AddCovenantSigs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddCovenantSigs(ctx, req.(*MsgAddCovenantSigs))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgSelectiveSlashingEvidenceResponse is the response for MsgSelectiveSlashingEvidence
+type MsgSelectiveSlashingEvidenceResponse struct {
 }
 
-func _Msg_BTCUndelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBTCUndelegate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BTCUndelegate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Msg/BTCUndelegate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BTCUndelegate(ctx, req.(*MsgBTCUndelegate))
+func (m *MsgSelectiveSlashingEvidenceResponse) Reset()         { *m = MsgSelectiveSlashingEvidenceResponse{} }
+func (m *MsgSelectiveSlashingEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSelectiveSlashingEvidenceResponse) ProtoMessage()    {}
+func (*MsgSelectiveSlashingEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{21}
+}
+func (m *MsgSelectiveSlashingEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSelectiveSlashingEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSelectiveSlashingEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSelectiveSlashingEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSelectiveSlashingEvidenceResponse.Merge(m, src)
+}
+func (m *MsgSelectiveSlashingEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSelectiveSlashingEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSelectiveSlashingEvidenceResponse.DiscardUnknown(m)
 }
 
-func _Msg_SelectiveSlashingEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSelectiveSlashingEvidence)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSelectiveSlashingEvidenceResponse proto.InternalMessageInfo
+
+// MsgSetPause is the message for the governance account or the pause
+// authority to pause or resume the processing of MsgCreateBTCDelegation and
+// MsgBTCUndelegate during incident response. Covenant signatures and slashing
+// evidence are always processed.
+type MsgSetPause struct {
+	// signer is the address of the governance account or the pause authority
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// paused indicates whether to pause or resume the processing
+	Paused bool `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	// reason is a human-readable explanation of the update
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgSetPause) Reset()         { *m = MsgSetPause{} }
+func (m *MsgSetPause) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPause) ProtoMessage()    {}
+func (*MsgSetPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{22}
+}
+func (m *MsgSetPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SelectiveSlashingEvidence(ctx, in)
+}
+func (m *MsgSetPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPause.Merge(m, src)
+}
+func (m *MsgSetPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPause proto.InternalMessageInfo
+
+func (m *MsgSetPause) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Msg/SelectiveSlashingEvidence",
+	return ""
+}
+
+func (m *MsgSetPause) GetPaused() bool {
+	if m != nil {
+		return m.Paused
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SelectiveSlashingEvidence(ctx, req.(*MsgSelectiveSlashingEvidence))
+	return false
+}
+
+func (m *MsgSetPause) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
+// MsgSetPauseResponse is the response for MsgSetPause
+type MsgSetPauseResponse struct {
+}
+
+func (m *MsgSetPauseResponse) Reset()         { *m = MsgSetPauseResponse{} }
+func (m *MsgSetPauseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPauseResponse) ProtoMessage()    {}
+func (*MsgSetPauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{23}
+}
+func (m *MsgSetPauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+}
+func (m *MsgSetPauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPauseResponse.Merge(m, src)
+}
+func (m *MsgSetPauseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPauseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPauseResponse proto.InternalMessageInfo
+
+// MsgUpdateParams defines a message for updating btcstaking module parameters.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	// just FYI: cosmos.AddressString marks that this field should use type alias
+	// for AddressString instead of string, but the functionality is not yet implemented
+	// in cosmos-proto
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the finality parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// activation_height is the Babylon height at which the params take effect.
+	// It is mutually exclusive with activation_epoch. If both are zero, the
+	// params take effect immediately. Otherwise, the params are scheduled and
+	// replace any params scheduled before.
+	ActivationHeight uint64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// activation_epoch is the epoch at whose first block the params take
+	// effect. It is mutually exclusive with activation_height.
+	ActivationEpoch uint64 `protobuf:"varint,4,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{24}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Msg/UpdateParams",
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return interceptor(ctx, in, info, handler)
+	return Params{}
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylon.btcstaking.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateFinalityProvider",
-			Handler:    _Msg_CreateFinalityProvider_Handler,
-		},
-		{
-			MethodName: "EditFinalityProvider",
-			Handler:    _Msg_EditFinalityProvider_Handler,
-		},
-		{
-			MethodName: "CreateBTCDelegation",
-			Handler:    _Msg_CreateBTCDelegation_Handler,
-		},
-		{
-			MethodName: "AddCovenantSigs",
-			Handler:    _Msg_AddCovenantSigs_Handler,
-		},
-		{
-			MethodName: "BTCUndelegate",
-			Handler:    _Msg_BTCUndelegate_Handler,
-		},
-		{
-			MethodName: "SelectiveSlashingEvidence",
-			Handler:    _Msg_SelectiveSlashingEvidence_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "babylon/btcstaking/v1/tx.proto",
+func (m *MsgUpdateParams) GetActivationHeight() uint64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
 }
 
-func (m *MsgCreateFinalityProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParams) GetActivationEpoch() uint64 {
+	if m != nil {
+		return m.ActivationEpoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateParamsResponse is the response to the MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgCreateFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MasterPubRand) > 0 {
-		i -= len(m.MasterPubRand)
-		copy(dAtA[i:], m.MasterPubRand)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MasterPubRand)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Pop != nil {
-		{
-			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.BtcPk != nil {
-		{
-			size := m.BtcPk.Size()
-			i -= size
-			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.BabylonPk != nil {
-		{
-			size, err := m.BabylonPk.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Commission != nil {
-		{
-			size := m.Commission.Size()
-			i -= size
-			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Description != nil {
-		{
-			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{25}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateFinalityProvider)(nil), "babylon.btcstaking.v1.MsgCreateFinalityProvider")
+	proto.RegisterType((*MsgCreateFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgCreateFinalityProviderResponse")
+	proto.RegisterType((*MsgEditFinalityProvider)(nil), "babylon.btcstaking.v1.MsgEditFinalityProvider")
+	proto.RegisterType((*MsgEditFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgEditFinalityProviderResponse")
+	proto.RegisterType((*MsgCreateBTCDelegation)(nil), "babylon.btcstaking.v1.MsgCreateBTCDelegation")
+	proto.RegisterType((*MsgCreateBTCDelegationResponse)(nil), "babylon.btcstaking.v1.MsgCreateBTCDelegationResponse")
+	proto.RegisterType((*MsgAddCovenantSigs)(nil), "babylon.btcstaking.v1.MsgAddCovenantSigs")
+	proto.RegisterType((*MsgAddCovenantSigsResponse)(nil), "babylon.btcstaking.v1.MsgAddCovenantSigsResponse")
+	proto.RegisterType((*MsgAddCovenantMuSig2Nonces)(nil), "babylon.btcstaking.v1.MsgAddCovenantMuSig2Nonces")
+	proto.RegisterType((*MsgAddCovenantMuSig2NoncesResponse)(nil), "babylon.btcstaking.v1.MsgAddCovenantMuSig2NoncesResponse")
+	proto.RegisterType((*MsgBTCUndelegate)(nil), "babylon.btcstaking.v1.MsgBTCUndelegate")
+	proto.RegisterType((*MsgBTCUndelegateResponse)(nil), "babylon.btcstaking.v1.MsgBTCUndelegateResponse")
+	proto.RegisterType((*MsgSetDelegationOperator)(nil), "babylon.btcstaking.v1.MsgSetDelegationOperator")
+	proto.RegisterType((*MsgSetDelegationOperatorResponse)(nil), "babylon.btcstaking.v1.MsgSetDelegationOperatorResponse")
+	proto.RegisterType((*MsgSetWatchtowerBackup)(nil), "babylon.btcstaking.v1.MsgSetWatchtowerBackup")
+	proto.RegisterType((*MsgSetWatchtowerBackupResponse)(nil), "babylon.btcstaking.v1.MsgSetWatchtowerBackupResponse")
+	proto.RegisterType((*MsgReportStakingSpend)(nil), "babylon.btcstaking.v1.MsgReportStakingSpend")
+	proto.RegisterType((*MsgReportStakingSpendResponse)(nil), "babylon.btcstaking.v1.MsgReportStakingSpendResponse")
+	proto.RegisterType((*MsgUpdateFinalityProviderStatus)(nil), "babylon.btcstaking.v1.MsgUpdateFinalityProviderStatus")
+	proto.RegisterType((*MsgUpdateFinalityProviderStatusResponse)(nil), "babylon.btcstaking.v1.MsgUpdateFinalityProviderStatusResponse")
+	proto.RegisterType((*MsgSelectiveSlashingEvidence)(nil), "babylon.btcstaking.v1.MsgSelectiveSlashingEvidence")
+	proto.RegisterType((*MsgSelectiveSlashingEvidenceResponse)(nil), "babylon.btcstaking.v1.MsgSelectiveSlashingEvidenceResponse")
+	proto.RegisterType((*MsgSetPause)(nil), "babylon.btcstaking.v1.MsgSetPause")
+	proto.RegisterType((*MsgSetPauseResponse)(nil), "babylon.btcstaking.v1.MsgSetPauseResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.btcstaking.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.btcstaking.v1.MsgUpdateParamsResponse")
 }
 
-func (m *MsgCreateFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("babylon/btcstaking/v1/tx.proto", fileDescriptor_4baddb53e97f38f2) }
+
+var fileDescriptor_4baddb53e97f38f2 = []byte{
+	// 1878 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x8a, 0x92, 0x2c, 0x3d, 0x8a, 0x92, 0x3c, 0xb6, 0x24, 0x7a, 0x6b, 0x8b, 0x32, 0xed,
+	0xda, 0x92, 0x13, 0x91, 0x96, 0xfc, 0xa7, 0x89, 0x0d, 0xa4, 0x08, 0x25, 0xb9, 0x36, 0x62, 0x36,
+	0xc4, 0x52, 0x6e, 0x81, 0xf4, 0x40, 0x2c, 0x77, 0x47, 0xcb, 0x05, 0xc9, 0x9d, 0xc5, 0xce, 0x90,
+	0xa2, 0xd0, 0xa2, 0x68, 0x82, 0x02, 0x3d, 0x15, 0xe8, 0xa9, 0x87, 0x02, 0xfd, 0x02, 0x3d, 0xe5,
+	0x90, 0x8f, 0x50, 0x14, 0x39, 0x06, 0x39, 0xb5, 0x3e, 0x08, 0x85, 0x0d, 0x34, 0x40, 0x0b, 0xf4,
+	0xd6, 0x7b, 0xb1, 0xb3, 0xb3, 0xb3, 0x24, 0xb3, 0x4b, 0x89, 0xa6, 0x6f, 0x9c, 0x99, 0xdf, 0xfb,
+	0xf7, 0x7b, 0x6f, 0xde, 0x3c, 0x92, 0xb0, 0x5e, 0xd7, 0xeb, 0x27, 0x2d, 0xe2, 0x14, 0xeb, 0xcc,
+	0xa0, 0x4c, 0x6f, 0xda, 0x8e, 0x55, 0xec, 0xee, 0x14, 0x59, 0xaf, 0xe0, 0x7a, 0x84, 0x11, 0xb4,
+	0x22, 0xce, 0x0b, 0xd1, 0x79, 0xa1, 0xbb, 0xa3, 0x5e, 0xb1, 0x88, 0x45, 0x38, 0xa2, 0xe8, 0x7f,
+	0x0a, 0xc0, 0xea, 0x55, 0x83, 0xd0, 0x36, 0xa1, 0xb5, 0xe0, 0x20, 0x58, 0x88, 0xa3, 0xb5, 0x60,
+	0x55, 0x6c, 0x53, 0xae, 0xbf, 0x4d, 0x2d, 0x71, 0x90, 0x17, 0x07, 0x86, 0x77, 0xe2, 0x32, 0x52,
+	0xa4, 0xd8, 0x70, 0x77, 0x1f, 0x3e, 0x6a, 0xee, 0x14, 0x9b, 0xf8, 0x24, 0x14, 0xce, 0xc7, 0x3b,
+	0xe9, 0xea, 0x9e, 0xde, 0x0e, 0x31, 0xef, 0xf7, 0x61, 0x8c, 0x06, 0x36, 0x9a, 0x2e, 0xb1, 0x1d,
+	0xe6, 0xc3, 0x06, 0x36, 0x04, 0xfa, 0x96, 0xb0, 0x1a, 0x69, 0xab, 0x63, 0xa6, 0xef, 0x84, 0x6b,
+	0x81, 0xca, 0x25, 0xd8, 0x25, 0xae, 0x00, 0xdc, 0x8e, 0x07, 0x44, 0xab, 0x00, 0x97, 0xff, 0x77,
+	0x0a, 0xae, 0x96, 0xa9, 0xb5, 0xe7, 0x61, 0x9d, 0xe1, 0xa7, 0xb6, 0xa3, 0xb7, 0x6c, 0x76, 0x52,
+	0xf1, 0x48, 0xd7, 0x36, 0xb1, 0x87, 0x56, 0x61, 0x96, 0xda, 0x96, 0x83, 0xbd, 0xac, 0xb2, 0xa1,
+	0x6c, 0xce, 0x6b, 0x62, 0x85, 0x0e, 0x20, 0x6d, 0x62, 0x6a, 0x78, 0xb6, 0xcb, 0x6c, 0xe2, 0x64,
+	0xa7, 0x36, 0x94, 0xcd, 0xf4, 0xee, 0xcd, 0x82, 0xe0, 0x35, 0xca, 0x06, 0x77, 0xbd, 0xb0, 0x1f,
+	0x41, 0xb5, 0x7e, 0x39, 0x54, 0x06, 0x30, 0x48, 0xbb, 0x6d, 0x53, 0xea, 0x6b, 0x49, 0xf9, 0x26,
+	0x4a, 0xdb, 0xaf, 0x4e, 0x73, 0x3f, 0x08, 0x14, 0x51, 0xb3, 0x59, 0xb0, 0x49, 0xb1, 0xad, 0xb3,
+	0x46, 0xe1, 0x05, 0xb6, 0x74, 0xe3, 0x64, 0x1f, 0x1b, 0xdf, 0x7e, 0xb5, 0x0d, 0xc2, 0xce, 0x3e,
+	0x36, 0xb4, 0x3e, 0x05, 0xe8, 0x23, 0x00, 0x11, 0x75, 0xcd, 0x6d, 0x66, 0xa7, 0xb9, 0x53, 0xb9,
+	0xd0, 0xa9, 0x20, 0x8b, 0x05, 0x99, 0xc5, 0x42, 0xa5, 0x53, 0xff, 0x04, 0x9f, 0x68, 0xf3, 0x42,
+	0xa4, 0xd2, 0x44, 0x65, 0x98, 0xad, 0x33, 0xc3, 0x97, 0x9d, 0xd9, 0x50, 0x36, 0x17, 0x4a, 0x8f,
+	0x5e, 0x9d, 0xe6, 0x76, 0x2d, 0x9b, 0x35, 0x3a, 0xf5, 0x82, 0x41, 0xda, 0x45, 0x81, 0x34, 0x1a,
+	0xba, 0xed, 0x84, 0x8b, 0x22, 0x3b, 0x71, 0x31, 0x2d, 0x94, 0x9e, 0x57, 0xee, 0x3f, 0xb8, 0x27,
+	0x54, 0xce, 0xd4, 0x99, 0x51, 0x69, 0xa2, 0xc7, 0x90, 0x72, 0x89, 0x9b, 0x9d, 0xe5, 0x7e, 0x6c,
+	0x16, 0x62, 0xcb, 0xb5, 0x50, 0xf1, 0x08, 0x39, 0xfa, 0xf4, 0xa8, 0x42, 0x28, 0xc5, 0x3c, 0x0a,
+	0xcd, 0x17, 0x42, 0xb7, 0x61, 0xa9, 0xad, 0x53, 0x86, 0xbd, 0x9a, 0xdb, 0xa9, 0xd7, 0x3c, 0xdd,
+	0x31, 0xb3, 0x17, 0x79, 0x06, 0x32, 0xc1, 0x76, 0xa5, 0x53, 0xd7, 0x74, 0xc7, 0x44, 0x39, 0x48,
+	0x1b, 0xc4, 0xa1, 0x9d, 0x36, 0xf6, 0x6a, 0xb6, 0x99, 0x9d, 0xe3, 0x18, 0x08, 0xb7, 0x9e, 0x9b,
+	0x8f, 0xd3, 0x5f, 0x7c, 0xf7, 0xe5, 0x5d, 0x91, 0xb6, 0xfc, 0x4d, 0xb8, 0x91, 0x98, 0x6b, 0x0d,
+	0x53, 0x97, 0x38, 0x14, 0xe7, 0xff, 0xa3, 0xc0, 0x5a, 0x99, 0x5a, 0x07, 0xa6, 0xcd, 0xce, 0x5d,
+	0x0f, 0x2b, 0x92, 0x39, 0xbf, 0x14, 0x16, 0x42, 0x06, 0x86, 0xca, 0x24, 0xf5, 0x4e, 0xca, 0x64,
+	0x7a, 0xc2, 0x32, 0x19, 0xa4, 0xe4, 0x06, 0xe4, 0x12, 0x82, 0x95, 0x84, 0xfc, 0x6b, 0x0e, 0x56,
+	0x25, 0x6d, 0xa5, 0xc3, 0xbd, 0x7d, 0xdc, 0xc2, 0x96, 0xce, 0x3d, 0x4b, 0xe2, 0x63, 0xb0, 0x12,
+	0xa7, 0xc6, 0xae, 0x44, 0x51, 0x3a, 0xa9, 0xb7, 0x29, 0x9d, 0xa8, 0x8a, 0xa7, 0xdf, 0x45, 0x15,
+	0xff, 0x02, 0x16, 0x8f, 0xdc, 0x5a, 0xa0, 0xb1, 0xd6, 0xb2, 0x29, 0xcb, 0xce, 0x6c, 0xa4, 0x26,
+	0x50, 0x9b, 0x3e, 0x72, 0x4b, 0xbe, 0xe2, 0x17, 0x36, 0x65, 0xe8, 0x06, 0x2c, 0x88, 0x80, 0x6a,
+	0xcc, 0x6e, 0x63, 0x7e, 0x57, 0x32, 0x5a, 0x5a, 0xec, 0x1d, 0xda, 0x6d, 0x8c, 0x6e, 0x42, 0x26,
+	0x84, 0x74, 0xf5, 0x56, 0x07, 0xf3, 0x7b, 0x90, 0xd2, 0x42, 0xb9, 0x9f, 0xf9, 0x7b, 0xe8, 0x19,
+	0x80, 0xd4, 0xd3, 0xe3, 0xb7, 0x20, 0xbd, 0xbb, 0xd5, 0x4f, 0x5b, 0x5f, 0x9b, 0xed, 0xee, 0x14,
+	0x0e, 0x3d, 0xdd, 0xa1, 0xba, 0xe1, 0xa7, 0xf0, 0xb9, 0x73, 0x44, 0xb4, 0xf9, 0xd0, 0x60, 0x0f,
+	0xed, 0x42, 0x9a, 0xb6, 0x74, 0xda, 0x10, 0xaa, 0xe6, 0x39, 0x85, 0x97, 0x5e, 0x9d, 0xe6, 0x32,
+	0xa5, 0xc3, 0xbd, 0xaa, 0x38, 0x39, 0xec, 0x69, 0x40, 0xe5, 0x67, 0x44, 0x60, 0xd5, 0x0c, 0x6a,
+	0x82, 0x78, 0x35, 0x29, 0x4d, 0x6d, 0x2b, 0x0b, 0x5c, 0xfc, 0xc3, 0x57, 0xa7, 0xb9, 0x87, 0xe3,
+	0x50, 0x55, 0xb5, 0x2d, 0x47, 0x67, 0x1d, 0x0f, 0x6b, 0x57, 0xa4, 0xe2, 0xd0, 0x76, 0xd5, 0xb6,
+	0xd0, 0x0f, 0x61, 0xb1, 0xe3, 0xd4, 0x89, 0x63, 0x4a, 0xe2, 0xd2, 0x9c, 0xb8, 0x8c, 0xdc, 0xe5,
+	0xd4, 0xdd, 0x80, 0x85, 0x3e, 0x58, 0x2f, 0xbb, 0xc0, 0xef, 0x66, 0x3a, 0x02, 0xf5, 0xd0, 0x1d,
+	0x58, 0x8a, 0x20, 0x01, 0xbf, 0x19, 0xce, 0x6f, 0x64, 0x20, 0x60, 0xf8, 0x00, 0x56, 0x22, 0x60,
+	0x3f, 0x43, 0x8b, 0x49, 0x0c, 0x5d, 0x96, 0xf8, 0x68, 0x13, 0x7d, 0xa1, 0xc0, 0x46, 0xc4, 0x55,
+	0x8c, 0x46, 0x9f, 0xb5, 0xa5, 0x49, 0x59, 0xbb, 0x2e, 0x4d, 0xbc, 0x1c, 0xf6, 0xc1, 0xa7, 0x0f,
+	0xc1, 0x74, 0x1b, 0xb7, 0x49, 0x76, 0x99, 0xdf, 0x59, 0xfe, 0x19, 0xfd, 0x18, 0x16, 0x3d, 0x7c,
+	0xac, 0x7b, 0x66, 0x4d, 0x37, 0x4d, 0x0f, 0x53, 0x9a, 0xbd, 0xc4, 0xfb, 0x4c, 0xf6, 0xdb, 0xaf,
+	0xb6, 0xaf, 0x88, 0x8b, 0xfb, 0x71, 0x70, 0x52, 0x65, 0x9e, 0xed, 0x58, 0x5a, 0x26, 0xc0, 0x8b,
+	0x4d, 0xf4, 0x00, 0x56, 0x8f, 0x6d, 0xd6, 0x30, 0x3d, 0xfd, 0x58, 0x6f, 0xf1, 0xfb, 0x12, 0x2a,
+	0x42, 0xdc, 0xcc, 0x95, 0xe8, 0xb4, 0xc4, 0x0c, 0x21, 0x35, 0xd8, 0x8b, 0x36, 0x60, 0x3d, 0xbe,
+	0xcf, 0xc8, 0x56, 0xf4, 0xbf, 0x29, 0x40, 0x65, 0x6a, 0x7d, 0x6c, 0x9a, 0x7b, 0xa4, 0x8b, 0x1d,
+	0xdd, 0x61, 0x55, 0xdb, 0xa2, 0x89, 0x6d, 0xe8, 0x29, 0x4c, 0x85, 0x2d, 0xf9, 0xad, 0xef, 0xeb,
+	0x94, 0xdb, 0xf4, 0x5f, 0xa3, 0xe8, 0x7a, 0xd5, 0x1a, 0x3a, 0x6d, 0x04, 0x8f, 0xb5, 0x96, 0x91,
+	0x17, 0xe7, 0x99, 0x4e, 0x1b, 0x68, 0x13, 0x96, 0xfb, 0x4a, 0xc3, 0xcf, 0x25, 0xcd, 0x4e, 0xfb,
+	0xdd, 0x42, 0x5b, 0x8c, 0xae, 0x0b, 0xf7, 0xd8, 0x80, 0xe5, 0xfe, 0xd2, 0xe4, 0x69, 0x9f, 0x99,
+	0x34, 0xed, 0x8b, 0x7d, 0x95, 0xed, 0xe7, 0xf9, 0x09, 0xa8, 0xd2, 0x9d, 0x61, 0x6b, 0x34, 0x3b,
+	0xcb, 0x1d, 0x5b, 0x0b, 0x11, 0x2f, 0x07, 0x64, 0x87, 0x32, 0x73, 0x0d, 0xd4, 0xef, 0xd3, 0x2e,
+	0xb3, 0xf2, 0xf9, 0xd4, 0xf0, 0x71, 0xb9, 0x53, 0xb5, 0xad, 0xdd, 0x9f, 0x12, 0xc7, 0xc0, 0xc9,
+	0xd9, 0x89, 0x61, 0x75, 0x2a, 0x8e, 0xd5, 0xe7, 0x30, 0xeb, 0x70, 0x4d, 0xe2, 0x3d, 0x78, 0x2f,
+	0xe1, 0x3d, 0x88, 0x33, 0x5e, 0x9a, 0xfe, 0xfa, 0x34, 0x77, 0x41, 0x13, 0x0a, 0xd0, 0x27, 0x90,
+	0xf2, 0x99, 0x9e, 0x9e, 0x94, 0x69, 0x5f, 0xcb, 0x20, 0x43, 0xb7, 0x20, 0x9f, 0x4c, 0x81, 0x64,
+	0xea, 0xaf, 0x0a, 0x2c, 0x97, 0xa9, 0x55, 0x3a, 0xdc, 0x7b, 0xe9, 0x88, 0x3b, 0x8a, 0x27, 0xe6,
+	0x27, 0xae, 0x96, 0x52, 0xef, 0xb8, 0x96, 0x06, 0x83, 0x55, 0x21, 0x3b, 0x1c, 0x85, 0x0c, 0xf1,
+	0xcf, 0x0a, 0x3f, 0xac, 0x62, 0x16, 0xdd, 0xdf, 0x4f, 0x5d, 0xec, 0xf9, 0xdd, 0x68, 0xe2, 0x50,
+	0x1f, 0xc0, 0x1c, 0x11, 0xba, 0xb2, 0xa9, 0x33, 0xfa, 0x93, 0x44, 0x0e, 0xfa, 0x9e, 0x87, 0x8d,
+	0x24, 0xf7, 0x64, 0x0c, 0xff, 0x50, 0xf8, 0xc4, 0x53, 0xc5, 0xec, 0xe7, 0x3a, 0x33, 0x1a, 0x8c,
+	0x1c, 0x63, 0xaf, 0xa4, 0x1b, 0xcd, 0x8e, 0x3b, 0x71, 0x04, 0x3f, 0x01, 0x74, 0x2c, 0x75, 0xca,
+	0x16, 0x79, 0x56, 0x2c, 0x97, 0x22, 0x19, 0x71, 0x80, 0xb6, 0x60, 0x19, 0x3b, 0x7c, 0x94, 0xc2,
+	0x66, 0xad, 0xce, 0x9d, 0x0b, 0xea, 0x5a, 0x5b, 0x92, 0xfb, 0x81, 0xcf, 0x71, 0x4d, 0x36, 0x26,
+	0x34, 0x19, 0xfd, 0x5f, 0x14, 0x58, 0x29, 0x53, 0x4b, 0xc3, 0x2e, 0xf1, 0x58, 0x35, 0xf0, 0xbe,
+	0xea, 0x62, 0xc7, 0x9c, 0x38, 0xf8, 0x7d, 0x98, 0xa3, 0xbe, 0x22, 0xff, 0xdd, 0x4c, 0x8d, 0x3b,
+	0xa4, 0x5c, 0xe4, 0xa2, 0x87, 0xbd, 0xc1, 0x70, 0x72, 0x70, 0x3d, 0xd6, 0x57, 0x19, 0xcd, 0xdf,
+	0xa6, 0xf8, 0x84, 0xfb, 0xd2, 0x35, 0x63, 0x86, 0xfe, 0x2a, 0xd3, 0x59, 0x27, 0xb9, 0x43, 0x69,
+	0x30, 0x2f, 0x67, 0xbf, 0x09, 0x9f, 0x91, 0x8b, 0x62, 0xec, 0x43, 0x15, 0x98, 0xa5, 0xdc, 0x2a,
+	0x67, 0x60, 0x71, 0xf7, 0x83, 0x84, 0x6e, 0x36, 0xec, 0x6a, 0x50, 0xa4, 0x36, 0x71, 0xf4, 0x56,
+	0xe0, 0xb5, 0x26, 0xf4, 0x88, 0x21, 0xd2, 0x63, 0xb5, 0x06, 0xb6, 0xad, 0x06, 0xe3, 0x55, 0x30,
+	0xcd, 0x87, 0x48, 0x8f, 0x3d, 0xe3, 0x5b, 0xe8, 0x3a, 0x80, 0x4f, 0xbb, 0x00, 0xcc, 0x70, 0xc0,
+	0x3c, 0x76, 0x4c, 0x71, 0xbc, 0x0a, 0xb3, 0x1e, 0xd6, 0x29, 0x71, 0xf8, 0x00, 0x3a, 0xaf, 0x89,
+	0xd5, 0x20, 0xd3, 0x5b, 0x70, 0xe7, 0x0c, 0x1e, 0x25, 0xe7, 0x7f, 0x52, 0xe0, 0x1a, 0x2f, 0xb2,
+	0x16, 0x36, 0x98, 0xdd, 0xc5, 0xe1, 0xf0, 0x71, 0xe0, 0x83, 0x1d, 0x63, 0xf2, 0x96, 0xb7, 0x0d,
+	0x97, 0x3d, 0x6c, 0x90, 0x2e, 0xf6, 0xb0, 0x59, 0x13, 0x29, 0xa2, 0xcd, 0xa0, 0xeb, 0x69, 0xcb,
+	0xf2, 0xe8, 0xa9, 0xcf, 0x79, 0xb5, 0x39, 0x18, 0xc7, 0x6d, 0xb8, 0x35, 0xca, 0x37, 0x19, 0xc4,
+	0xaf, 0x20, 0xcd, 0x71, 0xac, 0xa2, 0x77, 0x28, 0x46, 0xf7, 0x06, 0x5d, 0x1e, 0x71, 0x59, 0xc3,
+	0x60, 0x56, 0x61, 0xd6, 0xf5, 0x45, 0x4d, 0x1e, 0xc3, 0x9c, 0x26, 0x56, 0x7d, 0x6c, 0xa7, 0x92,
+	0xd9, 0x5e, 0x81, 0xcb, 0x7d, 0xd6, 0xa5, 0x53, 0xff, 0x55, 0x60, 0x49, 0x66, 0xa1, 0xc2, 0x7f,
+	0x65, 0x41, 0x8f, 0x60, 0x5e, 0xef, 0xb0, 0x06, 0xf1, 0x6c, 0x76, 0x72, 0xa6, 0x73, 0x11, 0x14,
+	0x3d, 0xf1, 0xfd, 0xf3, 0x35, 0x88, 0x2f, 0x68, 0xd7, 0x93, 0xbe, 0x67, 0x71, 0x50, 0xf8, 0x92,
+	0x06, 0x22, 0xe8, 0x3d, 0xb8, 0xe4, 0xdf, 0xcd, 0x2e, 0x2f, 0xc9, 0xb0, 0xb0, 0x52, 0xbc, 0xb0,
+	0x96, 0xa3, 0x03, 0x51, 0x5f, 0x5b, 0xd0, 0xb7, 0x57, 0xc3, 0x2e, 0x31, 0x1a, 0xa2, 0x4a, 0x97,
+	0xa2, 0xfd, 0x03, 0x7f, 0xfb, 0xf1, 0xa2, 0x4f, 0x42, 0xe4, 0x64, 0xfe, 0x2a, 0xac, 0x0d, 0xc5,
+	0x1b, 0x72, 0xb1, 0x7b, 0xba, 0x00, 0xa9, 0x32, 0xb5, 0xd0, 0x6f, 0x15, 0x58, 0x4d, 0xf8, 0xfd,
+	0xe6, 0x5e, 0x42, 0x48, 0x89, 0xbf, 0x02, 0xa8, 0x1f, 0x8c, 0x2b, 0x11, 0xba, 0x83, 0x7e, 0x0d,
+	0x57, 0x62, 0x7f, 0x33, 0x28, 0x24, 0x6b, 0x8c, 0xc3, 0xab, 0x8f, 0xc6, 0xc3, 0x4b, 0xfb, 0xbf,
+	0x84, 0xcb, 0x71, 0x5f, 0xd1, 0xb7, 0xcf, 0x0a, 0x68, 0x00, 0xae, 0x3e, 0x1c, 0x0b, 0x2e, 0x8d,
+	0x13, 0x58, 0x1a, 0x1e, 0xca, 0xb7, 0x92, 0x35, 0x0d, 0x41, 0xd5, 0x9d, 0x73, 0x43, 0xa5, 0xc1,
+	0xdf, 0x29, 0xb0, 0x96, 0x34, 0x70, 0x9e, 0x4f, 0x5d, 0xbf, 0x88, 0xfa, 0xe1, 0xd8, 0x22, 0xd2,
+	0x13, 0x1b, 0x32, 0x83, 0xf3, 0xdc, 0x9d, 0x64, 0x5d, 0x03, 0x40, 0xb5, 0x78, 0x4e, 0xa0, 0x34,
+	0xf5, 0xb9, 0x02, 0x2b, 0xf1, 0x83, 0xd5, 0x08, 0x55, 0xb1, 0x02, 0xea, 0x8f, 0xc6, 0x14, 0xe8,
+	0x2f, 0xb3, 0xb8, 0xb9, 0x68, 0x7b, 0xa4, 0xbe, 0x61, 0xb8, 0xfa, 0x70, 0x2c, 0xb8, 0x34, 0xde,
+	0x03, 0x14, 0x33, 0x96, 0xbc, 0x9f, 0xac, 0xec, 0xfb, 0x68, 0xf5, 0xc1, 0x38, 0x68, 0x69, 0xf9,
+	0x8f, 0x0a, 0x5c, 0x1b, 0x39, 0x43, 0x8c, 0xb8, 0xb6, 0xa3, 0xe4, 0xd4, 0x8f, 0xde, 0x4e, 0x4e,
+	0x3a, 0xf6, 0x7b, 0x05, 0xae, 0x26, 0x3f, 0xb4, 0xf7, 0x47, 0xf1, 0x9c, 0x20, 0xa4, 0x3e, 0x79,
+	0x0b, 0x21, 0xe9, 0xcf, 0x67, 0x30, 0x27, 0xdf, 0xcc, 0xfc, 0xc8, 0x2c, 0x73, 0x8c, 0x7a, 0xf7,
+	0x6c, 0x8c, 0xd4, 0x7d, 0x04, 0x0b, 0x03, 0x2f, 0xdf, 0xed, 0xb3, 0xb8, 0x0b, 0x70, 0x6a, 0xe1,
+	0x7c, 0xb8, 0xd0, 0x8e, 0x3a, 0xf3, 0x9b, 0xef, 0xbe, 0xbc, 0xab, 0x94, 0x5e, 0x7c, 0xfd, 0x7a,
+	0x5d, 0xf9, 0xe6, 0xf5, 0xba, 0xf2, 0xcf, 0xd7, 0xeb, 0xca, 0x1f, 0xde, 0xac, 0x5f, 0xf8, 0xe6,
+	0xcd, 0xfa, 0x85, 0xbf, 0xbf, 0x59, 0xbf, 0xf0, 0xd9, 0x99, 0x13, 0x60, 0xaf, 0xff, 0x7f, 0x07,
+	0x3e, 0x0e, 0xd6, 0x67, 0xf9, 0x1f, 0x0e, 0xf7, 0xff, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xfe, 0xc4,
+	0x90, 0xd5, 0xd8, 0x19, 0x00, 0x00,
 }
 
-func (m *MsgEditFinalityProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateFinalityProvider creates a new finality provider
+	CreateFinalityProvider(ctx context.Context, in *MsgCreateFinalityProvider, opts ...grpc.CallOption) (*MsgCreateFinalityProviderResponse, error)
+	// EditFinalityProvider edits an existing finality provider
+	EditFinalityProvider(ctx context.Context, in *MsgEditFinalityProvider, opts ...grpc.CallOption) (*MsgEditFinalityProviderResponse, error)
+	// CreateBTCDelegation creates a new BTC delegation
+	CreateBTCDelegation(ctx context.Context, in *MsgCreateBTCDelegation, opts ...grpc.CallOption) (*MsgCreateBTCDelegationResponse, error)
+	// AddCovenantSigs handles signatures from a covenant member
+	AddCovenantSigs(ctx context.Context, in *MsgAddCovenantSigs, opts ...grpc.CallOption) (*MsgAddCovenantSigsResponse, error)
+	// AddCovenantMuSig2Nonces handles the MuSig2 public nonces of a covenant
+	// member for co-signing a BTC delegation
+	AddCovenantMuSig2Nonces(ctx context.Context, in *MsgAddCovenantMuSig2Nonces, opts ...grpc.CallOption) (*MsgAddCovenantMuSig2NoncesResponse, error)
+	// BTCUndelegate handles a signature on unbonding tx from its delegator
+	BTCUndelegate(ctx context.Context, in *MsgBTCUndelegate, opts ...grpc.CallOption) (*MsgBTCUndelegateResponse, error)
+	// SetDelegationOperator authorizes an operator to undelegate a BTC delegation
+	// on behalf of the staker
+	SetDelegationOperator(ctx context.Context, in *MsgSetDelegationOperator, opts ...grpc.CallOption) (*MsgSetDelegationOperatorResponse, error)
+	// SetWatchtowerBackup deposits backup data of a BTC delegation for a
+	// watchtower designated by the staker
+	SetWatchtowerBackup(ctx context.Context, in *MsgSetWatchtowerBackup, opts ...grpc.CallOption) (*MsgSetWatchtowerBackupResponse, error)
+	// ReportStakingSpend reports that the staking output of a BTC delegation is
+	// spent outside the protocol
+	ReportStakingSpend(ctx context.Context, in *MsgReportStakingSpend, opts ...grpc.CallOption) (*MsgReportStakingSpendResponse, error)
+	// UpdateFinalityProviderStatus announces a planned downtime or key migration
+	// window of a finality provider
+	UpdateFinalityProviderStatus(ctx context.Context, in *MsgUpdateFinalityProviderStatus, opts ...grpc.CallOption) (*MsgUpdateFinalityProviderStatusResponse, error)
+	// SelectiveSlashingEvidence handles the evidence of selective slashing launched
+	// by a finality provider
+	SelectiveSlashingEvidence(ctx context.Context, in *MsgSelectiveSlashingEvidence, opts ...grpc.CallOption) (*MsgSelectiveSlashingEvidenceResponse, error)
+	// SetPause pauses or resumes the processing of new BTC delegations and
+	// early unbondings
+	SetPause(ctx context.Context, in *MsgSetPause, opts ...grpc.CallOption) (*MsgSetPauseResponse, error)
+	// UpdateParams updates the btcstaking module parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *MsgEditFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgEditFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Commission != nil {
-		{
-			size := m.Commission.Size()
-			i -= size
-			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Description != nil {
-		{
-			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BtcPk) > 0 {
-		i -= len(m.BtcPk)
-		copy(dAtA[i:], m.BtcPk)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BtcPk)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgEditFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateFinalityProvider(ctx context.Context, in *MsgCreateFinalityProvider, opts ...grpc.CallOption) (*MsgCreateFinalityProviderResponse, error) {
+	out := new(MsgCreateFinalityProviderResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/CreateFinalityProvider", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgEditFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) EditFinalityProvider(ctx context.Context, in *MsgEditFinalityProvider, opts ...grpc.CallOption) (*MsgEditFinalityProviderResponse, error) {
+	out := new(MsgEditFinalityProviderResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/EditFinalityProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgEditFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) CreateBTCDelegation(ctx context.Context, in *MsgCreateBTCDelegation, opts ...grpc.CallOption) (*MsgCreateBTCDelegationResponse, error) {
+	out := new(MsgCreateBTCDelegationResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/CreateBTCDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateBTCDelegation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddCovenantSigs(ctx context.Context, in *MsgAddCovenantSigs, opts ...grpc.CallOption) (*MsgAddCovenantSigsResponse, error) {
+	out := new(MsgAddCovenantSigsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/AddCovenantSigs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateBTCDelegation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddCovenantMuSig2Nonces(ctx context.Context, in *MsgAddCovenantMuSig2Nonces, opts ...grpc.CallOption) (*MsgAddCovenantMuSig2NoncesResponse, error) {
+	out := new(MsgAddCovenantMuSig2NoncesResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/AddCovenantMuSig2Nonces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateBTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DelegatorUnbondingSlashingSig != nil {
-		{
-			size := m.DelegatorUnbondingSlashingSig.Size()
-			i -= size
-			if _, err := m.DelegatorUnbondingSlashingSig.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x7a
-	}
-	if m.UnbondingSlashingTx != nil {
-		{
-			size := m.UnbondingSlashingTx.Size()
-			i -= size
-			if _, err := m.UnbondingSlashingTx.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
-	}
-	if m.UnbondingValue != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingValue))
-		i--
-		dAtA[i] = 0x68
-	}
-	if len(m.UnbondingTx) > 0 {
-		i -= len(m.UnbondingTx)
-		copy(dAtA[i:], m.UnbondingTx)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.UnbondingTx)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.UnbondingTime != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingTime))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.DelegatorSlashingSig != nil {
-		{
-			size := m.DelegatorSlashingSig.Size()
-			i -= size
-			if _, err := m.DelegatorSlashingSig.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.SlashingTx != nil {
-		{
-			size := m.SlashingTx.Size()
-			i -= size
-			if _, err := m.SlashingTx.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.StakingTx != nil {
-		{
-			size, err := m.StakingTx.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.StakingValue != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.StakingValue))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.StakingTime != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.StakingTime))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.FpBtcPkList) > 0 {
-		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.FpBtcPkList[iNdEx].Size()
-				i -= size
-				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if m.BtcPk != nil {
-		{
-			size := m.BtcPk.Size()
-			i -= size
-			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+func (c *msgClient) BTCUndelegate(ctx context.Context, in *MsgBTCUndelegate, opts ...grpc.CallOption) (*MsgBTCUndelegateResponse, error) {
+	out := new(MsgBTCUndelegateResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/BTCUndelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pop != nil {
-		{
-			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return out, nil
+}
+
+func (c *msgClient) SetDelegationOperator(ctx context.Context, in *MsgSetDelegationOperator, opts ...grpc.CallOption) (*MsgSetDelegationOperatorResponse, error) {
+	out := new(MsgSetDelegationOperatorResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/SetDelegationOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.BabylonPk != nil {
-		{
-			size, err := m.BabylonPk.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *msgClient) SetWatchtowerBackup(ctx context.Context, in *MsgSetWatchtowerBackup, opts ...grpc.CallOption) (*MsgSetWatchtowerBackupResponse, error) {
+	out := new(MsgSetWatchtowerBackupResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/SetWatchtowerBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) ReportStakingSpend(ctx context.Context, in *MsgReportStakingSpend, opts ...grpc.CallOption) (*MsgReportStakingSpendResponse, error) {
+	out := new(MsgReportStakingSpendResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/ReportStakingSpend", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCreateBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateFinalityProviderStatus(ctx context.Context, in *MsgUpdateFinalityProviderStatus, opts ...grpc.CallOption) (*MsgUpdateFinalityProviderStatusResponse, error) {
+	out := new(MsgUpdateFinalityProviderStatusResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/UpdateFinalityProviderStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateBTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SelectiveSlashingEvidence(ctx context.Context, in *MsgSelectiveSlashingEvidence, opts ...grpc.CallOption) (*MsgSelectiveSlashingEvidenceResponse, error) {
+	out := new(MsgSelectiveSlashingEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/SelectiveSlashingEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) SetPause(ctx context.Context, in *MsgSetPause, opts ...grpc.CallOption) (*MsgSetPauseResponse, error) {
+	out := new(MsgSetPauseResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/SetPause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAddCovenantSigs) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAddCovenantSigs) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateFinalityProvider creates a new finality provider
+	CreateFinalityProvider(context.Context, *MsgCreateFinalityProvider) (*MsgCreateFinalityProviderResponse, error)
+	// EditFinalityProvider edits an existing finality provider
+	EditFinalityProvider(context.Context, *MsgEditFinalityProvider) (*MsgEditFinalityProviderResponse, error)
+	// CreateBTCDelegation creates a new BTC delegation
+	CreateBTCDelegation(context.Context, *MsgCreateBTCDelegation) (*MsgCreateBTCDelegationResponse, error)
+	// AddCovenantSigs handles signatures from a covenant member
+	AddCovenantSigs(context.Context, *MsgAddCovenantSigs) (*MsgAddCovenantSigsResponse, error)
+	// AddCovenantMuSig2Nonces handles the MuSig2 public nonces of a covenant
+	// member for co-signing a BTC delegation
+	AddCovenantMuSig2Nonces(context.Context, *MsgAddCovenantMuSig2Nonces) (*MsgAddCovenantMuSig2NoncesResponse, error)
+	// BTCUndelegate handles a signature on unbonding tx from its delegator
+	BTCUndelegate(context.Context, *MsgBTCUndelegate) (*MsgBTCUndelegateResponse, error)
+	// SetDelegationOperator authorizes an operator to undelegate a BTC delegation
+	// on behalf of the staker
+	SetDelegationOperator(context.Context, *MsgSetDelegationOperator) (*MsgSetDelegationOperatorResponse, error)
+	// SetWatchtowerBackup deposits backup data of a BTC delegation for a
+	// watchtower designated by the staker
+	SetWatchtowerBackup(context.Context, *MsgSetWatchtowerBackup) (*MsgSetWatchtowerBackupResponse, error)
+	// ReportStakingSpend reports that the staking output of a BTC delegation is
+	// spent outside the protocol
+	ReportStakingSpend(context.Context, *MsgReportStakingSpend) (*MsgReportStakingSpendResponse, error)
+	// UpdateFinalityProviderStatus announces a planned downtime or key migration
+	// window of a finality provider
+	UpdateFinalityProviderStatus(context.Context, *MsgUpdateFinalityProviderStatus) (*MsgUpdateFinalityProviderStatusResponse, error)
+	// SelectiveSlashingEvidence handles the evidence of selective slashing launched
+	// by a finality provider
+	SelectiveSlashingEvidence(context.Context, *MsgSelectiveSlashingEvidence) (*MsgSelectiveSlashingEvidenceResponse, error)
+	// SetPause pauses or resumes the processing of new BTC delegations and
+	// early unbondings
+	SetPause(context.Context, *MsgSetPause) (*MsgSetPauseResponse, error)
+	// UpdateParams updates the btcstaking module parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *MsgAddCovenantSigs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SlashingUnbondingTxSigs) > 0 {
-		for iNdEx := len(m.SlashingUnbondingTxSigs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SlashingUnbondingTxSigs[iNdEx])
-			copy(dAtA[i:], m.SlashingUnbondingTxSigs[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.SlashingUnbondingTxSigs[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if m.UnbondingTxSig != nil {
-		{
-			size := m.UnbondingTxSig.Size()
-			i -= size
-			if _, err := m.UnbondingTxSig.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.SlashingTxSigs) > 0 {
-		for iNdEx := len(m.SlashingTxSigs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SlashingTxSigs[iNdEx])
-			copy(dAtA[i:], m.SlashingTxSigs[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.SlashingTxSigs[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateFinalityProvider(ctx context.Context, req *MsgCreateFinalityProvider) (*MsgCreateFinalityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFinalityProvider not implemented")
+}
+func (*UnimplementedMsgServer) EditFinalityProvider(ctx context.Context, req *MsgEditFinalityProvider) (*MsgEditFinalityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditFinalityProvider not implemented")
+}
+func (*UnimplementedMsgServer) CreateBTCDelegation(ctx context.Context, req *MsgCreateBTCDelegation) (*MsgCreateBTCDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBTCDelegation not implemented")
+}
+func (*UnimplementedMsgServer) AddCovenantSigs(ctx context.Context, req *MsgAddCovenantSigs) (*MsgAddCovenantSigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCovenantSigs not implemented")
+}
+func (*UnimplementedMsgServer) AddCovenantMuSig2Nonces(ctx context.Context, req *MsgAddCovenantMuSig2Nonces) (*MsgAddCovenantMuSig2NoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCovenantMuSig2Nonces not implemented")
+}
+func (*UnimplementedMsgServer) BTCUndelegate(ctx context.Context, req *MsgBTCUndelegate) (*MsgBTCUndelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCUndelegate not implemented")
+}
+func (*UnimplementedMsgServer) SetDelegationOperator(ctx context.Context, req *MsgSetDelegationOperator) (*MsgSetDelegationOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDelegationOperator not implemented")
+}
+func (*UnimplementedMsgServer) SetWatchtowerBackup(ctx context.Context, req *MsgSetWatchtowerBackup) (*MsgSetWatchtowerBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWatchtowerBackup not implemented")
+}
+func (*UnimplementedMsgServer) ReportStakingSpend(ctx context.Context, req *MsgReportStakingSpend) (*MsgReportStakingSpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportStakingSpend not implemented")
+}
+func (*UnimplementedMsgServer) UpdateFinalityProviderStatus(ctx context.Context, req *MsgUpdateFinalityProviderStatus) (*MsgUpdateFinalityProviderStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFinalityProviderStatus not implemented")
+}
+func (*UnimplementedMsgServer) SelectiveSlashingEvidence(ctx context.Context, req *MsgSelectiveSlashingEvidence) (*MsgSelectiveSlashingEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectiveSlashingEvidence not implemented")
+}
+func (*UnimplementedMsgServer) SetPause(ctx context.Context, req *MsgSetPause) (*MsgSetPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPause not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateFinalityProvider)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.StakingTxHash) > 0 {
-		i -= len(m.StakingTxHash)
-		copy(dAtA[i:], m.StakingTxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).CreateFinalityProvider(ctx, in)
 	}
-	if m.Pk != nil {
-		{
-			size := m.Pk.Size()
-			i -= size
-			if _, err := m.Pk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/CreateFinalityProvider",
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateFinalityProvider(ctx, req.(*MsgCreateFinalityProvider))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddCovenantSigsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_EditFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEditFinalityProvider)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddCovenantSigsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAddCovenantSigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).EditFinalityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/EditFinalityProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditFinalityProvider(ctx, req.(*MsgEditFinalityProvider))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBTCUndelegate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreateBTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateBTCDelegation)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CreateBTCDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/CreateBTCDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateBTCDelegation(ctx, req.(*MsgCreateBTCDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBTCUndelegate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_AddCovenantSigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddCovenantSigs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddCovenantSigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/AddCovenantSigs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddCovenantSigs(ctx, req.(*MsgAddCovenantSigs))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBTCUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UnbondingTxSig != nil {
-		{
-			size := m.UnbondingTxSig.Size()
-			i -= size
-			if _, err := m.UnbondingTxSig.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func _Msg_AddCovenantMuSig2Nonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddCovenantMuSig2Nonces)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.StakingTxHash) > 0 {
-		i -= len(m.StakingTxHash)
-		copy(dAtA[i:], m.StakingTxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).AddCovenantMuSig2Nonces(ctx, in)
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/AddCovenantMuSig2Nonces",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddCovenantMuSig2Nonces(ctx, req.(*MsgAddCovenantMuSig2Nonces))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBTCUndelegateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_BTCUndelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBTCUndelegate)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).BTCUndelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/BTCUndelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BTCUndelegate(ctx, req.(*MsgBTCUndelegate))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBTCUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SetDelegationOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDelegationOperator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDelegationOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/SetDelegationOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDelegationOperator(ctx, req.(*MsgSetDelegationOperator))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBTCUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_SetWatchtowerBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetWatchtowerBackup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetWatchtowerBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/SetWatchtowerBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetWatchtowerBackup(ctx, req.(*MsgSetWatchtowerBackup))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSelectiveSlashingEvidence) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ReportStakingSpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportStakingSpend)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).ReportStakingSpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/ReportStakingSpend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportStakingSpend(ctx, req.(*MsgReportStakingSpend))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSelectiveSlashingEvidence) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_UpdateFinalityProviderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFinalityProviderStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFinalityProviderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/UpdateFinalityProviderStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFinalityProviderStatus(ctx, req.(*MsgUpdateFinalityProviderStatus))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSelectiveSlashingEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func _Msg_SelectiveSlashingEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSelectiveSlashingEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SelectiveSlashingEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/SelectiveSlashingEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SelectiveSlashingEvidence(ctx, req.(*MsgSelectiveSlashingEvidence))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/SetPause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPause(ctx, req.(*MsgSetPause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.btcstaking.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFinalityProvider",
+			Handler:    _Msg_CreateFinalityProvider_Handler,
+		},
+		{
+			MethodName: "EditFinalityProvider",
+			Handler:    _Msg_EditFinalityProvider_Handler,
+		},
+		{
+			MethodName: "CreateBTCDelegation",
+			Handler:    _Msg_CreateBTCDelegation_Handler,
+		},
+		{
+			MethodName: "AddCovenantSigs",
+			Handler:    _Msg_AddCovenantSigs_Handler,
+		},
+		{
+			MethodName: "AddCovenantMuSig2Nonces",
+			Handler:    _Msg_AddCovenantMuSig2Nonces_Handler,
+		},
+		{
+			MethodName: "BTCUndelegate",
+			Handler:    _Msg_BTCUndelegate_Handler,
+		},
+		{
+			MethodName: "SetDelegationOperator",
+			Handler:    _Msg_SetDelegationOperator_Handler,
+		},
+		{
+			MethodName: "SetWatchtowerBackup",
+			Handler:    _Msg_SetWatchtowerBackup_Handler,
+		},
+		{
+			MethodName: "ReportStakingSpend",
+			Handler:    _Msg_ReportStakingSpend_Handler,
+		},
+		{
+			MethodName: "UpdateFinalityProviderStatus",
+			Handler:    _Msg_UpdateFinalityProviderStatus_Handler,
+		},
+		{
+			MethodName: "SelectiveSlashingEvidence",
+			Handler:    _Msg_SelectiveSlashingEvidence_Handler,
+		},
+		{
+			MethodName: "SetPause",
+			Handler:    _Msg_SetPause_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylon/btcstaking/v1/tx.proto",
+}
+
+func (m *MsgCreateFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RecoveredFpBtcSk) > 0 {
-		i -= len(m.RecoveredFpBtcSk)
-		copy(dAtA[i:], m.RecoveredFpBtcSk)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RecoveredFpBtcSk)))
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.MasterPubRand) > 0 {
+		i -= len(m.MasterPubRand)
+		copy(dAtA[i:], m.MasterPubRand)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MasterPubRand)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Pop != nil {
+		{
+			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BabylonPk != nil {
+		{
+			size, err := m.BabylonPk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Commission != nil {
+		{
+			size := m.Commission.Size()
+			i -= size
+			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.StakingTxHash) > 0 {
-		i -= len(m.StakingTxHash)
-		copy(dAtA[i:], m.StakingTxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+	if m.Description != nil {
+		{
+			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1889,7 +2399,7 @@ func (m *MsgSelectiveSlashingEvidence) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSelectiveSlashingEvidenceResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1899,12 +2409,12 @@ func (m *MsgSelectiveSlashingEvidenceResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgSelectiveSlashingEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSelectiveSlashingEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1912,7 +2422,7 @@ func (m *MsgSelectiveSlashingEvidenceResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgEditFinalityProvider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1922,37 +2432,58 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgEditFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgEditFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Commission != nil {
+		{
+			size := m.Commission.Size()
+			i -= size
+			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x22
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if m.Description != nil {
+		{
+			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BtcPk) > 0 {
+		i -= len(m.BtcPk)
+		copy(dAtA[i:], m.BtcPk)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BtcPk)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgEditFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1962,12 +2493,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgEditFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgEditFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1975,310 +2506,2778 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgCreateFinalityProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Description != nil {
-		l = m.Description.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Commission != nil {
-		l = m.Commission.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.BabylonPk != nil {
-		l = m.BabylonPk.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Pop != nil {
-		l = m.Pop.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MasterPubRand)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCreateBTCDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateFinalityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgCreateBTCDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgEditFinalityProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateBTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BtcPk)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Description != nil {
-		l = m.Description.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.WithdrawalBtcAddress) > 0 {
+		i -= len(m.WithdrawalBtcAddress)
+		copy(dAtA[i:], m.WithdrawalBtcAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawalBtcAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.Commission != nil {
-		l = m.Commission.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.RewardAddress) > 0 {
+		i -= len(m.RewardAddress)
+		copy(dAtA[i:], m.RewardAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	return n
-}
-
-func (m *MsgEditFinalityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgCreateBTCDelegation) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.DelegatorUnbondingSlashingSig != nil {
+		{
+			size := m.DelegatorUnbondingSlashingSig.Size()
+			i -= size
+			if _, err := m.DelegatorUnbondingSlashingSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.UnbondingSlashingTx != nil {
+		{
+			size := m.UnbondingSlashingTx.Size()
+			i -= size
+			if _, err := m.UnbondingSlashingTx.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
 	}
-	if m.BabylonPk != nil {
-		l = m.BabylonPk.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.UnbondingValue != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingValue))
+		i--
+		dAtA[i] = 0x68
 	}
-	if m.Pop != nil {
-		l = m.Pop.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.UnbondingTx) > 0 {
+		i -= len(m.UnbondingTx)
+		copy(dAtA[i:], m.UnbondingTx)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UnbondingTx)))
+		i--
+		dAtA[i] = 0x62
 	}
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.UnbondingTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingTime))
+		i--
+		dAtA[i] = 0x58
 	}
-	if len(m.FpBtcPkList) > 0 {
-		for _, e := range m.FpBtcPkList {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.DelegatorSlashingSig != nil {
+		{
+			size := m.DelegatorSlashingSig.Size()
+			i -= size
+			if _, err := m.DelegatorSlashingSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.StakingTime != 0 {
-		n += 1 + sovTx(uint64(m.StakingTime))
-	}
-	if m.StakingValue != 0 {
-		n += 1 + sovTx(uint64(m.StakingValue))
+	if m.SlashingTx != nil {
+		{
+			size := m.SlashingTx.Size()
+			i -= size
+			if _, err := m.SlashingTx.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
 	if m.StakingTx != nil {
-		l = m.StakingTx.Size()
-		n += 1 + l + sovTx(uint64(l))
+		{
+			size, err := m.StakingTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.SlashingTx != nil {
-		l = m.SlashingTx.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.StakingValue != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StakingValue))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.DelegatorSlashingSig != nil {
-		l = m.DelegatorSlashingSig.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.StakingTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StakingTime))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.UnbondingTime != 0 {
-		n += 1 + sovTx(uint64(m.UnbondingTime))
+	if len(m.FpBtcPkList) > 0 {
+		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.FpBtcPkList[iNdEx].Size()
+				i -= size
+				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.UnbondingTx)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.UnbondingValue != 0 {
-		n += 1 + sovTx(uint64(m.UnbondingValue))
+	if m.Pop != nil {
+		{
+			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.UnbondingSlashingTx != nil {
-		l = m.UnbondingSlashingTx.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.BabylonPk != nil {
+		{
+			size, err := m.BabylonPk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.DelegatorUnbondingSlashingSig != nil {
-		l = m.DelegatorUnbondingSlashingSig.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateBTCDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateBTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddCovenantSigs) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddCovenantSigs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddCovenantSigs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddCovenantSigs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Pk != nil {
-		l = m.Pk.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.SlashingUnbondingTxSigs) > 0 {
+		for iNdEx := len(m.SlashingUnbondingTxSigs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashingUnbondingTxSigs[iNdEx])
+			copy(dAtA[i:], m.SlashingUnbondingTxSigs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.SlashingUnbondingTxSigs[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = len(m.StakingTxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.UnbondingTxSig != nil {
+		{
+			size := m.UnbondingTxSig.Size()
+			i -= size
+			if _, err := m.UnbondingTxSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
 	if len(m.SlashingTxSigs) > 0 {
-		for _, b := range m.SlashingTxSigs {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.SlashingTxSigs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashingTxSigs[iNdEx])
+			copy(dAtA[i:], m.SlashingTxSigs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.SlashingTxSigs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	if m.UnbondingTxSig != nil {
-		l = m.UnbondingTxSig.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.SlashingUnbondingTxSigs) > 0 {
-		for _, b := range m.SlashingUnbondingTxSigs {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
+	if m.Pk != nil {
+		{
+			size := m.Pk.Size()
+			i -= size
+			if _, err := m.Pk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddCovenantSigsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddCovenantSigsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgBTCUndelegate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.StakingTxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.UnbondingTxSig != nil {
-		l = m.UnbondingTxSig.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgAddCovenantSigsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBTCUndelegateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddCovenantSigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSelectiveSlashingEvidence) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddCovenantMuSig2Nonces) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddCovenantMuSig2Nonces) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddCovenantMuSig2Nonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Sig != nil {
+		{
+			size := m.Sig.Size()
+			i -= size
+			if _, err := m.Sig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.StakingTxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Nonces.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.RecoveredFpBtcSk)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSelectiveSlashingEvidenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddCovenantMuSig2NoncesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddCovenantMuSig2NoncesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddCovenantMuSig2NoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBTCUndelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBTCUndelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBTCUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.UnbondingTxSig != nil {
+		{
+			size := m.UnbondingTxSig.Size()
+			i -= size
+			if _, err := m.UnbondingTxSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBTCUndelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBTCUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBTCUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDelegationOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDelegationOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDelegationOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDelegationOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDelegationOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDelegationOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetWatchtowerBackup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetWatchtowerBackup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetWatchtowerBackup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncryptedBackup) > 0 {
+		i -= len(m.EncryptedBackup)
+		copy(dAtA[i:], m.EncryptedBackup)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EncryptedBackup)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.WatchtowerAddress) > 0 {
+		i -= len(m.WatchtowerAddress)
+		copy(dAtA[i:], m.WatchtowerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WatchtowerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetWatchtowerBackupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetWatchtowerBackupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetWatchtowerBackupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportStakingSpend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportStakingSpend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportStakingSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpendTx != nil {
+		{
+			size, err := m.SpendTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportStakingSpendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportStakingSpendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportStakingSpendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFinalityProviderStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFinalityProviderStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFinalityProviderStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFinalityProviderStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFinalityProviderStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFinalityProviderStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSelectiveSlashingEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSelectiveSlashingEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSelectiveSlashingEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecoveredFpBtcSk) > 0 {
+		i -= len(m.RecoveredFpBtcSk)
+		copy(dAtA[i:], m.RecoveredFpBtcSk)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecoveredFpBtcSk)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSelectiveSlashingEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSelectiveSlashingEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSelectiveSlashingEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Description != nil {
+		l = m.Description.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Commission != nil {
+		l = m.Commission.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BabylonPk != nil {
+		l = m.BabylonPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pop != nil {
+		l = m.Pop.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MasterPubRand)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateFinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEditFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BtcPk)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Description != nil {
+		l = m.Description.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Commission != nil {
+		l = m.Commission.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEditFinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateBTCDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BabylonPk != nil {
+		l = m.BabylonPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pop != nil {
+		l = m.Pop.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for _, e := range m.FpBtcPkList {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.StakingTime != 0 {
+		n += 1 + sovTx(uint64(m.StakingTime))
+	}
+	if m.StakingValue != 0 {
+		n += 1 + sovTx(uint64(m.StakingValue))
+	}
+	if m.StakingTx != nil {
+		l = m.StakingTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SlashingTx != nil {
+		l = m.SlashingTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DelegatorSlashingSig != nil {
+		l = m.DelegatorSlashingSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingTime != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingTime))
+	}
+	l = len(m.UnbondingTx)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingValue != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingValue))
+	}
+	if m.UnbondingSlashingTx != nil {
+		l = m.UnbondingSlashingTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DelegatorUnbondingSlashingSig != nil {
+		l = m.DelegatorUnbondingSlashingSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	l = len(m.RewardAddress)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawalBtcAddress)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateBTCDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddCovenantSigs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pk != nil {
+		l = m.Pk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SlashingTxSigs) > 0 {
+		for _, b := range m.SlashingTxSigs {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.UnbondingTxSig != nil {
+		l = m.UnbondingTxSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SlashingUnbondingTxSigs) > 0 {
+		for _, b := range m.SlashingUnbondingTxSigs {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddCovenantSigsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddCovenantMuSig2Nonces) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Nonces.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Sig != nil {
+		l = m.Sig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddCovenantMuSig2NoncesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBTCUndelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingTxSig != nil {
+		l = m.UnbondingTxSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBTCUndelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDelegationOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetDelegationOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetWatchtowerBackup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WatchtowerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EncryptedBackup)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetWatchtowerBackupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReportStakingSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SpendTx != nil {
+		l = m.SpendTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReportStakingSpendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateFinalityProviderStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovTx(uint64(m.EndHeight))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateFinalityProviderStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSelectiveSlashingEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecoveredFpBtcSk)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSelectiveSlashingEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetPauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	if m.ActivationEpoch != 0 {
+		n += 1 + sovTx(uint64(m.ActivationEpoch))
+	}
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Description == nil {
+				m.Description = &types.Description{}
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Commission = &v
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonPk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BabylonPk == nil {
+				m.BabylonPk = &secp256k1.PubKey{}
+			}
+			if err := m.BabylonPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pop == nil {
+				m.Pop = &ProofOfPossession{}
+			}
+			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterPubRand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MasterPubRand = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcPk = append(m.BtcPk[:0], dAtA[iNdEx:postIndex]...)
+			if m.BtcPk == nil {
+				m.BtcPk = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Description == nil {
+				m.Description = &types.Description{}
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Commission = &v
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditFinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBTCDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBTCDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonPk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BabylonPk == nil {
+				m.BabylonPk = &secp256k1.PubKey{}
+			}
+			if err := m.BabylonPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pop == nil {
+				m.Pop = &ProofOfPossession{}
+			}
+			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPkList = append(m.FpBtcPkList, v)
+			if err := m.FpBtcPkList[len(m.FpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTime", wireType)
+			}
+			m.StakingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingValue", wireType)
+			}
+			m.StakingValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StakingTx == nil {
+				m.StakingTx = &types1.TransactionInfo{}
+			}
+			if err := m.StakingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v BTCSlashingTx
+			m.SlashingTx = &v
+			if err := m.SlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.DelegatorSlashingSig = &v
+			if err := m.DelegatorSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			m.UnbondingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingTx = append(m.UnbondingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.UnbondingTx == nil {
+				m.UnbondingTx = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingValue", wireType)
+			}
+			m.UnbondingValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v BTCSlashingTx
+			m.UnbondingSlashingTx = &v
+			if err := m.UnbondingSlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSlashingSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.DelegatorUnbondingSlashingSig = &v
+			if err := m.DelegatorUnbondingSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalBtcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalBtcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBTCDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBTCDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2301,10 +5300,10 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFinalityProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddCovenantSigs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddCovenantSigs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2341,9 +5340,9 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2353,31 +5352,30 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Description == nil {
-				m.Description = &types.Description{}
-			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.Pk = &v
+			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2405,17 +5403,212 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.Commission = &v
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxSigs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingTxSigs = append(m.SlashingTxSigs, make([]byte, postIndex-iNdEx))
+			copy(m.SlashingTxSigs[len(m.SlashingTxSigs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.UnbondingTxSig = &v
+			if err := m.UnbondingTxSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingUnbondingTxSigs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingUnbondingTxSigs = append(m.SlashingUnbondingTxSigs, make([]byte, postIndex-iNdEx))
+			copy(m.SlashingUnbondingTxSigs[len(m.SlashingUnbondingTxSigs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddCovenantSigsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddCovenantSigsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddCovenantSigsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddCovenantMuSig2Nonces) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddCovenantMuSig2Nonces: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddCovenantMuSig2Nonces: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BabylonPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2425,33 +5618,29 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BabylonPk == nil {
-				m.BabylonPk = &secp256k1.PubKey{}
-			}
-			if err := m.BabylonPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2461,30 +5650,27 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.BtcPk = &v
-			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2511,18 +5697,15 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pop == nil {
-				m.Pop = &ProofOfPossession{}
-			}
-			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Nonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterPubRand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2532,23 +5715,26 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterPubRand = string(dAtA[iNdEx:postIndex])
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.Sig = &v
+			if err := m.Sig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2571,7 +5757,7 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddCovenantMuSig2NoncesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2594,10 +5780,10 @@ func (m *MsgCreateFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFinalityProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddCovenantMuSig2NoncesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddCovenantMuSig2NoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2621,7 +5807,7 @@ func (m *MsgCreateFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2644,10 +5830,10 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditFinalityProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBTCUndelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBTCUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2684,9 +5870,9 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2696,67 +5882,29 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BtcPk = append(m.BtcPk[:0], dAtA[iNdEx:postIndex]...)
-			if m.BtcPk == nil {
-				m.BtcPk = []byte{}
-			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Description == nil {
-				m.Description = &types.Description{}
-			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxSig", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2766,25 +5914,24 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.Commission = &v
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.UnbondingTxSig = &v
+			if err := m.UnbondingTxSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2809,7 +5956,7 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBTCUndelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2832,10 +5979,10 @@ func (m *MsgEditFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditFinalityProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBTCUndelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBTCUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2859,7 +6006,7 @@ func (m *MsgEditFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDelegationOperator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2882,10 +6029,10 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBTCDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDelegationOperator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBTCDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDelegationOperator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2922,9 +6069,9 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BabylonPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2934,33 +6081,29 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BabylonPk == nil {
-				m.BabylonPk = &secp256k1.PubKey{}
-			}
-			if err := m.BabylonPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2970,33 +6113,129 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDelegationOperatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDelegationOperatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDelegationOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pop == nil {
-				m.Pop = &ProofOfPossession{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetWatchtowerBackup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetWatchtowerBackup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetWatchtowerBackup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3006,32 +6245,29 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.BtcPk = &v
-			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3041,70 +6277,29 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.FpBtcPkList = append(m.FpBtcPkList, v)
-			if err := m.FpBtcPkList[len(m.FpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTime", wireType)
-			}
-			m.StakingTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StakingTime |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingValue", wireType)
-			}
-			m.StakingValue = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StakingValue |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchtowerAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3114,31 +6309,27 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StakingTx == nil {
-				m.StakingTx = &types1.TransactionInfo{}
-			}
-			if err := m.StakingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WatchtowerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedBackup", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3165,71 +6356,116 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v BTCSlashingTx
-			m.SlashingTx = &v
-			if err := m.SlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.EncryptedBackup = append(m.EncryptedBackup[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedBackup == nil {
+				m.EncryptedBackup = []byte{}
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSig", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetWatchtowerBackupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340Signature
-			m.DelegatorSlashingSig = &v
-			if err := m.DelegatorSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetWatchtowerBackupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetWatchtowerBackupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.UnbondingTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingTime |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReportStakingSpend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 12:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReportStakingSpend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReportStakingSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3239,50 +6475,29 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbondingTx = append(m.UnbondingTx[:0], dAtA[iNdEx:postIndex]...)
-			if m.UnbondingTx == nil {
-				m.UnbondingTx = []byte{}
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingValue", wireType)
-			}
-			m.UnbondingValue = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingValue |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3292,32 +6507,29 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v BTCSlashingTx
-			m.UnbondingSlashingTx = &v
-			if err := m.UnbondingSlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSlashingSig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendTx", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3327,24 +6539,25 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340Signature
-			m.DelegatorUnbondingSlashingSig = &v
-			if err := m.DelegatorUnbondingSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SpendTx == nil {
+				m.SpendTx = &types1.TransactionInfo{}
+			}
+			if err := m.SpendTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3369,7 +6582,7 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBTCDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReportStakingSpendResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3392,10 +6605,10 @@ func (m *MsgCreateBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBTCDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportStakingSpendResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportStakingSpendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3419,7 +6632,7 @@ func (m *MsgCreateBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateFinalityProviderStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3442,10 +6655,10 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddCovenantSigs: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateFinalityProviderStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddCovenantSigs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateFinalityProviderStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3482,7 +6695,7 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3510,16 +6723,16 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.Pk = &v
-			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3529,29 +6742,16 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= FinalityProviderOperationalStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxSigs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			var byteLen int
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3561,29 +6761,16 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.StartHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SlashingTxSigs = append(m.SlashingTxSigs, make([]byte, postIndex-iNdEx))
-			copy(m.SlashingTxSigs[len(m.SlashingTxSigs)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxSig", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
-			var byteLen int
+			m.EndHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3593,32 +6780,16 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.EndHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonchain_babylon_types.BIP340Signature
-			m.UnbondingTxSig = &v
-			if err := m.UnbondingTxSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingUnbondingTxSigs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3628,23 +6799,23 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashingUnbondingTxSigs = append(m.SlashingUnbondingTxSigs, make([]byte, postIndex-iNdEx))
-			copy(m.SlashingUnbondingTxSigs[len(m.SlashingUnbondingTxSigs)-1], dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3667,7 +6838,7 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddCovenantSigsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateFinalityProviderStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3690,10 +6861,10 @@ func (m *MsgAddCovenantSigsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddCovenantSigsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateFinalityProviderStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddCovenantSigsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateFinalityProviderStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3717,7 +6888,7 @@ func (m *MsgAddCovenantSigsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3740,10 +6911,10 @@ func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBTCUndelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSelectiveSlashingEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBTCUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSelectiveSlashingEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3812,7 +6983,7 @@ func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxSig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveredFpBtcSk", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3839,10 +7010,9 @@ func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340Signature
-			m.UnbondingTxSig = &v
-			if err := m.UnbondingTxSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RecoveredFpBtcSk = append(m.RecoveredFpBtcSk[:0], dAtA[iNdEx:postIndex]...)
+			if m.RecoveredFpBtcSk == nil {
+				m.RecoveredFpBtcSk = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -3866,7 +7036,7 @@ func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBTCUndelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSelectiveSlashingEvidenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3889,10 +7059,10 @@ func (m *MsgBTCUndelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBTCUndelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSelectiveSlashingEvidenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBTCUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSelectiveSlashingEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3916,7 +7086,7 @@ func (m *MsgBTCUndelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPause) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3939,10 +7109,10 @@ func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSelectiveSlashingEvidence: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPause: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSelectiveSlashingEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPause: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3978,10 +7148,10 @@ func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3991,29 +7161,17 @@ func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Paused = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecoveredFpBtcSk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4023,25 +7181,23 @@ func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecoveredFpBtcSk = append(m.RecoveredFpBtcSk[:0], dAtA[iNdEx:postIndex]...)
-			if m.RecoveredFpBtcSk == nil {
-				m.RecoveredFpBtcSk = []byte{}
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4064,7 +7220,7 @@ func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSelectiveSlashingEvidenceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPauseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4087,10 +7243,10 @@ func (m *MsgSelectiveSlashingEvidenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSelectiveSlashingEvidenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPauseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSelectiveSlashingEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4208,6 +7364,44 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationEpoch", wireType)
+			}
+			m.ActivationEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
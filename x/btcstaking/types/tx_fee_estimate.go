@@ -0,0 +1,123 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	bbn "github.com/babylonchain/babylon/types"
+)
+
+// NewTxFeeEstimate estimates the virtual size and the minimum fee at the given
+// fee rate (in satoshis per virtual byte) of the given pre-signed tx, which
+// spends an output of the given value via the script path of the given spend
+// info
+func NewTxFeeEstimate(tx *wire.MsgTx, inputValue int64, si *btcstaking.SpendInfo, feeRate uint64) (*TxFeeEstimate, error) {
+	vsize, err := btcstaking.EstimateScriptPathSpendVirtualSize(tx, si)
+	if err != nil {
+		return nil, err
+	}
+	if feeRate > uint64(btcutil.MaxSatoshi/vsize) {
+		return nil, fmt.Errorf("fee rate %d is too high", feeRate)
+	}
+	minFee := vsize * int64(feeRate)
+
+	fee := inputValue
+	for _, out := range tx.TxOut {
+		fee -= out.Value
+	}
+
+	return &TxFeeEstimate{
+		VirtualSize: vsize,
+		MinFee:      minFee,
+		Fee:         fee,
+		Sufficient:  fee >= minFee,
+	}, nil
+}
+
+// CheckTxFeeRate checks that the fee of the given pre-signed tx, which spends
+// an output of the given value via the script path of the given spend info,
+// is no less than its estimated virtual size times the minimum fee rate
+func (p *Params) CheckTxFeeRate(tx *wire.MsgTx, inputValue int64, si *btcstaking.SpendInfo) error {
+	if p.MinTxFeeRateSatPerVbyte == 0 {
+		return nil
+	}
+	feeEstimate, err := NewTxFeeEstimate(tx, inputValue, si, p.MinTxFeeRateSatPerVbyte)
+	if err != nil {
+		return err
+	}
+	if !feeEstimate.Sufficient {
+		return ErrInsufficientTxFee.Wrapf(
+			"fee %d sat is lower than %d sat for %d vbytes at %d sat/vbyte",
+			feeEstimate.Fee, feeEstimate.MinFee, feeEstimate.VirtualSize, p.MinTxFeeRateSatPerVbyte,
+		)
+	}
+	return nil
+}
+
+// EstimateTxFees estimates the fees of the slashing tx, the unbonding tx and
+// the unbonding slashing tx of the BTC delegation at the given fee rate (in
+// satoshis per virtual byte), from the scripts of its staking and unbonding
+// outputs
+func (d *BTCDelegation) EstimateTxFees(bsParams *Params, btcNet *chaincfg.Params, feeRate uint64) (*QueryBTCDelegationTxFeesResponse, error) {
+	if d.BtcUndelegation == nil {
+		return nil, fmt.Errorf("BTC delegation has no unbonding information")
+	}
+
+	// slashing tx and unbonding tx spending the staking output
+	stakingInfo, err := d.GetStakingInfo(bsParams, btcNet)
+	if err != nil {
+		return nil, err
+	}
+	slashingPathInfo, err := stakingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+	unbondingPathInfo, err := stakingInfo.UnbondingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+	slashingTx, err := d.SlashingTx.ToMsgTx()
+	if err != nil {
+		return nil, fmt.Errorf("invalid slashing tx: %w", err)
+	}
+	slashingTxFee, err := NewTxFeeEstimate(slashingTx, stakingInfo.StakingOutput.Value, slashingPathInfo, feeRate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate the fee of the slashing tx: %w", err)
+	}
+	unbondingTx, err := bbn.NewBTCTxFromBytes(d.BtcUndelegation.UnbondingTx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid unbonding tx: %w", err)
+	}
+	unbondingTxFee, err := NewTxFeeEstimate(unbondingTx, stakingInfo.StakingOutput.Value, unbondingPathInfo, feeRate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate the fee of the unbonding tx: %w", err)
+	}
+
+	// unbonding slashing tx spending the unbonding output
+	unbondingInfo, err := d.GetUnbondingInfo(bsParams, btcNet)
+	if err != nil {
+		return nil, err
+	}
+	unbondingSlashingPathInfo, err := unbondingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+	unbondingSlashingTx, err := d.BtcUndelegation.SlashingTx.ToMsgTx()
+	if err != nil {
+		return nil, fmt.Errorf("invalid unbonding slashing tx: %w", err)
+	}
+	unbondingSlashingTxFee, err := NewTxFeeEstimate(unbondingSlashingTx, unbondingInfo.UnbondingOutput.Value, unbondingSlashingPathInfo, feeRate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate the fee of the unbonding slashing tx: %w", err)
+	}
+
+	return &QueryBTCDelegationTxFeesResponse{
+		SlashingTx:          slashingTxFee,
+		UnbondingTx:         unbondingTxFee,
+		UnbondingSlashingTx: unbondingSlashingTxFee,
+	}, nil
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxWatchtowerBackupSize is the maximum size of the backup data that a
+// staker can deposit for its watchtower, which is enough for a few pre-signed
+// BTC txs along with the encryption overhead
+const MaxWatchtowerBackupSize = 4096
+
+// Validate validates the watchtower backup. The backup data is public
+// ciphertext, so only its size is checked
+func (b *WatchtowerBackup) Validate() error {
+	if _, err := chainhash.NewHashFromStr(b.StakingTxHash); err != nil {
+		return fmt.Errorf("invalid staking tx hash of watchtower backup: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(b.WatchtowerAddress); err != nil {
+		return fmt.Errorf("invalid watchtower address: %w", err)
+	}
+	if len(b.EncryptedBackup) == 0 {
+		return fmt.Errorf("empty backup data")
+	}
+	if len(b.EncryptedBackup) > MaxWatchtowerBackupSize {
+		return fmt.Errorf("backup data is larger than %d bytes", MaxWatchtowerBackupSize)
+	}
+	return nil
+}
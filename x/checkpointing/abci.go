@@ -26,3 +26,12 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 	}
 	return nil
 }
+
+// EndBlocker is called at the end of every block.
+// Upon each EndBlock, the BLS sigs received after checkpoints are sealed are
+// aggregated into the checkpoints
+func EndBlocker(ctx context.Context, k keeper.Keeper) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+	k.AggregateLateBlsSigs(ctx)
+	return nil
+}
@@ -25,9 +25,11 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
+	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdRawCheckpoint())
 	cmd.AddCommand(CmdRawCheckpointList())
 	cmd.AddCommand(CmdRawCheckpoints())
+	cmd.AddCommand(CmdBlsSigRewardCredits())
 
 	return cmd
 }
@@ -132,3 +134,34 @@ func CmdRawCheckpoints() *cobra.Command {
 
 	return cmd
 }
+
+// CmdBlsSigRewardCredits defines the cobra command to query the reward credits
+// of the BLS sigs in the checkpoint of a given epoch
+func CmdBlsSigRewardCredits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bls-sig-reward-credits [epoch_number]",
+		Short: "retrieve the reward credits of the validators that signed the checkpoint of an epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epochNum, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BlsSigRewardCredits(cmd.Context(), &types.QueryBlsSigRewardCreditsRequest{EpochNum: epochNum})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
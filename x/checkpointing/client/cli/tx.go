@@ -1,9 +1,11 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"cosmossdk.io/core/address"
@@ -32,6 +34,7 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdWrappedCreateValidator(authcodec.NewBech32Codec(appparams.Bech32PrefixValAddr)))
+	cmd.AddCommand(CmdAddBlsSig())
 
 	return cmd
 }
@@ -83,3 +86,50 @@ before running the command (e.g., via babylond create-bls-key).`)
 
 	return cmd
 }
+
+func CmdAddBlsSig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-bls-sig [epoch_num] [block_hash]",
+		Short: "submit a BLS sig on the sealed checkpoint of a recent epoch",
+		Long: strings.TrimSpace(`add-bls-sig signs the checkpoint of the given epoch with the BLS key of the
+validator and submits it. The BLS sig is aggregated into the checkpoint if the
+checkpoint is sealed and not yet submitted to BTC, and the epoch is at most
+late_bls_sig_epoch_window epochs older than the current one, where
+late_bls_sig_epoch_window is a parameter of the module. The BLS key should exist in
+priv_validator_key.json and the transaction has to be signed by the validator operator.`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epochNum, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			blockHashBytes, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+			blockHash := types.BlockHash(blockHashBytes)
+
+			home, _ := cmd.Flags().GetString(flags.FlagHome)
+			msg, err := buildAddBlsSigMsg(home, clientCtx.GetFromAddress(), epochNum, blockHash)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	userHomeDir, err := os.UserHomeDir()
+	if err != nil {
+		panic(err)
+	}
+	cmd.Flags().String(flags.FlagHome, filepath.Join(userHomeDir, ".babylond"), "The node home directory")
+
+	return cmd
+}
@@ -203,13 +203,47 @@ func buildCommissionRates(rateStr, maxRateStr, maxChangeRateStr string) (commiss
 }
 
 func getValKeyFromFile(homeDir string) (*privval.ValidatorKeys, error) {
+	wrappedPV, err := loadWrappedFilePV(homeDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return privval.NewValidatorKeys(wrappedPV.GetValPrivKey(), wrappedPV.GetBlsPrivKey())
+}
+
+func loadWrappedFilePV(homeDir string) (*privval.WrappedFilePV, error) {
 	nodeCfg := cmtconfig.DefaultConfig()
 	keyPath := filepath.Join(homeDir, nodeCfg.PrivValidatorKeyFile())
 	statePath := filepath.Join(homeDir, nodeCfg.PrivValidatorStateFile())
 	if !cmtos.FileExists(keyPath) {
 		return nil, errors.New("validator key file does not exist")
 	}
-	wrappedPV := privval.LoadWrappedFilePV(keyPath, statePath)
+	return privval.LoadWrappedFilePV(keyPath, statePath), nil
+}
 
-	return privval.NewValidatorKeys(wrappedPV.GetValPrivKey(), wrappedPV.GetBlsPrivKey())
+// buildAddBlsSigMsg builds a MsgAddBlsSig signing the given checkpoint with
+// the BLS key of the validator under the given home directory
+func buildAddBlsSigMsg(homeDir string, signer sdk.AccAddress, epochNum uint64, blockHash types.BlockHash) (*types.MsgAddBlsSig, error) {
+	wrappedPV, err := loadWrappedFilePV(homeDir)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := wrappedPV.SignMsgWithBls(types.GetSignBytes(epochNum, blockHash))
+	if err != nil {
+		return nil, err
+	}
+	valAddr := sdk.ValAddress(signer)
+	msg := &types.MsgAddBlsSig{
+		Signer: signer.String(),
+		BlsSig: &types.BlsSig{
+			EpochNum:      epochNum,
+			BlockHash:     &blockHash,
+			BlsSig:        &sig,
+			SignerAddress: valAddr.String(),
+		},
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return msg, nil
 }
@@ -11,6 +11,9 @@ import (
 // state.
 // TODO: importing/exporting genesis
 func InitGenesis(ctx context.Context, k keeper.Keeper, genState types.GenesisState) {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
 	k.SetGenBlsKeys(ctx, genState.GenesisKeys)
 	// set epoch 0 to be finalised at genesis
 	k.SetLastFinalizedEpoch(ctx, 0)
@@ -19,5 +22,6 @@ func InitGenesis(ctx context.Context, k keeper.Keeper, genState types.GenesisSta
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx context.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
 	return genesis
 }
@@ -39,6 +39,7 @@ func TestInitGenesis(t *testing.T) {
 	}
 	genesisState := types.GenesisState{
 		GenesisKeys: genKeys,
+		Params:      types.DefaultParams(),
 	}
 
 	checkpointing.InitGenesis(ctx, ckptKeeper, genesisState)
@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/x/evidence/exported"
+	evidencetypes "cosmossdk.io/x/evidence/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	txformat "github.com/babylonchain/babylon/btctxformatter"
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
+)
+
+// HandleConflictingCheckpoint handles a checkpoint included in BTC that
+// conflicts with the local checkpoint of the same epoch. It verifies the BLS
+// multi-sig of the conflicting checkpoint against the validator set of the
+// epoch, and submits evidence against each of its signers to the evidence
+// module, which slashes and tombstones them
+func (k Keeper) HandleConflictingCheckpoint(ctx context.Context, rawCheckpoint txformat.RawBtcCheckpoint) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if k.evidenceKeeper == nil {
+		return types.ErrEvidenceKeeperNotSet
+	}
+
+	ckpt, err := types.FromBTCCkptToRawCkpt(&rawCheckpoint)
+	if err != nil {
+		return fmt.Errorf("failed to decode raw checkpoint from BTC raw checkpoint: %w", err)
+	}
+	if err := ckpt.ValidateBasic(); err != nil {
+		return err
+	}
+	localCkpt, signers, err := k.verifyConflictingCheckpoint(ctx, ckpt)
+	if err != nil {
+		return err
+	}
+	epoch, err := k.epochingKeeper.GetHistoricalEpoch(ctx, ckpt.EpochNum)
+	if err != nil {
+		return err
+	}
+	height := int64(epoch.GetLastBlockHeight())
+
+	numReported := 0
+	for _, signer := range signers {
+		evidence := types.NewConflictingCheckpointEvidence(ckpt, signer.GetValAddress(), height)
+		err := k.evidenceKeeper.SubmitEvidence(ctx, evidence)
+		if errors.Is(err, evidencetypes.ErrEvidenceExists) {
+			// the signer is already reported via another conflicting
+			// checkpoint of this epoch
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		numReported++
+	}
+	if numReported == 0 {
+		return types.ErrConflictingCkptReported.Wrapf("epoch %d", ckpt.EpochNum)
+	}
+
+	k.Logger(sdkCtx).Error(types.ErrConflictingCheckpoint.Wrapf("epoch %v", ckpt.EpochNum).Error(), "reported signers", numReported)
+	if err := sdkCtx.EventManager().EmitTypedEvent(
+		&types.EventConflictingCheckpoint{
+			ConflictingCheckpoint: ckpt,
+			LocalCheckpoint:       localCkpt,
+		},
+	); err != nil {
+		panic(err)
+	}
+
+	return nil
+}
+
+// verifyConflictingCheckpoint verifies that the given checkpoint has a valid
+// BLS multi-sig over a block hash different from the one of the local
+// checkpoint of the same epoch, and returns the local checkpoint and the
+// signers of the conflicting checkpoint
+func (k Keeper) verifyConflictingCheckpoint(ctx context.Context, ckpt *types.RawCheckpoint) (*types.RawCheckpointWithMeta, epochingtypes.ValidatorSet, error) {
+	localCkpt, err := k.GetRawCheckpoint(ctx, ckpt.EpochNum)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch the raw checkpoint at epoch %d from database: %w", ckpt.EpochNum, err)
+	}
+	if localCkpt.Ckpt.BlockHash.Equal(*ckpt.BlockHash) {
+		return nil, nil, types.ErrNotConflictingCheckpoint.Wrapf("epoch %d", ckpt.EpochNum)
+	}
+	if err := k.VerifyRawCheckpoint(ctx, ckpt); err != nil {
+		return nil, nil, err
+	}
+	signers, err := k.GetValidatorSet(ctx, ckpt.EpochNum).FindSubset(ckpt.Bitmap)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get the signer set via bitmap of epoch %d: %w", ckpt.EpochNum, err)
+	}
+
+	return localCkpt, signers, nil
+}
+
+// NewConflictingCheckpointEvidenceHandler returns the handler of
+// ConflictingCheckpointEvidence for the router of the evidence module.
+// Since the evidence can also be submitted through the evidence module
+// directly, the handler verifies the conflicting checkpoint again. Similar to
+// double signing, the validator who signed the conflicting checkpoint is
+// slashed, jailed forever and tombstoned
+func NewConflictingCheckpointEvidenceHandler(k *Keeper, sk types.StakingKeeper, slk types.SlashingKeeper) evidencetypes.Handler {
+	return func(ctx context.Context, e exported.Evidence) error {
+		evidence, ok := e.(*types.ConflictingCheckpointEvidence)
+		if !ok {
+			return fmt.Errorf("unexpected evidence type %T", e)
+		}
+		logger := k.Logger(sdk.UnwrapSDKContext(ctx))
+		ckpt := evidence.ConflictingCheckpoint
+		valAddr := evidence.MustGetValidatorAddress()
+
+		// ensure the validator has signed a conflicting checkpoint at the
+		// last block of the epoch
+		_, signers, err := k.verifyConflictingCheckpoint(ctx, ckpt)
+		if err != nil {
+			return err
+		}
+		signer, _, err := signers.FindValidatorWithIndex(valAddr)
+		if err != nil {
+			return fmt.Errorf("validator %s did not sign the conflicting checkpoint of epoch %d", evidence.ValidatorAddress, ckpt.EpochNum)
+		}
+		epoch, err := k.epochingKeeper.GetHistoricalEpoch(ctx, ckpt.EpochNum)
+		if err != nil {
+			return err
+		}
+		if evidence.Height != int64(epoch.GetLastBlockHeight()) {
+			return fmt.Errorf("height %d is not the last block height %d of epoch %d", evidence.Height, epoch.GetLastBlockHeight(), ckpt.EpochNum)
+		}
+
+		validator, err := sk.GetValidator(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+		if validator.IsUnbonded() {
+			logger.Info("ignored conflicting checkpoint; validator already unbonded", "validator", evidence.ValidatorAddress, "epoch", ckpt.EpochNum)
+			return nil
+		}
+		consAddrBytes, err := validator.GetConsAddr()
+		if err != nil {
+			return err
+		}
+		consAddr := sdk.ConsAddress(consAddrBytes)
+		if slk.IsTombstoned(ctx, consAddr) {
+			logger.Info("ignored conflicting checkpoint; validator already tombstoned", "validator", evidence.ValidatorAddress, "epoch", ckpt.EpochNum)
+			return nil
+		}
+
+		logger.Info("confirmed conflicting checkpoint", "validator", evidence.ValidatorAddress, "epoch", ckpt.EpochNum)
+
+		// slash the stake that was bonded to the validator at the end of the
+		// epoch, including unbonding delegations and redelegations started
+		// afterwards
+		slashFraction, err := slk.SlashFractionDoubleSign(ctx)
+		if err != nil {
+			return err
+		}
+		if err := slk.SlashWithInfractionReason(
+			ctx,
+			consAddr,
+			slashFraction,
+			signer.Power,
+			evidence.Height,
+			stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
+		); err != nil {
+			return err
+		}
+		if !validator.IsJailed() {
+			if err := slk.Jail(ctx, consAddr); err != nil {
+				return err
+			}
+		}
+		if err := slk.JailUntil(ctx, consAddr, evidencetypes.DoubleSignJailEndTime); err != nil {
+			return err
+		}
+		return slk.Tombstone(ctx, consAddr)
+	}
+}
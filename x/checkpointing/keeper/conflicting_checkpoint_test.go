@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/evidence/exported"
+	evidencetypes "cosmossdk.io/x/evidence/types"
+	"github.com/boljen/go-bitmap"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/btctxformatter"
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/testutil/mocks"
+	"github.com/babylonchain/babylon/x/checkpointing/keeper"
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
+)
+
+// FuzzHandleConflictingCheckpoint checks the following scenarios
+// 1. given a checkpoint identical to the local one, should return ErrNotConflictingCheckpoint
+// 2. given a conflicting checkpoint with an invalid sig, should return ErrInvalidRawCheckpoint
+// 3. given a conflicting checkpoint, should slash, jail and tombstone all its signers
+// 4. given the same conflicting checkpoint again, should return ErrConflictingCkptReported
+// 5. given evidence with a wrong height, the evidence handler should reject it
+func FuzzHandleConflictingCheckpoint(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		epochNum := r.Uint64()%1000 + 1
+		epoch := &epochingtypes.Epoch{
+			EpochNumber:          epochNum,
+			CurrentEpochInterval: 10,
+			FirstBlockHeight:     (epochNum-1)*10 + 1,
+		}
+		sortedValSet := epochingtypes.NewSortedValidatorSet(valSet)
+		blsPrivKeys := map[string]bls12381.PrivateKey{addr1.String(): blsPrivKey1, addr2.String(): blsPrivKey2}
+		consPubKeys := map[string]cryptotypes.PubKey{addr1.String(): pk1, addr2.String(): pk2}
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ek := mocks.NewMockEpochingKeeper(ctrl)
+		ek.EXPECT().GetValidatorSet(gomock.Any(), epochNum).Return(sortedValSet).AnyTimes()
+		ek.EXPECT().GetTotalVotingPower(gomock.Any(), epochNum).Return(int64(20)).AnyTimes()
+		ek.EXPECT().GetHistoricalEpoch(gomock.Any(), epochNum).Return(epoch, nil).AnyTimes()
+		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, ek, nil)
+		for i, val := range valSet {
+			err := ckptKeeper.CreateRegistration(ctx, pubkeys[i], val.Addr)
+			require.NoError(t, err)
+		}
+
+		// staking and slashing keepers expecting both validators to be
+		// punished exactly once
+		sk := mocks.NewMockStakingKeeper(ctrl)
+		sk.EXPECT().GetValidator(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, valAddr sdk.ValAddress) (stakingtypes.Validator, error) {
+				validator, err := stakingtypes.NewValidator(valAddr.String(), consPubKeys[valAddr.String()], stakingtypes.Description{})
+				validator.Status = stakingtypes.Bonded
+				return validator, err
+			},
+		).AnyTimes()
+		slashFraction := sdkmath.LegacyNewDecWithPrec(5, 2)
+		slk := mocks.NewMockSlashingKeeper(ctrl)
+		slk.EXPECT().IsTombstoned(gomock.Any(), gomock.Any()).Return(false).Times(2)
+		slk.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(slashFraction, nil).Times(2)
+		for _, val := range valSet {
+			consAddr := sdk.ConsAddress(consPubKeys[val.GetValAddressStr()].Address())
+			slk.EXPECT().SlashWithInfractionReason(
+				gomock.Any(), consAddr, slashFraction, val.Power, int64(epoch.GetLastBlockHeight()),
+				stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
+			).Return(nil).Times(1)
+			slk.EXPECT().Jail(gomock.Any(), consAddr).Return(nil).Times(1)
+			slk.EXPECT().JailUntil(gomock.Any(), consAddr, evidencetypes.DoubleSignJailEndTime).Return(nil).Times(1)
+			slk.EXPECT().Tombstone(gomock.Any(), consAddr).Return(nil).Times(1)
+		}
+
+		// evidence keeper routing evidence to the handler
+		handler := keeper.NewConflictingCheckpointEvidenceHandler(ckptKeeper, sk, slk)
+		submitted := map[string]bool{}
+		evk := mocks.NewMockEvidenceKeeper(ctrl)
+		evk.EXPECT().SubmitEvidence(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, evidence exported.Evidence) error {
+				if submitted[string(evidence.Hash())] {
+					return evidencetypes.ErrEvidenceExists
+				}
+				if err := handler(ctx, evidence); err != nil {
+					return err
+				}
+				submitted[string(evidence.Hash())] = true
+				return nil
+			},
+		).AnyTimes()
+		ckptKeeper.SetEvidenceKeeper(evk)
+
+		// add local checkpoint
+		localCkptWithMeta := datagen.GenRandomRawCheckpointWithMeta(r)
+		localCkptWithMeta.Ckpt.EpochNum = epochNum
+		localCkptWithMeta.Status = types.Sealed
+		err := ckptKeeper.AddRawCheckpoint(ctx, localCkptWithMeta)
+		require.NoError(t, err)
+
+		// 1. checkpoint identical to the local one
+		rawBtcCheckpoint := makeSignedBtcCkpt(r, t, epochNum, *localCkptWithMeta.Ckpt.BlockHash, sortedValSet, blsPrivKeys)
+		err = ckptKeeper.HandleConflictingCheckpoint(ctx, *rawBtcCheckpoint)
+		require.ErrorIs(t, err, types.ErrNotConflictingCheckpoint)
+
+		// 2. conflicting checkpoint with an invalid sig
+		conflictBlockHash := datagen.GenRandomBlockHash(r)
+		rawBtcCheckpoint = makeSignedBtcCkpt(r, t, epochNum, conflictBlockHash, sortedValSet, blsPrivKeys)
+		rawBtcCheckpoint.BlsSig = datagen.GenRandomByteArray(r, btctxformatter.BlsSigLength)
+		err = ckptKeeper.HandleConflictingCheckpoint(ctx, *rawBtcCheckpoint)
+		require.ErrorIs(t, err, types.ErrInvalidRawCheckpoint)
+
+		// 3. conflicting checkpoint signed by both validators
+		rawBtcCheckpoint = makeSignedBtcCkpt(r, t, epochNum, conflictBlockHash, sortedValSet, blsPrivKeys)
+		err = ckptKeeper.HandleConflictingCheckpoint(ctx, *rawBtcCheckpoint)
+		require.NoError(t, err)
+		require.Len(t, submitted, 2)
+
+		// 4. the same conflicting checkpoint again
+		err = ckptKeeper.HandleConflictingCheckpoint(ctx, *rawBtcCheckpoint)
+		require.ErrorIs(t, err, types.ErrConflictingCkptReported)
+
+		// 5. evidence with a wrong height
+		ckpt, err := types.FromBTCCkptToRawCkpt(rawBtcCheckpoint)
+		require.NoError(t, err)
+		evidence := types.NewConflictingCheckpointEvidence(ckpt, addr1, int64(epoch.GetLastBlockHeight())+1)
+		require.NoError(t, evidence.ValidateBasic())
+		err = handler(ctx, evidence)
+		require.Error(t, err)
+	})
+}
+
+// makeSignedBtcCkpt makes a BTC checkpoint over the given block hash signed by
+// all validators in the given validator set
+func makeSignedBtcCkpt(r *rand.Rand, t *testing.T, epochNum uint64, blockHash types.BlockHash, vals epochingtypes.ValidatorSet, blsPrivKeys map[string]bls12381.PrivateKey) *btctxformatter.RawBtcCheckpoint {
+	msgBytes := types.GetSignBytes(epochNum, blockHash)
+	bm := bitmap.New(types.BitmapBits)
+	sigs := make([]bls12381.Signature, len(vals))
+	for i, val := range vals {
+		bm.Set(i, true)
+		sigs[i] = bls12381.Sign(blsPrivKeys[val.GetValAddressStr()], msgBytes)
+	}
+	multiSig, err := bls12381.AggrSigList(sigs)
+	require.NoError(t, err)
+
+	return makeBtcCkptBytes(r, epochNum, blockHash.MustMarshal(), bm, multiSig.Bytes(), t)
+}
@@ -14,6 +14,16 @@ import (
 
 var _ types.QueryServer = Keeper{}
 
+// Params returns the parameters of the checkpointing module
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
 // RawCheckpointList returns a list of checkpoint by status in the ascending order of epoch
 func (k Keeper) RawCheckpointList(c context.Context, req *types.QueryRawCheckpointListRequest) (*types.QueryRawCheckpointListResponse, error) {
 	if req == nil {
@@ -158,6 +168,21 @@ func (k Keeper) LastCheckpointWithStatus(ctx context.Context, req *types.QueryLa
 	return nil, fmt.Errorf("cannot find checkpoint with status %v", req.Status)
 }
 
+// BlsSigRewardCredits returns the reward credits of the validators that
+// contributed their BLS sigs to the checkpoint of a given epoch
+func (k Keeper) BlsSigRewardCredits(ctx context.Context, req *types.QueryBlsSigRewardCreditsRequest) (*types.QueryBlsSigRewardCreditsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	credits, err := k.GetBlsSigRewardCredits(ctx, req.EpochNum)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBlsSigRewardCreditsResponse{Credits: credits}, nil
+}
+
 // GetLastCheckpointedEpoch returns the last epoch number that associates with a checkpoint
 func (k Keeper) GetLastCheckpointedEpoch(ctx context.Context) (uint64, error) {
 	curEpoch := k.GetEpoch(ctx).EpochNumber
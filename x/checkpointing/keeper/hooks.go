@@ -40,6 +40,14 @@ func (k Keeper) AfterRawCheckpointFinalized(ctx context.Context, epoch uint64) e
 	return nil
 }
 
+// AfterRawCheckpointUnfinalized - call hook if the checkpoint is no longer finalized
+func (k Keeper) AfterRawCheckpointUnfinalized(ctx context.Context, epoch uint64) error {
+	if k.hooks != nil {
+		return k.hooks.AfterRawCheckpointUnfinalized(ctx, epoch)
+	}
+	return nil
+}
+
 // AfterRawCheckpointBlsSigVerified - call hook if the checkpoint's BLS sig is verified
 func (k Keeper) AfterRawCheckpointBlsSigVerified(ctx context.Context, ckpt *types.RawCheckpoint) error {
 	if k.hooks != nil {
@@ -17,16 +17,22 @@ import (
 
 	"github.com/babylonchain/babylon/crypto/bls12381"
 	"github.com/babylonchain/babylon/x/checkpointing/types"
+	"github.com/babylonchain/babylon/x/checkpointing/verifier"
 	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
 )
 
 type (
 	Keeper struct {
-		cdc            codec.BinaryCodec
-		storeService   corestoretypes.KVStoreService
-		blsSigner      BlsSigner
-		epochingKeeper types.EpochingKeeper
-		hooks          types.CheckpointingHooks
+		cdc             codec.BinaryCodec
+		storeService    corestoretypes.KVStoreService
+		blsSigner       BlsSigner
+		epochingKeeper  types.EpochingKeeper
+		evidenceKeeper  types.EvidenceKeeper
+		incentiveKeeper types.IncentiveKeeper
+		hooks           types.CheckpointingHooks
+		// the address capable of executing a MsgUpdateParams message. Typically, this
+		// should be the x/gov module account.
+		authority string
 	}
 )
 
@@ -35,6 +41,7 @@ func NewKeeper(
 	storeService corestoretypes.KVStoreService,
 	signer BlsSigner,
 	ek types.EpochingKeeper,
+	authority string,
 ) Keeper {
 	return Keeper{
 		cdc:            cdc,
@@ -42,6 +49,7 @@ func NewKeeper(
 		blsSigner:      signer,
 		epochingKeeper: ek,
 		hooks:          nil,
+		authority:      authority,
 	}
 }
 
@@ -164,19 +172,8 @@ func (k Keeper) VerifyRawCheckpoint(ctx context.Context, ckpt *types.RawCheckpoi
 		}
 		sum += v.Power
 	}
-	if sum*3 <= totalPower*2 {
-		return types.ErrInvalidRawCheckpoint.Wrap("insufficient voting power")
-	}
-	msgBytes := types.GetSignBytes(ckpt.GetEpochNum(), *ckpt.BlockHash)
-	ok, err := bls12381.VerifyMultiSig(*ckpt.BlsMultiSig, signersPubKeys, msgBytes)
-	if err != nil {
-		return err
-	}
-	if !ok {
-		return types.ErrInvalidRawCheckpoint.Wrap("invalid BLS multi-sig")
-	}
 
-	return nil
+	return verifier.VerifyMultiSig(ckpt, signersPubKeys, uint64(sum), uint64(totalPower))
 }
 
 // VerifyCheckpoint verifies checkpoint from BTC. It verifies
@@ -263,6 +260,18 @@ func (k *Keeper) SetEpochingKeeper(ek types.EpochingKeeper) {
 	k.epochingKeeper = ek
 }
 
+// SetEvidenceKeeper sets the evidence keeper, through which the validators
+// who signed conflicting checkpoints are punished
+func (k *Keeper) SetEvidenceKeeper(ek types.EvidenceKeeper) {
+	k.evidenceKeeper = ek
+}
+
+// SetIncentiveKeeper sets the incentive keeper, which rewards the validators
+// who contributed their BLS sigs to finalized checkpoints
+func (k *Keeper) SetIncentiveKeeper(ik types.IncentiveKeeper) {
+	k.incentiveKeeper = ik
+}
+
 // SetCheckpointSubmitted sets the status of a checkpoint to SUBMITTED,
 // and records the associated state update in lifecycle
 func (k Keeper) SetCheckpointSubmitted(ctx context.Context, epoch uint64) {
@@ -308,6 +317,8 @@ func (k Keeper) SetCheckpointFinalized(ctx context.Context, epoch uint64) {
 	if err != nil {
 		k.Logger(sdkCtx).Error("failed to emit checkpoint finalized event for epoch %v: %v", ckpt.Ckpt.EpochNum, err)
 	}
+	// reward the validators who contributed their BLS sigs to the checkpoint
+	k.rewardBlsSigners(ctx, epoch)
 	// invoke hook, which is currently subscribed by ZoneConcierge
 	if err := k.AfterRawCheckpointFinalized(ctx, epoch); err != nil {
 		k.Logger(sdkCtx).Error("failed to trigger checkpoint finalized hook for epoch %v: %v", ckpt.Ckpt.EpochNum, err)
@@ -318,7 +329,12 @@ func (k Keeper) SetCheckpointFinalized(ctx context.Context, epoch uint64) {
 // and records the associated state update in lifecycle
 func (k Keeper) SetCheckpointForgotten(ctx context.Context, epoch uint64) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	ckpt := k.setCheckpointStatus(ctx, epoch, types.Submitted, types.Sealed)
+	from := types.Submitted
+	if ckptWithMeta, err := k.GetRawCheckpoint(ctx, epoch); err == nil && ckptWithMeta.Status == types.Confirmed {
+		// a confirmed checkpoint loses all its submissions upon a BTC reorg
+		from = types.Confirmed
+	}
+	ckpt := k.setCheckpointStatus(ctx, epoch, from, types.Sealed)
 	err := sdkCtx.EventManager().EmitTypedEvent(
 		&types.EventCheckpointForgotten{Checkpoint: ckpt},
 	)
@@ -327,6 +343,43 @@ func (k Keeper) SetCheckpointForgotten(ctx context.Context, epoch uint64) {
 	}
 }
 
+// SetCheckpointUnfinalized rolls back the status of a checkpoint from
+// FINALIZED to CONFIRMED upon a BTC reorg, and records the associated state
+// update in lifecycle. The last finalised epoch rolls back accordingly.
+func (k Keeper) SetCheckpointUnfinalized(ctx context.Context, epoch uint64) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	ckpt := k.setCheckpointStatus(ctx, epoch, types.Finalized, types.Confirmed)
+	// checkpoints are finalised in the order of epochs, so the parent epoch
+	// becomes the last finalised one
+	if k.GetLastFinalizedEpoch(ctx) >= epoch {
+		k.SetLastFinalizedEpoch(ctx, epoch-1)
+	}
+	err := sdkCtx.EventManager().EmitTypedEvent(
+		&types.EventCheckpointUnfinalized{Checkpoint: ckpt},
+	)
+	if err != nil {
+		k.Logger(sdkCtx).Error("failed to emit checkpoint unfinalized event for epoch %v: %v", epoch, err)
+	}
+	// invoke hook, which is currently subscribed by ZoneConcierge
+	if err := k.AfterRawCheckpointUnfinalized(ctx, epoch); err != nil {
+		k.Logger(sdkCtx).Error("failed to trigger checkpoint unfinalized hook for epoch %v: %v", epoch, err)
+	}
+}
+
+// SetCheckpointUnconfirmed rolls back the status of a checkpoint from
+// CONFIRMED to SUBMITTED upon a BTC reorg, and records the associated state
+// update in lifecycle
+func (k Keeper) SetCheckpointUnconfirmed(ctx context.Context, epoch uint64) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	ckpt := k.setCheckpointStatus(ctx, epoch, types.Confirmed, types.Submitted)
+	err := sdkCtx.EventManager().EmitTypedEvent(
+		&types.EventCheckpointUnconfirmed{Checkpoint: ckpt},
+	)
+	if err != nil {
+		k.Logger(sdkCtx).Error("failed to emit checkpoint unconfirmed event for epoch %v: %v", epoch, err)
+	}
+}
+
 // setCheckpointStatus sets a ckptWithMeta to the given state,
 // and records the state update in its lifecycle
 func (k Keeper) setCheckpointStatus(ctx context.Context, epoch uint64, from types.CheckpointStatus, to types.CheckpointStatus) *types.RawCheckpointWithMeta {
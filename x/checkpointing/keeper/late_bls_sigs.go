@@ -0,0 +1,231 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/boljen/go-bitmap"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+// AddLateBlsSig verifies a BLS sig on the sealed checkpoint of a recent epoch
+// and buffers it. Buffered BLS sigs are aggregated into the checkpoints upon
+// EndBlock via AggregateLateBlsSigs.
+func (k Keeper) AddLateBlsSig(ctx context.Context, sig *types.BlsSig) error {
+	// the checkpoint of the current epoch is not sealed yet, and the
+	// checkpoints of old epochs are likely to be submitted already
+	curEpoch := k.GetEpoch(ctx).EpochNumber
+	if sig.EpochNum >= curEpoch {
+		return types.ErrLateBlsSigNotAccepted.Wrapf("the checkpoint of epoch %d is not sealed yet", sig.EpochNum)
+	}
+	window := k.GetParams(ctx).LateBlsSigEpochWindow
+	if sig.EpochNum+window < curEpoch {
+		return types.ErrLateBlsSigNotAccepted.Wrapf(
+			"epoch %d is older than the last %d epochs", sig.EpochNum, window)
+	}
+
+	ckptWithMeta, err := k.GetRawCheckpoint(ctx, sig.EpochNum)
+	if err != nil {
+		return err
+	}
+	if ckptWithMeta.Status != types.Sealed {
+		return types.ErrLateBlsSigNotAccepted.Wrapf(
+			"the checkpoint of epoch %d is %s rather than sealed", sig.EpochNum, ckptWithMeta.Status)
+	}
+	if !ckptWithMeta.Ckpt.BlockHash.Equal(*sig.BlockHash) {
+		return types.ErrInvalidBlsSignature.Wrapf("the BLS sig is not on the checkpoint of epoch %d", sig.EpochNum)
+	}
+
+	// the signer has to be in the validator set of the epoch, and should not
+	// have contributed to the checkpoint
+	valAddr, err := sdk.ValAddressFromBech32(sig.SignerAddress)
+	if err != nil {
+		return err
+	}
+	_, index, err := k.GetValidatorSet(ctx, sig.EpochNum).FindValidatorWithIndex(valAddr)
+	if err != nil {
+		return types.ErrLateBlsSigNotAccepted.Wrapf("the signer is not a validator of epoch %d: %v", sig.EpochNum, err)
+	}
+	if bitmap.Get(ckptWithMeta.Ckpt.Bitmap, index) {
+		return types.ErrCkptAlreadyVoted
+	}
+	store := k.lateBlsSigStore(ctx)
+	key := types.LateBlsSigKey(sig.EpochNum, valAddr)
+	if store.Has(key) {
+		return types.ErrCkptAlreadyVoted.Wrap("the BLS sig is already received")
+	}
+
+	if err := k.VerifyBLSSig(ctx, sig); err != nil {
+		return err
+	}
+
+	store.Set(key, k.cdc.MustMarshal(sig))
+
+	return nil
+}
+
+// AggregateLateBlsSigs aggregates the buffered late BLS sigs into the
+// checkpoints of their epochs and clears the buffer. BLS sigs on checkpoints
+// that are no longer sealed are dropped.
+func (k Keeper) AggregateLateBlsSigs(ctx context.Context) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := k.lateBlsSigStore(ctx)
+
+	// collect buffered BLS sigs, which are ordered by epoch
+	var (
+		keys [][]byte
+		sigs []*types.BlsSig
+	)
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		var sig types.BlsSig
+		k.cdc.MustUnmarshal(iter.Value(), &sig)
+		keys = append(keys, bytes.Clone(iter.Key()))
+		sigs = append(sigs, &sig)
+	}
+	iter.Close()
+
+	for i := 0; i < len(sigs); {
+		epoch := sigs[i].EpochNum
+		j := i
+		for j < len(sigs) && sigs[j].EpochNum == epoch {
+			j++
+		}
+		k.aggregateLateBlsSigsOfEpoch(sdkCtx, epoch, sigs[i:j])
+		i = j
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) aggregateLateBlsSigsOfEpoch(ctx sdk.Context, epoch uint64, sigs []*types.BlsSig) {
+	ckptWithMeta, err := k.GetRawCheckpoint(ctx, epoch)
+	if err != nil {
+		panic(fmt.Errorf("failed to get the checkpoint of epoch %d with late BLS sigs: %w", epoch, err))
+	}
+	if ckptWithMeta.Status != types.Sealed {
+		k.Logger(ctx).Info("drop late BLS sigs on a checkpoint that is no longer sealed", "epoch", epoch)
+		return
+	}
+
+	vals := k.GetValidatorSet(ctx, epoch)
+	epochsLate := k.GetEpoch(ctx).EpochNumber - epoch
+	signers := make([]string, 0, len(sigs))
+	credits := make([]*types.BlsSigRewardCredit, 0, len(sigs))
+	for _, sig := range sigs {
+		// the signer address is verified upon buffering the BLS sig
+		valAddr, err := sdk.ValAddressFromBech32(sig.SignerAddress)
+		if err != nil {
+			panic(fmt.Errorf("invalid signer address of a verified late BLS sig: %w", err))
+		}
+		blsKey, err := k.GetBlsPubKey(ctx, valAddr)
+		if err != nil {
+			panic(fmt.Errorf("failed to get the BLS key of a verified late BLS sig: %w", err))
+		}
+		if err := ckptWithMeta.AddLateSig(vals, valAddr, blsKey, *sig.BlsSig); err != nil {
+			k.Logger(ctx).Error("skip invalid late BLS sig", "epoch", epoch, "signer", sig.SignerAddress, "err", err)
+			continue
+		}
+		signers = append(signers, sig.SignerAddress)
+
+		credit := &types.BlsSigRewardCredit{
+			ValidatorAddress: sig.SignerAddress,
+			EpochsLate:       epochsLate,
+			Credit:           types.BlsSigRewardCreditOf(epochsLate),
+		}
+		k.lateBlsSigCreditStore(ctx).Set(types.LateBlsSigKey(epoch, valAddr), k.cdc.MustMarshal(credit))
+		credits = append(credits, credit)
+	}
+	if len(signers) == 0 {
+		return
+	}
+
+	if err := k.UpdateCheckpoint(ctx, ckptWithMeta); err != nil {
+		panic(fmt.Errorf("failed to update the checkpoint of epoch %d: %w", epoch, err))
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(
+		&types.EventLateBlsSigsAggregated{
+			EpochNum:        epoch,
+			SignerAddresses: signers,
+			PowerSum:        ckptWithMeta.PowerSum,
+			Credits:         credits,
+		},
+	); err != nil {
+		k.Logger(ctx).Error("failed to emit event for aggregating late BLS sigs", "epoch", epoch, "err", err)
+	}
+}
+
+// GetBlsSigRewardCredits returns the reward credits of the validators that
+// contributed their BLS sigs to the checkpoint of the given epoch, in the
+// order of the epoch's validator set
+func (k Keeper) GetBlsSigRewardCredits(ctx context.Context, epoch uint64) ([]*types.BlsSigRewardCredit, error) {
+	ckptWithMeta, err := k.GetRawCheckpoint(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	creditStore := k.lateBlsSigCreditStore(ctx)
+	credits := []*types.BlsSigRewardCredit{}
+	for i, val := range k.GetValidatorSet(ctx, epoch) {
+		if !bitmap.Get(ckptWithMeta.Ckpt.Bitmap, i) {
+			continue
+		}
+		valAddr := val.GetValAddress()
+		if bz := creditStore.Get(types.LateBlsSigKey(epoch, valAddr)); bz != nil {
+			var credit types.BlsSigRewardCredit
+			k.cdc.MustUnmarshal(bz, &credit)
+			credits = append(credits, &credit)
+			continue
+		}
+		credits = append(credits, &types.BlsSigRewardCredit{
+			ValidatorAddress: valAddr.String(),
+			EpochsLate:       0,
+			Credit:           types.BlsSigRewardCreditOf(0),
+		})
+	}
+	return credits, nil
+}
+
+// rewardBlsSigners hands the reward credits of the validators that
+// contributed their BLS sigs to the finalized checkpoint of the given epoch to
+// the incentive module, if set
+func (k Keeper) rewardBlsSigners(ctx context.Context, epoch uint64) {
+	if k.incentiveKeeper == nil {
+		return
+	}
+	credits, err := k.GetBlsSigRewardCredits(ctx, epoch)
+	if err != nil {
+		// like the status update upon finalization, a missing checkpoint is
+		// tolerated, in which case there is no one to reward
+		k.Logger(sdk.UnwrapSDKContext(ctx)).Error("failed to get the BLS sig reward credits", "epoch", epoch, "error", err)
+		return
+	}
+	k.incentiveKeeper.RewardBLSSigners(ctx, epoch, credits)
+}
+
+// lateBlsSigStore returns the KVStore of the late BLS sigs
+// prefix: LateBlsSigPrefix
+// key: (epoch number, validator address)
+// value: BlsSig
+func (k Keeper) lateBlsSigStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.LateBlsSigPrefix)
+}
+
+// lateBlsSigCreditStore returns the KVStore of the reward credits of the
+// aggregated late BLS sigs
+// prefix: LateBlsSigCreditPrefix
+// key: (epoch number, validator address)
+// value: BlsSigRewardCredit
+func (k Keeper) lateBlsSigCreditStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.LateBlsSigCreditPrefix)
+}
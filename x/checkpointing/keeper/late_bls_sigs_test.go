@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/boljen/go-bitmap"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/testutil/mocks"
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
+)
+
+// FuzzAddAndAggregateLateBlsSigs checks that
+// 1. late BLS sigs are rejected if they are on the checkpoint of the current
+// epoch or an epoch out of the window, on another block hash, invalid, or
+// from a validator that already contributed to the checkpoint
+// 2. a valid late BLS sig is buffered once and aggregated into the checkpoint
+// upon AggregateLateBlsSigs, which clears the buffer
+// 3. the signer gets a reward credit that is halved per epoch of delay, and
+// the credits are handed to the incentive module once the checkpoint is
+// finalized
+// 4. a zero window disables late BLS sigs
+func FuzzAddAndAggregateLateBlsSigs(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		epochNum := datagen.RandomInt(r, 1000) + 1
+		epochsLate := datagen.RandomInt(r, int(types.DefaultLateBlsSigEpochWindow)) + 1
+		curEpoch := &epochingtypes.Epoch{EpochNumber: epochNum + epochsLate}
+		vals := epochingtypes.ValidatorSet{val1, val2}
+		sortedValSet := epochingtypes.NewSortedValidatorSet(vals)
+		blsPrivKeys := map[string]bls12381.PrivateKey{addr1.String(): blsPrivKey1, addr2.String(): blsPrivKey2}
+		blsPubKeys := map[string]bls12381.PublicKey{addr1.String(): blsPubKey1, addr2.String(): blsPubKey2}
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ek := mocks.NewMockEpochingKeeper(ctrl)
+		ek.EXPECT().GetEpoch(gomock.Any()).DoAndReturn(func(_ interface{}) *epochingtypes.Epoch { return curEpoch }).AnyTimes()
+		ek.EXPECT().GetValidatorSet(gomock.Any(), epochNum).Return(sortedValSet).AnyTimes()
+		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, ek, nil)
+		// the validator set is sorted in place, so register the BLS keys by
+		// address rather than by index
+		for _, val := range vals {
+			err := ckptKeeper.CreateRegistration(ctx, blsPubKeys[val.GetValAddressStr()], val.Addr)
+			require.NoError(t, err)
+		}
+
+		// a sealed checkpoint signed by the first validator only
+		onTimeSigner := sortedValSet[0]
+		lateSigner := sortedValSet[1]
+		ckptWithMeta := datagen.GenRandomRawCheckpointWithMeta(r)
+		ckptWithMeta.Ckpt.EpochNum = epochNum
+		ckptWithMeta.Status = types.Sealed
+		signBytes := types.GetSignBytes(epochNum, *ckptWithMeta.Ckpt.BlockHash)
+		onTimeSig := bls12381.Sign(blsPrivKeys[onTimeSigner.GetValAddressStr()], signBytes)
+		onTimePK := blsPubKeys[onTimeSigner.GetValAddressStr()]
+		ckptWithMeta.Ckpt.Bitmap = bitmap.New(types.BitmapBits)
+		bitmap.Set(ckptWithMeta.Ckpt.Bitmap, 0, true)
+		ckptWithMeta.Ckpt.BlsMultiSig = &onTimeSig
+		ckptWithMeta.BlsAggrPk = &onTimePK
+		ckptWithMeta.PowerSum = uint64(onTimeSigner.Power)
+		require.NoError(t, ckptKeeper.AddRawCheckpoint(ctx, ckptWithMeta))
+
+		newLateSig := func(signer epochingtypes.Validator, epoch uint64, blockHash types.BlockHash, privKey bls12381.PrivateKey) *types.BlsSig {
+			sig := bls12381.Sign(privKey, types.GetSignBytes(epoch, blockHash))
+			return &types.BlsSig{
+				EpochNum:      epoch,
+				BlockHash:     &blockHash,
+				BlsSig:        &sig,
+				SignerAddress: signer.GetValAddressStr(),
+			}
+		}
+		lateSignerKey := blsPrivKeys[lateSigner.GetValAddressStr()]
+		blockHash := *ckptWithMeta.Ckpt.BlockHash
+
+		// 1. invalid late BLS sigs
+		// the checkpoint of the current epoch is not sealed yet
+		err := ckptKeeper.AddLateBlsSig(ctx, newLateSig(lateSigner, curEpoch.EpochNumber, blockHash, lateSignerKey))
+		require.ErrorIs(t, err, types.ErrLateBlsSigNotAccepted)
+		// the epoch is out of the window
+		curEpoch = &epochingtypes.Epoch{EpochNumber: epochNum + types.DefaultLateBlsSigEpochWindow + 1}
+		err = ckptKeeper.AddLateBlsSig(ctx, newLateSig(lateSigner, epochNum, blockHash, lateSignerKey))
+		require.ErrorIs(t, err, types.ErrLateBlsSigNotAccepted)
+		curEpoch = &epochingtypes.Epoch{EpochNumber: epochNum + epochsLate}
+		// the BLS sig is on another block hash
+		err = ckptKeeper.AddLateBlsSig(ctx, newLateSig(lateSigner, epochNum, datagen.GenRandomBlockHash(r), lateSignerKey))
+		require.ErrorIs(t, err, types.ErrInvalidBlsSignature)
+		// the BLS sig is not signed by the signer's BLS key
+		err = ckptKeeper.AddLateBlsSig(ctx, newLateSig(lateSigner, epochNum, blockHash, bls12381.GenPrivKey()))
+		require.ErrorIs(t, err, types.ErrInvalidBlsSignature)
+		// the signer already contributed to the checkpoint
+		err = ckptKeeper.AddLateBlsSig(ctx, newLateSig(onTimeSigner, epochNum, blockHash, blsPrivKeys[onTimeSigner.GetValAddressStr()]))
+		require.ErrorIs(t, err, types.ErrCkptAlreadyVoted)
+
+		// 2. a valid late BLS sig is buffered once
+		lateSig := newLateSig(lateSigner, epochNum, blockHash, lateSignerKey)
+		require.NoError(t, ckptKeeper.AddLateBlsSig(ctx, lateSig))
+		err = ckptKeeper.AddLateBlsSig(ctx, lateSig)
+		require.ErrorIs(t, err, types.ErrCkptAlreadyVoted)
+		// the checkpoint is not updated before aggregation
+		storedCkpt, err := ckptKeeper.GetRawCheckpoint(ctx, epochNum)
+		require.NoError(t, err)
+		require.False(t, bitmap.Get(storedCkpt.Ckpt.Bitmap, 1))
+
+		// the late BLS sig is aggregated into the checkpoint
+		ckptKeeper.AggregateLateBlsSigs(ctx)
+		storedCkpt, err = ckptKeeper.GetRawCheckpoint(ctx, epochNum)
+		require.NoError(t, err)
+		require.Equal(t, types.Sealed, storedCkpt.Status)
+		require.True(t, bitmap.Get(storedCkpt.Ckpt.Bitmap, 0))
+		require.True(t, bitmap.Get(storedCkpt.Ckpt.Bitmap, 1))
+		require.Equal(t, uint64(onTimeSigner.Power+lateSigner.Power), storedCkpt.PowerSum)
+		pks := []bls12381.PublicKey{onTimePK, blsPubKeys[lateSigner.GetValAddressStr()]}
+		valid, err := bls12381.VerifyMultiSig(*storedCkpt.Ckpt.BlsMultiSig, pks, signBytes)
+		require.NoError(t, err)
+		require.True(t, valid)
+		// the buffer is cleared, and the late signer cannot contribute again
+		ckptKeeper.AggregateLateBlsSigs(ctx)
+		storedCkpt2, err := ckptKeeper.GetRawCheckpoint(ctx, epochNum)
+		require.NoError(t, err)
+		require.Equal(t, storedCkpt.PowerSum, storedCkpt2.PowerSum)
+		err = ckptKeeper.AddLateBlsSig(ctx, lateSig)
+		require.ErrorIs(t, err, types.ErrCkptAlreadyVoted)
+
+		// 3. the reward credits halve per epoch of delay
+		credits, err := ckptKeeper.GetBlsSigRewardCredits(ctx, epochNum)
+		require.NoError(t, err)
+		require.Len(t, credits, 2)
+		require.Equal(t, onTimeSigner.GetValAddressStr(), credits[0].ValidatorAddress)
+		require.Zero(t, credits[0].EpochsLate)
+		require.True(t, credits[0].Credit.Equal(types.BlsSigRewardCreditOf(0)))
+		require.Equal(t, lateSigner.GetValAddressStr(), credits[1].ValidatorAddress)
+		require.Equal(t, epochsLate, credits[1].EpochsLate)
+		require.True(t, credits[1].Credit.Equal(types.BlsSigRewardCreditOf(epochsLate)))
+
+		// the credits are handed to the incentive module upon finalization
+		ik := mocks.NewMockIncentiveKeeper(ctrl)
+		ik.EXPECT().RewardBLSSigners(gomock.Any(), epochNum, credits).Times(1)
+		ckptKeeper.SetIncentiveKeeper(ik)
+		ckptKeeper.SetCheckpointSubmitted(ctx, epochNum)
+		ckptKeeper.SetCheckpointConfirmed(ctx, epochNum)
+		ckptKeeper.SetCheckpointFinalized(ctx, epochNum)
+
+		// 4. a zero window disables late BLS sigs
+		params := ckptKeeper.GetParams(ctx)
+		params.LateBlsSigEpochWindow = 0
+		require.NoError(t, ckptKeeper.SetParams(ctx, params))
+		curEpoch = &epochingtypes.Epoch{EpochNumber: epochNum + types.DefaultLateBlsSigEpochWindow + 2}
+		lateCkpt := datagen.GenRandomRawCheckpointWithMeta(r)
+		lateCkpt.Ckpt.EpochNum = curEpoch.EpochNumber - 1
+		lateCkpt.Status = types.Sealed
+		require.NoError(t, ckptKeeper.AddRawCheckpoint(ctx, lateCkpt))
+		err = ckptKeeper.AddLateBlsSig(ctx, newLateSig(lateSigner, lateCkpt.Ckpt.EpochNum, *lateCkpt.Ckpt.BlockHash, lateSignerKey))
+		require.ErrorIs(t, err, types.ErrLateBlsSigNotAccepted)
+	})
+}
@@ -0,0 +1,23 @@
+package v2
+
+import (
+	corestoretypes "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The module
+// has no parameters in v1, where the number of recent epochs accepting late
+// BLS sigs is a constant. The migration sets the parameters to their default
+// values, which keep the window of v1.
+func MigrateStore(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	params := types.DefaultParams()
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := storeService.OpenKVStore(ctx)
+	return store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+}
@@ -0,0 +1,45 @@
+package v2_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/babylonchain/babylon/x/checkpointing/migrations/v2"
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	kvStore := storeService.OpenKVStore(ctx)
+
+	// v1 has no params
+	bz, err := kvStore.Get(types.ParamsKey)
+	require.NoError(t, err)
+	require.Nil(t, bz)
+
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+
+	bz, err = kvStore.Get(types.ParamsKey)
+	require.NoError(t, err)
+	var params types.Params
+	cdc.MustUnmarshal(bz, &params)
+	require.Equal(t, types.DefaultParams(), params)
+}
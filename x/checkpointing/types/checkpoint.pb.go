@@ -5,9 +5,11 @@ package types
 
 import (
 	bytes "bytes"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_babylonchain_babylon_crypto_bls12381 "github.com/babylonchain/babylon/crypto/bls12381"
 	types "github.com/cometbft/cometbft/abci/types"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -400,6 +402,68 @@ func (m *BlsSig) GetValidatorAddress() string {
 	return ""
 }
 
+// BlsSigRewardCredit is the reward credit of a validator for contributing its
+// BLS sig to the checkpoint of an epoch. BLS sigs aggregated before the
+// checkpoint is sealed receive the full credit, while the credit of late BLS
+// sigs decays with the number of epochs they are late.
+type BlsSigRewardCredit struct {
+	// validator_address is the address of the validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// epochs_late is the number of epochs between the checkpoint's epoch and
+	// the epoch in which the BLS sig is aggregated, which is zero for BLS sigs
+	// aggregated before the checkpoint is sealed
+	EpochsLate uint64 `protobuf:"varint,2,opt,name=epochs_late,json=epochsLate,proto3" json:"epochs_late,omitempty"`
+	// credit is the reward credit in [0, 1]
+	Credit cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=credit,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"credit"`
+}
+
+func (m *BlsSigRewardCredit) Reset()         { *m = BlsSigRewardCredit{} }
+func (m *BlsSigRewardCredit) String() string { return proto.CompactTextString(m) }
+func (*BlsSigRewardCredit) ProtoMessage()    {}
+func (*BlsSigRewardCredit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73996df9c6aabde4, []int{5}
+}
+func (m *BlsSigRewardCredit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlsSigRewardCredit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlsSigRewardCredit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlsSigRewardCredit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlsSigRewardCredit.Merge(m, src)
+}
+func (m *BlsSigRewardCredit) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlsSigRewardCredit) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlsSigRewardCredit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlsSigRewardCredit proto.InternalMessageInfo
+
+func (m *BlsSigRewardCredit) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *BlsSigRewardCredit) GetEpochsLate() uint64 {
+	if m != nil {
+		return m.EpochsLate
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("babylon.checkpointing.v1.CheckpointStatus", CheckpointStatus_name, CheckpointStatus_value)
 	proto.RegisterType((*RawCheckpoint)(nil), "babylon.checkpointing.v1.RawCheckpoint")
@@ -407,6 +471,7 @@ func init() {
 	proto.RegisterType((*InjectedCheckpoint)(nil), "babylon.checkpointing.v1.InjectedCheckpoint")
 	proto.RegisterType((*CheckpointStateUpdate)(nil), "babylon.checkpointing.v1.CheckpointStateUpdate")
 	proto.RegisterType((*BlsSig)(nil), "babylon.checkpointing.v1.BlsSig")
+	proto.RegisterType((*BlsSigRewardCredit)(nil), "babylon.checkpointing.v1.BlsSigRewardCredit")
 }
 
 func init() {
@@ -414,61 +479,67 @@ func init() {
 }
 
 var fileDescriptor_73996df9c6aabde4 = []byte{
-	// 853 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
-	0x1c, 0x8d, 0x5b, 0x37, 0x6c, 0x26, 0xcd, 0x2a, 0x8c, 0xb6, 0x28, 0xca, 0x4a, 0x49, 0x28, 0x42,
-	0x94, 0x05, 0xd9, 0x6a, 0x56, 0x48, 0xfc, 0x11, 0x82, 0xc4, 0x4d, 0x21, 0xda, 0xa6, 0x5b, 0xd9,
-	0x09, 0x48, 0x2b, 0x21, 0x6b, 0x6c, 0x4f, 0xec, 0x21, 0xb6, 0xc7, 0xf2, 0x8c, 0xbb, 0x1b, 0xee,
-	0x48, 0xa8, 0xa7, 0xfd, 0x02, 0x95, 0x90, 0xf8, 0x02, 0x7c, 0x07, 0x2e, 0x1c, 0xf7, 0x88, 0x16,
-	0x69, 0x41, 0xed, 0x05, 0xf8, 0x14, 0x68, 0xc6, 0x4e, 0xd3, 0x6c, 0x59, 0xb1, 0xa0, 0xde, 0x26,
-	0xcf, 0xef, 0x4d, 0x66, 0xde, 0xef, 0x3d, 0x0d, 0x78, 0xdb, 0x41, 0xce, 0x3c, 0xa4, 0xb1, 0xee,
-	0x06, 0xd8, 0x9d, 0x25, 0x94, 0xc4, 0x9c, 0xc4, 0xbe, 0x7e, 0xbc, 0x7b, 0x09, 0xd0, 0x92, 0x94,
-	0x72, 0x0a, 0x1b, 0x05, 0x55, 0x5b, 0xa1, 0x6a, 0xc7, 0xbb, 0xcd, 0xb6, 0x4f, 0xa9, 0x1f, 0x62,
-	0x5d, 0xf2, 0x9c, 0x6c, 0xaa, 0x73, 0x12, 0x61, 0xc6, 0x51, 0x94, 0xe4, 0xd2, 0xe6, 0x2d, 0x9f,
-	0xfa, 0x54, 0x2e, 0x75, 0xb1, 0x2a, 0xd0, 0xdb, 0x1c, 0xc7, 0x1e, 0x4e, 0x23, 0x12, 0x73, 0x1d,
-	0x39, 0x2e, 0xd1, 0xf9, 0x3c, 0xc1, 0x2c, 0xff, 0xb8, 0xfd, 0xab, 0x02, 0x6a, 0x26, 0x7a, 0x68,
-	0x5c, 0xfc, 0x17, 0xbc, 0x0d, 0x2a, 0x38, 0xa1, 0x6e, 0x60, 0xc7, 0x59, 0xd4, 0x50, 0x3a, 0xca,
-	0x8e, 0x6a, 0xde, 0x90, 0xc0, 0x61, 0x16, 0xc1, 0x77, 0x01, 0x70, 0x42, 0xea, 0xce, 0xec, 0x00,
-	0xb1, 0xa0, 0xb1, 0xd6, 0x51, 0x76, 0x36, 0xfb, 0xb5, 0xa7, 0xcf, 0xda, 0x95, 0xbe, 0x40, 0x3f,
-	0x47, 0x2c, 0x30, 0x2b, 0xce, 0x62, 0x09, 0x5f, 0x03, 0x65, 0x87, 0xf0, 0x08, 0x25, 0x8d, 0x75,
-	0xc1, 0x34, 0x8b, 0x5f, 0x10, 0x81, 0x9a, 0x13, 0x32, 0x3b, 0xca, 0x42, 0x4e, 0x6c, 0x46, 0xfc,
-	0x86, 0x2a, 0x37, 0xfa, 0xf8, 0xe9, 0xb3, 0xf6, 0x07, 0x3e, 0xe1, 0x41, 0xe6, 0x68, 0x2e, 0x8d,
-	0xf4, 0xc2, 0x08, 0x37, 0x40, 0x24, 0xd6, 0x2f, 0x0c, 0x4c, 0xe7, 0x09, 0xa7, 0xba, 0x13, 0xb2,
-	0xdd, 0xee, 0xdd, 0xf7, 0x77, 0x35, 0x8b, 0xf8, 0x31, 0xe2, 0x59, 0x8a, 0xcd, 0xaa, 0x13, 0xb2,
-	0x91, 0xd8, 0xd2, 0x22, 0xfe, 0x87, 0xea, 0x1f, 0xdf, 0xb7, 0x95, 0xed, 0x3f, 0xd7, 0xc0, 0xd6,
-	0xca, 0xed, 0xbe, 0x24, 0x3c, 0x18, 0x61, 0x8e, 0xe0, 0x47, 0x40, 0x75, 0x67, 0x09, 0x97, 0x17,
-	0xac, 0x76, 0xdf, 0xd2, 0x5e, 0x64, 0xba, 0xb6, 0x22, 0x37, 0xa5, 0x08, 0xf6, 0x41, 0x99, 0x71,
-	0xc4, 0x33, 0x26, 0x1d, 0xb8, 0xd9, 0xbd, 0xf3, 0x62, 0xf9, 0x52, 0x6b, 0x49, 0x85, 0x59, 0x28,
-	0xe1, 0x57, 0x40, 0x9c, 0xd7, 0x46, 0xbe, 0x9f, 0xda, 0xc9, 0x2c, 0x37, 0xe8, 0xff, 0x39, 0x70,
-	0x94, 0x39, 0x21, 0x71, 0xef, 0xe1, 0xb9, 0xb0, 0x9e, 0xf5, 0x7c, 0x3f, 0x3d, 0x9a, 0x89, 0x29,
-	0x26, 0xf4, 0x21, 0x4e, 0x6d, 0x96, 0x45, 0xd2, 0x5e, 0xd5, 0xbc, 0x21, 0x01, 0x2b, 0x8b, 0xe0,
-	0x08, 0x54, 0x42, 0x32, 0xc5, 0xee, 0xdc, 0x0d, 0x71, 0x63, 0xa3, 0xb3, 0xbe, 0x53, 0xed, 0xea,
-	0x2f, 0x7b, 0x05, 0x3c, 0x49, 0x3c, 0xc4, 0xb1, 0xb9, 0xdc, 0xa1, 0xf0, 0xfa, 0x47, 0x05, 0xc0,
-	0x61, 0xfc, 0x35, 0x76, 0x39, 0xf6, 0x2e, 0xc5, 0xc9, 0x58, 0x31, 0x5a, 0x7f, 0x49, 0xa3, 0x17,
-	0x73, 0x2a, 0x0c, 0x9f, 0x80, 0x5b, 0xf8, 0x91, 0x8c, 0xb1, 0x67, 0xbb, 0x34, 0x8a, 0x08, 0xb7,
-	0x49, 0x3c, 0xa5, 0xd2, 0xfe, 0x6a, 0xf7, 0x0d, 0x6d, 0x99, 0x70, 0x4d, 0x24, 0x5c, 0x1b, 0x14,
-	0x64, 0x43, 0x72, 0x87, 0xf1, 0x94, 0x9a, 0x10, 0x5f, 0xc1, 0xb6, 0x7f, 0x52, 0xc0, 0xd6, 0x3f,
-	0xde, 0x0e, 0x7e, 0x0a, 0x36, 0xc4, 0x9c, 0xb0, 0x3c, 0xf6, 0x7f, 0x1b, 0x70, 0x2e, 0x84, 0xaf,
-	0x83, 0xcd, 0xa2, 0x29, 0x98, 0xf8, 0x01, 0x97, 0x47, 0x55, 0x45, 0x46, 0x45, 0x39, 0x24, 0x04,
-	0x3f, 0x59, 0x94, 0x49, 0xf4, 0x58, 0x26, 0xa0, 0xda, 0x6d, 0x6a, 0x79, 0xc9, 0xb5, 0x45, 0xc9,
-	0xb5, 0xf1, 0xa2, 0xe4, 0x7d, 0xf5, 0xf1, 0x6f, 0x6d, 0xa5, 0xe8, 0x97, 0x40, 0x0b, 0xe3, 0xbf,
-	0x5d, 0x03, 0xe5, 0x7e, 0xc8, 0x2c, 0xe2, 0x5f, 0x67, 0x77, 0xbf, 0x00, 0xaf, 0x88, 0x7c, 0x8a,
-	0x76, 0xae, 0x5f, 0x47, 0x3b, 0xcb, 0x4e, 0x7e, 0xc4, 0x37, 0xc1, 0x4d, 0x46, 0xfc, 0x18, 0xa7,
-	0x36, 0xf2, 0xbc, 0x14, 0x33, 0x26, 0xd3, 0x59, 0x31, 0x6b, 0x39, 0xda, 0xcb, 0x41, 0xf8, 0x0e,
-	0x78, 0xf5, 0x18, 0x85, 0xc4, 0x43, 0x9c, 0x2e, 0x99, 0x1b, 0x92, 0x59, 0xbf, 0xf8, 0x50, 0x90,
-	0xa5, 0x0f, 0xa5, 0x3b, 0x7f, 0x29, 0xa0, 0xfe, 0xfc, 0x34, 0xa0, 0x06, 0x1a, 0xc6, 0xbd, 0xa3,
-	0xb1, 0x6d, 0x8d, 0x7b, 0xe3, 0x89, 0x65, 0xf7, 0x0c, 0x63, 0x32, 0x9a, 0x1c, 0xf4, 0xc6, 0xc3,
-	0xc3, 0xcf, 0xea, 0xa5, 0x66, 0xfd, 0xe4, 0xb4, 0xb3, 0xd9, 0x73, 0xdd, 0x2c, 0xca, 0x42, 0x24,
-	0x26, 0x0a, 0xb7, 0x01, 0xbc, 0xcc, 0xb7, 0x06, 0xbd, 0x83, 0xc1, 0x5e, 0x5d, 0x69, 0x82, 0x93,
-	0xd3, 0x4e, 0xd9, 0xc2, 0x28, 0xc4, 0x1e, 0xdc, 0x01, 0x5b, 0x2b, 0x9c, 0x49, 0x7f, 0x34, 0x1c,
-	0x8f, 0x07, 0x7b, 0xf5, 0xb5, 0x66, 0xed, 0xe4, 0xb4, 0x53, 0xb1, 0x32, 0x27, 0x22, 0x9c, 0x5f,
-	0x65, 0x1a, 0xf7, 0x0f, 0xf7, 0x87, 0xe6, 0x68, 0xb0, 0x57, 0x5f, 0xcf, 0x99, 0x06, 0x8d, 0xa7,
-	0x24, 0x8d, 0xae, 0x32, 0xf7, 0x87, 0x87, 0xbd, 0x83, 0xe1, 0x83, 0xc1, 0x5e, 0x5d, 0xcd, 0x99,
-	0xfb, 0x24, 0x46, 0x21, 0xf9, 0x06, 0x7b, 0x4d, 0xf5, 0xbb, 0x1f, 0x5a, 0xa5, 0xfe, 0xfd, 0x9f,
-	0xcf, 0x5a, 0xca, 0x93, 0xb3, 0x96, 0xf2, 0xfb, 0x59, 0x4b, 0x79, 0x7c, 0xde, 0x2a, 0x3d, 0x39,
-	0x6f, 0x95, 0x7e, 0x39, 0x6f, 0x95, 0x1e, 0xbc, 0xf7, 0x6f, 0x33, 0x7a, 0xf4, 0xdc, 0x23, 0x24,
-	0x9f, 0x03, 0xa7, 0x2c, 0x03, 0x77, 0xf7, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x97, 0x52,
-	0x24, 0xaa, 0x06, 0x00, 0x00,
+	// 949 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
+	0x18, 0x8d, 0x9b, 0x34, 0x6c, 0x26, 0xcd, 0x2a, 0x8c, 0xb6, 0x28, 0xa4, 0x52, 0x12, 0x82, 0x10,
+	0x65, 0x01, 0x5b, 0xc9, 0x0a, 0x89, 0x1f, 0x42, 0x90, 0x5f, 0x85, 0x68, 0x93, 0x6e, 0x65, 0x27,
+	0x20, 0xad, 0x84, 0xac, 0xf1, 0x78, 0x62, 0x0f, 0xb1, 0x3d, 0x96, 0x3d, 0x6e, 0x37, 0xdc, 0x91,
+	0x50, 0x4f, 0xfb, 0x0f, 0x54, 0x42, 0xe2, 0x8c, 0xc4, 0x81, 0xff, 0x80, 0xcb, 0x1e, 0x57, 0x9c,
+	0x50, 0x91, 0x0a, 0x6a, 0x2f, 0xc0, 0x5f, 0x81, 0x3c, 0x76, 0xda, 0x66, 0xdb, 0x15, 0xcb, 0x6a,
+	0x6f, 0x93, 0xf7, 0xbd, 0xef, 0xd3, 0xcc, 0xfb, 0xde, 0x8b, 0xc1, 0x5b, 0x06, 0x32, 0x16, 0x0e,
+	0xf3, 0x14, 0x6c, 0x13, 0x3c, 0xf7, 0x19, 0xf5, 0x38, 0xf5, 0x2c, 0x65, 0xbf, 0x75, 0x09, 0x90,
+	0xfd, 0x80, 0x71, 0x06, 0x2b, 0x29, 0x55, 0x5e, 0xa1, 0xca, 0xfb, 0xad, 0x6a, 0xdd, 0x62, 0xcc,
+	0x72, 0x88, 0x22, 0x78, 0x46, 0x34, 0x53, 0x38, 0x75, 0x49, 0xc8, 0x91, 0xeb, 0x27, 0xad, 0xd5,
+	0x5b, 0x16, 0xb3, 0x98, 0x38, 0x2a, 0xf1, 0x29, 0x45, 0x5f, 0xc5, 0x2c, 0x74, 0x59, 0xa8, 0x27,
+	0x85, 0xe4, 0x47, 0x5a, 0xda, 0xe2, 0xc4, 0x33, 0x49, 0xe0, 0x52, 0x8f, 0x2b, 0xc8, 0xc0, 0x54,
+	0xe1, 0x0b, 0x9f, 0xa4, 0xc5, 0xe6, 0xef, 0x12, 0x28, 0xa9, 0xe8, 0xa0, 0x77, 0x7e, 0x0d, 0xb8,
+	0x05, 0x0a, 0xc4, 0x67, 0xd8, 0xd6, 0xbd, 0xc8, 0xad, 0x48, 0x0d, 0x69, 0x3b, 0xa7, 0xde, 0x10,
+	0xc0, 0x6e, 0xe4, 0xc2, 0x77, 0x00, 0x30, 0x1c, 0x86, 0xe7, 0xba, 0x8d, 0x42, 0xbb, 0xb2, 0xd6,
+	0x90, 0xb6, 0x37, 0xba, 0xa5, 0xe3, 0x93, 0x7a, 0xa1, 0x1b, 0xa3, 0x9f, 0xa3, 0xd0, 0x56, 0x0b,
+	0xc6, 0xf2, 0x08, 0x5f, 0x01, 0x79, 0x83, 0x72, 0x17, 0xf9, 0x95, 0x6c, 0xcc, 0x54, 0xd3, 0x5f,
+	0x10, 0x81, 0x92, 0xe1, 0x84, 0xba, 0x1b, 0x39, 0x9c, 0xea, 0x21, 0xb5, 0x2a, 0x39, 0x31, 0xe8,
+	0xe3, 0xe3, 0x93, 0xfa, 0x07, 0x16, 0xe5, 0x76, 0x64, 0xc8, 0x98, 0xb9, 0x4a, 0xaa, 0x11, 0xb6,
+	0x11, 0xf5, 0x94, 0x73, 0x6d, 0x83, 0x85, 0xcf, 0x99, 0x62, 0x38, 0x61, 0xab, 0x7d, 0xe7, 0xfd,
+	0x96, 0xac, 0x51, 0xcb, 0x43, 0x3c, 0x0a, 0x88, 0x5a, 0x34, 0x9c, 0x70, 0x1c, 0x8f, 0xd4, 0xa8,
+	0xf5, 0x61, 0xee, 0xaf, 0xef, 0xeb, 0x52, 0xf3, 0xef, 0x35, 0xb0, 0xb9, 0xf2, 0xba, 0x2f, 0x29,
+	0xb7, 0xc7, 0x84, 0x23, 0xf8, 0x11, 0xc8, 0xe1, 0xb9, 0xcf, 0xc5, 0x03, 0x8b, 0xed, 0x37, 0xe5,
+	0xa7, 0xed, 0x43, 0x5e, 0x69, 0x57, 0x45, 0x13, 0xec, 0x82, 0x7c, 0xc8, 0x11, 0x8f, 0x42, 0xa1,
+	0xc0, 0xcd, 0xf6, 0xed, 0xa7, 0xb7, 0x5f, 0xf4, 0x6a, 0xa2, 0x43, 0x4d, 0x3b, 0xe1, 0x57, 0x20,
+	0xbe, 0xaf, 0x8e, 0x2c, 0x2b, 0xd0, 0xfd, 0x79, 0x25, 0xfb, 0xfc, 0x0a, 0xec, 0x45, 0x86, 0x43,
+	0xf1, 0x5d, 0xb2, 0x88, 0xa5, 0x0f, 0x3b, 0x96, 0x15, 0xec, 0xcd, 0xe3, 0x2d, 0xfa, 0xec, 0x80,
+	0x04, 0x7a, 0x18, 0xb9, 0x42, 0xde, 0x9c, 0x7a, 0x43, 0x00, 0x5a, 0xe4, 0xc2, 0x31, 0x28, 0x38,
+	0x74, 0x46, 0xf0, 0x02, 0x3b, 0xa4, 0xb2, 0xde, 0xc8, 0x6e, 0x17, 0xdb, 0xca, 0xb3, 0x3e, 0x81,
+	0x4c, 0x7d, 0x13, 0x71, 0xa2, 0x5e, 0x4c, 0x48, 0xb5, 0xfe, 0x49, 0x02, 0x70, 0xe8, 0x7d, 0x4d,
+	0x30, 0x27, 0xe6, 0x25, 0x3b, 0xf5, 0x56, 0x84, 0x56, 0x9e, 0x51, 0xe8, 0xe5, 0x9e, 0x52, 0xc1,
+	0xa7, 0xe0, 0x16, 0x79, 0x20, 0x6c, 0x6c, 0xea, 0x98, 0xb9, 0x2e, 0xe5, 0x3a, 0xf5, 0x66, 0x4c,
+	0xc8, 0x5f, 0x6c, 0xbf, 0x2e, 0x5f, 0x38, 0x5c, 0x8e, 0x1d, 0x2e, 0x0f, 0x52, 0x72, 0x4f, 0x70,
+	0x87, 0xde, 0x8c, 0xa9, 0x90, 0x5c, 0xc1, 0x9a, 0xbf, 0x48, 0x60, 0xf3, 0xda, 0xd7, 0xc1, 0x4f,
+	0xc1, 0x7a, 0xbc, 0x27, 0x52, 0x91, 0xfe, 0xf7, 0x82, 0x93, 0x46, 0xf8, 0x1a, 0xd8, 0x48, 0x93,
+	0x42, 0xa8, 0x65, 0x73, 0x71, 0xd5, 0x9c, 0x5a, 0x4c, 0xc2, 0x21, 0x20, 0xf8, 0xc9, 0x32, 0x4c,
+	0x71, 0xc4, 0x85, 0x03, 0x8a, 0xed, 0xaa, 0x9c, 0xe4, 0x5f, 0x5e, 0xe6, 0x5f, 0x9e, 0x2c, 0xf3,
+	0xdf, 0xcd, 0x3d, 0xfc, 0xa3, 0x2e, 0xa5, 0xf9, 0x8a, 0xd1, 0x54, 0xf8, 0x6f, 0xd7, 0x40, 0xbe,
+	0xeb, 0x84, 0x1a, 0xb5, 0x5e, 0x64, 0x76, 0xbf, 0x00, 0x2f, 0xc5, 0xfe, 0x8c, 0xd3, 0x99, 0x7d,
+	0x11, 0xe9, 0xcc, 0x1b, 0xc9, 0x15, 0xdf, 0x00, 0x37, 0x43, 0x6a, 0x79, 0x24, 0xd0, 0x91, 0x69,
+	0x06, 0x24, 0x0c, 0x85, 0x3b, 0x0b, 0x6a, 0x29, 0x41, 0x3b, 0x09, 0x08, 0xdf, 0x06, 0x2f, 0xef,
+	0x23, 0x87, 0x9a, 0x88, 0xb3, 0x0b, 0xe6, 0xba, 0x60, 0x96, 0xcf, 0x0b, 0x29, 0x59, 0xe8, 0x90,
+	0x69, 0xfe, 0x28, 0x01, 0x98, 0xe8, 0xa0, 0x92, 0x03, 0x14, 0x98, 0xbd, 0x80, 0x98, 0x94, 0x5f,
+	0x3f, 0x49, 0xba, 0x7e, 0x12, 0xac, 0x83, 0xa2, 0xd0, 0x2b, 0xd4, 0x9d, 0x78, 0xfb, 0xc9, 0xd2,
+	0x40, 0x02, 0x8d, 0xe2, 0xb5, 0x0e, 0x41, 0x1e, 0x8b, 0xb9, 0x42, 0x95, 0x42, 0xb7, 0xf5, 0xe8,
+	0xa4, 0x9e, 0x39, 0x3e, 0xa9, 0x6f, 0x25, 0x7f, 0xb9, 0xa1, 0x39, 0x97, 0x29, 0x53, 0x5c, 0xc4,
+	0x6d, 0x79, 0x44, 0x2c, 0x84, 0x17, 0x7d, 0x82, 0x7f, 0xfd, 0xf9, 0x5d, 0x90, 0x94, 0xe5, 0x3e,
+	0xc1, 0x6a, 0x3a, 0xe0, 0xf6, 0x3f, 0x12, 0x28, 0x3f, 0xe9, 0x1e, 0x28, 0x83, 0x4a, 0xef, 0xee,
+	0xde, 0x44, 0xd7, 0x26, 0x9d, 0xc9, 0x54, 0xd3, 0x3b, 0xbd, 0xde, 0x74, 0x3c, 0x1d, 0x75, 0x26,
+	0xc3, 0xdd, 0xcf, 0xca, 0x99, 0x6a, 0xf9, 0xf0, 0xa8, 0xb1, 0xd1, 0xc1, 0x38, 0x72, 0x23, 0x07,
+	0xc5, 0x0e, 0x84, 0x4d, 0x00, 0x2f, 0xf3, 0xb5, 0x41, 0x67, 0x34, 0xe8, 0x97, 0xa5, 0x2a, 0x38,
+	0x3c, 0x6a, 0xe4, 0x35, 0x82, 0x1c, 0x62, 0xc2, 0x6d, 0xb0, 0xb9, 0xc2, 0x99, 0x76, 0xc7, 0xc3,
+	0xc9, 0x64, 0xd0, 0x2f, 0xaf, 0x55, 0x4b, 0x87, 0x47, 0x8d, 0x82, 0x16, 0x19, 0x2e, 0xe5, 0xfc,
+	0x2a, 0xb3, 0x77, 0x6f, 0x77, 0x67, 0xa8, 0x8e, 0x07, 0xfd, 0x72, 0x36, 0x61, 0xf6, 0x98, 0x37,
+	0xa3, 0x81, 0x7b, 0x95, 0xb9, 0x33, 0xdc, 0xed, 0x8c, 0x86, 0xf7, 0x07, 0xfd, 0x72, 0x2e, 0x61,
+	0xee, 0x50, 0x0f, 0x39, 0xf4, 0x1b, 0x62, 0x56, 0x73, 0xdf, 0xfd, 0x50, 0xcb, 0x74, 0xef, 0x3d,
+	0x3a, 0xad, 0x49, 0x8f, 0x4f, 0x6b, 0xd2, 0x9f, 0xa7, 0x35, 0xe9, 0xe1, 0x59, 0x2d, 0xf3, 0xf8,
+	0xac, 0x96, 0xf9, 0xed, 0xac, 0x96, 0xb9, 0xff, 0xde, 0x7f, 0x79, 0xea, 0xc1, 0x13, 0xdf, 0x53,
+	0xf1, 0xf9, 0x32, 0xf2, 0x22, 0x20, 0x77, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x54, 0x3c,
+	0x89, 0x75, 0x07, 0x00, 0x00,
 }
 
 func (this *RawCheckpoint) Equal(that interface{}) bool {
@@ -877,6 +948,51 @@ func (m *BlsSig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlsSigRewardCredit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlsSigRewardCredit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlsSigRewardCredit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Credit.Size()
+		i -= size
+		if _, err := m.Credit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCheckpoint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochsLate != 0 {
+		i = encodeVarintCheckpoint(dAtA, i, uint64(m.EpochsLate))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintCheckpoint(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCheckpoint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCheckpoint(v)
 	base := offset
@@ -1005,6 +1121,24 @@ func (m *BlsSig) Size() (n int) {
 	return n
 }
 
+func (m *BlsSigRewardCredit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovCheckpoint(uint64(l))
+	}
+	if m.EpochsLate != 0 {
+		n += 1 + sovCheckpoint(uint64(m.EpochsLate))
+	}
+	l = m.Credit.Size()
+	n += 1 + l + sovCheckpoint(uint64(l))
+	return n
+}
+
 func sovCheckpoint(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1826,6 +1960,141 @@ func (m *BlsSig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BlsSigRewardCredit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCheckpoint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlsSigRewardCredit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlsSigRewardCredit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochsLate", wireType)
+			}
+			m.EpochsLate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochsLate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Credit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCheckpoint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCheckpoint(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"cosmossdk.io/x/evidence/exported"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -8,6 +9,9 @@ import (
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgWrappedCreateValidator{}, "checkpointing/MsgWrappedCreateValidator", nil)
+	cdc.RegisterConcrete(&MsgAddBlsSig{}, "checkpointing/MsgAddBlsSig", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "checkpointing/MsgUpdateParams", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -15,6 +19,13 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	// Register messages
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgWrappedCreateValidator{},
+		&MsgAddBlsSig{},
+		&MsgUpdateParams{},
+	)
+
+	// Register evidence
+	registry.RegisterImplementations((*exported.Evidence)(nil),
+		&ConflictingCheckpointEvidence{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
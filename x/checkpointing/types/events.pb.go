@@ -298,6 +298,98 @@ func (m *EventCheckpointForgotten) GetCheckpoint() *RawCheckpointWithMeta {
 	return nil
 }
 
+// EventCheckpointUnfinalized is emitted when a `Finalized` checkpoint rolls
+// back to the `Confirmed` state upon a BTC reorg.
+type EventCheckpointUnfinalized struct {
+	Checkpoint *RawCheckpointWithMeta `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+}
+
+func (m *EventCheckpointUnfinalized) Reset()         { *m = EventCheckpointUnfinalized{} }
+func (m *EventCheckpointUnfinalized) String() string { return proto.CompactTextString(m) }
+func (*EventCheckpointUnfinalized) ProtoMessage()    {}
+func (*EventCheckpointUnfinalized) Descriptor() ([]byte, []int) {
+	return fileDescriptor_950b7bd81c59f78a, []int{6}
+}
+func (m *EventCheckpointUnfinalized) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCheckpointUnfinalized) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCheckpointUnfinalized.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCheckpointUnfinalized) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCheckpointUnfinalized.Merge(m, src)
+}
+func (m *EventCheckpointUnfinalized) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCheckpointUnfinalized) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCheckpointUnfinalized.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCheckpointUnfinalized proto.InternalMessageInfo
+
+func (m *EventCheckpointUnfinalized) GetCheckpoint() *RawCheckpointWithMeta {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
+}
+
+// EventCheckpointUnconfirmed is emitted when a `Confirmed` checkpoint rolls
+// back to the `Submitted` state upon a BTC reorg.
+type EventCheckpointUnconfirmed struct {
+	Checkpoint *RawCheckpointWithMeta `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+}
+
+func (m *EventCheckpointUnconfirmed) Reset()         { *m = EventCheckpointUnconfirmed{} }
+func (m *EventCheckpointUnconfirmed) String() string { return proto.CompactTextString(m) }
+func (*EventCheckpointUnconfirmed) ProtoMessage()    {}
+func (*EventCheckpointUnconfirmed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_950b7bd81c59f78a, []int{7}
+}
+func (m *EventCheckpointUnconfirmed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCheckpointUnconfirmed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCheckpointUnconfirmed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCheckpointUnconfirmed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCheckpointUnconfirmed.Merge(m, src)
+}
+func (m *EventCheckpointUnconfirmed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCheckpointUnconfirmed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCheckpointUnconfirmed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCheckpointUnconfirmed proto.InternalMessageInfo
+
+func (m *EventCheckpointUnconfirmed) GetCheckpoint() *RawCheckpointWithMeta {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
+}
+
 // EventConflictingCheckpoint is emitted when two conflicting checkpoints are
 // found.
 type EventConflictingCheckpoint struct {
@@ -309,7 +401,7 @@ func (m *EventConflictingCheckpoint) Reset()         { *m = EventConflictingChec
 func (m *EventConflictingCheckpoint) String() string { return proto.CompactTextString(m) }
 func (*EventConflictingCheckpoint) ProtoMessage()    {}
 func (*EventConflictingCheckpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_950b7bd81c59f78a, []int{6}
+	return fileDescriptor_950b7bd81c59f78a, []int{8}
 }
 func (m *EventConflictingCheckpoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -352,6 +444,82 @@ func (m *EventConflictingCheckpoint) GetLocalCheckpoint() *RawCheckpointWithMeta
 	return nil
 }
 
+// EventLateBlsSigsAggregated is emitted when BLS signatures received after a
+// checkpoint is sealed are aggregated into the checkpoint
+type EventLateBlsSigsAggregated struct {
+	// epoch_num is the epoch number of the checkpoint
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// signer_addresses are the addresses of the validators whose BLS
+	// signatures are aggregated
+	SignerAddresses []string `protobuf:"bytes,2,rep,name=signer_addresses,json=signerAddresses,proto3" json:"signer_addresses,omitempty"`
+	// power_sum is the accumulated voting power of the checkpoint after the
+	// aggregation
+	PowerSum uint64 `protobuf:"varint,3,opt,name=power_sum,json=powerSum,proto3" json:"power_sum,omitempty"`
+	// credits are the decayed reward credits of the aggregated BLS signatures
+	Credits []*BlsSigRewardCredit `protobuf:"bytes,4,rep,name=credits,proto3" json:"credits,omitempty"`
+}
+
+func (m *EventLateBlsSigsAggregated) Reset()         { *m = EventLateBlsSigsAggregated{} }
+func (m *EventLateBlsSigsAggregated) String() string { return proto.CompactTextString(m) }
+func (*EventLateBlsSigsAggregated) ProtoMessage()    {}
+func (*EventLateBlsSigsAggregated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_950b7bd81c59f78a, []int{9}
+}
+func (m *EventLateBlsSigsAggregated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventLateBlsSigsAggregated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventLateBlsSigsAggregated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventLateBlsSigsAggregated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLateBlsSigsAggregated.Merge(m, src)
+}
+func (m *EventLateBlsSigsAggregated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventLateBlsSigsAggregated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLateBlsSigsAggregated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventLateBlsSigsAggregated proto.InternalMessageInfo
+
+func (m *EventLateBlsSigsAggregated) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *EventLateBlsSigsAggregated) GetSignerAddresses() []string {
+	if m != nil {
+		return m.SignerAddresses
+	}
+	return nil
+}
+
+func (m *EventLateBlsSigsAggregated) GetPowerSum() uint64 {
+	if m != nil {
+		return m.PowerSum
+	}
+	return 0
+}
+
+func (m *EventLateBlsSigsAggregated) GetCredits() []*BlsSigRewardCredit {
+	if m != nil {
+		return m.Credits
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventCheckpointAccumulating)(nil), "babylon.checkpointing.v1.EventCheckpointAccumulating")
 	proto.RegisterType((*EventCheckpointSealed)(nil), "babylon.checkpointing.v1.EventCheckpointSealed")
@@ -359,7 +527,10 @@ func init() {
 	proto.RegisterType((*EventCheckpointConfirmed)(nil), "babylon.checkpointing.v1.EventCheckpointConfirmed")
 	proto.RegisterType((*EventCheckpointFinalized)(nil), "babylon.checkpointing.v1.EventCheckpointFinalized")
 	proto.RegisterType((*EventCheckpointForgotten)(nil), "babylon.checkpointing.v1.EventCheckpointForgotten")
+	proto.RegisterType((*EventCheckpointUnfinalized)(nil), "babylon.checkpointing.v1.EventCheckpointUnfinalized")
+	proto.RegisterType((*EventCheckpointUnconfirmed)(nil), "babylon.checkpointing.v1.EventCheckpointUnconfirmed")
 	proto.RegisterType((*EventConflictingCheckpoint)(nil), "babylon.checkpointing.v1.EventConflictingCheckpoint")
+	proto.RegisterType((*EventLateBlsSigsAggregated)(nil), "babylon.checkpointing.v1.EventLateBlsSigsAggregated")
 }
 
 func init() {
@@ -367,27 +538,35 @@ func init() {
 }
 
 var fileDescriptor_950b7bd81c59f78a = []byte{
-	// 314 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0xce, 0x48, 0x4d, 0xce, 0x2e, 0xc8, 0xcf, 0xcc, 0x2b, 0xc9, 0xcc,
-	0x4b, 0xd7, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0x80, 0x2a, 0xd3, 0x43, 0x51, 0xa6, 0x57, 0x66, 0x28, 0xa5, 0x89, 0xd3, 0x00,
-	0x84, 0x00, 0xc4, 0x10, 0xa5, 0x3c, 0x2e, 0x69, 0x57, 0x90, 0xa1, 0xce, 0x70, 0x09, 0xc7, 0xe4,
-	0xe4, 0xd2, 0xdc, 0xd2, 0x9c, 0x44, 0x90, 0x16, 0x21, 0x7f, 0x2e, 0x2e, 0x84, 0x16, 0x09, 0x46,
-	0x05, 0x46, 0x0d, 0x6e, 0x23, 0x7d, 0x3d, 0x5c, 0x16, 0xeb, 0x05, 0x25, 0x96, 0x23, 0x0c, 0x0a,
-	0xcf, 0x2c, 0xc9, 0xf0, 0x4d, 0x2d, 0x49, 0x0c, 0x42, 0x32, 0x42, 0x29, 0x83, 0x4b, 0x14, 0xcd,
-	0xbe, 0xe0, 0xd4, 0xc4, 0x9c, 0xd4, 0x14, 0xea, 0xdb, 0x94, 0xcd, 0x25, 0x81, 0x6e, 0x53, 0x69,
-	0x52, 0x6e, 0x66, 0x49, 0x09, 0x7d, 0x2c, 0x73, 0xce, 0xcf, 0x4b, 0xcb, 0x2c, 0xca, 0xa5, 0x8f,
-	0x65, 0x6e, 0x99, 0x79, 0x89, 0x39, 0x99, 0x55, 0x74, 0xb2, 0x2c, 0xbf, 0x28, 0x3d, 0xbf, 0xa4,
-	0x24, 0x35, 0x8f, 0xfa, 0x96, 0xdd, 0x60, 0xe4, 0x92, 0x82, 0xd8, 0x96, 0x9f, 0x97, 0x96, 0x93,
-	0x99, 0x0c, 0xd2, 0x89, 0xd0, 0x22, 0x14, 0xc7, 0x25, 0x96, 0x8c, 0x90, 0x88, 0xc7, 0xb0, 0x5b,
-	0x9d, 0x48, 0xbb, 0x83, 0x44, 0x93, 0xb1, 0x9a, 0x1f, 0xc5, 0x25, 0x90, 0x93, 0x9f, 0x9c, 0x98,
-	0x83, 0x6c, 0x32, 0x13, 0x79, 0xbe, 0xe2, 0x07, 0x1b, 0x84, 0x90, 0x70, 0xf2, 0x3f, 0xf1, 0x48,
-	0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xd3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd,
-	0xe4, 0xfc, 0x5c, 0x7d, 0xa8, 0x2d, 0xc9, 0x19, 0x89, 0x99, 0x79, 0x30, 0x8e, 0x7e, 0x05, 0x5a,
-	0x3e, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x67, 0x60, 0x63, 0x40, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x47, 0xbc, 0x3a, 0x24, 0x2e, 0x04, 0x00, 0x00,
+	// 445 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xcb, 0x6a, 0xdb, 0x40,
+	0x14, 0x86, 0xad, 0x38, 0xb4, 0xcd, 0x64, 0x91, 0x20, 0x48, 0x11, 0x09, 0x08, 0x63, 0x28, 0x75,
+	0xa0, 0x48, 0x24, 0xa5, 0x0f, 0xe0, 0x98, 0x66, 0xd5, 0x36, 0x20, 0x53, 0x0a, 0x59, 0xd4, 0x8c,
+	0x46, 0xc7, 0xa3, 0x21, 0x73, 0x11, 0x73, 0xb1, 0x9b, 0x3e, 0x45, 0x1f, 0xab, 0xdd, 0x65, 0x99,
+	0x65, 0xb1, 0x5f, 0xa4, 0x48, 0x8e, 0xaa, 0xd4, 0x8d, 0xa1, 0x94, 0xd8, 0x4b, 0x9d, 0x33, 0xff,
+	0xf7, 0xe9, 0x47, 0x68, 0xd0, 0x8b, 0x14, 0xa7, 0xd7, 0x5c, 0xc9, 0x98, 0xe4, 0x40, 0xae, 0x0a,
+	0xc5, 0xa4, 0x65, 0x92, 0xc6, 0x93, 0x93, 0x18, 0x26, 0x20, 0xad, 0x89, 0x0a, 0xad, 0xac, 0xf2,
+	0x83, 0xbb, 0x63, 0xd1, 0x1f, 0xc7, 0xa2, 0xc9, 0xc9, 0xe1, 0xf1, 0x4a, 0x40, 0x33, 0x58, 0x40,
+	0xba, 0x12, 0x1d, 0xbd, 0x2d, 0xa1, 0x83, 0xdf, 0x8b, 0x3e, 0x21, 0x4e, 0x38, 0x8e, 0xcb, 0x88,
+	0x7f, 0x81, 0x50, 0x13, 0x09, 0xbc, 0x8e, 0xd7, 0xdb, 0x3d, 0x8d, 0xa3, 0x55, 0xe2, 0x28, 0xc1,
+	0xd3, 0x06, 0xf4, 0x89, 0xd9, 0xfc, 0x3d, 0x58, 0x9c, 0xdc, 0x43, 0x74, 0x73, 0x74, 0xb0, 0xe4,
+	0x1b, 0x02, 0xe6, 0x90, 0x3d, 0xbe, 0xe9, 0x0a, 0x05, 0xcb, 0x26, 0x97, 0x0a, 0x66, 0xed, 0x66,
+	0x64, 0x03, 0x25, 0xc7, 0x4c, 0x8b, 0xcd, 0xc8, 0xce, 0x99, 0xc4, 0x9c, 0x7d, 0xdd, 0x90, 0x4c,
+	0x69, 0xaa, 0xac, 0x05, 0xf9, 0xf8, 0x32, 0x81, 0x0e, 0x97, 0x64, 0x1f, 0xe5, 0x78, 0x7d, 0xdd,
+	0x1e, 0xd2, 0x91, 0xf5, 0x7d, 0xb7, 0x5b, 0xaf, 0xf6, 0x29, 0x39, 0xe6, 0x8c, 0x94, 0xc9, 0x26,
+	0xe2, 0x7f, 0x46, 0xcf, 0x49, 0xb3, 0x18, 0xfd, 0xe5, 0x7e, 0xf9, 0x8f, 0xee, 0xe4, 0x80, 0x3c,
+	0xc8, 0xbf, 0x44, 0xfb, 0x5c, 0x11, 0xcc, 0xef, 0x93, 0xb7, 0xfe, 0xaf, 0xd5, 0x5e, 0x05, 0x6a,
+	0x16, 0xdd, 0x1f, 0x75, 0xb5, 0x77, 0xd8, 0xc2, 0x19, 0x37, 0x43, 0x46, 0x4d, 0x9f, 0x52, 0x0d,
+	0x14, 0x97, 0xff, 0xdb, 0x11, 0xda, 0x81, 0x42, 0x91, 0x7c, 0x24, 0x9d, 0xa8, 0xda, 0x6c, 0x27,
+	0xcf, 0xaa, 0xc1, 0x07, 0x27, 0xfc, 0x63, 0xb4, 0x6f, 0x18, 0x95, 0xa0, 0x47, 0x38, 0xcb, 0x34,
+	0x18, 0x03, 0x26, 0xd8, 0xea, 0xb4, 0x7b, 0x3b, 0xc9, 0xde, 0x62, 0xde, 0xaf, 0xc7, 0x25, 0xa7,
+	0x50, 0x53, 0xd0, 0x23, 0xe3, 0x44, 0xd0, 0x5e, 0x70, 0xaa, 0xc1, 0xd0, 0x09, 0xff, 0x1c, 0x3d,
+	0x25, 0x1a, 0x32, 0x66, 0x4d, 0xb0, 0xdd, 0x69, 0xf7, 0x76, 0x4f, 0x5f, 0xad, 0xae, 0xb5, 0x78,
+	0xc5, 0x04, 0xa6, 0x58, 0x67, 0x83, 0x2a, 0x94, 0xd4, 0xe1, 0xb3, 0x8b, 0xef, 0xb3, 0xd0, 0xbb,
+	0x99, 0x85, 0xde, 0xcf, 0x59, 0xe8, 0x7d, 0x9b, 0x87, 0xad, 0x9b, 0x79, 0xd8, 0xba, 0x9d, 0x87,
+	0xad, 0xcb, 0x37, 0x94, 0xd9, 0xdc, 0xa5, 0x11, 0x51, 0x22, 0xbe, 0x43, 0x93, 0x1c, 0x33, 0x59,
+	0x3f, 0xc4, 0x5f, 0x96, 0x6e, 0x5c, 0x7b, 0x5d, 0x80, 0x49, 0x9f, 0x54, 0x57, 0xed, 0xeb, 0x5f,
+	0x01, 0x00, 0x00, 0xff, 0xff, 0xea, 0x42, 0x22, 0x09, 0xd8, 0x05, 0x00, 0x00,
 }
 
 func (m *EventCheckpointAccumulating) Marshal() (dAtA []byte, err error) {
@@ -600,6 +779,76 @@ func (m *EventCheckpointForgotten) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *EventCheckpointUnfinalized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCheckpointUnfinalized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCheckpointUnfinalized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Checkpoint != nil {
+		{
+			size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCheckpointUnconfirmed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCheckpointUnconfirmed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCheckpointUnconfirmed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Checkpoint != nil {
+		{
+			size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventConflictingCheckpoint) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -647,6 +896,62 @@ func (m *EventConflictingCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *EventLateBlsSigsAggregated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventLateBlsSigsAggregated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventLateBlsSigsAggregated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Credits) > 0 {
+		for iNdEx := len(m.Credits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Credits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PowerSum != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PowerSum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SignerAddresses) > 0 {
+		for iNdEx := len(m.SignerAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SignerAddresses[iNdEx])
+			copy(dAtA[i:], m.SignerAddresses[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.SignerAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -736,6 +1041,32 @@ func (m *EventCheckpointForgotten) Size() (n int) {
 	return n
 }
 
+func (m *EventCheckpointUnfinalized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Checkpoint != nil {
+		l = m.Checkpoint.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCheckpointUnconfirmed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Checkpoint != nil {
+		l = m.Checkpoint.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventConflictingCheckpoint) Size() (n int) {
 	if m == nil {
 		return 0
@@ -753,6 +1084,33 @@ func (m *EventConflictingCheckpoint) Size() (n int) {
 	return n
 }
 
+func (m *EventLateBlsSigsAggregated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovEvents(uint64(m.EpochNum))
+	}
+	if len(m.SignerAddresses) > 0 {
+		for _, s := range m.SignerAddresses {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.PowerSum != 0 {
+		n += 1 + sovEvents(uint64(m.PowerSum))
+	}
+	if len(m.Credits) > 0 {
+		for _, e := range m.Credits {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1275,7 +1633,7 @@ func (m *EventCheckpointForgotten) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventConflictingCheckpoint) Unmarshal(dAtA []byte) error {
+func (m *EventCheckpointUnfinalized) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1298,15 +1656,15 @@ func (m *EventConflictingCheckpoint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventConflictingCheckpoint: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCheckpointUnfinalized: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventConflictingCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCheckpointUnfinalized: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingCheckpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1333,28 +1691,200 @@ func (m *EventConflictingCheckpoint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConflictingCheckpoint == nil {
-				m.ConflictingCheckpoint = &RawCheckpoint{}
+			if m.Checkpoint == nil {
+				m.Checkpoint = &RawCheckpointWithMeta{}
 			}
-			if err := m.ConflictingCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalCheckpoint", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCheckpointUnconfirmed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCheckpointUnconfirmed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCheckpointUnconfirmed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Checkpoint == nil {
+				m.Checkpoint = &RawCheckpointWithMeta{}
+			}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventConflictingCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventConflictingCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventConflictingCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConflictingCheckpoint == nil {
+				m.ConflictingCheckpoint = &RawCheckpoint{}
+			}
+			if err := m.ConflictingCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1397,6 +1927,160 @@ func (m *EventConflictingCheckpoint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventLateBlsSigsAggregated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventLateBlsSigsAggregated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventLateBlsSigsAggregated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerAddresses = append(m.SignerAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerSum", wireType)
+			}
+			m.PowerSum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PowerSum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credits = append(m.Credits, &BlsSigRewardCredit{})
+			if err := m.Credits[len(m.Credits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/x/evidence/exported"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// RouteConflictingCheckpoint is the route of ConflictingCheckpointEvidence
+	// in the router of the evidence module
+	RouteConflictingCheckpoint = "conflictingcheckpoint"
+)
+
+var _ exported.Evidence = (*ConflictingCheckpointEvidence)(nil)
+
+func NewConflictingCheckpointEvidence(ckpt *RawCheckpoint, valAddr sdk.ValAddress, height int64) *ConflictingCheckpointEvidence {
+	return &ConflictingCheckpointEvidence{
+		ConflictingCheckpoint: ckpt,
+		ValidatorAddress:      valAddr.String(),
+		Height:                height,
+	}
+}
+
+// Route returns the route of the evidence in the evidence module
+func (e *ConflictingCheckpointEvidence) Route() string {
+	return RouteConflictingCheckpoint
+}
+
+// Hash returns the hash of the evidence. A validator can only be punished
+// once per epoch, so the hash only commits to the epoch number and the
+// validator address
+func (e *ConflictingCheckpointEvidence) Hash() []byte {
+	bz := sdk.Uint64ToBigEndian(e.ConflictingCheckpoint.GetEpochNum())
+	bz = append(bz, []byte(e.ValidatorAddress)...)
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic does a sanity check on the evidence
+func (e *ConflictingCheckpointEvidence) ValidateBasic() error {
+	if e.ConflictingCheckpoint == nil {
+		return fmt.Errorf("empty conflicting checkpoint")
+	}
+	if err := e.ConflictingCheckpoint.ValidateBasic(); err != nil {
+		return err
+	}
+	if _, err := sdk.ValAddressFromBech32(e.ValidatorAddress); err != nil {
+		return fmt.Errorf("invalid validator address: %w", err)
+	}
+	if e.Height < 0 {
+		return fmt.Errorf("negative height %d", e.Height)
+	}
+	return nil
+}
+
+// MustGetValidatorAddress returns the address of the validator who signed the
+// conflicting checkpoint
+func (e *ConflictingCheckpointEvidence) MustGetValidatorAddress() sdk.ValAddress {
+	valAddr, err := sdk.ValAddressFromBech32(e.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return valAddr
+}
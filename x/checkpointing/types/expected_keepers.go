@@ -2,7 +2,10 @@ package types
 
 import (
 	"context"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/evidence/exported"
 	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -13,6 +16,7 @@ import (
 // EpochingKeeper defines the expected interface needed to retrieve epoch info
 type EpochingKeeper interface {
 	GetEpoch(ctx context.Context) *epochingtypes.Epoch
+	GetHistoricalEpoch(ctx context.Context, epochNumber uint64) (*epochingtypes.Epoch, error)
 	EnqueueMsg(ctx context.Context, msg epochingtypes.QueuedMessage)
 	GetAppHash(ctx context.Context, height uint64) ([]byte, error)
 	GetValidatorSet(ctx context.Context, epochNumer uint64) epochingtypes.ValidatorSet
@@ -21,6 +25,35 @@ type EpochingKeeper interface {
 	GetPubKeyByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (cmtprotocrypto.PublicKey, error)
 }
 
+// EvidenceKeeper defines the expected interface needed to submit evidence of
+// conflicting checkpoints
+type EvidenceKeeper interface {
+	SubmitEvidence(ctx context.Context, evidence exported.Evidence) error
+}
+
+// StakingKeeper defines the expected interface needed to retrieve the
+// validators to be slashed
+type StakingKeeper interface {
+	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
+}
+
+// SlashingKeeper defines the expected interface needed to slash, jail and
+// tombstone the validators who signed conflicting checkpoints
+type SlashingKeeper interface {
+	IsTombstoned(ctx context.Context, consAddr sdk.ConsAddress) bool
+	Tombstone(ctx context.Context, consAddr sdk.ConsAddress) error
+	SlashWithInfractionReason(ctx context.Context, consAddr sdk.ConsAddress, fraction sdkmath.LegacyDec, power, distributionHeight int64, infraction stakingtypes.Infraction) error
+	SlashFractionDoubleSign(ctx context.Context) (sdkmath.LegacyDec, error)
+	Jail(ctx context.Context, consAddr sdk.ConsAddress) error
+	JailUntil(ctx context.Context, consAddr sdk.ConsAddress, jailTime time.Time) error
+}
+
+// IncentiveKeeper defines the expected interface needed to reward the
+// validators who contributed their BLS sigs to finalized checkpoints
+type IncentiveKeeper interface {
+	RewardBLSSigners(ctx context.Context, epoch uint64, credits []*BlsSigRewardCredit)
+}
+
 // Event Hooks
 // These can be utilized to communicate between a checkpointing keeper and another
 // keeper which must take particular actions when raw checkpoints change
@@ -33,5 +66,6 @@ type CheckpointingHooks interface {
 	AfterRawCheckpointConfirmed(ctx context.Context, epoch uint64) error             // Must be called when a raw checkpoint is CONFIRMED
 	AfterRawCheckpointForgotten(ctx context.Context, ckpt *RawCheckpoint) error      // Must be called when a raw checkpoint is FORGOTTEN
 	AfterRawCheckpointFinalized(ctx context.Context, epoch uint64) error             // Must be called when a raw checkpoint is FINALIZED
+	AfterRawCheckpointUnfinalized(ctx context.Context, epoch uint64) error           // Must be called when a FINALIZED raw checkpoint rolls back upon a BTC reorg
 	AfterRawCheckpointBlsSigVerified(ctx context.Context, ckpt *RawCheckpoint) error // Must be called when a raw checkpoint's multi-sig is verified
 }
@@ -16,12 +16,18 @@ import (
 
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	addresses := make(map[string]struct{}, 0)
 	for _, gk := range gs.GenesisKeys {
 		if _, exists := addresses[gk.ValidatorAddress]; exists {
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	ed25519 "github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -27,6 +28,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// genesis_keys defines the public keys for the genesis validators
 	GenesisKeys []*GenesisKey `protobuf:"bytes,1,rep,name=genesis_keys,json=genesisKeys,proto3" json:"genesis_keys,omitempty"`
+	// params defines all the parameters of the module
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +72,13 @@ func (m *GenesisState) GetGenesisKeys() []*GenesisKey {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 // GenesisKey defines public key information about the genesis validators
 type GenesisKey struct {
 	// validator_address is the address corresponding to a validator
@@ -143,28 +153,30 @@ func init() {
 }
 
 var fileDescriptor_bf2c524ebc9800de = []byte{
-	// 325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xcf, 0x6a, 0x2a, 0x31,
-	0x18, 0xc5, 0xcd, 0x15, 0xbc, 0x18, 0x5d, 0xb4, 0xb3, 0x12, 0xa1, 0x61, 0x90, 0x52, 0x84, 0x42,
-	0x82, 0x16, 0x17, 0x42, 0x37, 0x75, 0xe3, 0xa2, 0x8b, 0x8a, 0x5d, 0x14, 0xba, 0x91, 0x24, 0x13,
-	0xc6, 0x60, 0x9c, 0x0c, 0x93, 0x38, 0x34, 0x6f, 0xd1, 0x67, 0xe9, 0x53, 0x74, 0xe9, 0xb2, 0xcb,
-	0xa2, 0x2f, 0x52, 0x66, 0x26, 0xb5, 0xb4, 0x30, 0xab, 0xfc, 0xf9, 0xce, 0xf9, 0xce, 0xe1, 0x07,
-	0xaf, 0x18, 0x65, 0x4e, 0xe9, 0x84, 0xf0, 0xb5, 0xe0, 0x9b, 0x54, 0xcb, 0xc4, 0xca, 0x24, 0x26,
-	0xf9, 0x88, 0xc4, 0x22, 0x11, 0x46, 0x1a, 0x9c, 0x66, 0xda, 0xea, 0xa0, 0xe7, 0x75, 0xf8, 0x97,
-	0x0e, 0xe7, 0xa3, 0x7e, 0xc8, 0xb5, 0xd9, 0x6a, 0x43, 0x78, 0xe6, 0x52, 0xab, 0x89, 0x88, 0xc6,
-	0x93, 0xc9, 0x68, 0x4a, 0x36, 0xc2, 0x79, 0x6f, 0xbf, 0x3e, 0x83, 0x29, 0xb3, 0xda, 0x08, 0x57,
-	0xe9, 0x06, 0x4f, 0xb0, 0x3b, 0xaf, 0x42, 0x1f, 0x2d, 0xb5, 0x22, 0x98, 0xc3, 0xae, 0x2f, 0x51,
-	0x88, 0x4c, 0x0f, 0x84, 0xcd, 0x61, 0x67, 0x7c, 0x89, 0xeb, 0xaa, 0x60, 0xef, 0xbe, 0x17, 0x6e,
-	0xd9, 0x89, 0x4f, 0x77, 0x33, 0x78, 0x03, 0x10, 0xfe, 0xcc, 0x82, 0x6b, 0x78, 0x9e, 0x53, 0x25,
-	0x23, 0x6a, 0x75, 0xb6, 0xa2, 0x51, 0x94, 0x09, 0x53, 0x2c, 0x07, 0xc3, 0xf6, 0xf2, 0xec, 0x34,
-	0xb8, 0xab, 0xfe, 0x83, 0x29, 0xfc, 0xef, 0x5b, 0xf6, 0xfe, 0x85, 0x60, 0xd8, 0x19, 0x87, 0xf5,
-	0xf9, 0x33, 0x55, 0x66, 0xb7, 0x58, 0x79, 0x06, 0xb7, 0x10, 0xe6, 0x54, 0xad, 0xd2, 0x1d, 0x2b,
-	0xdc, 0xcd, 0xd2, 0x7d, 0x81, 0x2b, 0x5c, 0xb8, 0xc2, 0x85, 0x3d, 0x2e, 0xbc, 0xd8, 0xb1, 0xc2,
-	0xda, 0xce, 0xa9, 0x5a, 0x94, 0xfa, 0xd9, 0xc3, 0xfb, 0x01, 0x81, 0xfd, 0x01, 0x81, 0xcf, 0x03,
-	0x02, 0xaf, 0x47, 0xd4, 0xd8, 0x1f, 0x51, 0xe3, 0xe3, 0x88, 0x1a, 0xcf, 0x93, 0x58, 0xda, 0xf5,
-	0x8e, 0x61, 0xae, 0xb7, 0xc4, 0x77, 0xe1, 0x6b, 0x2a, 0x93, 0xef, 0x07, 0x79, 0xf9, 0x43, 0xda,
-	0xba, 0x54, 0x18, 0xd6, 0x2a, 0x29, 0xdf, 0x7c, 0x05, 0x00, 0x00, 0xff, 0xff, 0x1c, 0xab, 0xd1,
-	0x3a, 0xf3, 0x01, 0x00, 0x00,
+	// 367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0x41, 0x4b, 0xf3, 0x30,
+	0x1c, 0xc6, 0x9b, 0x77, 0x2f, 0x93, 0x65, 0x3b, 0x68, 0xf1, 0x30, 0x06, 0xd6, 0x32, 0x54, 0x06,
+	0x42, 0xc2, 0x26, 0x3b, 0x0c, 0x44, 0x70, 0x97, 0x1d, 0x3c, 0x38, 0xe6, 0xcd, 0xcb, 0x48, 0xda,
+	0xd0, 0x95, 0x75, 0x4d, 0x69, 0xb2, 0x62, 0xbf, 0x85, 0x37, 0xbf, 0x87, 0x9f, 0x62, 0xc7, 0x1d,
+	0x3d, 0x89, 0xac, 0x5f, 0x44, 0x9a, 0xc4, 0x89, 0x42, 0xf1, 0xd4, 0xb4, 0xfd, 0x3d, 0xcf, 0xff,
+	0xf9, 0xe7, 0x81, 0x17, 0x94, 0xd0, 0x3c, 0xe2, 0x31, 0xf6, 0x16, 0xcc, 0x5b, 0x26, 0x3c, 0x8c,
+	0x65, 0x18, 0x07, 0x38, 0xeb, 0xe3, 0x80, 0xc5, 0x4c, 0x84, 0x02, 0x25, 0x29, 0x97, 0xdc, 0x6e,
+	0x1b, 0x0e, 0xfd, 0xe0, 0x50, 0xd6, 0xef, 0x1c, 0x07, 0x3c, 0xe0, 0x0a, 0xc2, 0xe5, 0x49, 0xf3,
+	0x1d, 0xd7, 0xe3, 0x62, 0xc5, 0x05, 0xf6, 0xd2, 0x3c, 0x91, 0x1c, 0x33, 0x7f, 0x30, 0x1c, 0xf6,
+	0x47, 0x78, 0xc9, 0x72, 0xe3, 0xd8, 0xa9, 0x9e, 0x4c, 0x23, 0x31, 0x5f, 0xb2, 0xdc, 0x70, 0xe7,
+	0x95, 0x5c, 0x42, 0x52, 0xb2, 0x32, 0x76, 0xdd, 0x17, 0x00, 0x5b, 0x13, 0x1d, 0xf9, 0x41, 0x12,
+	0xc9, 0xec, 0x09, 0x6c, 0x99, 0x15, 0x4a, 0x33, 0xd1, 0x06, 0x6e, 0xad, 0xd7, 0x1c, 0x9c, 0xa1,
+	0xaa, 0x45, 0x90, 0x51, 0xdf, 0xb1, 0x7c, 0xd6, 0x0c, 0xf6, 0x67, 0x61, 0xdf, 0xc0, 0xba, 0x9e,
+	0xd4, 0xfe, 0xe7, 0x82, 0x5e, 0x73, 0xe0, 0x56, 0x5b, 0x4c, 0x15, 0x37, 0xfe, 0xbf, 0x79, 0x3f,
+	0xb5, 0x66, 0x46, 0xd5, 0x7d, 0x05, 0x10, 0x7e, 0x7b, 0xdb, 0x97, 0xf0, 0x28, 0x23, 0x51, 0xe8,
+	0x13, 0xc9, 0xd3, 0x39, 0xf1, 0xfd, 0x94, 0x89, 0x32, 0x1c, 0xe8, 0x35, 0x66, 0x87, 0xfb, 0x1f,
+	0xb7, 0xfa, 0xbb, 0x3d, 0x82, 0x07, 0xe6, 0x36, 0xfe, 0x1e, 0x3e, 0x8e, 0x54, 0xf6, 0x3a, 0x55,
+	0x4f, 0xfb, 0x1a, 0xc2, 0x8c, 0x44, 0xf3, 0x64, 0x4d, 0x4b, 0x75, 0x4d, 0xa9, 0x4f, 0x90, 0xae,
+	0x05, 0xe9, 0x5a, 0x90, 0xa9, 0x05, 0x4d, 0xd7, 0xb4, 0x94, 0x36, 0x32, 0x12, 0x4d, 0x15, 0x3f,
+	0xbe, 0xdf, 0xec, 0x1c, 0xb0, 0xdd, 0x39, 0xe0, 0x63, 0xe7, 0x80, 0xe7, 0xc2, 0xb1, 0xb6, 0x85,
+	0x63, 0xbd, 0x15, 0x8e, 0xf5, 0x38, 0x0c, 0x42, 0xb9, 0x58, 0x53, 0xe4, 0xf1, 0x15, 0x36, 0x59,
+	0xbc, 0x05, 0x09, 0xe3, 0xaf, 0x17, 0xfc, 0xf4, 0xab, 0x29, 0x99, 0x27, 0x4c, 0xd0, 0xba, 0xaa,
+	0xe9, 0xea, 0x33, 0x00, 0x00, 0xff, 0xff, 0x57, 0xbb, 0xf5, 0x01, 0x71, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -187,6 +199,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.GenesisKeys) > 0 {
 		for iNdEx := len(m.GenesisKeys) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -281,6 +303,8 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -374,6 +398,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -48,6 +48,15 @@ func (h MultiCheckpointingHooks) AfterRawCheckpointFinalized(ctx context.Context
 	return nil
 }
 
+func (h MultiCheckpointingHooks) AfterRawCheckpointUnfinalized(ctx context.Context, epoch uint64) error {
+	for i := range h {
+		if err := h[i].AfterRawCheckpointUnfinalized(ctx, epoch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (h MultiCheckpointingHooks) AfterRawCheckpointBlsSigVerified(ctx context.Context, ckpt *RawCheckpoint) error {
 	for i := range h {
 		if err := h[i].AfterRawCheckpointBlsSigVerified(ctx, ckpt); err != nil {
@@ -32,7 +32,10 @@ var (
 	AddrToBlsKeyPrefix = append(RegistrationPrefix, 0x0) // where we save the concrete BLS public keys
 	BlsKeyToAddrPrefix = append(RegistrationPrefix, 0x1) // where we save BLS key set
 
-	LastFinalizedEpochKey = []byte{0x04} // LastFinalizedEpochKey defines the key to store the last finalised epoch
+	LastFinalizedEpochKey  = []byte{0x04} // LastFinalizedEpochKey defines the key to store the last finalised epoch
+	LateBlsSigPrefix       = []byte{0x05} // reserve this namespace for BLS sigs received after checkpoints are sealed
+	LateBlsSigCreditPrefix = []byte{0x06} // reserve this namespace for reward credits of aggregated late BLS sigs
+	ParamsKey              = []byte{0x07} // key prefix for the parameters
 )
 
 // CkptsObjectKey defines epoch
@@ -58,3 +61,8 @@ func BlsKeyToAddrKey(pk bls12381.PublicKey) []byte {
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+// LateBlsSigKey defines epoch and validator address
+func LateBlsSigKey(epoch uint64, valAddr sdk.ValAddress) []byte {
+	return append(sdk.Uint64ToBigEndian(epoch), valAddr...)
+}
@@ -15,6 +15,8 @@ import (
 var (
 	// Ensure that MsgInsertHeader implements all functions of the Msg interface
 	_ sdk.Msg = (*MsgWrappedCreateValidator)(nil)
+	_ sdk.Msg = (*MsgAddBlsSig)(nil)
+	_ sdk.Msg = (*MsgUpdateParams)(nil)
 )
 
 func NewMsgWrappedCreateValidator(msgCreateVal *stakingtypes.MsgCreateValidator, blsPK *bls12381.PublicKey, pop *ProofOfPossession) (*MsgWrappedCreateValidator, error) {
@@ -54,3 +56,32 @@ func (m *MsgWrappedCreateValidator) ValidateBasic() error {
 func (msg MsgWrappedCreateValidator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return msg.MsgCreateValidator.UnpackInterfaces(unpacker)
 }
+
+// ValidateBasic validates statelesss message elements
+func (m *MsgAddBlsSig) ValidateBasic() error {
+	signer, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return err
+	}
+	if m.BlsSig == nil {
+		return errors.New("BLS sig is nil")
+	}
+	if m.BlsSig.BlockHash == nil || m.BlsSig.BlsSig == nil {
+		return errors.New("BLS sig misses block hash or signature")
+	}
+	if err := m.BlsSig.BlockHash.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := m.BlsSig.BlsSig.ValidateBasic(); err != nil {
+		return err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(m.BlsSig.SignerAddress)
+	if err != nil {
+		return err
+	}
+	// the BLS sig can only be submitted by the validator itself
+	if !signer.Equals(sdk.AccAddress(valAddr)) {
+		return errors.New("signer is not the operator of the validator signing the BLS sig")
+	}
+	return nil
+}
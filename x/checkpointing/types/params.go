@@ -0,0 +1,31 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	DefaultLateBlsSigEpochWindow uint64 = 3
+	MaxLateBlsSigEpochWindow     uint64 = 100
+)
+
+// NewParams creates a new Params instance
+func NewParams(lateBlsSigEpochWindow uint64) Params {
+	return Params{
+		LateBlsSigEpochWindow: lateBlsSigEpochWindow,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(DefaultLateBlsSigEpochWindow)
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if p.LateBlsSigEpochWindow > MaxLateBlsSigEpochWindow {
+		return fmt.Errorf("LateBlsSigEpochWindow must be no larger than %d", MaxLateBlsSigEpochWindow)
+	}
+
+	return nil
+}
@@ -976,6 +976,184 @@ func (m *RawCheckpointWithMetaResponse) GetLifecycle() []*CheckpointStateUpdateR
 	return nil
 }
 
+// QueryBlsSigRewardCreditsRequest is the request type for the
+// Query/BlsSigRewardCredits RPC method.
+type QueryBlsSigRewardCreditsRequest struct {
+	// epoch_num defines the epoch of the checkpoint
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+}
+
+func (m *QueryBlsSigRewardCreditsRequest) Reset()         { *m = QueryBlsSigRewardCreditsRequest{} }
+func (m *QueryBlsSigRewardCreditsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlsSigRewardCreditsRequest) ProtoMessage()    {}
+func (*QueryBlsSigRewardCreditsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{17}
+}
+func (m *QueryBlsSigRewardCreditsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlsSigRewardCreditsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlsSigRewardCreditsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlsSigRewardCreditsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlsSigRewardCreditsRequest.Merge(m, src)
+}
+func (m *QueryBlsSigRewardCreditsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlsSigRewardCreditsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlsSigRewardCreditsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlsSigRewardCreditsRequest proto.InternalMessageInfo
+
+func (m *QueryBlsSigRewardCreditsRequest) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+// QueryBlsSigRewardCreditsResponse is the response type for the
+// Query/BlsSigRewardCredits RPC method.
+type QueryBlsSigRewardCreditsResponse struct {
+	// credits are the reward credits of the validators that contributed their
+	// BLS sigs to the checkpoint, in the order of the epoch's validator set
+	Credits []*BlsSigRewardCredit `protobuf:"bytes,1,rep,name=credits,proto3" json:"credits,omitempty"`
+}
+
+func (m *QueryBlsSigRewardCreditsResponse) Reset()         { *m = QueryBlsSigRewardCreditsResponse{} }
+func (m *QueryBlsSigRewardCreditsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlsSigRewardCreditsResponse) ProtoMessage()    {}
+func (*QueryBlsSigRewardCreditsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{18}
+}
+func (m *QueryBlsSigRewardCreditsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlsSigRewardCreditsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlsSigRewardCreditsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlsSigRewardCreditsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlsSigRewardCreditsResponse.Merge(m, src)
+}
+func (m *QueryBlsSigRewardCreditsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlsSigRewardCreditsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlsSigRewardCreditsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlsSigRewardCreditsResponse proto.InternalMessageInfo
+
+func (m *QueryBlsSigRewardCreditsResponse) GetCredits() []*BlsSigRewardCredit {
+	if m != nil {
+		return m.Credits
+	}
+	return nil
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{19}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{20}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QueryRawCheckpointListRequest)(nil), "babylon.checkpointing.v1.QueryRawCheckpointListRequest")
 	proto.RegisterType((*QueryRawCheckpointListResponse)(nil), "babylon.checkpointing.v1.QueryRawCheckpointListResponse")
@@ -995,6 +1173,10 @@ func init() {
 	proto.RegisterType((*RawCheckpointResponse)(nil), "babylon.checkpointing.v1.RawCheckpointResponse")
 	proto.RegisterType((*CheckpointStateUpdateResponse)(nil), "babylon.checkpointing.v1.CheckpointStateUpdateResponse")
 	proto.RegisterType((*RawCheckpointWithMetaResponse)(nil), "babylon.checkpointing.v1.RawCheckpointWithMetaResponse")
+	proto.RegisterType((*QueryBlsSigRewardCreditsRequest)(nil), "babylon.checkpointing.v1.QueryBlsSigRewardCreditsRequest")
+	proto.RegisterType((*QueryBlsSigRewardCreditsResponse)(nil), "babylon.checkpointing.v1.QueryBlsSigRewardCreditsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.checkpointing.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.checkpointing.v1.QueryParamsResponse")
 }
 
 func init() {
@@ -1002,86 +1184,96 @@ func init() {
 }
 
 var fileDescriptor_113f1ca5c3c2ca44 = []byte{
-	// 1266 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x5d, 0x6f, 0x1b, 0x45,
-	0x17, 0xee, 0xda, 0x49, 0xf4, 0xfa, 0x38, 0xcd, 0x5b, 0x46, 0xa5, 0x35, 0x6e, 0xeb, 0x94, 0xa5,
-	0x94, 0xb4, 0xa8, 0xbb, 0xb2, 0xd3, 0x7c, 0x50, 0xfa, 0x01, 0x2e, 0x81, 0x4a, 0xfd, 0x20, 0x6c,
-	0x68, 0x91, 0x90, 0xe8, 0x32, 0xbb, 0x99, 0xae, 0x17, 0xaf, 0x77, 0x37, 0x9e, 0x59, 0x27, 0x56,
-	0xa9, 0x90, 0xe0, 0x0f, 0x54, 0x42, 0xe2, 0x8a, 0x7f, 0xc0, 0x0d, 0xdc, 0x71, 0xcd, 0x55, 0x25,
-	0x10, 0xaa, 0x84, 0x90, 0x10, 0x48, 0x50, 0x25, 0x88, 0xdf, 0x81, 0x76, 0x76, 0x1c, 0x7b, 0x6d,
-	0xaf, 0x1d, 0x3b, 0xb9, 0xe1, 0x2e, 0x3e, 0x7b, 0xce, 0xcc, 0x73, 0x9e, 0xf3, 0x31, 0x4f, 0xe0,
-	0x8c, 0x81, 0x8d, 0xa6, 0xe3, 0xb9, 0xaa, 0x59, 0x21, 0x66, 0xd5, 0xf7, 0x6c, 0x97, 0xd9, 0xae,
-	0xa5, 0x36, 0x8a, 0xea, 0x46, 0x40, 0xea, 0x4d, 0xc5, 0xaf, 0x7b, 0xcc, 0x43, 0x39, 0xe1, 0xa5,
-	0xc4, 0xbc, 0x94, 0x46, 0x31, 0x7f, 0xd4, 0xf2, 0x2c, 0x8f, 0x3b, 0xa9, 0xe1, 0x5f, 0x91, 0x7f,
-	0xfe, 0xa4, 0xe5, 0x79, 0x96, 0x43, 0x54, 0xec, 0xdb, 0x2a, 0x76, 0x5d, 0x8f, 0x61, 0x66, 0x7b,
-	0x2e, 0x15, 0x5f, 0x67, 0xc5, 0x57, 0xfe, 0xcb, 0x08, 0x1e, 0xa8, 0xcc, 0xae, 0x11, 0xca, 0x70,
-	0xcd, 0x17, 0x0e, 0x67, 0x13, 0x41, 0x19, 0x0e, 0xd5, 0xab, 0x44, 0xc0, 0xca, 0x9f, 0x4b, 0xf4,
-	0x6b, 0x1b, 0x84, 0xeb, 0x79, 0xd3, 0xa3, 0x35, 0x8f, 0xaa, 0x06, 0xa6, 0x24, 0x4a, 0x4d, 0x6d,
-	0x14, 0x0d, 0xc2, 0x70, 0x51, 0xf5, 0xb1, 0x65, 0xbb, 0x1c, 0x60, 0xe4, 0x2b, 0x7f, 0x23, 0xc1,
-	0xa9, 0xf7, 0x42, 0x17, 0x0d, 0x6f, 0x5e, 0xdf, 0x3d, 0xe8, 0x96, 0x4d, 0x99, 0x46, 0x36, 0x02,
-	0x42, 0x19, 0x2a, 0xc3, 0x14, 0x65, 0x98, 0x05, 0x34, 0x27, 0x9d, 0x96, 0xe6, 0x66, 0x4a, 0xe7,
-	0x95, 0x24, 0x82, 0x94, 0xf6, 0x01, 0x6b, 0x3c, 0x42, 0x13, 0x91, 0xe8, 0x6d, 0x80, 0xf6, 0xcd,
-	0xb9, 0xd4, 0x69, 0x69, 0x2e, 0x5b, 0x3a, 0xab, 0x44, 0x30, 0x95, 0x10, 0xa6, 0x12, 0x55, 0x40,
-	0xc0, 0x54, 0x56, 0xb1, 0x45, 0xc4, 0xfd, 0x5a, 0x47, 0xa4, 0xfc, 0xa3, 0x04, 0x85, 0x24, 0xb4,
-	0xd4, 0xf7, 0x5c, 0x4a, 0xd0, 0xc7, 0xf0, 0xff, 0x3a, 0xde, 0xd4, 0xdb, 0xd8, 0x42, 0xdc, 0xe9,
-	0xb9, 0x6c, 0x69, 0x29, 0x19, 0x77, 0xec, 0xb4, 0x0f, 0x6c, 0x56, 0xb9, 0x4d, 0x18, 0x6e, 0x9d,
-	0xa8, 0xcd, 0xd4, 0x3b, 0x3f, 0x53, 0xf4, 0x4e, 0x9f, 0x64, 0x5e, 0x19, 0x9a, 0x8c, 0x38, 0xac,
-	0x33, 0x9b, 0x65, 0x78, 0xa1, 0x37, 0x99, 0x16, 0xed, 0x27, 0x20, 0x43, 0x7c, 0xcf, 0xac, 0xe8,
-	0x6e, 0x50, 0xe3, 0xcc, 0x4f, 0x68, 0xff, 0xe3, 0x86, 0x3b, 0x41, 0x4d, 0xfe, 0x14, 0xf2, 0xfd,
-	0x22, 0x05, 0x05, 0xf7, 0x61, 0x26, 0x4e, 0x01, 0x8f, 0xdf, 0x07, 0x03, 0x87, 0x63, 0x0c, 0xc8,
-	0xeb, 0xfd, 0x6e, 0xa7, 0x2d, 0xe0, 0xf1, 0x5a, 0x4b, 0x63, 0xd7, 0xfa, 0x89, 0x04, 0x27, 0xfa,
-	0x5e, 0xf3, 0xdf, 0x2b, 0xf4, 0x17, 0x12, 0x9c, 0xe4, 0xa9, 0x94, 0x1d, 0xba, 0x1a, 0x18, 0x8e,
-	0x6d, 0xde, 0x24, 0xcd, 0xce, 0x19, 0x1b, 0x54, 0xec, 0x03, 0x1b, 0x9e, 0x9f, 0x5b, 0xa3, 0xde,
-	0x8b, 0x42, 0x50, 0xba, 0x0e, 0xc7, 0x1b, 0xd8, 0xb1, 0xd7, 0x31, 0xf3, 0xea, 0xfa, 0xa6, 0xcd,
-	0x2a, 0xba, 0xd8, 0x41, 0x2d, 0x6a, 0x2f, 0x24, 0x53, 0x7b, 0xaf, 0x15, 0x18, 0xd2, 0x5a, 0x76,
-	0xe8, 0x4d, 0xd2, 0xd4, 0x8e, 0x36, 0x7a, 0x8d, 0x07, 0x48, 0xeb, 0x22, 0x1c, 0xe7, 0xf9, 0xac,
-	0x84, 0x4c, 0x89, 0x8d, 0xb3, 0x97, 0xe9, 0xb9, 0x0f, 0xb9, 0xde, 0x38, 0x41, 0xc1, 0x01, 0x6c,
-	0x3b, 0x79, 0x05, 0xe4, 0xa8, 0x71, 0x89, 0x49, 0x5c, 0xd6, 0x71, 0xcb, 0x75, 0x2f, 0x68, 0x0f,
-	0xf8, 0x2c, 0x64, 0x23, 0x88, 0x66, 0x68, 0x15, 0x20, 0x81, 0x9b, 0xb8, 0x9f, 0xfc, 0x55, 0x0a,
-	0x5e, 0x1a, 0x78, 0x8e, 0x80, 0x7c, 0x02, 0x32, 0xcc, 0xf6, 0x75, 0x1e, 0xd9, 0xca, 0x95, 0xd9,
-	0x3e, 0xf7, 0xef, 0xbe, 0x25, 0xd5, 0x7d, 0x0b, 0xda, 0x80, 0xe9, 0x08, 0xb6, 0xf0, 0x48, 0xf3,
-	0x42, 0xdf, 0x49, 0x4e, 0x7b, 0x0f, 0x90, 0x94, 0x0e, 0xdb, 0x8a, 0xcb, 0xea, 0x4d, 0x2d, 0x4b,
-	0xdb, 0x96, 0xfc, 0x55, 0x38, 0xd2, 0xed, 0x80, 0x8e, 0x40, 0xba, 0x4a, 0x9a, 0x1c, 0x7e, 0x46,
-	0x0b, 0xff, 0x44, 0x47, 0x61, 0xb2, 0x81, 0x9d, 0x80, 0x08, 0xcc, 0xd1, 0x8f, 0x4b, 0xa9, 0x65,
-	0x49, 0xfe, 0x04, 0xce, 0x70, 0x10, 0xb7, 0x30, 0x65, 0xf1, 0x71, 0x8e, 0x37, 0xc1, 0x41, 0xd4,
-	0xf2, 0x33, 0x78, 0x79, 0xc8, 0x5d, 0xa2, 0x0a, 0xf7, 0x12, 0x96, 0xae, 0xba, 0xc7, 0x6d, 0x94,
-	0xb4, 0x6c, 0x7f, 0x95, 0xe0, 0xf9, 0xfe, 0x6b, 0x7e, 0xe0, 0xd2, 0x38, 0x03, 0x33, 0x86, 0xe3,
-	0x99, 0x55, 0xbd, 0x82, 0x69, 0x45, 0xaf, 0x90, 0x2d, 0x4e, 0x63, 0x46, 0x9b, 0xe6, 0xd6, 0x1b,
-	0x98, 0x56, 0x6e, 0x90, 0x2d, 0x74, 0x0c, 0xa6, 0x0c, 0x9b, 0xd5, 0xb0, 0x9f, 0x4b, 0x9f, 0x96,
-	0xe6, 0xa6, 0x35, 0xf1, 0x0b, 0x61, 0x38, 0x1c, 0x4e, 0x7e, 0x2d, 0x70, 0x98, 0xad, 0x53, 0xdb,
-	0xca, 0x4d, 0x84, 0x9f, 0xcb, 0x57, 0x7e, 0xff, 0x73, 0xf6, 0x35, 0xcb, 0x66, 0x95, 0xc0, 0x50,
-	0x4c, 0xaf, 0xa6, 0x8a, 0xcc, 0xcc, 0x0a, 0xb6, 0x5d, 0x75, 0x57, 0x9f, 0xd4, 0x9b, 0x3e, 0xf3,
-	0x42, 0xf5, 0x52, 0x2c, 0xcd, 0x2f, 0x17, 0x95, 0x35, 0xdb, 0x72, 0x31, 0x0b, 0xea, 0x44, 0xcb,
-	0x1a, 0x0e, 0xbd, 0x1d, 0x1e, 0xb9, 0x66, 0x5b, 0xf2, 0x3f, 0x12, 0x9c, 0x8a, 0xb3, 0x4e, 0xee,
-	0xfa, 0xeb, 0x98, 0xed, 0x8e, 0x3a, 0x7a, 0x03, 0x26, 0xc3, 0x22, 0x90, 0x31, 0xaa, 0x17, 0x05,
-	0x86, 0xcd, 0x2f, 0x7a, 0x7b, 0x9d, 0x50, 0x53, 0x30, 0x00, 0x91, 0xe9, 0x2d, 0x42, 0x4d, 0xf4,
-	0x22, 0x4c, 0x0b, 0x96, 0x88, 0x6d, 0x55, 0x18, 0x67, 0x61, 0x22, 0xc4, 0x19, 0x72, 0xc4, 0x4d,
-	0xe8, 0x1a, 0x40, 0xe4, 0x12, 0x0a, 0x37, 0xce, 0x43, 0xb6, 0x94, 0x57, 0x22, 0x55, 0xa7, 0xb4,
-	0x54, 0x9d, 0xf2, 0x7e, 0x4b, 0xd5, 0x95, 0x27, 0x1e, 0xff, 0x35, 0x2b, 0x69, 0x19, 0x1e, 0x13,
-	0x5a, 0xe5, 0xaf, 0xd3, 0x70, 0x6a, 0xe0, 0xbb, 0x83, 0xae, 0xc3, 0x84, 0x59, 0xf5, 0xc7, 0x6e,
-	0x18, 0x1e, 0xdc, 0xd1, 0xec, 0xa9, 0xb1, 0x65, 0x5a, 0x17, 0x5f, 0xe9, 0x1e, 0xbe, 0x3e, 0x82,
-	0xb0, 0x86, 0x3a, 0xb6, 0xac, 0xba, 0xee, 0x57, 0xf7, 0xd3, 0x15, 0xbb, 0x0f, 0x50, 0x48, 0x15,
-	0x7d, 0xd3, 0xb2, 0xea, 0xab, 0xd5, 0xb0, 0xa3, 0x7d, 0x6f, 0x93, 0xd4, 0x75, 0x1a, 0xd4, 0x72,
-	0x93, 0x51, 0x47, 0x73, 0xc3, 0x5a, 0x50, 0x43, 0x77, 0x21, 0xe3, 0xd8, 0x0f, 0x88, 0xd9, 0x34,
-	0x1d, 0x92, 0x9b, 0x1a, 0xf6, 0xd2, 0x0f, 0x6c, 0x2d, 0xad, 0x7d, 0x52, 0xe9, 0x19, 0xc0, 0x24,
-	0x9f, 0x70, 0xf4, 0x83, 0x04, 0xcf, 0xf5, 0xe8, 0x4a, 0xb4, 0x34, 0x6c, 0x13, 0x26, 0xe8, 0xe6,
-	0xfc, 0xf2, 0xe8, 0x81, 0x11, 0x3a, 0xf9, 0xd2, 0xe7, 0xbf, 0xfc, 0xfd, 0x65, 0xea, 0x22, 0x2a,
-	0xa9, 0x89, 0x9a, 0xbf, 0x4b, 0xf9, 0xa8, 0x0f, 0xa3, 0x22, 0x3d, 0x42, 0xdf, 0x4b, 0x70, 0x38,
-	0x76, 0x32, 0x9a, 0x1f, 0x05, 0x47, 0x0b, 0xfc, 0xc5, 0xd1, 0x82, 0x04, 0xf0, 0xcb, 0x1c, 0xf8,
-	0x22, 0xba, 0xb8, 0x57, 0xe0, 0xea, 0xc3, 0xdd, 0x0d, 0xf6, 0x08, 0x7d, 0x2b, 0xc1, 0x4c, 0x5c,
-	0xeb, 0xa1, 0x91, 0x60, 0xb4, 0xf6, 0x7e, 0x7e, 0x61, 0xc4, 0x28, 0x81, 0xbe, 0xc8, 0xd1, 0xbf,
-	0x8a, 0xce, 0xed, 0x99, 0xf6, 0xb0, 0x65, 0x8e, 0x74, 0xab, 0x29, 0xb4, 0x38, 0xe4, 0xfa, 0x04,
-	0x11, 0x98, 0x5f, 0x1a, 0x39, 0x4e, 0x00, 0xbf, 0xc2, 0x81, 0x2f, 0xa1, 0x05, 0x75, 0xe0, 0xff,
-	0x92, 0x3e, 0x0f, 0xe6, 0x72, 0x2e, 0xc6, 0xfb, 0x77, 0x12, 0x64, 0x3b, 0x5e, 0x72, 0x54, 0x1c,
-	0x82, 0xa3, 0x57, 0x6e, 0xe5, 0x4b, 0xa3, 0x84, 0x08, 0xd4, 0xaf, 0x73, 0xd4, 0x0b, 0x68, 0x3e,
-	0x19, 0x35, 0x07, 0x19, 0x03, 0xab, 0x8a, 0x4d, 0xf5, 0x93, 0x04, 0xc7, 0xfa, 0x6b, 0x10, 0x74,
-	0x79, 0x4c, 0xe9, 0x12, 0x65, 0x72, 0x65, 0x5f, 0xc2, 0x47, 0x5e, 0xe0, 0x49, 0xa9, 0xe8, 0xc2,
-	0xb0, 0xa4, 0x2e, 0x75, 0x8a, 0x2e, 0xf4, 0x87, 0x04, 0xb9, 0x24, 0x85, 0x81, 0xae, 0x0e, 0x81,
-	0x34, 0x44, 0x06, 0xe5, 0xaf, 0x8d, 0x1d, 0x2f, 0x92, 0xba, 0xca, 0x93, 0x5a, 0x46, 0x8b, 0xc9,
-	0x49, 0x39, 0x98, 0x32, 0xbd, 0x7b, 0xb6, 0xc5, 0x4e, 0x2a, 0xbf, 0xfb, 0x64, 0xbb, 0x20, 0x3d,
-	0xdd, 0x2e, 0x48, 0xcf, 0xb6, 0x0b, 0xd2, 0xe3, 0x9d, 0xc2, 0xa1, 0xa7, 0x3b, 0x85, 0x43, 0xbf,
-	0xed, 0x14, 0x0e, 0x7d, 0xb8, 0x30, 0xec, 0xd9, 0xd8, 0xea, 0xba, 0x8a, 0x35, 0x7d, 0x42, 0x8d,
-	0x29, 0xfe, 0xee, 0xce, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xee, 0xf6, 0x49, 0x5b, 0xd1, 0x11,
+	// 1426 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x5b, 0x6f, 0xdc, 0xc4,
+	0x17, 0x8f, 0x73, 0xfb, 0xff, 0x73, 0x36, 0x09, 0x65, 0x1a, 0xda, 0x65, 0xdb, 0x26, 0xc1, 0xb4,
+	0x25, 0x2d, 0xad, 0xad, 0xdd, 0x34, 0x97, 0x86, 0x36, 0x85, 0x0d, 0x29, 0x95, 0x7a, 0x21, 0x38,
+	0xb4, 0x48, 0x48, 0xd4, 0xcc, 0x7a, 0xa7, 0x5e, 0x77, 0xbd, 0x6b, 0xd7, 0x33, 0x4e, 0xba, 0x2a,
+	0x15, 0x12, 0x7c, 0x00, 0x2a, 0x21, 0xf1, 0xc4, 0x37, 0xe0, 0x05, 0xde, 0x78, 0xe6, 0xa9, 0x12,
+	0xa8, 0xaa, 0x84, 0x90, 0xb8, 0x48, 0x05, 0xb5, 0x88, 0xcf, 0x81, 0x3c, 0x1e, 0x67, 0xd7, 0xbb,
+	0xeb, 0xec, 0xa5, 0x79, 0xe1, 0x6d, 0x7d, 0x7c, 0xce, 0x99, 0xdf, 0xf9, 0xcd, 0x99, 0x39, 0x3f,
+	0x2f, 0x1c, 0x2d, 0xe0, 0x42, 0xcd, 0x76, 0xaa, 0xaa, 0x51, 0x22, 0x46, 0xd9, 0x75, 0xac, 0x2a,
+	0xb3, 0xaa, 0xa6, 0xba, 0x95, 0x55, 0xef, 0xf8, 0xc4, 0xab, 0x29, 0xae, 0xe7, 0x30, 0x07, 0xa5,
+	0x85, 0x97, 0x12, 0xf3, 0x52, 0xb6, 0xb2, 0x99, 0x29, 0xd3, 0x31, 0x1d, 0xee, 0xa4, 0x06, 0xbf,
+	0x42, 0xff, 0xcc, 0x61, 0xd3, 0x71, 0x4c, 0x9b, 0xa8, 0xd8, 0xb5, 0x54, 0x5c, 0xad, 0x3a, 0x0c,
+	0x33, 0xcb, 0xa9, 0x52, 0xf1, 0x76, 0x46, 0xbc, 0xe5, 0x4f, 0x05, 0xff, 0x96, 0xca, 0xac, 0x0a,
+	0xa1, 0x0c, 0x57, 0x5c, 0xe1, 0x70, 0x3c, 0x11, 0x54, 0xc1, 0xa6, 0x7a, 0x99, 0x08, 0x58, 0x99,
+	0x13, 0x89, 0x7e, 0x75, 0x83, 0x70, 0x3d, 0x96, 0xe8, 0xea, 0x62, 0x0f, 0x57, 0x22, 0x68, 0x27,
+	0x0d, 0x87, 0x56, 0x1c, 0xaa, 0x16, 0x30, 0x25, 0x21, 0x03, 0xea, 0x56, 0xb6, 0x40, 0x18, 0x0e,
+	0xfc, 0x4c, 0xab, 0xca, 0xeb, 0x08, 0x7d, 0xe5, 0x6f, 0x24, 0x38, 0xf2, 0x5e, 0xe0, 0xa2, 0xe1,
+	0xed, 0xb5, 0x9d, 0xac, 0x57, 0x2c, 0xca, 0x34, 0x72, 0xc7, 0x27, 0x94, 0xa1, 0x3c, 0x8c, 0x52,
+	0x86, 0x99, 0x4f, 0xd3, 0xd2, 0xac, 0x34, 0x37, 0x99, 0x3b, 0xa9, 0x24, 0xf1, 0xa8, 0xd4, 0x13,
+	0x6c, 0xf2, 0x08, 0x4d, 0x44, 0xa2, 0x8b, 0x00, 0xf5, 0x95, 0xd3, 0x83, 0xb3, 0xd2, 0x5c, 0x2a,
+	0x77, 0x5c, 0x09, 0x61, 0x2a, 0x01, 0x4c, 0x25, 0xdc, 0x28, 0x01, 0x53, 0xd9, 0xc0, 0x26, 0x11,
+	0xeb, 0x6b, 0x0d, 0x91, 0xf2, 0x8f, 0x12, 0x4c, 0x27, 0xa1, 0xa5, 0xae, 0x53, 0xa5, 0x04, 0x7d,
+	0x0c, 0x2f, 0x78, 0x78, 0x5b, 0xaf, 0x63, 0x0b, 0x70, 0x0f, 0xcd, 0xa5, 0x72, 0x4b, 0xc9, 0xb8,
+	0x63, 0xd9, 0x3e, 0xb0, 0x58, 0xe9, 0x2a, 0x61, 0x38, 0xca, 0xa8, 0x4d, 0x7a, 0x8d, 0xaf, 0x29,
+	0x7a, 0xa7, 0x4d, 0x31, 0xaf, 0x75, 0x2c, 0x46, 0x24, 0x6b, 0xac, 0x66, 0x19, 0x5e, 0x6e, 0x2d,
+	0x26, 0xa2, 0xfd, 0x10, 0x8c, 0x11, 0xd7, 0x31, 0x4a, 0x7a, 0xd5, 0xaf, 0x70, 0xe6, 0x87, 0xb5,
+	0xff, 0x73, 0xc3, 0x35, 0xbf, 0x22, 0x7f, 0x02, 0x99, 0x76, 0x91, 0x82, 0x82, 0x9b, 0x30, 0x19,
+	0xa7, 0x80, 0xc7, 0x3f, 0x07, 0x03, 0x13, 0x31, 0x06, 0xe4, 0x62, 0xbb, 0xd5, 0x69, 0x04, 0x3c,
+	0xbe, 0xd7, 0x52, 0xdf, 0x7b, 0xfd, 0x50, 0x82, 0x43, 0x6d, 0x97, 0xf9, 0xef, 0x6d, 0xf4, 0xe7,
+	0x12, 0x1c, 0xe6, 0xa5, 0xe4, 0x6d, 0xba, 0xe1, 0x17, 0x6c, 0xcb, 0xb8, 0x4c, 0x6a, 0x8d, 0x67,
+	0x6c, 0xb7, 0xcd, 0xde, 0xb3, 0xc3, 0xf3, 0x28, 0x3a, 0xea, 0xad, 0x28, 0x04, 0xa5, 0x45, 0x38,
+	0xb8, 0x85, 0x6d, 0xab, 0x88, 0x99, 0xe3, 0xe9, 0xdb, 0x16, 0x2b, 0xe9, 0xe2, 0xaa, 0x8a, 0xa8,
+	0x3d, 0x9d, 0x4c, 0xed, 0x8d, 0x28, 0x30, 0xa0, 0x35, 0x6f, 0xd3, 0xcb, 0xa4, 0xa6, 0x4d, 0x6d,
+	0xb5, 0x1a, 0xf7, 0x90, 0xd6, 0x45, 0x38, 0xc8, 0xeb, 0x59, 0x0f, 0x98, 0x12, 0x37, 0x4e, 0x37,
+	0xa7, 0xe7, 0x26, 0xa4, 0x5b, 0xe3, 0x04, 0x05, 0x7b, 0x70, 0xdb, 0xc9, 0xeb, 0x20, 0x87, 0x8d,
+	0x4b, 0x0c, 0x52, 0x65, 0x0d, 0xab, 0xac, 0x39, 0x7e, 0xfd, 0x80, 0xcf, 0x40, 0x2a, 0x84, 0x68,
+	0x04, 0x56, 0x01, 0x12, 0xb8, 0x89, 0xfb, 0xc9, 0x5f, 0x0d, 0xc2, 0xab, 0xbb, 0xe6, 0x11, 0x90,
+	0x0f, 0xc1, 0x18, 0xb3, 0x5c, 0x9d, 0x47, 0x46, 0xb5, 0x32, 0xcb, 0xe5, 0xfe, 0xcd, 0xab, 0x0c,
+	0x36, 0xaf, 0x82, 0xee, 0xc0, 0x78, 0x08, 0x5b, 0x78, 0x0c, 0xf1, 0x8d, 0xbe, 0x96, 0x5c, 0x76,
+	0x17, 0x90, 0x94, 0x06, 0xdb, 0x7a, 0x95, 0x79, 0x35, 0x2d, 0x45, 0xeb, 0x96, 0xcc, 0x2a, 0xec,
+	0x6b, 0x76, 0x40, 0xfb, 0x60, 0xa8, 0x4c, 0x6a, 0x1c, 0xfe, 0x98, 0x16, 0xfc, 0x44, 0x53, 0x30,
+	0xb2, 0x85, 0x6d, 0x9f, 0x08, 0xcc, 0xe1, 0xc3, 0xca, 0xe0, 0xb2, 0x24, 0xdf, 0x86, 0xa3, 0x1c,
+	0xc4, 0x15, 0x4c, 0x59, 0xfc, 0x38, 0xc7, 0x9b, 0x60, 0x2f, 0xf6, 0xf2, 0x53, 0x38, 0xd6, 0x61,
+	0x2d, 0xb1, 0x0b, 0x37, 0x12, 0x2e, 0x5d, 0xb5, 0xcb, 0xdb, 0x28, 0xe9, 0xb2, 0xfd, 0x45, 0x82,
+	0x97, 0xda, 0x5f, 0xf3, 0xbb, 0x5e, 0x1a, 0x47, 0x61, 0xb2, 0x60, 0x3b, 0x46, 0x59, 0x2f, 0x61,
+	0x5a, 0xd2, 0x4b, 0xe4, 0x2e, 0xa7, 0x71, 0x4c, 0x1b, 0xe7, 0xd6, 0x4b, 0x98, 0x96, 0x2e, 0x91,
+	0xbb, 0xe8, 0x00, 0x8c, 0x16, 0x2c, 0x56, 0xc1, 0x6e, 0x7a, 0x68, 0x56, 0x9a, 0x1b, 0xd7, 0xc4,
+	0x13, 0xc2, 0x30, 0x11, 0x9c, 0xfc, 0x8a, 0x6f, 0x33, 0x4b, 0xa7, 0x96, 0x99, 0x1e, 0x0e, 0x5e,
+	0xe7, 0xcf, 0xff, 0xfe, 0x64, 0xe6, 0xac, 0x69, 0xb1, 0x92, 0x5f, 0x50, 0x0c, 0xa7, 0xa2, 0x8a,
+	0xca, 0x8c, 0x12, 0xb6, 0xaa, 0xea, 0x8e, 0x36, 0xf1, 0x6a, 0x2e, 0x73, 0x02, 0x91, 0x93, 0xcd,
+	0xcd, 0x2f, 0x67, 0x95, 0x4d, 0xcb, 0xac, 0x62, 0xe6, 0x7b, 0x44, 0x4b, 0x15, 0x6c, 0x7a, 0x35,
+	0x48, 0xb9, 0x69, 0x99, 0xf2, 0x3f, 0x12, 0x1c, 0x89, 0xb3, 0x4e, 0xae, 0xbb, 0x45, 0xcc, 0x76,
+	0x8e, 0x3a, 0x7a, 0x13, 0x46, 0x82, 0x4d, 0x20, 0x7d, 0xec, 0x5e, 0x18, 0x18, 0x34, 0xbf, 0xe8,
+	0xed, 0x22, 0xa1, 0x86, 0x60, 0x00, 0x42, 0xd3, 0xdb, 0x84, 0x1a, 0xe8, 0x15, 0x18, 0x17, 0x2c,
+	0x11, 0xcb, 0x2c, 0x31, 0xce, 0xc2, 0xb0, 0x96, 0x0a, 0x39, 0xe2, 0x26, 0x74, 0x01, 0x20, 0x74,
+	0x09, 0xf4, 0x1d, 0xe7, 0x21, 0x95, 0xcb, 0x28, 0xa1, 0xf8, 0x53, 0x22, 0xf1, 0xa7, 0xbc, 0x1f,
+	0x89, 0xbf, 0xfc, 0xf0, 0x83, 0x3f, 0x67, 0x24, 0x6d, 0x8c, 0xc7, 0x04, 0x56, 0xf9, 0xeb, 0x21,
+	0x38, 0xb2, 0xeb, 0xdc, 0x41, 0x6b, 0x30, 0x6c, 0x94, 0xdd, 0xbe, 0x1b, 0x86, 0x07, 0x37, 0x34,
+	0xfb, 0x60, 0xdf, 0x32, 0xad, 0x89, 0xaf, 0xa1, 0x16, 0xbe, 0x3e, 0x82, 0x60, 0x0f, 0x75, 0x6c,
+	0x9a, 0x9e, 0xee, 0x96, 0x9f, 0xa7, 0x2b, 0x76, 0x06, 0x50, 0x40, 0x15, 0x7d, 0xcb, 0x34, 0xbd,
+	0x8d, 0x72, 0xd0, 0xd1, 0xae, 0xb3, 0x4d, 0x3c, 0x9d, 0xfa, 0x95, 0xf4, 0x48, 0xd8, 0xd1, 0xdc,
+	0xb0, 0xe9, 0x57, 0xd0, 0x75, 0x18, 0xb3, 0xad, 0x5b, 0xc4, 0xa8, 0x19, 0x36, 0x49, 0x8f, 0x76,
+	0x9a, 0xf4, 0xbb, 0xb6, 0x96, 0x56, 0xcf, 0x24, 0xaf, 0xc2, 0x4c, 0x34, 0x14, 0x37, 0x2d, 0x53,
+	0x23, 0xdb, 0xd8, 0x2b, 0xae, 0x79, 0xa4, 0x68, 0xb1, 0xee, 0x86, 0xc9, 0x6d, 0x98, 0x4d, 0x8e,
+	0x17, 0x1b, 0x7c, 0x11, 0xfe, 0x67, 0x84, 0x26, 0x31, 0x47, 0x4f, 0x25, 0x03, 0x6f, 0xcd, 0xa3,
+	0x45, 0xc1, 0xf2, 0x14, 0x20, 0xbe, 0xd6, 0x06, 0x57, 0xfb, 0x02, 0x9e, 0x7c, 0x1d, 0xf6, 0xc7,
+	0xac, 0x62, 0xd1, 0x55, 0x18, 0x0d, 0xbf, 0x0a, 0x44, 0x5f, 0xcd, 0x26, 0xaf, 0x19, 0x46, 0xe6,
+	0x87, 0x1f, 0x3e, 0x99, 0x19, 0xd0, 0x44, 0x54, 0xee, 0xd1, 0x04, 0x8c, 0xf0, 0xbc, 0xe8, 0x07,
+	0x09, 0x5e, 0x6c, 0x11, 0xdc, 0x68, 0xa9, 0xd3, 0x88, 0x48, 0xf8, 0xa0, 0xc8, 0x2c, 0xf7, 0x1e,
+	0x18, 0x96, 0x24, 0xaf, 0x7c, 0xf6, 0xf3, 0xdf, 0x5f, 0x0e, 0x9e, 0x41, 0x39, 0x35, 0xf1, 0x43,
+	0xa8, 0x49, 0x12, 0xaa, 0xf7, 0xc2, 0xee, 0xbd, 0x8f, 0xbe, 0x97, 0x60, 0x22, 0x96, 0x19, 0xcd,
+	0xf7, 0x82, 0x23, 0x02, 0x7f, 0xa6, 0xb7, 0x20, 0x01, 0xfc, 0x1c, 0x07, 0xbe, 0x88, 0xce, 0x74,
+	0x0b, 0x5c, 0xbd, 0xb7, 0xd3, 0x71, 0xf7, 0xd1, 0xb7, 0x12, 0x4c, 0x6a, 0x71, 0x69, 0xda, 0x13,
+	0x8c, 0xa8, 0x53, 0x32, 0x0b, 0x3d, 0x46, 0x09, 0xf4, 0x59, 0x8e, 0xfe, 0x75, 0x74, 0xa2, 0x6b,
+	0xda, 0x83, 0x96, 0xd9, 0xd7, 0x2c, 0x33, 0xd1, 0x62, 0x87, 0xe5, 0x13, 0xd4, 0x71, 0x66, 0xa9,
+	0xe7, 0x38, 0x01, 0xfc, 0x3c, 0x07, 0xbe, 0x84, 0x16, 0xd4, 0x5d, 0xbf, 0xc5, 0x5d, 0x1e, 0xcc,
+	0x75, 0x6e, 0x8c, 0xf7, 0xef, 0x24, 0x48, 0x35, 0x48, 0x1c, 0x94, 0xed, 0x80, 0xa3, 0x55, 0x87,
+	0x66, 0x72, 0xbd, 0x84, 0x08, 0xd4, 0x6f, 0x70, 0xd4, 0x0b, 0x68, 0x3e, 0x19, 0x35, 0x07, 0x19,
+	0x03, 0xab, 0x8a, 0x2b, 0xfc, 0x27, 0x09, 0x0e, 0xb4, 0x17, 0x67, 0xe8, 0x5c, 0x9f, 0x9a, 0x2e,
+	0xac, 0xe4, 0xfc, 0x73, 0x29, 0x42, 0x79, 0x81, 0x17, 0xa5, 0xa2, 0xd3, 0x9d, 0x8a, 0x5a, 0x69,
+	0x54, 0xa3, 0xe8, 0x0f, 0x09, 0xd2, 0x49, 0xd2, 0x0b, 0xad, 0x76, 0x80, 0xd4, 0x41, 0x1f, 0x66,
+	0x2e, 0xf4, 0x1d, 0x2f, 0x8a, 0x5a, 0xe5, 0x45, 0x2d, 0xa3, 0xc5, 0xe4, 0xa2, 0x6c, 0x4c, 0x99,
+	0xde, 0x7c, 0xb6, 0xa3, 0x3b, 0xe9, 0x37, 0x09, 0xf6, 0xb7, 0x99, 0x1b, 0xe8, 0x6c, 0xe7, 0x86,
+	0x4f, 0x98, 0x55, 0x99, 0x95, 0x7e, 0x42, 0x45, 0x39, 0x97, 0x79, 0x39, 0xeb, 0x68, 0xad, 0xa7,
+	0xc6, 0x0b, 0x4e, 0x10, 0xb5, 0x4c, 0xdd, 0xe3, 0x39, 0x75, 0x31, 0xab, 0xd0, 0x17, 0x12, 0x8c,
+	0x86, 0x73, 0x05, 0x9d, 0xea, 0x80, 0x29, 0x36, 0xce, 0x32, 0xa7, 0xbb, 0xf4, 0x16, 0xa0, 0xe7,
+	0x38, 0x68, 0x19, 0xcd, 0xaa, 0x1d, 0xfe, 0x1c, 0xcb, 0xbf, 0xfb, 0xf0, 0xe9, 0xb4, 0xf4, 0xf8,
+	0xe9, 0xb4, 0xf4, 0xd7, 0xd3, 0x69, 0xe9, 0xc1, 0xb3, 0xe9, 0x81, 0xc7, 0xcf, 0xa6, 0x07, 0x7e,
+	0x7d, 0x36, 0x3d, 0xf0, 0xe1, 0x42, 0x27, 0xf5, 0x72, 0xb7, 0x29, 0x29, 0xab, 0xb9, 0x84, 0x16,
+	0x46, 0xb9, 0xfc, 0x9b, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0x92, 0x21, 0x90, 0x67, 0x7f, 0x14,
 	0x00, 0x00,
 }
 
@@ -1114,6 +1306,11 @@ type QueryClient interface {
 	// LastCheckpointWithStatus queries the last checkpoint with a given status or
 	// a more matured status
 	LastCheckpointWithStatus(ctx context.Context, in *QueryLastCheckpointWithStatusRequest, opts ...grpc.CallOption) (*QueryLastCheckpointWithStatusResponse, error)
+	// BlsSigRewardCredits queries the reward credits of the validators that
+	// contributed their BLS sigs to the checkpoint of a given epoch
+	BlsSigRewardCredits(ctx context.Context, in *QueryBlsSigRewardCreditsRequest, opts ...grpc.CallOption) (*QueryBlsSigRewardCreditsResponse, error)
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -1187,6 +1384,24 @@ func (c *queryClient) LastCheckpointWithStatus(ctx context.Context, in *QueryLas
 	return out, nil
 }
 
+func (c *queryClient) BlsSigRewardCredits(ctx context.Context, in *QueryBlsSigRewardCreditsRequest, opts ...grpc.CallOption) (*QueryBlsSigRewardCreditsResponse, error) {
+	out := new(QueryBlsSigRewardCreditsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/BlsSigRewardCredits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// RawCheckpointList queries all checkpoints that match the given status.
@@ -1206,6 +1421,11 @@ type QueryServer interface {
 	// LastCheckpointWithStatus queries the last checkpoint with a given status or
 	// a more matured status
 	LastCheckpointWithStatus(context.Context, *QueryLastCheckpointWithStatusRequest) (*QueryLastCheckpointWithStatusResponse, error)
+	// BlsSigRewardCredits queries the reward credits of the validators that
+	// contributed their BLS sigs to the checkpoint of a given epoch
+	BlsSigRewardCredits(context.Context, *QueryBlsSigRewardCreditsRequest) (*QueryBlsSigRewardCreditsResponse, error)
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1233,6 +1453,12 @@ func (*UnimplementedQueryServer) RecentEpochStatusCount(ctx context.Context, req
 func (*UnimplementedQueryServer) LastCheckpointWithStatus(ctx context.Context, req *QueryLastCheckpointWithStatusRequest) (*QueryLastCheckpointWithStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LastCheckpointWithStatus not implemented")
 }
+func (*UnimplementedQueryServer) BlsSigRewardCredits(ctx context.Context, req *QueryBlsSigRewardCreditsRequest) (*QueryBlsSigRewardCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlsSigRewardCredits not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1364,6 +1590,42 @@ func _Query_LastCheckpointWithStatus_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BlsSigRewardCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlsSigRewardCreditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlsSigRewardCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/BlsSigRewardCredits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlsSigRewardCredits(ctx, req.(*QueryBlsSigRewardCreditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.checkpointing.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1396,6 +1658,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LastCheckpointWithStatus",
 			Handler:    _Query_LastCheckpointWithStatus_Handler,
 		},
+		{
+			MethodName: "BlsSigRewardCredits",
+			Handler:    _Query_BlsSigRewardCredits_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/checkpointing/v1/query.proto",
@@ -2105,6 +2375,127 @@ func (m *RawCheckpointWithMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBlsSigRewardCreditsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlsSigRewardCreditsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlsSigRewardCreditsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlsSigRewardCreditsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlsSigRewardCreditsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlsSigRewardCreditsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Credits) > 0 {
+		for iNdEx := len(m.Credits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Credits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2407,11 +2798,58 @@ func (m *RawCheckpointWithMetaResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryBlsSigRewardCreditsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryBlsSigRewardCreditsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Credits) > 0 {
+		for _, e := range m.Credits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *QueryRawCheckpointListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -4338,6 +4776,292 @@ func (m *RawCheckpointWithMetaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBlsSigRewardCreditsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlsSigRewardCreditsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlsSigRewardCreditsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlsSigRewardCreditsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlsSigRewardCreditsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlsSigRewardCreditsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credits = append(m.Credits, &BlsSigRewardCredit{})
+			if err := m.Credits[len(m.Credits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	types "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -107,38 +108,243 @@ func (m *MsgWrappedCreateValidatorResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWrappedCreateValidatorResponse proto.InternalMessageInfo
 
+// MsgAddBlsSig defines a message to add a BLS signature on the checkpoint of
+// a recent epoch
+type MsgAddBlsSig struct {
+	// signer is the account address of the validator's operator
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// bls_sig is the BLS signature of the validator on the checkpoint
+	BlsSig *BlsSig `protobuf:"bytes,2,opt,name=bls_sig,json=blsSig,proto3" json:"bls_sig,omitempty"`
+}
+
+func (m *MsgAddBlsSig) Reset()         { *m = MsgAddBlsSig{} }
+func (m *MsgAddBlsSig) String() string { return proto.CompactTextString(m) }
+func (*MsgAddBlsSig) ProtoMessage()    {}
+func (*MsgAddBlsSig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b16c54750152c21, []int{2}
+}
+func (m *MsgAddBlsSig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddBlsSig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddBlsSig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddBlsSig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddBlsSig.Merge(m, src)
+}
+func (m *MsgAddBlsSig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddBlsSig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddBlsSig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddBlsSig proto.InternalMessageInfo
+
+func (m *MsgAddBlsSig) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgAddBlsSig) GetBlsSig() *BlsSig {
+	if m != nil {
+		return m.BlsSig
+	}
+	return nil
+}
+
+// MsgAddBlsSigResponse defines the MsgAddBlsSig response type
+type MsgAddBlsSigResponse struct {
+}
+
+func (m *MsgAddBlsSigResponse) Reset()         { *m = MsgAddBlsSigResponse{} }
+func (m *MsgAddBlsSigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddBlsSigResponse) ProtoMessage()    {}
+func (*MsgAddBlsSigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b16c54750152c21, []int{3}
+}
+func (m *MsgAddBlsSigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddBlsSigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddBlsSigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddBlsSigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddBlsSigResponse.Merge(m, src)
+}
+func (m *MsgAddBlsSigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddBlsSigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddBlsSigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddBlsSigResponse proto.InternalMessageInfo
+
+// MsgUpdateParams defines a message for updating checkpointing module
+// parameters.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the checkpointing parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b16c54750152c21, []int{4}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse is the response to the MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b16c54750152c21, []int{5}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgWrappedCreateValidator)(nil), "babylon.checkpointing.v1.MsgWrappedCreateValidator")
 	proto.RegisterType((*MsgWrappedCreateValidatorResponse)(nil), "babylon.checkpointing.v1.MsgWrappedCreateValidatorResponse")
+	proto.RegisterType((*MsgAddBlsSig)(nil), "babylon.checkpointing.v1.MsgAddBlsSig")
+	proto.RegisterType((*MsgAddBlsSigResponse)(nil), "babylon.checkpointing.v1.MsgAddBlsSigResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.checkpointing.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.checkpointing.v1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("babylon/checkpointing/v1/tx.proto", fileDescriptor_6b16c54750152c21) }
 
 var fileDescriptor_6b16c54750152c21 = []byte{
-	// 357 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x3d, 0x4b, 0xfb, 0x40,
-	0x1c, 0xc7, 0x73, 0xff, 0xf2, 0x77, 0x38, 0xb7, 0x50, 0xb4, 0x66, 0x48, 0x1f, 0x04, 0x91, 0x0e,
-	0x77, 0xb4, 0xc5, 0x45, 0xb7, 0x3a, 0x4a, 0x11, 0x3a, 0x28, 0x88, 0x50, 0x2e, 0xe9, 0x71, 0x0d,
-	0x79, 0xb8, 0x90, 0xdf, 0x59, 0x9a, 0x4d, 0x9c, 0xc4, 0xc9, 0xd5, 0xad, 0x2f, 0xa1, 0x2f, 0xc3,
-	0xb1, 0xa3, 0xa3, 0xb4, 0x43, 0x7d, 0x19, 0xd2, 0x24, 0x45, 0xac, 0xde, 0xe2, 0x76, 0x0f, 0x9f,
-	0xdf, 0xf7, 0x81, 0x3b, 0x5c, 0x77, 0x98, 0x93, 0x06, 0x32, 0xa2, 0xee, 0x88, 0xbb, 0x7e, 0x2c,
-	0xbd, 0x48, 0x79, 0x91, 0xa0, 0xe3, 0x16, 0x55, 0x13, 0x12, 0x27, 0x52, 0x49, 0xb3, 0x52, 0x20,
-	0xe4, 0x1b, 0x42, 0xc6, 0x2d, 0xab, 0x2c, 0xa4, 0x90, 0x19, 0x44, 0xd7, 0xab, 0x9c, 0xb7, 0x8e,
-	0xb4, 0x92, 0x4e, 0x00, 0x03, 0x9f, 0xa7, 0x05, 0x57, 0x75, 0x25, 0x84, 0x12, 0x28, 0x28, 0xe6,
-	0xe7, 0x80, 0xc3, 0x15, 0xfb, 0x32, 0xb6, 0xf6, 0x0b, 0x20, 0x84, 0x6c, 0x3a, 0x04, 0x91, 0x5f,
-	0x34, 0xe6, 0x08, 0x1f, 0xf4, 0x40, 0x5c, 0x27, 0x2c, 0x8e, 0xf9, 0xf0, 0x3c, 0xe1, 0x4c, 0xf1,
-	0x2b, 0x16, 0x78, 0x43, 0xa6, 0x64, 0x62, 0xb6, 0x71, 0xc9, 0xe7, 0x69, 0x05, 0xd5, 0xd0, 0xf1,
-	0x6e, 0xbb, 0x46, 0x74, 0xe9, 0x49, 0x37, 0x80, 0x0b, 0x9e, 0xf6, 0xd7, 0xb0, 0x79, 0x8b, 0xcb,
-	0x21, 0x88, 0x81, 0x9b, 0x49, 0x0d, 0xc6, 0x1b, 0xad, 0xca, 0xbf, 0x4c, 0xa4, 0x49, 0xf2, 0x24,
-	0xa4, 0x88, 0x4a, 0x8a, 0xa8, 0xa4, 0x07, 0x62, 0xcb, 0xbd, 0x6f, 0x86, 0x3f, 0xce, 0x4e, 0xeb,
-	0x8f, 0xd3, 0xaa, 0xf1, 0x31, 0xad, 0x1a, 0x0f, 0xab, 0x59, 0xf3, 0x57, 0xa3, 0xc6, 0x21, 0xae,
-	0x6b, 0x1b, 0xf5, 0x39, 0xc4, 0x32, 0x02, 0xde, 0x7e, 0x41, 0xb8, 0xd4, 0x03, 0x61, 0x3e, 0x21,
-	0xbc, 0xa7, 0x29, 0xdf, 0xd1, 0xf7, 0xd5, 0xea, 0x5b, 0x67, 0x7f, 0x18, 0xda, 0x84, 0xb2, 0xfe,
-	0xdf, 0xaf, 0x66, 0x4d, 0xd4, 0xbd, 0x7c, 0x5d, 0xd8, 0x68, 0xbe, 0xb0, 0xd1, 0xfb, 0xc2, 0x46,
-	0xcf, 0x4b, 0xdb, 0x98, 0x2f, 0x6d, 0xe3, 0x6d, 0x69, 0x1b, 0x37, 0x27, 0xc2, 0x53, 0xa3, 0x3b,
-	0x87, 0xb8, 0x32, 0xa4, 0x85, 0x8f, 0x3b, 0x62, 0x5e, 0xb4, 0xd9, 0xd0, 0xc9, 0xd6, 0x4f, 0x51,
-	0x69, 0xcc, 0xc1, 0xd9, 0xc9, 0xde, 0xba, 0xf3, 0x19, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x29, 0x9a,
-	0x31, 0xa2, 0x02, 0x00, 0x00,
+	// 567 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x8b, 0xd3, 0x4e,
+	0x14, 0xc7, 0x93, 0xdd, 0xdf, 0xaf, 0xd2, 0x71, 0x71, 0x21, 0x94, 0xdd, 0x36, 0x87, 0x74, 0x5b,
+	0x71, 0x71, 0x0b, 0x26, 0xb4, 0x8b, 0x82, 0x15, 0x84, 0xad, 0x47, 0x29, 0x4a, 0x8a, 0x0a, 0x22,
+	0x94, 0x49, 0x32, 0x4c, 0x87, 0x26, 0x99, 0x90, 0x37, 0x5b, 0xb6, 0x37, 0xf1, 0x24, 0x9e, 0xbc,
+	0x7a, 0xdb, 0x3f, 0x61, 0x0f, 0xfe, 0x11, 0x7b, 0x2c, 0x9e, 0x3c, 0x89, 0xb4, 0x87, 0xf5, 0xe0,
+	0x1f, 0x21, 0x4d, 0x26, 0xed, 0x6e, 0x35, 0x6b, 0xf1, 0x94, 0xcc, 0xcc, 0xe7, 0x7d, 0xdf, 0xf7,
+	0xbd, 0x37, 0x0c, 0xaa, 0x39, 0xd8, 0x19, 0xfb, 0x3c, 0xb4, 0xdc, 0x01, 0x71, 0x87, 0x11, 0x67,
+	0xa1, 0x60, 0x21, 0xb5, 0x46, 0x4d, 0x4b, 0x9c, 0x98, 0x51, 0xcc, 0x05, 0xd7, 0xca, 0x12, 0x31,
+	0xaf, 0x20, 0xe6, 0xa8, 0xa9, 0x97, 0x28, 0xa7, 0x3c, 0x81, 0xac, 0xf9, 0x5f, 0xca, 0xeb, 0xfb,
+	0xb9, 0x92, 0x8e, 0x0f, 0xfd, 0x21, 0x19, 0x4b, 0xee, 0x20, 0x97, 0x5b, 0x6e, 0x48, 0xf4, 0x4e,
+	0x2e, 0x1a, 0xe1, 0x18, 0x07, 0x20, 0xb1, 0xaa, 0xcb, 0x21, 0xe0, 0x60, 0x81, 0xc0, 0xc3, 0xf4,
+	0xdc, 0x21, 0x02, 0x2f, 0x4b, 0xd1, 0x2b, 0x29, 0xd0, 0x4f, 0x3d, 0xa7, 0x0b, 0x79, 0xb4, 0x2b,
+	0x63, 0x03, 0x48, 0x74, 0x03, 0xa0, 0xe9, 0x41, 0x7d, 0xa2, 0xa2, 0x4a, 0x17, 0xe8, 0xab, 0x18,
+	0x47, 0x11, 0xf1, 0x9e, 0xc4, 0x04, 0x0b, 0xf2, 0x12, 0xfb, 0xcc, 0xc3, 0x82, 0xc7, 0x5a, 0x0b,
+	0x6d, 0x0e, 0xc9, 0xb8, 0xac, 0xee, 0xa9, 0x77, 0x6f, 0xb6, 0xf6, 0xcc, 0xbc, 0x56, 0x99, 0x1d,
+	0x1f, 0x9e, 0x92, 0xb1, 0x3d, 0x87, 0xb5, 0x37, 0xa8, 0x14, 0x00, 0xed, 0xbb, 0x89, 0x54, 0x7f,
+	0x94, 0x69, 0x95, 0x37, 0x12, 0x91, 0x86, 0x29, 0x7d, 0xc9, 0x2a, 0x4c, 0x59, 0x85, 0xd9, 0x05,
+	0xba, 0x92, 0xdd, 0xd6, 0x82, 0xdf, 0xf6, 0xda, 0xb5, 0xf7, 0xa7, 0x55, 0xe5, 0xc7, 0x69, 0x55,
+	0x79, 0x77, 0x71, 0xd6, 0xf8, 0x63, 0xa2, 0xfa, 0x6d, 0x54, 0xcb, 0xad, 0xc8, 0x26, 0x10, 0xf1,
+	0x10, 0x48, 0x3d, 0x46, 0x5b, 0x5d, 0xa0, 0x47, 0x9e, 0xd7, 0xf1, 0xa1, 0xc7, 0xa8, 0xb6, 0x83,
+	0x0a, 0xc0, 0x68, 0x48, 0xe2, 0xa4, 0xd8, 0xa2, 0x2d, 0x57, 0xda, 0x43, 0x74, 0x63, 0x3e, 0x57,
+	0x60, 0xb4, 0xbc, 0xb1, 0x46, 0x17, 0x7a, 0x8c, 0xda, 0x05, 0x27, 0xf9, 0xb6, 0xb7, 0x33, 0x9b,
+	0x52, 0xab, 0xbe, 0x83, 0x4a, 0x97, 0x73, 0x2e, 0xbc, 0x7c, 0x52, 0xd1, 0x76, 0x17, 0xe8, 0x8b,
+	0xc8, 0xc3, 0x82, 0x3c, 0x4f, 0x46, 0xae, 0x3d, 0x40, 0x45, 0x7c, 0x2c, 0x06, 0x3c, 0x66, 0x22,
+	0xed, 0x7f, 0xb1, 0x53, 0xfe, 0xf2, 0xf9, 0x5e, 0x49, 0x76, 0xef, 0xc8, 0xf3, 0x62, 0x02, 0xd0,
+	0x13, 0x31, 0x0b, 0xa9, 0xbd, 0x44, 0xb5, 0xc7, 0xa8, 0x90, 0x5e, 0x9a, 0xbf, 0xdb, 0x4d, 0x33,
+	0x75, 0xfe, 0x3b, 0xff, 0x56, 0x55, 0x6c, 0x19, 0xd5, 0xbe, 0x35, 0x37, 0xbc, 0xd4, 0xab, 0x57,
+	0xd0, 0xee, 0x8a, 0xb5, 0xcc, 0x76, 0xeb, 0xe7, 0x06, 0xda, 0xec, 0x02, 0xd5, 0x3e, 0xa8, 0x68,
+	0x27, 0xe7, 0xfe, 0x1c, 0xe6, 0x67, 0xcf, 0x1d, 0x91, 0xfe, 0xe8, 0x1f, 0x82, 0x32, 0x53, 0x9a,
+	0x8b, 0x8a, 0xcb, 0xa1, 0xee, 0x5f, 0xab, 0xb4, 0xe0, 0x74, 0x73, 0x3d, 0x6e, 0x91, 0xc4, 0x47,
+	0x5b, 0x57, 0x86, 0x75, 0x70, 0x6d, 0xfc, 0x65, 0x54, 0x6f, 0xae, 0x8d, 0x66, 0xd9, 0xf4, 0xff,
+	0xdf, 0x5e, 0x9c, 0x35, 0xd4, 0xce, 0xb3, 0xf3, 0xa9, 0xa1, 0x4e, 0xa6, 0x86, 0xfa, 0x7d, 0x6a,
+	0xa8, 0x1f, 0x67, 0x86, 0x32, 0x99, 0x19, 0xca, 0xd7, 0x99, 0xa1, 0xbc, 0xbe, 0x4f, 0x99, 0x18,
+	0x1c, 0x3b, 0xa6, 0xcb, 0x03, 0x4b, 0xaa, 0xbb, 0x03, 0xcc, 0xc2, 0x6c, 0x61, 0x9d, 0xac, 0xbc,
+	0x2c, 0x62, 0x1c, 0x11, 0x70, 0x0a, 0xc9, 0x0b, 0x70, 0xf8, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x21,
+	0x3d, 0x2c, 0x2b, 0x25, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -155,6 +361,13 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// WrappedCreateValidator defines a method for registering a new validator
 	WrappedCreateValidator(ctx context.Context, in *MsgWrappedCreateValidator, opts ...grpc.CallOption) (*MsgWrappedCreateValidatorResponse, error)
+	// AddBlsSig adds a BLS signature of a validator on the checkpoint of a
+	// recent epoch that the validator missed, e.g., due to downtime. The BLS
+	// signature is aggregated into the checkpoint if the checkpoint is still
+	// sealed, i.e., not submitted to BTC yet.
+	AddBlsSig(ctx context.Context, in *MsgAddBlsSig, opts ...grpc.CallOption) (*MsgAddBlsSigResponse, error)
+	// UpdateParams updates the checkpointing module parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -174,10 +387,35 @@ func (c *msgClient) WrappedCreateValidator(ctx context.Context, in *MsgWrappedCr
 	return out, nil
 }
 
+func (c *msgClient) AddBlsSig(ctx context.Context, in *MsgAddBlsSig, opts ...grpc.CallOption) (*MsgAddBlsSigResponse, error) {
+	out := new(MsgAddBlsSigResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Msg/AddBlsSig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// WrappedCreateValidator defines a method for registering a new validator
 	WrappedCreateValidator(context.Context, *MsgWrappedCreateValidator) (*MsgWrappedCreateValidatorResponse, error)
+	// AddBlsSig adds a BLS signature of a validator on the checkpoint of a
+	// recent epoch that the validator missed, e.g., due to downtime. The BLS
+	// signature is aggregated into the checkpoint if the checkpoint is still
+	// sealed, i.e., not submitted to BTC yet.
+	AddBlsSig(context.Context, *MsgAddBlsSig) (*MsgAddBlsSigResponse, error)
+	// UpdateParams updates the checkpointing module parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -187,6 +425,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) WrappedCreateValidator(ctx context.Context, req *MsgWrappedCreateValidator) (*MsgWrappedCreateValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WrappedCreateValidator not implemented")
 }
+func (*UnimplementedMsgServer) AddBlsSig(ctx context.Context, req *MsgAddBlsSig) (*MsgAddBlsSigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBlsSig not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -210,6 +454,42 @@ func _Msg_WrappedCreateValidator_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddBlsSig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddBlsSig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddBlsSig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Msg/AddBlsSig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddBlsSig(ctx, req.(*MsgAddBlsSig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.checkpointing.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -218,6 +498,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WrappedCreateValidator",
 			Handler:    _Msg_WrappedCreateValidator_Handler,
 		},
+		{
+			MethodName: "AddBlsSig",
+			Handler:    _Msg_AddBlsSig_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/checkpointing/v1/tx.proto",
@@ -293,6 +581,134 @@ func (m *MsgWrappedCreateValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgAddBlsSig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddBlsSig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddBlsSig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlsSig != nil {
+		{
+			size, err := m.BlsSig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddBlsSigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddBlsSigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddBlsSigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -330,13 +746,63 @@ func (m *MsgWrappedCreateValidatorResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgAddBlsSig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BlsSig != nil {
+		l = m.BlsSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func (m *MsgWrappedCreateValidator) Unmarshal(dAtA []byte) error {
+
+func (m *MsgAddBlsSigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgWrappedCreateValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -508,6 +974,339 @@ func (m *MsgWrappedCreateValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgAddBlsSig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddBlsSig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddBlsSig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsSig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlsSig == nil {
+				m.BlsSig = &BlsSig{}
+			}
+			if err := m.BlsSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddBlsSigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddBlsSigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddBlsSigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
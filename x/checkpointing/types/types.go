@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/boljen/go-bitmap"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -63,6 +64,54 @@ func (cm *RawCheckpointWithMeta) Accumulate(
 		return ErrCkptNotAccumulating
 	}
 
+	if err := cm.aggregate(vals, signerAddr, signerBlsKey, sig); err != nil {
+		return err
+	}
+
+	// update status when the threshold is reached
+	if int64(cm.PowerSum)*3 > totalPower*2 {
+		cm.Status = Sealed
+	}
+
+	return nil
+}
+
+// AddLateSig aggregates a BLS sig into a sealed checkpoint. This allows
+// validators that missed the checkpoint, e.g., due to downtime, to contribute
+// to it before it is submitted to BTC.
+func (cm *RawCheckpointWithMeta) AddLateSig(
+	vals epochingtypes.ValidatorSet,
+	signerAddr sdk.ValAddress,
+	signerBlsKey bls12381.PublicKey,
+	sig bls12381.Signature) error {
+
+	// the checkpoint should be sealed
+	if cm.Status != Sealed {
+		return ErrInvalidCkptStatus.Wrapf("late BLS sigs can only be added to a sealed checkpoint")
+	}
+
+	return cm.aggregate(vals, signerAddr, signerBlsKey, sig)
+}
+
+// BlsSigRewardCreditOf returns the reward credit of a BLS sig aggregated the
+// given number of epochs late. The full credit of BLS sigs aggregated before
+// the checkpoint is sealed is halved for every epoch of delay.
+func BlsSigRewardCreditOf(epochsLate uint64) sdkmath.LegacyDec {
+	credit := sdkmath.LegacyOneDec()
+	for i := uint64(0); i < epochsLate; i++ {
+		credit = credit.QuoInt64(2)
+	}
+	return credit
+}
+
+// aggregate aggregates the BLS sig of the signer into the checkpoint and
+// accumulates the signer's voting power
+func (cm *RawCheckpointWithMeta) aggregate(
+	vals epochingtypes.ValidatorSet,
+	signerAddr sdk.ValAddress,
+	signerBlsKey bls12381.PublicKey,
+	sig bls12381.Signature) error {
+
 	// get validator and its index
 	val, index, err := vals.FindValidatorWithIndex(signerAddr)
 	if err != nil {
@@ -99,11 +148,8 @@ func (cm *RawCheckpointWithMeta) Accumulate(
 	// update bitmap
 	bitmap.Set(cm.Ckpt.Bitmap, index, true)
 
-	// accumulate voting power and update status when the threshold is reached
+	// accumulate voting power
 	cm.PowerSum += uint64(val.Power)
-	if int64(cm.PowerSum)*3 > totalPower*2 {
-		cm.Status = Sealed
-	}
 
 	return nil
 }
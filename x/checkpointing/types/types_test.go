@@ -5,8 +5,10 @@ import (
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
+	"github.com/babylonchain/babylon/crypto/bls12381"
 	"github.com/babylonchain/babylon/testutil/datagen"
 	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
 	"github.com/babylonchain/babylon/x/checkpointing/types"
@@ -63,3 +65,57 @@ func TestRawCheckpointWithMeta_Accumulate4(t *testing.T) {
 		}
 	}
 }
+
+// 4 validators, where the last one adds its BLS sig after the checkpoint is sealed
+func TestRawCheckpointWithMeta_AddLateSig(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	epochNum := uint64(2)
+	n := 4
+	totalPower := int64(10) * int64(n)
+	ckptkeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, nil, nil)
+	blockHash := datagen.GenRandomBlockHash(r)
+	msg := types.GetSignBytes(epochNum, blockHash)
+	blsPubkeys, blsSigs := datagen.GenRandomPubkeysAndSigs(n, msg)
+	ckpt, err := ckptkeeper.BuildRawCheckpoint(ctx, epochNum, blockHash)
+	require.NoError(t, err)
+	valSet := datagen.GenRandomValSet(n)
+
+	// late sigs are not accepted before the checkpoint is sealed
+	err = ckpt.AddLateSig(valSet, valSet[0].Addr, blsPubkeys[0], blsSigs[0])
+	require.ErrorIs(t, err, types.ErrInvalidCkptStatus)
+
+	for i := 0; i < n-1; i++ {
+		err = ckpt.Accumulate(valSet, valSet[i].Addr, blsPubkeys[i], blsSigs[i], totalPower)
+		require.NoError(t, err)
+	}
+	require.Equal(t, types.Sealed, ckpt.Status)
+	powerSum := ckpt.PowerSum
+
+	err = ckpt.AddLateSig(valSet, valSet[n-1].Addr, blsPubkeys[n-1], blsSigs[n-1])
+	require.NoError(t, err)
+	require.Equal(t, types.Sealed, ckpt.Status)
+	require.Equal(t, powerSum+uint64(valSet[n-1].Power), ckpt.PowerSum)
+
+	// the aggregated sig is valid over all validators
+	aggrPK, err := bls12381.AggrPKList(blsPubkeys)
+	require.NoError(t, err)
+	require.True(t, aggrPK.Equal(*ckpt.BlsAggrPk))
+	valid, err := bls12381.Verify(*ckpt.Ckpt.BlsMultiSig, aggrPK, msg)
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	// the same validator cannot add its BLS sig twice
+	err = ckpt.AddLateSig(valSet, valSet[n-1].Addr, blsPubkeys[n-1], blsSigs[n-1])
+	require.ErrorIs(t, err, types.ErrCkptAlreadyVoted)
+}
+
+func TestBlsSigRewardCreditOf(t *testing.T) {
+	require.True(t, types.BlsSigRewardCreditOf(0).Equal(sdkmath.LegacyOneDec()))
+	require.True(t, types.BlsSigRewardCreditOf(1).Equal(sdkmath.LegacyNewDecWithPrec(5, 1)))
+	require.True(t, types.BlsSigRewardCreditOf(types.DefaultLateBlsSigEpochWindow).Equal(sdkmath.LegacyNewDecWithPrec(125, 3)))
+
+	// the credit decays with the number of epochs of delay
+	for i := uint64(0); i < types.DefaultLateBlsSigEpochWindow; i++ {
+		require.True(t, types.BlsSigRewardCreditOf(i+1).LT(types.BlsSigRewardCreditOf(i)))
+	}
+}
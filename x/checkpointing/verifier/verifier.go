@@ -0,0 +1,73 @@
+// Package verifier verifies raw checkpoints of Babylon against the validator
+// sets of their epochs. It does not access the Babylon state, so that
+// programs such as wallets and bridges can verify checkpoints with validator
+// sets obtained elsewhere, e.g., via the BlsPublicKeyList query.
+package verifier
+
+import (
+	"fmt"
+
+	txformat "github.com/babylonchain/babylon/btctxformatter"
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+// HasQuorum returns whether the signers' voting power is more than 2/3 of
+// the total voting power
+func HasQuorum(signerPower, totalPower uint64) bool {
+	return signerPower*3 > totalPower*2
+}
+
+// VerifyRawCheckpoint verifies that the raw checkpoint is signed by
+// validators with more than 2/3 of the voting power of the given validator
+// set, which has to be the validator set of the checkpoint's epoch ordered
+// as in Babylon
+func VerifyRawCheckpoint(ckpt *types.RawCheckpoint, valSet *types.ValidatorWithBlsKeySet) error {
+	if ckpt == nil {
+		return types.ErrInvalidRawCheckpoint.Wrap("empty raw checkpoint")
+	}
+	if err := ckpt.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// filter validators that contribute to the BLS multisig
+	signerSet, signerPower, err := valSet.FindSubsetWithPowerSum(ckpt.Bitmap)
+	if err != nil {
+		return types.ErrInvalidRawCheckpoint.Wrapf("failed to get the signer set via bitmap of epoch %d: %v", ckpt.EpochNum, err)
+	}
+
+	return VerifyMultiSig(ckpt, signerSet.GetBLSKeySet(), signerPower, valSet.GetTotalPower())
+}
+
+// VerifyBTCCheckpoint decodes the raw checkpoint from the checkpoint on BTC
+// and verifies it against the given validator set
+func VerifyBTCCheckpoint(btcCkpt *txformat.RawBtcCheckpoint, valSet *types.ValidatorWithBlsKeySet) (*types.RawCheckpoint, error) {
+	ckpt, err := types.FromBTCCkptToRawCkpt(btcCkpt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode raw checkpoint from BTC raw checkpoint: %w", err)
+	}
+	if err := VerifyRawCheckpoint(ckpt, valSet); err != nil {
+		return nil, err
+	}
+	return ckpt, nil
+}
+
+// VerifyMultiSig verifies the BLS multisig of the raw checkpoint given the
+// BLS public keys and the voting power of its signers, and the total voting
+// power of the checkpoint's epoch
+func VerifyMultiSig(ckpt *types.RawCheckpoint, signerBlsKeys []bls12381.PublicKey, signerPower, totalPower uint64) error {
+	if !HasQuorum(signerPower, totalPower) {
+		return types.ErrInsufficientVotingPower
+	}
+	if ckpt.BlsMultiSig == nil {
+		return types.ErrInvalidRawCheckpoint.Wrap("empty BLS multi-sig")
+	}
+	ok, err := bls12381.VerifyMultiSig(*ckpt.BlsMultiSig, signerBlsKeys, ckpt.SignedMsg())
+	if err != nil {
+		return types.ErrInvalidRawCheckpoint.Wrapf("failed to verify BLS multi-sig: %v", err)
+	}
+	if !ok {
+		return types.ErrInvalidRawCheckpoint.Wrap("invalid BLS multi-sig")
+	}
+	return nil
+}
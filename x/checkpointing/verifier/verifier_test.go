@@ -0,0 +1,69 @@
+package verifier_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/boljen/go-bitmap"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+	"github.com/babylonchain/babylon/x/checkpointing/verifier"
+)
+
+func FuzzVerifyRawCheckpoint(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		n := int(datagen.RandomInt(r, 10)) + 4
+		valSet, blsSKs := datagen.GenerateValidatorSetWithBLSPrivKeys(n)
+
+		// sign the checkpoint with a random subset of validators that has
+		// more than 2/3 of the voting power
+		ckpt := datagen.GenRandomRawCheckpoint(r)
+		numSigners := n*2/3 + 1 + int(datagen.RandomInt(r, n-n*2/3))
+		sigs := make([]bls12381.Signature, 0, numSigners)
+		for _, i := range r.Perm(n)[:numSigners] {
+			bitmap.Set(ckpt.Bitmap, i, true)
+			sigs = append(sigs, bls12381.Sign(blsSKs[i], ckpt.SignedMsg()))
+		}
+		multiSig, err := bls12381.AggrSigList(sigs)
+		require.NoError(t, err)
+		ckpt.BlsMultiSig = &multiSig
+
+		err = verifier.VerifyRawCheckpoint(ckpt, valSet)
+		require.NoError(t, err)
+
+		// the checkpoint can also be verified in its BTC format
+		btcCkpt, err := types.FromRawCkptToBTCCkpt(ckpt, datagen.GenRandomByteArray(r, 20))
+		require.NoError(t, err)
+		decodedCkpt, err := verifier.VerifyBTCCheckpoint(btcCkpt, valSet)
+		require.NoError(t, err)
+		require.True(t, ckpt.Equal(decodedCkpt))
+
+		// a checkpoint signed on a different block hash is invalid
+		invalidCkpt := *ckpt
+		blockHash := datagen.GenRandomBlockHash(r)
+		invalidCkpt.BlockHash = &blockHash
+		err = verifier.VerifyRawCheckpoint(&invalidCkpt, valSet)
+		require.ErrorIs(t, err, types.ErrInvalidRawCheckpoint)
+
+		// a checkpoint without sufficient voting power is invalid, even if
+		// its BLS multisig is valid
+		insufficientCkpt := *ckpt
+		insufficientCkpt.Bitmap = bitmap.New(types.BitmapBits)
+		sigs = sigs[:0]
+		for i := 0; i < n*2/3; i++ {
+			bitmap.Set(insufficientCkpt.Bitmap, i, true)
+			sigs = append(sigs, bls12381.Sign(blsSKs[i], ckpt.SignedMsg()))
+		}
+		multiSig, err = bls12381.AggrSigList(sigs)
+		require.NoError(t, err)
+		insufficientCkpt.BlsMultiSig = &multiSig
+		err = verifier.VerifyRawCheckpoint(&insufficientCkpt, valSet)
+		require.ErrorIs(t, err, types.ErrInsufficientVotingPower)
+	})
+}
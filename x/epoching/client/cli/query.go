@@ -25,6 +25,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryEpochMsgs())
+	cmd.AddCommand(CmdQueryEpochSlashes())
 
 	return cmd
 }
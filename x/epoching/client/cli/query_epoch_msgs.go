@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/babylonchain/babylon/x/epoching/types"
+)
+
+const flagSender = "sender"
+
+func CmdQueryEpochMsgs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-msgs [epoch_num]",
+		Short: "shows the messages queued in a given epoch, optionally of a given sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epochNum, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			sender, _ := cmd.Flags().GetString(flagSender)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EpochMsgs(cmd.Context(), &types.QueryEpochMsgsRequest{
+				EpochNum:   epochNum,
+				Pagination: pageReq,
+				Sender:     sender,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagSender, "", "Only show the messages sent by the given address")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "epoch-msgs")
+
+	return cmd
+}
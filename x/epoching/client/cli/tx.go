@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
@@ -32,6 +33,7 @@ func GetTxCmd() *cobra.Command {
 		NewRedelegateCmd(),
 		NewUnbondCmd(),
 		NewCancelUnbondingCmd(),
+		NewCancelQueuedMsgCmd(),
 	)
 
 	return cmd
@@ -226,3 +228,40 @@ $ %s tx epoching unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100%s --from m
 
 	return cmd
 }
+
+func NewCancelQueuedMsgCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-queued-msg [msg-id]",
+		Short: "Cancel a message queued in the current epoch",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a delegate, undelegate, redelegate or cancel-unbond message that is
+queued in the current epoch and has been sent by your wallet. The message ID
+is the hex-encoded msg_id returned by the epoch-msgs query.
+
+Example:
+$ %s tx epoching cancel-queued-msg 8C7A3B1B9C0F0A3E4D7E8A7A2D4C9B2E1F0A3B4C5D6E7F8091A2B3C4D5E6F708 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msgID, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelQueuedMsg(clientCtx.GetFromAddress(), msgID)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
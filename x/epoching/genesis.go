@@ -13,6 +13,11 @@ func InitGenesis(ctx context.Context, k keeper.Keeper, genState types.GenesisSta
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
 	}
+	if genState.PendingParams != nil {
+		if err := k.SetPendingParams(ctx, *genState.PendingParams); err != nil {
+			panic(err)
+		}
+	}
 
 	// init epoch number
 	k.InitEpoch(ctx)
@@ -28,6 +33,7 @@ func InitGenesis(ctx context.Context, k keeper.Keeper, genState types.GenesisSta
 func ExportGenesis(ctx context.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.PendingParams = k.GetPendingParams(ctx)
 
 	return genesis
 }
@@ -3,6 +3,7 @@ package keeper
 import (
 	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -25,6 +26,7 @@ func NewDropValidatorMsgDecorator(ek Keeper) *DropValidatorMsgDecorator {
 // - MsgUndelegate
 // - MsgBeginRedelegate
 // - MsgCancelUnbondingDelegation
+// including the ones wrapped in authz MsgExec
 func (qmd DropValidatorMsgDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	// skip if at genesis block, as genesis state contains txs that bootstrap the initial validator set
 	if ctx.BlockHeight() == 0 {
@@ -40,11 +42,24 @@ func (qmd DropValidatorMsgDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 	return next(ctx, tx, simulate)
 }
 
-// IsValidatorRelatedMsg checks if the given message is of non-wrapped type, which should be rejected
+// IsValidatorRelatedMsg checks if the given message is of non-wrapped type, which should be rejected.
+// Messages wrapped in authz MsgExec are checked as well, as they would otherwise bypass epoching
 func (qmd DropValidatorMsgDecorator) IsValidatorRelatedMsg(msg sdk.Msg) bool {
-	switch msg.(type) {
+	switch msg := msg.(type) {
 	case *stakingtypes.MsgCreateValidator, *stakingtypes.MsgDelegate, *stakingtypes.MsgUndelegate, *stakingtypes.MsgBeginRedelegate, *stakingtypes.MsgCancelUnbondingDelegation:
 		return true
+	case *authz.MsgExec:
+		innerMsgs, err := msg.GetMessages()
+		if err != nil {
+			// reject messages that cannot be inspected
+			return true
+		}
+		for _, innerMsg := range innerMsgs {
+			if qmd.IsValidatorRelatedMsg(innerMsg) {
+				return true
+			}
+		}
+		return false
 	default:
 		return false
 	}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 
@@ -20,8 +21,12 @@ func TestDropValidatorMsgDecorator(t *testing.T) {
 		{&stakingtypes.MsgUndelegate{}, true},
 		{&stakingtypes.MsgBeginRedelegate{}, true},
 		{&stakingtypes.MsgCancelUnbondingDelegation{}, true},
+		// wrapped in authz MsgExec
+		{newMsgExec(&stakingtypes.MsgDelegate{}), true},
+		{newMsgExec(&stakingtypes.MsgCancelUnbondingDelegation{}), true},
 		// allowed message types
 		{&stakingtypes.MsgEditValidator{}, false},
+		{newMsgExec(&stakingtypes.MsgEditValidator{}), false},
 	}
 
 	decorator := NewDropValidatorMsgDecorator(Keeper{})
@@ -35,3 +40,8 @@ func TestDropValidatorMsgDecorator(t *testing.T) {
 		}
 	}
 }
+
+func newMsgExec(msg sdk.Msg) sdk.Msg {
+	msgExec := authz.NewMsgExec(sdk.AccAddress("grantee"), []sdk.Msg{msg})
+	return &msgExec
+}
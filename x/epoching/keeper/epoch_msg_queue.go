@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
@@ -11,6 +12,7 @@ import (
 	"cosmossdk.io/store/prefix"
 	"github.com/babylonchain/babylon/x/epoching/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // InitMsgQueue initialises the msg queue length of the current epoch to 0
@@ -23,7 +25,8 @@ func (k Keeper) InitMsgQueue(ctx context.Context) {
 	store.Set(epochNumberBytes, queueLenBytes)
 }
 
-// GetQueueLength fetches the number of queued messages of a given epoch
+// GetQueueLength fetches the number of queued messages of a given epoch,
+// including the cancelled ones
 func (k Keeper) GetQueueLength(ctx context.Context, epochNumber uint64) uint64 {
 	store := k.msgQueueLengthStore(ctx)
 	epochNumberBytes := sdk.Uint64ToBigEndian(epochNumber)
@@ -100,6 +103,49 @@ func (k Keeper) GetEpochMsgs(ctx context.Context, epochNumber uint64) []*types.Q
 	return queuedMsgs
 }
 
+// RemoveQueuedMsg removes the message with the given ID from the queue of
+// the current epoch, on behalf of the sender of the message. The queue length
+// is not decremented, so that the indices of the remaining messages and the
+// messages enqueued afterwards do not collide.
+func (k Keeper) RemoveQueuedMsg(ctx context.Context, sender sdk.AccAddress, msgID []byte) (*types.QueuedMessage, error) {
+	epochNumber := k.GetEpoch(ctx).EpochNumber
+	store := k.msgQueueStore(ctx, epochNumber)
+
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var sdkMsg sdk.Msg
+		if err := k.cdc.UnmarshalInterface(iterator.Value(), &sdkMsg); err != nil {
+			panic(errorsmod.Wrap(types.ErrUnmarshal, err.Error()))
+		}
+		queuedMsg, ok := sdkMsg.(*types.QueuedMessage)
+		if !ok {
+			panic("invalid queued message")
+		}
+		if !bytes.Equal(queuedMsg.MsgId, msgID) {
+			continue
+		}
+
+		// the validator has registered its BLS key upon the wrapped
+		// MsgCreateValidator, so the message cannot be withdrawn
+		if _, ok := queuedMsg.Msg.(*types.QueuedMessage_MsgCreateValidator); ok {
+			return nil, errorsmod.Wrap(types.ErrQueuedMsgNotCancellable, "MsgCreateValidator cannot be cancelled")
+		}
+		msgSender, err := queuedMsg.Sender()
+		if err != nil {
+			return nil, err
+		}
+		if !msgSender.Equals(sender) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "the queued message is sent by %s rather than %s", msgSender, sender)
+		}
+
+		store.Delete(iterator.Key())
+		return queuedMsg, nil
+	}
+
+	return nil, errorsmod.Wrapf(types.ErrQueuedMsgNotFound, "msg ID %X, epoch %d", msgID, epochNumber)
+}
+
 // GetCurrentEpochMsgs returns the set of messages queued in the current epoch
 func (k Keeper) GetCurrentEpochMsgs(ctx context.Context) []*types.QueuedMessage {
 	epochNumber := k.GetEpoch(ctx).EpochNumber
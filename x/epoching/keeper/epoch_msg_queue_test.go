@@ -60,6 +60,69 @@ func FuzzEnqueueMsg(f *testing.F) {
 	})
 }
 
+// FuzzRemoveQueuedMsg tests RemoveQueuedMsg. It enqueues msgs of some senders,
+// removes one of them on behalf of its sender, and checks the remaining queue
+func FuzzRemoveQueuedMsg(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		helper := testhelper.NewHelper(t)
+		ctx, keeper, queryClient := helper.Ctx, helper.App.EpochingKeeper, helper.QueryClient
+
+		// enqueue a random number of msgs of two senders
+		senders := []sdk.AccAddress{
+			datagen.GenRandomByteArray(r, 20),
+			datagen.GenRandomByteArray(r, 20),
+		}
+		numQueuedMsgs := datagen.RandomInt(r, 100) + 2
+		for i := uint64(0); i < numQueuedMsgs; i++ {
+			msg := types.QueuedMessage{
+				TxId:  sdk.Uint64ToBigEndian(i),
+				MsgId: sdk.Uint64ToBigEndian(i),
+				Msg: &types.QueuedMessage_MsgDelegate{MsgDelegate: &stakingtypes.MsgDelegate{
+					DelegatorAddress: senders[i%2].String(),
+				}},
+			}
+			keeper.EnqueueMsg(ctx, msg)
+		}
+
+		// the query only returns the msgs of the given sender
+		resp, err := queryClient.EpochMsgs(ctx, &types.QueryEpochMsgsRequest{
+			EpochNum: 1,
+			Sender:   senders[0].String(),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Msgs, int((numQueuedMsgs+1)/2))
+
+		// the msg cannot be removed by the other sender
+		idx := datagen.RandomInt(r, int(numQueuedMsgs))
+		msgID := sdk.Uint64ToBigEndian(idx)
+		_, err = keeper.RemoveQueuedMsg(ctx, senders[(idx+1)%2], msgID)
+		require.Error(t, err)
+
+		// the msg is removed by its sender
+		removedMsg, err := keeper.RemoveQueuedMsg(ctx, senders[idx%2], msgID)
+		require.NoError(t, err)
+		require.Equal(t, msgID, removedMsg.MsgId)
+		_, err = keeper.RemoveQueuedMsg(ctx, senders[idx%2], msgID)
+		require.ErrorIs(t, err, types.ErrQueuedMsgNotFound)
+		epochMsgs := keeper.GetCurrentEpochMsgs(ctx)
+		require.Len(t, epochMsgs, int(numQueuedMsgs-1))
+		for _, msg := range epochMsgs {
+			require.NotEqual(t, msgID, msg.MsgId)
+		}
+
+		// msgs enqueued afterwards do not overwrite the remaining ones
+		keeper.EnqueueMsg(ctx, types.QueuedMessage{
+			TxId:  sdk.Uint64ToBigEndian(numQueuedMsgs),
+			MsgId: sdk.Uint64ToBigEndian(numQueuedMsgs),
+			Msg:   &types.QueuedMessage_MsgDelegate{MsgDelegate: &stakingtypes.MsgDelegate{}},
+		})
+		require.Len(t, keeper.GetCurrentEpochMsgs(ctx), int(numQueuedMsgs))
+	})
+}
+
 // FuzzHandleQueuedMsg_MsgWrappedDelegate tests HandleQueueMsg over MsgWrappedDelegate.
 // It enqueues some MsgWrappedDelegate, enters a new epoch (which triggers HandleQueueMsg), and check if the newly delegated tokens take effect or not
 func FuzzHandleQueuedMsg_MsgWrappedDelegate(f *testing.F) {
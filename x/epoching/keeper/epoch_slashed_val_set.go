@@ -123,6 +123,57 @@ func (k Keeper) ClearSlashedValidators(ctx context.Context, epochNumber uint64)
 	k.slashedVotingPowerStore(ctx).Delete(epochNumberBytes)
 }
 
+// recordSlash records the slash of a validator in the current epoch. Unlike
+// the slashed validator set, the slash records are never cleared, so that the
+// misbehaviour remains auditable after the epoch is checkpointed
+func (k Keeper) recordSlash(ctx context.Context, valAddr sdk.ValAddress, fraction sdkmath.LegacyDec, votingPower int64) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	epochNumber := k.GetEpoch(ctx).EpochNumber
+	store := k.slashRecordStore(ctx, epochNumber)
+
+	// the slash records of an epoch are indexed by their order
+	numRecords := uint64(0)
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		numRecords++
+	}
+	iterator.Close()
+
+	blockTime := sdkCtx.HeaderInfo().Time
+	record := &types.SlashRecord{
+		ValidatorAddress: valAddr.String(),
+		Fraction:         fraction,
+		VotingPower:      votingPower,
+		BlockHeight:      uint64(sdkCtx.HeaderInfo().Height),
+		BlockTime:        &blockTime,
+	}
+	store.Set(sdk.Uint64ToBigEndian(numRecords), k.cdc.MustMarshal(record))
+}
+
+// GetSlashRecords returns the slash records of a given epoch
+func (k Keeper) GetSlashRecords(ctx context.Context, epochNumber uint64) []*types.SlashRecord {
+	records := []*types.SlashRecord{}
+	iterator := k.slashRecordStore(ctx, epochNumber).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.SlashRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, &record)
+	}
+	return records
+}
+
+// slashRecordStore returns the KVStore of the slash records for a given epoch
+// prefix : SlashRecordKey || epochNumber
+// key: index of the slash record in the epoch
+// value: SlashRecord
+func (k Keeper) slashRecordStore(ctx context.Context, epochNumber uint64) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	slashRecordStore := prefix.NewStore(storeAdapter, types.SlashRecordKey)
+	epochNumberBytes := sdk.Uint64ToBigEndian(epochNumber)
+	return prefix.NewStore(slashRecordStore, epochNumberBytes)
+}
+
 // slashedValSetStore returns the KVStore of the slashed validator set for a given epoch
 // prefix : SlashedValidatorSetKey || epochNumber
 func (k Keeper) slashedValSetStore(ctx context.Context, epochNumber uint64) prefix.Store {
@@ -36,6 +36,7 @@ func FuzzSlashedValSet(f *testing.F) {
 		// slash a random subset of validators
 		numSlashed := r.Intn(len(getValSet))
 		excpectedSlashedVals := []sdk.ValAddress{}
+		expectedSlashedPowers := []int64{}
 		for i := 0; i < numSlashed; i++ {
 			idx := r.Intn(len(getValSet))
 			slashedVal := getValSet[idx]
@@ -43,10 +44,22 @@ func FuzzSlashedValSet(f *testing.F) {
 			require.NoError(t, err)
 			// add the slashed validator to the slashed validator set
 			excpectedSlashedVals = append(excpectedSlashedVals, slashedVal.Addr)
+			expectedSlashedPowers = append(expectedSlashedPowers, slashedVal.Power)
 			// remove the slashed validator from the validator set in order to avoid slashing a validator more than once
 			getValSet = append(getValSet[:idx], getValSet[idx+1:]...)
 		}
 
+		// check whether the slash records are consistent with the slashes, in
+		// the order they happened
+		slashRecords := keeper.GetSlashRecords(ctx, 1)
+		require.Len(t, slashRecords, numSlashed)
+		for i, record := range slashRecords {
+			require.Equal(t, excpectedSlashedVals[i].String(), record.ValidatorAddress)
+			require.Equal(t, expectedSlashedPowers[i], record.VotingPower)
+			require.True(t, record.Fraction.IsPositive())
+			require.Equal(t, uint64(ctx.HeaderInfo().Height), record.BlockHeight)
+		}
+
 		// check whether the slashed validator set in DB is consistent or not
 		actualSlashedVals := keeper.GetSlashedValidators(ctx, 1)
 		require.Equal(t, len(excpectedSlashedVals), len(actualSlashedVals))
@@ -67,6 +80,12 @@ func FuzzSlashedValSet(f *testing.F) {
 
 		// no validator is slashed in epoch 1
 		require.Empty(t, keeper.GetSlashedValidators(ctx, 2))
+
+		// the slash records of epoch 1 are retained and can be queried
+		resp, err := keeper.EpochSlashes(ctx, &types.QueryEpochSlashesRequest{EpochNum: 1})
+		require.NoError(t, err)
+		require.Equal(t, slashRecords, resp.Slashes)
+		require.Empty(t, keeper.GetSlashRecords(ctx, 2))
 	})
 }
 
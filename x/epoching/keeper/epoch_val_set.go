@@ -71,6 +71,21 @@ func (k Keeper) InitValidatorSet(ctx context.Context) {
 	k.votingPowerStore(ctx).Set(epochNumberBytes, totalPowerBytes)
 }
 
+// getLastValidatorSet returns the validator set of the staking module as of
+// the last validator set update, which becomes the validator set of the next
+// epoch once the current epoch ends
+func (k Keeper) getLastValidatorSet(ctx context.Context) types.ValidatorSet {
+	vals := []types.Validator{}
+	err := k.stk.IterateLastValidatorPowers(ctx, func(addr sdk.ValAddress, power int64) (stop bool) {
+		vals = append(vals, types.Validator{Addr: addr, Power: power})
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+	return types.NewSortedValidatorSet(vals)
+}
+
 // ClearValidatorSet removes the validator set of a given epoch
 // TODO: This is called upon the epoch is checkpointed
 func (k Keeper) ClearValidatorSet(ctx context.Context, epochNumber uint64) {
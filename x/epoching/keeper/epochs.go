@@ -61,6 +61,33 @@ func (k Keeper) GetHistoricalEpoch(ctx context.Context, epochNumber uint64) (*ty
 	return epoch, err
 }
 
+// GetEpochByHeight returns the epoch that contains the block at the given
+// height. As the epoch interval may change across epochs, the epoch is
+// located by the boundaries recorded in the epochs rather than derived
+// from the current epoch interval.
+func (k Keeper) GetEpochByHeight(ctx context.Context, height uint64) (*types.Epoch, error) {
+	curEpoch := k.GetEpoch(ctx)
+	if height > curEpoch.GetLastBlockHeight() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidHeight, "height %d is beyond the current epoch %d", height, curEpoch.EpochNumber)
+	}
+
+	// binary search over the epochs, whose first block heights are increasing
+	low, high := uint64(0), curEpoch.EpochNumber
+	for low < high {
+		mid := low + (high-low+1)/2
+		epoch, err := k.getEpochInfo(ctx, mid)
+		if err != nil {
+			return nil, err
+		}
+		if epoch.FirstBlockHeight <= height {
+			low = mid
+		} else {
+			high = mid - 1
+		}
+	}
+	return k.getEpochInfo(ctx, low)
+}
+
 // RecordLastHeaderAndAppHashRoot records the last header and Merkle root of all AppHashs
 // for the current epoch, and stores the epoch metadata to KVStore
 func (k Keeper) RecordLastHeaderAndAppHashRoot(ctx context.Context) error {
@@ -124,13 +151,18 @@ func (k Keeper) RecordSealerBlockHashForEpoch(ctx context.Context) *types.Epoch
 	return epoch
 }
 
-// IncEpoch adds epoch number by 1
+// IncEpoch adds epoch number by 1. The params queued during the previous
+// epoch take effect before the new epoch is created, so that the new epoch
+// uses the updated epoch interval.
 // CONTRACT: can only be invoked at the first block of an epoch
 func (k Keeper) IncEpoch(ctx context.Context) types.Epoch {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	epochNumber := k.GetEpoch(ctx).EpochNumber
 	incrementedEpochNumber := epochNumber + 1
 
+	if err := k.applyPendingParams(ctx); err != nil {
+		panic(fmt.Errorf("failed to apply pending params at epoch %d: %w", incrementedEpochNumber, err))
+	}
 	epochInterval := k.GetParams(ctx).EpochInterval
 	newEpoch := types.NewEpoch(incrementedEpochNumber, epochInterval, uint64(sdkCtx.HeaderInfo().Height), nil)
 	k.setEpochInfo(ctx, incrementedEpochNumber, &newEpoch)
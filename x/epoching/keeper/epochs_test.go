@@ -8,6 +8,7 @@ import (
 
 	"github.com/babylonchain/babylon/testutil/datagen"
 	testhelper "github.com/babylonchain/babylon/testutil/helper"
+	"github.com/babylonchain/babylon/x/epoching/types"
 )
 
 func FuzzEpochs(f *testing.F) {
@@ -47,3 +48,52 @@ func FuzzEpochs(f *testing.F) {
 		require.Equal(t, (expectedEpochNumber-1)*epochInterval+1, actualNewEpoch.FirstBlockHeight)
 	})
 }
+
+func FuzzEpochIntervalChange(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		helper := testhelper.NewHelper(t)
+		ctx, keeper := helper.Ctx, helper.App.EpochingKeeper
+		oldInterval := keeper.GetParams(ctx).EpochInterval
+
+		// queue a new epoch interval during epoch 1
+		newInterval := datagen.RandomInt(r, 20) + 2
+		err := keeper.SetPendingParams(ctx, types.NewParams(newInterval))
+		require.NoError(t, err)
+		require.Equal(t, oldInterval, keeper.GetParams(ctx).EpochInterval)
+		require.Equal(t, newInterval, keeper.GetPendingParams(ctx).EpochInterval)
+
+		// the new interval takes effect from epoch 2 onwards
+		for keeper.GetEpoch(ctx).EpochNumber < 3 {
+			ctx, err = helper.ApplyEmptyBlockWithVoteExtension(r)
+			require.NoError(t, err)
+		}
+		require.Nil(t, keeper.GetPendingParams(ctx))
+		require.Equal(t, newInterval, keeper.GetParams(ctx).EpochInterval)
+
+		// historical epochs keep the interval at their time
+		epoch1, err := keeper.GetHistoricalEpoch(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, oldInterval, epoch1.CurrentEpochInterval)
+		epoch2, err := keeper.GetHistoricalEpoch(ctx, 2)
+		require.NoError(t, err)
+		require.Equal(t, newInterval, epoch2.CurrentEpochInterval)
+		require.Equal(t, epoch1.GetLastBlockHeight()+1, epoch2.FirstBlockHeight)
+		epoch3 := keeper.GetEpoch(ctx)
+		require.Equal(t, newInterval, epoch3.CurrentEpochInterval)
+		require.Equal(t, epoch2.GetLastBlockHeight()+1, epoch3.FirstBlockHeight)
+
+		// epochs are located by height consistently across the interval change
+		for _, epoch := range []*types.Epoch{epoch1, epoch2, epoch3} {
+			height := epoch.FirstBlockHeight + datagen.RandomInt(r, int(epoch.CurrentEpochInterval))
+			resEpoch, err := keeper.GetEpochByHeight(ctx, height)
+			require.NoError(t, err)
+			require.Equal(t, epoch.EpochNumber, resEpoch.EpochNumber)
+		}
+		_, err = keeper.GetEpochByHeight(ctx, epoch3.GetLastBlockHeight()+1)
+		require.ErrorIs(t, err, types.ErrInvalidHeight)
+	})
+}
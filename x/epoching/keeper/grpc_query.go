@@ -28,7 +28,10 @@ func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+	return &types.QueryParamsResponse{
+		Params:        k.GetParams(ctx),
+		PendingParams: k.GetPendingParams(ctx),
+	}, nil
 }
 
 // CurrentEpoch handles the QueryCurrentEpochRequest query
@@ -98,19 +101,38 @@ func (k Keeper) EpochMsgs(c context.Context, req *types.QueryEpochMsgsRequest) (
 	// - We could add the epoch number to the query, and return nothing if the current epoch number is different. But it's a bit of pain to have to set it and not know why there's no result.
 	// - We could not reset the key to 0 when the queue is cleared, and just keep incrementing the ID forever. That way when the next query comes, it might skip some records that have been deleted, then resume from the next available record which has a higher key than the value in the pagination data structure.
 	// - We can do nothing, in which case some records that have been inserted after the delete might be skipped because their keys are lower than the pagionation state.
-	pageRes, err := query.Paginate(epochMsgsStore, req.Pagination, func(key, value []byte) error {
+	var sender sdk.AccAddress
+	if req.Sender != "" {
+		var err error
+		if sender, err = sdk.AccAddressFromBech32(req.Sender); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sender: %v", err)
+		}
+	}
+	pageRes, err := query.FilteredPaginate(epochMsgsStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		// unmarshal to queuedMsg
 		var sdkMsg sdk.Msg
 		if err := k.cdc.UnmarshalInterface(value, &sdkMsg); err != nil {
-			return err
+			return false, err
 		}
 		queuedMsg, ok := sdkMsg.(*types.QueuedMessage)
 		if !ok {
-			return errors.New("invalid queue message")
+			return false, errors.New("invalid queue message")
+		}
+		// filter out msgs of other senders
+		if sender != nil {
+			msgSender, err := queuedMsg.Sender()
+			if err != nil {
+				return false, err
+			}
+			if !msgSender.Equals(sender) {
+				return false, nil
+			}
 		}
 		// append to msgs
-		msgs = append(msgs, queuedMsg.ToResponse())
-		return nil
+		if accumulate {
+			msgs = append(msgs, queuedMsg.ToResponse())
+		}
+		return true, nil
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -244,3 +266,35 @@ func (k Keeper) EpochValSet(c context.Context, req *types.QueryEpochValSetReques
 	}
 	return resp, nil
 }
+
+// EpochSlashes handles the QueryEpochSlashesRequest query
+func (k Keeper) EpochSlashes(c context.Context, req *types.QueryEpochSlashesRequest) (*types.QueryEpochSlashesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	epoch := k.GetEpoch(ctx)
+	if epoch.EpochNumber < req.EpochNum {
+		return nil, types.ErrUnknownEpochNumber
+	}
+
+	slashes := []*types.SlashRecord{}
+	slashRecordStore := k.slashRecordStore(ctx, req.EpochNum)
+	pageRes, err := query.Paginate(slashRecordStore, req.Pagination, func(key, value []byte) error {
+		var record types.SlashRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		slashes = append(slashes, &record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEpochSlashesResponse{
+		Slashes:    slashes,
+		Pagination: pageRes,
+	}, nil
+}
@@ -13,17 +13,49 @@ import (
 // ensures Keeper implements EpochingHooks interfaces
 var _ types.EpochingHooks = Keeper{}
 
+// valSetHooks returns the registered hooks that implement
+// EpochingValSetHooks, or nil if no registered hook does, in which case the
+// validator sets of epochs and their differences are not computed at all
+func (k Keeper) valSetHooks() types.EpochingValSetHooks {
+	switch h := k.hooks.(type) {
+	case types.MultiEpochingHooks:
+		if h.HasValSetHooks() {
+			return h
+		}
+		return nil
+	case types.EpochingValSetHooks:
+		return h
+	default:
+		return nil
+	}
+}
+
 // AfterEpochBegins - call hook if registered
+// CONTRACT: the validator set of the epoch has been initialised
 func (k Keeper) AfterEpochBegins(ctx context.Context, epoch uint64) {
 	if k.hooks != nil {
 		k.hooks.AfterEpochBegins(ctx, epoch)
+		if vh := k.valSetHooks(); vh != nil {
+			valSet := k.GetValidatorSet(ctx, epoch)
+			var prevValSet types.ValidatorSet
+			if epoch > 0 {
+				prevValSet = k.GetValidatorSet(ctx, epoch-1)
+			}
+			vh.AfterEpochBeginsWithValSet(ctx, epoch, valSet, types.NewValidatorSetDiff(prevValSet, valSet))
+		}
 	}
 }
 
 // AfterEpochEnds - call hook if registered
+// CONTRACT: the validator set updates of the epoch have been applied to the
+// staking module
 func (k Keeper) AfterEpochEnds(ctx context.Context, epoch uint64) {
 	if k.hooks != nil {
 		k.hooks.AfterEpochEnds(ctx, epoch)
+		if vh := k.valSetHooks(); vh != nil {
+			diff := types.NewValidatorSetDiff(k.GetValidatorSet(ctx, epoch), k.getLastValidatorSet(ctx))
+			vh.AfterEpochEndsWithValSet(ctx, epoch, diff)
+		}
 	}
 }
 
@@ -31,6 +63,10 @@ func (k Keeper) AfterEpochEnds(ctx context.Context, epoch uint64) {
 func (k Keeper) BeforeSlashThreshold(ctx context.Context, valSet types.ValidatorSet) {
 	if k.hooks != nil {
 		k.hooks.BeforeSlashThreshold(ctx, valSet)
+		if vh := k.valSetHooks(); vh != nil {
+			epoch := k.GetEpoch(ctx).EpochNumber
+			vh.BeforeSlashThresholdWithValSet(ctx, epoch, k.GetValidatorSet(ctx, epoch), valSet)
+		}
 	}
 }
 
@@ -59,6 +95,10 @@ func (h Hooks) BeforeValidatorSlashed(ctx context.Context, valAddr sdk.ValAddres
 	// voting power of this validator
 	thisVotingPower, err := h.k.GetValidatorVotingPower(ctx, epochNumber, valAddr)
 	thisVal := types.Validator{Addr: valAddr, Power: thisVotingPower}
+	// record the slash immediately, including the ones of validators outside
+	// the validator set, while the validator set only changes at the end of
+	// the epoch
+	h.k.recordSlash(ctx, valAddr, fraction, thisVotingPower)
 	if err != nil {
 		// It's possible that the most powerful validator outside the validator set enrols to the validator after this validator is slashed.
 		// Consequently, here we cannot find this validator in the validatorSet map.
@@ -137,6 +177,8 @@ func (h Hooks) AfterBlsKeyRegistered(ctx context.Context, valAddr sdk.ValAddress
 
 func (h Hooks) AfterRawCheckpointConfirmed(ctx context.Context, epoch uint64) error { return nil }
 
+func (h Hooks) AfterRawCheckpointUnfinalized(ctx context.Context, epoch uint64) error { return nil }
+
 func (h Hooks) AfterRawCheckpointForgotten(ctx context.Context, ckpt *checkpointingtypes.RawCheckpoint) error {
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type msgServer struct {
@@ -210,11 +211,13 @@ func (ms msgServer) WrappedCancelUnbondingDelegation(goCtx context.Context, msg
 	}
 
 	// verification rules ported from staking module
-	if _, err := sdk.AccAddressFromBech32(msg.Msg.DelegatorAddress); err != nil {
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.Msg.DelegatorAddress)
+	if err != nil {
 		return nil, err
 	}
 
-	if _, err := sdk.ValAddressFromBech32(msg.Msg.ValidatorAddress); err != nil {
+	valAddr, err := sdk.ValAddressFromBech32(msg.Msg.ValidatorAddress)
+	if err != nil {
 		return nil, err
 	}
 
@@ -242,11 +245,52 @@ func (ms msgServer) WrappedCancelUnbondingDelegation(goCtx context.Context, msg
 		)
 	}
 
-	blockHeight := uint64(ctx.BlockHeader().Height)
+	validator, err := ms.stk.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+	if validator.IsJailed() {
+		return nil, stakingtypes.ErrValidatorJailed
+	}
+
+	// the unbonding delegation entry has to exist at the creation height, and
+	// has to hold sufficient balance that is not yet released
+	ubd, err := ms.stk.GetUnbondingDelegation(ctx, delegatorAddress, valAddr)
+	if err != nil {
+		return nil, err
+	}
+	entryFound := false
+	for _, entry := range ubd.Entries {
+		if entry.CreationHeight != msg.Msg.CreationHeight {
+			continue
+		}
+		entryFound = true
+		if entry.Balance.LT(msg.Msg.Amount.Amount) {
+			return nil, errorsmod.Wrap(
+				sdkerrors.ErrInvalidRequest,
+				"amount is greater than the unbonding delegation entry balance",
+			)
+		}
+		if entry.CompletionTime.Before(ctx.HeaderInfo().Time) {
+			return nil, errorsmod.Wrap(
+				sdkerrors.ErrInvalidRequest,
+				"unbonding delegation is already processed",
+			)
+		}
+		break
+	}
+	if !entryFound {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrNotFound,
+			"unbonding delegation entry is not found at block height %d", msg.Msg.CreationHeight,
+		)
+	}
+
+	blockHeight := uint64(ctx.HeaderInfo().Height)
 	if blockHeight == 0 {
 		return nil, types.ErrZeroEpochMsg
 	}
-	blockTime := ctx.BlockTime()
+	blockTime := ctx.HeaderInfo().Time
 	txid := tmhash.Sum(ctx.TxBytes())
 	queuedMsg, err := types.NewQueuedMessage(blockHeight, blockTime, txid, msg)
 	if err != nil {
@@ -270,10 +314,9 @@ func (ms msgServer) WrappedCancelUnbondingDelegation(goCtx context.Context, msg
 	return &types.MsgWrappedCancelUnbondingDelegationResponse{}, nil
 }
 
-// UpdateParams updates the params.
-// TODO investigate when it is the best time to update the params. We can update them
-// when the epoch changes, but we can also update them during the epoch and extend
-// the epoch duration.
+// UpdateParams queues the params, which take effect at the beginning of the
+// next epoch. Updating the params during an epoch would otherwise shift the
+// boundary of the ongoing epoch.
 func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if ms.authority != req.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
@@ -283,9 +326,39 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	if err := ms.SetParams(ctx, req.Params); err != nil {
+	if err := ms.SetPendingParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// CancelQueuedMsg handles the MsgCancelQueuedMsg request
+func (ms msgServer) CancelQueuedMsg(goCtx context.Context, msg *types.MsgCancelQueuedMsg) (*types.MsgCancelQueuedMsgResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	if len(msg.MsgId) != tmhash.Size {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "msg ID should be %d bytes, got %d", tmhash.Size, len(msg.MsgId))
+	}
+
+	if _, err := ms.RemoveQueuedMsg(ctx, signer, msg.MsgId); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(
+		&types.EventCancelQueuedMsg{
+			EpochNumber: ms.GetEpoch(ctx).EpochNumber,
+			Sender:      msg.Signer,
+			MsgId:       msg.MsgId,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelQueuedMsgResponse{}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 
@@ -112,6 +113,11 @@ func TestMsgWrappedCancelUnbondingDelegation(t *testing.T) {
 	ctx, err := helper.ApplyEmptyBlockWithVoteExtension(r)
 	require.NoError(t, err)
 
+	bondDenom, err := helper.App.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	delAddr := helper.GenAccs[0].GetAddress()
+	valAddr := helper.GenValidators.Keys[0].ValidatorAddress
+
 	testCases := []struct {
 		name      string
 		req       *stakingtypes.MsgCancelUnbondingDelegation
@@ -122,6 +128,11 @@ func TestMsgWrappedCancelUnbondingDelegation(t *testing.T) {
 			&stakingtypes.MsgCancelUnbondingDelegation{},
 			true,
 		},
+		{
+			"no unbonding delegation entry",
+			stakingtypes.NewMsgCancelUnbondingDelegation(delAddr.String(), valAddr, 1, sdk.NewInt64Coin(bondDenom, 100)),
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		wrappedMsg := types.NewMsgWrappedCancelUnbondingDelegation(tc.req)
@@ -35,3 +35,48 @@ func (k Keeper) GetParams(ctx context.Context) (p types.Params) {
 	k.cdc.MustUnmarshal(bz, &p)
 	return p
 }
+
+// SetPendingParams queues the x/epoching module parameters that take effect
+// at the beginning of the next epoch. It overwrites previously queued params.
+func (k Keeper) SetPendingParams(ctx context.Context, p types.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&p)
+	return store.Set(types.PendingParamsKey, bz)
+}
+
+// GetPendingParams returns the queued x/epoching module parameters, or nil
+// if there are none
+func (k Keeper) GetPendingParams(ctx context.Context) *types.Params {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.PendingParamsKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+
+	var p types.Params
+	k.cdc.MustUnmarshal(bz, &p)
+	return &p
+}
+
+// applyPendingParams replaces the params with the queued ones, if any
+// CONTRACT: can only be invoked at the first block of an epoch, before the
+// new epoch is created
+func (k Keeper) applyPendingParams(ctx context.Context) error {
+	p := k.GetPendingParams(ctx)
+	if p == nil {
+		return nil
+	}
+	if err := k.SetParams(ctx, *p); err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.PendingParamsKey)
+}
@@ -13,6 +13,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgWrappedBeginRedelegate{}, "epoching/WrappedBeginRedelegate", nil)
 	cdc.RegisterConcrete(&QueuedMessage{}, "epoching/QueuedMessage", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "epoching/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgCancelQueuedMsg{}, "epoching/MsgCancelQueuedMsg", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -24,6 +25,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgWrappedBeginRedelegate{},
 		&QueuedMessage{},
 		&MsgUpdateParams{},
+		&MsgCancelQueuedMsg{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -188,3 +188,25 @@ func (qm *QueuedMessage) UnwrapToSdkMsg() sdk.Msg {
 	}
 	return unwrappedMsgWithType
 }
+
+// Sender returns the address of the account that submitted the queued message
+func (qm *QueuedMessage) Sender() (sdk.AccAddress, error) {
+	switch unwrappedMsg := qm.Msg.(type) {
+	case *QueuedMessage_MsgCreateValidator:
+		valAddr, err := sdk.ValAddressFromBech32(unwrappedMsg.MsgCreateValidator.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		return sdk.AccAddress(valAddr), nil
+	case *QueuedMessage_MsgDelegate:
+		return sdk.AccAddressFromBech32(unwrappedMsg.MsgDelegate.DelegatorAddress)
+	case *QueuedMessage_MsgUndelegate:
+		return sdk.AccAddressFromBech32(unwrappedMsg.MsgUndelegate.DelegatorAddress)
+	case *QueuedMessage_MsgBeginRedelegate:
+		return sdk.AccAddressFromBech32(unwrappedMsg.MsgBeginRedelegate.DelegatorAddress)
+	case *QueuedMessage_MsgCancelUnbondingDelegation:
+		return sdk.AccAddressFromBech32(unwrappedMsg.MsgCancelUnbondingDelegation.DelegatorAddress)
+	default:
+		return nil, errorsmod.Wrap(ErrInvalidQueuedMessageType, qm.String())
+	}
+}
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types1 "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -645,6 +647,84 @@ func (m *Validator) GetPower() int64 {
 	return 0
 }
 
+// SlashRecord is the record of a slash of a validator in an epoch. It is
+// recorded upon the slash and retained afterwards, while the slash's
+// consequences on the validator set take effect at the end of the epoch
+type SlashRecord struct {
+	// validator_address is the address of the slashed validator (in
+	// sdk.ValAddress)
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// fraction is the fraction of the validator's stake being slashed
+	Fraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=fraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fraction"`
+	// voting_power is the validator's voting power in the epoch
+	VotingPower int64 `protobuf:"varint,3,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// block_height is the height of the block in which the validator is slashed
+	BlockHeight uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// block_time is the time of the block in which the validator is slashed
+	BlockTime *time.Time `protobuf:"bytes,5,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time,omitempty"`
+}
+
+func (m *SlashRecord) Reset()         { *m = SlashRecord{} }
+func (m *SlashRecord) String() string { return proto.CompactTextString(m) }
+func (*SlashRecord) ProtoMessage()    {}
+func (*SlashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f2f209d5311f84c, []int{7}
+}
+func (m *SlashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRecord.Merge(m, src)
+}
+func (m *SlashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashRecord proto.InternalMessageInfo
+
+func (m *SlashRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *SlashRecord) GetVotingPower() int64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetBlockTime() *time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("babylon.epoching.v1.BondState", BondState_name, BondState_value)
 	proto.RegisterType((*Epoch)(nil), "babylon.epoching.v1.Epoch")
@@ -654,6 +734,7 @@ func init() {
 	proto.RegisterType((*DelegationStateUpdate)(nil), "babylon.epoching.v1.DelegationStateUpdate")
 	proto.RegisterType((*DelegationLifecycle)(nil), "babylon.epoching.v1.DelegationLifecycle")
 	proto.RegisterType((*Validator)(nil), "babylon.epoching.v1.Validator")
+	proto.RegisterType((*SlashRecord)(nil), "babylon.epoching.v1.SlashRecord")
 }
 
 func init() {
@@ -661,64 +742,71 @@ func init() {
 }
 
 var fileDescriptor_2f2f209d5311f84c = []byte{
-	// 897 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xdf, 0x6e, 0xe3, 0x44,
-	0x14, 0xc6, 0xe3, 0xfc, 0x6b, 0x73, 0x92, 0x74, 0xc3, 0xb4, 0x8b, 0xb2, 0x15, 0x4a, 0x4b, 0x10,
-	0xa8, 0xaa, 0x90, 0x43, 0x4b, 0xb9, 0x05, 0x35, 0x4d, 0x44, 0x8a, 0x68, 0x56, 0x98, 0x6d, 0x2f,
-	0xb8, 0xc0, 0x1a, 0xdb, 0x53, 0xdb, 0x5a, 0x7b, 0xc6, 0xf2, 0x8c, 0xb3, 0xed, 0x05, 0xef, 0xb0,
-	0xcf, 0xc1, 0x93, 0x70, 0xd9, 0x4b, 0xee, 0x40, 0xed, 0x83, 0x2c, 0x9a, 0x19, 0xc7, 0x49, 0x69,
-	0xd4, 0xaa, 0x70, 0x37, 0x73, 0xce, 0x77, 0xbe, 0x99, 0xf3, 0xf3, 0xd1, 0x18, 0xfa, 0x0e, 0x76,
-	0xae, 0x23, 0x46, 0x07, 0x24, 0x61, 0x6e, 0x10, 0x52, 0x7f, 0x30, 0x3b, 0x28, 0xd6, 0x66, 0x92,
-	0x32, 0xc1, 0xd0, 0x66, 0xae, 0x31, 0x8b, 0xf8, 0xec, 0x60, 0x7b, 0xc7, 0x67, 0xcc, 0x8f, 0xc8,
-	0x40, 0x49, 0x9c, 0xec, 0x72, 0x20, 0xc2, 0x98, 0x70, 0x81, 0xe3, 0x44, 0x57, 0x6d, 0x6f, 0xf9,
-	0xcc, 0x67, 0x6a, 0x39, 0x90, 0xab, 0x3c, 0xba, 0xe3, 0x32, 0x1e, 0x33, 0x3e, 0xe0, 0x02, 0xbf,
-	0xd5, 0xa7, 0x39, 0x44, 0xe0, 0x83, 0x81, 0xb8, 0xca, 0x05, 0xbd, 0x5c, 0xe0, 0x60, 0x4e, 0x8a,
-	0xac, 0xcb, 0x42, 0xaa, 0xf3, 0xfd, 0x9b, 0x32, 0xd4, 0xc6, 0xf2, 0x1e, 0xe8, 0x53, 0x68, 0xa9,
-	0x0b, 0xd9, 0x34, 0x8b, 0x1d, 0x92, 0x76, 0x8d, 0x5d, 0x63, 0xaf, 0x6a, 0x35, 0x55, 0x6c, 0xaa,
-	0x42, 0xe8, 0x08, 0x3e, 0x76, 0xb3, 0x34, 0x25, 0x54, 0xd8, 0x5a, 0x1a, 0x52, 0x41, 0xd2, 0x19,
-	0x8e, 0xba, 0x65, 0x25, 0xde, 0xca, 0xb3, 0xca, 0xf0, 0x34, 0xcf, 0xa1, 0x2f, 0x01, 0x5d, 0x86,
-	0x29, 0x17, 0xb6, 0x13, 0x31, 0xf7, 0xad, 0x1d, 0x90, 0xd0, 0x0f, 0x44, 0xb7, 0xa2, 0x2a, 0x3a,
-	0x2a, 0x33, 0x94, 0x89, 0x89, 0x8a, 0xa3, 0x09, 0xbc, 0x88, 0x70, 0x21, 0x96, 0x14, 0xba, 0xd5,
-	0x5d, 0x63, 0xaf, 0x79, 0xb8, 0x6d, 0x6a, 0x44, 0xe6, 0x1c, 0x91, 0xf9, 0x66, 0x8e, 0x68, 0x58,
-	0x7d, 0xff, 0xd7, 0x8e, 0x61, 0xb5, 0x65, 0xa1, 0xf2, 0x92, 0x19, 0xd4, 0x87, 0x36, 0x4e, 0x12,
-	0x3b, 0xc0, 0x3c, 0xb0, 0x53, 0xc6, 0x44, 0xb7, 0xb6, 0x6b, 0xec, 0xb5, 0xac, 0x26, 0x4e, 0x92,
-	0x09, 0xe6, 0x81, 0xc5, 0x98, 0x40, 0x5f, 0xc0, 0x0b, 0x4e, 0x70, 0x44, 0x52, 0x7b, 0x2e, 0xed,
-	0xd6, 0x95, 0xaa, 0xad, 0xc3, 0xc7, 0x5a, 0x8b, 0xf6, 0xe1, 0xa3, 0x5c, 0x97, 0x37, 0x21, 0x95,
-	0x6b, 0x4a, 0x99, 0x1b, 0xe8, 0x1e, 0x30, 0x0f, 0xfa, 0x1f, 0xaa, 0xd0, 0xfe, 0x29, 0x23, 0x19,
-	0xf1, 0xce, 0x08, 0xe7, 0xd8, 0x27, 0x68, 0x13, 0x6a, 0xe2, 0xca, 0x0e, 0x3d, 0xc5, 0xb4, 0x65,
-	0x55, 0xc5, 0xd5, 0xa9, 0x87, 0x5e, 0x42, 0x3d, 0xe6, 0xbe, 0x8c, 0x96, 0x55, 0xb4, 0x16, 0x73,
-	0xff, 0xd4, 0x93, 0x9f, 0x61, 0x05, 0xa7, 0xa6, 0xb3, 0x84, 0xe8, 0x3b, 0x80, 0xff, 0x40, 0xa7,
-	0xe1, 0x14, 0x64, 0x7e, 0x85, 0x2d, 0x79, 0xb4, 0x9b, 0x12, 0x2c, 0x88, 0x3d, 0xc3, 0x51, 0xe8,
-	0x61, 0xc1, 0x52, 0x05, 0xa8, 0x79, 0xb8, 0x6f, 0xea, 0x99, 0x31, 0xf3, 0xa1, 0x32, 0xf3, 0xb1,
-	0x31, 0xcf, 0xb8, 0x7f, 0xa2, 0x4a, 0x2e, 0xe6, 0x15, 0x93, 0x92, 0x85, 0xe2, 0x07, 0x51, 0x34,
-	0x81, 0x96, 0xf4, 0xf7, 0x48, 0x44, 0x7c, 0x2c, 0x88, 0x42, 0xda, 0x3c, 0xfc, 0xec, 0x11, 0xdf,
-	0x51, 0x2e, 0x9d, 0x94, 0xac, 0x66, 0xbc, 0xd8, 0xa2, 0x29, 0x6c, 0x48, 0xa7, 0x8c, 0x16, 0x5e,
-	0x6b, 0xca, 0xeb, 0xf3, 0x47, 0xbc, 0xce, 0x0b, 0xf1, 0xa4, 0x64, 0xb5, 0xe3, 0xe5, 0xc0, 0xbc,
-	0x73, 0x87, 0xf8, 0x21, 0xb5, 0x53, 0x52, 0xb8, 0xae, 0x3f, 0xd9, 0xf9, 0x50, 0x96, 0x58, 0x64,
-	0xc9, 0x5a, 0x76, 0xfe, 0xaf, 0x28, 0xfa, 0x0d, 0x76, 0x14, 0x59, 0x4c, 0x5d, 0x12, 0xd9, 0x19,
-	0x75, 0x18, 0xf5, 0x42, 0x5a, 0xa0, 0x08, 0x19, 0xed, 0x36, 0xd4, 0x51, 0x47, 0x8f, 0x41, 0x56,
-	0xd5, 0xe7, 0xf3, 0xe2, 0x51, 0x51, 0x3b, 0x29, 0x59, 0x9f, 0xc4, 0x8f, 0xe4, 0x87, 0x35, 0xa8,
-	0xc4, 0xdc, 0xef, 0xff, 0x6e, 0xc0, 0xc6, 0x05, 0x8e, 0x7e, 0x16, 0x58, 0x90, 0xf3, 0xc4, 0x93,
-	0x17, 0x3b, 0x82, 0x1a, 0x97, 0x5b, 0x35, 0x82, 0x1b, 0x87, 0x3d, 0x73, 0xc5, 0x23, 0x64, 0x0e,
-	0x19, 0xf5, 0x54, 0x91, 0xa5, 0xc5, 0x0f, 0x86, 0xb1, 0xfc, 0xd4, 0x30, 0x56, 0x9e, 0x3d, 0x8c,
-	0x7d, 0x06, 0xa8, 0x98, 0x9c, 0x1f, 0xc3, 0x4b, 0xe2, 0x5e, 0xbb, 0x11, 0x41, 0xaf, 0x60, 0x7d,
-	0x86, 0x23, 0x1b, 0x7b, 0x9e, 0x7e, 0x89, 0x1a, 0xd6, 0xda, 0x0c, 0x47, 0xc7, 0x9e, 0x97, 0xa2,
-	0x6f, 0x75, 0x2a, 0x0a, 0x2f, 0x49, 0xb7, 0xbc, 0x5b, 0x51, 0x93, 0xb5, 0xaa, 0x9b, 0xfb, 0x04,
-	0x54, 0xbd, 0xf4, 0xef, 0x7f, 0x30, 0xe0, 0xe5, 0x82, 0xd9, 0xff, 0x87, 0xb4, 0x7c, 0xd5, 0xf2,
-	0xfd, 0xab, 0x1e, 0x40, 0x1d, 0xc7, 0x2c, 0xa3, 0x22, 0x07, 0xf3, 0x6a, 0xfe, 0xd5, 0xe5, 0x73,
-	0x5c, 0x7c, 0xf2, 0x13, 0x16, 0x52, 0x2b, 0x17, 0x3e, 0x40, 0x5e, 0x7d, 0x0a, 0x79, 0xed, 0xf9,
-	0xc8, 0xdf, 0xc1, 0xe6, 0x02, 0xc0, 0x3d, 0xe6, 0x1e, 0xb9, 0xcf, 0xdc, 0x23, 0xba, 0x91, 0xb1,
-	0x4e, 0x2d, 0x31, 0xdf, 0x5f, 0x09, 0x67, 0x25, 0x57, 0x65, 0xa3, 0xd0, 0x7f, 0x03, 0x8d, 0xc5,
-	0x2b, 0x81, 0xa0, 0x5a, 0x1c, 0xd5, 0xb2, 0xd4, 0x1a, 0x6d, 0x41, 0x2d, 0x61, 0xef, 0x88, 0x06,
-	0x59, 0xb1, 0xf4, 0x66, 0x7f, 0x0a, 0x8d, 0x82, 0x3a, 0x6a, 0xc2, 0xda, 0x89, 0x35, 0x3e, 0x7e,
-	0x33, 0x1e, 0x75, 0x4a, 0x08, 0xa0, 0x3e, 0x7c, 0x3d, 0x1d, 0x8d, 0x47, 0x1d, 0x03, 0xb5, 0xa1,
-	0x71, 0x3e, 0x95, 0xbb, 0xd3, 0xe9, 0xf7, 0x9d, 0x32, 0x6a, 0xc1, 0xba, 0xde, 0x8e, 0x47, 0x9d,
-	0x8a, 0xac, 0xb2, 0xc6, 0x67, 0xaf, 0x2f, 0xc6, 0xa3, 0x4e, 0x75, 0xf8, 0xc3, 0x1f, 0xb7, 0x3d,
-	0xe3, 0xe6, 0xb6, 0x67, 0xfc, 0x7d, 0xdb, 0x33, 0xde, 0xdf, 0xf5, 0x4a, 0x37, 0x77, 0xbd, 0xd2,
-	0x9f, 0x77, 0xbd, 0xd2, 0x2f, 0x5f, 0xf9, 0xa1, 0x08, 0x32, 0xc7, 0x74, 0x59, 0x3c, 0xc8, 0xfb,
-	0x73, 0x03, 0x1c, 0xd2, 0xf9, 0x66, 0x70, 0xb5, 0xf8, 0xb3, 0x8b, 0xeb, 0x84, 0x70, 0xa7, 0xae,
-	0x80, 0x7f, 0xfd, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x06, 0x18, 0x1e, 0x99, 0xfa, 0x07, 0x00,
-	0x00,
+	// 1010 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0xdf, 0x6e, 0xe3, 0xc4,
+	0x17, 0xc7, 0xe3, 0xfc, 0x69, 0x9b, 0x71, 0xd2, 0xcd, 0x4e, 0xbb, 0x3f, 0xa5, 0xfd, 0xa1, 0xa4,
+	0x04, 0x81, 0xaa, 0x02, 0x36, 0x29, 0xe5, 0x16, 0xd4, 0x34, 0x11, 0x29, 0xda, 0x66, 0xc1, 0xbb,
+	0xed, 0x05, 0x17, 0x58, 0x63, 0x7b, 0x62, 0x5b, 0xb5, 0x3d, 0x96, 0x67, 0x92, 0x6d, 0x2f, 0x78,
+	0x87, 0x3e, 0x07, 0xd7, 0x3c, 0xc4, 0x5e, 0x56, 0x5c, 0x21, 0x2e, 0x16, 0xd4, 0x3e, 0xc8, 0xa2,
+	0xf9, 0x13, 0x27, 0x55, 0xa3, 0x56, 0x0b, 0x12, 0x77, 0x73, 0xce, 0xf9, 0x9e, 0xe3, 0x33, 0x9f,
+	0x73, 0x32, 0x0a, 0xe8, 0x38, 0xc8, 0xb9, 0x8c, 0x48, 0x62, 0xe2, 0x94, 0xb8, 0x41, 0x98, 0xf8,
+	0xe6, 0xb4, 0x9b, 0x9f, 0x8d, 0x34, 0x23, 0x8c, 0xc0, 0x0d, 0xa5, 0x31, 0x72, 0xff, 0xb4, 0xbb,
+	0xdd, 0xf6, 0x09, 0xf1, 0x23, 0x6c, 0x0a, 0x89, 0x33, 0x19, 0x9b, 0x2c, 0x8c, 0x31, 0x65, 0x28,
+	0x4e, 0x65, 0xd6, 0xf6, 0xa6, 0x4f, 0x7c, 0x22, 0x8e, 0x26, 0x3f, 0x29, 0xef, 0x96, 0x4b, 0x68,
+	0x4c, 0xa8, 0x2d, 0x03, 0xd2, 0x50, 0xa1, 0xb6, 0xb4, 0x4c, 0xca, 0xd0, 0xb9, 0x6c, 0xc4, 0xc1,
+	0x0c, 0x75, 0x4d, 0x76, 0xa1, 0x04, 0x2d, 0x25, 0x70, 0x10, 0xc5, 0x79, 0xd4, 0x25, 0x61, 0x22,
+	0xe3, 0x9d, 0xeb, 0x22, 0xa8, 0x0c, 0x78, 0x8b, 0xf0, 0x43, 0x50, 0x13, 0xbd, 0xda, 0xc9, 0x24,
+	0x76, 0x70, 0xd6, 0xd4, 0x76, 0xb4, 0xdd, 0xb2, 0xa5, 0x0b, 0xdf, 0x48, 0xb8, 0xe0, 0x01, 0xf8,
+	0x9f, 0x3b, 0xc9, 0x32, 0x9c, 0x30, 0x5b, 0x4a, 0xc3, 0x84, 0xe1, 0x6c, 0x8a, 0xa2, 0x66, 0x51,
+	0x88, 0x37, 0x55, 0x54, 0x14, 0x3c, 0x56, 0x31, 0xf8, 0x19, 0x80, 0xe3, 0x30, 0xa3, 0xcc, 0x76,
+	0x22, 0xe2, 0x9e, 0xdb, 0x01, 0x0e, 0xfd, 0x80, 0x35, 0x4b, 0x22, 0xa3, 0x21, 0x22, 0x3d, 0x1e,
+	0x18, 0x0a, 0x3f, 0x1c, 0x82, 0x27, 0x11, 0xca, 0xc5, 0x1c, 0x50, 0xb3, 0xbc, 0xa3, 0xed, 0xea,
+	0xfb, 0xdb, 0x86, 0xa4, 0x67, 0xcc, 0xe8, 0x19, 0xaf, 0x66, 0xf4, 0x7a, 0xe5, 0xab, 0x3f, 0xdb,
+	0x9a, 0x55, 0x8f, 0x90, 0xaa, 0xc5, 0x23, 0xb0, 0x03, 0xea, 0x28, 0x4d, 0xed, 0x00, 0xd1, 0xc0,
+	0xce, 0x08, 0x61, 0xcd, 0xca, 0x8e, 0xb6, 0x5b, 0xb3, 0x74, 0x94, 0xa6, 0x43, 0x44, 0x03, 0x8b,
+	0x10, 0x06, 0x3f, 0x01, 0x4f, 0x28, 0x46, 0x11, 0xce, 0xec, 0x99, 0xb4, 0xb9, 0x22, 0x54, 0x75,
+	0xe9, 0x3e, 0x94, 0x5a, 0xb8, 0x07, 0x9e, 0x2a, 0x9d, 0xba, 0x04, 0x57, 0xae, 0x0a, 0xa5, 0x2a,
+	0x20, 0xef, 0x80, 0x68, 0xd0, 0x79, 0x57, 0x06, 0xf5, 0x1f, 0x26, 0x78, 0x82, 0xbd, 0x13, 0x4c,
+	0x29, 0xf2, 0x31, 0xdc, 0x00, 0x15, 0x76, 0x61, 0x87, 0x9e, 0x60, 0x5a, 0xb3, 0xca, 0xec, 0xe2,
+	0xd8, 0x83, 0xcf, 0xc0, 0x4a, 0x4c, 0x7d, 0xee, 0x2d, 0x0a, 0x6f, 0x25, 0xa6, 0xfe, 0xb1, 0xc7,
+	0xc7, 0xb0, 0x84, 0x93, 0xee, 0x2c, 0x20, 0xfa, 0x06, 0x80, 0x7f, 0x40, 0xa7, 0xea, 0xe4, 0x64,
+	0x7e, 0x02, 0x9b, 0xfc, 0xd3, 0x6e, 0x86, 0x11, 0xc3, 0xf6, 0x14, 0x45, 0xa1, 0x87, 0x18, 0xc9,
+	0x04, 0x20, 0x7d, 0x7f, 0xcf, 0x50, 0x2b, 0xa6, 0x96, 0xca, 0x50, 0x6b, 0x63, 0x9c, 0x50, 0xff,
+	0x48, 0xa4, 0x9c, 0xcd, 0x32, 0x86, 0x05, 0x0b, 0xc6, 0xf7, 0xbc, 0x70, 0x08, 0x6a, 0xbc, 0xbe,
+	0x87, 0x23, 0xec, 0x23, 0x86, 0x05, 0x52, 0x7d, 0xff, 0xa3, 0x07, 0xea, 0xf6, 0x95, 0x74, 0x58,
+	0xb0, 0xf4, 0x78, 0x6e, 0xc2, 0x11, 0x58, 0xe7, 0x95, 0x26, 0x49, 0x5e, 0x6b, 0x55, 0xd4, 0xfa,
+	0xf8, 0x81, 0x5a, 0xa7, 0xb9, 0x78, 0x58, 0xb0, 0xea, 0xf1, 0xa2, 0x63, 0x76, 0x73, 0x07, 0xfb,
+	0x61, 0x62, 0x67, 0x38, 0xaf, 0xba, 0xf6, 0xe8, 0xcd, 0x7b, 0x3c, 0xc5, 0xc2, 0x0b, 0xa5, 0x61,
+	0x7c, 0xcf, 0x0b, 0x7f, 0x06, 0x6d, 0x41, 0x16, 0x25, 0x2e, 0x8e, 0xec, 0x49, 0xe2, 0x90, 0xc4,
+	0x0b, 0x93, 0x1c, 0x45, 0x48, 0x92, 0x66, 0x55, 0x7c, 0xea, 0xe0, 0x21, 0xc8, 0x22, 0xfb, 0x74,
+	0x96, 0xdc, 0xcf, 0x73, 0x87, 0x05, 0xeb, 0x83, 0xf8, 0x81, 0x78, 0xaf, 0x02, 0x4a, 0x31, 0xf5,
+	0x3b, 0xbf, 0x68, 0x60, 0xfd, 0x0c, 0x45, 0x2f, 0x19, 0x62, 0xf8, 0x34, 0xf5, 0x78, 0x63, 0x07,
+	0xa0, 0x42, 0xb9, 0x29, 0x56, 0x70, 0x7d, 0xbf, 0x65, 0x2c, 0x79, 0x9f, 0x8c, 0x1e, 0x49, 0x3c,
+	0x91, 0x64, 0x49, 0xf1, 0xbd, 0x65, 0x2c, 0x3e, 0xb6, 0x8c, 0xa5, 0xf7, 0x5e, 0xc6, 0x0e, 0x01,
+	0x30, 0xdf, 0x9c, 0xe7, 0xe1, 0x18, 0xbb, 0x97, 0x6e, 0x84, 0xe1, 0x16, 0x58, 0x9b, 0xa2, 0xc8,
+	0x46, 0x9e, 0x27, 0x5f, 0xa2, 0xaa, 0xb5, 0x3a, 0x45, 0xd1, 0xa1, 0xe7, 0x65, 0xf0, 0x6b, 0x19,
+	0x8a, 0xc2, 0x31, 0x6e, 0x16, 0x77, 0x4a, 0x62, 0xb3, 0x96, 0xdd, 0xe6, 0x2e, 0x01, 0x91, 0xcf,
+	0xeb, 0x77, 0xde, 0x69, 0xe0, 0xd9, 0x9c, 0xd9, 0xbf, 0x87, 0xb4, 0xd8, 0x6a, 0xf1, 0x6e, 0xab,
+	0x5d, 0xb0, 0x82, 0x62, 0x32, 0x49, 0x98, 0x02, 0xb3, 0x35, 0x9b, 0x3a, 0x7f, 0x8e, 0xf3, 0x91,
+	0x1f, 0x91, 0x30, 0xb1, 0x94, 0xf0, 0x1e, 0xf2, 0xf2, 0x63, 0xc8, 0x2b, 0xef, 0x8f, 0xfc, 0x35,
+	0xd8, 0x98, 0x03, 0xb8, 0xc3, 0xdc, 0xc3, 0x77, 0x99, 0x7b, 0x58, 0x5e, 0x64, 0x20, 0x43, 0x0b,
+	0xcc, 0xf7, 0x96, 0xc2, 0x59, 0xca, 0x55, 0x94, 0x11, 0xe8, 0xbf, 0x02, 0xd5, 0xf9, 0x2b, 0x01,
+	0x41, 0x39, 0xff, 0x54, 0xcd, 0x12, 0x67, 0xb8, 0x09, 0x2a, 0x29, 0x79, 0x8d, 0x25, 0xc8, 0x92,
+	0x25, 0x8d, 0xce, 0x55, 0x11, 0xe8, 0x2f, 0x23, 0xfe, 0x66, 0x63, 0x97, 0x64, 0x1e, 0xfc, 0x14,
+	0x3c, 0xcd, 0x1f, 0x2d, 0xd1, 0x2e, 0xa6, 0x54, 0x75, 0xdc, 0xc8, 0x03, 0x87, 0xd2, 0x0f, 0x4f,
+	0xc0, 0xda, 0x38, 0x43, 0xae, 0xf8, 0xed, 0x89, 0xf1, 0xf4, 0xba, 0x6f, 0xde, 0xb6, 0x0b, 0x7f,
+	0xbc, 0x6d, 0xff, 0x5f, 0x0e, 0x83, 0x7a, 0xe7, 0x46, 0x48, 0xcc, 0x18, 0xb1, 0xc0, 0x78, 0x8e,
+	0x7d, 0xe4, 0x5e, 0xf6, 0xb1, 0xfb, 0xdb, 0xaf, 0x9f, 0x03, 0x35, 0xab, 0x3e, 0x76, 0xad, 0xbc,
+	0x04, 0x9f, 0xcf, 0x94, 0x30, 0xfe, 0x9b, 0x96, 0x8d, 0x96, 0x44, 0xa3, 0xba, 0xf4, 0x7d, 0xcf,
+	0x5d, 0xff, 0xc5, 0x08, 0xf7, 0x46, 0xa0, 0x9a, 0x2f, 0x22, 0xd4, 0xc1, 0xea, 0x91, 0x35, 0x38,
+	0x7c, 0x35, 0xe8, 0x37, 0x0a, 0x10, 0x80, 0x95, 0xde, 0x8b, 0x51, 0x7f, 0xd0, 0x6f, 0x68, 0xb0,
+	0x0e, 0xaa, 0xa7, 0x23, 0x6e, 0x1d, 0x8f, 0xbe, 0x6d, 0x14, 0x61, 0x0d, 0xac, 0x49, 0x73, 0xd0,
+	0x6f, 0x94, 0x78, 0x96, 0x35, 0x38, 0x79, 0x71, 0x36, 0xe8, 0x37, 0xca, 0xbd, 0xef, 0xde, 0xdc,
+	0xb4, 0xb4, 0xeb, 0x9b, 0x96, 0xf6, 0xd7, 0x4d, 0x4b, 0xbb, 0xba, 0x6d, 0x15, 0xae, 0x6f, 0x5b,
+	0x85, 0xdf, 0x6f, 0x5b, 0x85, 0x1f, 0xbf, 0xf0, 0x43, 0x16, 0x4c, 0x1c, 0xc3, 0x25, 0xb1, 0xa9,
+	0x46, 0xee, 0x06, 0x28, 0x4c, 0x66, 0x86, 0x79, 0x31, 0xff, 0x1f, 0xc4, 0x2e, 0x53, 0x4c, 0x9d,
+	0x15, 0x71, 0x81, 0x2f, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xee, 0xe2, 0xd4, 0x05, 0x28, 0x09,
+	0x00, 0x00,
 }
 
 func (m *Epoch) Marshal() (dAtA []byte, err error) {
@@ -1184,6 +1272,66 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SlashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTime != nil {
+		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.BlockTime):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintEpoching(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEpoching(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VotingPower != 0 {
+		i = encodeVarintEpoching(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEpoching(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintEpoching(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEpoching(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEpoching(v)
 	base := offset
@@ -1416,6 +1564,31 @@ func (m *Validator) Size() (n int) {
 	return n
 }
 
+func (m *SlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEpoching(uint64(l))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovEpoching(uint64(l))
+	if m.VotingPower != 0 {
+		n += 1 + sovEpoching(uint64(m.VotingPower))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEpoching(uint64(m.BlockHeight))
+	}
+	if m.BlockTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.BlockTime)
+		n += 1 + l + sovEpoching(uint64(l))
+	}
+	return n
+}
+
 func sovEpoching(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2666,6 +2839,196 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SlashRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEpoching
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockTime == nil {
+				m.BlockTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEpoching(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEpoching(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -23,4 +23,6 @@ var (
 	ErrInvalidEpoch              = errorsmod.Register(ModuleName, 12, "the epoch is invalid")
 	ErrInvalidHeight             = errorsmod.Register(ModuleName, 13, "the height is invalid")
 	ErrInsufficientBalance       = errorsmod.Register(ModuleName, 14, "the delegator has insufficient balance to perform delegate")
+	ErrQueuedMsgNotFound         = errorsmod.Register(ModuleName, 15, "the queued message is not found in the current epoch")
+	ErrQueuedMsgNotCancellable   = errorsmod.Register(ModuleName, 16, "the queued message cannot be cancelled")
 )
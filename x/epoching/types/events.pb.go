@@ -583,6 +583,68 @@ func (m *EventWrappedCancelUnbondingDelegation) GetEpochBoundary() uint64 {
 	return 0
 }
 
+// EventCancelQueuedMsg is the event emitted when a queued message has been
+// withdrawn by its sender
+type EventCancelQueuedMsg struct {
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	Sender      string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	MsgId       []byte `protobuf:"bytes,3,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+}
+
+func (m *EventCancelQueuedMsg) Reset()         { *m = EventCancelQueuedMsg{} }
+func (m *EventCancelQueuedMsg) String() string { return proto.CompactTextString(m) }
+func (*EventCancelQueuedMsg) ProtoMessage()    {}
+func (*EventCancelQueuedMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f0a2c43c7aaeb43, []int{8}
+}
+func (m *EventCancelQueuedMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelQueuedMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelQueuedMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCancelQueuedMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelQueuedMsg.Merge(m, src)
+}
+func (m *EventCancelQueuedMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelQueuedMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelQueuedMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelQueuedMsg proto.InternalMessageInfo
+
+func (m *EventCancelQueuedMsg) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EventCancelQueuedMsg) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventCancelQueuedMsg) GetMsgId() []byte {
+	if m != nil {
+		return m.MsgId
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventBeginEpoch)(nil), "babylon.epoching.v1.EventBeginEpoch")
 	proto.RegisterType((*EventEndEpoch)(nil), "babylon.epoching.v1.EventEndEpoch")
@@ -592,55 +654,57 @@ func init() {
 	proto.RegisterType((*EventWrappedUndelegate)(nil), "babylon.epoching.v1.EventWrappedUndelegate")
 	proto.RegisterType((*EventWrappedBeginRedelegate)(nil), "babylon.epoching.v1.EventWrappedBeginRedelegate")
 	proto.RegisterType((*EventWrappedCancelUnbondingDelegation)(nil), "babylon.epoching.v1.EventWrappedCancelUnbondingDelegation")
+	proto.RegisterType((*EventCancelQueuedMsg)(nil), "babylon.epoching.v1.EventCancelQueuedMsg")
 }
 
 func init() { proto.RegisterFile("babylon/epoching/v1/events.proto", fileDescriptor_2f0a2c43c7aaeb43) }
 
 var fileDescriptor_2f0a2c43c7aaeb43 = []byte{
-	// 674 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcd, 0x6e, 0xd4, 0x3c,
-	0x14, 0x6d, 0xe6, 0xef, 0x53, 0xfd, 0xf5, 0xd7, 0x33, 0x8c, 0x22, 0x2a, 0x86, 0x61, 0xa4, 0x8a,
-	0x4a, 0xc0, 0xa4, 0x05, 0x84, 0x10, 0xbb, 0x0e, 0x54, 0x6a, 0x91, 0x40, 0x10, 0xda, 0x22, 0xb1,
-	0x89, 0x9c, 0xd8, 0x24, 0x16, 0x89, 0x1d, 0xd9, 0xce, 0xd0, 0x79, 0x0b, 0x5e, 0x80, 0x1d, 0x0f,
-	0xc0, 0x4b, 0x20, 0x58, 0x76, 0x89, 0x58, 0x20, 0xd4, 0xae, 0x78, 0x0b, 0x14, 0xe7, 0xa7, 0x81,
-	0x4e, 0x51, 0xc5, 0x06, 0xb1, 0xf3, 0x3d, 0xe7, 0xdc, 0xeb, 0x9c, 0x7b, 0x1d, 0x1b, 0xf4, 0x5d,
-	0xe4, 0x4e, 0x42, 0xce, 0x2c, 0x12, 0x73, 0x2f, 0xa0, 0xcc, 0xb7, 0xc6, 0x1b, 0x16, 0x19, 0x13,
-	0xa6, 0xe4, 0x30, 0x16, 0x5c, 0x71, 0xd8, 0xce, 0x15, 0xc3, 0x42, 0x31, 0x1c, 0x6f, 0x5c, 0xec,
-	0xf8, 0xdc, 0xe7, 0x9a, 0xb7, 0xd2, 0x55, 0x26, 0x1d, 0xdc, 0x06, 0x8b, 0x5b, 0x69, 0xea, 0x88,
-	0xf8, 0x94, 0x6d, 0xa5, 0x72, 0x78, 0x05, 0xcc, 0xe9, 0x3c, 0x87, 0x25, 0x91, 0x4b, 0x84, 0x69,
-	0xf4, 0x8d, 0xb5, 0x86, 0xfd, 0xbf, 0xc6, 0x1e, 0x6b, 0x68, 0x70, 0x13, 0xcc, 0xeb, 0xac, 0x2d,
-	0x86, 0xcf, 0x9d, 0xf3, 0xbe, 0x06, 0x3a, 0x3a, 0x69, 0x1b, 0x31, 0x1c, 0x92, 0xa7, 0x09, 0x49,
-	0x08, 0x7e, 0x24, 0x7d, 0x38, 0x04, 0x6d, 0x2e, 0xa8, 0x4f, 0x19, 0x0a, 0x1d, 0x6d, 0xc3, 0x51,
-	0x93, 0x98, 0xe8, 0x12, 0xb3, 0xf6, 0x72, 0x41, 0xe9, 0xd4, 0xdd, 0x49, 0x4c, 0x4e, 0xed, 0x55,
-	0x3b, 0xb5, 0x17, 0xec, 0x82, 0x56, 0x40, 0xa8, 0x1f, 0x28, 0xb3, 0xae, 0xc9, 0x3c, 0x82, 0x6d,
-	0xd0, 0x54, 0x07, 0x0e, 0xc5, 0x66, 0xa3, 0x6f, 0xac, 0xcd, 0xd9, 0x0d, 0x75, 0xb0, 0x83, 0xe1,
-	0x05, 0xd0, 0x8a, 0xa4, 0x9f, 0xa2, 0x4d, 0x8d, 0x36, 0x23, 0xe9, 0xef, 0x60, 0xf8, 0xaa, 0xf2,
-	0x59, 0x48, 0x29, 0x41, 0xdd, 0x44, 0x11, 0x69, 0xb6, 0xfa, 0xf5, 0xb5, 0xb9, 0xd1, 0xbd, 0x2f,
-	0x5f, 0x2f, 0xdf, 0xf1, 0xa9, 0x0a, 0x12, 0x77, 0xe8, 0xf1, 0xc8, 0xf2, 0x78, 0x44, 0x94, 0xfb,
-	0x52, 0x9d, 0x2c, 0x90, 0xeb, 0x51, 0x2b, 0x35, 0x22, 0x87, 0xfa, 0xd3, 0x37, 0x8b, 0x12, 0x36,
-	0x2c, 0xca, 0x96, 0x90, 0x84, 0x1d, 0xd0, 0x24, 0x42, 0x70, 0x61, 0xfe, 0xa7, 0x5d, 0x67, 0xc1,
-	0xe0, 0x9d, 0x01, 0xda, 0x3a, 0xf9, 0x59, 0x88, 0x64, 0xb0, 0x1b, 0x08, 0x22, 0x03, 0x1e, 0x62,
-	0xb8, 0x0e, 0x3a, 0x32, 0x45, 0x08, 0x76, 0xc6, 0x5c, 0x51, 0xe6, 0x3b, 0x31, 0x7f, 0x9d, 0x77,
-	0xbd, 0x6e, 0xc3, 0x9c, 0xdb, 0xd7, 0xd4, 0x93, 0x94, 0x81, 0xd7, 0x01, 0x54, 0x5c, 0xa1, 0xf0,
-	0x67, 0x7d, 0x4d, 0xeb, 0x97, 0x34, 0x53, 0x55, 0xdf, 0x00, 0xb0, 0xac, 0x8f, 0x42, 0x8a, 0x91,
-	0xe2, 0x42, 0x9a, 0xf5, 0xd4, 0xb9, 0xbd, 0x5c, 0x54, 0x2f, 0x89, 0xc1, 0x07, 0x23, 0x9f, 0xec,
-	0x73, 0x81, 0xe2, 0x98, 0xe0, 0x07, 0x24, 0x24, 0x3e, 0x52, 0x04, 0x5e, 0x03, 0xcb, 0x38, 0x5b,
-	0x73, 0xe1, 0x20, 0x8c, 0x05, 0x91, 0x32, 0x9f, 0xeb, 0x52, 0x49, 0x6c, 0x66, 0x78, 0x2a, 0x2e,
-	0x37, 0x2b, 0xc5, 0xb5, 0x4c, 0x5c, 0x12, 0x85, 0xb8, 0x0b, 0x5a, 0x28, 0xe2, 0x09, 0x2b, 0x07,
-	0x9c, 0x45, 0x69, 0x1f, 0x31, 0x61, 0x3c, 0xd2, 0x03, 0x9e, 0xb5, 0xb3, 0x00, 0xae, 0x82, 0x85,
-	0xec, 0xc4, 0xb8, 0x3c, 0x61, 0x18, 0x89, 0x89, 0x9e, 0x74, 0xc3, 0x9e, 0xd7, 0xe8, 0x28, 0x07,
-	0x07, 0x1f, 0x0d, 0xd0, 0xad, 0xfa, 0xd8, 0x63, 0xf8, 0x1f, 0x75, 0xf2, 0xb6, 0x06, 0x56, 0xaa,
-	0x4e, 0xf4, 0xdf, 0x6d, 0x93, 0x3f, 0xb3, 0x73, 0x17, 0x98, 0x92, 0x27, 0xc2, 0x23, 0xce, 0x59,
-	0xae, 0xba, 0x19, 0xbf, 0xff, 0xab, 0xb7, 0x11, 0xb8, 0x84, 0x89, 0x54, 0x94, 0x21, 0x45, 0x39,
-	0x9b, 0x92, 0x5e, 0xd7, 0xe9, 0x2b, 0x15, 0xd1, 0xfe, 0xd9, 0xfd, 0x69, 0x4c, 0xef, 0x4f, 0xf3,
-	0xf7, 0xfd, 0x69, 0x4d, 0xeb, 0xcf, 0x77, 0x03, 0xac, 0x56, 0xfb, 0x73, 0x1f, 0x31, 0x8f, 0x84,
-	0x7b, 0xcc, 0xe5, 0x0c, 0x53, 0xe6, 0xe7, 0x07, 0x98, 0x72, 0xf6, 0x17, 0x06, 0x7f, 0x15, 0x2c,
-	0x7a, 0x82, 0x64, 0x1d, 0xcb, 0x2f, 0xb1, 0x86, 0xfe, 0x4f, 0x17, 0x0a, 0x78, 0x3b, 0xbb, 0xcc,
-	0xce, 0x77, 0x16, 0x46, 0x0f, 0x3f, 0x1d, 0xf5, 0x8c, 0xc3, 0xa3, 0x9e, 0xf1, 0xed, 0xa8, 0x67,
-	0xbc, 0x39, 0xee, 0xcd, 0x1c, 0x1e, 0xf7, 0x66, 0x3e, 0x1f, 0xf7, 0x66, 0x5e, 0xac, 0x57, 0x2e,
-	0xb0, 0xfc, 0xc5, 0xf0, 0x02, 0x44, 0x59, 0x11, 0x58, 0x07, 0x27, 0x4f, 0x8c, 0xbe, 0xc9, 0xdc,
-	0x96, 0x7e, 0x34, 0x6e, 0xfd, 0x08, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x83, 0x9b, 0xc9, 0x83, 0x06,
-	0x00, 0x00,
+	// 699 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcb, 0x6e, 0x13, 0x31,
+	0x14, 0xed, 0xe4, 0x85, 0x6a, 0xfa, 0x74, 0x42, 0x14, 0x51, 0x11, 0x42, 0xa4, 0x8a, 0x4a, 0x40,
+	0xd2, 0x02, 0x42, 0x88, 0x5d, 0x03, 0x95, 0x5a, 0x24, 0x10, 0x0c, 0x6d, 0x91, 0xd8, 0x8c, 0x3c,
+	0xe3, 0xcb, 0x8c, 0xc5, 0xc4, 0x8e, 0x6c, 0x4f, 0x68, 0xfe, 0x82, 0x1f, 0x60, 0xc7, 0x07, 0xf0,
+	0x13, 0x08, 0x96, 0x5d, 0x22, 0x16, 0x08, 0xb5, 0x2b, 0xfe, 0x02, 0x8d, 0xe7, 0xd1, 0x81, 0xb6,
+	0x50, 0xb1, 0x41, 0xec, 0xe6, 0x9e, 0x73, 0xae, 0xc7, 0xe7, 0x5e, 0xfb, 0x1a, 0x75, 0x5c, 0xe2,
+	0x4e, 0x42, 0xc1, 0xfb, 0x30, 0x12, 0x5e, 0xc0, 0xb8, 0xdf, 0x1f, 0xaf, 0xf5, 0x61, 0x0c, 0x5c,
+	0xab, 0xde, 0x48, 0x0a, 0x2d, 0x70, 0x3d, 0x55, 0xf4, 0x32, 0x45, 0x6f, 0xbc, 0x76, 0xb1, 0xe1,
+	0x0b, 0x5f, 0x18, 0xbe, 0x1f, 0x7f, 0x25, 0xd2, 0xee, 0x6d, 0x34, 0xbf, 0x11, 0xa7, 0x0e, 0xc0,
+	0x67, 0x7c, 0x23, 0x96, 0xe3, 0x2b, 0x68, 0xc6, 0xe4, 0x39, 0x3c, 0x1a, 0xba, 0x20, 0x5b, 0x56,
+	0xc7, 0x5a, 0xa9, 0xd8, 0xe7, 0x0d, 0xf6, 0xd8, 0x40, 0xdd, 0x9b, 0x68, 0xd6, 0x64, 0x6d, 0x70,
+	0x7a, 0xe6, 0x9c, 0xf7, 0x25, 0xd4, 0x30, 0x49, 0x9b, 0x84, 0xd3, 0x10, 0x9e, 0x46, 0x10, 0x01,
+	0x7d, 0xa4, 0x7c, 0xdc, 0x43, 0x75, 0x21, 0x99, 0xcf, 0x38, 0x09, 0x1d, 0x63, 0xc3, 0xd1, 0x93,
+	0x11, 0x98, 0x25, 0xa6, 0xed, 0xc5, 0x8c, 0x32, 0xa9, 0xdb, 0x93, 0x11, 0x1c, 0xfb, 0x57, 0xe9,
+	0xd8, 0xbf, 0x70, 0x13, 0xd5, 0x02, 0x60, 0x7e, 0xa0, 0x5b, 0x65, 0x43, 0xa6, 0x11, 0xae, 0xa3,
+	0xaa, 0xde, 0x73, 0x18, 0x6d, 0x55, 0x3a, 0xd6, 0xca, 0x8c, 0x5d, 0xd1, 0x7b, 0x5b, 0x14, 0x5f,
+	0x40, 0xb5, 0xa1, 0xf2, 0x63, 0xb4, 0x6a, 0xd0, 0xea, 0x50, 0xf9, 0x5b, 0x14, 0xbf, 0x2a, 0x6c,
+	0x8b, 0x68, 0x2d, 0x99, 0x1b, 0x69, 0x50, 0xad, 0x5a, 0xa7, 0xbc, 0x32, 0x33, 0xb8, 0xf7, 0xe5,
+	0xeb, 0xe5, 0x3b, 0x3e, 0xd3, 0x41, 0xe4, 0xf6, 0x3c, 0x31, 0xec, 0x7b, 0x62, 0x08, 0xda, 0x7d,
+	0xa9, 0x8f, 0x3e, 0x88, 0xeb, 0xb1, 0x7e, 0x6c, 0x44, 0xf5, 0xcc, 0xd6, 0xd7, 0xb3, 0x25, 0x6c,
+	0x9c, 0x2d, 0x9b, 0x43, 0x0a, 0x37, 0x50, 0x15, 0xa4, 0x14, 0xb2, 0x75, 0xce, 0xb8, 0x4e, 0x82,
+	0xee, 0x3b, 0x0b, 0xd5, 0x4d, 0xf2, 0xb3, 0x90, 0xa8, 0x60, 0x3b, 0x90, 0xa0, 0x02, 0x11, 0x52,
+	0xbc, 0x8a, 0x1a, 0x2a, 0x46, 0x80, 0x3a, 0x63, 0xa1, 0x19, 0xf7, 0x9d, 0x91, 0x78, 0x9d, 0x56,
+	0xbd, 0x6c, 0xe3, 0x94, 0xdb, 0x35, 0xd4, 0x93, 0x98, 0xc1, 0xd7, 0x11, 0xd6, 0x42, 0x93, 0xf0,
+	0x67, 0x7d, 0xc9, 0xe8, 0x17, 0x0c, 0x53, 0x54, 0xdf, 0x40, 0x38, 0x5f, 0x9f, 0x84, 0x8c, 0x12,
+	0x2d, 0xa4, 0x6a, 0x95, 0x63, 0xe7, 0xf6, 0x62, 0xb6, 0x7a, 0x4e, 0x74, 0x3f, 0x58, 0x69, 0x67,
+	0x9f, 0x4b, 0x32, 0x1a, 0x01, 0x7d, 0x00, 0x21, 0xf8, 0x44, 0x03, 0xbe, 0x86, 0x16, 0x69, 0xf2,
+	0x2d, 0xa4, 0x43, 0x28, 0x95, 0xa0, 0x54, 0xda, 0xd7, 0x85, 0x9c, 0x58, 0x4f, 0xf0, 0x58, 0x9c,
+	0xff, 0x2c, 0x17, 0x97, 0x12, 0x71, 0x4e, 0x64, 0xe2, 0x26, 0xaa, 0x91, 0xa1, 0x88, 0x78, 0xde,
+	0xe0, 0x24, 0x8a, 0xeb, 0x48, 0x81, 0x8b, 0xa1, 0x69, 0xf0, 0xb4, 0x9d, 0x04, 0x78, 0x19, 0xcd,
+	0x25, 0x27, 0xc6, 0x15, 0x11, 0xa7, 0x44, 0x4e, 0x4c, 0xa7, 0x2b, 0xf6, 0xac, 0x41, 0x07, 0x29,
+	0xd8, 0xfd, 0x68, 0xa1, 0x66, 0xd1, 0xc7, 0x0e, 0xa7, 0xff, 0xa9, 0x93, 0xb7, 0x25, 0xb4, 0x54,
+	0x74, 0x62, 0x6e, 0xb7, 0x0d, 0x7f, 0x67, 0xe7, 0x2e, 0x6a, 0x29, 0x11, 0x49, 0x0f, 0x9c, 0xd3,
+	0x5c, 0x35, 0x13, 0x7e, 0xf7, 0x57, 0x6f, 0x03, 0x74, 0x89, 0x82, 0xd2, 0x8c, 0x13, 0xcd, 0x04,
+	0x3f, 0x21, 0xbd, 0x6c, 0xd2, 0x97, 0x0a, 0xa2, 0xdd, 0xd3, 0xeb, 0x53, 0x39, 0xb9, 0x3e, 0xd5,
+	0xdf, 0xd7, 0xa7, 0x76, 0x52, 0x7d, 0xbe, 0x5b, 0x68, 0xb9, 0x58, 0x9f, 0xfb, 0x84, 0x7b, 0x10,
+	0xee, 0x70, 0x57, 0x70, 0xca, 0xb8, 0x9f, 0x1e, 0x60, 0x26, 0xf8, 0x3f, 0x68, 0xfc, 0x55, 0x34,
+	0xef, 0x49, 0x48, 0x2a, 0x96, 0x0e, 0xb1, 0x8a, 0xb9, 0xa7, 0x73, 0x19, 0xbc, 0x69, 0xd0, 0xb3,
+	0x9e, 0x85, 0x20, 0xbd, 0x9c, 0x89, 0xc7, 0xa3, 0xb1, 0xfb, 0xe7, 0x91, 0x1d, 0x6f, 0x51, 0x01,
+	0xa7, 0xe9, 0xa4, 0x98, 0xb6, 0xd3, 0xa8, 0x30, 0x31, 0xcb, 0x85, 0x89, 0x39, 0x78, 0xf8, 0xe9,
+	0xa0, 0x6d, 0xed, 0x1f, 0xb4, 0xad, 0x6f, 0x07, 0x6d, 0xeb, 0xcd, 0x61, 0x7b, 0x6a, 0xff, 0xb0,
+	0x3d, 0xf5, 0xf9, 0xb0, 0x3d, 0xf5, 0x62, 0xb5, 0x30, 0x2a, 0xd3, 0xb7, 0xc9, 0x0b, 0x08, 0xe3,
+	0x59, 0xd0, 0xdf, 0x3b, 0x7a, 0xcc, 0xcc, 0xcc, 0x74, 0x6b, 0xe6, 0x79, 0xba, 0xf5, 0x23, 0x00,
+	0x00, 0xff, 0xff, 0x28, 0x17, 0x5d, 0x2c, 0xed, 0x06, 0x00, 0x00,
 }
 
 func (m *EventBeginEpoch) Marshal() (dAtA []byte, err error) {
@@ -1037,6 +1101,48 @@ func (m *EventWrappedCancelUnbondingDelegation) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *EventCancelQueuedMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelQueuedMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelQueuedMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgId) > 0 {
+		i -= len(m.MsgId)
+		copy(dAtA[i:], m.MsgId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1241,6 +1347,26 @@ func (m *EventWrappedCancelUnbondingDelegation) Size() (n int) {
 	return n
 }
 
+func (m *EventCancelQueuedMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovEvents(uint64(m.EpochNumber))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MsgId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2515,6 +2641,141 @@ func (m *EventWrappedCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventCancelQueuedMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelQueuedMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelQueuedMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgId = append(m.MsgId[:0], dAtA[iNdEx:postIndex]...)
+			if m.MsgId == nil {
+				m.MsgId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
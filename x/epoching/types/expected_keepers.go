@@ -38,6 +38,7 @@ type StakingKeeper interface {
 	IterateLastValidatorPowers(ctx context.Context, handler func(operator sdk.ValAddress, power int64) bool) error
 	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
 	GetValidatorDelegations(ctx context.Context, valAddr sdk.ValAddress) ([]stakingtypes.Delegation, error)
+	GetUnbondingDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.UnbondingDelegation, error)
 	HasMaxUnbondingDelegationEntries(ctx context.Context, delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress) (bool, error)
 	BondDenom(ctx context.Context) (string, error)
 	HasReceivingRedelegation(ctx context.Context, delAddr sdk.AccAddress, valDstAddr sdk.ValAddress) (bool, error)
@@ -62,6 +63,23 @@ type EpochingHooks interface {
 	BeforeSlashThreshold(ctx context.Context, valSet ValidatorSet) // Must be called before a certain threshold (1/3 or 2/3) of validators are slashed in a single epoch
 }
 
+// EpochingValSetHooks is an optional extension of EpochingHooks, whose hooks
+// carry the validator set of the epoch, so that the subscribing modules do
+// not need to re-derive it from the staking module. The hooks are invoked
+// right after the corresponding EpochingHooks.
+type EpochingValSetHooks interface {
+	// Must be called after an epoch begins, with the validator set of this
+	// epoch and its difference from the validator set of the previous epoch
+	AfterEpochBeginsWithValSet(ctx context.Context, epoch uint64, valSet ValidatorSet, diff ValidatorSetDiff)
+	// Must be called after an epoch ends, with the difference between the
+	// validator set of this epoch and the validator set of the next epoch
+	AfterEpochEndsWithValSet(ctx context.Context, epoch uint64, diff ValidatorSetDiff)
+	// Must be called before a certain threshold of validators are slashed in
+	// a single epoch, with the validator set of this epoch and the slashed
+	// validators
+	BeforeSlashThresholdWithValSet(ctx context.Context, epoch uint64, valSet ValidatorSet, slashedVals ValidatorSet)
+}
+
 // StakingHooks event hooks for staking validator object (noalias)
 type StakingHooks interface {
 	BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction math.LegacyDec)              // Must be called right before a validator is slashed
@@ -20,6 +20,10 @@ func NewGenesis(params Params) *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-
+	if gs.PendingParams != nil {
+		if err := gs.PendingParams.Validate(); err != nil {
+			return err
+		}
+	}
 	return gs.Params.Validate()
 }
@@ -6,6 +6,7 @@ import (
 
 // combine multiple Epoching hooks, all hook functions are run in array sequence
 var _ EpochingHooks = &MultiEpochingHooks{}
+var _ EpochingValSetHooks = &MultiEpochingHooks{}
 
 type MultiEpochingHooks []EpochingHooks
 
@@ -30,3 +31,41 @@ func (h MultiEpochingHooks) BeforeSlashThreshold(ctx context.Context, valSet Val
 		h[i].BeforeSlashThreshold(ctx, valSet)
 	}
 }
+
+// HasValSetHooks returns whether any of the hooks implements
+// EpochingValSetHooks
+func (h MultiEpochingHooks) HasValSetHooks() bool {
+	for i := range h {
+		if _, ok := h[i].(EpochingValSetHooks); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// AfterEpochBeginsWithValSet runs the hooks that implement EpochingValSetHooks
+func (h MultiEpochingHooks) AfterEpochBeginsWithValSet(ctx context.Context, epoch uint64, valSet ValidatorSet, diff ValidatorSetDiff) {
+	for i := range h {
+		if vh, ok := h[i].(EpochingValSetHooks); ok {
+			vh.AfterEpochBeginsWithValSet(ctx, epoch, valSet, diff)
+		}
+	}
+}
+
+// AfterEpochEndsWithValSet runs the hooks that implement EpochingValSetHooks
+func (h MultiEpochingHooks) AfterEpochEndsWithValSet(ctx context.Context, epoch uint64, diff ValidatorSetDiff) {
+	for i := range h {
+		if vh, ok := h[i].(EpochingValSetHooks); ok {
+			vh.AfterEpochEndsWithValSet(ctx, epoch, diff)
+		}
+	}
+}
+
+// BeforeSlashThresholdWithValSet runs the hooks that implement EpochingValSetHooks
+func (h MultiEpochingHooks) BeforeSlashThresholdWithValSet(ctx context.Context, epoch uint64, valSet ValidatorSet, slashedVals ValidatorSet) {
+	for i := range h {
+		if vh, ok := h[i].(EpochingValSetHooks); ok {
+			vh.BeforeSlashThresholdWithValSet(ctx, epoch, valSet, slashedVals)
+		}
+	}
+}
@@ -29,6 +29,8 @@ var (
 	DelegationLifecycleKey = []byte{0x19} // key prefix for delegation life cycle
 	AppHashKey             = []byte{0x20} // key prefix for the app hash
 	ParamsKey              = []byte{0x21} // key prefix for the parameters
+	PendingParamsKey       = []byte{0x22} // key prefix for the parameters taking effect in the next epoch
+	SlashRecordKey         = []byte{0x23} // key prefix for the slash records in a single epoch
 )
 
 func KeyPrefix(p string) []byte {
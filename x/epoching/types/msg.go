@@ -12,6 +12,7 @@ var (
 	_ sdk.Msg = &MsgWrappedBeginRedelegate{}
 	_ sdk.Msg = &MsgWrappedCancelUnbondingDelegation{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgCancelQueuedMsg{}
 )
 
 // NewMsgWrappedDelegate creates a new MsgWrappedDelegate instance.
@@ -41,3 +42,11 @@ func NewMsgWrappedCancelUnbondingDelegation(msg *stakingtypes.MsgCancelUnbonding
 		Msg: msg,
 	}
 }
+
+// NewMsgCancelQueuedMsg creates a new MsgCancelQueuedMsg instance.
+func NewMsgCancelQueuedMsg(signer sdk.AccAddress, msgID []byte) *MsgCancelQueuedMsg {
+	return &MsgCancelQueuedMsg{
+		Signer: signer.String(),
+		MsgId:  msgID,
+	}
+}
@@ -75,6 +75,9 @@ var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 type QueryParamsResponse struct {
 	// params holds all the parameters of this module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// pending_params holds the parameters that will take effect at the
+	// beginning of the next epoch, if any
+	PendingParams *Params `protobuf:"bytes,2,opt,name=pending_params,json=pendingParams,proto3" json:"pending_params,omitempty"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -117,6 +120,13 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+func (m *QueryParamsResponse) GetPendingParams() *Params {
+	if m != nil {
+		return m.PendingParams
+	}
+	return nil
+}
+
 // QueryEpochInfoRequest is the request type for the Query/EpochInfo method
 type QueryEpochInfoRequest struct {
 	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
@@ -407,6 +417,9 @@ type QueryEpochMsgsRequest struct {
 	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
 	// pagination defines whether to have the pagination in the request
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// sender is the bech32 address of the sender of the requested messages.
+	// If empty, messages of all senders are returned.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
 func (m *QueryEpochMsgsRequest) Reset()         { *m = QueryEpochMsgsRequest{} }
@@ -456,6 +469,13 @@ func (m *QueryEpochMsgsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryEpochMsgsRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
 // QueryEpochMsgsResponse is the response type for the Query/EpochMsgs RPC
 // method
 type QueryEpochMsgsResponse struct {
@@ -941,6 +961,115 @@ func (m *QueryEpochValSetResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryEpochSlashesRequest is the request type for the Query/EpochSlashes RPC
+// method
+type QueryEpochSlashesRequest struct {
+	EpochNum   uint64             `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEpochSlashesRequest) Reset()         { *m = QueryEpochSlashesRequest{} }
+func (m *QueryEpochSlashesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochSlashesRequest) ProtoMessage()    {}
+func (*QueryEpochSlashesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1821b530f2ec2711, []int{18}
+}
+func (m *QueryEpochSlashesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochSlashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochSlashesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochSlashesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochSlashesRequest.Merge(m, src)
+}
+func (m *QueryEpochSlashesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochSlashesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochSlashesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochSlashesRequest proto.InternalMessageInfo
+
+func (m *QueryEpochSlashesRequest) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *QueryEpochSlashesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEpochSlashesResponse is the response type for the Query/EpochSlashes
+// RPC method
+type QueryEpochSlashesResponse struct {
+	// slashes are the slashes recorded in the epoch, in the order they happened
+	Slashes    []*SlashRecord      `protobuf:"bytes,1,rep,name=slashes,proto3" json:"slashes,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEpochSlashesResponse) Reset()         { *m = QueryEpochSlashesResponse{} }
+func (m *QueryEpochSlashesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochSlashesResponse) ProtoMessage()    {}
+func (*QueryEpochSlashesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1821b530f2ec2711, []int{19}
+}
+func (m *QueryEpochSlashesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochSlashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochSlashesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochSlashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochSlashesResponse.Merge(m, src)
+}
+func (m *QueryEpochSlashesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochSlashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochSlashesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochSlashesResponse proto.InternalMessageInfo
+
+func (m *QueryEpochSlashesResponse) GetSlashes() []*SlashRecord {
+	if m != nil {
+		return m.Slashes
+	}
+	return nil
+}
+
+func (m *QueryEpochSlashesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // EpochResponse is a structure that contains the metadata of an epoch
 type EpochResponse struct {
 	// epoch_number is the number of this epoch
@@ -972,7 +1101,7 @@ func (m *EpochResponse) Reset()         { *m = EpochResponse{} }
 func (m *EpochResponse) String() string { return proto.CompactTextString(m) }
 func (*EpochResponse) ProtoMessage()    {}
 func (*EpochResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{18}
+	return fileDescriptor_1821b530f2ec2711, []int{20}
 }
 func (m *EpochResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1070,7 +1199,7 @@ func (m *QueuedMessageResponse) Reset()         { *m = QueuedMessageResponse{} }
 func (m *QueuedMessageResponse) String() string { return proto.CompactTextString(m) }
 func (*QueuedMessageResponse) ProtoMessage()    {}
 func (*QueuedMessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{19}
+	return fileDescriptor_1821b530f2ec2711, []int{21}
 }
 func (m *QueuedMessageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1145,7 +1274,7 @@ func (m *QueuedMessageList) Reset()         { *m = QueuedMessageList{} }
 func (m *QueuedMessageList) String() string { return proto.CompactTextString(m) }
 func (*QueuedMessageList) ProtoMessage()    {}
 func (*QueuedMessageList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{20}
+	return fileDescriptor_1821b530f2ec2711, []int{22}
 }
 func (m *QueuedMessageList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1200,7 +1329,7 @@ func (m *ValStateUpdateResponse) Reset()         { *m = ValStateUpdateResponse{}
 func (m *ValStateUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*ValStateUpdateResponse) ProtoMessage()    {}
 func (*ValStateUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{21}
+	return fileDescriptor_1821b530f2ec2711, []int{23}
 }
 func (m *ValStateUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1269,6 +1398,8 @@ func init() {
 	proto.RegisterType((*QueryDelegationLifecycleResponse)(nil), "babylon.epoching.v1.QueryDelegationLifecycleResponse")
 	proto.RegisterType((*QueryEpochValSetRequest)(nil), "babylon.epoching.v1.QueryEpochValSetRequest")
 	proto.RegisterType((*QueryEpochValSetResponse)(nil), "babylon.epoching.v1.QueryEpochValSetResponse")
+	proto.RegisterType((*QueryEpochSlashesRequest)(nil), "babylon.epoching.v1.QueryEpochSlashesRequest")
+	proto.RegisterType((*QueryEpochSlashesResponse)(nil), "babylon.epoching.v1.QueryEpochSlashesResponse")
 	proto.RegisterType((*EpochResponse)(nil), "babylon.epoching.v1.EpochResponse")
 	proto.RegisterType((*QueuedMessageResponse)(nil), "babylon.epoching.v1.QueuedMessageResponse")
 	proto.RegisterType((*QueuedMessageList)(nil), "babylon.epoching.v1.QueuedMessageList")
@@ -1278,95 +1409,102 @@ func init() {
 func init() { proto.RegisterFile("babylon/epoching/v1/query.proto", fileDescriptor_1821b530f2ec2711) }
 
 var fileDescriptor_1821b530f2ec2711 = []byte{
-	// 1408 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0xdc, 0xc4,
-	0x17, 0x8f, 0x37, 0x9b, 0xb4, 0x79, 0x69, 0x9a, 0x64, 0xd2, 0xf6, 0x9b, 0x6e, 0xda, 0x4d, 0xbf,
-	0x2e, 0xf4, 0x47, 0xd2, 0xd8, 0x4d, 0x93, 0x02, 0xfd, 0x01, 0x55, 0xd3, 0x52, 0x12, 0xd4, 0xa2,
-	0xd4, 0x40, 0x0f, 0x5c, 0xcc, 0xec, 0x7a, 0xe2, 0xb5, 0xf0, 0x7a, 0x5c, 0xcf, 0xec, 0x92, 0xa8,
-	0x14, 0x21, 0xc4, 0x91, 0x43, 0x25, 0x0e, 0x08, 0x21, 0x21, 0x10, 0x47, 0xfe, 0x02, 0x04, 0x07,
-	0x8e, 0x3d, 0x16, 0x71, 0xe1, 0x04, 0xa8, 0x41, 0xfc, 0x1d, 0xc8, 0x33, 0xe3, 0x5d, 0xef, 0xc6,
-	0xee, 0x6e, 0xa2, 0x8a, 0xdb, 0x66, 0xde, 0xfb, 0xcc, 0xfb, 0xbc, 0xcf, 0x1b, 0xcf, 0x7c, 0x02,
-	0xb3, 0x15, 0x5c, 0xd9, 0xf2, 0x69, 0x60, 0x92, 0x90, 0x56, 0x6b, 0x5e, 0xe0, 0x9a, 0xcd, 0x45,
-	0xf3, 0x7e, 0x83, 0x44, 0x5b, 0x46, 0x18, 0x51, 0x4e, 0xd1, 0x94, 0x4a, 0x30, 0x92, 0x04, 0xa3,
-	0xb9, 0x58, 0x3a, 0xe4, 0x52, 0x97, 0x8a, 0xb8, 0x19, 0xff, 0x92, 0xa9, 0xa5, 0x59, 0x97, 0x52,
-	0xd7, 0x27, 0xa6, 0xf8, 0xab, 0xd2, 0xd8, 0x30, 0xb9, 0x57, 0x27, 0x8c, 0xe3, 0x7a, 0xa8, 0x12,
-	0x8e, 0xa9, 0x04, 0x1c, 0x7a, 0x26, 0x0e, 0x02, 0xca, 0x31, 0xf7, 0x68, 0xc0, 0x54, 0x74, 0xae,
-	0x4a, 0x59, 0x9d, 0x32, 0xb3, 0x82, 0x19, 0x91, 0x14, 0xcc, 0xe6, 0x62, 0x85, 0x70, 0xbc, 0x68,
-	0x86, 0xd8, 0xf5, 0x02, 0x91, 0xac, 0x72, 0x4f, 0x64, 0xd1, 0x0e, 0x71, 0x84, 0xeb, 0xc9, 0x6e,
-	0x7a, 0x56, 0x46, 0xab, 0x07, 0x91, 0xa3, 0x1f, 0x02, 0x74, 0x37, 0xae, 0xb3, 0x2e, 0x80, 0x16,
-	0xb9, 0xdf, 0x20, 0x8c, 0xeb, 0xeb, 0x30, 0xd5, 0xb1, 0xca, 0x42, 0x1a, 0x30, 0x82, 0x2e, 0xc1,
-	0xb0, 0x2c, 0x30, 0xad, 0x9d, 0xd0, 0xce, 0x8c, 0x5e, 0x98, 0x31, 0x32, 0x94, 0x31, 0x24, 0x68,
-	0xa5, 0xf8, 0xf8, 0x8f, 0xd9, 0x01, 0x4b, 0x01, 0xf4, 0x65, 0x38, 0x2c, 0x76, 0x7c, 0x3d, 0x4e,
-	0x5c, 0x0b, 0x36, 0xa8, 0x2a, 0x85, 0x66, 0x60, 0x44, 0x80, 0xed, 0xa0, 0x51, 0x17, 0xdb, 0x16,
-	0xad, 0xfd, 0x62, 0xe1, 0xad, 0x46, 0x5d, 0xb7, 0xe0, 0x48, 0x37, 0x4a, 0x51, 0x79, 0x05, 0x86,
-	0x44, 0x96, 0x62, 0xa2, 0x67, 0x32, 0x11, 0xb0, 0x04, 0x62, 0x49, 0x80, 0xfe, 0x7e, 0x7a, 0x4f,
-	0x96, 0xa6, 0x72, 0x0b, 0xa0, 0xad, 0xb2, 0xda, 0xf8, 0x94, 0x21, 0x47, 0x62, 0xc4, 0x23, 0x31,
-	0xe4, 0xa9, 0x50, 0x23, 0x31, 0xd6, 0xb1, 0x4b, 0x14, 0xd6, 0x4a, 0x21, 0xf5, 0x6f, 0x34, 0xf8,
-	0xdf, 0x8e, 0x12, 0x8a, 0xf7, 0x65, 0x18, 0x16, 0x34, 0x62, 0x09, 0x07, 0xfb, 0x24, 0xae, 0x10,
-	0xe8, 0x8d, 0x0e, 0x7e, 0x05, 0xc1, 0xef, 0x74, 0x4f, 0x7e, 0x6a, 0x93, 0x34, 0xc1, 0x12, 0x4c,
-	0x0b, 0x7e, 0x37, 0x1a, 0x51, 0x44, 0x02, 0xae, 0xaa, 0xc9, 0xd1, 0xbb, 0x70, 0x34, 0x23, 0xa6,
-	0xd8, 0x9f, 0x84, 0xb1, 0xaa, 0x5c, 0xb7, 0xdb, 0xea, 0x17, 0xad, 0x03, 0xd5, 0x54, 0x32, 0x7a,
-	0x11, 0x0e, 0xca, 0x89, 0x56, 0x68, 0x23, 0x70, 0x70, 0xb4, 0x25, 0xa8, 0x16, 0xad, 0x31, 0xb1,
-	0xba, 0xa2, 0x16, 0xf5, 0x8f, 0xd2, 0x27, 0xe2, 0x0e, 0x73, 0x59, 0x3f, 0x27, 0xa2, 0x6b, 0x46,
-	0x85, 0x3d, 0xcf, 0xe8, 0x3b, 0x2d, 0x7d, 0x0c, 0x64, 0x79, 0xd5, 0xe4, 0x6b, 0x50, 0xac, 0x33,
-	0x37, 0x19, 0xd0, 0x5c, 0xe6, 0x80, 0xee, 0x36, 0x48, 0x83, 0x38, 0x77, 0x08, 0x63, 0x69, 0x8d,
-	0x05, 0xee, 0xf9, 0x8d, 0xe9, 0x7b, 0x0d, 0x66, 0x04, 0xc7, 0xdb, 0x98, 0x13, 0xc6, 0x33, 0x85,
-	0x0a, 0x9c, 0x8e, 0x49, 0xec, 0x27, 0x81, 0x23, 0xa7, 0x30, 0x0b, 0xa3, 0x52, 0xc5, 0x2a, 0x6d,
-	0x04, 0x5c, 0x8d, 0x00, 0xc4, 0xd2, 0x8d, 0x78, 0xa5, 0x4b, 0xc9, 0xc1, 0x3d, 0x2b, 0xf9, 0x93,
-	0x06, 0xc7, 0xb2, 0x59, 0x2a, 0x3d, 0x2d, 0x98, 0xf4, 0x45, 0x48, 0x32, 0xb5, 0x53, 0xe2, 0x9e,
-	0xea, 0x2d, 0xee, 0x6d, 0x8f, 0x71, 0x6b, 0xdc, 0xef, 0xdc, 0xfb, 0xf9, 0x69, 0x7c, 0x05, 0xca,
-	0x82, 0xfc, 0x3d, 0xec, 0x7b, 0x0e, 0xe6, 0x34, 0xba, 0xed, 0x6d, 0x90, 0xea, 0x56, 0xd5, 0x4f,
-	0x7a, 0x45, 0x47, 0x61, 0x7f, 0x13, 0xfb, 0x36, 0x76, 0x9c, 0x48, 0x88, 0x3c, 0x62, 0xed, 0x6b,
-	0x62, 0xff, 0xba, 0xe3, 0x44, 0xfa, 0x67, 0x1a, 0xcc, 0xe6, 0xa2, 0x55, 0xf7, 0xf9, 0x70, 0x74,
-	0x4b, 0x86, 0x7c, 0x6f, 0x83, 0x4c, 0x17, 0x84, 0x1e, 0xf3, 0x99, 0x7a, 0xdc, 0xc3, 0xfe, 0xdb,
-	0x1c, 0x73, 0xf2, 0x6e, 0xe8, 0x60, 0xde, 0x6e, 0x23, 0xde, 0x27, 0xae, 0xa7, 0x5f, 0x55, 0x2c,
-	0x6e, 0x12, 0x9f, 0xb8, 0xa2, 0xad, 0xac, 0x26, 0x1c, 0xd2, 0xc9, 0xc2, 0x21, 0xb2, 0x09, 0x17,
-	0x4e, 0xe4, 0xa3, 0x55, 0x13, 0x37, 0x24, 0x5c, 0x30, 0x95, 0xf7, 0xe2, 0x99, 0x4c, 0xa6, 0x59,
-	0x7b, 0xc4, 0x85, 0x04, 0xcd, 0x8f, 0xd3, 0xb7, 0x62, 0xdc, 0x13, 0xe1, 0xff, 0xe9, 0x27, 0xff,
-	0xab, 0xa6, 0xae, 0xbd, 0x0e, 0x02, 0xad, 0x8f, 0x1e, 0x9a, 0xc9, 0x10, 0x93, 0xd3, 0x59, 0xce,
-	0x9b, 0x86, 0x4c, 0xb3, 0x52, 0x08, 0x74, 0x0e, 0x10, 0xa7, 0x1c, 0xfb, 0x76, 0x93, 0x72, 0x2f,
-	0x70, 0xed, 0x90, 0x7e, 0x48, 0x22, 0x41, 0x76, 0xd0, 0x9a, 0x10, 0x91, 0x7b, 0x22, 0xb0, 0x1e,
-	0xaf, 0x77, 0x1d, 0xdf, 0xc1, 0xbd, 0x1f, 0xdf, 0x7f, 0x0a, 0x30, 0xd6, 0x79, 0x45, 0xff, 0x1f,
-	0x0e, 0xb4, 0xa4, 0xac, 0x90, 0x48, 0xa9, 0x39, 0x9a, 0xa8, 0x59, 0x21, 0x11, 0x5a, 0x86, 0x23,
-	0x1d, 0xb7, 0xb8, 0xed, 0x05, 0x9c, 0x44, 0x4d, 0xec, 0xab, 0x5b, 0xe2, 0x50, 0xfa, 0x3a, 0x5f,
-	0x53, 0xb1, 0xb8, 0xc3, 0x0d, 0x2f, 0x62, 0xdc, 0xae, 0xf8, 0xb4, 0xfa, 0x81, 0x5d, 0x23, 0x9e,
-	0x5b, 0xe3, 0x82, 0x7b, 0xd1, 0x9a, 0x10, 0x91, 0x95, 0x38, 0xb0, 0x2a, 0xd6, 0xd1, 0x2a, 0x8c,
-	0xfb, 0xb8, 0x95, 0x1c, 0xbb, 0xa0, 0xe9, 0xa2, 0x68, 0xb3, 0x64, 0x48, 0x07, 0x64, 0x24, 0x16,
-	0xc9, 0x78, 0x27, 0xb1, 0x48, 0x2b, 0xc5, 0x47, 0x7f, 0xce, 0x6a, 0xd6, 0x58, 0x0c, 0x14, 0x7b,
-	0xc5, 0x11, 0x74, 0x16, 0x26, 0x71, 0x18, 0xda, 0x35, 0xcc, 0x6a, 0x76, 0x44, 0x29, 0xb7, 0x6b,
-	0x64, 0x73, 0x7a, 0x48, 0x9c, 0xe1, 0x83, 0x38, 0x0c, 0x57, 0x31, 0xab, 0x59, 0x94, 0xf2, 0x55,
-	0xb2, 0x89, 0x16, 0x60, 0x8a, 0x11, 0xec, 0x93, 0xc8, 0x6e, 0x21, 0xe2, 0xe4, 0x61, 0x91, 0x3c,
-	0x21, 0x43, 0xd7, 0x25, 0x24, 0x4e, 0x9f, 0x83, 0x49, 0x95, 0xae, 0x5a, 0xc2, 0xac, 0x36, 0xbd,
-	0x4f, 0x24, 0x8f, 0xcb, 0x80, 0xec, 0x08, 0xb3, 0x9a, 0xfe, 0xa3, 0x26, 0x9e, 0xab, 0x9d, 0x97,
-	0x3e, 0x9a, 0x82, 0x21, 0xbe, 0x69, 0x7b, 0x8e, 0xfa, 0xae, 0x8a, 0x7c, 0x73, 0xcd, 0x41, 0x87,
-	0x61, 0xb8, 0xce, 0xdc, 0x78, 0xb5, 0x20, 0x56, 0x87, 0xea, 0xcc, 0x5d, 0x73, 0xe2, 0xe1, 0x64,
-	0xa8, 0x37, 0x5a, 0x49, 0x09, 0x77, 0x0d, 0x60, 0x0f, 0x9a, 0x8d, 0x54, 0x5a, 0x7a, 0x4d, 0xc0,
-	0x60, 0x9d, 0xb9, 0x4a, 0xa1, 0xf8, 0xa7, 0xde, 0x84, 0xc9, 0x1d, 0x57, 0x6a, 0x3f, 0xe7, 0x24,
-	0x79, 0x08, 0x0b, 0x7b, 0x7b, 0x08, 0xf5, 0xaf, 0x35, 0x38, 0x92, 0x7d, 0x77, 0xa1, 0xe3, 0x00,
-	0x2c, 0x5e, 0xb6, 0x1d, 0xc2, 0xaa, 0x4a, 0xb9, 0x11, 0xb1, 0x72, 0x93, 0xb0, 0xea, 0x0e, 0x9d,
-	0x0a, 0xbd, 0x74, 0x1a, 0xdc, 0xb5, 0x4e, 0x17, 0x9e, 0x8c, 0xc2, 0x90, 0xb8, 0x0e, 0xd0, 0x27,
-	0x1a, 0x0c, 0x4b, 0xd3, 0x8a, 0x4e, 0xe7, 0x35, 0xd9, 0xe5, 0x90, 0x4b, 0x67, 0x7a, 0x27, 0xca,
-	0x56, 0xf5, 0x93, 0x9f, 0xfe, 0xf6, 0xf7, 0x17, 0x85, 0xe3, 0x68, 0xc6, 0xcc, 0x37, 0xec, 0xe8,
-	0x4b, 0x0d, 0x46, 0x5a, 0x26, 0x17, 0xcd, 0xe5, 0x6f, 0xde, 0xed, 0x9f, 0x4b, 0xf3, 0x7d, 0xe5,
-	0x2a, 0x2e, 0x8b, 0x82, 0xcb, 0x3c, 0x3a, 0x6b, 0xe6, 0xfe, 0x6b, 0xc0, 0xcc, 0x07, 0xad, 0x73,
-	0xf1, 0xea, 0xdc, 0x43, 0xf4, 0xb9, 0x06, 0xd0, 0xf6, 0xb1, 0xa8, 0x57, 0xb9, 0xb4, 0xa1, 0x2e,
-	0x9d, 0xeb, 0x2f, 0xb9, 0x2f, 0xa1, 0x94, 0x07, 0xfe, 0x4a, 0x83, 0x03, 0x69, 0x6b, 0x8a, 0x16,
-	0xf2, 0x6b, 0x64, 0xd8, 0xdb, 0x92, 0xd1, 0x6f, 0xba, 0x22, 0x35, 0x27, 0x48, 0xbd, 0x80, 0xf4,
-	0x4c, 0x52, 0x1d, 0xd7, 0x28, 0xfa, 0x36, 0x19, 0xa2, 0xb0, 0x28, 0xbd, 0x86, 0x98, 0x72, 0x72,
-	0x3d, 0x87, 0x98, 0xf6, 0x53, 0xfa, 0x65, 0x41, 0x69, 0x19, 0x5d, 0xe8, 0x7b, 0x88, 0x66, 0x5d,
-	0x7e, 0x9f, 0x0c, 0xfd, 0xa0, 0xc1, 0x78, 0x97, 0x4f, 0x43, 0xe7, 0xf3, 0x8b, 0x67, 0x1b, 0xcf,
-	0xd2, 0xe2, 0x2e, 0x10, 0x8a, 0xf4, 0x92, 0x20, 0xbd, 0x80, 0xe6, 0x9f, 0x41, 0xfa, 0xb2, 0x74,
-	0x79, 0x6d, 0xb6, 0x3f, 0x6b, 0x80, 0x76, 0x5a, 0x2b, 0xb4, 0x94, 0x5f, 0x3e, 0xd7, 0xc6, 0x95,
-	0x96, 0x77, 0x07, 0x52, 0xb4, 0xaf, 0x08, 0xda, 0x17, 0xd1, 0x52, 0x26, 0xed, 0xd6, 0xfb, 0x2f,
-	0x9c, 0x91, 0x40, 0x9a, 0x0f, 0x12, 0xb7, 0xf7, 0x10, 0xfd, 0xa2, 0xc1, 0x54, 0x86, 0x23, 0x42,
-	0xcf, 0xa0, 0x92, 0x6f, 0xe1, 0x4a, 0x17, 0x77, 0x89, 0x52, 0x1d, 0x5c, 0x15, 0x1d, 0xbc, 0x84,
-	0x96, 0x33, 0x3b, 0x70, 0x5a, 0xc8, 0x74, 0x0b, 0x89, 0x55, 0x7c, 0x18, 0x9f, 0x97, 0xd1, 0x94,
-	0x5d, 0x42, 0xbd, 0xbe, 0xe8, 0x0e, 0x5b, 0x57, 0x5a, 0xe8, 0x33, 0x5b, 0x51, 0xbd, 0x26, 0xa8,
-	0x5e, 0x42, 0x2f, 0xf7, 0x7f, 0xb0, 0xdb, 0x13, 0x60, 0x84, 0xaf, 0xbc, 0xf9, 0xf8, 0x69, 0x59,
-	0x7b, 0xf2, 0xb4, 0xac, 0xfd, 0xf5, 0xb4, 0xac, 0x3d, 0xda, 0x2e, 0x0f, 0x3c, 0xd9, 0x2e, 0x0f,
-	0xfc, 0xbe, 0x5d, 0x1e, 0x78, 0xef, 0xbc, 0xeb, 0xf1, 0x5a, 0xa3, 0x62, 0x54, 0x69, 0x3d, 0xd9,
-	0xbc, 0x5a, 0xc3, 0x5e, 0xd0, 0xaa, 0xb4, 0xd9, 0xae, 0xc5, 0xb7, 0x42, 0xc2, 0x2a, 0xc3, 0xe2,
-	0x0d, 0x59, 0xfa, 0x37, 0x00, 0x00, 0xff, 0xff, 0xb2, 0x10, 0x0c, 0x81, 0x1e, 0x12, 0x00, 0x00,
+	// 1516 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0x37, 0x9b, 0xb4, 0x79, 0x49, 0x9a, 0x64, 0xd2, 0x86, 0xad, 0xd3, 0x6e, 0x82, 0x0b,
+	0x6d, 0x9a, 0x34, 0x76, 0xd3, 0xa4, 0x40, 0x7f, 0x40, 0xd5, 0xb4, 0xb4, 0x09, 0x6a, 0x51, 0xeb,
+	0x42, 0x0f, 0x5c, 0xcc, 0xec, 0x7a, 0xe2, 0xb5, 0xf0, 0xda, 0xae, 0x67, 0x76, 0x49, 0x54, 0x15,
+	0x10, 0xe2, 0xc8, 0xa1, 0x12, 0x48, 0x08, 0x21, 0xa1, 0x22, 0x2e, 0x48, 0xfc, 0x05, 0x08, 0x0e,
+	0x1c, 0x7b, 0x2c, 0xe2, 0xc2, 0x09, 0x50, 0x8b, 0xf8, 0x27, 0xb8, 0x20, 0xcf, 0x8c, 0x77, 0xbd,
+	0x1b, 0x6f, 0x77, 0x13, 0x55, 0xbd, 0xed, 0xbe, 0xf9, 0xbe, 0x99, 0xef, 0x7d, 0x6f, 0x3c, 0xf3,
+	0x06, 0x66, 0x4a, 0xb8, 0xb4, 0xe5, 0x05, 0xbe, 0x41, 0xc2, 0xa0, 0x5c, 0x71, 0x7d, 0xc7, 0xa8,
+	0x2f, 0x19, 0x77, 0x6a, 0x24, 0xda, 0xd2, 0xc3, 0x28, 0x60, 0x01, 0x9a, 0x94, 0x00, 0x3d, 0x01,
+	0xe8, 0xf5, 0x25, 0x75, 0xbf, 0x13, 0x38, 0x01, 0x1f, 0x37, 0xe2, 0x5f, 0x02, 0xaa, 0xce, 0x38,
+	0x41, 0xe0, 0x78, 0xc4, 0xe0, 0xff, 0x4a, 0xb5, 0x0d, 0x83, 0xb9, 0x55, 0x42, 0x19, 0xae, 0x86,
+	0x12, 0x70, 0x48, 0x02, 0x70, 0xe8, 0x1a, 0xd8, 0xf7, 0x03, 0x86, 0x99, 0x1b, 0xf8, 0x54, 0x8e,
+	0xce, 0x97, 0x03, 0x5a, 0x0d, 0xa8, 0x51, 0xc2, 0x94, 0x08, 0x09, 0x46, 0x7d, 0xa9, 0x44, 0x18,
+	0x5e, 0x32, 0x42, 0xec, 0xb8, 0x3e, 0x07, 0x4b, 0xec, 0x6c, 0x96, 0xec, 0x10, 0x47, 0xb8, 0x9a,
+	0xcc, 0xa6, 0x65, 0x21, 0x1a, 0x39, 0x70, 0x8c, 0xb6, 0x1f, 0xd0, 0xcd, 0x78, 0x9d, 0x1b, 0x9c,
+	0x68, 0x92, 0x3b, 0x35, 0x42, 0x99, 0xf6, 0xa5, 0x02, 0x93, 0x2d, 0x61, 0x1a, 0x06, 0x3e, 0x25,
+	0xe8, 0x0c, 0x0c, 0x8a, 0x15, 0x0a, 0xca, 0xac, 0x32, 0x37, 0x7c, 0x6a, 0x5a, 0xcf, 0xb0, 0x46,
+	0x17, 0xa4, 0xd5, 0xfc, 0xc3, 0x3f, 0x67, 0xfa, 0x4c, 0x49, 0x40, 0xab, 0xb0, 0x2f, 0x24, 0xbe,
+	0xed, 0xfa, 0x8e, 0x25, 0xa7, 0xc8, 0x75, 0x9d, 0xc2, 0x1c, 0x95, 0x14, 0xf1, 0x57, 0x5b, 0x81,
+	0x03, 0x5c, 0xd5, 0x9b, 0x31, 0x72, 0xdd, 0xdf, 0x08, 0xa4, 0x5e, 0x34, 0x0d, 0x43, 0x9c, 0x6d,
+	0xf9, 0xb5, 0x2a, 0x97, 0x96, 0x37, 0xf7, 0xf2, 0xc0, 0xdb, 0xb5, 0xaa, 0x66, 0xc2, 0x54, 0x3b,
+	0x4b, 0xa6, 0xf3, 0x1a, 0x0c, 0x70, 0x94, 0xcc, 0x46, 0xcb, 0x94, 0xc2, 0x69, 0x09, 0xc5, 0x14,
+	0x04, 0xed, 0xfd, 0xf4, 0x9c, 0x34, 0x2d, 0xe5, 0x0a, 0x40, 0xb3, 0x54, 0x72, 0xe2, 0xa3, 0xba,
+	0xa8, 0xab, 0x1e, 0xd7, 0x55, 0x17, 0x5b, 0x4b, 0xd6, 0x55, 0xbf, 0x81, 0x1d, 0x22, 0xb9, 0x66,
+	0x8a, 0xa9, 0x7d, 0xab, 0xc0, 0x0b, 0xdb, 0x96, 0x90, 0xba, 0xcf, 0xc2, 0x20, 0x97, 0x11, 0x97,
+	0xa1, 0xbf, 0x47, 0xe1, 0x92, 0x81, 0xae, 0xb6, 0xe8, 0x13, 0x35, 0x38, 0xd6, 0x55, 0x9f, 0x9c,
+	0x24, 0x2d, 0x50, 0x85, 0x02, 0xd7, 0x77, 0xa9, 0x16, 0x45, 0xc4, 0x67, 0x72, 0x35, 0xb1, 0x7f,
+	0x1c, 0x38, 0x98, 0x31, 0x26, 0xd5, 0x1f, 0x81, 0xd1, 0xb2, 0x88, 0x5b, 0x4d, 0xf7, 0xf3, 0xe6,
+	0x48, 0x39, 0x05, 0x46, 0x2f, 0xc3, 0x3e, 0x51, 0xd1, 0x52, 0x50, 0xf3, 0x6d, 0x1c, 0x6d, 0x71,
+	0xa9, 0x79, 0x73, 0x94, 0x47, 0x57, 0x65, 0x30, 0xde, 0xa8, 0xa9, 0x2d, 0x71, 0x9d, 0x3a, 0xb4,
+	0x97, 0x2d, 0xd1, 0x56, 0xa4, 0xdc, 0x6e, 0x8b, 0x84, 0xa6, 0x60, 0x90, 0x12, 0xdf, 0x26, 0x51,
+	0xa1, 0x7f, 0x56, 0x99, 0x1b, 0x32, 0xe5, 0x3f, 0xed, 0x3b, 0x05, 0xa6, 0xda, 0x65, 0xc9, 0xec,
+	0xdf, 0x80, 0x7c, 0x95, 0x3a, 0x49, 0xe5, 0xe6, 0x33, 0x2b, 0x77, 0xb3, 0x46, 0x6a, 0xc4, 0xbe,
+	0x4e, 0x28, 0x4d, 0x9b, 0xcf, 0x79, 0xcf, 0xae, 0x7e, 0xdf, 0x2b, 0x30, 0xcd, 0x35, 0x5e, 0xc3,
+	0x8c, 0x50, 0x96, 0x69, 0xa0, 0x6f, 0xb7, 0x94, 0x68, 0x2f, 0xf1, 0x6d, 0x51, 0x9e, 0x19, 0x18,
+	0x16, 0xee, 0x96, 0x83, 0x9a, 0xcf, 0x64, 0x6d, 0x80, 0x87, 0x2e, 0xc5, 0x91, 0x36, 0x87, 0xfb,
+	0x77, 0xfd, 0x19, 0xfc, 0xac, 0xc0, 0xa1, 0x6c, 0x95, 0xd2, 0x4f, 0x13, 0x26, 0x3c, 0x3e, 0x24,
+	0x94, 0x5a, 0x29, 0x73, 0x8f, 0x76, 0x37, 0xf7, 0x9a, 0x4b, 0x99, 0x39, 0xe6, 0xb5, 0xce, 0xfd,
+	0xec, 0x3c, 0x3e, 0x07, 0x45, 0x2e, 0xfe, 0x36, 0xf6, 0x5c, 0x1b, 0xb3, 0x20, 0xba, 0xe6, 0x6e,
+	0x90, 0xf2, 0x56, 0xd9, 0x4b, 0x72, 0x45, 0x07, 0x61, 0x6f, 0x1d, 0x7b, 0x16, 0xb6, 0xed, 0x88,
+	0x9b, 0x3c, 0x64, 0xee, 0xa9, 0x63, 0xef, 0xa2, 0x6d, 0x47, 0xda, 0x67, 0x0a, 0xcc, 0x74, 0x64,
+	0xcb, 0xec, 0x3b, 0xd3, 0xd1, 0x15, 0x31, 0xe4, 0xb9, 0x1b, 0xa4, 0x90, 0xe3, 0x7e, 0x2c, 0x64,
+	0xfa, 0x71, 0x1b, 0x7b, 0xb7, 0x18, 0x66, 0xe4, 0xdd, 0xd0, 0xc6, 0xac, 0x99, 0x46, 0x3c, 0x4f,
+	0xbc, 0x9e, 0x76, 0x5e, 0xaa, 0xb8, 0x4c, 0x3c, 0xe2, 0xf0, 0xb4, 0xb2, 0x92, 0xb0, 0x49, 0xab,
+	0x0a, 0x9b, 0x88, 0x24, 0x1c, 0x98, 0xed, 0xcc, 0x96, 0x49, 0x5c, 0x12, 0x74, 0xae, 0x54, 0x1c,
+	0x98, 0x73, 0x99, 0x4a, 0xb3, 0xe6, 0x88, 0x17, 0xe2, 0x32, 0x3f, 0x4a, 0x1f, 0x97, 0x71, 0x4e,
+	0x84, 0x3d, 0xcf, 0xa3, 0x40, 0xfb, 0x4d, 0x81, 0xc2, 0x76, 0x01, 0x8d, 0x8f, 0x1e, 0xea, 0x49,
+	0x11, 0x93, 0xdd, 0x59, 0xec, 0x54, 0x0d, 0x01, 0x33, 0x53, 0x0c, 0x74, 0x02, 0x10, 0x0b, 0x18,
+	0xf6, 0xac, 0x7a, 0xc0, 0xf8, 0x0d, 0x1a, 0x7c, 0x48, 0x22, 0x2e, 0xb6, 0xdf, 0x1c, 0xe7, 0x23,
+	0xb7, 0xf9, 0xc0, 0x8d, 0x38, 0x8e, 0xae, 0x66, 0x7c, 0x7b, 0xbb, 0xda, 0xbe, 0x1f, 0xa7, 0x53,
+	0xba, 0xe5, 0x61, 0x5a, 0x21, 0xcf, 0xf5, 0x7c, 0xd5, 0x1e, 0x28, 0x70, 0x30, 0x43, 0x41, 0xe3,
+	0x1a, 0xdc, 0x43, 0x45, 0x48, 0x5a, 0x3a, 0x9b, 0x69, 0x29, 0xa7, 0x99, 0xa4, 0x1c, 0x44, 0xb6,
+	0x99, 0x10, 0x9e, 0xdd, 0x27, 0xfe, 0x6f, 0x0e, 0x46, 0x5b, 0xef, 0xb7, 0x17, 0x61, 0xa4, 0xe1,
+	0x4c, 0x89, 0x44, 0xd2, 0x9c, 0xe1, 0xc4, 0x9c, 0x12, 0x89, 0xd0, 0x0a, 0x4c, 0xb5, 0x5c, 0x81,
+	0x96, 0xeb, 0x33, 0x12, 0xd5, 0xb1, 0x27, 0x4f, 0xd2, 0xfd, 0xe9, 0xbb, 0x70, 0x5d, 0x8e, 0xc5,
+	0xbb, 0x60, 0xc3, 0x8d, 0x28, 0xb3, 0x4a, 0x5e, 0x50, 0xfe, 0xc0, 0xaa, 0x10, 0xd7, 0xa9, 0x30,
+	0x5e, 0xdf, 0xbc, 0x39, 0xce, 0x47, 0x56, 0xe3, 0x81, 0x35, 0x1e, 0x47, 0x6b, 0x30, 0xe6, 0xe1,
+	0x06, 0x38, 0xee, 0x43, 0x0b, 0x79, 0x9e, 0xa6, 0xaa, 0x8b, 0x1e, 0x54, 0x4f, 0x9a, 0x54, 0xfd,
+	0x9d, 0xa4, 0x49, 0x5d, 0xcd, 0xdf, 0xff, 0x6b, 0x46, 0x31, 0x47, 0x3d, 0x2c, 0xe7, 0x8a, 0x47,
+	0xd0, 0x71, 0x98, 0xc0, 0x61, 0x68, 0x55, 0x30, 0xad, 0x58, 0x51, 0x10, 0x30, 0xab, 0x42, 0x36,
+	0x0b, 0x03, 0xfc, 0x3b, 0xdf, 0x87, 0xc3, 0x70, 0x2d, 0xb6, 0x37, 0x08, 0xd8, 0x1a, 0xd9, 0x44,
+	0x8b, 0x30, 0x49, 0x09, 0xf6, 0x48, 0x64, 0x35, 0x18, 0x31, 0x78, 0x90, 0x83, 0xc7, 0xc5, 0xd0,
+	0x45, 0x41, 0x89, 0xe1, 0xf3, 0x30, 0x21, 0xe1, 0x32, 0x25, 0x4c, 0x2b, 0x85, 0x3d, 0x1c, 0x3c,
+	0x26, 0x06, 0x44, 0x46, 0x98, 0x56, 0xb4, 0x9f, 0xc4, 0x55, 0xbf, 0xfd, 0x62, 0x44, 0x93, 0x30,
+	0xc0, 0x36, 0x2d, 0xd7, 0x96, 0x67, 0x4f, 0x9e, 0x6d, 0xae, 0xdb, 0xe8, 0x00, 0x0c, 0x56, 0xa9,
+	0x13, 0x47, 0x73, 0x3c, 0x3a, 0x50, 0xa5, 0xce, 0xba, 0x1d, 0x17, 0x27, 0xc3, 0xbd, 0xe1, 0x52,
+	0xca, 0xb8, 0x0b, 0x00, 0xbb, 0xf0, 0x6c, 0xa8, 0xd4, 0xf0, 0x6b, 0x1c, 0xfa, 0xab, 0xd4, 0x91,
+	0x0e, 0xc5, 0x3f, 0xb5, 0x3a, 0x4c, 0x6c, 0xbb, 0x76, 0x7a, 0xd9, 0x27, 0x49, 0xb3, 0x90, 0xdb,
+	0x5d, 0xb3, 0xa0, 0x7d, 0xa3, 0xc0, 0x54, 0xf6, 0xf9, 0x8e, 0x0e, 0x03, 0xd0, 0x38, 0x6c, 0xd9,
+	0x84, 0x96, 0xa5, 0x73, 0x43, 0x3c, 0x72, 0x99, 0xd0, 0xf2, 0x36, 0x9f, 0x72, 0xdd, 0x7c, 0xea,
+	0xdf, 0xb1, 0x4f, 0xa7, 0xfe, 0x1b, 0x81, 0x01, 0xfe, 0x75, 0xa3, 0x4f, 0x14, 0x18, 0x14, 0x3d,
+	0x3e, 0x3a, 0xd6, 0x29, 0xc9, 0xb6, 0x37, 0x8a, 0x3a, 0xd7, 0x1d, 0x28, 0x52, 0xd5, 0x8e, 0x7c,
+	0xfa, 0xfb, 0x3f, 0x5f, 0xe4, 0x0e, 0xa3, 0x69, 0xa3, 0xf3, 0x93, 0x09, 0x7d, 0xa5, 0xc0, 0x50,
+	0xe3, 0x85, 0x80, 0xe6, 0x3b, 0x4f, 0xde, 0xfe, 0xf8, 0x50, 0x17, 0x7a, 0xc2, 0x4a, 0x2d, 0x4b,
+	0x5c, 0xcb, 0x02, 0x3a, 0x6e, 0x74, 0x7c, 0x9c, 0x51, 0xe3, 0x6e, 0x63, 0x5f, 0xbc, 0x3e, 0x7f,
+	0x0f, 0x7d, 0xae, 0x00, 0x34, 0x1f, 0x01, 0xa8, 0xdb, 0x72, 0xe9, 0xd7, 0x88, 0x7a, 0xa2, 0x37,
+	0x70, 0x4f, 0x46, 0xc9, 0x07, 0xc4, 0xd7, 0x0a, 0x8c, 0xa4, 0xfb, 0x7a, 0xb4, 0xd8, 0x79, 0x8d,
+	0x8c, 0xb7, 0x81, 0xaa, 0xf7, 0x0a, 0x97, 0xa2, 0xe6, 0xb9, 0xa8, 0x97, 0x90, 0x96, 0x29, 0xaa,
+	0xe5, 0x18, 0x45, 0x0f, 0x92, 0x22, 0xf2, 0x36, 0xae, 0x5b, 0x11, 0x53, 0xdd, 0xae, 0xba, 0xd0,
+	0x13, 0x56, 0x4a, 0x3a, 0xcb, 0x25, 0xad, 0xa0, 0x53, 0x3d, 0x17, 0xd1, 0xa8, 0x8a, 0xef, 0x93,
+	0xa2, 0x1f, 0x15, 0x18, 0x6b, 0xeb, 0x65, 0xd1, 0xc9, 0xce, 0x8b, 0x67, 0x37, 0xe7, 0xea, 0xd2,
+	0x0e, 0x18, 0x52, 0xf4, 0x32, 0x17, 0xbd, 0x88, 0x16, 0x9e, 0x22, 0xfa, 0xac, 0xe8, 0x84, 0x9b,
+	0x6a, 0x7f, 0x51, 0x00, 0x6d, 0x6f, 0x3f, 0xd1, 0x72, 0xe7, 0xe5, 0x3b, 0xb6, 0xba, 0xea, 0xca,
+	0xce, 0x48, 0x52, 0xf6, 0x39, 0x2e, 0xfb, 0x34, 0x5a, 0xce, 0x94, 0xdd, 0xe8, 0x91, 0x2c, 0x2f,
+	0x61, 0x1a, 0x77, 0x93, 0x8e, 0xf8, 0x1e, 0xfa, 0x55, 0x81, 0xc9, 0x8c, 0xae, 0x11, 0x3d, 0x45,
+	0x4a, 0xe7, 0x36, 0x57, 0x3d, 0xbd, 0x43, 0x96, 0xcc, 0xe0, 0x3c, 0xcf, 0xe0, 0x15, 0xb4, 0x92,
+	0x99, 0x81, 0xdd, 0x60, 0xa6, 0x53, 0x48, 0xda, 0xe9, 0x7b, 0xf1, 0x7e, 0x19, 0x4e, 0xb5, 0x94,
+	0xa8, 0xdb, 0x17, 0xdd, 0xd2, 0xfa, 0xaa, 0x8b, 0x3d, 0xa2, 0xa5, 0xd4, 0x0b, 0x5c, 0xea, 0x19,
+	0xf4, 0x6a, 0xef, 0x1b, 0xbb, 0x59, 0x01, 0x4a, 0x18, 0xfa, 0x41, 0x81, 0x91, 0x74, 0xaf, 0x86,
+	0xba, 0x09, 0x68, 0xed, 0x2a, 0x55, 0xbd, 0x57, 0xb8, 0x14, 0x7c, 0x86, 0x0b, 0x5e, 0x46, 0x4b,
+	0xbd, 0x0b, 0x96, 0x1d, 0xe0, 0xea, 0x5b, 0x0f, 0x1f, 0x17, 0x95, 0x47, 0x8f, 0x8b, 0xca, 0xdf,
+	0x8f, 0x8b, 0xca, 0xfd, 0x27, 0xc5, 0xbe, 0x47, 0x4f, 0x8a, 0x7d, 0x7f, 0x3c, 0x29, 0xf6, 0xbd,
+	0x77, 0xd2, 0x71, 0x59, 0xa5, 0x56, 0xd2, 0xcb, 0x41, 0x35, 0x99, 0xb6, 0x5c, 0xc1, 0xae, 0xdf,
+	0x58, 0x63, 0xb3, 0xb9, 0x0a, 0xdb, 0x0a, 0x09, 0x2d, 0x0d, 0xf2, 0xeb, 0x6e, 0xf9, 0xff, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0x64, 0xe0, 0x00, 0x9a, 0x4b, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1402,6 +1540,8 @@ type QueryClient interface {
 	DelegationLifecycle(ctx context.Context, in *QueryDelegationLifecycleRequest, opts ...grpc.CallOption) (*QueryDelegationLifecycleResponse, error)
 	// EpochValSet queries the validator set of a given epoch
 	EpochValSet(ctx context.Context, in *QueryEpochValSetRequest, opts ...grpc.CallOption) (*QueryEpochValSetResponse, error)
+	// EpochSlashes queries the slashes of validators recorded in a given epoch
+	EpochSlashes(ctx context.Context, in *QueryEpochSlashesRequest, opts ...grpc.CallOption) (*QueryEpochSlashesResponse, error)
 }
 
 type queryClient struct {
@@ -1493,6 +1633,15 @@ func (c *queryClient) EpochValSet(ctx context.Context, in *QueryEpochValSetReque
 	return out, nil
 }
 
+func (c *queryClient) EpochSlashes(ctx context.Context, in *QueryEpochSlashesRequest, opts ...grpc.CallOption) (*QueryEpochSlashesResponse, error) {
+	out := new(QueryEpochSlashesResponse)
+	err := c.cc.Invoke(ctx, "/babylon.epoching.v1.Query/EpochSlashes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of the module.
@@ -1516,6 +1665,8 @@ type QueryServer interface {
 	DelegationLifecycle(context.Context, *QueryDelegationLifecycleRequest) (*QueryDelegationLifecycleResponse, error)
 	// EpochValSet queries the validator set of a given epoch
 	EpochValSet(context.Context, *QueryEpochValSetRequest) (*QueryEpochValSetResponse, error)
+	// EpochSlashes queries the slashes of validators recorded in a given epoch
+	EpochSlashes(context.Context, *QueryEpochSlashesRequest) (*QueryEpochSlashesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1549,6 +1700,9 @@ func (*UnimplementedQueryServer) DelegationLifecycle(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) EpochValSet(ctx context.Context, req *QueryEpochValSetRequest) (*QueryEpochValSetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EpochValSet not implemented")
 }
+func (*UnimplementedQueryServer) EpochSlashes(ctx context.Context, req *QueryEpochSlashesRequest) (*QueryEpochSlashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochSlashes not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1716,6 +1870,24 @@ func _Query_EpochValSet_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EpochSlashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochSlashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochSlashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.epoching.v1.Query/EpochSlashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochSlashes(ctx, req.(*QueryEpochSlashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.epoching.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1756,6 +1928,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EpochValSet",
 			Handler:    _Query_EpochValSet_Handler,
 		},
+		{
+			MethodName: "EpochSlashes",
+			Handler:    _Query_EpochSlashes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/epoching/v1/query.proto",
@@ -1804,6 +1980,18 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PendingParams != nil {
+		{
+			size, err := m.PendingParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2040,6 +2228,13 @@ func (m *QueryEpochMsgsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -2436,6 +2631,95 @@ func (m *QueryEpochValSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEpochSlashesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochSlashesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochSlashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochSlashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochSlashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochSlashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Slashes) > 0 {
+		for iNdEx := len(m.Slashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EpochResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2478,12 +2762,12 @@ func (m *EpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 	}
 	if m.LastBlockTime != nil {
-		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastBlockTime):])
-		if err12 != nil {
-			return 0, err12
+		n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastBlockTime):])
+		if err15 != nil {
+			return 0, err15
 		}
-		i -= n12
-		i = encodeVarintQuery(dAtA, i, uint64(n12))
+		i -= n15
+		i = encodeVarintQuery(dAtA, i, uint64(n15))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -2533,12 +2817,12 @@ func (m *QueuedMessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 	}
 	if m.BlockTime != nil {
-		n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.BlockTime):])
-		if err13 != nil {
-			return 0, err13
+		n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.BlockTime):])
+		if err16 != nil {
+			return 0, err16
 		}
-		i -= n13
-		i = encodeVarintQuery(dAtA, i, uint64(n13))
+		i -= n16
+		i = encodeVarintQuery(dAtA, i, uint64(n16))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -2627,12 +2911,12 @@ func (m *ValStateUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if m.BlockTime != nil {
-		n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.BlockTime):])
-		if err14 != nil {
-			return 0, err14
+		n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.BlockTime):])
+		if err17 != nil {
+			return 0, err17
 		}
-		i -= n14
-		i = encodeVarintQuery(dAtA, i, uint64(n14))
+		i -= n17
+		i = encodeVarintQuery(dAtA, i, uint64(n17))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -2679,6 +2963,10 @@ func (m *QueryParamsResponse) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if m.PendingParams != nil {
+		l = m.PendingParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2776,6 +3064,10 @@ func (m *QueryEpochMsgsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2932,6 +3224,41 @@ func (m *QueryEpochValSetResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEpochSlashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochSlashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Slashes) > 0 {
+		for _, e := range m.Slashes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *EpochResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3150,23 +3477,59 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingParams", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingParams == nil {
+				m.PendingParams = &Params{}
+			}
+			if err := m.PendingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
@@ -3754,6 +4117,38 @@ func (m *QueryEpochMsgsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4749,6 +5144,231 @@ func (m *QueryEpochValSetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEpochSlashesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochSlashesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochSlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochSlashesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochSlashesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochSlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashes = append(m.Slashes, &SlashRecord{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EpochResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -349,7 +349,9 @@ type MsgUpdateParams struct {
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// params defines the epoching parameters to update.
 	//
-	// NOTE: All parameters must be supplied.
+	// NOTE: All parameters must be supplied. The parameters are queued and take
+	// effect at the beginning of the next epoch, so that the interval of the
+	// ongoing epoch is never changed.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
@@ -437,6 +439,100 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgCancelQueuedMsg is the message for withdrawing a message queued in the
+// current epoch
+type MsgCancelQueuedMsg struct {
+	// signer is the address of the sender of the queued message
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// msg_id is the ID of the queued message, i.e., hash of the marshaled
+	// message
+	MsgId []byte `protobuf:"bytes,2,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+}
+
+func (m *MsgCancelQueuedMsg) Reset()         { *m = MsgCancelQueuedMsg{} }
+func (m *MsgCancelQueuedMsg) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelQueuedMsg) ProtoMessage()    {}
+func (*MsgCancelQueuedMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5fc8fed8f4e58b6, []int{10}
+}
+func (m *MsgCancelQueuedMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelQueuedMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelQueuedMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelQueuedMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelQueuedMsg.Merge(m, src)
+}
+func (m *MsgCancelQueuedMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelQueuedMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelQueuedMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelQueuedMsg proto.InternalMessageInfo
+
+func (m *MsgCancelQueuedMsg) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgCancelQueuedMsg) GetMsgId() []byte {
+	if m != nil {
+		return m.MsgId
+	}
+	return nil
+}
+
+// MsgCancelQueuedMsgResponse is the response to the MsgCancelQueuedMsg message
+type MsgCancelQueuedMsgResponse struct {
+}
+
+func (m *MsgCancelQueuedMsgResponse) Reset()         { *m = MsgCancelQueuedMsgResponse{} }
+func (m *MsgCancelQueuedMsgResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelQueuedMsgResponse) ProtoMessage()    {}
+func (*MsgCancelQueuedMsgResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5fc8fed8f4e58b6, []int{11}
+}
+func (m *MsgCancelQueuedMsgResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelQueuedMsgResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelQueuedMsgResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelQueuedMsgResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelQueuedMsgResponse.Merge(m, src)
+}
+func (m *MsgCancelQueuedMsgResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelQueuedMsgResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelQueuedMsgResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelQueuedMsgResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgWrappedDelegate)(nil), "babylon.epoching.v1.MsgWrappedDelegate")
 	proto.RegisterType((*MsgWrappedDelegateResponse)(nil), "babylon.epoching.v1.MsgWrappedDelegateResponse")
@@ -448,49 +544,55 @@ func init() {
 	proto.RegisterType((*MsgWrappedCancelUnbondingDelegationResponse)(nil), "babylon.epoching.v1.MsgWrappedCancelUnbondingDelegationResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.epoching.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.epoching.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCancelQueuedMsg)(nil), "babylon.epoching.v1.MsgCancelQueuedMsg")
+	proto.RegisterType((*MsgCancelQueuedMsgResponse)(nil), "babylon.epoching.v1.MsgCancelQueuedMsgResponse")
 }
 
 func init() { proto.RegisterFile("babylon/epoching/v1/tx.proto", fileDescriptor_a5fc8fed8f4e58b6) }
 
 var fileDescriptor_a5fc8fed8f4e58b6 = []byte{
-	// 585 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4f, 0x6f, 0x12, 0x41,
-	0x18, 0xc6, 0x77, 0x6d, 0x6d, 0xd2, 0x57, 0x63, 0x75, 0x25, 0x16, 0x56, 0xb2, 0x20, 0x68, 0x54,
-	0xb4, 0xbb, 0x52, 0xb5, 0x6a, 0xe3, 0x41, 0xd1, 0x78, 0x30, 0x21, 0x31, 0x98, 0xc6, 0xc4, 0xc4,
-	0x98, 0x59, 0x76, 0x32, 0x6c, 0x60, 0x67, 0xd6, 0x9d, 0x69, 0x53, 0x4e, 0x36, 0x9e, 0x3c, 0x7a,
-	0xf0, 0x6c, 0xfa, 0x11, 0x7a, 0xf0, 0x43, 0xf4, 0xd8, 0x78, 0xf2, 0x64, 0x0c, 0x1c, 0xea, 0x07,
-	0xf0, 0x03, 0x18, 0xf6, 0x2f, 0x02, 0x0b, 0xe8, 0x8d, 0xe1, 0x7d, 0xde, 0xe7, 0xf9, 0xc1, 0x3e,
-	0x3b, 0x90, 0x37, 0x91, 0xd9, 0xed, 0x30, 0x6a, 0x60, 0x97, 0x35, 0x5b, 0x36, 0x25, 0xc6, 0x4e,
-	0xd5, 0x10, 0xbb, 0xba, 0xeb, 0x31, 0xc1, 0x94, 0xf3, 0xe1, 0x54, 0x8f, 0xa6, 0xfa, 0x4e, 0x55,
-	0xcd, 0x10, 0x46, 0x98, 0x3f, 0x37, 0x06, 0x9f, 0x02, 0xa9, 0x5a, 0x68, 0x32, 0xee, 0x30, 0x6e,
-	0x70, 0x81, 0xda, 0x81, 0x8d, 0x89, 0x05, 0x4a, 0xbc, 0xd4, 0xe2, 0xa4, 0x24, 0x17, 0x79, 0xc8,
-	0xe1, 0xa1, 0x22, 0x17, 0x58, 0xbc, 0x0d, 0xbc, 0x83, 0x43, 0x38, 0x5a, 0x0d, 0xdd, 0x1d, 0xee,
-	0xaf, 0x39, 0x9c, 0x04, 0x83, 0xd2, 0x1b, 0x50, 0xea, 0x9c, 0xbc, 0xf2, 0x90, 0xeb, 0x62, 0xeb,
-	0x29, 0xee, 0x60, 0x82, 0x04, 0x56, 0xee, 0xc2, 0x82, 0xc3, 0x49, 0x56, 0x2e, 0xca, 0xd7, 0x4e,
-	0xad, 0x97, 0xf5, 0xd0, 0x2a, 0x44, 0xd3, 0x43, 0x34, 0xbd, 0xce, 0x49, 0xb4, 0xd1, 0x18, 0xe8,
-	0x37, 0xcf, 0x7e, 0xdc, 0x2f, 0x48, 0xbf, 0xf6, 0x0b, 0xd2, 0x87, 0xe3, 0x83, 0xca, 0xe0, 0x9b,
-	0x52, 0x1e, 0xd4, 0x71, 0xfb, 0x06, 0xe6, 0x2e, 0xa3, 0x1c, 0x97, 0x10, 0x64, 0x92, 0xe9, 0x16,
-	0xb5, 0xa2, 0xf8, 0x7b, 0xc3, 0xf1, 0x57, 0xa6, 0xc4, 0x27, 0x3b, 0x69, 0x00, 0x1a, 0xe4, 0x27,
-	0x45, 0xc4, 0x08, 0x6d, 0xc8, 0x25, 0xf3, 0x1a, 0x26, 0x36, 0x6d, 0xe0, 0x98, 0xe3, 0xe1, 0x30,
-	0x47, 0x65, 0x0a, 0xc7, 0xc8, 0x62, 0x1a, 0x4c, 0x19, 0x2e, 0xa5, 0x86, 0xc5, 0x44, 0xef, 0xa1,
-	0x9c, 0x88, 0x9e, 0x20, 0xda, 0xc4, 0x9d, 0x2d, 0x6a, 0x32, 0x6a, 0xd9, 0x34, 0xfa, 0xbb, 0x6d,
-	0x46, 0x95, 0x67, 0xc3, 0x6c, 0x77, 0xa6, 0xb0, 0xa5, 0x5a, 0xa4, 0x51, 0xae, 0xc1, 0x8d, 0x39,
-	0x00, 0x62, 0xde, 0xcf, 0x32, 0xac, 0x0c, 0x1e, 0x85, 0x6b, 0x21, 0x81, 0x5f, 0xf8, 0x7d, 0x54,
-	0x36, 0x60, 0x19, 0x6d, 0x8b, 0x16, 0xf3, 0x6c, 0xd1, 0xf5, 0x11, 0x97, 0x6b, 0xd9, 0x6f, 0x5f,
-	0xd7, 0x32, 0x21, 0xe5, 0x63, 0xcb, 0xf2, 0x30, 0xe7, 0x2f, 0x85, 0x67, 0x53, 0xd2, 0x48, 0xa4,
-	0xca, 0x03, 0x58, 0x0a, 0x1a, 0x9d, 0x3d, 0xe1, 0xff, 0xae, 0x8b, 0xfa, 0x84, 0x17, 0x48, 0x0f,
-	0x42, 0x6a, 0x8b, 0x87, 0x3f, 0x0a, 0x52, 0x23, 0x5c, 0xd8, 0x3c, 0x33, 0xe0, 0x4f, 0xac, 0x4a,
-	0x39, 0x58, 0x1d, 0xa1, 0x8a, 0x88, 0xd7, 0x7f, 0x2f, 0xc2, 0x42, 0x9d, 0x13, 0xa5, 0x0d, 0x2b,
-	0xa3, 0xc5, 0xbf, 0x3a, 0x31, 0x70, 0xbc, 0xc2, 0xaa, 0x31, 0xa7, 0x30, 0x0a, 0x55, 0xde, 0xc1,
-	0xb9, 0xf1, 0xa2, 0x5f, 0x9f, 0xe1, 0x92, 0x48, 0xd5, 0xea, 0xdc, 0xd2, 0x38, 0x72, 0x4f, 0x86,
-	0x0b, 0x29, 0xcd, 0xd6, 0x67, 0xb8, 0x8d, 0xe8, 0xd5, 0x8d, 0x7f, 0xd3, 0xc7, 0x08, 0x5f, 0x64,
-	0x28, 0xce, 0xac, 0xf2, 0xfd, 0x19, 0xe6, 0xa9, 0x9b, 0xea, 0xa3, 0xff, 0xdd, 0x8c, 0x01, 0x4d,
-	0x38, 0xfd, 0x57, 0x73, 0x2f, 0xa7, 0x39, 0x0e, 0xab, 0xd4, 0x9b, 0xf3, 0xa8, 0xa2, 0x0c, 0xf5,
-	0xe4, 0xde, 0xf1, 0x41, 0x45, 0xae, 0x3d, 0x3f, 0xec, 0x69, 0xf2, 0x51, 0x4f, 0x93, 0x7f, 0xf6,
-	0x34, 0xf9, 0x53, 0x5f, 0x93, 0x8e, 0xfa, 0x9a, 0xf4, 0xbd, 0xaf, 0x49, 0xaf, 0x6f, 0x11, 0x5b,
-	0xb4, 0xb6, 0x4d, 0xbd, 0xc9, 0x1c, 0x23, 0x34, 0x6e, 0xb6, 0x90, 0x4d, 0xa3, 0x83, 0xb1, 0x9b,
-	0x5c, 0xfa, 0xa2, 0xeb, 0x62, 0x6e, 0x2e, 0xf9, 0xb7, 0xf7, 0xed, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xdf, 0xdb, 0xcd, 0x7f, 0x7f, 0x06, 0x00, 0x00,
+	// 651 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x6d, 0x4a, 0x23, 0xf5, 0x5a, 0x51, 0x30, 0x85, 0xb6, 0xa6, 0x72, 0x4a, 0x02, 0x02,
+	0x02, 0xb5, 0x9b, 0x02, 0x05, 0x22, 0x06, 0x08, 0x08, 0x09, 0xa4, 0x48, 0x60, 0x54, 0x21, 0x21,
+	0xa1, 0xea, 0x1c, 0x9f, 0x2e, 0x26, 0xf1, 0x9d, 0xf1, 0x39, 0x55, 0x33, 0x51, 0x31, 0x31, 0x32,
+	0x30, 0xa3, 0x7e, 0x84, 0x0e, 0x7c, 0x88, 0x8e, 0x15, 0x13, 0x13, 0x82, 0x64, 0x28, 0x1f, 0x03,
+	0xd9, 0x3e, 0xdb, 0xc1, 0x89, 0x93, 0xc0, 0x96, 0xcb, 0xfb, 0xbf, 0xff, 0xff, 0x77, 0xc9, 0x7b,
+	0x3a, 0xb0, 0x62, 0x40, 0xa3, 0xd3, 0xa2, 0x44, 0x43, 0x0e, 0xad, 0x37, 0x2c, 0x82, 0xb5, 0x9d,
+	0xb2, 0xe6, 0xed, 0xaa, 0x8e, 0x4b, 0x3d, 0x2a, 0x9d, 0xe5, 0x55, 0x35, 0xaa, 0xaa, 0x3b, 0x65,
+	0x79, 0x01, 0x53, 0x4c, 0x83, 0xba, 0xe6, 0x7f, 0x0a, 0xa5, 0x72, 0xbe, 0x4e, 0x99, 0x4d, 0x99,
+	0xc6, 0x3c, 0xd8, 0x0c, 0x6d, 0x0c, 0xe4, 0xc1, 0xc4, 0x4b, 0x5e, 0x1d, 0x96, 0xe4, 0x40, 0x17,
+	0xda, 0x8c, 0x2b, 0x96, 0x43, 0x8b, 0xed, 0xd0, 0x3b, 0x3c, 0xf0, 0xd2, 0x22, 0x77, 0xb7, 0x59,
+	0xd0, 0x66, 0x33, 0x1c, 0x16, 0x0a, 0x6f, 0x80, 0x54, 0x63, 0xf8, 0x95, 0x0b, 0x1d, 0x07, 0x99,
+	0x8f, 0x51, 0x0b, 0x61, 0xe8, 0x21, 0xe9, 0x36, 0x98, 0xb2, 0x19, 0x5e, 0x12, 0x57, 0xc5, 0xab,
+	0xb3, 0x1b, 0x45, 0x95, 0x5b, 0x71, 0x34, 0x95, 0xa3, 0xa9, 0x35, 0x86, 0xa3, 0x0e, 0xdd, 0xd7,
+	0x57, 0x4e, 0x7f, 0xdc, 0xcf, 0x0b, 0xbf, 0xf7, 0xf3, 0xc2, 0x87, 0xe3, 0x83, 0x92, 0xff, 0x4d,
+	0x61, 0x05, 0xc8, 0x83, 0xf6, 0x3a, 0x62, 0x0e, 0x25, 0x0c, 0x15, 0x20, 0x58, 0x48, 0xaa, 0x5b,
+	0xc4, 0x8c, 0xe2, 0xef, 0xf4, 0xc7, 0x5f, 0x1e, 0x11, 0x9f, 0xf4, 0x64, 0x01, 0x28, 0x60, 0x65,
+	0x58, 0x44, 0x8c, 0xd0, 0x04, 0xcb, 0x49, 0xbd, 0x8a, 0xb0, 0x45, 0x74, 0x14, 0x73, 0xdc, 0xef,
+	0xe7, 0x28, 0x8d, 0xe0, 0x48, 0x35, 0x66, 0xc1, 0x14, 0xc1, 0xc5, 0xcc, 0xb0, 0x98, 0xe8, 0x3d,
+	0x28, 0x26, 0xa2, 0x47, 0x90, 0xd4, 0x51, 0x6b, 0x8b, 0x18, 0x94, 0x98, 0x16, 0x89, 0x7e, 0x6e,
+	0x8b, 0x12, 0xe9, 0x49, 0x3f, 0xdb, 0xad, 0x11, 0x6c, 0x99, 0x16, 0x59, 0x94, 0x6b, 0xe0, 0xfa,
+	0x04, 0x00, 0x31, 0xef, 0x67, 0x11, 0xcc, 0xfb, 0x7f, 0x85, 0x63, 0x42, 0x0f, 0x3d, 0x0f, 0xe6,
+	0x51, 0xda, 0x04, 0x33, 0xb0, 0xed, 0x35, 0xa8, 0x6b, 0x79, 0x9d, 0x00, 0x71, 0xa6, 0xba, 0xf4,
+	0xed, 0xeb, 0xda, 0x02, 0xa7, 0x7c, 0x68, 0x9a, 0x2e, 0x62, 0xec, 0xa5, 0xe7, 0x5a, 0x04, 0xeb,
+	0x89, 0x54, 0xba, 0x07, 0x72, 0xe1, 0x44, 0x2f, 0x9d, 0x08, 0xee, 0x75, 0x41, 0x1d, 0xb2, 0x40,
+	0x6a, 0x18, 0x52, 0x3d, 0x79, 0xf8, 0x23, 0x2f, 0xe8, 0xbc, 0xa1, 0x72, 0xca, 0xe7, 0x4f, 0xac,
+	0x0a, 0xcb, 0x60, 0x31, 0x45, 0x15, 0x13, 0xbf, 0x0d, 0x66, 0x3e, 0xbc, 0xd9, 0x8b, 0x36, 0x6a,
+	0x23, 0xb3, 0xc6, 0xb0, 0xb4, 0x0e, 0x72, 0xcc, 0xc2, 0x04, 0xb9, 0x63, 0x81, 0xb9, 0x4e, 0x3a,
+	0x07, 0x72, 0x36, 0xc3, 0xdb, 0x96, 0x19, 0xd0, 0xce, 0xe9, 0xd3, 0x36, 0xc3, 0x4f, 0xcd, 0xca,
+	0xac, 0x4f, 0xc2, 0x35, 0x7c, 0x01, 0x52, 0x59, 0x11, 0xc9, 0xc6, 0xaf, 0x69, 0x30, 0xe5, 0x67,
+	0x37, 0xc1, 0x7c, 0x7a, 0x05, 0xaf, 0x0c, 0xbd, 0xfa, 0xe0, 0x32, 0xc9, 0xda, 0x84, 0xc2, 0x28,
+	0x54, 0x7a, 0x07, 0xce, 0x0c, 0xae, 0xdc, 0xb5, 0x31, 0x2e, 0x89, 0x54, 0x2e, 0x4f, 0x2c, 0x8d,
+	0x23, 0xf7, 0x44, 0x70, 0x3e, 0x63, 0xc7, 0xd4, 0x31, 0x6e, 0x29, 0xbd, 0xbc, 0xf9, 0x6f, 0xfa,
+	0x18, 0xe1, 0x8b, 0x08, 0x56, 0xc7, 0x2e, 0xd5, 0xdd, 0x31, 0xe6, 0x99, 0x9d, 0xf2, 0x83, 0xff,
+	0xed, 0x8c, 0x01, 0x0d, 0x30, 0xf7, 0xd7, 0x0e, 0x5d, 0xca, 0x72, 0xec, 0x57, 0xc9, 0x37, 0x26,
+	0x51, 0xc5, 0x19, 0x4d, 0x30, 0x9f, 0x1e, 0xfb, 0xcc, 0x39, 0x4b, 0x09, 0x65, 0x6d, 0x42, 0x61,
+	0x14, 0x26, 0x4f, 0xef, 0x1d, 0x1f, 0x94, 0xc4, 0xea, 0xb3, 0xc3, 0xae, 0x22, 0x1e, 0x75, 0x15,
+	0xf1, 0x67, 0x57, 0x11, 0x3f, 0xf5, 0x14, 0xe1, 0xa8, 0xa7, 0x08, 0xdf, 0x7b, 0x8a, 0xf0, 0x7a,
+	0x1d, 0x5b, 0x5e, 0xa3, 0x6d, 0xa8, 0x75, 0x6a, 0x6b, 0xdc, 0xbb, 0xde, 0x80, 0x16, 0x89, 0x0e,
+	0xda, 0x6e, 0xf2, 0xd6, 0x79, 0x1d, 0x07, 0x31, 0x23, 0x17, 0x3c, 0x5a, 0x37, 0xff, 0x04, 0x00,
+	0x00, 0xff, 0xff, 0x92, 0x3c, 0x44, 0x94, 0x76, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -519,6 +621,9 @@ type MsgClient interface {
 	WrappedCancelUnbondingDelegation(ctx context.Context, in *MsgWrappedCancelUnbondingDelegation, opts ...grpc.CallOption) (*MsgWrappedCancelUnbondingDelegationResponse, error)
 	// UpdateParams defines a method for updating epoching module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CancelQueuedMsg defines a method for the sender of a queued message to
+	// withdraw it before the end of the current epoch.
+	CancelQueuedMsg(ctx context.Context, in *MsgCancelQueuedMsg, opts ...grpc.CallOption) (*MsgCancelQueuedMsgResponse, error)
 }
 
 type msgClient struct {
@@ -574,6 +679,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) CancelQueuedMsg(ctx context.Context, in *MsgCancelQueuedMsg, opts ...grpc.CallOption) (*MsgCancelQueuedMsgResponse, error) {
+	out := new(MsgCancelQueuedMsgResponse)
+	err := c.cc.Invoke(ctx, "/babylon.epoching.v1.Msg/CancelQueuedMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// WrappedDelegate defines a method for performing a delegation of coins from
@@ -590,6 +704,9 @@ type MsgServer interface {
 	WrappedCancelUnbondingDelegation(context.Context, *MsgWrappedCancelUnbondingDelegation) (*MsgWrappedCancelUnbondingDelegationResponse, error)
 	// UpdateParams defines a method for updating epoching module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CancelQueuedMsg defines a method for the sender of a queued message to
+	// withdraw it before the end of the current epoch.
+	CancelQueuedMsg(context.Context, *MsgCancelQueuedMsg) (*MsgCancelQueuedMsgResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -611,6 +728,9 @@ func (*UnimplementedMsgServer) WrappedCancelUnbondingDelegation(ctx context.Cont
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) CancelQueuedMsg(ctx context.Context, req *MsgCancelQueuedMsg) (*MsgCancelQueuedMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelQueuedMsg not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -706,6 +826,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelQueuedMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelQueuedMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelQueuedMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.epoching.v1.Msg/CancelQueuedMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelQueuedMsg(ctx, req.(*MsgCancelQueuedMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.epoching.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -730,6 +868,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "CancelQueuedMsg",
+			Handler:    _Msg_CancelQueuedMsg_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/epoching/v1/tx.proto",
@@ -1030,6 +1172,66 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelQueuedMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelQueuedMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelQueuedMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgId) > 0 {
+		i -= len(m.MsgId)
+		copy(dAtA[i:], m.MsgId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelQueuedMsgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelQueuedMsgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelQueuedMsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1153,6 +1355,32 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelQueuedMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelQueuedMsgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1868,6 +2096,172 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelQueuedMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelQueuedMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelQueuedMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgId = append(m.MsgId[:0], dAtA[iNdEx:postIndex]...)
+			if m.MsgId == nil {
+				m.MsgId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelQueuedMsgResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelQueuedMsgResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelQueuedMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -96,3 +96,58 @@ func (vs ValidatorSet) MustMarshal() []byte {
 	}
 	return vsBytes
 }
+
+// ValidatorSetDiff is the difference between the validator sets of two
+// consecutive epochs. Each of the sets is sorted by validator address.
+type ValidatorSetDiff struct {
+	// Added is the set of validators that join the validator set
+	Added ValidatorSet
+	// Removed is the set of validators that leave the validator set, with
+	// their voting power before leaving
+	Removed ValidatorSet
+	// Updated is the set of validators whose voting power changes, with
+	// their new voting power
+	Updated ValidatorSet
+}
+
+// NewValidatorSetDiff computes the difference from the validator set prev to
+// the validator set cur
+func NewValidatorSetDiff(prev ValidatorSet, cur ValidatorSet) ValidatorSetDiff {
+	diff := ValidatorSetDiff{
+		Added:   ValidatorSet{},
+		Removed: ValidatorSet{},
+		Updated: ValidatorSet{},
+	}
+	prevPower := make(map[string]int64, len(prev))
+	for _, val := range prev {
+		prevPower[string(val.Addr)] = val.Power
+	}
+	curPower := make(map[string]int64, len(cur))
+	for _, val := range cur {
+		curPower[string(val.Addr)] = val.Power
+	}
+
+	for _, val := range cur {
+		power, ok := prevPower[string(val.Addr)]
+		if !ok {
+			diff.Added = append(diff.Added, val)
+		} else if power != val.Power {
+			diff.Updated = append(diff.Updated, val)
+		}
+	}
+	for _, val := range prev {
+		if _, ok := curPower[string(val.Addr)]; !ok {
+			diff.Removed = append(diff.Removed, val)
+		}
+	}
+
+	diff.Added = NewSortedValidatorSet(diff.Added)
+	diff.Removed = NewSortedValidatorSet(diff.Removed)
+	diff.Updated = NewSortedValidatorSet(diff.Updated)
+	return diff
+}
+
+// IsEmpty returns whether the validator set does not change
+func (d ValidatorSetDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Updated) == 0
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/babylonchain/babylon/x/epoching/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -21,3 +22,37 @@ func TestValidatorSet_FindValidatorWithIndex(t *testing.T) {
 	require.Nil(t, val)
 	require.Equal(t, 0, index)
 }
+
+func TestNewValidatorSetDiff(t *testing.T) {
+	prevValSet := datagen.GenRandomValSet(10)
+
+	// remove the first 3 validators, update the power of the next 3
+	// validators, and add 2 new validators
+	curVals := []types.Validator{}
+	for i, val := range prevValSet {
+		if i < 3 {
+			continue
+		}
+		if i < 6 {
+			val.Power += 1
+		}
+		curVals = append(curVals, val)
+	}
+	newVals := datagen.GenRandomValSet(2)
+	curVals = append(curVals, newVals...)
+	curValSet := types.NewSortedValidatorSet(curVals)
+
+	diff := types.NewValidatorSetDiff(prevValSet, curValSet)
+	require.False(t, diff.IsEmpty())
+	require.ElementsMatch(t, prevValSet[:3], diff.Removed)
+	require.Len(t, diff.Updated, 3)
+	for _, val := range diff.Updated {
+		_, idx, err := prevValSet.FindValidatorWithIndex(val.Addr)
+		require.NoError(t, err)
+		require.Equal(t, prevValSet[idx].Power+1, val.Power)
+	}
+	require.ElementsMatch(t, newVals, diff.Added)
+
+	// no difference between the same validator sets
+	require.True(t, types.NewValidatorSetDiff(curValSet, curValSet).IsEmpty())
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/babylonchain/babylon/x/finality/types"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
@@ -18,14 +19,40 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 func EndBlocker(ctx context.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
-	// if the BTC staking protocol is activated, i.e., there exists a height where a finality provider
-	// has voting power, start indexing and tallying blocks
-	if _, err := k.BTCStakingKeeper.GetBTCStakingActivatedHeight(ctx); err == nil {
+	// if the finality gadget is activated, i.e., there exists a height where
+	// the total voting power of finality providers reaches the threshold in
+	// the parameters, start indexing and tallying blocks
+	k.UpdateActivatedHeight(ctx)
+	if _, err := k.GetActivatedHeight(ctx); err == nil {
 		// index the current block
 		k.IndexBlock(ctx)
 		// tally all non-finalised blocks
 		k.TallyBlocks(ctx)
+		// handle the liveness of finality providers at the height that
+		// finality providers are no longer expected to vote for
+		if err := handleLiveness(ctx, k); err != nil {
+			return nil, err
+		}
+		// prune finality signatures of blocks that are finalised and
+		// checkpointed to BTC
+		k.PruneVotes(ctx)
 	}
 
 	return []abci.ValidatorUpdate{}, nil
 }
+
+// handleLiveness handles the liveness of finality providers at the height that
+// is FinalitySigTimeout blocks behind the current height, if the finality
+// gadget has been activated at that height
+func handleLiveness(ctx context.Context, k keeper.Keeper) error {
+	activatedHeight, err := k.GetActivatedHeight(ctx)
+	if err != nil {
+		return err
+	}
+	curHeight := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	timeout := k.GetParams(ctx).FinalitySigTimeout
+	if curHeight < activatedHeight+timeout {
+		return nil
+	}
+	return k.HandleLiveness(ctx, curHeight-timeout)
+}
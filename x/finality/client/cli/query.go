@@ -1,8 +1,11 @@
 package cli
 
 import (
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 
@@ -32,7 +35,15 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdBlock())
 	cmd.AddCommand(CmdListBlocks())
 	cmd.AddCommand(CmdVotesAtHeight())
+	cmd.AddCommand(CmdEvidence())
 	cmd.AddCommand(CmdListEvidences())
+	cmd.AddCommand(CmdLastPubRandCommit())
+	cmd.AddCommand(CmdSigningInfo())
+	cmd.AddCommand(CmdSigningInfos())
+	cmd.AddCommand(CmdConsumerFinalizedBlock())
+	cmd.AddCommand(CmdDelegationLifecycle())
+	cmd.AddCommand(CmdActivatedHeight())
+	cmd.AddCommand(CmdExportVotes())
 
 	return cmd
 }
@@ -139,6 +150,58 @@ func CmdBlock() *cobra.Command {
 	return cmd
 }
 
+func CmdLastPubRandCommit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "last-pub-rand-commit [fp_btc_pk_hex]",
+		Short: "show the last public randomness commitment of a given finality provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LastPubRandCommit(cmd.Context(), &types.QueryLastPubRandCommitRequest{
+				FpBtcPkHex: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdEvidence() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evidence [fp_btc_pk_hex]",
+		Short: "show the first slashable evidence and the extracted BTC SK of a given finality provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Evidence(cmd.Context(), &types.QueryEvidenceRequest{
+				FpBtcPkHex: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdListEvidences() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-evidences",
@@ -176,3 +239,226 @@ func CmdListEvidences() *cobra.Command {
 
 	return cmd
 }
+
+func CmdSigningInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-info [fp_btc_pk_hex]",
+		Short: "show the signing info of a given finality provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SigningInfo(cmd.Context(), &types.QuerySigningInfoRequest{
+				FpBtcPkHex: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSigningInfos() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-infos",
+		Short: "list the signing infos of all finality providers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SigningInfos(cmd.Context(), &types.QuerySigningInfosRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "signing-infos")
+
+	return cmd
+}
+
+func CmdConsumerFinalizedBlock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-finalized-block [consumer_id] [height]",
+		Short: "show the finalized block of a given consumer system at a given height",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ConsumerFinalizedBlock(cmd.Context(), &types.QueryConsumerFinalizedBlockRequest{
+				ConsumerId: args[0],
+				Height:     height,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDelegationLifecycle() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegation-lifecycle [staking_tx_hash_hex]",
+		Short: "show the lifecycle of a given BTC delegation, including its status, covenant quorum progress, staking tx depth and the activity of its finality providers",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DelegationLifecycle(cmd.Context(), &types.QueryDelegationLifecycleRequest{
+				StakingTxHashHex: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdActivatedHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "activated-height",
+		Short: "show the Babylon height at which the finality gadget activated",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ActivatedHeight(cmd.Context(), &types.QueryActivatedHeightRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// exportedVotes is the record of the finality signatures of a block that
+// CmdExportVotes outputs as a JSON line
+type exportedVotes struct {
+	Height     uint64   `json:"height"`
+	AppHash    string   `json:"app_hash"`
+	Finalized  bool     `json:"finalized"`
+	VotedPower uint64   `json:"voted_power"`
+	TotalPower uint64   `json:"total_power"`
+	FpBtcPks   []string `json:"fp_btc_pks"`
+}
+
+func CmdExportVotes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-votes [start_height] [end_height]",
+		Short: "export the finality provider pks who voted at each babylon height in the given range as JSON lines",
+		Long: strings.TrimSpace(`Export the finality provider pks who voted at each babylon height in the given range as JSON lines.
+The finality signatures of pruned blocks are queried at the height before pruning, which requires the
+node to be an archive node.`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startHeight, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			endHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			if startHeight > endHeight {
+				return fmt.Errorf("start height %d is larger than end height %d", startHeight, endHeight)
+			}
+
+			for height := startHeight; height <= endHeight; height++ {
+				blockRes, err := queryClient.Block(cmd.Context(), &types.QueryBlockRequest{Height: height})
+				if err != nil {
+					// the block is not indexed by the finality module
+					continue
+				}
+
+				// the finality signatures of pruned blocks are only available
+				// at heights before the pruning
+				votesQueryClient := queryClient
+				if prunedHeight := blockRes.Block.VotesPrunedHeight; prunedHeight != 0 {
+					votesQueryClient = types.NewQueryClient(clientCtx.WithHeight(int64(prunedHeight - 1)))
+				}
+				votesRes, err := votesQueryClient.VotesAtHeight(cmd.Context(), &types.QueryVotesAtHeightRequest{Height: height})
+				if err != nil {
+					return fmt.Errorf("failed to query votes at height %d: %w", height, err)
+				}
+
+				record := exportedVotes{
+					Height:     height,
+					AppHash:    hex.EncodeToString(blockRes.Block.AppHash),
+					Finalized:  blockRes.Block.Finalized,
+					VotedPower: blockRes.VotedPower,
+					TotalPower: blockRes.TotalPower,
+					FpBtcPks:   make([]string, 0, len(votesRes.BtcPks)),
+				}
+				for i := range votesRes.BtcPks {
+					record.FpBtcPks = append(record.FpBtcPks, votesRes.BtcPks[i].MarshalHex())
+				}
+				bz, err := json.Marshal(record)
+				if err != nil {
+					return err
+				}
+				if _, err := fmt.Fprintln(cmd.OutOrStdout(), string(bz)); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
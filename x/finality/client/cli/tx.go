@@ -14,6 +14,10 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	flagConsumerID = "consumer-id"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -25,12 +29,82 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(
+		NewCommitPubRandListCmd(),
 		NewAddFinalitySigCmd(),
+		NewUnjailFinalityProviderCmd(),
 	)
 
 	return cmd
 }
 
+func NewCommitPubRandListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-pubrand-list [fp_btc_pk] [start_height] [num_pub_rand] [commitment] [sig]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Commit a list of public randomness",
+		Long: strings.TrimSpace(
+			`Commit a list of public randomness via the merkle root of the list, ` +
+				`covering the heights [start_height, start_height+num_pub_rand-1]. ` +
+				`With --consumer-id, the public randomness is committed for the blocks of the given consumer system.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get finality provider BTC PK
+			fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			// get start height
+			startHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// get number of public randomness
+			numPubRand, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// get commitment
+			commitment, err := hex.DecodeString(args[3])
+			if err != nil {
+				return err
+			}
+
+			// get signature
+			sig, err := bbn.NewBIP340SignatureFromHex(args[4])
+			if err != nil {
+				return err
+			}
+
+			consumerID, _ := cmd.Flags().GetString(flagConsumerID)
+
+			msg := types.MsgCommitPubRandList{
+				Signer:      clientCtx.FromAddress.String(),
+				FpBtcPk:     fpBTCPK,
+				StartHeight: startHeight,
+				NumPubRand:  numPubRand,
+				Commitment:  commitment,
+				Sig:         sig,
+				ConsumerId:  consumerID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flagConsumerID, "", "The ID of the consumer system whose blocks the public randomness is committed for")
+
+	return cmd
+}
+
 func NewAddFinalitySigCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "add-finality-sig [fp_btc_pk] [block_height] [block_app_hash] [finality_sig]",
@@ -85,3 +159,38 @@ func NewAddFinalitySigCmd() *cobra.Command {
 
 	return cmd
 }
+
+func NewUnjailFinalityProviderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unjail-finality-provider [fp_btc_pk]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Unjail a finality provider jailed due to insufficient liveness",
+		Long: strings.TrimSpace(
+			`Unjail a finality provider jailed due to insufficient liveness, once its jail duration has passed. ` +
+				`The transaction has to be signed by the finality provider's Babylon account.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get finality provider BTC PK
+			fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUnjailFinalityProvider{
+				Signer:  clientCtx.FromAddress.String(),
+				FpBtcPk: fpBTCPK,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
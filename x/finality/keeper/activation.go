@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+// UpdateActivatedHeight records the current height as the height at which the
+// finality gadget activates, if it has not been activated yet and the total
+// voting power of the active finality providers at the current height reaches
+// the `MinTotalStakedSat` parameter. If the parameter is 0, the height at
+// which the BTC staking protocol activates is recorded instead.
+// This is triggered upon each `EndBlock`
+func (k Keeper) UpdateActivatedHeight(ctx context.Context) {
+	if k.hasActivatedHeight(ctx) {
+		return
+	}
+	btcStakingActivatedHeight, err := k.BTCStakingKeeper.GetBTCStakingActivatedHeight(ctx)
+	if err != nil {
+		// no finality provider has voting power yet
+		return
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	minTotalStakedSat := k.GetParams(ctx).MinTotalStakedSat
+	if minTotalStakedSat == 0 {
+		k.setActivatedHeight(ctx, btcStakingActivatedHeight)
+		return
+	}
+
+	height := uint64(sdkCtx.HeaderInfo().Height)
+	totalPower := uint64(0)
+	for _, power := range k.BTCStakingKeeper.GetVotingPowerTable(ctx, height) {
+		totalPower += power
+	}
+	if totalPower < minTotalStakedSat {
+		return
+	}
+
+	k.Logger(sdkCtx).Info("finality gadget is activated", "height", height, "total staked sat", totalPower)
+	k.setActivatedHeight(ctx, height)
+}
+
+// GetActivatedHeight returns the height at which the finality gadget
+// activates. If it has not been recorded and the `MinTotalStakedSat`
+// parameter is 0, the finality gadget activates along with the BTC staking
+// protocol
+func (k Keeper) GetActivatedHeight(ctx context.Context) (uint64, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.ActivatedHeightKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz != nil {
+		return sdk.BigEndianToUint64(bz), nil
+	}
+
+	if k.GetParams(ctx).MinTotalStakedSat == 0 {
+		return k.BTCStakingKeeper.GetBTCStakingActivatedHeight(ctx)
+	}
+	return 0, types.ErrFinalityNotActivated
+}
+
+// hasActivatedHeight returns whether the height at which the finality gadget
+// activates is recorded
+func (k Keeper) hasActivatedHeight(ctx context.Context) bool {
+	store := k.storeService.OpenKVStore(ctx)
+	has, err := store.Has(types.ActivatedHeightKey)
+	if err != nil {
+		panic(err)
+	}
+	return has
+}
+
+// setActivatedHeight records the height at which the finality gadget
+// activates
+func (k Keeper) setActivatedHeight(ctx context.Context, height uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.ActivatedHeightKey, sdk.Uint64ToBigEndian(height)); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	"context"
+)
+
+// HasConsumer checks whether the given consumer system is registered in the
+// consumer registry of zoneconcierge. No consumer system is registered if the
+// zoneconcierge keeper is not set
+func (k Keeper) HasConsumer(ctx context.Context, consumerID string) bool {
+	if k.zcKeeper == nil {
+		return false
+	}
+	return k.zcKeeper.HasConsumer(ctx, consumerID)
+}
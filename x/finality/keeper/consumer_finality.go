@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+// SetConsumerVote sets the vote of the given finality provider on the block of
+// the given consumer system at the given height
+func (k Keeper) SetConsumerVote(ctx context.Context, consumerID string, height uint64, fpBtcPK *bbn.BIP340PubKey, vote *types.ConsumerVote) {
+	store := k.consumerVoteHeightStore(ctx, consumerID, height)
+	store.Set(fpBtcPK.MustMarshal(), k.cdc.MustMarshal(vote))
+}
+
+// GetConsumerVote returns the vote of the given finality provider on the block
+// of the given consumer system at the given height
+func (k Keeper) GetConsumerVote(ctx context.Context, consumerID string, height uint64, fpBtcPK *bbn.BIP340PubKey) (*types.ConsumerVote, error) {
+	store := k.consumerVoteHeightStore(ctx, consumerID, height)
+	voteBytes := store.Get(fpBtcPK.MustMarshal())
+	if len(voteBytes) == 0 {
+		return nil, types.ErrVoteNotFound
+	}
+	var vote types.ConsumerVote
+	k.cdc.MustUnmarshal(voteBytes, &vote)
+	return &vote, nil
+}
+
+// GetConsumerVoters returns the BTC PKs of the finality providers that have
+// voted for the given block hash of the given consumer system at the given
+// height
+func (k Keeper) GetConsumerVoters(ctx context.Context, consumerID string, height uint64, blockHash []byte) map[string]struct{} {
+	voterBTCPKs := map[string]struct{}{}
+	iter := k.consumerVoteHeightStore(ctx, consumerID, height).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var vote types.ConsumerVote
+		k.cdc.MustUnmarshal(iter.Value(), &vote)
+		if !bytes.Equal(vote.BlockHash, blockHash) {
+			continue
+		}
+		fpBTCPK, err := bbn.NewBIP340PubKey(iter.Key())
+		if err != nil {
+			// failing to unmarshal finality provider's BTC PK in KVStore is a programming error
+			panic(fmt.Errorf("%w: %w", bbn.ErrUnmarshal, err))
+		}
+		voterBTCPKs[fpBTCPK.MarshalHex()] = struct{}{}
+	}
+	return voterBTCPKs
+}
+
+// GetConsumerVotingPowerTable returns the voting power table of the finality
+// providers securing the given consumer system at the given height of the
+// consumer system. The voting power table is snapshotted upon the first vote
+// on the height, and is empty if there is no vote on the height yet.
+func (k Keeper) GetConsumerVotingPowerTable(ctx context.Context, consumerID string, height uint64) map[string]uint64 {
+	vpTable := map[string]uint64{}
+	iter := k.consumerVotingPowerHeightStore(ctx, consumerID, height).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		fpBTCPK, err := bbn.NewBIP340PubKey(iter.Key())
+		if err != nil {
+			// failing to unmarshal finality provider's BTC PK in KVStore is a programming error
+			panic(fmt.Errorf("%w: %w", bbn.ErrUnmarshal, err))
+		}
+		vpTable[fpBTCPK.MarshalHex()] = sdk.BigEndianToUint64(iter.Value())
+	}
+	return vpTable
+}
+
+// getOrSnapshotConsumerVotingPowerTable returns the voting power table of the
+// given consumer system at the given height of the consumer system. If there
+// is none, it snapshots the voting power table that btcstaking recorded for
+// the consumer system at the Babylon height where the consumer block at the
+// given height is indexed. The voting power table thus only depends on the
+// voted height, and not on when the first vote on the height is cast. It
+// returns an error if the consumer block is not indexed on Babylon yet.
+func (k Keeper) getOrSnapshotConsumerVotingPowerTable(ctx context.Context, consumerID string, height uint64) (map[string]uint64, error) {
+	vpTable := k.GetConsumerVotingPowerTable(ctx, consumerID, height)
+	if len(vpTable) > 0 {
+		return vpTable, nil
+	}
+
+	indexedHeader, err := k.zcKeeper.GetHeader(ctx, consumerID, height)
+	if err != nil {
+		return nil, types.ErrConsumerBlockNotIndexed.Wrapf("consumer ID: %s, height: %d: %v", consumerID, height, err)
+	}
+	consumerVPTable := k.BTCStakingKeeper.GetConsumerVotingPowerTable(ctx, consumerID, indexedHeader.BabylonHeaderHeight)
+	store := k.consumerVotingPowerHeightStore(ctx, consumerID, height)
+	for fpBtcPKHex, power := range consumerVPTable {
+		if power == 0 {
+			continue
+		}
+		fpBtcPK, err := bbn.NewBIP340PubKeyFromHex(fpBtcPKHex)
+		if err != nil {
+			// failing to unmarshal finality provider's BTC PK in KVStore is a programming error
+			panic(fmt.Errorf("%w: %w", bbn.ErrUnmarshal, err))
+		}
+		store.Set(fpBtcPK.MustMarshal(), sdk.Uint64ToBigEndian(power))
+		vpTable[fpBtcPKHex] = power
+	}
+	return vpTable, nil
+}
+
+// SetConsumerBlock sets the given finalized block of a consumer system
+func (k Keeper) SetConsumerBlock(ctx context.Context, block *types.ConsumerBlock) {
+	store := k.consumerBlockStore(ctx, block.ConsumerId)
+	store.Set(sdk.Uint64ToBigEndian(block.Height), k.cdc.MustMarshal(block))
+}
+
+// GetConsumerBlock returns the finalized block of the given consumer system at
+// the given height
+func (k Keeper) GetConsumerBlock(ctx context.Context, consumerID string, height uint64) (*types.ConsumerBlock, error) {
+	store := k.consumerBlockStore(ctx, consumerID)
+	blockBytes := store.Get(sdk.Uint64ToBigEndian(height))
+	if len(blockBytes) == 0 {
+		return nil, types.ErrBlockNotFound.Wrapf("consumer ID: %s, height: %d", consumerID, height)
+	}
+	var block types.ConsumerBlock
+	k.cdc.MustUnmarshal(blockBytes, &block)
+	return &block, nil
+}
+
+// tallyConsumerBlock tallies the votes on the given block hash of the given
+// consumer system at the given height, and finalizes the block if it has
+// received votes of more than 2/3 of the voting power of the finality
+// providers securing the consumer system
+func (k Keeper) tallyConsumerBlock(ctx context.Context, consumerID string, height uint64, blockHash []byte) {
+	if _, err := k.GetConsumerBlock(ctx, consumerID, height); err == nil {
+		// the block at this height is already finalized
+		return
+	}
+
+	vpTable := k.GetConsumerVotingPowerTable(ctx, consumerID, height)
+	voterBTCPKs := k.GetConsumerVoters(ctx, consumerID, height, blockHash)
+	votedPower, totalPower := tally(vpTable, voterBTCPKs)
+	if !hasQuorum(votedPower, totalPower) {
+		return
+	}
+
+	block := &types.ConsumerBlock{
+		ConsumerId: consumerID,
+		Height:     height,
+		BlockHash:  blockHash,
+		Finalized:  true,
+	}
+	k.SetConsumerBlock(ctx, block)
+
+	event := types.NewEventConsumerBlockFinalized(block, votedPower, totalPower)
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("failed to emit EventConsumerBlockFinalized event: %w", err))
+	}
+}
+
+// consumerVoteHeightStore returns the KVStore of the votes on the block of the
+// given consumer system at the given height
+// prefix: ConsumerVoteKey
+// key: (consumer ID || height || finality provider PK)
+// value: ConsumerVote
+func (k Keeper) consumerVoteHeightStore(ctx context.Context, consumerID string, height uint64) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	voteStore := prefix.NewStore(storeAdapter, types.ConsumerVoteKey)
+	consumerStore := prefix.NewStore(voteStore, types.ConsumerIDKey(consumerID))
+	return prefix.NewStore(consumerStore, sdk.Uint64ToBigEndian(height))
+}
+
+// consumerVotingPowerHeightStore returns the KVStore of the voting power table
+// of the given consumer system at the given height
+// prefix: ConsumerVotingPowerKey
+// key: (consumer ID || height || finality provider PK)
+// value: voting power
+func (k Keeper) consumerVotingPowerHeightStore(ctx context.Context, consumerID string, height uint64) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	vpStore := prefix.NewStore(storeAdapter, types.ConsumerVotingPowerKey)
+	consumerStore := prefix.NewStore(vpStore, types.ConsumerIDKey(consumerID))
+	return prefix.NewStore(consumerStore, sdk.Uint64ToBigEndian(height))
+}
+
+// consumerBlockStore returns the KVStore of the finalized blocks of the given
+// consumer system
+// prefix: ConsumerBlockKey
+// key: (consumer ID || height)
+// value: ConsumerBlock
+func (k Keeper) consumerBlockStore(ctx context.Context, consumerID string) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	blockStore := prefix.NewStore(storeAdapter, types.ConsumerBlockKey)
+	return prefix.NewStore(blockStore, types.ConsumerIDKey(consumerID))
+}
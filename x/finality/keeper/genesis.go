@@ -2,6 +2,10 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
 
 	btcstk "github.com/babylonchain/babylon/btcstaking"
 	bbn "github.com/babylonchain/babylon/types"
@@ -22,6 +26,32 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 		k.SetSig(ctx, voteSig.BlockHeight, voteSig.FpBtcPk, voteSig.FinalitySig)
 	}
 
+	for _, prc := range gs.PubRandCommits {
+		k.SetPubRandCommit(ctx, prc.FpBtcPk, prc.PubRandCommit)
+	}
+
+	for _, info := range gs.SigningInfos {
+		k.SetSigningInfo(ctx, info)
+	}
+
+	for _, mb := range gs.MissedBlocks {
+		for _, index := range mb.MissedBlockIndexes {
+			k.setMissedBlockBitmapValue(ctx, mb.FpBtcPk, index, true)
+		}
+	}
+
+	for _, cprc := range gs.ConsumerPubRandCommits {
+		k.SetConsumerPubRandCommit(ctx, cprc.ConsumerId, cprc.FpBtcPk, cprc.PubRandCommit)
+	}
+
+	for _, block := range gs.ConsumerBlocks {
+		k.SetConsumerBlock(ctx, block)
+	}
+
+	if gs.ActivatedHeight > 0 {
+		k.setActivatedHeight(ctx, gs.ActivatedHeight)
+	}
+
 	return k.SetParams(ctx, gs.Params)
 }
 
@@ -42,11 +72,41 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	pubRandCommits, err := k.pubRandCommits(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	signingInfos, missedBlocks := k.signingInfosAndMissedBlocks(ctx)
+
+	consumerGs, err := k.consumersGenesis(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the recorded activated height is exported, as the finality gadget
+	// may otherwise activate along with the BTC staking protocol
+	activatedHeight := uint64(0)
+	if k.hasActivatedHeight(ctx) {
+		activatedHeight, err = k.GetActivatedHeight(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &types.GenesisState{
-		Params:        k.GetParams(ctx),
-		IndexedBlocks: blocks,
-		Evidences:     evidences,
-		VoteSigs:      voteSigs,
+		Params:         k.GetParams(ctx),
+		IndexedBlocks:  blocks,
+		Evidences:      evidences,
+		VoteSigs:       voteSigs,
+		PubRandCommits: pubRandCommits,
+		SigningInfos:   signingInfos,
+		MissedBlocks:   missedBlocks,
+
+		ConsumerPubRandCommits: consumerGs.ConsumerPubRandCommits,
+		ConsumerBlocks:         consumerGs.ConsumerBlocks,
+
+		ActivatedHeight: activatedHeight,
 	}, nil
 }
 
@@ -117,3 +177,99 @@ func (k Keeper) voteSigs(ctx context.Context) ([]*types.VoteSig, error) {
 
 	return voteSigs, nil
 }
+
+// pubRandCommits loads all public randomness commitments stored.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) pubRandCommits(ctx context.Context) ([]*types.FPPubRandCommit, error) {
+	iter := k.pubRandCommitStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	pubRandCommits := make([]*types.FPPubRandCommit, 0)
+	for ; iter.Valid(); iter.Next() {
+		// key contains the finality provider and the start height
+		fpBTCPK, err := bbn.NewBIP340PubKey(iter.Key()[:bbn.BIP340PubKeyLen])
+		if err != nil {
+			return nil, err
+		}
+		var prc types.PubRandCommit
+		if err := k.cdc.Unmarshal(iter.Value(), &prc); err != nil {
+			return nil, err
+		}
+
+		pubRandCommits = append(pubRandCommits, &types.FPPubRandCommit{
+			FpBtcPk:       fpBTCPK,
+			PubRandCommit: &prc,
+		})
+	}
+
+	return pubRandCommits, nil
+}
+
+// signingInfosAndMissedBlocks loads the signing infos and the missed blocks
+// bitmaps of all finality providers.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) signingInfosAndMissedBlocks(ctx context.Context) ([]*types.FinalityProviderSigningInfo, []*types.FinalityProviderMissedBlocks) {
+	signingInfos := make([]*types.FinalityProviderSigningInfo, 0)
+	missedBlocks := make([]*types.FinalityProviderMissedBlocks, 0)
+	k.IterateSigningInfos(ctx, func(info *types.FinalityProviderSigningInfo) bool {
+		signingInfos = append(signingInfos, info)
+		missedBlocks = append(missedBlocks, &types.FinalityProviderMissedBlocks{
+			FpBtcPk:            info.FpBtcPk,
+			MissedBlockIndexes: k.getMissedBlockIndexes(ctx, info.FpBtcPk),
+		})
+		return true
+	})
+	return signingInfos, missedBlocks
+}
+
+// consumersGenesis loads the public randomness commitments and the finalized
+// blocks of all consumer systems into a genesis state. The consumer systems
+// are registered in zoneconcierge, so the consumer IDs are parsed from the
+// keys of the stores.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) consumersGenesis(ctx context.Context) (*types.GenesisState, error) {
+	gs := &types.GenesisState{
+		ConsumerPubRandCommits: make([]*types.ConsumerPubRandCommit, 0),
+		ConsumerBlocks:         make([]*types.ConsumerBlock, 0),
+	}
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	prcIter := k.consumerPubRandCommitStore(ctx).Iterator(nil, nil)
+	defer prcIter.Close()
+	for ; prcIter.Valid(); prcIter.Next() {
+		// key contains the consumer ID, the finality provider and the start
+		// height
+		consumerID, rest, err := types.ParseConsumerIDKey(prcIter.Key())
+		if err != nil {
+			return nil, err
+		}
+		if len(rest) < bbn.BIP340PubKeyLen {
+			return nil, fmt.Errorf("malformed key %x of public randomness commitment", prcIter.Key())
+		}
+		fpBTCPK, err := bbn.NewBIP340PubKey(rest[:bbn.BIP340PubKeyLen])
+		if err != nil {
+			return nil, err
+		}
+		var prc types.PubRandCommit
+		if err := k.cdc.Unmarshal(prcIter.Value(), &prc); err != nil {
+			return nil, err
+		}
+		gs.ConsumerPubRandCommits = append(gs.ConsumerPubRandCommits, &types.ConsumerPubRandCommit{
+			ConsumerId:    consumerID,
+			FpBtcPk:       fpBTCPK,
+			PubRandCommit: &prc,
+		})
+	}
+
+	blockIter := prefix.NewStore(storeAdapter, types.ConsumerBlockKey).Iterator(nil, nil)
+	defer blockIter.Close()
+	for ; blockIter.Valid(); blockIter.Next() {
+		var block types.ConsumerBlock
+		if err := k.cdc.Unmarshal(blockIter.Value(), &block); err != nil {
+			return nil, err
+		}
+		gs.ConsumerBlocks = append(gs.ConsumerBlocks, &block)
+	}
+
+	return gs, nil
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -13,6 +14,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	bbn "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/babylonchain/babylon/x/finality/types"
 )
 
@@ -28,7 +30,23 @@ func (k Keeper) Block(ctx context.Context, req *types.QueryBlockRequest) (*types
 		return nil, err
 	}
 
-	return &types.QueryBlockResponse{Block: b}, nil
+	// the finality signatures of the block are pruned, use the aggregated
+	// result recorded upon finalisation
+	if b.VotesPrunedHeight != 0 {
+		return &types.QueryBlockResponse{
+			Block:      b,
+			VotedPower: b.VotedPower,
+			TotalPower: b.TotalPower,
+		}, nil
+	}
+
+	votedPower, totalPower := k.GetBlockTally(sdkCtx, req.Height)
+
+	return &types.QueryBlockResponse{
+		Block:      b,
+		VotedPower: votedPower,
+		TotalPower: totalPower,
+	}, nil
 }
 
 // ListBlocks returns a list of blocks at the given finalisation status
@@ -74,6 +92,12 @@ func (k Keeper) VotesAtHeight(ctx context.Context, req *types.QueryVotesAtHeight
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
+	// the finality signatures of the block are pruned, and are only available
+	// at heights before the pruning
+	if b, err := k.GetBlock(sdkCtx, req.Height); err == nil && b.VotesPrunedHeight != 0 {
+		return nil, types.ErrVotesPruned.Wrapf("query an archive node at height %d", b.VotesPrunedHeight-1)
+	}
+
 	// get the sig set of babylon block at given height
 	btcPks := []bbn.BIP340PubKey{}
 	sigSet := k.GetSigSet(sdkCtx, req.Height)
@@ -108,8 +132,14 @@ func (k Keeper) Evidence(ctx context.Context, req *types.QueryEvidenceRequest) (
 		return nil, types.ErrNoSlashableEvidence
 	}
 
+	btcSKHex, err := evidence.ExtractBTCSKHex()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to extract BTC SK from evidence: %v", err)
+	}
+
 	resp := &types.QueryEvidenceResponse{
-		Evidence: evidence,
+		Evidence:          evidence,
+		ExtractedBtcSkHex: btcSKHex,
 	}
 	return resp, nil
 }
@@ -156,3 +186,191 @@ func (k Keeper) ListEvidences(ctx context.Context, req *types.QueryListEvidences
 	}
 	return resp, nil
 }
+
+// ListPubRandCommit returns a list of public randomness commitments of the
+// given finality provider
+func (k Keeper) ListPubRandCommit(ctx context.Context, req *types.QueryListPubRandCommitRequest) (*types.QueryListPubRandCommitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal finality provider BTC PK hex: %v", err)
+	}
+
+	store := k.pubRandCommitFpStore(ctx, fpBTCPK)
+	var prCommits []*types.PubRandCommit
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var prCommit types.PubRandCommit
+		if err := k.cdc.Unmarshal(value, &prCommit); err != nil {
+			return err
+		}
+		prCommits = append(prCommits, &prCommit)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListPubRandCommitResponse{
+		PubRandCommits: prCommits,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// LastPubRandCommit returns the last public randomness commitment of the
+// given finality provider
+func (k Keeper) LastPubRandCommit(ctx context.Context, req *types.QueryLastPubRandCommitRequest) (*types.QueryLastPubRandCommitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal finality provider BTC PK hex: %v", err)
+	}
+
+	prCommit := k.GetLastPubRandCommit(ctx, fpBTCPK)
+	if prCommit == nil {
+		return nil, types.ErrNoPubRandYet
+	}
+
+	return &types.QueryLastPubRandCommitResponse{
+		PubRandCommit:       prCommit,
+		LastCommittedHeight: prCommit.EndHeight(),
+	}, nil
+}
+
+// SigningInfo returns the signing info of the given finality provider
+func (k Keeper) SigningInfo(ctx context.Context, req *types.QuerySigningInfoRequest) (*types.QuerySigningInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal finality provider BTC PK hex: %v", err)
+	}
+
+	info, err := k.GetSigningInfo(ctx, fpBTCPK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySigningInfoResponse{SigningInfo: *info}, nil
+}
+
+// SigningInfos returns the signing infos of all finality providers
+func (k Keeper) SigningInfos(ctx context.Context, req *types.QuerySigningInfosRequest) (*types.QuerySigningInfosResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	store := k.signingInfoStore(ctx)
+	var infos []types.FinalityProviderSigningInfo
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var info types.FinalityProviderSigningInfo
+		if err := k.cdc.Unmarshal(value, &info); err != nil {
+			return err
+		}
+		infos = append(infos, info)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySigningInfosResponse{
+		SigningInfos: infos,
+		Pagination:   pageRes,
+	}, nil
+}
+
+func (k Keeper) ConsumerFinalizedBlock(ctx context.Context, req *types.QueryConsumerFinalizedBlockRequest) (*types.QueryConsumerFinalizedBlockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if !k.HasConsumer(ctx, req.ConsumerId) {
+		return nil, types.ErrConsumerNotFound.Wrapf("consumer ID: %s", req.ConsumerId)
+	}
+
+	block, err := k.GetConsumerBlock(ctx, req.ConsumerId, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryConsumerFinalizedBlockResponse{Block: block}, nil
+}
+
+// DelegationLifecycle returns the lifecycle of the given BTC delegation,
+// aggregating its status and covenant quorum progress, the BTC confirmation
+// depth of its staking tx, and the finality activity of its finality
+// providers
+func (k Keeper) DelegationLifecycle(ctx context.Context, req *types.QueryDelegationLifecycleRequest) (*types.QueryDelegationLifecycleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	btcDel, err := k.BTCStakingKeeper.GetBTCDelegation(ctx, req.StakingTxHashHex)
+	if err != nil {
+		return nil, err
+	}
+	// the covenant quorum of the BTC delegation is the one in the params
+	// version it is created under
+	bsParams := k.BTCStakingKeeper.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if bsParams == nil {
+		return nil, bstypes.ErrParamsNotFound.Wrapf("params version %d of BTC delegation %s", btcDel.ParamsVersion, req.StakingTxHashHex)
+	}
+	delStatus := k.BTCStakingKeeper.GetBTCDelegationStatus(ctx, btcDel)
+	stakingTxDepth, onBTCChain := k.BTCStakingKeeper.GetStakingTxDepth(ctx, btcDel)
+
+	// finality activity of each finality provider at the current height
+	babylonHeight := uint64(sdkCtx.HeaderInfo().Height)
+	fpActivities := make([]types.FinalityProviderActivity, 0, len(btcDel.FpBtcPkList))
+	for i := range btcDel.FpBtcPkList {
+		fpBTCPK := btcDel.FpBtcPkList[i]
+		fp, err := k.BTCStakingKeeper.GetFinalityProvider(ctx, fpBTCPK.MustMarshal())
+		if err != nil {
+			return nil, err
+		}
+		// the signing info is only created once the finality provider has
+		// voting power
+		signingInfo, err := k.GetSigningInfo(ctx, &fpBTCPK)
+		if err != nil && !errors.Is(err, types.ErrSigningInfoNotFound) {
+			return nil, err
+		}
+		fpActivities = append(fpActivities, types.FinalityProviderActivity{
+			BtcPk:       &fpBTCPK,
+			VotingPower: k.BTCStakingKeeper.GetVotingPower(ctx, fpBTCPK.MustMarshal(), babylonHeight),
+			Slashed:     fp.IsSlashed(),
+			Jailed:      fp.Jailed,
+			SigningInfo: signingInfo,
+		})
+	}
+
+	return &types.QueryDelegationLifecycleResponse{
+		BtcDelegation:      bstypes.NewBTCDelegationResponse(btcDel, delStatus),
+		CovenantQuorum:     bsParams.CovenantQuorum,
+		NumCovenantSigs:    uint32(len(btcDel.CovenantSigs)),
+		StakingTxDepth:     stakingTxDepth,
+		StakingTxReorged:   !onBTCChain,
+		UnbondingRequested: btcDel.IsUnbondedEarly(),
+		FinalityProviders:  fpActivities,
+	}, nil
+}
+
+// ActivatedHeight returns the Babylon height at which the finality gadget
+// activated
+func (k Keeper) ActivatedHeight(ctx context.Context, req *types.QueryActivatedHeightRequest) (*types.QueryActivatedHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	activatedHeight, err := k.GetActivatedHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryActivatedHeightResponse{Height: activatedHeight}, nil
+}
@@ -1,16 +1,22 @@
 package keeper_test
 
 import (
+	"encoding/hex"
 	"math/rand"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/babylonchain/babylon/testutil/datagen"
 	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
 	bbn "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/babylonchain/babylon/x/finality/types"
 )
 
@@ -18,9 +24,12 @@ func FuzzBlock(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 	f.Fuzz(func(t *testing.T, seed int64) {
 		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
 		// Setup keeper and context
-		keeper, ctx := testkeeper.FinalityKeeper(t, nil, nil)
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		keeper, ctx := testkeeper.FinalityKeeper(t, bsKeeper, nil)
 		ctx = sdk.UnwrapSDKContext(ctx)
 
 		height := datagen.RandomInt(r, 100)
@@ -35,6 +44,26 @@ func FuzzBlock(f *testing.F) {
 		}
 
 		keeper.SetBlock(ctx, ib)
+
+		// a random finality provider set where a random subset has voted
+		fpSet := map[string]uint64{}
+		expectedVotedPower, expectedTotalPower := uint64(0), uint64(0)
+		numFps := datagen.RandomInt(r, 10) + 1
+		for i := uint64(0); i < numFps; i++ {
+			fpBTCPK, err := datagen.GenRandomBIP340PubKey(r)
+			require.NoError(t, err)
+			power := datagen.RandomInt(r, 1000) + 1
+			fpSet[fpBTCPK.MarshalHex()] = power
+			expectedTotalPower += power
+			if datagen.RandomInt(r, 2) == 1 {
+				sig, err := bbn.NewSchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
+				require.NoError(t, err)
+				keeper.SetSig(ctx, height, fpBTCPK, sig)
+				expectedVotedPower += power
+			}
+		}
+		bsKeeper.EXPECT().GetVotingPowerTable(gomock.Any(), gomock.Eq(height)).Return(fpSet).Times(1)
+
 		req := &types.QueryBlockRequest{
 			Height: height,
 		}
@@ -42,6 +71,8 @@ func FuzzBlock(f *testing.F) {
 		require.NoError(t, err)
 		require.Equal(t, height, resp.Block.Height)
 		require.Equal(t, appHash, resp.Block.AppHash)
+		require.Equal(t, expectedVotedPower, resp.VotedPower)
+		require.Equal(t, expectedTotalPower, resp.TotalPower)
 	})
 }
 
@@ -221,6 +252,12 @@ func FuzzQueryEvidence(f *testing.F) {
 			require.NoError(t, err)
 			require.Equal(t, randomFirstSlashableEvidence, evidenceResp.Evidence)
 			require.True(t, evidenceResp.Evidence.IsSlashable())
+
+			// the extracted BTC SK corresponds to the finality provider's BTC PK
+			btcSKBytes, err := hex.DecodeString(evidenceResp.ExtractedBtcSkHex)
+			require.NoError(t, err)
+			btcSK, _ := btcec.PrivKeyFromBytes(btcSKBytes)
+			require.Equal(t, bip340PK, bbn.NewBIP340PubKeyFromBTCPK(btcSK.PubKey()))
 		}
 	})
 }
@@ -288,3 +325,157 @@ func FuzzListEvidences(f *testing.F) {
 		}
 	})
 }
+
+func FuzzDelegationLifecycle(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		keeper, ctx := testkeeper.FinalityKeeper(t, bsKeeper, nil)
+		babylonHeight := datagen.RandomInt(r, 100) + 1
+		ctx = datagen.WithCtxHeight(ctx, babylonHeight)
+
+		// a BTC delegation under a random number of finality providers, where
+		// only a random subset has signing info
+		numFps := datagen.RandomInt(r, 5) + 1
+		fps := make([]*bstypes.FinalityProvider, 0, numFps)
+		fpBTCPKs := make([]bbn.BIP340PubKey, 0, numFps)
+		powers := make(map[string]uint64, numFps)
+		signingInfos := make(map[string]*types.FinalityProviderSigningInfo, numFps)
+		for i := uint64(0); i < numFps; i++ {
+			fp, err := datagen.GenRandomFinalityProvider(r)
+			require.NoError(t, err)
+			fp.Jailed = datagen.RandomInt(r, 2) == 1
+			fps = append(fps, fp)
+			fpBTCPKs = append(fpBTCPKs, *fp.BtcPk)
+			powers[fp.BtcPk.MarshalHex()] = datagen.RandomInt(r, 1000)
+			if datagen.RandomInt(r, 2) == 1 {
+				info := &types.FinalityProviderSigningInfo{
+					FpBtcPk:             fp.BtcPk,
+					StartHeight:         1,
+					MissedBlocksCounter: datagen.RandomInt(r, 10),
+					LastActiveHeight:    datagen.RandomInt(r, int(babylonHeight)),
+				}
+				keeper.SetSigningInfo(ctx, info)
+				signingInfos[fp.BtcPk.MarshalHex()] = info
+			}
+			bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fp.BtcPk.MustMarshal())).Return(fp, nil).AnyTimes()
+			bsKeeper.EXPECT().GetVotingPower(gomock.Any(), gomock.Eq(fp.BtcPk.MustMarshal()), babylonHeight).Return(powers[fp.BtcPk.MarshalHex()]).AnyTimes()
+		}
+
+		covenantSKs, _, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, &chaincfg.SimNetParams)
+		require.NoError(t, err)
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		startHeight := datagen.RandomInt(r, 100) + 1
+		btcDel, err := datagen.GenRandomBTCDelegation(
+			r,
+			t,
+			fpBTCPKs,
+			delSK,
+			covenantSKs,
+			covenantQuorum,
+			slashingAddress.EncodeAddress(),
+			startHeight, startHeight+1000, 10000,
+			sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2),
+			uint16(101),
+		)
+		require.NoError(t, err)
+		stakingTxHash := btcDel.MustGetStakingTxHash().String()
+		depth := datagen.RandomInt(r, 10)
+		reorged := datagen.RandomInt(r, 2) == 1
+
+		// the BTC delegation is created under an earlier params version with
+		// another covenant quorum than the current one
+		btcDel.ParamsVersion = uint32(datagen.RandomInt(r, 10))
+		bsParams := bstypes.DefaultParams()
+		bsParams.CovenantQuorum = covenantQuorum
+		bsKeeper.EXPECT().GetBTCDelegation(gomock.Any(), stakingTxHash).Return(btcDel, nil).Times(1)
+		bsKeeper.EXPECT().GetBTCDelegationStatus(gomock.Any(), btcDel).Return(bstypes.BTCDelegationStatus_ACTIVE).Times(1)
+		bsKeeper.EXPECT().GetStakingTxDepth(gomock.Any(), btcDel).Return(depth, !reorged).Times(1)
+		bsKeeper.EXPECT().GetParamsByVersion(gomock.Any(), btcDel.ParamsVersion).Return(&bsParams).Times(1)
+		currentParams := bstypes.DefaultParams()
+		currentParams.CovenantQuorum = covenantQuorum + 1
+		bsKeeper.EXPECT().GetParams(gomock.Any()).Return(currentParams).AnyTimes()
+
+		resp, err := keeper.DelegationLifecycle(ctx, &types.QueryDelegationLifecycleRequest{StakingTxHashHex: stakingTxHash})
+		require.NoError(t, err)
+		require.Equal(t, bstypes.NewBTCDelegationResponse(btcDel, bstypes.BTCDelegationStatus_ACTIVE), resp.BtcDelegation)
+		require.Equal(t, covenantQuorum, resp.CovenantQuorum)
+		require.Equal(t, uint32(len(btcDel.CovenantSigs)), resp.NumCovenantSigs)
+		require.Equal(t, depth, resp.StakingTxDepth)
+		require.Equal(t, reorged, resp.StakingTxReorged)
+		require.False(t, resp.UnbondingRequested)
+		require.Len(t, resp.FinalityProviders, len(fps))
+		for i, fpActivity := range resp.FinalityProviders {
+			fpBTCPKHex := fps[i].BtcPk.MarshalHex()
+			require.Equal(t, fpBTCPKHex, fpActivity.BtcPk.MarshalHex())
+			require.Equal(t, powers[fpBTCPKHex], fpActivity.VotingPower)
+			require.Equal(t, fps[i].IsSlashed(), fpActivity.Slashed)
+			require.Equal(t, fps[i].Jailed, fpActivity.Jailed)
+			require.Equal(t, signingInfos[fpBTCPKHex], fpActivity.SigningInfo)
+		}
+	})
+}
+
+func FuzzActivatedHeight(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		keeper, ctx := testkeeper.FinalityKeeper(t, bsKeeper, nil)
+
+		// set a random threshold of the total staked sats
+		params := keeper.GetParams(ctx)
+		params.MinTotalStakedSat = datagen.RandomInt(r, 1000) + 100
+		err := keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		// the finality gadget is not activated before BTC staking activates
+		bsKeeper.EXPECT().GetBTCStakingActivatedHeight(gomock.Any()).Return(uint64(0), bstypes.ErrBTCStakingNotActivated).Times(1)
+		keeper.UpdateActivatedHeight(ctx)
+		_, err = keeper.ActivatedHeight(ctx, &types.QueryActivatedHeightRequest{})
+		require.ErrorIs(t, err, types.ErrFinalityNotActivated)
+
+		// the finality gadget is not activated while the total staked sats
+		// are below the threshold
+		btcStakingActivatedHeight := datagen.RandomInt(r, 100) + 1
+		bsKeeper.EXPECT().GetBTCStakingActivatedHeight(gomock.Any()).Return(btcStakingActivatedHeight, nil).AnyTimes()
+		fpBTCPK, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		belowHeight := btcStakingActivatedHeight + datagen.RandomInt(r, 10)
+		ctx = datagen.WithCtxHeight(ctx, belowHeight)
+		bsKeeper.EXPECT().GetVotingPowerTable(gomock.Any(), belowHeight).
+			Return(map[string]uint64{fpBTCPK.MarshalHex(): params.MinTotalStakedSat - 1}).Times(1)
+		keeper.UpdateActivatedHeight(ctx)
+		_, err = keeper.ActivatedHeight(ctx, &types.QueryActivatedHeightRequest{})
+		require.ErrorIs(t, err, types.ErrFinalityNotActivated)
+
+		// the finality gadget activates once the total staked sats reach the
+		// threshold
+		activatedHeight := belowHeight + datagen.RandomInt(r, 10) + 1
+		ctx = datagen.WithCtxHeight(ctx, activatedHeight)
+		bsKeeper.EXPECT().GetVotingPowerTable(gomock.Any(), activatedHeight).
+			Return(map[string]uint64{fpBTCPK.MarshalHex(): params.MinTotalStakedSat}).Times(1)
+		keeper.UpdateActivatedHeight(ctx)
+		resp, err := keeper.ActivatedHeight(ctx, &types.QueryActivatedHeightRequest{})
+		require.NoError(t, err)
+		require.Equal(t, activatedHeight, resp.Height)
+
+		// the finality gadget remains activated afterwards
+		ctx = datagen.WithCtxHeight(ctx, activatedHeight+1)
+		keeper.UpdateActivatedHeight(ctx)
+		resp, err = keeper.ActivatedHeight(ctx, &types.QueryActivatedHeightRequest{})
+		require.NoError(t, err)
+		require.Equal(t, activatedHeight, resp.Height)
+	})
+}
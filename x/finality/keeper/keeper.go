@@ -20,6 +20,11 @@ type (
 
 		BTCStakingKeeper types.BTCStakingKeeper
 		IncentiveKeeper  types.IncentiveKeeper
+		// epochingKeeper is optional. Without it, finality signatures are
+		// never pruned
+		epochingKeeper types.EpochingKeeper
+		// zcKeeper is optional. Without it, no consumer system is registered
+		zcKeeper types.ZoneConciergeKeeper
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
 		authority string
@@ -43,6 +48,22 @@ func NewKeeper(
 	}
 }
 
+// SetEpochingKeeper sets the epoching keeper, which provides the last Babylon
+// height checkpointed to BTC for pruning finality signatures
+func (k *Keeper) SetEpochingKeeper(ek types.EpochingKeeper) *Keeper {
+	k.epochingKeeper = ek
+
+	return k
+}
+
+// SetZoneConciergeKeeper sets the zoneconcierge keeper, which maintains the
+// registry of consumer systems and indexes their blocks
+func (k *Keeper) SetZoneConciergeKeeper(zk types.ZoneConciergeKeeper) *Keeper {
+	k.zcKeeper = zk
+
+	return k
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
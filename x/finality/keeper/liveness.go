@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+// HandleLiveness handles the liveness of all active finality providers at the
+// given height, i.e., records whether each of them has voted for the block at
+// this height and jails those who have missed too many blocks in the sliding
+// window. The height has to be at least FinalitySigTimeout blocks behind the
+// current height, so that finality providers have had enough time to vote.
+func (k Keeper) HandleLiveness(ctx context.Context, height uint64) error {
+	vpTable := k.BTCStakingKeeper.GetVotingPowerTable(ctx, height)
+	if len(vpTable) == 0 {
+		// no active finality provider at this height
+		return nil
+	}
+	sigSet := k.GetSigSet(ctx, height)
+
+	// iterate over finality providers in a deterministic order, since jailing
+	// a finality provider emits events and writes indexed state
+	fpBTCPKHexList := make([]string, 0, len(vpTable))
+	for fpBTCPKHex := range vpTable {
+		fpBTCPKHexList = append(fpBTCPKHexList, fpBTCPKHex)
+	}
+	sort.Strings(fpBTCPKHexList)
+
+	for _, fpBTCPKHex := range fpBTCPKHexList {
+		fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(fpBTCPKHex)
+		if err != nil {
+			// failing to unmarshal finality provider BTC PK in KVStore is a programming error
+			panic(fmt.Errorf("%w: %w", bbn.ErrUnmarshal, err))
+		}
+		_, voted := sigSet[fpBTCPKHex]
+		if err := k.HandleFinalityProviderLiveness(ctx, fpBTCPK, !voted, height); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// HandleFinalityProviderLiveness updates the signing info of the given
+// finality provider w.r.t. whether it has missed the block at the given
+// height, and jails it if it has missed too many blocks in the sliding window
+func (k Keeper) HandleFinalityProviderLiveness(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, missed bool, height uint64) error {
+	fp, err := k.BTCStakingKeeper.GetFinalityProvider(ctx, fpBTCPK.MustMarshal())
+	if err != nil {
+		return err
+	}
+	// a finality provider that is jailed or slashed after this height still
+	// has voting power at this height, but there is no need to track its
+	// liveness anymore
+	if fp.Jailed || fp.IsSlashed() {
+		return nil
+	}
+
+	params := k.GetParams(ctx)
+	info, err := k.GetSigningInfo(ctx, fpBTCPK)
+	if errors.Is(err, types.ErrSigningInfoNotFound) {
+		// start tracking the liveness of this finality provider
+		info = &types.FinalityProviderSigningInfo{
+			FpBtcPk:     fpBTCPK,
+			StartHeight: height,
+		}
+	} else if err != nil {
+		return err
+	}
+
+	// update the missed blocks bitmap and counter at the index of this height
+	// in the sliding window
+	index := (height - info.StartHeight) % params.SignedBlocksWindow
+	prevMissed := k.GetMissedBlockBitmapValue(ctx, fpBTCPK, index)
+	switch {
+	case !prevMissed && missed:
+		k.setMissedBlockBitmapValue(ctx, fpBTCPK, index, true)
+		info.MissedBlocksCounter++
+	case prevMissed && !missed:
+		k.setMissedBlockBitmapValue(ctx, fpBTCPK, index, false)
+		info.MissedBlocksCounter--
+	}
+	if !missed {
+		info.LastActiveHeight = height
+	}
+
+	// jail the finality provider if it has missed too many blocks after a
+	// full sliding window
+	minHeight := info.StartHeight + params.SignedBlocksWindow
+	if height >= minHeight && info.MissedBlocksCounter > params.MaxMissedPerWindow() {
+		if err := k.BTCStakingKeeper.JailFinalityProvider(ctx, fpBTCPK.MustMarshal()); err != nil {
+			return fmt.Errorf("failed to jail finality provider %s: %w", fpBTCPK.MarshalHex(), err)
+		}
+
+		event := &types.EventJailedFinalityProvider{
+			FpBtcPk:             fpBTCPK,
+			JailedHeight:        height,
+			MissedBlocksCounter: info.MissedBlocksCounter,
+		}
+		if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
+			panic(fmt.Errorf("failed to emit EventJailedFinalityProvider event: %w", err))
+		}
+
+		// reset the sliding window, so that the finality provider will be
+		// tracked from scratch once it becomes active again
+		k.clearMissedBlockBitmap(ctx, fpBTCPK)
+		info.MissedBlocksCounter = 0
+		info.StartHeight = height + 1
+		// the finality provider can unjail itself after the jail duration
+		curHeight := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+		info.JailedUntilHeight = curHeight + params.JailDurationBlocks
+	}
+
+	k.SetSigningInfo(ctx, info)
+	return nil
+}
+
+// UnjailFinalityProvider unjails the given finality provider that is jailed
+// due to insufficient liveness, once its jail duration has passed. The
+// liveness of the finality provider is tracked from scratch since the current
+// height.
+func (k Keeper) UnjailFinalityProvider(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) error {
+	curHeight := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	info, err := k.GetSigningInfo(ctx, fpBTCPK)
+	if err != nil {
+		return err
+	}
+	if curHeight < info.JailedUntilHeight {
+		return types.ErrJailPeriodNotPassed.Wrapf("the finality provider can unjail itself from height %d", info.JailedUntilHeight)
+	}
+
+	if err := k.BTCStakingKeeper.UnjailFinalityProvider(ctx, fpBTCPK.MustMarshal()); err != nil {
+		return err
+	}
+
+	k.clearMissedBlockBitmap(ctx, fpBTCPK)
+	info.MissedBlocksCounter = 0
+	info.StartHeight = curHeight
+	info.JailedUntilHeight = 0
+	k.SetSigningInfo(ctx, info)
+
+	event := &types.EventUnjailedFinalityProvider{
+		FpBtcPk:        fpBTCPK,
+		UnjailedHeight: curHeight,
+	}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("failed to emit EventUnjailedFinalityProvider event: %w", err))
+	}
+
+	return nil
+}
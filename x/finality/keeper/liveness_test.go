@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/core/header"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	keepertest "github.com/babylonchain/babylon/testutil/keeper"
+	bbn "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+func FuzzHandleLiveness(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil)
+		params := fKeeper.GetParams(ctx)
+
+		_, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
+		fp := &bstypes.FinalityProvider{BtcPk: fpBTCPK}
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPK.MustMarshal())).Return(fp, nil).AnyTimes()
+
+		// the finality provider signs every block in the first sliding window
+		startHeight := datagen.RandomInt(r, 100) + 1
+		height := startHeight
+		for ; height < startHeight+params.SignedBlocksWindow; height++ {
+			err := fKeeper.HandleFinalityProviderLiveness(ctx, fpBTCPK, false, height)
+			require.NoError(t, err)
+		}
+		info, err := fKeeper.GetSigningInfo(ctx, fpBTCPK)
+		require.NoError(t, err)
+		require.Equal(t, startHeight, info.StartHeight)
+		require.Zero(t, info.MissedBlocksCounter)
+		require.Equal(t, height-1, info.LastActiveHeight)
+
+		// the finality provider then misses blocks, and is jailed once it
+		// has missed more than MaxMissedPerWindow blocks
+		maxMissed := params.MaxMissedPerWindow()
+		for i := uint64(0); i < maxMissed; i++ {
+			err := fKeeper.HandleFinalityProviderLiveness(ctx, fpBTCPK, true, height)
+			require.NoError(t, err)
+			height++
+		}
+		info, err = fKeeper.GetSigningInfo(ctx, fpBTCPK)
+		require.NoError(t, err)
+		require.Equal(t, maxMissed, info.MissedBlocksCounter)
+
+		bsKeeper.EXPECT().JailFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPK.MustMarshal())).Return(nil).Times(1)
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(height)})
+		err = fKeeper.HandleFinalityProviderLiveness(ctx, fpBTCPK, true, height)
+		require.NoError(t, err)
+
+		// the sliding window of the jailed finality provider is reset
+		info, err = fKeeper.GetSigningInfo(ctx, fpBTCPK)
+		require.NoError(t, err)
+		require.Zero(t, info.MissedBlocksCounter)
+		require.Equal(t, height+1, info.StartHeight)
+		for i := uint64(0); i < params.SignedBlocksWindow; i++ {
+			require.False(t, fKeeper.GetMissedBlockBitmapValue(ctx, fpBTCPK, i))
+		}
+
+		// a jailed finality provider is no longer tracked
+		fp.Jailed = true
+		err = fKeeper.HandleFinalityProviderLiveness(ctx, fpBTCPK, true, height+1)
+		require.NoError(t, err)
+		info, err = fKeeper.GetSigningInfo(ctx, fpBTCPK)
+		require.NoError(t, err)
+		require.Zero(t, info.MissedBlocksCounter)
+		require.Equal(t, height+params.JailDurationBlocks, info.JailedUntilHeight)
+
+		// the finality provider cannot unjail itself before the jail period
+		// has passed
+		jailedCtx := ctx.WithHeaderInfo(header.Info{Height: int64(info.JailedUntilHeight - 1)})
+		err = fKeeper.UnjailFinalityProvider(jailedCtx, fpBTCPK)
+		require.ErrorIs(t, err, types.ErrJailPeriodNotPassed)
+
+		// the finality provider is unjailed once the jail period has passed,
+		// and its liveness is tracked from the unjailing height
+		unjailHeight := info.JailedUntilHeight + datagen.RandomInt(r, 10)
+		unjailedCtx := ctx.WithHeaderInfo(header.Info{Height: int64(unjailHeight)})
+		bsKeeper.EXPECT().UnjailFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPK.MustMarshal())).Return(nil).Times(1)
+		err = fKeeper.UnjailFinalityProvider(unjailedCtx, fpBTCPK)
+		require.NoError(t, err)
+		info, err = fKeeper.GetSigningInfo(ctx, fpBTCPK)
+		require.NoError(t, err)
+		require.Zero(t, info.MissedBlocksCounter)
+		require.Zero(t, info.JailedUntilHeight)
+		require.Equal(t, unjailHeight, info.StartHeight)
+	})
+}
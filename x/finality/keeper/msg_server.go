@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -14,6 +15,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type msgServer struct {
@@ -45,6 +48,68 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+// CommitPubRandList commits a list of public randomness via a Merkle root
+func (ms msgServer) CommitPubRandList(goCtx context.Context, req *types.MsgCommitPubRandList) (*types.MsgCommitPubRandListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.FpBtcPk == nil {
+		return nil, types.ErrInvalidPubRand.Wrap("empty finality provider BTC PK")
+	}
+	prCommit := req.PubRandCommit()
+	if err := prCommit.Validate(); err != nil {
+		return nil, types.ErrInvalidPubRand.Wrapf("invalid public randomness commitment: %v", err)
+	}
+
+	// ensure the finality provider exists and is not slashed
+	fp, err := ms.BTCStakingKeeper.GetFinalityProvider(ctx, req.FpBtcPk.MustMarshal())
+	if err != nil {
+		return nil, err
+	}
+	if fp.IsSlashed() {
+		return nil, bstypes.ErrFpAlreadySlashed
+	}
+
+	// ensure the finality provider secures the consumer system, if the
+	// public randomness is for the blocks of a consumer system
+	if len(req.ConsumerId) > 0 && !ms.BTCStakingKeeper.HasConsumerFinalityProvider(ctx, req.ConsumerId, req.FpBtcPk.MustMarshal()) {
+		return nil, types.ErrFpNotSecuring.Wrapf("finality provider: %s, consumer ID: %s", req.FpBtcPk.MarshalHex(), req.ConsumerId)
+	}
+
+	// ensure the commitment only covers future Babylon heights, so that a
+	// commitment never covers a height the finality provider may have voted
+	// on with the public randomness derived from its master public randomness.
+	// Consumer heights are not Babylon heights, and votes on consumer blocks
+	// always use committed public randomness
+	if len(req.ConsumerId) == 0 && req.StartHeight <= uint64(ctx.HeaderInfo().Height) {
+		return nil, types.ErrInvalidPubRand.Wrapf("the start height (%d) is not after the current height (%d)", req.StartHeight, ctx.HeaderInfo().Height)
+	}
+
+	// ensure the commitment does not overlap with the last one, so that each
+	// height has a unique public randomness
+	var lastPrCommit *types.PubRandCommit
+	if len(req.ConsumerId) == 0 {
+		lastPrCommit = ms.GetLastPubRandCommit(ctx, req.FpBtcPk)
+	} else {
+		lastPrCommit = ms.GetLastConsumerPubRandCommit(ctx, req.ConsumerId, req.FpBtcPk)
+	}
+	if lastPrCommit != nil && req.StartHeight <= lastPrCommit.EndHeight() {
+		return nil, types.ErrInvalidPubRand.Wrapf("the start height (%d) has overlap with the height of the last committed public randomness (%d)", req.StartHeight, lastPrCommit.EndHeight())
+	}
+
+	// ensure the commitment is signed by the finality provider
+	if err := req.VerifySig(); err != nil {
+		return nil, types.ErrInvalidPubRand.Wrapf("invalid signature over the public randomness commitment: %v", err)
+	}
+
+	if len(req.ConsumerId) == 0 {
+		ms.SetPubRandCommit(ctx, req.FpBtcPk, prCommit)
+	} else {
+		ms.SetConsumerPubRandCommit(ctx, req.ConsumerId, req.FpBtcPk, prCommit)
+	}
+
+	return &types.MsgCommitPubRandListResponse{}, nil
+}
+
 // AddFinalitySig adds a new vote to a given block
 func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinalitySig) (*types.MsgAddFinalitySigResponse, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyAddFinalitySig)
@@ -103,8 +168,12 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 		return &types.MsgAddFinalitySigResponse{}, nil
 	}
 
-	// derive public randomness at this height from the master public randomness
-	pubRand := fp.MustGetPubRand(req.BlockHeight)
+	// get the public randomness at this height, either from the committed
+	// public randomness list or derived from the master public randomness
+	pubRand, err := ms.getPubRandForVote(ctx, fp, req)
+	if err != nil {
+		return nil, err
+	}
 
 	// verify EOTS signature w.r.t. public randomness
 	fpBTCPK, err := fpPK.ToBTCPK()
@@ -120,6 +189,14 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 	if err != nil {
 		return nil, err
 	}
+	// finality signatures of a block are pruned only after the block is
+	// finalised and checkpointed to BTC, thus votes on it are no longer useful.
+	// NOTE: without the canonical finality signature, a vote for a fork at
+	// this height cannot reveal the finality provider's BTC SK, thus
+	// equivocations are only slashable within `VoteRetentionBlocks`
+	if indexedBlock.VotesPrunedHeight != 0 {
+		return nil, types.ErrVotesPruned.Wrapf("height: %d", req.BlockHeight)
+	}
 	if !bytes.Equal(indexedBlock.AppHash, req.BlockAppHash) {
 		// the finality provider votes for a fork!
 
@@ -132,6 +209,7 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 			CanonicalFinalitySig: nil,
 			ForkAppHash:          req.BlockAppHash,
 			ForkFinalitySig:      req.FinalitySig,
+			PubRand:              req.PubRand,
 		}
 
 		// if this finality provider has also signed canonical block, slash it
@@ -179,17 +257,194 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 	return &types.MsgAddFinalitySigResponse{}, nil
 }
 
+// UnjailFinalityProvider unjails a finality provider that is jailed due to
+// insufficient liveness, once its jail duration has passed
+func (ms msgServer) UnjailFinalityProvider(goCtx context.Context, req *types.MsgUnjailFinalityProvider) (*types.MsgUnjailFinalityProviderResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	fp, err := ms.BTCStakingKeeper.GetFinalityProvider(ctx, req.FpBtcPk.MustMarshal())
+	if err != nil {
+		return nil, err
+	}
+
+	// ensure the signer corresponds to the finality provider's Babylon address
+	fpBabylonAddr := sdk.AccAddress(fp.BabylonPk.Address())
+	if req.Signer != fpBabylonAddr.String() {
+		return nil, status.Errorf(codes.PermissionDenied, "the signer does not correspond to the finality provider's Babylon address")
+	}
+
+	if fp.IsSlashed() {
+		return nil, bstypes.ErrFpAlreadySlashed
+	}
+	if !fp.Jailed {
+		return nil, bstypes.ErrFpNotJailed
+	}
+	if err := ms.Keeper.UnjailFinalityProvider(ctx, req.FpBtcPk); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnjailFinalityProviderResponse{}, nil
+}
+
+// AddConsumerFinalitySig adds a new vote to a given block of a consumer system
+func (ms msgServer) AddConsumerFinalitySig(goCtx context.Context, req *types.MsgAddConsumerFinalitySig) (*types.MsgAddConsumerFinalitySigResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyAddConsumerFinalitySig)
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, types.ErrInvalidFinalitySig.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !ms.HasConsumer(ctx, req.ConsumerId) {
+		return nil, types.ErrConsumerNotFound.Wrapf("consumer ID: %s", req.ConsumerId)
+	}
+
+	// ensure the finality provider exists, is not slashed, and secures the
+	// consumer system
+	fpPK := req.FpBtcPk
+	fp, err := ms.BTCStakingKeeper.GetFinalityProvider(ctx, fpPK.MustMarshal())
+	if err != nil {
+		return nil, err
+	}
+	if fp.IsSlashed() {
+		return nil, bstypes.ErrFpAlreadySlashed
+	}
+	if !ms.BTCStakingKeeper.HasConsumerFinalityProvider(ctx, req.ConsumerId, fpPK.MustMarshal()) {
+		return nil, types.ErrFpNotSecuring.Wrapf("finality provider: %s, consumer ID: %s", fpPK.MarshalHex(), req.ConsumerId)
+	}
+
+	// ensure the finality provider has voting power at this height of the
+	// consumer system
+	vpTable, err := ms.getOrSnapshotConsumerVotingPowerTable(ctx, req.ConsumerId, req.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+	if vpTable[fpPK.MarshalHex()] == 0 {
+		return nil, types.ErrInvalidFinalitySig.Wrapf("the finality provider %s does not have voting power at height %d of consumer %s", fpPK.MarshalHex(), req.BlockHeight, req.ConsumerId)
+	}
+
+	// ensure the finality provider has not cast the same vote yet
+	existingVote, err := ms.GetConsumerVote(ctx, req.ConsumerId, req.BlockHeight, fpPK)
+	if err == nil && bytes.Equal(existingVote.BlockHash, req.BlockHash) {
+		ms.Logger(ctx).Debug("Received duplicated consumer finality vote", "consumer", req.ConsumerId, "block height", req.BlockHeight, "finality provider", req.FpBtcPk)
+		return &types.MsgAddConsumerFinalitySigResponse{}, nil
+	}
+
+	// verify the public randomness is committed, and the EOTS signature
+	// w.r.t. the public randomness
+	prCommit, err := ms.GetConsumerPubRandCommitForHeight(ctx, req.ConsumerId, fpPK, req.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+	if err := prCommit.VerifyPubRand(req.BlockHeight, req.PubRand, req.Proof); err != nil {
+		return nil, types.ErrInvalidFinalitySig.Wrapf("the public randomness is not committed: %v", err)
+	}
+	fpBTCPK, err := fpPK.ToBTCPK()
+	if err != nil {
+		return nil, err
+	}
+	if err := eots.Verify(fpBTCPK, req.PubRand.ToFieldVal(), req.MsgToSign(), req.FinalitySig.ToModNScalar()); err != nil {
+		return nil, types.ErrInvalidFinalitySig.Wrapf("the EOTS signature is invalid: %v", err)
+	}
+
+	if existingVote != nil {
+		// the finality provider has voted for a conflicting block at the
+		// same height of the consumer system, slash it
+		evidence := &types.Evidence{
+			FpBtcPk:              fpPK,
+			BlockHeight:          req.BlockHeight,
+			CanonicalAppHash:     existingVote.BlockHash,
+			CanonicalFinalitySig: existingVote.FinalitySig,
+			ForkAppHash:          req.BlockHash,
+			ForkFinalitySig:      req.FinalitySig,
+			PubRand:              req.PubRand,
+			ConsumerId:           req.ConsumerId,
+		}
+		ms.slashFinalityProvider(ctx, fpPK, evidence)
+
+		// NOTE: we should NOT return error here, otherwise the slashing
+		// triggered in this tx will be rolled back
+		return &types.MsgAddConsumerFinalitySigResponse{}, nil
+	}
+
+	// this signature is good, add vote to DB and tally the voted block
+	ms.SetConsumerVote(ctx, req.ConsumerId, req.BlockHeight, fpPK, &types.ConsumerVote{
+		BlockHash:   req.BlockHash,
+		FinalitySig: req.FinalitySig,
+	})
+	ms.tallyConsumerBlock(ctx, req.ConsumerId, req.BlockHeight, req.BlockHash)
+
+	return &types.MsgAddConsumerFinalitySigResponse{}, nil
+}
+
+// getPubRandForVote returns the public randomness of the given vote. If the
+// finality provider has a public randomness commitment covering the voted
+// height, the vote has to carry a public randomness committed by it together
+// with the inclusion proof. Otherwise, the public randomness is derived from
+// the finality provider's master public randomness. As commitments only cover
+// heights after the height at which they are submitted, each height of a
+// finality provider has a single source, and thus a single value, of public
+// randomness, so that two conflicting votes always use the same public
+// randomness and reveal the finality provider's BTC SK.
+func (k Keeper) getPubRandForVote(ctx context.Context, fp *bstypes.FinalityProvider, req *types.MsgAddFinalitySig) (*eots.PublicRand, error) {
+	if (req.PubRand == nil) != (req.Proof == nil) {
+		return nil, types.ErrInvalidFinalitySig.Wrap("the public randomness and its inclusion proof must be given together")
+	}
+
+	prCommit, err := k.GetPubRandCommitForHeight(ctx, req.FpBtcPk, req.BlockHeight)
+	if err != nil {
+		if !errors.Is(err, types.ErrPubRandNotFound) {
+			return nil, err
+		}
+		// no commitment covers the voted height
+		if req.HasCommittedPubRand() {
+			return nil, types.ErrInvalidFinalitySig.Wrapf("no public randomness commitment covers height %d", req.BlockHeight)
+		}
+		return fp.MustGetPubRand(req.BlockHeight), nil
+	}
+	if !req.HasCommittedPubRand() {
+		return nil, types.ErrInvalidFinalitySig.Wrapf("the public randomness at height %d is committed, and the vote has to carry it with its inclusion proof", req.BlockHeight)
+	}
+	if err := prCommit.VerifyPubRand(req.BlockHeight, req.PubRand, req.Proof); err != nil {
+		return nil, types.ErrInvalidFinalitySig.Wrapf("the public randomness is not committed: %v", err)
+	}
+	return req.PubRand.ToFieldVal(), nil
+}
+
 // slashFinalityProvider slashes a finality provider with the given evidence
 // including setting its voting power to zero, extracting its BTC SK,
-// and emit an event
+// and emit an event. The finality provider is not slashed if its BTC SK
+// cannot be extracted from the evidence.
 func (k Keeper) slashFinalityProvider(ctx context.Context, fpBtcPk *bbn.BIP340PubKey, evidence *types.Evidence) {
-	// slash this finality provider, i.e., set its voting power to zero
-	if err := k.BTCStakingKeeper.SlashFinalityProvider(ctx, fpBtcPk.MustMarshal()); err != nil {
+	// extract the BTC SK of this finality provider, which is ensured to be
+	// the SK of the finality provider's BTC PK. This should not fail since
+	// both finality signatures in the evidence have been verified w.r.t. the
+	// same public randomness, but a failure must neither halt the chain nor
+	// publish a wrong SK
+	btcSKHex, err := evidence.ExtractBTCSKHex()
+	if err != nil {
+		k.Logger(sdk.UnwrapSDKContext(ctx)).Error("failed to extract BTC SK from slashable evidence",
+			"finality provider", fpBtcPk.MarshalHex(), "height", evidence.BlockHeight, "error", err)
+		return
+	}
+
+	// slash this finality provider, i.e., set its voting power to zero, for
+	// the infraction at the height of the equivocating finality signatures.
+	// Heights of consumer systems are not Babylon heights, so equivocations
+	// on consumer systems are accounted from the current Babylon height
+	infractionHeight := evidence.BlockHeight
+	if evidence.ConsumerId != "" {
+		infractionHeight = uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	}
+	if err := k.BTCStakingKeeper.SlashFinalityProvider(ctx, fpBtcPk.MustMarshal(), infractionHeight); err != nil {
 		panic(fmt.Errorf("failed to slash finality provider: %v", err))
 	}
 
 	// emit slashing event
-	eventSlashing := types.NewEventSlashedFinalityProvider(evidence)
+	eventSlashing := types.NewEventSlashedFinalityProvider(evidence, btcSKHex)
 	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(eventSlashing); err != nil {
 		panic(fmt.Errorf("failed to emit EventSlashedFinalityProvider event: %w", err))
 	}
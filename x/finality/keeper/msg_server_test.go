@@ -15,6 +15,7 @@ import (
 	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/babylonchain/babylon/x/finality/keeper"
 	"github.com/babylonchain/babylon/x/finality/types"
+	zctypes "github.com/babylonchain/babylon/x/zoneconcierge/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -127,7 +128,7 @@ func FuzzAddFinalitySig(f *testing.F) {
 		require.NoError(t, err)
 		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
 		// mock slashing interface
-		bsKeeper.EXPECT().SlashFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(nil).Times(1)
+		bsKeeper.EXPECT().SlashFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes), gomock.Eq(blockHeight)).Return(nil).Times(1)
 		// NOTE: even though this finality provider is slashed, the msg should be successful
 		// Otherwise the saved evidence will be rolled back
 		_, err = ms.AddFinalitySig(ctx, msg2)
@@ -219,7 +220,7 @@ func TestVoteForConflictingHashShouldRetrieveEvidenceAndSlash(t *testing.T) {
 	bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(),
 		gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
 	bsKeeper.EXPECT().SlashFinalityProvider(gomock.Any(),
-		gomock.Eq(fpBTCPKBytes)).Return(nil).Times(1)
+		gomock.Eq(fpBTCPKBytes), gomock.Eq(blockHeight)).Return(nil).Times(1)
 	_, err = ms.AddFinalitySig(ctx, msg)
 	require.NoError(t, err)
 	sig, err := fKeeper.GetSig(ctx, blockHeight, fpBTCPK)
@@ -227,3 +228,241 @@ func TestVoteForConflictingHashShouldRetrieveEvidenceAndSlash(t *testing.T) {
 	require.Equal(t, msg.FinalitySig.MustMarshal(),
 		sig.MustMarshal())
 }
+
+func FuzzCommitPubRandListAndAddFinalitySig(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+
+		// create and register a random finality provider
+		btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fpBBNSK, _, err := datagen.GenRandomSecp256k1KeyPair(r)
+		require.NoError(t, err)
+		msr, _, err := eots.NewMasterRandPair(r)
+		require.NoError(t, err)
+		fp, err := datagen.GenRandomCustomFinalityProvider(r, btcSK, fpBBNSK, msr)
+		require.NoError(t, err)
+		fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
+		fpBTCPKBytes := fpBTCPK.MustMarshal()
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).AnyTimes()
+		bsKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(fp.RegisteredEpoch).AnyTimes()
+
+		// commit a list of public randomness
+		startHeight := datagen.RandomInt(r, 10) + 1
+		numPubRand := datagen.RandomInt(r, 100) + 1
+		srList, prList, err := datagen.GenRandomPubRandList(r, numPubRand)
+		require.NoError(t, err)
+		signer := datagen.GenRandomAccount().Address
+		msg, err := types.NewMsgCommitPubRandList(signer, btcSK, startHeight, prList)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msg)
+		require.NoError(t, err)
+
+		// the commitment is stored
+		lastPrCommit := fKeeper.GetLastPubRandCommit(ctx, fpBTCPK)
+		require.NotNil(t, lastPrCommit)
+		require.Equal(t, msg.PubRandCommit(), lastPrCommit)
+		require.Equal(t, startHeight+numPubRand-1, lastPrCommit.EndHeight())
+
+		// a commitment overlapping with the last one is rejected
+		overlapHeight := datagen.RandomInt(r, int(numPubRand)) + startHeight
+		_, overlapPrList, err := datagen.GenRandomPubRandList(r, numPubRand)
+		require.NoError(t, err)
+		overlapMsg, err := types.NewMsgCommitPubRandList(signer, btcSK, overlapHeight, overlapPrList)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, overlapMsg)
+		require.ErrorIs(t, err, types.ErrInvalidPubRand)
+
+		// a commitment with an invalid signature is rejected
+		nextMsg, err := types.NewMsgCommitPubRandList(signer, btcSK, lastPrCommit.EndHeight()+1, overlapPrList)
+		require.NoError(t, err)
+		nextMsg.NumPubRand++
+		_, err = ms.CommitPubRandList(ctx, nextMsg)
+		require.ErrorIs(t, err, types.ErrInvalidPubRand)
+
+		// a commitment covering a height that is not in the future is rejected
+		pastMsg, err := types.NewMsgCommitPubRandList(signer, btcSK, lastPrCommit.EndHeight()+1, overlapPrList)
+		require.NoError(t, err)
+		pastCtx := ctx.WithHeaderInfo(header.Info{Height: int64(lastPrCommit.EndHeight() + 1)})
+		_, err = ms.CommitPubRandList(pastCtx, pastMsg)
+		require.ErrorIs(t, err, types.ErrInvalidPubRand)
+
+		// vote for a random height covered by the commitment
+		blockHeight := datagen.RandomInt(r, int(numPubRand)) + startHeight
+		idx := blockHeight - startHeight
+		blockHash := datagen.GenRandomByteArray(r, 32)
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(blockHeight), AppHash: blockHash})
+		fKeeper.IndexBlock(ctx)
+		bsKeeper.EXPECT().GetVotingPower(gomock.Any(), gomock.Eq(fpBTCPKBytes), gomock.Eq(blockHeight)).Return(uint64(1)).AnyTimes()
+		_, proofs := types.GetPubRandCommitAndProofs(prList)
+
+		// a vote with the public randomness derived from the master public
+		// randomness is rejected, since the height is covered by the commitment
+		masterSR, _, err := msr.DeriveRandPair(uint32(blockHeight))
+		require.NoError(t, err)
+		masterVoteMsg, err := types.NewMsgAddFinalitySig(signer, btcSK, masterSR, blockHeight, blockHash)
+		require.NoError(t, err)
+		_, err = ms.AddFinalitySig(ctx, masterVoteMsg)
+		require.ErrorIs(t, err, types.ErrInvalidFinalitySig)
+
+		// a vote with the public randomness of another height is rejected
+		voteMsg, err := types.NewMsgAddFinalitySig(signer, btcSK, srList[idx], blockHeight, blockHash)
+		require.NoError(t, err)
+		otherIdx := (idx + 1) % numPubRand
+		if otherIdx != idx {
+			voteMsg.PubRand = bbn.NewSchnorrPubRandFromFieldVal(prList[otherIdx])
+			voteMsg.Proof = proofs[otherIdx]
+			_, err = ms.AddFinalitySig(ctx, voteMsg)
+			require.ErrorIs(t, err, types.ErrInvalidFinalitySig)
+		}
+
+		// a vote with the committed public randomness is accepted
+		voteMsg.PubRand = bbn.NewSchnorrPubRandFromFieldVal(prList[idx])
+		voteMsg.Proof = proofs[idx]
+		_, err = ms.AddFinalitySig(ctx, voteMsg)
+		require.NoError(t, err)
+		sig, err := fKeeper.GetSig(ctx, blockHeight, fpBTCPK)
+		require.NoError(t, err)
+		require.Equal(t, voteMsg.FinalitySig.MustMarshal(), sig.MustMarshal())
+
+		// equivocation with the committed public randomness leaks the SK
+		forkHash := datagen.GenRandomByteArray(r, 32)
+		forkMsg, err := types.NewMsgAddFinalitySig(signer, btcSK, srList[idx], blockHeight, forkHash)
+		require.NoError(t, err)
+		forkMsg.PubRand = voteMsg.PubRand
+		forkMsg.Proof = voteMsg.Proof
+		bsKeeper.EXPECT().SlashFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes), gomock.Eq(blockHeight)).Return(nil).Times(1)
+		_, err = ms.AddFinalitySig(ctx, forkMsg)
+		require.NoError(t, err)
+		evidence, err := fKeeper.GetEvidence(ctx, fpBTCPK, blockHeight)
+		require.NoError(t, err)
+		btcSK2, err := evidence.ExtractBTCSK()
+		require.NoError(t, err)
+		require.Equal(t, btcSK.PubKey().SerializeCompressed()[1:], btcSK2.PubKey().SerializeCompressed()[1:])
+	})
+}
+
+func FuzzAddConsumerFinalitySig(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		zcKeeper := types.NewMockZoneConciergeKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil)
+		fKeeper.SetZoneConciergeKeeper(zcKeeper)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+
+		// create a random finality provider
+		btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fpBBNSK, _, err := datagen.GenRandomSecp256k1KeyPair(r)
+		require.NoError(t, err)
+		msr, _, err := eots.NewMasterRandPair(r)
+		require.NoError(t, err)
+		fp, err := datagen.GenRandomCustomFinalityProvider(r, btcSK, fpBBNSK, msr)
+		require.NoError(t, err)
+		fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
+		fpBTCPKBytes := fpBTCPK.MustMarshal()
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).AnyTimes()
+
+		// the consumer system is registered in zoneconcierge
+		consumerID := datagen.GenRandomHexStr(r, 16)
+		signer := datagen.GenRandomAccount().Address
+		zcKeeper.EXPECT().HasConsumer(gomock.Any(), gomock.Eq(consumerID)).Return(true).AnyTimes()
+
+		// generate a list of public randomness for the consumer system
+		startHeight := datagen.RandomInt(r, 10) + 1
+		numPubRand := uint64(10)
+		srList, prList, err := datagen.GenRandomPubRandList(r, numPubRand)
+		require.NoError(t, err)
+		commitMsg, err := types.NewMsgCommitConsumerPubRandList(signer, consumerID, btcSK, startHeight, prList)
+		require.NoError(t, err)
+
+		// Case 1: the finality provider cannot commit public randomness for
+		// a consumer system it is not registered to secure in btcstaking
+		bsKeeper.EXPECT().HasConsumerFinalityProvider(gomock.Any(), gomock.Eq(consumerID), gomock.Eq(fpBTCPKBytes)).Return(false).Times(1)
+		_, err = ms.CommitPubRandList(ctx, commitMsg)
+		require.ErrorIs(t, err, types.ErrFpNotSecuring)
+
+		// Case 2: the finality provider secures the consumer system once it
+		// is registered for the consumer system in btcstaking
+		bsKeeper.EXPECT().HasConsumerFinalityProvider(gomock.Any(), gomock.Eq(consumerID), gomock.Eq(fpBTCPKBytes)).Return(true).AnyTimes()
+
+		// commit the public randomness for the consumer system, which does
+		// not affect the public randomness of Babylon
+		_, err = ms.CommitPubRandList(ctx, commitMsg)
+		require.NoError(t, err)
+		require.Nil(t, fKeeper.GetLastPubRandCommit(ctx, fpBTCPK))
+
+		// generate a vote on a block of the consumer system
+		idx := datagen.RandomInt(r, int(numPubRand))
+		blockHeight := startHeight + idx
+		blockHash := datagen.GenRandomByteArray(r, 32)
+		_, proofList := types.GetPubRandCommitAndProofs(prList)
+		msg, err := types.NewMsgAddConsumerFinalitySig(signer, consumerID, btcSK, srList[idx], prList[idx], proofList[idx], blockHeight, blockHash)
+		require.NoError(t, err)
+
+		// Case 3: fail if the block of the consumer system is not indexed on
+		// Babylon yet
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(datagen.RandomInt(r, 100) + 1)})
+		babylonHeight := uint64(ctx.HeaderInfo().Height)
+		zcKeeper.EXPECT().GetHeader(gomock.Any(), gomock.Eq(consumerID), gomock.Eq(blockHeight)).Return(nil, zctypes.ErrHeaderNotFound).Times(1)
+		_, err = ms.AddConsumerFinalitySig(ctx, msg)
+		require.ErrorIs(t, err, types.ErrConsumerBlockNotIndexed)
+
+		// the voting power table is snapshotted at the Babylon height where
+		// the consumer block is indexed, rather than at the current height
+		indexedHeight := babylonHeight + datagen.RandomInt(r, 10) + 1
+		zcKeeper.EXPECT().GetHeader(gomock.Any(), gomock.Eq(consumerID), gomock.Eq(blockHeight)).Return(&zctypes.IndexedHeader{
+			ChainId:             consumerID,
+			Height:              blockHeight,
+			BabylonHeaderHeight: indexedHeight,
+		}, nil).AnyTimes()
+
+		// Case 4: fail if the finality provider does not have voting power
+		bsKeeper.EXPECT().GetConsumerVotingPowerTable(gomock.Any(), gomock.Eq(consumerID), gomock.Eq(indexedHeight)).Return(nil).Times(1)
+		_, err = ms.AddConsumerFinalitySig(ctx, msg)
+		require.Error(t, err)
+
+		// Case 5: the block is finalized upon the vote of the finality
+		// provider holding all voting power of the consumer system
+		bsKeeper.EXPECT().GetConsumerVotingPowerTable(gomock.Any(), gomock.Eq(consumerID), gomock.Eq(indexedHeight)).Return(map[string]uint64{
+			fpBTCPK.MarshalHex(): datagen.RandomInt(r, 1000) + 1,
+		}).Times(1)
+		_, err = ms.AddConsumerFinalitySig(ctx, msg)
+		require.NoError(t, err)
+		block, err := fKeeper.GetConsumerBlock(ctx, consumerID, blockHeight)
+		require.NoError(t, err)
+		require.True(t, block.Finalized)
+		require.Equal(t, blockHash, block.BlockHash)
+
+		// Case 6: duplicate votes are accepted
+		_, err = ms.AddConsumerFinalitySig(ctx, msg)
+		require.NoError(t, err)
+
+		// Case 7: the finality provider is slashed if it votes for a
+		// conflicting block of the consumer system
+		blockHash2 := datagen.GenRandomByteArray(r, 32)
+		msg2, err := types.NewMsgAddConsumerFinalitySig(signer, consumerID, btcSK, srList[idx], prList[idx], proofList[idx], blockHeight, blockHash2)
+		require.NoError(t, err)
+		bsKeeper.EXPECT().SlashFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes), gomock.Eq(babylonHeight)).Return(nil).Times(1)
+		_, err = ms.AddConsumerFinalitySig(ctx, msg2)
+		require.NoError(t, err)
+		// the finalized block is not affected
+		block, err = fKeeper.GetConsumerBlock(ctx, consumerID, blockHeight)
+		require.NoError(t, err)
+		require.Equal(t, blockHash, block.BlockHash)
+	})
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+// MaxPrunedHeightsPerBlock is the maximum number of heights whose finality
+// signatures are pruned in a single `EndBlock`, so that catching up with a
+// long history does not blow up the block time
+const MaxPrunedHeightsPerBlock = uint64(100)
+
+// PruneVotes prunes the finality signatures of blocks that
+// - are finalised, or not finalisable as they have no finality provider,
+// - are in epochs that are checkpointed to BTC, and
+// - are more than `VoteRetentionBlocks` blocks behind the current height
+// Only the aggregated result is kept in the IndexedBlock of a pruned height.
+// This is triggered upon each `EndBlock`
+func (k Keeper) PruneVotes(ctx context.Context) {
+	retention := k.GetParams(ctx).VoteRetentionBlocks
+	if retention == 0 || k.epochingKeeper == nil {
+		return
+	}
+	curHeight := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	if curHeight <= retention {
+		return
+	}
+
+	// the last height whose finality signatures can be pruned
+	endHeight := curHeight - retention
+	nextHeightToFinalize := k.getNextHeightToFinalize(ctx)
+	if nextHeightToFinalize == 0 {
+		// no block is finalised yet
+		return
+	}
+	endHeight = min(endHeight, nextHeightToFinalize-1)
+	checkpointedHeight, ok := k.getLastCheckpointedHeight(ctx)
+	if !ok {
+		return
+	}
+	endHeight = min(endHeight, checkpointedHeight)
+
+	startHeight := k.getNextHeightToPrune(ctx)
+	if startHeight == 0 {
+		activatedHeight, err := k.GetActivatedHeight(ctx)
+		if err != nil {
+			return
+		}
+		startHeight = activatedHeight
+	}
+	endHeight = min(endHeight, startHeight+MaxPrunedHeightsPerBlock-1)
+	if endHeight < startHeight {
+		return
+	}
+
+	for height := startHeight; height <= endHeight; height++ {
+		k.pruneVotesAtHeight(ctx, height, curHeight)
+	}
+	k.setNextHeightToPrune(ctx, endHeight+1)
+}
+
+// pruneVotesAtHeight removes the finality signatures of the block at the
+// given height, and records the number of them and the current height in the
+// block's IndexedBlock
+func (k Keeper) pruneVotesAtHeight(ctx context.Context, height uint64, curHeight uint64) {
+	ib, err := k.GetBlock(ctx, height)
+	if err != nil {
+		// the block is not indexed, thus does not have any finality signature
+		return
+	}
+	if ib.VotesPrunedHeight != 0 {
+		return
+	}
+
+	store := k.voteHeightStore(ctx, height)
+	iter := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	ib.NumVotes = uint64(len(keys))
+	ib.VotesPrunedHeight = curHeight
+	k.SetBlock(ctx, ib)
+}
+
+// getLastCheckpointedHeight returns the last Babylon height of the last epoch
+// that is checkpointed to BTC, if any
+func (k Keeper) getLastCheckpointedHeight(ctx context.Context) (uint64, bool) {
+	lastFinalizedEpoch := k.GetLastFinalizedEpoch(ctx)
+	if lastFinalizedEpoch == 0 {
+		return 0, false
+	}
+	epoch, err := k.epochingKeeper.GetHistoricalEpoch(ctx, lastFinalizedEpoch)
+	if err != nil {
+		return 0, false
+	}
+	return epoch.GetLastBlockHeight(), true
+}
+
+// getNextHeightToPrune returns the next height whose finality signatures are
+// to be pruned, or 0 if no finality signature has been pruned yet
+func (k Keeper) getNextHeightToPrune(ctx context.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.NextHeightToPruneKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setNextHeightToPrune sets the next height whose finality signatures are to
+// be pruned
+func (k Keeper) setNextHeightToPrune(ctx context.Context, height uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.NextHeightToPruneKey, sdk.Uint64ToBigEndian(height)); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	keepertest "github.com/babylonchain/babylon/testutil/keeper"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+func FuzzPruneVotes(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		bsKeeper.EXPECT().GetParams(gomock.Any()).Return(bstypes.Params{MaxActiveFinalityProviders: 100}).AnyTimes()
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		eKeeper := types.NewMockEpochingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper)
+		fKeeper.SetEpochingKeeper(eKeeper)
+
+		params := fKeeper.GetParams(ctx)
+		params.VoteRetentionBlocks = params.FinalitySigTimeout + 1
+		err := fKeeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		// activate BTC staking protocol at a random height
+		activatedHeight := datagen.RandomInt(r, 10) + 1
+
+		// index a list of blocks, give all of them QCs, and finalise them
+		numBlocks := uint64(10)
+		for i := activatedHeight; i < activatedHeight+numBlocks; i++ {
+			fKeeper.SetBlock(ctx, &types.IndexedBlock{
+				Height:    i,
+				AppHash:   datagen.GenRandomByteArray(r, 32),
+				Finalized: false,
+			})
+			err := giveQCToHeight(r, ctx, bsKeeper, fKeeper, i)
+			require.NoError(t, err)
+		}
+		// we don't test incentive in this function
+		bsKeeper.EXPECT().GetVotingPowerDistCache(gomock.Any(), gomock.Any()).Return(bstypes.NewVotingPowerDistCache(), nil).Times(int(numBlocks))
+		iKeeper.EXPECT().RewardBTCStaking(gomock.Any(), gomock.Any(), gomock.Any()).Return().Times(int(numBlocks))
+		bsKeeper.EXPECT().RemoveVotingPowerDistCache(gomock.Any(), gomock.Any()).Return().Times(int(numBlocks))
+		bsKeeper.EXPECT().GetBTCStakingActivatedHeight(gomock.Any()).Return(activatedHeight, nil).AnyTimes()
+		ctx = datagen.WithCtxHeight(ctx, activatedHeight+numBlocks-1)
+		fKeeper.TallyBlocks(ctx)
+
+		// no block is pruned before any epoch is checkpointed to BTC
+		curHeight := activatedHeight + numBlocks + params.VoteRetentionBlocks
+		ctx = datagen.WithCtxHeight(ctx, curHeight)
+		bsKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(uint64(0)).Times(1)
+		fKeeper.PruneVotes(ctx)
+		for i := activatedHeight; i < activatedHeight+numBlocks; i++ {
+			require.Len(t, fKeeper.GetSigSet(ctx, i), 3)
+		}
+
+		// checkpoint an epoch ending at a random height among the blocks,
+		// expect all blocks up to this height are pruned
+		checkpointedHeight := activatedHeight + datagen.RandomInt(r, int(numBlocks))
+		bsKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(uint64(1)).Times(1)
+		eKeeper.EXPECT().GetHistoricalEpoch(gomock.Any(), gomock.Eq(uint64(1))).Return(&epochingtypes.Epoch{
+			EpochNumber:          1,
+			CurrentEpochInterval: checkpointedHeight,
+			FirstBlockHeight:     1,
+		}, nil).Times(1)
+		fKeeper.PruneVotes(ctx)
+
+		for i := activatedHeight; i < activatedHeight+numBlocks; i++ {
+			if i > checkpointedHeight {
+				require.Len(t, fKeeper.GetSigSet(ctx, i), 3)
+				ib, err := fKeeper.GetBlock(ctx, i)
+				require.NoError(t, err)
+				require.Zero(t, ib.VotesPrunedHeight)
+				continue
+			}
+
+			require.Nil(t, fKeeper.GetSigSet(ctx, i))
+			// the aggregated result is kept
+			res, err := fKeeper.Block(ctx, &types.QueryBlockRequest{Height: i})
+			require.NoError(t, err)
+			require.True(t, res.Block.Finalized)
+			require.Equal(t, uint64(3), res.Block.NumVotes)
+			require.Equal(t, curHeight, res.Block.VotesPrunedHeight)
+			require.Equal(t, uint64(3), res.VotedPower)
+			require.Equal(t, uint64(4), res.TotalPower)
+			// the finality signatures are no longer queryable
+			_, err = fKeeper.VotesAtHeight(ctx, &types.QueryVotesAtHeightRequest{Height: i})
+			require.ErrorIs(t, err, types.ErrVotesPruned)
+		}
+	})
+}
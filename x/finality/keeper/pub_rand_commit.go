@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+// SetPubRandCommit adds the given public randomness commitment for the given finality provider
+func (k Keeper) SetPubRandCommit(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, prCommit *types.PubRandCommit) {
+	store := k.pubRandCommitFpStore(ctx, fpBtcPK)
+	store.Set(sdk.Uint64ToBigEndian(prCommit.StartHeight), k.cdc.MustMarshal(prCommit))
+}
+
+// GetLastPubRandCommit returns the last public randomness commitment of the given
+// finality provider, or nil if the finality provider has not committed any
+func (k Keeper) GetLastPubRandCommit(ctx context.Context, fpBtcPK *bbn.BIP340PubKey) *types.PubRandCommit {
+	return k.getLastPubRandCommit(k.pubRandCommitFpStore(ctx, fpBtcPK))
+}
+
+// GetPubRandCommitForHeight returns the public randomness commitment of the given
+// finality provider that covers the given height
+func (k Keeper) GetPubRandCommitForHeight(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, height uint64) (*types.PubRandCommit, error) {
+	return k.getPubRandCommitForHeight(k.pubRandCommitFpStore(ctx, fpBtcPK), height)
+}
+
+// getLastPubRandCommit returns the last public randomness commitment in the
+// given store of public randomness commitments of a finality provider, or nil
+// if there is none
+func (k Keeper) getLastPubRandCommit(store prefix.Store) *types.PubRandCommit {
+	iter := store.ReverseIterator(nil, nil)
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil
+	}
+	var prCommit types.PubRandCommit
+	k.cdc.MustUnmarshal(iter.Value(), &prCommit)
+	return &prCommit
+}
+
+// getPubRandCommitForHeight returns the public randomness commitment in the
+// given store of public randomness commitments of a finality provider that
+// covers the given height
+func (k Keeper) getPubRandCommitForHeight(store prefix.Store, height uint64) (*types.PubRandCommit, error) {
+	// the commitment covering the height is the one with the largest start
+	// height that is no larger than the height
+	iter := store.ReverseIterator(nil, sdk.Uint64ToBigEndian(height+1))
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil, types.ErrPubRandNotFound.Wrapf("no public randomness commitment before height %d", height)
+	}
+	var prCommit types.PubRandCommit
+	k.cdc.MustUnmarshal(iter.Value(), &prCommit)
+	if !prCommit.IsInRange(height) {
+		return nil, types.ErrPubRandNotFound.Wrapf("no public randomness commitment covers height %d", height)
+	}
+	return &prCommit, nil
+}
+
+// pubRandCommitFpStore returns the KVStore of the public randomness commitments
+// of the given finality provider
+// prefix: PubRandCommitKey
+// key: (finality provider PK || start height)
+// value: PubRandCommit
+func (k Keeper) pubRandCommitFpStore(ctx context.Context, fpBtcPK *bbn.BIP340PubKey) prefix.Store {
+	prcStore := k.pubRandCommitStore(ctx)
+	return prefix.NewStore(prcStore, fpBtcPK.MustMarshal())
+}
+
+// pubRandCommitStore returns the KVStore of the public randomness commitments
+// prefix: PubRandCommitKey
+// key: (prefix)
+// value: PubRandCommit
+func (k Keeper) pubRandCommitStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.PubRandCommitKey)
+}
+
+// SetConsumerPubRandCommit adds the given public randomness commitment of the
+// given finality provider for the blocks of the given consumer system
+func (k Keeper) SetConsumerPubRandCommit(ctx context.Context, consumerID string, fpBtcPK *bbn.BIP340PubKey, prCommit *types.PubRandCommit) {
+	store := k.consumerPubRandCommitFpStore(ctx, consumerID, fpBtcPK)
+	store.Set(sdk.Uint64ToBigEndian(prCommit.StartHeight), k.cdc.MustMarshal(prCommit))
+}
+
+// GetLastConsumerPubRandCommit returns the last public randomness commitment
+// of the given finality provider for the given consumer system, or nil if the
+// finality provider has not committed any
+func (k Keeper) GetLastConsumerPubRandCommit(ctx context.Context, consumerID string, fpBtcPK *bbn.BIP340PubKey) *types.PubRandCommit {
+	return k.getLastPubRandCommit(k.consumerPubRandCommitFpStore(ctx, consumerID, fpBtcPK))
+}
+
+// GetConsumerPubRandCommitForHeight returns the public randomness commitment
+// of the given finality provider for the given consumer system that covers
+// the given height of the consumer system
+func (k Keeper) GetConsumerPubRandCommitForHeight(ctx context.Context, consumerID string, fpBtcPK *bbn.BIP340PubKey, height uint64) (*types.PubRandCommit, error) {
+	return k.getPubRandCommitForHeight(k.consumerPubRandCommitFpStore(ctx, consumerID, fpBtcPK), height)
+}
+
+// consumerPubRandCommitFpStore returns the KVStore of the public randomness
+// commitments of the given finality provider for the given consumer system
+// prefix: ConsumerPubRandKey
+// key: (consumer ID || finality provider PK || start height)
+// value: PubRandCommit
+func (k Keeper) consumerPubRandCommitFpStore(ctx context.Context, consumerID string, fpBtcPK *bbn.BIP340PubKey) prefix.Store {
+	consumerStore := prefix.NewStore(k.consumerPubRandCommitStore(ctx), types.ConsumerIDKey(consumerID))
+	return prefix.NewStore(consumerStore, fpBtcPK.MustMarshal())
+}
+
+// consumerPubRandCommitStore returns the KVStore of the public randomness
+// commitments for consumer systems
+// prefix: ConsumerPubRandKey
+// key: (prefix)
+// value: PubRandCommit
+func (k Keeper) consumerPubRandCommitStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.ConsumerPubRandKey)
+}
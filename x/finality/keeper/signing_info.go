@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+// SetSigningInfo sets the signing info of the given finality provider
+func (k Keeper) SetSigningInfo(ctx context.Context, info *types.FinalityProviderSigningInfo) {
+	store := k.signingInfoStore(ctx)
+	store.Set(info.FpBtcPk.MustMarshal(), k.cdc.MustMarshal(info))
+}
+
+// GetSigningInfo returns the signing info of the given finality provider
+func (k Keeper) GetSigningInfo(ctx context.Context, fpBtcPK *bbn.BIP340PubKey) (*types.FinalityProviderSigningInfo, error) {
+	store := k.signingInfoStore(ctx)
+	infoBytes := store.Get(fpBtcPK.MustMarshal())
+	if len(infoBytes) == 0 {
+		return nil, types.ErrSigningInfoNotFound.Wrapf("finality provider %s", fpBtcPK.MarshalHex())
+	}
+	var info types.FinalityProviderSigningInfo
+	k.cdc.MustUnmarshal(infoBytes, &info)
+	return &info, nil
+}
+
+// IterateSigningInfos iterates over the signing infos of all finality
+// providers until the handler returns false
+func (k Keeper) IterateSigningInfos(ctx context.Context, handler func(info *types.FinalityProviderSigningInfo) bool) {
+	iter := k.signingInfoStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var info types.FinalityProviderSigningInfo
+		k.cdc.MustUnmarshal(iter.Value(), &info)
+		if !handler(&info) {
+			break
+		}
+	}
+}
+
+// GetMissedBlockBitmapValue returns whether the given finality provider has
+// missed the block at the given index of the sliding window
+func (k Keeper) GetMissedBlockBitmapValue(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, index uint64) bool {
+	store := k.missedBlockBitmapFpStore(ctx, fpBtcPK)
+	return store.Has(sdk.Uint64ToBigEndian(index))
+}
+
+// setMissedBlockBitmapValue sets whether the given finality provider has
+// missed the block at the given index of the sliding window
+func (k Keeper) setMissedBlockBitmapValue(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, index uint64, missed bool) {
+	store := k.missedBlockBitmapFpStore(ctx, fpBtcPK)
+	if missed {
+		store.Set(sdk.Uint64ToBigEndian(index), []byte{1})
+	} else {
+		store.Delete(sdk.Uint64ToBigEndian(index))
+	}
+}
+
+// getMissedBlockIndexes returns the indexes of the blocks in the sliding
+// window that the given finality provider has missed
+func (k Keeper) getMissedBlockIndexes(ctx context.Context, fpBtcPK *bbn.BIP340PubKey) []uint64 {
+	indexes := []uint64{}
+	iter := k.missedBlockBitmapFpStore(ctx, fpBtcPK).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		indexes = append(indexes, sdk.BigEndianToUint64(iter.Key()))
+	}
+	return indexes
+}
+
+// clearMissedBlockBitmap clears the missed blocks bitmap of the given
+// finality provider
+func (k Keeper) clearMissedBlockBitmap(ctx context.Context, fpBtcPK *bbn.BIP340PubKey) {
+	for _, index := range k.getMissedBlockIndexes(ctx, fpBtcPK) {
+		k.setMissedBlockBitmapValue(ctx, fpBtcPK, index, false)
+	}
+}
+
+// signingInfoStore returns the KVStore of the signing infos of finality
+// providers
+// prefix: FPSigningInfoKey
+// key: finality provider PK
+// value: FinalityProviderSigningInfo
+func (k Keeper) signingInfoStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.FPSigningInfoKey)
+}
+
+// missedBlockBitmapFpStore returns the KVStore of the missed blocks bitmap of
+// the given finality provider, where only the indexes of the missed blocks
+// are stored
+// prefix: FPMissedBlockBitmapKey || finality provider PK
+// key: index in the sliding window
+// value: []byte{1}
+func (k Keeper) missedBlockBitmapFpStore(ctx context.Context, fpBtcPK *bbn.BIP340PubKey) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bitmapStore := prefix.NewStore(storeAdapter, types.FPMissedBlockBitmapKey)
+	return prefix.NewStore(bitmapStore, fpBtcPK.MustMarshal())
+}
@@ -11,17 +11,17 @@ import (
 // TallyBlocks tries to finalise all blocks that are non-finalised AND have a non-nil
 // finality provider set, from earliest to the latest.
 //
-// This function is invoked upon each `EndBlock` *after* the BTC staking protocol is activated
+// This function is invoked upon each `EndBlock` *after* the finality gadget is activated
 // It ensures that at height `h`, the ancestor chain `[activated_height, h-1]` contains either
 // - finalised blocks (i.e., block with finality provider set AND QC of this finality provider set)
 // - non-finalisable blocks (i.e., block with no active finality providers)
 // but without block that has finality providers set AND does not receive QC
 func (k Keeper) TallyBlocks(ctx context.Context) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	activatedHeight, err := k.BTCStakingKeeper.GetBTCStakingActivatedHeight(ctx)
+	activatedHeight, err := k.GetActivatedHeight(ctx)
 	if err != nil {
-		// invoking TallyBlocks when BTC staking protocol is not activated is a programming error
-		panic(fmt.Errorf("cannot tally a block when the BTC staking protocol hasn't been activated yet, current height: %v, activated height: %v",
+		// invoking TallyBlocks when the finality gadget is not activated is a programming error
+		panic(fmt.Errorf("cannot tally a block when the finality gadget hasn't been activated yet, current height: %v, activated height: %v",
 			sdkCtx.HeaderInfo().Height, activatedHeight))
 	}
 
@@ -50,9 +50,10 @@ func (k Keeper) TallyBlocks(ctx context.Context) {
 		if fpSet != nil && !ib.Finalized {
 			// has finality providers, non-finalised: tally and try to finalise the block
 			voterBTCPKs := k.GetVoters(ctx, ib.Height)
-			if tally(fpSet, voterBTCPKs) {
+			votedPower, totalPower := tally(fpSet, voterBTCPKs)
+			if hasQuorum(votedPower, totalPower) {
 				// if this block gets >2/3 votes, finalise it
-				k.finalizeBlock(ctx, ib, voterBTCPKs)
+				k.finalizeBlock(ctx, ib, voterBTCPKs, votedPower, totalPower)
 			} else {
 				// if not, then this block and all subsequent blocks should not be finalised
 				// thus, we need to break here
@@ -76,10 +77,29 @@ func (k Keeper) TallyBlocks(ctx context.Context) {
 
 // finalizeBlock sets a block to be finalised in KVStore and distributes rewards to
 // finality providers and delegations
-func (k Keeper) finalizeBlock(ctx context.Context, block *types.IndexedBlock, voterBTCPKs map[string]struct{}) {
-	// set block to be finalised in KVStore
+func (k Keeper) finalizeBlock(
+	ctx context.Context,
+	block *types.IndexedBlock,
+	voterBTCPKs map[string]struct{},
+	votedPower uint64,
+	totalPower uint64,
+) {
+	// set block to be finalised in KVStore, along with the aggregated result
+	// that is kept after its finality signatures are pruned
 	block.Finalized = true
+	block.VotedPower = votedPower
+	block.TotalPower = totalPower
 	k.SetBlock(ctx, block)
+	// notify subscribers that the block is finalised
+	event := &types.EventBlockFinalized{
+		Height:     block.Height,
+		AppHash:    block.AppHash,
+		VotedPower: votedPower,
+		TotalPower: totalPower,
+	}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("failed to emit EventBlockFinalized event: %w", err))
+	}
 	// set next height to finalise as height+1
 	k.setNextHeightToFinalize(ctx, block.Height+1)
 	// distribute rewards to BTC staking stakeholders w.r.t. the voting power distribution cache
@@ -99,16 +119,32 @@ func (k Keeper) finalizeBlock(ctx context.Context, block *types.IndexedBlock, vo
 	types.RecordLastFinalizedHeight(block.Height)
 }
 
-// tally checks whether a block with the given finality provider set and votes reaches a quorum or not
-func tally(fpSet map[string]uint64, voterBTCPKs map[string]struct{}) bool {
-	totalPower := uint64(0)
-	votedPower := uint64(0)
+// GetBlockTally returns the voting power of the finality providers that have
+// voted for the block at the given height, and the total voting power of the
+// finality provider set at this height
+func (k Keeper) GetBlockTally(ctx context.Context, height uint64) (votedPower uint64, totalPower uint64) {
+	fpSet := k.BTCStakingKeeper.GetVotingPowerTable(ctx, height)
+	if fpSet == nil {
+		return 0, 0
+	}
+	return tally(fpSet, k.GetVoters(ctx, height))
+}
+
+// tally returns the voting power of the voted finality providers and the total
+// voting power of the given finality provider set
+func tally(fpSet map[string]uint64, voterBTCPKs map[string]struct{}) (votedPower uint64, totalPower uint64) {
 	for pkStr, power := range fpSet {
 		totalPower += power
 		if _, ok := voterBTCPKs[pkStr]; ok {
 			votedPower += power
 		}
 	}
+	return votedPower, totalPower
+}
+
+// hasQuorum checks whether the voted voting power is more than 2/3 of the total
+// voting power
+func hasQuorum(votedPower uint64, totalPower uint64) bool {
 	return votedPower*3 > totalPower*2
 }
 
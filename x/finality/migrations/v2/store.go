@@ -0,0 +1,43 @@
+package v2
+
+import (
+	corestoretypes "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The
+// parameters stored by v1 predate the liveness parameters, which are thus
+// zero after decoding and would make the sliding window of the liveness
+// tracking divide by zero. If the sliding window is unset, the migration sets
+// all liveness parameters to their default values, and it keeps all other
+// parameters as they are.
+func MigrateStore(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	store := storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.ParamsKey)
+	if err != nil {
+		return err
+	}
+
+	var params types.Params
+	if bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	if params.SignedBlocksWindow == 0 {
+		defaultParams := types.DefaultParams()
+		params.SignedBlocksWindow = defaultParams.SignedBlocksWindow
+		params.FinalitySigTimeout = defaultParams.FinalitySigTimeout
+		params.MinSignedPerWindow = defaultParams.MinSignedPerWindow
+		params.JailDurationBlocks = defaultParams.JailDurationBlocks
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	return store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+}
@@ -8,16 +8,22 @@ import (
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCommitPubRandList{}, "finality/MsgCommitPubRandList", nil)
 	cdc.RegisterConcrete(&MsgAddFinalitySig{}, "finality/MsgAddFinalitySig", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "finality/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgAddConsumerFinalitySig{}, "finality/MsgAddConsumerFinalitySig", nil)
+	cdc.RegisterConcrete(&MsgUnjailFinalityProvider{}, "finality/MsgUnjailFinalityProvider", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	// Register messages
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
+		&MsgCommitPubRandList{},
 		&MsgAddFinalitySig{},
 		&MsgUpdateParams{},
+		&MsgAddConsumerFinalitySig{},
+		&MsgUnjailFinalityProvider{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
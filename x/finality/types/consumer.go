@@ -0,0 +1,16 @@
+package types
+
+// MaxConsumerIDLength is the maximum length of the ID of a consumer system
+const MaxConsumerIDLength = 64
+
+// ValidateConsumerID checks that the given consumer ID is not empty and not
+// longer than MaxConsumerIDLength
+func ValidateConsumerID(consumerID string) error {
+	if len(consumerID) == 0 {
+		return ErrInvalidConsumer.Wrap("empty consumer ID")
+	}
+	if len(consumerID) > MaxConsumerIDLength {
+		return ErrInvalidConsumer.Wrapf("consumer ID is longer than %d bytes", MaxConsumerIDLength)
+	}
+	return nil
+}
@@ -6,14 +6,22 @@ import (
 
 // x/finality module sentinel errors
 var (
-	ErrBlockNotFound       = errorsmod.Register(ModuleName, 1100, "Block is not found")
-	ErrVoteNotFound        = errorsmod.Register(ModuleName, 1101, "vote is not found")
-	ErrHeightTooHigh       = errorsmod.Register(ModuleName, 1102, "the chain has not reached the given height yet")
-	ErrPubRandNotFound     = errorsmod.Register(ModuleName, 1103, "public randomness is not found")
-	ErrNoPubRandYet        = errorsmod.Register(ModuleName, 1104, "the finality provider has not committed any public randomness yet")
-	ErrTooFewPubRand       = errorsmod.Register(ModuleName, 1105, "the request contains too few public randomness")
-	ErrInvalidPubRand      = errorsmod.Register(ModuleName, 1106, "the public randomness list is invalid")
-	ErrEvidenceNotFound    = errorsmod.Register(ModuleName, 1107, "evidence is not found")
-	ErrInvalidFinalitySig  = errorsmod.Register(ModuleName, 1108, "finality signature is not valid")
-	ErrNoSlashableEvidence = errorsmod.Register(ModuleName, 1109, "there is no slashable evidence")
+	ErrBlockNotFound           = errorsmod.Register(ModuleName, 1100, "Block is not found")
+	ErrVoteNotFound            = errorsmod.Register(ModuleName, 1101, "vote is not found")
+	ErrHeightTooHigh           = errorsmod.Register(ModuleName, 1102, "the chain has not reached the given height yet")
+	ErrPubRandNotFound         = errorsmod.Register(ModuleName, 1103, "public randomness is not found")
+	ErrNoPubRandYet            = errorsmod.Register(ModuleName, 1104, "the finality provider has not committed any public randomness yet")
+	ErrTooFewPubRand           = errorsmod.Register(ModuleName, 1105, "the request contains too few public randomness")
+	ErrInvalidPubRand          = errorsmod.Register(ModuleName, 1106, "the public randomness list is invalid")
+	ErrEvidenceNotFound        = errorsmod.Register(ModuleName, 1107, "evidence is not found")
+	ErrInvalidFinalitySig      = errorsmod.Register(ModuleName, 1108, "finality signature is not valid")
+	ErrNoSlashableEvidence     = errorsmod.Register(ModuleName, 1109, "there is no slashable evidence")
+	ErrSigningInfoNotFound     = errorsmod.Register(ModuleName, 1110, "signing info of the finality provider is not found")
+	ErrConsumerNotFound        = errorsmod.Register(ModuleName, 1111, "consumer system is not found")
+	ErrConsumerBlockNotIndexed = errorsmod.Register(ModuleName, 1112, "the block of the consumer system is not indexed")
+	ErrInvalidConsumer         = errorsmod.Register(ModuleName, 1113, "consumer ID is not valid")
+	ErrFpNotSecuring           = errorsmod.Register(ModuleName, 1114, "the finality provider does not secure the consumer system")
+	ErrFinalityNotActivated    = errorsmod.Register(ModuleName, 1115, "the finality gadget is not activated yet")
+	ErrVotesPruned             = errorsmod.Register(ModuleName, 1116, "the finality signatures of the block are pruned")
+	ErrJailPeriodNotPassed     = errorsmod.Register(ModuleName, 1117, "the jail period of the finality provider has not passed yet")
 )
@@ -1,7 +1,16 @@
 package types
 
-func NewEventSlashedFinalityProvider(evidence *Evidence) *EventSlashedFinalityProvider {
+func NewEventSlashedFinalityProvider(evidence *Evidence, extractedBTCSKHex string) *EventSlashedFinalityProvider {
 	return &EventSlashedFinalityProvider{
-		Evidence: evidence,
+		Evidence:          evidence,
+		ExtractedBtcSkHex: extractedBTCSKHex,
+	}
+}
+
+func NewEventConsumerBlockFinalized(block *ConsumerBlock, votedPower, totalPower uint64) *EventConsumerBlockFinalized {
+	return &EventConsumerBlockFinalized{
+		Block:      block,
+		VotedPower: votedPower,
+		TotalPower: totalPower,
 	}
 }
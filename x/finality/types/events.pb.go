@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -27,6 +29,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type EventSlashedFinalityProvider struct {
 	// evidence is the evidence that the finality provider double signs
 	Evidence *Evidence `protobuf:"bytes,1,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	// extracted_btc_sk_hex is the hex str of the finality provider's BTC SK
+	// extracted from the evidence
+	ExtractedBtcSkHex string `protobuf:"bytes,2,opt,name=extracted_btc_sk_hex,json=extractedBtcSkHex,proto3" json:"extracted_btc_sk_hex,omitempty"`
 }
 
 func (m *EventSlashedFinalityProvider) Reset()         { *m = EventSlashedFinalityProvider{} }
@@ -69,27 +74,309 @@ func (m *EventSlashedFinalityProvider) GetEvidence() *Evidence {
 	return nil
 }
 
+func (m *EventSlashedFinalityProvider) GetExtractedBtcSkHex() string {
+	if m != nil {
+		return m.ExtractedBtcSkHex
+	}
+	return ""
+}
+
+// EventBlockFinalized is the event emitted when a block is finalised by
+// finality providers with more than 2/3 of the voting power
+type EventBlockFinalized struct {
+	// height is the height of the finalised block
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// app_hash is the AppHash of the finalised block
+	AppHash []byte `protobuf:"bytes,2,opt,name=app_hash,json=appHash,proto3" json:"app_hash,omitempty"`
+	// voted_power is the voting power of the finality providers that have
+	// voted for the block
+	VotedPower uint64 `protobuf:"varint,3,opt,name=voted_power,json=votedPower,proto3" json:"voted_power,omitempty"`
+	// total_power is the total voting power of the finality provider set at
+	// the block's height
+	TotalPower uint64 `protobuf:"varint,4,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+}
+
+func (m *EventBlockFinalized) Reset()         { *m = EventBlockFinalized{} }
+func (m *EventBlockFinalized) String() string { return proto.CompactTextString(m) }
+func (*EventBlockFinalized) ProtoMessage()    {}
+func (*EventBlockFinalized) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c34c03aae5e3e6bf, []int{1}
+}
+func (m *EventBlockFinalized) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBlockFinalized) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBlockFinalized.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBlockFinalized) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBlockFinalized.Merge(m, src)
+}
+func (m *EventBlockFinalized) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBlockFinalized) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBlockFinalized.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBlockFinalized proto.InternalMessageInfo
+
+func (m *EventBlockFinalized) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EventBlockFinalized) GetAppHash() []byte {
+	if m != nil {
+		return m.AppHash
+	}
+	return nil
+}
+
+func (m *EventBlockFinalized) GetVotedPower() uint64 {
+	if m != nil {
+		return m.VotedPower
+	}
+	return 0
+}
+
+func (m *EventBlockFinalized) GetTotalPower() uint64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
+}
+
+// EventJailedFinalityProvider is the event emitted when a finality provider is
+// jailed due to missing too many blocks in the sliding window
+type EventJailedFinalityProvider struct {
+	// fp_btc_pk is the BTC PK of the jailed finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// jailed_height is the height at which the finality provider is jailed
+	JailedHeight uint64 `protobuf:"varint,2,opt,name=jailed_height,json=jailedHeight,proto3" json:"jailed_height,omitempty"`
+	// missed_blocks_counter is the number of blocks the finality provider has
+	// missed in the sliding window
+	MissedBlocksCounter uint64 `protobuf:"varint,3,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+}
+
+func (m *EventJailedFinalityProvider) Reset()         { *m = EventJailedFinalityProvider{} }
+func (m *EventJailedFinalityProvider) String() string { return proto.CompactTextString(m) }
+func (*EventJailedFinalityProvider) ProtoMessage()    {}
+func (*EventJailedFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c34c03aae5e3e6bf, []int{2}
+}
+func (m *EventJailedFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventJailedFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventJailedFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventJailedFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventJailedFinalityProvider.Merge(m, src)
+}
+func (m *EventJailedFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventJailedFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventJailedFinalityProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventJailedFinalityProvider proto.InternalMessageInfo
+
+func (m *EventJailedFinalityProvider) GetJailedHeight() uint64 {
+	if m != nil {
+		return m.JailedHeight
+	}
+	return 0
+}
+
+func (m *EventJailedFinalityProvider) GetMissedBlocksCounter() uint64 {
+	if m != nil {
+		return m.MissedBlocksCounter
+	}
+	return 0
+}
+
+// EventUnjailedFinalityProvider is the event emitted when a jailed finality
+// provider unjails itself
+type EventUnjailedFinalityProvider struct {
+	// fp_btc_pk is the BTC PK of the unjailed finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// unjailed_height is the height at which the finality provider is unjailed
+	UnjailedHeight uint64 `protobuf:"varint,2,opt,name=unjailed_height,json=unjailedHeight,proto3" json:"unjailed_height,omitempty"`
+}
+
+func (m *EventUnjailedFinalityProvider) Reset()         { *m = EventUnjailedFinalityProvider{} }
+func (m *EventUnjailedFinalityProvider) String() string { return proto.CompactTextString(m) }
+func (*EventUnjailedFinalityProvider) ProtoMessage()    {}
+func (*EventUnjailedFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c34c03aae5e3e6bf, []int{3}
+}
+func (m *EventUnjailedFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUnjailedFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUnjailedFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUnjailedFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUnjailedFinalityProvider.Merge(m, src)
+}
+func (m *EventUnjailedFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUnjailedFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUnjailedFinalityProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUnjailedFinalityProvider proto.InternalMessageInfo
+
+func (m *EventUnjailedFinalityProvider) GetUnjailedHeight() uint64 {
+	if m != nil {
+		return m.UnjailedHeight
+	}
+	return 0
+}
+
+// EventConsumerBlockFinalized is the event emitted when a block of a consumer
+// system is finalised by the finality providers securing the consumer system
+// with more than 2/3 of the voting power
+type EventConsumerBlockFinalized struct {
+	// block is the finalised block of the consumer system
+	Block *ConsumerBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	// voted_power is the voting power of the finality providers that have
+	// voted for the block
+	VotedPower uint64 `protobuf:"varint,2,opt,name=voted_power,json=votedPower,proto3" json:"voted_power,omitempty"`
+	// total_power is the total voting power of the finality providers
+	// securing the consumer system at the block's height
+	TotalPower uint64 `protobuf:"varint,3,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+}
+
+func (m *EventConsumerBlockFinalized) Reset()         { *m = EventConsumerBlockFinalized{} }
+func (m *EventConsumerBlockFinalized) String() string { return proto.CompactTextString(m) }
+func (*EventConsumerBlockFinalized) ProtoMessage()    {}
+func (*EventConsumerBlockFinalized) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c34c03aae5e3e6bf, []int{4}
+}
+func (m *EventConsumerBlockFinalized) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConsumerBlockFinalized) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConsumerBlockFinalized.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventConsumerBlockFinalized) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConsumerBlockFinalized.Merge(m, src)
+}
+func (m *EventConsumerBlockFinalized) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConsumerBlockFinalized) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConsumerBlockFinalized.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConsumerBlockFinalized proto.InternalMessageInfo
+
+func (m *EventConsumerBlockFinalized) GetBlock() *ConsumerBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *EventConsumerBlockFinalized) GetVotedPower() uint64 {
+	if m != nil {
+		return m.VotedPower
+	}
+	return 0
+}
+
+func (m *EventConsumerBlockFinalized) GetTotalPower() uint64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventSlashedFinalityProvider)(nil), "babylon.finality.v1.EventSlashedFinalityProvider")
+	proto.RegisterType((*EventBlockFinalized)(nil), "babylon.finality.v1.EventBlockFinalized")
+	proto.RegisterType((*EventJailedFinalityProvider)(nil), "babylon.finality.v1.EventJailedFinalityProvider")
+	proto.RegisterType((*EventUnjailedFinalityProvider)(nil), "babylon.finality.v1.EventUnjailedFinalityProvider")
+	proto.RegisterType((*EventConsumerBlockFinalized)(nil), "babylon.finality.v1.EventConsumerBlockFinalized")
 }
 
 func init() { proto.RegisterFile("babylon/finality/v1/events.proto", fileDescriptor_c34c03aae5e3e6bf) }
 
 var fileDescriptor_c34c03aae5e3e6bf = []byte{
-	// 193 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0xcb, 0xcc, 0x4b, 0xcc, 0xc9, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4,
-	0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xaa,
-	0xd0, 0x83, 0xa9, 0xd0, 0x2b, 0x33, 0x94, 0x52, 0xc2, 0xa6, 0x0d, 0xae, 0x00, 0xac, 0x51, 0x29,
-	0x92, 0x4b, 0xc6, 0x15, 0x64, 0x50, 0x70, 0x4e, 0x62, 0x71, 0x46, 0x6a, 0x8a, 0x1b, 0x54, 0x36,
-	0xa0, 0x28, 0xbf, 0x2c, 0x33, 0x25, 0xb5, 0x48, 0xc8, 0x92, 0x8b, 0x23, 0x15, 0xc4, 0xca, 0x4b,
-	0x4e, 0x95, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd5, 0xc3, 0x62, 0x97, 0x9e, 0x2b, 0x54,
-	0x51, 0x10, 0x5c, 0xb9, 0x93, 0xd7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78,
-	0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44,
-	0x19, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0x0d, 0x4b, 0xce,
-	0x48, 0xcc, 0xcc, 0x83, 0x71, 0xf4, 0x2b, 0x10, 0x4e, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62,
-	0x03, 0xbb, 0xd6, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x4e, 0xb5, 0x88, 0x0a, 0x01, 0x00,
-	0x00,
+	// 502 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0xd3, 0xd2, 0x8f, 0x6d, 0x00, 0xe1, 0x14, 0x14, 0x0a, 0x75, 0x23, 0x73, 0xa0, 0x27,
+	0xbb, 0x4d, 0x11, 0x82, 0xab, 0xab, 0xa2, 0x50, 0x2e, 0x96, 0x2b, 0x2e, 0x5c, 0xac, 0xb5, 0xb3,
+	0x89, 0x5d, 0x3b, 0xbb, 0x2b, 0xef, 0xda, 0x24, 0xfc, 0x04, 0x4e, 0x5c, 0x91, 0xf8, 0x41, 0x88,
+	0x53, 0x8f, 0x88, 0x03, 0x42, 0xc9, 0x1f, 0x41, 0x3b, 0x76, 0x02, 0x44, 0x96, 0x72, 0xe3, 0xb6,
+	0x3b, 0xf3, 0x66, 0xf6, 0xbd, 0xa7, 0xb7, 0xa8, 0x1b, 0xe0, 0x60, 0x9a, 0x32, 0x6a, 0x0f, 0x63,
+	0x8a, 0xd3, 0x58, 0x4e, 0xed, 0xe2, 0xd4, 0x26, 0x05, 0xa1, 0x52, 0x58, 0x3c, 0x63, 0x92, 0xe9,
+	0xed, 0x0a, 0x61, 0x2d, 0x10, 0x56, 0x71, 0x7a, 0xb0, 0x3f, 0x62, 0x23, 0x06, 0x7d, 0x5b, 0x9d,
+	0x4a, 0xe8, 0x81, 0x59, 0xb7, 0x6c, 0x39, 0x06, 0x18, 0xf3, 0xa3, 0x86, 0x1e, 0x5f, 0xa8, 0xfd,
+	0x57, 0x29, 0x16, 0x11, 0x19, 0xbc, 0xaa, 0xda, 0x6e, 0xc6, 0x8a, 0x78, 0x40, 0x32, 0xfd, 0x25,
+	0xda, 0x21, 0xea, 0x44, 0x43, 0xd2, 0xd1, 0xba, 0xda, 0xf1, 0x5e, 0xef, 0xd0, 0xaa, 0xa1, 0x60,
+	0x5d, 0x54, 0x20, 0x6f, 0x09, 0xd7, 0x6d, 0xb4, 0x4f, 0x26, 0x32, 0xc3, 0xa1, 0x24, 0x03, 0x3f,
+	0x90, 0xa1, 0x2f, 0x12, 0x3f, 0x22, 0x93, 0x4e, 0xb3, 0xab, 0x1d, 0xef, 0x7a, 0xf7, 0x96, 0x3d,
+	0x47, 0x86, 0x57, 0x49, 0x9f, 0x4c, 0x14, 0x99, 0x36, 0x90, 0x71, 0x52, 0x16, 0x26, 0x25, 0x95,
+	0x0f, 0x64, 0xa0, 0x3f, 0x40, 0x5b, 0x11, 0x89, 0x47, 0x91, 0x04, 0x06, 0x9b, 0x5e, 0x75, 0xd3,
+	0x1f, 0xa2, 0x1d, 0xcc, 0xb9, 0x1f, 0x61, 0x11, 0xc1, 0xd2, 0x96, 0xb7, 0x8d, 0x39, 0xef, 0x63,
+	0x11, 0xe9, 0x47, 0x68, 0xaf, 0x60, 0xea, 0x5d, 0xce, 0xde, 0x93, 0xac, 0xb3, 0x01, 0x73, 0x08,
+	0x4a, 0xae, 0xaa, 0x28, 0x80, 0x64, 0x12, 0xa7, 0x15, 0x60, 0xb3, 0x04, 0x40, 0x09, 0x00, 0xe6,
+	0x37, 0x0d, 0x3d, 0x02, 0x32, 0x97, 0x38, 0x4e, 0x6b, 0x8c, 0xf1, 0xd0, 0xee, 0x90, 0x83, 0x2c,
+	0x9e, 0x00, 0xaf, 0x96, 0xf3, 0xfc, 0xc7, 0xcf, 0xa3, 0xde, 0x28, 0x96, 0x51, 0x1e, 0x58, 0x21,
+	0x1b, 0xdb, 0x95, 0x4f, 0x61, 0x84, 0x63, 0xba, 0xb8, 0xd8, 0x72, 0xca, 0x89, 0xb0, 0x9c, 0xd7,
+	0xee, 0xd9, 0xb3, 0x13, 0x37, 0x0f, 0xde, 0x90, 0xa9, 0xb7, 0x3d, 0xe4, 0x8e, 0x0c, 0xdd, 0x44,
+	0x7f, 0x82, 0x6e, 0x5f, 0xc3, 0x6b, 0x7e, 0xa5, 0xb7, 0x09, 0xb4, 0x5a, 0x65, 0xb1, 0x5f, 0xaa,
+	0xee, 0xa1, 0xfb, 0xe3, 0x58, 0x08, 0xe5, 0xa9, 0xb2, 0x49, 0xf8, 0x21, 0xcb, 0xa9, 0x5c, 0x8a,
+	0x6c, 0x97, 0x4d, 0xb0, 0x50, 0x9c, 0x97, 0x2d, 0xf3, 0x8b, 0x86, 0x0e, 0x41, 0xcc, 0x5b, 0x7a,
+	0xfd, 0xff, 0xe4, 0x3c, 0x45, 0x77, 0x73, 0x5a, 0x27, 0xe8, 0x4e, 0x4e, 0xff, 0x96, 0x64, 0x7e,
+	0x5e, 0x78, 0x7d, 0xce, 0xa8, 0xc8, 0xc7, 0x24, 0x5b, 0x09, 0xc0, 0x0b, 0x74, 0x0b, 0xb4, 0x56,
+	0x09, 0x34, 0x6b, 0x13, 0xf8, 0xcf, 0xac, 0x57, 0x0e, 0xac, 0xe6, 0xa0, 0xb9, 0x2e, 0x07, 0x1b,
+	0xab, 0x39, 0x70, 0x2e, 0xbf, 0xce, 0x0c, 0xed, 0x66, 0x66, 0x68, 0xbf, 0x66, 0x86, 0xf6, 0x69,
+	0x6e, 0x34, 0x6e, 0xe6, 0x46, 0xe3, 0xfb, 0xdc, 0x68, 0xbc, 0x3b, 0x59, 0xe7, 0xcd, 0xe4, 0xcf,
+	0xcf, 0x03, 0x9b, 0x82, 0x2d, 0xf8, 0x74, 0x67, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x81, 0xcf,
+	0xc9, 0x4e, 0xe7, 0x03, 0x00, 0x00,
 }
 
 func (m *EventSlashedFinalityProvider) Marshal() (dAtA []byte, err error) {
@@ -112,6 +399,13 @@ func (m *EventSlashedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExtractedBtcSkHex) > 0 {
+		i -= len(m.ExtractedBtcSkHex)
+		copy(dAtA[i:], m.ExtractedBtcSkHex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExtractedBtcSkHex)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Evidence != nil {
 		{
 			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
@@ -121,43 +415,784 @@ func (m *EventSlashedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, e
 			i -= size
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
-		i--
-		dAtA[i] = 0xa
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBlockFinalized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBlockFinalized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBlockFinalized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalPower != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VotedPower != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.VotedPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AppHash) > 0 {
+		i -= len(m.AppHash)
+		copy(dAtA[i:], m.AppHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.AppHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventJailedFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventJailedFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventJailedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.JailedHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JailedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUnjailedFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUnjailedFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUnjailedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnjailedHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.UnjailedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventConsumerBlockFinalized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventConsumerBlockFinalized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventConsumerBlockFinalized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalPower != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VotedPower != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.VotedPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventSlashedFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Evidence != nil {
+		l = m.Evidence.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExtractedBtcSkHex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBlockFinalized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	l = len(m.AppHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.VotedPower != 0 {
+		n += 1 + sovEvents(uint64(m.VotedPower))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovEvents(uint64(m.TotalPower))
+	}
+	return n
+}
+
+func (m *EventJailedFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JailedHeight != 0 {
+		n += 1 + sovEvents(uint64(m.JailedHeight))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovEvents(uint64(m.MissedBlocksCounter))
+	}
+	return n
+}
+
+func (m *EventUnjailedFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.UnjailedHeight != 0 {
+		n += 1 + sovEvents(uint64(m.UnjailedHeight))
+	}
+	return n
+}
+
+func (m *EventConsumerBlockFinalized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.VotedPower != 0 {
+		n += 1 + sovEvents(uint64(m.VotedPower))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovEvents(uint64(m.TotalPower))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventSlashedFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSlashedFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSlashedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Evidence == nil {
+				m.Evidence = &Evidence{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtractedBtcSkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtractedBtcSkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBlockFinalized) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBlockFinalized: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBlockFinalized: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppHash == nil {
+				m.AppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedPower", wireType)
+			}
+			m.VotedPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotedPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *EventSlashedFinalityProvider) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventJailedFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventJailedFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventJailedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedHeight", wireType)
+			}
+			m.JailedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	if m.Evidence != nil {
-		l = m.Evidence.Size()
-		n += 1 + l + sovEvents(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *EventUnjailedFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUnjailedFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUnjailedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailedHeight", wireType)
+			}
+			m.UnjailedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnjailedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventSlashedFinalityProvider) Unmarshal(dAtA []byte) error {
+func (m *EventConsumerBlockFinalized) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -180,15 +1215,15 @@ func (m *EventSlashedFinalityProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSlashedFinalityProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventConsumerBlockFinalized: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSlashedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventConsumerBlockFinalized: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -215,13 +1250,51 @@ func (m *EventSlashedFinalityProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Evidence == nil {
-				m.Evidence = &Evidence{}
+			if m.Block == nil {
+				m.Block = &ConsumerBlock{}
 			}
-			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedPower", wireType)
+			}
+			m.VotedPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotedPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
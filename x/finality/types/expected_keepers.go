@@ -4,19 +4,42 @@ import (
 	"context"
 
 	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
+	zctypes "github.com/babylonchain/babylon/x/zoneconcierge/types"
 )
 
 type BTCStakingKeeper interface {
 	GetParams(ctx context.Context) bstypes.Params
+	GetParamsByVersion(ctx context.Context, v uint32) *bstypes.Params
 	GetFinalityProvider(ctx context.Context, fpBTCPK []byte) (*bstypes.FinalityProvider, error)
 	HasFinalityProvider(ctx context.Context, fpBTCPK []byte) bool
-	SlashFinalityProvider(ctx context.Context, fpBTCPK []byte) error
+	SlashFinalityProvider(ctx context.Context, fpBTCPK []byte, infractionHeight uint64) error
+	JailFinalityProvider(ctx context.Context, fpBTCPK []byte) error
+	UnjailFinalityProvider(ctx context.Context, fpBTCPK []byte) error
 	GetVotingPower(ctx context.Context, fpBTCPK []byte, height uint64) uint64
 	GetVotingPowerTable(ctx context.Context, height uint64) map[string]uint64
+	HasConsumerFinalityProvider(ctx context.Context, consumerID string, fpBTCPK []byte) bool
+	GetConsumerVotingPowerTable(ctx context.Context, consumerID string, height uint64) map[string]uint64
 	GetBTCStakingActivatedHeight(ctx context.Context) (uint64, error)
 	GetVotingPowerDistCache(ctx context.Context, height uint64) (*bstypes.VotingPowerDistCache, error)
 	RemoveVotingPowerDistCache(ctx context.Context, height uint64)
 	GetLastFinalizedEpoch(ctx context.Context) uint64
+	GetBTCDelegation(ctx context.Context, stakingTxHashStr string) (*bstypes.BTCDelegation, error)
+	GetBTCDelegationStatus(ctx context.Context, btcDel *bstypes.BTCDelegation) bstypes.BTCDelegationStatus
+	GetStakingTxDepth(ctx context.Context, btcDel *bstypes.BTCDelegation) (uint64, bool)
+}
+
+// EpochingKeeper defines the expected interface needed to find the last
+// Babylon height checkpointed to BTC.
+type EpochingKeeper interface {
+	GetHistoricalEpoch(ctx context.Context, epochNumber uint64) (*epochingtypes.Epoch, error)
+}
+
+// ZoneConciergeKeeper defines the expected interface needed to look up the
+// registered consumer systems and their blocks indexed on Babylon.
+type ZoneConciergeKeeper interface {
+	HasConsumer(ctx context.Context, consumerID string) bool
+	GetHeader(ctx context.Context, chainID string, height uint64) (*zctypes.IndexedHeader, error)
 }
 
 // IncentiveKeeper defines the expected interface needed to distribute rewards.
@@ -2,9 +2,11 @@ package types
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 
 	"github.com/babylonchain/babylon/crypto/eots"
+	bbn "github.com/babylonchain/babylon/types"
 	"github.com/btcsuite/btcd/btcec/v2"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -42,8 +44,8 @@ func (e *Evidence) ValidateBasic() error {
 	if e.FpBtcPk == nil {
 		return fmt.Errorf("empty FpBtcPk")
 	}
-	if len(e.MasterPubRand) == 0 {
-		return fmt.Errorf("empty MasterPubRand")
+	if len(e.MasterPubRand) == 0 && e.PubRand == nil {
+		return fmt.Errorf("empty MasterPubRand and PubRand")
 	}
 	if len(e.CanonicalAppHash) != 32 {
 		return fmt.Errorf("malformed CanonicalAppHash")
@@ -67,7 +69,8 @@ func (e *Evidence) IsSlashable() bool {
 	return true
 }
 
-// ExtractBTCSK extracts the BTC SK given the data in the evidence
+// ExtractBTCSK extracts the BTC SK given the data in the evidence, and ensures
+// the extracted SK is the one of the finality provider
 func (e *Evidence) ExtractBTCSK() (*btcec.PrivateKey, error) {
 	if !e.IsSlashable() {
 		return nil, fmt.Errorf("the evidence lacks some fields so does not allow extracting BTC SK")
@@ -76,17 +79,45 @@ func (e *Evidence) ExtractBTCSK() (*btcec.PrivateKey, error) {
 	if err != nil {
 		return nil, err
 	}
-	mpr, err := eots.NewMasterPublicRandFromBase58(e.MasterPubRand)
+	pubRand, err := e.getPubRand()
 	if err != nil {
 		return nil, err
 	}
-	pubRand, err := mpr.DerivePubRand(uint32(e.BlockHeight))
-	if err != nil {
-		return nil, err
-	}
-	return eots.Extract(
+	btcSK, err := eots.Extract(
 		btcPK, pubRand,
 		e.canonicalMsgToSign(), e.CanonicalFinalitySig.ToModNScalar(), // msg and sig for canonical block
 		e.forkMsgToSign(), e.ForkFinalitySig.ToModNScalar(), // msg and sig for fork block
 	)
+	if err != nil {
+		return nil, err
+	}
+	// the SK is wrong if the two finality signatures do not use the same
+	// public randomness
+	if !bbn.NewBIP340PubKeyFromBTCPK(btcSK.PubKey()).Equals(e.FpBtcPk) {
+		return nil, fmt.Errorf("the extracted BTC SK does not match the finality provider's BTC PK")
+	}
+	return btcSK, nil
+}
+
+// ExtractBTCSKHex extracts the BTC SK given the data in the evidence, and
+// returns it as a hex str
+func (e *Evidence) ExtractBTCSKHex() (string, error) {
+	btcSK, err := e.ExtractBTCSK()
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(btcSK.Serialize()), nil
+}
+
+// getPubRand returns the public randomness of the evidence, either given in
+// the evidence or derived from the master public randomness
+func (e *Evidence) getPubRand() (*eots.PublicRand, error) {
+	if e.PubRand != nil {
+		return e.PubRand.ToFieldVal(), nil
+	}
+	mpr, err := eots.NewMasterPublicRandFromBase58(e.MasterPubRand)
+	if err != nil {
+		return nil, err
+	}
+	return mpr.DerivePubRand(uint32(e.BlockHeight))
 }
@@ -33,6 +33,20 @@ type IndexedBlock struct {
 	// finalized indicates whether the IndexedBlock is finalised by 2/3
 	// finality providers or not
 	Finalized bool `protobuf:"varint,3,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	// voted_power is the voting power of the finality providers that have
+	// submitted finality signatures for the block, recorded upon finalisation
+	VotedPower uint64 `protobuf:"varint,4,opt,name=voted_power,json=votedPower,proto3" json:"voted_power,omitempty"`
+	// total_power is the total voting power of the finality provider set at
+	// the block's height, recorded upon finalisation
+	TotalPower uint64 `protobuf:"varint,5,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// num_votes is the number of finality signatures of the block, recorded
+	// when they are pruned
+	NumVotes uint64 `protobuf:"varint,6,opt,name=num_votes,json=numVotes,proto3" json:"num_votes,omitempty"`
+	// votes_pruned_height is the Babylon height at which the finality
+	// signatures of the block are pruned. 0 means they are not pruned. The
+	// finality signatures can be queried from an archive node at
+	// votes_pruned_height - 1
+	VotesPrunedHeight uint64 `protobuf:"varint,7,opt,name=votes_pruned_height,json=votesPrunedHeight,proto3" json:"votes_pruned_height,omitempty"`
 }
 
 func (m *IndexedBlock) Reset()         { *m = IndexedBlock{} }
@@ -89,6 +103,34 @@ func (m *IndexedBlock) GetFinalized() bool {
 	return false
 }
 
+func (m *IndexedBlock) GetVotedPower() uint64 {
+	if m != nil {
+		return m.VotedPower
+	}
+	return 0
+}
+
+func (m *IndexedBlock) GetTotalPower() uint64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
+}
+
+func (m *IndexedBlock) GetNumVotes() uint64 {
+	if m != nil {
+		return m.NumVotes
+	}
+	return 0
+}
+
+func (m *IndexedBlock) GetVotesPrunedHeight() uint64 {
+	if m != nil {
+		return m.VotesPrunedHeight
+	}
+	return 0
+}
+
 // Evidence is the evidence that a finality provider has signed finality
 // signatures with correct public randomness on two conflicting Babylon headers
 type Evidence struct {
@@ -111,6 +153,14 @@ type Evidence struct {
 	// fork_finality_sig is the finality signature to the fork block
 	// where finality signature is an EOTS signature
 	ForkFinalitySig *github_com_babylonchain_babylon_types.SchnorrEOTSSig `protobuf:"bytes,7,opt,name=fork_finality_sig,json=forkFinalitySig,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrEOTSSig" json:"fork_finality_sig,omitempty"`
+	// pub_rand is the public randomness the finality provider has committed
+	// to at block_height, if the finality provider commits to public
+	// randomness via PubRandCommit rather than master_pub_rand
+	PubRand *github_com_babylonchain_babylon_types.SchnorrPubRand `protobuf:"bytes,8,opt,name=pub_rand,json=pubRand,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrPubRand" json:"pub_rand,omitempty"`
+	// consumer_id is the ID of the consumer system whose conflicting blocks
+	// the finality provider has signed, or empty if the conflicting blocks
+	// are Babylon blocks
+	ConsumerId string `protobuf:"bytes,9,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
 func (m *Evidence) Reset()         { *m = Evidence{} }
@@ -174,9 +224,289 @@ func (m *Evidence) GetForkAppHash() []byte {
 	return nil
 }
 
+func (m *Evidence) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+// PubRandCommit is a commitment to a list of public randomness of a finality
+// provider, one for each height in [start_height, start_height + num_pub_rand - 1]
+type PubRandCommit struct {
+	// start_height is the height of the first public randomness in the list
+	StartHeight uint64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// num_pub_rand is the number of public randomness in the list
+	NumPubRand uint64 `protobuf:"varint,2,opt,name=num_pub_rand,json=numPubRand,proto3" json:"num_pub_rand,omitempty"`
+	// commitment is the Merkle root of the list of public randomness
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (m *PubRandCommit) Reset()         { *m = PubRandCommit{} }
+func (m *PubRandCommit) String() string { return proto.CompactTextString(m) }
+func (*PubRandCommit) ProtoMessage()    {}
+func (*PubRandCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{2}
+}
+func (m *PubRandCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PubRandCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PubRandCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PubRandCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubRandCommit.Merge(m, src)
+}
+func (m *PubRandCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *PubRandCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubRandCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubRandCommit proto.InternalMessageInfo
+
+func (m *PubRandCommit) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *PubRandCommit) GetNumPubRand() uint64 {
+	if m != nil {
+		return m.NumPubRand
+	}
+	return 0
+}
+
+func (m *PubRandCommit) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+// FinalityProviderSigningInfo defines a finality provider's signing info for
+// monitoring its liveness
+type FinalityProviderSigningInfo struct {
+	// fp_btc_pk is the BTC PK of the finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// start_height is the height from which the liveness of the finality
+	// provider is tracked
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// missed_blocks_counter is the number of blocks the finality provider has
+	// missed in the current sliding window
+	MissedBlocksCounter uint64 `protobuf:"varint,3,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// last_active_height is the last height the finality provider has voted
+	// for, or 0 if it has not voted since start_height
+	LastActiveHeight uint64 `protobuf:"varint,4,opt,name=last_active_height,json=lastActiveHeight,proto3" json:"last_active_height,omitempty"`
+	// jailed_until_height is the height from which the finality provider
+	// can unjail itself, if it is jailed
+	JailedUntilHeight uint64 `protobuf:"varint,5,opt,name=jailed_until_height,json=jailedUntilHeight,proto3" json:"jailed_until_height,omitempty"`
+}
+
+func (m *FinalityProviderSigningInfo) Reset()         { *m = FinalityProviderSigningInfo{} }
+func (m *FinalityProviderSigningInfo) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderSigningInfo) ProtoMessage()    {}
+func (*FinalityProviderSigningInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{3}
+}
+func (m *FinalityProviderSigningInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderSigningInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderSigningInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProviderSigningInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderSigningInfo.Merge(m, src)
+}
+func (m *FinalityProviderSigningInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderSigningInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderSigningInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderSigningInfo proto.InternalMessageInfo
+
+func (m *FinalityProviderSigningInfo) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *FinalityProviderSigningInfo) GetMissedBlocksCounter() uint64 {
+	if m != nil {
+		return m.MissedBlocksCounter
+	}
+	return 0
+}
+
+func (m *FinalityProviderSigningInfo) GetLastActiveHeight() uint64 {
+	if m != nil {
+		return m.LastActiveHeight
+	}
+	return 0
+}
+
+func (m *FinalityProviderSigningInfo) GetJailedUntilHeight() uint64 {
+	if m != nil {
+		return m.JailedUntilHeight
+	}
+	return 0
+}
+
+// ConsumerVote is a vote of a finality provider on a block of a consumer
+// system
+type ConsumerVote struct {
+	// block_hash is the hash of the voted block
+	BlockHash []byte `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// finality_sig is the EOTS finality signature on the voted block
+	FinalitySig *github_com_babylonchain_babylon_types.SchnorrEOTSSig `protobuf:"bytes,2,opt,name=finality_sig,json=finalitySig,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrEOTSSig" json:"finality_sig,omitempty"`
+}
+
+func (m *ConsumerVote) Reset()         { *m = ConsumerVote{} }
+func (m *ConsumerVote) String() string { return proto.CompactTextString(m) }
+func (*ConsumerVote) ProtoMessage()    {}
+func (*ConsumerVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{4}
+}
+func (m *ConsumerVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerVote.Merge(m, src)
+}
+func (m *ConsumerVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerVote proto.InternalMessageInfo
+
+func (m *ConsumerVote) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+// ConsumerBlock is a block of a consumer system that is finalized by the
+// finality providers securing the consumer system
+type ConsumerBlock struct {
+	// consumer_id is the ID of the consumer system
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// height is the height of the block in the consumer system
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// block_hash is the hash of the block
+	BlockHash []byte `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// finalized indicates whether the block is finalized by 2/3 of the
+	// voting power of the finality providers securing the consumer system
+	Finalized bool `protobuf:"varint,4,opt,name=finalized,proto3" json:"finalized,omitempty"`
+}
+
+func (m *ConsumerBlock) Reset()         { *m = ConsumerBlock{} }
+func (m *ConsumerBlock) String() string { return proto.CompactTextString(m) }
+func (*ConsumerBlock) ProtoMessage()    {}
+func (*ConsumerBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{5}
+}
+func (m *ConsumerBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerBlock.Merge(m, src)
+}
+func (m *ConsumerBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerBlock proto.InternalMessageInfo
+
+func (m *ConsumerBlock) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *ConsumerBlock) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ConsumerBlock) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *ConsumerBlock) GetFinalized() bool {
+	if m != nil {
+		return m.Finalized
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*IndexedBlock)(nil), "babylon.finality.v1.IndexedBlock")
 	proto.RegisterType((*Evidence)(nil), "babylon.finality.v1.Evidence")
+	proto.RegisterType((*PubRandCommit)(nil), "babylon.finality.v1.PubRandCommit")
+	proto.RegisterType((*FinalityProviderSigningInfo)(nil), "babylon.finality.v1.FinalityProviderSigningInfo")
+	proto.RegisterType((*ConsumerVote)(nil), "babylon.finality.v1.ConsumerVote")
+	proto.RegisterType((*ConsumerBlock)(nil), "babylon.finality.v1.ConsumerBlock")
 }
 
 func init() {
@@ -184,34 +514,55 @@ func init() {
 }
 
 var fileDescriptor_ca5b87e52e3e6d02 = []byte{
-	// 427 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0x4d, 0x6f, 0xd3, 0x30,
-	0x18, 0x6e, 0xb6, 0xd1, 0x0f, 0xaf, 0xd3, 0xc0, 0x4c, 0x53, 0x41, 0x28, 0x2b, 0x3d, 0xa0, 0x1e,
-	0x50, 0xb3, 0xb1, 0x09, 0x71, 0x25, 0xd2, 0xd0, 0x06, 0x07, 0x2a, 0x97, 0x13, 0x17, 0xcb, 0x76,
-	0xdc, 0xd8, 0x6a, 0x67, 0x5b, 0x89, 0x5b, 0x2d, 0xfc, 0x0a, 0x7e, 0x16, 0xc7, 0x71, 0x43, 0x3b,
-	0x54, 0xa8, 0xfd, 0x23, 0x28, 0x4e, 0x9a, 0xc0, 0x09, 0x24, 0x6e, 0x7e, 0x9f, 0xf7, 0xd1, 0xf3,
-	0x21, 0xbf, 0x60, 0x40, 0x09, 0xcd, 0xe6, 0x5a, 0x05, 0x53, 0xa9, 0xc8, 0x5c, 0xda, 0x2c, 0x58,
-	0x9e, 0x55, 0xef, 0x91, 0x49, 0xb4, 0xd5, 0xf0, 0x71, 0xc9, 0x19, 0x55, 0xf8, 0xf2, 0xec, 0xe9,
-	0x51, 0xac, 0x63, 0xed, 0xf6, 0x41, 0xfe, 0x2a, 0xa8, 0x03, 0x0c, 0xba, 0xd7, 0x2a, 0xe2, 0xb7,
-	0x3c, 0x0a, 0xe7, 0x9a, 0xcd, 0xe0, 0x31, 0x68, 0x0a, 0x2e, 0x63, 0x61, 0x7b, 0x5e, 0xdf, 0x1b,
-	0xee, 0xa1, 0x72, 0x82, 0x4f, 0x40, 0x9b, 0x18, 0x83, 0x05, 0x49, 0x45, 0x6f, 0xa7, 0xef, 0x0d,
-	0xbb, 0xa8, 0x45, 0x8c, 0xb9, 0x22, 0xa9, 0x80, 0xcf, 0x40, 0xa7, 0xf0, 0xf9, 0xc2, 0xa3, 0xde,
-	0x6e, 0xdf, 0x1b, 0xb6, 0x51, 0x0d, 0x0c, 0xbe, 0xef, 0x82, 0xf6, 0xe5, 0x52, 0x46, 0x5c, 0x31,
-	0x0e, 0x11, 0xe8, 0x4c, 0x0d, 0xa6, 0x96, 0x61, 0x33, 0x73, 0x06, 0xdd, 0xf0, 0xf5, 0xfd, 0xea,
-	0xe4, 0x55, 0x2c, 0xad, 0x58, 0xd0, 0x11, 0xd3, 0x37, 0x41, 0x19, 0x9d, 0x09, 0x22, 0xd5, 0x76,
-	0x08, 0x6c, 0x66, 0x78, 0x3a, 0x0a, 0xaf, 0xc7, 0xe7, 0x17, 0xa7, 0xe3, 0x05, 0xfd, 0xc0, 0x33,
-	0xd4, 0x9a, 0x9a, 0xd0, 0xb2, 0xf1, 0x0c, 0x3e, 0x07, 0x5d, 0x9a, 0x47, 0xc7, 0x65, 0xee, 0x1d,
-	0x97, 0x7b, 0xdf, 0x61, 0x57, 0x45, 0xf8, 0x17, 0xe0, 0xf0, 0x86, 0xa4, 0x96, 0x27, 0xd8, 0x2c,
-	0x28, 0x4e, 0x88, 0x2a, 0x72, 0x76, 0xd0, 0x41, 0x01, 0x8f, 0x17, 0x14, 0x11, 0x15, 0xc1, 0x97,
-	0x00, 0x32, 0xa2, 0xb4, 0x92, 0x8c, 0xcc, 0x71, 0x55, 0x77, 0xcf, 0xd5, 0x7d, 0x58, 0x6d, 0xde,
-	0x96, 0xbd, 0x07, 0xe0, 0x60, 0xaa, 0x93, 0x59, 0x4d, 0x7c, 0xe0, 0x88, 0xfb, 0x39, 0xb8, 0xe5,
-	0x28, 0x70, 0x5c, 0x2b, 0x6e, 0x7f, 0x03, 0xa7, 0x32, 0xee, 0x35, 0x5d, 0xfb, 0x37, 0xf7, 0xab,
-	0x93, 0x8b, 0x7f, 0x6b, 0x3f, 0x61, 0x42, 0xe9, 0x24, 0xb9, 0xfc, 0xf8, 0x69, 0x32, 0x91, 0x31,
-	0x3a, 0xaa, 0x74, 0xdf, 0x95, 0xb2, 0x13, 0x19, 0xc3, 0x08, 0x3c, 0x72, 0x99, 0xfe, 0xb0, 0x6a,
-	0xfd, 0xa7, 0xd5, 0x61, 0x2e, 0xf9, 0x9b, 0x4b, 0xf8, 0xfe, 0xdb, 0xda, 0xf7, 0xee, 0xd6, 0xbe,
-	0xf7, 0x73, 0xed, 0x7b, 0x5f, 0x37, 0x7e, 0xe3, 0x6e, 0xe3, 0x37, 0x7e, 0x6c, 0xfc, 0xc6, 0xe7,
-	0xd3, 0xbf, 0x19, 0xdc, 0xd6, 0x77, 0xeb, 0xbc, 0x68, 0xd3, 0xdd, 0xe1, 0xf9, 0xaf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x12, 0x77, 0xc2, 0xaa, 0xd8, 0x02, 0x00, 0x00,
+	// 767 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0x36, 0x65, 0xd9, 0x92, 0x9e, 0x28, 0xd8, 0xa6, 0x5c, 0x43, 0xad, 0x5b, 0x49, 0xd5, 0x50,
+	0x78, 0x28, 0x24, 0xff, 0x42, 0xd1, 0xd5, 0x32, 0x5c, 0x58, 0xed, 0x50, 0x81, 0x6a, 0x3b, 0x34,
+	0xc3, 0xe1, 0x48, 0x9e, 0xc8, 0x8b, 0xc8, 0x3b, 0x82, 0x3c, 0x2a, 0x56, 0xf6, 0x2c, 0x99, 0xf2,
+	0x57, 0xe4, 0x5f, 0x49, 0x46, 0x8f, 0x81, 0x07, 0x23, 0xb0, 0xf7, 0xfc, 0x0d, 0xc1, 0x1d, 0x49,
+	0xc9, 0x52, 0x86, 0x04, 0x31, 0xb2, 0x91, 0xdf, 0xf7, 0xf1, 0xdd, 0x7b, 0xef, 0xbe, 0xf7, 0x08,
+	0x1d, 0x0b, 0x5b, 0x33, 0x9f, 0xb3, 0xde, 0x98, 0x32, 0xec, 0x53, 0x31, 0xeb, 0x4d, 0x8f, 0xe6,
+	0xcf, 0xdd, 0x30, 0xe2, 0x82, 0x1b, 0xf5, 0x4c, 0xd3, 0x9d, 0xe3, 0xd3, 0xa3, 0x1f, 0x76, 0x5d,
+	0xee, 0x72, 0xc5, 0xf7, 0xe4, 0x53, 0x2a, 0xed, 0x7c, 0xd0, 0x40, 0x1f, 0x30, 0x87, 0x5c, 0x11,
+	0xa7, 0xef, 0x73, 0x7b, 0x62, 0xec, 0xc1, 0xa6, 0x47, 0xa8, 0xeb, 0x89, 0x86, 0xd6, 0xd6, 0x0e,
+	0x8a, 0x66, 0xf6, 0x66, 0x7c, 0x0f, 0x65, 0x1c, 0x86, 0xc8, 0xc3, 0xb1, 0xd7, 0x28, 0xb4, 0xb5,
+	0x03, 0xdd, 0x2c, 0xe1, 0x30, 0xbc, 0xc4, 0xb1, 0x67, 0xfc, 0x08, 0x95, 0xf4, 0xa0, 0xe7, 0xc4,
+	0x69, 0xac, 0xb7, 0xb5, 0x83, 0xb2, 0xb9, 0x00, 0x8c, 0x16, 0x54, 0xa7, 0x5c, 0x10, 0x07, 0x85,
+	0xfc, 0x19, 0x89, 0x1a, 0x45, 0x15, 0x15, 0x14, 0x34, 0x94, 0x88, 0x14, 0x08, 0x2e, 0xb0, 0x9f,
+	0x09, 0x36, 0x52, 0x81, 0x82, 0x52, 0xc1, 0x3e, 0x54, 0x58, 0x12, 0x20, 0xf9, 0x49, 0xdc, 0xd8,
+	0x54, 0x74, 0x99, 0x25, 0xc1, 0x7f, 0xf2, 0xdd, 0xe8, 0x42, 0x5d, 0x11, 0x28, 0x8c, 0x12, 0x46,
+	0x1c, 0x94, 0x25, 0x5f, 0x52, 0xb2, 0x1d, 0x45, 0x0d, 0x15, 0x73, 0xa9, 0x88, 0xce, 0x9b, 0x22,
+	0x94, 0x2f, 0xa6, 0xd4, 0x21, 0xcc, 0x26, 0x86, 0x09, 0x95, 0x71, 0x88, 0x2c, 0x61, 0xa3, 0x70,
+	0xa2, 0xea, 0xd5, 0xfb, 0xbf, 0xdd, 0xdc, 0xb6, 0x8e, 0x5d, 0x2a, 0xbc, 0xc4, 0xea, 0xda, 0x3c,
+	0xe8, 0x65, 0xad, 0xb4, 0x3d, 0x4c, 0x59, 0xfe, 0xd2, 0x13, 0xb3, 0x90, 0xc4, 0xdd, 0xfe, 0x60,
+	0x78, 0x72, 0x7a, 0x38, 0x4c, 0xac, 0xbf, 0xc8, 0xcc, 0x2c, 0x8d, 0xc3, 0xbe, 0xb0, 0x87, 0x13,
+	0xe3, 0x67, 0xd0, 0x2d, 0xd9, 0xc9, 0x3c, 0x93, 0x82, 0xca, 0xa4, 0xaa, 0xb0, 0x34, 0x07, 0xe3,
+	0x17, 0xd8, 0x0a, 0x70, 0x2c, 0x48, 0x84, 0xc2, 0xc4, 0x42, 0x11, 0x66, 0x69, 0xdb, 0x2a, 0x66,
+	0x2d, 0x85, 0x87, 0x89, 0x65, 0x62, 0xe6, 0x18, 0xbf, 0x82, 0x61, 0x63, 0xc6, 0x19, 0xb5, 0xb1,
+	0x8f, 0xe6, 0xdd, 0x2f, 0xaa, 0xee, 0x6f, 0xcf, 0x99, 0xb3, 0xec, 0x1a, 0x3a, 0x50, 0x1b, 0xf3,
+	0x68, 0xb2, 0x10, 0x6e, 0x28, 0x61, 0x55, 0x82, 0xb9, 0x86, 0xc1, 0xde, 0x22, 0x62, 0xee, 0x0e,
+	0x14, 0x53, 0x57, 0xf5, 0x55, 0xef, 0xff, 0x7e, 0x73, 0xdb, 0x3a, 0xfd, 0xb2, 0xea, 0x47, 0xb6,
+	0xc7, 0x78, 0x14, 0x5d, 0xfc, 0xfd, 0xcf, 0x68, 0x44, 0x5d, 0x73, 0x77, 0x1e, 0xf7, 0x8f, 0x2c,
+	0xec, 0x88, 0xba, 0x86, 0x03, 0x3b, 0x2a, 0xa7, 0xa5, 0xa3, 0x4a, 0x8f, 0x3c, 0x6a, 0x4b, 0x86,
+	0x7c, 0x78, 0xca, 0x08, 0xca, 0xf3, 0x46, 0x96, 0xbf, 0x32, 0x78, 0xd6, 0x73, 0xb3, 0x14, 0x66,
+	0xcd, 0x6f, 0x41, 0xd5, 0xe6, 0x2c, 0x4e, 0x02, 0x12, 0x21, 0xea, 0x34, 0x2a, 0xea, 0x82, 0x20,
+	0x87, 0x06, 0x4e, 0x47, 0x40, 0x2d, 0xfb, 0xe8, 0x9c, 0x07, 0x01, 0x15, 0xf2, 0xe6, 0x63, 0x81,
+	0x23, 0x81, 0x96, 0x06, 0xa8, 0xaa, 0xb0, 0xec, 0xe6, 0xdb, 0xa0, 0x4b, 0x2b, 0xcf, 0xb3, 0x4d,
+	0xcd, 0x01, 0x2c, 0x09, 0xf2, 0x3b, 0x6f, 0x02, 0xd8, 0x2a, 0x5c, 0x40, 0x98, 0x50, 0xb6, 0xd0,
+	0xcd, 0x07, 0x48, 0xe7, 0x75, 0x01, 0xf6, 0xf3, 0xda, 0x87, 0x11, 0x97, 0x4e, 0x8e, 0x46, 0xd4,
+	0x65, 0x94, 0xb9, 0x03, 0x36, 0xe6, 0xdf, 0xca, 0xd2, 0x4b, 0x85, 0x15, 0x3e, 0x2d, 0xec, 0x18,
+	0xbe, 0x0b, 0x68, 0x1c, 0x13, 0x07, 0x29, 0xa3, 0xc7, 0xc8, 0xe6, 0x09, 0x13, 0x24, 0x52, 0x15,
+	0x14, 0xcd, 0x7a, 0x4a, 0xaa, 0x15, 0x13, 0x9f, 0xa7, 0x94, 0xb4, 0xb7, 0x8f, 0x63, 0x81, 0xb0,
+	0x2d, 0xe8, 0x94, 0xe4, 0xc1, 0xd3, 0x05, 0xb1, 0x2d, 0x99, 0x33, 0x45, 0x64, 0x27, 0x74, 0xa1,
+	0xfe, 0x14, 0x53, 0x9f, 0x38, 0x28, 0x61, 0x82, 0xfa, 0xb9, 0x3c, 0x5d, 0x17, 0x3b, 0x29, 0xf5,
+	0xaf, 0x64, 0xb2, 0x41, 0x7f, 0xa9, 0x81, 0x7e, 0x9e, 0xdd, 0x96, 0x5c, 0x15, 0xc6, 0x4f, 0x00,
+	0xd9, 0x60, 0xca, 0xe1, 0x50, 0xad, 0x31, 0x2b, 0xe9, 0x58, 0xca, 0xd1, 0x78, 0x02, 0xfa, 0x92,
+	0x4b, 0x0b, 0x8f, 0x74, 0x69, 0x75, 0xbc, 0x70, 0x68, 0xe7, 0x85, 0x06, 0xb5, 0x3c, 0x99, 0x74,
+	0xcf, 0xae, 0xd8, 0x4b, 0x5b, 0xb5, 0xd7, 0x83, 0x45, 0x5c, 0x58, 0x5a, 0xc4, 0xcb, 0x65, 0xac,
+	0xaf, 0x96, 0xb1, 0xb4, 0x8c, 0x8b, 0x2b, 0xcb, 0xb8, 0xff, 0xe7, 0xdb, 0xbb, 0xa6, 0x76, 0x7d,
+	0xd7, 0xd4, 0xde, 0xdf, 0x35, 0xb5, 0x57, 0xf7, 0xcd, 0xb5, 0xeb, 0xfb, 0xe6, 0xda, 0xbb, 0xfb,
+	0xe6, 0xda, 0xff, 0x87, 0x9f, 0x2b, 0xf2, 0x6a, 0xf1, 0xc7, 0x51, 0xf5, 0x5a, 0x9b, 0xea, 0x0f,
+	0x72, 0xf2, 0x31, 0x00, 0x00, 0xff, 0xff, 0x80, 0xe8, 0xda, 0x7f, 0x92, 0x06, 0x00, 0x00,
 }
 
 func (m *IndexedBlock) Marshal() (dAtA []byte, err error) {
@@ -234,6 +585,26 @@ func (m *IndexedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VotesPrunedHeight != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.VotesPrunedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NumVotes != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.NumVotes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TotalPower != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.VotedPower != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.VotedPower))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Finalized {
 		i--
 		if m.Finalized {
@@ -279,6 +650,25 @@ func (m *Evidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintFinality(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.PubRand != nil {
+		{
+			size := m.PubRand.Size()
+			i -= size
+			if _, err := m.PubRand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.ForkFinalitySig != nil {
 		{
 			size := m.ForkFinalitySig.Size()
@@ -344,50 +734,251 @@ func (m *Evidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintFinality(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFinality(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PubRandCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *IndexedBlock) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PubRandCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubRandCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovFinality(uint64(m.Height))
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintFinality(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.AppHash)
-	if l > 0 {
-		n += 1 + l + sovFinality(uint64(l))
+	if m.NumPubRand != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.NumPubRand))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Finalized {
-		n += 2
+	if m.StartHeight != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Evidence) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FinalityProviderSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.FpBtcPk != nil {
-		l = m.FpBtcPk.Size()
-		n += 1 + l + sovFinality(uint64(l))
+	if m.JailedUntilHeight != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.JailedUntilHeight))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovFinality(uint64(m.BlockHeight))
+	if m.LastActiveHeight != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.LastActiveHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.MasterPubRand)
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalitySig != nil {
+		{
+			size := m.FinalitySig.Size()
+			i -= size
+			if _, err := m.FinalitySig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintFinality(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintFinality(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintFinality(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFinality(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFinality(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IndexedBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFinality(uint64(m.Height))
+	}
+	l = len(m.AppHash)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.Finalized {
+		n += 2
+	}
+	if m.VotedPower != 0 {
+		n += 1 + sovFinality(uint64(m.VotedPower))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovFinality(uint64(m.TotalPower))
+	}
+	if m.NumVotes != 0 {
+		n += 1 + sovFinality(uint64(m.NumVotes))
+	}
+	if m.VotesPrunedHeight != 0 {
+		n += 1 + sovFinality(uint64(m.VotesPrunedHeight))
+	}
+	return n
+}
+
+func (m *Evidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovFinality(uint64(m.BlockHeight))
+	}
+	l = len(m.MasterPubRand)
 	if l > 0 {
 		n += 1 + l + sovFinality(uint64(l))
 	}
@@ -407,6 +998,98 @@ func (m *Evidence) Size() (n int) {
 		l = m.ForkFinalitySig.Size()
 		n += 1 + l + sovFinality(uint64(l))
 	}
+	if m.PubRand != nil {
+		l = m.PubRand.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	return n
+}
+
+func (m *PubRandCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovFinality(uint64(m.StartHeight))
+	}
+	if m.NumPubRand != 0 {
+		n += 1 + sovFinality(uint64(m.NumPubRand))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	return n
+}
+
+func (m *FinalityProviderSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovFinality(uint64(m.StartHeight))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovFinality(uint64(m.MissedBlocksCounter))
+	}
+	if m.LastActiveHeight != 0 {
+		n += 1 + sovFinality(uint64(m.LastActiveHeight))
+	}
+	if m.JailedUntilHeight != 0 {
+		n += 1 + sovFinality(uint64(m.JailedUntilHeight))
+	}
+	return n
+}
+
+func (m *ConsumerVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.FinalitySig != nil {
+		l = m.FinalitySig.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovFinality(uint64(m.Height))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.Finalized {
+		n += 2
+	}
 	return n
 }
 
@@ -447,9 +1130,568 @@ func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppHash == nil {
+				m.AppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedPower", wireType)
+			}
+			m.VotedPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotedPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumVotes", wireType)
+			}
+			m.NumVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotesPrunedHeight", wireType)
+			}
+			m.VotesPrunedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotesPrunedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Evidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Evidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Evidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterPubRand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MasterPubRand = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalAppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanonicalAppHash = append(m.CanonicalAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CanonicalAppHash == nil {
+				m.CanonicalAppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkAppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForkAppHash = append(m.ForkAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ForkAppHash == nil {
+				m.ForkAppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalFinalitySig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
+			m.CanonicalFinalitySig = &v
+			if err := m.CanonicalFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkFinalitySig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
+			m.ForkFinalitySig = &v
+			if err := m.ForkFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrPubRand
+			m.PubRand = &v
+			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubRandCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubRandCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPubRand", wireType)
 			}
-			m.Height = 0
+			m.NumPubRand = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -459,14 +1701,14 @@ func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.NumPubRand |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -493,31 +1735,11 @@ func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.AppHash == nil {
-				m.AppHash = []byte{}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFinality
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Finalized = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFinality(dAtA[iNdEx:])
@@ -539,7 +1761,7 @@ func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Evidence) Unmarshal(dAtA []byte) error {
+func (m *FinalityProviderSigningInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -562,10 +1784,10 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Evidence: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalityProviderSigningInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Evidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalityProviderSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -605,9 +1827,9 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			m.BlockHeight = 0
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -617,16 +1839,16 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				m.StartHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterPubRand", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
 			}
-			var stringLen uint64
+			m.MissedBlocksCounter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -636,27 +1858,102 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MissedBlocksCounter |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFinality
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActiveHeight", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			m.LastActiveHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastActiveHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntilHeight", wireType)
+			}
+			m.JailedUntilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntilHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFinality
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterPubRand = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalAppHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -683,14 +1980,14 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CanonicalAppHash = append(m.CanonicalAppHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.CanonicalAppHash == nil {
-				m.CanonicalAppHash = []byte{}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForkAppHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalitySig", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -717,16 +2014,67 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForkAppHash = append(m.ForkAppHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.ForkAppHash == nil {
-				m.ForkAppHash = []byte{}
+			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
+			m.FinalitySig = &v
+			if err := m.FinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalFinalitySig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -736,30 +2084,46 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFinality
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFinality
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
-			m.CanonicalFinalitySig = &v
-			if err := m.CanonicalFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForkFinalitySig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -786,12 +2150,31 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
-			m.ForkFinalitySig = &v
-			if err := m.ForkFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFinality(dAtA[iNdEx:])
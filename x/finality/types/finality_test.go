@@ -0,0 +1,42 @@
+package types_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/babylonchain/babylon/crypto/eots"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzEvidence_ExtractBTCSK(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		sk, err := eots.KeyGen(r)
+		require.NoError(t, err)
+		blockHeight := datagen.RandomInt(r, 100) + 1
+
+		// the BTC SK is extracted from the evidence
+		evidence, err := datagen.GenRandomEvidence(r, sk, blockHeight)
+		require.NoError(t, err)
+		extractedSK, err := evidence.ExtractBTCSK()
+		require.NoError(t, err)
+		require.True(t, evidence.FpBtcPk.Equals(bbn.NewBIP340PubKeyFromBTCPK(extractedSK.PubKey())))
+
+		// the extraction fails rather than returning a wrong SK if the fork
+		// finality signature uses another public randomness
+		otherSR, _, err := eots.RandGen(r)
+		require.NoError(t, err)
+		forkMsg := append(sdk.Uint64ToBigEndian(blockHeight), evidence.ForkAppHash...)
+		forkSig, err := eots.Sign(sk, otherSR, forkMsg)
+		require.NoError(t, err)
+		evidence.ForkFinalitySig = bbn.NewSchnorrEOTSSigFromModNScalar(forkSig)
+		_, err = evidence.ExtractBTCSK()
+		require.Error(t, err)
+	})
+}
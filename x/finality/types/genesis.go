@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -10,6 +12,64 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	// TODO: add validate to IndexedBlocks, Evidences, VoteSigs, PublicRandomness
+	// TODO: add validate to IndexedBlocks, Evidences, VoteSigs
+	for _, prc := range gs.PubRandCommits {
+		if prc.FpBtcPk == nil {
+			return fmt.Errorf("empty finality provider BTC PK in public randomness commitment")
+		}
+		if prc.PubRandCommit == nil {
+			return fmt.Errorf("empty public randomness commitment of finality provider %s", prc.FpBtcPk.MarshalHex())
+		}
+		if err := prc.PubRandCommit.Validate(); err != nil {
+			return fmt.Errorf("invalid public randomness commitment of finality provider %s: %w", prc.FpBtcPk.MarshalHex(), err)
+		}
+	}
+	for _, info := range gs.SigningInfos {
+		if info.FpBtcPk == nil {
+			return fmt.Errorf("empty finality provider BTC PK in signing info")
+		}
+	}
+	for _, mb := range gs.MissedBlocks {
+		if mb.FpBtcPk == nil {
+			return fmt.Errorf("empty finality provider BTC PK in missed blocks")
+		}
+		for _, index := range mb.MissedBlockIndexes {
+			if index >= gs.Params.SignedBlocksWindow {
+				return fmt.Errorf("missed block index %d of finality provider %s is out of the signed blocks window", index, mb.FpBtcPk.MarshalHex())
+			}
+		}
+	}
+	if err := gs.validateConsumers(); err != nil {
+		return err
+	}
 	return gs.Params.Validate()
 }
+
+// validateConsumers validates the public randomness commitments and finalized
+// blocks of consumer systems. The consumer systems themselves are registered
+// in zoneconcierge, and their finality providers in btcstaking.
+func (gs GenesisState) validateConsumers() error {
+	for _, cprc := range gs.ConsumerPubRandCommits {
+		if err := ValidateConsumerID(cprc.ConsumerId); err != nil {
+			return err
+		}
+		if cprc.FpBtcPk == nil {
+			return fmt.Errorf("empty finality provider BTC PK in public randomness commitment of consumer %s", cprc.ConsumerId)
+		}
+		if cprc.PubRandCommit == nil {
+			return fmt.Errorf("empty public randomness commitment of finality provider %s", cprc.FpBtcPk.MarshalHex())
+		}
+		if err := cprc.PubRandCommit.Validate(); err != nil {
+			return fmt.Errorf("invalid public randomness commitment of finality provider %s: %w", cprc.FpBtcPk.MarshalHex(), err)
+		}
+	}
+	for _, block := range gs.ConsumerBlocks {
+		if err := ValidateConsumerID(block.ConsumerId); err != nil {
+			return err
+		}
+		if !block.Finalized {
+			return fmt.Errorf("non-finalized block at height %d of consumer %s", block.Height, block.ConsumerId)
+		}
+	}
+	return nil
+}
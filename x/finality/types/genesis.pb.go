@@ -34,6 +34,21 @@ type GenesisState struct {
 	Evidences []*Evidence `protobuf:"bytes,3,rep,name=evidences,proto3" json:"evidences,omitempty"`
 	// votes_sigs contains all the votes of finality providers ever registered.
 	VoteSigs []*VoteSig `protobuf:"bytes,4,rep,name=vote_sigs,json=voteSigs,proto3" json:"vote_sigs,omitempty"`
+	// pub_rand_commits contains the public randomness commitments of all
+	// finality providers
+	PubRandCommits []*FPPubRandCommit `protobuf:"bytes,5,rep,name=pub_rand_commits,json=pubRandCommits,proto3" json:"pub_rand_commits,omitempty"`
+	// signing_infos contains the signing infos of all finality providers
+	SigningInfos []*FinalityProviderSigningInfo `protobuf:"bytes,6,rep,name=signing_infos,json=signingInfos,proto3" json:"signing_infos,omitempty"`
+	// missed_blocks contains the missed blocks bitmaps of all finality providers
+	MissedBlocks []*FinalityProviderMissedBlocks `protobuf:"bytes,7,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks,omitempty"`
+	// consumer_pub_rand_commits contains the public randomness commitments of
+	// finality providers for consumer systems
+	ConsumerPubRandCommits []*ConsumerPubRandCommit `protobuf:"bytes,10,rep,name=consumer_pub_rand_commits,json=consumerPubRandCommits,proto3" json:"consumer_pub_rand_commits,omitempty"`
+	// consumer_blocks contains the finalized blocks of consumer systems
+	ConsumerBlocks []*ConsumerBlock `protobuf:"bytes,11,rep,name=consumer_blocks,json=consumerBlocks,proto3" json:"consumer_blocks,omitempty"`
+	// activated_height is the Babylon height at which the finality gadget
+	// activated. It is 0 if the finality gadget has not been activated
+	ActivatedHeight uint64 `protobuf:"varint,12,opt,name=activated_height,json=activatedHeight,proto3" json:"activated_height,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -97,6 +112,204 @@ func (m *GenesisState) GetVoteSigs() []*VoteSig {
 	return nil
 }
 
+func (m *GenesisState) GetPubRandCommits() []*FPPubRandCommit {
+	if m != nil {
+		return m.PubRandCommits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSigningInfos() []*FinalityProviderSigningInfo {
+	if m != nil {
+		return m.SigningInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMissedBlocks() []*FinalityProviderMissedBlocks {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsumerPubRandCommits() []*ConsumerPubRandCommit {
+	if m != nil {
+		return m.ConsumerPubRandCommits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsumerBlocks() []*ConsumerBlock {
+	if m != nil {
+		return m.ConsumerBlocks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetActivatedHeight() uint64 {
+	if m != nil {
+		return m.ActivatedHeight
+	}
+	return 0
+}
+
+// ConsumerPubRandCommit is a public randomness commitment of a finality
+// provider for a consumer system
+type ConsumerPubRandCommit struct {
+	// consumer_id is the ID of the consumer system
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// fp_btc_pk is the BTC PK of the finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// pub_rand_commit is the public randomness commitment
+	PubRandCommit *PubRandCommit `protobuf:"bytes,3,opt,name=pub_rand_commit,json=pubRandCommit,proto3" json:"pub_rand_commit,omitempty"`
+}
+
+func (m *ConsumerPubRandCommit) Reset()         { *m = ConsumerPubRandCommit{} }
+func (m *ConsumerPubRandCommit) String() string { return proto.CompactTextString(m) }
+func (*ConsumerPubRandCommit) ProtoMessage()    {}
+func (*ConsumerPubRandCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52dc577f74d797d1, []int{1}
+}
+func (m *ConsumerPubRandCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerPubRandCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerPubRandCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerPubRandCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerPubRandCommit.Merge(m, src)
+}
+func (m *ConsumerPubRandCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerPubRandCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerPubRandCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerPubRandCommit proto.InternalMessageInfo
+
+func (m *ConsumerPubRandCommit) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *ConsumerPubRandCommit) GetPubRandCommit() *PubRandCommit {
+	if m != nil {
+		return m.PubRandCommit
+	}
+	return nil
+}
+
+// FinalityProviderMissedBlocks contains the missed blocks bitmap of a finality
+// provider in the current sliding window
+type FinalityProviderMissedBlocks struct {
+	// fp_btc_pk is the BTC PK of the finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// missed_block_indexes are the indexes in the sliding window of the blocks
+	// the finality provider has missed
+	MissedBlockIndexes []uint64 `protobuf:"varint,2,rep,packed,name=missed_block_indexes,json=missedBlockIndexes,proto3" json:"missed_block_indexes,omitempty"`
+}
+
+func (m *FinalityProviderMissedBlocks) Reset()         { *m = FinalityProviderMissedBlocks{} }
+func (m *FinalityProviderMissedBlocks) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderMissedBlocks) ProtoMessage()    {}
+func (*FinalityProviderMissedBlocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52dc577f74d797d1, []int{2}
+}
+func (m *FinalityProviderMissedBlocks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderMissedBlocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderMissedBlocks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProviderMissedBlocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderMissedBlocks.Merge(m, src)
+}
+func (m *FinalityProviderMissedBlocks) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderMissedBlocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderMissedBlocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderMissedBlocks proto.InternalMessageInfo
+
+func (m *FinalityProviderMissedBlocks) GetMissedBlockIndexes() []uint64 {
+	if m != nil {
+		return m.MissedBlockIndexes
+	}
+	return nil
+}
+
+// FPPubRandCommit is a public randomness commitment of a finality provider
+type FPPubRandCommit struct {
+	// fp_btc_pk is the BTC PK of the finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// pub_rand_commit is the public randomness commitment
+	PubRandCommit *PubRandCommit `protobuf:"bytes,2,opt,name=pub_rand_commit,json=pubRandCommit,proto3" json:"pub_rand_commit,omitempty"`
+}
+
+func (m *FPPubRandCommit) Reset()         { *m = FPPubRandCommit{} }
+func (m *FPPubRandCommit) String() string { return proto.CompactTextString(m) }
+func (*FPPubRandCommit) ProtoMessage()    {}
+func (*FPPubRandCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52dc577f74d797d1, []int{3}
+}
+func (m *FPPubRandCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FPPubRandCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FPPubRandCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FPPubRandCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FPPubRandCommit.Merge(m, src)
+}
+func (m *FPPubRandCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *FPPubRandCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_FPPubRandCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FPPubRandCommit proto.InternalMessageInfo
+
+func (m *FPPubRandCommit) GetPubRandCommit() *PubRandCommit {
+	if m != nil {
+		return m.PubRandCommit
+	}
+	return nil
+}
+
 // VoteSig the vote of an finality provider
 // with the block of the vote, the finality provider btc public key and the vote signature.
 type VoteSig struct {
@@ -113,7 +326,7 @@ func (m *VoteSig) Reset()         { *m = VoteSig{} }
 func (m *VoteSig) String() string { return proto.CompactTextString(m) }
 func (*VoteSig) ProtoMessage()    {}
 func (*VoteSig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52dc577f74d797d1, []int{1}
+	return fileDescriptor_52dc577f74d797d1, []int{4}
 }
 func (m *VoteSig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -151,40 +364,60 @@ func (m *VoteSig) GetBlockHeight() uint64 {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "babylon.finality.v1.GenesisState")
+	proto.RegisterType((*ConsumerPubRandCommit)(nil), "babylon.finality.v1.ConsumerPubRandCommit")
+	proto.RegisterType((*FinalityProviderMissedBlocks)(nil), "babylon.finality.v1.FinalityProviderMissedBlocks")
+	proto.RegisterType((*FPPubRandCommit)(nil), "babylon.finality.v1.FPPubRandCommit")
 	proto.RegisterType((*VoteSig)(nil), "babylon.finality.v1.VoteSig")
 }
 
 func init() { proto.RegisterFile("babylon/finality/v1/genesis.proto", fileDescriptor_52dc577f74d797d1) }
 
 var fileDescriptor_52dc577f74d797d1 = []byte{
-	// 421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x8f, 0x93, 0x40,
-	0x14, 0x80, 0xa1, 0x6d, 0x76, 0xed, 0x80, 0x1e, 0x46, 0x0f, 0xa4, 0x2a, 0xa5, 0x3d, 0xf5, 0x04,
-	0xbb, 0xdd, 0x8d, 0x71, 0xe3, 0x8d, 0x64, 0xe3, 0xae, 0x1e, 0x24, 0x60, 0x3c, 0xe8, 0x81, 0x00,
-	0x9d, 0x0e, 0x93, 0x76, 0x19, 0xc2, 0x4c, 0x49, 0xf9, 0x0d, 0x5e, 0xfc, 0x59, 0x3d, 0xf6, 0x68,
-	0x9a, 0xd8, 0x98, 0xf6, 0x8f, 0x18, 0x06, 0xb0, 0x1e, 0x48, 0xdc, 0xdb, 0xbc, 0xc7, 0xf7, 0xbe,
-	0xf7, 0x1e, 0x79, 0x60, 0x14, 0x06, 0x61, 0xb1, 0xa4, 0x89, 0x35, 0x27, 0x49, 0xb0, 0x24, 0xbc,
-	0xb0, 0xf2, 0x4b, 0x0b, 0xa3, 0x04, 0x31, 0xc2, 0xcc, 0x34, 0xa3, 0x9c, 0xc2, 0xe7, 0x35, 0x62,
-	0x36, 0x88, 0x99, 0x5f, 0x0e, 0x5e, 0x60, 0x8a, 0xa9, 0xf8, 0x6e, 0x95, 0xaf, 0x0a, 0x1d, 0x18,
-	0x6d, 0xb6, 0x34, 0xc8, 0x82, 0x87, 0x5a, 0x36, 0x18, 0xb7, 0x11, 0x7f, 0xc5, 0x82, 0x19, 0x7f,
-	0xef, 0x00, 0xf5, 0x7d, 0x35, 0x82, 0xc7, 0x03, 0x8e, 0xe0, 0x0d, 0x38, 0xab, 0x24, 0x9a, 0x6c,
-	0xc8, 0x13, 0x65, 0xfa, 0xd2, 0x6c, 0x19, 0xc9, 0x74, 0x04, 0x62, 0xf7, 0x36, 0xfb, 0xa1, 0xe4,
-	0xd6, 0x05, 0xf0, 0x0e, 0x3c, 0x23, 0xc9, 0x0c, 0xad, 0xd1, 0xcc, 0x0f, 0x97, 0x34, 0x5a, 0x30,
-	0xad, 0x63, 0x74, 0x27, 0xca, 0x74, 0xd4, 0xaa, 0xb8, 0xaf, 0x50, 0xbb, 0x24, 0xdd, 0xa7, 0xe4,
-	0x9f, 0x88, 0xc1, 0x77, 0xa0, 0x8f, 0x72, 0x32, 0x43, 0x49, 0x84, 0x98, 0xd6, 0x15, 0x92, 0xd7,
-	0xad, 0x92, 0xdb, 0x9a, 0x72, 0x4f, 0x3c, 0xbc, 0x01, 0xfd, 0x9c, 0x72, 0xe4, 0x33, 0x82, 0x99,
-	0xd6, 0x13, 0xc5, 0xaf, 0x5a, 0x8b, 0xbf, 0x50, 0x8e, 0x3c, 0x82, 0xdd, 0x27, 0x79, 0xf5, 0x60,
-	0xe3, 0x5f, 0x32, 0x38, 0xaf, 0xb3, 0x70, 0x04, 0x54, 0xb1, 0x85, 0x1f, 0x23, 0x82, 0x63, 0x2e,
-	0x7e, 0x47, 0xcf, 0x55, 0x44, 0xee, 0x4e, 0xa4, 0xa0, 0x0b, 0xfa, 0xf3, 0xd4, 0x0f, 0x79, 0xe4,
-	0xa7, 0x0b, 0xad, 0x63, 0xc8, 0x13, 0xd5, 0x7e, 0xb3, 0xdb, 0x0f, 0xa7, 0x98, 0xf0, 0x78, 0x15,
-	0x9a, 0x11, 0x7d, 0xb0, 0xea, 0xbe, 0x51, 0x1c, 0x90, 0xa4, 0x09, 0x2c, 0x5e, 0xa4, 0x88, 0x99,
-	0xf6, 0xbd, 0x73, 0x75, 0x7d, 0xe1, 0xac, 0xc2, 0x8f, 0xa8, 0x70, 0xcf, 0xe7, 0xa9, 0xcd, 0x23,
-	0x67, 0x01, 0xbf, 0x01, 0xb5, 0x99, 0xb1, 0xdc, 0x40, 0xeb, 0x0a, 0xed, 0xdb, 0xdd, 0x7e, 0x78,
-	0xfd, 0x38, 0xad, 0x17, 0xc5, 0x09, 0xcd, 0xb2, 0xdb, 0x4f, 0x9f, 0xbd, 0x72, 0x39, 0xa5, 0xb1,
-	0x79, 0x04, 0xdb, 0x1f, 0x36, 0x07, 0x5d, 0xde, 0x1e, 0x74, 0xf9, 0xf7, 0x41, 0x97, 0x7f, 0x1c,
-	0x75, 0x69, 0x7b, 0xd4, 0xa5, 0x9f, 0x47, 0x5d, 0xfa, 0x7a, 0xf1, 0x3f, 0xf9, 0xfa, 0x74, 0x45,
-	0xa2, 0x4f, 0x78, 0x26, 0x0e, 0xe8, 0xea, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9b, 0x01, 0x09,
-	0x37, 0xd6, 0x02, 0x00, 0x00,
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc7, 0x33, 0x10, 0x3e, 0x32, 0x71, 0x08, 0x9a, 0xcb, 0xbd, 0xf2, 0xe5, 0x72, 0x93, 0x10,
+	0x75, 0x91, 0x76, 0xe1, 0x84, 0x0f, 0x55, 0x45, 0xdd, 0x19, 0xd1, 0x12, 0x50, 0x5b, 0xcb, 0x69,
+	0x59, 0xb4, 0x0b, 0xcb, 0x1f, 0x13, 0x67, 0x04, 0x9e, 0xb1, 0x3c, 0x93, 0x88, 0xbc, 0x45, 0x1f,
+	0xa2, 0x2f, 0xd0, 0xb7, 0x60, 0xc9, 0x12, 0x21, 0x15, 0x55, 0xf0, 0x00, 0x7d, 0x85, 0x2a, 0x63,
+	0xe7, 0x8b, 0xba, 0x14, 0x55, 0x65, 0x37, 0x67, 0xf2, 0x3f, 0xbf, 0x39, 0xe7, 0xe4, 0x3f, 0x63,
+	0xb8, 0xee, 0xd8, 0x4e, 0xff, 0x84, 0xd1, 0x7a, 0x9b, 0x50, 0xfb, 0x84, 0x88, 0x7e, 0xbd, 0xb7,
+	0x51, 0xf7, 0x31, 0xc5, 0x9c, 0x70, 0x2d, 0x8c, 0x98, 0x60, 0xe8, 0xaf, 0x44, 0xa2, 0x0d, 0x25,
+	0x5a, 0x6f, 0x63, 0x75, 0xc5, 0x67, 0x3e, 0x93, 0xbf, 0xd7, 0x07, 0xab, 0x58, 0xba, 0x5a, 0x49,
+	0xa3, 0x85, 0x76, 0x64, 0x07, 0x09, 0x6c, 0xb5, 0x9a, 0xa6, 0x18, 0x81, 0xa5, 0xa6, 0xfa, 0x6d,
+	0x0e, 0x2a, 0x2f, 0xe3, 0x12, 0x5a, 0xc2, 0x16, 0x18, 0xed, 0xc0, 0xf9, 0x18, 0xa2, 0x82, 0x0a,
+	0xa8, 0xe5, 0x37, 0xff, 0xd3, 0x52, 0x4a, 0xd2, 0x0c, 0x29, 0xd1, 0xb3, 0x67, 0x57, 0xe5, 0x8c,
+	0x99, 0x24, 0xa0, 0x7d, 0xb8, 0x44, 0xa8, 0x87, 0x4f, 0xb1, 0x67, 0x39, 0x27, 0xcc, 0x3d, 0xe6,
+	0xea, 0x4c, 0x65, 0xb6, 0x96, 0xdf, 0x5c, 0x4f, 0x45, 0x34, 0x63, 0xa9, 0x3e, 0x50, 0x9a, 0x05,
+	0x32, 0x11, 0x71, 0xf4, 0x1c, 0xe6, 0x70, 0x8f, 0x78, 0x98, 0xba, 0x98, 0xab, 0xb3, 0x12, 0xf2,
+	0x7f, 0x2a, 0x64, 0x2f, 0x51, 0x99, 0x63, 0x3d, 0xda, 0x81, 0xb9, 0x1e, 0x13, 0xd8, 0xe2, 0xc4,
+	0xe7, 0x6a, 0x56, 0x26, 0xaf, 0xa5, 0x26, 0x1f, 0x31, 0x81, 0x5b, 0xc4, 0x37, 0x17, 0x7b, 0xf1,
+	0x82, 0xa3, 0xd7, 0x70, 0x39, 0xec, 0x3a, 0x56, 0x64, 0x53, 0xcf, 0x72, 0x59, 0x10, 0x10, 0xc1,
+	0xd5, 0x39, 0x49, 0x78, 0x94, 0x4a, 0x78, 0x61, 0x18, 0x5d, 0xc7, 0xb4, 0xa9, 0xb7, 0x2b, 0xc5,
+	0xe6, 0x52, 0x38, 0x19, 0x72, 0xf4, 0x0e, 0x16, 0x38, 0xf1, 0x29, 0xa1, 0xbe, 0x45, 0x68, 0x9b,
+	0x71, 0x75, 0x5e, 0xc2, 0x1a, 0xe9, 0xb0, 0x64, 0x6d, 0x44, 0x6c, 0xd0, 0x4b, 0xd4, 0x8a, 0x33,
+	0x9b, 0xb4, 0xcd, 0x4c, 0x85, 0x8f, 0x03, 0x8e, 0x8e, 0x60, 0x21, 0x20, 0x9c, 0x8f, 0xe7, 0xbc,
+	0x20, 0xb1, 0x1b, 0xf7, 0xc2, 0xbe, 0x92, 0x99, 0xf1, 0xa0, 0x4d, 0x25, 0x98, 0x88, 0x10, 0x86,
+	0xff, 0xba, 0x8c, 0xf2, 0x6e, 0x80, 0x23, 0xeb, 0x87, 0x39, 0x40, 0x79, 0xc6, 0x93, 0xd4, 0x33,
+	0x76, 0x93, 0xac, 0xe9, 0x69, 0xfc, 0xe3, 0xa6, 0x6d, 0x73, 0x74, 0x08, 0x8b, 0xa3, 0x63, 0x92,
+	0x06, 0xf2, 0x12, 0x5e, 0xbd, 0x13, 0x1e, 0x3b, 0x65, 0xc9, 0x9d, 0x0c, 0x39, 0x7a, 0x0c, 0x97,
+	0x6d, 0x57, 0x90, 0x9e, 0x2d, 0xb0, 0x67, 0x75, 0x30, 0xf1, 0x3b, 0x42, 0x55, 0x2a, 0xa0, 0x96,
+	0x35, 0x8b, 0xa3, 0xfd, 0x7d, 0xb9, 0x7d, 0x90, 0x5d, 0xcc, 0x2d, 0xc3, 0xea, 0x05, 0x80, 0x7f,
+	0xa7, 0xd6, 0x8b, 0xca, 0x30, 0x3f, 0xaa, 0x8b, 0x78, 0xd2, 0xff, 0x39, 0x13, 0x0e, 0xb7, 0x9a,
+	0x1e, 0x32, 0x61, 0xae, 0x1d, 0x5a, 0x8e, 0x70, 0xad, 0xf0, 0x58, 0x9d, 0xa9, 0x80, 0x9a, 0xa2,
+	0x3f, 0xbd, 0xbc, 0x2a, 0x6f, 0xfa, 0x44, 0x74, 0xba, 0x8e, 0xe6, 0xb2, 0xa0, 0x9e, 0x34, 0xe0,
+	0x76, 0x6c, 0x42, 0x87, 0x41, 0x5d, 0xf4, 0x43, 0xcc, 0x35, 0xbd, 0x69, 0x6c, 0x6d, 0x37, 0x8c,
+	0xae, 0x73, 0x88, 0xfb, 0xe6, 0x42, 0x3b, 0xd4, 0x85, 0x6b, 0x1c, 0xa3, 0x03, 0x58, 0xbc, 0x35,
+	0x6a, 0x75, 0xb6, 0x02, 0x7e, 0x3a, 0x8c, 0xe9, 0x09, 0x17, 0xa6, 0xfc, 0x56, 0xfd, 0x04, 0xe0,
+	0xda, 0x5d, 0x7f, 0xf7, 0x74, 0x03, 0xe0, 0xcf, 0x34, 0xd0, 0x80, 0x2b, 0x93, 0x66, 0xb4, 0xe2,
+	0x9b, 0x1c, 0xdf, 0xfd, 0xac, 0x89, 0x26, 0x0c, 0x16, 0xdf, 0x78, 0x5e, 0xfd, 0x0c, 0x60, 0xf1,
+	0xd6, 0xcd, 0x79, 0x90, 0xca, 0x52, 0x46, 0x3b, 0xf3, 0xbb, 0xa3, 0xfd, 0x02, 0xe0, 0x42, 0xf2,
+	0x5e, 0xa0, 0x75, 0xa8, 0xc4, 0xad, 0x26, 0x76, 0x03, 0xd2, 0x6e, 0x79, 0xb9, 0x17, 0x5b, 0xed,
+	0x41, 0x9c, 0xf2, 0x01, 0x2a, 0xc3, 0x72, 0x07, 0x6f, 0x9b, 0xb4, 0x89, 0xa2, 0x3f, 0xbb, 0xbc,
+	0x2a, 0x6f, 0xdf, 0x0f, 0xdb, 0x72, 0x3b, 0x94, 0x45, 0xd1, 0xde, 0x9b, 0xb7, 0xad, 0xc1, 0xb3,
+	0x97, 0x1f, 0xd2, 0x5a, 0xc4, 0xd7, 0x0f, 0xce, 0xae, 0x4b, 0xe0, 0xfc, 0xba, 0x04, 0xbe, 0x5e,
+	0x97, 0xc0, 0xc7, 0x9b, 0x52, 0xe6, 0xfc, 0xa6, 0x94, 0xb9, 0xb8, 0x29, 0x65, 0xde, 0x37, 0x7e,
+	0x05, 0x3f, 0x1d, 0x7f, 0x5f, 0xe4, 0x39, 0xce, 0xbc, 0xfc, 0xb4, 0x6c, 0x7d, 0x0f, 0x00, 0x00,
+	0xff, 0xff, 0x47, 0x34, 0xb6, 0x7e, 0xf0, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -207,6 +440,81 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ActivatedHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ActivatedHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.ConsumerBlocks) > 0 {
+		for iNdEx := len(m.ConsumerBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ConsumerPubRandCommits) > 0 {
+		for iNdEx := len(m.ConsumerPubRandCommits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerPubRandCommits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.MissedBlocks) > 0 {
+		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SigningInfos) > 0 {
+		for iNdEx := len(m.SigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PubRandCommits) > 0 {
+		for iNdEx := len(m.PubRandCommits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PubRandCommits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.VoteSigs) > 0 {
 		for iNdEx := len(m.VoteSigs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -262,7 +570,7 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *VoteSig) Marshal() (dAtA []byte, err error) {
+func (m *ConsumerPubRandCommit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -272,23 +580,23 @@ func (m *VoteSig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VoteSig) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConsumerPubRandCommit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VoteSig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConsumerPubRandCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.FinalitySig != nil {
+	if m.PubRandCommit != nil {
 		{
-			size := m.FinalitySig.Size()
-			i -= size
-			if _, err := m.FinalitySig.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.PubRandCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
@@ -306,64 +614,309 @@ func (m *VoteSig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConsumerId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FinalityProviderMissedBlocks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *FinalityProviderMissedBlocks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderMissedBlocks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.IndexedBlocks) > 0 {
-		for _, e := range m.IndexedBlocks {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.Evidences) > 0 {
-		for _, e := range m.Evidences {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MissedBlockIndexes) > 0 {
+		dAtA4 := make([]byte, len(m.MissedBlockIndexes)*10)
+		var j3 int
+		for _, num := range m.MissedBlockIndexes {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintGenesis(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.VoteSigs) > 0 {
-		for _, e := range m.VoteSigs {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *VoteSig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FPPubRandCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FPPubRandCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FPPubRandCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
-	}
-	if m.FpBtcPk != nil {
+	if m.PubRandCommit != nil {
+		{
+			size, err := m.PubRandCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteSig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteSig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteSig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalitySig != nil {
+		{
+			size := m.FinalitySig.Size()
+			i -= size
+			if _, err := m.FinalitySig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.IndexedBlocks) > 0 {
+		for _, e := range m.IndexedBlocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Evidences) > 0 {
+		for _, e := range m.Evidences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoteSigs) > 0 {
+		for _, e := range m.VoteSigs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PubRandCommits) > 0 {
+		for _, e := range m.PubRandCommits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SigningInfos) > 0 {
+		for _, e := range m.SigningInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MissedBlocks) > 0 {
+		for _, e := range m.MissedBlocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsumerPubRandCommits) > 0 {
+		for _, e := range m.ConsumerPubRandCommits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsumerBlocks) > 0 {
+		for _, e := range m.ConsumerBlocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ActivatedHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.ActivatedHeight))
+	}
+	return n
+}
+
+func (m *ConsumerPubRandCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PubRandCommit != nil {
+		l = m.PubRandCommit.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *FinalityProviderMissedBlocks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MissedBlockIndexes) > 0 {
+		l = 0
+		for _, e := range m.MissedBlockIndexes {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *FPPubRandCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PubRandCommit != nil {
+		l = m.PubRandCommit.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *VoteSig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.FpBtcPk != nil {
 		l = m.FpBtcPk.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
@@ -544,6 +1097,630 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRandCommits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubRandCommits = append(m.PubRandCommits, &FPPubRandCommit{})
+			if err := m.PubRandCommits[len(m.PubRandCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningInfos = append(m.SigningInfos, &FinalityProviderSigningInfo{})
+			if err := m.SigningInfos[len(m.SigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBlocks = append(m.MissedBlocks, &FinalityProviderMissedBlocks{})
+			if err := m.MissedBlocks[len(m.MissedBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerPubRandCommits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerPubRandCommits = append(m.ConsumerPubRandCommits, &ConsumerPubRandCommit{})
+			if err := m.ConsumerPubRandCommits[len(m.ConsumerPubRandCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerBlocks = append(m.ConsumerBlocks, &ConsumerBlock{})
+			if err := m.ConsumerBlocks[len(m.ConsumerBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivatedHeight", wireType)
+			}
+			m.ActivatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerPubRandCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerPubRandCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerPubRandCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRandCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubRandCommit == nil {
+				m.PubRandCommit = &PubRandCommit{}
+			}
+			if err := m.PubRandCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalityProviderMissedBlocks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProviderMissedBlocks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProviderMissedBlocks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MissedBlockIndexes = append(m.MissedBlockIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MissedBlockIndexes) == 0 {
+					m.MissedBlockIndexes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MissedBlockIndexes = append(m.MissedBlockIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlockIndexes", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FPPubRandCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FPPubRandCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FPPubRandCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRandCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubRandCommit == nil {
+				m.PubRandCommit = &PubRandCommit{}
+			}
+			if err := m.PubRandCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
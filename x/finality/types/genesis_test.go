@@ -21,10 +21,19 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
-				Params: types.Params{},
+				Params: types.DefaultParams(),
 			},
 			valid: true,
 		},
+		{
+			// params of chains upgraded from a version without the liveness
+			// params are zero and are set by the store migration
+			desc: "zero params are invalid",
+			genState: &types.GenesisState{
+				Params: types.Params{},
+			},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "finality"
@@ -20,4 +22,30 @@ var (
 	ParamsKey               = []byte{0x03} // key prefix for the parameters
 	EvidenceKey             = []byte{0x04} // key prefix for evidences
 	NextHeightToFinalizeKey = []byte{0x05} // key prefix for next height to finalise
+	PubRandCommitKey        = []byte{0x06} // key prefix for public randomness commitments
+	FPSigningInfoKey        = []byte{0x07} // key prefix for signing infos of finality providers
+	FPMissedBlockBitmapKey  = []byte{0x08} // key prefix for missed blocks bitmaps of finality providers
+	ConsumerPubRandKey      = []byte{0x0B} // key prefix for public randomness commitments for consumer systems
+	ConsumerVoteKey         = []byte{0x0C} // key prefix for votes on blocks of consumer systems
+	ConsumerVotingPowerKey  = []byte{0x0D} // key prefix for voting power tables of consumer systems
+	ConsumerBlockKey        = []byte{0x0E} // key prefix for finalized blocks of consumer systems
+	ActivatedHeightKey      = []byte{0x0F} // key for the height at which the finality gadget activated
+	NextHeightToPruneKey    = []byte{0x10} // key for the next height whose votes are to be pruned
 )
+
+// ConsumerIDKey returns the key of the given consumer ID, i.e., the consumer ID
+// prefixed by its length, so that no consumer ID key is a prefix of another one
+func ConsumerIDKey(consumerID string) []byte {
+	return append([]byte{byte(len(consumerID))}, []byte(consumerID)...)
+}
+
+// ParseConsumerIDKey parses the consumer ID prefixed by its length at the
+// beginning of the given key, and returns the consumer ID and the remainder
+// of the key
+func ParseConsumerIDKey(key []byte) (string, []byte, error) {
+	if len(key) == 0 || len(key) < 1+int(key[0]) {
+		return "", nil, fmt.Errorf("malformed consumer ID key %x", key)
+	}
+	idLen := int(key[0])
+	return string(key[1 : 1+idLen]), key[1+idLen:], nil
+}
@@ -7,7 +7,8 @@ import (
 
 // performance oriented metrics measuring the execution time of each message
 const (
-	MetricsKeyAddFinalitySig = "add_finality_sig"
+	MetricsKeyAddFinalitySig         = "add_finality_sig"
+	MetricsKeyAddConsumerFinalitySig = "add_consumer_finality_sig"
 )
 
 // Metrics for monitoring block finalization status
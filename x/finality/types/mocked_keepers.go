@@ -9,6 +9,8 @@ import (
 	reflect "reflect"
 
 	types "github.com/babylonchain/babylon/x/btcstaking/types"
+	types0 "github.com/babylonchain/babylon/x/epoching/types"
+	types1 "github.com/babylonchain/babylon/x/zoneconcierge/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -35,6 +37,35 @@ func (m *MockBTCStakingKeeper) EXPECT() *MockBTCStakingKeeperMockRecorder {
 	return m.recorder
 }
 
+// GetBTCDelegation mocks base method.
+func (m *MockBTCStakingKeeper) GetBTCDelegation(ctx context.Context, stakingTxHashStr string) (*types.BTCDelegation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBTCDelegation", ctx, stakingTxHashStr)
+	ret0, _ := ret[0].(*types.BTCDelegation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBTCDelegation indicates an expected call of GetBTCDelegation.
+func (mr *MockBTCStakingKeeperMockRecorder) GetBTCDelegation(ctx, stakingTxHashStr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBTCDelegation", reflect.TypeOf((*MockBTCStakingKeeper)(nil).GetBTCDelegation), ctx, stakingTxHashStr)
+}
+
+// GetBTCDelegationStatus mocks base method.
+func (m *MockBTCStakingKeeper) GetBTCDelegationStatus(ctx context.Context, btcDel *types.BTCDelegation) types.BTCDelegationStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBTCDelegationStatus", ctx, btcDel)
+	ret0, _ := ret[0].(types.BTCDelegationStatus)
+	return ret0
+}
+
+// GetBTCDelegationStatus indicates an expected call of GetBTCDelegationStatus.
+func (mr *MockBTCStakingKeeperMockRecorder) GetBTCDelegationStatus(ctx, btcDel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBTCDelegationStatus", reflect.TypeOf((*MockBTCStakingKeeper)(nil).GetBTCDelegationStatus), ctx, btcDel)
+}
+
 // GetBTCStakingActivatedHeight mocks base method.
 func (m *MockBTCStakingKeeper) GetBTCStakingActivatedHeight(ctx context.Context) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +81,20 @@ func (mr *MockBTCStakingKeeperMockRecorder) GetBTCStakingActivatedHeight(ctx int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBTCStakingActivatedHeight", reflect.TypeOf((*MockBTCStakingKeeper)(nil).GetBTCStakingActivatedHeight), ctx)
 }
 
+// GetConsumerVotingPowerTable mocks base method.
+func (m *MockBTCStakingKeeper) GetConsumerVotingPowerTable(ctx context.Context, consumerID string, height uint64) map[string]uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsumerVotingPowerTable", ctx, consumerID, height)
+	ret0, _ := ret[0].(map[string]uint64)
+	return ret0
+}
+
+// GetConsumerVotingPowerTable indicates an expected call of GetConsumerVotingPowerTable.
+func (mr *MockBTCStakingKeeperMockRecorder) GetConsumerVotingPowerTable(ctx, consumerID, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsumerVotingPowerTable", reflect.TypeOf((*MockBTCStakingKeeper)(nil).GetConsumerVotingPowerTable), ctx, consumerID, height)
+}
+
 // GetFinalityProvider mocks base method.
 func (m *MockBTCStakingKeeper) GetFinalityProvider(ctx context.Context, fpBTCPK []byte) (*types.FinalityProvider, error) {
 	m.ctrl.T.Helper()
@@ -93,6 +138,35 @@ func (mr *MockBTCStakingKeeperMockRecorder) GetParams(ctx interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockBTCStakingKeeper)(nil).GetParams), ctx)
 }
 
+// GetParamsByVersion mocks base method.
+func (m *MockBTCStakingKeeper) GetParamsByVersion(ctx context.Context, v uint32) *types.Params {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParamsByVersion", ctx, v)
+	ret0, _ := ret[0].(*types.Params)
+	return ret0
+}
+
+// GetParamsByVersion indicates an expected call of GetParamsByVersion.
+func (mr *MockBTCStakingKeeperMockRecorder) GetParamsByVersion(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParamsByVersion", reflect.TypeOf((*MockBTCStakingKeeper)(nil).GetParamsByVersion), ctx, v)
+}
+
+// GetStakingTxDepth mocks base method.
+func (m *MockBTCStakingKeeper) GetStakingTxDepth(ctx context.Context, btcDel *types.BTCDelegation) (uint64, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStakingTxDepth", ctx, btcDel)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetStakingTxDepth indicates an expected call of GetStakingTxDepth.
+func (mr *MockBTCStakingKeeperMockRecorder) GetStakingTxDepth(ctx, btcDel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStakingTxDepth", reflect.TypeOf((*MockBTCStakingKeeper)(nil).GetStakingTxDepth), ctx, btcDel)
+}
+
 // GetVotingPower mocks base method.
 func (m *MockBTCStakingKeeper) GetVotingPower(ctx context.Context, fpBTCPK []byte, height uint64) uint64 {
 	m.ctrl.T.Helper()
@@ -136,6 +210,20 @@ func (mr *MockBTCStakingKeeperMockRecorder) GetVotingPowerTable(ctx, height inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVotingPowerTable", reflect.TypeOf((*MockBTCStakingKeeper)(nil).GetVotingPowerTable), ctx, height)
 }
 
+// HasConsumerFinalityProvider mocks base method.
+func (m *MockBTCStakingKeeper) HasConsumerFinalityProvider(ctx context.Context, consumerID string, fpBTCPK []byte) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasConsumerFinalityProvider", ctx, consumerID, fpBTCPK)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasConsumerFinalityProvider indicates an expected call of HasConsumerFinalityProvider.
+func (mr *MockBTCStakingKeeperMockRecorder) HasConsumerFinalityProvider(ctx, consumerID, fpBTCPK interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasConsumerFinalityProvider", reflect.TypeOf((*MockBTCStakingKeeper)(nil).HasConsumerFinalityProvider), ctx, consumerID, fpBTCPK)
+}
+
 // HasFinalityProvider mocks base method.
 func (m *MockBTCStakingKeeper) HasFinalityProvider(ctx context.Context, fpBTCPK []byte) bool {
 	m.ctrl.T.Helper()
@@ -150,6 +238,20 @@ func (mr *MockBTCStakingKeeperMockRecorder) HasFinalityProvider(ctx, fpBTCPK int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasFinalityProvider", reflect.TypeOf((*MockBTCStakingKeeper)(nil).HasFinalityProvider), ctx, fpBTCPK)
 }
 
+// JailFinalityProvider mocks base method.
+func (m *MockBTCStakingKeeper) JailFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JailFinalityProvider", ctx, fpBTCPK)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JailFinalityProvider indicates an expected call of JailFinalityProvider.
+func (mr *MockBTCStakingKeeperMockRecorder) JailFinalityProvider(ctx, fpBTCPK interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailFinalityProvider", reflect.TypeOf((*MockBTCStakingKeeper)(nil).JailFinalityProvider), ctx, fpBTCPK)
+}
+
 // RemoveVotingPowerDistCache mocks base method.
 func (m *MockBTCStakingKeeper) RemoveVotingPowerDistCache(ctx context.Context, height uint64) {
 	m.ctrl.T.Helper()
@@ -163,17 +265,121 @@ func (mr *MockBTCStakingKeeperMockRecorder) RemoveVotingPowerDistCache(ctx, heig
 }
 
 // SlashFinalityProvider mocks base method.
-func (m *MockBTCStakingKeeper) SlashFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
+func (m *MockBTCStakingKeeper) SlashFinalityProvider(ctx context.Context, fpBTCPK []byte, infractionHeight uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SlashFinalityProvider", ctx, fpBTCPK)
+	ret := m.ctrl.Call(m, "SlashFinalityProvider", ctx, fpBTCPK, infractionHeight)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SlashFinalityProvider indicates an expected call of SlashFinalityProvider.
-func (mr *MockBTCStakingKeeperMockRecorder) SlashFinalityProvider(ctx, fpBTCPK interface{}) *gomock.Call {
+func (mr *MockBTCStakingKeeperMockRecorder) SlashFinalityProvider(ctx, fpBTCPK, infractionHeight interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashFinalityProvider", reflect.TypeOf((*MockBTCStakingKeeper)(nil).SlashFinalityProvider), ctx, fpBTCPK, infractionHeight)
+}
+
+// UnjailFinalityProvider mocks base method.
+func (m *MockBTCStakingKeeper) UnjailFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnjailFinalityProvider", ctx, fpBTCPK)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnjailFinalityProvider indicates an expected call of UnjailFinalityProvider.
+func (mr *MockBTCStakingKeeperMockRecorder) UnjailFinalityProvider(ctx, fpBTCPK interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnjailFinalityProvider", reflect.TypeOf((*MockBTCStakingKeeper)(nil).UnjailFinalityProvider), ctx, fpBTCPK)
+}
+
+// MockEpochingKeeper is a mock of EpochingKeeper interface.
+type MockEpochingKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockEpochingKeeperMockRecorder
+}
+
+// MockEpochingKeeperMockRecorder is the mock recorder for MockEpochingKeeper.
+type MockEpochingKeeperMockRecorder struct {
+	mock *MockEpochingKeeper
+}
+
+// NewMockEpochingKeeper creates a new mock instance.
+func NewMockEpochingKeeper(ctrl *gomock.Controller) *MockEpochingKeeper {
+	mock := &MockEpochingKeeper{ctrl: ctrl}
+	mock.recorder = &MockEpochingKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEpochingKeeper) EXPECT() *MockEpochingKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetHistoricalEpoch mocks base method.
+func (m *MockEpochingKeeper) GetHistoricalEpoch(ctx context.Context, epochNumber uint64) (*types0.Epoch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoricalEpoch", ctx, epochNumber)
+	ret0, _ := ret[0].(*types0.Epoch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistoricalEpoch indicates an expected call of GetHistoricalEpoch.
+func (mr *MockEpochingKeeperMockRecorder) GetHistoricalEpoch(ctx, epochNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoricalEpoch", reflect.TypeOf((*MockEpochingKeeper)(nil).GetHistoricalEpoch), ctx, epochNumber)
+}
+
+// MockZoneConciergeKeeper is a mock of ZoneConciergeKeeper interface.
+type MockZoneConciergeKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockZoneConciergeKeeperMockRecorder
+}
+
+// MockZoneConciergeKeeperMockRecorder is the mock recorder for MockZoneConciergeKeeper.
+type MockZoneConciergeKeeperMockRecorder struct {
+	mock *MockZoneConciergeKeeper
+}
+
+// NewMockZoneConciergeKeeper creates a new mock instance.
+func NewMockZoneConciergeKeeper(ctrl *gomock.Controller) *MockZoneConciergeKeeper {
+	mock := &MockZoneConciergeKeeper{ctrl: ctrl}
+	mock.recorder = &MockZoneConciergeKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockZoneConciergeKeeper) EXPECT() *MockZoneConciergeKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetHeader mocks base method.
+func (m *MockZoneConciergeKeeper) GetHeader(ctx context.Context, chainID string, height uint64) (*types1.IndexedHeader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHeader", ctx, chainID, height)
+	ret0, _ := ret[0].(*types1.IndexedHeader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHeader indicates an expected call of GetHeader.
+func (mr *MockZoneConciergeKeeperMockRecorder) GetHeader(ctx, chainID, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeader", reflect.TypeOf((*MockZoneConciergeKeeper)(nil).GetHeader), ctx, chainID, height)
+}
+
+// HasConsumer mocks base method.
+func (m *MockZoneConciergeKeeper) HasConsumer(ctx context.Context, consumerID string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasConsumer", ctx, consumerID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasConsumer indicates an expected call of HasConsumer.
+func (mr *MockZoneConciergeKeeperMockRecorder) HasConsumer(ctx, consumerID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashFinalityProvider", reflect.TypeOf((*MockBTCStakingKeeper)(nil).SlashFinalityProvider), ctx, fpBTCPK)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasConsumer", reflect.TypeOf((*MockZoneConciergeKeeper)(nil).HasConsumer), ctx, consumerID)
 }
 
 // MockIncentiveKeeper is a mock of IncentiveKeeper interface.
@@ -1,18 +1,109 @@
 package types
 
 import (
+	"fmt"
+
 	"github.com/babylonchain/babylon/crypto/eots"
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // ensure that these message types implement the sdk.Msg interface
 var (
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgCommitPubRandList{}
 	_ sdk.Msg = &MsgAddFinalitySig{}
+	_ sdk.Msg = &MsgAddConsumerFinalitySig{}
+	_ sdk.Msg = &MsgUnjailFinalityProvider{}
 )
 
+// NewMsgCommitPubRandList creates a MsgCommitPubRandList committing to the
+// given list of public randomness starting from startHeight, signed by sk
+func NewMsgCommitPubRandList(signer string, sk *btcec.PrivateKey, startHeight uint64, pubRandList []*eots.PublicRand) (*MsgCommitPubRandList, error) {
+	return NewMsgCommitConsumerPubRandList(signer, "", sk, startHeight, pubRandList)
+}
+
+// NewMsgCommitConsumerPubRandList creates a MsgCommitPubRandList committing to
+// the given list of public randomness for the blocks of the given consumer
+// system starting from startHeight, signed by sk
+func NewMsgCommitConsumerPubRandList(signer string, consumerID string, sk *btcec.PrivateKey, startHeight uint64, pubRandList []*eots.PublicRand) (*MsgCommitPubRandList, error) {
+	commitment, _ := GetPubRandCommitAndProofs(pubRandList)
+	msg := &MsgCommitPubRandList{
+		Signer:      signer,
+		FpBtcPk:     bbn.NewBIP340PubKeyFromBTCPK(sk.PubKey()),
+		StartHeight: startHeight,
+		NumPubRand:  uint64(len(pubRandList)),
+		Commitment:  commitment,
+		ConsumerId:  consumerID,
+	}
+	hash, err := msg.HashToSign()
+	if err != nil {
+		return nil, err
+	}
+	sig, err := schnorr.Sign(sk, hash)
+	if err != nil {
+		return nil, err
+	}
+	msg.Sig = bbn.NewBIP340SignatureFromBTCSig(sig)
+
+	return msg, nil
+}
+
+// HashToSign returns the hash of (start_height || num_pub_rand || commitment),
+// followed by consumer_id if it is not empty, that the finality provider signs
+func (m *MsgCommitPubRandList) HashToSign() ([]byte, error) {
+	hasher := tmhash.New()
+	if _, err := hasher.Write(sdk.Uint64ToBigEndian(m.StartHeight)); err != nil {
+		return nil, err
+	}
+	if _, err := hasher.Write(sdk.Uint64ToBigEndian(m.NumPubRand)); err != nil {
+		return nil, err
+	}
+	if _, err := hasher.Write(m.Commitment); err != nil {
+		return nil, err
+	}
+	if _, err := hasher.Write([]byte(m.ConsumerId)); err != nil {
+		return nil, err
+	}
+	return hasher.Sum(nil), nil
+}
+
+// VerifySig verifies that the message is signed by the finality provider
+func (m *MsgCommitPubRandList) VerifySig() error {
+	msgHash, err := m.HashToSign()
+	if err != nil {
+		return err
+	}
+	pk, err := m.FpBtcPk.ToBTCPK()
+	if err != nil {
+		return err
+	}
+	if m.Sig == nil {
+		return fmt.Errorf("empty signature")
+	}
+	schnorrSig, err := m.Sig.ToBTCSig()
+	if err != nil {
+		return err
+	}
+	if !schnorrSig.Verify(msgHash, pk) {
+		return fmt.Errorf("failed to verify signature")
+	}
+	return nil
+}
+
+// PubRandCommit returns the public randomness commitment in the message
+func (m *MsgCommitPubRandList) PubRandCommit() *PubRandCommit {
+	return &PubRandCommit{
+		StartHeight: m.StartHeight,
+		NumPubRand:  m.NumPubRand,
+		Commitment:  m.Commitment,
+	}
+}
+
 func NewMsgAddFinalitySig(signer string, sk *btcec.PrivateKey, sr *eots.PrivateRand, blockHeight uint64, blockHash []byte) (*MsgAddFinalitySig, error) {
 	msg := &MsgAddFinalitySig{
 		Signer:       signer,
@@ -47,3 +138,74 @@ func (m *MsgAddFinalitySig) VerifyEOTSSig(mpr *eots.MasterPublicRand) error {
 
 	return eots.Verify(pk, pubRand, msgToSign, m.FinalitySig.ToModNScalar())
 }
+
+// HasCommittedPubRand returns whether the message carries the public
+// randomness and its inclusion proof, i.e., whether the finality provider
+// signs with public randomness committed via MsgCommitPubRandList
+func (m *MsgAddFinalitySig) HasCommittedPubRand() bool {
+	return m.PubRand != nil && m.Proof != nil
+}
+
+// NewMsgAddConsumerFinalitySig creates a MsgAddConsumerFinalitySig voting for
+// the given block of the given consumer system, signed by sk with the public
+// randomness at the given index of the committed list of public randomness
+func NewMsgAddConsumerFinalitySig(
+	signer string,
+	consumerID string,
+	sk *btcec.PrivateKey,
+	sr *eots.PrivateRand,
+	pubRand *eots.PublicRand,
+	proof *cmtcrypto.Proof,
+	blockHeight uint64,
+	blockHash []byte,
+) (*MsgAddConsumerFinalitySig, error) {
+	msg := &MsgAddConsumerFinalitySig{
+		Signer:      signer,
+		ConsumerId:  consumerID,
+		FpBtcPk:     bbn.NewBIP340PubKeyFromBTCPK(sk.PubKey()),
+		BlockHeight: blockHeight,
+		BlockHash:   blockHash,
+		PubRand:     bbn.NewSchnorrPubRandFromFieldVal(pubRand),
+		Proof:       proof,
+	}
+	sig, err := eots.Sign(sk, sr, msg.MsgToSign())
+	if err != nil {
+		return nil, err
+	}
+	msg.FinalitySig = bbn.NewSchnorrEOTSSigFromModNScalar(sig)
+
+	return msg, nil
+}
+
+// MsgToSign returns the message that the EOTS finality signature signs
+func (m *MsgAddConsumerFinalitySig) MsgToSign() []byte {
+	return msgToSignForVote(m.BlockHeight, m.BlockHash)
+}
+
+// ValidateBasic performs stateless checks on the message
+func (m *MsgAddConsumerFinalitySig) ValidateBasic() error {
+	if err := ValidateConsumerID(m.ConsumerId); err != nil {
+		return err
+	}
+	if m.FpBtcPk == nil {
+		return fmt.Errorf("empty finality provider BTC PK")
+	}
+	if len(m.BlockHash) != 32 {
+		return fmt.Errorf("malformed block hash")
+	}
+	if m.FinalitySig == nil {
+		return fmt.Errorf("empty finality signature")
+	}
+	if m.PubRand == nil || m.Proof == nil {
+		return fmt.Errorf("empty public randomness or inclusion proof")
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless checks on the message
+func (m *MsgUnjailFinalityProvider) ValidateBasic() error {
+	if m.FpBtcPk == nil {
+		return fmt.Errorf("empty finality provider BTC PK")
+	}
+	return nil
+}
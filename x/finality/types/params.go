@@ -1,10 +1,29 @@
 package types
 
 import (
+	"fmt"
+
+	"cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
+const (
+	defaultSignedBlocksWindow = uint64(100)
+	defaultFinalitySigTimeout = uint64(3)
+	defaultJailDurationBlocks = uint64(8640)
+	// defaultVoteRetentionBlocks is 7 days of Babylon blocks of 5 seconds,
+	// which exceeds the default unbonding time of BTC delegations, i.e., the
+	// checkpoint finalization timeout of 100 BTC blocks, so that equivocations
+	// remain slashable until the BTC delegations unbonding right after them
+	// are withdrawable
+	defaultVoteRetentionBlocks = uint64(120960)
+)
+
+var (
+	defaultMinSignedPerWindow = math.LegacyNewDecWithPrec(5, 1)
+)
+
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 // ParamKeyTable the param key table for launch module
@@ -14,7 +33,13 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return Params{}
+	return Params{
+		SignedBlocksWindow:  defaultSignedBlocksWindow,
+		FinalitySigTimeout:  defaultFinalitySigTimeout,
+		MinSignedPerWindow:  defaultMinSignedPerWindow,
+		JailDurationBlocks:  defaultJailDurationBlocks,
+		VoteRetentionBlocks: defaultVoteRetentionBlocks,
+	}
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -24,9 +49,40 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if p.SignedBlocksWindow == 0 {
+		return fmt.Errorf("signed blocks window must be positive")
+	}
+	if p.FinalitySigTimeout == 0 {
+		return fmt.Errorf("finality signature timeout must be positive")
+	}
+	if p.MinSignedPerWindow.IsNil() {
+		return fmt.Errorf("min signed per window must not be nil")
+	}
+	if p.MinSignedPerWindow.IsNegative() || p.MinSignedPerWindow.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("min signed per window must be in [0, 1], got %s", p.MinSignedPerWindow)
+	}
+	// finality signatures are needed for the liveness of finality providers
+	// until the finality signature timeout passes
+	if p.VoteRetentionBlocks != 0 && p.VoteRetentionBlocks <= p.FinalitySigTimeout {
+		return fmt.Errorf("vote retention blocks must be 0 or larger than finality signature timeout (%d), got %d", p.FinalitySigTimeout, p.VoteRetentionBlocks)
+	}
 	return nil
 }
 
+// MinSignedPerWindowInt returns the minimum number of blocks in the sliding
+// window that a finality provider has to vote for
+func (p Params) MinSignedPerWindowInt() uint64 {
+	// NOTE: RoundInt64 will never panic as the sliding window is bounded
+	window := math.LegacyNewDec(int64(p.SignedBlocksWindow))
+	return uint64(p.MinSignedPerWindow.Mul(window).RoundInt64())
+}
+
+// MaxMissedPerWindow returns the maximum number of blocks in the sliding
+// window that a finality provider can miss without being jailed
+func (p Params) MaxMissedPerWindow() uint64 {
+	return p.SignedBlocksWindow - p.MinSignedPerWindowInt()
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -25,6 +27,36 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// signed_blocks_window is the size of the sliding window for tracking the
+	// liveness of finality providers
+	SignedBlocksWindow uint64 `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+	// finality_sig_timeout is the number of blocks that finality providers have
+	// to vote for a block before being considered as missing the block
+	FinalitySigTimeout uint64 `protobuf:"varint,2,opt,name=finality_sig_timeout,json=finalitySigTimeout,proto3" json:"finality_sig_timeout,omitempty"`
+	// min_signed_per_window is the minimum portion of blocks in the sliding
+	// window that a finality provider has to vote for to avoid being jailed
+	MinSignedPerWindow cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_signed_per_window"`
+	// min_total_staked_sat is the minimum total voting power, in satoshis, of
+	// the active finality providers at a Babylon height for the finality gadget
+	// to activate at this height. Once activated, the finality gadget remains
+	// active. If it is 0, the finality gadget activates at the height where the
+	// BTC staking protocol activates
+	MinTotalStakedSat uint64 `protobuf:"varint,4,opt,name=min_total_staked_sat,json=minTotalStakedSat,proto3" json:"min_total_staked_sat,omitempty"`
+	// vote_retention_blocks is the number of Babylon blocks that the finality
+	// signatures of a block are kept for. Once a block is finalised, its epoch
+	// is checkpointed to BTC and it is older than vote_retention_blocks, its
+	// finality signatures are pruned and only the aggregated result is kept in
+	// the IndexedBlock. If it is 0, finality signatures are never pruned.
+	// Votes on a block whose finality signatures are pruned are rejected, thus
+	// a finality provider voting for a fork at such a height can no longer be
+	// slashed. vote_retention_blocks thus bounds the slashing window of
+	// equivocations, and should cover at least the unbonding time of BTC
+	// delegations, so that their stake is still at risk throughout the window
+	VoteRetentionBlocks uint64 `protobuf:"varint,5,opt,name=vote_retention_blocks,json=voteRetentionBlocks,proto3" json:"vote_retention_blocks,omitempty"`
+	// jail_duration_blocks is the number of Babylon blocks that a finality
+	// provider jailed due to insufficient liveness has to wait for before it
+	// can unjail itself
+	JailDurationBlocks uint64 `protobuf:"varint,6,opt,name=jail_duration_blocks,json=jailDurationBlocks,proto3" json:"jail_duration_blocks,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +91,41 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetSignedBlocksWindow() uint64 {
+	if m != nil {
+		return m.SignedBlocksWindow
+	}
+	return 0
+}
+
+func (m *Params) GetFinalitySigTimeout() uint64 {
+	if m != nil {
+		return m.FinalitySigTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetMinTotalStakedSat() uint64 {
+	if m != nil {
+		return m.MinTotalStakedSat
+	}
+	return 0
+}
+
+func (m *Params) GetVoteRetentionBlocks() uint64 {
+	if m != nil {
+		return m.VoteRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetJailDurationBlocks() uint64 {
+	if m != nil {
+		return m.JailDurationBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.finality.v1.Params")
 }
@@ -66,17 +133,32 @@ func init() {
 func init() { proto.RegisterFile("babylon/finality/v1/params.proto", fileDescriptor_25539c9a61c72ee9) }
 
 var fileDescriptor_25539c9a61c72ee9 = []byte{
-	// 158 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0xcb, 0xcc, 0x4b, 0xcc, 0xc9, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4,
-	0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xaa,
-	0xd0, 0x83, 0xa9, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb, 0x83,
-	0x58, 0x10, 0xa5, 0x4a, 0x7c, 0x5c, 0x6c, 0x01, 0x60, 0xad, 0x56, 0x2c, 0x33, 0x16, 0xc8, 0x33,
-	0x38, 0x79, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13,
-	0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x41, 0x7a, 0x66,
-	0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd4, 0xfc, 0xe4, 0x8c, 0xc4, 0xcc, 0x3c,
-	0x18, 0x47, 0xbf, 0x02, 0xe1, 0xa0, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x15, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x67, 0x01, 0xe8, 0x48, 0xb1, 0x00, 0x00, 0x00,
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x92, 0x3f, 0x4e, 0xc3, 0x30,
+	0x14, 0xc6, 0x93, 0x52, 0x3a, 0x44, 0x2c, 0xa4, 0xad, 0x14, 0x8a, 0x94, 0x56, 0x4c, 0x5d, 0x88,
+	0x5b, 0xd8, 0x18, 0xab, 0x4e, 0x88, 0xa1, 0x6a, 0x2a, 0x21, 0xb1, 0x58, 0x4e, 0x62, 0x52, 0xd3,
+	0xd8, 0x8e, 0x62, 0xb7, 0x25, 0xb7, 0x60, 0x64, 0xe4, 0x10, 0x1c, 0xa2, 0x63, 0xc5, 0x84, 0x18,
+	0x2a, 0xd4, 0x1e, 0x80, 0x2b, 0xa0, 0xd8, 0x89, 0x60, 0xf3, 0xd3, 0xef, 0x7d, 0xef, 0x7b, 0x7f,
+	0x6c, 0xf5, 0x02, 0x14, 0xe4, 0x09, 0x67, 0xe0, 0x91, 0x30, 0x94, 0x10, 0x99, 0x83, 0xd5, 0x10,
+	0xa4, 0x28, 0x43, 0x54, 0x78, 0x69, 0xc6, 0x25, 0xb7, 0x9b, 0x65, 0x86, 0x57, 0x65, 0x78, 0xab,
+	0x61, 0xa7, 0x15, 0xf3, 0x98, 0x2b, 0x0e, 0x8a, 0x97, 0x4e, 0xed, 0x9c, 0x85, 0x5c, 0x50, 0x2e,
+	0xa0, 0x06, 0x3a, 0xd0, 0xe8, 0xe2, 0xa7, 0x66, 0x35, 0x26, 0xaa, 0xac, 0x3d, 0xb0, 0x5a, 0x82,
+	0xc4, 0x0c, 0x47, 0x30, 0x48, 0x78, 0xb8, 0x10, 0x70, 0x4d, 0x58, 0xc4, 0xd7, 0x8e, 0xd9, 0x33,
+	0xfb, 0xf5, 0xa9, 0xad, 0xd9, 0x48, 0xa1, 0x7b, 0x45, 0x0a, 0x45, 0x65, 0x0e, 0x05, 0x89, 0xa1,
+	0x24, 0x14, 0xf3, 0xa5, 0x74, 0x6a, 0x5a, 0x51, 0x31, 0x9f, 0xc4, 0x33, 0x4d, 0xec, 0xc8, 0x6a,
+	0x53, 0xc2, 0x60, 0xe9, 0x93, 0xe2, 0xac, 0x32, 0x39, 0xea, 0x99, 0xfd, 0x93, 0xd1, 0x70, 0xb3,
+	0xeb, 0x1a, 0x5f, 0xbb, 0xee, 0xb9, 0xee, 0x51, 0x44, 0x0b, 0x8f, 0x70, 0x40, 0x91, 0x9c, 0x7b,
+	0x77, 0x38, 0x46, 0x61, 0x3e, 0xc6, 0xe1, 0xc7, 0xfb, 0xa5, 0x55, 0x8e, 0x30, 0xc6, 0xe1, 0xd4,
+	0xa6, 0x84, 0xf9, 0xaa, 0xdc, 0x04, 0x67, 0x65, 0x5f, 0xc0, 0x6a, 0x15, 0x2e, 0x92, 0x4b, 0x94,
+	0x40, 0x21, 0xd1, 0x02, 0x47, 0x50, 0x20, 0xe9, 0xd4, 0x55, 0x5f, 0xa7, 0x94, 0xb0, 0x59, 0x81,
+	0x7c, 0x45, 0x7c, 0x24, 0xed, 0x2b, 0xab, 0xbd, 0xe2, 0x12, 0xc3, 0x0c, 0x4b, 0xcc, 0x24, 0xe1,
+	0xac, 0x5c, 0x81, 0x73, 0xac, 0x14, 0xcd, 0x02, 0x4e, 0x2b, 0xa6, 0x57, 0x50, 0x0c, 0xff, 0x84,
+	0x48, 0x02, 0xa3, 0x65, 0x86, 0xfe, 0x4b, 0x1a, 0x7a, 0xf8, 0x82, 0x8d, 0x4b, 0xa4, 0x15, 0x37,
+	0xf5, 0xd7, 0xb7, 0xae, 0x31, 0xba, 0xdd, 0xec, 0x5d, 0x73, 0xbb, 0x77, 0xcd, 0xef, 0xbd, 0x6b,
+	0xbe, 0x1c, 0x5c, 0x63, 0x7b, 0x70, 0x8d, 0xcf, 0x83, 0x6b, 0x3c, 0x0c, 0x62, 0x22, 0xe7, 0xcb,
+	0xc0, 0x0b, 0x39, 0x05, 0xe5, 0x71, 0xc3, 0x39, 0x22, 0xac, 0x0a, 0xc0, 0xf3, 0xdf, 0x6f, 0x90,
+	0x79, 0x8a, 0x45, 0xd0, 0x50, 0x47, 0xbc, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x2b, 0xe1, 0xb1,
+	0xdd, 0x2e, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -99,6 +181,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.JailDurationBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.JailDurationBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.VoteRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VoteRetentionBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinTotalStakedSat != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinTotalStakedSat))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MinSignedPerWindow.Size()
+		i -= size
+		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.FinalitySigTimeout != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FinalitySigTimeout))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -119,6 +236,23 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovParams(uint64(m.SignedBlocksWindow))
+	}
+	if m.FinalitySigTimeout != 0 {
+		n += 1 + sovParams(uint64(m.FinalitySigTimeout))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MinTotalStakedSat != 0 {
+		n += 1 + sovParams(uint64(m.MinTotalStakedSat))
+	}
+	if m.VoteRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.VoteRetentionBlocks))
+	}
+	if m.JailDurationBlocks != 0 {
+		n += 1 + sovParams(uint64(m.JailDurationBlocks))
+	}
 	return n
 }
 
@@ -157,6 +291,134 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalitySigTimeout", wireType)
+			}
+			m.FinalitySigTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalitySigTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTotalStakedSat", wireType)
+			}
+			m.MinTotalStakedSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinTotalStakedSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteRetentionBlocks", wireType)
+			}
+			m.VoteRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDurationBlocks", wireType)
+			}
+			m.JailDurationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailDurationBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	"github.com/babylonchain/babylon/crypto/eots"
+	bbn "github.com/babylonchain/babylon/types"
+)
+
+// GetPubRandCommitAndProofs commits to the given list of public randomness
+// via a Merkle tree, and returns the Merkle root and the inclusion proof of
+// each public randomness
+func GetPubRandCommitAndProofs(pubRandList []*eots.PublicRand) ([]byte, []*cmtcrypto.Proof) {
+	commitment, proofs := eots.CommitPubRandList(pubRandList)
+	protoProofs := make([]*cmtcrypto.Proof, 0, len(proofs))
+	for _, proof := range proofs {
+		protoProofs = append(protoProofs, proof.ToProto())
+	}
+	return commitment, protoProofs
+}
+
+// EndHeight returns the last height that the commitment covers
+func (prc *PubRandCommit) EndHeight() uint64 {
+	return prc.StartHeight + prc.NumPubRand - 1
+}
+
+// IsInRange checks whether the commitment covers the given height
+func (prc *PubRandCommit) IsInRange(height uint64) bool {
+	return prc.StartHeight <= height && height <= prc.EndHeight()
+}
+
+func (prc *PubRandCommit) Validate() error {
+	if prc.NumPubRand == 0 {
+		return fmt.Errorf("empty list of public randomness")
+	}
+	if prc.StartHeight+prc.NumPubRand < prc.StartHeight {
+		return fmt.Errorf("the range of heights [%d, %d+%d) overflows", prc.StartHeight, prc.StartHeight, prc.NumPubRand)
+	}
+	if len(prc.Commitment) != tmhash.Size {
+		return fmt.Errorf("commitment must be %d bytes, got %d", tmhash.Size, len(prc.Commitment))
+	}
+	return nil
+}
+
+// VerifyPubRand verifies that the given public randomness at the given height
+// is committed by the commitment, with the given inclusion proof
+func (prc *PubRandCommit) VerifyPubRand(height uint64, pubRand *bbn.SchnorrPubRand, protoProof *cmtcrypto.Proof) error {
+	if !prc.IsInRange(height) {
+		return fmt.Errorf("height %d is not in the range [%d, %d] of the commitment", height, prc.StartHeight, prc.EndHeight())
+	}
+	proof, err := merkle.ProofFromProto(protoProof)
+	if err != nil {
+		return fmt.Errorf("malformed inclusion proof: %w", err)
+	}
+	return eots.VerifyPubRandInclusion(prc.Commitment, prc.NumPubRand, height-prc.StartHeight, pubRand.ToFieldVal(), proof)
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
+	types "github.com/babylonchain/babylon/x/btcstaking/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -198,6 +199,13 @@ func (m *QueryBlockRequest) GetHeight() uint64 {
 type QueryBlockResponse struct {
 	// block is the Babylon at the given height
 	Block *IndexedBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	// voted_power is the voting power of the finality providers that have
+	// submitted finality signatures for the block
+	VotedPower uint64 `protobuf:"varint,2,opt,name=voted_power,json=votedPower,proto3" json:"voted_power,omitempty"`
+	// total_power is the total voting power of the finality provider set at
+	// the block's height. The block is finalised once voted_power is more than
+	// 2/3 of total_power
+	TotalPower uint64 `protobuf:"varint,3,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
 }
 
 func (m *QueryBlockResponse) Reset()         { *m = QueryBlockResponse{} }
@@ -240,6 +248,20 @@ func (m *QueryBlockResponse) GetBlock() *IndexedBlock {
 	return nil
 }
 
+func (m *QueryBlockResponse) GetVotedPower() uint64 {
+	if m != nil {
+		return m.VotedPower
+	}
+	return 0
+}
+
+func (m *QueryBlockResponse) GetTotalPower() uint64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
+}
+
 // QueryListBlocksRequest is the request type for the
 // Query/ListBlocks RPC method.
 type QueryListBlocksRequest struct {
@@ -492,6 +514,9 @@ func (m *QueryEvidenceRequest) GetFpBtcPkHex() string {
 // Query/Evidence RPC method.
 type QueryEvidenceResponse struct {
 	Evidence *Evidence `protobuf:"bytes,1,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	// extracted_btc_sk_hex is the hex str of the finality provider's BTC SK
+	// extracted from the evidence
+	ExtractedBtcSkHex string `protobuf:"bytes,2,opt,name=extracted_btc_sk_hex,json=extractedBtcSkHex,proto3" json:"extracted_btc_sk_hex,omitempty"`
 }
 
 func (m *QueryEvidenceResponse) Reset()         { *m = QueryEvidenceResponse{} }
@@ -534,6 +559,13 @@ func (m *QueryEvidenceResponse) GetEvidence() *Evidence {
 	return nil
 }
 
+func (m *QueryEvidenceResponse) GetExtractedBtcSkHex() string {
+	if m != nil {
+		return m.ExtractedBtcSkHex
+	}
+	return ""
+}
+
 // QueryListEvidencesRequest is the request type for the
 // Query/ListEvidences RPC method.
 type QueryListEvidencesRequest struct {
@@ -647,967 +679,4753 @@ func (m *QueryListEvidencesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("babylon.finality.v1.QueriedBlockStatus", QueriedBlockStatus_name, QueriedBlockStatus_value)
-	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.finality.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.finality.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryBlockRequest)(nil), "babylon.finality.v1.QueryBlockRequest")
-	proto.RegisterType((*QueryBlockResponse)(nil), "babylon.finality.v1.QueryBlockResponse")
-	proto.RegisterType((*QueryListBlocksRequest)(nil), "babylon.finality.v1.QueryListBlocksRequest")
-	proto.RegisterType((*QueryListBlocksResponse)(nil), "babylon.finality.v1.QueryListBlocksResponse")
-	proto.RegisterType((*QueryVotesAtHeightRequest)(nil), "babylon.finality.v1.QueryVotesAtHeightRequest")
-	proto.RegisterType((*QueryVotesAtHeightResponse)(nil), "babylon.finality.v1.QueryVotesAtHeightResponse")
-	proto.RegisterType((*QueryEvidenceRequest)(nil), "babylon.finality.v1.QueryEvidenceRequest")
-	proto.RegisterType((*QueryEvidenceResponse)(nil), "babylon.finality.v1.QueryEvidenceResponse")
-	proto.RegisterType((*QueryListEvidencesRequest)(nil), "babylon.finality.v1.QueryListEvidencesRequest")
-	proto.RegisterType((*QueryListEvidencesResponse)(nil), "babylon.finality.v1.QueryListEvidencesResponse")
+// QueryListPubRandCommitRequest is the request type for the
+// Query/ListPubRandCommit RPC method.
+type QueryListPubRandCommitRequest struct {
+	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the finality provider
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("babylon/finality/v1/query.proto", fileDescriptor_32bddab77af6fdae) }
-
-var fileDescriptor_32bddab77af6fdae = []byte{
-	// 854 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x4f, 0x33, 0x45,
-	0x18, 0xee, 0xf0, 0x7d, 0x94, 0x8f, 0xf9, 0xbe, 0x1a, 0x18, 0x10, 0x71, 0x91, 0x02, 0x8b, 0x16,
-	0x04, 0xb2, 0x43, 0x8b, 0xd1, 0x10, 0x0f, 0x48, 0x23, 0x48, 0x15, 0x4b, 0x5d, 0x13, 0x13, 0xb9,
-	0x34, 0xbb, 0x65, 0xd8, 0x6e, 0x68, 0x77, 0x96, 0xee, 0xb4, 0x69, 0x43, 0x48, 0x8c, 0x07, 0x2e,
-	0x7a, 0x30, 0xf1, 0xe2, 0x85, 0x83, 0x5e, 0xfd, 0x47, 0x38, 0x92, 0x78, 0x31, 0x1e, 0x88, 0x01,
-	0xff, 0x10, 0xb3, 0x33, 0xb3, 0x5d, 0x16, 0xb6, 0xb4, 0x07, 0x6e, 0xec, 0xcc, 0xf3, 0xbe, 0xcf,
-	0xf3, 0xfe, 0x98, 0x87, 0xc2, 0x39, 0xd3, 0x30, 0x3b, 0x35, 0xea, 0xe0, 0x63, 0xdb, 0x31, 0x6a,
-	0x36, 0xeb, 0xe0, 0x56, 0x16, 0x9f, 0x36, 0x49, 0xa3, 0xa3, 0xb9, 0x0d, 0xca, 0x28, 0x9a, 0x90,
-	0x00, 0x2d, 0x00, 0x68, 0xad, 0xac, 0x32, 0x69, 0x51, 0x8b, 0xf2, 0x7b, 0xec, 0xff, 0x25, 0xa0,
-	0xca, 0x7b, 0x16, 0xa5, 0x56, 0x8d, 0x60, 0xc3, 0xb5, 0xb1, 0xe1, 0x38, 0x94, 0x19, 0xcc, 0xa6,
-	0x8e, 0x27, 0x6f, 0x57, 0x2a, 0xd4, 0xab, 0x53, 0x0f, 0x9b, 0x86, 0x47, 0x04, 0x03, 0x6e, 0x65,
-	0x4d, 0xc2, 0x8c, 0x2c, 0x76, 0x0d, 0xcb, 0x76, 0x38, 0x58, 0x62, 0xe7, 0xe3, 0x54, 0xb9, 0x46,
-	0xc3, 0xa8, 0x07, 0xd9, 0xd4, 0x38, 0x44, 0x57, 0x22, 0xc7, 0xa8, 0x93, 0x10, 0x7d, 0xe3, 0xf3,
-	0x94, 0x78, 0xa0, 0x4e, 0x4e, 0x9b, 0xc4, 0x63, 0x6a, 0x09, 0x4e, 0x44, 0x4e, 0x3d, 0x97, 0x3a,
-	0x1e, 0x41, 0x9b, 0x30, 0x29, 0x08, 0xa6, 0xc1, 0x3c, 0x58, 0x7e, 0x9d, 0x9b, 0xd1, 0x62, 0x0a,
-	0xd7, 0x44, 0x50, 0xfe, 0xe5, 0xd5, 0xcd, 0x5c, 0x42, 0x97, 0x01, 0xea, 0x2a, 0x1c, 0xe7, 0x19,
-	0xf3, 0x35, 0x5a, 0x39, 0x91, 0x34, 0x68, 0x0a, 0x26, 0xab, 0xc4, 0xb6, 0xaa, 0x8c, 0xe7, 0x7b,
-	0xa9, 0xcb, 0x2f, 0xf5, 0x6b, 0x29, 0x4a, 0x82, 0x25, 0xfb, 0x27, 0x70, 0xd8, 0xf4, 0x0f, 0x24,
-	0xf9, 0x42, 0x2c, 0x79, 0xc1, 0x39, 0x22, 0x6d, 0x72, 0x24, 0x22, 0x05, 0x5e, 0xfd, 0x1d, 0xc0,
-	0x29, 0x9e, 0x6f, 0xdf, 0xf6, 0x18, 0xbf, 0x09, 0x0a, 0x45, 0x5b, 0x30, 0xe9, 0x31, 0x83, 0x35,
-	0x45, 0x45, 0x6f, 0xe5, 0x96, 0x62, 0x93, 0xfa, 0xc1, 0xb6, 0x4c, 0xfa, 0x2d, 0x87, 0xeb, 0x32,
-	0x0c, 0xed, 0x42, 0x18, 0x4e, 0x66, 0x7a, 0x88, 0x2b, 0xcb, 0x68, 0x62, 0x8c, 0x9a, 0x3f, 0x46,
-	0x4d, 0x2c, 0x8a, 0x1c, 0xa3, 0x56, 0x32, 0x2c, 0x22, 0xc9, 0xf5, 0x7b, 0x91, 0xea, 0x25, 0x80,
-	0xef, 0x3c, 0xd2, 0x18, 0xb6, 0x9d, 0x17, 0xe2, 0x8b, 0x7c, 0x31, 0x58, 0xe5, 0x32, 0x00, 0x7d,
-	0x11, 0x23, 0x6f, 0xa9, 0xaf, 0x3c, 0xc1, 0x1b, 0xd1, 0xb7, 0x01, 0xdf, 0xe5, 0xf2, 0xbe, 0xa3,
-	0x8c, 0x78, 0xdb, 0x6c, 0x8f, 0x0f, 0xaa, 0xdf, 0x1c, 0xeb, 0x50, 0x89, 0x0b, 0x92, 0x65, 0x1d,
-	0xc0, 0x11, 0x93, 0x55, 0xca, 0xae, 0xac, 0xeb, 0x4d, 0xfe, 0xe3, 0x7f, 0x6e, 0xe6, 0x72, 0x96,
-	0xcd, 0xaa, 0x4d, 0x53, 0xab, 0xd0, 0x3a, 0x96, 0x55, 0x56, 0xaa, 0x86, 0xed, 0x04, 0x1f, 0x98,
-	0x75, 0x5c, 0xe2, 0x69, 0xf9, 0x42, 0x69, 0xe3, 0xa3, 0xf5, 0x52, 0xd3, 0xfc, 0x8a, 0x74, 0xf4,
-	0xa4, 0xc9, 0x2a, 0xa5, 0x13, 0x4f, 0xdd, 0x84, 0x93, 0x9c, 0x6e, 0xa7, 0x65, 0x1f, 0x11, 0xa7,
-	0x12, 0xf4, 0x19, 0x2d, 0xc0, 0xd4, 0xb1, 0x5b, 0x16, 0x5c, 0xe5, 0x2a, 0x69, 0x73, 0x95, 0xa3,
-	0x3a, 0x3c, 0x76, 0xf3, 0x7e, 0xe0, 0x1e, 0x69, 0xab, 0x3a, 0x7c, 0xfb, 0x41, 0x68, 0xb7, 0xf7,
-	0xaf, 0x88, 0x3c, 0x93, 0x7b, 0x37, 0x1b, 0xdb, 0xfd, 0x6e, 0x60, 0x17, 0xae, 0x5e, 0x00, 0xd9,
-	0x33, 0x7f, 0xa4, 0xc1, 0xbd, 0x17, 0x8a, 0x7a, 0xe3, 0x31, 0xa3, 0xc1, 0xca, 0x91, 0xce, 0xbd,
-	0xe6, 0x67, 0xa2, 0x51, 0xcf, 0xb6, 0x5b, 0x7f, 0x00, 0x39, 0x87, 0x07, 0x42, 0x64, 0x89, 0x9f,
-	0xc2, 0xd1, 0x40, 0x73, 0xb0, 0x61, 0x7d, 0x6a, 0x0c, 0xf1, 0xcf, 0xb6, 0x60, 0x2b, 0x5b, 0xe2,
-	0xcd, 0x47, 0x9f, 0x19, 0x1a, 0x87, 0xa9, 0xe2, 0x41, 0xb1, 0xbc, 0x5b, 0x28, 0x6e, 0xef, 0x17,
-	0x0e, 0x77, 0x3e, 0x1f, 0x4b, 0xa0, 0x14, 0x1c, 0x0d, 0x3f, 0x01, 0x1a, 0x81, 0x2f, 0xb6, 0x8b,
-	0xdf, 0x8f, 0x0d, 0xe5, 0x7e, 0x1a, 0x81, 0xc3, 0xbc, 0x4a, 0xf4, 0x03, 0x80, 0x49, 0x61, 0x42,
-	0xa8, 0xf7, 0x7b, 0x8e, 0x3a, 0x9e, 0xb2, 0xdc, 0x1f, 0x28, 0x44, 0xab, 0x8b, 0x3f, 0xfe, 0xf5,
-	0xdf, 0xaf, 0x43, 0xb3, 0x68, 0x06, 0xf7, 0x36, 0x60, 0x74, 0x01, 0xe0, 0x30, 0xaf, 0x03, 0x65,
-	0x7a, 0x27, 0xbe, 0xef, 0x85, 0xca, 0x52, 0x5f, 0x9c, 0xe4, 0x5f, 0xe3, 0xfc, 0x19, 0xf4, 0x7e,
-	0x2c, 0xbf, 0x78, 0xf7, 0xf8, 0x4c, 0x6c, 0xd5, 0x39, 0xfa, 0x19, 0x40, 0x18, 0x5a, 0x0a, 0x5a,
-	0xed, 0xcd, 0xf2, 0xc8, 0x1c, 0x95, 0xb5, 0xc1, 0xc0, 0x03, 0xf5, 0x45, 0xfa, 0xd1, 0x25, 0x80,
-	0xa9, 0x88, 0x1b, 0x20, 0xad, 0x37, 0x49, 0x9c, 0xd7, 0x28, 0x78, 0x60, 0xbc, 0xd4, 0xb5, 0xca,
-	0x75, 0x7d, 0x80, 0x16, 0x63, 0x75, 0xb5, 0xfc, 0x98, 0xb0, 0x5d, 0x7f, 0x02, 0xf8, 0x2a, 0x58,
-	0x73, 0xf4, 0x61, 0x6f, 0xaa, 0x07, 0x16, 0xa3, 0xac, 0x0c, 0x02, 0x95, 0x82, 0xf6, 0xb8, 0xa0,
-	0x3c, 0xfa, 0x0c, 0x3f, 0xf5, 0xff, 0xb9, 0xec, 0x36, 0xa8, 0x1f, 0xd9, 0xf0, 0xf0, 0x59, 0xc4,
-	0xbd, 0xce, 0x71, 0xf0, 0xfa, 0xd0, 0x6f, 0x00, 0xa6, 0x22, 0x6f, 0xfa, 0xa9, 0x6e, 0xc6, 0xb9,
-	0xd0, 0x53, 0xdd, 0x8c, 0x35, 0x0b, 0x35, 0xc3, 0xc5, 0xcf, 0xa3, 0x74, 0xac, 0xf8, 0xae, 0x2f,
-	0xe4, 0xbf, 0xbc, 0xba, 0x4d, 0x83, 0xeb, 0xdb, 0x34, 0xf8, 0xf7, 0x36, 0x0d, 0x7e, 0xb9, 0x4b,
-	0x27, 0xae, 0xef, 0xd2, 0x89, 0xbf, 0xef, 0xd2, 0x89, 0xc3, 0xf5, 0x7e, 0x0e, 0xdf, 0x0e, 0x53,
-	0x72, 0xb3, 0x37, 0x93, 0xfc, 0xa7, 0xca, 0xc6, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x11,
-	0x04, 0x7a, 0x88, 0x09, 0x00, 0x00,
+func (m *QueryListPubRandCommitRequest) Reset()         { *m = QueryListPubRandCommitRequest{} }
+func (m *QueryListPubRandCommitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListPubRandCommitRequest) ProtoMessage()    {}
+func (*QueryListPubRandCommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{12}
+}
+func (m *QueryListPubRandCommitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListPubRandCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListPubRandCommitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListPubRandCommitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListPubRandCommitRequest.Merge(m, src)
+}
+func (m *QueryListPubRandCommitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListPubRandCommitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListPubRandCommitRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryListPubRandCommitRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryListPubRandCommitRequest) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
+	}
+	return ""
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Block queries a block at a given height
-	Block(ctx context.Context, in *QueryBlockRequest, opts ...grpc.CallOption) (*QueryBlockResponse, error)
-	// ListBlocks is a range query for blocks at a given status
-	ListBlocks(ctx context.Context, in *QueryListBlocksRequest, opts ...grpc.CallOption) (*QueryListBlocksResponse, error)
-	// VotesAtHeight queries finality providers who have signed the block at given height.
-	VotesAtHeight(ctx context.Context, in *QueryVotesAtHeightRequest, opts ...grpc.CallOption) (*QueryVotesAtHeightResponse, error)
-	// Evidence queries the first evidence which can be used for extracting the BTC SK
-	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
-	// ListEvidences queries is a range query for evidences
-	ListEvidences(ctx context.Context, in *QueryListEvidencesRequest, opts ...grpc.CallOption) (*QueryListEvidencesResponse, error)
+func (m *QueryListPubRandCommitRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryListPubRandCommitResponse is the response type for the
+// Query/ListPubRandCommit RPC method.
+type QueryListPubRandCommitResponse struct {
+	// pub_rand_commits is the list of public randomness commitments
+	PubRandCommits []*PubRandCommit `protobuf:"bytes,1,rep,name=pub_rand_commits,json=pubRandCommits,proto3" json:"pub_rand_commits,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryListPubRandCommitResponse) Reset()         { *m = QueryListPubRandCommitResponse{} }
+func (m *QueryListPubRandCommitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListPubRandCommitResponse) ProtoMessage()    {}
+func (*QueryListPubRandCommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{13}
+}
+func (m *QueryListPubRandCommitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListPubRandCommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListPubRandCommitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListPubRandCommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListPubRandCommitResponse.Merge(m, src)
+}
+func (m *QueryListPubRandCommitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListPubRandCommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListPubRandCommitResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListPubRandCommitResponse proto.InternalMessageInfo
+
+func (m *QueryListPubRandCommitResponse) GetPubRandCommits() []*PubRandCommit {
+	if m != nil {
+		return m.PubRandCommits
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Block(ctx context.Context, in *QueryBlockRequest, opts ...grpc.CallOption) (*QueryBlockResponse, error) {
-	out := new(QueryBlockResponse)
-	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/Block", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListPubRandCommitResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ListBlocks(ctx context.Context, in *QueryListBlocksRequest, opts ...grpc.CallOption) (*QueryListBlocksResponse, error) {
-	out := new(QueryListBlocksResponse)
-	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/ListBlocks", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryLastPubRandCommitRequest is the request type for the
+// Query/LastPubRandCommit RPC method.
+type QueryLastPubRandCommitRequest struct {
+	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the finality provider
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+}
+
+func (m *QueryLastPubRandCommitRequest) Reset()         { *m = QueryLastPubRandCommitRequest{} }
+func (m *QueryLastPubRandCommitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLastPubRandCommitRequest) ProtoMessage()    {}
+func (*QueryLastPubRandCommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{14}
+}
+func (m *QueryLastPubRandCommitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastPubRandCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastPubRandCommitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryLastPubRandCommitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastPubRandCommitRequest.Merge(m, src)
+}
+func (m *QueryLastPubRandCommitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastPubRandCommitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastPubRandCommitRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) VotesAtHeight(ctx context.Context, in *QueryVotesAtHeightRequest, opts ...grpc.CallOption) (*QueryVotesAtHeightResponse, error) {
-	out := new(QueryVotesAtHeightResponse)
-	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/VotesAtHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLastPubRandCommitRequest proto.InternalMessageInfo
+
+func (m *QueryLastPubRandCommitRequest) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error) {
-	out := new(QueryEvidenceResponse)
-	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/Evidence", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryLastPubRandCommitResponse is the response type for the
+// Query/LastPubRandCommit RPC method.
+type QueryLastPubRandCommitResponse struct {
+	// pub_rand_commit is the last public randomness commitment
+	PubRandCommit *PubRandCommit `protobuf:"bytes,1,opt,name=pub_rand_commit,json=pubRandCommit,proto3" json:"pub_rand_commit,omitempty"`
+	// last_committed_height is the last height the finality provider has
+	// committed public randomness for
+	LastCommittedHeight uint64 `protobuf:"varint,2,opt,name=last_committed_height,json=lastCommittedHeight,proto3" json:"last_committed_height,omitempty"`
+}
+
+func (m *QueryLastPubRandCommitResponse) Reset()         { *m = QueryLastPubRandCommitResponse{} }
+func (m *QueryLastPubRandCommitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLastPubRandCommitResponse) ProtoMessage()    {}
+func (*QueryLastPubRandCommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{15}
+}
+func (m *QueryLastPubRandCommitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastPubRandCommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastPubRandCommitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryLastPubRandCommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastPubRandCommitResponse.Merge(m, src)
+}
+func (m *QueryLastPubRandCommitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastPubRandCommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastPubRandCommitResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListEvidences(ctx context.Context, in *QueryListEvidencesRequest, opts ...grpc.CallOption) (*QueryListEvidencesResponse, error) {
-	out := new(QueryListEvidencesResponse)
-	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/ListEvidences", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLastPubRandCommitResponse proto.InternalMessageInfo
+
+func (m *QueryLastPubRandCommitResponse) GetPubRandCommit() *PubRandCommit {
+	if m != nil {
+		return m.PubRandCommit
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Block queries a block at a given height
-	Block(context.Context, *QueryBlockRequest) (*QueryBlockResponse, error)
-	// ListBlocks is a range query for blocks at a given status
-	ListBlocks(context.Context, *QueryListBlocksRequest) (*QueryListBlocksResponse, error)
-	// VotesAtHeight queries finality providers who have signed the block at given height.
-	VotesAtHeight(context.Context, *QueryVotesAtHeightRequest) (*QueryVotesAtHeightResponse, error)
-	// Evidence queries the first evidence which can be used for extracting the BTC SK
-	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
-	// ListEvidences queries is a range query for evidences
-	ListEvidences(context.Context, *QueryListEvidencesRequest) (*QueryListEvidencesResponse, error)
+func (m *QueryLastPubRandCommitResponse) GetLastCommittedHeight() uint64 {
+	if m != nil {
+		return m.LastCommittedHeight
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QuerySigningInfoRequest is the request type for the
+// Query/SigningInfo RPC method.
+type QuerySigningInfoRequest struct {
+	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the finality provider
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QuerySigningInfoRequest) Reset()         { *m = QuerySigningInfoRequest{} }
+func (m *QuerySigningInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningInfoRequest) ProtoMessage()    {}
+func (*QuerySigningInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{16}
 }
-func (*UnimplementedQueryServer) Block(ctx context.Context, req *QueryBlockRequest) (*QueryBlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Block not implemented")
+func (m *QuerySigningInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ListBlocks(ctx context.Context, req *QueryListBlocksRequest) (*QueryListBlocksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListBlocks not implemented")
+func (m *QuerySigningInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) VotesAtHeight(ctx context.Context, req *QueryVotesAtHeightRequest) (*QueryVotesAtHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VotesAtHeight not implemented")
+func (m *QuerySigningInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningInfoRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Evidence(ctx context.Context, req *QueryEvidenceRequest) (*QueryEvidenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Evidence not implemented")
+func (m *QuerySigningInfoRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ListEvidences(ctx context.Context, req *QueryListEvidencesRequest) (*QueryListEvidencesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListEvidences not implemented")
+func (m *QuerySigningInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningInfoRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QuerySigningInfoRequest proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.finality.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QuerySigningInfoRequest) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Block(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.finality.v1.Query/Block",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Block(ctx, req.(*QueryBlockRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QuerySigningInfoResponse is the response type for the
+// Query/SigningInfo RPC method.
+type QuerySigningInfoResponse struct {
+	// signing_info is the signing info of the finality provider
+	SigningInfo FinalityProviderSigningInfo `protobuf:"bytes,1,opt,name=signing_info,json=signingInfo,proto3" json:"signing_info"`
 }
 
-func _Query_ListBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListBlocksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListBlocks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.finality.v1.Query/ListBlocks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListBlocks(ctx, req.(*QueryListBlocksRequest))
+func (m *QuerySigningInfoResponse) Reset()         { *m = QuerySigningInfoResponse{} }
+func (m *QuerySigningInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningInfoResponse) ProtoMessage()    {}
+func (*QuerySigningInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{17}
+}
+func (m *QuerySigningInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QuerySigningInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningInfoResponse.Merge(m, src)
+}
+func (m *QuerySigningInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningInfoResponse.DiscardUnknown(m)
 }
 
-func _Query_VotesAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVotesAtHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).VotesAtHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.finality.v1.Query/VotesAtHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VotesAtHeight(ctx, req.(*QueryVotesAtHeightRequest))
+var xxx_messageInfo_QuerySigningInfoResponse proto.InternalMessageInfo
+
+func (m *QuerySigningInfoResponse) GetSigningInfo() FinalityProviderSigningInfo {
+	if m != nil {
+		return m.SigningInfo
 	}
-	return interceptor(ctx, in, info, handler)
+	return FinalityProviderSigningInfo{}
 }
 
-func _Query_Evidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEvidenceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Evidence(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.finality.v1.Query/Evidence",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Evidence(ctx, req.(*QueryEvidenceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QuerySigningInfosRequest is the request type for the
+// Query/SigningInfos RPC method.
+type QuerySigningInfosRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_ListEvidences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListEvidencesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListEvidences(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.finality.v1.Query/ListEvidences",
+func (m *QuerySigningInfosRequest) Reset()         { *m = QuerySigningInfosRequest{} }
+func (m *QuerySigningInfosRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningInfosRequest) ProtoMessage()    {}
+func (*QuerySigningInfosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{18}
+}
+func (m *QuerySigningInfosRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningInfosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningInfosRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListEvidences(ctx, req.(*QueryListEvidencesRequest))
+}
+func (m *QuerySigningInfosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningInfosRequest.Merge(m, src)
+}
+func (m *QuerySigningInfosRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningInfosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningInfosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySigningInfosRequest proto.InternalMessageInfo
+
+func (m *QuerySigningInfosRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylon.finality.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Block",
-			Handler:    _Query_Block_Handler,
-		},
-		{
-			MethodName: "ListBlocks",
-			Handler:    _Query_ListBlocks_Handler,
-		},
-		{
-			MethodName: "VotesAtHeight",
-			Handler:    _Query_VotesAtHeight_Handler,
-		},
-		{
-			MethodName: "Evidence",
-			Handler:    _Query_Evidence_Handler,
-		},
-		{
-			MethodName: "ListEvidences",
-			Handler:    _Query_ListEvidences_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "babylon/finality/v1/query.proto",
+// QuerySigningInfosResponse is the response type for the
+// Query/SigningInfos RPC method.
+type QuerySigningInfosResponse struct {
+	// signing_infos is the signing infos of the finality providers
+	SigningInfos []FinalityProviderSigningInfo `protobuf:"bytes,1,rep,name=signing_infos,json=signingInfos,proto3" json:"signing_infos"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySigningInfosResponse) Reset()         { *m = QuerySigningInfosResponse{} }
+func (m *QuerySigningInfosResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningInfosResponse) ProtoMessage()    {}
+func (*QuerySigningInfosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{19}
+}
+func (m *QuerySigningInfosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningInfosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningInfosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySigningInfosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningInfosResponse.Merge(m, src)
+}
+func (m *QuerySigningInfosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningInfosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningInfosResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QuerySigningInfosResponse proto.InternalMessageInfo
+
+func (m *QuerySigningInfosResponse) GetSigningInfos() []FinalityProviderSigningInfo {
+	if m != nil {
+		return m.SigningInfos
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryConsumerFinalizedBlockRequest is the request type for the
+// Query/ConsumerFinalizedBlock RPC method.
+type QueryConsumerFinalizedBlockRequest struct {
+	// consumer_id is the ID of the consumer system
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// height is the height of the block in the consumer system
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryConsumerFinalizedBlockRequest) Reset()         { *m = QueryConsumerFinalizedBlockRequest{} }
+func (m *QueryConsumerFinalizedBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerFinalizedBlockRequest) ProtoMessage()    {}
+func (*QueryConsumerFinalizedBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{20}
+}
+func (m *QueryConsumerFinalizedBlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerFinalizedBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerFinalizedBlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryBlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryConsumerFinalizedBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerFinalizedBlockRequest.Merge(m, src)
 }
-
-func (m *QueryBlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryConsumerFinalizedBlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerFinalizedBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerFinalizedBlockRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryConsumerFinalizedBlockRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerFinalizedBlockRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryBlockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerFinalizedBlockRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryBlockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryConsumerFinalizedBlockResponse is the response type for the
+// Query/ConsumerFinalizedBlock RPC method.
+type QueryConsumerFinalizedBlockResponse struct {
+	// block is the finalized block of the consumer system at the given height
+	Block *ConsumerBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
 }
 
-func (m *QueryBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Block != nil {
-		{
-			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryConsumerFinalizedBlockResponse) Reset()         { *m = QueryConsumerFinalizedBlockResponse{} }
+func (m *QueryConsumerFinalizedBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerFinalizedBlockResponse) ProtoMessage()    {}
+func (*QueryConsumerFinalizedBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{21}
+}
+func (m *QueryConsumerFinalizedBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerFinalizedBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerFinalizedBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumerFinalizedBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerFinalizedBlockResponse.Merge(m, src)
+}
+func (m *QueryConsumerFinalizedBlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerFinalizedBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerFinalizedBlockResponse.DiscardUnknown(m)
 }
 
-func (m *QueryListBlocksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerFinalizedBlockResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerFinalizedBlockResponse) GetBlock() *ConsumerBlock {
+	if m != nil {
+		return m.Block
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryListBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDelegationLifecycleRequest is the request type for the
+// Query/DelegationLifecycle RPC method.
+type QueryDelegationLifecycleRequest struct {
+	// staking_tx_hash_hex is the hash of the staking tx of the BTC delegation
+	// in hex
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
 }
 
-func (m *QueryListBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDelegationLifecycleRequest) Reset()         { *m = QueryDelegationLifecycleRequest{} }
+func (m *QueryDelegationLifecycleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationLifecycleRequest) ProtoMessage()    {}
+func (*QueryDelegationLifecycleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{22}
+}
+func (m *QueryDelegationLifecycleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationLifecycleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationLifecycleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDelegationLifecycleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationLifecycleRequest.Merge(m, src)
+}
+func (m *QueryDelegationLifecycleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationLifecycleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationLifecycleRequest.DiscardUnknown(m)
 }
 
-func (m *QueryListBlocksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegationLifecycleRequest proto.InternalMessageInfo
+
+func (m *QueryDelegationLifecycleRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryListBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDelegationLifecycleResponse is the response type for the
+// Query/DelegationLifecycle RPC method.
+type QueryDelegationLifecycleResponse struct {
+	// btc_delegation is the BTC delegation with its current status and its
+	// unbonding information
+	BtcDelegation *types.BTCDelegationResponse `protobuf:"bytes,1,opt,name=btc_delegation,json=btcDelegation,proto3" json:"btc_delegation,omitempty"`
+	// covenant_quorum is the number of covenant signatures the BTC delegation
+	// needs to become active
+	CovenantQuorum uint32 `protobuf:"varint,2,opt,name=covenant_quorum,json=covenantQuorum,proto3" json:"covenant_quorum,omitempty"`
+	// num_covenant_sigs is the number of covenant members that have signed the
+	// BTC delegation so far
+	NumCovenantSigs uint32 `protobuf:"varint,3,opt,name=num_covenant_sigs,json=numCovenantSigs,proto3" json:"num_covenant_sigs,omitempty"`
+	// staking_tx_depth is the depth of the staking tx in the canonical BTC
+	// chain
+	StakingTxDepth uint64 `protobuf:"varint,4,opt,name=staking_tx_depth,json=stakingTxDepth,proto3" json:"staking_tx_depth,omitempty"`
+	// staking_tx_reorged is whether the BTC header including the staking tx is
+	// no longer on the canonical BTC chain
+	StakingTxReorged bool `protobuf:"varint,5,opt,name=staking_tx_reorged,json=stakingTxReorged,proto3" json:"staking_tx_reorged,omitempty"`
+	// unbonding_requested is whether the staker has requested to unbond the
+	// BTC delegation early by submitting its signature on the unbonding tx
+	UnbondingRequested bool `protobuf:"varint,6,opt,name=unbonding_requested,json=unbondingRequested,proto3" json:"unbonding_requested,omitempty"`
+	// finality_providers is the finality activity of each finality provider
+	// that the BTC delegation delegates to
+	FinalityProviders []FinalityProviderActivity `protobuf:"bytes,7,rep,name=finality_providers,json=finalityProviders,proto3" json:"finality_providers"`
+}
+
+func (m *QueryDelegationLifecycleResponse) Reset()         { *m = QueryDelegationLifecycleResponse{} }
+func (m *QueryDelegationLifecycleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationLifecycleResponse) ProtoMessage()    {}
+func (*QueryDelegationLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{23}
+}
+func (m *QueryDelegationLifecycleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryListBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDelegationLifecycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationLifecycleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Blocks) > 0 {
-		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryDelegationLifecycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationLifecycleResponse.Merge(m, src)
+}
+func (m *QueryDelegationLifecycleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationLifecycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationLifecycleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationLifecycleResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationLifecycleResponse) GetBtcDelegation() *types.BTCDelegationResponse {
+	if m != nil {
+		return m.BtcDelegation
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryVotesAtHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDelegationLifecycleResponse) GetCovenantQuorum() uint32 {
+	if m != nil {
+		return m.CovenantQuorum
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryVotesAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDelegationLifecycleResponse) GetNumCovenantSigs() uint32 {
+	if m != nil {
+		return m.NumCovenantSigs
+	}
+	return 0
 }
 
-func (m *QueryVotesAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryDelegationLifecycleResponse) GetStakingTxDepth() uint64 {
+	if m != nil {
+		return m.StakingTxDepth
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryVotesAtHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDelegationLifecycleResponse) GetStakingTxReorged() bool {
+	if m != nil {
+		return m.StakingTxReorged
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryVotesAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDelegationLifecycleResponse) GetUnbondingRequested() bool {
+	if m != nil {
+		return m.UnbondingRequested
+	}
+	return false
 }
 
-func (m *QueryVotesAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BtcPks) > 0 {
-		for iNdEx := len(m.BtcPks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.BtcPks[iNdEx].Size()
-				i -= size
-				if _, err := m.BtcPks[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDelegationLifecycleResponse) GetFinalityProviders() []FinalityProviderActivity {
+	if m != nil {
+		return m.FinalityProviders
+	}
+	return nil
+}
+
+// FinalityProviderActivity is the finality activity of a finality provider
+type FinalityProviderActivity struct {
+	// btc_pk is the BTC PK of the finality provider
+	BtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
+	// voting_power is the voting power of the finality provider at the current
+	// Babylon height
+	VotingPower uint64 `protobuf:"varint,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// slashed is whether the finality provider is slashed
+	Slashed bool `protobuf:"varint,3,opt,name=slashed,proto3" json:"slashed,omitempty"`
+	// jailed is whether the finality provider is jailed
+	Jailed bool `protobuf:"varint,4,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// signing_info is the signing info tracking the liveness of the finality
+	// provider. It is nil if the finality provider has never had voting power
+	SigningInfo *FinalityProviderSigningInfo `protobuf:"bytes,5,opt,name=signing_info,json=signingInfo,proto3" json:"signing_info,omitempty"`
+}
+
+func (m *FinalityProviderActivity) Reset()         { *m = FinalityProviderActivity{} }
+func (m *FinalityProviderActivity) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderActivity) ProtoMessage()    {}
+func (*FinalityProviderActivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{24}
+}
+func (m *FinalityProviderActivity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderActivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderActivity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *FinalityProviderActivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderActivity.Merge(m, src)
+}
+func (m *FinalityProviderActivity) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderActivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderActivity.DiscardUnknown(m)
 }
 
-func (m *QueryEvidenceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FinalityProviderActivity proto.InternalMessageInfo
+
+func (m *FinalityProviderActivity) GetVotingPower() uint64 {
+	if m != nil {
+		return m.VotingPower
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryEvidenceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FinalityProviderActivity) GetSlashed() bool {
+	if m != nil {
+		return m.Slashed
+	}
+	return false
 }
 
-func (m *QueryEvidenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FpBtcPkHex) > 0 {
-		i -= len(m.FpBtcPkHex)
-		copy(dAtA[i:], m.FpBtcPkHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
-		i--
-		dAtA[i] = 0xa
+func (m *FinalityProviderActivity) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryEvidenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FinalityProviderActivity) GetSigningInfo() *FinalityProviderSigningInfo {
+	if m != nil {
+		return m.SigningInfo
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryActivatedHeightRequest is the request type for the
+// Query/ActivatedHeight RPC method.
+type QueryActivatedHeightRequest struct {
 }
 
-func (m *QueryEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Evidence != nil {
-		{
-			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryActivatedHeightRequest) Reset()         { *m = QueryActivatedHeightRequest{} }
+func (m *QueryActivatedHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActivatedHeightRequest) ProtoMessage()    {}
+func (*QueryActivatedHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{25}
+}
+func (m *QueryActivatedHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActivatedHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActivatedHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryListEvidencesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryActivatedHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActivatedHeightRequest.Merge(m, src)
+}
+func (m *QueryActivatedHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActivatedHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActivatedHeightRequest.DiscardUnknown(m)
 }
 
-func (m *QueryListEvidencesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryActivatedHeightRequest proto.InternalMessageInfo
+
+// QueryActivatedHeightResponse is the response type for the
+// Query/ActivatedHeight RPC method.
+type QueryActivatedHeightResponse struct {
+	// height is the Babylon height at which the finality gadget activated
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *QueryListEvidencesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryActivatedHeightResponse) Reset()         { *m = QueryActivatedHeightResponse{} }
+func (m *QueryActivatedHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActivatedHeightResponse) ProtoMessage()    {}
+func (*QueryActivatedHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{26}
+}
+func (m *QueryActivatedHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActivatedHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActivatedHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.StartHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryActivatedHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActivatedHeightResponse.Merge(m, src)
+}
+func (m *QueryActivatedHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActivatedHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActivatedHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActivatedHeightResponse proto.InternalMessageInfo
+
+func (m *QueryActivatedHeightResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryListEvidencesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterEnum("babylon.finality.v1.QueriedBlockStatus", QueriedBlockStatus_name, QueriedBlockStatus_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.finality.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.finality.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryBlockRequest)(nil), "babylon.finality.v1.QueryBlockRequest")
+	proto.RegisterType((*QueryBlockResponse)(nil), "babylon.finality.v1.QueryBlockResponse")
+	proto.RegisterType((*QueryListBlocksRequest)(nil), "babylon.finality.v1.QueryListBlocksRequest")
+	proto.RegisterType((*QueryListBlocksResponse)(nil), "babylon.finality.v1.QueryListBlocksResponse")
+	proto.RegisterType((*QueryVotesAtHeightRequest)(nil), "babylon.finality.v1.QueryVotesAtHeightRequest")
+	proto.RegisterType((*QueryVotesAtHeightResponse)(nil), "babylon.finality.v1.QueryVotesAtHeightResponse")
+	proto.RegisterType((*QueryEvidenceRequest)(nil), "babylon.finality.v1.QueryEvidenceRequest")
+	proto.RegisterType((*QueryEvidenceResponse)(nil), "babylon.finality.v1.QueryEvidenceResponse")
+	proto.RegisterType((*QueryListEvidencesRequest)(nil), "babylon.finality.v1.QueryListEvidencesRequest")
+	proto.RegisterType((*QueryListEvidencesResponse)(nil), "babylon.finality.v1.QueryListEvidencesResponse")
+	proto.RegisterType((*QueryListPubRandCommitRequest)(nil), "babylon.finality.v1.QueryListPubRandCommitRequest")
+	proto.RegisterType((*QueryListPubRandCommitResponse)(nil), "babylon.finality.v1.QueryListPubRandCommitResponse")
+	proto.RegisterType((*QueryLastPubRandCommitRequest)(nil), "babylon.finality.v1.QueryLastPubRandCommitRequest")
+	proto.RegisterType((*QueryLastPubRandCommitResponse)(nil), "babylon.finality.v1.QueryLastPubRandCommitResponse")
+	proto.RegisterType((*QuerySigningInfoRequest)(nil), "babylon.finality.v1.QuerySigningInfoRequest")
+	proto.RegisterType((*QuerySigningInfoResponse)(nil), "babylon.finality.v1.QuerySigningInfoResponse")
+	proto.RegisterType((*QuerySigningInfosRequest)(nil), "babylon.finality.v1.QuerySigningInfosRequest")
+	proto.RegisterType((*QuerySigningInfosResponse)(nil), "babylon.finality.v1.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryConsumerFinalizedBlockRequest)(nil), "babylon.finality.v1.QueryConsumerFinalizedBlockRequest")
+	proto.RegisterType((*QueryConsumerFinalizedBlockResponse)(nil), "babylon.finality.v1.QueryConsumerFinalizedBlockResponse")
+	proto.RegisterType((*QueryDelegationLifecycleRequest)(nil), "babylon.finality.v1.QueryDelegationLifecycleRequest")
+	proto.RegisterType((*QueryDelegationLifecycleResponse)(nil), "babylon.finality.v1.QueryDelegationLifecycleResponse")
+	proto.RegisterType((*FinalityProviderActivity)(nil), "babylon.finality.v1.FinalityProviderActivity")
+	proto.RegisterType((*QueryActivatedHeightRequest)(nil), "babylon.finality.v1.QueryActivatedHeightRequest")
+	proto.RegisterType((*QueryActivatedHeightResponse)(nil), "babylon.finality.v1.QueryActivatedHeightResponse")
+}
+
+func init() { proto.RegisterFile("babylon/finality/v1/query.proto", fileDescriptor_32bddab77af6fdae) }
+
+var fileDescriptor_32bddab77af6fdae = []byte{
+	// 1731 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdd, 0x4f, 0x1b, 0xc7,
+	0x16, 0x67, 0x21, 0x18, 0x72, 0x8c, 0xf9, 0x18, 0x48, 0xae, 0xe3, 0x04, 0x43, 0x36, 0x09, 0x70,
+	0x09, 0x78, 0xf9, 0xc8, 0xcd, 0x87, 0xee, 0x95, 0x72, 0x31, 0x84, 0x0b, 0xb9, 0x5c, 0xe2, 0x98,
+	0xe8, 0x4a, 0xc9, 0xd5, 0xd5, 0x6a, 0x76, 0x3d, 0xd8, 0xdb, 0xd8, 0xbb, 0x8e, 0x67, 0xec, 0x42,
+	0x10, 0x52, 0xd5, 0x4a, 0x79, 0xa8, 0x2a, 0xb5, 0x52, 0x5e, 0xda, 0x87, 0x48, 0x6d, 0xa5, 0x3e,
+	0xf5, 0x0f, 0x68, 0xfb, 0xd8, 0xb7, 0x3c, 0x46, 0xed, 0x4b, 0xd5, 0x4a, 0x51, 0x95, 0xb4, 0xff,
+	0x47, 0xb5, 0xb3, 0xb3, 0xeb, 0x5d, 0xb3, 0x06, 0x63, 0xd1, 0x37, 0xef, 0xcc, 0x39, 0x73, 0x7e,
+	0xbf, 0x33, 0x67, 0x66, 0x7e, 0xc7, 0x30, 0xa6, 0x61, 0x6d, 0xb7, 0x68, 0x99, 0xca, 0xb6, 0x61,
+	0xe2, 0xa2, 0xc1, 0x76, 0x95, 0xda, 0xbc, 0xf2, 0xa4, 0x4a, 0x2a, 0xbb, 0xa9, 0x72, 0xc5, 0x62,
+	0x16, 0x1a, 0x16, 0x06, 0x29, 0xd7, 0x20, 0x55, 0x9b, 0x4f, 0x8c, 0xe4, 0xad, 0xbc, 0xc5, 0xe7,
+	0x15, 0xfb, 0x97, 0x63, 0x9a, 0xb8, 0x90, 0xb7, 0xac, 0x7c, 0x91, 0x28, 0xb8, 0x6c, 0x28, 0xd8,
+	0x34, 0x2d, 0x86, 0x99, 0x61, 0x99, 0x54, 0xcc, 0x4e, 0xeb, 0x16, 0x2d, 0x59, 0x54, 0xd1, 0x30,
+	0x25, 0x4e, 0x04, 0xa5, 0x36, 0xaf, 0x11, 0x86, 0xe7, 0x95, 0x32, 0xce, 0x1b, 0x26, 0x37, 0x16,
+	0xb6, 0xe3, 0x61, 0xa8, 0xca, 0xb8, 0x82, 0x4b, 0xee, 0x6a, 0x72, 0x98, 0x85, 0x07, 0xd1, 0xb1,
+	0xb9, 0xe8, 0xda, 0x68, 0x4c, 0xa7, 0x0c, 0x3f, 0x36, 0xcc, 0x7c, 0x03, 0x3b, 0x79, 0x04, 0xd0,
+	0x7d, 0xfb, 0x33, 0xc3, 0xd7, 0xce, 0x92, 0x27, 0x55, 0x42, 0x99, 0x9c, 0x81, 0xe1, 0xc0, 0x28,
+	0x2d, 0x5b, 0x26, 0x25, 0xe8, 0x16, 0x44, 0x1c, 0x0c, 0x71, 0x69, 0x5c, 0x9a, 0x8a, 0x2e, 0x9c,
+	0x4f, 0x85, 0xe4, 0x26, 0xe5, 0x38, 0xa5, 0x4f, 0xbd, 0x7c, 0x3d, 0xd6, 0x91, 0x15, 0x0e, 0xf2,
+	0x55, 0x18, 0xe2, 0x2b, 0xa6, 0x8b, 0x96, 0xfe, 0x58, 0x84, 0x41, 0x67, 0x21, 0x52, 0x20, 0x46,
+	0xbe, 0xc0, 0xf8, 0x7a, 0xa7, 0xb2, 0xe2, 0x4b, 0xfe, 0x58, 0x02, 0xe4, 0xb7, 0x16, 0xe1, 0x6f,
+	0x40, 0xb7, 0x66, 0x0f, 0x88, 0xe8, 0x17, 0x43, 0xa3, 0xaf, 0x9b, 0x39, 0xb2, 0x43, 0x72, 0x8e,
+	0xa7, 0x63, 0x8f, 0xc6, 0x20, 0x5a, 0xb3, 0x18, 0xc9, 0xa9, 0x65, 0xeb, 0x5d, 0x52, 0x89, 0x77,
+	0xf2, 0x60, 0xc0, 0x87, 0x32, 0xf6, 0x88, 0x6d, 0xc0, 0x2c, 0x86, 0x8b, 0xc2, 0xa0, 0xcb, 0x31,
+	0xe0, 0x43, 0xdc, 0x40, 0xfe, 0x42, 0x82, 0xb3, 0x1c, 0xd1, 0x86, 0x41, 0x19, 0x5f, 0xdb, 0xcd,
+	0x15, 0xba, 0x0d, 0x11, 0xca, 0x30, 0xab, 0x3a, 0x49, 0xe9, 0x5f, 0x98, 0x0c, 0x85, 0x65, 0x3b,
+	0x1b, 0x02, 0xd6, 0x16, 0x37, 0xcf, 0x0a, 0x37, 0xb4, 0x0a, 0x50, 0xdf, 0x7f, 0x0e, 0x2e, 0xba,
+	0x30, 0x91, 0x72, 0x8a, 0x25, 0x65, 0x17, 0x4b, 0xca, 0xd9, 0x30, 0x51, 0x2c, 0xa9, 0x0c, 0xce,
+	0x13, 0x11, 0x3c, 0xeb, 0xf3, 0x94, 0x5f, 0x48, 0xf0, 0x97, 0x03, 0x18, 0xeb, 0x3b, 0xc7, 0x53,
+	0x61, 0x83, 0xec, 0x6a, 0x2d, 0x77, 0xc2, 0x01, 0xfd, 0x2b, 0x04, 0xde, 0xe4, 0x91, 0xf0, 0x9c,
+	0xb8, 0x01, 0x7c, 0x8b, 0x70, 0x8e, 0xc3, 0xfb, 0xaf, 0xc5, 0x08, 0x5d, 0x62, 0x6b, 0x7c, 0xaf,
+	0x8f, 0x2a, 0x85, 0x12, 0x24, 0xc2, 0x9c, 0x04, 0xad, 0x7b, 0xd0, 0xa3, 0x31, 0x5d, 0x2d, 0x0b,
+	0x5e, 0x7d, 0xe9, 0xeb, 0x3f, 0xbf, 0x1e, 0x5b, 0xc8, 0x1b, 0xac, 0x50, 0xd5, 0x52, 0xba, 0x55,
+	0x52, 0x04, 0x4b, 0xbd, 0x80, 0x0d, 0xd3, 0xfd, 0x50, 0xd8, 0x6e, 0x99, 0xd0, 0x54, 0x7a, 0x3d,
+	0xb3, 0x78, 0x6d, 0x2e, 0x53, 0xd5, 0xfe, 0x4d, 0x76, 0xb3, 0x11, 0x8d, 0xe9, 0x99, 0xc7, 0x54,
+	0xbe, 0x05, 0x23, 0x3c, 0xdc, 0x9d, 0x9a, 0x91, 0x23, 0xa6, 0xee, 0xe6, 0x19, 0x5d, 0x84, 0xd8,
+	0x76, 0x59, 0x75, 0x62, 0xa9, 0x05, 0xb2, 0xc3, 0x51, 0x9e, 0xce, 0xc2, 0x76, 0x39, 0x6d, 0x3b,
+	0xae, 0x91, 0x1d, 0xf9, 0x03, 0x09, 0xce, 0x34, 0xf8, 0x7a, 0xc9, 0xef, 0x25, 0x62, 0x4c, 0x94,
+	0xee, 0x68, 0x68, 0xfa, 0x3d, 0x47, 0xcf, 0x1c, 0x29, 0x30, 0x42, 0x76, 0x58, 0x05, 0xeb, 0x76,
+	0xf5, 0xda, 0xe1, 0xa9, 0x13, 0xbe, 0x93, 0x87, 0x1f, 0xf2, 0xe6, 0xd2, 0x4c, 0xdf, 0xe2, 0x28,
+	0x9e, 0x49, 0x70, 0xce, 0x2b, 0x02, 0x77, 0x41, 0x5a, 0xa7, 0xd1, 0x47, 0x19, 0xae, 0x30, 0x35,
+	0x90, 0xeb, 0x28, 0x1f, 0x73, 0x52, 0x7b, 0x62, 0xd5, 0xf8, 0xa5, 0x04, 0x89, 0x30, 0x20, 0x22,
+	0x27, 0x7f, 0x87, 0xd3, 0x2e, 0x49, 0xb7, 0x26, 0x8f, 0x48, 0x4a, 0xdd, 0xfe, 0xe4, 0x4a, 0xf2,
+	0x43, 0x09, 0x46, 0x3d, 0x90, 0x99, 0xaa, 0x96, 0xc5, 0x66, 0x6e, 0xd9, 0x2a, 0x95, 0x0c, 0xd6,
+	0xfa, 0xc6, 0x9f, 0x58, 0xc6, 0xbe, 0x91, 0x20, 0xd9, 0x0c, 0x8c, 0xc8, 0xda, 0x06, 0x0c, 0x96,
+	0xab, 0x9a, 0x5a, 0xc1, 0x66, 0x4e, 0xd5, 0xf9, 0x94, 0x9b, 0x3c, 0x39, 0xfc, 0x2a, 0x0e, 0xac,
+	0xd2, 0x5f, 0xf6, 0x7f, 0x9e, 0x60, 0x1a, 0xd3, 0x6e, 0x16, 0x71, 0xdb, 0x59, 0x94, 0x3f, 0xf7,
+	0xd8, 0xe3, 0x66, 0xec, 0xef, 0xc2, 0x40, 0x03, 0x7b, 0x71, 0x9c, 0x5a, 0x21, 0x1f, 0x0b, 0x90,
+	0x47, 0x0b, 0x70, 0xa6, 0x88, 0x29, 0x13, 0xeb, 0xd8, 0xa7, 0x4b, 0x1c, 0x09, 0xe7, 0x71, 0x18,
+	0xb6, 0x27, 0x97, 0xdd, 0x39, 0xe7, 0x68, 0xc8, 0xff, 0x10, 0xf7, 0xeb, 0x96, 0x91, 0x37, 0x0d,
+	0x33, 0xbf, 0x6e, 0x6e, 0x5b, 0xc7, 0x20, 0x58, 0x85, 0xf8, 0x41, 0x6f, 0xc1, 0xec, 0x21, 0xf4,
+	0x51, 0x67, 0x58, 0x35, 0xcc, 0x6d, 0x4b, 0xd0, 0x9a, 0x0b, 0xa5, 0xb5, 0x2a, 0x7e, 0x67, 0x2a,
+	0x96, 0x7d, 0x20, 0x2a, 0xbe, 0xf5, 0xc4, 0x9b, 0x1b, 0xa5, 0xf5, 0x21, 0x59, 0x3b, 0x18, 0xd6,
+	0xbb, 0x0e, 0x82, 0x95, 0x2b, 0xb5, 0x5d, 0xb9, 0xdf, 0xbb, 0x97, 0x4e, 0x30, 0x88, 0x20, 0xf7,
+	0x3f, 0x88, 0xf9, 0xc9, 0xb9, 0x15, 0xdb, 0x2e, 0xbb, 0x3e, 0x1f, 0xbb, 0x13, 0xac, 0xe1, 0xff,
+	0x83, 0xcc, 0x29, 0x2c, 0x5b, 0x26, 0xad, 0x96, 0x48, 0xc5, 0x01, 0xf2, 0xd4, 0x7d, 0x0d, 0x45,
+	0xc6, 0xc6, 0x20, 0xaa, 0x0b, 0x03, 0xd5, 0xc8, 0xb9, 0xbb, 0xec, 0x0e, 0xad, 0xe7, 0x7c, 0xef,
+	0x58, 0x67, 0xe0, 0x1d, 0x53, 0xe1, 0xd2, 0xa1, 0xcb, 0x8b, 0x5c, 0xdd, 0x0c, 0x4a, 0x9c, 0xf0,
+	0xc2, 0x76, 0xd7, 0xf0, 0x6b, 0x1c, 0x39, 0x03, 0x63, 0x3c, 0xc0, 0x0a, 0x29, 0x92, 0x3c, 0xa7,
+	0xb4, 0x61, 0x6c, 0x13, 0x7d, 0x57, 0x2f, 0x7a, 0x8f, 0xd8, 0x2c, 0x0c, 0x0b, 0x15, 0xa8, 0xb2,
+	0x1d, 0xb5, 0x80, 0x69, 0xc1, 0x57, 0xaa, 0x83, 0x62, 0xea, 0xc1, 0xce, 0x1a, 0xa6, 0x05, 0xbb,
+	0x60, 0xbf, 0xeb, 0x82, 0xf1, 0xe6, 0x4b, 0x0a, 0xc0, 0x5b, 0xd0, 0x6f, 0x57, 0x7d, 0xce, 0x33,
+	0x11, 0xc8, 0x67, 0x3c, 0xe4, 0x75, 0xed, 0x69, 0x63, 0x4f, 0x3f, 0x58, 0xae, 0x2f, 0xe7, 0x6d,
+	0x44, 0x4c, 0x63, 0x7a, 0x7d, 0x18, 0x4d, 0xc2, 0x80, 0x6e, 0xd5, 0x88, 0x89, 0x4d, 0xa6, 0x3e,
+	0xa9, 0x5a, 0x95, 0x6a, 0x89, 0x67, 0x33, 0x96, 0xed, 0x77, 0x87, 0xef, 0xf3, 0x51, 0x34, 0x0d,
+	0x43, 0x66, 0xb5, 0xa4, 0x7a, 0xc6, 0xd4, 0xc8, 0x53, 0xae, 0xde, 0x62, 0xd9, 0x01, 0xb3, 0x5a,
+	0x5a, 0x16, 0xe3, 0x5b, 0x46, 0x9e, 0xa2, 0x29, 0x18, 0xf4, 0xb1, 0xcf, 0x91, 0x32, 0x2b, 0xc4,
+	0x4f, 0xf1, 0x3d, 0xea, 0xf7, 0xa8, 0xaf, 0xd8, 0xa3, 0x68, 0x06, 0x90, 0xcf, 0xb2, 0x42, 0xac,
+	0x4a, 0x9e, 0xe4, 0xe2, 0xdd, 0xe3, 0xd2, 0x54, 0xaf, 0x2f, 0x4d, 0x59, 0x67, 0x1c, 0x29, 0x30,
+	0x5c, 0x35, 0x35, 0xcb, 0xcc, 0xd9, 0xf6, 0x15, 0x27, 0xd5, 0x24, 0x17, 0x8f, 0x70, 0x73, 0xe4,
+	0x4d, 0x65, 0xdd, 0x19, 0xa4, 0x01, 0x72, 0x77, 0x53, 0x2d, 0x8b, 0x32, 0xa7, 0xf1, 0x1e, 0x7e,
+	0x28, 0x66, 0x5b, 0x3a, 0x14, 0x4b, 0x3a, 0x33, 0x6a, 0x06, 0xdb, 0x15, 0x27, 0x62, 0x68, 0xbb,
+	0x61, 0x9e, 0xca, 0xcf, 0x3b, 0x21, 0xde, 0xcc, 0x0b, 0xfd, 0x07, 0x22, 0xce, 0x4d, 0xc5, 0xf7,
+	0xaa, 0x7d, 0xd1, 0xd4, 0xcd, 0x45, 0x93, 0x2d, 0x2a, 0x6a, 0x16, 0xb3, 0xd9, 0xfb, 0xe5, 0x75,
+	0xd4, 0x19, 0x73, 0xf4, 0x75, 0x1c, 0x7a, 0x68, 0x11, 0xd3, 0x02, 0xc9, 0xf1, 0xdd, 0xe9, 0xcd,
+	0xba, 0x9f, 0xf6, 0x79, 0x79, 0x07, 0x1b, 0x45, 0x92, 0xe3, 0x7b, 0xd1, 0x9b, 0x15, 0x5f, 0x68,
+	0xab, 0xe1, 0x46, 0xec, 0x6e, 0xef, 0x46, 0x0c, 0xde, 0x85, 0xa3, 0x70, 0x9e, 0x17, 0x34, 0xcf,
+	0x04, 0xf6, 0x2e, 0x76, 0xb7, 0xeb, 0xb9, 0x0e, 0x17, 0xc2, 0xa7, 0x45, 0xad, 0x37, 0xd1, 0xa8,
+	0xd3, 0xb7, 0x01, 0x1d, 0x94, 0xf7, 0x68, 0x08, 0x62, 0x9b, 0xf7, 0x36, 0xd5, 0xd5, 0xf5, 0xcd,
+	0xa5, 0x8d, 0xf5, 0x47, 0x77, 0x56, 0x06, 0x3b, 0x50, 0x0c, 0x4e, 0xd7, 0x3f, 0x25, 0xd4, 0x03,
+	0x5d, 0x4b, 0x9b, 0x0f, 0x07, 0x3b, 0x17, 0x7e, 0x1f, 0x82, 0x6e, 0x1e, 0x19, 0xbd, 0x27, 0x41,
+	0xc4, 0xe9, 0x9f, 0x50, 0xf3, 0x3e, 0x22, 0xd8, 0xac, 0x25, 0xa6, 0x8e, 0x36, 0x74, 0x08, 0xc8,
+	0x97, 0xde, 0xff, 0xf1, 0xb7, 0xe7, 0x9d, 0xa3, 0xe8, 0xbc, 0xd2, 0xbc, 0xbd, 0x44, 0xcf, 0x24,
+	0xe8, 0xe6, 0x3c, 0xd0, 0x44, 0xf3, 0x85, 0xfd, 0x97, 0x62, 0x62, 0xf2, 0x48, 0x3b, 0x11, 0x7f,
+	0x86, 0xc7, 0x9f, 0x40, 0x97, 0x43, 0xe3, 0x3b, 0xfd, 0x86, 0xb2, 0xe7, 0x64, 0x75, 0x1f, 0x7d,
+	0x24, 0x01, 0xd4, 0x5b, 0x19, 0x74, 0xb5, 0x79, 0x94, 0x03, 0x4d, 0x59, 0x62, 0xa6, 0x35, 0xe3,
+	0x96, 0xf2, 0x22, 0xfa, 0xa0, 0x17, 0x12, 0xc4, 0x02, 0x5d, 0x08, 0x4a, 0x35, 0x0f, 0x12, 0xd6,
+	0xe3, 0x24, 0x94, 0x96, 0xed, 0x05, 0xae, 0xab, 0x1c, 0xd7, 0x15, 0x74, 0x29, 0x14, 0x97, 0xdd,
+	0xbf, 0xfa, 0xd2, 0xf5, 0xb5, 0x04, 0xbd, 0xae, 0x58, 0x46, 0x7f, 0x6d, 0x1e, 0xaa, 0xa1, 0xb5,
+	0x49, 0x4c, 0xb7, 0x62, 0x2a, 0x00, 0xad, 0x71, 0x40, 0x69, 0xf4, 0x4f, 0xe5, 0xb0, 0x7f, 0x1f,
+	0xea, 0xb7, 0x9a, 0xb2, 0x17, 0x50, 0x45, 0xfb, 0x8a, 0xd7, 0xd8, 0xfc, 0x20, 0xc1, 0xd0, 0x01,
+	0x9d, 0x8b, 0x16, 0x0e, 0xdf, 0xb6, 0x30, 0x6d, 0x99, 0x58, 0x3c, 0x96, 0x8f, 0x20, 0xf2, 0x80,
+	0x13, 0xd9, 0x44, 0x1b, 0xed, 0x12, 0x69, 0x10, 0xa2, 0x6a, 0xd1, 0xa0, 0xcc, 0x21, 0x85, 0x8f,
+	0x43, 0x0a, 0xb7, 0x41, 0x0a, 0xff, 0x69, 0xa4, 0xb8, 0x22, 0x6e, 0x60, 0x86, 0x3e, 0x95, 0x20,
+	0x16, 0xe8, 0xe1, 0x0e, 0xab, 0xfb, 0xb0, 0xae, 0x33, 0xa1, 0xb4, 0x6c, 0x2f, 0x88, 0x4c, 0x70,
+	0x22, 0xe3, 0x28, 0x19, 0x4a, 0xa4, 0xde, 0x07, 0x7e, 0x2b, 0x41, 0xd4, 0x77, 0xd9, 0xa3, 0x43,
+	0x4e, 0xfd, 0x41, 0xcd, 0x9e, 0x98, 0x6d, 0xd1, 0x5a, 0x80, 0xda, 0xe0, 0xa0, 0x56, 0xd1, 0x4a,
+	0xbb, 0xd9, 0xf5, 0xbf, 0x67, 0xe8, 0x33, 0x09, 0xfa, 0xb6, 0xfc, 0x42, 0xb6, 0x35, 0x34, 0x5e,
+	0x4e, 0x53, 0xad, 0x9a, 0x0b, 0xf4, 0xd3, 0x1c, 0xfd, 0x65, 0x24, 0x87, 0xa2, 0x0f, 0xe8, 0x73,
+	0xf4, 0x8b, 0x04, 0x67, 0xc3, 0x75, 0x2a, 0xba, 0xd1, 0x3c, 0xec, 0xa1, 0xc2, 0x39, 0x71, 0xf3,
+	0xf8, 0x8e, 0x02, 0xf9, 0x26, 0x47, 0xbe, 0x86, 0x56, 0x43, 0x91, 0xbb, 0xd2, 0x9b, 0x2a, 0x7b,
+	0x3e, 0x61, 0xbe, 0x2f, 0x6c, 0x9e, 0xda, 0xff, 0x9a, 0x34, 0x3c, 0x2b, 0xaf, 0x24, 0x18, 0x0e,
+	0x51, 0xb4, 0xe8, 0x5a, 0x73, 0x84, 0xcd, 0x35, 0x75, 0xe2, 0x6f, 0xc7, 0xf4, 0x6a, 0xa9, 0x98,
+	0x82, 0x8a, 0x9a, 0x2a, 0x7b, 0x21, 0xb2, 0x7d, 0x5f, 0x29, 0x7a, 0xd0, 0xbf, 0x92, 0x60, 0xa0,
+	0x41, 0xb4, 0xa0, 0xb9, 0xe6, 0xc0, 0xc2, 0xe5, 0x4f, 0x62, 0xfe, 0x18, 0x1e, 0x82, 0xc6, 0x2c,
+	0xa7, 0x31, 0x89, 0xae, 0x84, 0xd2, 0xc0, 0xae, 0x97, 0xe8, 0xad, 0xd3, 0x77, 0x5f, 0xbe, 0x49,
+	0x4a, 0xaf, 0xde, 0x24, 0xa5, 0x5f, 0xdf, 0x24, 0xa5, 0x4f, 0xde, 0x26, 0x3b, 0x5e, 0xbd, 0x4d,
+	0x76, 0xfc, 0xf4, 0x36, 0xd9, 0xf1, 0x68, 0xee, 0x28, 0xf9, 0xb9, 0x53, 0x5f, 0x99, 0x2b, 0x51,
+	0x2d, 0xc2, 0xff, 0xbf, 0x5e, 0xfc, 0x23, 0x00, 0x00, 0xff, 0xff, 0x74, 0x31, 0x91, 0xea, 0xc0,
+	0x17, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Block queries a block at a given height
+	Block(ctx context.Context, in *QueryBlockRequest, opts ...grpc.CallOption) (*QueryBlockResponse, error)
+	// ListBlocks is a range query for blocks at a given status
+	ListBlocks(ctx context.Context, in *QueryListBlocksRequest, opts ...grpc.CallOption) (*QueryListBlocksResponse, error)
+	// VotesAtHeight queries finality providers who have signed the block at given height.
+	VotesAtHeight(ctx context.Context, in *QueryVotesAtHeightRequest, opts ...grpc.CallOption) (*QueryVotesAtHeightResponse, error)
+	// Evidence queries the first evidence which can be used for extracting the BTC SK
+	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
+	// ListPubRandCommit is a range query for public randomness commitments of a given finality provider
+	ListPubRandCommit(ctx context.Context, in *QueryListPubRandCommitRequest, opts ...grpc.CallOption) (*QueryListPubRandCommitResponse, error)
+	// LastPubRandCommit queries the last public randomness commitment of a given finality provider
+	LastPubRandCommit(ctx context.Context, in *QueryLastPubRandCommitRequest, opts ...grpc.CallOption) (*QueryLastPubRandCommitResponse, error)
+	// ListEvidences queries is a range query for evidences
+	ListEvidences(ctx context.Context, in *QueryListEvidencesRequest, opts ...grpc.CallOption) (*QueryListEvidencesResponse, error)
+	// SigningInfo queries the signing info of a given finality provider
+	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
+	// SigningInfos queries the signing infos of all finality providers
+	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// ConsumerFinalizedBlock queries the finalized block of a given consumer
+	// system at a given height
+	ConsumerFinalizedBlock(ctx context.Context, in *QueryConsumerFinalizedBlockRequest, opts ...grpc.CallOption) (*QueryConsumerFinalizedBlockResponse, error)
+	// DelegationLifecycle queries the lifecycle of a BTC delegation in a
+	// single response, combining its status, its covenant quorum progress, the
+	// BTC confirmation depth of its staking tx, the finality activity of its
+	// finality providers, and its unbonding progress
+	DelegationLifecycle(ctx context.Context, in *QueryDelegationLifecycleRequest, opts ...grpc.CallOption) (*QueryDelegationLifecycleResponse, error)
+	// ActivatedHeight queries the Babylon height at which the finality gadget
+	// activated, i.e., the first height from which blocks are finalized
+	ActivatedHeight(ctx context.Context, in *QueryActivatedHeightRequest, opts ...grpc.CallOption) (*QueryActivatedHeightResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryListEvidencesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Block(ctx context.Context, in *QueryBlockRequest, opts ...grpc.CallOption) (*QueryBlockResponse, error) {
+	out := new(QueryBlockResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/Block", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryListEvidencesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Evidences) > 0 {
-		for iNdEx := len(m.Evidences) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Evidences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) ListBlocks(ctx context.Context, in *QueryListBlocksRequest, opts ...grpc.CallOption) (*QueryListBlocksResponse, error) {
+	out := new(QueryListBlocksResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/ListBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) VotesAtHeight(ctx context.Context, in *QueryVotesAtHeightRequest, opts ...grpc.CallOption) (*QueryVotesAtHeightResponse, error) {
+	out := new(QueryVotesAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/VotesAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error) {
+	out := new(QueryEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/Evidence", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ListPubRandCommit(ctx context.Context, in *QueryListPubRandCommitRequest, opts ...grpc.CallOption) (*QueryListPubRandCommitResponse, error) {
+	out := new(QueryListPubRandCommitResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/ListPubRandCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) LastPubRandCommit(ctx context.Context, in *QueryLastPubRandCommitRequest, opts ...grpc.CallOption) (*QueryLastPubRandCommitResponse, error) {
+	out := new(QueryLastPubRandCommitResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/LastPubRandCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	return out, nil
+}
+
+func (c *queryClient) ListEvidences(ctx context.Context, in *QueryListEvidencesRequest, opts ...grpc.CallOption) (*QueryListEvidencesResponse, error) {
+	out := new(QueryListEvidencesResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/ListEvidences", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error) {
+	out := new(QuerySigningInfoResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/SigningInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Block != nil {
-		l = m.Block.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error) {
+	out := new(QuerySigningInfosResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/SigningInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryListBlocksRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ConsumerFinalizedBlock(ctx context.Context, in *QueryConsumerFinalizedBlockRequest, opts ...grpc.CallOption) (*QueryConsumerFinalizedBlockResponse, error) {
+	out := new(QueryConsumerFinalizedBlockResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/ConsumerFinalizedBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+	return out, nil
+}
+
+func (c *queryClient) DelegationLifecycle(ctx context.Context, in *QueryDelegationLifecycleRequest, opts ...grpc.CallOption) (*QueryDelegationLifecycleResponse, error) {
+	out := new(QueryDelegationLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/DelegationLifecycle", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ActivatedHeight(ctx context.Context, in *QueryActivatedHeightRequest, opts ...grpc.CallOption) (*QueryActivatedHeightResponse, error) {
+	out := new(QueryActivatedHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/ActivatedHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryListBlocksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Block queries a block at a given height
+	Block(context.Context, *QueryBlockRequest) (*QueryBlockResponse, error)
+	// ListBlocks is a range query for blocks at a given status
+	ListBlocks(context.Context, *QueryListBlocksRequest) (*QueryListBlocksResponse, error)
+	// VotesAtHeight queries finality providers who have signed the block at given height.
+	VotesAtHeight(context.Context, *QueryVotesAtHeightRequest) (*QueryVotesAtHeightResponse, error)
+	// Evidence queries the first evidence which can be used for extracting the BTC SK
+	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
+	// ListPubRandCommit is a range query for public randomness commitments of a given finality provider
+	ListPubRandCommit(context.Context, *QueryListPubRandCommitRequest) (*QueryListPubRandCommitResponse, error)
+	// LastPubRandCommit queries the last public randomness commitment of a given finality provider
+	LastPubRandCommit(context.Context, *QueryLastPubRandCommitRequest) (*QueryLastPubRandCommitResponse, error)
+	// ListEvidences queries is a range query for evidences
+	ListEvidences(context.Context, *QueryListEvidencesRequest) (*QueryListEvidencesResponse, error)
+	// SigningInfo queries the signing info of a given finality provider
+	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
+	// SigningInfos queries the signing infos of all finality providers
+	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// ConsumerFinalizedBlock queries the finalized block of a given consumer
+	// system at a given height
+	ConsumerFinalizedBlock(context.Context, *QueryConsumerFinalizedBlockRequest) (*QueryConsumerFinalizedBlockResponse, error)
+	// DelegationLifecycle queries the lifecycle of a BTC delegation in a
+	// single response, combining its status, its covenant quorum progress, the
+	// BTC confirmation depth of its staking tx, the finality activity of its
+	// finality providers, and its unbonding progress
+	DelegationLifecycle(context.Context, *QueryDelegationLifecycleRequest) (*QueryDelegationLifecycleResponse, error)
+	// ActivatedHeight queries the Babylon height at which the finality gadget
+	// activated, i.e., the first height from which blocks are finalized
+	ActivatedHeight(context.Context, *QueryActivatedHeightRequest) (*QueryActivatedHeightResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Block(ctx context.Context, req *QueryBlockRequest) (*QueryBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Block not implemented")
+}
+func (*UnimplementedQueryServer) ListBlocks(ctx context.Context, req *QueryListBlocksRequest) (*QueryListBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlocks not implemented")
+}
+func (*UnimplementedQueryServer) VotesAtHeight(ctx context.Context, req *QueryVotesAtHeightRequest) (*QueryVotesAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotesAtHeight not implemented")
+}
+func (*UnimplementedQueryServer) Evidence(ctx context.Context, req *QueryEvidenceRequest) (*QueryEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Evidence not implemented")
+}
+func (*UnimplementedQueryServer) ListPubRandCommit(ctx context.Context, req *QueryListPubRandCommitRequest) (*QueryListPubRandCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPubRandCommit not implemented")
+}
+func (*UnimplementedQueryServer) LastPubRandCommit(ctx context.Context, req *QueryLastPubRandCommitRequest) (*QueryLastPubRandCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastPubRandCommit not implemented")
+}
+func (*UnimplementedQueryServer) ListEvidences(ctx context.Context, req *QueryListEvidencesRequest) (*QueryListEvidencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvidences not implemented")
+}
+func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningInfo not implemented")
+}
+func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
+}
+func (*UnimplementedQueryServer) ConsumerFinalizedBlock(ctx context.Context, req *QueryConsumerFinalizedBlockRequest) (*QueryConsumerFinalizedBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsumerFinalizedBlock not implemented")
+}
+func (*UnimplementedQueryServer) DelegationLifecycle(ctx context.Context, req *QueryDelegationLifecycleRequest) (*QueryDelegationLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationLifecycle not implemented")
+}
+func (*UnimplementedQueryServer) ActivatedHeight(ctx context.Context, req *QueryActivatedHeightRequest) (*QueryActivatedHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivatedHeight not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Blocks) > 0 {
-		for _, e := range m.Blocks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Block(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/Block",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Block(ctx, req.(*QueryBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/ListBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListBlocks(ctx, req.(*QueryListBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VotesAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotesAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotesAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/VotesAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotesAtHeight(ctx, req.(*QueryVotesAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Evidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Evidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/Evidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Evidence(ctx, req.(*QueryEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListPubRandCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListPubRandCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPubRandCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/ListPubRandCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPubRandCommit(ctx, req.(*QueryListPubRandCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LastPubRandCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastPubRandCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastPubRandCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/LastPubRandCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastPubRandCommit(ctx, req.(*QueryLastPubRandCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListEvidences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListEvidencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListEvidences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/ListEvidences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListEvidences(ctx, req.(*QueryListEvidencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/SigningInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningInfo(ctx, req.(*QuerySigningInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/SigningInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningInfos(ctx, req.(*QuerySigningInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConsumerFinalizedBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerFinalizedBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsumerFinalizedBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/ConsumerFinalizedBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsumerFinalizedBlock(ctx, req.(*QueryConsumerFinalizedBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationLifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/DelegationLifecycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationLifecycle(ctx, req.(*QueryDelegationLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActivatedHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActivatedHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActivatedHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/ActivatedHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActivatedHeight(ctx, req.(*QueryActivatedHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.finality.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Block",
+			Handler:    _Query_Block_Handler,
+		},
+		{
+			MethodName: "ListBlocks",
+			Handler:    _Query_ListBlocks_Handler,
+		},
+		{
+			MethodName: "VotesAtHeight",
+			Handler:    _Query_VotesAtHeight_Handler,
+		},
+		{
+			MethodName: "Evidence",
+			Handler:    _Query_Evidence_Handler,
+		},
+		{
+			MethodName: "ListPubRandCommit",
+			Handler:    _Query_ListPubRandCommit_Handler,
+		},
+		{
+			MethodName: "LastPubRandCommit",
+			Handler:    _Query_LastPubRandCommit_Handler,
+		},
+		{
+			MethodName: "ListEvidences",
+			Handler:    _Query_ListEvidences_Handler,
+		},
+		{
+			MethodName: "SigningInfo",
+			Handler:    _Query_SigningInfo_Handler,
+		},
+		{
+			MethodName: "SigningInfos",
+			Handler:    _Query_SigningInfos_Handler,
+		},
+		{
+			MethodName: "ConsumerFinalizedBlock",
+			Handler:    _Query_ConsumerFinalizedBlock_Handler,
+		},
+		{
+			MethodName: "DelegationLifecycle",
+			Handler:    _Query_DelegationLifecycle_Handler,
+		},
+		{
+			MethodName: "ActivatedHeight",
+			Handler:    _Query_ActivatedHeight_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylon/finality/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VotedPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotedPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListBlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotesAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotesAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotesAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotesAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotesAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotesAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BtcPks) > 0 {
+		for iNdEx := len(m.BtcPks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.BtcPks[iNdEx].Size()
+				i -= size
+				if _, err := m.BtcPks[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvidenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvidenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvidenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExtractedBtcSkHex) > 0 {
+		i -= len(m.ExtractedBtcSkHex)
+		copy(dAtA[i:], m.ExtractedBtcSkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExtractedBtcSkHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Evidence != nil {
+		{
+			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListEvidencesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListEvidencesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListEvidencesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListEvidencesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListEvidencesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListEvidencesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Evidences) > 0 {
+		for iNdEx := len(m.Evidences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPubRandCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPubRandCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPubRandCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPubRandCommitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPubRandCommitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPubRandCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PubRandCommits) > 0 {
+		for iNdEx := len(m.PubRandCommits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PubRandCommits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastPubRandCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastPubRandCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastPubRandCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastPubRandCommitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastPubRandCommitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastPubRandCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastCommittedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastCommittedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PubRandCommit != nil {
+		{
+			size, err := m.PubRandCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SigningInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningInfosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfosRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningInfosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SigningInfos) > 0 {
+		for iNdEx := len(m.SigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerFinalizedBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerFinalizedBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerFinalizedBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerFinalizedBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerFinalizedBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerFinalizedBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationLifecycleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationLifecycleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationLifecycleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationLifecycleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationLifecycleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationLifecycleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FinalityProviders) > 0 {
+		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.UnbondingRequested {
+		i--
+		if m.UnbondingRequested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StakingTxReorged {
+		i--
+		if m.StakingTxReorged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StakingTxDepth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingTxDepth))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NumCovenantSigs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumCovenantSigs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CovenantQuorum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CovenantQuorum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BtcDelegation != nil {
+		{
+			size, err := m.BtcDelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityProviderActivity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderActivity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderActivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SigningInfo != nil {
+		{
+			size, err := m.SigningInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Slashed {
+		i--
+		if m.Slashed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActivatedHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActivatedHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActivatedHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActivatedHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActivatedHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActivatedHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VotedPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotedPower))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPower))
+	}
+	return n
+}
+
+func (m *QueryListBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListBlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotesAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryVotesAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcPks) > 0 {
+		for _, e := range m.BtcPks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEvidenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Evidence != nil {
+		l = m.Evidence.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExtractedBtcSkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListEvidencesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListEvidencesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Evidences) > 0 {
+		for _, e := range m.Evidences {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListPubRandCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListPubRandCommitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PubRandCommits) > 0 {
+		for _, e := range m.PubRandCommits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLastPubRandCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLastPubRandCommitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubRandCommit != nil {
+		l = m.PubRandCommit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LastCommittedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastCommittedHeight))
+	}
+	return n
+}
+
+func (m *QuerySigningInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SigningInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SigningInfos) > 0 {
+		for _, e := range m.SigningInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerFinalizedBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryConsumerFinalizedBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationLifecycleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationLifecycleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcDelegation != nil {
+		l = m.BtcDelegation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CovenantQuorum != 0 {
+		n += 1 + sovQuery(uint64(m.CovenantQuorum))
+	}
+	if m.NumCovenantSigs != 0 {
+		n += 1 + sovQuery(uint64(m.NumCovenantSigs))
+	}
+	if m.StakingTxDepth != 0 {
+		n += 1 + sovQuery(uint64(m.StakingTxDepth))
+	}
+	if m.StakingTxReorged {
+		n += 2
+	}
+	if m.UnbondingRequested {
+		n += 2
+	}
+	if len(m.FinalityProviders) > 0 {
+		for _, e := range m.FinalityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FinalityProviderActivity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	if m.Slashed {
+		n += 2
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.SigningInfo != nil {
+		l = m.SigningInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActivatedHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryActivatedHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &IndexedBlock{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedPower", wireType)
+			}
+			m.VotedPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotedPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListBlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListBlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= QueriedBlockStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListBlocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListBlocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, &IndexedBlock{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotesAtHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotesAtHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotesAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotesAtHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotesAtHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotesAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPks", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.BtcPks = append(m.BtcPks, v)
+			if err := m.BtcPks[len(m.BtcPks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Evidence == nil {
+				m.Evidence = &Evidence{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtractedBtcSkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtractedBtcSkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListEvidencesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListEvidencesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListEvidencesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListEvidencesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListEvidencesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListEvidencesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidences = append(m.Evidences, &Evidence{})
+			if err := m.Evidences[len(m.Evidences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListPubRandCommitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListPubRandCommitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListPubRandCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListPubRandCommitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListPubRandCommitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListPubRandCommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRandCommits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubRandCommits = append(m.PubRandCommits, &PubRandCommit{})
+			if err := m.PubRandCommits[len(m.PubRandCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastPubRandCommitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastPubRandCommitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastPubRandCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastPubRandCommitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastPubRandCommitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastPubRandCommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRandCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubRandCommit == nil {
+				m.PubRandCommit = &PubRandCommit{}
+			}
+			if err := m.PubRandCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCommittedHeight", wireType)
+			}
+			m.LastCommittedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastCommittedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryVotesAtHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryVotesAtHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.BtcPks) > 0 {
-		for _, e := range m.BtcPks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	return n
-}
-
-func (m *QueryEvidenceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryEvidenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Evidence != nil {
-		l = m.Evidence.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryListEvidencesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StartHeight != 0 {
-		n += 1 + sovQuery(uint64(m.StartHeight))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryListEvidencesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Evidences) > 0 {
-		for _, e := range m.Evidences {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1630,12 +5448,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1657,7 +5511,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1670,25 +5524,59 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningInfos = append(m.SigningInfos, FinalityProviderSigningInfo{})
+			if err := m.SigningInfos[len(m.SigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1715,7 +5603,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1740,7 +5631,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerFinalizedBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1763,13 +5654,45 @@ func (m *QueryBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerFinalizedBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerFinalizedBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
@@ -1809,7 +5732,7 @@ func (m *QueryBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerFinalizedBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1832,10 +5755,10 @@ func (m *QueryBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerFinalizedBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerFinalizedBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1868,7 +5791,7 @@ func (m *QueryBlockResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Block == nil {
-				m.Block = &IndexedBlock{}
+				m.Block = &ConsumerBlock{}
 			}
 			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1895,7 +5818,7 @@ func (m *QueryBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListBlocksRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationLifecycleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1918,36 +5841,17 @@ func (m *QueryListBlocksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListBlocksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationLifecycleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationLifecycleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= QueriedBlockStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1957,27 +5861,23 @@ func (m *QueryListBlocksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2000,7 +5900,7 @@ func (m *QueryListBlocksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListBlocksResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationLifecycleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2023,15 +5923,15 @@ func (m *QueryListBlocksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListBlocksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationLifecycleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationLifecycleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2058,16 +5958,18 @@ func (m *QueryListBlocksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Blocks = append(m.Blocks, &IndexedBlock{})
-			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BtcDelegation == nil {
+				m.BtcDelegation = &types.BTCDelegationResponse{}
+			}
+			if err := m.BtcDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantQuorum", wireType)
 			}
-			var msglen int
+			m.CovenantQuorum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2077,83 +5979,16 @@ func (m *QueryListBlocksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CovenantQuorum |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryVotesAtHeightRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesAtHeightRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumCovenantSigs", wireType)
 			}
-			m.Height = 0
+			m.NumCovenantSigs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2163,66 +5998,75 @@ func (m *QueryVotesAtHeightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.NumCovenantSigs |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxDepth", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			m.StakingTxDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingTxDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxReorged", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryVotesAtHeightResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.StakingTxReorged = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingRequested", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesAtHeightResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.UnbondingRequested = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2232,24 +6076,23 @@ func (m *QueryVotesAtHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.BtcPks = append(m.BtcPks, v)
-			if err := m.BtcPks[len(m.BtcPks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FinalityProviders = append(m.FinalityProviders, FinalityProviderActivity{})
+			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2274,7 +6117,7 @@ func (m *QueryVotesAtHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
+func (m *FinalityProviderActivity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2297,17 +6140,17 @@ func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEvidenceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalityProviderActivity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalityProviderActivity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2317,77 +6160,89 @@ func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Slashed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEvidenceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2414,10 +6269,10 @@ func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Evidence == nil {
-				m.Evidence = &Evidence{}
+			if m.SigningInfo == nil {
+				m.SigningInfo = &FinalityProviderSigningInfo{}
 			}
-			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2442,7 +6297,7 @@ func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListEvidencesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryActivatedHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2465,67 +6320,12 @@ func (m *QueryListEvidencesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListEvidencesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActivatedHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListEvidencesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActivatedHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
-			}
-			m.StartHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2547,7 +6347,7 @@ func (m *QueryListEvidencesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListEvidencesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryActivatedHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2570,51 +6370,17 @@ func (m *QueryListEvidencesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListEvidencesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActivatedHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListEvidencesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActivatedHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidences", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Evidences = append(m.Evidences, &Evidence{})
-			if err := m.Evidences[len(m.Evidences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2624,28 +6390,11 @@ func (m *QueryListEvidencesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
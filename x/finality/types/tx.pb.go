@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -31,6 +32,134 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MsgCommitPubRandList defines a message for committing a list of public
+// randomness for EOTS
+type MsgCommitPubRandList struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// fp_btc_pk is the BTC PK of the finality provider that commits the public randomness
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// start_height is the start block height of the list of public randomness
+	StartHeight uint64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// num_pub_rand is the number of public randomness committed
+	NumPubRand uint64 `protobuf:"varint,4,opt,name=num_pub_rand,json=numPubRand,proto3" json:"num_pub_rand,omitempty"`
+	// commitment is the Merkle root of the list of public randomness, where
+	// the i-th leaf is the public randomness at height start_height + i
+	Commitment []byte `protobuf:"bytes,5,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// sig is the signature on (start_height || num_pub_rand || commitment) signed by
+	// SK corresponding to fp_btc_pk, followed by consumer_id if it is not empty.
+	// This prevents others from committing public randomness on behalf of fp_btc_pk
+	Sig *github_com_babylonchain_babylon_types.BIP340Signature `protobuf:"bytes,6,opt,name=sig,proto3,customtype=github.com/babylonchain/babylon/types.BIP340Signature" json:"sig,omitempty"`
+	// consumer_id is the ID of the consumer system whose blocks the public
+	// randomness is used for, or empty if the public randomness is used for
+	// Babylon blocks
+	ConsumerId string `protobuf:"bytes,7,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+}
+
+func (m *MsgCommitPubRandList) Reset()         { *m = MsgCommitPubRandList{} }
+func (m *MsgCommitPubRandList) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitPubRandList) ProtoMessage()    {}
+func (*MsgCommitPubRandList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{0}
+}
+func (m *MsgCommitPubRandList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitPubRandList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitPubRandList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitPubRandList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitPubRandList.Merge(m, src)
+}
+func (m *MsgCommitPubRandList) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitPubRandList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitPubRandList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitPubRandList proto.InternalMessageInfo
+
+func (m *MsgCommitPubRandList) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgCommitPubRandList) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *MsgCommitPubRandList) GetNumPubRand() uint64 {
+	if m != nil {
+		return m.NumPubRand
+	}
+	return 0
+}
+
+func (m *MsgCommitPubRandList) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+func (m *MsgCommitPubRandList) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+// MsgCommitPubRandListResponse is the response to the MsgCommitPubRandList message
+type MsgCommitPubRandListResponse struct {
+}
+
+func (m *MsgCommitPubRandListResponse) Reset()         { *m = MsgCommitPubRandListResponse{} }
+func (m *MsgCommitPubRandListResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitPubRandListResponse) ProtoMessage()    {}
+func (*MsgCommitPubRandListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{1}
+}
+func (m *MsgCommitPubRandListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitPubRandListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitPubRandListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitPubRandListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitPubRandListResponse.Merge(m, src)
+}
+func (m *MsgCommitPubRandListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitPubRandListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitPubRandListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitPubRandListResponse proto.InternalMessageInfo
+
 // MsgAddFinalitySig defines a message for adding a finality vote
 type MsgAddFinalitySig struct {
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
@@ -45,13 +174,20 @@ type MsgAddFinalitySig struct {
 	// the `s` in a Schnorr signature `(r, s)`
 	// `r` is the public randomness that is already committed by the finality provider
 	FinalitySig *github_com_babylonchain_babylon_types.SchnorrEOTSSig `protobuf:"bytes,5,opt,name=finality_sig,json=finalitySig,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrEOTSSig" json:"finality_sig,omitempty"`
+	// pub_rand is the public randomness used for the finality signature. It
+	// is only needed if the finality provider commits to public randomness via
+	// MsgCommitPubRandList rather than the master public randomness
+	PubRand *github_com_babylonchain_babylon_types.SchnorrPubRand `protobuf:"bytes,6,opt,name=pub_rand,json=pubRand,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrPubRand" json:"pub_rand,omitempty"`
+	// proof is the proof that the given public randomness is committed under
+	// the finality provider's commitment covering block_height
+	Proof *crypto.Proof `protobuf:"bytes,7,opt,name=proof,proto3" json:"proof,omitempty"`
 }
 
 func (m *MsgAddFinalitySig) Reset()         { *m = MsgAddFinalitySig{} }
 func (m *MsgAddFinalitySig) String() string { return proto.CompactTextString(m) }
 func (*MsgAddFinalitySig) ProtoMessage()    {}
 func (*MsgAddFinalitySig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2dd6da066b6baf1d, []int{0}
+	return fileDescriptor_2dd6da066b6baf1d, []int{2}
 }
 func (m *MsgAddFinalitySig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -101,6 +237,13 @@ func (m *MsgAddFinalitySig) GetBlockAppHash() []byte {
 	return nil
 }
 
+func (m *MsgAddFinalitySig) GetProof() *crypto.Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
 // MsgAddFinalitySigResponse is the response to the MsgAddFinalitySig message
 type MsgAddFinalitySigResponse struct {
 }
@@ -109,7 +252,7 @@ func (m *MsgAddFinalitySigResponse) Reset()         { *m = MsgAddFinalitySigResp
 func (m *MsgAddFinalitySigResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddFinalitySigResponse) ProtoMessage()    {}
 func (*MsgAddFinalitySigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2dd6da066b6baf1d, []int{1}
+	return fileDescriptor_2dd6da066b6baf1d, []int{3}
 }
 func (m *MsgAddFinalitySigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -155,7 +298,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2dd6da066b6baf1d, []int{2}
+	return fileDescriptor_2dd6da066b6baf1d, []int{4}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,7 +349,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2dd6da066b6baf1d, []int{3}
+	return fileDescriptor_2dd6da066b6baf1d, []int{5}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,51 +378,290 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgAddConsumerFinalitySig defines a message for adding a finality vote on
+// a block of a consumer system
+type MsgAddConsumerFinalitySig struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// consumer_id is the ID of the consumer system
+	ConsumerId string `protobuf:"bytes,2,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// fp_btc_pk is the BTC PK of the finality provider that casts this vote
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,3,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// block_height is the height of the voted block in the consumer system
+	BlockHeight uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// block_hash is the hash of the voted block
+	BlockHash []byte `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// finality_sig is the EOTS finality signature on (block_height || block_hash)
+	FinalitySig *github_com_babylonchain_babylon_types.SchnorrEOTSSig `protobuf:"bytes,6,opt,name=finality_sig,json=finalitySig,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrEOTSSig" json:"finality_sig,omitempty"`
+	// pub_rand is the public randomness used for the finality signature,
+	// committed by the finality provider for the consumer system
+	PubRand *github_com_babylonchain_babylon_types.SchnorrPubRand `protobuf:"bytes,7,opt,name=pub_rand,json=pubRand,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrPubRand" json:"pub_rand,omitempty"`
+	// proof is the proof that the given public randomness is committed under
+	// the finality provider's commitment covering block_height
+	Proof *crypto.Proof `protobuf:"bytes,8,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *MsgAddConsumerFinalitySig) Reset()         { *m = MsgAddConsumerFinalitySig{} }
+func (m *MsgAddConsumerFinalitySig) String() string { return proto.CompactTextString(m) }
+func (*MsgAddConsumerFinalitySig) ProtoMessage()    {}
+func (*MsgAddConsumerFinalitySig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{6}
+}
+func (m *MsgAddConsumerFinalitySig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddConsumerFinalitySig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddConsumerFinalitySig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddConsumerFinalitySig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddConsumerFinalitySig.Merge(m, src)
+}
+func (m *MsgAddConsumerFinalitySig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddConsumerFinalitySig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddConsumerFinalitySig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddConsumerFinalitySig proto.InternalMessageInfo
+
+func (m *MsgAddConsumerFinalitySig) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgAddConsumerFinalitySig) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *MsgAddConsumerFinalitySig) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *MsgAddConsumerFinalitySig) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *MsgAddConsumerFinalitySig) GetProof() *crypto.Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// MsgAddConsumerFinalitySigResponse is the response to the MsgAddConsumerFinalitySig message
+type MsgAddConsumerFinalitySigResponse struct {
+}
+
+func (m *MsgAddConsumerFinalitySigResponse) Reset()         { *m = MsgAddConsumerFinalitySigResponse{} }
+func (m *MsgAddConsumerFinalitySigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddConsumerFinalitySigResponse) ProtoMessage()    {}
+func (*MsgAddConsumerFinalitySigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{7}
+}
+func (m *MsgAddConsumerFinalitySigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddConsumerFinalitySigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddConsumerFinalitySigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddConsumerFinalitySigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddConsumerFinalitySigResponse.Merge(m, src)
+}
+func (m *MsgAddConsumerFinalitySigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddConsumerFinalitySigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddConsumerFinalitySigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddConsumerFinalitySigResponse proto.InternalMessageInfo
+
+// MsgUnjailFinalityProvider defines a message for unjailing a finality
+// provider that is jailed due to insufficient liveness
+type MsgUnjailFinalityProvider struct {
+	// signer is the Babylon address of the finality provider
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// fp_btc_pk is the BTC PK of the finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+}
+
+func (m *MsgUnjailFinalityProvider) Reset()         { *m = MsgUnjailFinalityProvider{} }
+func (m *MsgUnjailFinalityProvider) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailFinalityProvider) ProtoMessage()    {}
+func (*MsgUnjailFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{8}
+}
+func (m *MsgUnjailFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailFinalityProvider.Merge(m, src)
+}
+func (m *MsgUnjailFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailFinalityProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailFinalityProvider proto.InternalMessageInfo
+
+func (m *MsgUnjailFinalityProvider) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// MsgUnjailFinalityProviderResponse is the response to the MsgUnjailFinalityProvider message
+type MsgUnjailFinalityProviderResponse struct {
+}
+
+func (m *MsgUnjailFinalityProviderResponse) Reset()         { *m = MsgUnjailFinalityProviderResponse{} }
+func (m *MsgUnjailFinalityProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailFinalityProviderResponse) ProtoMessage()    {}
+func (*MsgUnjailFinalityProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{9}
+}
+func (m *MsgUnjailFinalityProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailFinalityProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailFinalityProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailFinalityProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailFinalityProviderResponse.Merge(m, src)
+}
+func (m *MsgUnjailFinalityProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailFinalityProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailFinalityProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailFinalityProviderResponse proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterType((*MsgCommitPubRandList)(nil), "babylon.finality.v1.MsgCommitPubRandList")
+	proto.RegisterType((*MsgCommitPubRandListResponse)(nil), "babylon.finality.v1.MsgCommitPubRandListResponse")
 	proto.RegisterType((*MsgAddFinalitySig)(nil), "babylon.finality.v1.MsgAddFinalitySig")
 	proto.RegisterType((*MsgAddFinalitySigResponse)(nil), "babylon.finality.v1.MsgAddFinalitySigResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.finality.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.finality.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgAddConsumerFinalitySig)(nil), "babylon.finality.v1.MsgAddConsumerFinalitySig")
+	proto.RegisterType((*MsgAddConsumerFinalitySigResponse)(nil), "babylon.finality.v1.MsgAddConsumerFinalitySigResponse")
+	proto.RegisterType((*MsgUnjailFinalityProvider)(nil), "babylon.finality.v1.MsgUnjailFinalityProvider")
+	proto.RegisterType((*MsgUnjailFinalityProviderResponse)(nil), "babylon.finality.v1.MsgUnjailFinalityProviderResponse")
 }
 
 func init() { proto.RegisterFile("babylon/finality/v1/tx.proto", fileDescriptor_2dd6da066b6baf1d) }
 
 var fileDescriptor_2dd6da066b6baf1d = []byte{
-	// 531 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x6f, 0xd3, 0x30,
-	0x1c, 0x6d, 0xf6, 0xa7, 0xa8, 0x6e, 0x55, 0x44, 0x98, 0x58, 0xda, 0xa1, 0xb4, 0x54, 0x13, 0xaa,
-	0x26, 0x48, 0xb6, 0x6e, 0x9a, 0x60, 0xb7, 0x46, 0x02, 0x0d, 0x50, 0x45, 0x95, 0xc2, 0x05, 0x0e,
-	0x91, 0x93, 0xa6, 0xb6, 0xd5, 0x36, 0xb6, 0x62, 0x77, 0x5a, 0x6e, 0x88, 0x4f, 0xc0, 0x81, 0x0f,
-	0x32, 0x21, 0x3e, 0xc4, 0x8e, 0x13, 0x27, 0xd4, 0x43, 0x85, 0xda, 0xc3, 0xbe, 0x06, 0x6a, 0xe2,
-	0xa8, 0xb0, 0x15, 0xd1, 0x9b, 0x7f, 0x7e, 0xef, 0xe7, 0xf7, 0xfc, 0xfc, 0x33, 0x78, 0xe8, 0x42,
-	0x37, 0x1a, 0xd0, 0xc0, 0xec, 0x91, 0x00, 0x0e, 0x88, 0x88, 0xcc, 0xb3, 0x03, 0x53, 0x9c, 0x1b,
-	0x2c, 0xa4, 0x82, 0xaa, 0xf7, 0x25, 0x6a, 0xa4, 0xa8, 0x71, 0x76, 0x50, 0xde, 0x42, 0x14, 0xd1,
-	0x18, 0x37, 0xe7, 0xab, 0x84, 0x5a, 0x2e, 0x79, 0x94, 0x0f, 0x29, 0x77, 0x12, 0x20, 0x29, 0x24,
-	0xb4, 0x9d, 0x54, 0xe6, 0x90, 0xa3, 0xf9, 0xe9, 0x43, 0x8e, 0x24, 0x50, 0x5d, 0x26, 0xce, 0x60,
-	0x08, 0x87, 0xb2, 0xb5, 0xf6, 0x6d, 0x0d, 0xdc, 0x6b, 0x71, 0xd4, 0xec, 0x76, 0x5f, 0x4a, 0x4a,
-	0x87, 0x20, 0xf5, 0x01, 0xc8, 0x72, 0x82, 0x02, 0x3f, 0xd4, 0x94, 0xaa, 0x52, 0xcf, 0xd9, 0xb2,
-	0x52, 0x6d, 0x90, 0xeb, 0x31, 0xc7, 0x15, 0x9e, 0xc3, 0xfa, 0xda, 0x5a, 0x55, 0xa9, 0x17, 0xac,
-	0xe3, 0xf1, 0xa4, 0xd2, 0x40, 0x44, 0xe0, 0x91, 0x6b, 0x78, 0x74, 0x68, 0x4a, 0x45, 0x0f, 0x43,
-	0x12, 0xa4, 0x85, 0x29, 0x22, 0xe6, 0x73, 0xc3, 0x7a, 0xd5, 0x3e, 0x3c, 0xda, 0x6f, 0x8f, 0xdc,
-	0x37, 0x7e, 0x64, 0xdf, 0xe9, 0x31, 0x4b, 0x78, 0xed, 0xbe, 0xfa, 0x08, 0x14, 0xdc, 0x01, 0xf5,
-	0xfa, 0x0e, 0xf6, 0x09, 0xc2, 0x42, 0x5b, 0xaf, 0x2a, 0xf5, 0x0d, 0x3b, 0x1f, 0xef, 0x9d, 0xc6,
-	0x5b, 0xea, 0x2e, 0x28, 0x26, 0x14, 0xc8, 0x98, 0x83, 0x21, 0xc7, 0xda, 0xc6, 0x5c, 0xdb, 0x4e,
-	0x1a, 0x9b, 0x8c, 0x9d, 0x42, 0x8e, 0xd5, 0x8f, 0xa0, 0x90, 0x5e, 0xd3, 0xe1, 0x04, 0x69, 0x9b,
-	0xb1, 0xbf, 0x67, 0xe3, 0x49, 0xe5, 0x68, 0x35, 0x7f, 0x1d, 0x0f, 0x07, 0x34, 0x0c, 0x5f, 0xbc,
-	0x7d, 0xd7, 0xe9, 0x10, 0x64, 0xe7, 0x7b, 0x8b, 0x44, 0x4e, 0xf2, 0x9f, 0xaf, 0x2f, 0xf6, 0x64,
-	0x0c, 0xb5, 0x1d, 0x50, 0xba, 0x95, 0x99, 0xed, 0x73, 0x46, 0x03, 0xee, 0xd7, 0xbe, 0x2a, 0xe0,
-	0x6e, 0x8b, 0xa3, 0xf7, 0xac, 0x0b, 0x85, 0xdf, 0x8e, 0xb3, 0x56, 0x8f, 0x41, 0x0e, 0x8e, 0x04,
-	0xa6, 0x21, 0x11, 0x51, 0x12, 0xa9, 0xa5, 0xfd, 0xf8, 0xfe, 0x74, 0x4b, 0xbe, 0x62, 0xb3, 0xdb,
-	0x0d, 0x7d, 0xce, 0x3b, 0x22, 0x24, 0x01, 0xb2, 0x17, 0x54, 0xf5, 0x39, 0xc8, 0x26, 0xaf, 0x15,
-	0x87, 0x9d, 0x6f, 0xec, 0x18, 0x4b, 0xe6, 0xc5, 0x48, 0x44, 0xac, 0x8d, 0xcb, 0x49, 0x25, 0x63,
-	0xcb, 0x86, 0x93, 0xe2, 0xdc, 0xf0, 0xe2, 0xa8, 0x5a, 0x09, 0x6c, 0xdf, 0x70, 0x95, 0x3a, 0x6e,
-	0x8c, 0x15, 0xb0, 0xde, 0xe2, 0x48, 0xc5, 0xa0, 0x78, 0x63, 0x0e, 0x1e, 0x2f, 0xd5, 0xbb, 0x75,
-	0xf7, 0xb2, 0xb1, 0x1a, 0x2f, 0x55, 0x54, 0x5d, 0x50, 0xf8, 0x2b, 0x9f, 0xdd, 0x7f, 0xf5, 0xff,
-	0xc9, 0x2a, 0x3f, 0x59, 0x85, 0x95, 0x6a, 0x94, 0x37, 0x3f, 0x5d, 0x5f, 0xec, 0x29, 0xd6, 0xeb,
-	0xcb, 0xa9, 0xae, 0x5c, 0x4d, 0x75, 0xe5, 0xd7, 0x54, 0x57, 0xbe, 0xcc, 0xf4, 0xcc, 0xd5, 0x4c,
-	0xcf, 0xfc, 0x9c, 0xe9, 0x99, 0x0f, 0xfb, 0xff, 0x9b, 0x8a, 0xf3, 0xc5, 0xb7, 0x89, 0x07, 0xc4,
-	0xcd, 0xc6, 0x7f, 0xe6, 0xf0, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x10, 0x9d, 0x41, 0x5a, 0xd4,
-	0x03, 0x00, 0x00,
+	// 855 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0xdb, 0x36,
+	0x14, 0x8f, 0x62, 0xc7, 0x69, 0x68, 0x23, 0x43, 0xb5, 0xa0, 0x55, 0xd4, 0x56, 0x76, 0xb3, 0x62,
+	0xc8, 0x8a, 0x4d, 0x6a, 0xd2, 0x2e, 0x58, 0x7b, 0x8b, 0x8b, 0x0d, 0xed, 0xba, 0x60, 0x86, 0xbc,
+	0x5e, 0xb6, 0x83, 0xa0, 0x7f, 0xa6, 0xb8, 0x44, 0x24, 0x47, 0x52, 0x41, 0x7d, 0x2b, 0xf6, 0x09,
+	0x76, 0xd8, 0x07, 0xe9, 0x61, 0xc0, 0xbe, 0x42, 0x8f, 0xc5, 0x76, 0xd9, 0x72, 0x08, 0x86, 0xe4,
+	0xd0, 0xaf, 0x31, 0x88, 0xa2, 0x1c, 0xdb, 0x91, 0x37, 0xa7, 0x68, 0x7b, 0x13, 0xf9, 0x7e, 0x7c,
+	0xfc, 0xe9, 0xfd, 0x7e, 0xef, 0x49, 0xe0, 0x7a, 0xe0, 0x07, 0xc3, 0x03, 0x82, 0x9d, 0x01, 0xc2,
+	0xfe, 0x01, 0x12, 0x43, 0xe7, 0x70, 0xcb, 0x11, 0xcf, 0x6c, 0xca, 0x88, 0x20, 0xfa, 0x87, 0x2a,
+	0x6a, 0x97, 0x51, 0xfb, 0x70, 0xcb, 0x5c, 0x83, 0x04, 0x12, 0x19, 0x77, 0xf2, 0xa7, 0x02, 0x6a,
+	0xae, 0x87, 0x84, 0xa7, 0x84, 0x7b, 0x45, 0xa0, 0x58, 0xa8, 0xd0, 0xd5, 0x62, 0xe5, 0xa4, 0x1c,
+	0xe6, 0xd9, 0x53, 0x0e, 0x55, 0xe0, 0x86, 0x88, 0x71, 0x14, 0xb3, 0x14, 0x61, 0xe1, 0x84, 0x6c,
+	0x48, 0x05, 0x71, 0x28, 0x23, 0x64, 0xa0, 0xc2, 0x9d, 0x2a, 0x6e, 0xd4, 0x67, 0x7e, 0xaa, 0x32,
+	0x6f, 0x9c, 0x2c, 0x82, 0xb5, 0x3d, 0x0e, 0x1f, 0x92, 0x34, 0x45, 0xa2, 0x97, 0x05, 0xae, 0x8f,
+	0xa3, 0x6f, 0x10, 0x17, 0xfa, 0x15, 0xd0, 0xe0, 0x08, 0xe2, 0x98, 0x19, 0x5a, 0x47, 0xdb, 0x5c,
+	0x71, 0xd5, 0x4a, 0x77, 0xc1, 0xca, 0x80, 0x7a, 0x81, 0x08, 0x3d, 0xba, 0x6f, 0x2c, 0x76, 0xb4,
+	0xcd, 0x56, 0x77, 0xe7, 0xe8, 0xb8, 0xbd, 0x0d, 0x91, 0x48, 0xb2, 0xc0, 0x0e, 0x49, 0xea, 0xa8,
+	0x4b, 0xc3, 0xc4, 0x47, 0xb8, 0x5c, 0x38, 0x62, 0x48, 0x63, 0x6e, 0x77, 0x1f, 0xf7, 0xee, 0xde,
+	0xbb, 0xd3, 0xcb, 0x82, 0x27, 0xf1, 0xd0, 0x5d, 0x1e, 0xd0, 0xae, 0x08, 0x7b, 0xfb, 0xfa, 0x4d,
+	0xd0, 0xe2, 0xc2, 0x67, 0xc2, 0x4b, 0x62, 0x04, 0x13, 0x61, 0xd4, 0x3a, 0xda, 0x66, 0xdd, 0x6d,
+	0xca, 0xbd, 0x47, 0x72, 0x4b, 0xef, 0x80, 0x16, 0xce, 0x52, 0x8f, 0x66, 0x81, 0xc7, 0x7c, 0x1c,
+	0x19, 0x75, 0x09, 0x01, 0x38, 0x4b, 0x15, 0x69, 0xdd, 0x02, 0x20, 0x94, 0x6f, 0x91, 0xc6, 0x58,
+	0x18, 0x4b, 0x39, 0x33, 0x77, 0x6c, 0x47, 0x7f, 0x02, 0x6a, 0x1c, 0x41, 0xa3, 0x21, 0x29, 0xdf,
+	0x3f, 0x3a, 0x6e, 0x7f, 0x7e, 0x11, 0xca, 0x7d, 0x04, 0xb1, 0x2f, 0x32, 0x16, 0xbb, 0x79, 0x16,
+	0xbd, 0x0d, 0x9a, 0x21, 0xc1, 0x3c, 0x4b, 0x63, 0xe6, 0xa1, 0xc8, 0x58, 0x96, 0x25, 0x02, 0xe5,
+	0xd6, 0xe3, 0xe8, 0x41, 0xf3, 0xe7, 0xd7, 0x2f, 0x6e, 0xab, 0x9a, 0x6d, 0x58, 0xe0, 0x7a, 0x55,
+	0x8d, 0xdd, 0x98, 0x53, 0x82, 0x79, 0xbc, 0xf1, 0x7b, 0x0d, 0x5c, 0xde, 0xe3, 0x70, 0x37, 0x8a,
+	0xbe, 0x52, 0x3a, 0xf5, 0x11, 0x7c, 0xdf, 0x0a, 0x04, 0x07, 0x24, 0xdc, 0x9f, 0x52, 0x40, 0xee,
+	0x29, 0x05, 0x6e, 0x81, 0xd5, 0x02, 0xe2, 0x53, 0xea, 0x25, 0x3e, 0x4f, 0xa4, 0x06, 0x2d, 0xb7,
+	0x38, 0xb8, 0x4b, 0xe9, 0x23, 0x9f, 0x27, 0xfa, 0x0f, 0xa0, 0x55, 0x7a, 0xcd, 0xcb, 0xcb, 0x2d,
+	0x75, 0xe8, 0x7e, 0x71, 0x74, 0xdc, 0xbe, 0x37, 0x1f, 0xbf, 0x7e, 0x98, 0x60, 0xc2, 0xd8, 0x97,
+	0xdf, 0x7e, 0xd7, 0xef, 0x23, 0xe8, 0x36, 0x07, 0x63, 0x15, 0xe9, 0x83, 0x4b, 0x23, 0x03, 0x34,
+	0xde, 0x30, 0xb1, 0xaa, 0xbf, 0xbb, 0x4c, 0x8b, 0x07, 0xdd, 0x06, 0x4b, 0xb2, 0x65, 0xa4, 0x88,
+	0xcd, 0x6d, 0xc3, 0x3e, 0x6b, 0x29, 0xbb, 0x68, 0x29, 0xbb, 0x97, 0xc7, 0xdd, 0x02, 0x36, 0xa9,
+	0xec, 0x35, 0xb0, 0x7e, 0x4e, 0xb8, 0x91, 0xac, 0xbf, 0x6a, 0xe0, 0x83, 0x3d, 0x0e, 0x9f, 0xd2,
+	0xc8, 0x17, 0x71, 0x4f, 0x76, 0x9d, 0xbe, 0x03, 0x56, 0xfc, 0x4c, 0x24, 0x84, 0x21, 0x31, 0x2c,
+	0x74, 0xed, 0x1a, 0x7f, 0xfc, 0xf6, 0xd9, 0x9a, 0x6a, 0xf7, 0xdd, 0x28, 0x62, 0x31, 0xe7, 0x7d,
+	0xc1, 0x10, 0x86, 0xee, 0x19, 0x54, 0xbf, 0x0f, 0x1a, 0x45, 0xdf, 0x4a, 0xc5, 0x9b, 0xdb, 0xd7,
+	0xec, 0x8a, 0xc1, 0x62, 0x17, 0x97, 0x74, 0xeb, 0x2f, 0x8f, 0xdb, 0x0b, 0xae, 0x3a, 0xf0, 0x60,
+	0x35, 0x27, 0x7c, 0x96, 0x6a, 0x63, 0x1d, 0x5c, 0x9d, 0x62, 0x35, 0x62, 0xfc, 0x77, 0xad, 0x7c,
+	0x9f, 0x87, 0xca, 0xca, 0xf3, 0x18, 0x72, 0xaa, 0x19, 0x16, 0xa7, 0x9b, 0x61, 0xd2, 0xb1, 0xb5,
+	0x77, 0xe3, 0xd8, 0xfa, 0x79, 0xc7, 0xde, 0x00, 0x40, 0x41, 0x72, 0xb7, 0x16, 0x13, 0x61, 0xa5,
+	0x00, 0x54, 0x59, 0xb5, 0xf1, 0xae, 0xac, 0xba, 0xfc, 0xd6, 0xad, 0x7a, 0xe9, 0x0d, 0xac, 0xfa,
+	0x11, 0xb8, 0x39, 0x53, 0xda, 0x71, 0xcb, 0xe6, 0x06, 0x78, 0x8a, 0x7f, 0xf4, 0xd1, 0x41, 0x09,
+	0xe8, 0x31, 0x72, 0x88, 0xa2, 0x98, 0xbd, 0xcf, 0x89, 0x54, 0xc5, 0xbd, 0x9a, 0x55, 0xc9, 0x7d,
+	0xfb, 0xcf, 0x3a, 0xa8, 0xed, 0x71, 0xa8, 0xff, 0x04, 0x2e, 0x9f, 0xff, 0x9c, 0x7d, 0x52, 0xd9,
+	0x2f, 0x55, 0x53, 0xd9, 0xdc, 0x9a, 0x1b, 0x5a, 0x5e, 0xad, 0x27, 0x60, 0x75, 0x6a, 0x78, 0x7f,
+	0x3c, 0x2b, 0xc9, 0x24, 0xce, 0xb4, 0xe7, 0xc3, 0x8d, 0x6e, 0x0a, 0x40, 0x6b, 0x62, 0x9e, 0xdc,
+	0x9a, 0x75, 0x7e, 0x1c, 0x65, 0x7e, 0x3a, 0x0f, 0x6a, 0x74, 0xc7, 0x73, 0x0d, 0x5c, 0x99, 0x31,
+	0x02, 0xfe, 0x8b, 0x6e, 0x05, 0xde, 0xdc, 0xb9, 0x18, 0x7e, 0x82, 0xc2, 0x0c, 0x13, 0xce, 0xa4,
+	0x50, 0x8d, 0x37, 0x77, 0x2e, 0x86, 0x2f, 0x29, 0x98, 0x4b, 0xcf, 0x5f, 0xbf, 0xb8, 0xad, 0x75,
+	0xbf, 0x7e, 0x79, 0x62, 0x69, 0xaf, 0x4e, 0x2c, 0xed, 0x9f, 0x13, 0x4b, 0xfb, 0xe5, 0xd4, 0x5a,
+	0x78, 0x75, 0x6a, 0x2d, 0xfc, 0x75, 0x6a, 0x2d, 0x7c, 0x7f, 0xe7, 0xff, 0xec, 0xfd, 0xec, 0xec,
+	0xb7, 0x4b, 0x3a, 0x3d, 0x68, 0xc8, 0x7f, 0xae, 0xbb, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xaf,
+	0xb0, 0xa9, 0x12, 0x33, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -294,11 +676,19 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
+	// CommitPubRandList commits a list of public randomness for EOTS
+	CommitPubRandList(ctx context.Context, in *MsgCommitPubRandList, opts ...grpc.CallOption) (*MsgCommitPubRandListResponse, error)
 	// AddFinalitySig adds a finality signature to a given block
 	AddFinalitySig(ctx context.Context, in *MsgAddFinalitySig, opts ...grpc.CallOption) (*MsgAddFinalitySigResponse, error)
 	// TODO: msg for evidence of equivocation. this is not specified yet
 	// UpdateParams updates the finality module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// AddConsumerFinalitySig adds a finality signature to a given block of a
+	// consumer system
+	AddConsumerFinalitySig(ctx context.Context, in *MsgAddConsumerFinalitySig, opts ...grpc.CallOption) (*MsgAddConsumerFinalitySigResponse, error)
+	// UnjailFinalityProvider unjails a finality provider jailed due to
+	// insufficient liveness
+	UnjailFinalityProvider(ctx context.Context, in *MsgUnjailFinalityProvider, opts ...grpc.CallOption) (*MsgUnjailFinalityProviderResponse, error)
 }
 
 type msgClient struct {
@@ -309,6 +699,15 @@ func NewMsgClient(cc grpc1.ClientConn) MsgClient {
 	return &msgClient{cc}
 }
 
+func (c *msgClient) CommitPubRandList(ctx context.Context, in *MsgCommitPubRandList, opts ...grpc.CallOption) (*MsgCommitPubRandListResponse, error) {
+	out := new(MsgCommitPubRandListResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Msg/CommitPubRandList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AddFinalitySig(ctx context.Context, in *MsgAddFinalitySig, opts ...grpc.CallOption) (*MsgAddFinalitySigResponse, error) {
 	out := new(MsgAddFinalitySigResponse)
 	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Msg/AddFinalitySig", in, out, opts...)
@@ -327,30 +726,83 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) AddConsumerFinalitySig(ctx context.Context, in *MsgAddConsumerFinalitySig, opts ...grpc.CallOption) (*MsgAddConsumerFinalitySigResponse, error) {
+	out := new(MsgAddConsumerFinalitySigResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Msg/AddConsumerFinalitySig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnjailFinalityProvider(ctx context.Context, in *MsgUnjailFinalityProvider, opts ...grpc.CallOption) (*MsgUnjailFinalityProviderResponse, error) {
+	out := new(MsgUnjailFinalityProviderResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Msg/UnjailFinalityProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
+	// CommitPubRandList commits a list of public randomness for EOTS
+	CommitPubRandList(context.Context, *MsgCommitPubRandList) (*MsgCommitPubRandListResponse, error)
 	// AddFinalitySig adds a finality signature to a given block
 	AddFinalitySig(context.Context, *MsgAddFinalitySig) (*MsgAddFinalitySigResponse, error)
 	// TODO: msg for evidence of equivocation. this is not specified yet
 	// UpdateParams updates the finality module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// AddConsumerFinalitySig adds a finality signature to a given block of a
+	// consumer system
+	AddConsumerFinalitySig(context.Context, *MsgAddConsumerFinalitySig) (*MsgAddConsumerFinalitySigResponse, error)
+	// UnjailFinalityProvider unjails a finality provider jailed due to
+	// insufficient liveness
+	UnjailFinalityProvider(context.Context, *MsgUnjailFinalityProvider) (*MsgUnjailFinalityProviderResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
 type UnimplementedMsgServer struct {
 }
 
+func (*UnimplementedMsgServer) CommitPubRandList(ctx context.Context, req *MsgCommitPubRandList) (*MsgCommitPubRandListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitPubRandList not implemented")
+}
 func (*UnimplementedMsgServer) AddFinalitySig(ctx context.Context, req *MsgAddFinalitySig) (*MsgAddFinalitySigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddFinalitySig not implemented")
 }
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) AddConsumerFinalitySig(ctx context.Context, req *MsgAddConsumerFinalitySig) (*MsgAddConsumerFinalitySigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddConsumerFinalitySig not implemented")
+}
+func (*UnimplementedMsgServer) UnjailFinalityProvider(ctx context.Context, req *MsgUnjailFinalityProvider) (*MsgUnjailFinalityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailFinalityProvider not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
+func _Msg_CommitPubRandList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitPubRandList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitPubRandList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Msg/CommitPubRandList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitPubRandList(ctx, req.(*MsgCommitPubRandList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_AddFinalitySig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgAddFinalitySig)
 	if err := dec(in); err != nil {
@@ -387,24 +839,173 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylon.finality.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AddFinalitySig",
-			Handler:    _Msg_AddFinalitySig_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "babylon/finality/v1/tx.proto",
-}
-
-func (m *MsgAddFinalitySig) Marshal() (dAtA []byte, err error) {
+func _Msg_AddConsumerFinalitySig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddConsumerFinalitySig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddConsumerFinalitySig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Msg/AddConsumerFinalitySig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddConsumerFinalitySig(ctx, req.(*MsgAddConsumerFinalitySig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnjailFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailFinalityProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailFinalityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Msg/UnjailFinalityProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailFinalityProvider(ctx, req.(*MsgUnjailFinalityProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.finality.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CommitPubRandList",
+			Handler:    _Msg_CommitPubRandList_Handler,
+		},
+		{
+			MethodName: "AddFinalitySig",
+			Handler:    _Msg_AddFinalitySig_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "AddConsumerFinalitySig",
+			Handler:    _Msg_AddConsumerFinalitySig_Handler,
+		},
+		{
+			MethodName: "UnjailFinalityProvider",
+			Handler:    _Msg_UnjailFinalityProvider_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylon/finality/v1/tx.proto",
+}
+
+func (m *MsgCommitPubRandList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitPubRandList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitPubRandList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Sig != nil {
+		{
+			size := m.Sig.Size()
+			i -= size
+			if _, err := m.Sig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NumPubRand != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NumPubRand))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitPubRandListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitPubRandListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitPubRandListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddFinalitySig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -424,6 +1025,30 @@ func (m *MsgAddFinalitySig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PubRand != nil {
+		{
+			size := m.PubRand.Size()
+			i -= size
+			if _, err := m.PubRand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.FinalitySig != nil {
 		{
 			size := m.FinalitySig.Size()
@@ -556,85 +1181,1194 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgAddConsumerFinalitySig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAddFinalitySig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgAddConsumerFinalitySig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddConsumerFinalitySig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.FpBtcPk != nil {
-		l = m.FpBtcPk.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.PubRand != nil {
+		{
+			size := m.PubRand.Size()
+			i -= size
+			if _, err := m.PubRand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.FinalitySig != nil {
+		{
+			size := m.FinalitySig.Size()
+			i -= size
+			if _, err := m.FinalitySig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.BlockHeight != 0 {
-		n += 1 + sovTx(uint64(m.BlockHeight))
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.BlockAppHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.FinalitySig != nil {
-		l = m.FinalitySig.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddConsumerFinalitySigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddConsumerFinalitySigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddConsumerFinalitySigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCommitPubRandList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.NumPubRand != 0 {
+		n += 1 + sovTx(uint64(m.NumPubRand))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Sig != nil {
+		l = m.Sig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCommitPubRandListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddFinalitySig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.BlockHeight))
+	}
+	l = len(m.BlockAppHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FinalitySig != nil {
+		l = m.FinalitySig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PubRand != nil {
+		l = m.PubRand.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddFinalitySigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddConsumerFinalitySig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.BlockHeight))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FinalitySig != nil {
+		l = m.FinalitySig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PubRand != nil {
+		l = m.PubRand.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddConsumerFinalitySigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnjailFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnjailFinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCommitPubRandList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitPubRandList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitPubRandList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPubRand", wireType)
+			}
+			m.NumPubRand = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPubRand |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.Sig = &v
+			if err := m.Sig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitPubRandListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitPubRandListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitPubRandListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFinalitySig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFinalitySig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockAppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockAppHash = append(m.BlockAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockAppHash == nil {
+				m.BlockAppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalitySig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
+			m.FinalitySig = &v
+			if err := m.FinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrPubRand
+			m.PubRand = &v
+			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddFinalitySigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFinalitySigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFinalitySigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgAddFinalitySigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
+func (m *MsgAddConsumerFinalitySig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -657,10 +2391,10 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddFinalitySig: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddConsumerFinalitySig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddFinalitySig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddConsumerFinalitySig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -696,6 +2430,38 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
 			}
@@ -730,7 +2496,7 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -749,9 +2515,9 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockAppHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -778,12 +2544,12 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockAppHash = append(m.BlockAppHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockAppHash == nil {
-				m.BlockAppHash = []byte{}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FinalitySig", wireType)
 			}
@@ -818,6 +2584,77 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrPubRand
+			m.PubRand = &v
+			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -839,7 +2676,7 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddFinalitySigResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddConsumerFinalitySigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -862,10 +2699,10 @@ func (m *MsgAddFinalitySigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddFinalitySigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddConsumerFinalitySigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddFinalitySigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddConsumerFinalitySigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -889,7 +2726,7 @@ func (m *MsgAddFinalitySigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailFinalityProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -912,15 +2749,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailFinalityProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -948,13 +2785,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -964,22 +2801,24 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1004,7 +2843,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1027,10 +2866,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailFinalityProviderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	"github.com/babylonchain/babylon/x/incentive/types"
+)
+
+// RewardBLSSigners distributes rewards to the validators who contributed their
+// BLS sigs to the checkpoint at a given epoch, in proportion to their reward
+// credits
+func (k Keeper) RewardBLSSigners(ctx context.Context, epoch uint64, credits []*ckpttypes.BlsSigRewardCredit) {
+	gauge := k.GetBLSSignerGauge(ctx, epoch)
+	if gauge == nil || len(credits) == 0 {
+		// no reward or nobody to reward at this epoch
+		return
+	}
+
+	totalCredit := math.LegacyZeroDec()
+	for _, credit := range credits {
+		totalCredit = totalCredit.Add(credit.Credit)
+	}
+	if !totalCredit.IsPositive() {
+		return
+	}
+
+	coinsToSigners := sdk.NewCoins()
+	for _, credit := range credits {
+		valAddr, err := sdk.ValAddressFromBech32(credit.ValidatorAddress)
+		if err != nil {
+			// the credits are derived from the validator set of the epoch,
+			// so this can only be a programming error
+			panic(fmt.Errorf("invalid validator address in BLS sig reward credit: %w", err))
+		}
+		portion := credit.Credit.QuoTruncate(totalCredit)
+		coins := gauge.GetCoinsPortion(portion)
+		if !coins.IsAllPositive() {
+			continue
+		}
+		// the operator account of the validator receives the reward
+		k.accumulateRewardGauge(ctx, types.BLSSignerType, sdk.AccAddress(valAddr), coins)
+		coinsToSigners = coinsToSigners.Add(coins...)
+	}
+
+	// emit event for the distribution
+	event := &types.EventBLSSignerRewardDistributed{
+		Epoch:          epoch,
+		NumSigners:     uint64(len(credits)),
+		CoinsToSigners: coinsToSigners,
+	}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("failed to emit EventBLSSignerRewardDistributed event: %w", err))
+	}
+}
+
+func (k Keeper) accumulateBLSSignerReward(ctx context.Context, blsSignerReward sdk.Coins) {
+	if !blsSignerReward.IsAllPositive() {
+		return
+	}
+	epoch := k.epochingKeeper.GetEpoch(ctx)
+
+	// update BLS signer reward gauge
+	gauge := k.GetBLSSignerGauge(ctx, epoch.EpochNumber)
+	if gauge == nil {
+		// if this epoch does not have a gauge yet, create a new one
+		gauge = types.NewGauge(blsSignerReward...)
+	} else {
+		// if this epoch already has a gauge, accumulate coins in the gauge
+		gauge.Coins = gauge.Coins.Add(blsSignerReward...)
+	}
+
+	k.SetBLSSignerGauge(ctx, epoch.EpochNumber, gauge)
+
+	// transfer the BLS signer reward from fee collector account to incentive module account
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, blsSignerReward)
+	if err != nil {
+		// this can only be programming error and is unrecoverable
+		panic(err)
+	}
+}
+
+func (k Keeper) SetBLSSignerGauge(ctx context.Context, epoch uint64, gauge *types.Gauge) {
+	store := k.blsSignerGaugeStore(ctx)
+	gaugeBytes := k.cdc.MustMarshal(gauge)
+	store.Set(sdk.Uint64ToBigEndian(epoch), gaugeBytes)
+}
+
+func (k Keeper) GetBLSSignerGauge(ctx context.Context, epoch uint64) *types.Gauge {
+	store := k.blsSignerGaugeStore(ctx)
+	gaugeBytes := store.Get(sdk.Uint64ToBigEndian(epoch))
+	if gaugeBytes == nil {
+		return nil
+	}
+
+	var gauge types.Gauge
+	k.cdc.MustUnmarshal(gaugeBytes, &gauge)
+	return &gauge
+}
+
+// blsSignerGaugeStore returns the KVStore of the gauge of total reward for
+// the validators who contributed their BLS sigs to the checkpoint at each epoch
+// prefix: BLSSignerGaugeKey
+// key: epoch number
+// value: gauge of rewards for BLS signers at this epoch
+func (k Keeper) blsSignerGaugeStore(ctx context.Context) prefix.Store {
+	storeAdaptor := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdaptor, types.BLSSignerGaugeKey)
+}
@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	"github.com/babylonchain/babylon/x/incentive/types"
+)
+
+func FuzzRewardBLSSigners(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock bank keeper
+		bankKeeper := types.NewMockBankKeeper(ctrl)
+
+		// create incentive keeper
+		keeper, ctx := testkeeper.IncentiveKeeper(t, bankKeeper, nil, nil)
+		epoch := datagen.RandomInt(r, 1000) + 1
+
+		// no gauge at this epoch, so nobody is rewarded
+		signers := datagen.GenRandomValSet(int(datagen.RandomInt(r, 10)) + 1)
+		credits := make([]*ckpttypes.BlsSigRewardCredit, 0, len(signers))
+		for _, val := range signers {
+			epochsLate := datagen.RandomInt(r, int(ckpttypes.DefaultLateBlsSigEpochWindow)+1)
+			credits = append(credits, &ckpttypes.BlsSigRewardCredit{
+				ValidatorAddress: val.GetValAddressStr(),
+				EpochsLate:       epochsLate,
+				Credit:           ckpttypes.BlsSigRewardCreditOf(epochsLate),
+			})
+		}
+		keeper.RewardBLSSigners(ctx, epoch, credits)
+		for _, val := range signers {
+			require.Nil(t, keeper.GetRewardGauge(ctx, types.BLSSignerType, sdk.AccAddress(val.GetValAddress())))
+		}
+
+		// set a random gauge
+		gauge := datagen.GenRandomGauge(r)
+		keeper.SetBLSSignerGauge(ctx, epoch, gauge)
+
+		keeper.RewardBLSSigners(ctx, epoch, credits)
+
+		// each signer gets the portion of the gauge corresponding to its credit
+		totalCredit := math.LegacyZeroDec()
+		for _, credit := range credits {
+			totalCredit = totalCredit.Add(credit.Credit)
+		}
+		distributedCoins := sdk.NewCoins()
+		for i, val := range signers {
+			expectedCoins := gauge.GetCoinsPortion(credits[i].Credit.QuoTruncate(totalCredit))
+			rg := keeper.GetRewardGauge(ctx, types.BLSSignerType, sdk.AccAddress(val.GetValAddress()))
+			if !expectedCoins.IsAllPositive() {
+				require.Nil(t, rg)
+				continue
+			}
+			require.NotNil(t, rg)
+			require.Equal(t, expectedCoins, rg.Coins)
+			distributedCoins = distributedCoins.Add(expectedCoins...)
+
+			// a signer with a lower credit gets no more reward
+			for j := range signers {
+				if credits[j].Credit.LT(credits[i].Credit) {
+					otherRg := keeper.GetRewardGauge(ctx, types.BLSSignerType, sdk.AccAddress(signers[j].GetValAddress()))
+					if otherRg != nil {
+						require.True(t, otherRg.Coins.IsAllLTE(rg.Coins))
+					}
+				}
+			}
+		}
+		// the distributed coins never exceed the gauge
+		require.True(t, distributedCoins.IsAllLTE(gauge.Coins))
+	})
+}
@@ -24,14 +24,30 @@ func (k Keeper) RewardBTCStaking(ctx context.Context, height uint64, filteredDc
 		// get coins that will be allocated to the finality provider and its BTC delegations
 		fpPortion := filteredDc.GetFinalityProviderPortion(fp)
 		coinsForFpsAndDels := gauge.GetCoinsPortion(fpPortion)
+		// if the finality provider is slashed at or before this height, then
+		// neither it nor its BTC delegations accrue rewards anymore. Instead,
+		// the rewards are burned or sent to the community pool
+		if k.isSlashedAtHeight(ctx, fp.GetAddress(), height) {
+			k.disposeSlashedReward(ctx, coinsForFpsAndDels)
+			continue
+		}
+		// self-delegations of the finality provider are exempt from the
+		// commission, so the commission is only charged on the coins of the
+		// other BTC delegations
+		coinsForSelfDels := types.GetCoinsPortion(coinsForFpsAndDels, fp.GetSelfDelegationPortion())
+		coinsForOtherDels := coinsForFpsAndDels.Sub(coinsForSelfDels...)
 		// reward the finality provider with commission
-		coinsForCommission := types.GetCoinsPortion(coinsForFpsAndDels, *fp.Commission)
+		coinsForCommission := types.GetCoinsPortion(coinsForOtherDels, *fp.Commission)
 		k.accumulateRewardGauge(ctx, types.FinalityProviderType, fp.GetAddress(), coinsForCommission)
-		// reward the rest of coins to each BTC delegation proportional to its voting power portion
-		coinsForBTCDels := coinsForFpsAndDels.Sub(coinsForCommission...)
+		coinsForOtherDels = coinsForOtherDels.Sub(coinsForCommission...)
+		// reward the rest of coins to each BTC delegation proportional to its
+		// voting power portion among the BTC delegations of its kind
 		for _, btcDel := range fp.BtcDels {
-			btcDelPortion := fp.GetBTCDelPortion(btcDel)
-			coinsForDel := types.GetCoinsPortion(coinsForBTCDels, btcDelPortion)
+			coinsForDels := coinsForOtherDels
+			if fp.IsSelfDelegation(btcDel) {
+				coinsForDels = coinsForSelfDels
+			}
+			coinsForDel := types.GetCoinsPortion(coinsForDels, fp.GetBTCDelRewardPortion(btcDel))
 			k.accumulateRewardGauge(ctx, types.BTCDelegationType, btcDel.GetAddress(), coinsForDel)
 		}
 	}
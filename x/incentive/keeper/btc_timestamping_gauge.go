@@ -4,6 +4,7 @@ import (
 	"context"
 	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
+	"fmt"
 	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	"github.com/babylonchain/babylon/x/incentive/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -21,8 +22,7 @@ func (k Keeper) RewardBTCTimestamping(ctx context.Context, epoch uint64, rdi *bt
 
 	params := k.GetParams(ctx)
 	btcTimestampingPortion := params.BTCTimestampingPortion()
-	// TODO: parameterise bestPortion
-	bestPortion := math.LegacyNewDecWithPrec(80, 2) // 80 * 10^{-2} = 0.8
+	bestPortion := params.BestSubmissionPortion
 
 	// distribute coins to best submitter
 	submitterPortion := params.SubmitterPortion.QuoTruncate(btcTimestampingPortion)
@@ -38,6 +38,19 @@ func (k Keeper) RewardBTCTimestamping(ctx context.Context, epoch uint64, rdi *bt
 	k.accumulateRewardGauge(ctx, types.ReporterType, rdi.Best.Reporter, coinsToBestReporter)
 	restCoinsToReporters := coinsToReporters.Sub(coinsToBestReporter...)
 
+	// emit event for the distribution
+	event := &types.EventBTCTimestampingRewardDistributed{
+		Epoch:               epoch,
+		BestSubmitter:       rdi.Best.Submitter.String(),
+		BestReporter:        rdi.Best.Reporter.String(),
+		NumOtherSubmissions: uint64(len(rdi.Others)),
+		CoinsToSubmitters:   coinsToSubmitters,
+		CoinsToReporters:    coinsToReporters,
+	}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("failed to emit EventBTCTimestampingRewardDistributed event: %w", err))
+	}
+
 	// if there is only 1 submission, distribute the rest to submitter and reporter, then skip the rest logic
 	if len(rdi.Others) == 0 {
 		// give rest coins to the best submitter
@@ -38,7 +38,7 @@ func FuzzRewardBTCTimestamping(f *testing.F) {
 		// parameters
 		params := types.DefaultParams()
 		btcTimestampingPortion := params.BTCTimestampingPortion()
-		bestPortion := math.LegacyNewDecWithPrec(80, 2) // 80 * 10^{-2} = 0.8
+		bestPortion := params.BestSubmissionPortion
 
 		// expected values
 		distributedCoins := sdk.NewCoins()
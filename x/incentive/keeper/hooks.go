@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// Wrapper struct
+type Hooks struct {
+	k Keeper
+}
+
+// ensures Hooks implements BtcStakingHooks interface
+var _ bstypes.BtcStakingHooks = Hooks{}
+
+// Create new incentive hooks
+func (k Keeper) Hooks() Hooks { return Hooks{k} }
+
+// AfterFinalityProviderSlashed claws back the unclaimed commission of the
+// slashed finality provider, and disposes of the rewards of blocks since the
+// infraction height that are yet to be distributed to it and its BTC
+// delegations
+func (h Hooks) AfterFinalityProviderSlashed(ctx context.Context, fp *bstypes.FinalityProvider, infractionHeight uint64) {
+	h.k.slashFinalityProviderReward(ctx, fp, infractionHeight)
+}
+
+// AfterFinalityProviderJailed does nothing, since a jailed finality provider
+// has no voting power and thus accrues no reward
+func (h Hooks) AfterFinalityProviderJailed(ctx context.Context, fp *bstypes.FinalityProvider) {
+}
+
+// AfterBTCDelegationActivated does nothing, since the reward of a BTC
+// delegation is distributed according to the voting power distribution,
+// which includes the BTC delegation from the next Babylon block on
+func (h Hooks) AfterBTCDelegationActivated(ctx context.Context, btcDel *bstypes.BTCDelegation) {
+}
@@ -6,7 +6,8 @@ import (
 )
 
 // HandleCoinsInFeeCollector intercepts a portion of coins in fee collector, and distributes
-// them to BTC staking gauge and BTC timestamping gauge of the current height and epoch, respectively.
+// them to BTC staking gauge of the current height, and BTC timestamping gauge and BLS signer gauge
+// of the current epoch.
 // It is invoked upon every `BeginBlock`.
 // adapted from https://github.com/cosmos/cosmos-sdk/blob/release/v0.47.x/x/distribution/keeper/allocation.go#L15-L26
 func (k Keeper) HandleCoinsInFeeCollector(ctx context.Context) {
@@ -37,4 +38,10 @@ func (k Keeper) HandleCoinsInFeeCollector(ctx context.Context) {
 	btcTimestampingPortion := params.BTCTimestampingPortion()
 	btcTimestampingReward := types.GetCoinsPortion(feesCollectedInt, btcTimestampingPortion)
 	k.accumulateBTCTimestampingReward(ctx, btcTimestampingReward)
+
+	// record BLS signer gauge for the current epoch, and transfer corresponding amount
+	// from fee collector account to incentive module account
+	blsSignerPortion := params.BLSSignerPortion()
+	blsSignerReward := types.GetCoinsPortion(feesCollectedInt, blsSignerPortion)
+	k.accumulateBLSSignerReward(ctx, blsSignerReward)
 }
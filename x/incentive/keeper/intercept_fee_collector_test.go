@@ -40,7 +40,7 @@ func FuzzInterceptFeeCollector(f *testing.F) {
 		// mock epoching keeper
 		epochNum := datagen.RandomInt(r, 100) + 1
 		epochingKeeper := types.NewMockEpochingKeeper(ctrl)
-		epochingKeeper.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: epochNum}).Times(1)
+		epochingKeeper.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: epochNum}).Times(2)
 
 		keeper, ctx := testkeeper.IncentiveKeeper(t, bankKeeper, accountKeeper, epochingKeeper)
 		height := datagen.RandomInt(r, 1000)
@@ -51,8 +51,10 @@ func FuzzInterceptFeeCollector(f *testing.F) {
 		params := keeper.GetParams(ctx)
 		feesForBTCStaking := types.GetCoinsPortion(fees, params.BTCStakingPortion())
 		feesForBTCTimestamping := types.GetCoinsPortion(fees, params.BTCTimestampingPortion())
+		feesForBLSSigner := types.GetCoinsPortion(fees, params.BLSSignerPortion())
 		bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), gomock.Eq(authtypes.FeeCollectorName), gomock.Eq(types.ModuleName), gomock.Eq(feesForBTCStaking)).Times(1)
 		bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), gomock.Eq(authtypes.FeeCollectorName), gomock.Eq(types.ModuleName), gomock.Eq(feesForBTCTimestamping)).Times(1)
+		bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), gomock.Eq(authtypes.FeeCollectorName), gomock.Eq(types.ModuleName), gomock.Eq(feesForBLSSigner)).Times(1)
 
 		// handle coins in fee collector
 		keeper.HandleCoinsInFeeCollector(ctx)
@@ -69,14 +71,20 @@ func FuzzInterceptFeeCollector(f *testing.F) {
 		require.NotNil(t, btcTimestampingGauge)
 		require.Equal(t, btcTimestampingFee, btcTimestampingGauge.Coins)
 
+		// assert correctness of BLS signer gauge at epoch
+		blsSignerGauge := keeper.GetBLSSignerGauge(ctx, epochNum)
+		require.NotNil(t, blsSignerGauge)
+		require.Equal(t, feesForBLSSigner, blsSignerGauge.Coins)
+
 		// accumulate for this epoch again and see if the epoch's BTC timestamping gauge has accumulated or not
 		height += 1
 		ctx = datagen.WithCtxHeight(ctx, height)
 		bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees).Times(1)
 		accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), authtypes.FeeCollectorName).Return(feeCollectorAcc).Times(1)
-		epochingKeeper.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: epochNum}).Times(1)
+		epochingKeeper.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: epochNum}).Times(2)
 		bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), gomock.Eq(authtypes.FeeCollectorName), gomock.Eq(types.ModuleName), gomock.Eq(feesForBTCStaking)).Times(1)
 		bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), gomock.Eq(authtypes.FeeCollectorName), gomock.Eq(types.ModuleName), gomock.Eq(feesForBTCTimestamping)).Times(1)
+		bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), gomock.Eq(authtypes.FeeCollectorName), gomock.Eq(types.ModuleName), gomock.Eq(feesForBLSSigner)).Times(1)
 		// handle coins in fee collector
 		keeper.HandleCoinsInFeeCollector(ctx)
 		// assert BTC timestamping gauge has doubled
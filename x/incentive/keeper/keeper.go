@@ -2,6 +2,7 @@ package keeper
 
 import (
 	corestoretypes "cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
 	"fmt"
 
 	"cosmossdk.io/log"
@@ -14,10 +15,14 @@ type (
 	Keeper struct {
 		cdc          codec.BinaryCodec
 		storeService corestoretypes.KVStoreService
+		// tsKey is the key of the transient store, which holds the
+		// refundable messages and refunded txs of the current block
+		tsKey storetypes.StoreKey
 
 		epochingKeeper types.EpochingKeeper
 		bankKeeper     types.BankKeeper
 		accountKeeper  types.AccountKeeper
+		distrKeeper    types.DistributionKeeper
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
 		authority string
@@ -29,8 +34,10 @@ type (
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService corestoretypes.KVStoreService,
+	tsKey storetypes.StoreKey,
 	bankKeeper types.BankKeeper,
 	accountKeeper types.AccountKeeper,
+	distrKeeper types.DistributionKeeper,
 	epochingKeeper types.EpochingKeeper,
 	authority string,
 	feeCollectorName string,
@@ -38,9 +45,11 @@ func NewKeeper(
 	return Keeper{
 		cdc:              cdc,
 		storeService:     storeService,
+		tsKey:            tsKey,
 		epochingKeeper:   epochingKeeper,
 		bankKeeper:       bankKeeper,
 		accountKeeper:    accountKeeper,
+		distrKeeper:      distrKeeper,
 		authority:        authority,
 		feeCollectorName: feeCollectorName,
 	}
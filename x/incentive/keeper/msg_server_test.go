@@ -61,5 +61,10 @@ func FuzzWithdrawReward(f *testing.F) {
 		newRg := ik.GetRewardGauge(ctx, sType, sAddr)
 		require.NotNil(t, newRg)
 		require.True(t, newRg.IsFullyWithdrawn())
+
+		// ensure the withdrawal event is emitted
+		events := ctx.EventManager().Events()
+		require.NotEmpty(t, events)
+		require.Equal(t, "babylon.incentive.EventRewardWithdrawn", events[len(events)-1].Type)
 	})
 }
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/incentive/types"
+)
+
+var _ sdk.PostDecorator = &RefundTxDecorator{}
+
+// RefundTxDecorator refunds the fee of a successful tx whose messages are all
+// refundable, i.e., indexed via IndexRefundableMsg by their message handlers.
+// At most MaxRefundedTxsPerBlock txs are refunded in a block, so that
+// refundable txs cannot be used to congest blocks for free.
+type RefundTxDecorator struct {
+	k *Keeper
+}
+
+// NewRefundTxDecorator creates a new RefundTxDecorator
+func NewRefundTxDecorator(k *Keeper) *RefundTxDecorator {
+	return &RefundTxDecorator{
+		k: k,
+	}
+}
+
+func (d *RefundTxDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	// only do this when finalizing a block
+	if ctx.ExecMode() != sdk.ExecModeFinalize || simulate {
+		return next(ctx, tx, simulate, success)
+	}
+	// ignore unsuccessful tx, whose state updates, including the refundable
+	// messages, are rolled back
+	if !success {
+		return next(ctx, tx, simulate, success)
+	}
+
+	// the refundable messages are removed regardless of whether the tx is
+	// refunded, so that they cannot be refunded again
+	if !d.k.isRefundTx(ctx, tx) {
+		return next(ctx, tx, simulate, success)
+	}
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return next(ctx, tx, simulate, success)
+	}
+	if err := d.k.RefundTx(ctx, feeTx); err != nil {
+		// failing to refund a tx does not fail the tx
+		d.k.Logger(ctx).Error("failed to refund tx", "error", err)
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+// isRefundTx returns whether all messages of the given tx are refundable. It
+// removes all messages of the tx from the set of refundable messages.
+func (k Keeper) isRefundTx(ctx context.Context, tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	refundable := len(msgs) > 0
+	for _, msg := range msgs {
+		msgHash := types.HashMsg(msg)
+		if !k.HasRefundableMsg(ctx, msgHash) {
+			refundable = false
+			continue
+		}
+		k.RemoveRefundableMsg(ctx, msgHash)
+	}
+	return refundable
+}
+
+// RefundTx refunds the fee of the given tx from the fee collector to the
+// account paying the fee, i.e., the fee granter if any or otherwise the fee
+// payer, unless MaxRefundedTxsPerBlock txs are already refunded in the
+// current block
+func (k Keeper) RefundTx(ctx sdk.Context, tx sdk.FeeTx) error {
+	fee := tx.GetFee()
+	if fee.IsZero() {
+		return nil
+	}
+	numRefundedTxs := k.GetNumRefundedTxs(ctx)
+	if numRefundedTxs >= uint64(k.GetParams(ctx).MaxRefundedTxsPerBlock) {
+		return nil
+	}
+
+	recipient := sdk.AccAddress(tx.FeePayer())
+	if granter := tx.FeeGranter(); len(granter) > 0 {
+		recipient = granter
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, k.feeCollectorName, recipient, fee); err != nil {
+		return fmt.Errorf("failed to refund fee %s to %s: %w", fee.String(), recipient.String(), err)
+	}
+	k.setNumRefundedTxs(ctx, numRefundedTxs+1)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventTxFeeRefunded{
+		TxHash:    hex.EncodeToString(tmhash.Sum(ctx.TxBytes())),
+		Recipient: recipient.String(),
+		Fee:       fee,
+	})
+}
+
+// GetNumRefundedTxs returns the number of txs refunded in the current block
+func (k Keeper) GetNumRefundedTxs(ctx context.Context) uint64 {
+	bz := sdk.UnwrapSDKContext(ctx).TransientStore(k.tsKey).Get(types.RefundedTxsKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setNumRefundedTxs records the number of txs refunded in the current block
+// in the transient store, which is cleared after each block execution
+// key: RefundedTxsKey
+// value: BigEndianUint64(numRefundedTxs)
+func (k Keeper) setNumRefundedTxs(ctx context.Context, numRefundedTxs uint64) {
+	store := sdk.UnwrapSDKContext(ctx).TransientStore(k.tsKey)
+	store.Set(types.RefundedTxsKey, sdk.Uint64ToBigEndian(numRefundedTxs))
+}
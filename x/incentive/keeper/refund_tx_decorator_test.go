@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/incentive/keeper"
+	"github.com/babylonchain/babylon/x/incentive/types"
+)
+
+// feeTx is a minimal sdk.FeeTx for testing RefundTxDecorator
+type feeTx struct {
+	msgs  []sdk.Msg
+	fee   sdk.Coins
+	payer sdk.AccAddress
+}
+
+func (tx *feeTx) GetMsgs() []sdk.Msg                    { return tx.msgs }
+func (tx *feeTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+func (tx *feeTx) GetGas() uint64                        { return 0 }
+func (tx *feeTx) GetFee() sdk.Coins                     { return tx.fee }
+func (tx *feeTx) FeePayer() []byte                      { return tx.payer }
+func (tx *feeTx) FeeGranter() []byte                    { return nil }
+
+func nextPostHandler(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) { return ctx, nil }
+
+func genRandomCovenantMsg(r *rand.Rand) sdk.Msg {
+	return &bstypes.MsgAddCovenantSigs{
+		Signer:        datagen.GenRandomAccount().Address,
+		StakingTxHash: datagen.GenRandomBtcdHash(r).String(),
+	}
+}
+
+func FuzzRefundTxDecorator(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bankKeeper := types.NewMockBankKeeper(ctrl)
+		k, ctx := testkeeper.IncentiveKeeper(t, bankKeeper, nil, nil)
+		ctx = datagen.WithCtxHeight(ctx, datagen.RandomInt(r, 1000)+1).WithExecMode(sdk.ExecModeFinalize)
+		decorator := keeper.NewRefundTxDecorator(k)
+
+		// set a random cap on the number of refunded txs per block
+		params := k.GetParams(ctx)
+		params.MaxRefundedTxsPerBlock = uint32(datagen.RandomInt(r, 5) + 1)
+		err := k.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		genRefundableTx := func() *feeTx {
+			msg := genRandomCovenantMsg(r)
+			k.IndexRefundableMsg(ctx, msg)
+			return &feeTx{
+				msgs:  []sdk.Msg{msg},
+				fee:   fees,
+				payer: datagen.GenRandomAccount().GetAddress(),
+			}
+		}
+
+		// a failed tx is not refunded
+		_, err = decorator.PostHandle(ctx, genRefundableTx(), false, false, nextPostHandler)
+		require.NoError(t, err)
+
+		// a tx with a non-refundable message is not refunded, and its
+		// refundable message cannot be refunded any more
+		mixedTx := genRefundableTx()
+		refundableMsg := mixedTx.msgs[0]
+		mixedTx.msgs = append(mixedTx.msgs, genRandomCovenantMsg(r))
+		_, err = decorator.PostHandle(ctx, mixedTx, false, true, nextPostHandler)
+		require.NoError(t, err)
+		require.False(t, k.HasRefundableMsg(ctx, types.HashMsg(refundableMsg)))
+
+		// refundable txs are refunded up to the cap
+		numTxs := int(params.MaxRefundedTxsPerBlock) + int(datagen.RandomInt(r, 3)) + 1
+		for i := 0; i < numTxs; i++ {
+			tx := genRefundableTx()
+			if i < int(params.MaxRefundedTxsPerBlock) {
+				bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), authtypes.FeeCollectorName, tx.payer, fees).Return(nil).Times(1)
+			}
+			_, err = decorator.PostHandle(ctx, tx, false, true, nextPostHandler)
+			require.NoError(t, err)
+			require.False(t, k.HasRefundableMsg(ctx, types.HashMsg(tx.msgs[0])))
+		}
+		require.Equal(t, uint64(params.MaxRefundedTxsPerBlock), k.GetNumRefundedTxs(ctx))
+
+		// a refundable message that is not a top-level message of a tx, e.g.,
+		// one executed via authz, is never removed by the decorator
+		nestedMsg := genRandomCovenantMsg(r)
+		k.IndexRefundableMsg(ctx, nestedMsg)
+		require.True(t, k.HasRefundableMsg(ctx, types.HashMsg(nestedMsg)))
+
+		// the cap and the refundable messages are reset upon a new block, as
+		// the transient store is cleared upon commit
+		ctx.MultiStore().(storetypes.CommitMultiStore).Commit()
+		ctx = datagen.WithCtxHeight(ctx, uint64(ctx.HeaderInfo().Height)+1)
+		require.Zero(t, k.GetNumRefundedTxs(ctx))
+		require.False(t, k.HasRefundableMsg(ctx, types.HashMsg(nestedMsg)))
+		tx := genRefundableTx()
+		bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), authtypes.FeeCollectorName, tx.payer, fees).Return(nil).Times(1)
+		_, err = decorator.PostHandle(ctx, tx, false, true, nextPostHandler)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), k.GetNumRefundedTxs(ctx))
+	})
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/incentive/types"
+)
+
+// IndexRefundableMsg indexes the given message as refundable. It is invoked by
+// the message handlers of other modules upon accepting a message that
+// contributes to the protocol, e.g., a new covenant signature, so that the
+// fee of the tx carrying the message can be refunded by RefundTxDecorator.
+// Messages are indexed in the transient store, so that messages that are not
+// top-level messages of a tx, e.g., messages executed via authz, and thus never
+// removed by RefundTxDecorator, are discarded at the end of the block.
+func (k Keeper) IndexRefundableMsg(ctx context.Context, msg sdk.Msg) {
+	store := k.refundableMsgKeySetStore(ctx)
+	store.Set(types.HashMsg(msg), []byte{0x00})
+}
+
+// HasRefundableMsg checks whether the message with the given hash is
+// refundable
+func (k Keeper) HasRefundableMsg(ctx context.Context, msgHash []byte) bool {
+	store := k.refundableMsgKeySetStore(ctx)
+	return store.Has(msgHash)
+}
+
+// RemoveRefundableMsg removes the message with the given hash from the set of
+// refundable messages
+func (k Keeper) RemoveRefundableMsg(ctx context.Context, msgHash []byte) {
+	store := k.refundableMsgKeySetStore(ctx)
+	store.Delete(msgHash)
+}
+
+// refundableMsgKeySetStore returns the transient KVStore of the set of hashes
+// of refundable messages. The transient store is cleared after each block
+// execution, so it only contains the refundable messages of the current block
+// prefix: RefundableMsgKeySetKey
+// key: message hash
+// value: a dummy value
+func (k Keeper) refundableMsgKeySetStore(ctx context.Context) prefix.Store {
+	store := sdk.UnwrapSDKContext(ctx).TransientStore(k.tsKey)
+	return prefix.NewStore(store, types.RefundableMsgKeySetKey)
+}
@@ -26,6 +26,15 @@ func (k Keeper) withdrawReward(ctx context.Context, sType types.StakeholderType,
 	// empty reward gauge
 	rg.SetFullyWithdrawn()
 	k.SetRewardGauge(ctx, sType, addr, rg)
+	// emit event for the withdrawal
+	event := &types.EventRewardWithdrawn{
+		Type:    sType.String(),
+		Address: addr.String(),
+		Coins:   withdrawableCoins,
+	}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
+		return nil, err
+	}
 	// all good, return
 	return withdrawableCoins, nil
 }
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/incentive/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// slashFinalityProviderReward handles the rewards of a newly slashed finality
+// provider, including
+// - recording the infraction height so that the finality provider and its BTC
+// delegations do not accrue the rewards of blocks since this height that are
+// distributed from now on, and
+// - clawing back the unclaimed commission of the finality provider.
+// The clawed back rewards are burned or sent to the community pool, depending
+// on the parameters.
+// NOTE: the rewards of blocks since the infraction height that are already
+// distributed to the BTC delegations of the finality provider are not clawed
+// back. The reward gauge of a BTC delegation aggregates its rewards across
+// heights, thus cannot tell apart the rewards of these blocks.
+func (k Keeper) slashFinalityProviderReward(ctx context.Context, fp *bstypes.FinalityProvider, infractionHeight uint64) {
+	fpAddr := sdk.AccAddress(fp.BabylonPk.Address())
+
+	// record the infraction height of the finality provider, which can be
+	// before the height at which the finality provider is slashed, e.g., when
+	// the evidence of an equivocation is submitted later
+	k.setSlashedHeight(ctx, fpAddr, infractionHeight)
+
+	// claw back the unclaimed commission of the finality provider
+	rg := k.GetRewardGauge(ctx, types.FinalityProviderType, fpAddr)
+	if rg == nil {
+		// the finality provider has never received any commission
+		return
+	}
+	clawedBackCoins := rg.ClawBack()
+	k.SetRewardGauge(ctx, types.FinalityProviderType, fpAddr, rg)
+	k.disposeSlashedReward(ctx, clawedBackCoins)
+}
+
+// disposeSlashedReward burns the given rewards or sends them to the
+// community pool, depending on the parameters
+func (k Keeper) disposeSlashedReward(ctx context.Context, reward sdk.Coins) {
+	// if reward contains nothing, do nothing
+	if !reward.IsAllPositive() {
+		return
+	}
+
+	var err error
+	if k.GetParams(ctx).BurnSlashedRewards {
+		err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, reward)
+	} else {
+		moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+		err = k.distrKeeper.FundCommunityPool(ctx, reward, moduleAddr)
+	}
+	if err != nil {
+		// the incentive module account always holds all undistributed rewards,
+		// so this can only be programming error and is unrecoverable
+		panic(fmt.Errorf("failed to dispose rewards of slashed finality provider: %w", err))
+	}
+}
+
+// isSlashedAtHeight checks whether the finality provider with the given
+// address is slashed at or before the given height
+func (k Keeper) isSlashedAtHeight(ctx context.Context, fpAddr sdk.AccAddress, height uint64) bool {
+	slashedHeight, ok := k.getSlashedHeight(ctx, fpAddr)
+	return ok && slashedHeight <= height
+}
+
+func (k Keeper) setSlashedHeight(ctx context.Context, fpAddr sdk.AccAddress, height uint64) {
+	store := k.slashedFPStore(ctx)
+	store.Set(fpAddr.Bytes(), sdk.Uint64ToBigEndian(height))
+}
+
+func (k Keeper) getSlashedHeight(ctx context.Context, fpAddr sdk.AccAddress) (uint64, bool) {
+	store := k.slashedFPStore(ctx)
+	heightBytes := store.Get(fpAddr.Bytes())
+	if heightBytes == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(heightBytes), true
+}
+
+// slashedFPStore returns the KVStore of the infraction heights of slashed
+// finality providers
+// prefix: SlashedFPKey
+// key: finality provider's address
+// value: Babylon height of the infraction of the finality provider
+func (k Keeper) slashedFPStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.SlashedFPKey)
+}
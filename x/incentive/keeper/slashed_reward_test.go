@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/x/incentive/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzSlashFinalityProviderReward(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock bank and distribution keepers
+		bankKeeper := types.NewMockBankKeeper(ctrl)
+		distrKeeper := types.NewMockDistributionKeeper(ctrl)
+
+		// create incentive keeper that either burns rewards of slashed
+		// finality providers or sends them to the community pool
+		keeper, ctx := testkeeper.IncentiveKeeperWithDistrKeeper(t, bankKeeper, nil, distrKeeper, nil)
+		params := keeper.GetParams(ctx)
+		params.BurnSlashedRewards = r.Intn(2) == 0
+		err := keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+		expectDisposal := func(coins sdk.Coins) {
+			if !coins.IsAllPositive() {
+				return
+			}
+			if params.BurnSlashedRewards {
+				bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, coins).Return(nil).Times(1)
+			} else {
+				moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+				distrKeeper.EXPECT().FundCommunityPool(gomock.Any(), coins, moduleAddr).Return(nil).Times(1)
+			}
+		}
+
+		// generate a random voting power distribution cache, and
+		// pick a random finality provider in it to be slashed
+		dc, err := datagen.GenRandomVotingPowerDistCache(r, 100)
+		require.NoError(t, err)
+		slashedFpIdx := datagen.RandomInt(r, len(dc.FinalityProviders))
+		slashedFpDistInfo := dc.FinalityProviders[slashedFpIdx]
+		slashedFpAddr := slashedFpDistInfo.GetAddress()
+		slashedFp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		slashedFp.BabylonPk = slashedFpDistInfo.BabylonPk
+		// the evidence of the infraction is submitted after the infraction
+		infractionHeight := datagen.RandomInt(r, 1000) + 1
+		slashedFp.SlashedBabylonHeight = infractionHeight + datagen.RandomInt(r, 1000)
+
+		// distribute rewards at a height since the infraction before the
+		// finality provider is slashed, which its BTC delegations keep
+		preSlashingHeight := infractionHeight + datagen.RandomInt(r, 1000)
+		keeper.SetBTCStakingGauge(ctx, preSlashingHeight, datagen.GenRandomGauge(r))
+		keeper.RewardBTCStaking(ctx, preSlashingHeight, dc)
+		delRgs := map[string]*types.RewardGauge{}
+		for _, btcDel := range slashedFpDistInfo.BtcDels {
+			delRgs[btcDel.GetAddress().String()] = keeper.GetRewardGauge(ctx, types.BTCDelegationType, btcDel.GetAddress())
+		}
+
+		// give the finality provider some partially withdrawn commission
+		rg := datagen.GenRandomRewardGauge(r)
+		rg.WithdrawnCoins = datagen.GenRandomWithdrawnCoins(r, rg.Coins)
+		keeper.SetRewardGauge(ctx, types.FinalityProviderType, slashedFpAddr, rg)
+		withdrawableCoins := rg.GetWithdrawableCoins()
+
+		// slashing the finality provider claws back its unclaimed commission
+		expectDisposal(withdrawableCoins)
+		keeper.Hooks().AfterFinalityProviderSlashed(ctx, slashedFp, infractionHeight)
+		rg = keeper.GetRewardGauge(ctx, types.FinalityProviderType, slashedFpAddr)
+		require.NotNil(t, rg)
+		require.True(t, rg.IsFullyWithdrawn())
+
+		// distribute rewards at a height since the infraction, which can be
+		// before the finality provider is slashed
+		height := infractionHeight + datagen.RandomInt(r, 2000)
+		gauge := datagen.GenRandomGauge(r)
+		keeper.SetBTCStakingGauge(ctx, height, gauge)
+		fpPortion := dc.GetFinalityProviderPortion(slashedFpDistInfo)
+		coinsForSlashedFp := gauge.GetCoinsPortion(fpPortion)
+		expectDisposal(coinsForSlashedFp)
+		keeper.RewardBTCStaking(ctx, height, dc)
+
+		// the slashed finality provider and its BTC delegations do not accrue
+		// rewards anymore, while the BTC delegations keep their rewards
+		// distributed before the slashing
+		rg = keeper.GetRewardGauge(ctx, types.FinalityProviderType, slashedFpAddr)
+		require.True(t, rg.IsFullyWithdrawn())
+		for _, btcDel := range slashedFpDistInfo.BtcDels {
+			delRg := keeper.GetRewardGauge(ctx, types.BTCDelegationType, btcDel.GetAddress())
+			require.Equal(t, delRgs[btcDel.GetAddress().String()], delRg)
+		}
+	})
+}
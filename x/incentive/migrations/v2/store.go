@@ -0,0 +1,38 @@
+package v2
+
+import (
+	corestoretypes "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/incentive/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The
+// parameters stored by v1 predate the portion of the BLS signers, which is
+// thus nil after decoding and would fail intercepting the fee collector upon
+// every block. If the portion is unset, the migration sets it to its default
+// value, and it keeps all other parameters as they are.
+func MigrateStore(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	store := storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.ParamsKey)
+	if err != nil {
+		return err
+	}
+
+	var params types.Params
+	if bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	if params.BlsSignerPortion.IsNil() {
+		params.BlsSignerPortion = types.DefaultParams().BlsSignerPortion
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	return store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+}
@@ -0,0 +1,78 @@
+package v2_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	v2 "github.com/babylonchain/babylon/x/incentive/migrations/v2"
+	"github.com/babylonchain/babylon/x/incentive/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	kvStore := storeService.OpenKVStore(ctx)
+
+	// v1 params carry no portion of the BLS signers
+	v1Params := types.DefaultParams()
+	v1Params.SubmitterPortion = sdkmath.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, kvStore.Set(types.ParamsKey, removeField(t, cdc.MustMarshal(&v1Params), 7)))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+
+	bz, err := kvStore.Get(types.ParamsKey)
+	require.NoError(t, err)
+	var params types.Params
+	cdc.MustUnmarshal(bz, &params)
+	require.NoError(t, params.Validate())
+
+	// the portion of the BLS signers is set to its default while the others
+	// are kept
+	expectedParams := types.DefaultParams()
+	expectedParams.SubmitterPortion = v1Params.SubmitterPortion
+	require.Equal(t, expectedParams.String(), params.String())
+
+	// a portion that is already set is kept as well
+	params.BlsSignerPortion = sdkmath.LegacyNewDecWithPrec(1, 2)
+	require.NoError(t, kvStore.Set(types.ParamsKey, cdc.MustMarshal(&params)))
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+	bz, err = kvStore.Get(types.ParamsKey)
+	require.NoError(t, err)
+	var migratedParams types.Params
+	cdc.MustUnmarshal(bz, &migratedParams)
+	require.True(t, params.BlsSignerPortion.Equal(migratedParams.BlsSignerPortion))
+}
+
+// removeField removes the field with the given number from the encoded
+// message
+func removeField(t *testing.T, bz []byte, num protowire.Number) []byte {
+	var out []byte
+	for len(bz) > 0 {
+		fieldNum, _, n := protowire.ConsumeField(bz)
+		require.True(t, n > 0)
+		if fieldNum != num {
+			out = append(out, bz[:n]...)
+		}
+		bz = bz[n:]
+	}
+	return out
+}
@@ -0,0 +1,1430 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: babylon/incentive/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventBTCTimestampingRewardDistributed is the event emitted when the BTC
+// timestamping reward of a finalized epoch is distributed to the submitters
+// and reporters of its checkpoint
+type EventBTCTimestampingRewardDistributed struct {
+	// epoch is the number of the finalized epoch
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// best_submitter is the address of the submitter of the best submission
+	BestSubmitter string `protobuf:"bytes,2,opt,name=best_submitter,json=bestSubmitter,proto3" json:"best_submitter,omitempty"`
+	// best_reporter is the address of the reporter of the best submission
+	BestReporter string `protobuf:"bytes,3,opt,name=best_reporter,json=bestReporter,proto3" json:"best_reporter,omitempty"`
+	// num_other_submissions is the number of the other submissions that
+	// receive the rest of the reward
+	NumOtherSubmissions uint64 `protobuf:"varint,4,opt,name=num_other_submissions,json=numOtherSubmissions,proto3" json:"num_other_submissions,omitempty"`
+	// coins_to_submitters is the reward distributed to all submitters
+	CoinsToSubmitters github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=coins_to_submitters,json=coinsToSubmitters,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins_to_submitters"`
+	// coins_to_reporters is the reward distributed to all reporters
+	CoinsToReporters github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=coins_to_reporters,json=coinsToReporters,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins_to_reporters"`
+}
+
+func (m *EventBTCTimestampingRewardDistributed) Reset()         { *m = EventBTCTimestampingRewardDistributed{} }
+func (m *EventBTCTimestampingRewardDistributed) String() string { return proto.CompactTextString(m) }
+func (*EventBTCTimestampingRewardDistributed) ProtoMessage()    {}
+func (*EventBTCTimestampingRewardDistributed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78c8437b872382b3, []int{0}
+}
+func (m *EventBTCTimestampingRewardDistributed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBTCTimestampingRewardDistributed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBTCTimestampingRewardDistributed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBTCTimestampingRewardDistributed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBTCTimestampingRewardDistributed.Merge(m, src)
+}
+func (m *EventBTCTimestampingRewardDistributed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBTCTimestampingRewardDistributed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBTCTimestampingRewardDistributed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBTCTimestampingRewardDistributed proto.InternalMessageInfo
+
+func (m *EventBTCTimestampingRewardDistributed) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EventBTCTimestampingRewardDistributed) GetBestSubmitter() string {
+	if m != nil {
+		return m.BestSubmitter
+	}
+	return ""
+}
+
+func (m *EventBTCTimestampingRewardDistributed) GetBestReporter() string {
+	if m != nil {
+		return m.BestReporter
+	}
+	return ""
+}
+
+func (m *EventBTCTimestampingRewardDistributed) GetNumOtherSubmissions() uint64 {
+	if m != nil {
+		return m.NumOtherSubmissions
+	}
+	return 0
+}
+
+func (m *EventBTCTimestampingRewardDistributed) GetCoinsToSubmitters() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CoinsToSubmitters
+	}
+	return nil
+}
+
+func (m *EventBTCTimestampingRewardDistributed) GetCoinsToReporters() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CoinsToReporters
+	}
+	return nil
+}
+
+// EventBLSSignerRewardDistributed is the event emitted when the BLS signer
+// reward of a finalized epoch is distributed to the validators who contributed
+// their BLS sigs to its checkpoint
+type EventBLSSignerRewardDistributed struct {
+	// epoch is the number of the finalized epoch
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// num_signers is the number of the validators that receive the reward
+	NumSigners uint64 `protobuf:"varint,2,opt,name=num_signers,json=numSigners,proto3" json:"num_signers,omitempty"`
+	// coins_to_signers is the reward distributed to all the validators
+	CoinsToSigners github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins_to_signers,json=coinsToSigners,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins_to_signers"`
+}
+
+func (m *EventBLSSignerRewardDistributed) Reset()         { *m = EventBLSSignerRewardDistributed{} }
+func (m *EventBLSSignerRewardDistributed) String() string { return proto.CompactTextString(m) }
+func (*EventBLSSignerRewardDistributed) ProtoMessage()    {}
+func (*EventBLSSignerRewardDistributed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78c8437b872382b3, []int{1}
+}
+func (m *EventBLSSignerRewardDistributed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBLSSignerRewardDistributed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBLSSignerRewardDistributed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBLSSignerRewardDistributed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBLSSignerRewardDistributed.Merge(m, src)
+}
+func (m *EventBLSSignerRewardDistributed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBLSSignerRewardDistributed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBLSSignerRewardDistributed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBLSSignerRewardDistributed proto.InternalMessageInfo
+
+func (m *EventBLSSignerRewardDistributed) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EventBLSSignerRewardDistributed) GetNumSigners() uint64 {
+	if m != nil {
+		return m.NumSigners
+	}
+	return 0
+}
+
+func (m *EventBLSSignerRewardDistributed) GetCoinsToSigners() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CoinsToSigners
+	}
+	return nil
+}
+
+// EventRewardWithdrawn is the event emitted when a stakeholder withdraws
+// its reward
+type EventRewardWithdrawn struct {
+	// type is the type of the stakeholder
+	// {submitter, reporter, finality_provider, btc_delegation, bls_signer}
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// address is the address of the stakeholder in bech32 string
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// coins is the withdrawn coins
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *EventRewardWithdrawn) Reset()         { *m = EventRewardWithdrawn{} }
+func (m *EventRewardWithdrawn) String() string { return proto.CompactTextString(m) }
+func (*EventRewardWithdrawn) ProtoMessage()    {}
+func (*EventRewardWithdrawn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78c8437b872382b3, []int{2}
+}
+func (m *EventRewardWithdrawn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRewardWithdrawn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRewardWithdrawn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRewardWithdrawn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRewardWithdrawn.Merge(m, src)
+}
+func (m *EventRewardWithdrawn) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRewardWithdrawn) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRewardWithdrawn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRewardWithdrawn proto.InternalMessageInfo
+
+func (m *EventRewardWithdrawn) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *EventRewardWithdrawn) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventRewardWithdrawn) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+// EventTxFeeRefunded is the event emitted when the fee of a refundable tx is
+// refunded
+type EventTxFeeRefunded struct {
+	// tx_hash is the hash of the refunded tx in hex
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// recipient is the address of the account paying the fee in bech32 string
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// fee is the refunded fee
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+}
+
+func (m *EventTxFeeRefunded) Reset()         { *m = EventTxFeeRefunded{} }
+func (m *EventTxFeeRefunded) String() string { return proto.CompactTextString(m) }
+func (*EventTxFeeRefunded) ProtoMessage()    {}
+func (*EventTxFeeRefunded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78c8437b872382b3, []int{3}
+}
+func (m *EventTxFeeRefunded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTxFeeRefunded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTxFeeRefunded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTxFeeRefunded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTxFeeRefunded.Merge(m, src)
+}
+func (m *EventTxFeeRefunded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTxFeeRefunded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTxFeeRefunded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTxFeeRefunded proto.InternalMessageInfo
+
+func (m *EventTxFeeRefunded) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *EventTxFeeRefunded) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EventTxFeeRefunded) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EventBTCTimestampingRewardDistributed)(nil), "babylon.incentive.EventBTCTimestampingRewardDistributed")
+	proto.RegisterType((*EventBLSSignerRewardDistributed)(nil), "babylon.incentive.EventBLSSignerRewardDistributed")
+	proto.RegisterType((*EventRewardWithdrawn)(nil), "babylon.incentive.EventRewardWithdrawn")
+	proto.RegisterType((*EventTxFeeRefunded)(nil), "babylon.incentive.EventTxFeeRefunded")
+}
+
+func init() { proto.RegisterFile("babylon/incentive/events.proto", fileDescriptor_78c8437b872382b3) }
+
+var fileDescriptor_78c8437b872382b3 = []byte{
+	// 542 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xdd, 0x6a, 0x13, 0x41,
+	0x14, 0xce, 0x9a, 0xb4, 0xa5, 0x53, 0x2d, 0xed, 0x34, 0xe2, 0x5a, 0x64, 0x13, 0x22, 0x85, 0xdc,
+	0xb8, 0x6b, 0xea, 0x1b, 0xa4, 0x2a, 0x82, 0x82, 0xb0, 0x09, 0x08, 0x82, 0x84, 0xfd, 0x39, 0xcd,
+	0x0e, 0xba, 0x33, 0xcb, 0x9c, 0xd9, 0x34, 0xc1, 0x97, 0xf0, 0x2d, 0x84, 0xde, 0xfb, 0x0e, 0xbd,
+	0xb3, 0x97, 0x5e, 0xa9, 0x24, 0x2f, 0x22, 0x33, 0xb3, 0x9b, 0xf4, 0xd2, 0x8b, 0xf6, 0x6a, 0x67,
+	0xce, 0x77, 0xe6, 0xfb, 0x39, 0xb3, 0x0c, 0xf1, 0xe2, 0x28, 0x5e, 0x7c, 0x11, 0x3c, 0x60, 0x3c,
+	0x01, 0xae, 0xd8, 0x0c, 0x02, 0x98, 0x01, 0x57, 0xe8, 0x17, 0x52, 0x28, 0x41, 0x0f, 0x2b, 0xdc,
+	0x5f, 0xe3, 0xc7, 0xed, 0xa9, 0x98, 0x0a, 0x83, 0x06, 0x7a, 0x65, 0x1b, 0x8f, 0xbd, 0x44, 0x60,
+	0x2e, 0x30, 0x88, 0x23, 0x84, 0x60, 0x36, 0x88, 0x41, 0x45, 0x83, 0x20, 0x11, 0x8c, 0x5b, 0xbc,
+	0xf7, 0xa3, 0x49, 0x4e, 0x5e, 0x69, 0xe6, 0xe1, 0xf8, 0x6c, 0xcc, 0x72, 0x40, 0x15, 0xe5, 0x05,
+	0xe3, 0xd3, 0x10, 0x2e, 0x22, 0x99, 0xbe, 0x64, 0xa8, 0x24, 0x8b, 0x4b, 0x05, 0x29, 0x6d, 0x93,
+	0x2d, 0x28, 0x44, 0x92, 0xb9, 0x4e, 0xd7, 0xe9, 0xb7, 0x42, 0xbb, 0xa1, 0x27, 0x64, 0x3f, 0x06,
+	0x54, 0x13, 0x2c, 0xe3, 0x9c, 0x29, 0x05, 0xd2, 0xbd, 0xd7, 0x75, 0xfa, 0xbb, 0xe1, 0x03, 0x5d,
+	0x1d, 0xd5, 0x45, 0xfa, 0x94, 0x98, 0xc2, 0x44, 0x42, 0x21, 0xa4, 0xee, 0x6a, 0x9a, 0xae, 0xfb,
+	0xba, 0x18, 0x56, 0x35, 0x7a, 0x4a, 0x1e, 0xf2, 0x32, 0x9f, 0x08, 0x95, 0x81, 0xb4, 0x84, 0x88,
+	0x4c, 0x70, 0x74, 0x5b, 0x46, 0xf1, 0x88, 0x97, 0xf9, 0x7b, 0x8d, 0x8d, 0x36, 0x10, 0xfd, 0x4a,
+	0x8e, 0x74, 0x1a, 0x9c, 0x28, 0xb1, 0xf1, 0x80, 0xee, 0x56, 0xb7, 0xd9, 0xdf, 0x3b, 0x7d, 0xec,
+	0xdb, 0xf4, 0xbe, 0x4e, 0xef, 0x57, 0xe9, 0xfd, 0x33, 0xc1, 0xf8, 0xf0, 0xf9, 0xd5, 0xef, 0x4e,
+	0xe3, 0xf2, 0x4f, 0xa7, 0x3f, 0x65, 0x2a, 0x2b, 0x63, 0x3f, 0x11, 0x79, 0x50, 0x8d, 0xca, 0x7e,
+	0x9e, 0x61, 0xfa, 0x39, 0x50, 0x8b, 0x02, 0xd0, 0x1c, 0xc0, 0xf0, 0xd0, 0xe8, 0x8c, 0xc5, 0x3a,
+	0x14, 0xd2, 0x05, 0xa1, 0x6b, 0xf1, 0x3a, 0x19, 0xba, 0xdb, 0xb7, 0xaf, 0x7d, 0x50, 0x69, 0xd7,
+	0xa3, 0xc2, 0xde, 0x4f, 0x87, 0x74, 0xec, 0xbd, 0xbd, 0x1b, 0x8d, 0xd8, 0x94, 0x83, 0xfc, 0xdf,
+	0x1b, 0xeb, 0x90, 0x3d, 0x3d, 0x65, 0x34, 0x87, 0xd0, 0x5c, 0x57, 0x2b, 0x24, 0xbc, 0xcc, 0x2d,
+	0x0d, 0xd2, 0x92, 0x1c, 0x6c, 0x46, 0x5a, 0x75, 0x35, 0x6f, 0x3f, 0xd3, 0x7e, 0x3d, 0x4f, 0x2b,
+	0xd1, 0xfb, 0xee, 0x90, 0xb6, 0x49, 0x64, 0x83, 0x7c, 0x60, 0x2a, 0x4b, 0x65, 0x74, 0xc1, 0x29,
+	0x25, 0x2d, 0x7d, 0xce, 0xa4, 0xd8, 0x0d, 0xcd, 0x9a, 0xba, 0x64, 0x27, 0x4a, 0x53, 0x09, 0x88,
+	0xd5, 0xff, 0x56, 0x6f, 0x69, 0x44, 0xb6, 0x0c, 0xf1, 0x5d, 0x58, 0xb6, 0xcc, 0xbd, 0x4b, 0x87,
+	0x50, 0xe3, 0x74, 0x3c, 0x7f, 0x0d, 0x10, 0xc2, 0x79, 0xc9, 0x53, 0x48, 0xe9, 0x23, 0xb2, 0xa3,
+	0xe6, 0x93, 0x2c, 0xc2, 0xac, 0xb2, 0xba, 0xad, 0xe6, 0x6f, 0x22, 0xcc, 0xe8, 0x13, 0xb2, 0x2b,
+	0x21, 0x61, 0x05, 0x03, 0xae, 0x2a, 0xbb, 0x9b, 0x02, 0xfd, 0x44, 0x9a, 0xe7, 0x00, 0x77, 0x61,
+	0x57, 0xf3, 0x0e, 0xdf, 0x5e, 0x2d, 0x3d, 0xe7, 0x7a, 0xe9, 0x39, 0x7f, 0x97, 0x9e, 0xf3, 0x6d,
+	0xe5, 0x35, 0xae, 0x57, 0x5e, 0xe3, 0xd7, 0xca, 0x6b, 0x7c, 0x1c, 0xdc, 0x20, 0xaa, 0x9e, 0x93,
+	0x24, 0x8b, 0x18, 0xaf, 0x37, 0xc1, 0xfc, 0xc6, 0xeb, 0x63, 0x78, 0xe3, 0x6d, 0xf3, 0x68, 0xbc,
+	0xf8, 0x17, 0x00, 0x00, 0xff, 0xff, 0xce, 0x6f, 0x2c, 0x2d, 0x9f, 0x04, 0x00, 0x00,
+}
+
+func (m *EventBTCTimestampingRewardDistributed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBTCTimestampingRewardDistributed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBTCTimestampingRewardDistributed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CoinsToReporters) > 0 {
+		for iNdEx := len(m.CoinsToReporters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoinsToReporters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CoinsToSubmitters) > 0 {
+		for iNdEx := len(m.CoinsToSubmitters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoinsToSubmitters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.NumOtherSubmissions != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NumOtherSubmissions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BestReporter) > 0 {
+		i -= len(m.BestReporter)
+		copy(dAtA[i:], m.BestReporter)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BestReporter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BestSubmitter) > 0 {
+		i -= len(m.BestSubmitter)
+		copy(dAtA[i:], m.BestSubmitter)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BestSubmitter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBLSSignerRewardDistributed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBLSSignerRewardDistributed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBLSSignerRewardDistributed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CoinsToSigners) > 0 {
+		for iNdEx := len(m.CoinsToSigners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoinsToSigners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NumSigners != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NumSigners))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRewardWithdrawn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRewardWithdrawn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRewardWithdrawn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTxFeeRefunded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTxFeeRefunded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTxFeeRefunded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventBTCTimestampingRewardDistributed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovEvents(uint64(m.Epoch))
+	}
+	l = len(m.BestSubmitter)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BestReporter)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.NumOtherSubmissions != 0 {
+		n += 1 + sovEvents(uint64(m.NumOtherSubmissions))
+	}
+	if len(m.CoinsToSubmitters) > 0 {
+		for _, e := range m.CoinsToSubmitters {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.CoinsToReporters) > 0 {
+		for _, e := range m.CoinsToReporters {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventBLSSignerRewardDistributed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovEvents(uint64(m.Epoch))
+	}
+	if m.NumSigners != 0 {
+		n += 1 + sovEvents(uint64(m.NumSigners))
+	}
+	if len(m.CoinsToSigners) > 0 {
+		for _, e := range m.CoinsToSigners {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventRewardWithdrawn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventTxFeeRefunded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventBTCTimestampingRewardDistributed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBTCTimestampingRewardDistributed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBTCTimestampingRewardDistributed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestSubmitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BestSubmitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestReporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BestReporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOtherSubmissions", wireType)
+			}
+			m.NumOtherSubmissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOtherSubmissions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinsToSubmitters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoinsToSubmitters = append(m.CoinsToSubmitters, types.Coin{})
+			if err := m.CoinsToSubmitters[len(m.CoinsToSubmitters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinsToReporters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoinsToReporters = append(m.CoinsToReporters, types.Coin{})
+			if err := m.CoinsToReporters[len(m.CoinsToReporters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBLSSignerRewardDistributed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBLSSignerRewardDistributed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBLSSignerRewardDistributed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSigners", wireType)
+			}
+			m.NumSigners = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumSigners |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinsToSigners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoinsToSigners = append(m.CoinsToSigners, types.Coin{})
+			if err := m.CoinsToSigners[len(m.CoinsToSigners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRewardWithdrawn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRewardWithdrawn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRewardWithdrawn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTxFeeRefunded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTxFeeRefunded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTxFeeRefunded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
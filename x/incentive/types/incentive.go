@@ -44,6 +44,14 @@ func (rg *RewardGauge) Add(coins sdk.Coins) {
 	rg.Coins = rg.Coins.Add(coins...)
 }
 
+// ClawBack removes all withdrawable coins from the reward gauge and returns them
+// typically called when the stakeholder is slashed
+func (rg *RewardGauge) ClawBack() sdk.Coins {
+	clawedBackCoins := rg.GetWithdrawableCoins()
+	rg.Coins = rg.Coins.Sub(clawedBackCoins...)
+	return clawedBackCoins
+}
+
 func GetCoinsPortion(coinsInt sdk.Coins, portion math.LegacyDec) sdk.Coins {
 	// coins with decimal value
 	coins := sdk.NewDecCoinsFromCoins(coinsInt...)
@@ -63,10 +71,11 @@ const (
 	ReporterType
 	FinalityProviderType
 	BTCDelegationType
+	BLSSignerType
 )
 
 func GetAllStakeholderTypes() []StakeholderType {
-	return []StakeholderType{SubmitterType, ReporterType, FinalityProviderType, BTCDelegationType}
+	return []StakeholderType{SubmitterType, ReporterType, FinalityProviderType, BTCDelegationType, BLSSignerType}
 }
 
 func NewStakeHolderType(stBytes []byte) (StakeholderType, error) {
@@ -81,6 +90,8 @@ func NewStakeHolderType(stBytes []byte) (StakeholderType, error) {
 		return FinalityProviderType, nil
 	} else if stBytes[0] == byte(BTCDelegationType) {
 		return BTCDelegationType, nil
+	} else if stBytes[0] == byte(BLSSignerType) {
+		return BLSSignerType, nil
 	} else {
 		return SubmitterType, fmt.Errorf("invalid stBytes")
 	}
@@ -95,6 +106,8 @@ func NewStakeHolderTypeFromString(stStr string) (StakeholderType, error) {
 		return FinalityProviderType, nil
 	} else if stStr == "btc_delegation" {
 		return BTCDelegationType, nil
+	} else if stStr == "bls_signer" {
+		return BLSSignerType, nil
 	} else {
 		return SubmitterType, fmt.Errorf("invalid stStr")
 	}
@@ -113,6 +126,8 @@ func (st StakeholderType) String() string {
 		return "finality_provider"
 	} else if st == BTCDelegationType {
 		return "btc_delegation"
+	} else if st == BLSSignerType {
+		return "bls_signer"
 	}
 	panic("invalid stakeholder type")
 }
@@ -12,6 +12,9 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_incentive"
+
+	// TStoreKey defines the transient store key
+	TStoreKey = "transient_incentive"
 )
 
 var (
@@ -19,4 +22,8 @@ var (
 	BTCStakingGaugeKey      = []byte{0x02} // key prefix for BTC staking gauge at each height
 	BTCTimestampingGaugeKey = []byte{0x03} // key prefix for BTC timestamping gauge at each height
 	RewardGaugeKey          = []byte{0x04} // key prefix for reward gauge for a given stakeholder in a given type
+	SlashedFPKey            = []byte{0x05} // key prefix for the infraction height of each slashed finality provider
+	RefundableMsgKeySetKey  = []byte{0x06} // key prefix for the set of hashes of refundable messages in the transient store
+	RefundedTxsKey          = []byte{0x07} // key for the number of refunded txs in the current block in the transient store
+	BLSSignerGaugeKey       = []byte{0x08} // key prefix for BLS signer gauge at each epoch
 )
@@ -21,6 +21,13 @@ func DefaultParams() Params {
 		SubmitterPortion:  math.LegacyNewDecWithPrec(5, 2), // 5 * 10^{-2} = 0.05
 		ReporterPortion:   math.LegacyNewDecWithPrec(5, 2), // 5 * 10^{-2} = 0.05
 		BtcStakingPortion: math.LegacyNewDecWithPrec(2, 1), // 2 * 10^{-1} = 0.2
+		// rewards of slashed finality providers go to the community pool
+		BurnSlashedRewards: false,
+		// best submission gets 80% of the submitter/reporter rewards
+		BestSubmissionPortion: math.LegacyNewDecWithPrec(8, 1), // 8 * 10^{-1} = 0.8
+		// fees of at most 100 refundable txs are refunded in a block
+		MaxRefundedTxsPerBlock: 100,
+		BlsSignerPortion:       math.LegacyNewDecWithPrec(5, 2), // 5 * 10^{-2} = 0.05
 	}
 }
 
@@ -34,6 +41,7 @@ func (p *Params) TotalPortion() math.LegacyDec {
 	sum := p.SubmitterPortion
 	sum = sum.Add(p.ReporterPortion)
 	sum = sum.Add(p.BtcStakingPortion)
+	sum = sum.Add(p.BlsSignerPortion)
 	return sum
 }
 
@@ -49,6 +57,12 @@ func (p *Params) BTCStakingPortion() math.LegacyDec {
 	return p.BtcStakingPortion
 }
 
+// BLSSignerPortion returns the portion of the validators who contributed their
+// BLS sigs to checkpoints
+func (p *Params) BLSSignerPortion() math.LegacyDec {
+	return p.BlsSignerPortion
+}
+
 // Validate validates the set of params
 func (p Params) Validate() error {
 	if p.SubmitterPortion.IsNil() {
@@ -60,6 +74,15 @@ func (p Params) Validate() error {
 	if p.BtcStakingPortion.IsNil() {
 		return fmt.Errorf("BtcStakingPortion should not be nil")
 	}
+	if p.BlsSignerPortion.IsNil() {
+		return fmt.Errorf("BlsSignerPortion should not be nil")
+	}
+	if p.BestSubmissionPortion.IsNil() {
+		return fmt.Errorf("BestSubmissionPortion should not be nil")
+	}
+	if p.BestSubmissionPortion.IsNegative() || p.BestSubmissionPortion.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("BestSubmissionPortion should be in range [0, 1]")
+	}
 
 	// sum of all portions should be less than 1
 	if p.TotalPortion().GTE(math.LegacyOneDec()) {
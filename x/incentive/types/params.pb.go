@@ -38,6 +38,29 @@ type Params struct {
 	// NOTE: the portion of each Finality Provider/delegation is calculated by using its voting
 	// power and finality provider's commission
 	BtcStakingPortion cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=btc_staking_portion,json=btcStakingPortion,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"btc_staking_portion"`
+	// burn_slashed_rewards decides what happens to the rewards of slashed
+	// finality providers, including their unclaimed commission and the rewards
+	// of blocks at or after the infraction height that are distributed after
+	// the slashing. If true, such rewards are burned. Otherwise, they are sent
+	// to the community pool. The rewards of blocks at or after the infraction
+	// height that are distributed to BTC delegations before the slashing are
+	// kept by the BTC delegations.
+	BurnSlashedRewards bool `protobuf:"varint,4,opt,name=burn_slashed_rewards,json=burnSlashedRewards,proto3" json:"burn_slashed_rewards,omitempty"`
+	// best_submission_portion is the portion of the submitter/reporter rewards
+	// of a finalized epoch that goes to the submitter/reporter of the best
+	// checkpoint submission. The rest is split evenly among the submitters/reporters
+	// of the other submissions, if any.
+	BestSubmissionPortion cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=best_submission_portion,json=bestSubmissionPortion,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"best_submission_portion"`
+	// max_refunded_txs_per_block is the maximum number of txs whose fees are
+	// refunded in a block, where a tx is refundable if all of its messages are
+	// accepted protocol contributions, e.g., new covenant signatures. Refunds
+	// are disabled if it is 0.
+	MaxRefundedTxsPerBlock uint32 `protobuf:"varint,6,opt,name=max_refunded_txs_per_block,json=maxRefundedTxsPerBlock,proto3" json:"max_refunded_txs_per_block,omitempty"`
+	// bls_signer_portion is the portion of rewards that goes to the validators
+	// who contributed their BLS sigs to the checkpoint of an epoch
+	// NOTE: the portion of each validator is calculated by using its reward
+	// credit, which decreases with the number of epochs its BLS sig is late
+	BlsSignerPortion cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=bls_signer_portion,json=blsSignerPortion,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bls_signer_portion"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -72,6 +95,20 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetBurnSlashedRewards() bool {
+	if m != nil {
+		return m.BurnSlashedRewards
+	}
+	return false
+}
+
+func (m *Params) GetMaxRefundedTxsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxRefundedTxsPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.incentive.Params")
 }
@@ -79,26 +116,34 @@ func init() {
 func init() { proto.RegisterFile("babylon/incentive/params.proto", fileDescriptor_c42276168f0adf4b) }
 
 var fileDescriptor_c42276168f0adf4b = []byte{
-	// 294 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0xcf, 0xcc, 0x4b, 0x4e, 0xcd, 0x2b, 0xc9, 0x2c, 0x4b, 0xd5, 0x2f, 0x48,
-	0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0xca, 0xeb, 0xc1,
-	0xe5, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xb2, 0xfa, 0x20, 0x16, 0x44, 0xa1, 0x94, 0x64,
-	0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x71, 0x3c, 0x44, 0x02, 0xc2, 0x81, 0x48, 0x29, 0xad, 0x67, 0xe2,
-	0x62, 0x0b, 0x00, 0x1b, 0x2a, 0x14, 0xc7, 0x25, 0x58, 0x5c, 0x9a, 0x94, 0x9b, 0x59, 0x52, 0x92,
-	0x5a, 0x14, 0x5f, 0x90, 0x5f, 0x54, 0x92, 0x99, 0x9f, 0x27, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9,
-	0x64, 0x78, 0xe2, 0x9e, 0x3c, 0xc3, 0xad, 0x7b, 0xf2, 0xd2, 0x10, 0xbd, 0xc5, 0x29, 0xd9, 0x7a,
-	0x99, 0xf9, 0xfa, 0xb9, 0x89, 0x25, 0x19, 0x7a, 0x3e, 0xa9, 0xe9, 0x89, 0xc9, 0x95, 0x2e, 0xa9,
-	0xc9, 0x97, 0xb6, 0xe8, 0x72, 0x41, 0x8d, 0x76, 0x49, 0x4d, 0x0e, 0x12, 0x80, 0x9b, 0x15, 0x00,
-	0x31, 0x4a, 0x28, 0x86, 0x4b, 0xa0, 0x28, 0x15, 0x64, 0x2e, 0x92, 0xf1, 0x4c, 0xe4, 0x1a, 0xcf,
-	0x0f, 0x33, 0x0a, 0x66, 0x7a, 0x22, 0x97, 0x70, 0x52, 0x49, 0x72, 0x7c, 0x71, 0x49, 0x62, 0x76,
-	0x66, 0x5e, 0x3a, 0xdc, 0x02, 0x66, 0x72, 0x2d, 0x10, 0x4c, 0x2a, 0x49, 0x0e, 0x86, 0x18, 0x06,
-	0xb5, 0xc2, 0x8a, 0x65, 0xc6, 0x02, 0x79, 0x06, 0x27, 0xef, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c,
-	0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e,
-	0x3c, 0x96, 0x63, 0x88, 0x32, 0x4c, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5,
-	0x87, 0x46, 0x4d, 0x72, 0x46, 0x62, 0x66, 0x1e, 0x8c, 0xa3, 0x5f, 0x81, 0x14, 0x93, 0x25, 0x95,
-	0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x58, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x55, 0x13,
-	0x48, 0x09, 0xeb, 0x01, 0x00, 0x00,
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xb1, 0x8e, 0xd3, 0x40,
+	0x10, 0x86, 0x6d, 0x08, 0x01, 0x56, 0x42, 0x5c, 0xcc, 0x01, 0x21, 0x48, 0x4e, 0x44, 0x95, 0x06,
+	0x9b, 0x13, 0xdd, 0x95, 0xd1, 0x75, 0x50, 0x44, 0x0e, 0x15, 0x42, 0xac, 0x76, 0xd7, 0x8b, 0xbd,
+	0x8a, 0xbd, 0x6b, 0xed, 0xac, 0xc1, 0x79, 0x0b, 0x4a, 0x4a, 0x1e, 0x82, 0x87, 0xb8, 0xf2, 0x44,
+	0x85, 0x28, 0x22, 0x94, 0x54, 0xbc, 0xc5, 0xc9, 0x5e, 0xdb, 0x4a, 0xed, 0xce, 0xa3, 0x6f, 0xfc,
+	0xcd, 0x2f, 0xcf, 0x18, 0xf9, 0x94, 0xd0, 0x5d, 0xa6, 0x64, 0x28, 0x24, 0xe3, 0xd2, 0x88, 0xaf,
+	0x3c, 0x2c, 0x88, 0x26, 0x39, 0x04, 0x85, 0x56, 0x46, 0x79, 0x93, 0x96, 0x07, 0x3d, 0x9f, 0x9d,
+	0x27, 0x2a, 0x51, 0x0d, 0x0d, 0xeb, 0x27, 0xdb, 0x38, 0x7b, 0xc1, 0x14, 0xe4, 0x0a, 0xb0, 0x05,
+	0xb6, 0xb0, 0xe8, 0xd5, 0xff, 0x11, 0x1a, 0xaf, 0x1b, 0xa9, 0xf7, 0x19, 0x4d, 0xa0, 0xa4, 0xb9,
+	0x30, 0x86, 0x6b, 0x5c, 0x28, 0x6d, 0x84, 0x92, 0x53, 0x77, 0xe1, 0x2e, 0x1f, 0xae, 0x2e, 0xae,
+	0xf7, 0x73, 0xe7, 0xef, 0x7e, 0xfe, 0xd2, 0xbe, 0x0b, 0xf1, 0x36, 0x10, 0x2a, 0xcc, 0x89, 0x49,
+	0x83, 0xf7, 0x3c, 0x21, 0x6c, 0x77, 0xc5, 0xd9, 0xef, 0x5f, 0xaf, 0x51, 0xab, 0xbe, 0xe2, 0x2c,
+	0x3a, 0xeb, 0x5d, 0x6b, 0xab, 0xf2, 0x3e, 0xa1, 0x33, 0xcd, 0x6b, 0xef, 0x89, 0xfe, 0xce, 0x50,
+	0xfd, 0xe3, 0x4e, 0xd5, 0xd9, 0x09, 0x7a, 0x42, 0x0d, 0xc3, 0x60, 0xc8, 0x56, 0xc8, 0xa4, 0x1f,
+	0x70, 0x77, 0xe8, 0x80, 0x09, 0x35, 0x6c, 0x63, 0x65, 0xdd, 0x88, 0x37, 0xe8, 0x9c, 0x96, 0x5a,
+	0x62, 0xc8, 0x08, 0xa4, 0x3c, 0xc6, 0x9a, 0x7f, 0x23, 0x3a, 0x86, 0xe9, 0x68, 0xe1, 0x2e, 0x1f,
+	0x44, 0x5e, 0xcd, 0x36, 0x16, 0x45, 0x96, 0x78, 0x02, 0x3d, 0xa7, 0x1c, 0x0c, 0x6e, 0xbe, 0x05,
+	0x80, 0x50, 0xb2, 0x0f, 0x76, 0x6f, 0x68, 0xb0, 0xa7, 0xb5, 0x71, 0xd3, 0x0b, 0xbb, 0x70, 0x97,
+	0x68, 0x96, 0x93, 0x0a, 0x6b, 0xfe, 0xa5, 0x94, 0x31, 0x8f, 0xb1, 0xa9, 0x00, 0x17, 0x5c, 0x63,
+	0x9a, 0x29, 0xb6, 0x9d, 0x8e, 0x17, 0xee, 0xf2, 0x51, 0xf4, 0x2c, 0x27, 0x55, 0xd4, 0x36, 0x7c,
+	0xa8, 0x60, 0xcd, 0xf5, 0xaa, 0xa6, 0x1e, 0x46, 0x1e, 0xcd, 0x00, 0x83, 0x48, 0xe4, 0xc9, 0x6e,
+	0xee, 0x0f, 0x5e, 0x3d, 0xcd, 0x60, 0xd3, 0xb8, 0xda, 0x70, 0x97, 0xa3, 0x1f, 0x3f, 0xe7, 0xce,
+	0xea, 0xdd, 0xf5, 0xc1, 0x77, 0x6f, 0x0e, 0xbe, 0xfb, 0xef, 0xe0, 0xbb, 0xdf, 0x8f, 0xbe, 0x73,
+	0x73, 0xf4, 0x9d, 0x3f, 0x47, 0xdf, 0xf9, 0x78, 0x91, 0x08, 0x93, 0x96, 0x34, 0x60, 0x2a, 0x0f,
+	0xdb, 0xa3, 0x66, 0x29, 0x11, 0xb2, 0x2b, 0xc2, 0xea, 0xe4, 0x1f, 0x30, 0xbb, 0x82, 0x03, 0x1d,
+	0x37, 0xf7, 0xfb, 0xf6, 0x36, 0x00, 0x00, 0xff, 0xff, 0xd3, 0xbc, 0xbe, 0x83, 0x25, 0x03, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -121,6 +166,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BlsSignerPortion.Size()
+		i -= size
+		if _, err := m.BlsSignerPortion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.MaxRefundedTxsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRefundedTxsPerBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.BestSubmissionPortion.Size()
+		i -= size
+		if _, err := m.BestSubmissionPortion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.BurnSlashedRewards {
+		i--
+		if m.BurnSlashedRewards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.BtcStakingPortion.Size()
 		i -= size
@@ -177,6 +257,16 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.BtcStakingPortion.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.BurnSlashedRewards {
+		n += 2
+	}
+	l = m.BestSubmissionPortion.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxRefundedTxsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxRefundedTxsPerBlock))
+	}
+	l = m.BlsSignerPortion.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -317,6 +407,113 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnSlashedRewards", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnSlashedRewards = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestSubmissionPortion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BestSubmissionPortion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRefundedTxsPerBlock", wireType)
+			}
+			m.MaxRefundedTxsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRefundedTxsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsSignerPortion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlsSignerPortion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// HashMsg returns the hash of the given message, which identifies the message
+// in the set of refundable messages
+func HashMsg(msg sdk.Msg) []byte {
+	msgBytes, err := proto.Marshal(msg)
+	if err != nil {
+		// a message that has passed the tx decoder can always be marshaled
+		panic(err)
+	}
+	return tmhash.Sum(msgBytes)
+}
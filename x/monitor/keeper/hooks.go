@@ -43,6 +43,10 @@ func (h Hooks) AfterRawCheckpointFinalized(ctx context.Context, epoch uint64) er
 	return nil
 }
 
+func (h Hooks) AfterRawCheckpointUnfinalized(ctx context.Context, epoch uint64) error {
+	return nil
+}
+
 func (h Hooks) AfterRawCheckpointBlsSigVerified(ctx context.Context, ckpt *checkpointingtypes.RawCheckpoint) error {
 	return h.k.updateBtcLightClientHeightForCheckpoint(ctx, ckpt)
 }
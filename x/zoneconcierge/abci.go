@@ -19,5 +19,7 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 
 func EndBlocker(ctx context.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+	// re-send BTC timestamps that failed to be delivered to consumer chains
+	k.RetryBTCTimestamps(ctx)
 	return []abci.ValidatorUpdate{}, nil
 }
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -26,6 +27,10 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdChainsInfo())
 	cmd.AddCommand(CmdFinalizedChainsInfo())
 	cmd.AddCommand(CmdEpochChainsInfoInfo())
+	cmd.AddCommand(CmdFinalizedHeaderBundle())
+	cmd.AddCommand(CmdConsumers())
+	cmd.AddCommand(CmdConsumer())
+	cmd.AddCommand(CmdExportEpochChainInfo())
 	return cmd
 }
 
@@ -103,3 +108,156 @@ func CmdEpochChainsInfoInfo() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdFinalizedHeaderBundle() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalized-header-bundle <chain-id> <height>",
+		Short: "retrieve the header of a chain at a given height along with the proofs that it is BTC-finalized",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			req := types.QueryFinalizedHeaderBundleRequest{ChainId: args[0], Height: height}
+			resp, err := queryClient.FinalizedHeaderBundle(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdConsumers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumers",
+		Short: "retrieve all consumer chains in the consumer registry",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := types.QueryConsumersRequest{Pagination: pageReq}
+			resp, err := queryClient.Consumers(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "consumers")
+	return cmd
+}
+
+func CmdConsumer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer <consumer-id>",
+		Short: "retrieve the registration of a consumer chain in the consumer registry",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			req := types.QueryConsumerRequest{ConsumerId: args[0]}
+			resp, err := queryClient.Consumer(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+const flagOutFile = "out-file"
+
+func CmdExportEpochChainInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-epoch-chain-info <chain-id> <from-epoch> <to-epoch>",
+		Short: "export the snapshots of a chain's info over a range of finalized epochs into a verifiable archive",
+		Long: `Export the snapshots of a chain's info at the end of each epoch within the given range into a JSON archive.
+For each epoch that timestamps a header of the chain, the archive includes the bundle proving that the header is
+BTC-finalized. The snapshots have to be exported before they are pruned, and all epochs in the range have to be
+finalized.`,
+		Example: `babylond query zoneconcierge export-epoch-chain-info my-chain 1 100 --out-file my-chain-epochs.json`,
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID := args[0]
+			fromEpoch, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			toEpoch, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			if fromEpoch > toEpoch {
+				return fmt.Errorf("from-epoch %d is higher than to-epoch %d", fromEpoch, toEpoch)
+			}
+			outFile, _ := cmd.Flags().GetString(flagOutFile)
+
+			archive := &types.ChainInfoArchive{ChainId: chainID}
+			for epoch := fromEpoch; epoch <= toEpoch; epoch++ {
+				req := &types.QueryEpochChainsInfoRequest{EpochNum: epoch, ChainIds: []string{chainID}}
+				resp, err := queryClient.EpochChainsInfo(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+				chainInfo := resp.ChainsInfo[0]
+				if chainInfo.LatestHeader == nil {
+					// the chain info is not known in this epoch yet, or pruned
+					return fmt.Errorf("no snapshot of the chain info of %s in epoch %d", chainID, epoch)
+				}
+
+				archived := &types.ArchivedEpochChainInfo{EpochNum: epoch, ChainInfo: chainInfo}
+				// prove the latest header if it is timestamped in this epoch
+				if chainInfo.LatestHeader.BabylonEpoch == epoch {
+					bundleReq := &types.QueryFinalizedHeaderBundleRequest{ChainId: chainID, Height: chainInfo.LatestHeader.Height}
+					bundleResp, err := queryClient.FinalizedHeaderBundle(cmd.Context(), bundleReq)
+					if err != nil {
+						return err
+					}
+					archived.Bundle = bundleResp.Bundle
+				}
+				archive.EpochChainInfos = append(archive.EpochChainInfos, archived)
+			}
+			if err := archive.ValidateBasic(); err != nil {
+				return err
+			}
+
+			bz, err := clientCtx.Codec.MarshalJSON(archive)
+			if err != nil {
+				return err
+			}
+			return os.WriteFile(outFile, bz, 0o644)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(flagOutFile, "epoch_chain_info.json", "The path of the exported archive")
+
+	return cmd
+}
@@ -15,6 +15,10 @@ func InitGenesis(ctx context.Context, k keeper.Keeper, genState types.GenesisSta
 		panic(err)
 	}
 
+	for _, consumer := range genState.Consumers {
+		k.SetConsumer(ctx, consumer)
+	}
+
 	k.SetPort(ctx, genState.PortId)
 	// Only try to bind to port if it is not already bound, since we may already own
 	// port capability from capability InitGenesis
@@ -33,5 +37,6 @@ func ExportGenesis(ctx context.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 	genesis.PortId = k.GetPort(ctx)
+	genesis.Consumers = k.GetAllConsumers(ctx)
 	return genesis
 }
@@ -13,10 +13,14 @@ import (
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		PortId: types.PortID,
-		Params: types.Params{IbcPacketTimeoutSeconds: 100},
+		Params: types.Params{IbcPacketTimeoutSeconds: 100, PermissionedChannels: true},
+		Consumers: []*types.ConsumerRegister{
+			{ConsumerId: "consumer-1", Name: "consumer 1", MaxHeadersPerBlock: 2},
+			{ConsumerId: "consumer-2", Name: "consumer 2", Description: "the second consumer chain"},
+		},
 	}
 
-	k, ctx := keepertest.ZoneConciergeKeeper(t, nil, nil, nil, nil)
+	k, ctx := keepertest.ZoneConciergeKeeper(t, nil, nil, nil, nil, nil)
 	zoneconcierge.InitGenesis(ctx, *k, genesisState)
 	got := zoneconcierge.ExportGenesis(ctx, *k)
 	require.NotNil(t, got)
@@ -26,4 +30,5 @@ func TestGenesis(t *testing.T) {
 
 	require.Equal(t, genesisState.PortId, got.PortId)
 	require.Equal(t, genesisState.Params, got.Params)
+	require.Equal(t, genesisState.Consumers, got.Consumers)
 }
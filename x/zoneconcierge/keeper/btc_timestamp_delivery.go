@@ -0,0 +1,263 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/babylonchain/babylon/x/zoneconcierge/types"
+)
+
+// SetInFlightBTCTimestamp records the delivery of the BTC timestamp carried by
+// the IBC packet with the given sequence, until the packet is acknowledged or
+// times out
+func (k Keeper) SetInFlightBTCTimestamp(ctx context.Context, sequence uint64, delivery *types.BTCTimestampDelivery) {
+	store := k.inFlightBTCTimestampStore(ctx, delivery.ChannelId)
+	store.Set(sdk.Uint64ToBigEndian(sequence), k.cdc.MustMarshal(delivery))
+}
+
+// GetInFlightBTCTimestamp returns the delivery of the BTC timestamp carried by
+// the IBC packet with the given sequence under the given channel, or nil if
+// the packet does not carry a BTC timestamp or is not in flight
+func (k Keeper) GetInFlightBTCTimestamp(ctx context.Context, channelID string, sequence uint64) *types.BTCTimestampDelivery {
+	store := k.inFlightBTCTimestampStore(ctx, channelID)
+	deliveryBytes := store.Get(sdk.Uint64ToBigEndian(sequence))
+	if len(deliveryBytes) == 0 {
+		return nil
+	}
+	var delivery types.BTCTimestampDelivery
+	k.cdc.MustUnmarshal(deliveryBytes, &delivery)
+	return &delivery
+}
+
+// GetBTCTimestampRetries returns all BTC timestamps queued for retry, ordered
+// by channel ID and epoch number
+func (k Keeper) GetBTCTimestampRetries(ctx context.Context) []*types.BTCTimestampDelivery {
+	retries := []*types.BTCTimestampDelivery{}
+	iter := k.btcTimestampRetryStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var delivery types.BTCTimestampDelivery
+		k.cdc.MustUnmarshal(iter.Value(), &delivery)
+		retries = append(retries, &delivery)
+	}
+	return retries
+}
+
+// OnBTCTimestampAcknowledged handles the acknowledgement of the IBC packet with
+// the given sequence under the given channel. If the consumer chain has
+// rejected the BTC timestamp in the packet, i.e., ackErr is not empty, the BTC
+// timestamp is queued for retry.
+func (k Keeper) OnBTCTimestampAcknowledged(ctx context.Context, channelID string, sequence uint64, ackErr string) {
+	delivery := k.GetInFlightBTCTimestamp(ctx, channelID, sequence)
+	if delivery == nil {
+		// the packet was sent before its delivery was tracked
+		return
+	}
+	k.inFlightBTCTimestampStore(ctx, channelID).Delete(sdk.Uint64ToBigEndian(sequence))
+
+	if len(ackErr) == 0 {
+		return
+	}
+	k.Logger(sdk.UnwrapSDKContext(ctx)).Error("the consumer chain rejected the BTC timestamp",
+		"channelID", channelID, "epoch", delivery.EpochNum, "error", ackErr)
+	k.onBTCTimestampDeliveryFailed(ctx, delivery)
+}
+
+// OnBTCTimestampTimeout handles the timeout of the IBC packet with the given
+// sequence under the given channel. As Zone Concierge channels are ordered, IBC
+// closes the channel upon the timeout, so that neither the BTC timestamp in the
+// packet nor any other BTC timestamp under the channel can be delivered via
+// the channel anymore. All of them are thus dropped.
+func (k Keeper) OnBTCTimestampTimeout(ctx context.Context, channelID string, sequence uint64) {
+	k.Logger(sdk.UnwrapSDKContext(ctx)).Error("the IBC packet of the BTC timestamp timed out",
+		"channelID", channelID, "sequence", sequence)
+	k.OnBTCTimestampChannelClosed(ctx, channelID)
+}
+
+// OnBTCTimestampChannelClosed drops all BTC timestamps in flight or queued for
+// retry under the given channel, which is closed
+func (k Keeper) OnBTCTimestampChannelClosed(ctx context.Context, channelID string) {
+	inFlightStore := k.inFlightBTCTimestampStore(ctx, channelID)
+	var (
+		keys       [][]byte
+		deliveries []*types.BTCTimestampDelivery
+	)
+	iter := inFlightStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		var delivery types.BTCTimestampDelivery
+		k.cdc.MustUnmarshal(iter.Value(), &delivery)
+		keys = append(keys, iter.Key())
+		deliveries = append(deliveries, &delivery)
+	}
+	iter.Close()
+	for i, delivery := range deliveries {
+		inFlightStore.Delete(keys[i])
+		k.dropBTCTimestamp(ctx, delivery, "the channel is closed")
+	}
+
+	for _, delivery := range k.GetBTCTimestampRetries(ctx) {
+		if delivery.ChannelId != channelID {
+			continue
+		}
+		k.btcTimestampRetryStore(ctx).Delete(btcTimestampRetryKey(delivery))
+		k.dropBTCTimestamp(ctx, delivery, "the channel is closed")
+	}
+}
+
+// RetryBTCTimestamps re-sends all BTC timestamps queued for retry. It is
+// triggered upon EndBlocker.
+// A re-sent BTC timestamp carries the last w+1 BTC headers rather than the
+// BTC headers broadcast upon the epoch being finalised, so that it extends the
+// BTC light client of the consumer chain regardless of the BTC headers the
+// consumer chain has missed.
+func (k Keeper) RetryBTCTimestamps(ctx context.Context) {
+	retries := k.GetBTCTimestampRetries(ctx)
+	if len(retries) == 0 {
+		return
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	zcPort := k.GetPort(ctx)
+	// metadata shared across BTC timestamps in the same epoch
+	finalizedInfos := map[uint64]*finalizedInfo{}
+	for _, delivery := range retries {
+		k.btcTimestampRetryStore(ctx).Delete(btcTimestampRetryKey(delivery))
+
+		// the channel might have been closed since the BTC timestamp was
+		// queued
+		channel, found := k.channelKeeper.GetChannel(sdkCtx, zcPort, delivery.ChannelId)
+		if !found || channel.State != channeltypes.OPEN {
+			k.dropBTCTimestamp(ctx, delivery, "the channel is not open")
+			continue
+		}
+		identifiedChannel := channeltypes.NewIdentifiedChannel(zcPort, delivery.ChannelId, channel)
+
+		info, ok := finalizedInfos[delivery.EpochNum]
+		if !ok {
+			var err error
+			info, err = k.getFinalizedInfo(ctx, delivery.EpochNum, k.getDeepEnoughBTCHeaders(ctx))
+			if err != nil {
+				k.dropBTCTimestamp(ctx, delivery, fmt.Sprintf("failed to generate metadata of the epoch: %v", err))
+				continue
+			}
+			finalizedInfos[delivery.EpochNum] = info
+		}
+
+		if err := k.sendBTCTimestamp(ctx, identifiedChannel, info, delivery.Attempts); err != nil {
+			k.Logger(sdkCtx).Error("failed to re-send BTC timestamp", "channelID", delivery.ChannelId, "epoch", delivery.EpochNum, "error", err)
+		}
+	}
+}
+
+// dropBTCTimestampRetries drops all BTC timestamps queued for retry whose
+// epochs are no earlier than the given epoch, which is no longer finalised
+// upon a BTC reorg. The BTC timestamps will be broadcast again once the
+// epochs become finalised again.
+func (k Keeper) dropBTCTimestampRetries(ctx context.Context, epoch uint64) {
+	for _, delivery := range k.GetBTCTimestampRetries(ctx) {
+		if delivery.EpochNum < epoch {
+			continue
+		}
+		k.btcTimestampRetryStore(ctx).Delete(btcTimestampRetryKey(delivery))
+		k.dropBTCTimestamp(ctx, delivery, "the epoch is no longer finalised")
+	}
+}
+
+// sendBTCTimestamp constructs the BTC timestamp of the finalised epoch for the
+// given channel and sends it in an IBC packet. The delivery of the BTC
+// timestamp is tracked until the packet is acknowledged or times out. If the
+// packet cannot be sent, the BTC timestamp is queued for retry.
+func (k Keeper) sendBTCTimestamp(
+	ctx context.Context,
+	channel channeltypes.IdentifiedChannel,
+	finalizedInfo *finalizedInfo,
+	attempts uint32,
+) error {
+	// get the ID of the chain under this channel
+	chainID, err := k.getChainID(ctx, channel)
+	if err != nil {
+		return fmt.Errorf("failed to get chain ID: %w", err)
+	}
+
+	// generate timestamp for this channel
+	btcTimestamp, err := k.createBTCTimestamp(ctx, chainID, channel, finalizedInfo)
+	if err != nil {
+		return fmt.Errorf("failed to generate BTC timestamp for chain %s: %w", chainID, err)
+	}
+
+	// wrap BTC timestamp to IBC packet and send it
+	delivery := &types.BTCTimestampDelivery{
+		ChannelId: channel.ChannelId,
+		EpochNum:  finalizedInfo.EpochInfo.EpochNumber,
+		Attempts:  attempts,
+	}
+	seq, err := k.SendIBCPacket(ctx, channel, types.NewBTCTimestampPacketData(btcTimestamp))
+	if err != nil {
+		k.onBTCTimestampDeliveryFailed(ctx, delivery)
+		return fmt.Errorf("failed to send BTC timestamp IBC packet to chain %s: %w", chainID, err)
+	}
+	k.SetInFlightBTCTimestamp(ctx, seq, delivery)
+
+	return nil
+}
+
+// onBTCTimestampDeliveryFailed queues the given BTC timestamp for retry, or
+// drops it if it has been retried for the maximum number of times. Either way,
+// it replaces the BTC timestamp of the same channel and epoch queued earlier.
+func (k Keeper) onBTCTimestampDeliveryFailed(ctx context.Context, delivery *types.BTCTimestampDelivery) {
+	delivery.Attempts++
+	if delivery.Attempts > k.GetParams(ctx).MaxBtcTimestampRetries {
+		k.btcTimestampRetryStore(ctx).Delete(btcTimestampRetryKey(delivery))
+		k.dropBTCTimestamp(ctx, delivery, "the maximum number of retries is reached")
+		return
+	}
+	k.btcTimestampRetryStore(ctx).Set(btcTimestampRetryKey(delivery), k.cdc.MustMarshal(delivery))
+}
+
+// dropBTCTimestamp gives up delivering the given BTC timestamp, and emits an
+// event so that operators of the consumer chain can act on it
+func (k Keeper) dropBTCTimestamp(ctx context.Context, delivery *types.BTCTimestampDelivery, reason string) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	k.Logger(sdkCtx).Error("dropped BTC timestamp", "channelID", delivery.ChannelId, "epoch", delivery.EpochNum, "attempts", delivery.Attempts, "reason", reason)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBTCTimestampDropped,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChannelID, delivery.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyEpochNum, fmt.Sprintf("%d", delivery.EpochNum)),
+			sdk.NewAttribute(types.AttributeKeyAttempts, fmt.Sprintf("%d", delivery.Attempts)),
+		),
+	)
+}
+
+// btcTimestampRetryKey returns the key of the given BTC timestamp in the
+// retry queue
+func btcTimestampRetryKey(delivery *types.BTCTimestampDelivery) []byte {
+	return append(types.ChannelIDKey(delivery.ChannelId), sdk.Uint64ToBigEndian(delivery.EpochNum)...)
+}
+
+// inFlightBTCTimestampStore returns the KVStore of the BTC timestamps in IBC
+// packets under the given channel that are not acknowledged yet
+// prefix: InFlightBTCTimestampKey
+// key: (channel ID || packet sequence)
+// value: BTCTimestampDelivery
+func (k Keeper) inFlightBTCTimestampStore(ctx context.Context, channelID string) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	inFlightStore := prefix.NewStore(storeAdapter, types.InFlightBTCTimestampKey)
+	return prefix.NewStore(inFlightStore, types.ChannelIDKey(channelID))
+}
+
+// btcTimestampRetryStore returns the KVStore of the BTC timestamps queued for
+// retry
+// prefix: BTCTimestampRetryKey
+// key: (channel ID || epoch number)
+// value: BTCTimestampDelivery
+func (k Keeper) btcTimestampRetryStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.BTCTimestampRetryKey)
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/app"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/babylonchain/babylon/x/zoneconcierge/types"
+)
+
+func FuzzBTCTimestampRetries(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		babylonApp := app.Setup(t, false)
+		zcKeeper := babylonApp.ZoneConciergeKeeper
+		ctx := babylonApp.NewContext(false)
+
+		maxRetries := zcKeeper.GetParams(ctx).MaxBtcTimestampRetries
+		channelID := "channel-" + datagen.GenRandomHexStr(r, 4)
+		epochNum := datagen.RandomInt(r, 100) + 1
+
+		// a successfully acknowledged BTC timestamp is no longer tracked
+		seq := datagen.RandomInt(r, 1000) + 1
+		zcKeeper.SetInFlightBTCTimestamp(ctx, seq, &types.BTCTimestampDelivery{ChannelId: channelID, EpochNum: epochNum})
+		require.NotNil(t, zcKeeper.GetInFlightBTCTimestamp(ctx, channelID, seq))
+		zcKeeper.OnBTCTimestampAcknowledged(ctx, channelID, seq, "")
+		require.Nil(t, zcKeeper.GetInFlightBTCTimestamp(ctx, channelID, seq))
+		require.Empty(t, zcKeeper.GetBTCTimestampRetries(ctx))
+
+		// acknowledgement of an untracked packet is a no-op
+		zcKeeper.OnBTCTimestampAcknowledged(ctx, channelID, seq+1, "rejected")
+		require.Empty(t, zcKeeper.GetBTCTimestampRetries(ctx))
+
+		// a BTC timestamp rejected by the consumer chain is queued for retry
+		// until reaching the maximum number of retries
+		for attempts := uint32(0); attempts <= maxRetries; attempts++ {
+			seq++
+			zcKeeper.SetInFlightBTCTimestamp(ctx, seq, &types.BTCTimestampDelivery{ChannelId: channelID, EpochNum: epochNum, Attempts: attempts})
+			zcKeeper.OnBTCTimestampAcknowledged(ctx, channelID, seq, "rejected")
+			require.Nil(t, zcKeeper.GetInFlightBTCTimestamp(ctx, channelID, seq))
+
+			retries := zcKeeper.GetBTCTimestampRetries(ctx)
+			if attempts+1 > maxRetries {
+				require.Empty(t, retries)
+			} else {
+				require.Len(t, retries, 1)
+				require.Equal(t, channelID, retries[0].ChannelId)
+				require.Equal(t, epochNum, retries[0].EpochNum)
+				require.Equal(t, attempts+1, retries[0].Attempts)
+			}
+		}
+
+		// retrying a BTC timestamp under a channel that is not open drops it
+		seq++
+		zcKeeper.SetInFlightBTCTimestamp(ctx, seq, &types.BTCTimestampDelivery{ChannelId: channelID, EpochNum: epochNum})
+		zcKeeper.OnBTCTimestampAcknowledged(ctx, channelID, seq, "rejected")
+		if maxRetries > 0 {
+			require.Len(t, zcKeeper.GetBTCTimestampRetries(ctx), 1)
+		}
+		zcKeeper.RetryBTCTimestamps(ctx)
+		require.Empty(t, zcKeeper.GetBTCTimestampRetries(ctx))
+
+		// a timeout closes the ordered channel, so that all BTC timestamps in
+		// flight or queued for retry under the channel are dropped, while
+		// those under other channels are kept
+		otherChannelID := channelID + "-other"
+		numInFlight := datagen.RandomInt(r, 5) + 2
+		for i := uint64(0); i < numInFlight; i++ {
+			zcKeeper.SetInFlightBTCTimestamp(ctx, seq+i, &types.BTCTimestampDelivery{ChannelId: channelID, EpochNum: epochNum + i})
+			zcKeeper.SetInFlightBTCTimestamp(ctx, seq+i, &types.BTCTimestampDelivery{ChannelId: otherChannelID, EpochNum: epochNum + i})
+		}
+		if maxRetries > 0 {
+			// queue the BTC timestamp of the last packet for retry
+			zcKeeper.OnBTCTimestampAcknowledged(ctx, channelID, seq+numInFlight-1, "rejected")
+			require.Len(t, zcKeeper.GetBTCTimestampRetries(ctx), 1)
+		}
+		zcKeeper.OnBTCTimestampTimeout(ctx, channelID, seq)
+		require.Empty(t, zcKeeper.GetBTCTimestampRetries(ctx))
+		for i := uint64(0); i < numInFlight; i++ {
+			require.Nil(t, zcKeeper.GetInFlightBTCTimestamp(ctx, channelID, seq+i))
+			require.NotNil(t, zcKeeper.GetInFlightBTCTimestamp(ctx, otherChannelID, seq+i))
+		}
+
+		// closing the other channel drops its BTC timestamps as well
+		zcKeeper.OnBTCTimestampChannelClosed(ctx, otherChannelID)
+		for i := uint64(0); i < numInFlight; i++ {
+			require.Nil(t, zcKeeper.GetInFlightBTCTimestamp(ctx, otherChannelID, seq+i))
+		}
+	})
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+
+	"github.com/babylonchain/babylon/x/zoneconcierge/types"
+)
+
+// SetConsumer adds the given consumer chain to the consumer registry
+func (k Keeper) SetConsumer(ctx context.Context, consumer *types.ConsumerRegister) {
+	store := k.consumerRegistryStore(ctx)
+	store.Set([]byte(consumer.ConsumerId), k.cdc.MustMarshal(consumer))
+}
+
+// HasConsumer checks if the consumer chain with the given chain ID is in the
+// consumer registry
+func (k Keeper) HasConsumer(ctx context.Context, consumerID string) bool {
+	store := k.consumerRegistryStore(ctx)
+	return store.Has([]byte(consumerID))
+}
+
+// GetConsumer returns the registration of the consumer chain with the given
+// chain ID
+func (k Keeper) GetConsumer(ctx context.Context, consumerID string) (*types.ConsumerRegister, error) {
+	store := k.consumerRegistryStore(ctx)
+	consumerBytes := store.Get([]byte(consumerID))
+	if len(consumerBytes) == 0 {
+		return nil, types.ErrConsumerNotRegistered.Wrapf("consumer ID: %s", consumerID)
+	}
+	var consumer types.ConsumerRegister
+	k.cdc.MustUnmarshal(consumerBytes, &consumer)
+	return &consumer, nil
+}
+
+// GetAllConsumers returns the registrations of all consumer chains in the
+// consumer registry
+func (k Keeper) GetAllConsumers(ctx context.Context) []*types.ConsumerRegister {
+	store := k.consumerRegistryStore(ctx)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	consumers := []*types.ConsumerRegister{}
+	for ; iter.Valid(); iter.Next() {
+		var consumer types.ConsumerRegister
+		k.cdc.MustUnmarshal(iter.Value(), &consumer)
+		consumers = append(consumers, &consumer)
+	}
+	return consumers
+}
+
+// DeleteConsumer removes the consumer chain with the given chain ID from the
+// consumer registry
+func (k Keeper) DeleteConsumer(ctx context.Context, consumerID string) {
+	k.consumerRegistryStore(ctx).Delete([]byte(consumerID))
+	k.consumerHeaderCountStore(ctx).Delete([]byte(consumerID))
+}
+
+// ValidateChannelConsumer checks that the chain at the other end of the given
+// connection hops is allowed to open an IBC channel with the zoneconcierge
+// module. When permissioned channels are enabled, only consumer chains in the
+// consumer registry are allowed.
+func (k Keeper) ValidateChannelConsumer(ctx context.Context, connectionHops []string) error {
+	if !k.GetParams(ctx).PermissionedChannels {
+		return nil
+	}
+	if len(connectionHops) != 1 {
+		return errorsmod.Wrapf(channeltypes.ErrTooManyConnectionHops, "expected 1, got %d", len(connectionHops))
+	}
+	chainID, err := k.getChainIDByConnection(ctx, connectionHops[0])
+	if err != nil {
+		return err
+	}
+	if !k.HasConsumer(ctx, chainID) {
+		return types.ErrConsumerNotRegistered.Wrapf("chain %s cannot open a channel under permissioned channels", chainID)
+	}
+	return nil
+}
+
+// getChainIDByConnection returns the ID of the chain at the other end of the
+// given IBC connection
+func (k Keeper) getChainIDByConnection(ctx context.Context, connectionID string) (string, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	connection, err := k.channelKeeper.GetConnection(sdkCtx, connectionID)
+	if err != nil {
+		return "", err
+	}
+	clientState, found := k.clientKeeper.GetClientState(sdkCtx, connection.GetClientID())
+	if !found {
+		return "", fmt.Errorf("client state of connection %s is not found", connectionID)
+	}
+	// TODO: support for chains other than Cosmos zones
+	cmtClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return "", fmt.Errorf("client must be a Comet client, expected: %T, got: %T", &ibctmtypes.ClientState{}, clientState)
+	}
+	return cmtClientState.ChainId, nil
+}
+
+// tryIndexHeader checks whether a header of the given consumer chain can be
+// indexed in the current block w.r.t. the maximum number of headers per block
+// in its registration, and counts the header if so. Headers of consumer chains
+// that are not in the consumer registry are not limited.
+func (k Keeper) tryIndexHeader(ctx context.Context, consumerID string) bool {
+	consumer, err := k.GetConsumer(ctx, consumerID)
+	if err != nil || consumer.MaxHeadersPerBlock == 0 {
+		return true
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	store := k.consumerHeaderCountStore(ctx)
+	numIndexed := uint64(0)
+	if countBytes := store.Get([]byte(consumerID)); len(countBytes) > 0 {
+		// the count is reset upon a new block
+		if sdk.BigEndianToUint64(countBytes[:8]) == height {
+			numIndexed = sdk.BigEndianToUint64(countBytes[8:])
+		}
+	}
+	if !consumer.AllowsHeaders(numIndexed) {
+		return false
+	}
+	store.Set([]byte(consumerID), append(sdk.Uint64ToBigEndian(height), sdk.Uint64ToBigEndian(numIndexed+1)...))
+	return true
+}
+
+// consumerRegistryStore stores the registrations of consumer chains
+// prefix: ConsumerRegisterKey
+// key: consumer ID
+// value: ConsumerRegister
+func (k Keeper) consumerRegistryStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.ConsumerRegisterKey)
+}
+
+// consumerHeaderCountStore stores the number of headers of each consumer chain
+// indexed in the last block it has headers indexed
+// prefix: ConsumerHeaderCountKey
+// key: consumer ID
+// value: (Babylon height || number of indexed headers)
+func (k Keeper) consumerHeaderCountStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.ConsumerHeaderCountKey)
+}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/core/header"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/app"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	zckeeper "github.com/babylonchain/babylon/x/zoneconcierge/keeper"
+	"github.com/babylonchain/babylon/x/zoneconcierge/types"
+)
+
+func FuzzConsumerRegistry(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		babylonApp := app.Setup(t, false)
+		zcKeeper := babylonApp.ZoneConciergeKeeper
+		ctx := babylonApp.NewContext(false)
+		msgServer := zckeeper.NewMsgServerImpl(zcKeeper)
+		authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+		consumer := types.ConsumerRegister{
+			ConsumerId:         datagen.GenRandomHexStr(r, 10),
+			Name:               datagen.GenRandomHexStr(r, 5),
+			Description:        datagen.GenRandomHexStr(r, 30),
+			MaxHeadersPerBlock: uint32(datagen.RandomInt(r, 5) + 1),
+		}
+
+		// only the governance account can register a consumer chain
+		_, err := msgServer.RegisterConsumer(ctx, &types.MsgRegisterConsumer{
+			Authority: datagen.GenRandomAccount().Address,
+			Consumer:  consumer,
+		})
+		require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+		_, err = msgServer.RegisterConsumer(ctx, &types.MsgRegisterConsumer{Authority: authority, Consumer: consumer})
+		require.NoError(t, err)
+		actualConsumer, err := zcKeeper.GetConsumer(ctx, consumer.ConsumerId)
+		require.NoError(t, err)
+		require.Equal(t, consumer, *actualConsumer)
+
+		// a consumer chain cannot be registered twice
+		_, err = msgServer.RegisterConsumer(ctx, &types.MsgRegisterConsumer{Authority: authority, Consumer: consumer})
+		require.ErrorIs(t, err, types.ErrConsumerExists)
+
+		// headers beyond the maximum number of headers per block are not indexed
+		numHeaders := uint64(consumer.MaxHeadersPerBlock) + datagen.RandomInt(r, 5) + 1
+		SimulateNewHeaders(ctx, r, &zcKeeper, consumer.ConsumerId, 0, numHeaders)
+		chainInfo, err := zcKeeper.GetChainInfo(ctx, consumer.ConsumerId)
+		require.NoError(t, err)
+		require.Equal(t, uint64(consumer.MaxHeadersPerBlock), chainInfo.TimestampedHeadersCount)
+
+		// the limit is reset upon a new block
+		ctx = ctx.WithHeaderInfo(header.Info{Height: ctx.HeaderInfo().Height + 1})
+		SimulateNewHeaders(ctx, r, &zcKeeper, consumer.ConsumerId, numHeaders, 1)
+		chainInfo, err = zcKeeper.GetChainInfo(ctx, consumer.ConsumerId)
+		require.NoError(t, err)
+		require.Equal(t, uint64(consumer.MaxHeadersPerBlock)+1, chainInfo.TimestampedHeadersCount)
+
+		// channel handshakes are not checked when permissioned channels are
+		// disabled, and are rejected for unknown connections otherwise
+		require.NoError(t, zcKeeper.ValidateChannelConsumer(ctx, []string{"connection-0"}))
+		params := zcKeeper.GetParams(ctx)
+		params.PermissionedChannels = true
+		require.NoError(t, zcKeeper.SetParams(ctx, params))
+		require.Error(t, zcKeeper.ValidateChannelConsumer(ctx, []string{"connection-0"}))
+
+		// deregister the consumer chain
+		_, err = msgServer.DeregisterConsumer(ctx, &types.MsgDeregisterConsumer{Authority: authority, ConsumerId: consumer.ConsumerId})
+		require.NoError(t, err)
+		require.False(t, zcKeeper.HasConsumer(ctx, consumer.ConsumerId))
+		_, err = msgServer.DeregisterConsumer(ctx, &types.MsgDeregisterConsumer{Authority: authority, ConsumerId: consumer.ConsumerId})
+		require.ErrorIs(t, err, types.ErrConsumerNotRegistered)
+	})
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/zoneconcierge/types"
+)
+
+// getCovenantAttestation returns the covenant attestation over the BTC
+// delegations under the active finality providers at the current height. The
+// BTC delegations are capped at the maximum number in the params, in the
+// descending order of the voting power of the finality providers, so that the
+// attestation does not blow up the size of the IBC packet
+func (k Keeper) getCovenantAttestation(ctx context.Context) (*types.CovenantAttestation, error) {
+	height := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+
+	dc, err := k.btcStakingKeeper.GetVotingPowerDistCache(ctx, height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get voting power distribution at height %d: %w", height, err)
+	}
+
+	// covenant PK set hashes are identical for delegations under the same
+	// params version, so cache them
+	pksHashes := map[uint32][]byte{}
+
+	attestation := &types.CovenantAttestation{
+		Height:           height,
+		TotalVotingPower: dc.TotalVotingPower,
+	}
+	maxDels := int(k.GetParams(ctx).MaxCovenantAttestationDelegations)
+	maxActiveFPs := k.btcStakingKeeper.GetParams(ctx).MaxActiveFinalityProviders
+	for _, fp := range dc.GetActiveFinalityProviders(maxActiveFPs) {
+		for _, d := range fp.BtcDels {
+			if len(attestation.Delegations) == maxDels {
+				attestation.Truncated = true
+				return attestation, nil
+			}
+			btcDel, err := k.btcStakingKeeper.GetBTCDelegation(ctx, d.StakingTxHash)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get BTC delegation %s: %w", d.StakingTxHash, err)
+			}
+			bsParams := k.btcStakingKeeper.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+			if bsParams == nil {
+				return nil, fmt.Errorf("params version %d of BTC delegation %s does not exist", btcDel.ParamsVersion, d.StakingTxHash)
+			}
+			pksHash, ok := pksHashes[btcDel.ParamsVersion]
+			if !ok {
+				pksHash = types.CovenantPKsHash(bsParams.CovenantPks)
+				pksHashes[btcDel.ParamsVersion] = pksHash
+			}
+
+			attestation.Delegations = append(attestation.Delegations, &types.DelegationCovenantAttestation{
+				StakingTxHash:    d.StakingTxHash,
+				FpBtcPk:          fp.BtcPk,
+				VotingPower:      d.VotingPower,
+				CovenantPksHash:  pksHash,
+				CovenantQuorum:   bsParams.CovenantQuorum,
+				CovenantSigCount: uint32(len(btcDel.CovenantSigs)),
+			})
+		}
+	}
+
+	return attestation, nil
+}
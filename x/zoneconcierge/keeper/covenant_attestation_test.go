@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	zctypes "github.com/babylonchain/babylon/x/zoneconcierge/types"
+)
+
+// FuzzCovenantAttestation checks that a BTC timestamp sent to a consumer
+// chain carries the covenant attestation over the BTC delegations under the
+// active finality providers, capped at the maximum number in the params
+func FuzzCovenantAttestation(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// a voting power distribution with a random number of finality
+		// providers, each with a random number of BTC delegations
+		bsParams := bstypes.DefaultParams()
+		covenantPKs := []bbn.BIP340PubKey{}
+		for i := 0; i < 3; i++ {
+			_, pk, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			covenantPKs = append(covenantPKs, *bbn.NewBIP340PubKeyFromBTCPK(pk))
+		}
+		bsParams.CovenantPks = covenantPKs
+		dc := bstypes.NewVotingPowerDistCache()
+		btcDels := map[string]*bstypes.BTCDelegation{}
+		numFPs := int(datagen.RandomInt(r, 5) + 1)
+		for i := 0; i < numFPs; i++ {
+			fpPK, err := datagen.GenRandomBIP340PubKey(r)
+			require.NoError(t, err)
+			fp := &bstypes.FinalityProviderDistInfo{BtcPk: fpPK}
+			numDels := int(datagen.RandomInt(r, 5) + 1)
+			for j := 0; j < numDels; j++ {
+				stakingTxHash := datagen.GenRandomBtcdHash(r).String()
+				votingPower := datagen.RandomInt(r, 100000) + 1
+				fp.BtcDels = append(fp.BtcDels, &bstypes.BTCDelDistInfo{
+					StakingTxHash: stakingTxHash,
+					VotingPower:   votingPower,
+				})
+				fp.TotalVotingPower += votingPower
+				btcDels[stakingTxHash] = &bstypes.BTCDelegation{
+					CovenantSigs: make([]*bstypes.CovenantAdaptorSignatures, bsParams.CovenantQuorum),
+				}
+			}
+			dc.AddFinalityProviderDistInfo(fp)
+		}
+		dc.ApplyActiveFinalityProviders(bsParams.MaxActiveFinalityProviders)
+
+		// mock BTC staking keeper
+		bsKeeper := zctypes.NewMockBTCStakingKeeper(ctrl)
+		bsKeeper.EXPECT().GetParams(gomock.Any()).Return(bsParams).AnyTimes()
+		bsKeeper.EXPECT().GetParamsByVersion(gomock.Any(), uint32(0)).Return(&bsParams).AnyTimes()
+		bsKeeper.EXPECT().GetVotingPowerDistCache(gomock.Any(), gomock.Any()).Return(dc, nil).AnyTimes()
+		bsKeeper.EXPECT().GetBTCDelegation(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ interface{}, stakingTxHash string) (*bstypes.BTCDelegation, error) {
+				return btcDels[stakingTxHash], nil
+			},
+		).AnyTimes()
+
+		// mock the finalised epoch, its checkpoint and the checkpoint's
+		// submission to BTC
+		epoch := datagen.GenRandomEpoch(r)
+		epoch.EpochNumber++
+		prevEpoch := *epoch
+		prevEpoch.EpochNumber--
+		epochingKeeper := zctypes.NewMockEpochingKeeper(ctrl)
+		epochingKeeper.EXPECT().GetEpoch(gomock.Any()).Return(&prevEpoch).AnyTimes()
+		epochingKeeper.EXPECT().GetHistoricalEpoch(gomock.Any(), epoch.EpochNumber).Return(epoch, nil).AnyTimes()
+		checkpointingKeeper := zctypes.NewMockCheckpointingKeeper(ctrl)
+		checkpointingKeeper.EXPECT().GetRawCheckpoint(gomock.Any(), epoch.EpochNumber).Return(datagen.GenRandomRawCheckpointWithMeta(r), nil).AnyTimes()
+		checkpointingKeeper.EXPECT().GetBLSPubKeySet(gomock.Any(), epoch.EpochNumber).Return([]*checkpointingtypes.ValidatorWithBlsKey{}, nil).AnyTimes()
+		btccKeeper := zctypes.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetEpochData(gomock.Any(), epoch.EpochNumber).Return(&btcctypes.EpochData{}).AnyTimes()
+		btccKeeper.EXPECT().GetEpochBestSubmissionBtcInfo(gomock.Any(), gomock.Any()).Return(&btcctypes.SubmissionBtcInfo{}).AnyTimes()
+		btccKeeper.EXPECT().GetSubmissionData(gomock.Any(), gomock.Any()).Return(&btcctypes.SubmissionData{}).AnyTimes()
+
+		// mock an open IBC channel with a consumer chain
+		consumerID := datagen.GenRandomHexStr(r, 10)
+		channel := channeltypes.IdentifiedChannel{
+			State:     channeltypes.OPEN,
+			PortId:    zctypes.PortID,
+			ChannelId: "channel-" + datagen.GenRandomHexStr(r, 4),
+		}
+		channelKeeper := zctypes.NewMockChannelKeeper(ctrl)
+		channelKeeper.EXPECT().GetAllChannels(gomock.Any()).Return([]channeltypes.IdentifiedChannel{channel}).AnyTimes()
+		channelKeeper.EXPECT().GetChannelClientState(gomock.Any(), channel.PortId, channel.ChannelId).Return("", &ibctmtypes.ClientState{ChainId: consumerID}, nil).AnyTimes()
+		channelKeeper.EXPECT().GetNextSequenceSend(gomock.Any(), channel.PortId, channel.ChannelId).Return(uint64(2), true).AnyTimes()
+		scopedKeeper := zctypes.NewMockScopedKeeper(ctrl)
+		scopedKeeper.EXPECT().GetCapability(gomock.Any(), gomock.Any()).Return(&capabilitytypes.Capability{}, true).AnyTimes()
+		// capture the IBC packet sent to the consumer chain
+		var packetData []byte
+		ics4Wrapper := zctypes.NewMockICS4Wrapper(ctrl)
+		ics4Wrapper.EXPECT().SendPacket(gomock.Any(), gomock.Any(), channel.PortId, channel.ChannelId, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ sdk.Context, _ *capabilitytypes.Capability, _, _ string, _ interface{}, _ uint64, data []byte) (uint64, error) {
+				packetData = data
+				return 2, nil
+			},
+		).Times(1)
+
+		zcKeeper, ctx := testkeeper.ZoneConciergeKeeperWithIBCKeepers(t, ics4Wrapper, channelKeeper, scopedKeeper, nil, checkpointingKeeper, btccKeeper, epochingKeeper, bsKeeper)
+		zcKeeper.SetPort(ctx, zctypes.PortID)
+		params := zctypes.DefaultParams()
+		params.IncludeCovenantAttestation = true
+		params.MaxCovenantAttestationDelegations = uint32(datagen.RandomInt(r, len(btcDels)) + 1)
+		require.NoError(t, zcKeeper.SetParams(ctx, params))
+
+		// the consumer chain has headers timestamped before the finalised
+		// epoch
+		SimulateNewHeaders(ctx, r, zcKeeper, consumerID, 0, datagen.RandomInt(r, 10)+1)
+		zcKeeper.Hooks().AfterEpochEnds(ctx, epoch.EpochNumber)
+
+		// send the BTC timestamp of the finalised epoch
+		zcKeeper.BroadcastBTCTimestamps(ctx, epoch.EpochNumber, nil)
+		require.NotNil(t, zcKeeper.GetInFlightBTCTimestamp(ctx, channel.ChannelId, 2))
+
+		// the BTC timestamp carries the covenant attestation over the BTC
+		// delegations of the finality providers with the most voting power
+		var packet zctypes.ZoneconciergePacketData
+		require.NoError(t, packet.Unmarshal(packetData))
+		attestation := packet.GetBtcTimestamp().CovenantAttestation
+		require.NotNil(t, attestation)
+		require.Equal(t, dc.TotalVotingPower, attestation.TotalVotingPower)
+		maxDels := int(params.MaxCovenantAttestationDelegations)
+		require.Len(t, attestation.Delegations, min(maxDels, len(btcDels)))
+		require.Equal(t, maxDels < len(btcDels), attestation.Truncated)
+		i := 0
+		pksHash := zctypes.CovenantPKsHash(covenantPKs)
+		for _, fp := range dc.GetActiveFinalityProviders(bsParams.MaxActiveFinalityProviders) {
+			for _, d := range fp.BtcDels {
+				if i == len(attestation.Delegations) {
+					break
+				}
+				delAttestation := attestation.Delegations[i]
+				require.Equal(t, d.StakingTxHash, delAttestation.StakingTxHash)
+				require.True(t, fp.BtcPk.Equals(delAttestation.FpBtcPk))
+				require.Equal(t, d.VotingPower, delAttestation.VotingPower)
+				require.True(t, bytes.Equal(pksHash, delAttestation.CovenantPksHash))
+				require.Equal(t, bsParams.CovenantQuorum, delAttestation.CovenantQuorum)
+				require.Equal(t, bsParams.CovenantQuorum, delAttestation.CovenantSigCount)
+				i++
+			}
+		}
+		require.Equal(t, len(attestation.Delegations), i)
+	})
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+
 	"cosmossdk.io/store/prefix"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -84,6 +85,38 @@ func (k Keeper) recordEpochChainInfo(ctx context.Context, chainID string, epochN
 	store.Set(sdk.Uint64ToBigEndian(epochNumber), k.cdc.MustMarshal(chainInfo))
 }
 
+// pruneEpochChainInfos prunes the chain info snapshots of all CZs at epochs
+// that are the retention number of epochs or more older than the given
+// finalised epoch. It is a no-op if the retention is zero.
+func (k Keeper) pruneEpochChainInfos(ctx context.Context, finalizedEpoch uint64) {
+	retention := uint64(k.GetParams(ctx).EpochChainInfoRetention)
+	if retention == 0 || finalizedEpoch < retention {
+		return
+	}
+	// epochs no later than this one are pruned
+	pruneUntilEpoch := finalizedEpoch - retention
+	for _, chainID := range k.GetAllChainIDs(ctx) {
+		k.pruneEpochChainInfosOfChain(ctx, chainID, pruneUntilEpoch)
+	}
+}
+
+// pruneEpochChainInfosOfChain removes the chain info snapshots of the given
+// CZ at all epochs no later than the given epoch
+func (k Keeper) pruneEpochChainInfosOfChain(ctx context.Context, chainID string, pruneUntilEpoch uint64) {
+	store := k.epochChainInfoStore(ctx, chainID)
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(pruneUntilEpoch+1))
+	defer iter.Close()
+
+	// collect the keys first as the store cannot be mutated during iteration
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 // epochChainInfoStore stores each epoch's latest ChainInfo for a CZ
 // prefix: EpochChainInfoKey || chainID
 // key: epochNumber
@@ -110,3 +110,51 @@ func FuzzGetEpochHeaders(f *testing.F) {
 		}
 	})
 }
+
+func FuzzPruneEpochChainInfos(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		babylonApp := app.Setup(t, false)
+		zcKeeper := babylonApp.ZoneConciergeKeeper
+		ctx := babylonApp.NewContext(false)
+		hooks := zcKeeper.Hooks()
+
+		// set a random retention
+		retention := datagen.RandomInt(r, 5) + 1
+		params := zcKeeper.GetParams(ctx)
+		params.EpochChainInfoRetention = uint32(retention)
+		err := zcKeeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		// record the chain info of a random number of chains over a random
+		// number of epochs
+		numChains := datagen.RandomInt(r, 5) + 1
+		chainIDs := []string{}
+		for i := uint64(0); i < numChains; i++ {
+			chainID := "test-chainid-" + datagen.GenRandomHexStr(r, 10)
+			SimulateNewHeaders(ctx, r, &zcKeeper, chainID, 0, datagen.RandomInt(r, 10)+1)
+			chainIDs = append(chainIDs, chainID)
+		}
+		numEpochs := datagen.RandomInt(r, 20) + 1
+		for epoch := uint64(1); epoch <= numEpochs; epoch++ {
+			babylonApp.EpochingKeeper.IncEpoch(ctx)
+			hooks.AfterEpochEnds(ctx, epoch)
+		}
+
+		// finalise a random epoch
+		finalizedEpoch := datagen.RandomInt(r, int(numEpochs)) + 1
+		err = hooks.AfterRawCheckpointFinalized(ctx, finalizedEpoch)
+		require.NoError(t, err)
+
+		// only the chain info of epochs within the retention are retained
+		for _, chainID := range chainIDs {
+			for epoch := uint64(1); epoch <= numEpochs; epoch++ {
+				exists := zcKeeper.EpochChainInfoExists(ctx, chainID, epoch)
+				require.Equal(t, epoch+retention > finalizedEpoch, exists, "epoch %d", epoch)
+			}
+		}
+	})
+}
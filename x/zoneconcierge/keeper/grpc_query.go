@@ -399,3 +399,95 @@ func (k Keeper) FinalizedChainInfoUntilHeight(c context.Context, req *types.Quer
 
 	return resp, nil
 }
+
+func (k Keeper) FinalizedHeaderBundle(c context.Context, req *types.QueryFinalizedHeaderBundleRequest) (*types.QueryFinalizedHeaderBundleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.ChainId) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "chain ID cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// find the CZ header at the given height
+	header, err := k.GetHeader(ctx, req.ChainId, req.Height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	// the epoch that timestamps the CZ header has to be finalised
+	epochNum := header.BabylonEpoch
+	if lastFinalizedEpoch := k.GetLastFinalizedEpoch(ctx); epochNum > lastFinalizedEpoch {
+		return nil, status.Error(codes.FailedPrecondition, types.ErrEpochNotFinalized.Wrapf(
+			"the CZ header is timestamped in epoch %d, while the last finalized epoch is %d", epochNum, lastFinalizedEpoch,
+		).Error())
+	}
+
+	bundle := &types.FinalizedHeaderBundle{Header: header}
+
+	// find the epoch metadata, the raw checkpoint and the best submission key
+	// of the epoch
+	bundle.EpochInfo, err = k.epochingKeeper.GetHistoricalEpoch(ctx, epochNum)
+	if err != nil {
+		return nil, err
+	}
+	rawCheckpoint, err := k.checkpointingKeeper.GetRawCheckpoint(ctx, epochNum)
+	if err != nil {
+		return nil, err
+	}
+	bundle.RawCheckpoint = rawCheckpoint.Ckpt
+	_, bundle.BtcSubmissionKey, err = k.btccKeeper.GetBestSubmission(ctx, epochNum)
+	if err != nil {
+		return nil, err
+	}
+
+	// generate all proofs
+	bundle.Proof, err = k.proveFinalizedHeader(ctx, header, bundle.EpochInfo, bundle.BtcSubmissionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFinalizedHeaderBundleResponse{Bundle: bundle}, nil
+}
+
+func (k Keeper) Consumers(c context.Context, req *types.QueryConsumersRequest) (*types.QueryConsumersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	consumers := []*types.ConsumerRegister{}
+	store := k.consumerRegistryStore(ctx)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var consumer types.ConsumerRegister
+		k.cdc.MustUnmarshal(value, &consumer)
+		consumers = append(consumers, &consumer)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryConsumersResponse{Consumers: consumers, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Consumer(c context.Context, req *types.QueryConsumerRequest) (*types.QueryConsumerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.ConsumerId) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "consumer ID cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	consumer, err := k.GetConsumer(ctx, req.ConsumerId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryConsumerResponse{Consumer: consumer}, nil
+}
@@ -396,7 +396,7 @@ func FuzzFinalizedChainInfo(f *testing.F) {
 		btclcKeeper.EXPECT().GetMainChainFrom(gomock.Any(), gomock.Any()).Return([]*btclightclienttypes.BTCHeaderInfo{mockBTCHeaderInfo}).AnyTimes()
 		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(mockBTCHeaderInfo).AnyTimes()
 
-		zcKeeper, ctx := testkeeper.ZoneConciergeKeeper(t, btclcKeeper, checkpointingKeeper, btccKeeper, epochingKeeper)
+		zcKeeper, ctx := testkeeper.ZoneConciergeKeeper(t, btclcKeeper, checkpointingKeeper, btccKeeper, epochingKeeper, nil)
 		hooks := zcKeeper.Hooks()
 
 		var (
@@ -436,3 +436,74 @@ func FuzzFinalizedChainInfo(f *testing.F) {
 		}
 	})
 }
+
+func FuzzFinalizedHeaderBundle(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// simulate the scenario that a random epoch has ended and finalised
+		epoch := datagen.GenRandomEpoch(r)
+
+		// mock checkpointing keeper
+		checkpointingKeeper := zctypes.NewMockCheckpointingKeeper(ctrl)
+		checkpointingKeeper.EXPECT().GetBLSPubKeySet(gomock.Any(), gomock.Eq(epoch.EpochNumber)).Return([]*checkpointingtypes.ValidatorWithBlsKey{}, nil).AnyTimes()
+		randomRawCkpt := datagen.GenRandomRawCheckpoint(r)
+		randomRawCkpt.EpochNum = epoch.EpochNumber
+		checkpointingKeeper.EXPECT().GetRawCheckpoint(gomock.Any(), gomock.Eq(epoch.EpochNumber)).Return(
+			&checkpointingtypes.RawCheckpointWithMeta{
+				Ckpt: randomRawCkpt,
+			}, nil,
+		).AnyTimes()
+		checkpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(epoch.EpochNumber).AnyTimes()
+		// mock btccheckpoint keeper
+		btccKeeper := zctypes.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		submissionKey := &btcctypes.SubmissionKey{
+			Key: []*btcctypes.TransactionKey{},
+		}
+		btccKeeper.EXPECT().GetBestSubmission(gomock.Any(), gomock.Eq(epoch.EpochNumber)).Return(
+			btcctypes.Finalized,
+			submissionKey,
+			nil,
+		).AnyTimes()
+		mockSubmissionData := &btcctypes.SubmissionData{TxsInfo: []*btcctypes.TransactionInfo{}}
+		btccKeeper.EXPECT().GetSubmissionData(gomock.Any(), gomock.Any()).Return(mockSubmissionData).AnyTimes()
+		// mock epoching keeper
+		epochingKeeper := zctypes.NewMockEpochingKeeper(ctrl)
+		epochingKeeper.EXPECT().GetEpoch(gomock.Any()).Return(epoch).AnyTimes()
+		epochingKeeper.EXPECT().GetHistoricalEpoch(gomock.Any(), gomock.Eq(epoch.EpochNumber)).Return(epoch, nil).AnyTimes()
+		// mock btclc keeper
+		btclcKeeper := zctypes.NewMockBTCLightClientKeeper(ctrl)
+
+		zcKeeper, ctx := testkeeper.ZoneConciergeKeeper(t, btclcKeeper, checkpointingKeeper, btccKeeper, epochingKeeper, nil)
+
+		czChainID := datagen.GenRandomHexStr(r, 30)
+		numHeaders := datagen.RandomInt(r, 100) + 1
+		headers := SimulateNewHeaders(ctx, r, zcKeeper, czChainID, 0, numHeaders)
+
+		// the bundle of a random header includes the header, the epoch's
+		// metadata and checkpoint, and the proofs
+		height := datagen.RandomInt(r, int(numHeaders))
+		resp, err := zcKeeper.FinalizedHeaderBundle(ctx, &zctypes.QueryFinalizedHeaderBundleRequest{ChainId: czChainID, Height: height})
+		require.NoError(t, err)
+		bundle := resp.Bundle
+		require.Equal(t, czChainID, bundle.Header.ChainId)
+		require.Equal(t, height, bundle.Header.Height)
+		require.Equal(t, headers[height].Header.AppHash, bundle.Header.Hash)
+		require.Equal(t, epoch.EpochNumber, bundle.EpochInfo.EpochNumber)
+		require.Equal(t, randomRawCkpt, bundle.RawCheckpoint)
+		require.Equal(t, submissionKey, bundle.BtcSubmissionKey)
+		require.NotNil(t, bundle.Proof)
+		require.NotNil(t, bundle.Proof.ProofCzHeaderInEpoch)
+		require.NotNil(t, bundle.Proof.ProofEpochSealed)
+
+		// there is no bundle for a header that does not exist
+		_, err = zcKeeper.FinalizedHeaderBundle(ctx, &zctypes.QueryFinalizedHeaderBundleRequest{ChainId: czChainID, Height: numHeaders})
+		require.Error(t, err)
+	})
+}
@@ -1,10 +1,14 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types" //nolint:staticcheck
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 
 	"github.com/babylonchain/babylon/x/zoneconcierge/types"
 )
@@ -26,6 +30,22 @@ func (k Keeper) HandleHeaderWithValidCommit(ctx context.Context, txHash []byte,
 
 	k.Logger(sdkCtx).Debug("found new IBC header", "header", indexedHeader)
 
+	// ensure the consumer chain does not exceed the maximum number of headers
+	// per block in its registration, otherwise ignore the header
+	if !k.tryIndexHeader(ctx, indexedHeader.ChainId) {
+		k.Logger(sdkCtx).Info("skipped IBC header as the consumer chain reaches the maximum number of headers in this block",
+			"chainID", indexedHeader.ChainId, "height", indexedHeader.Height)
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeHeaderSkipped,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyChainID, indexedHeader.ChainId),
+				sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", indexedHeader.Height)),
+			),
+		)
+		return
+	}
+
 	var (
 		chainInfo *types.ChainInfo
 		err       error
@@ -71,3 +91,29 @@ func (k Keeper) HandleHeaderWithValidCommit(ctx context.Context, txHash []byte,
 		}
 	}
 }
+
+// VerifyHeaderWithClient ensures the given CZ header corresponds to a
+// consensus state of the IBC light client that it updates, i.e., the IBC
+// client has verified the header and stored a consensus state with the same
+// app hash and timestamp at the header's height
+func (k Keeper) VerifyHeaderWithClient(ctx context.Context, header *types.HeaderInfo) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	// the consensus state is indexed by the header's height along with the
+	// revision number encoded in the chain ID, same as in the IBC client
+	height := clienttypes.NewHeight(clienttypes.ParseChainID(header.ChainId), header.Height)
+	consensusState, exist := k.clientKeeper.GetClientConsensusState(sdkCtx, header.ClientId, height)
+	if !exist {
+		return errorsmod.Wrapf(types.ErrHeaderNotVerified, "client %s has no consensus state at height %s", header.ClientId, height)
+	}
+	cmtConsensusState, ok := consensusState.(*ibctmtypes.ConsensusState)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrHeaderNotVerified, "client %s is not a Comet client", header.ClientId)
+	}
+	if !bytes.Equal(cmtConsensusState.Root.GetHash(), header.AppHash) {
+		return errorsmod.Wrapf(types.ErrHeaderNotVerified, "app hash mismatch at height %s", height)
+	}
+	if !cmtConsensusState.Timestamp.Equal(header.Time) {
+		return errorsmod.Wrapf(types.ErrHeaderNotVerified, "timestamp mismatch at height %s", height)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types" //nolint:staticcheck
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	zctypes "github.com/babylonchain/babylon/x/zoneconcierge/types"
+)
+
+func FuzzVerifyHeaderWithClient(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientID := "07-tendermint-" + datagen.GenRandomHexStr(r, 4)
+		// the revision number of the chain ID is part of the consensus
+		// state's height
+		revision := datagen.RandomInt(r, 10) + 1
+		chainID := fmt.Sprintf("test-%d", revision)
+		header := datagen.HeaderToHeaderInfo(datagen.GenRandomIBCTMHeader(r, chainID, datagen.RandomInt(r, 1000)+1))
+		header.ClientId = clientID
+		header.Time = time.Unix(int64(datagen.RandomInt(r, 1000000)), 0).UTC()
+		height := clienttypes.NewHeight(revision, header.Height)
+
+		clientKeeper := zctypes.NewMockClientKeeper(ctrl)
+		zcKeeper, ctx := testkeeper.ZoneConciergeKeeperWithClientKeeper(t, clientKeeper, nil, nil, nil, nil, nil)
+
+		// no consensus state at the header's height
+		clientKeeper.EXPECT().GetClientConsensusState(gomock.Any(), clientID, height).Return(nil, false).Times(1)
+		err := zcKeeper.VerifyHeaderWithClient(ctx, header)
+		require.ErrorIs(t, err, zctypes.ErrHeaderNotVerified)
+
+		// consensus state with a different app hash
+		consensusState := ibctmtypes.NewConsensusState(
+			header.Time,
+			commitmenttypes.NewMerkleRoot(datagen.GenRandomByteArray(r, 32)),
+			datagen.GenRandomByteArray(r, 32),
+		)
+		clientKeeper.EXPECT().GetClientConsensusState(gomock.Any(), clientID, height).Return(consensusState, true).Times(1)
+		err = zcKeeper.VerifyHeaderWithClient(ctx, header)
+		require.ErrorIs(t, err, zctypes.ErrHeaderNotVerified)
+
+		// consensus state with a different timestamp
+		consensusState = ibctmtypes.NewConsensusState(
+			header.Time.Add(time.Second),
+			commitmenttypes.NewMerkleRoot(header.AppHash),
+			datagen.GenRandomByteArray(r, 32),
+		)
+		clientKeeper.EXPECT().GetClientConsensusState(gomock.Any(), clientID, height).Return(consensusState, true).Times(1)
+		err = zcKeeper.VerifyHeaderWithClient(ctx, header)
+		require.ErrorIs(t, err, zctypes.ErrHeaderNotVerified)
+
+		// consensus state matching the header
+		consensusState = ibctmtypes.NewConsensusState(
+			header.Time,
+			commitmenttypes.NewMerkleRoot(header.AppHash),
+			datagen.GenRandomByteArray(r, 32),
+		)
+		clientKeeper.EXPECT().GetClientConsensusState(gomock.Any(), clientID, height).Return(consensusState, true).Times(1)
+		err = zcKeeper.VerifyHeaderWithClient(ctx, header)
+		require.NoError(t, err)
+	})
+}
@@ -39,6 +39,17 @@ func (h Hooks) AfterRawCheckpointFinalized(ctx context.Context, epoch uint64) er
 	h.k.setLastSentSegment(ctx, &types.BTCChainSegment{
 		BtcHeaders: headersToBroadcast,
 	})
+
+	// prune the chain info snapshots that are beyond the retention
+	h.k.pruneEpochChainInfos(ctx, epoch)
+	return nil
+}
+
+// AfterRawCheckpointUnfinalized is triggered upon a finalised epoch rolls
+// back upon a BTC reorg
+func (h Hooks) AfterRawCheckpointUnfinalized(ctx context.Context, epoch uint64) error {
+	// BTC timestamps of this epoch and its descendants are no longer valid
+	h.k.dropBTCTimestampRetries(ctx, epoch)
 	return nil
 }
 
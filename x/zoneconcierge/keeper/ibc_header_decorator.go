@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types" //nolint:staticcheck
@@ -91,6 +93,29 @@ func (d *IBCHeaderDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, su
 		// fail, eventually failing the entire tx. All state updates due to this
 		// failed tx will be rolled back.
 		isOnFork := !clientState.FrozenHeight.IsZero()
+
+		// a canonical header has to correspond to the consensus state that
+		// the IBC client stores upon verifying it, otherwise ignore it
+		// NOTE: a fork header does not lead to a consensus state since the IBC
+		// client is frozen upon it. Instead, the IBC client verifies the
+		// fork header before freezing.
+		if !isOnFork {
+			if err := d.k.VerifyHeaderWithClient(ctx, headerInfo); err != nil {
+				d.k.Logger(ctx).Info("skipped IBC header that is not verified by the IBC client",
+					"chainID", headerInfo.ChainId, "height", headerInfo.Height, "err", err)
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeHeaderUnverified,
+						sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+						sdk.NewAttribute(types.AttributeKeyChainID, headerInfo.ChainId),
+						sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", headerInfo.Height)),
+						sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+					),
+				)
+				continue
+			}
+		}
+
 		d.k.HandleHeaderWithValidCommit(ctx, txHash, headerInfo, isOnFork)
 
 		// unfreeze client (by setting FrozenHeight to zero again) if the client is frozen
@@ -17,9 +17,10 @@ import (
 	"github.com/hashicorp/go-metrics"
 )
 
-// SendIBCPacket sends an IBC packet to a channel
+// SendIBCPacket sends an IBC packet to a channel, and returns the sequence
+// number of the packet
 // (adapted from https://github.com/cosmos/ibc-go/blob/v5.0.0/modules/apps/transfer/keeper/relay.go)
-func (k Keeper) SendIBCPacket(ctx context.Context, channel channeltypes.IdentifiedChannel, packetData *types.ZoneconciergePacketData) error {
+func (k Keeper) SendIBCPacket(ctx context.Context, channel channeltypes.IdentifiedChannel, packetData *types.ZoneconciergePacketData) (uint64, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	// get src/dst ports and channels
 	sourcePort := channel.PortId
@@ -31,7 +32,7 @@ func (k Keeper) SendIBCPacket(ctx context.Context, channel channeltypes.Identifi
 	// See spec for this logic: https://github.com/cosmos/ibc/tree/master/spec/app/ics-020-fungible-token-transfer#packet-relay
 	channelCap, ok := k.scopedKeeper.GetCapability(sdkCtx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
 	if !ok {
-		return errorsmod.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability: sourcePort: %s, sourceChannel: %s", sourcePort, sourceChannel)
+		return 0, errorsmod.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability: sourcePort: %s, sourceChannel: %s", sourcePort, sourceChannel)
 	}
 
 	// timeout
@@ -51,11 +52,12 @@ func (k Keeper) SendIBCPacket(ctx context.Context, channel channeltypes.Identifi
 
 	// send packet
 	if err != nil {
-		// Failed/timeout packet should not make the system crash
+		// Failed/timeout packet should not make the system crash, and is
+		// handled by the caller
 		k.Logger(sdkCtx).Error(fmt.Sprintf("failed to send IBC packet (sequence number: %d) to channel %v port %s: %v", seq, destinationChannel, destinationPort, err))
-	} else {
-		k.Logger(sdkCtx).Info(fmt.Sprintf("successfully sent IBC packet (sequence number: %d) to channel %v port %s", seq, destinationChannel, destinationPort))
+		return 0, err
 	}
+	k.Logger(sdkCtx).Info(fmt.Sprintf("successfully sent IBC packet (sequence number: %d) to channel %v port %s", seq, destinationChannel, destinationPort))
 
 	// metrics stuff
 	labels := []metrics.Label{
@@ -70,5 +72,5 @@ func (k Keeper) SendIBCPacket(ctx context.Context, channel channeltypes.Identifi
 		)
 	}()
 
-	return nil
+	return seq, nil
 }
@@ -25,6 +25,7 @@ type finalizedInfo struct {
 	ProofEpochSealed    *types.ProofEpochSealed
 	ProofEpochSubmitted []*btcctypes.TransactionInfo
 	BTCHeaders          []*btclctypes.BTCHeaderInfo
+	CovenantAttestation *types.CovenantAttestation
 }
 
 // getChainID gets the ID of the counterparty chain under the given channel
@@ -81,6 +82,16 @@ func (k Keeper) getFinalizedInfo(
 		return nil, err
 	}
 
+	// covenant attestation over the BTC delegations backing the BTC staking
+	// power, if enabled
+	var covenantAttestation *types.CovenantAttestation
+	if k.GetParams(ctx).IncludeCovenantAttestation {
+		covenantAttestation, err = k.getCovenantAttestation(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// construct finalizedInfo
 	finalizedInfo := &finalizedInfo{
 		EpochInfo:           finalizedEpochInfo,
@@ -89,6 +100,7 @@ func (k Keeper) getFinalizedInfo(
 		ProofEpochSealed:    proofEpochSealed,
 		ProofEpochSubmitted: proofEpochSubmitted,
 		BTCHeaders:          headersToBroadcast,
+		CovenantAttestation: covenantAttestation,
 	}
 
 	return finalizedInfo, nil
@@ -140,6 +152,7 @@ func (k Keeper) createBTCTimestamp(
 			ProofEpochSealed:     finalizedInfo.ProofEpochSealed,
 			ProofEpochSubmitted:  finalizedInfo.ProofEpochSubmitted,
 		},
+		CovenantAttestation: finalizedInfo.CovenantAttestation,
 	}
 
 	// if there is a CZ header checkpointed in this finalised epoch,
@@ -238,25 +251,8 @@ func (k Keeper) BroadcastBTCTimestamps(
 
 	// for each channel, construct and send BTC timestamp
 	for _, channel := range openZCChannels {
-		// get the ID of the chain under this channel
-		chainID, err := k.getChainID(ctx, channel)
-		if err != nil {
-			k.Logger(sdkCtx).Error("failed to get chain ID, skip sending BTC timestamp for this chain", "channelID", channel.ChannelId, "error", err)
-			continue
-		}
-
-		// generate timestamp for this channel
-		btcTimestamp, err := k.createBTCTimestamp(ctx, chainID, channel, finalizedInfo)
-		if err != nil {
-			k.Logger(sdkCtx).Error("failed to generate BTC timestamp, skip sending BTC timestamp for this chain", "chainID", chainID, "error", err)
-			continue
-		}
-
-		// wrap BTC timestamp to IBC packet
-		packet := types.NewBTCTimestampPacketData(btcTimestamp)
-		// send IBC packet
-		if err := k.SendIBCPacket(ctx, channel, packet); err != nil {
-			k.Logger(sdkCtx).Error("failed to send BTC timestamp IBC packet, skip sending BTC timestamp for this chain", "chainID", chainID, "channelID", channel.ChannelId, "error", err)
+		if err := k.sendBTCTimestamp(ctx, channel, finalizedInfo, 0); err != nil {
+			k.Logger(sdkCtx).Error("failed to send BTC timestamp, skip sending BTC timestamp for this chain", "channelID", channel.ChannelId, "error", err)
 			continue
 		}
 	}
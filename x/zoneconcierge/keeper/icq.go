@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/zoneconcierge/types"
+)
+
+// HandleInterchainQuery serves an interchain query from a consumer chain. It
+// returns the queried KV pair at the last committed height along with its
+// Merkle proof, which is verifiable against the app hash in the header of the
+// current height. The query is charged to the gas meter of the context, i.e.,
+// the relayer of the IBC packet, by a flat cost and the read cost of the bytes
+// of the KV pair and its Merkle proof.
+func (k Keeper) HandleInterchainQuery(ctx context.Context, req *types.InterchainQueryRequest) (*types.InterchainQueryResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.GasMeter().ConsumeGas(types.GasInterchainQuery, "interchain query")
+
+	height := sdkCtx.HeaderInfo().Height
+	key, value, proof, err := k.QueryStore(req.StoreKey, req.Key, height)
+	if err != nil {
+		return nil, err
+	}
+	readBytes := len(key) + len(value) + proof.Size()
+	sdkCtx.GasMeter().ConsumeGas(sdkCtx.KVGasConfig().ReadCostPerByte*uint64(readBytes), "interchain query read")
+
+	return &types.InterchainQueryResponse{
+		Height: height,
+		Key:    key,
+		Value:  value,
+		Proof:  proof,
+	}, nil
+}
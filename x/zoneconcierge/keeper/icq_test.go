@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/core/header"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testhelper "github.com/babylonchain/babylon/testutil/helper"
+	bbn "github.com/babylonchain/babylon/types"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	zctypes "github.com/babylonchain/babylon/x/zoneconcierge/types"
+)
+
+func FuzzInterchainQuery(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		h := testhelper.NewHelper(t)
+		zck := h.App.ZoneConciergeKeeper
+		var err error
+
+		// apply a random number of blocks
+		numBlocks := datagen.RandomInt(r, 5) + 1
+		for i := uint64(0); i < numBlocks; i++ {
+			h.Ctx, err = h.ApplyEmptyBlockWithVoteExtension(r)
+			h.NoError(err)
+		}
+
+		// the app hash of the last committed block, which will be in the
+		// header of the next block
+		committedHeight := h.Ctx.HeaderInfo().Height
+		appHash := h.Ctx.HeaderInfo().AppHash
+		nextCtx := h.Ctx.WithHeaderInfo(header.Info{Height: committedHeight + 1})
+
+		// BTC tip height at the last committed height
+		btcTip := h.App.BTCLightClientKeeper.GetTipInfo(h.Ctx)
+		req := &zctypes.InterchainQueryRequest{
+			StoreKey: bstypes.StoreKey,
+			Key:      zctypes.ICQKeyBTCTipHeight(uint64(committedHeight)),
+		}
+		gasBefore := nextCtx.GasMeter().GasConsumed()
+		resp, err := zck.HandleInterchainQuery(nextCtx, req)
+		h.NoError(err)
+		// the query is metered by a flat cost and the read cost of the
+		// response
+		require.Greater(t, nextCtx.GasMeter().GasConsumed()-gasBefore, zctypes.GasInterchainQuery)
+		require.Equal(t, committedHeight+1, resp.Height)
+		require.Equal(t, btcTip.Height, sdk.BigEndianToUint64(resp.Value))
+		h.NoError(resp.Verify(req, appHash))
+
+		// BTC header at the BTC tip height
+		req = &zctypes.InterchainQueryRequest{
+			StoreKey: btclctypes.StoreKey,
+			Key:      zctypes.ICQKeyBTCHeader(btcTip.Height),
+		}
+		resp, err = zck.HandleInterchainQuery(nextCtx, req)
+		h.NoError(err)
+		var headerInfo btclctypes.BTCHeaderInfo
+		h.NoError(headerInfo.Unmarshal(resp.Value))
+		require.True(t, btcTip.Eq(&headerInfo))
+		h.NoError(resp.Verify(req, appHash))
+
+		// voting power of a non-existing finality provider comes with an
+		// absence proof
+		_, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		req = &zctypes.InterchainQueryRequest{
+			StoreKey: bstypes.StoreKey,
+			Key:      zctypes.ICQKeyVotingPower(uint64(committedHeight), bbn.NewBIP340PubKeyFromBTCPK(fpPK)),
+		}
+		resp, err = zck.HandleInterchainQuery(nextCtx, req)
+		h.NoError(err)
+		require.Empty(t, resp.Value)
+		h.NoError(resp.Verify(req, appHash))
+
+		// the proof does not verify against another app hash, or for another key
+		require.Error(t, resp.Verify(req, datagen.GenRandomByteArray(r, 32)))
+		otherReq := &zctypes.InterchainQueryRequest{
+			StoreKey: bstypes.StoreKey,
+			Key:      zctypes.ICQKeyBTCTipHeight(uint64(committedHeight)),
+		}
+		require.Error(t, resp.Verify(otherReq, appHash))
+
+		// non-whitelisted KV pairs cannot be queried
+		_, err = zck.HandleInterchainQuery(nextCtx, &zctypes.InterchainQueryRequest{
+			StoreKey: bstypes.StoreKey,
+			Key:      bstypes.BTCDelegationKey,
+		})
+		require.ErrorIs(t, err, zctypes.ErrInterchainQueryNotAllowed)
+		_, err = zck.HandleInterchainQuery(nextCtx, &zctypes.InterchainQueryRequest{
+			StoreKey: zctypes.StoreKey,
+			Key:      zctypes.ChainInfoKey,
+		})
+		require.ErrorIs(t, err, zctypes.ErrInterchainQueryNotAllowed)
+
+		// keys longer than the maximum length cannot be queried
+		_, err = zck.HandleInterchainQuery(nextCtx, &zctypes.InterchainQueryRequest{
+			StoreKey: bstypes.StoreKey,
+			Key:      append(zctypes.ICQKeyBTCTipHeight(uint64(committedHeight)), datagen.GenRandomByteArray(r, zctypes.MaxInterchainQueryKeyLength)...),
+		})
+		require.ErrorIs(t, err, zctypes.ErrInterchainQueryNotAllowed)
+	})
+}
@@ -29,6 +29,7 @@ type (
 		checkpointingKeeper types.CheckpointingKeeper
 		btccKeeper          types.BtcCheckpointKeeper
 		epochingKeeper      types.EpochingKeeper
+		btcStakingKeeper    types.BTCStakingKeeper
 		storeQuerier        storetypes.Queryable
 		scopedKeeper        types.ScopedKeeper
 		// the address capable of executing a MsgUpdateParams message. Typically, this
@@ -50,6 +51,7 @@ func NewKeeper(
 	checkpointingKeeper types.CheckpointingKeeper,
 	btccKeeper types.BtcCheckpointKeeper,
 	epochingKeeper types.EpochingKeeper,
+	btcStakingKeeper types.BTCStakingKeeper,
 	storeQuerier storetypes.Queryable,
 	scopedKeeper types.ScopedKeeper,
 	authority string,
@@ -67,6 +69,7 @@ func NewKeeper(
 		checkpointingKeeper: checkpointingKeeper,
 		btccKeeper:          btccKeeper,
 		epochingKeeper:      epochingKeeper,
+		btcStakingKeeper:    btcStakingKeeper,
 		storeQuerier:        storeQuerier,
 		scopedKeeper:        scopedKeeper,
 		authority:           authority,
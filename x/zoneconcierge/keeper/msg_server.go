@@ -37,3 +37,36 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// RegisterConsumer registers a consumer chain in the consumer registry
+func (ms msgServer) RegisterConsumer(goCtx context.Context, req *types.MsgRegisterConsumer) (*types.MsgRegisterConsumerResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+	if err := req.Consumer.Validate(); err != nil {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid consumer: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ms.HasConsumer(ctx, req.Consumer.ConsumerId) {
+		return nil, types.ErrConsumerExists.Wrapf("consumer ID: %s", req.Consumer.ConsumerId)
+	}
+	ms.SetConsumer(ctx, &req.Consumer)
+
+	return &types.MsgRegisterConsumerResponse{}, nil
+}
+
+// DeregisterConsumer removes a consumer chain from the consumer registry
+func (ms msgServer) DeregisterConsumer(goCtx context.Context, req *types.MsgDeregisterConsumer) (*types.MsgDeregisterConsumerResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !ms.HasConsumer(ctx, req.ConsumerId) {
+		return nil, types.ErrConsumerNotRegistered.Wrapf("consumer ID: %s", req.ConsumerId)
+	}
+	ms.DeleteConsumer(ctx, req.ConsumerId)
+
+	return &types.MsgDeregisterConsumerResponse{}, nil
+}
@@ -97,6 +97,18 @@ func (k Keeper) proveFinalizedChainInfo(
 	chainInfo *types.ChainInfo,
 	epochInfo *epochingtypes.Epoch,
 	bestSubmissionKey *btcctypes.SubmissionKey,
+) (*types.ProofFinalizedChainInfo, error) {
+	return k.proveFinalizedHeader(ctx, chainInfo.LatestHeader, epochInfo, bestSubmissionKey)
+}
+
+// proveFinalizedHeader generates proofs that a CZ header has been finalised by
+// the given epoch with epochInfo
+// CONTRACT: this is only a private helper function for simplifying the implementation of RPC calls
+func (k Keeper) proveFinalizedHeader(
+	ctx context.Context,
+	header *types.IndexedHeader,
+	epochInfo *epochingtypes.Epoch,
+	bestSubmissionKey *btcctypes.SubmissionKey,
 ) (*types.ProofFinalizedChainInfo, error) {
 	var (
 		err   error
@@ -104,7 +116,7 @@ func (k Keeper) proveFinalizedChainInfo(
 	)
 
 	// Proof that the CZ header is timestamped in epoch
-	proof.ProofCzHeaderInEpoch, err = k.ProveCZHeaderInEpoch(ctx, chainInfo.LatestHeader, epochInfo)
+	proof.ProofCzHeaderInEpoch, err = k.ProveCZHeaderInEpoch(ctx, header, epochInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,8 @@
 package zoneconcierge
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
 	"github.com/babylonchain/babylon/x/zoneconcierge/keeper"
 	"github.com/babylonchain/babylon/x/zoneconcierge/types"
@@ -50,6 +52,11 @@ func (im IBCModule) OnChanOpenInit(
 		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
 	}
 
+	// ensure the counterparty chain is allowed to open a channel
+	if err := im.keeper.ValidateChannelConsumer(ctx, connectionHops); err != nil {
+		return "", err
+	}
+
 	// Claim channel capability passed back by IBC module
 	if err := im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
 		return "", err
@@ -85,6 +92,11 @@ func (im IBCModule) OnChanOpenTry(
 		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s", counterpartyVersion, types.Version)
 	}
 
+	// ensure the counterparty chain is allowed to open a channel
+	if err := im.keeper.ValidateChannelConsumer(ctx, connectionHops); err != nil {
+		return "", err
+	}
+
 	// Module may have already claimed capability in OnChanOpenInit in the case of crossing hellos
 	// (ie chainA and chainB both call ChanOpenInit before one of them calls ChanOpenTry)
 	// If module can already authenticate the capability then module already owns it so we don't need to claim
@@ -140,6 +152,7 @@ func (im IBCModule) OnChanCloseConfirm(
 	portID,
 	channelID string,
 ) error {
+	im.keeper.OnBTCTimestampChannelClosed(ctx, channelID)
 	return nil
 }
 
@@ -149,9 +162,29 @@ func (im IBCModule) OnRecvPacket(
 	modulePacket channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
-	// Babylon is supposed to not take any IBC packet
 	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
-	return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "Babylon is supposed to not take any IBC packet"))
+	var modulePacketData types.ZoneconciergePacketData
+	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error()))
+	}
+
+	switch packet := modulePacketData.Packet.(type) {
+	case *types.ZoneconciergePacketData_IcqRequest:
+		// interchain query from a consumer chain, the response is carried
+		// by the acknowledgement
+		resp, err := im.keeper.HandleInterchainQuery(ctx, packet.IcqRequest)
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+		respBytes, err := resp.Marshal()
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+		return channeltypes.NewResultAcknowledgement(respBytes)
+	default:
+		// apart from interchain queries, Babylon is supposed to not take any IBC packet
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "Babylon is supposed to not take any IBC packet"))
+	}
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface
@@ -173,13 +206,12 @@ func (im IBCModule) OnAcknowledgementPacket(
 		}
 	}
 
-	// // TODO (Babylon): Dispatch and process packet
-	// switch packet := modulePacketData.Packet.(type) {
-	// default:
-	// 	errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
-	// 	return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
-	// }
+	var modulePacketData types.ZoneconciergePacketData
+	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
+	}
 
+	var ackErr string
 	switch resp := ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Result:
 		im.keeper.Logger(ctx).Info("received an Acknowledgement message", "result", string(resp.Result))
@@ -199,6 +231,15 @@ func (im IBCModule) OnAcknowledgementPacket(
 				sdk.NewAttribute(types.AttributeKeyAckError, resp.Error),
 			),
 		)
+		ackErr = resp.Error
+	}
+
+	switch modulePacketData.Packet.(type) {
+	case *types.ZoneconciergePacketData_BtcTimestamp:
+		im.keeper.OnBTCTimestampAcknowledged(ctx, modulePacket.SourceChannel, modulePacket.Sequence, ackErr)
+	default:
+		errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, modulePacketData.Packet)
+		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
 	}
 
 	return nil
@@ -215,14 +256,24 @@ func (im IBCModule) OnTimeoutPacket(
 		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
 	}
 
-	// // TODO (Babylon): Dispatch and process packet
-	// switch packet := modulePacketData.Packet.(type) {
-	// default:
-	// 	errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
-	// 	return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
-	// }
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTimeout,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChannelID, modulePacket.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", modulePacket.Sequence)),
+		),
+	)
+
+	switch modulePacketData.Packet.(type) {
+	case *types.ZoneconciergePacketData_BtcTimestamp:
+		im.keeper.OnBTCTimestampTimeout(ctx, modulePacket.SourceChannel, modulePacket.Sequence)
+	default:
+		errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, modulePacketData.Packet)
+		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+	}
 
-	// TODO: close channel upon timeout
+	// IBC closes the ordered channel upon the timeout
 
 	return nil
 }
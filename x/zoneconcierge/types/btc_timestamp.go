@@ -11,11 +11,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	txformat "github.com/babylonchain/babylon/btctxformatter"
-	"github.com/babylonchain/babylon/crypto/bls12381"
 	bbn "github.com/babylonchain/babylon/types"
 	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	btclckeeper "github.com/babylonchain/babylon/x/btclightclient/keeper"
 	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	checkpointingverifier "github.com/babylonchain/babylon/x/checkpointing/verifier"
 	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
 )
 
@@ -117,24 +117,9 @@ func VerifyEpochSealed(epoch *epochingtypes.Epoch, rawCkpt *checkpointingtypes.R
 		Ensure more than 2/3 (in voting power) validators of this epoch have signed (epoch_num || block_hash) in the raw checkpoint
 	*/
 	valSet := &checkpointingtypes.ValidatorWithBlsKeySet{ValSet: proof.ValidatorSet}
-	// filter validator set that contributes to the signature
-	signerSet, signerSetPower, err := valSet.FindSubsetWithPowerSum(rawCkpt.Bitmap)
-	if err != nil {
-		return err
-	}
-	// ensure the signerSet has > 2/3 voting power
-	if signerSetPower*3 <= valSet.GetTotalPower()*2 {
-		return checkpointingtypes.ErrInsufficientVotingPower
-	}
-	// verify BLS multisig
-	signedMsgBytes := rawCkpt.SignedMsg()
-	ok, err := bls12381.VerifyMultiSig(*rawCkpt.BlsMultiSig, signerSet.GetBLSKeySet(), signedMsgBytes)
-	if err != nil {
+	if err := checkpointingverifier.VerifyRawCheckpoint(rawCkpt, valSet); err != nil {
 		return err
 	}
-	if !ok {
-		return fmt.Errorf("BLS signature does not match the public key")
-	}
 
 	// Ensure The epoch medatata is committed to the app_hash of the sealer header
 	if err := VerifyEpochInfo(epoch, proof.ProofEpochInfo); err != nil {
@@ -229,11 +214,10 @@ func VerifyEpochSubmitted(rawCkpt *checkpointingtypes.RawCheckpoint, txsInfo []*
 	// decode parsedProof to checkpoint data
 	checkpointData := [][]byte{}
 	for i, proof := range parsedProofs {
-		data, err := txformat.GetCheckpointData(
+		data, err := proof.GetCheckpointData(
 			babylonTag,
 			txformat.CurrentVersion,
 			uint8(i),
-			proof.OpReturnData,
 		)
 
 		if err != nil {
@@ -335,3 +319,29 @@ func (ts *BTCTimestamp) VerifyStateless(
 
 	return nil
 }
+
+// VerifyStateless verifies the bundle of the archived chain info snapshot,
+// i.e., the latest header in the snapshot is BTC-finalised, given the BTC
+// headers that include the epoch's checkpoint. It performs the same stateless
+// checks as the BTC timestamp of the header.
+func (a *ArchivedEpochChainInfo) VerifyStateless(
+	btcHeadersWithCkpt []*wire.BlockHeader,
+	powLimit *big.Int,
+	ckptTag txformat.BabylonTag,
+) error {
+	if err := a.ValidateBasic(); err != nil {
+		return err
+	}
+	if a.Bundle == nil {
+		// no header is timestamped in this epoch, thus nothing to verify
+		return nil
+	}
+	ts := &BTCTimestamp{
+		Header:           a.Bundle.Header,
+		EpochInfo:        a.Bundle.EpochInfo,
+		RawCheckpoint:    a.Bundle.RawCheckpoint,
+		BtcSubmissionKey: a.Bundle.BtcSubmissionKey,
+		Proof:            a.Bundle.Proof,
+	}
+	return ts.VerifyStateless(btcHeadersWithCkpt, powLimit, ckptTag)
+}
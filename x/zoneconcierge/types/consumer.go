@@ -0,0 +1,34 @@
+package types
+
+// MaxConsumerIDLength is the maximum length of the chain ID of a consumer chain
+const MaxConsumerIDLength = 64
+
+// ValidateConsumerID checks that the given consumer ID is not empty and not
+// longer than MaxConsumerIDLength
+func ValidateConsumerID(consumerID string) error {
+	if len(consumerID) == 0 {
+		return ErrInvalidConsumer.Wrap("empty consumer ID")
+	}
+	if len(consumerID) > MaxConsumerIDLength {
+		return ErrInvalidConsumer.Wrapf("consumer ID is longer than %d bytes", MaxConsumerIDLength)
+	}
+	return nil
+}
+
+// Validate performs basic validation of the consumer registration
+func (cr *ConsumerRegister) Validate() error {
+	if err := ValidateConsumerID(cr.ConsumerId); err != nil {
+		return err
+	}
+	if len(cr.Name) == 0 {
+		return ErrInvalidConsumer.Wrap("empty name")
+	}
+	return nil
+}
+
+// AllowsHeaders returns whether the consumer chain is allowed to have another
+// header indexed in the current block, given the number of its headers that
+// are already indexed in the current block
+func (cr *ConsumerRegister) AllowsHeaders(numIndexed uint64) bool {
+	return cr.MaxHeadersPerBlock == 0 || numIndexed < uint64(cr.MaxHeadersPerBlock)
+}
@@ -6,14 +6,20 @@ import (
 
 // x/zoneconcierge module sentinel errors
 var (
-	ErrInvalidVersion          = errorsmod.Register(ModuleName, 1101, "invalid version")
-	ErrHeaderNotFound          = errorsmod.Register(ModuleName, 1102, "no header exists at this height")
-	ErrInvalidHeader           = errorsmod.Register(ModuleName, 1103, "input header is invalid")
-	ErrChainInfoNotFound       = errorsmod.Register(ModuleName, 1104, "no chain info exists")
-	ErrEpochChainInfoNotFound  = errorsmod.Register(ModuleName, 1105, "no chain info exists at this epoch")
-	ErrEpochHeadersNotFound    = errorsmod.Register(ModuleName, 1106, "no timestamped header exists at this epoch")
-	ErrInvalidProofEpochSealed = errorsmod.Register(ModuleName, 1107, "invalid ProofEpochSealed")
-	ErrInvalidMerkleProof      = errorsmod.Register(ModuleName, 1108, "invalid Merkle inclusion proof")
-	ErrInvalidChainInfo        = errorsmod.Register(ModuleName, 1109, "invalid chain info")
-	ErrInvalidChainIDs         = errorsmod.Register(ModuleName, 1110, "chain ids contain duplicates or empty strings")
+	ErrInvalidVersion            = errorsmod.Register(ModuleName, 1101, "invalid version")
+	ErrHeaderNotFound            = errorsmod.Register(ModuleName, 1102, "no header exists at this height")
+	ErrInvalidHeader             = errorsmod.Register(ModuleName, 1103, "input header is invalid")
+	ErrChainInfoNotFound         = errorsmod.Register(ModuleName, 1104, "no chain info exists")
+	ErrEpochChainInfoNotFound    = errorsmod.Register(ModuleName, 1105, "no chain info exists at this epoch")
+	ErrEpochHeadersNotFound      = errorsmod.Register(ModuleName, 1106, "no timestamped header exists at this epoch")
+	ErrInvalidProofEpochSealed   = errorsmod.Register(ModuleName, 1107, "invalid ProofEpochSealed")
+	ErrInvalidMerkleProof        = errorsmod.Register(ModuleName, 1108, "invalid Merkle inclusion proof")
+	ErrInvalidChainInfo          = errorsmod.Register(ModuleName, 1109, "invalid chain info")
+	ErrInvalidChainIDs           = errorsmod.Register(ModuleName, 1110, "chain ids contain duplicates or empty strings")
+	ErrEpochNotFinalized         = errorsmod.Register(ModuleName, 1111, "the epoch is not finalized yet")
+	ErrInvalidConsumer           = errorsmod.Register(ModuleName, 1112, "invalid consumer registration")
+	ErrConsumerExists            = errorsmod.Register(ModuleName, 1113, "the consumer is already registered")
+	ErrConsumerNotRegistered     = errorsmod.Register(ModuleName, 1114, "the consumer is not registered")
+	ErrInterchainQueryNotAllowed = errorsmod.Register(ModuleName, 1115, "the interchain query is not allowed")
+	ErrHeaderNotVerified         = errorsmod.Register(ModuleName, 1116, "the header does not match any consensus state of the IBC light client")
 )
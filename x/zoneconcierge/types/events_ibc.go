@@ -2,8 +2,19 @@ package types
 
 // IBC events
 const (
-	EventTypeAck = "acknowledgement"
+	EventTypeAck                 = "acknowledgement"
+	EventTypeTimeout             = "timeout"
+	EventTypeBTCTimestampDropped = "btc_timestamp_dropped"
+	EventTypeHeaderSkipped       = "header_skipped"
+	EventTypeHeaderUnverified    = "header_unverified"
 
 	AttributeKeyAckSuccess = "success"
 	AttributeKeyAckError   = "error"
+	AttributeKeyChannelID  = "channel_id"
+	AttributeKeySequence   = "sequence"
+	AttributeKeyEpochNum   = "epoch_num"
+	AttributeKeyAttempts   = "attempts"
+	AttributeKeyChainID    = "chain_id"
+	AttributeKeyHeight     = "height"
+	AttributeKeyReason     = "reason"
 )
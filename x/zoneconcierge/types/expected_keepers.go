@@ -8,6 +8,7 @@ import (
 
 	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
 	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
 	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -53,11 +54,13 @@ type ChannelKeeper interface {
 	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
 	GetAllChannels(ctx sdk.Context) (channels []channeltypes.IdentifiedChannel)
 	GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, ibcexported.ClientState, error)
+	GetConnection(ctx sdk.Context, connectionID string) (ibcexported.ConnectionI, error)
 }
 
 // ClientKeeper defines the expected IBC client keeper
 type ClientKeeper interface {
 	GetClientState(ctx sdk.Context, clientID string) (ibcexported.ClientState, bool)
+	GetClientConsensusState(ctx sdk.Context, clientID string, height ibcexported.Height) (ibcexported.ConsensusState, bool)
 	SetClientState(ctx sdk.Context, clientID string, clientState ibcexported.ClientState)
 }
 
@@ -105,6 +108,13 @@ type EpochingKeeper interface {
 	GetEpoch(ctx context.Context) *epochingtypes.Epoch
 }
 
+type BTCStakingKeeper interface {
+	GetParams(ctx context.Context) bstypes.Params
+	GetParamsByVersion(ctx context.Context, v uint32) *bstypes.Params
+	GetVotingPowerDistCache(ctx context.Context, height uint64) (*bstypes.VotingPowerDistCache, error)
+	GetBTCDelegation(ctx context.Context, stakingTxHashStr string) (*bstypes.BTCDelegation, error)
+}
+
 // CometClient is a Comet client that allows to query tx inclusion proofs
 type CometClient interface {
 	Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error)
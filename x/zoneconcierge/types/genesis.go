@@ -29,5 +29,15 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	consumerIDs := make(map[string]struct{}, len(gs.Consumers))
+	for _, consumer := range gs.Consumers {
+		if err := consumer.Validate(); err != nil {
+			return err
+		}
+		if _, ok := consumerIDs[consumer.ConsumerId]; ok {
+			return ErrInvalidConsumer.Wrapf("duplicate consumer ID %s", consumer.ConsumerId)
+		}
+		consumerIDs[consumer.ConsumerId] = struct{}{}
+	}
 	return nil
 }
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"bytes"
+
+	"cosmossdk.io/store/rootmulti"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bbn "github.com/babylonchain/babylon/types"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// AllowedInterchainQueries is the whitelist of KV pairs that consumer chains
+// can query via interchain queries, where the key is the store key of a module
+// and the value is the list of allowed key prefixes in the module's store.
+// The key layouts of the whitelisted KV pairs are a stable API for consumer
+// chains, and are constructed by the ICQKey* functions below.
+var AllowedInterchainQueries = map[string][][]byte{
+	btclctypes.StoreKey: {
+		btclctypes.HeadersObjectPrefix,
+	},
+	bstypes.StoreKey: {
+		bstypes.FinalityProviderKey,
+		bstypes.VotingPowerKey,
+		bstypes.BTCHeightKey,
+	},
+}
+
+const (
+	// MaxInterchainQueryKeyLength is the maximum length of the key in an
+	// interchain query, which exceeds the length of all whitelisted keys
+	MaxInterchainQueryKeyLength = 128
+	// GasInterchainQuery is the gas of serving an interchain query on top of
+	// the per-byte read cost of the KV pair and its Merkle proof, as the
+	// query is served from the committed multistore, which is not metered
+	// by the gas meter of the IBC packet
+	GasInterchainQuery uint64 = 10_000
+)
+
+// ICQKeyBTCHeader returns the key of the BTC header at the given BTC height
+// in the btclightclient store
+// key: HeadersObjectPrefix || BigEndianUint64(btcHeight)
+// value: BTCHeaderInfo
+func ICQKeyBTCHeader(btcHeight uint64) []byte {
+	return append(bytes.Clone(btclctypes.HeadersObjectPrefix), btclctypes.HeadersObjectKey(btcHeight)...)
+}
+
+// ICQKeyBTCTipHeight returns the key of the height of the BTC tip at the given
+// Babylon height in the btcstaking store. Together with ICQKeyBTCHeader, a
+// consumer chain gets the BTC tip at the given Babylon height.
+// key: BTCHeightKey || BigEndianUint64(babylonHeight)
+// value: BigEndianUint64(btcTipHeight)
+func ICQKeyBTCTipHeight(babylonHeight uint64) []byte {
+	return append(bytes.Clone(bstypes.BTCHeightKey), sdk.Uint64ToBigEndian(babylonHeight)...)
+}
+
+// ICQKeyFinalityProvider returns the key of the finality provider with the
+// given BTC PK in the btcstaking store
+// key: FinalityProviderKey || fpBTCPK
+// value: FinalityProvider
+func ICQKeyFinalityProvider(fpBTCPK *bbn.BIP340PubKey) []byte {
+	return append(bytes.Clone(bstypes.FinalityProviderKey), fpBTCPK.MustMarshal()...)
+}
+
+// ICQKeyVotingPower returns the key of the voting power of the finality
+// provider with the given BTC PK at the given Babylon height in the btcstaking
+// store. The key does not exist if the finality provider is not in the voting
+// power table at this height.
+// key: VotingPowerKey || BigEndianUint64(babylonHeight) || fpBTCPK
+// value: BigEndianUint64(votingPower)
+func ICQKeyVotingPower(babylonHeight uint64, fpBTCPK *bbn.BIP340PubKey) []byte {
+	key := append(bytes.Clone(bstypes.VotingPowerKey), sdk.Uint64ToBigEndian(babylonHeight)...)
+	return append(key, fpBTCPK.MustMarshal()...)
+}
+
+// ValidateBasic ensures that the interchain query is for a KV pair whitelisted
+// in AllowedInterchainQueries, and that its key is bounded
+func (req *InterchainQueryRequest) ValidateBasic() error {
+	if len(req.Key) > MaxInterchainQueryKeyLength {
+		return ErrInterchainQueryNotAllowed.Wrapf("key is longer than %d bytes", MaxInterchainQueryKeyLength)
+	}
+	prefixes, ok := AllowedInterchainQueries[req.StoreKey]
+	if !ok {
+		return ErrInterchainQueryNotAllowed.Wrapf("store %s is not queryable", req.StoreKey)
+	}
+	for _, prefix := range prefixes {
+		// the key has to be strictly longer than the prefix, so that an
+		// interchain query cannot be for the entire prefix store
+		if len(req.Key) > len(prefix) && bytes.HasPrefix(req.Key, prefix) {
+			return nil
+		}
+	}
+	return ErrInterchainQueryNotAllowed.Wrapf("key %x in store %s is not queryable", req.Key, req.StoreKey)
+}
+
+// Verify verifies that the KV pair in the response is committed to the given
+// app hash of Babylon at the height of the response, and is for the given
+// interchain query
+func (resp *InterchainQueryResponse) Verify(req *InterchainQueryRequest, appHash []byte) error {
+	if !bytes.Equal(resp.Key, req.Key) {
+		return ErrInvalidMerkleProof.Wrapf("the response is for key %x rather than the queried key %x", resp.Key, req.Key)
+	}
+	if resp.Proof == nil {
+		return ErrInvalidMerkleProof.Wrap("empty proof")
+	}
+
+	keypath := merkle.KeyPath{}
+	keypath = keypath.AppendKey([]byte(req.StoreKey), merkle.KeyEncodingURL)
+	keypath = keypath.AppendKey(resp.Key, merkle.KeyEncodingURL)
+
+	// unlike VerifyStore, a non-empty value has to come with a membership
+	// proof, and an empty value has to come with an absence proof
+	prt := rootmulti.DefaultProofRuntime()
+	if len(resp.Value) > 0 {
+		if err := prt.VerifyValue(resp.Proof, appHash, keypath.String(), resp.Value); err != nil {
+			return ErrInvalidMerkleProof.Wrapf("invalid membership proof: %v", err)
+		}
+	} else {
+		if err := prt.VerifyAbsence(resp.Proof, appHash, keypath.String()); err != nil {
+			return ErrInvalidMerkleProof.Wrapf("invalid absence proof: %v", err)
+		}
+	}
+	return nil
+}
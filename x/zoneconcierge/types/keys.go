@@ -28,15 +28,26 @@ const (
 )
 
 var (
-	PortKey               = []byte{0x11} // PortKey defines the key to store the port ID in store
-	ChainInfoKey          = []byte{0x12} // ChainInfoKey defines the key to store the chain info for each CZ in store
-	CanonicalChainKey     = []byte{0x13} // CanonicalChainKey defines the key to store the canonical chain for each CZ in store
-	ForkKey               = []byte{0x14} // ForkKey defines the key to store the forks for each CZ in store
-	EpochChainInfoKey     = []byte{0x15} // EpochChainInfoKey defines the key to store each epoch's latests chain info for each CZ in store
-	LastSentBTCSegmentKey = []byte{0x16} // LastSentBTCSegmentKey is key holding last btc light client segment sent to other cosmos zones
-	ParamsKey             = []byte{0x17} // key prefix for the parameters
+	PortKey                 = []byte{0x11} // PortKey defines the key to store the port ID in store
+	ChainInfoKey            = []byte{0x12} // ChainInfoKey defines the key to store the chain info for each CZ in store
+	CanonicalChainKey       = []byte{0x13} // CanonicalChainKey defines the key to store the canonical chain for each CZ in store
+	ForkKey                 = []byte{0x14} // ForkKey defines the key to store the forks for each CZ in store
+	EpochChainInfoKey       = []byte{0x15} // EpochChainInfoKey defines the key to store each epoch's latests chain info for each CZ in store
+	LastSentBTCSegmentKey   = []byte{0x16} // LastSentBTCSegmentKey is key holding last btc light client segment sent to other cosmos zones
+	ParamsKey               = []byte{0x17} // key prefix for the parameters
+	InFlightBTCTimestampKey = []byte{0x18} // key prefix for the BTC timestamps in IBC packets that are not acknowledged yet
+	BTCTimestampRetryKey    = []byte{0x19} // key prefix for the BTC timestamps queued for retry
+	ConsumerRegisterKey     = []byte{0x1a} // key prefix for the consumer registry
+	ConsumerHeaderCountKey  = []byte{0x1b} // key prefix for the number of headers of each consumer indexed in the current block
 )
 
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+// ChannelIDKey returns the key prefix of the given IBC channel ID. The channel
+// ID is prefixed with its length, so that the keys of different channels never
+// prefix each other
+func ChannelIDKey(channelID string) []byte {
+	return append([]byte{byte(len(channelID))}, []byte(channelID)...)
+}
@@ -9,6 +9,7 @@ import (
 	types "github.com/babylonchain/babylon/x/btclightclient/types"
 	types2 "github.com/babylonchain/babylon/x/checkpointing/types"
 	types1 "github.com/babylonchain/babylon/x/epoching/types"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -33,6 +34,7 @@ type ZoneconciergePacketData struct {
 	//
 	// Types that are valid to be assigned to Packet:
 	//	*ZoneconciergePacketData_BtcTimestamp
+	//	*ZoneconciergePacketData_IcqRequest
 	Packet isZoneconciergePacketData_Packet `protobuf_oneof:"packet"`
 }
 
@@ -78,8 +80,12 @@ type isZoneconciergePacketData_Packet interface {
 type ZoneconciergePacketData_BtcTimestamp struct {
 	BtcTimestamp *BTCTimestamp `protobuf:"bytes,1,opt,name=btc_timestamp,json=btcTimestamp,proto3,oneof" json:"btc_timestamp,omitempty"`
 }
+type ZoneconciergePacketData_IcqRequest struct {
+	IcqRequest *InterchainQueryRequest `protobuf:"bytes,2,opt,name=icq_request,json=icqRequest,proto3,oneof" json:"icq_request,omitempty"`
+}
 
 func (*ZoneconciergePacketData_BtcTimestamp) isZoneconciergePacketData_Packet() {}
+func (*ZoneconciergePacketData_IcqRequest) isZoneconciergePacketData_Packet()   {}
 
 func (m *ZoneconciergePacketData) GetPacket() isZoneconciergePacketData_Packet {
 	if m != nil {
@@ -95,10 +101,18 @@ func (m *ZoneconciergePacketData) GetBtcTimestamp() *BTCTimestamp {
 	return nil
 }
 
+func (m *ZoneconciergePacketData) GetIcqRequest() *InterchainQueryRequest {
+	if x, ok := m.GetPacket().(*ZoneconciergePacketData_IcqRequest); ok {
+		return x.IcqRequest
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ZoneconciergePacketData) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ZoneconciergePacketData_BtcTimestamp)(nil),
+		(*ZoneconciergePacketData_IcqRequest)(nil),
 	}
 }
 
@@ -124,6 +138,10 @@ type BTCTimestamp struct {
 	//
 	//Proofs that the header is finalized
 	Proof *ProofFinalizedChainInfo `protobuf:"bytes,6,opt,name=proof,proto3" json:"proof,omitempty"`
+	// covenant_attestation is the covenant attestation over the BTC delegations
+	// backing the BTC staking power upon the epoch being finalised. It is only
+	// included if enabled in the module's params
+	CovenantAttestation *CovenantAttestation `protobuf:"bytes,7,opt,name=covenant_attestation,json=covenantAttestation,proto3" json:"covenant_attestation,omitempty"`
 }
 
 func (m *BTCTimestamp) Reset()         { *m = BTCTimestamp{} }
@@ -201,9 +219,152 @@ func (m *BTCTimestamp) GetProof() *ProofFinalizedChainInfo {
 	return nil
 }
 
+func (m *BTCTimestamp) GetCovenantAttestation() *CovenantAttestation {
+	if m != nil {
+		return m.CovenantAttestation
+	}
+	return nil
+}
+
+// InterchainQueryRequest is a query that a consumer chain sends to Babylon
+// for a KV pair in a whitelisted store of Babylon, e.g., the BTC tip or the
+// voting power table. The KV pair is returned in the acknowledgement along
+// with its Merkle proof against the app hash of Babylon.
+type InterchainQueryRequest struct {
+	// store_key is the store key of the queried module, e.g., btcstaking
+	StoreKey string `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	// key is the full key of the queried KV pair in the module's store,
+	// including the prefix
+	Key []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *InterchainQueryRequest) Reset()         { *m = InterchainQueryRequest{} }
+func (m *InterchainQueryRequest) String() string { return proto.CompactTextString(m) }
+func (*InterchainQueryRequest) ProtoMessage()    {}
+func (*InterchainQueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be12e124c5c4fdb9, []int{2}
+}
+func (m *InterchainQueryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterchainQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterchainQueryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterchainQueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterchainQueryRequest.Merge(m, src)
+}
+func (m *InterchainQueryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterchainQueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterchainQueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterchainQueryRequest proto.InternalMessageInfo
+
+func (m *InterchainQueryRequest) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *InterchainQueryRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+// InterchainQueryResponse is the result of an InterchainQueryRequest
+type InterchainQueryResponse struct {
+	// height is the Babylon height whose header commits to the app hash that
+	// the proof is verified against
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// key is the full key of the queried KV pair
+	Key []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the value of the queried KV pair, which is empty if the key
+	// does not exist
+	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// proof is the Merkle proof of the membership or absence of the KV pair
+	Proof *crypto.ProofOps `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *InterchainQueryResponse) Reset()         { *m = InterchainQueryResponse{} }
+func (m *InterchainQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*InterchainQueryResponse) ProtoMessage()    {}
+func (*InterchainQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be12e124c5c4fdb9, []int{3}
+}
+func (m *InterchainQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterchainQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterchainQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterchainQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterchainQueryResponse.Merge(m, src)
+}
+func (m *InterchainQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterchainQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterchainQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterchainQueryResponse proto.InternalMessageInfo
+
+func (m *InterchainQueryResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *InterchainQueryResponse) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *InterchainQueryResponse) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *InterchainQueryResponse) GetProof() *crypto.ProofOps {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ZoneconciergePacketData)(nil), "babylon.zoneconcierge.v1.ZoneconciergePacketData")
 	proto.RegisterType((*BTCTimestamp)(nil), "babylon.zoneconcierge.v1.BTCTimestamp")
+	proto.RegisterType((*InterchainQueryRequest)(nil), "babylon.zoneconcierge.v1.InterchainQueryRequest")
+	proto.RegisterType((*InterchainQueryResponse)(nil), "babylon.zoneconcierge.v1.InterchainQueryResponse")
 }
 
 func init() {
@@ -211,37 +372,48 @@ func init() {
 }
 
 var fileDescriptor_be12e124c5c4fdb9 = []byte{
-	// 471 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x1b, 0xca, 0x2a, 0x70, 0x37, 0x84, 0x7c, 0x21, 0xda, 0x21, 0x9a, 0x2a, 0x01, 0x45,
-	0x9a, 0x1c, 0x65, 0x88, 0x03, 0x27, 0xa4, 0x96, 0x3f, 0xab, 0x10, 0x30, 0x85, 0x71, 0xd9, 0xa5,
-	0xb2, 0xdd, 0xb7, 0x8d, 0xd5, 0xd6, 0x8e, 0x12, 0xaf, 0x5b, 0xf7, 0x29, 0xf8, 0x52, 0x48, 0x1c,
-	0x77, 0xe4, 0x88, 0xda, 0x2f, 0x82, 0xec, 0xfc, 0x69, 0x52, 0x94, 0x4b, 0xe4, 0xf7, 0xc9, 0xcf,
-	0x8f, 0xfd, 0x3e, 0x7e, 0xd1, 0x73, 0x46, 0xd9, 0x7a, 0xa1, 0xa4, 0x7f, 0xa7, 0x24, 0x70, 0x25,
-	0xb9, 0x80, 0x64, 0x06, 0xfe, 0x2a, 0xf0, 0x63, 0xca, 0xe7, 0xa0, 0x49, 0x9c, 0x28, 0xad, 0xb0,
-	0x9b, 0x63, 0xa4, 0x86, 0x91, 0x55, 0x70, 0x7c, 0x5a, 0x18, 0x30, 0xcd, 0x79, 0x04, 0x7c, 0x1e,
-	0x2b, 0x21, 0xb5, 0x31, 0xa8, 0x09, 0x99, 0xcf, 0xf1, 0xab, 0x82, 0xde, 0xfd, 0x11, 0x72, 0x66,
-	0xe8, 0xff, 0x50, 0x52, 0x31, 0x5e, 0x88, 0x59, 0x64, 0xbe, 0x50, 0x3a, 0x57, 0x94, 0x9c, 0xef,
-	0x15, 0x3c, 0xc4, 0x8a, 0x47, 0xb9, 0x6b, 0xb1, 0xce, 0x99, 0xd3, 0xc6, 0x6e, 0xeb, 0x7d, 0x59,
-	0xba, 0x97, 0xa0, 0x67, 0x57, 0x55, 0xf9, 0xc2, 0x26, 0xf2, 0x9e, 0x6a, 0x8a, 0xbf, 0xa0, 0x23,
-	0xa6, 0xf9, 0x58, 0x8b, 0x25, 0xa4, 0x9a, 0x2e, 0x63, 0xd7, 0x39, 0x71, 0xfa, 0xdd, 0xb3, 0x17,
-	0xa4, 0x29, 0x27, 0x32, 0xb8, 0x1c, 0x5e, 0x16, 0xf4, 0x79, 0x2b, 0x3c, 0x64, 0x9a, 0x97, 0xf5,
-	0xe0, 0x11, 0xea, 0x64, 0x71, 0xf7, 0x7e, 0xb5, 0xd1, 0x61, 0x15, 0xc5, 0xef, 0x50, 0x27, 0x02,
-	0x3a, 0x81, 0x24, 0x3f, 0xe2, 0x65, 0xf3, 0x11, 0x23, 0x39, 0x81, 0x5b, 0x98, 0x9c, 0x5b, 0x3c,
-	0xcc, 0xb7, 0xe1, 0x11, 0xea, 0x9a, 0xab, 0x66, 0x55, 0xea, 0x3e, 0x38, 0x69, 0xf7, 0xbb, 0x67,
-	0xfd, 0xd2, 0x65, 0x2f, 0xcb, 0xec, 0xa6, 0x99, 0xc5, 0x48, 0x4e, 0x55, 0x88, 0x98, 0xe6, 0x59,
-	0x99, 0xe2, 0xb7, 0x08, 0xd9, 0x40, 0xc7, 0x42, 0x4e, 0x95, 0xdb, 0xb6, 0xf7, 0x29, 0xdf, 0x89,
-	0x94, 0x59, 0xaf, 0x02, 0xf2, 0xc1, 0xac, 0xc3, 0xc7, 0x56, 0x32, 0x36, 0xf8, 0x2b, 0x7a, 0x92,
-	0xd0, 0x9b, 0xf1, 0xee, 0x95, 0xdd, 0x87, 0x7b, 0xed, 0xd4, 0x26, 0xc2, 0x78, 0x84, 0xf4, 0x66,
-	0x58, 0x6a, 0xe1, 0x51, 0x52, 0x2d, 0xf1, 0x0f, 0x84, 0x4d, 0x57, 0xe9, 0x35, 0x5b, 0x8a, 0x34,
-	0x15, 0x4a, 0x8e, 0xe7, 0xb0, 0x76, 0x0f, 0xf6, 0x3c, 0xeb, 0x23, 0xb8, 0x0a, 0xc8, 0xf7, 0x92,
-	0xff, 0x0c, 0xeb, 0xf0, 0x29, 0xd3, 0xbc, 0xa6, 0xe0, 0x4f, 0xe8, 0x20, 0x4e, 0x94, 0x9a, 0xba,
-	0x1d, 0xeb, 0x14, 0x34, 0x87, 0x7d, 0x61, 0xb0, 0x8f, 0x42, 0xd2, 0x85, 0xb8, 0x83, 0xc9, 0x30,
-	0xa2, 0x42, 0xda, 0xbc, 0xb2, 0xfd, 0x83, 0x6f, 0xbf, 0x37, 0x9e, 0x73, 0xbf, 0xf1, 0x9c, 0xbf,
-	0x1b, 0xcf, 0xf9, 0xb9, 0xf5, 0x5a, 0xf7, 0x5b, 0xaf, 0xf5, 0x67, 0xeb, 0xb5, 0xae, 0xde, 0xcc,
-	0x84, 0x8e, 0xae, 0x19, 0xe1, 0x6a, 0xe9, 0xe7, 0xee, 0xdc, 0xec, 0x2e, 0x0a, 0xff, 0x76, 0x6f,
-	0x3a, 0xf5, 0x3a, 0x86, 0x94, 0x75, 0xec, 0x4c, 0xbe, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0x31,
-	0xea, 0x29, 0xbc, 0xb1, 0x03, 0x00, 0x00,
+	// 654 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x41, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0xbb, 0x14, 0x2a, 0x4c, 0x8b, 0x21, 0x23, 0x81, 0x0d, 0xc4, 0x86, 0x34, 0x51, 0x31,
+	0xc1, 0xad, 0xc5, 0x78, 0xf0, 0x64, 0xa4, 0x2a, 0x10, 0xa3, 0xe0, 0x80, 0x17, 0x2e, 0x75, 0x76,
+	0xfa, 0x68, 0x27, 0xb4, 0x33, 0xcb, 0xee, 0xb4, 0x50, 0x3e, 0x83, 0x07, 0xbf, 0x85, 0x1f, 0x45,
+	0x8f, 0x1c, 0x3d, 0x1a, 0xfa, 0x45, 0xcc, 0xcc, 0xce, 0x6e, 0xbb, 0xad, 0xf5, 0xd2, 0xcc, 0x7b,
+	0xfd, 0xbf, 0xdf, 0xce, 0x7b, 0xef, 0xbf, 0x8b, 0x1e, 0xf9, 0xd4, 0x1f, 0x74, 0xa4, 0xa8, 0xde,
+	0x48, 0x01, 0x4c, 0x0a, 0xc6, 0x21, 0x6c, 0x41, 0xb5, 0x5f, 0xab, 0x06, 0x94, 0x5d, 0x80, 0xf2,
+	0x82, 0x50, 0x2a, 0x89, 0x5d, 0x2b, 0xf3, 0x32, 0x32, 0xaf, 0x5f, 0xdb, 0xd8, 0x49, 0x00, 0xbe,
+	0x62, 0xac, 0x0d, 0xec, 0x22, 0x90, 0x5c, 0x28, 0x0d, 0xc8, 0x24, 0x62, 0xce, 0xc6, 0xd3, 0x44,
+	0x3d, 0xfa, 0x87, 0x8b, 0x96, 0x56, 0x4f, 0x49, 0xbd, 0x31, 0x70, 0x87, 0xb7, 0xda, 0xfa, 0x17,
+	0x52, 0xf2, 0x58, 0xc6, 0xea, 0x2b, 0x89, 0x1e, 0x02, 0xc9, 0xda, 0x96, 0x9a, 0x9c, 0xad, 0xe6,
+	0xa1, 0x02, 0xd1, 0x84, 0xb0, 0xab, 0x6f, 0xc8, 0xc2, 0x41, 0xa0, 0x64, 0x35, 0x08, 0xa5, 0x3c,
+	0xb7, 0x7f, 0xef, 0xcc, 0x1c, 0x46, 0xb6, 0x6d, 0xa3, 0xae, 0xfc, 0x74, 0xd0, 0xfa, 0xd9, 0x78,
+	0xfe, 0xd8, 0x4c, 0xec, 0x2d, 0x55, 0x14, 0x7f, 0x44, 0xcb, 0xbe, 0x62, 0x0d, 0xc5, 0xbb, 0x10,
+	0x29, 0xda, 0x0d, 0x5c, 0x67, 0xcb, 0xd9, 0x2e, 0xee, 0x3e, 0xf6, 0x66, 0xcd, 0xd1, 0xdb, 0x3b,
+	0xad, 0x9f, 0x26, 0xea, 0x83, 0x1c, 0x29, 0xf9, 0x8a, 0xa5, 0x31, 0x3e, 0x41, 0x45, 0xce, 0x2e,
+	0x1b, 0x21, 0x5c, 0xf6, 0x20, 0x52, 0xee, 0x9c, 0x81, 0x3d, 0x9f, 0x0d, 0x3b, 0x14, 0x0a, 0x42,
+	0xd6, 0xa6, 0x5c, 0x7c, 0xee, 0x41, 0x38, 0x20, 0x71, 0xdd, 0x41, 0x8e, 0x20, 0xce, 0x2e, 0x6d,
+	0xb4, 0xb7, 0x88, 0x0a, 0xf1, 0x8e, 0x2b, 0x3f, 0xe6, 0x51, 0x69, 0xfc, 0xf9, 0xf8, 0x35, 0x2a,
+	0xb4, 0x81, 0x36, 0x21, 0xb4, 0xf7, 0x7e, 0xf2, 0xbf, 0x47, 0x35, 0xe1, 0x1a, 0x9a, 0x07, 0x46,
+	0x4e, 0x6c, 0x19, 0x3e, 0x44, 0x45, 0xdd, 0x7f, 0x1c, 0x45, 0xee, 0xdc, 0x56, 0x7e, 0xbb, 0xb8,
+	0xbb, 0x9d, 0x52, 0x26, 0x16, 0x18, 0xb7, 0x1f, 0x23, 0x0e, 0xc5, 0xb9, 0x24, 0xc8, 0x57, 0x2c,
+	0x0e, 0x23, 0xfc, 0x0a, 0x21, 0xb3, 0xc5, 0x06, 0x17, 0xe7, 0xd2, 0xcd, 0x9b, 0xfb, 0xa4, 0xe6,
+	0xf0, 0xd2, 0x05, 0xf7, 0x6b, 0xde, 0x3b, 0x7d, 0x26, 0x4b, 0x26, 0xa5, 0x31, 0xf8, 0x13, 0xba,
+	0x1f, 0xd2, 0xab, 0xc6, 0xc8, 0x5a, 0xee, 0xfc, 0x44, 0x3b, 0x19, 0x1b, 0x6a, 0x06, 0xa1, 0x57,
+	0xf5, 0x34, 0x47, 0x96, 0xc3, 0xf1, 0x10, 0x7f, 0x41, 0x58, 0x77, 0x15, 0xf5, 0xfc, 0x2e, 0x8f,
+	0x22, 0x2e, 0x45, 0xe3, 0x02, 0x06, 0xee, 0xc2, 0x04, 0x33, 0xeb, 0xfb, 0x7e, 0xcd, 0x3b, 0x49,
+	0xf5, 0x1f, 0x60, 0x40, 0x56, 0x7c, 0xc5, 0x32, 0x19, 0xbc, 0x8f, 0x16, 0x8c, 0x0b, 0xdd, 0x82,
+	0x21, 0xd5, 0x66, 0x0f, 0xfb, 0x58, 0xcb, 0xde, 0x73, 0x41, 0x3b, 0xfc, 0x06, 0x9a, 0x75, 0xbd,
+	0x60, 0x33, 0xaf, 0xb8, 0x1e, 0x7f, 0x45, 0xab, 0x4c, 0xf6, 0x41, 0x50, 0xa1, 0x1a, 0x54, 0x29,
+	0xbd, 0x4c, 0xc5, 0xa5, 0x70, 0xef, 0x19, 0xee, 0xb3, 0xd9, 0xdc, 0xba, 0xad, 0x7a, 0x33, 0x2a,
+	0x22, 0x0f, 0xd8, 0x74, 0xb2, 0xb2, 0x8f, 0xd6, 0xfe, 0xed, 0x2d, 0xbc, 0x89, 0x96, 0x22, 0x25,
+	0x43, 0x30, 0x23, 0xd1, 0xae, 0x59, 0x22, 0x8b, 0x26, 0xa1, 0x3b, 0x5c, 0x41, 0x79, 0x9d, 0xd6,
+	0xbe, 0x2d, 0x11, 0x7d, 0xac, 0x7c, 0x73, 0xd0, 0xfa, 0x14, 0x29, 0x0a, 0xa4, 0x88, 0x00, 0xaf,
+	0x69, 0xf7, 0x69, 0x7b, 0x18, 0x4e, 0x9e, 0xd8, 0x68, 0x9a, 0x82, 0x57, 0xd1, 0x42, 0x9f, 0x76,
+	0x7a, 0x60, 0x6c, 0x51, 0x22, 0x71, 0x80, 0x6b, 0xc9, 0x3c, 0xe3, 0x6d, 0x6f, 0x7a, 0xa3, 0xb7,
+	0xde, 0x8b, 0xdf, 0xfa, 0x78, 0x90, 0x47, 0x41, 0x64, 0x27, 0xb7, 0x77, 0xf4, 0xeb, 0xae, 0xec,
+	0xdc, 0xde, 0x95, 0x9d, 0x3f, 0x77, 0x65, 0xe7, 0xfb, 0xb0, 0x9c, 0xbb, 0x1d, 0x96, 0x73, 0xbf,
+	0x87, 0xe5, 0xdc, 0xd9, 0xcb, 0x16, 0x57, 0xed, 0x9e, 0xef, 0x31, 0xd9, 0xad, 0xda, 0xf9, 0x99,
+	0x2b, 0x27, 0x41, 0xf5, 0x7a, 0xe2, 0x6b, 0xa1, 0x06, 0x01, 0x44, 0x7e, 0xc1, 0x7c, 0x23, 0x5e,
+	0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x05, 0x6d, 0x4c, 0x53, 0x60, 0x05, 0x00, 0x00,
 }
 
 func (m *ZoneconciergePacketData) Marshal() (dAtA []byte, err error) {
@@ -297,6 +469,27 @@ func (m *ZoneconciergePacketData_BtcTimestamp) MarshalToSizedBuffer(dAtA []byte)
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ZoneconciergePacketData_IcqRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZoneconciergePacketData_IcqRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.IcqRequest != nil {
+		{
+			size, err := m.IcqRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
 func (m *BTCTimestamp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -317,6 +510,18 @@ func (m *BTCTimestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CovenantAttestation != nil {
+		{
+			size, err := m.CovenantAttestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.Proof != nil {
 		{
 			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
@@ -394,6 +599,97 @@ func (m *BTCTimestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InterchainQueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterchainQueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterchainQueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InterchainQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterchainQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterchainQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPacket(v)
 	base := offset
@@ -429,6 +725,18 @@ func (m *ZoneconciergePacketData_BtcTimestamp) Size() (n int) {
 	}
 	return n
 }
+func (m *ZoneconciergePacketData_IcqRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IcqRequest != nil {
+		l = m.IcqRequest.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
 func (m *BTCTimestamp) Size() (n int) {
 	if m == nil {
 		return 0
@@ -461,6 +769,51 @@ func (m *BTCTimestamp) Size() (n int) {
 		l = m.Proof.Size()
 		n += 1 + l + sovPacket(uint64(l))
 	}
+	if m.CovenantAttestation != nil {
+		l = m.CovenantAttestation.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *InterchainQueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *InterchainQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovPacket(uint64(m.Height))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
 	return n
 }
 
@@ -534,6 +887,41 @@ func (m *ZoneconciergePacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Packet = &ZoneconciergePacketData_BtcTimestamp{v}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcqRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &InterchainQueryRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &ZoneconciergePacketData_IcqRequest{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -798,6 +1186,331 @@ func (m *BTCTimestamp) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantAttestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CovenantAttestation == nil {
+				m.CovenantAttestation = &CovenantAttestation{}
+			}
+			if err := m.CovenantAttestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterchainQueryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainQueryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterchainQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.ProofOps{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -7,6 +7,15 @@ import (
 const (
 	DefaultIbcPacketTimeoutSeconds uint32 = 60 * 60 * 24       // 24 hours
 	MaxIbcPacketTimeoutSeconds     uint32 = 60 * 60 * 24 * 365 // 1 year
+	DefaultMaxBTCTimestampRetries  uint32 = 3
+	MaxMaxBTCTimestampRetries      uint32 = 100
+	// DefaultEpochChainInfoRetention disables pruning the chain info
+	// snapshots by default
+	DefaultEpochChainInfoRetention uint32 = 0
+	// DefaultMaxCovenantAttestationDelegations bounds the size of the
+	// covenant attestation in a BTC timestamp packet
+	DefaultMaxCovenantAttestationDelegations uint32 = 1000
+	MaxMaxCovenantAttestationDelegations     uint32 = 10000
 )
 
 // NewParams creates a new Params instance
@@ -18,7 +27,11 @@ func NewParams(ibcPacketTimeoutSeconds uint32) Params {
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultIbcPacketTimeoutSeconds)
+	p := NewParams(DefaultIbcPacketTimeoutSeconds)
+	p.MaxBtcTimestampRetries = DefaultMaxBTCTimestampRetries
+	p.EpochChainInfoRetention = DefaultEpochChainInfoRetention
+	p.MaxCovenantAttestationDelegations = DefaultMaxCovenantAttestationDelegations
+	return p
 }
 
 // Validate validates the set of params
@@ -29,6 +42,15 @@ func (p Params) Validate() error {
 	if p.IbcPacketTimeoutSeconds > MaxIbcPacketTimeoutSeconds {
 		return fmt.Errorf("IbcPacketTimeoutSeconds must be no larger than %d", MaxIbcPacketTimeoutSeconds)
 	}
+	if p.MaxBtcTimestampRetries > MaxMaxBTCTimestampRetries {
+		return fmt.Errorf("MaxBtcTimestampRetries must be no larger than %d", MaxMaxBTCTimestampRetries)
+	}
+	if p.IncludeCovenantAttestation && p.MaxCovenantAttestationDelegations == 0 {
+		return fmt.Errorf("MaxCovenantAttestationDelegations must be positive if IncludeCovenantAttestation is enabled")
+	}
+	if p.MaxCovenantAttestationDelegations > MaxMaxCovenantAttestationDelegations {
+		return fmt.Errorf("MaxCovenantAttestationDelegations must be no larger than %d", MaxMaxCovenantAttestationDelegations)
+	}
 
 	return nil
 }
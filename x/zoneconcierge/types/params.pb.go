@@ -28,6 +28,26 @@ type Params struct {
 	// ibc_packet_timeout_seconds is the time period after which an unrelayed
 	// IBC packet becomes timeout, measured in seconds
 	IbcPacketTimeoutSeconds uint32 `protobuf:"varint,1,opt,name=ibc_packet_timeout_seconds,json=ibcPacketTimeoutSeconds,proto3" json:"ibc_packet_timeout_seconds,omitempty" yaml:"ibc_packet_timeout_seconds"`
+	// include_covenant_attestation indicates whether BTC timestamps include the
+	// covenant attestation over the BTC delegations backing the BTC staking power
+	IncludeCovenantAttestation bool `protobuf:"varint,2,opt,name=include_covenant_attestation,json=includeCovenantAttestation,proto3" json:"include_covenant_attestation,omitempty" yaml:"include_covenant_attestation"`
+	// max_btc_timestamp_retries is the maximum number of times a BTC timestamp
+	// is re-sent to a consumer chain after it fails to be sent, is rejected by
+	// the consumer chain, or times out. Zero disables retries
+	MaxBtcTimestampRetries uint32 `protobuf:"varint,3,opt,name=max_btc_timestamp_retries,json=maxBtcTimestampRetries,proto3" json:"max_btc_timestamp_retries,omitempty" yaml:"max_btc_timestamp_retries"`
+	// permissioned_channels indicates whether only consumer chains in the
+	// consumer registry can open IBC channels with the zoneconcierge module
+	PermissionedChannels bool `protobuf:"varint,4,opt,name=permissioned_channels,json=permissionedChannels,proto3" json:"permissioned_channels,omitempty" yaml:"permissioned_channels"`
+	// epoch_chain_info_retention is the number of the latest finalised epochs
+	// whose snapshots of chain info are retained. Upon an epoch being finalised,
+	// the snapshots of chain info of all epochs that are this number of epochs
+	// or more older are pruned. Zero disables pruning
+	EpochChainInfoRetention uint32 `protobuf:"varint,5,opt,name=epoch_chain_info_retention,json=epochChainInfoRetention,proto3" json:"epoch_chain_info_retention,omitempty" yaml:"epoch_chain_info_retention"`
+	// max_covenant_attestation_delegations is the maximum number of BTC
+	// delegations in the covenant attestation of a BTC timestamp. BTC
+	// delegations under finality providers with more voting power are included
+	// first. It has to be positive if include_covenant_attestation is enabled
+	MaxCovenantAttestationDelegations uint32 `protobuf:"varint,6,opt,name=max_covenant_attestation_delegations,json=maxCovenantAttestationDelegations,proto3" json:"max_covenant_attestation_delegations,omitempty" yaml:"max_covenant_attestation_delegations"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -70,6 +90,41 @@ func (m *Params) GetIbcPacketTimeoutSeconds() uint32 {
 	return 0
 }
 
+func (m *Params) GetIncludeCovenantAttestation() bool {
+	if m != nil {
+		return m.IncludeCovenantAttestation
+	}
+	return false
+}
+
+func (m *Params) GetMaxBtcTimestampRetries() uint32 {
+	if m != nil {
+		return m.MaxBtcTimestampRetries
+	}
+	return 0
+}
+
+func (m *Params) GetPermissionedChannels() bool {
+	if m != nil {
+		return m.PermissionedChannels
+	}
+	return false
+}
+
+func (m *Params) GetEpochChainInfoRetention() uint32 {
+	if m != nil {
+		return m.EpochChainInfoRetention
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCovenantAttestationDelegations() uint32 {
+	if m != nil {
+		return m.MaxCovenantAttestationDelegations
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.zoneconcierge.v1.Params")
 }
@@ -79,22 +134,36 @@ func init() {
 }
 
 var fileDescriptor_c0696c936eb15fe4 = []byte{
-	// 227 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0xaf, 0xca, 0xcf, 0x4b, 0x4d, 0xce, 0xcf, 0x4b, 0xce, 0x4c, 0x2d, 0x4a,
-	0x4f, 0xd5, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0x80, 0x2a, 0xd3, 0x43, 0x51, 0xa6, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e, 0x9f,
-	0x9e, 0x0f, 0x56, 0xa4, 0x0f, 0x62, 0x41, 0xd4, 0x2b, 0x15, 0x71, 0xb1, 0x05, 0x80, 0xf5, 0x0b,
-	0x25, 0x71, 0x49, 0x65, 0x26, 0x25, 0xc7, 0x17, 0x24, 0x26, 0x67, 0xa7, 0x96, 0xc4, 0x97, 0x64,
-	0xe6, 0xa6, 0xe6, 0x97, 0x96, 0xc4, 0x17, 0x83, 0x0c, 0x49, 0x29, 0x96, 0x60, 0x54, 0x60, 0xd4,
-	0xe0, 0x75, 0x52, 0xfd, 0x74, 0x4f, 0x5e, 0xb1, 0x32, 0x31, 0x37, 0xc7, 0x4a, 0x09, 0xb7, 0x5a,
-	0xa5, 0x20, 0xf1, 0xcc, 0xa4, 0xe4, 0x00, 0xb0, 0x5c, 0x08, 0x44, 0x2a, 0x18, 0x22, 0x63, 0xc5,
-	0xf2, 0x62, 0x81, 0x3c, 0xa3, 0x93, 0xff, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e,
-	0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31,
-	0x44, 0x99, 0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0x3d, 0x92,
-	0x9c, 0x91, 0x98, 0x99, 0x07, 0xe3, 0xe8, 0x57, 0xa0, 0x79, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x38,
-	0x89, 0x0d, 0xec, 0x17, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2d, 0xf3, 0xe4, 0xef, 0x24,
-	0x01, 0x00, 0x00,
+	// 450 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x31, 0x8f, 0xd3, 0x30,
+	0x14, 0x80, 0x1b, 0x28, 0x15, 0x8a, 0xc4, 0x12, 0x1d, 0x5c, 0xa8, 0x4e, 0x49, 0xcf, 0xdc, 0x89,
+	0x93, 0x90, 0x1a, 0x9d, 0x10, 0xcb, 0x6d, 0xb4, 0x2c, 0x4c, 0x9c, 0xcc, 0xb1, 0xb0, 0x58, 0xb6,
+	0xfb, 0x2e, 0xb5, 0x88, 0xed, 0x28, 0x76, 0xab, 0x96, 0x89, 0x9f, 0xc0, 0x4f, 0xe0, 0xe7, 0x30,
+	0xde, 0xc8, 0x14, 0xa1, 0x76, 0x61, 0xce, 0xcc, 0x80, 0xe2, 0xa4, 0x2a, 0x9c, 0x5a, 0x6e, 0x73,
+	0xfc, 0x7d, 0x79, 0xcf, 0xef, 0x3d, 0xdb, 0x3f, 0x65, 0x94, 0x2d, 0x33, 0xad, 0x92, 0xcf, 0x5a,
+	0x01, 0xd7, 0x8a, 0x0b, 0x28, 0x52, 0x48, 0xe6, 0xe7, 0x49, 0x4e, 0x0b, 0x2a, 0xcd, 0x30, 0x2f,
+	0xb4, 0xd5, 0x41, 0xd8, 0x6a, 0xc3, 0x7f, 0xb4, 0xe1, 0xfc, 0xbc, 0x7f, 0x90, 0xea, 0x54, 0x3b,
+	0x29, 0xa9, 0x57, 0x8d, 0x8f, 0x7e, 0x77, 0xfd, 0xde, 0xa5, 0x0b, 0x10, 0x30, 0xbf, 0x2f, 0x18,
+	0x27, 0x39, 0xe5, 0x9f, 0xc0, 0x12, 0x2b, 0x24, 0xe8, 0x99, 0x25, 0xa6, 0x8e, 0x32, 0x31, 0xa1,
+	0x37, 0xf0, 0xce, 0x1e, 0x8d, 0x4e, 0xab, 0x32, 0x3e, 0x5e, 0x52, 0x99, 0x5d, 0xa0, 0xfd, 0x2e,
+	0xc2, 0x87, 0x82, 0xf1, 0x4b, 0xc7, 0xae, 0x1a, 0xf4, 0xbe, 0x21, 0x81, 0xf0, 0x8f, 0x84, 0xe2,
+	0xd9, 0x6c, 0x02, 0x84, 0xeb, 0x39, 0x28, 0xaa, 0x2c, 0xa1, 0xd6, 0x82, 0xb1, 0xd4, 0x0a, 0xad,
+	0xc2, 0x7b, 0x03, 0xef, 0xec, 0xe1, 0xe8, 0x79, 0x55, 0xc6, 0xcf, 0xda, 0x2c, 0xff, 0xb1, 0x11,
+	0xee, 0xb7, 0x78, 0xdc, 0xd2, 0xd7, 0x5b, 0x18, 0x10, 0xff, 0xa9, 0xa4, 0x0b, 0xc2, 0x2c, 0x77,
+	0xe7, 0x33, 0x96, 0xca, 0x9c, 0x14, 0x60, 0x0b, 0x01, 0x26, 0xbc, 0xef, 0xaa, 0x39, 0xa9, 0xca,
+	0x78, 0xd0, 0xe4, 0xd9, 0xab, 0x22, 0xfc, 0x44, 0xd2, 0xc5, 0xc8, 0xf2, 0xab, 0x0d, 0xc1, 0x0d,
+	0x08, 0x3e, 0xf8, 0x8f, 0x73, 0x28, 0xa4, 0x30, 0x46, 0x68, 0x05, 0x13, 0xc2, 0xa7, 0x54, 0x29,
+	0xc8, 0x4c, 0xd8, 0x75, 0x45, 0x0c, 0xaa, 0x32, 0x3e, 0x6a, 0x82, 0xef, 0xd4, 0x10, 0x3e, 0xf8,
+	0x7b, 0x7f, 0xdc, 0x6e, 0xd7, 0x63, 0x80, 0x5c, 0xf3, 0x69, 0x2d, 0x0a, 0x45, 0x84, 0xba, 0xd6,
+	0xf5, 0x59, 0x40, 0xb9, 0x06, 0x3d, 0xb8, 0x3d, 0x86, 0xfd, 0x2e, 0xc2, 0x87, 0x0e, 0x8e, 0x6b,
+	0xf6, 0x56, 0x5d, 0x6b, 0xbc, 0x21, 0xc1, 0x17, 0xcf, 0x3f, 0xa9, 0x2b, 0xde, 0xd5, 0x55, 0x32,
+	0x81, 0x0c, 0x52, 0xb7, 0x34, 0x61, 0xcf, 0xa5, 0x4b, 0xaa, 0x32, 0x7e, 0xb1, 0xed, 0xd3, 0x5d,
+	0x7f, 0x21, 0x7c, 0x2c, 0xe9, 0x62, 0xc7, 0x4c, 0xde, 0x6c, 0x9d, 0x8b, 0xee, 0xaf, 0x6f, 0xb1,
+	0x37, 0x7a, 0xf7, 0x7d, 0x15, 0x79, 0x37, 0xab, 0xc8, 0xfb, 0xb9, 0x8a, 0xbc, 0xaf, 0xeb, 0xa8,
+	0x73, 0xb3, 0x8e, 0x3a, 0x3f, 0xd6, 0x51, 0xe7, 0xe3, 0xab, 0x54, 0xd8, 0xe9, 0x8c, 0x0d, 0xb9,
+	0x96, 0x49, 0x7b, 0xa7, 0x5d, 0x91, 0x9b, 0x8f, 0x64, 0x71, 0xeb, 0x25, 0xd8, 0x65, 0x0e, 0x86,
+	0xf5, 0xdc, 0xb5, 0x7e, 0xf9, 0x27, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x6c, 0xc6, 0x27, 0x2f, 0x03,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -119,6 +188,21 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.IbcPacketTimeoutSeconds != that1.IbcPacketTimeoutSeconds {
 		return false
 	}
+	if this.IncludeCovenantAttestation != that1.IncludeCovenantAttestation {
+		return false
+	}
+	if this.MaxBtcTimestampRetries != that1.MaxBtcTimestampRetries {
+		return false
+	}
+	if this.PermissionedChannels != that1.PermissionedChannels {
+		return false
+	}
+	if this.EpochChainInfoRetention != that1.EpochChainInfoRetention {
+		return false
+	}
+	if this.MaxCovenantAttestationDelegations != that1.MaxCovenantAttestationDelegations {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -141,6 +225,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxCovenantAttestationDelegations != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCovenantAttestationDelegations))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EpochChainInfoRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EpochChainInfoRetention))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PermissionedChannels {
+		i--
+		if m.PermissionedChannels {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxBtcTimestampRetries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBtcTimestampRetries))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IncludeCovenantAttestation {
+		i--
+		if m.IncludeCovenantAttestation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.IbcPacketTimeoutSeconds != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.IbcPacketTimeoutSeconds))
 		i--
@@ -169,6 +288,21 @@ func (m *Params) Size() (n int) {
 	if m.IbcPacketTimeoutSeconds != 0 {
 		n += 1 + sovParams(uint64(m.IbcPacketTimeoutSeconds))
 	}
+	if m.IncludeCovenantAttestation {
+		n += 2
+	}
+	if m.MaxBtcTimestampRetries != 0 {
+		n += 1 + sovParams(uint64(m.MaxBtcTimestampRetries))
+	}
+	if m.PermissionedChannels {
+		n += 2
+	}
+	if m.EpochChainInfoRetention != 0 {
+		n += 1 + sovParams(uint64(m.EpochChainInfoRetention))
+	}
+	if m.MaxCovenantAttestationDelegations != 0 {
+		n += 1 + sovParams(uint64(m.MaxCovenantAttestationDelegations))
+	}
 	return n
 }
 
@@ -226,6 +360,103 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeCovenantAttestation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeCovenantAttestation = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBtcTimestampRetries", wireType)
+			}
+			m.MaxBtcTimestampRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBtcTimestampRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionedChannels", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PermissionedChannels = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochChainInfoRetention", wireType)
+			}
+			m.EpochChainInfoRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochChainInfoRetention |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCovenantAttestationDelegations", wireType)
+			}
+			m.MaxCovenantAttestationDelegations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCovenantAttestationDelegations |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
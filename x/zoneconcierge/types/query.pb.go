@@ -986,6 +986,302 @@ func (m *QueryFinalizedChainInfoUntilHeightResponse) GetProof() *ProofFinalizedC
 	return nil
 }
 
+// QueryFinalizedHeaderBundleRequest is request type for the
+// Query/FinalizedHeaderBundle RPC method.
+type QueryFinalizedHeaderBundleRequest struct {
+	// chain_id is the ID of the CZ
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// height is the height of the CZ header
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryFinalizedHeaderBundleRequest) Reset()         { *m = QueryFinalizedHeaderBundleRequest{} }
+func (m *QueryFinalizedHeaderBundleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalizedHeaderBundleRequest) ProtoMessage()    {}
+func (*QueryFinalizedHeaderBundleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd665af90102da38, []int{18}
+}
+func (m *QueryFinalizedHeaderBundleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalizedHeaderBundleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalizedHeaderBundleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalizedHeaderBundleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalizedHeaderBundleRequest.Merge(m, src)
+}
+func (m *QueryFinalizedHeaderBundleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalizedHeaderBundleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalizedHeaderBundleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalizedHeaderBundleRequest proto.InternalMessageInfo
+
+func (m *QueryFinalizedHeaderBundleRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QueryFinalizedHeaderBundleRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryFinalizedHeaderBundleResponse is response type for the
+// Query/FinalizedHeaderBundle RPC method.
+type QueryFinalizedHeaderBundleResponse struct {
+	// bundle is the CZ header along with the proofs that it is BTC-finalised
+	Bundle *FinalizedHeaderBundle `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+}
+
+func (m *QueryFinalizedHeaderBundleResponse) Reset()         { *m = QueryFinalizedHeaderBundleResponse{} }
+func (m *QueryFinalizedHeaderBundleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalizedHeaderBundleResponse) ProtoMessage()    {}
+func (*QueryFinalizedHeaderBundleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd665af90102da38, []int{19}
+}
+func (m *QueryFinalizedHeaderBundleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalizedHeaderBundleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalizedHeaderBundleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalizedHeaderBundleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalizedHeaderBundleResponse.Merge(m, src)
+}
+func (m *QueryFinalizedHeaderBundleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalizedHeaderBundleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalizedHeaderBundleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalizedHeaderBundleResponse proto.InternalMessageInfo
+
+func (m *QueryFinalizedHeaderBundleResponse) GetBundle() *FinalizedHeaderBundle {
+	if m != nil {
+		return m.Bundle
+	}
+	return nil
+}
+
+// QueryConsumersRequest is request type for the Query/Consumers RPC method.
+type QueryConsumersRequest struct {
+	// pagination defines whether to have the pagination in the request
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConsumersRequest) Reset()         { *m = QueryConsumersRequest{} }
+func (m *QueryConsumersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumersRequest) ProtoMessage()    {}
+func (*QueryConsumersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd665af90102da38, []int{20}
+}
+func (m *QueryConsumersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumersRequest.Merge(m, src)
+}
+func (m *QueryConsumersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumersRequest proto.InternalMessageInfo
+
+func (m *QueryConsumersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryConsumersResponse is response type for the Query/Consumers RPC method.
+type QueryConsumersResponse struct {
+	// consumers are the registrations of the consumer chains
+	Consumers []*ConsumerRegister `protobuf:"bytes,1,rep,name=consumers,proto3" json:"consumers,omitempty"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConsumersResponse) Reset()         { *m = QueryConsumersResponse{} }
+func (m *QueryConsumersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumersResponse) ProtoMessage()    {}
+func (*QueryConsumersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd665af90102da38, []int{21}
+}
+func (m *QueryConsumersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumersResponse.Merge(m, src)
+}
+func (m *QueryConsumersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumersResponse proto.InternalMessageInfo
+
+func (m *QueryConsumersResponse) GetConsumers() []*ConsumerRegister {
+	if m != nil {
+		return m.Consumers
+	}
+	return nil
+}
+
+func (m *QueryConsumersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryConsumerRequest is request type for the Query/Consumer RPC method.
+type QueryConsumerRequest struct {
+	// consumer_id is the chain ID of the consumer chain
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+}
+
+func (m *QueryConsumerRequest) Reset()         { *m = QueryConsumerRequest{} }
+func (m *QueryConsumerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerRequest) ProtoMessage()    {}
+func (*QueryConsumerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd665af90102da38, []int{22}
+}
+func (m *QueryConsumerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerRequest.Merge(m, src)
+}
+func (m *QueryConsumerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+// QueryConsumerResponse is response type for the Query/Consumer RPC method.
+type QueryConsumerResponse struct {
+	// consumer is the registration of the consumer chain
+	Consumer *ConsumerRegister `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+}
+
+func (m *QueryConsumerResponse) Reset()         { *m = QueryConsumerResponse{} }
+func (m *QueryConsumerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerResponse) ProtoMessage()    {}
+func (*QueryConsumerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd665af90102da38, []int{23}
+}
+func (m *QueryConsumerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerResponse.Merge(m, src)
+}
+func (m *QueryConsumerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerResponse) GetConsumer() *ConsumerRegister {
+	if m != nil {
+		return m.Consumer
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.zoneconcierge.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.zoneconcierge.v1.QueryParamsResponse")
@@ -1005,6 +1301,12 @@ func init() {
 	proto.RegisterType((*QueryFinalizedChainsInfoResponse)(nil), "babylon.zoneconcierge.v1.QueryFinalizedChainsInfoResponse")
 	proto.RegisterType((*QueryFinalizedChainInfoUntilHeightRequest)(nil), "babylon.zoneconcierge.v1.QueryFinalizedChainInfoUntilHeightRequest")
 	proto.RegisterType((*QueryFinalizedChainInfoUntilHeightResponse)(nil), "babylon.zoneconcierge.v1.QueryFinalizedChainInfoUntilHeightResponse")
+	proto.RegisterType((*QueryFinalizedHeaderBundleRequest)(nil), "babylon.zoneconcierge.v1.QueryFinalizedHeaderBundleRequest")
+	proto.RegisterType((*QueryFinalizedHeaderBundleResponse)(nil), "babylon.zoneconcierge.v1.QueryFinalizedHeaderBundleResponse")
+	proto.RegisterType((*QueryConsumersRequest)(nil), "babylon.zoneconcierge.v1.QueryConsumersRequest")
+	proto.RegisterType((*QueryConsumersResponse)(nil), "babylon.zoneconcierge.v1.QueryConsumersResponse")
+	proto.RegisterType((*QueryConsumerRequest)(nil), "babylon.zoneconcierge.v1.QueryConsumerRequest")
+	proto.RegisterType((*QueryConsumerResponse)(nil), "babylon.zoneconcierge.v1.QueryConsumerResponse")
 }
 
 func init() {
@@ -1012,81 +1314,94 @@ func init() {
 }
 
 var fileDescriptor_cd665af90102da38 = []byte{
-	// 1176 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0x8e, 0xf3, 0xab, 0xc9, 0x5b, 0x0a, 0xd5, 0x24, 0x2d, 0x8b, 0xdb, 0x6e, 0x22, 0x43, 0x69,
-	0x5a, 0x12, 0x9b, 0x4d, 0x49, 0xab, 0x72, 0xa0, 0x6a, 0x52, 0x92, 0x46, 0x45, 0xa5, 0x35, 0x04,
-	0x24, 0x2e, 0xc6, 0xf6, 0xce, 0x7a, 0xad, 0x64, 0x3d, 0x5b, 0xdb, 0xbb, 0xed, 0x36, 0x84, 0x03,
-	0xea, 0x1d, 0x24, 0x2e, 0x88, 0x13, 0x27, 0x0e, 0x1c, 0x7a, 0xe3, 0x4f, 0x40, 0xea, 0x81, 0x43,
-	0x25, 0x2e, 0x9c, 0x10, 0x4a, 0xf8, 0x37, 0x90, 0x90, 0x67, 0xc6, 0xbb, 0xfe, 0xb9, 0xeb, 0x0d,
-	0xb9, 0xed, 0x8c, 0xdf, 0xfb, 0xbe, 0xef, 0xbd, 0x79, 0x33, 0xef, 0x2d, 0xbc, 0x65, 0xe8, 0x46,
-	0x77, 0x8f, 0x38, 0xca, 0x53, 0xe2, 0x60, 0x93, 0x38, 0xa6, 0x8d, 0x5d, 0x0b, 0x2b, 0x9d, 0xaa,
-	0xf2, 0xa8, 0x8d, 0xdd, 0xae, 0xdc, 0x72, 0x89, 0x4f, 0x50, 0x99, 0x5b, 0xc9, 0x31, 0x2b, 0xb9,
-	0x53, 0x15, 0xe7, 0x2d, 0x62, 0x11, 0x6a, 0xa4, 0x04, 0xbf, 0x98, 0xbd, 0x78, 0xc1, 0x22, 0xc4,
-	0xda, 0xc3, 0x8a, 0xde, 0xb2, 0x15, 0xdd, 0x71, 0x88, 0xaf, 0xfb, 0x36, 0x71, 0x3c, 0xfe, 0xf5,
-	0xaa, 0x49, 0xbc, 0x26, 0xf1, 0x14, 0x43, 0xf7, 0x30, 0xa3, 0x51, 0x3a, 0x55, 0x03, 0xfb, 0x7a,
-	0x55, 0x69, 0xe9, 0x96, 0xed, 0x50, 0x63, 0x6e, 0xbb, 0x1c, 0xea, 0x33, 0x7c, 0xd3, 0x6c, 0x60,
-	0x73, 0xb7, 0x45, 0x6c, 0xc7, 0x0f, 0xf4, 0xc5, 0x36, 0xb8, 0xf5, 0x95, 0xd0, 0xba, 0xff, 0xc5,
-	0x76, 0xac, 0xc0, 0x3a, 0x65, 0x2a, 0x85, 0xa6, 0xb8, 0x45, 0xcc, 0x06, 0xb7, 0x0a, 0x7f, 0x27,
-	0xc9, 0x53, 0xc9, 0x89, 0xe7, 0x81, 0x59, 0x5f, 0xca, 0xb5, 0x6e, 0xe9, 0xae, 0xde, 0xe4, 0xd1,
-	0x4b, 0xf3, 0x80, 0x1e, 0x06, 0x31, 0x3f, 0xa0, 0x9b, 0x2a, 0x7e, 0xd4, 0xc6, 0x9e, 0x2f, 0xed,
-	0xc0, 0x5c, 0x6c, 0xd7, 0x6b, 0x11, 0xc7, 0xc3, 0xe8, 0x03, 0x98, 0x66, 0xce, 0x65, 0x61, 0x51,
-	0x58, 0x2a, 0xad, 0x2e, 0xca, 0x79, 0x27, 0x21, 0x33, 0xcf, 0xf5, 0xc9, 0x17, 0x7f, 0x2d, 0x8c,
-	0xa9, 0xdc, 0x4b, 0xda, 0xe2, 0x64, 0x77, 0xb1, 0x5e, 0xc3, 0x2e, 0x27, 0x43, 0x6f, 0xc0, 0x8c,
-	0xd9, 0xd0, 0x6d, 0x47, 0xb3, 0x6b, 0x14, 0x77, 0x56, 0x3d, 0x45, 0xd7, 0xdb, 0x35, 0x74, 0x0e,
-	0xa6, 0x1b, 0xd8, 0xb6, 0x1a, 0x7e, 0x79, 0x7c, 0x51, 0x58, 0x9a, 0x54, 0xf9, 0x4a, 0xfa, 0x51,
-	0xe0, 0x02, 0x43, 0x24, 0x2e, 0xf0, 0x56, 0x60, 0x1f, 0xec, 0x70, 0x81, 0x97, 0xf3, 0x05, 0x6e,
-	0x3b, 0x35, 0xfc, 0x04, 0xd7, 0x38, 0x00, 0x77, 0x43, 0xeb, 0xf0, 0x4a, 0x9d, 0xb8, 0xbb, 0x1a,
-	0x5b, 0x7a, 0x94, 0xb6, 0xb4, 0xba, 0x90, 0x0f, 0xb3, 0x49, 0xdc, 0x5d, 0x4f, 0x2d, 0x05, 0x4e,
-	0x0c, 0xca, 0x93, 0x34, 0x38, 0x4b, 0xb5, 0x6d, 0x04, 0x41, 0x7c, 0x64, 0x7b, 0x7e, 0x18, 0xe8,
-	0x26, 0x40, 0xbf, 0xa2, 0xb8, 0xc2, 0xb7, 0x65, 0x56, 0x7e, 0x72, 0x50, 0x7e, 0x32, 0xab, 0x72,
-	0x5e, 0x7e, 0xf2, 0x03, 0xdd, 0xc2, 0xdc, 0x57, 0x8d, 0x78, 0x4a, 0x5f, 0xc3, 0xb9, 0x24, 0x01,
-	0x8f, 0xff, 0x3c, 0xcc, 0x86, 0xa9, 0x0c, 0xce, 0x68, 0x62, 0x69, 0x56, 0x9d, 0xe1, 0xb9, 0xf4,
-	0xd0, 0x56, 0x8c, 0x7e, 0x9c, 0x27, 0x68, 0x18, 0x3d, 0x43, 0x8e, 0xf1, 0xaf, 0x45, 0xf9, 0xbd,
-	0x6d, 0xa7, 0x4e, 0xc2, 0x08, 0x07, 0xf1, 0x4b, 0x1a, 0xbc, 0x9e, 0x72, 0xe3, 0xba, 0xef, 0x40,
-	0x89, 0x9a, 0x79, 0x9a, 0xed, 0xd4, 0x09, 0xf5, 0x2c, 0xad, 0xbe, 0x99, 0x9f, 0x75, 0x0a, 0x41,
-	0x11, 0xc0, 0xec, 0xa1, 0x49, 0x9f, 0xc3, 0x79, 0x4a, 0xf0, 0x61, 0x70, 0x6f, 0x32, 0xc5, 0xd1,
-	0x1b, 0xa5, 0x39, 0xed, 0x26, 0xcd, 0xfe, 0xa4, 0x3a, 0x43, 0x37, 0xee, 0xb7, 0x9b, 0x71, 0xe5,
-	0xe3, 0x09, 0xe5, 0x35, 0xb8, 0x90, 0x0d, 0x7c, 0xa2, 0xf2, 0xbf, 0xe2, 0xf9, 0x09, 0x4e, 0x94,
-	0xd7, 0x52, 0x81, 0x2b, 0xb2, 0x99, 0x71, 0xaa, 0xc7, 0x29, 0xaa, 0x9f, 0x05, 0x28, 0xa7, 0xe9,
-	0x79, 0x80, 0xb7, 0xe1, 0x54, 0x78, 0x23, 0x58, 0x70, 0x85, 0x2f, 0x56, 0xe8, 0x77, 0x72, 0xd5,
-	0xf7, 0x19, 0x3f, 0x8c, 0x40, 0x27, 0x3d, 0x90, 0x44, 0xae, 0x06, 0x1e, 0x73, 0x34, 0x91, 0xe3,
-	0xb1, 0x44, 0x4a, 0x06, 0x5c, 0xcc, 0xc1, 0x3d, 0xb1, 0x24, 0x48, 0x9f, 0xc2, 0x02, 0xe5, 0xd8,
-	0xb4, 0x1d, 0x7d, 0xcf, 0x7e, 0x8a, 0x6b, 0xa3, 0x5d, 0x21, 0x34, 0x0f, 0x53, 0x2d, 0x97, 0x74,
-	0x30, 0xd5, 0x3e, 0xa3, 0xb2, 0x85, 0xf4, 0x4c, 0x80, 0xc5, 0x7c, 0x58, 0xae, 0xfe, 0x4b, 0x38,
-	0x5b, 0x0f, 0x3f, 0x6b, 0xe9, 0x6a, 0x5d, 0x1e, 0xf0, 0xc4, 0xc5, 0x50, 0x29, 0xe8, 0x5c, 0x3d,
-	0xcd, 0x24, 0xf9, 0x70, 0x25, 0x43, 0x45, 0xf0, 0x69, 0xc7, 0xf1, 0xed, 0xbd, 0xbb, 0xf4, 0xe9,
-	0x3e, 0xfe, 0xa3, 0xdf, 0x0f, 0x7e, 0x22, 0x1a, 0xfc, 0xf3, 0x09, 0xb8, 0x5a, 0x84, 0x96, 0xa7,
-	0x61, 0x07, 0xe6, 0x13, 0x69, 0x08, 0xb3, 0x20, 0x14, 0xbd, 0xb3, 0xa8, 0x9e, 0x62, 0x42, 0x37,
-	0x01, 0x58, 0xd1, 0x51, 0x30, 0x56, 0xdd, 0x62, 0x0f, 0xac, 0xd7, 0xc8, 0x3b, 0x55, 0x99, 0x96,
-	0x96, 0xca, 0x4a, 0x94, 0xba, 0xde, 0x87, 0x57, 0x5d, 0xfd, 0xb1, 0xd6, 0x1f, 0x09, 0x68, 0x7c,
-	0xd1, 0xea, 0x8a, 0x8d, 0x0f, 0x01, 0x86, 0xaa, 0x3f, 0xde, 0xe8, 0xed, 0xa9, 0xa7, 0xdd, 0xe8,
-	0x12, 0xed, 0x00, 0x32, 0x7c, 0x53, 0xf3, 0xda, 0x46, 0xd3, 0xf6, 0x3c, 0x9b, 0x38, 0xda, 0x2e,
-	0xee, 0x96, 0x27, 0x13, 0x98, 0xf1, 0x79, 0xa5, 0x53, 0x95, 0x3f, 0xe9, 0xd9, 0xdf, 0xc3, 0x5d,
-	0xf5, 0x8c, 0xe1, 0x9b, 0xb1, 0x1d, 0xb4, 0x45, 0xb3, 0x4f, 0xea, 0xe5, 0x29, 0x8a, 0x54, 0x1d,
-	0xd0, 0xfa, 0x03, 0xb3, 0x8c, 0xa2, 0x61, 0xfe, 0xab, 0xcf, 0x4e, 0xc3, 0x14, 0x3d, 0x30, 0xf4,
-	0xad, 0x00, 0xd3, 0x6c, 0x4e, 0x40, 0x03, 0xca, 0x2f, 0x3d, 0x9e, 0x88, 0x2b, 0x05, 0xad, 0xd9,
-	0x99, 0x4b, 0x4b, 0xdf, 0xfc, 0xf1, 0xcf, 0xf7, 0xe3, 0x12, 0x5a, 0x54, 0x86, 0xcc, 0x44, 0xe8,
-	0xb9, 0x00, 0xd3, 0xec, 0xce, 0x0e, 0x55, 0x14, 0x9b, 0x61, 0x86, 0x2a, 0x8a, 0xcf, 0x29, 0xd2,
-	0x16, 0x55, 0x74, 0x1b, 0xdd, 0xca, 0x57, 0xd4, 0xaf, 0x4d, 0x65, 0x3f, 0xbc, 0x29, 0x07, 0x0a,
-	0x7b, 0x48, 0x94, 0x7d, 0x76, 0x25, 0x0e, 0xd0, 0x0f, 0x02, 0xcc, 0xf6, 0xc6, 0x00, 0xa4, 0x0c,
-	0x51, 0x91, 0x9c, 0x48, 0xc4, 0x77, 0x8b, 0x3b, 0x14, 0xcf, 0x25, 0x7b, 0x5c, 0xd0, 0x4f, 0x02,
-	0x40, 0xff, 0x75, 0x40, 0x85, 0xa8, 0xa2, 0x2f, 0xa1, 0x58, 0x1d, 0xc1, 0x83, 0xab, 0x5b, 0xa1,
-	0xea, 0x2e, 0xa3, 0x4b, 0xc3, 0xd4, 0xd1, 0xc4, 0xa2, 0x5f, 0x05, 0x78, 0x2d, 0xd1, 0xd3, 0xd1,
-	0xda, 0x10, 0xd6, 0xec, 0xe1, 0x42, 0xbc, 0x3e, 0xaa, 0x1b, 0x57, 0x7c, 0x8d, 0x2a, 0x5e, 0x41,
-	0xef, 0xe4, 0x2b, 0x66, 0x0f, 0x4b, 0x54, 0xf7, 0x2f, 0x02, 0x94, 0x22, 0x6d, 0x1a, 0x0d, 0xcb,
-	0x54, 0x7a, 0xa2, 0x10, 0x57, 0x47, 0x71, 0xe1, 0x5a, 0xdf, 0xa3, 0x5a, 0x65, 0xb4, 0x9c, 0xaf,
-	0x95, 0x37, 0xba, 0x48, 0xc9, 0xa2, 0xdf, 0x05, 0x38, 0x93, 0xec, 0xa9, 0xe8, 0x7a, 0x01, 0xfa,
-	0x8c, 0xe6, 0x2e, 0xde, 0x18, 0xd9, 0xaf, 0xf8, 0x8d, 0x4b, 0x6b, 0x67, 0xa9, 0xf7, 0x94, 0xfd,
-	0xde, 0x40, 0x71, 0x80, 0x7e, 0x13, 0x60, 0x2e, 0xa3, 0xcf, 0xa2, 0x9b, 0x43, 0x94, 0xe5, 0xb7,
-	0x7c, 0xf1, 0xfd, 0xe3, 0xb8, 0xf2, 0xb8, 0x6e, 0xd0, 0xb8, 0xaa, 0x48, 0xc9, 0x8f, 0x2b, 0xb3,
-	0xed, 0xa3, 0x7f, 0x05, 0xb8, 0x38, 0xb0, 0x65, 0xa2, 0x8d, 0x91, 0x64, 0x65, 0xf7, 0x79, 0xf1,
-	0xce, 0xff, 0x03, 0xe1, 0x51, 0x3e, 0xa4, 0x51, 0xde, 0x43, 0xdb, 0x85, 0xa3, 0xcc, 0x78, 0x39,
-	0x03, 0xc4, 0xde, 0xcb, 0xb9, 0xfe, 0xf1, 0x8b, 0xc3, 0x8a, 0xf0, 0xf2, 0xb0, 0x22, 0xfc, 0x7d,
-	0x58, 0x11, 0xbe, 0x3b, 0xaa, 0x8c, 0xbd, 0x3c, 0xaa, 0x8c, 0xfd, 0x79, 0x54, 0x19, 0xfb, 0x62,
-	0xcd, 0xb2, 0xfd, 0x46, 0xdb, 0x90, 0x4d, 0xd2, 0x0c, 0xe9, 0x28, 0x4c, 0x8f, 0xfb, 0x49, 0x82,
-	0xdd, 0xef, 0xb6, 0xb0, 0x67, 0x4c, 0xd3, 0x3f, 0xd4, 0xd7, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff,
-	0x03, 0xf9, 0xf2, 0x6b, 0xc4, 0x10, 0x00, 0x00,
+	// 1387 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0xd3, 0x74, 0xbb, 0x79, 0xcb, 0x8f, 0x32, 0x49, 0xcb, 0xe2, 0xb6, 0x9b, 0xe0, 0x52,
+	0x9a, 0xb6, 0x89, 0xdd, 0x4d, 0x48, 0xa3, 0x00, 0xa2, 0x6a, 0x52, 0xf2, 0x43, 0xad, 0x42, 0x6b,
+	0x9a, 0x22, 0x71, 0x59, 0x6c, 0xef, 0xec, 0xae, 0x95, 0xac, 0x67, 0xbb, 0xf6, 0x6e, 0x9b, 0x86,
+	0x70, 0x40, 0xdc, 0x41, 0xe2, 0x82, 0xe0, 0xc2, 0xa9, 0x07, 0x90, 0x7a, 0x83, 0xff, 0x00, 0xa9,
+	0x07, 0x0e, 0x95, 0xb8, 0x70, 0x42, 0x28, 0xe1, 0xc0, 0x3f, 0x81, 0x84, 0x3c, 0x33, 0xf6, 0xda,
+	0x5e, 0x7b, 0xd7, 0x9b, 0xe6, 0x16, 0xcf, 0xbe, 0xf7, 0xbe, 0xef, 0x7b, 0xf3, 0x66, 0xe6, 0x53,
+	0xe0, 0x2d, 0x5d, 0xd3, 0x77, 0xb6, 0x89, 0xa5, 0x3c, 0x26, 0x16, 0x36, 0x88, 0x65, 0x98, 0xb8,
+	0x59, 0xc5, 0x4a, 0xbb, 0xa8, 0x3c, 0x68, 0xe1, 0xe6, 0x8e, 0xdc, 0x68, 0x12, 0x87, 0xa0, 0x3c,
+	0x8f, 0x92, 0x43, 0x51, 0x72, 0xbb, 0x28, 0x8e, 0x57, 0x49, 0x95, 0xd0, 0x20, 0xc5, 0xfd, 0x8b,
+	0xc5, 0x8b, 0x67, 0xab, 0x84, 0x54, 0xb7, 0xb1, 0xa2, 0x35, 0x4c, 0x45, 0xb3, 0x2c, 0xe2, 0x68,
+	0x8e, 0x49, 0x2c, 0x9b, 0xff, 0x7a, 0xd9, 0x20, 0x76, 0x9d, 0xd8, 0x8a, 0xae, 0xd9, 0x98, 0xc1,
+	0x28, 0xed, 0xa2, 0x8e, 0x1d, 0xad, 0xa8, 0x34, 0xb4, 0xaa, 0x69, 0xd1, 0x60, 0x1e, 0x3b, 0xed,
+	0xf1, 0xd3, 0x1d, 0xc3, 0xa8, 0x61, 0x63, 0xab, 0x41, 0x4c, 0xcb, 0x71, 0xf9, 0x85, 0x16, 0x78,
+	0xf4, 0x25, 0x2f, 0xba, 0xf3, 0x8b, 0x69, 0x55, 0xdd, 0xe8, 0xae, 0x50, 0xc9, 0x0b, 0xc5, 0x0d,
+	0x62, 0xd4, 0x78, 0x94, 0xf7, 0x77, 0x14, 0xbc, 0xab, 0x39, 0xe1, 0x3e, 0xb0, 0xe8, 0x0b, 0x89,
+	0xd1, 0x0d, 0xad, 0xa9, 0xd5, 0xb9, 0x7a, 0x69, 0x1c, 0xd0, 0x5d, 0x57, 0xf3, 0x1d, 0xba, 0xa8,
+	0xe2, 0x07, 0x2d, 0x6c, 0x3b, 0xd2, 0x26, 0x8c, 0x85, 0x56, 0xed, 0x06, 0xb1, 0x6c, 0x8c, 0x3e,
+	0x80, 0x0c, 0x4b, 0xce, 0x0b, 0x93, 0xc2, 0x54, 0x6e, 0x76, 0x52, 0x4e, 0xda, 0x09, 0x99, 0x65,
+	0x2e, 0x8d, 0x3c, 0xfb, 0x6b, 0x62, 0x48, 0xe5, 0x59, 0xd2, 0x2a, 0x07, 0x5b, 0xc3, 0x5a, 0x19,
+	0x37, 0x39, 0x18, 0x7a, 0x03, 0xb2, 0x46, 0x4d, 0x33, 0xad, 0x92, 0x59, 0xa6, 0x75, 0x47, 0xd5,
+	0x13, 0xf4, 0x7b, 0xbd, 0x8c, 0x4e, 0x43, 0xa6, 0x86, 0xcd, 0x6a, 0xcd, 0xc9, 0x0f, 0x4f, 0x0a,
+	0x53, 0x23, 0x2a, 0xff, 0x92, 0xbe, 0x17, 0x60, 0x2c, 0x54, 0x89, 0x13, 0xbc, 0xee, 0xc6, 0xbb,
+	0x2b, 0x9c, 0xe0, 0xc5, 0x64, 0x82, 0xeb, 0x56, 0x19, 0x3f, 0xc2, 0x65, 0x5e, 0x80, 0xa7, 0xa1,
+	0x25, 0x78, 0xa9, 0x42, 0x9a, 0x5b, 0x25, 0xf6, 0x69, 0x53, 0xd8, 0xdc, 0xec, 0x44, 0x72, 0x99,
+	0x15, 0xd2, 0xdc, 0xb2, 0xd5, 0x9c, 0x9b, 0xc4, 0x4a, 0xd9, 0x52, 0x09, 0x4e, 0x51, 0x6e, 0xcb,
+	0xae, 0x88, 0xdb, 0xa6, 0xed, 0x78, 0x42, 0x57, 0x00, 0x3a, 0x13, 0xc5, 0x19, 0xbe, 0x2d, 0xb3,
+	0xf1, 0x93, 0xdd, 0xf1, 0x93, 0xd9, 0x94, 0xf3, 0xf1, 0x93, 0xef, 0x68, 0x55, 0xcc, 0x73, 0xd5,
+	0x40, 0xa6, 0xf4, 0x05, 0x9c, 0x8e, 0x02, 0x70, 0xfd, 0x67, 0x60, 0xd4, 0x6b, 0xa5, 0xbb, 0x47,
+	0xc7, 0xa6, 0x46, 0xd5, 0x2c, 0xef, 0xa5, 0x8d, 0x56, 0x43, 0xf0, 0xc3, 0xbc, 0x41, 0xfd, 0xe0,
+	0x59, 0xe5, 0x10, 0xfe, 0x7c, 0x10, 0xdf, 0x5e, 0xb7, 0x2a, 0xc4, 0x53, 0xd8, 0x0b, 0x5f, 0x2a,
+	0xc1, 0xeb, 0x5d, 0x69, 0x9c, 0xf7, 0x4d, 0xc8, 0xd1, 0x30, 0xbb, 0x64, 0x5a, 0x15, 0x42, 0x33,
+	0x73, 0xb3, 0xe7, 0x93, 0xbb, 0x4e, 0x4b, 0xd0, 0x0a, 0x60, 0xf8, 0xd5, 0xa4, 0x4f, 0xe0, 0x0c,
+	0x05, 0xf8, 0xd0, 0x3d, 0x37, 0xb1, 0xe4, 0xe8, 0x89, 0x2a, 0x59, 0xad, 0x3a, 0xed, 0xfe, 0x88,
+	0x9a, 0xa5, 0x0b, 0x1b, 0xad, 0x7a, 0x98, 0xf9, 0x70, 0x84, 0x79, 0x19, 0xce, 0xc6, 0x17, 0x3e,
+	0x52, 0xfa, 0x9f, 0xf3, 0xfe, 0xb8, 0x3b, 0xca, 0x67, 0x29, 0xc5, 0x11, 0x59, 0x89, 0xd9, 0xd5,
+	0xc3, 0x0c, 0xd5, 0x13, 0x01, 0xf2, 0xdd, 0xf0, 0x5c, 0xe0, 0x0d, 0x38, 0xe1, 0x9d, 0x08, 0x26,
+	0x2e, 0xf5, 0xc1, 0xf2, 0xf2, 0x8e, 0x6e, 0xfa, 0xee, 0xc3, 0x59, 0x9f, 0x27, 0xdd, 0x90, 0x48,
+	0xaf, 0x7a, 0x6e, 0x73, 0xb0, 0x91, 0xc3, 0xa1, 0x46, 0x4a, 0x3a, 0x9c, 0x4b, 0xa8, 0x7b, 0x64,
+	0x4d, 0x90, 0xee, 0xc1, 0x04, 0xc5, 0x58, 0x31, 0x2d, 0x6d, 0xdb, 0x7c, 0x8c, 0xcb, 0x83, 0x1d,
+	0x21, 0x34, 0x0e, 0xc7, 0x1b, 0x4d, 0xd2, 0xc6, 0x94, 0x7b, 0x56, 0x65, 0x1f, 0xd2, 0x57, 0x02,
+	0x4c, 0x26, 0x97, 0xe5, 0xec, 0x3f, 0x83, 0x53, 0x15, 0xef, 0xe7, 0x52, 0xf7, 0xb4, 0x4e, 0xf7,
+	0xb8, 0xe2, 0x42, 0x55, 0x69, 0xd1, 0xb1, 0x4a, 0x37, 0x92, 0xe4, 0xc0, 0xa5, 0x18, 0x16, 0xee,
+	0x4f, 0x9b, 0x96, 0x63, 0x6e, 0xaf, 0xd1, 0xab, 0xfb, 0xf0, 0x97, 0x7e, 0x47, 0xfc, 0xb1, 0xa0,
+	0xf8, 0xa7, 0xc7, 0xe0, 0x72, 0x1a, 0x58, 0xde, 0x86, 0x4d, 0x18, 0x8f, 0xb4, 0xc1, 0xeb, 0x82,
+	0x90, 0xf6, 0xcc, 0xa2, 0x4a, 0x17, 0x12, 0x5a, 0x04, 0x60, 0x43, 0x47, 0x8b, 0xb1, 0xe9, 0x16,
+	0xfd, 0x62, 0xfe, 0x43, 0xde, 0x2e, 0xca, 0x74, 0xb4, 0x54, 0x36, 0xa2, 0x34, 0x75, 0x03, 0x5e,
+	0x69, 0x6a, 0x0f, 0x4b, 0x1d, 0x4b, 0x40, 0xf5, 0x05, 0xa7, 0x2b, 0x64, 0x1f, 0xdc, 0x1a, 0xaa,
+	0xf6, 0x70, 0xd9, 0x5f, 0x53, 0x5f, 0x6e, 0x06, 0x3f, 0xd1, 0x26, 0x20, 0xdd, 0x31, 0x4a, 0x76,
+	0x4b, 0xaf, 0x9b, 0xb6, 0x6d, 0x12, 0xab, 0xb4, 0x85, 0x77, 0xf2, 0x23, 0x91, 0x9a, 0x61, 0xbf,
+	0xd2, 0x2e, 0xca, 0x1f, 0xfb, 0xf1, 0xb7, 0xf0, 0x8e, 0x7a, 0x52, 0x77, 0x8c, 0xd0, 0x0a, 0x5a,
+	0xa5, 0xdd, 0x27, 0x95, 0xfc, 0x71, 0x5a, 0xa9, 0xd8, 0xe3, 0xe9, 0x77, 0xc3, 0x62, 0x86, 0x86,
+	0xe5, 0x4b, 0xf7, 0xe1, 0xcd, 0xf0, 0x7e, 0xb1, 0x43, 0xb2, 0xd4, 0xb2, 0xca, 0xdb, 0xf8, 0x05,
+	0x3c, 0x41, 0x1d, 0xa4, 0x5e, 0x75, 0xf9, 0xfe, 0xaf, 0x42, 0x46, 0xa7, 0x2b, 0x7c, 0xc7, 0x95,
+	0x14, 0x73, 0x1f, 0x2a, 0xc4, 0xd3, 0x3b, 0xaf, 0x3c, 0xb1, 0xec, 0x56, 0x3d, 0x70, 0xff, 0x1c,
+	0xd5, 0x2b, 0xff, 0xb3, 0x00, 0xa7, 0xa3, 0x08, 0x5c, 0xc4, 0x1a, 0x8c, 0x1a, 0xde, 0x22, 0x3f,
+	0xbf, 0x97, 0x7b, 0x4c, 0x2e, 0x0f, 0x55, 0x71, 0xd5, 0xb4, 0x1d, 0xdc, 0x54, 0x3b, 0xc9, 0x47,
+	0x77, 0x2b, 0x2f, 0xc0, 0x78, 0x88, 0xac, 0xd7, 0x8d, 0x09, 0xc8, 0x79, 0x68, 0x9d, 0xbd, 0x04,
+	0x6f, 0x69, 0xbd, 0xdc, 0xd5, 0x47, 0x5f, 0xe4, 0x0a, 0x64, 0xbd, 0x30, 0xde, 0xc5, 0x41, 0x34,
+	0xfa, 0xb9, 0xb3, 0xbf, 0xbe, 0x06, 0xc7, 0x29, 0x02, 0xfa, 0x5a, 0x80, 0x0c, 0xf3, 0xa5, 0xa8,
+	0xc7, 0x75, 0xd7, 0x6d, 0x87, 0xc5, 0x99, 0x94, 0xd1, 0x8c, 0xb9, 0x34, 0xf5, 0xe5, 0x1f, 0xff,
+	0x7c, 0x3b, 0x2c, 0xa1, 0x49, 0xa5, 0x8f, 0x07, 0x47, 0x4f, 0x05, 0xc8, 0xb0, 0xe9, 0xea, 0xcb,
+	0x28, 0xe4, 0x99, 0xc5, 0x99, 0x94, 0xd1, 0x9c, 0xd1, 0x2a, 0x65, 0x74, 0x03, 0x5d, 0x4f, 0x66,
+	0xd4, 0xb9, 0x0b, 0x95, 0x5d, 0xfe, 0x77, 0x79, 0x4f, 0x61, 0x0f, 0x97, 0xb2, 0xcb, 0xce, 0xd8,
+	0x1e, 0xfa, 0x4e, 0x80, 0x51, 0xdf, 0x76, 0x22, 0xa5, 0x0f, 0x8b, 0xa8, 0x03, 0x16, 0xaf, 0xa6,
+	0x4f, 0x48, 0xdf, 0x4b, 0xf6, 0x98, 0xa1, 0x1f, 0x05, 0x80, 0xce, 0x6b, 0x84, 0x52, 0x41, 0x05,
+	0x5f, 0x5e, 0xb1, 0x38, 0x40, 0x06, 0x67, 0x37, 0x43, 0xd9, 0x5d, 0x44, 0x17, 0xfa, 0xb1, 0xa3,
+	0x8d, 0x45, 0xbf, 0x08, 0xf0, 0x6a, 0xc4, 0x43, 0xa2, 0xf9, 0x3e, 0xa8, 0xf1, 0x66, 0x56, 0xbc,
+	0x36, 0x68, 0x1a, 0x67, 0x3c, 0x47, 0x19, 0xcf, 0xa0, 0x2b, 0xc9, 0x8c, 0xd9, 0x43, 0x16, 0xe4,
+	0xfd, 0x93, 0x00, 0xb9, 0x80, 0x2d, 0x44, 0xfd, 0x3a, 0xd5, 0xed, 0x60, 0xc5, 0xd9, 0x41, 0x52,
+	0x38, 0xd7, 0x77, 0x28, 0x57, 0x19, 0x4d, 0x27, 0x73, 0xe5, 0xc6, 0x2a, 0x30, 0xb2, 0xe8, 0x77,
+	0x01, 0x4e, 0x46, 0x3d, 0x1c, 0xba, 0x96, 0x02, 0x3e, 0xc6, 0x4c, 0x8a, 0x0b, 0x03, 0xe7, 0xa5,
+	0x3f, 0x71, 0xdd, 0xdc, 0x59, 0xeb, 0x6d, 0x65, 0xd7, 0x37, 0xb0, 0x7b, 0xe8, 0x37, 0x01, 0xc6,
+	0x62, 0x7c, 0x1d, 0x5a, 0xec, 0xc3, 0x2c, 0xd9, 0x62, 0x8a, 0xef, 0x1e, 0x26, 0x95, 0xeb, 0x5a,
+	0xa0, 0xba, 0x8a, 0x48, 0x49, 0xd6, 0x15, 0x6b, 0x33, 0xd1, 0x7f, 0x02, 0x9c, 0xeb, 0x69, 0xd1,
+	0xd0, 0xf2, 0x40, 0xb4, 0xe2, 0x7d, 0xa5, 0x78, 0xf3, 0xc5, 0x8a, 0x70, 0x95, 0x77, 0xa9, 0xca,
+	0x5b, 0x68, 0x3d, 0xb5, 0xca, 0x98, 0x9b, 0xd3, 0xad, 0xd8, 0xb9, 0x39, 0xff, 0x15, 0xe0, 0x54,
+	0xac, 0xa3, 0x40, 0xef, 0xa5, 0xa5, 0x1c, 0x63, 0x94, 0xc4, 0xf7, 0x0f, 0x97, 0xcc, 0x75, 0xde,
+	0xa3, 0x3a, 0x37, 0xd0, 0xed, 0x34, 0x3a, 0xd9, 0xbc, 0x96, 0x98, 0xff, 0xe9, 0x25, 0xf5, 0x07,
+	0xf7, 0x91, 0xf0, 0x2d, 0x46, 0xdf, 0x47, 0x22, 0x62, 0xa0, 0xc4, 0xab, 0xe9, 0x13, 0xb8, 0x8c,
+	0x2b, 0x54, 0xc6, 0x05, 0x74, 0xbe, 0xc7, 0x35, 0xec, 0xf3, 0x79, 0x22, 0x40, 0xd6, 0x2b, 0x81,
+	0xe4, 0x94, 0x58, 0x1e, 0x37, 0x25, 0x75, 0x3c, 0xa7, 0xb6, 0x48, 0xa9, 0xcd, 0xa1, 0x62, 0x0a,
+	0x6a, 0xca, 0x6e, 0xc0, 0x2a, 0xed, 0x2d, 0x7d, 0xf4, 0x6c, 0xbf, 0x20, 0x3c, 0xdf, 0x2f, 0x08,
+	0x7f, 0xef, 0x17, 0x84, 0x6f, 0x0e, 0x0a, 0x43, 0xcf, 0x0f, 0x0a, 0x43, 0x7f, 0x1e, 0x14, 0x86,
+	0x3e, 0x9d, 0xaf, 0x9a, 0x4e, 0xad, 0xa5, 0xcb, 0x06, 0xa9, 0x7b, 0x65, 0xe9, 0x6e, 0xf8, 0x18,
+	0x8f, 0x22, 0x28, 0xce, 0x4e, 0x03, 0xdb, 0x7a, 0x86, 0xfe, 0xcb, 0x6f, 0xee, 0xff, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0x43, 0x4b, 0xa9, 0xc6, 0x66, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1123,6 +1438,15 @@ type QueryClient interface {
 	// FinalizedChainInfoUntilHeight queries the BTC-finalised info no later than
 	// the provided CZ height, with proofs
 	FinalizedChainInfoUntilHeight(ctx context.Context, in *QueryFinalizedChainInfoUntilHeightRequest, opts ...grpc.CallOption) (*QueryFinalizedChainInfoUntilHeightResponse, error)
+	// FinalizedHeaderBundle queries the CZ header at the provided height along
+	// with everything needed to verify that it is BTC-finalised, packaged as a
+	// single bundle
+	FinalizedHeaderBundle(ctx context.Context, in *QueryFinalizedHeaderBundleRequest, opts ...grpc.CallOption) (*QueryFinalizedHeaderBundleResponse, error)
+	// Consumers queries all consumer chains in the consumer registry
+	Consumers(ctx context.Context, in *QueryConsumersRequest, opts ...grpc.CallOption) (*QueryConsumersResponse, error)
+	// Consumer queries the registration of a consumer chain in the consumer
+	// registry
+	Consumer(ctx context.Context, in *QueryConsumerRequest, opts ...grpc.CallOption) (*QueryConsumerResponse, error)
 }
 
 type queryClient struct {
@@ -1214,6 +1538,33 @@ func (c *queryClient) FinalizedChainInfoUntilHeight(ctx context.Context, in *Que
 	return out, nil
 }
 
+func (c *queryClient) FinalizedHeaderBundle(ctx context.Context, in *QueryFinalizedHeaderBundleRequest, opts ...grpc.CallOption) (*QueryFinalizedHeaderBundleResponse, error) {
+	out := new(QueryFinalizedHeaderBundleResponse)
+	err := c.cc.Invoke(ctx, "/babylon.zoneconcierge.v1.Query/FinalizedHeaderBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Consumers(ctx context.Context, in *QueryConsumersRequest, opts ...grpc.CallOption) (*QueryConsumersResponse, error) {
+	out := new(QueryConsumersResponse)
+	err := c.cc.Invoke(ctx, "/babylon.zoneconcierge.v1.Query/Consumers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Consumer(ctx context.Context, in *QueryConsumerRequest, opts ...grpc.CallOption) (*QueryConsumerResponse, error) {
+	out := new(QueryConsumerResponse)
+	err := c.cc.Invoke(ctx, "/babylon.zoneconcierge.v1.Query/Consumer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of the module.
@@ -1238,6 +1589,15 @@ type QueryServer interface {
 	// FinalizedChainInfoUntilHeight queries the BTC-finalised info no later than
 	// the provided CZ height, with proofs
 	FinalizedChainInfoUntilHeight(context.Context, *QueryFinalizedChainInfoUntilHeightRequest) (*QueryFinalizedChainInfoUntilHeightResponse, error)
+	// FinalizedHeaderBundle queries the CZ header at the provided height along
+	// with everything needed to verify that it is BTC-finalised, packaged as a
+	// single bundle
+	FinalizedHeaderBundle(context.Context, *QueryFinalizedHeaderBundleRequest) (*QueryFinalizedHeaderBundleResponse, error)
+	// Consumers queries all consumer chains in the consumer registry
+	Consumers(context.Context, *QueryConsumersRequest) (*QueryConsumersResponse, error)
+	// Consumer queries the registration of a consumer chain in the consumer
+	// registry
+	Consumer(context.Context, *QueryConsumerRequest) (*QueryConsumerResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1271,6 +1631,15 @@ func (*UnimplementedQueryServer) FinalizedChainsInfo(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) FinalizedChainInfoUntilHeight(ctx context.Context, req *QueryFinalizedChainInfoUntilHeightRequest) (*QueryFinalizedChainInfoUntilHeightResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinalizedChainInfoUntilHeight not implemented")
 }
+func (*UnimplementedQueryServer) FinalizedHeaderBundle(ctx context.Context, req *QueryFinalizedHeaderBundleRequest) (*QueryFinalizedHeaderBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizedHeaderBundle not implemented")
+}
+func (*UnimplementedQueryServer) Consumers(ctx context.Context, req *QueryConsumersRequest) (*QueryConsumersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Consumers not implemented")
+}
+func (*UnimplementedQueryServer) Consumer(ctx context.Context, req *QueryConsumerRequest) (*QueryConsumerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Consumer not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1438,6 +1807,60 @@ func _Query_FinalizedChainInfoUntilHeight_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FinalizedHeaderBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalizedHeaderBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalizedHeaderBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.zoneconcierge.v1.Query/FinalizedHeaderBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalizedHeaderBundle(ctx, req.(*QueryFinalizedHeaderBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Consumers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Consumers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.zoneconcierge.v1.Query/Consumers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Consumers(ctx, req.(*QueryConsumersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Consumer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Consumer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.zoneconcierge.v1.Query/Consumer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Consumer(ctx, req.(*QueryConsumerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.zoneconcierge.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1478,6 +1901,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FinalizedChainInfoUntilHeight",
 			Handler:    _Query_FinalizedChainInfoUntilHeight_Handler,
 		},
+		{
+			MethodName: "FinalizedHeaderBundle",
+			Handler:    _Query_FinalizedHeaderBundle_Handler,
+		},
+		{
+			MethodName: "Consumers",
+			Handler:    _Query_Consumers_Handler,
+		},
+		{
+			MethodName: "Consumer",
+			Handler:    _Query_Consumer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/zoneconcierge/v1/query.proto",
@@ -2213,15 +2648,234 @@ func (m *QueryFinalizedChainInfoUntilHeightResponse) MarshalToSizedBuffer(dAtA [
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryFinalizedHeaderBundleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalizedHeaderBundleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalizedHeaderBundleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalizedHeaderBundleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalizedHeaderBundleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalizedHeaderBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Bundle != nil {
+		{
+			size, err := m.Bundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Consumers) > 0 {
+		for iNdEx := len(m.Consumers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Consumers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Consumer != nil {
+		{
+			size, err := m.Consumer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
 func (m *QueryParamsRequest) Size() (n int) {
@@ -2520,13 +3174,660 @@ func (m *QueryFinalizedChainInfoUntilHeightResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryFinalizedHeaderBundleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryFinalizedHeaderBundleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bundle != nil {
+		l = m.Bundle.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Consumers) > 0 {
+		for _, e := range m.Consumers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Consumer != nil {
+		l = m.Consumer.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeaderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeaderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeaderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeaderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &IndexedHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkHeaders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ForkHeaders == nil {
+				m.ForkHeaders = &Forks{}
+			}
+			if err := m.ForkHeaders.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainIds = append(m.ChainIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChainsInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2549,12 +3850,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChainsInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChainsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainIds = append(m.ChainIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2576,7 +3909,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChainsInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2599,15 +3932,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChainsInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChainsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainsInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2634,7 +3967,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChainsInfo = append(m.ChainsInfo, &ChainInfo{})
+			if err := m.ChainsInfo[len(m.ChainsInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2659,7 +3993,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeaderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochChainsInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2682,17 +4016,17 @@ func (m *QueryHeaderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeaderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochChainsInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochChainsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
 			}
-			var stringLen uint64
+			m.EpochNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2702,29 +4036,16 @@ func (m *QueryHeaderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochNum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2734,11 +4055,24 @@ func (m *QueryHeaderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainIds = append(m.ChainIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2760,7 +4094,7 @@ func (m *QueryHeaderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeaderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochChainsInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2783,51 +4117,15 @@ func (m *QueryHeaderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeaderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochChainsInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochChainsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Header == nil {
-				m.Header = &IndexedHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForkHeaders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainsInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2854,10 +4152,8 @@ func (m *QueryHeaderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ForkHeaders == nil {
-				m.ForkHeaders = &Forks{}
-			}
-			if err := m.ForkHeaders.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChainsInfo = append(m.ChainsInfo, &ChainInfo{})
+			if err := m.ChainsInfo[len(m.ChainsInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2882,7 +4178,7 @@ func (m *QueryHeaderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChainListRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListHeadersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2905,13 +4201,45 @@ func (m *QueryChainListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChainListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListHeadersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChainListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListHeadersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2968,7 +4296,7 @@ func (m *QueryChainListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChainListResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListHeadersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2991,17 +4319,17 @@ func (m *QueryChainListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChainListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListHeadersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChainListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListHeadersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3011,23 +4339,25 @@ func (m *QueryChainListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainIds = append(m.ChainIds, string(dAtA[iNdEx:postIndex]))
+			m.Headers = append(m.Headers, &IndexedHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3086,7 +4416,7 @@ func (m *QueryChainListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChainsInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListEpochHeadersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3109,15 +4439,34 @@ func (m *QueryChainsInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChainsInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListEpochHeadersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChainsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListEpochHeadersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3145,7 +4494,7 @@ func (m *QueryChainsInfoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainIds = append(m.ChainIds, string(dAtA[iNdEx:postIndex]))
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3168,7 +4517,7 @@ func (m *QueryChainsInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChainsInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListEpochHeadersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3191,15 +4540,15 @@ func (m *QueryChainsInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChainsInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListEpochHeadersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChainsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListEpochHeadersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainsInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3226,8 +4575,8 @@ func (m *QueryChainsInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainsInfo = append(m.ChainsInfo, &ChainInfo{})
-			if err := m.ChainsInfo[len(m.ChainsInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Headers = append(m.Headers, &IndexedHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3252,7 +4601,7 @@ func (m *QueryChainsInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochChainsInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalizedChainsInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3275,32 +4624,13 @@ func (m *QueryEpochChainsInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochChainsInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalizedChainsInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochChainsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalizedChainsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
-			}
-			m.EpochNum = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochNum |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
 			}
@@ -3332,6 +4662,26 @@ func (m *QueryEpochChainsInfoRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainIds = append(m.ChainIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Prove = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3353,7 +4703,7 @@ func (m *QueryEpochChainsInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochChainsInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalizedChainsInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3376,15 +4726,15 @@ func (m *QueryEpochChainsInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochChainsInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalizedChainsInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochChainsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalizedChainsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainsInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedChainsInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3411,8 +4761,8 @@ func (m *QueryEpochChainsInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainsInfo = append(m.ChainsInfo, &ChainInfo{})
-			if err := m.ChainsInfo[len(m.ChainsInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FinalizedChainsInfo = append(m.FinalizedChainsInfo, &FinalizedChainInfo{})
+			if err := m.FinalizedChainsInfo[len(m.FinalizedChainsInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3437,7 +4787,7 @@ func (m *QueryEpochChainsInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListHeadersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalizedChainInfoUntilHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3460,10 +4810,10 @@ func (m *QueryListHeadersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListHeadersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalizedChainInfoUntilHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListHeadersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalizedChainInfoUntilHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3499,10 +4849,10 @@ func (m *QueryListHeadersRequest) Unmarshal(dAtA []byte) error {
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3512,28 +4862,31 @@ func (m *QueryListHeadersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prove", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Prove = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3555,7 +4908,7 @@ func (m *QueryListHeadersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListHeadersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalizedChainInfoUntilHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3578,15 +4931,15 @@ func (m *QueryListHeadersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListHeadersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalizedChainInfoUntilHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListHeadersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalizedChainInfoUntilHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedChainInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3613,14 +4966,16 @@ func (m *QueryListHeadersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Headers = append(m.Headers, &IndexedHeader{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.FinalizedChainInfo == nil {
+				m.FinalizedChainInfo = &ChainInfo{}
+			}
+			if err := m.FinalizedChainInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3647,68 +5002,18 @@ func (m *QueryListHeadersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.EpochInfo == nil {
+				m.EpochInfo = &types.Epoch{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EpochInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryListEpochHeadersRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListEpochHeadersRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListEpochHeadersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawCheckpoint", wireType)
 			}
-			m.EpochNum = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3718,16 +5023,33 @@ func (m *QueryListEpochHeadersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNum |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RawCheckpoint == nil {
+				m.RawCheckpoint = &types1.RawCheckpoint{}
+			}
+			if err := m.RawCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcSubmissionKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3737,77 +5059,31 @@ func (m *QueryListEpochHeadersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryListEpochHeadersResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.BtcSubmissionKey == nil {
+				m.BtcSubmissionKey = &types2.SubmissionKey{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.BtcSubmissionKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListEpochHeadersResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListEpochHeadersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3834,8 +5110,10 @@ func (m *QueryListEpochHeadersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Headers = append(m.Headers, &IndexedHeader{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Proof == nil {
+				m.Proof = &ProofFinalizedChainInfo{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3860,7 +5138,7 @@ func (m *QueryListEpochHeadersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalizedChainsInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalizedHeaderBundleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3883,15 +5161,15 @@ func (m *QueryFinalizedChainsInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalizedChainsInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalizedHeaderBundleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalizedChainsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalizedHeaderBundleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3919,13 +5197,13 @@ func (m *QueryFinalizedChainsInfoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainIds = append(m.ChainIds, string(dAtA[iNdEx:postIndex]))
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var v int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3935,12 +5213,11 @@ func (m *QueryFinalizedChainsInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Prove = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3962,7 +5239,7 @@ func (m *QueryFinalizedChainsInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalizedChainsInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalizedHeaderBundleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3985,15 +5262,15 @@ func (m *QueryFinalizedChainsInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalizedChainsInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalizedHeaderBundleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalizedChainsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalizedHeaderBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedChainsInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bundle", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4020,8 +5297,10 @@ func (m *QueryFinalizedChainsInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FinalizedChainsInfo = append(m.FinalizedChainsInfo, &FinalizedChainInfo{})
-			if err := m.FinalizedChainsInfo[len(m.FinalizedChainsInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Bundle == nil {
+				m.Bundle = &FinalizedHeaderBundle{}
+			}
+			if err := m.Bundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4046,7 +5325,7 @@ func (m *QueryFinalizedChainsInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalizedChainInfoUntilHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4069,17 +5348,17 @@ func (m *QueryFinalizedChainInfoUntilHeightRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalizedChainInfoUntilHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalizedChainInfoUntilHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4089,63 +5368,28 @@ func (m *QueryFinalizedChainInfoUntilHeightRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prove", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Prove = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4167,7 +5411,7 @@ func (m *QueryFinalizedChainInfoUntilHeightRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryFinalizedChainInfoUntilHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4190,15 +5434,15 @@ func (m *QueryFinalizedChainInfoUntilHeightResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalizedChainInfoUntilHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalizedChainInfoUntilHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedChainInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4225,16 +5469,14 @@ func (m *QueryFinalizedChainInfoUntilHeightResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinalizedChainInfo == nil {
-				m.FinalizedChainInfo = &ChainInfo{}
-			}
-			if err := m.FinalizedChainInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Consumers = append(m.Consumers, &ConsumerRegister{})
+			if err := m.Consumers[len(m.Consumers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4261,54 +5503,68 @@ func (m *QueryFinalizedChainInfoUntilHeightResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EpochInfo == nil {
-				m.EpochInfo = &types.Epoch{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.EpochInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawCheckpoint", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RawCheckpoint == nil {
-				m.RawCheckpoint = &types1.RawCheckpoint{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if err := m.RawCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcSubmissionKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4318,31 +5574,77 @@ func (m *QueryFinalizedChainInfoUntilHeightResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BtcSubmissionKey == nil {
-				m.BtcSubmissionKey = &types2.SubmissionKey{}
-			}
-			if err := m.BtcSubmissionKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4369,10 +5671,10 @@ func (m *QueryFinalizedChainInfoUntilHeightResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Proof == nil {
-				m.Proof = &ProofFinalizedChainInfo{}
+			if m.Consumer == nil {
+				m.Consumer = &ConsumerRegister{}
 			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Consumer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
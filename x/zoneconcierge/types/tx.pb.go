@@ -127,36 +127,235 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgRegisterConsumer defines a message for registering a consumer chain in
+// the consumer registry.
+type MsgRegisterConsumer struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// consumer is the registration of the consumer chain
+	Consumer ConsumerRegister `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer"`
+}
+
+func (m *MsgRegisterConsumer) Reset()         { *m = MsgRegisterConsumer{} }
+func (m *MsgRegisterConsumer) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterConsumer) ProtoMessage()    {}
+func (*MsgRegisterConsumer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35e2112d987e4e18, []int{2}
+}
+func (m *MsgRegisterConsumer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterConsumer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterConsumer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterConsumer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterConsumer.Merge(m, src)
+}
+func (m *MsgRegisterConsumer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterConsumer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterConsumer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterConsumer proto.InternalMessageInfo
+
+func (m *MsgRegisterConsumer) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRegisterConsumer) GetConsumer() ConsumerRegister {
+	if m != nil {
+		return m.Consumer
+	}
+	return ConsumerRegister{}
+}
+
+// MsgRegisterConsumerResponse is the response to the MsgRegisterConsumer message.
+type MsgRegisterConsumerResponse struct {
+}
+
+func (m *MsgRegisterConsumerResponse) Reset()         { *m = MsgRegisterConsumerResponse{} }
+func (m *MsgRegisterConsumerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterConsumerResponse) ProtoMessage()    {}
+func (*MsgRegisterConsumerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35e2112d987e4e18, []int{3}
+}
+func (m *MsgRegisterConsumerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterConsumerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterConsumerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterConsumerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterConsumerResponse.Merge(m, src)
+}
+func (m *MsgRegisterConsumerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterConsumerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterConsumerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterConsumerResponse proto.InternalMessageInfo
+
+// MsgDeregisterConsumer defines a message for removing a consumer chain from
+// the consumer registry.
+type MsgDeregisterConsumer struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// consumer_id is the chain ID of the consumer chain
+	ConsumerId string `protobuf:"bytes,2,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+}
+
+func (m *MsgDeregisterConsumer) Reset()         { *m = MsgDeregisterConsumer{} }
+func (m *MsgDeregisterConsumer) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterConsumer) ProtoMessage()    {}
+func (*MsgDeregisterConsumer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35e2112d987e4e18, []int{4}
+}
+func (m *MsgDeregisterConsumer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterConsumer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterConsumer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeregisterConsumer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterConsumer.Merge(m, src)
+}
+func (m *MsgDeregisterConsumer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterConsumer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterConsumer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeregisterConsumer proto.InternalMessageInfo
+
+func (m *MsgDeregisterConsumer) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgDeregisterConsumer) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+// MsgDeregisterConsumerResponse is the response to the MsgDeregisterConsumer message.
+type MsgDeregisterConsumerResponse struct {
+}
+
+func (m *MsgDeregisterConsumerResponse) Reset()         { *m = MsgDeregisterConsumerResponse{} }
+func (m *MsgDeregisterConsumerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterConsumerResponse) ProtoMessage()    {}
+func (*MsgDeregisterConsumerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35e2112d987e4e18, []int{5}
+}
+func (m *MsgDeregisterConsumerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterConsumerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterConsumerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeregisterConsumerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterConsumerResponse.Merge(m, src)
+}
+func (m *MsgDeregisterConsumerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterConsumerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterConsumerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeregisterConsumerResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.zoneconcierge.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.zoneconcierge.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRegisterConsumer)(nil), "babylon.zoneconcierge.v1.MsgRegisterConsumer")
+	proto.RegisterType((*MsgRegisterConsumerResponse)(nil), "babylon.zoneconcierge.v1.MsgRegisterConsumerResponse")
+	proto.RegisterType((*MsgDeregisterConsumer)(nil), "babylon.zoneconcierge.v1.MsgDeregisterConsumer")
+	proto.RegisterType((*MsgDeregisterConsumerResponse)(nil), "babylon.zoneconcierge.v1.MsgDeregisterConsumerResponse")
 }
 
 func init() { proto.RegisterFile("babylon/zoneconcierge/v1/tx.proto", fileDescriptor_35e2112d987e4e18) }
 
 var fileDescriptor_35e2112d987e4e18 = []byte{
-	// 331 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0xaf, 0xca, 0xcf, 0x4b, 0x4d, 0xce, 0xcf, 0x4b, 0xce, 0x4c, 0x2d, 0x4a,
-	0x4f, 0xd5, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x80,
-	0x2a, 0xd1, 0x43, 0x51, 0xa2, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa4,
-	0x0f, 0x62, 0x41, 0xd4, 0x4b, 0x49, 0x26, 0xe7, 0x17, 0xe7, 0xe6, 0x17, 0xc7, 0x43, 0x24, 0x20,
-	0x1c, 0xa8, 0x94, 0x38, 0x84, 0xa7, 0x9f, 0x5b, 0x9c, 0x0e, 0xb2, 0x22, 0xb7, 0x38, 0x1d, 0x2a,
-	0xa1, 0x8a, 0xd3, 0x19, 0x05, 0x89, 0x45, 0x89, 0xb9, 0x50, 0xfd, 0x4a, 0x33, 0x19, 0xb9, 0xf8,
-	0x7d, 0x8b, 0xd3, 0x43, 0x0b, 0x52, 0x12, 0x4b, 0x52, 0x03, 0xc0, 0x32, 0x42, 0x66, 0x5c, 0x9c,
-	0x89, 0xa5, 0x25, 0x19, 0xf9, 0x45, 0x99, 0x25, 0x95, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e,
-	0x12, 0x97, 0xb6, 0xe8, 0x8a, 0x40, 0x2d, 0x76, 0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x0e, 0x2e,
-	0x29, 0xca, 0xcc, 0x4b, 0x0f, 0x42, 0x28, 0x15, 0xb2, 0xe3, 0x62, 0x83, 0x98, 0x2d, 0xc1, 0xa4,
-	0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xa0, 0x87, 0xcb, 0x9f, 0x7a, 0x10, 0x9b, 0x9c, 0x58, 0x4e, 0xdc,
-	0x93, 0x67, 0x08, 0x82, 0xea, 0xb2, 0xe2, 0x6b, 0x7a, 0xbe, 0x41, 0x0b, 0x61, 0x9e, 0x92, 0x24,
-	0x97, 0x38, 0x9a, 0xd3, 0x82, 0x52, 0x8b, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x8d, 0xaa, 0xb8, 0x98,
-	0x7d, 0x8b, 0xd3, 0x85, 0x72, 0xb8, 0x78, 0x50, 0x5c, 0xae, 0x89, 0xdb, 0x46, 0x34, 0x93, 0xa4,
-	0x0c, 0x89, 0x56, 0x0a, 0xb3, 0x54, 0x8a, 0xb5, 0xe1, 0xf9, 0x06, 0x2d, 0x46, 0x27, 0xff, 0x13,
-	0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86,
-	0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d,
-	0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x9a, 0x9e, 0x9c, 0x91, 0x98, 0x99, 0x07, 0xe3, 0xe8, 0x57,
-	0xa0, 0xc5, 0x46, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x2a, 0x8c, 0x01, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x05, 0xf5, 0x03, 0x87, 0x3a, 0x02, 0x00, 0x00,
+	// 480 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x6b, 0xd4, 0x40,
+	0x18, 0xc6, 0x77, 0xaa, 0x16, 0xf7, 0xad, 0xa8, 0xc4, 0x4a, 0xd3, 0x48, 0xb3, 0xeb, 0x82, 0x50,
+	0x17, 0x9b, 0x61, 0x2b, 0x55, 0xf0, 0x20, 0xb8, 0x7a, 0x11, 0x0c, 0x4a, 0xc4, 0x8b, 0x97, 0x92,
+	0x3f, 0xc3, 0x6c, 0xa0, 0xc9, 0x84, 0x99, 0xd9, 0xb2, 0xeb, 0x41, 0x8a, 0x9f, 0xc0, 0xab, 0x9f,
+	0xc1, 0x4b, 0x0f, 0x7e, 0x88, 0x1e, 0x8b, 0x27, 0x4f, 0x22, 0xbb, 0x87, 0x7e, 0x01, 0x3f, 0x80,
+	0x34, 0x99, 0xd9, 0xb2, 0xc9, 0xa6, 0x58, 0xf1, 0xb6, 0xb3, 0xef, 0xf3, 0x3e, 0xcf, 0xef, 0x9d,
+	0x37, 0x09, 0xdc, 0x0d, 0xfc, 0x60, 0xbc, 0xc7, 0x52, 0xfc, 0x81, 0xa5, 0x24, 0x64, 0x69, 0x18,
+	0x13, 0x4e, 0x09, 0xde, 0xef, 0x61, 0x39, 0x72, 0x32, 0xce, 0x24, 0x33, 0x4c, 0x25, 0x71, 0xe6,
+	0x24, 0xce, 0x7e, 0xcf, 0x5a, 0xa5, 0x8c, 0xb2, 0x5c, 0x84, 0x4f, 0x7f, 0x15, 0x7a, 0x6b, 0x3d,
+	0x64, 0x22, 0x61, 0x62, 0xb7, 0x28, 0x14, 0x07, 0x55, 0x5a, 0x2b, 0x4e, 0x38, 0x11, 0xf4, 0x34,
+	0x22, 0x11, 0x54, 0x15, 0xee, 0xd5, 0x62, 0x64, 0x3e, 0xf7, 0x13, 0xdd, 0xff, 0xa0, 0x56, 0x36,
+	0xcf, 0x96, 0xab, 0x3b, 0x5f, 0x10, 0xdc, 0x70, 0x05, 0x7d, 0x97, 0x45, 0xbe, 0x24, 0x6f, 0x72,
+	0x1f, 0xe3, 0x11, 0x34, 0xfd, 0xa1, 0x1c, 0x30, 0x1e, 0xcb, 0xb1, 0x89, 0xda, 0x68, 0xb3, 0xd9,
+	0x37, 0xbf, 0x7f, 0xdb, 0x5a, 0x55, 0x98, 0xcf, 0xa2, 0x88, 0x13, 0x21, 0xde, 0x4a, 0x1e, 0xa7,
+	0xd4, 0x3b, 0x93, 0x1a, 0x4f, 0x61, 0xb9, 0x20, 0x31, 0x97, 0xda, 0x68, 0x73, 0x65, 0xbb, 0xed,
+	0xd4, 0xdd, 0x8a, 0x53, 0x24, 0xf5, 0x2f, 0x1f, 0xfd, 0x6c, 0x35, 0x3c, 0xd5, 0xf5, 0xe4, 0xfa,
+	0xa7, 0x93, 0xc3, 0xee, 0x99, 0x5f, 0x67, 0x1d, 0xd6, 0x4a, 0x68, 0x1e, 0x11, 0x19, 0x4b, 0x05,
+	0xe9, 0x7c, 0x45, 0x70, 0xcb, 0x15, 0xd4, 0x23, 0x34, 0x16, 0x92, 0xf0, 0xe7, 0x2c, 0x15, 0xc3,
+	0x84, 0xf0, 0x7f, 0x46, 0x7f, 0x05, 0x57, 0x43, 0xe5, 0xa1, 0xe0, 0xbb, 0xf5, 0xf0, 0x3a, 0x4d,
+	0xa7, 0xab, 0x31, 0x66, 0x0e, 0x95, 0x41, 0x36, 0xe0, 0xce, 0x02, 0xd8, 0xd9, 0x30, 0x07, 0x08,
+	0x6e, 0xbb, 0x82, 0xbe, 0x20, 0xfc, 0x7f, 0x8d, 0xd3, 0x82, 0x15, 0x0d, 0xb3, 0x1b, 0x47, 0xf9,
+	0x44, 0x4d, 0x0f, 0xf4, 0x5f, 0x2f, 0xa3, 0x0a, 0x61, 0x0b, 0x36, 0x16, 0x12, 0x68, 0xc6, 0xed,
+	0xdf, 0x4b, 0x70, 0xc9, 0x15, 0xd4, 0xd8, 0x83, 0x6b, 0x73, 0xcf, 0xca, 0xfd, 0xfa, 0x6b, 0x2a,
+	0xed, 0xce, 0xea, 0xfd, 0xb5, 0x54, 0xa7, 0x1a, 0x23, 0xb8, 0x59, 0x59, 0xf1, 0xd6, 0xb9, 0x36,
+	0x65, 0xb9, 0xb5, 0x73, 0x21, 0xf9, 0x2c, 0xf9, 0x23, 0x18, 0x0b, 0xf6, 0x81, 0xcf, 0x35, 0xab,
+	0x36, 0x58, 0x8f, 0x2f, 0xd8, 0xa0, 0xf3, 0xad, 0x2b, 0x07, 0x27, 0x87, 0x5d, 0xd4, 0x7f, 0x7d,
+	0x34, 0xb1, 0xd1, 0xf1, 0xc4, 0x46, 0xbf, 0x26, 0x36, 0xfa, 0x3c, 0xb5, 0x1b, 0xc7, 0x53, 0xbb,
+	0xf1, 0x63, 0x6a, 0x37, 0xde, 0xef, 0xd0, 0x58, 0x0e, 0x86, 0x81, 0x13, 0xb2, 0x04, 0xab, 0x8c,
+	0x70, 0xe0, 0xc7, 0xa9, 0x3e, 0xe0, 0x51, 0xe9, 0x03, 0x20, 0xc7, 0x19, 0x11, 0xc1, 0x72, 0xfe,
+	0xda, 0x3f, 0xfc, 0x13, 0x00, 0x00, 0xff, 0xff, 0x99, 0x4e, 0xde, 0x70, 0xd4, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -173,6 +372,10 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// UpdateParams updates the zoneconcierge module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterConsumer registers a consumer chain in the consumer registry.
+	RegisterConsumer(ctx context.Context, in *MsgRegisterConsumer, opts ...grpc.CallOption) (*MsgRegisterConsumerResponse, error)
+	// DeregisterConsumer removes a consumer chain from the consumer registry.
+	DeregisterConsumer(ctx context.Context, in *MsgDeregisterConsumer, opts ...grpc.CallOption) (*MsgDeregisterConsumerResponse, error)
 }
 
 type msgClient struct {
@@ -192,10 +395,32 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RegisterConsumer(ctx context.Context, in *MsgRegisterConsumer, opts ...grpc.CallOption) (*MsgRegisterConsumerResponse, error) {
+	out := new(MsgRegisterConsumerResponse)
+	err := c.cc.Invoke(ctx, "/babylon.zoneconcierge.v1.Msg/RegisterConsumer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeregisterConsumer(ctx context.Context, in *MsgDeregisterConsumer, opts ...grpc.CallOption) (*MsgDeregisterConsumerResponse, error) {
+	out := new(MsgDeregisterConsumerResponse)
+	err := c.cc.Invoke(ctx, "/babylon.zoneconcierge.v1.Msg/DeregisterConsumer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams updates the zoneconcierge module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterConsumer registers a consumer chain in the consumer registry.
+	RegisterConsumer(context.Context, *MsgRegisterConsumer) (*MsgRegisterConsumerResponse, error)
+	// DeregisterConsumer removes a consumer chain from the consumer registry.
+	DeregisterConsumer(context.Context, *MsgDeregisterConsumer) (*MsgDeregisterConsumerResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -205,6 +430,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) RegisterConsumer(ctx context.Context, req *MsgRegisterConsumer) (*MsgRegisterConsumerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterConsumer not implemented")
+}
+func (*UnimplementedMsgServer) DeregisterConsumer(ctx context.Context, req *MsgDeregisterConsumer) (*MsgDeregisterConsumerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterConsumer not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -228,6 +459,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterConsumer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterConsumer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterConsumer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.zoneconcierge.v1.Msg/RegisterConsumer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterConsumer(ctx, req.(*MsgRegisterConsumer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeregisterConsumer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterConsumer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterConsumer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.zoneconcierge.v1.Msg/DeregisterConsumer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterConsumer(ctx, req.(*MsgDeregisterConsumer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.zoneconcierge.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -236,6 +503,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RegisterConsumer",
+			Handler:    _Msg_RegisterConsumer_Handler,
+		},
+		{
+			MethodName: "DeregisterConsumer",
+			Handler:    _Msg_DeregisterConsumer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/zoneconcierge/v1/tx.proto",
@@ -304,69 +579,242 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRegisterConsumer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRegisterConsumer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterConsumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Consumer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterConsumerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterConsumerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterConsumerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgDeregisterConsumer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgDeregisterConsumer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
+
+func (m *MsgDeregisterConsumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterConsumerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterConsumerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterConsumerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterConsumer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Consumer.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterConsumerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeregisterConsumer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeregisterConsumerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
 		if wireType == 4 {
 			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
@@ -510,6 +958,335 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRegisterConsumer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterConsumer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Consumer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterConsumerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterConsumerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterConsumerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterConsumer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterConsumer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterConsumerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterConsumerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterConsumerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
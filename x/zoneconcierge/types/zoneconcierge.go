@@ -2,11 +2,14 @@ package types
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"fmt"
 
 	"cosmossdk.io/store/rootmulti"
 	"github.com/cometbft/cometbft/crypto/merkle"
 	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	bbn "github.com/babylonchain/babylon/types"
 )
 
 // VerifyStore verifies whether a KV pair is committed to the Merkle root, with the assistance of a Merkle proof
@@ -129,6 +132,56 @@ func (ci *ChainInfo) ValidateBasic() error {
 	return nil
 }
 
+// ValidateBasic ensures the archived chain info snapshot is consistent with
+// its bundle, i.e., the bundle proves the latest header in the snapshot if the
+// header is timestamped in the snapshot's epoch
+func (a *ArchivedEpochChainInfo) ValidateBasic() error {
+	if a.ChainInfo == nil {
+		return ErrInvalidChainInfo.Wrap("ChainInfo is nil")
+	}
+	if err := a.ChainInfo.ValidateBasic(); err != nil {
+		return err
+	}
+	latestHeader := a.ChainInfo.LatestHeader
+	if latestHeader.BabylonEpoch > a.EpochNum {
+		return ErrInvalidChainInfo.Wrapf("the latest header is timestamped in epoch %d after the snapshot's epoch %d", latestHeader.BabylonEpoch, a.EpochNum)
+	}
+	if latestHeader.BabylonEpoch < a.EpochNum {
+		// no header is timestamped in this epoch, thus nothing to prove
+		if a.Bundle != nil {
+			return ErrInvalidChainInfo.Wrapf("no header is timestamped in epoch %d, but the bundle is not nil", a.EpochNum)
+		}
+		return nil
+	}
+	if a.Bundle == nil || a.Bundle.Header == nil || a.Bundle.EpochInfo == nil {
+		return ErrInvalidChainInfo.Wrapf("the bundle of epoch %d is incomplete", a.EpochNum)
+	}
+	if !a.Bundle.Header.Equal(latestHeader) {
+		return ErrInvalidChainInfo.Wrap("the header in the bundle is not the latest header in the snapshot")
+	}
+	if a.Bundle.EpochInfo.EpochNumber != a.EpochNum {
+		return ErrInvalidChainInfo.Wrapf("the bundle is of epoch %d rather than %d", a.Bundle.EpochInfo.EpochNumber, a.EpochNum)
+	}
+	return nil
+}
+
+// ValidateBasic ensures the snapshots in the archive belong to the archive's
+// CZ and are in the strictly ascending order of epochs
+func (a *ChainInfoArchive) ValidateBasic() error {
+	for i, archived := range a.EpochChainInfos {
+		if err := archived.ValidateBasic(); err != nil {
+			return err
+		}
+		if archived.ChainInfo.ChainId != a.ChainId {
+			return ErrInvalidChainInfo.Wrapf("the snapshot of epoch %d is of chain %s rather than %s", archived.EpochNum, archived.ChainInfo.ChainId, a.ChainId)
+		}
+		if i > 0 && archived.EpochNum <= a.EpochChainInfos[i-1].EpochNum {
+			return ErrInvalidChainInfo.Wrap("the snapshots are not in the ascending order of epochs")
+		}
+	}
+	return nil
+}
+
 func NewBTCTimestampPacketData(btcTimestamp *BTCTimestamp) *ZoneconciergePacketData {
 	return &ZoneconciergePacketData{
 		Packet: &ZoneconciergePacketData_BtcTimestamp{
@@ -136,3 +189,13 @@ func NewBTCTimestampPacketData(btcTimestamp *BTCTimestamp) *ZoneconciergePacketD
 		},
 	}
 }
+
+// CovenantPKsHash returns the SHA256 hash of the concatenation of the given
+// covenant PKs, in the order they are specified in the BTC staking params
+func CovenantPKsHash(covenantPKs []bbn.BIP340PubKey) []byte {
+	h := sha256.New()
+	for _, pk := range covenantPKs {
+		h.Write(pk.MustMarshal())
+	}
+	return h.Sum(nil)
+}
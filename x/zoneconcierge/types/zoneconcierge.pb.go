@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
 	types2 "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	types3 "github.com/babylonchain/babylon/x/btclightclient/types"
 	types1 "github.com/babylonchain/babylon/x/checkpointing/types"
@@ -521,23 +522,37 @@ func (m *ProofFinalizedChainInfo) GetProofEpochSubmitted() []*types2.Transaction
 	return nil
 }
 
-// Btc light client chain segment grown during last finalized epoch
-type BTCChainSegment struct {
-	BtcHeaders []*types3.BTCHeaderInfo `protobuf:"bytes,1,rep,name=btc_headers,json=btcHeaders,proto3" json:"btc_headers,omitempty"`
+// FinalizedHeaderBundle is a self-contained bundle for verifying that a CZ
+// header is BTC-finalised. It includes the CZ header, the epoch that
+// timestamps the CZ header, the epoch's raw checkpoint, and the proofs that
+// the CZ header is timestamped in the epoch, the epoch is sealed, and the
+// epoch's checkpoint is included in BTC.
+type FinalizedHeaderBundle struct {
+	// header is the CZ header
+	Header *IndexedHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// epoch_info is the metadata of the epoch that timestamps the CZ header
+	EpochInfo *types.Epoch `protobuf:"bytes,2,opt,name=epoch_info,json=epochInfo,proto3" json:"epoch_info,omitempty"`
+	// raw_checkpoint is the raw checkpoint of this epoch
+	RawCheckpoint *types1.RawCheckpoint `protobuf:"bytes,3,opt,name=raw_checkpoint,json=rawCheckpoint,proto3" json:"raw_checkpoint,omitempty"`
+	// btc_submission_key is position of two BTC txs that include the raw
+	// checkpoint of this epoch
+	BtcSubmissionKey *types2.SubmissionKey `protobuf:"bytes,4,opt,name=btc_submission_key,json=btcSubmissionKey,proto3" json:"btc_submission_key,omitempty"`
+	// proof is the proof that the CZ header is BTC-finalised
+	Proof *ProofFinalizedChainInfo `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
 }
 
-func (m *BTCChainSegment) Reset()         { *m = BTCChainSegment{} }
-func (m *BTCChainSegment) String() string { return proto.CompactTextString(m) }
-func (*BTCChainSegment) ProtoMessage()    {}
-func (*BTCChainSegment) Descriptor() ([]byte, []int) {
+func (m *FinalizedHeaderBundle) Reset()         { *m = FinalizedHeaderBundle{} }
+func (m *FinalizedHeaderBundle) String() string { return proto.CompactTextString(m) }
+func (*FinalizedHeaderBundle) ProtoMessage()    {}
+func (*FinalizedHeaderBundle) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ab886e1868e5c5cd, []int{6}
 }
-func (m *BTCChainSegment) XXX_Unmarshal(b []byte) error {
+func (m *FinalizedHeaderBundle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BTCChainSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FinalizedHeaderBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BTCChainSegment.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FinalizedHeaderBundle.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -547,486 +562,726 @@ func (m *BTCChainSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *BTCChainSegment) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BTCChainSegment.Merge(m, src)
+func (m *FinalizedHeaderBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizedHeaderBundle.Merge(m, src)
 }
-func (m *BTCChainSegment) XXX_Size() int {
+func (m *FinalizedHeaderBundle) XXX_Size() int {
 	return m.Size()
 }
-func (m *BTCChainSegment) XXX_DiscardUnknown() {
-	xxx_messageInfo_BTCChainSegment.DiscardUnknown(m)
+func (m *FinalizedHeaderBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizedHeaderBundle.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BTCChainSegment proto.InternalMessageInfo
+var xxx_messageInfo_FinalizedHeaderBundle proto.InternalMessageInfo
 
-func (m *BTCChainSegment) GetBtcHeaders() []*types3.BTCHeaderInfo {
+func (m *FinalizedHeaderBundle) GetHeader() *IndexedHeader {
 	if m != nil {
-		return m.BtcHeaders
+		return m.Header
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*IndexedHeader)(nil), "babylon.zoneconcierge.v1.IndexedHeader")
-	proto.RegisterType((*Forks)(nil), "babylon.zoneconcierge.v1.Forks")
-	proto.RegisterType((*ChainInfo)(nil), "babylon.zoneconcierge.v1.ChainInfo")
-	proto.RegisterType((*FinalizedChainInfo)(nil), "babylon.zoneconcierge.v1.FinalizedChainInfo")
-	proto.RegisterType((*ProofEpochSealed)(nil), "babylon.zoneconcierge.v1.ProofEpochSealed")
-	proto.RegisterType((*ProofFinalizedChainInfo)(nil), "babylon.zoneconcierge.v1.ProofFinalizedChainInfo")
-	proto.RegisterType((*BTCChainSegment)(nil), "babylon.zoneconcierge.v1.BTCChainSegment")
+func (m *FinalizedHeaderBundle) GetEpochInfo() *types.Epoch {
+	if m != nil {
+		return m.EpochInfo
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("babylon/zoneconcierge/v1/zoneconcierge.proto", fileDescriptor_ab886e1868e5c5cd)
+func (m *FinalizedHeaderBundle) GetRawCheckpoint() *types1.RawCheckpoint {
+	if m != nil {
+		return m.RawCheckpoint
+	}
+	return nil
 }
 
-var fileDescriptor_ab886e1868e5c5cd = []byte{
-	// 928 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xdf, 0x6e, 0x1b, 0xc5,
-	0x17, 0xce, 0xc6, 0x4e, 0xd2, 0x1c, 0xc7, 0x6d, 0x7e, 0x93, 0xf4, 0xd7, 0x6d, 0x10, 0x8e, 0xe5,
-	0x4a, 0xc5, 0x45, 0xb0, 0x96, 0x0d, 0x5c, 0xc0, 0x1d, 0xb6, 0x5a, 0x9a, 0x82, 0x28, 0x5a, 0xbb,
-	0x05, 0x21, 0xd0, 0x6a, 0xff, 0x8c, 0x77, 0x57, 0x59, 0xef, 0x58, 0xbb, 0x13, 0x37, 0xce, 0x53,
-	0xf4, 0x2d, 0xb8, 0xe6, 0x01, 0xb8, 0xe7, 0xb2, 0x97, 0xdc, 0x81, 0x92, 0x57, 0xe0, 0x86, 0x3b,
-	0x34, 0x67, 0x66, 0xd6, 0xbb, 0x8d, 0x4c, 0xe0, 0x26, 0xda, 0x99, 0xf9, 0xce, 0x77, 0xbe, 0xf9,
-	0xce, 0x99, 0xe3, 0xc0, 0x07, 0x9e, 0xeb, 0x2d, 0x13, 0x96, 0xf6, 0x2e, 0x58, 0x4a, 0x7d, 0x96,
-	0xfa, 0x31, 0xcd, 0x42, 0xda, 0x5b, 0xf4, 0xab, 0x1b, 0xd6, 0x3c, 0x63, 0x9c, 0x11, 0x53, 0xa1,
-	0xad, 0xea, 0xe1, 0xa2, 0x7f, 0x74, 0x18, 0xb2, 0x90, 0x21, 0xa8, 0x27, 0xbe, 0x24, 0xfe, 0xe8,
-	0x38, 0x64, 0x2c, 0x4c, 0x68, 0x0f, 0x57, 0xde, 0xd9, 0xb4, 0xc7, 0xe3, 0x19, 0xcd, 0xb9, 0x3b,
-	0x9b, 0x2b, 0xc0, 0xbb, 0x9c, 0xa6, 0x01, 0xcd, 0x66, 0x71, 0xca, 0x7b, 0x7e, 0xb6, 0x9c, 0x73,
-	0x26, 0xb0, 0x6c, 0xaa, 0x8e, 0x0b, 0x75, 0x1e, 0xf7, 0xfd, 0x88, 0xfa, 0xa7, 0x73, 0x26, 0x90,
-	0x8b, 0x7e, 0x75, 0x43, 0xa1, 0x1f, 0x6a, 0xf4, 0xea, 0x24, 0x4e, 0x43, 0x44, 0x27, 0xb9, 0x73,
-	0x4a, 0x97, 0x0a, 0xf7, 0x68, 0x2d, 0xee, 0x1a, 0x65, 0x47, 0x43, 0xe9, 0x9c, 0xf9, 0x91, 0x42,
-	0xe9, 0x6f, 0x85, 0xb1, 0x4a, 0x22, 0x93, 0x38, 0x8c, 0xc4, 0x5f, 0x5a, 0xa8, 0x2c, 0xed, 0x48,
-	0x7c, 0xe7, 0x97, 0x4d, 0x68, 0x9e, 0xa4, 0x01, 0x3d, 0xa7, 0xc1, 0x53, 0xea, 0x06, 0x34, 0x23,
-	0xf7, 0xe1, 0x96, 0x1f, 0xb9, 0x71, 0xea, 0xc4, 0x81, 0x69, 0xb4, 0x8d, 0xee, 0xae, 0xbd, 0x83,
-	0xeb, 0x93, 0x80, 0x10, 0xa8, 0x47, 0x6e, 0x1e, 0x99, 0x9b, 0x6d, 0xa3, 0xbb, 0x67, 0xe3, 0x37,
-	0xf9, 0x3f, 0x6c, 0x47, 0x54, 0xd0, 0x9a, 0xb5, 0xb6, 0xd1, 0xad, 0xdb, 0x6a, 0x45, 0x3e, 0x86,
-	0xba, 0xf0, 0xd7, 0xac, 0xb7, 0x8d, 0x6e, 0x63, 0x70, 0x64, 0x49, 0xf3, 0x2d, 0x6d, 0xbe, 0x35,
-	0xd1, 0xe6, 0x0f, 0xeb, 0xaf, 0x7f, 0x3f, 0x36, 0x6c, 0x44, 0x13, 0x0b, 0x0e, 0xd4, 0x05, 0x9c,
-	0x08, 0xe5, 0x38, 0x98, 0x70, 0x0b, 0x13, 0xfe, 0x4f, 0x1d, 0x49, 0xa1, 0x4f, 0x45, 0xf6, 0x01,
-	0xdc, 0x7d, 0x1b, 0x2f, 0xc5, 0x6c, 0xa3, 0x98, 0x83, 0x6a, 0x84, 0x54, 0xf6, 0x00, 0x9a, 0x3a,
-	0x06, 0xcd, 0x33, 0x77, 0x10, 0xbb, 0xa7, 0x36, 0x1f, 0x8b, 0x3d, 0xf2, 0x10, 0xee, 0x68, 0x10,
-	0x3f, 0x97, 0x22, 0x6e, 0xa1, 0x08, 0x1d, 0x3b, 0x39, 0x17, 0x02, 0x3a, 0xcf, 0x60, 0xeb, 0x09,
-	0xcb, 0x4e, 0x73, 0xf2, 0x39, 0xec, 0x48, 0x05, 0xb9, 0x59, 0x6b, 0xd7, 0xba, 0x8d, 0xc1, 0x7b,
-	0xd6, 0xba, 0xfe, 0xb4, 0x2a, 0x86, 0xdb, 0x3a, 0xae, 0xf3, 0xa7, 0x01, 0xbb, 0x23, 0xb4, 0x3a,
-	0x9d, 0xb2, 0x7f, 0xaa, 0xc3, 0x57, 0xd0, 0x4c, 0x5c, 0x4e, 0x73, 0xae, 0x2e, 0x8d, 0x05, 0xf9,
-	0x0f, 0x19, 0xf7, 0x64, 0xb4, 0x2a, 0xf8, 0x10, 0xd4, 0xda, 0x99, 0x8a, 0x9b, 0x60, 0x1d, 0x1b,
-	0x83, 0xe3, 0xf5, 0x64, 0x78, 0x61, 0xbb, 0x21, 0x83, 0xe4, 0xed, 0x3f, 0x83, 0xfb, 0xc5, 0x6b,
-	0xa2, 0x81, 0x92, 0x95, 0x3b, 0x3e, 0x3b, 0x4b, 0x39, 0xb6, 0x40, 0xdd, 0xbe, 0x57, 0x02, 0xc8,
-	0xcc, 0xf9, 0x48, 0x1c, 0x77, 0x7e, 0xae, 0x01, 0x79, 0x12, 0xa7, 0x6e, 0x12, 0x5f, 0xd0, 0xe0,
-	0x5f, 0xdd, 0xff, 0x05, 0x1c, 0x4e, 0x75, 0x80, 0xa3, 0x40, 0xe9, 0x94, 0x29, 0x1b, 0x1e, 0xac,
-	0x57, 0x5e, 0xb0, 0xdb, 0x64, 0x7a, 0x3d, 0xe3, 0xa7, 0x00, 0xd8, 0x10, 0x92, 0xac, 0xa6, 0x1a,
-	0x57, 0x93, 0x15, 0x0f, 0x6d, 0xd1, 0xb7, 0xb0, 0x47, 0xec, 0x5d, 0xdc, 0xc2, 0xd0, 0xaf, 0xe1,
-	0x76, 0xe6, 0xbe, 0x72, 0x56, 0x4f, 0x56, 0xf5, 0xfd, 0xaa, 0x24, 0x95, 0xe7, 0x2d, 0x38, 0x6c,
-	0xf7, 0xd5, 0xa8, 0xd8, 0xb3, 0x9b, 0x59, 0x79, 0x49, 0x5e, 0x00, 0xf1, 0xb8, 0xef, 0xe4, 0x67,
-	0xde, 0x2c, 0xce, 0xf3, 0x98, 0xa5, 0x62, 0x62, 0xe0, 0x33, 0x28, 0x73, 0x56, 0xe7, 0xce, 0xa2,
-	0x6f, 0x8d, 0x0b, 0xfc, 0x97, 0x74, 0x69, 0xef, 0x7b, 0xdc, 0xaf, 0xec, 0x90, 0x2f, 0x60, 0x0b,
-	0x27, 0x1a, 0x3e, 0x8f, 0xc6, 0xa0, 0xbf, 0xde, 0xa9, 0x6f, 0x04, 0xec, 0x7a, 0x55, 0x6c, 0x19,
-	0xdf, 0xf9, 0xcb, 0x80, 0x7d, 0x84, 0xa0, 0x13, 0x63, 0xea, 0x26, 0x34, 0x20, 0x36, 0x34, 0x17,
-	0x6e, 0x12, 0x07, 0x2e, 0x67, 0x99, 0x93, 0x53, 0x6e, 0x1a, 0xf8, 0x10, 0x3e, 0x5c, 0xef, 0xc1,
-	0x4b, 0x0d, 0xff, 0x36, 0xe6, 0xd1, 0x30, 0xc9, 0x85, 0xea, 0xbd, 0x82, 0x63, 0x4c, 0x39, 0x79,
-	0x0c, 0xfb, 0x98, 0xd1, 0x29, 0x55, 0x46, 0x96, 0xf9, 0x1d, 0x6b, 0x35, 0xae, 0x2d, 0x39, 0xae,
-	0xa5, 0xea, 0xe7, 0xf3, 0xdc, 0xbe, 0x3d, 0x2f, 0xc4, 0x61, 0x7d, 0x9e, 0xc1, 0x41, 0x99, 0x66,
-	0xe1, 0x26, 0x28, 0xb0, 0x76, 0x33, 0xd3, 0xfe, 0x8a, 0xe9, 0xa5, 0x9b, 0x8c, 0x29, 0xef, 0xfc,
-	0xb4, 0x09, 0xf7, 0xd6, 0xd8, 0x43, 0xc6, 0x60, 0xca, 0x3c, 0xfe, 0x85, 0x1e, 0x48, 0xb1, 0x1e,
-	0x33, 0xc6, 0xcd, 0xc9, 0x0e, 0x31, 0x78, 0x74, 0x21, 0xdf, 0xc7, 0x89, 0x9a, 0x45, 0xdf, 0x01,
-	0x29, 0x8b, 0xcf, 0xd1, 0x6d, 0xe5, 0xc2, 0xfb, 0x37, 0x94, 0xb0, 0x54, 0x9f, 0xf2, 0x55, 0x54,
-	0xc5, 0x7e, 0x84, 0xbb, 0x15, 0x66, 0xd1, 0x2c, 0x9c, 0xd3, 0x40, 0x8d, 0xb0, 0x47, 0xeb, 0x3b,
-	0x6d, 0x92, 0xb9, 0x69, 0xee, 0xfa, 0x3c, 0x66, 0xb2, 0x2f, 0x0e, 0x4a, 0xdc, 0x9a, 0xa5, 0xf3,
-	0x03, 0xdc, 0x19, 0x4e, 0x46, 0xe8, 0xce, 0x98, 0x86, 0x33, 0x9a, 0x72, 0x72, 0x02, 0x0d, 0xd1,
-	0xd8, 0x7a, 0x54, 0xca, 0x0e, 0xe9, 0x96, 0xf3, 0x94, 0x7f, 0xa3, 0x16, 0x7d, 0x6b, 0x38, 0x19,
-	0x69, 0x37, 0xa6, 0xcc, 0x06, 0x8f, 0xfb, 0x6a, 0x78, 0x0c, 0x9f, 0xff, 0x7a, 0xd9, 0x32, 0xde,
-	0x5c, 0xb6, 0x8c, 0x3f, 0x2e, 0x5b, 0xc6, 0xeb, 0xab, 0xd6, 0xc6, 0x9b, 0xab, 0xd6, 0xc6, 0x6f,
-	0x57, 0xad, 0x8d, 0xef, 0x3f, 0x09, 0x63, 0x1e, 0x9d, 0x79, 0x96, 0xcf, 0x66, 0x3d, 0xc5, 0x8c,
-	0x53, 0x42, 0x2f, 0x7a, 0xe7, 0x6f, 0xfd, 0x87, 0xc1, 0x97, 0x73, 0x9a, 0x7b, 0xdb, 0xf8, 0xe3,
-	0xf4, 0xd1, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x76, 0x46, 0x5d, 0x86, 0x87, 0x08, 0x00, 0x00,
+func (m *FinalizedHeaderBundle) GetBtcSubmissionKey() *types2.SubmissionKey {
+	if m != nil {
+		return m.BtcSubmissionKey
+	}
+	return nil
 }
 
-func (m *IndexedHeader) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FinalizedHeaderBundle) GetProof() *ProofFinalizedChainInfo {
+	if m != nil {
+		return m.Proof
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *IndexedHeader) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ArchivedEpochChainInfo is the snapshot of a CZ's chain info at the end of an
+// epoch, exported for archival before it is pruned
+type ArchivedEpochChainInfo struct {
+	// epoch_num is the epoch of the snapshot
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// chain_info is the snapshot of the chain info
+	ChainInfo *ChainInfo `protobuf:"bytes,2,opt,name=chain_info,json=chainInfo,proto3" json:"chain_info,omitempty"`
+	// bundle is the bundle for verifying that the latest header in the chain
+	// info is BTC-finalised. It is nil if no header of the CZ is timestamped in
+	// this epoch
+	Bundle *FinalizedHeaderBundle `protobuf:"bytes,3,opt,name=bundle,proto3" json:"bundle,omitempty"`
 }
 
-func (m *IndexedHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BabylonTxHash) > 0 {
-		i -= len(m.BabylonTxHash)
-		copy(dAtA[i:], m.BabylonTxHash)
-		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.BabylonTxHash)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.BabylonEpoch != 0 {
-		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.BabylonEpoch))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.BabylonHeaderHeight != 0 {
-		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.BabylonHeaderHeight))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.BabylonHeaderHash) > 0 {
-		i -= len(m.BabylonHeaderHash)
-		copy(dAtA[i:], m.BabylonHeaderHash)
-		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.BabylonHeaderHash)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Time != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
-		if err1 != nil {
-			return 0, err1
+func (m *ArchivedEpochChainInfo) Reset()         { *m = ArchivedEpochChainInfo{} }
+func (m *ArchivedEpochChainInfo) String() string { return proto.CompactTextString(m) }
+func (*ArchivedEpochChainInfo) ProtoMessage()    {}
+func (*ArchivedEpochChainInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab886e1868e5c5cd, []int{7}
+}
+func (m *ArchivedEpochChainInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedEpochChainInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedEpochChainInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n1
-		i = encodeVarintZoneconcierge(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Height != 0 {
-		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *Forks) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ArchivedEpochChainInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedEpochChainInfo.Merge(m, src)
+}
+func (m *ArchivedEpochChainInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedEpochChainInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedEpochChainInfo.DiscardUnknown(m)
 }
 
-func (m *Forks) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ArchivedEpochChainInfo proto.InternalMessageInfo
+
+func (m *ArchivedEpochChainInfo) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
 }
 
-func (m *Forks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Headers) > 0 {
-		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+func (m *ArchivedEpochChainInfo) GetChainInfo() *ChainInfo {
+	if m != nil {
+		return m.ChainInfo
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ChainInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ArchivedEpochChainInfo) GetBundle() *FinalizedHeaderBundle {
+	if m != nil {
+		return m.Bundle
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ChainInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ChainInfoArchive is an archive of the snapshots of a CZ's chain info over
+// a range of epochs
+type ChainInfoArchive struct {
+	// chain_id is the ID of the CZ
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// epoch_chain_infos is the list of snapshots in the ascending order of
+	// epochs
+	EpochChainInfos []*ArchivedEpochChainInfo `protobuf:"bytes,2,rep,name=epoch_chain_infos,json=epochChainInfos,proto3" json:"epoch_chain_infos,omitempty"`
 }
 
-func (m *ChainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TimestampedHeadersCount != 0 {
-		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.TimestampedHeadersCount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.LatestForks != nil {
-		{
-			size, err := m.LatestForks.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.LatestHeader != nil {
-		{
-			size, err := m.LatestHeader.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+func (m *ChainInfoArchive) Reset()         { *m = ChainInfoArchive{} }
+func (m *ChainInfoArchive) String() string { return proto.CompactTextString(m) }
+func (*ChainInfoArchive) ProtoMessage()    {}
+func (*ChainInfoArchive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab886e1868e5c5cd, []int{8}
+}
+func (m *ChainInfoArchive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainInfoArchive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainInfoArchive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ChainInfoArchive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainInfoArchive.Merge(m, src)
+}
+func (m *ChainInfoArchive) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainInfoArchive) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainInfoArchive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainInfoArchive proto.InternalMessageInfo
+
+func (m *ChainInfoArchive) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *FinalizedChainInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ChainInfoArchive) GetEpochChainInfos() []*ArchivedEpochChainInfo {
+	if m != nil {
+		return m.EpochChainInfos
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *FinalizedChainInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Btc light client chain segment grown during last finalized epoch
+type BTCChainSegment struct {
+	BtcHeaders []*types3.BTCHeaderInfo `protobuf:"bytes,1,rep,name=btc_headers,json=btcHeaders,proto3" json:"btc_headers,omitempty"`
 }
 
-func (m *FinalizedChainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Proof != nil {
-		{
-			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+func (m *BTCChainSegment) Reset()         { *m = BTCChainSegment{} }
+func (m *BTCChainSegment) String() string { return proto.CompactTextString(m) }
+func (*BTCChainSegment) ProtoMessage()    {}
+func (*BTCChainSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab886e1868e5c5cd, []int{9}
+}
+func (m *BTCChainSegment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCChainSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCChainSegment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x32
+		return b[:n], nil
 	}
-	if m.BtcSubmissionKey != nil {
-		{
-			size, err := m.BtcSubmissionKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *BTCChainSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCChainSegment.Merge(m, src)
+}
+func (m *BTCChainSegment) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCChainSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCChainSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCChainSegment proto.InternalMessageInfo
+
+func (m *BTCChainSegment) GetBtcHeaders() []*types3.BTCHeaderInfo {
+	if m != nil {
+		return m.BtcHeaders
 	}
-	if m.RawCheckpoint != nil {
-		{
-			size, err := m.RawCheckpoint.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+	return nil
+}
+
+// CovenantAttestation is the covenant committee's attestation over the BTC
+// delegations that back the BTC staking power at a Babylon height. It allows
+// a consumer chain to verify the delegations backing the voting power rather
+// than trusting the headline voting power alone.
+type CovenantAttestation struct {
+	// height is the Babylon height of the attested voting power distribution
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// total_voting_power is the total voting power of the active finality
+	// providers at this height
+	TotalVotingPower uint64 `protobuf:"varint,2,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+	// delegations is the list of BTC delegations under the active finality
+	// providers at this height, in the descending order of the voting power of
+	// the finality providers, up to max_covenant_attestation_delegations
+	Delegations []*DelegationCovenantAttestation `protobuf:"bytes,3,rep,name=delegations,proto3" json:"delegations,omitempty"`
+	// truncated indicates whether there are more BTC delegations under the
+	// active finality providers than those included in delegations
+	Truncated bool `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (m *CovenantAttestation) Reset()         { *m = CovenantAttestation{} }
+func (m *CovenantAttestation) String() string { return proto.CompactTextString(m) }
+func (*CovenantAttestation) ProtoMessage()    {}
+func (*CovenantAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab886e1868e5c5cd, []int{10}
+}
+func (m *CovenantAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CovenantAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CovenantAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if m.EpochInfo != nil {
-		{
-			size, err := m.EpochInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *CovenantAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CovenantAttestation.Merge(m, src)
+}
+func (m *CovenantAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *CovenantAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CovenantAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CovenantAttestation proto.InternalMessageInfo
+
+func (m *CovenantAttestation) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	if m.FinalizedChainInfo != nil {
-		{
-			size, err := m.FinalizedChainInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *CovenantAttestation) GetTotalVotingPower() uint64 {
+	if m != nil {
+		return m.TotalVotingPower
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *CovenantAttestation) GetDelegations() []*DelegationCovenantAttestation {
+	if m != nil {
+		return m.Delegations
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ProofEpochSealed) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CovenantAttestation) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *ProofEpochSealed) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DelegationCovenantAttestation is the covenant committee's attestation over
+// a single BTC delegation
+type DelegationCovenantAttestation struct {
+	// staking_tx_hash is the staking tx hash of the BTC delegation
+	StakingTxHash string `protobuf:"bytes,1,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// fp_btc_pk is the BTC PK of the finality provider the voting power of
+	// the BTC delegation is counted for
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// voting_power is the voting power of the BTC delegation
+	VotingPower uint64 `protobuf:"varint,3,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// covenant_pks_hash is the SHA256 hash of the covenant PK set that the
+	// BTC delegation is verified against
+	CovenantPksHash []byte `protobuf:"bytes,4,opt,name=covenant_pks_hash,json=covenantPksHash,proto3" json:"covenant_pks_hash,omitempty"`
+	// covenant_quorum is the minimum number of covenant signatures required by
+	// the covenant PK set
+	CovenantQuorum uint32 `protobuf:"varint,5,opt,name=covenant_quorum,json=covenantQuorum,proto3" json:"covenant_quorum,omitempty"`
+	// covenant_sig_count is the number of covenant members that have signed
+	// the BTC delegation
+	CovenantSigCount uint32 `protobuf:"varint,6,opt,name=covenant_sig_count,json=covenantSigCount,proto3" json:"covenant_sig_count,omitempty"`
 }
 
-func (m *ProofEpochSealed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ProofEpochValSet != nil {
-		{
-			size, err := m.ProofEpochValSet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+func (m *DelegationCovenantAttestation) Reset()         { *m = DelegationCovenantAttestation{} }
+func (m *DelegationCovenantAttestation) String() string { return proto.CompactTextString(m) }
+func (*DelegationCovenantAttestation) ProtoMessage()    {}
+func (*DelegationCovenantAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab886e1868e5c5cd, []int{11}
+}
+func (m *DelegationCovenantAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationCovenantAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationCovenantAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if m.ProofEpochInfo != nil {
-		{
-			size, err := m.ProofEpochInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+}
+func (m *DelegationCovenantAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationCovenantAttestation.Merge(m, src)
+}
+func (m *DelegationCovenantAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationCovenantAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationCovenantAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationCovenantAttestation proto.InternalMessageInfo
+
+func (m *DelegationCovenantAttestation) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
 	}
-	if len(m.ValidatorSet) > 0 {
-		for iNdEx := len(m.ValidatorSet) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValidatorSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return ""
+}
+
+func (m *DelegationCovenantAttestation) GetVotingPower() uint64 {
+	if m != nil {
+		return m.VotingPower
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ProofFinalizedChainInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DelegationCovenantAttestation) GetCovenantPksHash() []byte {
+	if m != nil {
+		return m.CovenantPksHash
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ProofFinalizedChainInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DelegationCovenantAttestation) GetCovenantQuorum() uint32 {
+	if m != nil {
+		return m.CovenantQuorum
+	}
+	return 0
 }
 
-func (m *ProofFinalizedChainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProofEpochSubmitted) > 0 {
-		for iNdEx := len(m.ProofEpochSubmitted) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ProofEpochSubmitted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+func (m *DelegationCovenantAttestation) GetCovenantSigCount() uint32 {
+	if m != nil {
+		return m.CovenantSigCount
 	}
-	if m.ProofEpochSealed != nil {
-		{
-			size, err := m.ProofEpochSealed.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+	return 0
+}
+
+// BTCTimestampDelivery tracks the delivery of the BTC timestamp of a finalised
+// epoch to the consumer chain under an IBC channel. It is kept while the IBC
+// packet carrying the BTC timestamp is in flight, and while the BTC timestamp
+// is queued for retry.
+type BTCTimestampDelivery struct {
+	// channel_id is the ID of the IBC channel to the consumer chain
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// epoch_num is the number of the finalised epoch of the BTC timestamp
+	EpochNum uint64 `protobuf:"varint,2,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// attempts is the number of failed attempts of delivering the BTC timestamp
+	Attempts uint32 `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+}
+
+func (m *BTCTimestampDelivery) Reset()         { *m = BTCTimestampDelivery{} }
+func (m *BTCTimestampDelivery) String() string { return proto.CompactTextString(m) }
+func (*BTCTimestampDelivery) ProtoMessage()    {}
+func (*BTCTimestampDelivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab886e1868e5c5cd, []int{12}
+}
+func (m *BTCTimestampDelivery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCTimestampDelivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCTimestampDelivery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
+	}
+}
+func (m *BTCTimestampDelivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCTimestampDelivery.Merge(m, src)
+}
+func (m *BTCTimestampDelivery) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCTimestampDelivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCTimestampDelivery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCTimestampDelivery proto.InternalMessageInfo
+
+func (m *BTCTimestampDelivery) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *BTCTimestampDelivery) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *BTCTimestampDelivery) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+// ConsumerRegister is the registration of a consumer chain in the consumer
+// registry. When permissioned channels are enabled, only registered consumer
+// chains can open IBC channels with the zoneconcierge module.
+type ConsumerRegister struct {
+	// consumer_id is the chain ID of the consumer chain
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// name is the name of the consumer chain
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// description is a description of the consumer chain
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// max_headers_per_block is the maximum number of headers of the consumer
+	// chain that are indexed in a single Babylon block. Zero means no limit
+	MaxHeadersPerBlock uint32 `protobuf:"varint,4,opt,name=max_headers_per_block,json=maxHeadersPerBlock,proto3" json:"max_headers_per_block,omitempty"`
+}
+
+func (m *ConsumerRegister) Reset()         { *m = ConsumerRegister{} }
+func (m *ConsumerRegister) String() string { return proto.CompactTextString(m) }
+func (*ConsumerRegister) ProtoMessage()    {}
+func (*ConsumerRegister) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab886e1868e5c5cd, []int{13}
+}
+func (m *ConsumerRegister) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerRegister) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerRegister.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerRegister) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerRegister.Merge(m, src)
+}
+func (m *ConsumerRegister) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerRegister) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerRegister.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerRegister proto.InternalMessageInfo
+
+func (m *ConsumerRegister) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *ConsumerRegister) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ConsumerRegister) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ConsumerRegister) GetMaxHeadersPerBlock() uint32 {
+	if m != nil {
+		return m.MaxHeadersPerBlock
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*IndexedHeader)(nil), "babylon.zoneconcierge.v1.IndexedHeader")
+	proto.RegisterType((*Forks)(nil), "babylon.zoneconcierge.v1.Forks")
+	proto.RegisterType((*ChainInfo)(nil), "babylon.zoneconcierge.v1.ChainInfo")
+	proto.RegisterType((*FinalizedChainInfo)(nil), "babylon.zoneconcierge.v1.FinalizedChainInfo")
+	proto.RegisterType((*ProofEpochSealed)(nil), "babylon.zoneconcierge.v1.ProofEpochSealed")
+	proto.RegisterType((*ProofFinalizedChainInfo)(nil), "babylon.zoneconcierge.v1.ProofFinalizedChainInfo")
+	proto.RegisterType((*FinalizedHeaderBundle)(nil), "babylon.zoneconcierge.v1.FinalizedHeaderBundle")
+	proto.RegisterType((*ArchivedEpochChainInfo)(nil), "babylon.zoneconcierge.v1.ArchivedEpochChainInfo")
+	proto.RegisterType((*ChainInfoArchive)(nil), "babylon.zoneconcierge.v1.ChainInfoArchive")
+	proto.RegisterType((*BTCChainSegment)(nil), "babylon.zoneconcierge.v1.BTCChainSegment")
+	proto.RegisterType((*CovenantAttestation)(nil), "babylon.zoneconcierge.v1.CovenantAttestation")
+	proto.RegisterType((*DelegationCovenantAttestation)(nil), "babylon.zoneconcierge.v1.DelegationCovenantAttestation")
+	proto.RegisterType((*BTCTimestampDelivery)(nil), "babylon.zoneconcierge.v1.BTCTimestampDelivery")
+	proto.RegisterType((*ConsumerRegister)(nil), "babylon.zoneconcierge.v1.ConsumerRegister")
+}
+
+func init() {
+	proto.RegisterFile("babylon/zoneconcierge/v1/zoneconcierge.proto", fileDescriptor_ab886e1868e5c5cd)
+}
+
+var fileDescriptor_ab886e1868e5c5cd = []byte{
+	// 1426 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4b, 0x73, 0x13, 0xc7,
+	0x16, 0x66, 0x24, 0xf9, 0xa1, 0x23, 0x0b, 0x8b, 0xb6, 0x0d, 0xc2, 0x5c, 0x6c, 0x5f, 0x51, 0x05,
+	0x86, 0xe2, 0x8e, 0x90, 0xe1, 0xde, 0x5b, 0xc9, 0x26, 0x85, 0xc4, 0xcb, 0x24, 0x05, 0x4a, 0xcb,
+	0x90, 0x47, 0x91, 0x9a, 0x1a, 0xcd, 0xb4, 0xa4, 0x29, 0xcd, 0x4c, 0x4f, 0x66, 0x5a, 0xc2, 0xf6,
+	0x36, 0xcb, 0x6c, 0xf8, 0x01, 0xd9, 0x67, 0x9d, 0x1f, 0x90, 0x45, 0xb2, 0xca, 0x92, 0x45, 0x16,
+	0xa9, 0x2c, 0x92, 0x14, 0xfc, 0x85, 0x6c, 0xb2, 0x4b, 0xf5, 0x63, 0x46, 0x33, 0x02, 0xd9, 0x49,
+	0x48, 0x36, 0x2e, 0xf5, 0xe9, 0xaf, 0xcf, 0xe3, 0x3b, 0x8f, 0x39, 0x86, 0xab, 0x5d, 0xb3, 0x7b,
+	0xe0, 0x52, 0xbf, 0x7e, 0x48, 0x7d, 0x62, 0x51, 0xdf, 0x72, 0x48, 0xd8, 0x27, 0xf5, 0x71, 0x23,
+	0x2b, 0xd0, 0x83, 0x90, 0x32, 0x8a, 0xaa, 0x0a, 0xad, 0x67, 0x2f, 0xc7, 0x8d, 0xf5, 0xd5, 0x3e,
+	0xed, 0x53, 0x01, 0xaa, 0xf3, 0x5f, 0x12, 0xbf, 0xbe, 0xd9, 0xa7, 0xb4, 0xef, 0x92, 0xba, 0x38,
+	0x75, 0x47, 0xbd, 0x3a, 0x73, 0x3c, 0x12, 0x31, 0xd3, 0x0b, 0x14, 0xe0, 0x3c, 0x23, 0xbe, 0x4d,
+	0x42, 0xcf, 0xf1, 0x59, 0xdd, 0x0a, 0x0f, 0x02, 0x46, 0x39, 0x96, 0xf6, 0xd4, 0x75, 0xe2, 0x5d,
+	0x97, 0x59, 0xd6, 0x80, 0x58, 0xc3, 0x80, 0x72, 0xe4, 0xb8, 0x91, 0x15, 0x28, 0xf4, 0xc5, 0x18,
+	0x3d, 0xb9, 0x71, 0xfc, 0xbe, 0x40, 0xbb, 0x91, 0x31, 0x24, 0x07, 0x0a, 0x77, 0x79, 0x26, 0xee,
+	0x15, 0x95, 0xb5, 0x18, 0x4a, 0x02, 0x6a, 0x0d, 0x14, 0x2a, 0xfe, 0xad, 0x30, 0x7a, 0xca, 0x49,
+	0xd7, 0xe9, 0x0f, 0xf8, 0x5f, 0x92, 0x78, 0x99, 0x92, 0x48, 0x7c, 0xed, 0xeb, 0x1c, 0x94, 0x77,
+	0x7d, 0x9b, 0xec, 0x13, 0xfb, 0x1e, 0x31, 0x6d, 0x12, 0xa2, 0xb3, 0xb0, 0x68, 0x0d, 0x4c, 0xc7,
+	0x37, 0x1c, 0xbb, 0xaa, 0x6d, 0x69, 0xdb, 0x45, 0xbc, 0x20, 0xce, 0xbb, 0x36, 0x42, 0x50, 0x18,
+	0x98, 0xd1, 0xa0, 0x9a, 0xdb, 0xd2, 0xb6, 0x97, 0xb0, 0xf8, 0x8d, 0x4e, 0xc3, 0xfc, 0x80, 0x70,
+	0xb5, 0xd5, 0xfc, 0x96, 0xb6, 0x5d, 0xc0, 0xea, 0x84, 0x6e, 0x40, 0x81, 0xf3, 0x5b, 0x2d, 0x6c,
+	0x69, 0xdb, 0xa5, 0x9d, 0x75, 0x5d, 0x92, 0xaf, 0xc7, 0xe4, 0xeb, 0x7b, 0x31, 0xf9, 0xcd, 0xc2,
+	0xb3, 0x9f, 0x37, 0x35, 0x2c, 0xd0, 0x48, 0x87, 0x15, 0x15, 0x80, 0x31, 0x10, 0xee, 0x18, 0xc2,
+	0xe0, 0x9c, 0x30, 0x78, 0x4a, 0x5d, 0x49, 0x47, 0xef, 0x71, 0xeb, 0x3b, 0xb0, 0x36, 0x8d, 0x97,
+	0xce, 0xcc, 0x0b, 0x67, 0x56, 0xb2, 0x2f, 0xa4, 0x67, 0x17, 0xa0, 0x1c, 0xbf, 0x11, 0xe4, 0x55,
+	0x17, 0x04, 0x76, 0x49, 0x09, 0x6f, 0x73, 0x19, 0xba, 0x08, 0xcb, 0x31, 0x88, 0xed, 0x4b, 0x27,
+	0x16, 0x85, 0x13, 0xf1, 0xdb, 0xbd, 0x7d, 0xee, 0x40, 0xed, 0x3e, 0xcc, 0xdd, 0xa1, 0xe1, 0x30,
+	0x42, 0x37, 0x61, 0x41, 0x7a, 0x10, 0x55, 0xf3, 0x5b, 0xf9, 0xed, 0xd2, 0xce, 0x25, 0x7d, 0x56,
+	0x7d, 0xea, 0x19, 0xc2, 0x71, 0xfc, 0xae, 0xf6, 0xab, 0x06, 0xc5, 0x96, 0xa0, 0xda, 0xef, 0xd1,
+	0xa3, 0xf2, 0xf0, 0x1e, 0x94, 0x5d, 0x93, 0x91, 0x88, 0xa9, 0xa0, 0x45, 0x42, 0xfe, 0x84, 0xc5,
+	0x25, 0xf9, 0x5a, 0x25, 0xbc, 0x09, 0xea, 0x6c, 0xf4, 0x78, 0x24, 0x22, 0x8f, 0xa5, 0x9d, 0xcd,
+	0xd9, 0xca, 0x44, 0xc0, 0xb8, 0x24, 0x1f, 0xc9, 0xe8, 0xdf, 0x86, 0xb3, 0x49, 0x37, 0x11, 0x5b,
+	0xb9, 0x15, 0x19, 0x16, 0x1d, 0xf9, 0x4c, 0x94, 0x40, 0x01, 0x9f, 0x49, 0x01, 0xa4, 0xe5, 0xa8,
+	0xc5, 0xaf, 0x6b, 0x5f, 0xe5, 0x01, 0xdd, 0x71, 0x7c, 0xd3, 0x75, 0x0e, 0x89, 0xfd, 0x87, 0xe2,
+	0x7f, 0x04, 0xab, 0xbd, 0xf8, 0x81, 0xa1, 0x40, 0x7e, 0x8f, 0x2a, 0x1a, 0x2e, 0xcc, 0xf6, 0x3c,
+	0xd1, 0x8e, 0x51, 0xef, 0x55, 0x8b, 0x6f, 0x01, 0x88, 0x82, 0x90, 0xca, 0xf2, 0xaa, 0x70, 0x63,
+	0x65, 0x49, 0xa3, 0x8d, 0x1b, 0xba, 0xa8, 0x11, 0x5c, 0x14, 0x22, 0xf1, 0xf4, 0x01, 0x9c, 0x0c,
+	0xcd, 0xa7, 0xc6, 0xa4, 0x65, 0xab, 0x85, 0xa9, 0x94, 0x64, 0xda, 0x9b, 0xeb, 0xc0, 0xe6, 0xd3,
+	0x56, 0x22, 0xc3, 0xe5, 0x30, 0x7d, 0x44, 0x8f, 0x00, 0x75, 0x99, 0x65, 0x44, 0xa3, 0xae, 0xe7,
+	0x44, 0x91, 0x43, 0x7d, 0x3e, 0x31, 0xaa, 0x73, 0x53, 0x3a, 0xb3, 0x73, 0x67, 0xdc, 0xd0, 0x3b,
+	0x09, 0xfe, 0x5d, 0x72, 0x80, 0x2b, 0x5d, 0x66, 0x65, 0x24, 0xe8, 0x2e, 0xcc, 0x89, 0x89, 0x26,
+	0xda, 0xa3, 0xb4, 0xd3, 0x98, 0xcd, 0x54, 0x9b, 0xc3, 0x5e, 0xcd, 0x0a, 0x96, 0xef, 0x6b, 0xbf,
+	0x69, 0x50, 0x11, 0x10, 0xc1, 0x44, 0x87, 0x98, 0x2e, 0xb1, 0x11, 0x86, 0xf2, 0xd8, 0x74, 0x1d,
+	0xdb, 0x64, 0x34, 0x34, 0x22, 0xc2, 0xaa, 0x9a, 0x68, 0x84, 0xff, 0xcc, 0xe6, 0xe0, 0x71, 0x0c,
+	0xff, 0xc0, 0x61, 0x83, 0xa6, 0x1b, 0x71, 0xaf, 0x97, 0x12, 0x1d, 0x1d, 0xc2, 0xd0, 0x6d, 0xa8,
+	0x08, 0x8b, 0x46, 0x2a, 0x33, 0x32, 0xcd, 0xe7, 0xf4, 0xc9, 0xb8, 0xd6, 0xe5, 0xb8, 0x96, 0x5e,
+	0x3f, 0x0c, 0x22, 0x7c, 0x32, 0x48, 0x9c, 0x13, 0xf9, 0xb9, 0x0f, 0x2b, 0x69, 0x35, 0x63, 0xd3,
+	0x15, 0x0e, 0xe6, 0x8f, 0xd7, 0x54, 0x99, 0x68, 0x7a, 0x6c, 0xba, 0x1d, 0xc2, 0x6a, 0x5f, 0xe6,
+	0xe0, 0xcc, 0x0c, 0x7a, 0x50, 0x07, 0xaa, 0xd2, 0x8e, 0x75, 0x18, 0x0f, 0x24, 0x27, 0x1e, 0x33,
+	0xda, 0xf1, 0xc6, 0x56, 0xc5, 0xe3, 0xd6, 0xa1, 0xec, 0x8f, 0x5d, 0x35, 0x8b, 0x3e, 0x04, 0x94,
+	0x76, 0x3e, 0x12, 0x6c, 0x2b, 0x16, 0xae, 0x1c, 0x93, 0xc2, 0x54, 0x7e, 0xd2, 0xa1, 0xa8, 0x8c,
+	0x7d, 0x02, 0x6b, 0x19, 0xcd, 0xbc, 0x58, 0x18, 0x23, 0xb6, 0x1a, 0x61, 0x97, 0x67, 0x57, 0xda,
+	0x5e, 0x68, 0xfa, 0x91, 0x69, 0x31, 0x87, 0xca, 0xba, 0x58, 0x49, 0xe9, 0x8e, 0xb5, 0xd4, 0x3e,
+	0xcb, 0xc3, 0x5a, 0x42, 0x92, 0x8c, 0xa9, 0x39, 0xf2, 0x6d, 0x97, 0xa0, 0x77, 0xf8, 0x57, 0x83,
+	0x9f, 0xab, 0xda, 0x54, 0x4d, 0x1f, 0x33, 0xba, 0xd4, 0xb3, 0xa9, 0x5e, 0xcd, 0xbd, 0x59, 0xaf,
+	0xe6, 0xff, 0x81, 0x5e, 0x2d, 0xfc, 0x6d, 0xbd, 0x3a, 0xf7, 0x86, 0xbd, 0xfa, 0xad, 0x06, 0xa7,
+	0x6f, 0x86, 0xd6, 0xc0, 0x19, 0x13, 0x5b, 0x90, 0x31, 0x29, 0xd7, 0x73, 0x20, 0x79, 0x31, 0xfc,
+	0x91, 0x27, 0x32, 0x51, 0xc0, 0x8b, 0x42, 0xf0, 0x60, 0xe4, 0xa1, 0x26, 0xc0, 0x5f, 0x9b, 0xad,
+	0x45, 0x2b, 0x31, 0x70, 0x17, 0xe6, 0xbb, 0x22, 0xe3, 0x8a, 0xe3, 0xfa, 0x11, 0x5f, 0x95, 0xd7,
+	0x15, 0x0a, 0x56, 0xcf, 0x6b, 0x9f, 0x6b, 0x50, 0x49, 0x2c, 0xa8, 0x68, 0x8e, 0xfa, 0x44, 0x3c,
+	0x81, 0x53, 0x32, 0xb2, 0x49, 0x08, 0x51, 0x35, 0x27, 0xaa, 0xfa, 0xda, 0x6c, 0x1f, 0x5e, 0x4f,
+	0x13, 0x5e, 0x26, 0x99, 0x73, 0x54, 0x7b, 0x02, 0xcb, 0xcd, 0xbd, 0x96, 0x10, 0x74, 0x48, 0xdf,
+	0x23, 0x3e, 0x43, 0xbb, 0x50, 0xe2, 0x55, 0x10, 0xef, 0x00, 0x72, 0xf4, 0x6d, 0xa7, 0xd3, 0x9f,
+	0x5e, 0xbe, 0xc6, 0x0d, 0xbd, 0xb9, 0xd7, 0x8a, 0xdb, 0xbc, 0x47, 0x31, 0x74, 0x99, 0x75, 0x4f,
+	0xed, 0x01, 0xdf, 0x6b, 0xb0, 0xd2, 0xa2, 0x63, 0xe2, 0x9b, 0x3e, 0xbb, 0xc9, 0xf8, 0x47, 0xd6,
+	0xe4, 0x7d, 0x96, 0x5a, 0xb5, 0xb4, 0xcc, 0xaa, 0x75, 0x15, 0x10, 0xa3, 0xcc, 0x74, 0x8d, 0x31,
+	0xe5, 0x05, 0x6b, 0x04, 0xf4, 0xa9, 0xda, 0x09, 0x0a, 0xb8, 0x22, 0x6e, 0x1e, 0x8b, 0x8b, 0x36,
+	0x97, 0xa3, 0x8f, 0xa0, 0x64, 0x13, 0x97, 0xf4, 0x85, 0xce, 0x78, 0x59, 0xf9, 0xff, 0x6c, 0x4e,
+	0x6e, 0x25, 0xe0, 0xd7, 0xf8, 0x84, 0xd3, 0xba, 0xd0, 0xbf, 0xa0, 0xc8, 0xc2, 0x91, 0x6f, 0x99,
+	0x7c, 0x84, 0xf0, 0x06, 0x58, 0xc4, 0x13, 0x41, 0xed, 0x9b, 0x1c, 0x9c, 0x3f, 0x52, 0x19, 0x5f,
+	0xba, 0x22, 0x66, 0x0e, 0x79, 0x0c, 0xf1, 0xd2, 0x25, 0xd3, 0x5a, 0x56, 0x62, 0xb9, 0x74, 0x21,
+	0x0c, 0xc5, 0x5e, 0x60, 0x70, 0xba, 0x83, 0xa1, 0x5c, 0x46, 0x9b, 0xff, 0xfb, 0xf1, 0xa7, 0xcd,
+	0x9d, 0xbe, 0xc3, 0x06, 0xa3, 0xae, 0x6e, 0x51, 0xaf, 0xae, 0xc2, 0x11, 0xd9, 0x8f, 0x0f, 0x75,
+	0x76, 0x10, 0x90, 0x48, 0x6f, 0xee, 0xb6, 0xaf, 0xdf, 0xb8, 0xd6, 0x1e, 0x75, 0x79, 0xdf, 0x2d,
+	0xf4, 0x82, 0x26, 0xb3, 0xda, 0x43, 0xf4, 0x6f, 0x58, 0xca, 0xd0, 0x27, 0xb7, 0xd9, 0xd2, 0x38,
+	0xc5, 0xdc, 0x15, 0x38, 0x65, 0x29, 0xaf, 0x8d, 0x60, 0x18, 0x49, 0x07, 0x0b, 0x62, 0x2b, 0x5c,
+	0x8e, 0x2f, 0xda, 0xc3, 0x48, 0xb8, 0x78, 0x09, 0x12, 0x91, 0xf1, 0xe9, 0x88, 0x86, 0x23, 0x4f,
+	0xf4, 0x71, 0x19, 0x9f, 0x8c, 0xc5, 0xef, 0x0b, 0x29, 0x4f, 0x5e, 0x02, 0x8c, 0x9c, 0xbe, 0x5a,
+	0x99, 0xe6, 0x05, 0xb6, 0x12, 0xdf, 0x74, 0x9c, 0xbe, 0xdc, 0x95, 0x7c, 0x58, 0x6d, 0xee, 0xb5,
+	0x92, 0xdd, 0xf9, 0x16, 0x71, 0x9d, 0x31, 0x09, 0x0f, 0xd0, 0x79, 0xd1, 0xab, 0xbe, 0x4f, 0xdc,
+	0x49, 0x2f, 0x14, 0x95, 0x64, 0xd7, 0xce, 0xf6, 0x79, 0x6e, 0xaa, 0xcf, 0xd7, 0x61, 0xd1, 0x64,
+	0x8c, 0x78, 0x01, 0x93, 0xbb, 0x5f, 0x19, 0x27, 0xe7, 0xda, 0x17, 0xbc, 0xed, 0xa8, 0x1f, 0x8d,
+	0x3c, 0x12, 0x62, 0xd2, 0x77, 0x22, 0x46, 0x42, 0xb4, 0x09, 0x25, 0x4b, 0xc9, 0x26, 0xd6, 0x20,
+	0x16, 0xc9, 0xff, 0x13, 0x7c, 0xd3, 0x23, 0xc2, 0x52, 0x11, 0x8b, 0xdf, 0x68, 0x8b, 0x97, 0x5d,
+	0x64, 0x85, 0x4e, 0xc0, 0x53, 0x2d, 0x0c, 0x15, 0x71, 0x5a, 0x84, 0x1a, 0xb0, 0xe6, 0x99, 0xfb,
+	0xc9, 0xee, 0x18, 0x90, 0xd0, 0xe8, 0xba, 0xd4, 0x1a, 0x0a, 0x8a, 0xcb, 0x18, 0x79, 0xe6, 0xbe,
+	0xea, 0x90, 0x36, 0x09, 0x9b, 0xfc, 0xa6, 0xf9, 0xf0, 0xbb, 0x17, 0x1b, 0xda, 0xf3, 0x17, 0x1b,
+	0xda, 0x2f, 0x2f, 0x36, 0xb4, 0x67, 0x2f, 0x37, 0x4e, 0x3c, 0x7f, 0xb9, 0x71, 0xe2, 0x87, 0x97,
+	0x1b, 0x27, 0x3e, 0xfe, 0xef, 0x71, 0xb5, 0xb0, 0x3f, 0xf5, 0x4f, 0xa6, 0xa8, 0x8d, 0xee, 0xbc,
+	0xf8, 0xff, 0xe4, 0xfa, 0xef, 0x01, 0x00, 0x00, 0xff, 0xff, 0x87, 0x59, 0x11, 0x01, 0x8a, 0x0e,
+	0x00, 0x00,
+}
+
+func (m *IndexedHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BabylonTxHash) > 0 {
+		i -= len(m.BabylonTxHash)
+		copy(dAtA[i:], m.BabylonTxHash)
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.BabylonTxHash)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x42
 	}
-	if m.ProofCzHeaderInEpoch != nil {
-		{
-			size, err := m.ProofCzHeaderInEpoch.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+	if m.BabylonEpoch != 0 {
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.BabylonEpoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BabylonHeaderHeight != 0 {
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.BabylonHeaderHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.BabylonHeaderHash) > 0 {
+		i -= len(m.BabylonHeaderHash)
+		copy(dAtA[i:], m.BabylonHeaderHash)
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.BabylonHeaderHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Time != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
+		if err1 != nil {
+			return 0, err1
 		}
+		i -= n1
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.ChainId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BTCChainSegment) Marshal() (dAtA []byte, err error) {
+func (m *Forks) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1036,20 +1291,20 @@ func (m *BTCChainSegment) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BTCChainSegment) MarshalTo(dAtA []byte) (int, error) {
+func (m *Forks) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BTCChainSegment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Forks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BtcHeaders) > 0 {
-		for iNdEx := len(m.BtcHeaders) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.BtcHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1057,201 +1312,2015 @@ func (m *BTCChainSegment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
 		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintZoneconcierge(dAtA []byte, offset int, v uint64) int {
-	offset -= sovZoneconcierge(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ChainInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *IndexedHeader) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ChainInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovZoneconcierge(uint64(l))
+	if m.TimestampedHeadersCount != 0 {
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.TimestampedHeadersCount))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovZoneconcierge(uint64(l))
+	if m.LatestForks != nil {
+		{
+			size, err := m.LatestForks.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Height != 0 {
-		n += 1 + sovZoneconcierge(uint64(m.Height))
+	if m.LatestHeader != nil {
+		{
+			size, err := m.LatestHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Time != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
-		n += 1 + l + sovZoneconcierge(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.BabylonHeaderHash)
-	if l > 0 {
-		n += 1 + l + sovZoneconcierge(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalizedChainInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.BabylonHeaderHeight != 0 {
-		n += 1 + sovZoneconcierge(uint64(m.BabylonHeaderHeight))
-	}
-	if m.BabylonEpoch != 0 {
-		n += 1 + sovZoneconcierge(uint64(m.BabylonEpoch))
-	}
-	l = len(m.BabylonTxHash)
-	if l > 0 {
-		n += 1 + l + sovZoneconcierge(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Forks) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Headers) > 0 {
-		for _, e := range m.Headers {
-			l = e.Size()
-			n += 1 + l + sovZoneconcierge(uint64(l))
-		}
-	}
-	return n
+func (m *FinalizedChainInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChainInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *FinalizedChainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovZoneconcierge(uint64(l))
-	}
-	if m.LatestHeader != nil {
-		l = m.LatestHeader.Size()
-		n += 1 + l + sovZoneconcierge(uint64(l))
-	}
-	if m.LatestForks != nil {
-		l = m.LatestForks.Size()
-		n += 1 + l + sovZoneconcierge(uint64(l))
-	}
-	if m.TimestampedHeadersCount != 0 {
-		n += 1 + sovZoneconcierge(uint64(m.TimestampedHeadersCount))
-	}
-	return n
-}
-
-func (m *FinalizedChainInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovZoneconcierge(uint64(l))
+	if m.BtcSubmissionKey != nil {
+		{
+			size, err := m.BtcSubmissionKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.FinalizedChainInfo != nil {
-		l = m.FinalizedChainInfo.Size()
-		n += 1 + l + sovZoneconcierge(uint64(l))
+	if m.RawCheckpoint != nil {
+		{
+			size, err := m.RawCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.EpochInfo != nil {
-		l = m.EpochInfo.Size()
-		n += 1 + l + sovZoneconcierge(uint64(l))
-	}
-	if m.RawCheckpoint != nil {
-		l = m.RawCheckpoint.Size()
-		n += 1 + l + sovZoneconcierge(uint64(l))
+		{
+			size, err := m.EpochInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.BtcSubmissionKey != nil {
-		l = m.BtcSubmissionKey.Size()
-		n += 1 + l + sovZoneconcierge(uint64(l))
+	if m.FinalizedChainInfo != nil {
+		{
+			size, err := m.FinalizedChainInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Proof != nil {
-		l = m.Proof.Size()
-		n += 1 + l + sovZoneconcierge(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ProofEpochSealed) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProofEpochSealed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofEpochSealed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofEpochSealed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ValidatorSet) > 0 {
-		for _, e := range m.ValidatorSet {
-			l = e.Size()
-			n += 1 + l + sovZoneconcierge(uint64(l))
+	if m.ProofEpochValSet != nil {
+		{
+			size, err := m.ProofEpochValSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.ProofEpochInfo != nil {
-		l = m.ProofEpochInfo.Size()
-		n += 1 + l + sovZoneconcierge(uint64(l))
+		{
+			size, err := m.ProofEpochInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ProofEpochValSet != nil {
-		l = m.ProofEpochValSet.Size()
-		n += 1 + l + sovZoneconcierge(uint64(l))
+	if len(m.ValidatorSet) > 0 {
+		for iNdEx := len(m.ValidatorSet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ProofFinalizedChainInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProofFinalizedChainInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofFinalizedChainInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofFinalizedChainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProofCzHeaderInEpoch != nil {
-		l = m.ProofCzHeaderInEpoch.Size()
-		n += 1 + l + sovZoneconcierge(uint64(l))
+	if len(m.ProofEpochSubmitted) > 0 {
+		for iNdEx := len(m.ProofEpochSubmitted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProofEpochSubmitted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if m.ProofEpochSealed != nil {
-		l = m.ProofEpochSealed.Size()
-		n += 1 + l + sovZoneconcierge(uint64(l))
+		{
+			size, err := m.ProofEpochSealed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.ProofEpochSubmitted) > 0 {
-		for _, e := range m.ProofEpochSubmitted {
-			l = e.Size()
-			n += 1 + l + sovZoneconcierge(uint64(l))
+	if m.ProofCzHeaderInEpoch != nil {
+		{
+			size, err := m.ProofCzHeaderInEpoch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalizedHeaderBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalizedHeaderBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalizedHeaderBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BtcSubmissionKey != nil {
+		{
+			size, err := m.BtcSubmissionKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RawCheckpoint != nil {
+		{
+			size, err := m.RawCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EpochInfo != nil {
+		{
+			size, err := m.EpochInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivedEpochChainInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedEpochChainInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedEpochChainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Bundle != nil {
+		{
+			size, err := m.Bundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChainInfo != nil {
+		{
+			size, err := m.ChainInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainInfoArchive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainInfoArchive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainInfoArchive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochChainInfos) > 0 {
+		for iNdEx := len(m.EpochChainInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochChainInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCChainSegment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCChainSegment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCChainSegment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BtcHeaders) > 0 {
+		for iNdEx := len(m.BtcHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CovenantAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CovenantAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CovenantAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationCovenantAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationCovenantAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationCovenantAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CovenantSigCount != 0 {
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.CovenantSigCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CovenantQuorum != 0 {
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.CovenantQuorum))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CovenantPksHash) > 0 {
+		i -= len(m.CovenantPksHash)
+		copy(dAtA[i:], m.CovenantPksHash)
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.CovenantPksHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.VotingPower != 0 {
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCTimestampDelivery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCTimestampDelivery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCTimestampDelivery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attempts != 0 {
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerRegister) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerRegister) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerRegister) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxHeadersPerBlock != 0 {
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.MaxHeadersPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintZoneconcierge(dAtA []byte, offset int, v uint64) int {
+	offset -= sovZoneconcierge(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IndexedHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.Height))
+	}
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	l = len(m.BabylonHeaderHash)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.BabylonHeaderHeight != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.BabylonHeaderHeight))
+	}
+	if m.BabylonEpoch != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.BabylonEpoch))
+	}
+	l = len(m.BabylonTxHash)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	return n
+}
+
+func (m *Forks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovZoneconcierge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChainInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.LatestHeader != nil {
+		l = m.LatestHeader.Size()
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.LatestForks != nil {
+		l = m.LatestForks.Size()
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.TimestampedHeadersCount != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.TimestampedHeadersCount))
+	}
+	return n
+}
+
+func (m *FinalizedChainInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.FinalizedChainInfo != nil {
+		l = m.FinalizedChainInfo.Size()
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.EpochInfo != nil {
+		l = m.EpochInfo.Size()
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.RawCheckpoint != nil {
+		l = m.RawCheckpoint.Size()
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.BtcSubmissionKey != nil {
+		l = m.BtcSubmissionKey.Size()
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	return n
+}
+
+func (m *ProofEpochSealed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorSet) > 0 {
+		for _, e := range m.ValidatorSet {
+			l = e.Size()
+			n += 1 + l + sovZoneconcierge(uint64(l))
+		}
+	}
+	if m.ProofEpochInfo != nil {
+		l = m.ProofEpochInfo.Size()
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.ProofEpochValSet != nil {
+		l = m.ProofEpochValSet.Size()
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	return n
+}
+
+func (m *ProofFinalizedChainInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProofCzHeaderInEpoch != nil {
+		l = m.ProofCzHeaderInEpoch.Size()
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.ProofEpochSealed != nil {
+		l = m.ProofEpochSealed.Size()
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if len(m.ProofEpochSubmitted) > 0 {
+		for _, e := range m.ProofEpochSubmitted {
+			l = e.Size()
+			n += 1 + l + sovZoneconcierge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FinalizedHeaderBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.EpochInfo != nil {
+		l = m.EpochInfo.Size()
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.RawCheckpoint != nil {
+		l = m.RawCheckpoint.Size()
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.BtcSubmissionKey != nil {
+		l = m.BtcSubmissionKey.Size()
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	return n
+}
+
+func (m *ArchivedEpochChainInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.EpochNum))
+	}
+	if m.ChainInfo != nil {
+		l = m.ChainInfo.Size()
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.Bundle != nil {
+		l = m.Bundle.Size()
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	return n
+}
+
+func (m *ChainInfoArchive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if len(m.EpochChainInfos) > 0 {
+		for _, e := range m.EpochChainInfos {
+			l = e.Size()
+			n += 1 + l + sovZoneconcierge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BTCChainSegment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcHeaders) > 0 {
+		for _, e := range m.BtcHeaders {
+			l = e.Size()
+			n += 1 + l + sovZoneconcierge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CovenantAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.Height))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.TotalVotingPower))
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovZoneconcierge(uint64(l))
+		}
+	}
+	if m.Truncated {
+		n += 2
+	}
+	return n
+}
+
+func (m *DelegationCovenantAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.VotingPower))
+	}
+	l = len(m.CovenantPksHash)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.CovenantQuorum != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.CovenantQuorum))
+	}
+	if m.CovenantSigCount != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.CovenantSigCount))
+	}
+	return n
+}
+
+func (m *BTCTimestampDelivery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.EpochNum != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.EpochNum))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.Attempts))
+	}
+	return n
+}
+
+func (m *ConsumerRegister) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.MaxHeadersPerBlock != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.MaxHeadersPerBlock))
+	}
+	return n
+}
+
+func sovZoneconcierge(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozZoneconcierge(x uint64) (n int) {
+	return sovZoneconcierge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IndexedHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZoneconcierge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonHeaderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BabylonHeaderHash = append(m.BabylonHeaderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BabylonHeaderHash == nil {
+				m.BabylonHeaderHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonHeaderHeight", wireType)
+			}
+			m.BabylonHeaderHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BabylonHeaderHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonEpoch", wireType)
+			}
+			m.BabylonEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BabylonEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonTxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BabylonTxHash = append(m.BabylonTxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BabylonTxHash == nil {
+				m.BabylonTxHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZoneconcierge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Forks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZoneconcierge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Forks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Forks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, &IndexedHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZoneconcierge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZoneconcierge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestHeader == nil {
+				m.LatestHeader = &IndexedHeader{}
+			}
+			if err := m.LatestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestForks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestForks == nil {
+				m.LatestForks = &Forks{}
+			}
+			if err := m.LatestForks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampedHeadersCount", wireType)
+			}
+			m.TimestampedHeadersCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimestampedHeadersCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZoneconcierge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalizedChainInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZoneconcierge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalizedChainInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalizedChainInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedChainInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalizedChainInfo == nil {
+				m.FinalizedChainInfo = &ChainInfo{}
+			}
+			if err := m.FinalizedChainInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EpochInfo == nil {
+				m.EpochInfo = &types.Epoch{}
+			}
+			if err := m.EpochInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RawCheckpoint == nil {
+				m.RawCheckpoint = &types1.RawCheckpoint{}
+			}
+			if err := m.RawCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcSubmissionKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BtcSubmissionKey == nil {
+				m.BtcSubmissionKey = &types2.SubmissionKey{}
+			}
+			if err := m.BtcSubmissionKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &ProofFinalizedChainInfo{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZoneconcierge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProofEpochSealed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZoneconcierge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProofEpochSealed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProofEpochSealed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSet = append(m.ValidatorSet, &types1.ValidatorWithBlsKey{})
+			if err := m.ValidatorSet[len(m.ValidatorSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofEpochInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProofEpochInfo == nil {
+				m.ProofEpochInfo = &crypto.ProofOps{}
+			}
+			if err := m.ProofEpochInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofEpochValSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProofEpochValSet == nil {
+				m.ProofEpochValSet = &crypto.ProofOps{}
+			}
+			if err := m.ProofEpochValSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZoneconcierge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *BTCChainSegment) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.BtcHeaders) > 0 {
-		for _, e := range m.BtcHeaders {
-			l = e.Size()
-			n += 1 + l + sovZoneconcierge(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovZoneconcierge(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozZoneconcierge(x uint64) (n int) {
-	return sovZoneconcierge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *IndexedHeader) Unmarshal(dAtA []byte) error {
+func (m *ProofFinalizedChainInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1274,17 +3343,17 @@ func (m *IndexedHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IndexedHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProofFinalizedChainInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IndexedHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProofFinalizedChainInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofCzHeaderInEpoch", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowZoneconcierge
@@ -1294,29 +3363,33 @@ func (m *IndexedHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthZoneconcierge
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthZoneconcierge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if m.ProofCzHeaderInEpoch == nil {
+				m.ProofCzHeaderInEpoch = &crypto.ProofOps{}
+			}
+			if err := m.ProofCzHeaderInEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofEpochSealed", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowZoneconcierge
@@ -1326,31 +3399,33 @@ func (m *IndexedHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthZoneconcierge
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthZoneconcierge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
+			if m.ProofEpochSealed == nil {
+				m.ProofEpochSealed = &ProofEpochSealed{}
+			}
+			if err := m.ProofEpochSealed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofEpochSubmitted", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowZoneconcierge
@@ -1360,14 +3435,79 @@ func (m *IndexedHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofEpochSubmitted = append(m.ProofEpochSubmitted, &types2.TransactionInfo{})
+			if err := m.ProofEpochSubmitted[len(m.ProofEpochSubmitted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZoneconcierge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalizedHeaderBundle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZoneconcierge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalizedHeaderBundle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalizedHeaderBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1394,18 +3534,18 @@ func (m *IndexedHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Time == nil {
-				m.Time = new(time.Time)
+			if m.Header == nil {
+				m.Header = &IndexedHeader{}
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BabylonHeaderHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochInfo", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowZoneconcierge
@@ -1415,31 +3555,33 @@ func (m *IndexedHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthZoneconcierge
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthZoneconcierge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BabylonHeaderHash = append(m.BabylonHeaderHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BabylonHeaderHash == nil {
-				m.BabylonHeaderHash = []byte{}
+			if m.EpochInfo == nil {
+				m.EpochInfo = &types.Epoch{}
+			}
+			if err := m.EpochInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BabylonHeaderHeight", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawCheckpoint", wireType)
 			}
-			m.BabylonHeaderHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowZoneconcierge
@@ -1449,35 +3591,33 @@ func (m *IndexedHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BabylonHeaderHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BabylonEpoch", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthZoneconcierge
 			}
-			m.BabylonEpoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowZoneconcierge
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BabylonEpoch |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
 			}
-		case 8:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RawCheckpoint == nil {
+				m.RawCheckpoint = &types1.RawCheckpoint{}
+			}
+			if err := m.RawCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BabylonTxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcSubmissionKey", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowZoneconcierge
@@ -1487,79 +3627,31 @@ func (m *IndexedHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthZoneconcierge
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthZoneconcierge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BabylonTxHash = append(m.BabylonTxHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BabylonTxHash == nil {
-				m.BabylonTxHash = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipZoneconcierge(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthZoneconcierge
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Forks) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowZoneconcierge
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.BtcSubmissionKey == nil {
+				m.BtcSubmissionKey = &types2.SubmissionKey{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.BtcSubmissionKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Forks: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Forks: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 3:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1586,8 +3678,10 @@ func (m *Forks) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Headers = append(m.Headers, &IndexedHeader{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Proof == nil {
+				m.Proof = &ProofFinalizedChainInfo{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1612,7 +3706,7 @@ func (m *Forks) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainInfo) Unmarshal(dAtA []byte) error {
+func (m *ArchivedEpochChainInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1635,17 +3729,17 @@ func (m *ChainInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArchivedEpochChainInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArchivedEpochChainInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
 			}
-			var stringLen uint64
+			m.EpochNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowZoneconcierge
@@ -1655,27 +3749,14 @@ func (m *ChainInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochNum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthZoneconcierge
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthZoneconcierge
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1702,16 +3783,16 @@ func (m *ChainInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LatestHeader == nil {
-				m.LatestHeader = &IndexedHeader{}
+			if m.ChainInfo == nil {
+				m.ChainInfo = &ChainInfo{}
 			}
-			if err := m.LatestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChainInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestForks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bundle", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1738,32 +3819,13 @@ func (m *ChainInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LatestForks == nil {
-				m.LatestForks = &Forks{}
+			if m.Bundle == nil {
+				m.Bundle = &FinalizedHeaderBundle{}
 			}
-			if err := m.LatestForks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Bundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimestampedHeadersCount", wireType)
-			}
-			m.TimestampedHeadersCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowZoneconcierge
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TimestampedHeadersCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipZoneconcierge(dAtA[iNdEx:])
@@ -1785,7 +3847,7 @@ func (m *ChainInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FinalizedChainInfo) Unmarshal(dAtA []byte) error {
+func (m *ChainInfoArchive) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1808,10 +3870,10 @@ func (m *FinalizedChainInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FinalizedChainInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainInfoArchive: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FinalizedChainInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainInfoArchive: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1848,7 +3910,7 @@ func (m *FinalizedChainInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedChainInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochChainInfos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1875,16 +3937,64 @@ func (m *FinalizedChainInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinalizedChainInfo == nil {
-				m.FinalizedChainInfo = &ChainInfo{}
-			}
-			if err := m.FinalizedChainInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EpochChainInfos = append(m.EpochChainInfos, &ArchivedEpochChainInfo{})
+			if err := m.EpochChainInfos[len(m.EpochChainInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZoneconcierge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCChainSegment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZoneconcierge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCChainSegment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCChainSegment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeaders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1911,18 +4021,66 @@ func (m *FinalizedChainInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EpochInfo == nil {
-				m.EpochInfo = &types.Epoch{}
-			}
-			if err := m.EpochInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BtcHeaders = append(m.BtcHeaders, &types3.BTCHeaderInfo{})
+			if err := m.BtcHeaders[len(m.BtcHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawCheckpoint", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZoneconcierge(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CovenantAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZoneconcierge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CovenantAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CovenantAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowZoneconcierge
@@ -1932,33 +4090,16 @@ func (m *FinalizedChainInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthZoneconcierge
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthZoneconcierge
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RawCheckpoint == nil {
-				m.RawCheckpoint = &types1.RawCheckpoint{}
-			}
-			if err := m.RawCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcSubmissionKey", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
 			}
-			var msglen int
+			m.TotalVotingPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowZoneconcierge
@@ -1968,31 +4109,14 @@ func (m *FinalizedChainInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TotalVotingPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthZoneconcierge
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthZoneconcierge
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BtcSubmissionKey == nil {
-				m.BtcSubmissionKey = &types2.SubmissionKey{}
-			}
-			if err := m.BtcSubmissionKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2019,13 +4143,31 @@ func (m *FinalizedChainInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Proof == nil {
-				m.Proof = &ProofFinalizedChainInfo{}
-			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delegations = append(m.Delegations, &DelegationCovenantAttestation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipZoneconcierge(dAtA[iNdEx:])
@@ -2047,7 +4189,7 @@ func (m *FinalizedChainInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProofEpochSealed) Unmarshal(dAtA []byte) error {
+func (m *DelegationCovenantAttestation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2070,17 +4212,17 @@ func (m *ProofEpochSealed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProofEpochSealed: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationCovenantAttestation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProofEpochSealed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationCovenantAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowZoneconcierge
@@ -2090,31 +4232,29 @@ func (m *ProofEpochSealed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthZoneconcierge
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthZoneconcierge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSet = append(m.ValidatorSet, &types1.ValidatorWithBlsKey{})
-			if err := m.ValidatorSet[len(m.ValidatorSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofEpochInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowZoneconcierge
@@ -2124,33 +4264,51 @@ func (m *ProofEpochSealed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthZoneconcierge
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthZoneconcierge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProofEpochInfo == nil {
-				m.ProofEpochInfo = &crypto.ProofOps{}
-			}
-			if err := m.ProofEpochInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofEpochValSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantPksHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowZoneconcierge
@@ -2160,28 +4318,64 @@ func (m *ProofEpochSealed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthZoneconcierge
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthZoneconcierge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProofEpochValSet == nil {
-				m.ProofEpochValSet = &crypto.ProofOps{}
-			}
-			if err := m.ProofEpochValSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CovenantPksHash = append(m.CovenantPksHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CovenantPksHash == nil {
+				m.CovenantPksHash = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantQuorum", wireType)
+			}
+			m.CovenantQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CovenantQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSigCount", wireType)
+			}
+			m.CovenantSigCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CovenantSigCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipZoneconcierge(dAtA[iNdEx:])
@@ -2203,7 +4397,7 @@ func (m *ProofEpochSealed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProofFinalizedChainInfo) Unmarshal(dAtA []byte) error {
+func (m *BTCTimestampDelivery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2226,17 +4420,17 @@ func (m *ProofFinalizedChainInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProofFinalizedChainInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: BTCTimestampDelivery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProofFinalizedChainInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BTCTimestampDelivery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofCzHeaderInEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowZoneconcierge
@@ -2246,33 +4440,29 @@ func (m *ProofFinalizedChainInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthZoneconcierge
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthZoneconcierge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProofCzHeaderInEpoch == nil {
-				m.ProofCzHeaderInEpoch = &crypto.ProofOps{}
-			}
-			if err := m.ProofCzHeaderInEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofEpochSealed", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
 			}
-			var msglen int
+			m.EpochNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowZoneconcierge
@@ -2282,33 +4472,16 @@ func (m *ProofFinalizedChainInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EpochNum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthZoneconcierge
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthZoneconcierge
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ProofEpochSealed == nil {
-				m.ProofEpochSealed = &ProofEpochSealed{}
-			}
-			if err := m.ProofEpochSealed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofEpochSubmitted", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
 			}
-			var msglen int
+			m.Attempts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowZoneconcierge
@@ -2318,26 +4491,11 @@ func (m *ProofFinalizedChainInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Attempts |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthZoneconcierge
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthZoneconcierge
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProofEpochSubmitted = append(m.ProofEpochSubmitted, &types2.TransactionInfo{})
-			if err := m.ProofEpochSubmitted[len(m.ProofEpochSubmitted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipZoneconcierge(dAtA[iNdEx:])
@@ -2359,7 +4517,7 @@ func (m *ProofFinalizedChainInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BTCChainSegment) Unmarshal(dAtA []byte) error {
+func (m *ConsumerRegister) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2382,17 +4540,17 @@ func (m *BTCChainSegment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BTCChainSegment: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerRegister: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BTCChainSegment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerRegister: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeaders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowZoneconcierge
@@ -2402,26 +4560,107 @@ func (m *BTCChainSegment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthZoneconcierge
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthZoneconcierge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BtcHeaders = append(m.BtcHeaders, &types3.BTCHeaderInfo{})
-			if err := m.BtcHeaders[len(m.BtcHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeadersPerBlock", wireType)
+			}
+			m.MaxHeadersPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeadersPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipZoneconcierge(dAtA[iNdEx:])
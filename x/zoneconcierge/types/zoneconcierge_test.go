@@ -0,0 +1,42 @@
+package types_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/zoneconcierge/types"
+)
+
+func FuzzCovenantPKsHash(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		numPKs := int(datagen.RandomInt(r, 10)) + 2
+		pks := make([]bbn.BIP340PubKey, 0, numPKs)
+		for i := 0; i < numPKs; i++ {
+			pk, err := datagen.GenRandomBIP340PubKey(r)
+			require.NoError(t, err)
+			pks = append(pks, *pk)
+		}
+
+		// the hash is deterministic
+		hash := types.CovenantPKsHash(pks)
+		require.Len(t, hash, 32)
+		require.Equal(t, hash, types.CovenantPKsHash(pks))
+
+		// the hash commits to the order of the PKs
+		swapped := make([]bbn.BIP340PubKey, numPKs)
+		copy(swapped, pks)
+		swapped[0], swapped[1] = swapped[1], swapped[0]
+		require.NotEqual(t, hash, types.CovenantPKsHash(swapped))
+
+		// the hash commits to every PK
+		require.NotEqual(t, hash, types.CovenantPKsHash(pks[1:]))
+	})
+}